@@ -139,6 +139,10 @@ gardenClientConnection:
   kubeconfigValidity:
 {{ toYaml .Values.config.gardenClientConnection.kubeconfigValidity | indent 4 }}
   {{- end }}
+{{- if .Values.config.gardenClientConnection.bootstrapAttestation }}
+  bootstrapAttestation:
+{{ toYaml .Values.config.gardenClientConnection.bootstrapAttestation | indent 4 }}
+  {{- end }}
   {{- if .Values.config.gardenClientConnection.kubeconfig }}
   kubeconfig: /etc/gardenlet/kubeconfig-garden/kubeconfig
   {{- end }}
@@ -177,6 +181,12 @@ controllers:
     {{- end }}
   bastion:
     concurrentSyncs: {{ required ".Values.config.controllers.bastion.concurrentSyncs is required" .Values.config.controllers.bastion.concurrentSyncs }}
+    {{- if .Values.config.controllers.bastion.defaultIdleTimeout }}
+    defaultIdleTimeout: {{ .Values.config.controllers.bastion.defaultIdleTimeout }}
+    {{- end }}
+    {{- if .Values.config.controllers.bastion.defaultSessionRecording }}
+    defaultSessionRecording: {{ .Values.config.controllers.bastion.defaultSessionRecording }}
+    {{- end }}
   {{- if .Values.config.controllers.controllerInstallation }}
   controllerInstallation:
     concurrentSyncs: {{ required ".Values.config.controllers.controllerInstallation.concurrentSyncs is required" .Values.config.controllers.controllerInstallation.concurrentSyncs }}
@@ -220,6 +230,14 @@ controllers:
     {{- if .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
     dnsEntryTTLSeconds: {{ .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
     {{- end }}
+    {{- if .Values.config.controllers.shoot.controlPlaneMigration }}
+    controlPlaneMigration:
+{{ toYaml .Values.config.controllers.shoot.controlPlaneMigration | indent 6 }}
+    {{- end }}
+    {{- if .Values.config.controllers.shoot.imageOverrides }}
+    imageOverrides:
+{{ toYaml .Values.config.controllers.shoot.imageOverrides | indent 6 }}
+    {{- end }}
   shootCare:
     concurrentSyncs: {{ required ".Values.config.controllers.shootCare.concurrentSyncs is required" .Values.config.controllers.shootCare.concurrentSyncs }}
     syncPeriod: {{ required ".Values.config.controllers.shootCare.syncPeriod is required" .Values.config.controllers.shootCare.syncPeriod }}
@@ -238,12 +256,32 @@ controllers:
 {{ toYaml .Values.config.controllers.shootCare.conditionThresholds | indent 4 }}
     {{- end }}
     webhookRemediatorEnabled: {{ required ".Values.config.controllers.shootCare.webhookRemediatorEnabled is required" .Values.config.controllers.shootCare.webhookRemediatorEnabled }}
+    {{- if .Values.config.controllers.shootCare.remediation }}
+    remediation:
+{{ toYaml .Values.config.controllers.shootCare.remediation | indent 6 }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootCare.apiServerAvailabilityProbing }}
+    apiServerAvailabilityProbing:
+{{ toYaml .Values.config.controllers.shootCare.apiServerAvailabilityProbing | indent 6 }}
+    {{- end }}
   seedCare:
     syncPeriod: {{ required ".Values.config.controllers.seedCare.syncPeriod is required" .Values.config.controllers.seedCare.syncPeriod }}
     conditionThresholds:
     {{- if .Values.config.controllers.seedCare.conditionThresholds }}
 {{ toYaml .Values.config.controllers.seedCare.conditionThresholds | indent 4 }}
     {{- end }}
+    {{- if .Values.config.controllers.seedCare.capacityPressure }}
+    capacityPressure:
+{{ toYaml .Values.config.controllers.seedCare.capacityPressure | indent 6 }}
+    {{- end }}
+    {{- if .Values.config.controllers.seedCare.imageVerification }}
+    imageVerification:
+{{ toYaml .Values.config.controllers.seedCare.imageVerification | indent 6 }}
+    {{- end }}
+  {{- if .Values.config.controllers.seedCRD }}
+  seedCRD:
+    syncPeriod: {{ required ".Values.config.controllers.seedCRD.syncPeriod is required" .Values.config.controllers.seedCRD.syncPeriod }}
+  {{- end }}
   {{- if .Values.config.controllers.shootState }}
   shootState:
     concurrentSyncs: {{ required ".Values.config.controllers.shootState.concurrentSyncs is required" .Values.config.controllers.shootState.concurrentSyncs }}
@@ -253,6 +291,22 @@ controllers:
   shootStatus:
     concurrentSyncs: {{ required ".Values.config.controllers.shootStatus.concurrentSyncs is required" .Values.config.controllers.shootStatus.concurrentSyncs }}
   {{- end }}
+  {{- if .Values.config.controllers.shootDebugPod }}
+  shootDebugPod:
+    concurrentSyncs: {{ required ".Values.config.controllers.shootDebugPod.concurrentSyncs is required" .Values.config.controllers.shootDebugPod.concurrentSyncs }}
+  {{- end }}
+  {{- if .Values.config.controllers.shootEventMirror }}
+  shootEventMirror:
+{{ toYaml .Values.config.controllers.shootEventMirror | indent 4 }}
+  {{- end }}
+  {{- if .Values.config.controllers.shootAvailabilityReport }}
+  shootAvailabilityReport:
+{{ toYaml .Values.config.controllers.shootAvailabilityReport | indent 4 }}
+  {{- end }}
+  {{- if .Values.config.controllers.shootInfrastructureDrift }}
+  shootInfrastructureDrift:
+{{ toYaml .Values.config.controllers.shootInfrastructureDrift | indent 4 }}
+  {{- end }}
   {{- if .Values.config.controllers.managedSeed }}
   managedSeed:
     concurrentSyncs: {{ required ".Values.config.controllers.managedSeed.concurrentSyncs is required" .Values.config.controllers.managedSeed.concurrentSyncs }}
@@ -365,6 +419,10 @@ exposureClassHandlers:
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
 {{- end}}
+{{- if .Values.config.offlineBundle }}
+offlineBundle:
+{{ toYaml .Values.config.offlineBundle | indent 2 }}
+{{- end }}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
@@ -145,7 +145,7 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 			{
 				APIGroups: []string{""},
 				Resources: []string{"pods"},
-				Verbs:     []string{"list", "watch", "delete", "deletecollection"},
+				Verbs:     []string{"create", "list", "watch", "delete", "deletecollection"},
 			},
 			{
 				APIGroups: []string{""},
@@ -173,10 +173,15 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				ResourceNames: []string{"vali-vali-0"},
 				Verbs:         []string{"delete"},
 			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"limitranges", "resourcequotas"},
+				Verbs:     []string{"create", "delete", "get", "list", "watch", "patch", "update"},
+			},
 			{
 				APIGroups: []string{""},
 				Resources: []string{"events"},
-				Verbs:     []string{"get", "list", "create", "patch", "update"},
+				Verbs:     []string{"get", "list", "watch", "create", "patch", "update"},
 			},
 			{
 				APIGroups: []string{"admissionregistration.k8s.io"},
@@ -250,6 +255,7 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 					"controlplanes.extensions.gardener.cloud",
 					"networks.extensions.gardener.cloud",
 					"selfhostedshootexposures.extensions.gardener.cloud",
+					"shootleftovers.extensions.gardener.cloud",
 					"verticalpodautoscalers.autoscaling.k8s.io",
 					"verticalpodautoscalercheckpoints.autoscaling.k8s.io",
 					"perses.perses.dev",
@@ -286,12 +292,12 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 			},
 			{
 				APIGroups: []string{"extensions.gardener.cloud"},
-				Resources: []string{"backupbuckets", "backupentries", "bastions", "clusters", "containerruntimes", "controlplanes", "dnsrecords", "extensions", "infrastructures", "networks", "operatingsystemconfigs", "selfhostedshootexposures", "workers"},
+				Resources: []string{"backupbuckets", "backupentries", "bastions", "clusters", "containerruntimes", "controlplanes", "dnsrecords", "extensions", "infrastructures", "networks", "operatingsystemconfigs", "selfhostedshootexposures", "shootleftovers", "workers"},
 				Verbs:     []string{"create", "delete", "get", "list", "watch", "patch", "update"},
 			},
 			{
 				APIGroups: []string{"extensions.gardener.cloud"},
-				Resources: []string{"backupbuckets/status", "backupentries/status", "containerruntimes/status", "controlplanes/status", "dnsrecords/status", "extensions/status", "infrastructures/status", "networks/status", "operatingsystemconfigs/status", "selfhostedshootexposures/status", "workers/status"},
+				Resources: []string{"backupbuckets/status", "backupentries/status", "containerruntimes/status", "controlplanes/status", "dnsrecords/status", "extensions/status", "infrastructures/status", "networks/status", "operatingsystemconfigs/status", "selfhostedshootexposures/status", "shootleftovers/status", "workers/status"},
 				Verbs:     []string{"patch", "update"},
 			},
 			{
@@ -641,6 +647,10 @@ func ComputeExpectedGardenletConfiguration(
 				AutoRotationJitterPercentageMin: ptr.To[int32](70),
 				AutoRotationJitterPercentageMax: ptr.To[int32](90),
 			},
+			ObjectCache: &gardenletconfigv1alpha1.GardenObjectCache{
+				MaxIdleTime:  &metav1.Duration{Duration: 10 * time.Minute},
+				CacheSecrets: ptr.To(false),
+			},
 		},
 		SeedClientConnection: &gardenletconfigv1alpha1.SeedClientConnection{
 			ClientConnectionConfiguration: componentbaseconfigv1alpha1.ClientConnectionConfiguration{
@@ -759,6 +769,9 @@ func ComputeExpectedGardenletConfiguration(
 					},
 				},
 			},
+			SeedCRD: &gardenletconfigv1alpha1.SeedCRDControllerConfiguration{
+				SyncPeriod: &metav1.Duration{Duration: time.Hour},
+			},
 			ShootState: &gardenletconfigv1alpha1.ShootStateControllerConfiguration{
 				ConcurrentSyncs: &five,
 				SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
@@ -766,6 +779,9 @@ func ComputeExpectedGardenletConfiguration(
 			ShootStatus: &gardenletconfigv1alpha1.ShootStatusControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
+			ShootDebugPod: &gardenletconfigv1alpha1.ShootDebugPodControllerConfiguration{
+				ConcurrentSyncs: &five,
+			},
 			TokenRequestorServiceAccount: &gardenletconfigv1alpha1.TokenRequestorServiceAccountControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
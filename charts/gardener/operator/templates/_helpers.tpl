@@ -104,6 +104,15 @@ config.yaml: |
 {{ toYaml .Values.config.controllers.gardenCare.conditionThresholds | indent 6 }}
       {{- end }}
     {{- end }}
+    {{- if .Values.config.controllers.gardenETCDCapacity }}
+    gardenETCDCapacity:
+      {{- if .Values.config.controllers.gardenETCDCapacity.syncPeriod }}
+      syncPeriod: {{ .Values.config.controllers.gardenETCDCapacity.syncPeriod }}
+      {{- end }}
+      {{- if .Values.config.controllers.gardenETCDCapacity.quotaUtilizationThresholdPercentage }}
+      quotaUtilizationThresholdPercentage: {{ .Values.config.controllers.gardenETCDCapacity.quotaUtilizationThresholdPercentage }}
+      {{- end }}
+    {{- end }}
     {{- if .Values.config.controllers.gardenletDeployer }}
     gardenletDeployer:
       {{- if .Values.config.controllers.gardenletDeployer.concurrentSyncs }}
@@ -28,8 +28,11 @@ import (
 	"k8s.io/client-go/dynamic"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
 	logsv1 "k8s.io/component-base/logs/api/v1"
 	"k8s.io/component-base/version"
 	"k8s.io/component-base/version/verflag"
@@ -112,6 +115,7 @@ type Options struct {
 	SeedManagementInformerFactory seedmanagementinformers.SharedInformerFactory
 	SettingsInformerFactory       settingsinformers.SharedInformerFactory
 	SecurityInformerFactory       securityinformers.SharedInformerFactory
+	EventBroadcaster              record.EventBroadcaster
 
 	Logs *logsv1.LoggingConfiguration
 }
@@ -190,6 +194,9 @@ func (o *Options) config(kubeAPIServerConfig *rest.Config, kubeClient *kubernete
 
 	apiConfig.SubjectAccessReviewer = kubeClient.AuthorizationV1().SubjectAccessReviews()
 
+	o.EventBroadcaster = record.NewBroadcaster()
+	apiConfig.EventRecorder = o.EventBroadcaster.NewRecorder(kubernetesscheme.Scheme, corev1.EventSource{Component: "gardener-apiserver"})
+
 	protobufLoopbackConfig := *gardenerAPIServerConfig.LoopbackClientConfig
 	if protobufLoopbackConfig.ContentType == "" {
 		protobufLoopbackConfig.ContentType = runtime.ContentTypeProtobuf
@@ -331,6 +338,17 @@ func (o *Options) Run(ctx context.Context) error {
 		return err
 	}
 
+	if err := server.GenericAPIServer.AddPostStartHook("start-gardener-apiserver-event-broadcaster", func(context genericapiserver.PostStartHookContext) error {
+		o.EventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+		go func() {
+			<-context.Done()
+			o.EventBroadcaster.Shutdown()
+		}()
+		return nil
+	}); err != nil {
+		return err
+	}
+
 	if err := server.GenericAPIServer.AddPostStartHook("bootstrap-garden-cluster", func(_ genericapiserver.PostStartHookContext) error {
 		for _, namespace := range []string{gardencorev1beta1.GardenerSeedLeaseNamespace, gardencorev1beta1.GardenerShootIssuerNamespace} {
 			if _, err := kubeClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{}); client.IgnoreNotFound(err) != nil {
@@ -16,11 +16,14 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/component-base/version/verflag"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	controllerwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -29,6 +32,7 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/webhook/certificates"
 	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/operator/v1alpha1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	clientmapbuilder "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/builder"
 	"github.com/gardener/gardener/pkg/controllerutils/routes"
@@ -139,6 +143,11 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 		return fmt.Errorf("failed verifying Gardener version: %w", err)
 	}
 
+	log.Info("Applying feature gate overrides")
+	if err := applyFeatureGateOverrides(ctx, log, mgr.GetAPIReader()); err != nil {
+		return fmt.Errorf("failed applying feature gate overrides: %w", err)
+	}
+
 	log.Info("Adding certificate management to manager")
 	mode, url := extensionswebhook.ModeService, os.Getenv("WEBHOOK_URL")
 	if v := os.Getenv("WEBHOOK_MODE"); v != "" {
@@ -202,3 +211,27 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 	log.Info("Starting manager")
 	return mgr.Start(ctx)
 }
+
+// applyFeatureGateOverrides applies the feature gate overrides specified on the Garden object (if it exists) and
+// registers the metrics exposing the states of the feature gates.
+func applyFeatureGateOverrides(ctx context.Context, log logr.Logger, reader client.Reader) error {
+	gardenList := &operatorv1alpha1.GardenList{}
+	if err := reader.List(ctx, gardenList, client.Limit(1)); err != nil && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("failed listing Gardens: %w", err)
+	}
+
+	var overrides map[string]bool
+	if len(gardenList.Items) > 0 {
+		var err error
+		overrides, err = features.ApplyOverrides(features.DefaultFeatureGate, gardenList.Items[0].Annotations)
+		if err != nil {
+			return err
+		}
+
+		if len(overrides) > 0 {
+			log.Info("Applied feature gate overrides from Garden", "overrides", overrides, "featureGates", features.DefaultFeatureGate)
+		}
+	}
+
+	return features.RegisterMetrics(runtimemetrics.Registry, features.DefaultFeatureGate, overrides)
+}
@@ -149,6 +149,8 @@ func addAllFieldIndexes(ctx context.Context, i client.FieldIndexer) error {
 	for _, fn := range []func(context.Context, client.FieldIndexer) error{
 		// core API group
 		indexer.AddProjectNamespace,
+		indexer.AddShootSeedName,
+		indexer.AddShootStatusSeedName,
 	} {
 		if err := fn(ctx, i); err != nil {
 			return err
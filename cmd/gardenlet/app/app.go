@@ -40,9 +40,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/gardener/gardener/cmd/utils/initrun"
+	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/api/indexer"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
@@ -64,6 +66,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrap/certificate"
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrappers"
 	"github.com/gardener/gardener/pkg/gardenlet/controller"
+	"github.com/gardener/gardener/pkg/gardenlet/offlinebundle"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -110,6 +113,16 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 		cfg.SeedClientConnection.Kubeconfig = kubeconfig
 	}
 
+	var offlineBundle *offlinebundle.Bundle
+	if cfg.OfflineBundle != nil {
+		log.Info("Loading offline bundle", "path", cfg.OfflineBundle.Path)
+		var err error
+		if offlineBundle, err = offlinebundle.Load(cfg.OfflineBundle); err != nil {
+			return err
+		}
+		imagevector.Override(offlineBundle.Containers, offlineBundle.Charts)
+	}
+
 	log.Info("Getting rest config for runtime cluster")
 	runtimeRESTConfig, err := kubernetes.RESTConfigFromClientConnectionConfiguration(&cfg.SeedClientConnection.ClientConnectionConfiguration, nil)
 	if err != nil {
@@ -214,6 +227,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 					selfHostedShootInfo:       selfHostedShootInfo,
 					healthManager:             healthManager,
 					kubeconfigBootstrapResult: kubeconfigBootstrapResult,
+					offlineBundle:             offlineBundle,
 				},
 			},
 		}
@@ -243,6 +257,7 @@ type garden struct {
 	selfHostedShootInfo       *gardenlet.SelfHostedShootInfo
 	healthManager             gardenerhealthz.Manager
 	kubeconfigBootstrapResult *bootstrappers.KubeconfigBootstrapResult
+	offlineBundle             *offlinebundle.Bundle
 }
 
 func (g *garden) Start(ctx context.Context) error {
@@ -258,6 +273,21 @@ func (g *garden) Start(ctx context.Context) error {
 		return err
 	}
 
+	gardenRESTConfig, err = kubernetes.ApplyRequestOptions(gardenRESTConfig, kubernetes.WithRateLimiterMetrics("garden"))
+	if err != nil {
+		return err
+	}
+
+	var (
+		objectCacheConfig     = g.config.GardenClientConnection.ObjectCache
+		singleObjectCacheFunc = func(obj client.Object) cache.NewCacheFunc {
+			return kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, obj, objectCacheConfig.MaxIdleTime.Duration)
+		}
+		// secretsCache caches secrets outside the seed namespace which are read individually, see the `NewClient`
+		// function below.
+		secretsCache cache.Cache
+	)
+
 	log.Info("Setting up cluster object for garden")
 	gardenCluster, err := cluster.New(gardenRESTConfig, func(opts *cluster.Options) {
 		opts.Scheme = kubernetes.GardenScheme
@@ -274,6 +304,13 @@ func (g *garden) Start(ctx context.Context) error {
 			seedNamespace := gardenerutils.ComputeGardenNamespace(g.config.SeedConfig.Name)
 
 			opts.NewCache = func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
+				if ptr.Deref(objectCacheConfig.CacheSecrets, false) {
+					var err error
+					if secretsCache, err = singleObjectCacheFunc(&corev1.Secret{})(config, opts); err != nil {
+						return nil, err
+					}
+				}
+
 				// gardenlet should watch only objects which are related to the seed it is responsible for.
 				opts.ByObject = map[client.Object]cache.ByObject{
 					&gardencorev1beta1.ControllerInstallation{}: {
@@ -310,20 +347,20 @@ func (g *garden) Start(ctx context.Context) error {
 						// Gardenlet does not have the required RBAC permissions for listing/watching the following
 						// resources on cluster level. Hence, we need to watch them individually with the help of a
 						// SingleObject cache.
-						&corev1.ConfigMap{}:                         kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &corev1.ConfigMap{}),
-						&corev1.Namespace{}:                         kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &corev1.Namespace{}),
-						&coordinationv1.Lease{}:                     kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &coordinationv1.Lease{}),
-						&certificatesv1.CertificateSigningRequest{}: kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &certificatesv1.CertificateSigningRequest{}),
-						&gardencorev1.ControllerDeployment{}:        kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1.ControllerDeployment{}),
-						&gardencorev1beta1.CloudProfile{}:           kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.CloudProfile{}),
-						&gardencorev1beta1.NamespacedCloudProfile{}: kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.NamespacedCloudProfile{}),
-						&gardencorev1beta1.ExposureClass{}:          kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.ExposureClass{}),
-						&gardencorev1beta1.InternalSecret{}:         kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.InternalSecret{}),
-						&gardencorev1beta1.Project{}:                kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.Project{}),
-						&gardencorev1beta1.SecretBinding{}:          kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.SecretBinding{}),
-						&gardencorev1beta1.ShootState{}:             kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.ShootState{}),
-						&securityv1alpha1.CredentialsBinding{}:      kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &securityv1alpha1.CredentialsBinding{}),
-						&securityv1alpha1.WorkloadIdentity{}:        kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &securityv1alpha1.WorkloadIdentity{}),
+						&corev1.ConfigMap{}:                         singleObjectCacheFunc(&corev1.ConfigMap{}),
+						&corev1.Namespace{}:                         singleObjectCacheFunc(&corev1.Namespace{}),
+						&coordinationv1.Lease{}:                     singleObjectCacheFunc(&coordinationv1.Lease{}),
+						&certificatesv1.CertificateSigningRequest{}: singleObjectCacheFunc(&certificatesv1.CertificateSigningRequest{}),
+						&gardencorev1.ControllerDeployment{}:        singleObjectCacheFunc(&gardencorev1.ControllerDeployment{}),
+						&gardencorev1beta1.CloudProfile{}:           singleObjectCacheFunc(&gardencorev1beta1.CloudProfile{}),
+						&gardencorev1beta1.NamespacedCloudProfile{}: singleObjectCacheFunc(&gardencorev1beta1.NamespacedCloudProfile{}),
+						&gardencorev1beta1.ExposureClass{}:          singleObjectCacheFunc(&gardencorev1beta1.ExposureClass{}),
+						&gardencorev1beta1.InternalSecret{}:         singleObjectCacheFunc(&gardencorev1beta1.InternalSecret{}),
+						&gardencorev1beta1.Project{}:                singleObjectCacheFunc(&gardencorev1beta1.Project{}),
+						&gardencorev1beta1.SecretBinding{}:          singleObjectCacheFunc(&gardencorev1beta1.SecretBinding{}),
+						&gardencorev1beta1.ShootState{}:             singleObjectCacheFunc(&gardencorev1beta1.ShootState{}),
+						&securityv1alpha1.CredentialsBinding{}:      singleObjectCacheFunc(&securityv1alpha1.CredentialsBinding{}),
+						&securityv1alpha1.WorkloadIdentity{}:        singleObjectCacheFunc(&securityv1alpha1.WorkloadIdentity{}),
 					},
 					kubernetes.GardenScheme,
 				)(config, opts)
@@ -333,6 +370,8 @@ func (g *garden) Start(ctx context.Context) error {
 			// read a secret from another namespace. There might be secrets in namespace other than the seed-specific
 			// namespace (e.g., backup secret in the SeedSpec). Hence, let's use a fallback client which falls back to an
 			// uncached reader in case it fails to read objects from the cache.
+			// If enabled, such secrets are read via a cache watching them individually instead, which reduces the load on
+			// the garden cluster significantly.
 			opts.NewClient = func(config *rest.Config, options client.Options) (client.Client, error) {
 				uncachedOptions := options
 				uncachedOptions.Cache = nil
@@ -346,14 +385,20 @@ func (g *garden) Start(ctx context.Context) error {
 					return nil, err
 				}
 
-				return &kubernetes.FallbackClient{
+				fallbackClient := &kubernetes.FallbackClient{
 					Client: cachedClient,
 					Reader: uncachedClient,
 					KindToNamespaces: map[string]sets.Set[string]{
 						"Secret":         sets.New(seedNamespace),
 						"ServiceAccount": sets.New(seedNamespace),
 					},
-				}, nil
+				}
+
+				if secretsCache != nil {
+					fallbackClient.KindToReader = map[string]client.Reader{"Secret": secretsCache}
+				}
+
+				return fallbackClient, nil
 			}
 		} else {
 			opts.NewCache = func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
@@ -400,9 +445,9 @@ func (g *garden) Start(ctx context.Context) error {
 						// Gardenlet does not have the required RBAC permissions for listing/watching the following
 						// resources on cluster level. Hence, we need to watch them individually with the help of a
 						// SingleObject cache.
-						&corev1.ConfigMap{}:      kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &corev1.ConfigMap{}),
-						&corev1.Secret{}:         kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &corev1.Secret{}),
-						&corev1.ServiceAccount{}: kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &corev1.ServiceAccount{}),
+						&corev1.ConfigMap{}:      singleObjectCacheFunc(&corev1.ConfigMap{}),
+						&corev1.Secret{}:         singleObjectCacheFunc(&corev1.Secret{}),
+						&corev1.ServiceAccount{}: singleObjectCacheFunc(&corev1.ServiceAccount{}),
 					},
 					kubernetes.GardenScheme,
 				)(config, opts)
@@ -432,6 +477,13 @@ func (g *garden) Start(ctx context.Context) error {
 		return fmt.Errorf("failed adding indexes: %w", err)
 	}
 
+	if secretsCache != nil {
+		log.Info("Adding cache for secrets outside the seed namespace to manager")
+		if err := g.mgr.Add(secretsCache); err != nil {
+			return fmt.Errorf("failed adding cache for secrets outside the seed namespace to manager: %w", err)
+		}
+	}
+
 	log.Info("Adding garden cluster to manager")
 	if err := g.mgr.Add(gardenCluster); err != nil {
 		return fmt.Errorf("failed adding garden cluster to manager: %w", err)
@@ -452,6 +504,10 @@ func (g *garden) Start(ctx context.Context) error {
 		}
 	}
 
+	if err := g.applyFeatureGateOverrides(ctx, log, gardenCluster.GetAPIReader()); err != nil {
+		return err
+	}
+
 	log.Info("Updating last operation status of processing Shoots to 'Aborted'")
 	if err := g.updateProcessingShootStatusToAborted(ctx, gardenCluster.GetClient()); err != nil {
 		return err
@@ -472,6 +528,10 @@ func (g *garden) Start(ctx context.Context) error {
 		WithGardenClient(gardenCluster.GetClient()).
 		WithSeedClient(g.mgr.GetClient()).
 		WithClientConnectionConfig(&g.config.ShootClientConnection.ClientConnectionConfiguration).
+		WithClientSetOptions(
+			kubernetes.WithUserAgentSuffix("shoot-client"),
+			kubernetes.WithRateLimiterMetrics("shoot"),
+		).
 		Build(log)
 	if err != nil {
 		return fmt.Errorf("failed to build shoot ClientMap: %w", err)
@@ -527,6 +587,7 @@ func (g *garden) Start(ctx context.Context) error {
 		g.config,
 		g.healthManager,
 		shoot,
+		g.offlineBundle,
 	); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
 	}
@@ -534,6 +595,31 @@ func (g *garden) Start(ctx context.Context) error {
 	return nil
 }
 
+// applyFeatureGateOverrides applies the feature gate overrides specified on the Seed object and registers the metrics
+// exposing the states of the feature gates.
+func (g *garden) applyFeatureGateOverrides(ctx context.Context, log logr.Logger, gardenReader client.Reader) error {
+	var overrides map[string]bool
+
+	if !gardenlet.IsResponsibleForSelfHostedShoot() {
+		seed := &gardencorev1beta1.Seed{}
+		if err := gardenReader.Get(ctx, client.ObjectKey{Name: g.config.SeedConfig.Name}, seed); err != nil {
+			return fmt.Errorf("failed reading Seed %s: %w", g.config.SeedConfig.Name, err)
+		}
+
+		var err error
+		overrides, err = features.ApplyOverrides(features.DefaultFeatureGate, seed.Annotations)
+		if err != nil {
+			return err
+		}
+
+		if len(overrides) > 0 {
+			log.Info("Applied feature gate overrides from Seed", "overrides", overrides, "featureGates", features.DefaultFeatureGate)
+		}
+	}
+
+	return features.RegisterMetrics(runtimemetrics.Registry, features.DefaultFeatureGate, overrides)
+}
+
 func (g *garden) registerSeed(ctx context.Context, gardenClient client.Client) error {
 	seed := &gardencorev1beta1.Seed{
 		ObjectMeta: metav1.ObjectMeta{
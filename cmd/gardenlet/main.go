@@ -10,6 +10,8 @@ import (
 
 	"github.com/gardener/gardener/cmd/gardenlet/app"
 	"github.com/gardener/gardener/cmd/utils"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/gardenlet/features"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
@@ -19,6 +21,8 @@ func main() {
 	features.RegisterFeatureGates()
 
 	flow.RegisterMetrics(runtimemetrics.Registry)
+	kubernetes.RegisterMetrics(runtimemetrics.Registry)
+	clientmap.RegisterMetrics(runtimemetrics.Registry)
 
 	if err := app.NewCommand().ExecuteContext(signals.SetupSignalHandler()); err != nil {
 		panic(err)
@@ -5,9 +5,12 @@
 package cmd
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"os"
+	goruntime "runtime"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/pflag"
@@ -23,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	"github.com/gardener/gardener/pkg/logger"
 )
 
@@ -33,6 +37,15 @@ const (
 	LeaderElectionIDFlag = "leader-election-id"
 	// LeaderElectionNamespaceFlag is the name of the command line flag to specify the leader election namespace.
 	LeaderElectionNamespaceFlag = "leader-election-namespace"
+	// LeaderElectionLeaseDurationFlag is the name of the command line flag to specify the duration that non-leader
+	// candidates will wait to force acquire leadership.
+	LeaderElectionLeaseDurationFlag = "leader-election-lease-duration"
+	// LeaderElectionRenewDeadlineFlag is the name of the command line flag to specify the duration that the acting
+	// leader will retry refreshing leadership before giving up.
+	LeaderElectionRenewDeadlineFlag = "leader-election-renew-deadline"
+	// LeaderElectionRetryPeriodFlag is the name of the command line flag to specify the duration the leader election
+	// clients should wait between tries of actions.
+	LeaderElectionRetryPeriodFlag = "leader-election-retry-period"
 	// WebhookServerHostFlag is the name of the command line flag to specify the webhook config host for 'url' mode.
 	WebhookServerHostFlag = "webhook-config-server-host"
 	// WebhookServerPortFlag is the name of the command line flag to specify the webhook server port.
@@ -46,6 +59,12 @@ const (
 	// HealthBindAddressFlag is the name of the command line flag to specify the TCP address that the controller
 	// should bind to for serving health probes
 	HealthBindAddressFlag = "health-bind-address"
+	// EnableProfilingFlag is the name of the command line flag to specify whether the profiling handlers are served
+	// via the metrics server.
+	EnableProfilingFlag = "enable-profiling"
+	// EnableContentionProfilingFlag is the name of the command line flag to specify whether lock contention profiling
+	// is enabled. It is only considered if profiling is enabled.
+	EnableContentionProfilingFlag = "enable-contention-profiling"
 
 	// MaxConcurrentReconcilesFlag is the name of the command line flag to specify the maximum number of
 	// concurrent reconciliations a controller can do.
@@ -77,6 +96,15 @@ const (
 	LogFormatFlag = "log-format"
 )
 
+const (
+	// DefaultLeaderElectionLeaseDuration is the default value for the leader election lease duration.
+	DefaultLeaderElectionLeaseDuration = 15 * time.Second
+	// DefaultLeaderElectionRenewDeadline is the default value for the leader election renew deadline.
+	DefaultLeaderElectionRenewDeadline = 10 * time.Second
+	// DefaultLeaderElectionRetryPeriod is the default value for the leader election retry period.
+	DefaultLeaderElectionRetryPeriod = 2 * time.Second
+)
+
 // LeaderElectionNameID returns a leader election ID for the given name.
 func LeaderElectionNameID(name string) string {
 	return name + "-leader-election"
@@ -170,6 +198,12 @@ type ManagerOptions struct {
 	LeaderElectionID string
 	// LeaderElectionNamespace is the namespace to do leader election in.
 	LeaderElectionNamespace string
+	// LeaderElectionLeaseDuration is the duration that non-leader candidates will wait to force acquire leadership.
+	LeaderElectionLeaseDuration time.Duration
+	// LeaderElectionRenewDeadline is the duration that the acting leader will retry refreshing leadership before giving up.
+	LeaderElectionRenewDeadline time.Duration
+	// LeaderElectionRetryPeriod is the duration the leader election clients should wait between tries of actions.
+	LeaderElectionRetryPeriod time.Duration
 	// WebhookServerHost is the host for the webhook server.
 	WebhookServerHost string
 	// WebhookServerPort is the port for the webhook server.
@@ -180,6 +214,10 @@ type ManagerOptions struct {
 	MetricsBindAddress string
 	// HealthBindAddress is the TCP address that the controller should bind to for serving health probes.
 	HealthBindAddress string
+	// EnableProfiling specifies whether the profiling handlers are served via the metrics server.
+	EnableProfiling bool
+	// EnableContentionProfiling specifies whether lock contention profiling is enabled.
+	EnableContentionProfiling bool
 	// LogLevel defines the level/severity for the logs. Must be one of [info,debug,error]
 	LogLevel string
 	// LogFormat defines the format for the logs. Must be one of [json,text]
@@ -193,11 +231,16 @@ func (m *ManagerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&m.LeaderElection, LeaderElectionFlag, m.LeaderElection, "Whether to use leader election or not when running this controller manager.")
 	fs.StringVar(&m.LeaderElectionID, LeaderElectionIDFlag, m.LeaderElectionID, "The leader election id to use.")
 	fs.StringVar(&m.LeaderElectionNamespace, LeaderElectionNamespaceFlag, m.LeaderElectionNamespace, "The namespace to do leader election in.")
+	fs.DurationVar(&m.LeaderElectionLeaseDuration, LeaderElectionLeaseDurationFlag, cmp.Or(m.LeaderElectionLeaseDuration, DefaultLeaderElectionLeaseDuration), "The duration that non-leader candidates will wait to force acquire leadership.")
+	fs.DurationVar(&m.LeaderElectionRenewDeadline, LeaderElectionRenewDeadlineFlag, cmp.Or(m.LeaderElectionRenewDeadline, DefaultLeaderElectionRenewDeadline), "The duration that the acting leader will retry refreshing leadership before giving up.")
+	fs.DurationVar(&m.LeaderElectionRetryPeriod, LeaderElectionRetryPeriodFlag, cmp.Or(m.LeaderElectionRetryPeriod, DefaultLeaderElectionRetryPeriod), "The duration the leader election clients should wait between tries of actions.")
 	fs.StringVar(&m.WebhookServerHost, WebhookServerHostFlag, m.WebhookServerHost, "The webhook server host.")
 	fs.IntVar(&m.WebhookServerPort, WebhookServerPortFlag, m.WebhookServerPort, "The webhook server port.")
 	fs.StringVar(&m.WebhookCertDir, WebhookCertDirFlag, m.WebhookCertDir, "The directory that contains the webhook server key and certificate.")
 	fs.StringVar(&m.MetricsBindAddress, MetricsBindAddressFlag, ":8080", "bind address for the metrics server")
 	fs.StringVar(&m.HealthBindAddress, HealthBindAddressFlag, ":8081", "bind address for the health server")
+	fs.BoolVar(&m.EnableProfiling, EnableProfilingFlag, m.EnableProfiling, "Whether to serve the profiling handlers (/debug/pprof) via the metrics server.")
+	fs.BoolVar(&m.EnableContentionProfiling, EnableContentionProfilingFlag, m.EnableContentionProfiling, "Whether to enable lock contention profiling. Only considered if profiling is enabled.")
 	fs.StringVar(&m.LogLevel, LogLevelFlag, logger.InfoLevel, "The level/severity for the logs. Must be one of [info,debug,error]")
 	fs.StringVar(&m.LogFormat, LogFormatFlag, logger.FormatJSON, "The format for the logs. Must be one of [json,text]")
 }
@@ -212,21 +255,45 @@ func (m *ManagerOptions) Complete() error {
 		return fmt.Errorf("invalid --%s: %s", LogFormatFlag, m.LogFormat)
 	}
 
+	var (
+		leaseDuration = cmp.Or(m.LeaderElectionLeaseDuration, DefaultLeaderElectionLeaseDuration)
+		renewDeadline = cmp.Or(m.LeaderElectionRenewDeadline, DefaultLeaderElectionRenewDeadline)
+		retryPeriod   = cmp.Or(m.LeaderElectionRetryPeriod, DefaultLeaderElectionRetryPeriod)
+	)
+
+	if retryPeriod < 0 {
+		return fmt.Errorf("invalid --%s: %s, must be positive", LeaderElectionRetryPeriodFlag, retryPeriod)
+	}
+
+	if renewDeadline <= retryPeriod {
+		return fmt.Errorf("invalid --%s: %s, must be greater than --%s", LeaderElectionRenewDeadlineFlag, renewDeadline, LeaderElectionRetryPeriodFlag)
+	}
+
+	if leaseDuration <= renewDeadline {
+		return fmt.Errorf("invalid --%s: %s, must be greater than --%s", LeaderElectionLeaseDurationFlag, leaseDuration, LeaderElectionRenewDeadlineFlag)
+	}
+
 	logger, err := logger.NewZapLogger(m.LogLevel, m.LogFormat)
 	if err != nil {
 		return fmt.Errorf("error instantiating zap logger: %w", err)
 	}
 
 	m.config = &ManagerConfig{
-		m.LeaderElection,
-		m.LeaderElectionID,
-		m.LeaderElectionNamespace,
-		m.WebhookServerHost,
-		m.WebhookServerPort,
-		m.WebhookCertDir,
-		m.MetricsBindAddress,
-		m.HealthBindAddress,
-		logger}
+		LeaderElection:              m.LeaderElection,
+		LeaderElectionID:            m.LeaderElectionID,
+		LeaderElectionNamespace:     m.LeaderElectionNamespace,
+		LeaderElectionLeaseDuration: leaseDuration,
+		LeaderElectionRenewDeadline: renewDeadline,
+		LeaderElectionRetryPeriod:   retryPeriod,
+		WebhookServerHost:           m.WebhookServerHost,
+		WebhookServerPort:           m.WebhookServerPort,
+		WebhookCertDir:              m.WebhookCertDir,
+		MetricsBindAddress:          m.MetricsBindAddress,
+		HealthBindAddress:           m.HealthBindAddress,
+		EnableProfiling:             m.EnableProfiling,
+		EnableContentionProfiling:   m.EnableContentionProfiling,
+		Logger:                      logger,
+	}
 	return nil
 }
 
@@ -243,6 +310,12 @@ type ManagerConfig struct {
 	LeaderElectionID string
 	// LeaderElectionNamespace is the namespace to do leader election in.
 	LeaderElectionNamespace string
+	// LeaderElectionLeaseDuration is the duration that non-leader candidates will wait to force acquire leadership.
+	LeaderElectionLeaseDuration time.Duration
+	// LeaderElectionRenewDeadline is the duration that the acting leader will retry refreshing leadership before giving up.
+	LeaderElectionRenewDeadline time.Duration
+	// LeaderElectionRetryPeriod is the duration the leader election clients should wait between tries of actions.
+	LeaderElectionRetryPeriod time.Duration
 	// WebhookServerHost is the host for the webhook server.
 	WebhookServerHost string
 	// WebhookServerPort is the port for the webhook server.
@@ -253,6 +326,10 @@ type ManagerConfig struct {
 	MetricsBindAddress string
 	// HealthBindAddress is the TCP address that the controller should bind to for serving health probes.
 	HealthBindAddress string
+	// EnableProfiling specifies whether the profiling handlers are served via the metrics server.
+	EnableProfiling bool
+	// EnableContentionProfiling specifies whether lock contention profiling is enabled.
+	EnableContentionProfiling bool
 	// Logger is a logr.Logger compliant logger
 	Logger logr.Logger
 }
@@ -263,7 +340,16 @@ func (c *ManagerConfig) Apply(opts *manager.Options) {
 	opts.LeaderElectionResourceLock = resourcelock.LeasesResourceLock
 	opts.LeaderElectionID = c.LeaderElectionID
 	opts.LeaderElectionNamespace = c.LeaderElectionNamespace
+	opts.LeaseDuration = durationOrNil(c.LeaderElectionLeaseDuration)
+	opts.RenewDeadline = durationOrNil(c.LeaderElectionRenewDeadline)
+	opts.RetryPeriod = durationOrNil(c.LeaderElectionRetryPeriod)
 	opts.Metrics = metricsserver.Options{BindAddress: c.MetricsBindAddress}
+	if c.EnableProfiling {
+		opts.Metrics.ExtraHandlers = routes.ProfilingHandlers
+		if c.EnableContentionProfiling {
+			goruntime.SetBlockProfileRate(1)
+		}
+	}
 	opts.HealthProbeBindAddress = c.HealthBindAddress
 	opts.Logger = c.Logger
 	opts.Controller = controllerconfig.Controller{RecoverPanic: ptr.To(true)}
@@ -274,6 +360,13 @@ func (c *ManagerConfig) Apply(opts *manager.Options) {
 	})
 }
 
+func durationOrNil(d time.Duration) *time.Duration {
+	if d == 0 {
+		return nil
+	}
+	return &d
+}
+
 // Options initializes empty manager.Options, applies the set values and returns it.
 func (c *ManagerConfig) Options() manager.Options {
 	var opts manager.Options
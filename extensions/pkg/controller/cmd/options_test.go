@@ -7,9 +7,11 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"github.com/spf13/pflag"
 	"go.uber.org/mock/gomock"
 	"k8s.io/client-go/rest"
@@ -200,14 +202,22 @@ var _ = Describe("Options", func() {
 			logFormat               = "text"
 			logLevelDefault         = "info"
 			logFormatDefault        = "json"
+			leaseDuration           = 30 * time.Second
+			renewDeadline           = 20 * time.Second
+			retryPeriod             = 5 * time.Second
 		)
 		command := test.NewCommandBuilder(name).
 			Flags(
 				test.BoolFlag("leader-election", true),
 				test.StringFlag("leader-election-id", leaderElectionID),
 				test.StringFlag("leader-election-namespace", leaderElectionNamespace),
+				test.StringFlag("leader-election-lease-duration", leaseDuration.String()),
+				test.StringFlag("leader-election-renew-deadline", renewDeadline.String()),
+				test.StringFlag("leader-election-retry-period", retryPeriod.String()),
 				test.StringFlag("metrics-bind-address", metricsBindAddress),
 				test.StringFlag("health-bind-address", healthBindAddress),
+				test.BoolFlag("enable-profiling", true),
+				test.BoolFlag("enable-contention-profiling", true),
 				test.StringFlag("log-level", logLevel),
 				test.StringFlag("log-format", logFormat),
 			).
@@ -223,13 +233,18 @@ var _ = Describe("Options", func() {
 
 				Expect(fs.Parse(command)).NotTo(HaveOccurred())
 				Expect(opts).To(Equal(ManagerOptions{
-					LeaderElection:          true,
-					LeaderElectionID:        leaderElectionID,
-					LeaderElectionNamespace: leaderElectionNamespace,
-					MetricsBindAddress:      metricsBindAddress,
-					HealthBindAddress:       healthBindAddress,
-					LogLevel:                logLevel,
-					LogFormat:               logFormat,
+					LeaderElection:              true,
+					LeaderElectionID:            leaderElectionID,
+					LeaderElectionNamespace:     leaderElectionNamespace,
+					LeaderElectionLeaseDuration: leaseDuration,
+					LeaderElectionRenewDeadline: renewDeadline,
+					LeaderElectionRetryPeriod:   retryPeriod,
+					MetricsBindAddress:          metricsBindAddress,
+					HealthBindAddress:           healthBindAddress,
+					EnableProfiling:             true,
+					EnableContentionProfiling:   true,
+					LogLevel:                    logLevel,
+					LogFormat:                   logFormat,
 				}))
 			})
 
@@ -250,13 +265,16 @@ var _ = Describe("Options", func() {
 						Slice(),
 				)).NotTo(HaveOccurred())
 				Expect(opts).To(Equal(ManagerOptions{
-					LeaderElection:          true,
-					LeaderElectionID:        leaderElectionID,
-					LeaderElectionNamespace: leaderElectionNamespace,
-					MetricsBindAddress:      metricsBindAddress,
-					HealthBindAddress:       healthBindAddress,
-					LogLevel:                logLevelDefault,
-					LogFormat:               logFormatDefault,
+					LeaderElection:              true,
+					LeaderElectionID:            leaderElectionID,
+					LeaderElectionNamespace:     leaderElectionNamespace,
+					LeaderElectionLeaseDuration: 15 * time.Second,
+					LeaderElectionRenewDeadline: 10 * time.Second,
+					LeaderElectionRetryPeriod:   2 * time.Second,
+					MetricsBindAddress:          metricsBindAddress,
+					HealthBindAddress:           healthBindAddress,
+					LogLevel:                    logLevelDefault,
+					LogFormat:                   logFormatDefault,
 				}))
 			})
 		})
@@ -296,6 +314,23 @@ var _ = Describe("Options", func() {
 				Expect(opts.Complete()).To(MatchError("invalid --log-format: bar"))
 			})
 
+			It("should fail on invalid leader election durations", func() {
+				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+				opts := ManagerOptions{}
+
+				opts.AddFlags(fs)
+
+				Expect(fs.Parse(
+					test.NewCommandBuilder(name).
+						Flags(
+							test.StringFlag("leader-election-lease-duration", "10s"),
+						).
+						Command().
+						Slice(),
+				)).NotTo(HaveOccurred())
+				Expect(opts.Complete()).To(MatchError("invalid --leader-election-lease-duration: 10s, must be greater than --leader-election-renew-deadline"))
+			})
+
 			It("should complete without error after the flags have been parsed", func() {
 				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
 				opts := ManagerOptions{}
@@ -321,6 +356,27 @@ var _ = Describe("Options", func() {
 				Expect(opts.Completed()).To(HaveField("LeaderElectionNamespace", leaderElectionNamespace))
 				Expect(opts.Completed()).To(HaveField("MetricsBindAddress", metricsBindAddress))
 				Expect(opts.Completed()).To(HaveField("HealthBindAddress", healthBindAddress))
+				Expect(opts.Completed()).To(HaveField("LeaderElectionLeaseDuration", leaseDuration))
+				Expect(opts.Completed()).To(HaveField("LeaderElectionRenewDeadline", renewDeadline))
+				Expect(opts.Completed()).To(HaveField("LeaderElectionRetryPeriod", retryPeriod))
+				Expect(opts.Completed()).To(HaveField("EnableProfiling", true))
+				Expect(opts.Completed()).To(HaveField("EnableContentionProfiling", true))
+			})
+
+			It("should apply the leader election durations and profiling handlers to the manager options", func() {
+				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+				opts := ManagerOptions{}
+
+				opts.AddFlags(fs)
+
+				Expect(fs.Parse(command)).NotTo(HaveOccurred())
+				Expect(opts.Complete()).NotTo(HaveOccurred())
+
+				mgrOpts := opts.Completed().Options()
+				Expect(mgrOpts.LeaseDuration).To(PointTo(Equal(leaseDuration)))
+				Expect(mgrOpts.RenewDeadline).To(PointTo(Equal(renewDeadline)))
+				Expect(mgrOpts.RetryPeriod).To(PointTo(Equal(retryPeriod)))
+				Expect(mgrOpts.Metrics.ExtraHandlers).To(HaveKey("/debug/pprof/profile"))
 			})
 
 			It("should yield an enabled Logger after completion", func() {
@@ -7,6 +7,7 @@ package healthcheck
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -158,7 +159,11 @@ func (a *Actuator) ExecuteHealthCheckFunctions(ctx context.Context, log logr.Log
 			healthCheckResult, err := check.Check(ctx, request)
 
 			if healthCheckResult != nil && errorCodeCheckFunc != nil {
-				healthCheckResult.Codes = append(healthCheckResult.Codes, errorCodeCheckFunc(fmt.Errorf("%s", healthCheckResult.Detail))...)
+				for _, code := range errorCodeCheckFunc(fmt.Errorf("%s", healthCheckResult.Detail)) {
+					if !slices.Contains(healthCheckResult.Codes, code) {
+						healthCheckResult.Codes = append(healthCheckResult.Codes, code)
+					}
+				}
 			}
 
 			channel <- channelResult{
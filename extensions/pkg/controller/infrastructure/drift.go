@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// DriftDetector can optionally be implemented by an [Actuator] to detect whether the infrastructure resources of a
+// shoot cluster deviate from their desired state.
+type DriftDetector interface {
+	// DetectDrift is invoked when the [extensionsv1alpha1.Infrastructure] resource is annotated with the
+	// `gardener.cloud/operation=detect-drift` operation annotation.
+	//
+	// Implementations must not create, update, or delete any infrastructure resources. They should compare the actual
+	// state of the infrastructure resources with the state the last reconciliation would have produced and return
+	// whether they deviate, together with a human-readable description of the deviations.
+	DetectDrift(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) (bool, string, error)
+}
@@ -10,7 +10,9 @@ import (
 
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -87,6 +89,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return r.delete(ctx, log.WithValues("operation", "delete"), infrastructure, cluster)
 	case operationType == gardencorev1beta1.LastOperationTypeRestore:
 		return r.restore(ctx, log.WithValues("operation", "restore"), infrastructure, cluster)
+	case infrastructure.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationDetectDrift:
+		return r.detectDrift(ctx, log.WithValues("operation", "detect-drift"), infrastructure, cluster)
 	default:
 		return r.reconcile(ctx, log.WithValues("operation", "reconcile"), infrastructure, cluster, operationType)
 	}
@@ -153,7 +157,10 @@ func (r *reconciler) delete(
 	if cluster != nil && v1beta1helper.ShootNeedsForceDeletion(cluster.Shoot) {
 		err = r.actuator.ForceDelete(ctx, log, infrastructure, cluster)
 	} else {
-		err = r.actuator.Delete(ctx, log, infrastructure, cluster)
+		err = r.sweepOrphanedResources(ctx, log, infrastructure, cluster)
+		if err == nil {
+			err = r.actuator.Delete(ctx, log, infrastructure, cluster)
+		}
 	}
 
 	if err != nil {
@@ -168,6 +175,25 @@ func (r *reconciler) delete(
 	return reconcile.Result{}, r.removeFinalizerFromInfrastructure(ctx, log, infrastructure)
 }
 
+func (r *reconciler) sweepOrphanedResources(
+	ctx context.Context,
+	log logr.Logger,
+	infrastructure *extensionsv1alpha1.Infrastructure,
+	cluster *extensionscontroller.Cluster,
+) error {
+	sweeper, ok := r.actuator.(Sweeper)
+	if !ok || cluster == nil || !v1beta1helper.ShootUsesAggressiveCleanup(cluster.Shoot) {
+		return nil
+	}
+
+	log.Info("Sweeping orphaned infrastructure resources")
+	if err := sweeper.SweepOrphanedResources(ctx, log, infrastructure, cluster); err != nil {
+		return fmt.Errorf("failed sweeping orphaned infrastructure resources: %w", err)
+	}
+
+	return nil
+}
+
 func (r *reconciler) migrate(
 	ctx context.Context,
 	log logr.Logger,
@@ -239,6 +265,52 @@ func (r *reconciler) restore(
 	return reconcile.Result{}, err
 }
 
+func (r *reconciler) detectDrift(
+	ctx context.Context,
+	log logr.Logger,
+	infrastructure *extensionsv1alpha1.Infrastructure,
+	cluster *extensionscontroller.Cluster,
+) (
+	reconcile.Result,
+	error,
+) {
+	drift := &extensionsv1alpha1.InfrastructureDrift{State: extensionsv1alpha1.InfrastructureDriftStateUnknown}
+
+	driftDetector, ok := r.actuator.(DriftDetector)
+	switch {
+	case !ok:
+		drift.Description = ptr.To("The extension does not support drift detection")
+	case infrastructure.Status.LastOperation == nil || infrastructure.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded:
+		drift.Description = ptr.To("The infrastructure has not been reconciled successfully yet")
+	default:
+		log.Info("Starting the drift detection of infrastructure")
+		drifted, description, err := driftDetector.DetectDrift(ctx, log, infrastructure, cluster)
+		if err != nil {
+			// The error is only reported in the status since the caller decides when to request the next detection.
+			log.Error(err, "Error detecting drift of infrastructure")
+			drift.Description = ptr.To(fmt.Sprintf("Error detecting drift: %v", err))
+			break
+		}
+
+		drift.State = extensionsv1alpha1.InfrastructureDriftStateInSync
+		if drifted {
+			drift.State = extensionsv1alpha1.InfrastructureDriftStateDrifted
+		}
+		if description != "" {
+			drift.Description = &description
+		}
+	}
+	drift.LastDetectionTime = metav1.Now()
+
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	infrastructure.Status.Drift = drift
+	if err := r.client.Status().Patch(ctx, infrastructure, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating drift status: %w", err)
+	}
+
+	return reconcile.Result{}, r.removeAnnotation(ctx, log, infrastructure)
+}
+
 func (r *reconciler) removeFinalizerFromInfrastructure(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure) error {
 	if controllerutil.ContainsFinalizer(infrastructure, FinalizerName) {
 		log.Info("Removing finalizer")
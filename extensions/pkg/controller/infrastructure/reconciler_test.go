@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+type fakeActuator struct {
+	Actuator
+}
+
+type fakeSweepingActuator struct {
+	Actuator
+
+	sweepErr error
+	swept    int
+}
+
+func (a *fakeSweepingActuator) SweepOrphanedResources(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error {
+	a.swept++
+	return a.sweepErr
+}
+
+var _ = Describe("reconciler", func() {
+	Describe("#sweepOrphanedResources", func() {
+		var (
+			ctx            = context.Background()
+			log            = logr.Discard()
+			infrastructure *extensionsv1alpha1.Infrastructure
+			cluster        *extensionscontroller.Cluster
+			actuator       *fakeSweepingActuator
+			r              *reconciler
+		)
+
+		BeforeEach(func() {
+			infrastructure = &extensionsv1alpha1.Infrastructure{}
+			cluster = &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.AnnotationShootAggressiveCleanup: "true"}},
+			}}
+			actuator = &fakeSweepingActuator{}
+			r = &reconciler{actuator: actuator}
+		})
+
+		It("should sweep orphaned resources if the shoot is deleted in the aggressive cleanup mode", func() {
+			Expect(r.sweepOrphanedResources(ctx, log, infrastructure, cluster)).To(Succeed())
+			Expect(actuator.swept).To(Equal(1))
+		})
+
+		It("should return the error of the sweeper", func() {
+			actuator.sweepErr = errors.New("fake")
+
+			Expect(r.sweepOrphanedResources(ctx, log, infrastructure, cluster)).To(MatchError(ContainSubstring("fake")))
+		})
+
+		It("should not sweep orphaned resources if the aggressive cleanup mode is not enabled", func() {
+			cluster.Shoot.Annotations[v1beta1constants.AnnotationShootAggressiveCleanup] = "false"
+
+			Expect(r.sweepOrphanedResources(ctx, log, infrastructure, cluster)).To(Succeed())
+			Expect(actuator.swept).To(BeZero())
+		})
+
+		It("should not sweep orphaned resources if there is no cluster", func() {
+			Expect(r.sweepOrphanedResources(ctx, log, infrastructure, nil)).To(Succeed())
+			Expect(actuator.swept).To(BeZero())
+		})
+
+		It("should do nothing if the actuator does not implement the sweeper", func() {
+			r.actuator = &fakeActuator{}
+
+			Expect(r.sweepOrphanedResources(ctx, log, infrastructure, cluster)).To(Succeed())
+		})
+	})
+})
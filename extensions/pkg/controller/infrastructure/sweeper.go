@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Sweeper can optionally be implemented by an [Actuator] to delete orphaned infrastructure resources of a shoot cluster.
+type Sweeper interface {
+	// SweepOrphanedResources is invoked before the [extensionsv1alpha1.Infrastructure] resource is deleted if the shoot
+	// cluster is deleted in the aggressive cleanup mode (see `shoot.gardener.cloud/aggressive-cleanup` annotation).
+	//
+	// Implementations should delete all resources of the shoot cluster at the cloud provider which were not created by
+	// the extension itself and would otherwise be orphaned, e.g. load balancers or volumes whose Kubernetes objects
+	// in the shoot cluster were finalized before the cloud provider resources were deleted.
+	SweepOrphanedResources(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootleftover
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Actuator acts upon [extensionsv1alpha1.ShootLeftover] resources.
+type Actuator interface {
+	// Reconcile reconciles the [extensionsv1alpha1.ShootLeftover] resource.
+	//
+	// Implementations should scan the infrastructure account for resources
+	// which belong to the deleted shoot (e.g., load balancers, disks, or IP
+	// addresses). If `.spec.cleanup` is true, they should delete the detected
+	// resources. The returned list must contain all resources which still
+	// exist after the reconciliation, it is reported in the status.
+	Reconcile(context.Context, logr.Logger, *extensionsv1alpha1.ShootLeftover) ([]extensionsv1alpha1.LeftoverResource, error)
+
+	// Delete is invoked when the [extensionsv1alpha1.ShootLeftover]
+	// resource is deleted.
+	//
+	// Implementations must not delete the detected provider resources but
+	// only clean up what they created themselves during the scans.
+	Delete(context.Context, logr.Logger, *extensionsv1alpha1.ShootLeftover) error
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootleftover
+
+import (
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+)
+
+const (
+	// FinalizerName is the shootleftover controller finalizer.
+	FinalizerName = "extensions.gardener.cloud/shootleftover"
+	// ControllerName is the name of the controller.
+	ControllerName = "shootleftover"
+)
+
+// AddArgs are arguments for adding a ShootLeftover controller to a manager.
+type AddArgs struct {
+	// Actuator is a ShootLeftover actuator.
+	Actuator Actuator
+	// ControllerOptions are the controller options used for creating a controller.
+	// The options.Reconciler is always overridden with a reconciler created from the
+	// given actuator.
+	ControllerOptions controller.Options
+	// Predicates are the predicates to use.
+	// If unset, GenerationChangedPredicate will be used.
+	Predicates []predicate.Predicate
+	// Type is the type of the resource considered for reconciliation.
+	Type string
+	// SyncPeriod is the duration after which a successfully reconciled ShootLeftover is scanned again. If zero, the
+	// infrastructure account is only scanned when the ShootLeftover is reconciled.
+	SyncPeriod time.Duration
+	// ExtensionClasses defines the extension classes this controller is responsible for.
+	ExtensionClasses []extensionsv1alpha1.ExtensionClass
+}
+
+// DefaultPredicates returns the default predicates for a ShootLeftover reconciler.
+func DefaultPredicates(ignoreOperationAnnotation bool) []predicate.Predicate {
+	return extensionspredicate.DefaultControllerPredicates(ignoreOperationAnnotation)
+}
+
+// Add creates a new ShootLeftover Controller and adds it to the Manager.
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager, args AddArgs) error {
+	predicates := predicateutils.AddTypeAndClassPredicates(args.Predicates, args.ExtensionClasses, args.Type)
+	return add(mgr, args, predicates)
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, args AddArgs, predicates []predicate.Predicate) error {
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(args.ControllerOptions).
+		Watches(
+			&extensionsv1alpha1.ShootLeftover{},
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(predicates...),
+		).
+		Complete(NewReconciler(mgr, args.Actuator, args.SyncPeriod))
+}
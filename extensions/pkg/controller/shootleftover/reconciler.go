@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootleftover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+type reconciler struct {
+	actuator   Actuator
+	syncPeriod time.Duration
+
+	client        client.Client
+	statusUpdater extensionscontroller.StatusUpdaterCustom
+}
+
+// NewReconciler creates a new reconcile.Reconciler that reconciles
+// ShootLeftover resources of Gardener's `extensions.gardener.cloud` API group.
+func NewReconciler(mgr manager.Manager, actuator Actuator, syncPeriod time.Duration) reconcile.Reconciler {
+	return reconcilerutils.OperationAnnotationWrapper(
+		mgr,
+		func() client.Object { return &extensionsv1alpha1.ShootLeftover{} },
+		&reconciler{
+			actuator:      actuator,
+			syncPeriod:    syncPeriod,
+			client:        mgr.GetClient(),
+			statusUpdater: extensionscontroller.NewStatusUpdater(mgr.GetClient()),
+		},
+	)
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shootLeftover := &extensionsv1alpha1.ShootLeftover{}
+	if err := r.client.Get(ctx, request.NamespacedName, shootLeftover); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shootLeftover.DeletionTimestamp != nil {
+		return r.delete(ctx, log, shootLeftover)
+	}
+
+	return r.reconcile(ctx, log, shootLeftover)
+}
+
+func (r *reconciler) reconcile(ctx context.Context, log logr.Logger, shootLeftover *extensionsv1alpha1.ShootLeftover) (reconcile.Result, error) {
+	if !controllerutil.ContainsFinalizer(shootLeftover, FinalizerName) {
+		log.Info("Adding finalizer")
+		if err := controllerutils.AddFinalizers(ctx, r.client, shootLeftover, FinalizerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+	}
+
+	operationType := v1beta1helper.ComputeOperationType(shootLeftover.ObjectMeta, shootLeftover.Status.LastOperation)
+	if err := r.statusUpdater.ProcessingCustom(ctx, log, shootLeftover, operationType, "Scanning for leftover resources", nil); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	secretMetadata, err := kubernetesutils.GetSecretMetadataByReference(ctx, r.client, &shootLeftover.Spec.SecretRef)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get shoot leftover secret: %w", err)
+	}
+
+	if !controllerutil.ContainsFinalizer(secretMetadata, FinalizerName) {
+		log.Info("Adding finalizer to secret", "secret", client.ObjectKeyFromObject(secretMetadata))
+		if err := controllerutils.AddFinalizers(ctx, r.client, secretMetadata, FinalizerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to add finalizer to secret: %w", err)
+		}
+	}
+
+	log.Info("Starting the reconciliation of ShootLeftover")
+	resources, err := r.actuator.Reconcile(ctx, log, shootLeftover)
+	if err != nil {
+		_ = r.statusUpdater.ErrorCustom(ctx, log, shootLeftover, reconcilerutils.ReconcileErrCauseOrErr(err), operationType, "Error scanning for leftover resources", nil)
+		return reconcilerutils.ReconcileErr(err)
+	}
+
+	updateResourcesFunc := func(_ extensionsv1alpha1.Status) error {
+		shootLeftover.Status.Resources = resources
+		shootLeftover.Status.LastScanTime = &metav1.Time{Time: time.Now().UTC()}
+		return nil
+	}
+
+	if err := r.statusUpdater.SuccessCustom(ctx, log, shootLeftover, operationType, fmt.Sprintf("Successfully scanned for leftover resources, %d found", len(resources)), updateResourcesFunc); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+}
+
+func (r *reconciler) delete(ctx context.Context, log logr.Logger, shootLeftover *extensionsv1alpha1.ShootLeftover) (reconcile.Result, error) {
+	if !controllerutil.ContainsFinalizer(shootLeftover, FinalizerName) {
+		log.Info("Deleting ShootLeftover causes a no-op as there is no finalizer")
+		return reconcile.Result{}, nil
+	}
+
+	operationType := v1beta1helper.ComputeOperationType(shootLeftover.ObjectMeta, shootLeftover.Status.LastOperation)
+	if err := r.statusUpdater.ProcessingCustom(ctx, log, shootLeftover, operationType, "Deleting the ShootLeftover", nil); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Starting the deletion of ShootLeftover")
+	if err := r.actuator.Delete(ctx, log, shootLeftover); err != nil {
+		_ = r.statusUpdater.ErrorCustom(ctx, log, shootLeftover, reconcilerutils.ReconcileErrCauseOrErr(err), operationType, "Error deleting ShootLeftover", nil)
+		return reconcilerutils.ReconcileErr(err)
+	}
+
+	if err := r.statusUpdater.SuccessCustom(ctx, log, shootLeftover, operationType, "Successfully deleted ShootLeftover", nil); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	secretMetadata, err := kubernetesutils.GetSecretMetadataByReference(ctx, r.client, &shootLeftover.Spec.SecretRef)
+	if client.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get shoot leftover secret: %w", err)
+	}
+
+	if secretMetadata != nil && controllerutil.ContainsFinalizer(secretMetadata, FinalizerName) {
+		log.Info("Removing finalizer from secret", "secret", client.ObjectKeyFromObject(secretMetadata))
+		if err := controllerutils.RemoveFinalizers(ctx, r.client, secretMetadata, FinalizerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to remove finalizer from secret: %w", err)
+		}
+	}
+
+	if controllerutil.ContainsFinalizer(shootLeftover, FinalizerName) {
+		log.Info("Removing finalizer")
+		if err := controllerutils.RemoveFinalizers(ctx, r.client, shootLeftover, FinalizerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
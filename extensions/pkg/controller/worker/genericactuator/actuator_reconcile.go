@@ -119,7 +119,9 @@ func (a *genericActuator) Reconcile(ctx context.Context, log logr.Logger, worker
 
 		newError := fmt.Errorf("failed while waiting for all machine deployments to be ready: %w", err)
 		if a.errorCodeCheckFunc != nil {
-			return v1beta1helper.NewErrorWithCodes(newError, a.errorCodeCheckFunc(err)...)
+			// keep the codes determined for the failed machines in addition to the ones determined by the provider
+			codes := sets.New(v1beta1helper.ExtractErrorCodes(err)...).Insert(a.errorCodeCheckFunc(err)...)
+			return v1beta1helper.NewErrorWithCodes(newError, sets.List(codes)...)
 		}
 		return newError
 	}
@@ -470,7 +472,11 @@ func (a *genericActuator) waitUntilUnwantedMachineDeploymentsDeleted(ctx context
 		for _, existingMachineDeployment := range existingMachineDeployments.Items {
 			if !wantedMachineDeployments.HasDeployment(existingMachineDeployment.Name) {
 				for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
-					return retryutils.SevereError(fmt.Errorf("machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description))
+					err := fmt.Errorf("machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
+					if codes := extensionsworkerhelper.MachineErrorCodes(failedMachine.LastOperation); len(codes) > 0 {
+						err = v1beta1helper.NewErrorWithCodes(err, codes...)
+					}
+					return retryutils.SevereError(err)
 				}
 
 				log.Info("Waiting until unwanted machine deployment is deleted", "machineDeployment", &existingMachineDeployment)
@@ -5,15 +5,51 @@
 package helper
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinecodes "github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
 	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/gardener/gardener/extensions/pkg/util"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 )
 
+// KnownMachineErrorCodes maps Gardener error codes to functions which check whether the description of a failed
+// machine operation indicates the respective error.
+var KnownMachineErrorCodes = map[gardencorev1beta1.ErrorCode]func(string) bool{
+	gardencorev1beta1.ErrorInfraQuotaExceeded:        isQuotaExceeded,
+	gardencorev1beta1.ErrorInfraImageNotFound:        regexp.MustCompile(`(?i)(image.*not.?found|invalidamiid|invalid.?image|imagenotfound)`).MatchString,
+	gardencorev1beta1.ErrorInfraIPAddressesExhausted: regexp.MustCompile(`(?i)(insufficientfreeaddresses|subnet.*is.?full|ip.*(address|space).*exhausted|no (more )?(free|available) ip)`).MatchString,
+}
+
+var (
+	quotaExceededRegex = regexp.MustCompile(`(?i)(quota.*exceeded|exceeded.*quota)`)
+	limitExceededRegex = regexp.MustCompile(`(?i)(\w*)LimitExceeded`)
+)
+
+// isQuotaExceeded checks whether the given description indicates an exceeded quota. Error codes like
+// `VcpuLimitExceeded` indicate an exceeded quota as well, however, `RequestLimitExceeded` indicates that the request
+// was throttled by the provider.
+func isQuotaExceeded(description string) bool {
+	if quotaExceededRegex.MatchString(description) {
+		return true
+	}
+
+	for _, match := range limitExceededRegex.FindAllStringSubmatch(description, -1) {
+		if !strings.HasSuffix(strings.ToLower(match[1]), "request") {
+			return true
+		}
+	}
+	return false
+}
+
 // GetMachineSetWithMachineClass checks if for the given <machineDeploymentName>, there exists a machine set in the <ownerReferenceToMachineSet> with the machine class <machineClassName>
 // returns the machine set or nil
 func GetMachineSetWithMachineClass(machineDeploymentName, machineClassName string, ownerReferenceToMachineSet map[string][]machinev1alpha1.MachineSet) *machinev1alpha1.MachineSet {
@@ -33,22 +69,51 @@ func ReportFailedMachines(status machinev1alpha1.MachineDeploymentStatus) error
 		return nil
 	}
 
-	descriptionPerFailedMachines := make(map[string][]string)
+	var (
+		descriptionPerFailedMachines = make(map[string][]string)
+		codesPerDescription          = make(map[string]sets.Set[gardencorev1beta1.ErrorCode])
+	)
 	for _, machine := range machines {
-		descriptionPerFailedMachines[machine.LastOperation.Description] = append(descriptionPerFailedMachines[machine.LastOperation.Description],
-			fmt.Sprintf("%q", machine.Name))
+		description := machine.LastOperation.Description
+		descriptionPerFailedMachines[description] = append(descriptionPerFailedMachines[description], fmt.Sprintf("%q", machine.Name))
+		if _, ok := codesPerDescription[description]; !ok {
+			codesPerDescription[description] = sets.New[gardencorev1beta1.ErrorCode]()
+		}
+		codesPerDescription[description].Insert(MachineErrorCodes(machine.LastOperation)...)
 	}
 
-	allErrs := &multierror.Error{
-		ErrorFormat: errorsutils.NewErrorFormatFuncWithPrefix("machine(s) failed"),
-	}
+	var (
+		allErrs = &multierror.Error{
+			ErrorFormat: errorsutils.NewErrorFormatFuncWithPrefix("machine(s) failed"),
+		}
+		allCodes = sets.New[gardencorev1beta1.ErrorCode]()
+	)
 	for description, names := range descriptionPerFailedMachines {
-		allErrs = multierror.Append(allErrs, fmt.Errorf("%s: %s", strings.Join(names, ", "), description))
+		err := fmt.Errorf("%s: %s", strings.Join(names, ", "), description)
+		if codes := codesPerDescription[description]; codes.Len() > 0 {
+			err = v1beta1helper.NewErrorWithCodes(err, sets.List(codes)...)
+			allCodes.Insert(codes.UnsortedList()...)
+		}
+		allErrs = multierror.Append(allErrs, err)
 	}
 
+	if allCodes.Len() > 0 {
+		return v1beta1helper.NewErrorWithCodes(allErrs, sets.List(allCodes)...)
+	}
 	return allErrs
 }
 
+// MachineErrorCodes determines the Gardener error codes for the given last operation of a failed machine. The codes are
+// derived from the description of the operation. If the machine-controller-manager reports that a resource has been
+// exhausted without further details, ErrorInfraResourcesDepleted is returned.
+func MachineErrorCodes(lastOperation machinev1alpha1.LastOperation) []gardencorev1beta1.ErrorCode {
+	codes := util.DetermineErrorCodes(errors.New(lastOperation.Description), KnownMachineErrorCodes)
+	if len(codes) == 0 && lastOperation.ErrorCode == machinecodes.ResourceExhausted.String() {
+		codes = append(codes, gardencorev1beta1.ErrorInfraResourcesDepleted)
+	}
+	return codes
+}
+
 // GetOldMachineSets returns all machine sets except the latest one.
 func GetOldMachineSets(machineSets []machinev1alpha1.MachineSet, latestMachineSet machinev1alpha1.MachineSet) []machinev1alpha1.MachineSet {
 	var oldMachineSets []machinev1alpha1.MachineSet
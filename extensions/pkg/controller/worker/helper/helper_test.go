@@ -12,6 +12,8 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 
 	. "github.com/gardener/gardener/extensions/pkg/controller/worker/helper"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 var _ = Describe("Helper Tests", func() {
@@ -108,4 +110,44 @@ var _ = Describe("Helper Tests", func() {
 			})),
 		),
 	)
+
+	Describe("#ReportFailedMachines with known machine errors", func() {
+		It("should attach the error codes of the failed machines", func() {
+			err := ReportFailedMachines(machinev1alpha1.MachineDeploymentStatus{
+				FailedMachines: []*machinev1alpha1.MachineSummary{
+					{
+						Name:          "machine1",
+						LastOperation: machinev1alpha1.LastOperation{Description: "Cloud provider message - QuotaExceeded: quota 'CPUS' exceeded"},
+					},
+					{
+						Name:          "machine2",
+						LastOperation: machinev1alpha1.LastOperation{Description: "Cloud provider message - image 'foo' not found"},
+					},
+					{
+						Name:          "machine3",
+						LastOperation: machinev1alpha1.LastOperation{Description: "foo"},
+					},
+				},
+			})
+
+			Expect(err).To(MatchError(ContainSubstring("machine(s) failed")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorInfraQuotaExceeded, gardencorev1beta1.ErrorInfraImageNotFound))
+		})
+	})
+
+	DescribeTable("#MachineErrorCodes",
+		func(lastOperation machinev1alpha1.LastOperation, expected []gardencorev1beta1.ErrorCode) {
+			Expect(MachineErrorCodes(lastOperation)).To(Equal(expected))
+		},
+
+		Entry("unknown error", machinev1alpha1.LastOperation{Description: "foo"}, nil),
+		Entry("quota exceeded", machinev1alpha1.LastOperation{Description: "VcpuLimitExceeded: You have requested more vCPU capacity than your current vCPU limit"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}),
+		Entry("quota exceeded with quota message", machinev1alpha1.LastOperation{Description: "QUOTA_EXCEEDED: Quota 'CPUS' exceeded. Limit: 24.0 in region europe-west1"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}),
+		Entry("throttled request", machinev1alpha1.LastOperation{Description: "RequestLimitExceeded: Request limit exceeded."}, nil),
+		Entry("throttled request and quota exceeded", machinev1alpha1.LastOperation{Description: "RequestLimitExceeded: Request limit exceeded. VcpuLimitExceeded: You have requested more vCPU capacity"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}),
+		Entry("image not found", machinev1alpha1.LastOperation{Description: "InvalidAMIID.NotFound: The image id '[ami-123]' does not exist"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraImageNotFound}),
+		Entry("IP addresses exhausted", machinev1alpha1.LastOperation{Description: "InsufficientFreeAddressesInSubnet: There are not enough free addresses in subnet"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraIPAddressesExhausted}),
+		Entry("exhausted resource without details", machinev1alpha1.LastOperation{Description: "foo", ErrorCode: "ResourceExhausted"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraResourcesDepleted}),
+		Entry("exhausted resource with details", machinev1alpha1.LastOperation{Description: "quota exceeded", ErrorCode: "ResourceExhausted"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}),
+	)
 })
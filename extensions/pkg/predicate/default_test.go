@@ -47,6 +47,7 @@ var _ = Describe("Default", func() {
 					Entry("reconcile", "reconcile"),
 					Entry("migrate", "migrate"),
 					Entry("restore", "restore"),
+					Entry("detect-drift", "detect-drift"),
 				)
 
 				It("should return true when the deletion timestamp is set", func() {
@@ -87,6 +88,7 @@ var _ = Describe("Default", func() {
 					Entry("reconcile", "reconcile"),
 					Entry("migrate", "migrate"),
 					Entry("restore", "restore"),
+					Entry("detect-drift", "detect-drift"),
 				)
 
 				It("should return true when the deletion timestamp is set and the status is equal", func() {
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csimigration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+// AnnotationMigratedPlugins is the annotation which the kubelet maintains on its CSINode object. It contains the
+// comma-separated names of the in-tree volume plugins for which the kubelet has enabled the CSI migration.
+const AnnotationMigratedPlugins = "storage.alpha.kubernetes.io/migrated-plugins"
+
+// Config describes the CSI migration of a provider.
+type Config struct {
+	// InTreePluginName is the name of the in-tree volume plugin, e.g. 'kubernetes.io/aws-ebs'.
+	InTreePluginName string
+	// CSIDriverName is the name of the CSI driver replacing the in-tree volume plugin, e.g. 'ebs.csi.aws.com'.
+	CSIDriverName string
+	// FeatureGates are the feature gates which must be enabled for the migration, e.g. 'CSIMigrationAWS'.
+	FeatureGates []string
+	// KubernetesVersion is the Kubernetes version as of which the migration is performed. It can be overwritten per
+	// shoot with the 'alpha.csimigration.shoot.extensions.gardener.cloud/kubernetes-version' annotation.
+	KubernetesVersion string
+}
+
+// ShootKubernetesVersion returns the Kubernetes version as of which the migration is performed for the given shoot.
+func (c Config) ShootKubernetesVersion(shoot *gardencorev1beta1.Shoot) string {
+	if version, ok := shoot.Annotations[extensionsv1alpha1.ShootAlphaCSIMigrationKubernetesVersion]; ok {
+		return version
+	}
+	return c.KubernetesVersion
+}
+
+// IsEnabled returns true if the CSI migration shall be performed for the given shoot, i.e., if its Kubernetes version
+// is at least the version as of which the migration is performed.
+func (c Config) IsEnabled(shoot *gardencorev1beta1.Shoot) (bool, error) {
+	version := c.ShootKubernetesVersion(shoot)
+	if version == "" {
+		return false, nil
+	}
+	return versionutils.CompareVersions(shoot.Spec.Kubernetes.Version, ">=", version)
+}
+
+// FeatureGatesMap returns the feature gates for the migration in the format of the component configurations.
+func (c Config) FeatureGatesMap() map[string]bool {
+	featureGates := make(map[string]bool, len(c.FeatureGates))
+	for _, featureGate := range c.FeatureGates {
+		featureGates[featureGate] = true
+	}
+	return featureGates
+}
+
+// EnsureKubeletFeatureGates enables the feature gates for the migration in the given kubelet configuration. It is
+// supposed to be called by the `EnsureKubeletConfiguration` function of control plane webhooks, i.e., it changes the
+// kubelet configuration in the `OperatingSystemConfig`s. Feature gates explicitly configured otherwise are not changed.
+func (c Config) EnsureKubeletFeatureGates(kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) {
+	if len(c.FeatureGates) == 0 {
+		return
+	}
+
+	if kubeletConfig.FeatureGates == nil {
+		kubeletConfig.FeatureGates = make(map[string]bool, len(c.FeatureGates))
+	}
+	for featureGate, enabled := range c.FeatureGatesMap() {
+		if _, ok := kubeletConfig.FeatureGates[featureGate]; !ok {
+			kubeletConfig.FeatureGates[featureGate] = enabled
+		}
+	}
+}
+
+// IsNodeStaged returns true if the kubelet of the given CSINode has registered the CSI driver and has enabled the
+// migration for the in-tree volume plugin.
+func (c Config) IsNodeStaged(csiNode *storagev1.CSINode) bool {
+	if csiNode == nil {
+		return false
+	}
+
+	if !slices.ContainsFunc(csiNode.Spec.Drivers, func(driver storagev1.CSINodeDriver) bool { return driver.Name == c.CSIDriverName }) {
+		return false
+	}
+
+	return slices.ContainsFunc(strings.Split(csiNode.Annotations[AnnotationMigratedPlugins], ","), func(plugin string) bool {
+		return strings.TrimSpace(plugin) == c.InTreePluginName
+	})
+}
+
+// InTreeVolumesAttached returns the volumes of the in-tree volume plugin which are still attached to the given node.
+func (c Config) InTreeVolumesAttached(node *corev1.Node) []corev1.UniqueVolumeName {
+	var volumes []corev1.UniqueVolumeName
+	for _, volume := range node.Status.VolumesAttached {
+		if strings.HasPrefix(string(volume.Name), c.InTreePluginName+"/") {
+			volumes = append(volumes, volume.Name)
+		}
+	}
+	return volumes
+}
+
+// PendingVolumeAttachments returns the names of the VolumeAttachments of the CSI driver for the given node which are
+// not yet attached.
+func (c Config) PendingVolumeAttachments(volumeAttachments []storagev1.VolumeAttachment, nodeName string) []string {
+	var names []string
+	for _, volumeAttachment := range volumeAttachments {
+		if volumeAttachment.Spec.Attacher == c.CSIDriverName && volumeAttachment.Spec.NodeName == nodeName && !volumeAttachment.Status.Attached {
+			names = append(names, volumeAttachment.Name)
+		}
+	}
+	return names
+}
+
+// Progress describes the progress of the migration in a shoot cluster.
+type Progress struct {
+	// Nodes is the number of nodes in the cluster.
+	Nodes int
+	// StagedNodes is the number of nodes whose kubelet has registered the CSI driver and has enabled the migration.
+	StagedNodes int
+	// InTreeVolumesAttached is the number of volumes of the in-tree volume plugin which are still attached.
+	InTreeVolumesAttached int
+	// PendingVolumeAttachments is the number of VolumeAttachments of the CSI driver which are not yet attached.
+	PendingVolumeAttachments int
+}
+
+// Completed returns true if all nodes are staged and no volumes of the in-tree volume plugin are attached anymore.
+func (p Progress) Completed() bool {
+	return p.StagedNodes == p.Nodes && p.InTreeVolumesAttached == 0 && p.PendingVolumeAttachments == 0
+}
+
+// Percentage returns the progress in percent based on the number of staged nodes. It can be used for the progress of
+// the last operation of extension resources.
+func (p Progress) Percentage() int32 {
+	if p.Nodes == 0 {
+		return 100
+	}
+	return int32(p.StagedNodes * 100 / p.Nodes) // #nosec G115 -- StagedNodes is never larger than Nodes.
+}
+
+// String returns a human-readable description of the progress.
+func (p Progress) String() string {
+	return fmt.Sprintf("%d/%d nodes staged for CSI migration, %d in-tree volume(s) still attached, %d pending volume attachment(s)",
+		p.StagedNodes, p.Nodes, p.InTreeVolumesAttached, p.PendingVolumeAttachments)
+}
+
+// GetProgress computes the progress of the migration based on the Nodes, CSINodes, and VolumeAttachments in the shoot
+// cluster that the given client talks to.
+func (c Config) GetProgress(ctx context.Context, shootClient client.Reader) (Progress, error) {
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList); err != nil {
+		return Progress{}, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	csiNodeList := &storagev1.CSINodeList{}
+	if err := shootClient.List(ctx, csiNodeList); err != nil {
+		return Progress{}, fmt.Errorf("failed listing CSINodes: %w", err)
+	}
+
+	volumeAttachmentList := &storagev1.VolumeAttachmentList{}
+	if err := shootClient.List(ctx, volumeAttachmentList); err != nil {
+		return Progress{}, fmt.Errorf("failed listing VolumeAttachments: %w", err)
+	}
+
+	csiNodes := make(map[string]*storagev1.CSINode, len(csiNodeList.Items))
+	for i := range csiNodeList.Items {
+		csiNodes[csiNodeList.Items[i].Name] = &csiNodeList.Items[i]
+	}
+
+	progress := Progress{Nodes: len(nodeList.Items)}
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+
+		if c.IsNodeStaged(csiNodes[node.Name]) {
+			progress.StagedNodes++
+		}
+		progress.InTreeVolumesAttached += len(c.InTreeVolumesAttached(node))
+		progress.PendingVolumeAttachments += len(c.PendingVolumeAttachments(volumeAttachmentList.Items, node.Name))
+	}
+
+	return progress, nil
+}
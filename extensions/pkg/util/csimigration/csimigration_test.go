@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csimigration_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/util/csimigration"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("CSIMigration", func() {
+	var config Config
+
+	BeforeEach(func() {
+		config = Config{
+			InTreePluginName:  "kubernetes.io/foo-disk",
+			CSIDriverName:     "disk.csi.foo.com",
+			FeatureGates:      []string{"CSIMigrationFoo"},
+			KubernetesVersion: "1.33",
+		}
+	})
+
+	Describe("#IsEnabled", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.33.2"}}}
+		})
+
+		It("should return true if the shoot version is at least the configured version", func() {
+			Expect(config.IsEnabled(shoot)).To(BeTrue())
+		})
+
+		It("should return false if the shoot version is lower than the configured version", func() {
+			shoot.Spec.Kubernetes.Version = "1.32.5"
+			Expect(config.IsEnabled(shoot)).To(BeFalse())
+		})
+
+		It("should consider the version from the shoot annotation", func() {
+			shoot.Annotations = map[string]string{"alpha.csimigration.shoot.extensions.gardener.cloud/kubernetes-version": "1.34"}
+			Expect(config.IsEnabled(shoot)).To(BeFalse())
+		})
+
+		It("should return false if no version is configured", func() {
+			config.KubernetesVersion = ""
+			Expect(config.IsEnabled(shoot)).To(BeFalse())
+		})
+	})
+
+	Describe("#EnsureKubeletFeatureGates", func() {
+		It("should enable the feature gates", func() {
+			kubeletConfig := &kubeletconfigv1beta1.KubeletConfiguration{}
+			config.EnsureKubeletFeatureGates(kubeletConfig)
+			Expect(kubeletConfig.FeatureGates).To(Equal(map[string]bool{"CSIMigrationFoo": true}))
+		})
+
+		It("should not overwrite explicitly configured feature gates", func() {
+			kubeletConfig := &kubeletconfigv1beta1.KubeletConfiguration{FeatureGates: map[string]bool{"CSIMigrationFoo": false, "Bar": true}}
+			config.EnsureKubeletFeatureGates(kubeletConfig)
+			Expect(kubeletConfig.FeatureGates).To(Equal(map[string]bool{"CSIMigrationFoo": false, "Bar": true}))
+		})
+	})
+
+	Describe("#IsNodeStaged", func() {
+		It("should return false if there is no CSINode", func() {
+			Expect(config.IsNodeStaged(nil)).To(BeFalse())
+		})
+
+		It("should return false if the driver is not registered", func() {
+			Expect(config.IsNodeStaged(csiNode("node", false, "kubernetes.io/foo-disk"))).To(BeFalse())
+		})
+
+		It("should return false if the plugin is not migrated", func() {
+			Expect(config.IsNodeStaged(csiNode("node", true, "kubernetes.io/bar"))).To(BeFalse())
+		})
+
+		It("should return true if the driver is registered and the plugin is migrated", func() {
+			Expect(config.IsNodeStaged(csiNode("node", true, "kubernetes.io/bar, kubernetes.io/foo-disk"))).To(BeTrue())
+		})
+	})
+
+	Describe("#GetProgress", func() {
+		var (
+			ctx         = context.Background()
+			shootClient client.Client
+		)
+
+		BeforeEach(func() {
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		})
+
+		It("should report a completed migration if there are no nodes", func() {
+			progress, err := config.GetProgress(ctx, shootClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress.Completed()).To(BeTrue())
+			Expect(progress.Percentage()).To(Equal(int32(100)))
+		})
+
+		It("should compute the progress of the migration", func() {
+			node1 := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+				Status: corev1.NodeStatus{VolumesAttached: []corev1.AttachedVolume{
+					{Name: "kubernetes.io/foo-disk/vol-1"},
+					{Name: "kubernetes.io/csi/disk.csi.foo.com^vol-2"},
+				}},
+			}
+			node2 := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node2"}}
+			volumeAttachment := &storagev1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{Name: "csi-123"},
+				Spec:       storagev1.VolumeAttachmentSpec{Attacher: "disk.csi.foo.com", NodeName: "node2"},
+			}
+
+			for _, obj := range []client.Object{node1, node2, csiNode("node2", true, "kubernetes.io/foo-disk"), volumeAttachment} {
+				Expect(shootClient.Create(ctx, obj)).To(Succeed())
+			}
+
+			progress, err := config.GetProgress(ctx, shootClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress).To(Equal(Progress{Nodes: 2, StagedNodes: 1, InTreeVolumesAttached: 1, PendingVolumeAttachments: 1}))
+			Expect(progress.Completed()).To(BeFalse())
+			Expect(progress.Percentage()).To(Equal(int32(50)))
+			Expect(progress.String()).To(Equal("1/2 nodes staged for CSI migration, 1 in-tree volume(s) still attached, 1 pending volume attachment(s)"))
+		})
+	})
+})
+
+func csiNode(name string, driverRegistered bool, migratedPlugins string) *storagev1.CSINode {
+	obj := &storagev1.CSINode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{"storage.alpha.kubernetes.io/migrated-plugins": migratedPlugins},
+		},
+	}
+	if driverRegistered {
+		obj.Spec.Drivers = []storagev1.CSINodeDriver{{Name: "disk.csi.foo.com", NodeID: name}}
+	}
+	return obj
+}
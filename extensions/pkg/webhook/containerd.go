@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// EnsureContainerdRegistry merges the given registry config into the given slice. If no registry config with the same
+// upstream exists, it is appended. Otherwise, the hosts of both configs are merged, existing hosts keep their position.
+// An error is returned if both configs contain different values for the same field or for a host with the same URL,
+// i.e., if multiple extensions contribute conflicting configurations for the same upstream.
+func EnsureContainerdRegistry(items []extensionsv1alpha1.RegistryConfig, item extensionsv1alpha1.RegistryConfig) ([]extensionsv1alpha1.RegistryConfig, error) {
+	i := slices.IndexFunc(items, func(r extensionsv1alpha1.RegistryConfig) bool {
+		return r.Upstream == item.Upstream
+	})
+	if i < 0 {
+		return append(items, item), nil
+	}
+
+	merged := *items[i].DeepCopy()
+
+	server, err := mergeOptionalValue(merged.Server, item.Server)
+	if err != nil {
+		return nil, fmt.Errorf("conflicting server for registry %q: %w", item.Upstream, err)
+	}
+	merged.Server = server
+
+	readinessProbe, err := mergeOptionalValue(merged.ReadinessProbe, item.ReadinessProbe)
+	if err != nil {
+		return nil, fmt.Errorf("conflicting readiness probe for registry %q: %w", item.Upstream, err)
+	}
+	merged.ReadinessProbe = readinessProbe
+
+	for _, host := range item.Hosts {
+		j := slices.IndexFunc(merged.Hosts, func(h extensionsv1alpha1.RegistryHost) bool {
+			return h.URL == host.URL
+		})
+		if j < 0 {
+			merged.Hosts = append(merged.Hosts, host)
+			continue
+		}
+
+		if !reflect.DeepEqual(merged.Hosts[j], host) {
+			return nil, fmt.Errorf("conflicting configuration for host %q of registry %q", host.URL, item.Upstream)
+		}
+	}
+
+	items[i] = merged
+	return items, nil
+}
+
+// EnsureContainerdPlugin merges the given plugin config into the given slice. If no plugin config with the same path
+// exists, it is appended. Otherwise, the values of both configs are merged recursively. An error is returned if the
+// operations of both configs differ or if they contain different values for the same key, i.e., if multiple extensions
+// contribute conflicting configurations for the same plugin path.
+func EnsureContainerdPlugin(items []extensionsv1alpha1.PluginConfig, item extensionsv1alpha1.PluginConfig) ([]extensionsv1alpha1.PluginConfig, error) {
+	i := slices.IndexFunc(items, func(p extensionsv1alpha1.PluginConfig) bool {
+		return slices.Equal(p.Path, item.Path)
+	})
+	if i < 0 {
+		return append(items, item), nil
+	}
+
+	var (
+		path     = strings.Join(item.Path, ".")
+		existing = items[i]
+	)
+
+	if ptr.Deref(existing.Op, extensionsv1alpha1.AddPluginPathOperation) != ptr.Deref(item.Op, extensionsv1alpha1.AddPluginPathOperation) {
+		return nil, fmt.Errorf("conflicting operations for plugin path %q", path)
+	}
+
+	existingValues, err := pluginValues(existing.Values)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding existing values for plugin path %q: %w", path, err)
+	}
+	values, err := pluginValues(item.Values)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding values for plugin path %q: %w", path, err)
+	}
+
+	if err := mergePluginValues(existingValues, values, path); err != nil {
+		return nil, err
+	}
+
+	merged := *existing.DeepCopy()
+	if len(existingValues) > 0 {
+		raw, err := json.Marshal(existingValues)
+		if err != nil {
+			return nil, fmt.Errorf("failed encoding values for plugin path %q: %w", path, err)
+		}
+		merged.Values = &apiextensionsv1.JSON{Raw: raw}
+	}
+
+	items[i] = merged
+	return items, nil
+}
+
+func mergeOptionalValue[T comparable](existing, value *T) (*T, error) {
+	if existing == nil {
+		return value, nil
+	}
+	if value != nil && *existing != *value {
+		return nil, fmt.Errorf("%v and %v", *existing, *value)
+	}
+	return existing, nil
+}
+
+func pluginValues(values *apiextensionsv1.JSON) (map[string]any, error) {
+	out := map[string]any{}
+	if values == nil || len(values.Raw) == 0 {
+		return out, nil
+	}
+	if err := json.Unmarshal(values.Raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func mergePluginValues(dst, src map[string]any, path string) error {
+	for key, value := range src {
+		existing, ok := dst[key]
+		if !ok {
+			dst[key] = value
+			continue
+		}
+
+		existingMap, existingIsMap := existing.(map[string]any)
+		valueMap, valueIsMap := value.(map[string]any)
+		if existingIsMap && valueIsMap {
+			if err := mergePluginValues(existingMap, valueMap, path+"."+key); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(existing, value) {
+			return fmt.Errorf("conflicting values for key %q of plugin path %q", key, path)
+		}
+	}
+
+	return nil
+}
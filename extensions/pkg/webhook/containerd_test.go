@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/extensions/pkg/webhook"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Containerd", func() {
+	Describe("#EnsureContainerdRegistry", func() {
+		var registries []extensionsv1alpha1.RegistryConfig
+
+		BeforeEach(func() {
+			registries = []extensionsv1alpha1.RegistryConfig{
+				{
+					Upstream: "docker.io",
+					Server:   ptr.To("https://registry-1.docker.io"),
+					Hosts:    []extensionsv1alpha1.RegistryHost{{URL: "https://mirror1.example.com"}},
+				},
+				{
+					Upstream: "gcr.io",
+				},
+			}
+		})
+
+		It("should append the registry if no registry with the same upstream exists", func() {
+			result, err := webhook.EnsureContainerdRegistry(registries, extensionsv1alpha1.RegistryConfig{Upstream: "quay.io"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(3))
+			Expect(result[2].Upstream).To(Equal("quay.io"))
+		})
+
+		It("should merge the hosts of registries with the same upstream", func() {
+			result, err := webhook.EnsureContainerdRegistry(registries, extensionsv1alpha1.RegistryConfig{
+				Upstream:       "docker.io",
+				ReadinessProbe: ptr.To(true),
+				Hosts: []extensionsv1alpha1.RegistryHost{
+					{URL: "https://mirror2.example.com"},
+					{URL: "https://mirror1.example.com"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]extensionsv1alpha1.RegistryConfig{
+				{
+					Upstream:       "docker.io",
+					Server:         ptr.To("https://registry-1.docker.io"),
+					ReadinessProbe: ptr.To(true),
+					Hosts: []extensionsv1alpha1.RegistryHost{
+						{URL: "https://mirror1.example.com"},
+						{URL: "https://mirror2.example.com"},
+					},
+				},
+				{
+					Upstream: "gcr.io",
+				},
+			}))
+		})
+
+		It("should be idempotent", func() {
+			result, err := webhook.EnsureContainerdRegistry(registries, *registries[0].DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(registries))
+		})
+
+		It("should return an error if the servers conflict", func() {
+			_, err := webhook.EnsureContainerdRegistry(registries, extensionsv1alpha1.RegistryConfig{
+				Upstream: "docker.io",
+				Server:   ptr.To("https://other.example.com"),
+			})
+			Expect(err).To(MatchError(ContainSubstring(`conflicting server for registry "docker.io"`)))
+		})
+
+		It("should return an error if the hosts with the same URL conflict", func() {
+			_, err := webhook.EnsureContainerdRegistry(registries, extensionsv1alpha1.RegistryConfig{
+				Upstream: "docker.io",
+				Hosts:    []extensionsv1alpha1.RegistryHost{{URL: "https://mirror1.example.com", OverridePath: ptr.To(true)}},
+			})
+			Expect(err).To(MatchError(ContainSubstring(`conflicting configuration for host "https://mirror1.example.com" of registry "docker.io"`)))
+		})
+	})
+
+	Describe("#EnsureContainerdPlugin", func() {
+		var plugins []extensionsv1alpha1.PluginConfig
+
+		BeforeEach(func() {
+			plugins = []extensionsv1alpha1.PluginConfig{
+				{
+					Path:   []string{"io.containerd.grpc.v1.cri", "containerd", "runtimes", "runsc"},
+					Values: &apiextensionsv1.JSON{Raw: []byte(`{"runtime_type":"io.containerd.runsc.v1","options":{"TypeUrl":"foo"}}`)},
+				},
+			}
+		})
+
+		It("should append the plugin if no plugin with the same path exists", func() {
+			result, err := webhook.EnsureContainerdPlugin(plugins, extensionsv1alpha1.PluginConfig{
+				Path: []string{"io.containerd.grpc.v1.cri", "containerd", "runtimes", "kata"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(2))
+		})
+
+		It("should merge the values of plugins with the same path", func() {
+			result, err := webhook.EnsureContainerdPlugin(plugins, extensionsv1alpha1.PluginConfig{
+				Path:   []string{"io.containerd.grpc.v1.cri", "containerd", "runtimes", "runsc"},
+				Values: &apiextensionsv1.JSON{Raw: []byte(`{"options":{"ConfigPath":"/etc/runsc.toml"},"runtime_type":"io.containerd.runsc.v1"}`)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(string(result[0].Values.Raw)).To(Equal(`{"options":{"ConfigPath":"/etc/runsc.toml","TypeUrl":"foo"},"runtime_type":"io.containerd.runsc.v1"}`))
+		})
+
+		It("should return an error if the values conflict", func() {
+			_, err := webhook.EnsureContainerdPlugin(plugins, extensionsv1alpha1.PluginConfig{
+				Path:   []string{"io.containerd.grpc.v1.cri", "containerd", "runtimes", "runsc"},
+				Values: &apiextensionsv1.JSON{Raw: []byte(`{"options":{"TypeUrl":"bar"}}`)},
+			})
+			Expect(err).To(MatchError(ContainSubstring(`conflicting values for key "TypeUrl"`)))
+		})
+
+		It("should return an error if the operations conflict", func() {
+			_, err := webhook.EnsureContainerdPlugin(plugins, extensionsv1alpha1.PluginConfig{
+				Op:   ptr.To(extensionsv1alpha1.RemovePluginPathOperation),
+				Path: []string{"io.containerd.grpc.v1.cri", "containerd", "runtimes", "runsc"},
+			})
+			Expect(err).To(MatchError(ContainSubstring("conflicting operations")))
+		})
+	})
+})
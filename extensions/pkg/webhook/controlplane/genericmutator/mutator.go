@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/coreos/go-systemd/v22/unit"
@@ -348,7 +349,49 @@ func (m *mutator) mutateOperatingSystemConfigReconcile(ctx context.Context, gctx
 		return err
 	}
 
-	return m.ensurer.EnsureCRIConfig(ctx, gctx, osc.Spec.CRIConfig, oldCRIConfig)
+	// The ensurer works on a copy of the CRI config, so that its containerd contributions can be merged into the ones of
+	// other extensions with conflict detection instead of the last writer silently winning.
+	criConfig := osc.Spec.CRIConfig.DeepCopy()
+	if err := m.ensurer.EnsureCRIConfig(ctx, gctx, criConfig, oldCRIConfig); err != nil {
+		return err
+	}
+
+	mergedCRIConfig, err := mergeCRIConfig(osc.Spec.CRIConfig, criConfig)
+	if err != nil {
+		return fmt.Errorf("failed merging containerd configuration: %w", err)
+	}
+	osc.Spec.CRIConfig = mergedCRIConfig
+
+	return nil
+}
+
+// mergeCRIConfig returns the CRI config as ensured by the ensurer, but with its containerd registry and plugin configs
+// merged into the existing ones via the conflict-detecting merge helpers. Existing configs cannot be removed this way.
+func mergeCRIConfig(existing, ensured *extensionsv1alpha1.CRIConfig) (*extensionsv1alpha1.CRIConfig, error) {
+	if existing == nil || existing.Containerd == nil || ensured == nil || ensured.Containerd == nil {
+		return ensured, nil
+	}
+
+	var (
+		merged = ensured.DeepCopy()
+		err    error
+	)
+
+	merged.Containerd.Registries = slices.Clone(existing.Containerd.Registries)
+	for _, registry := range ensured.Containerd.Registries {
+		if merged.Containerd.Registries, err = extensionswebhook.EnsureContainerdRegistry(merged.Containerd.Registries, registry); err != nil {
+			return nil, err
+		}
+	}
+
+	merged.Containerd.Plugins = slices.Clone(existing.Containerd.Plugins)
+	for _, plugin := range ensured.Containerd.Plugins {
+		if merged.Containerd.Plugins, err = extensionswebhook.EnsureContainerdPlugin(merged.Containerd.Plugins, plugin); err != nil {
+			return nil, err
+		}
+	}
+
+	return merged, nil
 }
 
 func (m *mutator) ensureKubeletServiceUnitContent(ctx context.Context, gctx extensionscontextwebhook.GardenContext, kubeletVersion *semver.Version, content, oldContent *string) error {
@@ -18,6 +18,7 @@ import (
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
@@ -590,6 +591,60 @@ var _ = Describe("Mutator", func() {
 					cloudProvider := extensionswebhook.FileWithPath(newOSC.Spec.Files, genericmutator.CloudProviderConfigPath)
 					Expect(cloudProvider).To(BeNil())
 				})
+
+				Context("containerd contributions", func() {
+					var oldOSC *extensionsv1alpha1.OperatingSystemConfig
+
+					BeforeEach(func() {
+						newOSC.Spec.Units = nil
+						newOSC.Spec.Files = nil
+						newOSC.Spec.CRIConfig.Containerd.Registries[0].Server = ptr.To("https://registry.k8s.io")
+						newOSC.Spec.CRIConfig.Containerd.Plugins = []extensionsv1alpha1.PluginConfig{{
+							Path:   []string{"io.containerd.grpc.v1.cri", "containerd", "runtimes", "runsc"},
+							Values: &apiextensionsv1.JSON{Raw: []byte(`{"runtime_type":"io.containerd.runsc.v1"}`)},
+						}}
+						oldOSC = newOSC.DeepCopy()
+
+						ensurer.EXPECT().ShouldProvisionKubeletCloudProviderConfig(context.Background(), gomock.Any(), kubernetesVersionSemver).Return(false)
+						ensurer.EXPECT().EnsureAdditionalFiles(context.Background(), gomock.Any(), &newOSC.Spec.Files, &oldOSC.Spec.Files)
+						ensurer.EXPECT().EnsureAdditionalUnits(context.Background(), gomock.Any(), &newOSC.Spec.Units, &oldOSC.Spec.Units)
+					})
+
+					It("should merge the containerd contributions of the ensurer into the existing ones", func() {
+						ensurer.EXPECT().EnsureCRIConfig(context.Background(), gomock.Any(), newOSC.Spec.CRIConfig, oldOSC.Spec.CRIConfig).DoAndReturn(
+							func(_ context.Context, _ extensionscontextwebhook.GardenContext, criConfig, _ *extensionsv1alpha1.CRIConfig) error {
+								criConfig.Containerd.Registries = []extensionsv1alpha1.RegistryConfig{
+									{Upstream: "registry.k8s.io", Hosts: []extensionsv1alpha1.RegistryHost{{URL: "https://mirror.example.com"}}},
+									{Upstream: "docker.io", Server: ptr.To("https://registry-1.docker.io")},
+								}
+								criConfig.Containerd.Plugins = []extensionsv1alpha1.PluginConfig{{
+									Path:   []string{"io.containerd.grpc.v1.cri", "containerd", "runtimes", "runsc"},
+									Values: &apiextensionsv1.JSON{Raw: []byte(`{"options":{"TypeUrl":"io.containerd.runsc.v1.options"}}`)},
+								}}
+								return nil
+							})
+
+						Expect(mutator.Mutate(context.Background(), newOSC, oldOSC)).To(Succeed())
+						Expect(newOSC.Spec.CRIConfig.Containerd.Registries).To(Equal([]extensionsv1alpha1.RegistryConfig{
+							{Upstream: "registry.k8s.io", Server: ptr.To("https://registry.k8s.io"), Hosts: []extensionsv1alpha1.RegistryHost{{URL: "https://mirror.example.com"}}},
+							{Upstream: "docker.io", Server: ptr.To("https://registry-1.docker.io")},
+						}))
+						Expect(newOSC.Spec.CRIConfig.Containerd.Plugins).To(HaveLen(1))
+						Expect(newOSC.Spec.CRIConfig.Containerd.Plugins[0].Values.Raw).To(MatchJSON(`{"runtime_type":"io.containerd.runsc.v1","options":{"TypeUrl":"io.containerd.runsc.v1.options"}}`))
+					})
+
+					It("should fail if the containerd contributions of the ensurer conflict with the existing ones", func() {
+						ensurer.EXPECT().EnsureCRIConfig(context.Background(), gomock.Any(), newOSC.Spec.CRIConfig, oldOSC.Spec.CRIConfig).DoAndReturn(
+							func(_ context.Context, _ extensionscontextwebhook.GardenContext, criConfig, _ *extensionsv1alpha1.CRIConfig) error {
+								criConfig.Containerd.Registries[0].Server = ptr.To("https://mirror.example.com")
+								criConfig.Containerd.Plugins[0].Values = &apiextensionsv1.JSON{Raw: []byte(`{"runtime_type":"io.containerd.kata.v2"}`)}
+								return nil
+							})
+
+						Expect(mutator.Mutate(context.Background(), newOSC, oldOSC)).To(MatchError(ContainSubstring(`conflicting server for registry "registry.k8s.io"`)))
+						Expect(newOSC.Spec.CRIConfig.Containerd.Registries[0].Server).To(PointTo(Equal("https://registry.k8s.io")))
+					})
+				})
 			})
 		})
 	})
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"encoding/json"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// LabelNetworkPolicyToWebhookTargets is the label the shoot kube-apiserver pods carry in order to be allowed to reach the
+// webhook servers of extensions.
+const LabelNetworkPolicyToWebhookTargets = resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "to-" + v1beta1constants.LabelNetworkPolicyExtensionsNamespaceAlias + "-" + v1beta1constants.LabelNetworkPolicyWebhookTargets
+
+// ShootNamespaceSelector returns the selector for the shoot namespaces in the seed whose kube-apiservers call the shoot
+// webhooks. The given labels (usually the shoot namespace selector of the extension) further restrict the namespaces.
+func ShootNamespaceSelector(shootNamespaceSelector map[string]string) metav1.LabelSelector {
+	matchLabels := map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}
+	maps.Copy(matchLabels, shootNamespaceSelector)
+	return metav1.LabelSelector{MatchLabels: matchLabels}
+}
+
+// BuildNetworkPolicy builds a NetworkPolicy for the given webhooks which allows the kube-apiservers calling them to reach
+// the webhook server pods matching the given pod selector on the given server port. Seed webhooks are called by the
+// seed's kube-apiserver which might run outside the cluster, hence ingress from everywhere is allowed if there is at
+// least one of them. Shoot webhooks are only called by the shoot kube-apiservers running in the shoot namespaces
+// matching the given selector. It returns nil if there are no webhooks.
+func BuildNetworkPolicy(
+	webhooks []*Webhook,
+	namespace string,
+	componentName string, doNotPrefixComponentName bool,
+	serverPort int,
+	podSelector map[string]string,
+	shootNamespaceSelector map[string]string,
+) *networkingv1.NetworkPolicy {
+	hasSeedWebhooks, hasShootWebhooks := webhookTargets(webhooks)
+	if !hasSeedWebhooks && !hasShootWebhooks {
+		return nil
+	}
+
+	ingressRule := networkingv1.NetworkPolicyIngressRule{
+		Ports: []networkingv1.NetworkPolicyPort{webhookServerPort(serverPort)},
+	}
+
+	if !hasSeedWebhooks {
+		ingressRule.From = []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: ptr.To(ShootNamespaceSelector(shootNamespaceSelector)),
+			PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{LabelNetworkPolicyToWebhookTargets: v1beta1constants.LabelNetworkPolicyAllowed}},
+		}}
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ingress-to-" + PrefixedName(componentName, doNotPrefixComponentName) + "-webhook-server",
+			Namespace: namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: podSelector},
+			Ingress:     []networkingv1.NetworkPolicyIngressRule{ingressRule},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+}
+
+// InjectNetworkPolicyAnnotations injects the annotations into the given webhook server service which make the network
+// policy controller of gardener-resource-manager create the NetworkPolicies needed for the given webhooks, see
+// BuildNetworkPolicy for details.
+func InjectNetworkPolicyAnnotations(service *corev1.Service, webhooks []*Webhook, serverPort int, shootNamespaceSelector map[string]string) error {
+	hasSeedWebhooks, hasShootWebhooks := webhookTargets(webhooks)
+	port := webhookServerPort(serverPort)
+
+	if hasSeedWebhooks {
+		rawPorts, err := json.Marshal([]networkingv1.NetworkPolicyPort{port})
+		if err != nil {
+			return err
+		}
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, resourcesv1alpha1.NetworkingFromWorldToPorts, string(rawPorts))
+	}
+
+	if hasShootWebhooks {
+		if err := gardenerutils.InjectNetworkPolicyAnnotationsForWebhookTargets(service, port); err != nil {
+			return err
+		}
+		if err := gardenerutils.InjectNetworkPolicyNamespaceSelectors(service, ShootNamespaceSelector(shootNamespaceSelector)); err != nil {
+			return err
+		}
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, resourcesv1alpha1.NetworkingPodLabelSelectorNamespaceAlias, v1beta1constants.LabelNetworkPolicyExtensionsNamespaceAlias)
+	}
+
+	return nil
+}
+
+func webhookTargets(webhooks []*Webhook) (hasSeedWebhooks, hasShootWebhooks bool) {
+	for _, webhook := range webhooks {
+		switch webhook.Target {
+		case TargetSeed:
+			hasSeedWebhooks = true
+		case TargetShoot:
+			hasShootWebhooks = true
+		}
+	}
+	return
+}
+
+func webhookServerPort(serverPort int) networkingv1.NetworkPolicyPort {
+	return networkingv1.NetworkPolicyPort{
+		Protocol: ptr.To(corev1.ProtocolTCP),
+		Port:     ptr.To(intstr.FromInt32(int32(serverPort))), // #nosec: G115 - Port is validated on Kubernetes level
+	}
+}
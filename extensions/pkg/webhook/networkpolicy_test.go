@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/extensions/pkg/webhook"
+)
+
+var _ = Describe("NetworkPolicy", func() {
+	var (
+		namespace              = "extension-provider-test"
+		providerName           = "provider-test"
+		serverPort             = 10250
+		podSelector            = map[string]string{"app": "gardener-extension-provider-test"}
+		shootNamespaceSelector = map[string]string{"shoot.gardener.cloud/provider": "test"}
+
+		seedWebhook  = &Webhook{Name: "seed", Target: TargetSeed}
+		shootWebhook = &Webhook{Name: "shoot", Target: TargetShoot}
+
+		port = networkingv1.NetworkPolicyPort{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(10250))}
+	)
+
+	Describe("#BuildNetworkPolicy", func() {
+		It("should return nil if there are no webhooks", func() {
+			Expect(BuildNetworkPolicy(nil, namespace, providerName, false, serverPort, podSelector, shootNamespaceSelector)).To(BeNil())
+		})
+
+		It("should allow ingress from everywhere if there are seed webhooks", func() {
+			Expect(BuildNetworkPolicy([]*Webhook{seedWebhook, shootWebhook}, namespace, providerName, false, serverPort, podSelector, shootNamespaceSelector)).To(Equal(&networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-to-gardener-extension-provider-test-webhook-server",
+					Namespace: namespace,
+				},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: podSelector},
+					Ingress:     []networkingv1.NetworkPolicyIngressRule{{Ports: []networkingv1.NetworkPolicyPort{port}}},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				},
+			}))
+		})
+
+		It("should only allow ingress from the shoot kube-apiservers if there are only shoot webhooks", func() {
+			Expect(BuildNetworkPolicy([]*Webhook{shootWebhook}, namespace, providerName, false, serverPort, podSelector, shootNamespaceSelector)).To(Equal(&networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-to-gardener-extension-provider-test-webhook-server",
+					Namespace: namespace,
+				},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: podSelector},
+					Ingress: []networkingv1.NetworkPolicyIngressRule{{
+						From: []networkingv1.NetworkPolicyPeer{{
+							NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{
+								"gardener.cloud/role":           "shoot",
+								"shoot.gardener.cloud/provider": "test",
+							}},
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{
+								"networking.resources.gardener.cloud/to-extensions-all-webhook-targets": "allowed",
+							}},
+						}},
+						Ports: []networkingv1.NetworkPolicyPort{port},
+					}},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				},
+			}))
+		})
+	})
+
+	Describe("#InjectNetworkPolicyAnnotations", func() {
+		var service *corev1.Service
+
+		BeforeEach(func() {
+			service = &corev1.Service{}
+		})
+
+		It("should not inject any annotations if there are no webhooks", func() {
+			Expect(InjectNetworkPolicyAnnotations(service, nil, serverPort, shootNamespaceSelector)).To(Succeed())
+			Expect(service.Annotations).To(BeEmpty())
+		})
+
+		It("should inject the annotations for seed and shoot webhooks", func() {
+			Expect(InjectNetworkPolicyAnnotations(service, []*Webhook{seedWebhook, shootWebhook}, serverPort, shootNamespaceSelector)).To(Succeed())
+			Expect(service.Annotations).To(Equal(map[string]string{
+				"networking.resources.gardener.cloud/from-world-to-ports":                    `[{"protocol":"TCP","port":10250}]`,
+				"networking.resources.gardener.cloud/from-all-webhook-targets-allowed-ports": `[{"protocol":"TCP","port":10250}]`,
+				"networking.resources.gardener.cloud/namespace-selectors":                    `[{"matchLabels":{"gardener.cloud/role":"shoot","shoot.gardener.cloud/provider":"test"}}]`,
+				"networking.resources.gardener.cloud/pod-label-selector-namespace-alias":     "extensions",
+			}))
+		})
+
+		It("should only inject the annotations for shoot webhooks", func() {
+			Expect(InjectNetworkPolicyAnnotations(service, []*Webhook{shootWebhook}, serverPort, nil)).To(Succeed())
+			Expect(service.Annotations).To(Equal(map[string]string{
+				"networking.resources.gardener.cloud/from-all-webhook-targets-allowed-ports": `[{"protocol":"TCP","port":10250}]`,
+				"networking.resources.gardener.cloud/namespace-selectors":                    `[{"matchLabels":{"gardener.cloud/role":"shoot"}}]`,
+				"networking.resources.gardener.cloud/pod-label-selector-namespace-alias":     "extensions",
+			}))
+		})
+	})
+})
@@ -0,0 +1,45 @@
+#!/usr/bin/env bash
+# SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+#
+# SPDX-License-Identifier: Apache-2.0
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+echo "> Load Tests"
+
+namespace="garden"
+metrics_endpoints=""
+pids=()
+
+cleanup() {
+  for pid in "${pids[@]}"; do
+    kill "$pid" 2>/dev/null || true
+  done
+}
+trap cleanup EXIT
+
+# Port-forward the metrics endpoints of the Gardener components running in the local setup so that the load test can
+# measure their workqueues.
+port_forward() {
+  local component="$1" deployment="$2" port="$3"
+
+  if ! kubectl -n "$namespace" get deployment "$deployment" &>/dev/null; then
+    echo "Deployment $namespace/$deployment not found, skipping metrics of $component"
+    return
+  fi
+
+  kubectl -n "$namespace" port-forward "deployment/$deployment" "$port:$port" >/dev/null &
+  pids+=("$!")
+  metrics_endpoints+="${metrics_endpoints:+,}$component=http://localhost:$port/metrics"
+}
+
+port_forward scheduler gardener-scheduler 19251
+port_forward controller-manager gardener-controller-manager 2719
+port_forward gardenlet gardenlet 2729
+
+# Give the port-forwards some time to be established.
+sleep 3
+
+go run ./test/load --metrics-endpoint="$metrics_endpoints" "$@"
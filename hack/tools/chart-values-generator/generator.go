@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const header = `// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by chart-values-generator. DO NOT EDIT.
+
+`
+
+// initialisms are words which are written in upper case in Go identifiers.
+var initialisms = map[string]struct{}{
+	"api": {}, "cpu": {}, "dns": {}, "http": {}, "https": {}, "id": {}, "ip": {}, "json": {}, "tcp": {}, "tls": {},
+	"udp": {}, "uid": {}, "url": {}, "vpn": {},
+}
+
+// schema is the subset of a JSON schema which is supported by the generator.
+type schema struct {
+	Type                 string             `json:"type"`
+	Description          string             `json:"description"`
+	Properties           map[string]*schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *schema            `json:"items"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+
+	// GoType is the Go type which is used for the value instead of a generated one, e.g. `corev1.ServicePort`.
+	GoType string `json:"x-go-type"`
+	// GoTypeImport is the import path of the package of the GoType, e.g. `k8s.io/api/core/v1`.
+	GoTypeImport string `json:"x-go-type-import"`
+}
+
+type generator struct {
+	types   bytes.Buffer
+	imports map[string]string
+}
+
+// Generate generates the Go code with the typed values structs for the given values schema. The top-level struct is
+// named by the given type name, nested objects are named by concatenating the names of their parents and their keys.
+// Optional values are generated as pointers (or nil-able slices and maps) which are omitted when unset, so that the
+// defaults of the chart's values.yaml apply.
+func Generate(schemaJSON []byte, packageName, typeName string) ([]byte, error) {
+	root := &schema{}
+	if err := json.Unmarshal(schemaJSON, root); err != nil {
+		return nil, fmt.Errorf("failed parsing schema: %w", err)
+	}
+
+	if root.Type != "object" || len(root.Properties) == 0 {
+		return nil, fmt.Errorf("schema must describe an object with properties")
+	}
+
+	g := &generator{imports: map[string]string{}}
+	if err := g.generateStruct(typeName, root); err != nil {
+		return nil, err
+	}
+
+	out := &bytes.Buffer{}
+	out.WriteString(header)
+	fmt.Fprintf(out, "package %s\n\n", packageName)
+
+	if len(g.imports) > 0 {
+		out.WriteString("import (\n")
+		for _, alias := range slices.Sorted(maps.Keys(g.imports)) {
+			fmt.Fprintf(out, "\t%s %q\n", alias, g.imports[alias])
+		}
+		out.WriteString(")\n\n")
+	}
+
+	out.Write(g.types.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+func (g *generator) generateStruct(typeName string, s *schema) error {
+	var (
+		fields      bytes.Buffer
+		nestedTypes []func() error
+	)
+
+	for _, key := range slices.Sorted(maps.Keys(s.Properties)) {
+		property := s.Properties[key]
+		fieldName := goName(key)
+		required := slices.Contains(s.Required, key)
+
+		fieldType, nested, err := g.goType(typeName+fieldName, property)
+		if err != nil {
+			return fmt.Errorf("failed generating type for %q: %w", key, err)
+		}
+		if nested != nil {
+			nestedTypes = append(nestedTypes, nested)
+		}
+
+		tag := key
+		if !required {
+			tag += ",omitempty"
+			if !strings.HasPrefix(fieldType, "[]") && !strings.HasPrefix(fieldType, "map[") && fieldType != "any" {
+				fieldType = "*" + fieldType
+			}
+		}
+
+		if property.Description != "" {
+			writeComment(&fields, "\t", fieldComment(key, fieldName, property.Description))
+		}
+		fmt.Fprintf(&fields, "\t%s %s `json:%q`\n", fieldName, fieldType, tag)
+	}
+
+	writeComment(&g.types, "", typeName+" is generated from the values schema of the chart.")
+	fmt.Fprintf(&g.types, "type %s struct {\n%s}\n\n", typeName, fields.String())
+
+	for _, generateNested := range nestedTypes {
+		if err := generateNested(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// goType returns the Go type for the given schema. If the schema requires a new struct type, a function generating it
+// is returned as well.
+func (g *generator) goType(typeName string, s *schema) (string, func() error, error) {
+	if s.GoType != "" {
+		if s.GoTypeImport != "" {
+			alias, _, ok := strings.Cut(strings.TrimLeft(s.GoType, "[]*"), ".")
+			if !ok {
+				return "", nil, fmt.Errorf("x-go-type %q must be qualified with a package when x-go-type-import is set", s.GoType)
+			}
+			g.imports[alias] = s.GoTypeImport
+		}
+		return s.GoType, nil, nil
+	}
+
+	switch s.Type {
+	case "string":
+		return "string", nil, nil
+	case "integer":
+		return "int", nil, nil
+	case "number":
+		return "float64", nil, nil
+	case "boolean":
+		return "bool", nil, nil
+	case "array":
+		if s.Items == nil {
+			return "[]any", nil, nil
+		}
+		itemType, nested, err := g.goType(typeName, s.Items)
+		if err != nil {
+			return "", nil, err
+		}
+		return "[]" + itemType, nested, nil
+	case "object":
+		if len(s.Properties) > 0 {
+			return typeName, func() error { return g.generateStruct(typeName, s) }, nil
+		}
+		return g.mapType(typeName, s)
+	case "":
+		return "any", nil, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported type %q", s.Type)
+	}
+}
+
+func (g *generator) mapType(typeName string, s *schema) (string, func() error, error) {
+	if len(s.AdditionalProperties) == 0 || string(s.AdditionalProperties) == "true" {
+		return "map[string]any", nil, nil
+	}
+
+	valueSchema := &schema{}
+	if err := json.Unmarshal(s.AdditionalProperties, valueSchema); err != nil {
+		return "", nil, fmt.Errorf("failed parsing additionalProperties: %w", err)
+	}
+
+	valueType, nested, err := g.goType(typeName+"Value", valueSchema)
+	if err != nil {
+		return "", nil, err
+	}
+	return "map[string]" + valueType, nested, nil
+}
+
+// goName converts the given camel-case values key to an exported Go identifier, e.g. `loadBalancerIP` to
+// `LoadBalancerIP` or `apiServerURL` to `APIServerURL`.
+func goName(key string) string {
+	var (
+		name strings.Builder
+		word []rune
+	)
+
+	flush := func() {
+		if len(word) == 0 {
+			return
+		}
+		if _, ok := initialisms[strings.ToLower(string(word))]; ok {
+			name.WriteString(strings.ToUpper(string(word)))
+		} else {
+			name.WriteRune(unicode.ToUpper(word[0]))
+			name.WriteString(string(word[1:]))
+		}
+		word = nil
+	}
+
+	for _, r := range key {
+		switch {
+		case r == '-' || r == '_' || r == '.':
+			flush()
+		case unicode.IsUpper(r) && len(word) > 0 && !unicode.IsUpper(word[len(word)-1]):
+			flush()
+			word = append(word, r)
+		default:
+			word = append(word, r)
+		}
+	}
+	flush()
+
+	return name.String()
+}
+
+func writeComment(out *bytes.Buffer, indent, text string) {
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		fmt.Fprintf(out, "%s// %s\n", indent, strings.TrimSpace(line))
+	}
+}
+
+// fieldComment returns the comment for a field. Descriptions in the schema conventionally start with the values key
+// which is replaced by the name of the field.
+func fieldComment(key, fieldName, description string) string {
+	if rest, ok := strings.CutPrefix(description, key+" "); ok {
+		return fieldName + " " + rest
+	}
+	return description
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Generator", func() {
+	Describe("#Generate", func() {
+		DescribeTable("should generate the values structs",
+			func(schemaJSON, expectedTypes string) {
+				code, err := Generate([]byte(schemaJSON), "foo", "Values")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(code)).To(Equal(`// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by chart-values-generator. DO NOT EDIT.
+
+package foo
+` + expectedTypes))
+			},
+
+			Entry("scalar types",
+				`{
+  "type": "object",
+  "required": ["name", "replicas", "ratio", "enabled"],
+  "properties": {
+    "name": {"type": "string"},
+    "replicas": {"type": "integer"},
+    "ratio": {"type": "number"},
+    "enabled": {"type": "boolean"}
+  }
+}`,
+				`
+// Values is generated from the values schema of the chart.
+type Values struct {
+	Enabled  bool    `+"`json:\"enabled\"`"+`
+	Name     string  `+"`json:\"name\"`"+`
+	Ratio    float64 `+"`json:\"ratio\"`"+`
+	Replicas int     `+"`json:\"replicas\"`"+`
+}
+`),
+
+			Entry("optional values as pointers, slices and maps",
+				`{
+  "type": "object",
+  "properties": {
+    "name": {"type": "string"},
+    "hosts": {"type": "array", "items": {"type": "string"}},
+    "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+    "annotations": {"type": "object"},
+    "anything": {}
+  }
+}`,
+				`
+// Values is generated from the values schema of the chart.
+type Values struct {
+	Annotations map[string]any    `+"`json:\"annotations,omitempty\"`"+`
+	Anything    any               `+"`json:\"anything,omitempty\"`"+`
+	Hosts       []string          `+"`json:\"hosts,omitempty\"`"+`
+	Labels      map[string]string `+"`json:\"labels,omitempty\"`"+`
+	Name        *string           `+"`json:\"name,omitempty\"`"+`
+}
+`),
+
+			Entry("nested objects named after their parents",
+				`{
+  "type": "object",
+  "required": ["gateway"],
+  "properties": {
+    "gateway": {
+      "type": "object",
+      "properties": {
+        "ports": {"type": "array", "items": {"type": "object", "properties": {"port": {"type": "integer"}}}}
+      }
+    }
+  }
+}`,
+				`
+// Values is generated from the values schema of the chart.
+type Values struct {
+	Gateway ValuesGateway `+"`json:\"gateway\"`"+`
+}
+
+// ValuesGateway is generated from the values schema of the chart.
+type ValuesGateway struct {
+	Ports []ValuesGatewayPorts `+"`json:\"ports,omitempty\"`"+`
+}
+
+// ValuesGatewayPorts is generated from the values schema of the chart.
+type ValuesGatewayPorts struct {
+	Port *int `+"`json:\"port,omitempty\"`"+`
+}
+`),
+
+			Entry("Go types with imports",
+				`{
+  "type": "object",
+  "required": ["ports"],
+  "properties": {
+    "ports": {"type": "array", "x-go-type": "[]corev1.ServicePort", "x-go-type-import": "k8s.io/api/core/v1"},
+    "duration": {"type": "string", "x-go-type": "string"}
+  }
+}`,
+				`
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Values is generated from the values schema of the chart.
+type Values struct {
+	Duration *string              `+"`json:\"duration,omitempty\"`"+`
+	Ports    []corev1.ServicePort `+"`json:\"ports\"`"+`
+}
+`),
+
+			Entry("field comments from descriptions",
+				`{
+  "type": "object",
+  "properties": {
+    "loadBalancerIP": {"type": "string", "description": "loadBalancerIP is the IP of the load balancer.\nIt is optional."},
+    "vpnEnabled": {"type": "boolean", "description": "Whether the VPN is enabled."}
+  }
+}`,
+				`
+// Values is generated from the values schema of the chart.
+type Values struct {
+	// LoadBalancerIP is the IP of the load balancer.
+	// It is optional.
+	LoadBalancerIP *string `+"`json:\"loadBalancerIP,omitempty\"`"+`
+	// Whether the VPN is enabled.
+	VPNEnabled *bool `+"`json:\"vpnEnabled,omitempty\"`"+`
+}
+`),
+		)
+
+		DescribeTable("should fail for invalid schemas",
+			func(schemaJSON, expectedError string) {
+				_, err := Generate([]byte(schemaJSON), "foo", "Values")
+				Expect(err).To(MatchError(ContainSubstring(expectedError)))
+			},
+
+			Entry("malformed JSON", `{`, "failed parsing schema"),
+			Entry("no object", `{"type": "string"}`, "schema must describe an object with properties"),
+			Entry("object without properties", `{"type": "object"}`, "schema must describe an object with properties"),
+			Entry("unsupported type",
+				`{"type": "object", "properties": {"foo": {"type": "null"}}}`,
+				`failed generating type for "foo": unsupported type "null"`,
+			),
+			Entry("unqualified Go type with import",
+				`{"type": "object", "properties": {"foo": {"x-go-type": "ServicePort", "x-go-type-import": "k8s.io/api/core/v1"}}}`,
+				`x-go-type "ServicePort" must be qualified with a package when x-go-type-import is set`,
+			),
+			Entry("malformed additionalProperties",
+				`{"type": "object", "properties": {"foo": {"type": "object", "additionalProperties": 1}}}`,
+				"failed parsing additionalProperties",
+			),
+		)
+	})
+
+	DescribeTable("#goName",
+		func(key, expected string) {
+			Expect(goName(key)).To(Equal(expected))
+		},
+
+		Entry("simple key", "replicas", "Replicas"),
+		Entry("camel case key", "loadBalancerClass", "LoadBalancerClass"),
+		Entry("initialism at the end", "loadBalancerIP", "LoadBalancerIP"),
+		Entry("initialisms at the beginning and the end", "apiServerURL", "APIServerURL"),
+		Entry("lower case initialism", "vpn", "VPN"),
+		Entry("separators", "proxy-protocol_enabled.value", "ProxyProtocolEnabledValue"),
+	)
+
+	DescribeTable("#fieldComment",
+		func(key, fieldName, description, expected string) {
+			Expect(fieldComment(key, fieldName, description)).To(Equal(expected))
+		},
+
+		Entry("description starting with the key", "apiServerURL", "APIServerURL", "apiServerURL is the URL.", "APIServerURL is the URL."),
+		Entry("description not starting with the key", "apiServerURL", "APIServerURL", "The URL of the API server.", "The URL of the API server."),
+	)
+})
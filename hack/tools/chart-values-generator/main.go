@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	opts := &Options{}
+
+	cmd := &cobra.Command{
+		Use: "chart-values-generator",
+
+		Short: "Generate typed Go values structs from the values.schema.json of a Helm chart.",
+
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			schema, err := os.ReadFile(opts.Schema)
+			if err != nil {
+				return fmt.Errorf("failed reading schema: %w", err)
+			}
+
+			code, err := Generate(schema, opts.Package, opts.TypeName)
+			if err != nil {
+				return err
+			}
+
+			return os.WriteFile(opts.Output, code, 0644)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+
+	if err := cmd.Execute(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+)
+
+// Options contains the options for the chart values generator.
+type Options struct {
+	Schema   string
+	Package  string
+	TypeName string
+	Output   string
+}
+
+// AddFlags adds the flags for the options to the given flag set.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Schema, "schema", "", "Path to the values.schema.json of the chart.")
+	fs.StringVar(&o.Package, "package", os.Getenv("GOPACKAGE"), "Name of the package of the generated file. Defaults to $GOPACKAGE when invoked via go:generate.")
+	fs.StringVar(&o.TypeName, "type", "", "Name of the generated top-level values type.")
+	fs.StringVar(&o.Output, "output", "", "Path of the generated file.")
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if o.Schema == "" {
+		return fmt.Errorf("--schema must be set")
+	}
+	if o.Package == "" {
+		return fmt.Errorf("--package must be set")
+	}
+	if o.TypeName == "" {
+		return fmt.Errorf("--type must be set")
+	}
+	if o.Output == "" {
+		return fmt.Errorf("--output must be set")
+	}
+	return nil
+}
@@ -29,6 +29,7 @@ AVAILABLE_CODEGEN_OPTIONS=(
   "shootresourcereservation_groups"
   "shoottolerationrestriction_groups"
   "shootdnsrewriting_groups"
+  "shootpurposeprofile_groups"
   "provider_local_groups"
   "extensions_config_groups"
   "nodeagent_groups"
@@ -378,6 +379,22 @@ shootdnsrewriting_groups() {
 }
 export -f shootdnsrewriting_groups
 
+shootpurposeprofile_groups() {
+  source "${CODE_GEN_DIR}/kube_codegen.sh"
+  echo "Generating API groups for plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile"
+  
+  kube::codegen::gen_helpers \
+    --boilerplate "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt" \
+    --extra-peer-dir github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile \
+    --extra-peer-dir github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile/v1alpha1 \
+    --extra-peer-dir k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/conversion \
+    --extra-peer-dir k8s.io/apimachinery/pkg/runtime \
+    --extra-peer-dir k8s.io/component-base/config \
+    --extra-peer-dir k8s.io/component-base/config/v1alpha1 \
+    "${PROJECT_ROOT}/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile"
+}
+export -f shootpurposeprofile_groups
+
 shootresourcereservation_groups() {
   source "${CODE_GEN_DIR}/kube_codegen.sh"
   echo "Generating API groups for plugin/pkg/shoot/resourcereservation/apis/shootresourcereservation"
@@ -527,6 +544,7 @@ HELPERS_ONLY_GROUPS=(
   "shootresourcereservation_groups"
   "shoottolerationrestriction_groups"
   "shootdnsrewriting_groups"
+  "shootpurposeprofile_groups"
   "provider_local_groups"
   "extensions_config_groups"
   "nodeagent_groups"
@@ -13,6 +13,10 @@ const (
 	ContainerImageNameAlpineConntrack = "alpine-conntrack"
 	// ContainerImageNameAlpineIptables is a constant for an image in the image vector with name 'alpine-iptables'.
 	ContainerImageNameAlpineIptables = "alpine-iptables"
+	// ContainerImageNameAmdGpuDevicePlugin is a constant for an image in the image vector with name 'amd-gpu-device-plugin'.
+	ContainerImageNameAmdGpuDevicePlugin = "amd-gpu-device-plugin"
+	// ContainerImageNameAmdGpuDriverInstallerGardenlinux is a constant for an image in the image vector with name 'amd-gpu-driver-installer-gardenlinux'.
+	ContainerImageNameAmdGpuDriverInstallerGardenlinux = "amd-gpu-driver-installer-gardenlinux"
 	// ContainerImageNameApiserverProxySidecar is a constant for an image in the image vector with name 'apiserver-proxy-sidecar'.
 	ContainerImageNameApiserverProxySidecar = "apiserver-proxy-sidecar"
 	// ContainerImageNameBlackboxExporter is a constant for an image in the image vector with name 'blackbox-exporter'.
@@ -77,6 +81,10 @@ const (
 	ContainerImageNameIstioIstiod = "istio-istiod"
 	// ContainerImageNameIstioProxy is a constant for an image in the image vector with name 'istio-proxy'.
 	ContainerImageNameIstioProxy = "istio-proxy"
+	// ContainerImageNameKonnectivityAgent is a constant for an image in the image vector with name 'konnectivity-agent'.
+	ContainerImageNameKonnectivityAgent = "konnectivity-agent"
+	// ContainerImageNameKonnectivityServer is a constant for an image in the image vector with name 'konnectivity-server'.
+	ContainerImageNameKonnectivityServer = "konnectivity-server"
 	// ContainerImageNameKubeApiserver is a constant for an image in the image vector with name 'kube-apiserver'.
 	ContainerImageNameKubeApiserver = "kube-apiserver"
 	// ContainerImageNameKubeControllerManager is a constant for an image in the image vector with name 'kube-controller-manager'.
@@ -105,10 +113,16 @@ const (
 	ContainerImageNameNodeLocalDns = "node-local-dns"
 	// ContainerImageNameNodeProblemDetector is a constant for an image in the image vector with name 'node-problem-detector'.
 	ContainerImageNameNodeProblemDetector = "node-problem-detector"
+	// ContainerImageNameNvidiaDevicePlugin is a constant for an image in the image vector with name 'nvidia-device-plugin'.
+	ContainerImageNameNvidiaDevicePlugin = "nvidia-device-plugin"
+	// ContainerImageNameNvidiaDriverInstallerGardenlinux is a constant for an image in the image vector with name 'nvidia-driver-installer-gardenlinux'.
+	ContainerImageNameNvidiaDriverInstallerGardenlinux = "nvidia-driver-installer-gardenlinux"
 	// ContainerImageNameOpentelemetryCollector is a constant for an image in the image vector with name 'opentelemetry-collector'.
 	ContainerImageNameOpentelemetryCollector = "opentelemetry-collector"
 	// ContainerImageNameOpentelemetryOperator is a constant for an image in the image vector with name 'opentelemetry-operator'.
 	ContainerImageNameOpentelemetryOperator = "opentelemetry-operator"
+	// ContainerImageNameOpsToolbelt is a constant for an image in the image vector with name 'ops-toolbelt'.
+	ContainerImageNameOpsToolbelt = "ops-toolbelt"
 	// ContainerImageNamePauseContainer is a constant for an image in the image vector with name 'pause-container'.
 	ContainerImageNamePauseContainer = "pause-container"
 	// ContainerImageNamePerses is a constant for an image in the image vector with name 'perses'.
@@ -49,3 +49,12 @@ func Containers() imagevector.ImageVector {
 func Charts() imagevector.ImageVector {
 	return chartsImageVector
 }
+
+// Override merges the given overrides into the container and chart image vectors. The overrides take precedence over
+// the images from the embedded image vectors and the ones from the environment overrides. It is supposed to be called
+// during startup before the image vectors are used, e.g., for applying the image vector overrides of a gardenlet
+// offline bundle.
+func Override(containers, charts imagevector.ImageVector) {
+	containersImageVector = imagevector.Merge(containersImageVector, containers)
+	chartsImageVector = imagevector.Merge(chartsImageVector, charts)
+}
@@ -321,8 +321,10 @@ func (a *authorizer) authorizeSecret(requestAuthorizer *authwebhook.RequestAutho
 		return auth.DecisionAllow, "", nil
 	}
 
+	// Secrets in other namespaces can be listed/watched individually (e.g., by gardenlet's single object cache) if they
+	// are related to the seed.
 	return requestAuthorizer.Check(graph.VertexTypeSecret, attrs,
-		authwebhook.WithAllowedVerbs("get", "patch", "update", "delete"),
+		authwebhook.WithAllowedVerbs("get", "list", "watch", "patch", "update", "delete"),
 		authwebhook.WithAlwaysAllowedVerbs("create"),
 	)
 }
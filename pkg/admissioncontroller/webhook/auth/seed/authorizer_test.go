@@ -18,6 +18,7 @@ import (
 	eventsv1 "k8s.io/api/events/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apiserver/pkg/authentication/serviceaccount"
 	"k8s.io/apiserver/pkg/authentication/user"
 	auth "k8s.io/apiserver/pkg/authorization/authorizer"
@@ -34,6 +35,7 @@ import (
 	graphutils "github.com/gardener/gardener/pkg/utils/graph"
 	mockgraph "github.com/gardener/gardener/pkg/utils/graph/mock"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+	fakeauthorizerwebhook "github.com/gardener/gardener/pkg/webhook/authorizer/fake"
 )
 
 var _ = Describe("Seed", func() {
@@ -2043,12 +2045,10 @@ var _ = Describe("Seed", func() {
 						decision, reason, err := authorizer.Authorize(ctx, attrs)
 						Expect(err).NotTo(HaveOccurred())
 						Expect(decision).To(Equal(auth.DecisionNoOpinion))
-						Expect(reason).To(ContainSubstring("only the following verbs are allowed for this resource type: [create delete get patch update]"))
+						Expect(reason).To(ContainSubstring("only the following verbs are allowed for this resource type: [create delete get list patch update watch]"))
 
 					},
 
-					Entry("list", "list"),
-					Entry("watch", "watch"),
 					Entry("deletecollection", "deletecollection"),
 				)
 
@@ -2093,10 +2093,60 @@ var _ = Describe("Seed", func() {
 					},
 
 					Entry("get", "get"),
+					Entry("list", "list"),
+					Entry("watch", "watch"),
 					Entry("patch", "patch"),
 					Entry("update", "update"),
 					Entry("delete", "delete"),
 				)
+
+				DescribeTable("should not have an opinion when listing/watching secrets without name outside the seed's namespace",
+					func(verb, namespace string, authorizeWithSelectors, withLabelSelector bool, expectedReason string) {
+						authorizer = NewAuthorizer(log, graph, fakeauthorizerwebhook.NewWithSelectorsChecker(authorizeWithSelectors))
+						attrs.Name = ""
+						attrs.Namespace = namespace
+						attrs.Verb = verb
+
+						if withLabelSelector {
+							selector, err := labels.Parse("foo=bar")
+							Expect(err).NotTo(HaveOccurred())
+							requirements, _ := selector.Requirements()
+							attrs.LabelSelectorRequirements = requirements
+						}
+
+						decision, reason, err := authorizer.Authorize(ctx, attrs)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(decision).To(Equal(auth.DecisionNoOpinion))
+						Expect(reason).To(ContainSubstring(expectedReason))
+					},
+
+					Entry("list in namespace", "list", "bar", true, false, "must specify field or label selector"),
+					Entry("watch in namespace", "watch", "bar", true, false, "must specify field or label selector"),
+					Entry("list in all namespaces", "list", "", true, false, "must specify field or label selector"),
+					Entry("watch in all namespaces", "watch", "", true, false, "must specify field or label selector"),
+					Entry("list in namespace w/ label selector", "list", "bar", true, true, "must specify field or label selector"),
+					Entry("list in namespace w/o authorization with selectors", "list", "bar", false, false, "No Object name found"),
+					Entry("watch in all namespaces w/o authorization with selectors", "watch", "", false, false, "No Object name found"),
+					Entry("list in namespace w/ label selector w/o authorization with selectors", "list", "bar", false, true, "No Object name found"),
+				)
+
+				DescribeTable("should not have an opinion when listing/watching a single secret not related to the seed",
+					func(verb, namespace string) {
+						attrs.Namespace = namespace
+						attrs.Verb = verb
+
+						graph.EXPECT().HasPathFrom(graphutils.VertexTypeSecret, namespace, name, graphutils.VertexTypeSeed, "", seedName).Return(false)
+						decision, reason, err := authorizer.Authorize(ctx, attrs)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(decision).To(Equal(auth.DecisionNoOpinion))
+						Expect(reason).To(ContainSubstring("no relationship found"))
+					},
+
+					Entry("list in namespace", "list", "bar"),
+					Entry("watch in namespace", "watch", "bar"),
+					Entry("list in garden namespace", "list", v1beta1constants.GardenNamespace),
+					Entry("watch in other seed's namespace", "watch", "seed-other"),
+				)
 			})
 
 			Context("when requested for InternalSecrets", func() {
@@ -10,6 +10,7 @@ import (
 	"math"
 	"time"
 
+	"golang.org/x/crypto/ssh"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/authentication"
@@ -29,3 +30,26 @@ func ValidateKubeconfigRequest(req *authentication.KubeconfigRequest) field.Erro
 	}
 	return allErrs
 }
+
+// ValidateSSHCertificateRequest validates a SSHCertificateRequest.
+func ValidateSSHCertificateRequest(req *authentication.SSHCertificateRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if len(req.Spec.PublicKey) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("publicKey"), "must provide a public key"))
+	} else if publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(req.Spec.PublicKey)); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("publicKey"), req.Spec.PublicKey, "must be a valid SSH public key in authorized_keys format: "+err.Error()))
+	} else if _, ok := publicKey.(*ssh.Certificate); ok {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("publicKey"), req.Spec.PublicKey, "must be a plain public key and not a certificate"))
+	}
+
+	const min = 10 * time.Minute
+	if req.Spec.ExpirationSeconds < int64(min.Seconds()) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("expirationSeconds"), req.Spec.ExpirationSeconds, "may not specify a duration less than 10 minutes"))
+	}
+	if req.Spec.ExpirationSeconds > math.MaxUint32 {
+		allErrs = append(allErrs, field.TooLong(specPath.Child("expirationSeconds"), req.Spec.ExpirationSeconds, math.MaxUint32))
+	}
+	return allErrs
+}
@@ -5,12 +5,15 @@
 package validation_test
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
 	"math"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"golang.org/x/crypto/ssh"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/api/authentication/validation"
@@ -69,3 +72,61 @@ var _ = Describe("ValidateKubeconfigRequest", func() {
 		Expect(errors).To(BeEmpty())
 	})
 })
+
+var _ = Describe("ValidateSSHCertificateRequest", func() {
+	var req *authentication.SSHCertificateRequest
+
+	BeforeEach(func() {
+		publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		sshPublicKey, err := ssh.NewPublicKey(publicKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		req = &authentication.SSHCertificateRequest{
+			Spec: authentication.SSHCertificateRequestSpec{
+				PublicKey:         string(ssh.MarshalAuthorizedKey(sshPublicKey)),
+				ExpirationSeconds: int64((time.Minute * 10).Seconds()),
+			},
+		}
+	})
+
+	It("should succeed for a valid request", func() {
+		Expect(validation.ValidateSSHCertificateRequest(req)).To(BeEmpty())
+	})
+
+	It("should fail when the public key is missing", func() {
+		req.Spec.PublicKey = ""
+
+		Expect(validation.ValidateSSHCertificateRequest(req)).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.publicKey"),
+		}))
+	})
+
+	It("should fail when the public key cannot be parsed", func() {
+		req.Spec.PublicKey = "ssh-ed25519 foo"
+
+		Expect(validation.ValidateSSHCertificateRequest(req)).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.publicKey"),
+		}))
+	})
+
+	It("should fail when expirationSeconds is less than 10 minutes", func() {
+		req.Spec.ExpirationSeconds = int64((time.Minute * 9).Seconds())
+
+		Expect(validation.ValidateSSHCertificateRequest(req)).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.expirationSeconds"),
+		}))
+	})
+
+	It("should fail when expirationSeconds is more than 2^32 seconds", func() {
+		req.Spec.ExpirationSeconds = math.MaxUint32 + 1
+
+		Expect(validation.ValidateSSHCertificateRequest(req)).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeTooLong),
+			"Field": Equal("spec.expirationSeconds"),
+		}))
+	})
+})
@@ -5,6 +5,9 @@
 package validation
 
 import (
+	"fmt"
+	"time"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -41,6 +44,18 @@ func ValidateControllerManagerConfiguration(conf *controllermanagerconfigv1alpha
 func validateControllerManagerControllerConfiguration(conf controllermanagerconfigv1alpha1.ControllerManagerControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	if conf.CertificateExpiry != nil {
+		allErrs = append(allErrs, validateCertificateExpiryControllerConfiguration(conf.CertificateExpiry, fldPath.Child("certificateExpiry"))...)
+	}
+
+	if conf.CertificateSigningRequest != nil {
+		allErrs = append(allErrs, validateCertificateSigningRequestControllerConfiguration(conf.CertificateSigningRequest, fldPath.Child("certificateSigningRequest"))...)
+	}
+
+	if conf.ShootForceUpgrade != nil {
+		allErrs = append(allErrs, validateShootForceUpgradeControllerConfiguration(conf.ShootForceUpgrade, fldPath.Child("shootForceUpgrade"))...)
+	}
+
 	projectFldPath := fldPath.Child("project")
 	if conf.Project != nil {
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
@@ -54,11 +69,84 @@ func validateControllerManagerControllerConfiguration(conf controllermanagerconf
 	return allErrs
 }
 
+func validateCertificateExpiryControllerConfiguration(conf *controllermanagerconfigv1alpha1.CertificateExpiryControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration, "must be positive"))
+	}
+	if conf.ExpiryWindow != nil && conf.ExpiryWindow.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("expiryWindow"), conf.ExpiryWindow.Duration, "must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateCertificateSigningRequestControllerConfiguration(conf *controllermanagerconfigv1alpha1.CertificateSigningRequestControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.SeedAttestation != nil && len(conf.SeedAttestation.Verifier) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("seedAttestation", "verifier"), "must provide the name of the attestation verifier"))
+	}
+
+	return allErrs
+}
+
+func validateShootForceUpgradeControllerConfiguration(conf *controllermanagerconfigv1alpha1.ShootForceUpgradeControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration, "must be positive"))
+	}
+	if conf.BatchSize != nil && *conf.BatchSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("batchSize"), *conf.BatchSize, "must be positive"))
+	}
+	if conf.NotificationLeadTime != nil && conf.NotificationLeadTime.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("notificationLeadTime"), conf.NotificationLeadTime.Duration, "must not be negative"))
+	}
+	if conf.NotificationLeadTime != nil && conf.MaxNotificationLeadTime != nil && conf.MaxNotificationLeadTime.Duration < conf.NotificationLeadTime.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxNotificationLeadTime"), conf.MaxNotificationLeadTime.Duration, "must not be less than notificationLeadTime"))
+	}
+
+	if businessHours := conf.BusinessHours; businessHours != nil {
+		businessHoursFldPath := fldPath.Child("businessHours")
+
+		begin, err := time.Parse("15:04", businessHours.Begin)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(businessHoursFldPath.Child("begin"), businessHours.Begin, "must be in the format HH:MM"))
+		}
+		end, err2 := time.Parse("15:04", businessHours.End)
+		if err2 != nil {
+			allErrs = append(allErrs, field.Invalid(businessHoursFldPath.Child("end"), businessHours.End, "must be in the format HH:MM"))
+		}
+		if err == nil && err2 == nil && !end.After(begin) {
+			allErrs = append(allErrs, field.Invalid(businessHoursFldPath.Child("end"), businessHours.End, "must be after begin"))
+		}
+
+		if businessHours.Location != nil {
+			if _, err := time.LoadLocation(*businessHours.Location); err != nil {
+				allErrs = append(allErrs, field.Invalid(businessHoursFldPath.Child("location"), *businessHours.Location, fmt.Sprintf("unknown location: %v", err)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateProjectControllerConfiguration(conf *controllermanagerconfigv1alpha1.ProjectControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, quotaConfig := range conf.Quotas {
 		allErrs = append(allErrs, validateProjectQuotaConfiguration(quotaConfig, fldPath.Child("quotas").Index(i))...)
 	}
+
+	if conf.StaleLockPeriodDays != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*conf.StaleLockPeriodDays), fldPath.Child("staleLockPeriodDays"))...)
+
+		if conf.StaleExpirationTimeDays != nil && *conf.StaleLockPeriodDays >= *conf.StaleExpirationTimeDays {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("staleLockPeriodDays"), *conf.StaleLockPeriodDays, "must be less than staleExpirationTimeDays"))
+		}
+	}
+
 	return allErrs
 }
 
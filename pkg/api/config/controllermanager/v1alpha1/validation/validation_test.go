@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -155,6 +157,162 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 				))
 			})
 		})
+
+		Context("StaleLockPeriodDays", func() {
+			BeforeEach(func() {
+				conf.Controllers.Project = &controllermanagerconfigv1alpha1.ProjectControllerConfiguration{
+					StaleExpirationTimeDays: ptr.To(90),
+					StaleLockPeriodDays:     ptr.To(7),
+				}
+			})
+
+			It("should allow valid configuration", func() {
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should forbid negative values", func() {
+				conf.Controllers.Project.StaleLockPeriodDays = ptr.To(-1)
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.staleLockPeriodDays"),
+					})),
+				))
+			})
+
+			It("should forbid values not less than the stale expiration time", func() {
+				conf.Controllers.Project.StaleLockPeriodDays = ptr.To(90)
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("controllers.project.staleLockPeriodDays"),
+						"Detail": Equal("must be less than staleExpirationTimeDays"),
+					})),
+				))
+			})
+		})
+	})
+
+	Context("CertificateExpiryControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.CertificateExpiry = &controllermanagerconfigv1alpha1.CertificateExpiryControllerConfiguration{
+				SyncPeriod:   &metav1.Duration{Duration: time.Hour},
+				ExpiryWindow: &metav1.Duration{Duration: 720 * time.Hour},
+			}
+		})
+
+		It("should allow valid configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should not allow non-positive durations", func() {
+			conf.Controllers.CertificateExpiry.SyncPeriod.Duration = 0
+			conf.Controllers.CertificateExpiry.ExpiryWindow.Duration = -time.Hour
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.certificateExpiry.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.certificateExpiry.expiryWindow"),
+				})),
+			))
+		})
+	})
+
+	Context("ShootForceUpgradeControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.ShootForceUpgrade = &controllermanagerconfigv1alpha1.ShootForceUpgradeControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+				BatchSize:               ptr.To(5),
+				NotificationLeadTime:    &metav1.Duration{Duration: 168 * time.Hour},
+				MaxNotificationLeadTime: &metav1.Duration{Duration: 720 * time.Hour},
+				BusinessHours: &controllermanagerconfigv1alpha1.BusinessHours{
+					Begin:    "08:00",
+					End:      "18:00",
+					Location: ptr.To("Europe/Berlin"),
+				},
+			}
+		})
+
+		It("should allow valid configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should not allow invalid durations and batch sizes", func() {
+			conf.Controllers.ShootForceUpgrade.SyncPeriod.Duration = 0
+			conf.Controllers.ShootForceUpgrade.BatchSize = ptr.To(0)
+			conf.Controllers.ShootForceUpgrade.MaxNotificationLeadTime.Duration = time.Hour
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootForceUpgrade.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootForceUpgrade.batchSize"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootForceUpgrade.maxNotificationLeadTime"),
+				})),
+			))
+		})
+
+		It("should not allow invalid business hours", func() {
+			conf.Controllers.ShootForceUpgrade.BusinessHours.Begin = "8am"
+			conf.Controllers.ShootForceUpgrade.BusinessHours.Location = ptr.To("Mars/Olympus")
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootForceUpgrade.businessHours.begin"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootForceUpgrade.businessHours.location"),
+				})),
+			))
+		})
+
+		It("should not allow business hours ending before they begin", func() {
+			conf.Controllers.ShootForceUpgrade.BusinessHours.End = "07:00"
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootForceUpgrade.businessHours.end"),
+				})),
+			))
+		})
+	})
+
+	Context("CertificateSigningRequestControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.CertificateSigningRequest = &controllermanagerconfigv1alpha1.CertificateSigningRequestControllerConfiguration{
+				SeedAttestation: &controllermanagerconfigv1alpha1.SeedAttestation{Verifier: "tpm"},
+			}
+		})
+
+		It("should allow valid configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should require the attestation verifier", func() {
+			conf.Controllers.CertificateSigningRequest.SeedAttestation.Verifier = ""
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.certificateSigningRequest.seedAttestation.verifier"),
+				})),
+			))
+		})
 	})
 
 	Context("ShootStateControllerConfiguration", func() {
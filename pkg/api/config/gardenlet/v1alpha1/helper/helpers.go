@@ -82,3 +82,21 @@ func GetManagedResourceProgressingThreshold(c *gardenletconfigv1alpha1.Gardenlet
 	}
 	return nil
 }
+
+// GetAllowedImageOverrides returns the names of the images which may be overridden for single shoots.
+func GetAllowedImageOverrides(c *gardenletconfigv1alpha1.GardenletConfiguration) []string {
+	if c != nil && c.Controllers != nil && c.Controllers.Shoot != nil && c.Controllers.Shoot.ImageOverrides != nil {
+		return c.Controllers.Shoot.ImageOverrides.AllowedImages
+	}
+	return nil
+}
+
+// GetAPIServerAvailabilityProbing returns the configuration of the API server availability probing if it is enabled,
+// otherwise it returns nil.
+func GetAPIServerAvailabilityProbing(c *gardenletconfigv1alpha1.GardenletConfiguration) *gardenletconfigv1alpha1.APIServerAvailabilityProbing {
+	if c != nil && c.Controllers != nil && c.Controllers.ShootCare != nil &&
+		c.Controllers.ShootCare.APIServerAvailabilityProbing != nil && c.Controllers.ShootCare.APIServerAvailabilityProbing.Enabled {
+		return c.Controllers.ShootCare.APIServerAvailabilityProbing
+	}
+	return nil
+}
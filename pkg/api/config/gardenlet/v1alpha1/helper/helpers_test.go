@@ -321,4 +321,73 @@ var _ = Describe("helper", func() {
 			Expect(GetManagedResourceProgressingThreshold(gardenletConfig)).To(Equal(threshold))
 		})
 	})
+
+	Describe("#GetAllowedImageOverrides", func() {
+		It("should return nil when the GardenletConfiguration is nil", func() {
+			Expect(GetAllowedImageOverrides(nil)).To(BeNil())
+		})
+
+		It("should return nil when the image overrides configuration is not set", func() {
+			gardenletConfig := &gardenletconfigv1alpha1.GardenletConfiguration{
+				Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{
+					Shoot: &gardenletconfigv1alpha1.ShootControllerConfiguration{},
+				},
+			}
+
+			Expect(GetAllowedImageOverrides(gardenletConfig)).To(BeNil())
+		})
+
+		It("should return the allowed images", func() {
+			gardenletConfig := &gardenletconfigv1alpha1.GardenletConfiguration{
+				Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{
+					Shoot: &gardenletconfigv1alpha1.ShootControllerConfiguration{
+						ImageOverrides: &gardenletconfigv1alpha1.ShootImageOverrides{AllowedImages: []string{"kube-apiserver"}},
+					},
+				},
+			}
+
+			Expect(GetAllowedImageOverrides(gardenletConfig)).To(ConsistOf("kube-apiserver"))
+		})
+	})
+
+	Describe("#GetAPIServerAvailabilityProbing", func() {
+		It("should return nil when the GardenletConfiguration is nil", func() {
+			Expect(GetAPIServerAvailabilityProbing(nil)).To(BeNil())
+		})
+
+		It("should return nil when the probing is not configured", func() {
+			gardenletConfig := &gardenletconfigv1alpha1.GardenletConfiguration{
+				Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{
+					ShootCare: &gardenletconfigv1alpha1.ShootCareControllerConfiguration{},
+				},
+			}
+
+			Expect(GetAPIServerAvailabilityProbing(gardenletConfig)).To(BeNil())
+		})
+
+		It("should return nil when the probing is disabled", func() {
+			gardenletConfig := &gardenletconfigv1alpha1.GardenletConfiguration{
+				Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{
+					ShootCare: &gardenletconfigv1alpha1.ShootCareControllerConfiguration{
+						APIServerAvailabilityProbing: &gardenletconfigv1alpha1.APIServerAvailabilityProbing{Enabled: false},
+					},
+				},
+			}
+
+			Expect(GetAPIServerAvailabilityProbing(gardenletConfig)).To(BeNil())
+		})
+
+		It("should return the configuration when the probing is enabled", func() {
+			probing := &gardenletconfigv1alpha1.APIServerAvailabilityProbing{Enabled: true}
+			gardenletConfig := &gardenletconfigv1alpha1.GardenletConfiguration{
+				Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{
+					ShootCare: &gardenletconfigv1alpha1.ShootCareControllerConfiguration{
+						APIServerAvailabilityProbing: probing,
+					},
+				},
+			}
+
+			Expect(GetAPIServerAvailabilityProbing(gardenletConfig)).To(BeIdenticalTo(probing))
+		})
+	})
 })
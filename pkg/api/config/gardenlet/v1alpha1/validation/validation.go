@@ -5,8 +5,11 @@
 package validation
 
 import (
+	"crypto/x509"
 	"fmt"
 	"net"
+	"net/url"
+	"regexp"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -21,6 +24,7 @@ import (
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/logger"
+	signatureutils "github.com/gardener/gardener/pkg/utils/signature"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
@@ -50,12 +54,33 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		if cfg.Controllers.Bastion != nil {
 			allErrs = append(allErrs, validateBastionControllerConfiguration(cfg.Controllers.Bastion, fldPath.Child("controllers", "bastion"))...)
 		}
+		if cfg.Controllers.Seed != nil {
+			allErrs = append(allErrs, validateSeedControllerConfiguration(cfg.Controllers.Seed, fldPath.Child("controllers", "seed"))...)
+		}
 		if cfg.Controllers.Shoot != nil {
 			allErrs = append(allErrs, validateShootControllerConfiguration(cfg.Controllers.Shoot, fldPath.Child("controllers", "shoot"))...)
 		}
 		if cfg.Controllers.ShootCare != nil {
 			allErrs = append(allErrs, validateShootCareControllerConfiguration(cfg.Controllers.ShootCare, fldPath.Child("controllers", "shootCare"))...)
 		}
+		if cfg.Controllers.ShootEventMirror != nil {
+			allErrs = append(allErrs, validateShootEventMirrorControllerConfiguration(cfg.Controllers.ShootEventMirror, fldPath.Child("controllers", "shootEventMirror"))...)
+		}
+		if cfg.Controllers.ShootLeftover != nil {
+			allErrs = append(allErrs, validateShootLeftoverControllerConfiguration(cfg.Controllers.ShootLeftover, fldPath.Child("controllers", "shootLeftover"))...)
+		}
+		if cfg.Controllers.ShootAvailabilityReport != nil {
+			allErrs = append(allErrs, validateShootAvailabilityReportControllerConfiguration(cfg.Controllers.ShootAvailabilityReport, fldPath.Child("controllers", "shootAvailabilityReport"))...)
+		}
+		if cfg.Controllers.ShootInfrastructureDrift != nil {
+			allErrs = append(allErrs, validateShootInfrastructureDriftControllerConfiguration(cfg.Controllers.ShootInfrastructureDrift, fldPath.Child("controllers", "shootInfrastructureDrift"))...)
+		}
+		if cfg.Controllers.SeedCare != nil {
+			allErrs = append(allErrs, validateSeedCareControllerConfiguration(cfg.Controllers.SeedCare, fldPath.Child("controllers", "seedCare"))...)
+		}
+		if cfg.Controllers.SeedCRD != nil {
+			allErrs = append(allErrs, validateSeedCRDControllerConfiguration(cfg.Controllers.SeedCRD, fldPath.Child("controllers", "seedCRD"))...)
+		}
 		if cfg.Controllers.ManagedSeed != nil {
 			allErrs = append(allErrs, validateManagedSeedControllerConfiguration(cfg.Controllers.ManagedSeed, fldPath.Child("controllers", "managedSeed"))...)
 		}
@@ -119,6 +144,26 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if cfg.OfflineBundle != nil {
+		allErrs = append(allErrs, validateOfflineBundle(cfg.OfflineBundle, fldPath.Child("offlineBundle"))...)
+	}
+
+	return allErrs
+}
+
+func validateOfflineBundle(cfg *gardenletconfigv1alpha1.OfflineBundle, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.Path == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("path"), "must provide the path of the bundle"))
+	}
+	if cfg.SignaturePath == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("signaturePath"), "must provide the path of the bundle signature"))
+	}
+	if cfg.PublicKeyPath == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("publicKeyPath"), "must provide the path of the public key for verifying the bundle signature"))
+	}
+
 	return allErrs
 }
 
@@ -155,6 +200,16 @@ func validateGardenClientConnection(conf *gardenletconfigv1alpha1.GardenClientCo
 		allErrs = append(allErrs, validateKubeconfigValidity(conf.KubeconfigValidity, fldPath.Child("kubeconfigValidity"))...)
 	}
 
+	if conf.BootstrapAttestation != nil && len(conf.BootstrapAttestation.Provider) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("bootstrapAttestation", "provider"), "must provide the name of the attestation provider"))
+	}
+
+	if conf.ObjectCache != nil {
+		if v := conf.ObjectCache.MaxIdleTime; v != nil && v.Duration < time.Minute {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("objectCache", "maxIdleTime"), *v, "max idle time must be at least 1m"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -226,6 +281,30 @@ func validateShootControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootCont
 		}
 	}
 
+	if cfg.ControlPlaneMigration != nil && cfg.ControlPlaneMigration.DNSCutoverTTLSeconds != nil {
+		const (
+			dnsCutoverTTLSecondsMin = 10
+			dnsCutoverTTLSecondsMax = 600
+		)
+
+		if ttl := *cfg.ControlPlaneMigration.DNSCutoverTTLSeconds; ttl < dnsCutoverTTLSecondsMin || ttl > dnsCutoverTTLSecondsMax {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("controlPlaneMigration", "dnsCutoverTTLSeconds"), ttl, fmt.Sprintf("must be within [%d,%d]", dnsCutoverTTLSecondsMin, dnsCutoverTTLSecondsMax)))
+		}
+	}
+
+	if cfg.ImageOverrides != nil {
+		allowedImages := sets.New[string]()
+		for i, name := range cfg.ImageOverrides.AllowedImages {
+			idxPath := fldPath.Child("imageOverrides", "allowedImages").Index(i)
+			if name == "" {
+				allErrs = append(allErrs, field.Required(idxPath, "image name must not be empty"))
+			} else if allowedImages.Has(name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath, name))
+			}
+			allowedImages.Insert(name)
+		}
+	}
+
 	return allErrs
 }
 
@@ -252,6 +331,241 @@ func validateShootCareControllerConfiguration(cfg *gardenletconfigv1alpha1.Shoot
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ConditionThresholds[i].Duration.Duration), fldPath.Child("conditionThresholds").Index(i).Child("duration"))...)
 	}
 
+	if cfg.Remediation != nil {
+		allErrs = append(allErrs, validateShootRemediation(cfg.Remediation, fldPath.Child("remediation"))...)
+	}
+
+	if cfg.APIServerAvailabilityProbing != nil {
+		allErrs = append(allErrs, validateAPIServerAvailabilityProbing(cfg.APIServerAvailabilityProbing, fldPath.Child("apiServerAvailabilityProbing"))...)
+	}
+
+	return allErrs
+}
+
+func validateAPIServerAvailabilityProbing(cfg *gardenletconfigv1alpha1.APIServerAvailabilityProbing, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.Timeout != nil && cfg.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), cfg.Timeout.Duration.String(), "must be positive"))
+	}
+
+	if cfg.ExternalProber != nil {
+		if proberURL, err := url.Parse(cfg.ExternalProber.URL); err != nil || (proberURL.Scheme != "https" && proberURL.Scheme != "http") || proberURL.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("externalProber", "url"), cfg.ExternalProber.URL, "must be a valid http or https URL"))
+		}
+	}
+
+	return allErrs
+}
+
+var availableShootRemediationActions = sets.New(
+	string(gardenletconfigv1alpha1.ShootRemediationActionRestartCrashLoopingPods),
+	string(gardenletconfigv1alpha1.ShootRemediationActionRetriggerFailedExtensions),
+	string(gardenletconfigv1alpha1.ShootRemediationActionKickStuckWebhooks),
+)
+
+func validateShootRemediation(cfg *gardenletconfigv1alpha1.ShootRemediation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	actions := sets.New[string]()
+
+	for i, rule := range cfg.Rules {
+		idxPath := fldPath.Child("rules").Index(i)
+
+		if !availableShootRemediationActions.Has(string(rule.Action)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("action"), rule.Action, sets.List(availableShootRemediationActions)))
+		} else if actions.Has(string(rule.Action)) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("action"), rule.Action))
+		}
+		actions.Insert(string(rule.Action))
+
+		if rule.Threshold != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(rule.Threshold.Duration), idxPath.Child("threshold"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootEventMirrorControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootEventMirrorControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+
+	names := sets.New[string]()
+	for i, rule := range cfg.Rules {
+		idxPath := fldPath.Child("rules").Index(i)
+
+		if rule.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(rule.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), rule.Name))
+		}
+		names.Insert(rule.Name)
+
+		if rule.Reason == nil && rule.Message == nil {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide a reason or a message"))
+		}
+		if rule.Reason != nil {
+			if _, err := regexp.Compile(*rule.Reason); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("reason"), *rule.Reason, fmt.Sprintf("must be a valid regular expression: %v", err)))
+			}
+		}
+		if rule.Message != nil {
+			if _, err := regexp.Compile(*rule.Message); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("message"), *rule.Message, fmt.Sprintf("must be a valid regular expression: %v", err)))
+			}
+		}
+	}
+
+	if cfg.DeduplicationWindow != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.DeduplicationWindow.Duration), fldPath.Child("deduplicationWindow"))...)
+	}
+
+	if cfg.MaxEventsPerHour != nil && *cfg.MaxEventsPerHour <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxEventsPerHour"), *cfg.MaxEventsPerHour, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateShootLeftoverControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootLeftoverControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "must be greater than 0"))
+	}
+
+	if cfg.RetentionPeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.RetentionPeriod.Duration), fldPath.Child("retentionPeriod"))...)
+	}
+
+	return allErrs
+}
+
+func validateShootAvailabilityReportControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootAvailabilityReportControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "must be greater than 0"))
+	}
+
+	if cfg.MaxReports != nil && *cfg.MaxReports <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReports"), *cfg.MaxReports, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateShootInfrastructureDriftControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootInfrastructureDriftControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateSeedCRDControllerConfiguration(cfg *gardenletconfigv1alpha1.SeedCRDControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateSeedControllerConfiguration(cfg *gardenletconfigv1alpha1.SeedControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.IstioCanaryUpgrade != nil {
+		istioCanaryUpgradePath := fldPath.Child("istioCanaryUpgrade")
+
+		if percentage := cfg.IstioCanaryUpgrade.IngressGatewayPercentage; percentage != nil && (*percentage < 1 || *percentage > 100) {
+			allErrs = append(allErrs, field.Invalid(istioCanaryUpgradePath.Child("ingressGatewayPercentage"), *percentage, "must be between 1 and 100"))
+		}
+
+		if cfg.IstioCanaryUpgrade.VerificationPeriod != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.IstioCanaryUpgrade.VerificationPeriod.Duration), istioCanaryUpgradePath.Child("verificationPeriod"))...)
+		}
+
+		if failureThreshold := cfg.IstioCanaryUpgrade.FailureThreshold; failureThreshold != nil && failureThreshold.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(istioCanaryUpgradePath.Child("failureThreshold"), failureThreshold.Duration.String(), "must be positive"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSeedCareControllerConfiguration(cfg *gardenletconfigv1alpha1.SeedCareControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.CapacityPressure != nil {
+		capacityPressurePath := fldPath.Child("capacityPressure")
+
+		if threshold := cfg.CapacityPressure.Threshold; threshold != nil && (*threshold < 1 || *threshold > 100) {
+			allErrs = append(allErrs, field.Invalid(capacityPressurePath.Child("threshold"), *threshold, "must be between 1 and 100"))
+		}
+
+		if cfg.CapacityPressure.PredictionHorizon != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.CapacityPressure.PredictionHorizon.Duration), capacityPressurePath.Child("predictionHorizon"))...)
+		}
+	}
+
+	if cfg.ImageVerification != nil {
+		allErrs = append(allErrs, validateSeedImageVerification(cfg.ImageVerification, fldPath.Child("imageVerification"))...)
+	}
+
+	return allErrs
+}
+
+func validateSeedImageVerification(cfg *gardenletconfigv1alpha1.SeedImageVerification, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.Enabled && len(cfg.PublicKeys) == 0 && len(cfg.FulcioRoots) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one public key or Fulcio root certificate is required"))
+	}
+
+	for i, publicKey := range cfg.PublicKeys {
+		if _, err := signatureutils.ParsePublicKey([]byte(publicKey)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("publicKeys").Index(i), publicKey, err.Error()))
+		}
+	}
+
+	for i, root := range cfg.FulcioRoots {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(root)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("fulcioRoots").Index(i), root, "must be a PEM-encoded certificate"))
+		}
+	}
+
+	if len(cfg.FulcioRoots) > 0 {
+		if len(cfg.KeylessIdentities) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("keylessIdentities"), "at least one identity is required if Fulcio root certificates are configured"))
+		}
+		if len(cfg.RekorPublicKeys) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("rekorPublicKeys"), "at least one Rekor public key is required if Fulcio root certificates are configured"))
+		}
+	}
+
+	for i, identity := range cfg.KeylessIdentities {
+		identityPath := fldPath.Child("keylessIdentities").Index(i)
+		if identity.Issuer == "" {
+			allErrs = append(allErrs, field.Required(identityPath.Child("issuer"), "issuer must be set"))
+		}
+		if identity.Subject == "" {
+			allErrs = append(allErrs, field.Required(identityPath.Child("subject"), "subject must be set"))
+		}
+	}
+
+	for i, publicKey := range cfg.RekorPublicKeys {
+		if _, err := signatureutils.ParsePublicKey([]byte(publicKey)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rekorPublicKeys").Index(i), publicKey, err.Error()))
+		}
+	}
+
 	return allErrs
 }
 
@@ -320,6 +634,10 @@ func validateBastionControllerConfiguration(cfg *gardenletconfigv1alpha1.Bastion
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
 	}
 
+	if cfg.DefaultIdleTimeout != nil && cfg.DefaultIdleTimeout.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("defaultIdleTimeout"), cfg.DefaultIdleTimeout.Duration.String(), "idle timeout must be at least 1m"))
+	}
+
 	return allErrs
 }
 
@@ -253,6 +253,54 @@ var _ = Describe("GardenletConfiguration", func() {
 						}))))
 					})
 				})
+
+				Context("bootstrap attestation", func() {
+					It("should allow valid configurations", func() {
+						cfg.GardenClientConnection = &gardenletconfigv1alpha1.GardenClientConnection{
+							BootstrapAttestation: &gardenletconfigv1alpha1.BootstrapAttestation{Provider: "tpm"},
+						}
+
+						Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+					})
+
+					It("should require the attestation provider", func() {
+						cfg.GardenClientConnection = &gardenletconfigv1alpha1.GardenClientConnection{
+							BootstrapAttestation: &gardenletconfigv1alpha1.BootstrapAttestation{},
+						}
+
+						Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("gardenClientConnection.bootstrapAttestation.provider"),
+						}))))
+					})
+				})
+
+				Context("object cache", func() {
+					It("should allow valid configurations", func() {
+						cfg.GardenClientConnection = &gardenletconfigv1alpha1.GardenClientConnection{
+							ObjectCache: &gardenletconfigv1alpha1.GardenObjectCache{
+								MaxIdleTime:  &metav1.Duration{Duration: 30 * time.Minute},
+								CacheSecrets: ptr.To(true),
+							},
+						}
+
+						Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+					})
+
+					It("should forbid max idle time less than 1m", func() {
+						cfg.GardenClientConnection = &gardenletconfigv1alpha1.GardenClientConnection{
+							ObjectCache: &gardenletconfigv1alpha1.GardenObjectCache{
+								MaxIdleTime: &metav1.Duration{Duration: 30 * time.Second},
+							},
+						}
+
+						Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("gardenClientConnection.objectCache.maxIdleTime"),
+							"Detail": ContainSubstring("must be at least 1m"),
+						}))))
+					})
+				})
 			})
 
 			Context("seed client connection", func() {
@@ -359,6 +407,223 @@ var _ = Describe("GardenletConfiguration", func() {
 					"Field": Equal("controllers.shoot.dnsEntryTTLSeconds"),
 				}))))
 			})
+
+			It("should allow valid values for the DNS cutover TTL", func() {
+				cfg.Controllers.Shoot.ControlPlaneMigration = &gardenletconfigv1alpha1.ShootControlPlaneMigration{DNSCutoverTTLSeconds: ptr.To[int64](30)}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid invalid values for the DNS cutover TTL", func() {
+				cfg.Controllers.Shoot.ControlPlaneMigration = &gardenletconfigv1alpha1.ShootControlPlaneMigration{DNSCutoverTTLSeconds: ptr.To[int64](5)}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shoot.controlPlaneMigration.dnsCutoverTTLSeconds"),
+				}))))
+			})
+
+			It("should allow valid image overrides configuration", func() {
+				cfg.Controllers.Shoot.ImageOverrides = &gardenletconfigv1alpha1.ShootImageOverrides{AllowedImages: []string{"kube-apiserver", "etcd"}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate image names in the image overrides configuration", func() {
+				cfg.Controllers.Shoot.ImageOverrides = &gardenletconfigv1alpha1.ShootImageOverrides{AllowedImages: []string{"kube-apiserver", "", "kube-apiserver"}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shoot.imageOverrides.allowedImages[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.shoot.imageOverrides.allowedImages[2]"),
+					})),
+				))
+			})
+		})
+
+		Context("seed controller", func() {
+			It("should allow valid istio canary upgrade configuration", func() {
+				cfg.Controllers.Seed = &gardenletconfigv1alpha1.SeedControllerConfiguration{
+					IstioCanaryUpgrade: &gardenletconfigv1alpha1.IstioCanaryUpgrade{
+						Enabled:                  true,
+						IngressGatewayPercentage: ptr.To[int32](25),
+						VerificationPeriod:       &metav1.Duration{Duration: 30 * time.Minute},
+						FailureThreshold:         &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid invalid istio canary upgrade configuration", func() {
+				cfg.Controllers.Seed = &gardenletconfigv1alpha1.SeedControllerConfiguration{
+					IstioCanaryUpgrade: &gardenletconfigv1alpha1.IstioCanaryUpgrade{
+						Enabled:                  true,
+						IngressGatewayPercentage: ptr.To[int32](0),
+						VerificationPeriod:       &metav1.Duration{Duration: -1},
+						FailureThreshold:         &metav1.Duration{},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seed.istioCanaryUpgrade.ingressGatewayPercentage"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seed.istioCanaryUpgrade.verificationPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seed.istioCanaryUpgrade.failureThreshold"),
+					})),
+				))
+			})
+		})
+
+		Context("seedCare controller", func() {
+			It("should allow valid capacity pressure configuration", func() {
+				cfg.Controllers.SeedCare = &gardenletconfigv1alpha1.SeedCareControllerConfiguration{
+					CapacityPressure: &gardenletconfigv1alpha1.SeedCapacityPressure{
+						Enabled:           true,
+						Threshold:         ptr.To[int32](90),
+						PredictionHorizon: &metav1.Duration{Duration: time.Hour},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid invalid capacity pressure configuration", func() {
+				cfg.Controllers.SeedCare = &gardenletconfigv1alpha1.SeedCareControllerConfiguration{
+					CapacityPressure: &gardenletconfigv1alpha1.SeedCapacityPressure{
+						Enabled:           true,
+						Threshold:         ptr.To[int32](101),
+						PredictionHorizon: &metav1.Duration{Duration: -1},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedCare.capacityPressure.threshold"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedCare.capacityPressure.predictionHorizon"),
+					})),
+				))
+			})
+		})
+
+		Context("seedCare controller image verification", func() {
+			It("should allow valid image verification configuration", func() {
+				cfg.Controllers.SeedCare = &gardenletconfigv1alpha1.SeedCareControllerConfiguration{
+					ImageVerification: &gardenletconfigv1alpha1.SeedImageVerification{
+						Enabled:    true,
+						PublicKeys: []string{testPublicKey},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should allow disabled image verification without keys", func() {
+				cfg.Controllers.SeedCare = &gardenletconfigv1alpha1.SeedCareControllerConfiguration{
+					ImageVerification: &gardenletconfigv1alpha1.SeedImageVerification{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid enabled image verification without keys", func() {
+				cfg.Controllers.SeedCare = &gardenletconfigv1alpha1.SeedCareControllerConfiguration{
+					ImageVerification: &gardenletconfigv1alpha1.SeedImageVerification{Enabled: true},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedCare.imageVerification"),
+					})),
+				))
+			})
+
+			It("should forbid invalid public keys and Fulcio roots", func() {
+				cfg.Controllers.SeedCare = &gardenletconfigv1alpha1.SeedCareControllerConfiguration{
+					ImageVerification: &gardenletconfigv1alpha1.SeedImageVerification{
+						Enabled:           true,
+						PublicKeys:        []string{"foo"},
+						FulcioRoots:       []string{"bar"},
+						KeylessIdentities: []gardenletconfigv1alpha1.KeylessIdentity{{Issuer: "https://issuer.example.com", Subject: "signer@example.com"}},
+						RekorPublicKeys:   []string{testPublicKey},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedCare.imageVerification.publicKeys[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedCare.imageVerification.fulcioRoots[0]"),
+					})),
+				))
+			})
+			It("should require identities and Rekor public keys for Fulcio roots", func() {
+				cfg.Controllers.SeedCare = &gardenletconfigv1alpha1.SeedCareControllerConfiguration{
+					ImageVerification: &gardenletconfigv1alpha1.SeedImageVerification{
+						Enabled:     true,
+						PublicKeys:  []string{testPublicKey},
+						FulcioRoots: []string{"bar"},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ContainElements(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedCare.imageVerification.keylessIdentities"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedCare.imageVerification.rekorPublicKeys"),
+					})),
+				))
+			})
+
+			It("should forbid incomplete identities and invalid Rekor public keys", func() {
+				cfg.Controllers.SeedCare = &gardenletconfigv1alpha1.SeedCareControllerConfiguration{
+					ImageVerification: &gardenletconfigv1alpha1.SeedImageVerification{
+						Enabled:           true,
+						PublicKeys:        []string{testPublicKey},
+						KeylessIdentities: []gardenletconfigv1alpha1.KeylessIdentity{{}},
+						RekorPublicKeys:   []string{"foo"},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedCare.imageVerification.keylessIdentities[0].issuer"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.seedCare.imageVerification.keylessIdentities[0].subject"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedCare.imageVerification.rekorPublicKeys[0]"),
+					})),
+				))
+			})
 		})
 
 		Context("shootCare controller", func() {
@@ -397,6 +662,71 @@ var _ = Describe("GardenletConfiguration", func() {
 				))
 			})
 
+			It("should allow valid remediation rules", func() {
+				cfg.Controllers.ShootCare.Remediation = &gardenletconfigv1alpha1.ShootRemediation{Rules: []gardenletconfigv1alpha1.ShootRemediationRule{
+					{Action: "RestartCrashLoopingPods", Threshold: &metav1.Duration{Duration: time.Minute}},
+					{Action: "RetriggerFailedExtensions"},
+					{Action: "KickStuckWebhooks"},
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid invalid remediation rules", func() {
+				cfg.Controllers.ShootCare.Remediation = &gardenletconfigv1alpha1.ShootRemediation{Rules: []gardenletconfigv1alpha1.ShootRemediationRule{
+					{Action: "DeleteAllPods"},
+					{Action: "RestartCrashLoopingPods", Threshold: &metav1.Duration{Duration: -1}},
+					{Action: "RestartCrashLoopingPods"},
+				}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.shootCare.remediation.rules[0].action"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.remediation.rules[1].threshold"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.shootCare.remediation.rules[2].action"),
+					})),
+				))
+			})
+
+			It("should allow valid API server availability probing configuration", func() {
+				cfg.Controllers.ShootCare.APIServerAvailabilityProbing = &gardenletconfigv1alpha1.APIServerAvailabilityProbing{
+					Enabled: true,
+					Timeout: &metav1.Duration{Duration: 5 * time.Second},
+					ExternalProber: &gardenletconfigv1alpha1.ExternalAPIServerProber{
+						URL:    "https://prober.example.com/probe",
+						Module: ptr.To("http_2xx"),
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid invalid API server availability probing configuration", func() {
+				cfg.Controllers.ShootCare.APIServerAvailabilityProbing = &gardenletconfigv1alpha1.APIServerAvailabilityProbing{
+					Enabled:        true,
+					Timeout:        &metav1.Duration{},
+					ExternalProber: &gardenletconfigv1alpha1.ExternalAPIServerProber{URL: "ftp://prober.example.com"},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.apiServerAvailabilityProbing.timeout"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.apiServerAvailabilityProbing.externalProber.url"),
+					})),
+				))
+			})
+
 			It("should not panic when staleExtensionHealthChecks is set but threshold is nil", func() {
 				cfg.Controllers.ShootCare.StaleExtensionHealthChecks = &gardenletconfigv1alpha1.StaleExtensionHealthChecks{Enabled: true}
 
@@ -488,6 +818,193 @@ var _ = Describe("GardenletConfiguration", func() {
 					})),
 				))
 			})
+
+			It("should forbid too short default idle timeouts", func() {
+				cfg.Controllers.Bastion.DefaultIdleTimeout = &metav1.Duration{Duration: 30 * time.Second}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.bastion.defaultIdleTimeout"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot event mirror controller", func() {
+			BeforeEach(func() {
+				cfg.Controllers.ShootEventMirror = &gardenletconfigv1alpha1.ShootEventMirrorControllerConfiguration{
+					ConcurrentSyncs:     ptr.To(5),
+					Rules:               []gardenletconfigv1alpha1.ShootEventMirrorRule{{Name: "foo", Reason: ptr.To("^Foo$")}},
+					DeduplicationWindow: &metav1.Duration{Duration: time.Hour},
+					MaxEventsPerHour:    ptr.To[int32](30),
+				}
+			})
+
+			It("should allow valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootEventMirror.ConcurrentSyncs = ptr.To(-1)
+				cfg.Controllers.ShootEventMirror.DeduplicationWindow = &metav1.Duration{Duration: -time.Minute}
+				cfg.Controllers.ShootEventMirror.MaxEventsPerHour = ptr.To[int32](0)
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootEventMirror.concurrentSyncs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootEventMirror.deduplicationWindow"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootEventMirror.maxEventsPerHour"),
+					})),
+				))
+			})
+
+			It("should forbid invalid rules", func() {
+				cfg.Controllers.ShootEventMirror.Rules = append(cfg.Controllers.ShootEventMirror.Rules,
+					gardenletconfigv1alpha1.ShootEventMirrorRule{Name: "foo", Message: ptr.To("bar")},
+					gardenletconfigv1alpha1.ShootEventMirrorRule{Reason: ptr.To("(")},
+					gardenletconfigv1alpha1.ShootEventMirrorRule{Name: "baz", Message: ptr.To("[")},
+					gardenletconfigv1alpha1.ShootEventMirrorRule{Name: "qux"},
+				)
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.shootEventMirror.rules[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootEventMirror.rules[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootEventMirror.rules[2].reason"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootEventMirror.rules[3].message"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootEventMirror.rules[4]"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot leftover controller", func() {
+			BeforeEach(func() {
+				cfg.Controllers.ShootLeftover = &gardenletconfigv1alpha1.ShootLeftoverControllerConfiguration{
+					SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+					RetentionPeriod: &metav1.Duration{Duration: 720 * time.Hour},
+					Cleanup:         ptr.To(false),
+				}
+			})
+
+			It("should allow valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootLeftover.SyncPeriod = &metav1.Duration{}
+				cfg.Controllers.ShootLeftover.RetentionPeriod = &metav1.Duration{Duration: -time.Hour}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootLeftover.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootLeftover.retentionPeriod"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot availability report controller", func() {
+			BeforeEach(func() {
+				cfg.Controllers.ShootAvailabilityReport = &gardenletconfigv1alpha1.ShootAvailabilityReportControllerConfiguration{
+					SyncPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+					MaxReports: ptr.To[int32](12),
+				}
+			})
+
+			It("should allow valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootAvailabilityReport.SyncPeriod = &metav1.Duration{}
+				cfg.Controllers.ShootAvailabilityReport.MaxReports = ptr.To[int32](0)
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootAvailabilityReport.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootAvailabilityReport.maxReports"),
+					})),
+				))
+			})
+		})
+
+		Context("shoot infrastructure drift controller", func() {
+			BeforeEach(func() {
+				cfg.Controllers.ShootInfrastructureDrift = &gardenletconfigv1alpha1.ShootInfrastructureDriftControllerConfiguration{
+					SyncPeriod:           &metav1.Duration{Duration: time.Hour},
+					ReconcileOnlyOnDrift: ptr.To(true),
+				}
+			})
+
+			It("should allow valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid a non-positive sync period", func() {
+				cfg.Controllers.ShootInfrastructureDrift.SyncPeriod = &metav1.Duration{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootInfrastructureDrift.syncPeriod"),
+					})),
+				))
+			})
+		})
+
+		Context("seed CRD controller", func() {
+			BeforeEach(func() {
+				cfg.Controllers.SeedCRD = &gardenletconfigv1alpha1.SeedCRDControllerConfiguration{
+					SyncPeriod: &metav1.Duration{Duration: time.Hour},
+				}
+			})
+
+			It("should allow valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid a non-positive sync period", func() {
+				cfg.Controllers.SeedCRD.SyncPeriod = &metav1.Duration{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedCRD.syncPeriod"),
+					})),
+				))
+			})
 		})
 
 		Context("network policy controller", func() {
@@ -804,6 +1321,37 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("offlineBundle", func() {
+			It("should pass with valid offline bundle options", func() {
+				cfg.OfflineBundle = &gardenletconfigv1alpha1.OfflineBundle{
+					Path:          "/bundle/bundle.tar.gz",
+					SignaturePath: "/bundle/bundle.tar.gz.sig",
+					PublicKeyPath: "/bundle-key/key.pub",
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with missing offline bundle paths", func() {
+				cfg.OfflineBundle = &gardenletconfigv1alpha1.OfflineBundle{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("offlineBundle.path"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("offlineBundle.signaturePath"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("offlineBundle.publicKeyPath"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
@@ -829,3 +1377,7 @@ var _ = Describe("GardenletConfiguration", func() {
 		})
 	})
 })
+
+const testPublicKey = `-----BEGIN PUBLIC KEY-----
+MCowBQYDK2VwAyEAGb9ECWmEzf6FQbrBZ9w7lshQhqowtrbLDFw4rXAxZuE=
+-----END PUBLIC KEY-----`
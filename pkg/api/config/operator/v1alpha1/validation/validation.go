@@ -46,6 +46,7 @@ func validateControllerConfiguration(conf operatorconfigv1alpha1.ControllerConfi
 
 	allErrs = append(allErrs, validateGardenControllerConfiguration(conf.Garden, fldPath.Child("garden"))...)
 	allErrs = append(allErrs, validateGardenCareControllerConfiguration(conf.GardenCare, fldPath.Child("gardenCare"))...)
+	allErrs = append(allErrs, validateGardenETCDCapacityControllerConfiguration(conf.GardenETCDCapacity, fldPath.Child("gardenETCDCapacity"))...)
 	allErrs = append(allErrs, validateGardenletDeployerControllerConfig(conf.GardenletDeployer, fldPath.Child("gardenletDeployer"))...)
 	allErrs = append(allErrs, validateNetworkPolicyControllerConfiguration(conf.NetworkPolicy, fldPath.Child("networkPolicy"))...)
 
@@ -69,6 +70,18 @@ func validateGardenCareControllerConfiguration(conf operatorconfigv1alpha1.Garde
 	return allErrs
 }
 
+func validateGardenETCDCapacityControllerConfiguration(conf operatorconfigv1alpha1.GardenETCDCapacityControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+
+	if threshold := ptr.Deref(conf.QuotaUtilizationThresholdPercentage, 0); threshold <= 0 || threshold > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("quotaUtilizationThresholdPercentage"), conf.QuotaUtilizationThresholdPercentage, "must be between 1 and 100"))
+	}
+
+	return allErrs
+}
+
 func validateGardenletDeployerControllerConfig(conf operatorconfigv1alpha1.GardenletDeployerControllerConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -43,6 +43,10 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 				GardenCare: operatorconfigv1alpha1.GardenCareControllerConfiguration{
 					SyncPeriod: &metav1.Duration{Duration: time.Minute},
 				},
+				GardenETCDCapacity: operatorconfigv1alpha1.GardenETCDCapacityControllerConfiguration{
+					SyncPeriod:                          &metav1.Duration{Duration: 5 * time.Minute},
+					QuotaUtilizationThresholdPercentage: ptr.To[int32](80),
+				},
 				GardenletDeployer: operatorconfigv1alpha1.GardenletDeployerControllerConfig{
 					ConcurrentSyncs: ptr.To(5),
 				},
@@ -219,6 +223,41 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			})
 		})
 
+		Context("GardenETCDCapacity", func() {
+			It("should return errors because sync period is < 15s", func() {
+				conf.Controllers.GardenETCDCapacity.SyncPeriod = &metav1.Duration{Duration: time.Second}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.gardenETCDCapacity.syncPeriod"),
+					})),
+				))
+			})
+
+			DescribeTable("quota utilization threshold",
+				func(threshold *int32, matcher gomegatypes.GomegaMatcher) {
+					conf.Controllers.GardenETCDCapacity.QuotaUtilizationThresholdPercentage = threshold
+
+					Expect(ValidateOperatorConfiguration(conf)).To(matcher)
+				},
+
+				Entry("nil", nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.gardenETCDCapacity.quotaUtilizationThresholdPercentage"),
+				})))),
+				Entry("zero", ptr.To[int32](0), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.gardenETCDCapacity.quotaUtilizationThresholdPercentage"),
+				})))),
+				Entry("above 100", ptr.To[int32](101), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.gardenETCDCapacity.quotaUtilizationThresholdPercentage"),
+				})))),
+				Entry("100", ptr.To[int32](100), BeEmpty()),
+			)
+		})
+
 		Context("network policy", func() {
 			It("should return errors because concurrent syncs are <= 0", func() {
 				conf.Controllers.NetworkPolicy.ConcurrentSyncs = ptr.To(0)
@@ -5,16 +5,20 @@
 package validation
 
 import (
+	"net/url"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
@@ -92,6 +96,9 @@ func validateResourceManagerControllerConfiguration(conf resourcemanagerconfigv1
 		if conf.CSRApprover.MachineNamespace != nil && *conf.CSRApprover.MachineNamespace == "" {
 			allErrs = append(allErrs, field.Required(fldPathCSRApprover.Child("machineNamespace"), "machine namespace must be nil or not empty"))
 		}
+		if conf.CSRApprover.NodeAddressVerification != nil {
+			allErrs = append(allErrs, validateNodeAddressVerification(*conf.CSRApprover.NodeAddressVerification, conf.CSRApprover.MachineNamespace, fldPathCSRApprover.Child("nodeAddressVerification"))...)
+		}
 	}
 
 	if conf.GarbageCollector.Enabled {
@@ -100,6 +107,7 @@ func validateResourceManagerControllerConfiguration(conf resourcemanagerconfigv1
 
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.Health.ConcurrentSyncs, fldPath.Child("health"))...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.Health.SyncPeriod, fldPath.Child("health"))...)
+	allErrs = append(allErrs, validateCustomHealthChecks(conf.Health.CustomHealthChecks, fldPath.Child("health", "customHealthChecks"))...)
 
 	allErrs = append(allErrs, validateManagedResourceControllerConfiguration(conf.ManagedResource, fldPath.Child("managedResources"))...)
 
@@ -114,6 +122,45 @@ func validateResourceManagerControllerConfiguration(conf resourcemanagerconfigv1
 	return allErrs
 }
 
+var availableNodeAddressVerificationBackends = sets.New(
+	resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendNode,
+	resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendMachine,
+	resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendWebhook,
+)
+
+func validateNodeAddressVerification(conf resourcemanagerconfigv1alpha1.NodeAddressVerification, machineNamespace *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableNodeAddressVerificationBackends.Has(conf.Backend) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("backend"), conf.Backend, sets.List(availableNodeAddressVerificationBackends)))
+	}
+
+	if conf.Backend == resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendMachine && machineNamespace == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("backend"), "machine namespace must be configured for the Machine backend"))
+	}
+
+	if conf.Backend != resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendWebhook {
+		if conf.Webhook != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("webhook"), "webhook must only be configured for the Webhook backend"))
+		}
+		return allErrs
+	}
+
+	if conf.Webhook == nil {
+		return append(allErrs, field.Required(fldPath.Child("webhook"), "webhook must be configured for the Webhook backend"))
+	}
+
+	if webhookURL, err := url.Parse(conf.Webhook.URL); err != nil || webhookURL.Scheme != "https" || webhookURL.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("webhook", "url"), conf.Webhook.URL, "must be a valid https URL"))
+	}
+
+	if conf.Webhook.Timeout != nil && conf.Webhook.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("webhook", "timeout"), conf.Webhook.Timeout.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
 func validateManagedResourceControllerConfiguration(conf resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -163,6 +210,20 @@ func validatePodSchedulerNameWebhookConfiguration(conf resourcemanagerconfigv1al
 		allErrs = append(allErrs, field.Required(fldPath.Child("schedulerName"), "must specify schedulerName when webhook is enabled"))
 	}
 
+	for i, rule := range conf.Rules {
+		idxPath := fldPath.Child("rules").Index(i)
+
+		if len(rule.SchedulerName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("schedulerName"), "must specify schedulerName"))
+		}
+		if rule.NamespaceSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(rule.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("namespaceSelector"))...)
+		}
+		if rule.PodSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(rule.PodSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("podSelector"))...)
+		}
+	}
+
 	return allErrs
 }
 
@@ -215,6 +276,35 @@ func validateSyncPeriod(val *metav1.Duration, fldPath *field.Path) field.ErrorLi
 	return allErrs
 }
 
+func validateCustomHealthChecks(checks []resourcemanagerconfigv1alpha1.CustomHealthCheck, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs    = field.ErrorList{}
+		groupKinds = sets.New[schema.GroupKind]()
+	)
+
+	for i, check := range checks {
+		idxPath := fldPath.Index(i)
+
+		if check.Kind == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("kind"), "must provide a kind"))
+		}
+
+		groupKind := schema.GroupKind{Group: check.Group, Kind: check.Kind}
+		if groupKinds.Has(groupKind) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, groupKind.String()))
+		}
+		groupKinds.Insert(groupKind)
+
+		if check.Expression == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("expression"), "must provide an expression"))
+		} else if _, err := health.NewCELHealthCheck(check.Expression, check.Message); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("expression"), check.Expression, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 func validateSystemComponentsConfigWebhookConfig(conf *resourcemanagerconfigv1alpha1.SystemComponentsConfigWebhookConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
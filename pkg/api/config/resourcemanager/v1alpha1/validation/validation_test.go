@@ -260,6 +260,93 @@ var _ = Describe("Validation", func() {
 
 					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
 				})
+
+				Context("node address verification", func() {
+					BeforeEach(func() {
+						conf.Controllers.CSRApprover.Enabled = true
+						conf.Controllers.CSRApprover.ConcurrentSyncs = ptr.To(1)
+					})
+
+					It("should succeed for valid configurations", func() {
+						conf.Controllers.CSRApprover.NodeAddressVerification = &resourcemanagerconfigv1alpha1.NodeAddressVerification{
+							Backend: resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendWebhook,
+							Webhook: &resourcemanagerconfigv1alpha1.NodeAddressVerificationWebhook{
+								URL:     "https://verifier.example.com/verify",
+								Timeout: &metav1.Duration{Duration: 5 * time.Second},
+							},
+						}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+					})
+
+					It("should return errors for unsupported backends", func() {
+						conf.Controllers.CSRApprover.NodeAddressVerification = &resourcemanagerconfigv1alpha1.NodeAddressVerification{Backend: "foo"}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeNotSupported),
+								"Field": Equal("controllers.csrApprover.nodeAddressVerification.backend"),
+							})),
+						))
+					})
+
+					It("should return errors when the Machine backend is used without machine namespace", func() {
+						conf.Controllers.CSRApprover.NodeAddressVerification = &resourcemanagerconfigv1alpha1.NodeAddressVerification{Backend: resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendMachine}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeRequired),
+								"Field": Equal("controllers.csrApprover.nodeAddressVerification.backend"),
+							})),
+						))
+					})
+
+					It("should return errors when the webhook is configured for other backends", func() {
+						conf.Controllers.CSRApprover.NodeAddressVerification = &resourcemanagerconfigv1alpha1.NodeAddressVerification{
+							Backend: resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendNode,
+							Webhook: &resourcemanagerconfigv1alpha1.NodeAddressVerificationWebhook{URL: "https://verifier.example.com"},
+						}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("controllers.csrApprover.nodeAddressVerification.webhook"),
+							})),
+						))
+					})
+
+					It("should return errors when the webhook is missing for the Webhook backend", func() {
+						conf.Controllers.CSRApprover.NodeAddressVerification = &resourcemanagerconfigv1alpha1.NodeAddressVerification{Backend: resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendWebhook}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeRequired),
+								"Field": Equal("controllers.csrApprover.nodeAddressVerification.webhook"),
+							})),
+						))
+					})
+
+					It("should return errors for an invalid webhook configuration", func() {
+						conf.Controllers.CSRApprover.NodeAddressVerification = &resourcemanagerconfigv1alpha1.NodeAddressVerification{
+							Backend: resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendWebhook,
+							Webhook: &resourcemanagerconfigv1alpha1.NodeAddressVerificationWebhook{
+								URL:     "http://verifier.example.com",
+								Timeout: &metav1.Duration{},
+							},
+						}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("controllers.csrApprover.nodeAddressVerification.webhook.url"),
+							})),
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("controllers.csrApprover.nodeAddressVerification.webhook.timeout"),
+							})),
+						))
+					})
+				})
 			})
 
 			Context("garbage collector", func() {
@@ -323,6 +410,43 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				It("should allow valid custom health checks", func() {
+					conf.Controllers.Health.CustomHealthChecks = []resourcemanagerconfigv1alpha1.CustomHealthCheck{
+						{Group: "dns.gardener.cloud", Kind: "DNSEntry", Expression: `object.status.state == "Ready"`},
+						{Group: "cert.gardener.cloud", Kind: "Certificate", Expression: `object.status.state == "Ready"`, Message: "certificate is not ready"},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return errors because custom health checks are invalid", func() {
+					conf.Controllers.Health.CustomHealthChecks = []resourcemanagerconfigv1alpha1.CustomHealthCheck{
+						{Group: "dns.gardener.cloud", Kind: "DNSEntry", Expression: `object.status.state == "Ready"`},
+						{Group: "dns.gardener.cloud", Kind: "DNSEntry", Expression: `object.status.state == "Ready"`},
+						{Group: "foo", Expression: `'foo'`},
+						{Group: "bar", Kind: "Bar"},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("controllers.health.customHealthChecks[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.customHealthChecks[2].kind"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.health.customHealthChecks[2].expression"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.customHealthChecks[3].expression"),
+						})),
+					))
+				})
 			})
 
 			Context("managed resources", func() {
@@ -447,6 +571,42 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				It("should allow valid rules", func() {
+					conf.Webhooks.PodSchedulerName.Enabled = true
+					conf.Webhooks.PodSchedulerName.SchedulerName = ptr.To("default-scheduler")
+					conf.Webhooks.PodSchedulerName.Rules = []resourcemanagerconfigv1alpha1.PodSchedulerNameRule{{
+						SchedulerName:     "bin-packing-scheduler",
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"gardener.cloud/role": "shoot"}},
+						PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"gardener.cloud/role": "controlplane"}},
+					}}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return errors for invalid rules", func() {
+					conf.Webhooks.PodSchedulerName.Enabled = true
+					conf.Webhooks.PodSchedulerName.SchedulerName = ptr.To("default-scheduler")
+					conf.Webhooks.PodSchedulerName.Rules = []resourcemanagerconfigv1alpha1.PodSchedulerNameRule{{
+						NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "Bar"}}},
+						PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "b a r"}},
+					}}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("webhooks.podSchedulerName.rules[0].schedulerName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("webhooks.podSchedulerName.rules[0].namespaceSelector.matchExpressions[0].operator"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("webhooks.podSchedulerName.rules[0].podSelector.matchLabels"),
+						})),
+					))
+				})
 			})
 
 			Context("projected token mount", func() {
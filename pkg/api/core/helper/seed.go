@@ -7,6 +7,7 @@ package helper
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -65,6 +66,17 @@ func TaintsAreTolerated(taints []core.SeedTaint, tolerations []core.Toleration)
 	return true
 }
 
+// NonExpiredTolerations returns the given tolerations without the ones which are expired at the given time.
+func NonExpiredTolerations(tolerations []core.Toleration, now time.Time) []core.Toleration {
+	var out []core.Toleration
+	for _, toleration := range tolerations {
+		if toleration.ExpirationTimestamp == nil || now.Before(toleration.ExpirationTimestamp.Time) {
+			out = append(out, toleration)
+		}
+	}
+	return out
+}
+
 // SeedSettingSchedulingVisible returns true if the 'scheduling' setting is set to 'visible'.
 func SeedSettingSchedulingVisible(settings *core.SeedSettings) bool {
 	return settings == nil || settings.Scheduling == nil || settings.Scheduling.Visible
@@ -75,6 +87,11 @@ func SeedSettingTopologyAwareRoutingEnabled(settings *core.SeedSettings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
 
+// SeedSettingDrainEnabled returns true if the seed is being drained.
+func SeedSettingDrainEnabled(settings *core.SeedSettings) bool {
+	return settings != nil && settings.Drain != nil && settings.Drain.Enabled
+}
+
 // CalculateSeedUsage returns a map representing the number of shoots per seed from the given list of shoots.
 // It takes both spec.seedName and status.seedName into account.
 func CalculateSeedUsage(shootList []*core.Shoot) map[string]int {
@@ -6,6 +6,7 @@ package helper_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -130,6 +131,24 @@ var _ = Describe("Helper", func() {
 		),
 	)
 
+	Describe("#NonExpiredTolerations", func() {
+		var (
+			now         = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+			noExpiry    = core.Toleration{Key: "foo"}
+			notExpired  = core.Toleration{Key: "bar", ExpirationTimestamp: &metav1.Time{Time: now.Add(time.Minute)}}
+			expired     = core.Toleration{Key: "baz", ExpirationTimestamp: &metav1.Time{Time: now.Add(-time.Minute)}}
+			expiringNow = core.Toleration{Key: "qux", ExpirationTimestamp: &metav1.Time{Time: now}}
+		)
+
+		It("should return nil if there are no tolerations", func() {
+			Expect(NonExpiredTolerations(nil, now)).To(BeNil())
+		})
+
+		It("should drop expired tolerations", func() {
+			Expect(NonExpiredTolerations([]core.Toleration{noExpiry, notExpired, expired, expiringNow}, now)).To(HaveExactElements(noExpiry, notExpired))
+		})
+	})
+
 	DescribeTable("#SeedSettingSchedulingVisible",
 		func(settings *core.SeedSettings, expectation bool) {
 			Expect(SeedSettingSchedulingVisible(settings)).To(Equal(expectation))
@@ -152,6 +171,17 @@ var _ = Describe("Helper", func() {
 		Entry("topology-aware routing disabled", &core.SeedSettings{TopologyAwareRouting: &core.SeedSettingTopologyAwareRouting{Enabled: false}}, false),
 	)
 
+	DescribeTable("#SeedSettingDrainEnabled",
+		func(settings *core.SeedSettings, expected bool) {
+			Expect(SeedSettingDrainEnabled(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, false),
+		Entry("no drain setting", &core.SeedSettings{}, false),
+		Entry("drain enabled", &core.SeedSettings{Drain: &core.SeedSettingDrain{Enabled: true}}, true),
+		Entry("drain disabled", &core.SeedSettings{Drain: &core.SeedSettingDrain{Enabled: false}}, false),
+	)
+
 	Describe("#CalculateSeedUsage", func() {
 		type shootCase struct {
 			specSeedName, statusSeedName string
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/api/core/helper"
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+// deprecation is an entry of the deprecation registry. Users creating or updating a Shoot which matches the entry get
+// its message returned as warning.
+type deprecation struct {
+	// Matches returns whether the Shoot uses the deprecated or discouraged configuration. The oldShoot is nil when the
+	// Shoot is created.
+	Matches func(shoot, oldShoot *core.Shoot) bool
+	// Message is the warning returned to the user. It should explain what to use instead.
+	Message string
+}
+
+// deprecations is the central registry of deprecated and discouraged Shoot configurations. When deprecating a field,
+// add an entry here (see docs/development/changing-the-api.md) and remove it together with the field.
+var deprecations = []deprecation{
+	// TODO(plkokanov): Remove this after support for Kubernetes v1.32 is dropped.
+	// We do not check for the Kubernetes version here because the shoot validation code is called before this
+	// and forbids setting .spec.kubernetes.kubeControllerManager.podEvictionTimeout for kubernetes >= v1.33.
+	{
+		Matches: func(shoot, _ *core.Shoot) bool {
+			return shoot.Spec.Kubernetes.KubeControllerManager != nil && shoot.Spec.Kubernetes.KubeControllerManager.PodEvictionTimeout != nil
+		},
+		Message: "you are setting the spec.kubernetes.kubeControllerManager.podEvictionTimeout field. The field does not have effect since Kubernetes 1.13 and is forbidden to be set starting from Kubernetes 1.33. Instead, use the spec.kubernetes.kubeAPIServer.(defaultNotReadyTolerationSeconds/defaultUnreachableTolerationSeconds) fields.",
+	},
+	// TODO(AleksandarSavchev): Remove this after support for Kubernetes v1.33 is dropped.
+	// We do not check for the Kubernetes version here because the shoot validation code is called before this
+	// and forbids setting the etcd encryption key rotation start and complete annotations for kubernetes >= v1.34.
+	operationAnnotationDeprecation(v1beta1constants.OperationRotateETCDEncryptionKeyStart),
+	operationAnnotationDeprecation(v1beta1constants.OperationRotateETCDEncryptionKeyComplete),
+	{
+		Matches: func(shoot, _ *core.Shoot) bool {
+			lessThan133, _ := versionutils.CompareVersions(shoot.Spec.Kubernetes.Version, "<", "1.33")
+			return lessThan133 && shoot.Spec.Kubernetes.ClusterAutoscaler != nil && shoot.Spec.Kubernetes.ClusterAutoscaler.MaxEmptyBulkDelete != nil
+		},
+		Message: "you are setting the spec.kubernetes.clusterAutoscaler.maxEmptyBulkDelete field. The field has been deprecated and is forbidden to be set starting from Kubernetes 1.33. The value is not used and will be set to nil. Instead, use the spec.kubernetes.clusterAutoscaler.maxScaleDownParallelism field.",
+	},
+	{
+		Matches: func(shoot, _ *core.Shoot) bool {
+			return versionutils.ConstraintK8sGreaterEqual133.CheckVersion(shoot.Spec.Kubernetes.Version) && ptr.Deref(shoot.Spec.CloudProfileName, "") != ""
+		},
+		Message: "you are setting the spec.cloudProfileName field. The field is deprecated and will be forcefully set empty starting with Kubernetes 1.34. Use the new spec.cloudProfile.name field instead.",
+	},
+	{
+		Matches: func(shoot, _ *core.Shoot) bool {
+			return shoot.Spec.Addons != nil
+		},
+		Message: "you are setting the spec.addons field. The field is deprecated and will be forbidden starting with Kubernetes 1.35. Use the spec.managedAddons field instead.",
+	},
+	{
+		Matches: func(shoot, _ *core.Shoot) bool {
+			return versionutils.ConstraintK8sGreaterEqual135.CheckVersion(shoot.Spec.Kubernetes.Version) && helper.IsKubeProxyIPVSMode(shoot.Spec.Kubernetes.KubeProxy)
+		},
+		Message: "you are using IPVS mode for kube-proxy. The IPVS mode is deprecated starting with Kubernetes 1.35. Please switch to iptables or nftables mode.",
+	},
+	{
+		Matches: func(shoot, oldShoot *core.Shoot) bool {
+			return !versionutils.ConstraintK8sGreaterEqual135.CheckVersion(shoot.Spec.Kubernetes.Version) && oldShoot != nil && helper.IsKubeProxyIPVSMode(shoot.Spec.Kubernetes.KubeProxy) && !helper.IsKubeProxyIPVSMode(oldShoot.Spec.Kubernetes.KubeProxy)
+		},
+		Message: "you have switched to IPVS mode for kube-proxy. The IPVS mode is deprecated starting with Kubernetes 1.35. Please switch to iptables or nftables mode.",
+	},
+	{
+		Matches: func(shoot, _ *core.Shoot) bool {
+			return shoot.Spec.Kubernetes.KubeScheduler != nil && shoot.Spec.Kubernetes.KubeScheduler.KubeMaxPDVols != nil
+		},
+		Message: "you are setting the spec.kubernetes.kubeScheduler.kubeMaxPDVols field. The field has been deprecated and is forbidden to be set starting from Kubernetes 1.35. The kubeMaxPDVols configuration is no longer necessary as values are set by the respective CSI driver.",
+	},
+	{
+		Matches: func(shoot, _ *core.Shoot) bool {
+			return shoot.Spec.SecretBindingName != nil
+		},
+		Message: "spec.secretBindingName is deprecated and will be disallowed starting with Kubernetes 1.34. For migration instructions, see: https://github.com/gardener/gardener/blob/master/docs/usage/shoot-operations/secretbinding-to-credentialsbinding-migration.md",
+	},
+
+	// Discouraged configurations
+	{
+		Matches: func(shoot, _ *core.Shoot) bool {
+			return ptr.Deref(shoot.Spec.Purpose, "") == core.ShootPurposeProduction && !helper.IsWorkerless(shoot) && !helper.IsHAControlPlaneConfigured(shoot)
+		},
+		Message: "you are using a Shoot with purpose production without a highly available control plane. It is recommended to configure spec.controlPlane.highAvailability for production clusters, see https://github.com/gardener/gardener/blob/master/docs/usage/high-availability/shoot_high_availability.md",
+	},
+}
+
+func operationAnnotationDeprecation(operation string) deprecation {
+	return deprecation{
+		Matches: func(shoot, _ *core.Shoot) bool {
+			return shoot.Annotations[v1beta1constants.GardenerOperation] == operation
+		},
+		Message: fmt.Sprintf("you are setting the operation annotation to %s. This annotation has been deprecated and is forbidden to be set starting from Kubernetes 1.34. Instead, use the %s annotation, which performs a full rotation of the ETCD encryption key.", operation, v1beta1constants.OperationRotateETCDEncryptionKey),
+	}
+}
+
+// getDeprecationWarnings returns the messages of all entries of the deprecation registry matching the given Shoot.
+func getDeprecationWarnings(shoot, oldShoot *core.Shoot) []string {
+	var warnings []string
+	for _, d := range deprecations {
+		if d.Matches(shoot, oldShoot) {
+			warnings = append(warnings, d.Message)
+		}
+	}
+	return warnings
+}
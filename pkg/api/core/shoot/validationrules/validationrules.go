@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validationrules
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// VariableShoot is the name of the variable containing the Shoot in validation rules.
+	VariableShoot = "shoot"
+	// VariableOldShoot is the name of the variable containing the Shoot before the update in validation rules. It is null
+	// when the Shoot is created.
+	VariableOldShoot = "oldShoot"
+
+	// costLimit is the maximum runtime cost of evaluating a single validation rule. It prevents rules from blocking the
+	// admission of Shoots, e.g., by iterating excessively over large lists.
+	costLimit = 1000000
+)
+
+var newEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable(VariableShoot, cel.DynType),
+		cel.Variable(VariableOldShoot, cel.DynType),
+		cel.OptionalTypes(),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Sets(),
+	)
+})
+
+// Compile compiles the given validation rule expression. It returns an error if the expression is invalid or does not
+// evaluate to a boolean.
+func Compile(expression string) (cel.Program, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to bool but evaluates to %s", outputType)
+	}
+
+	return env.Program(ast, cel.CostLimit(costLimit))
+}
+
+// Evaluate evaluates the given compiled validation rule for the given Shoot. The oldShoot is nil when the Shoot is
+// created.
+func Evaluate(program cel.Program, shoot, oldShoot *core.Shoot) (bool, error) {
+	shootObj, err := toUnstructured(shoot)
+	if err != nil {
+		return false, err
+	}
+
+	var oldShootObj any
+	if oldShoot != nil {
+		if oldShootObj, err = toUnstructured(oldShoot); err != nil {
+			return false, err
+		}
+	}
+
+	out, _, err := program.Eval(map[string]any{
+		VariableShoot:    shootObj,
+		VariableOldShoot: oldShootObj,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must evaluate to bool but evaluated to %s", out.Type().TypeName())
+	}
+
+	return result, nil
+}
+
+// toUnstructured converts the given Shoot to the representation of version core.gardener.cloud/v1beta1 so that
+// validation rules are written against the public API.
+func toUnstructured(shoot *core.Shoot) (map[string]any, error) {
+	v1beta1Shoot := &gardencorev1beta1.Shoot{}
+	if err := api.Scheme.Convert(shoot, v1beta1Shoot, nil); err != nil {
+		return nil, fmt.Errorf("failed converting Shoot to version %s: %w", gardencorev1beta1.SchemeGroupVersion, err)
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(v1beta1Shoot)
+}
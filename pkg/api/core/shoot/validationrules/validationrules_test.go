@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validationrules_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/api/core/shoot/validationrules"
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var _ = Describe("ValidationRules", func() {
+	var shoot *core.Shoot
+
+	BeforeEach(func() {
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+			Spec: core.ShootSpec{
+				Region: "eu-1",
+				Provider: core.Provider{
+					Type: "local",
+					Workers: []core.Worker{
+						{Name: "worker1", Machine: core.Machine{Type: "large"}},
+						{Name: "worker2", Machine: core.Machine{Type: "small"}},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#Compile", func() {
+		It("should compile a valid expression", func() {
+			Expect(Compile(`shoot.spec.region.startsWith("eu-")`)).NotTo(BeNil())
+		})
+
+		It("should fail for an invalid expression", func() {
+			_, err := Compile(`shoot.spec.region ==`)
+			Expect(err).To(MatchError(ContainSubstring("Syntax error")))
+		})
+
+		It("should fail for an undeclared variable", func() {
+			_, err := Compile(`cloudProfile.spec.type == "local"`)
+			Expect(err).To(MatchError(ContainSubstring("undeclared reference to 'cloudProfile'")))
+		})
+
+		It("should fail for an expression which does not evaluate to bool", func() {
+			_, err := Compile(`size(shoot.spec.provider.workers)`)
+			Expect(err).To(MatchError(ContainSubstring("expression must evaluate to bool but evaluates to int")))
+		})
+	})
+
+	Describe("#Evaluate", func() {
+		evaluate := func(expression string, shoot, oldShoot *core.Shoot) (bool, error) {
+			GinkgoHelper()
+			program, err := Compile(expression)
+			Expect(err).NotTo(HaveOccurred())
+			return Evaluate(program, shoot, oldShoot)
+		}
+
+		It("should evaluate the expression against the v1beta1 representation of the Shoot", func() {
+			expression := `shoot.spec.region != "eu-1" || shoot.spec.provider.workers.all(w, w.machine.type != "large")`
+			Expect(evaluate(expression, shoot, nil)).To(BeFalse())
+
+			shoot.Spec.Region = "us-1"
+			Expect(evaluate(expression, shoot, nil)).To(BeTrue())
+		})
+
+		It("should expose the old Shoot on updates", func() {
+			expression := `oldShoot == null || oldShoot.spec.region == shoot.spec.region`
+			Expect(evaluate(expression, shoot, nil)).To(BeTrue())
+
+			oldShoot := shoot.DeepCopy()
+			Expect(evaluate(expression, shoot, oldShoot)).To(BeTrue())
+
+			shoot.Spec.Region = "us-1"
+			Expect(evaluate(expression, shoot, oldShoot)).To(BeFalse())
+		})
+
+		It("should fail if the expression does not evaluate to bool", func() {
+			_, err := evaluate(`shoot.metadata.?labels.orValue({}).size() > 0 ? true : shoot.spec.region`, shoot, nil)
+			Expect(err).To(MatchError(ContainSubstring("expression must evaluate to bool")))
+		})
+
+		It("should fail if a referenced field does not exist", func() {
+			_, err := evaluate(`shoot.spec.dns.domain.endsWith(".example.com")`, shoot, nil)
+			Expect(err).To(MatchError(ContainSubstring("no such key: dns")))
+		})
+
+		It("should fail if the cost limit is exceeded", func() {
+			for range 100 {
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, core.Worker{Name: "worker", Machine: core.Machine{Type: "large"}})
+			}
+
+			_, err := evaluate(`shoot.spec.provider.workers.all(a, shoot.spec.provider.workers.all(b, shoot.spec.provider.workers.all(c, a.name.size() + b.name.size() + c.name.size() > 0)))`, shoot, nil)
+			Expect(err).To(MatchError(ContainSubstring("cost limit exceeded")))
+		})
+	})
+})
@@ -295,6 +295,12 @@ var _ = Describe("Warnings", func() {
 				Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(ContainElement(ContainSubstring("you are setting the spec.cloudProfileName field. The field is deprecated")))
 			})
 
+			It("should return a warning when cloudProfileName is set and the Kubernetes version is a v1.33 pre-release", func() {
+				shoot.Spec.Kubernetes.Version = "1.33.0-alpha.1"
+				shoot.Spec.CloudProfileName = ptr.To("local-profile")
+				Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(ContainElement(ContainSubstring("you are setting the spec.cloudProfileName field. The field is deprecated")))
+			})
+
 			It("should not return a warning when cloudProfileName is empty and the Kubernetes version is >= v1.33", func() {
 				shoot.Spec.Kubernetes.Version = "1.33.1"
 				Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(BeEmpty())
@@ -344,6 +350,31 @@ var _ = Describe("Warnings", func() {
 			})
 		})
 
+		Describe("spec.controlPlane.highAvailability", func() {
+			BeforeEach(func() {
+				shoot.Spec.Purpose = ptr.To(core.ShootPurposeProduction)
+			})
+
+			It("should return a warning when a production shoot does not have a highly available control plane", func() {
+				Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(ContainElement(ContainSubstring("you are using a Shoot with purpose production without a highly available control plane")))
+			})
+
+			It("should not return a warning when a production shoot has a highly available control plane", func() {
+				shoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}}}
+				Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(BeEmpty())
+			})
+
+			It("should not return a warning for workerless shoots", func() {
+				shoot.Spec.Provider.Workers = nil
+				Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(BeEmpty())
+			})
+
+			It("should not return a warning for shoots with another purpose", func() {
+				shoot.Spec.Purpose = ptr.To(core.ShootPurposeEvaluation)
+				Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(BeEmpty())
+			})
+		})
+
 		Describe("spec.kubernetes.kubeScheduler.kubeMaxPDVols", func() {
 			It("should print a warning when kubeMaxPDVols is set", func() {
 				shoot.Spec.Kubernetes.KubeScheduler = &core.KubeSchedulerConfig{
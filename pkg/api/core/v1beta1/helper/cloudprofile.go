@@ -87,6 +87,28 @@ func VersionIsPreview(version gardencorev1beta1.ExpirableVersion) bool {
 	return CurrentLifecycleClassification(version) == gardencorev1beta1.ClassificationPreview
 }
 
+// VersionExpirationTime returns the time at which the given version expires, or nil if it does not expire.
+func VersionExpirationTime(version gardencorev1beta1.ExpirableVersion) *time.Time {
+	if len(version.Lifecycle) == 0 {
+		// Deprecated: legacy expiration field is used. Remove once the legacy fields are removed.
+		if version.ExpirationDate == nil {
+			return nil
+		}
+		return &version.ExpirationDate.Time
+	}
+
+	for _, stage := range version.Lifecycle {
+		if stage.Classification == gardencorev1beta1.ClassificationExpired {
+			if stage.StartTime == nil {
+				return &time.Time{}
+			}
+			return &stage.StartTime.Time
+		}
+	}
+
+	return nil
+}
+
 // DetermineMachineImageForName finds the cloud specific machine images in the <cloudProfile> for the given <name> and
 // region. In case it does not find the machine image with the <name>, it returns false. Otherwise, true and the
 // cloud-specific machine image will be returned.
@@ -160,6 +160,23 @@ var _ = Describe("CloudProfile Helper", func() {
 
 	})
 
+	DescribeTable("#VersionExpirationTime",
+		func(version gardencorev1beta1.ExpirableVersion, expected *time.Time) {
+			Expect(VersionExpirationTime(version)).To(Equal(expected))
+		},
+
+		Entry("no expiration", gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}, nil),
+		Entry("legacy expiration date", gardencorev1beta1.ExpirableVersion{Version: "1.0.0", ExpirationDate: &metav1.Time{Time: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)}}, ptr.To(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC))),
+		Entry("lifecycle without expired stage", gardencorev1beta1.ExpirableVersion{Version: "1.0.0", Lifecycle: []gardencorev1beta1.LifecycleStage{
+			{Classification: gardencorev1beta1.ClassificationSupported},
+			{Classification: gardencorev1beta1.ClassificationDeprecated, StartTime: &metav1.Time{Time: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}},
+		}}, nil),
+		Entry("lifecycle with expired stage", gardencorev1beta1.ExpirableVersion{Version: "1.0.0", Lifecycle: []gardencorev1beta1.LifecycleStage{
+			{Classification: gardencorev1beta1.ClassificationSupported},
+			{Classification: gardencorev1beta1.ClassificationExpired, StartTime: &metav1.Time{Time: time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)}},
+		}}, ptr.To(time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC))),
+	)
+
 	Describe("#FindMachineImageVersion", func() {
 		var machineImages []gardencorev1beta1.MachineImage
 
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"slices"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// WellKnownErrorCodeDefinitions contains the definitions of the error codes which are well-known to Gardener.
+var WellKnownErrorCodeDefinitions = []gardencorev1beta1.ErrorCodeDefinition{
+	{
+		Code:         gardencorev1beta1.ErrorInfraUnauthenticated,
+		Description:  "The client request was not completed because it lacks valid authentication credentials for the requested resource.",
+		UserError:    true,
+		NonRetryable: true,
+	},
+	{
+		Code:         gardencorev1beta1.ErrorInfraUnauthorized,
+		Description:  "The server understood the request but refused to authorize it.",
+		UserError:    true,
+		NonRetryable: true,
+	},
+	{
+		Code:         gardencorev1beta1.ErrorInfraQuotaExceeded,
+		Description:  "Infrastructure quota limits are exceeded.",
+		UserError:    true,
+		NonRetryable: true,
+	},
+	{
+		Code:         gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+		Description:  "Infrastructure request rate limits are exceeded.",
+		NonRetryable: true,
+	},
+	{
+		Code:         gardencorev1beta1.ErrorInfraDependencies,
+		Description:  "Dependent objects on the infrastructure level prevent the operation.",
+		UserError:    true,
+		NonRetryable: true,
+	},
+	{
+		Code:        gardencorev1beta1.ErrorRetryableInfraDependencies,
+		Description: "Dependent objects on the infrastructure level prevent the operation, but the operation should be retried.",
+	},
+	{
+		Code:        gardencorev1beta1.ErrorInfraResourcesDepleted,
+		Description: "Resources in the infrastructure are depleted.",
+		UserError:   true,
+	},
+	{
+		Code:         gardencorev1beta1.ErrorInfraImageNotFound,
+		Description:  "A machine image could not be found in the infrastructure.",
+		UserError:    true,
+		NonRetryable: true,
+	},
+	{
+		Code:        gardencorev1beta1.ErrorInfraIPAddressesExhausted,
+		Description: "The IP addresses in the infrastructure networks are exhausted.",
+		UserError:   true,
+	},
+	{
+		Code:        gardencorev1beta1.ErrorCleanupClusterResources,
+		Description: "Resources in the cluster are stuck in deletion.",
+		UserError:   true,
+	},
+	{
+		Code:         gardencorev1beta1.ErrorConfigurationProblem,
+		Description:  "The configuration is invalid.",
+		UserError:    true,
+		NonRetryable: true,
+	},
+	{
+		Code:        gardencorev1beta1.ErrorRetryableConfigurationProblem,
+		Description: "The configuration is invalid, but the problem is likely to be resolved after some time.",
+		UserError:   true,
+	},
+	{
+		Code:         gardencorev1beta1.ErrorProblematicWebhook,
+		Description:  "A webhook does not follow the Kubernetes best practices.",
+		UserError:    true,
+		NonRetryable: true,
+	},
+}
+
+// ErrorCodeDefinitionsFromControllerRegistrations returns the error code definitions contributed by the given
+// ControllerRegistrations. The definitions are ordered by the names of the ControllerRegistrations, so that the
+// definition of the extension whose ControllerRegistration name sorts first takes precedence if multiple extensions
+// define the same error code.
+func ErrorCodeDefinitionsFromControllerRegistrations(controllerRegistrations []gardencorev1beta1.ControllerRegistration) []gardencorev1beta1.ErrorCodeDefinition {
+	sorted := slices.Clone(controllerRegistrations)
+	slices.SortFunc(sorted, func(a, b gardencorev1beta1.ControllerRegistration) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var definitions []gardencorev1beta1.ErrorCodeDefinition
+	for _, controllerRegistration := range sorted {
+		definitions = append(definitions, controllerRegistration.Spec.ErrorCodes...)
+	}
+	return definitions
+}
+
+// ErrorCodeDefinitionFor returns the definition of the given error code and whether it is known. Besides the
+// well-known error codes, the given error code definitions contributed by extensions are considered (see
+// ErrorCodeDefinitionsFromControllerRegistrations). Well-known error codes cannot be redefined by extensions. If
+// multiple definitions exist for the same error code, the first one is returned.
+func ErrorCodeDefinitionFor(code gardencorev1beta1.ErrorCode, extensionDefinitions []gardencorev1beta1.ErrorCodeDefinition) (gardencorev1beta1.ErrorCodeDefinition, bool) {
+	for _, definitions := range [][]gardencorev1beta1.ErrorCodeDefinition{WellKnownErrorCodeDefinitions, extensionDefinitions} {
+		if i := slices.IndexFunc(definitions, func(definition gardencorev1beta1.ErrorCodeDefinition) bool {
+			return definition.Code == code
+		}); i >= 0 {
+			return definitions[i], true
+		}
+	}
+
+	return gardencorev1beta1.ErrorCodeDefinition{}, false
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Helper", func() {
+	var (
+		fooDefinition = gardencorev1beta1.ErrorCodeDefinition{Code: "ERR_FOO", Description: "foo", NonRetryable: true}
+		barDefinition = gardencorev1beta1.ErrorCodeDefinition{Code: "ERR_FOO", Description: "bar"}
+		bazDefinition = gardencorev1beta1.ErrorCodeDefinition{Code: "ERR_BAZ", Description: "baz"}
+	)
+
+	Describe("#ErrorCodeDefinitionsFromControllerRegistrations", func() {
+		It("should return the definitions ordered by the names of the ControllerRegistrations", func() {
+			Expect(ErrorCodeDefinitionsFromControllerRegistrations([]gardencorev1beta1.ControllerRegistration{
+				{ObjectMeta: metav1.ObjectMeta{Name: "provider-b"}, Spec: gardencorev1beta1.ControllerRegistrationSpec{ErrorCodes: []gardencorev1beta1.ErrorCodeDefinition{barDefinition, bazDefinition}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "provider-c"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "provider-a"}, Spec: gardencorev1beta1.ControllerRegistrationSpec{ErrorCodes: []gardencorev1beta1.ErrorCodeDefinition{fooDefinition}}},
+			})).To(Equal([]gardencorev1beta1.ErrorCodeDefinition{fooDefinition, barDefinition, bazDefinition}))
+		})
+
+		It("should return nil if no ControllerRegistration contributes error codes", func() {
+			Expect(ErrorCodeDefinitionsFromControllerRegistrations([]gardencorev1beta1.ControllerRegistration{{ObjectMeta: metav1.ObjectMeta{Name: "provider-a"}}})).To(BeNil())
+		})
+	})
+
+	Describe("#ErrorCodeDefinitionFor", func() {
+		It("should return the definition of well-known error codes", func() {
+			definition, ok := ErrorCodeDefinitionFor(gardencorev1beta1.ErrorInfraQuotaExceeded, nil)
+			Expect(ok).To(BeTrue())
+			Expect(definition.UserError).To(BeTrue())
+			Expect(definition.NonRetryable).To(BeTrue())
+		})
+
+		It("should not know undefined error codes", func() {
+			_, ok := ErrorCodeDefinitionFor("ERR_FOO", []gardencorev1beta1.ErrorCodeDefinition{bazDefinition})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should return the first definition of error codes contributed by extensions", func() {
+			definition, ok := ErrorCodeDefinitionFor("ERR_FOO", []gardencorev1beta1.ErrorCodeDefinition{bazDefinition, fooDefinition, barDefinition})
+			Expect(ok).To(BeTrue())
+			Expect(definition).To(Equal(fooDefinition))
+		})
+
+		It("should not allow redefining well-known error codes", func() {
+			definition, ok := ErrorCodeDefinitionFor(gardencorev1beta1.ErrorInfraQuotaExceeded, []gardencorev1beta1.ErrorCodeDefinition{{Code: gardencorev1beta1.ErrorInfraQuotaExceeded, Description: "foo"}})
+			Expect(ok).To(BeTrue())
+			Expect(definition.NonRetryable).To(BeTrue())
+		})
+
+		It("should consider the given error codes for the retry classification", func() {
+			lastError := gardencorev1beta1.LastError{Codes: []gardencorev1beta1.ErrorCode{"ERR_FOO"}}
+			Expect(HasNonRetryableErrorCode(nil, lastError)).To(BeFalse())
+			Expect(HasNonRetryableErrorCode([]gardencorev1beta1.ErrorCodeDefinition{fooDefinition}, lastError)).To(BeTrue())
+		})
+	})
+})
@@ -178,18 +178,14 @@ func LastErrorWithTaskID(description string, taskID string, codes ...gardencorev
 }
 
 // HasNonRetryableErrorCode returns true if at least one of given list of last errors has at least one error code that
-// indicates that an automatic retry would not help fixing the problem.
-func HasNonRetryableErrorCode(lastErrors ...gardencorev1beta1.LastError) bool {
+// indicates that an automatic retry would not help fixing the problem. Besides the well-known error codes, the given
+// error code definitions contributed by extensions are considered, see ErrorCodeDefinitionFor.
+func HasNonRetryableErrorCode(extensionDefinitions []gardencorev1beta1.ErrorCodeDefinition, lastErrors ...gardencorev1beta1.LastError) bool {
 	return slices.ContainsFunc(lastErrors, func(lastError gardencorev1beta1.LastError) bool {
-		return sets.New(
-			gardencorev1beta1.ErrorInfraUnauthenticated,
-			gardencorev1beta1.ErrorInfraUnauthorized,
-			gardencorev1beta1.ErrorInfraDependencies,
-			gardencorev1beta1.ErrorInfraQuotaExceeded,
-			gardencorev1beta1.ErrorInfraRateLimitsExceeded,
-			gardencorev1beta1.ErrorConfigurationProblem,
-			gardencorev1beta1.ErrorProblematicWebhook,
-		).HasAny(lastError.Codes...)
+		return slices.ContainsFunc(lastError.Codes, func(code gardencorev1beta1.ErrorCode) bool {
+			definition, ok := ErrorCodeDefinitionFor(code, extensionDefinitions)
+			return ok && definition.NonRetryable
+		})
 	})
 }
 
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// IsMaintenanceFreezeActive returns true if the given time is within one of the windows of the given MaintenanceFreeze.
+// The beginning of a window is inclusive while its end is exclusive.
+func IsMaintenanceFreezeActive(freeze *gardencorev1beta1.MaintenanceFreeze, now time.Time) bool {
+	for _, window := range freeze.Spec.Windows {
+		if !now.Before(window.Begin.Time) && now.Before(window.End.Time) {
+			return true
+		}
+	}
+	return false
+}
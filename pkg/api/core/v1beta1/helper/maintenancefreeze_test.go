@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("MaintenanceFreeze", func() {
+	var now = time.Date(2026, 12, 24, 12, 0, 0, 0, time.UTC)
+
+	DescribeTable("#IsMaintenanceFreezeActive",
+		func(windows []gardencorev1beta1.MaintenanceFreezeWindow, expected bool) {
+			freeze := &gardencorev1beta1.MaintenanceFreeze{Spec: gardencorev1beta1.MaintenanceFreezeSpec{Windows: windows}}
+			Expect(IsMaintenanceFreezeActive(freeze, now)).To(Equal(expected))
+		},
+
+		Entry("no windows", nil, false),
+		Entry("window in the past", []gardencorev1beta1.MaintenanceFreezeWindow{
+			{Begin: metav1.NewTime(now.Add(-2 * time.Hour)), End: metav1.NewTime(now.Add(-time.Hour))},
+		}, false),
+		Entry("window in the future", []gardencorev1beta1.MaintenanceFreezeWindow{
+			{Begin: metav1.NewTime(now.Add(time.Hour)), End: metav1.NewTime(now.Add(2 * time.Hour))},
+		}, false),
+		Entry("window ends now", []gardencorev1beta1.MaintenanceFreezeWindow{
+			{Begin: metav1.NewTime(now.Add(-time.Hour)), End: metav1.NewTime(now)},
+		}, false),
+		Entry("window begins now", []gardencorev1beta1.MaintenanceFreezeWindow{
+			{Begin: metav1.NewTime(now), End: metav1.NewTime(now.Add(time.Hour))},
+		}, true),
+		Entry("one of multiple windows is active", []gardencorev1beta1.MaintenanceFreezeWindow{
+			{Begin: metav1.NewTime(now.Add(-2 * time.Hour)), End: metav1.NewTime(now.Add(-time.Hour))},
+			{Begin: metav1.NewTime(now.Add(-time.Minute)), End: metav1.NewTime(now.Add(time.Hour))},
+		}, true),
+	)
+})
@@ -5,6 +5,8 @@
 package helper
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/utils/ptr"
@@ -54,6 +56,17 @@ func TaintsAreTolerated(taints []gardencorev1beta1.SeedTaint, tolerations []gard
 	return true
 }
 
+// NonExpiredTolerations returns the given tolerations without the ones which are expired at the given time.
+func NonExpiredTolerations(tolerations []gardencorev1beta1.Toleration, now time.Time) []gardencorev1beta1.Toleration {
+	var out []gardencorev1beta1.Toleration
+	for _, toleration := range tolerations {
+		if toleration.ExpirationTimestamp == nil || now.Before(toleration.ExpirationTimestamp.Time) {
+			out = append(out, toleration)
+		}
+	}
+	return out
+}
+
 // SeedSettingExcessCapacityReservationEnabled returns true if the 'excess capacity reservation' setting is enabled.
 func SeedSettingExcessCapacityReservationEnabled(settings *gardencorev1beta1.SeedSettings) bool {
 	return settings == nil || settings.ExcessCapacityReservation == nil || ptr.Deref(settings.ExcessCapacityReservation.Enabled, true)
@@ -96,6 +109,52 @@ func SeedSettingZonalIngressEnabled(settings *gardencorev1beta1.SeedSettings) bo
 	return ptr.Deref(settings.LoadBalancerServices.ZonalIngress.Enabled, true)
 }
 
+// SeedSettingIstioTLSTerminationEnabled returns true if the Istio TLS termination is enabled for the seed.
+func SeedSettingIstioTLSTerminationEnabled(settings *gardencorev1beta1.SeedSettings) bool {
+	return settings != nil && settings.IstioTLSTermination != nil && settings.IstioTLSTermination.Enabled
+}
+
+// SeedSettingSchedulingBinPackingEnabled returns true if the seed uses the bin-packing scheduling profile for shoot
+// control plane pods.
+func SeedSettingSchedulingBinPackingEnabled(settings *gardencorev1beta1.SeedSettings) bool {
+	return settings != nil && settings.Scheduling != nil && ptr.Deref(settings.Scheduling.Profile, gardencorev1beta1.SchedulingProfileBalanced) == gardencorev1beta1.SchedulingProfileBinPacking
+}
+
+// SeedSettingDrainEnabled returns true if the seed is being drained.
+func SeedSettingDrainEnabled(settings *gardencorev1beta1.SeedSettings) bool {
+	return settings != nil && settings.Drain != nil && settings.Drain.Enabled
+}
+
+// SeedSettingControlPlaneResourceQuotaEnabled returns true if the ResourceQuotas and LimitRanges in the control plane
+// namespaces of shoots are enabled for the seed.
+func SeedSettingControlPlaneResourceQuotaEnabled(settings *gardencorev1beta1.SeedSettings) bool {
+	return settings != nil && settings.ControlPlaneResourceQuota != nil && settings.ControlPlaneResourceQuota.Enabled
+}
+
+// ControlPlaneSizeClassForShoot returns the control plane size class for the given shoot, i.e., the first size class
+// whose maximum number of nodes is greater than or equal to the sum of the maximum numbers of nodes of the shoot's worker
+// pools. If no size class matches, the last one is returned. It returns nil if the control plane resource quota is not
+// enabled or no size classes are configured.
+func ControlPlaneSizeClassForShoot(settings *gardencorev1beta1.SeedSettings, shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.ControlPlaneSizeClass {
+	if !SeedSettingControlPlaneResourceQuotaEnabled(settings) || len(settings.ControlPlaneResourceQuota.SizeClasses) == 0 {
+		return nil
+	}
+
+	var maxNodes int64
+	for _, worker := range shoot.Spec.Provider.Workers {
+		maxNodes += int64(worker.Maximum)
+	}
+
+	sizeClasses := settings.ControlPlaneResourceQuota.SizeClasses
+	for i, sizeClass := range sizeClasses {
+		if sizeClass.MaxNodes == nil || int64(*sizeClass.MaxNodes) >= maxNodes {
+			return &sizeClasses[i]
+		}
+	}
+
+	return &sizeClasses[len(sizeClasses)-1]
+}
+
 // SeedSettingZoneSelectionMode returns the zone selection mode, or empty string if not configured.
 func SeedSettingZoneSelectionMode(settings *gardencorev1beta1.SeedSettings) gardencorev1beta1.ZoneSelectionMode {
 	if settings == nil || settings.ZoneSelection == nil {
@@ -6,12 +6,14 @@ package helper_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
@@ -130,6 +132,24 @@ var _ = Describe("Helper", func() {
 		),
 	)
 
+	Describe("#NonExpiredTolerations", func() {
+		var (
+			now         = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+			noExpiry    = gardencorev1beta1.Toleration{Key: "foo"}
+			notExpired  = gardencorev1beta1.Toleration{Key: "bar", ExpirationTimestamp: &metav1.Time{Time: now.Add(time.Minute)}}
+			expired     = gardencorev1beta1.Toleration{Key: "baz", ExpirationTimestamp: &metav1.Time{Time: now.Add(-time.Minute)}}
+			expiringNow = gardencorev1beta1.Toleration{Key: "qux", ExpirationTimestamp: &metav1.Time{Time: now}}
+		)
+
+		It("should return nil if there are no tolerations", func() {
+			Expect(NonExpiredTolerations(nil, now)).To(BeNil())
+		})
+
+		It("should drop expired tolerations", func() {
+			Expect(NonExpiredTolerations([]gardencorev1beta1.Toleration{noExpiry, notExpired, expired, expiringNow}, now)).To(HaveExactElements(noExpiry, notExpired))
+		})
+	})
+
 	DescribeTable("#SeedSettingExcessCapacityReservationEnabled",
 		func(settings *gardencorev1beta1.SeedSettings, expected bool) {
 			Expect(SeedSettingExcessCapacityReservationEnabled(settings)).To(Equal(expected))
@@ -201,6 +221,101 @@ var _ = Describe("Helper", func() {
 		Entry("topology-aware routing disabled", &gardencorev1beta1.SeedSettings{TopologyAwareRouting: &gardencorev1beta1.SeedSettingTopologyAwareRouting{Enabled: false}}, false),
 	)
 
+	DescribeTable("#SeedSettingSchedulingBinPackingEnabled",
+		func(settings *gardencorev1beta1.SeedSettings, expected bool) {
+			Expect(SeedSettingSchedulingBinPackingEnabled(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, false),
+		Entry("no scheduling setting", &gardencorev1beta1.SeedSettings{}, false),
+		Entry("no profile", &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true}}, false),
+		Entry("balanced profile", &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Profile: ptr.To(gardencorev1beta1.SchedulingProfileBalanced)}}, false),
+		Entry("bin-packing profile", &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Profile: ptr.To(gardencorev1beta1.SchedulingProfileBinPacking)}}, true),
+	)
+
+	DescribeTable("#SeedSettingDrainEnabled",
+		func(settings *gardencorev1beta1.SeedSettings, expected bool) {
+			Expect(SeedSettingDrainEnabled(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, false),
+		Entry("no drain setting", &gardencorev1beta1.SeedSettings{}, false),
+		Entry("drain enabled", &gardencorev1beta1.SeedSettings{Drain: &gardencorev1beta1.SeedSettingDrain{Enabled: true}}, true),
+		Entry("drain disabled", &gardencorev1beta1.SeedSettings{Drain: &gardencorev1beta1.SeedSettingDrain{Enabled: false}}, false),
+	)
+
+	DescribeTable("#SeedSettingControlPlaneResourceQuotaEnabled",
+		func(settings *gardencorev1beta1.SeedSettings, expected bool) {
+			Expect(SeedSettingControlPlaneResourceQuotaEnabled(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, false),
+		Entry("no control plane resource quota setting", &gardencorev1beta1.SeedSettings{}, false),
+		Entry("control plane resource quota enabled", &gardencorev1beta1.SeedSettings{ControlPlaneResourceQuota: &gardencorev1beta1.SeedSettingControlPlaneResourceQuota{Enabled: true}}, true),
+		Entry("control plane resource quota disabled", &gardencorev1beta1.SeedSettings{ControlPlaneResourceQuota: &gardencorev1beta1.SeedSettingControlPlaneResourceQuota{Enabled: false}}, false),
+	)
+
+	Describe("#ControlPlaneSizeClassForShoot", func() {
+		var (
+			settings *gardencorev1beta1.SeedSettings
+			shoot    *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			settings = &gardencorev1beta1.SeedSettings{
+				ControlPlaneResourceQuota: &gardencorev1beta1.SeedSettingControlPlaneResourceQuota{
+					Enabled: true,
+					SizeClasses: []gardencorev1beta1.ControlPlaneSizeClass{
+						{Name: "small", MaxNodes: ptr.To[int32](10)},
+						{Name: "medium", MaxNodes: ptr.To[int32](50)},
+						{Name: "large"},
+					},
+				},
+			}
+			shoot = &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+				{Name: "pool1", Maximum: 5},
+				{Name: "pool2", Maximum: 5},
+			}}}}
+		})
+
+		It("should return nil if the setting is disabled", func() {
+			settings.ControlPlaneResourceQuota.Enabled = false
+			Expect(ControlPlaneSizeClassForShoot(settings, shoot)).To(BeNil())
+		})
+
+		It("should return nil if no size classes are configured", func() {
+			settings.ControlPlaneResourceQuota.SizeClasses = nil
+			Expect(ControlPlaneSizeClassForShoot(settings, shoot)).To(BeNil())
+		})
+
+		It("should return the first matching size class", func() {
+			Expect(ControlPlaneSizeClassForShoot(settings, shoot).Name).To(Equal("small"))
+
+			shoot.Spec.Provider.Workers[0].Maximum = 40
+			Expect(ControlPlaneSizeClassForShoot(settings, shoot).Name).To(Equal("medium"))
+
+			shoot.Spec.Provider.Workers[0].Maximum = 400
+			Expect(ControlPlaneSizeClassForShoot(settings, shoot).Name).To(Equal("large"))
+		})
+
+		It("should return the last size class if no size class matches", func() {
+			settings.ControlPlaneResourceQuota.SizeClasses = settings.ControlPlaneResourceQuota.SizeClasses[:2]
+			shoot.Spec.Provider.Workers[0].Maximum = 400
+			Expect(ControlPlaneSizeClassForShoot(settings, shoot).Name).To(Equal("medium"))
+		})
+	})
+
+	DescribeTable("#SeedSettingIstioTLSTerminationEnabled",
+		func(settings *gardencorev1beta1.SeedSettings, expected bool) {
+			Expect(SeedSettingIstioTLSTerminationEnabled(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, false),
+		Entry("no istio TLS termination setting", &gardencorev1beta1.SeedSettings{}, false),
+		Entry("istio TLS termination enabled", &gardencorev1beta1.SeedSettings{IstioTLSTermination: &gardencorev1beta1.SeedSettingIstioTLSTermination{Enabled: true}}, true),
+		Entry("istio TLS termination disabled", &gardencorev1beta1.SeedSettings{IstioTLSTermination: &gardencorev1beta1.SeedSettingIstioTLSTermination{Enabled: false}}, false),
+	)
+
 	DescribeTable("#SeedSettingZonalIngressEnabled",
 		func(settings *gardencorev1beta1.SeedSettings, expectation bool) {
 			Expect(SeedSettingZonalIngressEnabled(settings)).To(Equal(expectation))
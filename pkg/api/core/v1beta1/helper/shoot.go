@@ -51,6 +51,16 @@ func ShootIgnoresAlerts(shoot *gardencorev1beta1.Shoot) bool {
 	return ignore
 }
 
+// ShootUsesAggressiveCleanup checks if the given shoot shall be deleted in the aggressive cleanup mode.
+func ShootUsesAggressiveCleanup(shoot *gardencorev1beta1.Shoot) bool {
+	if shoot == nil {
+		return false
+	}
+
+	aggressive, _ := strconv.ParseBool(shoot.Annotations[v1beta1constants.AnnotationShootAggressiveCleanup])
+	return aggressive
+}
+
 // ShootWantsAlertManager checks if the given shoot specification requires an alert manager.
 func ShootWantsAlertManager(shoot *gardencorev1beta1.Shoot) bool {
 	return !ShootIgnoresAlerts(shoot) && shoot.Spec.Monitoring != nil && shoot.Spec.Monitoring.Alerting != nil && len(shoot.Spec.Monitoring.Alerting.EmailReceivers) > 0
@@ -714,6 +724,16 @@ func GetEncryptionProviderType(apiServerConfig *gardencorev1beta1.KubeAPIServerC
 	return ""
 }
 
+// GetNodeConnectivityMode returns the mode used by the kube-apiserver to connect to the shoot cluster network. It
+// defaults to VPN if the mode is not set.
+func GetNodeConnectivityMode(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) gardencorev1beta1.NodeConnectivityMode {
+	if apiServerConfig != nil && apiServerConfig.NodeConnectivityMode != nil {
+		return *apiServerConfig.NodeConnectivityMode
+	}
+
+	return gardencorev1beta1.NodeConnectivityModeVPN
+}
+
 // GetEncryptionProviderTypeInStatus returns the encryption provider from the shoot status.
 func GetEncryptionProviderTypeInStatus(gardenStatus gardencorev1beta1.ShootStatus) gardencorev1beta1.EncryptionProviderType {
 	if gardenStatus.Credentials != nil && gardenStatus.Credentials.EncryptionAtRest != nil {
@@ -200,6 +200,25 @@ var _ = Describe("Helper", func() {
 			BeTrue()),
 	)
 
+	DescribeTable("#ShootUsesAggressiveCleanup",
+		func(shoot *gardencorev1beta1.Shoot, match gomegatypes.GomegaMatcher) {
+			Expect(ShootUsesAggressiveCleanup(shoot)).To(match)
+		},
+
+		Entry("shoot is nil",
+			nil,
+			BeFalse()),
+		Entry("no aggressive-cleanup annotation present",
+			&gardencorev1beta1.Shoot{},
+			BeFalse()),
+		Entry("aggressive-cleanup annotation present but value is false",
+			&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.AnnotationShootAggressiveCleanup: "false"}}},
+			BeFalse()),
+		Entry("aggressive-cleanup annotation present and value is true",
+			&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.AnnotationShootAggressiveCleanup: "true"}}},
+			BeTrue()),
+	)
+
 	var profile = gardencorev1beta1.SchedulingProfileBinPacking
 
 	DescribeTable("#ShootSchedulingProfile",
@@ -1853,6 +1872,16 @@ var _ = Describe("Helper", func() {
 			}, "foo"),
 		)
 
+		DescribeTable("#GetNodeConnectivityMode",
+			func(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig, expectedMode gardencorev1beta1.NodeConnectivityMode) {
+				Expect(GetNodeConnectivityMode(kubeAPIServerConfig)).To(Equal(expectedMode))
+			},
+
+			Entry("kubeAPIServerConfig is nil", nil, gardencorev1beta1.NodeConnectivityModeVPN),
+			Entry("mode is nil", &gardencorev1beta1.KubeAPIServerConfig{}, gardencorev1beta1.NodeConnectivityModeVPN),
+			Entry("mode is set", &gardencorev1beta1.KubeAPIServerConfig{NodeConnectivityMode: ptr.To(gardencorev1beta1.NodeConnectivityModeKonnectivity)}, gardencorev1beta1.NodeConnectivityModeKonnectivity),
+		)
+
 		DescribeTable("#GetEncryptionProviderInStatus",
 			func(status gardencorev1beta1.ShootStatus, expected string) {
 				Expect(string(GetEncryptionProviderTypeInStatus(status))).To(Equal(expected))
@@ -11,6 +11,7 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -18,6 +19,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/api/core/helper"
+	"github.com/gardener/gardener/pkg/api/core/shoot/validationrules"
 	"github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/features"
@@ -138,6 +140,8 @@ func ValidateCloudProfileSpec(spec *core.CloudProfileSpec, fldPath *field.Path)
 	allErrs = append(allErrs, validateCloudProfileRegions(spec.Regions, fldPath.Child("regions"))...)
 	allErrs = append(allErrs, validateCloudProfileBastion(spec, fldPath.Child("bastion"))...)
 	allErrs = append(allErrs, validateCloudProfileLimits(spec.Limits, fldPath.Child("limits"))...)
+	allErrs = append(allErrs, validateShootValidationRules(spec.ShootValidationRules, fldPath.Child("shootValidationRules"))...)
+	allErrs = append(allErrs, validateVersionLifecyclePolicy(spec.VersionLifecyclePolicy, fldPath.Child("versionLifecyclePolicy"))...)
 	if spec.SeedSelector != nil {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.SeedSelector.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("seedSelector"))...)
 	}
@@ -527,6 +531,72 @@ func validateCloudProfileLimitsUpdate(newLimits, oldLimits *core.Limits, fldPath
 	return allErrs
 }
 
+func validateShootValidationRules(rules []core.ShootValidationRule, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		if len(rule.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(rule.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), rule.Name))
+		}
+		names.Insert(rule.Name)
+
+		if len(rule.Expression) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("expression"), "must provide an expression"))
+		} else if _, err := validationrules.Compile(rule.Expression); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("expression"), rule.Expression, fmt.Sprintf("expression could not be compiled: %v", err)))
+		}
+
+		if rule.Message != nil && len(strings.TrimSpace(*rule.Message)) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("message"), *rule.Message, "message must not be empty if specified"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateVersionLifecyclePolicy(policy *core.VersionLifecyclePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateVersionClassificationTransitions(policy.Kubernetes, fldPath.Child("kubernetes"))...)
+	allErrs = append(allErrs, validateVersionClassificationTransitions(policy.MachineImages, fldPath.Child("machineImages"))...)
+
+	return allErrs
+}
+
+func validateVersionClassificationTransitions(transitions *core.VersionClassificationTransitions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if transitions == nil {
+		return allErrs
+	}
+
+	for _, transition := range []struct {
+		name     string
+		duration *metav1.Duration
+	}{
+		{"supportAfter", transitions.SupportAfter},
+		{"deprecateAfter", transitions.DeprecateAfter},
+		{"expireAfter", transitions.ExpireAfter},
+	} {
+		if transition.duration != nil && transition.duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(transition.name), transition.duration.Duration, fmt.Sprintf("%s must be larger than 0", transition.name)))
+		}
+	}
+
+	return allErrs
+}
+
 // HasDecreasedMaxNodesTotal checks whether the new maxNodesTotal has been decreased.
 func HasDecreasedMaxNodesTotal(newMaxNodesTotal, oldMaxNodesTotal *int32) bool {
 	return newMaxNodesTotal != nil && oldMaxNodesTotal != nil && *newMaxNodesTotal < *oldMaxNodesTotal
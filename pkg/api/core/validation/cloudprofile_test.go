@@ -1966,6 +1966,119 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 				})
 			})
 
+			Context("shoot validation rules validation", func() {
+				It("should allow valid rules", func() {
+					cloudProfile.Spec.ShootValidationRules = []core.ShootValidationRule{
+						{Name: "region", Expression: `shoot.spec.region.startsWith("eu-")`},
+						{Name: "workers", Expression: `shoot.spec.provider.workers.all(w, w.maximum <= 10)`, Message: ptr.To("workers must not have more than 10 nodes")},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid rules without name and expression", func() {
+					cloudProfile.Spec.ShootValidationRules = []core.ShootValidationRule{{}}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.shootValidationRules[0].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.shootValidationRules[0].expression"),
+						})),
+					))
+				})
+
+				It("should forbid duplicate names", func() {
+					cloudProfile.Spec.ShootValidationRules = []core.ShootValidationRule{
+						{Name: "region", Expression: "true"},
+						{Name: "region", Expression: "true"},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.shootValidationRules[1].name"),
+					}))))
+				})
+
+				It("should forbid expressions which cannot be compiled", func() {
+					cloudProfile.Spec.ShootValidationRules = []core.ShootValidationRule{
+						{Name: "syntax", Expression: `shoot.spec.region ==`},
+						{Name: "variable", Expression: `seed.spec.provider.type == "local"`},
+						{Name: "type", Expression: `size(shoot.spec.provider.workers)`},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.shootValidationRules[0].expression"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.shootValidationRules[1].expression"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.shootValidationRules[2].expression"),
+						})),
+					))
+				})
+
+				It("should forbid empty messages", func() {
+					cloudProfile.Spec.ShootValidationRules = []core.ShootValidationRule{
+						{Name: "region", Expression: "true", Message: ptr.To(" ")},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.shootValidationRules[0].message"),
+					}))))
+				})
+			})
+
+			Context("version lifecycle policy validation", func() {
+				It("should allow a valid policy", func() {
+					cloudProfile.Spec.VersionLifecyclePolicy = &core.VersionLifecyclePolicy{
+						Kubernetes: &core.VersionClassificationTransitions{
+							SupportAfter:   &metav1.Duration{Duration: 7 * 24 * time.Hour},
+							DeprecateAfter: &metav1.Duration{Duration: 90 * 24 * time.Hour},
+							ExpireAfter:    &metav1.Duration{Duration: 30 * 24 * time.Hour},
+						},
+						MachineImages: &core.VersionClassificationTransitions{
+							DeprecateAfter: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+						},
+						DryRun: ptr.To(true),
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid non-positive durations", func() {
+					cloudProfile.Spec.VersionLifecyclePolicy = &core.VersionLifecyclePolicy{
+						Kubernetes: &core.VersionClassificationTransitions{
+							SupportAfter: &metav1.Duration{},
+						},
+						MachineImages: &core.VersionClassificationTransitions{
+							DeprecateAfter: &metav1.Duration{Duration: -time.Hour},
+							ExpireAfter:    &metav1.Duration{Duration: time.Hour},
+						},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.versionLifecyclePolicy.kubernetes.supportAfter"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.versionLifecyclePolicy.machineImages.deprecateAfter"),
+						})),
+					))
+				})
+			})
+
 			It("should forbid unsupported seed selectors", func() {
 				cloudProfile.Spec.SeedSelector.MatchLabels["foo"] = "no/slash/allowed"
 
@@ -5,10 +5,12 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -68,6 +70,24 @@ func ValidateHelmControllerDeployment(helmControllerDeployment *core.HelmControl
 
 	allErrs = append(allErrs, ValidateOCIRepository(helmControllerDeployment.OCIRepository, fldPath.Child("ociRepository"))...)
 
+	for i, overlay := range helmControllerDeployment.ValuesOverlays {
+		idxPath := fldPath.Child("valuesOverlays").Index(i)
+
+		if overlay.SeedSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(overlay.SeedSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("seedSelector"))...)
+		}
+
+		if overlay.Values == nil || len(overlay.Values.Raw) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("values"), "values must be provided"))
+			continue
+		}
+
+		var values map[string]any
+		if err := json.Unmarshal(overlay.Values.Raw, &values); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("values"), string(overlay.Values.Raw), fmt.Sprintf("values must be a JSON object: %v", err)))
+		}
+	}
+
 	return allErrs
 }
 
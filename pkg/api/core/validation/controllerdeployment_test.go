@@ -140,6 +140,41 @@ var _ = Describe("#ValidateControllerDeployment", func() {
 					"Detail": ContainSubstring("must provide either"),
 				}))))
 			})
+
+			It("should allow valid values overlays", func() {
+				controllerDeployment.Helm.ValuesOverlays = []HelmValuesOverlay{
+					{Values: &apiextensionsv1.JSON{Raw: []byte(`{"replicas":1}`)}},
+					{
+						SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"size": "big"}},
+						Values:       &apiextensionsv1.JSON{Raw: []byte(`{"resources":{"requests":{"memory":"1Gi"}}}`)},
+					},
+				}
+
+				Expect(ValidateControllerDeployment(controllerDeployment)).To(BeEmpty())
+			})
+
+			It("should forbid invalid values overlays", func() {
+				controllerDeployment.Helm.ValuesOverlays = []HelmValuesOverlay{
+					{SeedSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "size", Operator: "invalid"}}}, Values: &apiextensionsv1.JSON{Raw: []byte(`{}`)}},
+					{},
+					{Values: &apiextensionsv1.JSON{Raw: []byte(`["foo"]`)}},
+				}
+
+				Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("helm.valuesOverlays[0].seedSelector.matchExpressions[0].operator"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("helm.valuesOverlays[1].values"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("helm.valuesOverlays[2].values"),
+					})),
+				))
+			})
 		})
 
 		Context("with ociRepository", func() {
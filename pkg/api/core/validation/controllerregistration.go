@@ -6,6 +6,8 @@ package validation
 
 import (
 	"fmt"
+	"maps"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -36,12 +38,37 @@ var availableExtensionStrategiesForReconcile = availableExtensionStrategies.Clon
 	core.AfterWorker,
 )
 
+// availableControllerResourceCapabilities maps the supported capabilities to the extension kind they can be advertised for.
+var availableControllerResourceCapabilities = map[core.ControllerResourceCapability]string{
+	core.ControllerResourceCapabilityDNSFailover: extensionsv1alpha1.DNSRecordResource,
+}
+
+var (
+	wellKnownErrorCodes = sets.New(
+		core.ErrorInfraUnauthenticated,
+		core.ErrorInfraUnauthorized,
+		core.ErrorInfraQuotaExceeded,
+		core.ErrorInfraRateLimitsExceeded,
+		core.ErrorInfraDependencies,
+		core.ErrorRetryableInfraDependencies,
+		core.ErrorInfraResourcesDepleted,
+		core.ErrorInfraImageNotFound,
+		core.ErrorInfraIPAddressesExhausted,
+		core.ErrorCleanupClusterResources,
+		core.ErrorConfigurationProblem,
+		core.ErrorRetryableConfigurationProblem,
+		core.ErrorProblematicWebhook,
+	)
+	errorCodeRegex = regexp.MustCompile(`^ERR_[A-Z0-9_]+$`)
+)
+
 // ValidateControllerRegistration validates a ControllerRegistration object.
 func ValidateControllerRegistration(controllerRegistration *core.ControllerRegistration) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&controllerRegistration.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateControllerRegistrationSpec(&controllerRegistration.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateControllerRegistrationDependencies(controllerRegistration.Name, controllerRegistration.Spec.Dependencies, field.NewPath("spec", "dependencies"))...)
 
 	return allErrs
 }
@@ -51,6 +78,7 @@ func ValidateControllerRegistrationSpec(spec *core.ControllerRegistrationSpec, f
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, ValidateControllerResources(spec.Resources, []core.ClusterType{core.ClusterTypeShoot, core.ClusterTypeSeed}, fldPath.Child("resources"))...)
+	allErrs = append(allErrs, ValidateErrorCodeDefinitions(spec.ErrorCodes, fldPath.Child("errorCodes"))...)
 
 	if deployment := spec.Deployment; deployment != nil {
 		deploymentPath := fldPath.Child("deployment")
@@ -82,6 +110,30 @@ func ValidateControllerRegistrationSpec(spec *core.ControllerRegistrationSpec, f
 				allErrs = append(allErrs, field.Required(fld.Child("name"), "must not be empty"))
 			}
 		}
+
+		if deployment.Rollout != nil {
+			allErrs = append(allErrs, validateControllerDeploymentRollout(deployment.Rollout, deploymentPath.Child("rollout"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateControllerDeploymentRollout(rollout *core.ControllerDeploymentRollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if canary := rollout.Canary; canary != nil {
+		canaryPath := fldPath.Child("canary")
+
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&canary.SeedSelector, metav1validation.LabelSelectorValidationOptions{}, canaryPath.Child("seedSelector"))...)
+
+		if canary.VerificationDuration != nil && canary.VerificationDuration.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(canaryPath.Child("verificationDuration"), canary.VerificationDuration.Duration.String(), "must not be negative"))
+		}
+
+		if rate := canary.MinShootReconcileSuccessRate; rate != nil && (*rate < 0 || *rate > 100) {
+			allErrs = append(allErrs, field.Invalid(canaryPath.Child("minShootReconcileSuccessRate"), *rate, "must be a percentage between 0 and 100"))
+		}
 	}
 
 	return allErrs
@@ -114,6 +166,23 @@ func ValidateControllerResources(resources []core.ControllerResource, clusterTyp
 		}
 		resourceKindToType[resource.Kind] = resource.Type
 
+		capabilities := sets.New[core.ControllerResourceCapability]()
+		for j, capability := range resource.Capabilities {
+			capabilityPath := idxPath.Child("capabilities").Index(j)
+
+			kind, ok := availableControllerResourceCapabilities[capability]
+			if !ok {
+				allErrs = append(allErrs, field.NotSupported(capabilityPath, capability, slices.Sorted(maps.Keys(availableControllerResourceCapabilities))))
+			} else if kind != resource.Kind {
+				allErrs = append(allErrs, field.Forbidden(capabilityPath, fmt.Sprintf("capability is only supported when kind is %s", kind)))
+			}
+
+			if capabilities.Has(capability) {
+				allErrs = append(allErrs, field.Duplicate(capabilityPath, capability))
+			}
+			capabilities.Insert(capability)
+		}
+
 		if resource.Kind != extensionsv1alpha1.ExtensionResource {
 			if len(resource.AutoEnable) > 0 {
 				allErrs = append(allErrs, field.Forbidden(idxPath.Child("autoEnable"), fmt.Sprintf("field must not be set when kind != %s", extensionsv1alpha1.ExtensionResource)))
@@ -184,6 +253,66 @@ func ValidateControllerResources(resources []core.ControllerResource, clusterTyp
 	return allErrs
 }
 
+// ValidateErrorCodeDefinitions validates the provided list of ErrorCodeDefinition objects.
+func ValidateErrorCodeDefinitions(definitions []core.ErrorCodeDefinition, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		codes   = sets.New[core.ErrorCode]()
+	)
+
+	for i, definition := range definitions {
+		idxPath := fldPath.Index(i)
+
+		if len(definition.Code) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("code"), "field is required"))
+		} else if !errorCodeRegex.MatchString(string(definition.Code)) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("code"), definition.Code, fmt.Sprintf("must match regex %q", errorCodeRegex.String())))
+		}
+
+		if wellKnownErrorCodes.Has(definition.Code) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("code"), "well-known error codes must not be redefined"))
+		}
+
+		if codes.Has(definition.Code) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("code"), definition.Code))
+		}
+		codes.Insert(definition.Code)
+
+		if len(definition.Description) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("description"), "field is required"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateControllerRegistrationDependencies(name string, dependencies []core.ControllerRegistrationDependency, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, dependency := range dependencies {
+		idxPath := fldPath.Index(i)
+
+		if len(dependency.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field is required"))
+			continue
+		}
+
+		if dependency.Name == name {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), "a controller registration must not depend on itself"))
+		}
+
+		if names.Has(dependency.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), dependency.Name))
+		}
+		names.Insert(dependency.Name)
+	}
+
+	return allErrs
+}
+
 // ValidateControllerRegistrationUpdate validates a ControllerRegistration object before an update.
 func ValidateControllerRegistrationUpdate(new, old *core.ControllerRegistration) field.ErrorList {
 	allErrs := field.ErrorList{}
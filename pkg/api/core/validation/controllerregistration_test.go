@@ -194,6 +194,118 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.deployment.deploymentRefs[0].name"),
 			}))))
 		})
+
+		It("should allow a valid canary rollout", func() {
+			controllerRegistration.Spec.Deployment.Rollout = &core.ControllerDeploymentRollout{
+				Canary: &core.ControllerDeploymentCanaryRollout{
+					SeedSelector:                 metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+					VerificationDuration:         &metav1.Duration{Duration: time.Hour},
+					MinShootReconcileSuccessRate: ptr.To[int32](95),
+				},
+			}
+
+			Expect(ValidateControllerRegistration(controllerRegistration)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid canary rollout", func() {
+			controllerRegistration.Spec.Deployment.Rollout = &core.ControllerDeploymentRollout{
+				Canary: &core.ControllerDeploymentCanaryRollout{
+					SeedSelector:                 metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "canary", Operator: "invalid"}}},
+					VerificationDuration:         &metav1.Duration{Duration: -time.Hour},
+					MinShootReconcileSuccessRate: ptr.To[int32](101),
+				},
+			}
+
+			Expect(ValidateControllerRegistration(controllerRegistration)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.rollout.canary.seedSelector.matchExpressions[0].operator"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.rollout.canary.verificationDuration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.rollout.canary.minShootReconcileSuccessRate"),
+				})),
+			))
+		})
+
+		It("should allow valid error code definitions", func() {
+			controllerRegistration.Spec.ErrorCodes = []core.ErrorCodeDefinition{
+				{Code: "ERR_FOO", Description: "foo", UserError: true},
+				{Code: "ERR_BAR_2", Description: "bar", NonRetryable: true},
+			}
+
+			Expect(ValidateControllerRegistration(controllerRegistration)).To(BeEmpty())
+		})
+
+		It("should forbid invalid error code definitions", func() {
+			controllerRegistration.Spec.ErrorCodes = []core.ErrorCodeDefinition{
+				{Code: "", Description: "foo"},
+				{Code: "err_foo", Description: "foo"},
+				{Code: core.ErrorInfraQuotaExceeded, Description: "foo"},
+				{Code: "ERR_FOO", Description: "foo"},
+				{Code: "ERR_FOO"},
+			}
+
+			Expect(ValidateControllerRegistration(controllerRegistration)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.errorCodes[0].code"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.errorCodes[1].code"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.errorCodes[2].code"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.errorCodes[4].code"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.errorCodes[4].description"),
+				})),
+			))
+		})
+
+		It("should allow valid dependencies", func() {
+			controllerRegistration.Spec.Dependencies = []core.ControllerRegistrationDependency{
+				{Name: "networking-foo"},
+				{Name: "provider-bar"},
+			}
+
+			Expect(ValidateControllerRegistration(controllerRegistration)).To(BeEmpty())
+		})
+
+		It("should forbid invalid dependencies", func() {
+			controllerRegistration.Spec.Dependencies = []core.ControllerRegistrationDependency{
+				{Name: ""},
+				{Name: "extension-abc"},
+				{Name: "networking-foo"},
+				{Name: "networking-foo"},
+			}
+
+			Expect(ValidateControllerRegistration(controllerRegistration)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.dependencies[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.dependencies[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.dependencies[3].name"),
+				})),
+			))
+		})
 	})
 
 	Describe("#ValidateControllerRegistrationUpdate", func() {
@@ -348,6 +460,31 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should allow advertising the DNSFailover capability for DNSRecords", func() {
+			resources[0].Kind = extensionsv1alpha1.DNSRecordResource
+			resources[0].Capabilities = []core.ControllerResourceCapability{core.ControllerResourceCapabilityDNSFailover}
+
+			Expect(ValidateControllerResources(resources, validModes, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid capabilities", func() {
+			resources[0].Capabilities = []core.ControllerResourceCapability{core.ControllerResourceCapabilityDNSFailover, "foo", core.ControllerResourceCapabilityDNSFailover}
+
+			Expect(ValidateControllerResources(resources, validModes, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("resources[0].capabilities[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("resources[0].capabilities[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("resources[0].capabilities[2]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("resources[0].capabilities[2]"),
+			}))))
+		})
+
 		It("should allow setting valid autoEnable modes", func() {
 			resources[0].Kind = "Extension"
 			resources[0].AutoEnable = []core.ClusterType{core.ClusterTypeShoot, core.ClusterTypeSeed}
@@ -36,7 +36,7 @@ func ValidateExposureClass(exposureClass *core.ExposureClass) field.ErrorList {
 		if exposureClass.Scheduling.SeedSelector != nil {
 			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&exposureClass.Scheduling.SeedSelector.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, field.NewPath("scheduling", "seedSelector"))...)
 		}
-		allErrs = append(allErrs, ValidateTolerations(exposureClass.Scheduling.Tolerations, field.NewPath("scheduling", "tolerations"))...)
+		allErrs = append(allErrs, ValidateTolerationsWithoutExpiration(exposureClass.Scheduling.Tolerations, field.NewPath("scheduling", "tolerations"))...)
 	}
 
 	return allErrs
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateMaintenanceFreeze validates a MaintenanceFreeze object.
+func ValidateMaintenanceFreeze(maintenanceFreeze *core.MaintenanceFreeze) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&maintenanceFreeze.ObjectMeta, false, ValidateName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateMaintenanceFreezeSpec(&maintenanceFreeze.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateMaintenanceFreezeUpdate validates a MaintenanceFreeze object before an update.
+func ValidateMaintenanceFreezeUpdate(newMaintenanceFreeze, oldMaintenanceFreeze *core.MaintenanceFreeze) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newMaintenanceFreeze.ObjectMeta, &oldMaintenanceFreeze.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateMaintenanceFreeze(newMaintenanceFreeze)...)
+
+	return allErrs
+}
+
+// ValidateMaintenanceFreezeStatusUpdate validates the status field of a MaintenanceFreeze object.
+func ValidateMaintenanceFreezeStatusUpdate(newMaintenanceFreeze, oldMaintenanceFreeze *core.MaintenanceFreeze) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("status", "deferredShoots")
+		shoots  = sets.New[string]()
+	)
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newMaintenanceFreeze.ObjectMeta, &oldMaintenanceFreeze.ObjectMeta, field.NewPath("metadata"))...)
+
+	for i, deferredShoot := range newMaintenanceFreeze.Status.DeferredShoots {
+		idxPath := fldPath.Index(i)
+
+		if len(deferredShoot.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must provide the namespace of the shoot"))
+		}
+		if len(deferredShoot.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the shoot"))
+		}
+
+		key := deferredShoot.Namespace + "/" + deferredShoot.Name
+		if shoots.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		}
+		shoots.Insert(key)
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceFreezeSpec(spec *core.MaintenanceFreezeSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Windows) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("windows"), "must provide at least one window"))
+	}
+
+	for i, window := range spec.Windows {
+		idxPath := fldPath.Child("windows").Index(i)
+
+		if window.Begin.IsZero() {
+			allErrs = append(allErrs, field.Required(idxPath.Child("begin"), "must provide the beginning of the window"))
+		}
+		if window.End.IsZero() {
+			allErrs = append(allErrs, field.Required(idxPath.Child("end"), "must provide the end of the window"))
+		}
+		if !window.Begin.IsZero() && !window.End.IsZero() && !window.Begin.Before(&window.End) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), window.End.String(), "end must be after begin"))
+		}
+	}
+
+	if spec.ProjectSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ProjectSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("projectSelector"))...)
+	}
+	if spec.SeedSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.SeedSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("seedSelector"))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener/pkg/api/core/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var _ = Describe("MaintenanceFreeze Validation Tests", func() {
+	var (
+		now               = time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC)
+		maintenanceFreeze *core.MaintenanceFreeze
+	)
+
+	BeforeEach(func() {
+		maintenanceFreeze = &core.MaintenanceFreeze{
+			ObjectMeta: metav1.ObjectMeta{Name: "year-end", ResourceVersion: "1"},
+			Spec: core.MaintenanceFreezeSpec{
+				Windows: []core.MaintenanceFreezeWindow{{
+					Begin: metav1.NewTime(now),
+					End:   metav1.NewTime(now.Add(14 * 24 * time.Hour)),
+				}},
+				ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "production"}},
+				SeedSelector:    &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+			},
+		}
+	})
+
+	Describe("#ValidateMaintenanceFreeze", func() {
+		It("should allow a valid MaintenanceFreeze", func() {
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(BeEmpty())
+		})
+
+		It("should allow a MaintenanceFreeze without selectors", func() {
+			maintenanceFreeze.Spec.ProjectSelector = nil
+			maintenanceFreeze.Spec.SeedSelector = nil
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(BeEmpty())
+		})
+
+		It("should forbid an empty name", func() {
+			maintenanceFreeze.Name = ""
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			}))))
+		})
+
+		It("should forbid a MaintenanceFreeze without windows", func() {
+			maintenanceFreeze.Spec.Windows = nil
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.windows"),
+			}))))
+		})
+
+		It("should forbid windows without begin and end", func() {
+			maintenanceFreeze.Spec.Windows = []core.MaintenanceFreezeWindow{{}}
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.windows[0].begin"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.windows[0].end"),
+				})),
+			))
+		})
+
+		It("should forbid windows ending before they begin", func() {
+			maintenanceFreeze.Spec.Windows = append(maintenanceFreeze.Spec.Windows, core.MaintenanceFreezeWindow{
+				Begin: metav1.NewTime(now),
+				End:   metav1.NewTime(now),
+			})
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.windows[1].end"),
+			}))))
+		})
+
+		It("should forbid invalid selectors", func() {
+			maintenanceFreeze.Spec.ProjectSelector.MatchLabels["foo"] = "no/slash/allowed"
+			maintenanceFreeze.Spec.SeedSelector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "invalid"}}
+
+			Expect(ValidateMaintenanceFreeze(maintenanceFreeze)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.projectSelector.matchLabels"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.seedSelector.matchExpressions[0].operator"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateMaintenanceFreezeUpdate", func() {
+		It("should validate the new MaintenanceFreeze", func() {
+			newMaintenanceFreeze := maintenanceFreeze.DeepCopy()
+			newMaintenanceFreeze.Spec.Windows = nil
+
+			Expect(ValidateMaintenanceFreezeUpdate(newMaintenanceFreeze, maintenanceFreeze)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.windows"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateMaintenanceFreezeStatusUpdate", func() {
+		It("should allow valid deferred shoots", func() {
+			newMaintenanceFreeze := maintenanceFreeze.DeepCopy()
+			newMaintenanceFreeze.Status.DeferredShoots = []core.DeferredShootMaintenance{
+				{Namespace: "garden-foo", Name: "bar", LastDeferredTime: metav1.NewTime(now)},
+				{Namespace: "garden-foo", Name: "baz", LastDeferredTime: metav1.NewTime(now)},
+			}
+
+			Expect(ValidateMaintenanceFreezeStatusUpdate(newMaintenanceFreeze, maintenanceFreeze)).To(BeEmpty())
+		})
+
+		It("should forbid incomplete and duplicate deferred shoots", func() {
+			newMaintenanceFreeze := maintenanceFreeze.DeepCopy()
+			newMaintenanceFreeze.Status.DeferredShoots = []core.DeferredShootMaintenance{
+				{},
+				{Namespace: "garden-foo", Name: "bar"},
+				{Namespace: "garden-foo", Name: "bar"},
+			}
+
+			Expect(ValidateMaintenanceFreezeStatusUpdate(newMaintenanceFreeze, maintenanceFreeze)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.deferredShoots[0].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.deferredShoots[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("status.deferredShoots[2]"),
+				})),
+			))
+		})
+	})
+})
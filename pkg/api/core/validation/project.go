@@ -148,13 +148,43 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, opts projectValidationOp
 	}
 
 	if projectSpec.Tolerations != nil {
-		allErrs = append(allErrs, ValidateTolerations(projectSpec.Tolerations.Defaults, fldPath.Child("tolerations", "defaults"))...)
-		allErrs = append(allErrs, ValidateTolerations(projectSpec.Tolerations.Whitelist, fldPath.Child("tolerations", "whitelist"))...)
+		allErrs = append(allErrs, ValidateTolerationsWithoutExpiration(projectSpec.Tolerations.Defaults, fldPath.Child("tolerations", "defaults"))...)
+		allErrs = append(allErrs, ValidateTolerationsWithoutExpiration(projectSpec.Tolerations.Whitelist, fldPath.Child("tolerations", "whitelist"))...)
 		allErrs = append(allErrs, ValidateTolerationsAgainstAllowlist(projectSpec.Tolerations.Defaults, projectSpec.Tolerations.Whitelist, fldPath.Child("tolerations", "defaults"))...)
 	}
 
 	allErrs = append(allErrs, validateDualApprovalForDeletion(projectSpec.DualApprovalForDeletion, fldPath.Child("dualApprovalForDeletion"))...)
 
+	if projectSpec.ShootDefaults != nil {
+		allErrs = append(allErrs, validateProjectShootDefaults(projectSpec.ShootDefaults, fldPath.Child("shootDefaults"))...)
+	}
+
+	return allErrs
+}
+
+func validateProjectShootDefaults(shootDefaults *core.ProjectShootDefaults, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if maintenance := shootDefaults.Maintenance; maintenance != nil && maintenance.TimeWindow != nil {
+		allErrs = append(allErrs, validateMaintenanceTimeWindow(maintenance.TimeWindow, fldPath.Child("maintenance", "timeWindow"))...)
+	}
+
+	if hibernation := shootDefaults.Hibernation; hibernation != nil {
+		allErrs = append(allErrs, ValidateHibernationSchedules(hibernation.Schedules, fldPath.Child("hibernation", "schedules"))...)
+	}
+
+	if workers := shootDefaults.Workers; workers != nil {
+		workersPath := fldPath.Child("workers")
+
+		if workers.MachineImage != nil && len(workers.MachineImage.Name) == 0 {
+			allErrs = append(allErrs, field.Required(workersPath.Child("machineImage", "name"), "must specify a machine image name"))
+		}
+		if workers.Volume != nil {
+			allErrs = append(allErrs, validateWorkerVolume(workers.Volume, workersPath.Child("volume"))...)
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabels(workers.Labels, workersPath.Child("labels"))...)
+	}
+
 	return allErrs
 }
 
@@ -307,6 +337,20 @@ func ValidateTolerations(tolerations []core.Toleration, fldPath *field.Path) fie
 	return allErrs
 }
 
+// ValidateTolerationsWithoutExpiration validates the given tolerations and forbids expiration timestamps since they
+// are only supported for shoot tolerations.
+func ValidateTolerationsWithoutExpiration(tolerations []core.Toleration, fldPath *field.Path) field.ErrorList {
+	allErrs := ValidateTolerations(tolerations, fldPath)
+
+	for i, toleration := range tolerations {
+		if toleration.ExpirationTimestamp != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("expirationTimestamp"), "expiration timestamps are only supported for shoot tolerations"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateTolerationsAgainstAllowlist validates the given tolerations against the given allowlist.
 func ValidateTolerationsAgainstAllowlist(tolerations, allowlist []core.Toleration, fldPath *field.Path) field.ErrorList {
 	var (
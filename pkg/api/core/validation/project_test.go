@@ -561,6 +561,27 @@ var _ = Describe("Project Validation Tests", func() {
 			))
 		})
 
+		It("should forbid tolerations with expiration timestamps", func() {
+			tolerations := []core.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{}}}
+			project.Spec.Tolerations = &core.ProjectTolerations{
+				Defaults:  tolerations,
+				Whitelist: tolerations,
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.tolerations.defaults[0].expirationTimestamp"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.tolerations.whitelist[0].expirationTimestamp"),
+				})),
+			))
+		})
+
 		Context("dual approval for deletion config", func() {
 			It("should forbid empty resources", func() {
 				project.Spec.DualApprovalForDeletion = append(project.Spec.DualApprovalForDeletion, core.DualApprovalForDeletion{})
@@ -625,6 +646,66 @@ var _ = Describe("Project Validation Tests", func() {
 			})
 		})
 
+		Context("shoot defaults", func() {
+			It("should allow valid configurations", func() {
+				project.Spec.ShootDefaults = &core.ProjectShootDefaults{
+					Maintenance: &core.ProjectShootMaintenanceDefaults{
+						TimeWindow:               &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+						ConfineSpecUpdateRollout: ptr.To(true),
+					},
+					Hibernation: &core.ProjectShootHibernationDefaults{
+						Schedules: []core.HibernationSchedule{{Start: ptr.To("00 20 * * 1,2,3,4,5")}},
+					},
+					Workers: &core.ProjectShootWorkerDefaults{
+						MachineImage: &core.ShootMachineImage{Name: "gardenlinux"},
+						Volume:       &core.Volume{VolumeSize: "50Gi"},
+						Labels:       map[string]string{"team": "foo"},
+					},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configurations", func() {
+				project.Spec.ShootDefaults = &core.ProjectShootDefaults{
+					Maintenance: &core.ProjectShootMaintenanceDefaults{
+						TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "221000+0100"},
+					},
+					Hibernation: &core.ProjectShootHibernationDefaults{
+						Schedules: []core.HibernationSchedule{{Start: ptr.To("foo")}},
+					},
+					Workers: &core.ProjectShootWorkerDefaults{
+						MachineImage: &core.ShootMachineImage{},
+						Volume:       &core.Volume{VolumeSize: "fifty"},
+						Labels:       map[string]string{"foo": "no/slash/allowed"},
+					},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.shootDefaults.maintenance.timeWindow"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.shootDefaults.hibernation.schedules[0].start"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.shootDefaults.workers.machineImage.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.shootDefaults.workers.volume.size"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.shootDefaults.workers.labels"),
+					})),
+				))
+			})
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
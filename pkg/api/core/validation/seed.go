@@ -12,6 +12,7 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/api/core/helper"
@@ -46,6 +47,7 @@ func ValidateSeed(seed *core.Seed) field.ErrorList {
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&seed.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateSeedOperation(seed.Annotations[v1beta1constants.GardenerOperation], field.NewPath("metadata", "annotations").Key(v1beta1constants.GardenerOperation))...)
+	allErrs = append(allErrs, featuresvalidation.ValidateFeatureGateOverrides(seed.Annotations, field.NewPath("metadata", "annotations"))...)
 	allErrs = append(allErrs, ValidateSeedSpec(&seed.Spec, field.NewPath("spec"), false)...)
 
 	return allErrs
@@ -163,6 +165,8 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 				allErrs = append(allErrs, kubernetescorevalidation.ValidateQualifiedName(*class, fldPath.Child("settings", "loadBalancerServices", "class"))...)
 			}
 
+			allErrs = append(allErrs, validateLoadBalancerServicesProxyProtocol(seedSpec.Settings.LoadBalancerServices.ProxyProtocol, fldPath.Child("settings", "loadBalancerServices", "proxyProtocol"))...)
+
 			if policy := seedSpec.Settings.LoadBalancerServices.ExternalTrafficPolicy; policy != nil && !availableExternalTrafficPolicies.Has(string(*policy)) {
 				allErrs = append(allErrs, field.NotSupported(fldPath.Child("settings", "loadBalancerServices", "externalTrafficPolicy"), *policy, sets.List(availableExternalTrafficPolicies)))
 			}
@@ -188,6 +192,8 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 				if policy := zoneSettings.ExternalTrafficPolicy; policy != nil && !availableExternalTrafficPolicies.Has(string(*policy)) {
 					allErrs = append(allErrs, field.NotSupported(fldPath.Child("settings", "loadBalancerServices", "zones").Index(i).Child("externalTrafficPolicy"), *policy, sets.List(availableExternalTrafficPolicies)))
 				}
+
+				allErrs = append(allErrs, validateLoadBalancerServicesProxyProtocol(zoneSettings.ProxyProtocol, fldPath.Child("settings", "loadBalancerServices", "zones").Index(i).Child("proxyProtocol"))...)
 			}
 		}
 		if seedSpec.Settings.VerticalPodAutoscaler != nil {
@@ -205,6 +211,15 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		if helper.SeedSettingTopologyAwareRoutingEnabled(seedSpec.Settings) && len(seedSpec.Provider.Zones) <= 1 {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("settings", "topologyAwareRouting", "enabled"), "topology-aware routing can only be enabled on multi-zone Seed clusters (with at least two zones in spec.provider.zones)"))
 		}
+		if seedSpec.Settings.Scheduling != nil && seedSpec.Settings.Scheduling.Profile != nil && !availableSchedulingProfiles.Has(string(*seedSpec.Settings.Scheduling.Profile)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("settings", "scheduling", "profile"), *seedSpec.Settings.Scheduling.Profile, sets.List(availableSchedulingProfiles)))
+		}
+		if seedSpec.Settings.Drain != nil && seedSpec.Settings.Drain.MaxConcurrentMigrations != nil && *seedSpec.Settings.Drain.MaxConcurrentMigrations <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("settings", "drain", "maxConcurrentMigrations"), *seedSpec.Settings.Drain.MaxConcurrentMigrations, "must be greater than 0"))
+		}
+		if seedSpec.Settings.ControlPlaneResourceQuota != nil {
+			allErrs = append(allErrs, validateSeedSettingControlPlaneResourceQuota(seedSpec.Settings.ControlPlaneResourceQuota, fldPath.Child("settings", "controlPlaneResourceQuota"))...)
+		}
 		if seedSpec.Settings.VerticalPodAutoscaler != nil {
 			allErrs = append(allErrs, featuresvalidation.ValidateVpaFeatureGates(seedSpec.Settings.VerticalPodAutoscaler.FeatureGates, fldPath.Child("settings", "verticalPodAutoscaler", "featureGates"))...)
 		}
@@ -231,6 +246,9 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 			allErrs = append(allErrs, field.Required(fldPath.Child("dns", "provider"),
 				"ingress controller requires dns.provider to be set"))
 		}
+		if wildcardCertificate := seedSpec.Ingress.WildcardCertificate; wildcardCertificate != nil && wildcardCertificate.IssuerName != nil {
+			allErrs = append(allErrs, ValidateDNS1123Subdomain(*wildcardCertificate.IssuerName, fldPath.Child("ingress", "wildcardCertificate", "issuerName"))...)
+		}
 	}
 
 	if dnsProvider := seedSpec.DNS.Provider; dnsProvider != nil {
@@ -284,6 +302,51 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 	return allErrs
 }
 
+func validateLoadBalancerServicesProxyProtocol(proxyProtocol *core.LoadBalancerServicesProxyProtocol, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if proxyProtocol == nil || len(proxyProtocol.TLVs) == 0 {
+		return allErrs
+	}
+
+	if !proxyProtocol.Allowed {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("tlvs"), "TLVs can only be configured if proxy protocol is allowed"))
+	}
+
+	var (
+		types = sets.New[int32]()
+		names = sets.New[string]()
+	)
+
+	for i, tlv := range proxyProtocol.TLVs {
+		idxPath := fldPath.Child("tlvs").Index(i)
+
+		if tlv.Type < 0 || tlv.Type > 255 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("type"), tlv.Type, "must be in the range [0,255]"))
+		} else if types.Has(tlv.Type) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("type"), tlv.Type))
+		}
+		types.Insert(tlv.Type)
+
+		if len(tlv.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+			continue
+		}
+		for _, msg := range validation.IsDNS1123Label(tlv.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), tlv.Name, msg))
+		}
+		if len(tlv.Name) > 32 {
+			allErrs = append(allErrs, field.TooLong(idxPath.Child("name"), tlv.Name, 32))
+		}
+		if names.Has(tlv.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), tlv.Name))
+		}
+		names.Insert(tlv.Name)
+	}
+
+	return allErrs
+}
+
 func validateSeedBackup(seedBackup *core.Backup, seedProviderType string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -511,3 +574,59 @@ func validateSeedDNSProviderConfig(dnsConfig core.SeedDNSProviderConfig, fldPath
 
 	return allErrs
 }
+
+func validateSeedSettingControlPlaneResourceQuota(controlPlaneResourceQuota *core.SeedSettingControlPlaneResourceQuota, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlaneResourceQuota.Enabled && len(controlPlaneResourceQuota.SizeClasses) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sizeClasses"), "at least one size class is required when the control plane resource quota is enabled"))
+	}
+
+	var (
+		names        = sets.New[string]()
+		lastMaxNodes int32
+	)
+
+	for i, sizeClass := range controlPlaneResourceQuota.SizeClasses {
+		idxPath := fldPath.Child("sizeClasses").Index(i)
+
+		if len(sizeClass.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			allErrs = append(allErrs, validateDNS1123Label(sizeClass.Name, idxPath.Child("name"))...)
+			if names.Has(sizeClass.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), sizeClass.Name))
+			}
+			names.Insert(sizeClass.Name)
+		}
+
+		if sizeClass.MaxNodes == nil {
+			if i != len(controlPlaneResourceQuota.SizeClasses)-1 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("maxNodes"), "must be set for all but the last size class"))
+			}
+		} else if *sizeClass.MaxNodes <= lastMaxNodes {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxNodes"), *sizeClass.MaxNodes, fmt.Sprintf("must be greater than %d (the size classes must be ordered by ascending maximum number of nodes)", lastMaxNodes)))
+		} else {
+			lastMaxNodes = *sizeClass.MaxNodes
+		}
+
+		if len(sizeClass.Quota) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("quota"), "cannot be empty"))
+		}
+		for resource, value := range sizeClass.Quota {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(resource.String(), value, idxPath.Child("quota").Child(resource.String()))...)
+		}
+		for resource, value := range sizeClass.ContainerMax {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(resource.String(), value, idxPath.Child("containerMax").Child(resource.String()))...)
+		}
+		for resource, value := range sizeClass.ContainerDefaultRequest {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(resource.String(), value, idxPath.Child("containerDefaultRequest").Child(resource.String()))...)
+
+			if maxValue, ok := sizeClass.ContainerMax[resource]; ok && value.Cmp(maxValue) > 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("containerDefaultRequest").Child(resource.String()), value.String(), fmt.Sprintf("must not be greater than the container maximum %s", maxValue.String())))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -232,6 +232,23 @@ var _ = Describe("Seed Validation Tests", func() {
 			})
 		})
 
+		Context("feature gate overrides annotation", func() {
+			It("should allow valid overrides", func() {
+				metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "features.gardener.cloud/overrides", "DefaultSeccompProfile=true")
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should forbid unknown feature gates", func() {
+				metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "features.gardener.cloud/overrides", "Foo=true")
+
+				Expect(ValidateSeed(seed)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[features.gardener.cloud/overrides]"),
+				}))))
+			})
+		})
+
 		It("should forbid Seed specification with empty or invalid keys", func() {
 			invalidCIDR := "invalid-cidr"
 			seed.Spec.Provider = core.SeedProvider{
@@ -1281,6 +1298,77 @@ var _ = Describe("Seed Validation Tests", func() {
 					))
 				})
 
+				It("should allow valid proxy protocol TLVs", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						LoadBalancerServices: &core.SeedSettingLoadBalancerServices{
+							ProxyProtocol: &core.LoadBalancerServicesProxyProtocol{
+								Allowed: true,
+								TLVs: []core.ProxyProtocolTLV{
+									{Type: 234, Name: "vpce-id"},
+									{Type: 5, Name: "unique-id"},
+								},
+							},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(BeEmpty())
+				})
+
+				It("should prevent invalid proxy protocol TLVs", func() {
+					seed.Spec.Provider.Zones = []string{"a", "b"}
+					seed.Spec.Settings = &core.SeedSettings{
+						LoadBalancerServices: &core.SeedSettingLoadBalancerServices{
+							ProxyProtocol: &core.LoadBalancerServicesProxyProtocol{
+								Allowed: true,
+								TLVs: []core.ProxyProtocolTLV{
+									{Type: 256, Name: "Invalid_Name"},
+									{Type: 5, Name: "unique-id"},
+									{Type: 5, Name: "unique-id"},
+									{Type: 6, Name: "a-name-which-is-longer-than-32-characters"},
+									{Type: 7},
+								},
+							},
+							Zones: []core.SeedSettingLoadBalancerServicesZones{{
+								Name: "a",
+								ProxyProtocol: &core.LoadBalancerServicesProxyProtocol{
+									TLVs: []core.ProxyProtocolTLV{{Type: 234, Name: "vpce-id"}},
+								},
+							}},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.loadBalancerServices.proxyProtocol.tlvs[0].type"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.loadBalancerServices.proxyProtocol.tlvs[0].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.settings.loadBalancerServices.proxyProtocol.tlvs[2].type"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.settings.loadBalancerServices.proxyProtocol.tlvs[2].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeTooLong),
+							"Field": Equal("spec.settings.loadBalancerServices.proxyProtocol.tlvs[3].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.settings.loadBalancerServices.proxyProtocol.tlvs[4].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.settings.loadBalancerServices.zones[0].proxyProtocol.tlvs"),
+						})),
+					))
+				})
+
 				It("should allow valid zonal load balancer service annotations and traffic policy", func() {
 					for _, p := range []string{"Cluster", "Local"} {
 						policy := corev1.ServiceExternalTrafficPolicy(p)
@@ -1524,6 +1612,158 @@ var _ = Describe("Seed Validation Tests", func() {
 				Expect(ValidateSeed(seed)).To(BeEmpty())
 			})
 
+			Context("scheduling", func() {
+				It("should allow the bin-packing scheduling profile", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						Scheduling: &core.SeedSettingScheduling{
+							Visible: true,
+							Profile: ptr.To(core.SchedulingProfileBinPacking),
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(BeEmpty())
+				})
+
+				It("should forbid unknown scheduling profiles", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						Scheduling: &core.SeedSettingScheduling{
+							Visible: true,
+							Profile: ptr.To(core.SchedulingProfile("foo")),
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.settings.scheduling.profile"),
+						})),
+					))
+				})
+			})
+
+			Context("drain", func() {
+				It("should allow draining the seed", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						Drain: &core.SeedSettingDrain{
+							Enabled:                 true,
+							MaxConcurrentMigrations: ptr.To[int32](3),
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(BeEmpty())
+				})
+
+				It("should forbid non-positive max concurrent migrations", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						Drain: &core.SeedSettingDrain{
+							Enabled:                 true,
+							MaxConcurrentMigrations: ptr.To[int32](0),
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.drain.maxConcurrentMigrations"),
+						})),
+					))
+				})
+			})
+
+			Context("control plane resource quota", func() {
+				It("should allow valid size classes", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						ControlPlaneResourceQuota: &core.SeedSettingControlPlaneResourceQuota{
+							Enabled: true,
+							SizeClasses: []core.ControlPlaneSizeClass{
+								{
+									Name:                    "small",
+									MaxNodes:                ptr.To[int32](10),
+									Quota:                   corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("10")},
+									ContainerMax:            corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+									ContainerDefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+								},
+								{
+									Name:  "large",
+									Quota: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("40")},
+								},
+							},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(BeEmpty())
+				})
+
+				It("should require size classes if enabled", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						ControlPlaneResourceQuota: &core.SeedSettingControlPlaneResourceQuota{Enabled: true},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.settings.controlPlaneResourceQuota.sizeClasses"),
+						})),
+					))
+				})
+
+				It("should forbid invalid size classes", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						ControlPlaneResourceQuota: &core.SeedSettingControlPlaneResourceQuota{
+							Enabled: true,
+							SizeClasses: []core.ControlPlaneSizeClass{
+								{
+									Name:                    "small",
+									MaxNodes:                ptr.To[int32](10),
+									Quota:                   corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("-1")},
+									ContainerMax:            corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+									ContainerDefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+								},
+								{
+									Name: "small",
+								},
+								{
+									Name:     "Large",
+									MaxNodes: ptr.To[int32](5),
+									Quota:    corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("40")},
+								},
+							},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.controlPlaneResourceQuota.sizeClasses[0].quota.requests.cpu"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.controlPlaneResourceQuota.sizeClasses[0].containerDefaultRequest.memory"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.settings.controlPlaneResourceQuota.sizeClasses[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.settings.controlPlaneResourceQuota.sizeClasses[1].maxNodes"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.settings.controlPlaneResourceQuota.sizeClasses[1].quota"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.controlPlaneResourceQuota.sizeClasses[2].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.controlPlaneResourceQuota.sizeClasses[2].maxNodes"),
+						})),
+					))
+				})
+			})
+
 			Context("zone selection", func() {
 				It("should prevent configuring zone selection when spec.provider.zones is empty", func() {
 					seed.Spec.Provider.Zones = nil
@@ -1746,6 +1986,20 @@ var _ = Describe("Seed Validation Tests", func() {
 				}))
 			})
 
+			It("should succeed if the wildcard certificate is requested from a valid issuer", func() {
+				seed.Spec.Ingress.WildcardCertificate = &core.IngressWildcardCertificate{IssuerName: ptr.To("garden")}
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should fail if the issuer name of the wildcard certificate is invalid", func() {
+				seed.Spec.Ingress.WildcardCertificate = &core.IngressWildcardCertificate{IssuerName: ptr.To("invalid_issuer")}
+
+				Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.ingress.wildcardCertificate.issuerName"),
+				}))
+			})
 		})
 
 		Context("DNSProvider config", func() {
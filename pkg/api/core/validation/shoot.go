@@ -6,6 +6,8 @@ package validation
 
 import (
 	"fmt"
+	"maps"
+	"math"
 	"math/big"
 	"net"
 	"net/url"
@@ -57,6 +59,10 @@ var (
 		string(core.ProxyModeNFTables),
 		string(core.ProxyModeIPVS),
 	)
+	availableNodeConnectivityModes = sets.New(
+		string(core.NodeConnectivityModeVPN),
+		string(core.NodeConnectivityModeKonnectivity),
+	)
 	availableKubernetesDashboardAuthenticationModes = sets.New(
 		core.KubernetesDashboardAuthModeToken,
 	)
@@ -68,6 +74,7 @@ var (
 		v1beta1constants.ShootOperationMaintain,
 		v1beta1constants.ShootOperationRetry,
 		v1beta1constants.ShootOperationForceInPlaceUpdate,
+		v1beta1constants.ShootOperationComputeControlPlaneDrift,
 	).Union(availableShootMaintenanceOperations)
 	availableShootMaintenanceOperations = sets.New(
 		v1beta1constants.GardenerOperationReconcile,
@@ -144,6 +151,39 @@ var (
 		string(core.SchedulingProfileBalanced),
 		string(core.SchedulingProfileBinPacking),
 	)
+	reservedSchedulerNames = sets.New(
+		corev1.DefaultSchedulerName,
+		"bin-packing-scheduler",
+	)
+	// availableFilterSchedulerPlugins maps the in-tree filter plugins of the kube-scheduler which can be configured in
+	// additional scheduler profiles to the constraint for the Kubernetes versions supporting them (nil means all versions).
+	availableFilterSchedulerPlugins = map[string]*versionutils.Constraints{
+		"DynamicResources":   versionutils.ConstraintK8sGreaterEqual134,
+		"InterPodAffinity":   nil,
+		"NodeAffinity":       nil,
+		"NodeName":           nil,
+		"NodePorts":          nil,
+		"NodeResourcesFit":   nil,
+		"NodeUnschedulable":  nil,
+		"NodeVolumeLimits":   nil,
+		"PodTopologySpread":  nil,
+		"TaintToleration":    nil,
+		"VolumeBinding":      nil,
+		"VolumeRestrictions": nil,
+		"VolumeZone":         nil,
+	}
+	// availableScoreSchedulerPlugins maps the in-tree score plugins of the kube-scheduler which can be configured in
+	// additional scheduler profiles to the constraint for the Kubernetes versions supporting them (nil means all versions).
+	availableScoreSchedulerPlugins = map[string]*versionutils.Constraints{
+		"ImageLocality":                   nil,
+		"InterPodAffinity":                nil,
+		"NodeAffinity":                    nil,
+		"NodeResourcesBalancedAllocation": nil,
+		"NodeResourcesFit":                nil,
+		"PodTopologySpread":               nil,
+		"TaintToleration":                 nil,
+		"VolumeBinding":                   nil,
+	}
 	errorCodesAllowingForceDeletion = sets.New(
 		core.ErrorInfraUnauthenticated,
 		core.ErrorInfraUnauthorized,
@@ -156,7 +196,8 @@ var (
 		gardencorev1beta1.GardenerName,
 		v1beta1constants.ReferenceProtectionFinalizerName,
 	)
-	availableUpdateStrategies = sets.New(core.AutoRollingUpdate, core.AutoInPlaceUpdate, core.ManualInPlaceUpdate)
+	availableUpdateStrategies   = sets.New(core.AutoRollingUpdate, core.AutoInPlaceUpdate, core.ManualInPlaceUpdate)
+	availableAcceleratorVendors = sets.New(core.AcceleratorVendorNVIDIA, core.AcceleratorVendorAMD)
 
 	// asymmetric algorithms from https://datatracker.ietf.org/doc/html/rfc7518#section-3.1
 	availableOIDCSigningAlgs = sets.New(
@@ -214,6 +255,38 @@ func ValidateShootWithOpts(shoot *core.Shoot, opts shootValidationOptions) field
 	allErrs = append(allErrs, validateShootOperation(v1beta1helper.GetShootGardenerOperations(shoot.Annotations), v1beta1helper.GetShootMaintenanceOperations(shoot.Annotations), shoot, field.NewPath("metadata", "annotations"))...)
 	allErrs = append(allErrs, ValidateShootSpec(shoot.ObjectMeta, &shoot.Spec, opts, field.NewPath("spec"), false)...)
 	allErrs = append(allErrs, ValidateShootHAConfig(shoot)...)
+	allErrs = append(allErrs, validateShootImageOverrides(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateShootMonitoringRetention(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
+
+	return allErrs
+}
+
+func validateShootImageOverrides(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	value, ok := annotations[v1beta1constants.AnnotationShootImageOverrides]
+	if !ok {
+		return allErrs
+	}
+
+	if _, err := gardenerutils.ParseImageOverrides(value); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootImageOverrides), value, err.Error()))
+	}
+
+	return allErrs
+}
+
+func validateShootMonitoringRetention(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	value, ok := annotations[v1beta1constants.AnnotationShootMonitoringRetention]
+	if !ok {
+		return allErrs
+	}
+
+	if _, err := gardenerutils.ParseMonitoringRetention(value); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootMonitoringRetention), value, err.Error()))
+	}
 
 	return allErrs
 }
@@ -316,6 +389,7 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, opts shootV
 	allErrs = append(allErrs, ValidateCloudProfileReference(spec.CloudProfile, spec.CloudProfileName, spec.Kubernetes.Version, fldPath)...)
 	allErrs = append(allErrs, validateProvider(meta.Namespace, spec.Provider, spec.Kubernetes, spec.Networking, workerless, fldPath.Child("provider"), inTemplate)...)
 	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Purpose, workerless, spec.Kubernetes.Version, fldPath.Child("addons"))...)
+	allErrs = append(allErrs, validateManagedAddons(spec.ManagedAddons, workerless, fldPath.Child("managedAddons"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
 	allErrs = append(allErrs, ValidateResources(spec.Resources, fldPath.Child("resources"), true)...)
@@ -324,6 +398,7 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, opts shootV
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"), workerless)...)
 	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
 	allErrs = append(allErrs, ValidateHibernation(meta.Annotations, spec.Hibernation, fldPath.Child("hibernation"))...)
+	allErrs = append(allErrs, validateCleanup(spec.Cleanup, fldPath.Child("cleanup"))...)
 
 	if len(spec.Region) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("region"), "must specify a region"))
@@ -422,6 +497,7 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 	allErrs = append(allErrs, validateDNSUpdate(newSpec.DNS, oldSpec.DNS, newSpec.SeedName != nil, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, ValidateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, false, fldPath.Child("kubernetes", "version"))...)
 
+	allErrs = append(allErrs, validateServiceAccountConfigUpdate(newSpec.Kubernetes.KubeAPIServer, oldSpec.Kubernetes.KubeAPIServer, fldPath.Child("kubernetes", "kubeAPIServer", "serviceAccountConfig"))...)
 	allErrs = append(allErrs, validateKubeControllerManagerUpdate(newSpec.Kubernetes.KubeControllerManager, oldSpec.Kubernetes.KubeControllerManager, fldPath.Child("kubernetes", "kubeControllerManager"))...)
 
 	if err := validateWorkerUpdate(len(newSpec.Provider.Workers) > 0, len(oldSpec.Provider.Workers) > 0, fldPath.Child("provider", "workers")); err != nil {
@@ -671,6 +747,12 @@ func validateAddons(addons *core.Addons, purpose *core.ShootPurpose, workerless
 				allErrs = append(allErrs, field.NotSupported(fldPath.Child("nginxIngress", "externalTrafficPolicy"), *policy, sets.List(availableNginxIngressExternalTrafficPolicies)))
 			}
 		}
+
+		allErrs = append(allErrs, apivalidation.ValidateAnnotations(addons.NginxIngress.LoadBalancerAnnotations, fldPath.Child("nginxIngress", "loadBalancerAnnotations"))...)
+
+		if autoscaling := addons.NginxIngress.Autoscaling; autoscaling != nil {
+			allErrs = append(allErrs, validateNginxIngressAutoscaling(autoscaling, fldPath.Child("nginxIngress", "autoscaling"))...)
+		}
 	}
 
 	if helper.KubernetesDashboardEnabled(addons) {
@@ -684,6 +766,64 @@ func validateAddons(addons *core.Addons, purpose *core.ShootPurpose, workerless
 	return allErrs
 }
 
+func validateNginxIngressAutoscaling(autoscaling *core.NginxIngressAutoscaling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	minReplicas := int32(1)
+	if autoscaling.MinReplicas != nil {
+		minReplicas = *autoscaling.MinReplicas
+		if minReplicas < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), minReplicas, "must be greater than or equal to 1"))
+		}
+	}
+
+	if autoscaling.MaxReplicas < minReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), autoscaling.MaxReplicas, "must be greater than or equal to minReplicas"))
+	}
+
+	if utilization := autoscaling.TargetCPUUtilizationPercentage; utilization != nil && (*utilization < 1 || *utilization > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetCPUUtilizationPercentage"), *utilization, "must be between 1 and 100"))
+	}
+
+	return allErrs
+}
+
+func validateManagedAddons(managedAddons []core.ManagedAddon, workerless bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(managedAddons) == 0 {
+		return allErrs
+	}
+
+	if workerless {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "managed addons cannot be enabled for Workerless Shoot clusters"))
+		return allErrs
+	}
+
+	names := sets.New[string]()
+	for i, addon := range managedAddons {
+		idxPath := fldPath.Index(i)
+
+		if len(addon.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			allErrs = append(allErrs, validateDNS1123Label(addon.Name, idxPath.Child("name"))...)
+			if names.Has(addon.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), addon.Name))
+			}
+			names.Insert(addon.Name)
+		}
+
+		if addon.Version != nil {
+			if _, err := semver.NewConstraint(*addon.Version); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("version"), *addon.Version, fmt.Sprintf("must be a valid semantic version constraint: %v", err)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 const (
 	// kube-controller-manager's default value for --node-cidr-mask-size for IPv4
 	defaultNodeCIDRMaskSizeV4 = 24
@@ -758,6 +898,33 @@ func ValidateEncryptionConfigUpdate(newConfig, oldConfig *core.EncryptionConfig,
 	return allErrs
 }
 
+// validateServiceAccountConfigUpdate ensures that a change of the service account issuer does not invalidate the tokens
+// issued by the previous issuer, i.e., the previous issuer must be kept as accepted issuer until the tokens expired.
+func validateServiceAccountConfigUpdate(newConfig, oldConfig *core.KubeAPIServerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldConfig == nil || oldConfig.ServiceAccountConfig == nil || oldConfig.ServiceAccountConfig.Issuer == nil {
+		return allErrs
+	}
+
+	var (
+		oldIssuer       = *oldConfig.ServiceAccountConfig.Issuer
+		newIssuer       *string
+		acceptedIssuers []string
+	)
+
+	if newConfig != nil && newConfig.ServiceAccountConfig != nil {
+		newIssuer = newConfig.ServiceAccountConfig.Issuer
+		acceptedIssuers = newConfig.ServiceAccountConfig.AcceptedIssuers
+	}
+
+	if ptr.Deref(newIssuer, "") != oldIssuer && !slices.Contains(acceptedIssuers, oldIssuer) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("issuer"), fmt.Sprintf("the previous issuer %q must be added to the accepted issuers when changing the issuer, otherwise the service account tokens issued by it are no longer accepted", oldIssuer)))
+	}
+
+	return allErrs
+}
+
 func validateKubeControllerManagerUpdate(newConfig, oldConfig *core.KubeControllerManagerConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1110,6 +1277,109 @@ func validateDNS(dns *core.DNS, fldPath *field.Path) field.ErrorList {
 		}
 	}
 
+	if dns.APIServerRecord != nil {
+		allErrs = append(allErrs, validateDNSAPIServerRecord(dns.APIServerRecord, primaryDNSProvider, fldPath.Child("apiServerRecord"))...)
+	}
+
+	return allErrs
+}
+
+var (
+	availableDNSRecordTTLTypes       = sets.New("A", "AAAA", "CNAME")
+	availableDNSHealthCheckProtocols = sets.New(core.DNSHealthCheckProtocolHTTPS, core.DNSHealthCheckProtocolTCP)
+)
+
+func validateDNSAPIServerRecord(record *core.DNSAPIServerRecord, primaryDNSProvider *core.DNSProvider, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs     = field.ErrorList{}
+		recordTypes = sets.New[string]()
+	)
+
+	for i, ttl := range record.TTLs {
+		idxPath := fldPath.Child("ttls").Index(i)
+
+		if !availableDNSRecordTTLTypes.Has(ttl.RecordType) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("recordType"), ttl.RecordType, sets.List(availableDNSRecordTTLTypes)))
+		} else if recordTypes.Has(ttl.RecordType) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("recordType"), ttl.RecordType))
+		}
+		recordTypes.Insert(ttl.RecordType)
+
+		// see https://www.rfc-editor.org/rfc/rfc2181#section-8
+		for _, msg := range validation.IsInRange(int(ttl.Seconds), 1, math.MaxInt32) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("seconds"), ttl.Seconds, msg))
+		}
+	}
+
+	if failover := record.Failover; failover != nil {
+		failoverPath := fldPath.Child("failover")
+
+		if primaryDNSProvider == nil || primaryDNSProvider.Type == nil || *primaryDNSProvider.Type == core.DNSUnmanaged {
+			allErrs = append(allErrs, field.Forbidden(failoverPath, "failover is only supported for shoots with a managed primary DNS provider"))
+		}
+
+		allErrs = append(allErrs, validateDNSFailoverValues(failover.Values, failoverPath.Child("values"))...)
+		allErrs = append(allErrs, validateDNSHealthCheck(failover.HealthCheck, failoverPath.Child("healthCheck"))...)
+	}
+
+	return allErrs
+}
+
+func validateDNSFailoverValues(values []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(values) == 0 {
+		return append(allErrs, field.Required(fldPath, "at least one value must be provided"))
+	}
+
+	var hostnames int
+	for i, value := range values {
+		if net.ParseIP(value) != nil {
+			continue
+		}
+
+		hostnames++
+		for _, msg := range validation.IsDNS1123Subdomain(value) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), value, msg))
+		}
+	}
+
+	if hostnames > 0 && len(values) > 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, values, "must either be a list of IP addresses or a single hostname"))
+	}
+
+	return allErrs
+}
+
+func validateDNSHealthCheck(healthCheck core.DNSHealthCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if healthCheck.Protocol != nil && !availableDNSHealthCheckProtocols.Has(*healthCheck.Protocol) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), *healthCheck.Protocol, sets.List(availableDNSHealthCheckProtocols)))
+	}
+
+	if healthCheck.Port != nil {
+		for _, msg := range validation.IsValidPortNum(int(*healthCheck.Port)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), *healthCheck.Port, msg))
+		}
+	}
+
+	if healthCheck.Path != nil {
+		if ptr.Deref(healthCheck.Protocol, core.DNSHealthCheckProtocolHTTPS) != core.DNSHealthCheckProtocolHTTPS {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("path"), fmt.Sprintf("path is only supported for protocol %q", core.DNSHealthCheckProtocolHTTPS)))
+		} else if !strings.HasPrefix(*healthCheck.Path, "/") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), *healthCheck.Path, "must be an absolute path"))
+		}
+	}
+
+	if healthCheck.IntervalSeconds != nil && *healthCheck.IntervalSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("intervalSeconds"), *healthCheck.IntervalSeconds, "must be greater than 0"))
+	}
+
+	if healthCheck.FailureThreshold != nil && *healthCheck.FailureThreshold <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failureThreshold"), *healthCheck.FailureThreshold, "must be greater than 0"))
+	}
+
 	return allErrs
 }
 
@@ -1142,6 +1412,102 @@ func validateKubernetes(kubernetes core.Kubernetes, networking *core.Networking,
 		if verticalPodAutoscaler := kubernetes.VerticalPodAutoscaler; verticalPodAutoscaler != nil {
 			allErrs = append(allErrs, ValidateVerticalPodAutoscaler(*verticalPodAutoscaler, fldPath.Child("verticalPodAutoscaler"))...)
 		}
+
+		if workloadDefaults := kubernetes.WorkloadDefaults; workloadDefaults != nil {
+			allErrs = append(allErrs, validateWorkloadDefaults(*workloadDefaults, fldPath.Child("workloadDefaults"))...)
+		}
+	}
+
+	return allErrs
+}
+
+var availableUnsatisfiableConstraintActions = sets.New(
+	string(corev1.DoNotSchedule),
+	string(corev1.ScheduleAnyway),
+)
+
+func validateWorkloadDefaults(workloadDefaults core.WorkloadDefaults, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	topologyKeys := sets.New[string]()
+	for i, constraint := range workloadDefaults.TopologySpreadConstraints {
+		idxPath := fldPath.Child("topologySpreadConstraints").Index(i)
+
+		if constraint.MaxSkew <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxSkew"), constraint.MaxSkew, "must be greater than zero"))
+		}
+		if constraint.MinDomains != nil && *constraint.MinDomains <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("minDomains"), *constraint.MinDomains, "must be greater than zero"))
+		}
+
+		if len(constraint.TopologyKey) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("topologyKey"), "must specify a topology key"))
+		} else {
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(constraint.TopologyKey, idxPath.Child("topologyKey"))...)
+			if topologyKeys.Has(constraint.TopologyKey) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("topologyKey"), constraint.TopologyKey))
+			}
+			topologyKeys.Insert(constraint.TopologyKey)
+		}
+
+		if !availableUnsatisfiableConstraintActions.Has(string(constraint.WhenUnsatisfiable)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("whenUnsatisfiable"), constraint.WhenUnsatisfiable, sets.List(availableUnsatisfiableConstraintActions)))
+		}
+
+		if constraint.LabelSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(constraint.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("labelSelector"))...)
+		}
+
+		for j, key := range constraint.MatchLabelKeys {
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(key, idxPath.Child("matchLabelKeys").Index(j))...)
+		}
+	}
+
+	if affinity := workloadDefaults.Affinity; affinity != nil {
+		affinityPath := fldPath.Child("affinity")
+
+		if affinity.NodeAffinity != nil && affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil &&
+			len(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) == 0 {
+			allErrs = append(allErrs, field.Required(affinityPath.Child("nodeAffinity", "requiredDuringSchedulingIgnoredDuringExecution", "nodeSelectorTerms"), "must have at least one node selector term"))
+		}
+
+		if affinity.PodAffinity != nil {
+			allErrs = append(allErrs, validatePodAffinityTerms(affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution, affinityPath.Child("podAffinity"))...)
+		}
+
+		if affinity.PodAntiAffinity != nil {
+			allErrs = append(allErrs, validatePodAffinityTerms(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, affinityPath.Child("podAntiAffinity"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validatePodAffinityTerms(required []corev1.PodAffinityTerm, preferred []corev1.WeightedPodAffinityTerm, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	validateTerm := func(term corev1.PodAffinityTerm, fldPath *field.Path) {
+		if len(term.TopologyKey) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("topologyKey"), "must specify a topology key"))
+		} else {
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(term.TopologyKey, fldPath.Child("topologyKey"))...)
+		}
+
+		if term.LabelSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(term.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("labelSelector"))...)
+		}
+	}
+
+	for i, term := range required {
+		validateTerm(term, fldPath.Child("requiredDuringSchedulingIgnoredDuringExecution").Index(i))
+	}
+
+	for i, term := range preferred {
+		idxPath := fldPath.Child("preferredDuringSchedulingIgnoredDuringExecution").Index(i)
+		if term.Weight < 1 || term.Weight > 100 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), term.Weight, "must be in the range 1-100"))
+		}
+		validateTerm(term.PodAffinityTerm, idxPath.Child("podAffinityTerm"))
 	}
 
 	return allErrs
@@ -1186,6 +1552,10 @@ func validateKubernetesForWorkerlessShoot(kubernetes core.Kubernetes, fldPath *f
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("verticalPodAutoScaler"), workerlessErrorMsg))
 	}
 
+	if kubernetes.WorkloadDefaults != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("workloadDefaults"), workerlessErrorMsg))
+	}
+
 	return allErrs
 }
 
@@ -1210,6 +1580,9 @@ func validateNetworking(networking *core.Networking, workerless bool, fldPath *f
 		if networking.Nodes != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodes"), workerlessErrorMsg))
 		}
+		if networking.Egress != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("egress"), workerlessErrorMsg))
+		}
 	} else {
 		if networking == nil {
 			allErrs = append(allErrs, field.Required(fldPath, "networking should not be nil for a Shoot with workers"))
@@ -1268,6 +1641,110 @@ func validateNetworking(networking *core.Networking, workerless bool, fldPath *f
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(path, cidr.GetCIDR())...)
 	}
 
+	if networking.Egress != nil && !workerless {
+		allErrs = append(allErrs, ValidateEgress(networking.Egress, fldPath.Child("egress"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateEgress validates the given egress configuration. It is shared with the validation of the extension resources
+// the configuration is passed to.
+func ValidateEgress(egress *core.Egress, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs   = field.ErrorList{}
+		poolNames = sets.New[string]()
+	)
+
+	for i, pool := range egress.IPPools {
+		idxPath := fldPath.Child("ipPools").Index(i)
+
+		if len(pool.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must be provided"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(pool.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), pool.Name, msg))
+			}
+			if poolNames.Has(pool.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), pool.Name))
+			}
+			poolNames.Insert(pool.Name)
+		}
+
+		switch {
+		case pool.Count == nil && len(pool.Addresses) == 0:
+			allErrs = append(allErrs, field.Required(idxPath, "either count or addresses must be provided"))
+		case pool.Count != nil && len(pool.Addresses) > 0:
+			allErrs = append(allErrs, field.Forbidden(idxPath, "count and addresses must not be provided at the same time"))
+		case pool.Count != nil && *pool.Count <= 0:
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("count"), *pool.Count, "must be greater than 0"))
+		}
+
+		addresses := sets.New[string]()
+		for j, address := range pool.Addresses {
+			addressPath := idxPath.Child("addresses").Index(j)
+
+			if addresses.Has(address) {
+				allErrs = append(allErrs, field.Duplicate(addressPath, address))
+				continue
+			}
+			addresses.Insert(address)
+
+			allErrs = append(allErrs, validation.IsValidIP(addressPath, address)...)
+		}
+	}
+
+	for i, selection := range egress.NamespaceSelections {
+		idxPath := fldPath.Child("namespaceSelections").Index(i)
+
+		if len(selection.IPPool) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("ipPool"), "ipPool must be provided"))
+		} else if !poolNames.Has(selection.IPPool) {
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("ipPool"), selection.IPPool))
+		}
+
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&selection.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("namespaceSelector"))...)
+	}
+
+	if egress.Filter != nil {
+		allErrs = append(allErrs, validateEgressFilter(egress.Filter, fldPath.Child("filter"))...)
+	}
+
+	return allErrs
+}
+
+func validateEgressFilter(filter *core.EgressFilter, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs   = field.ErrorList{}
+		allowList = sets.New[string]()
+		denyList  = sets.New[string]()
+	)
+
+	validateList := func(list []string, known sets.Set[string], listPath *field.Path) {
+		for i, c := range list {
+			path := listPath.Index(i)
+
+			if known.Has(c) {
+				allErrs = append(allErrs, field.Duplicate(path, c))
+				continue
+			}
+			known.Insert(c)
+
+			cidr := cidrvalidation.NewCIDR(c, path)
+			allErrs = append(allErrs, cidr.ValidateParse()...)
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(path, cidr.GetCIDR())...)
+		}
+	}
+
+	validateList(filter.AllowList, allowList, fldPath.Child("allowList"))
+	validateList(filter.DenyList, denyList, fldPath.Child("denyList"))
+
+	for i, c := range filter.DenyList {
+		if allowList.Has(c) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("denyList").Index(i), c, "must not be contained in allowList at the same time"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -1840,6 +2317,14 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, kubernetesVe
 		if kubeAPIServer.ServiceAccountConfig.Issuer != nil {
 			allErrs = append(allErrs, ValidateOIDCIssuerURL(*kubeAPIServer.ServiceAccountConfig.Issuer, fldPath.Child("serviceAccountConfig", "issuer"))...)
 		}
+		if projectedTokenExpiration := kubeAPIServer.ServiceAccountConfig.ProjectedTokenExpiration; projectedTokenExpiration != nil {
+			if projectedTokenExpiration.Duration < 10*time.Minute {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("serviceAccountConfig", "projectedTokenExpiration"), *projectedTokenExpiration, "must be at least 10m"))
+			}
+			if projectedTokenExpiration.Duration > 720*time.Hour {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("serviceAccountConfig", "projectedTokenExpiration"), *projectedTokenExpiration, "must be at most 720h (30d)"))
+			}
+		}
 		if len(kubeAPIServer.ServiceAccountConfig.AcceptedIssuers) > 0 && !opts.AllowInvalidAcceptedIssuers {
 			issuers := sets.New[string]()
 			if kubeAPIServer.ServiceAccountConfig.Issuer != nil {
@@ -1879,6 +2364,18 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, kubernetesVe
 		fldPath.Child("autoscaling"))...,
 	)
 
+	if kubeAPIServer.AccessRestrictions != nil {
+		allErrs = append(allErrs, validateAPIServerAccessRestrictions(kubeAPIServer.AccessRestrictions, fldPath.Child("accessRestrictions"))...)
+	}
+
+	if mode := kubeAPIServer.NodeConnectivityMode; mode != nil {
+		if !availableNodeConnectivityModes.Has(string(*mode)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("nodeConnectivityMode"), *mode, sets.List(availableNodeConnectivityModes)))
+		} else if workerless && *mode == core.NodeConnectivityModeKonnectivity {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeConnectivityMode"), workerlessErrorMsg))
+		}
+	}
+
 	allErrs = append(allErrs, featuresvalidation.ValidateFeatureGates(kubeAPIServer.FeatureGates, kubernetesVersion, fldPath.Child("featureGates"))...)
 
 	allErrs = append(allErrs, validateAPIAudiences(kubeAPIServer.APIAudiences, fldPath.Child("apiAudiences"))...)
@@ -1886,6 +2383,27 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, kubernetesVe
 	return allErrs
 }
 
+func validateAPIServerAccessRestrictions(accessRestrictions *core.APIServerAccessRestrictions, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs      = field.ErrorList{}
+		sourceRanges = sets.New[string]()
+	)
+
+	for i, sourceRange := range accessRestrictions.SourceRanges {
+		idxPath := fldPath.Child("sourceRanges").Index(i)
+
+		if sourceRanges.Has(sourceRange) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, sourceRange))
+			continue
+		}
+		sourceRanges.Insert(sourceRange)
+
+		allErrs = append(allErrs, validation.IsValidCIDR(idxPath, sourceRange)...)
+	}
+
+	return allErrs
+}
+
 // ValidateOIDCIssuerURL validates if the given issuerURL follow the expected format.
 func ValidateOIDCIssuerURL(issuerURL string, issuerFldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -1978,6 +2496,23 @@ func ValidateKubeControllerManager(kcm *core.KubeControllerManagerConfig, networ
 				allErrs = append(allErrs, field.Invalid(hpaPath.Child("cpuInitializationPeriod"), *hpa.CPUInitializationPeriod, "cpu initialization period must not be less than a second"))
 			}
 		}
+
+		if nodeEviction := kcm.NodeEviction; nodeEviction != nil {
+			nodeEvictionPath := fldPath.Child("nodeEviction")
+
+			if nodeEviction.Rate != nil && *nodeEviction.Rate <= 0 {
+				allErrs = append(allErrs, field.Invalid(nodeEvictionPath.Child("rate"), *nodeEviction.Rate, "rate must be greater than 0"))
+			}
+			if nodeEviction.SecondaryRate != nil && *nodeEviction.SecondaryRate < 0 {
+				allErrs = append(allErrs, field.Invalid(nodeEvictionPath.Child("secondaryRate"), *nodeEviction.SecondaryRate, "secondary rate must not be negative"))
+			}
+			if nodeEviction.LargeClusterSizeThreshold != nil && *nodeEviction.LargeClusterSizeThreshold <= 0 {
+				allErrs = append(allErrs, field.Invalid(nodeEvictionPath.Child("largeClusterSizeThreshold"), *nodeEviction.LargeClusterSizeThreshold, "large cluster size threshold must be greater than 0"))
+			}
+			if nodeEviction.UnhealthyZoneThreshold != nil && (*nodeEviction.UnhealthyZoneThreshold <= 0 || *nodeEviction.UnhealthyZoneThreshold > 1) {
+				allErrs = append(allErrs, field.Invalid(nodeEvictionPath.Child("unhealthyZoneThreshold"), *nodeEviction.UnhealthyZoneThreshold, "unhealthy zone threshold must be greater than 0 and less than or equal to 1"))
+			}
+		}
 	} else {
 		if kcm.NodeCIDRMaskSize != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeCIDRMaskSize"), workerlessErrorMsg))
@@ -1995,6 +2530,13 @@ func ValidateKubeControllerManager(kcm *core.KubeControllerManagerConfig, networ
 		if kcm.NodeMonitorGracePeriod != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeMonitorGracePeriod"), workerlessErrorMsg))
 		}
+		if kcm.NodeEviction != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeEviction"), workerlessErrorMsg))
+		}
+	}
+
+	if controllerWorkers := kcm.ControllerWorkers; controllerWorkers != nil {
+		allErrs = append(allErrs, validateControllerWorkers(controllerWorkers, workerless, fldPath.Child("controllerWorkers"))...)
 	}
 
 	allErrs = append(allErrs, featuresvalidation.ValidateFeatureGates(kcm.FeatureGates, kubernetesVersion, fldPath.Child("featureGates"))...)
@@ -2002,6 +2544,53 @@ func ValidateKubeControllerManager(kcm *core.KubeControllerManagerConfig, networ
 	return allErrs
 }
 
+func validateControllerWorkers(controllerWorkers *core.ControllerWorkersConfig, workerless bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, workers := range map[string]*int32{
+		"endpoint":         controllerWorkers.Endpoint,
+		"serviceEndpoint":  controllerWorkers.ServiceEndpoint,
+		"garbageCollector": controllerWorkers.GarbageCollector,
+	} {
+		if workers != nil && *workers <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *workers, "number of workers must be greater than 0"))
+		}
+	}
+
+	for name, workers := range map[string]*int32{
+		"deployment":  controllerWorkers.Deployment,
+		"replicaSet":  controllerWorkers.ReplicaSet,
+		"statefulSet": controllerWorkers.StatefulSet,
+	} {
+		if workers == nil {
+			continue
+		}
+		if workerless {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(name), workerlessErrorMsg))
+		} else if *workers <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *workers, "number of workers must be greater than 0"))
+		}
+	}
+
+	// The namespace, resource quota and service account token controllers can only be disabled for workerless shoots.
+	for name, workers := range map[string]*int32{
+		"namespace":           controllerWorkers.Namespace,
+		"resourceQuota":       controllerWorkers.ResourceQuota,
+		"serviceAccountToken": controllerWorkers.ServiceAccountToken,
+	} {
+		if workers == nil {
+			continue
+		}
+		if *workers < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *workers, "number of workers must not be negative"))
+		} else if *workers == 0 && !workerless {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *workers, "controller can only be disabled for workerless shoots"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateAPIAudiences(audiences []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -2032,6 +2621,7 @@ func validateKubeScheduler(ks *core.KubeSchedulerConfig, kubernetesVersion strin
 			}
 		}
 
+		allErrs = append(allErrs, validateSchedulerProfiles(ks.Profiles, kubernetesVersion, fldPath.Child("profiles"))...)
 		allErrs = append(allErrs, validateKubeMaxPDVols(ks.KubeMaxPDVols, kubernetesVersion, fldPath.Child("kubeMaxPDVols"))...)
 		allErrs = append(allErrs, featuresvalidation.ValidateFeatureGates(ks.FeatureGates, kubernetesVersion, fldPath.Child("featureGates"))...)
 	}
@@ -2039,6 +2629,90 @@ func validateKubeScheduler(ks *core.KubeSchedulerConfig, kubernetesVersion strin
 	return allErrs
 }
 
+func validateSchedulerProfiles(profiles []core.SchedulerProfile, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	schedulerNames := sets.New[string]()
+
+	for i, profile := range profiles {
+		idxPath := fldPath.Index(i)
+
+		if profile.SchedulerName == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("schedulerName"), "scheduler name must be provided"))
+		} else if reservedSchedulerNames.Has(profile.SchedulerName) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("schedulerName"), fmt.Sprintf("scheduler name is reserved for profiles managed by Gardener: %s", strings.Join(sets.List(reservedSchedulerNames), ", "))))
+		} else if schedulerNames.Has(profile.SchedulerName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("schedulerName"), profile.SchedulerName))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(profile.SchedulerName) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("schedulerName"), profile.SchedulerName, msg))
+			}
+		}
+		schedulerNames.Insert(profile.SchedulerName)
+
+		if profile.Plugins == nil {
+			continue
+		}
+
+		pluginsPath := idxPath.Child("plugins")
+		allErrs = append(allErrs, validateSchedulerPluginSet(profile.Plugins.Filter, availableFilterSchedulerPlugins, false, kubernetesVersion, pluginsPath.Child("filter"))...)
+		allErrs = append(allErrs, validateSchedulerPluginSet(profile.Plugins.Score, availableScoreSchedulerPlugins, true, kubernetesVersion, pluginsPath.Child("score"))...)
+	}
+
+	return allErrs
+}
+
+func validateSchedulerPluginSet(pluginSet *core.SchedulerPluginSet, availablePlugins map[string]*versionutils.Constraints, weighted bool, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if pluginSet == nil {
+		return allErrs
+	}
+
+	validatePlugin := func(plugin core.SchedulerPlugin, fldPath *field.Path) {
+		constraint, ok := availablePlugins[plugin.Name]
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("name"), plugin.Name, slices.Sorted(maps.Keys(availablePlugins))))
+		} else if constraint != nil && !constraint.CheckVersion(kubernetesVersion) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), fmt.Sprintf("plugin %q is not supported for Kubernetes version %s", plugin.Name, kubernetesVersion)))
+		}
+	}
+
+	enabledPlugins := sets.New[string]()
+	for i, plugin := range pluginSet.Enabled {
+		idxPath := fldPath.Child("enabled").Index(i)
+
+		validatePlugin(plugin, idxPath)
+
+		if enabledPlugins.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		enabledPlugins.Insert(plugin.Name)
+
+		if plugin.Weight != nil {
+			if !weighted {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("weight"), "weight is only applicable for score plugins"))
+			} else if *plugin.Weight < 1 || *plugin.Weight > 100 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), *plugin.Weight, "weight must be between 1 and 100"))
+			}
+		}
+	}
+
+	for i, plugin := range pluginSet.Disabled {
+		idxPath := fldPath.Child("disabled").Index(i)
+
+		if plugin.Name != "*" {
+			validatePlugin(plugin, idxPath)
+		}
+
+		if plugin.Weight != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("weight"), "weight must not be set for disabled plugins"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateKubeMaxPDVols(kubeMaxPDVols *string, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -2091,6 +2765,86 @@ func validateMonitoring(monitoring *core.Monitoring, fldPath *field.Path) field.
 	return allErrs
 }
 
+var (
+	cleanupWebhooksKinds = sets.New(
+		"admissionregistration.k8s.io/MutatingWebhookConfiguration",
+		"admissionregistration.k8s.io/ValidatingWebhookConfiguration",
+	)
+	cleanupExtendedAPIsKinds = sets.New(
+		"apiextensions.k8s.io/CustomResourceDefinition",
+		"apiregistration.k8s.io/APIService",
+	)
+	cleanupKubernetesResourcesKinds = sets.New(
+		"apps/DaemonSet",
+		"apps/Deployment",
+		"apps/ReplicaSet",
+		"apps/StatefulSet",
+		"batch/CronJob",
+		"batch/Job",
+		"networking.k8s.io/Ingress",
+		"PersistentVolumeClaim",
+		"Pod",
+		"ReplicationController",
+		"Service",
+	)
+	cleanupVolumeSnapshotsKinds = sets.New(
+		"snapshot.storage.k8s.io/VolumeSnapshot",
+		"snapshot.storage.k8s.io/VolumeSnapshotContent",
+	)
+
+	// maxCleanupFinalizeGracePeriod is the maximum finalize grace period for the cleanup of shoot resources.
+	maxCleanupFinalizeGracePeriod = 24 * time.Hour
+)
+
+func validateCleanup(cleanup *core.Cleanup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cleanup == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateCleanupPolicy(cleanup.Webhooks, cleanupWebhooksKinds, fldPath.Child("webhooks"))...)
+	allErrs = append(allErrs, validateCleanupPolicy(cleanup.ExtendedAPIs, cleanupExtendedAPIsKinds, fldPath.Child("extendedAPIs"))...)
+	allErrs = append(allErrs, validateCleanupPolicy(cleanup.KubernetesResources, cleanupKubernetesResourcesKinds, fldPath.Child("kubernetesResources"))...)
+	allErrs = append(allErrs, validateCleanupPolicy(cleanup.VolumeSnapshots, cleanupVolumeSnapshotsKinds, fldPath.Child("volumeSnapshots"))...)
+
+	return allErrs
+}
+
+func validateCleanupPolicy(policy *core.CleanupPolicy, supportedKinds sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy == nil {
+		return allErrs
+	}
+
+	if policy.FinalizeGracePeriod != nil {
+		if policy.FinalizeGracePeriod.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("finalizeGracePeriod"), policy.FinalizeGracePeriod.Duration.String(), "must not be negative"))
+		} else if policy.FinalizeGracePeriod.Duration > maxCleanupFinalizeGracePeriod {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("finalizeGracePeriod"), policy.FinalizeGracePeriod.Duration.String(), fmt.Sprintf("must not be greater than %s", maxCleanupFinalizeGracePeriod)))
+		}
+	}
+
+	kinds := sets.New[string]()
+	for i, groupKind := range policy.ForceFinalize {
+		idxPath := fldPath.Child("forceFinalize").Index(i)
+		kind := groupKind.Kind
+		if groupKind.Group != "" {
+			kind = groupKind.Group + "/" + groupKind.Kind
+		}
+
+		if !supportedKinds.Has(kind) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, kind, sets.List(supportedKinds)))
+		} else if kinds.Has(kind) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, kind))
+		}
+		kinds.Insert(kind)
+	}
+
+	return allErrs
+}
+
 func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	emails := sets.New[string]()
@@ -2142,20 +2896,27 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 	}
 
 	if maintenance.TimeWindow != nil {
-		maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
-		if err != nil {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow", "begin/end"), maintenance.TimeWindow, err.Error()))
-		} else {
-			duration := maintenanceTimeWindow.Duration()
-			if duration > core.MaintenanceTimeWindowDurationMaximum {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow"), duration, fmt.Sprintf("time window must not be greater than %s", core.MaintenanceTimeWindowDurationMaximum)))
-				return allErrs
-			}
-			if duration < core.MaintenanceTimeWindowDurationMinimum {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow"), duration, fmt.Sprintf("time window must not be smaller than %s", core.MaintenanceTimeWindowDurationMinimum)))
-				return allErrs
-			}
-		}
+		allErrs = append(allErrs, validateMaintenanceTimeWindow(maintenance.TimeWindow, fldPath.Child("timeWindow"))...)
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceTimeWindow(timeWindow *core.MaintenanceTimeWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(timeWindow.Begin, timeWindow.End)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("begin/end"), timeWindow, err.Error()))
+		return allErrs
+	}
+
+	duration := maintenanceTimeWindow.Duration()
+	if duration > core.MaintenanceTimeWindowDurationMaximum {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration, fmt.Sprintf("time window must not be greater than %s", core.MaintenanceTimeWindowDurationMaximum)))
+	}
+	if duration < core.MaintenanceTimeWindowDurationMinimum {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration, fmt.Sprintf("time window must not be smaller than %s", core.MaintenanceTimeWindowDurationMinimum)))
 	}
 
 	return allErrs
@@ -2306,12 +3067,7 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, shootNamespa
 	}
 
 	if worker.Volume != nil {
-		if worker.Volume.Name != nil {
-			allErrs = append(allErrs, validateVolumeName(*worker.Volume.Name, fldPath.Child("volume", "name"))...)
-		}
-		if !volumeSizeRegex.MatchString(worker.Volume.VolumeSize) {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("volume", "size"), worker.Volume.VolumeSize, fmt.Sprintf("volume size must match the regex %s", volumeSizeRegex)))
-		}
+		allErrs = append(allErrs, validateWorkerVolume(worker.Volume, fldPath.Child("volume"))...)
 	}
 
 	if worker.DataVolumes != nil {
@@ -2383,6 +3139,33 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, shootNamespa
 		allErrs = append(allErrs, ValidateSysctls(worker.Sysctls, fldPath.Child("sysctls"))...)
 	}
 
+	if worker.Accelerators != nil {
+		allErrs = append(allErrs, validateWorkerAccelerators(worker.Accelerators, fldPath.Child("accelerators"))...)
+	}
+
+	return allErrs
+}
+
+func validateWorkerAccelerators(accelerators *core.WorkerAccelerators, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableAcceleratorVendors.Has(accelerators.Vendor) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("vendor"), accelerators.Vendor, sets.List(availableAcceleratorVendors)))
+	}
+
+	return allErrs
+}
+
+func validateWorkerVolume(volume *core.Volume, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if volume.Name != nil {
+		allErrs = append(allErrs, validateVolumeName(*volume.Name, fldPath.Child("name"))...)
+	}
+	if !volumeSizeRegex.MatchString(volume.VolumeSize) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("size"), volume.VolumeSize, fmt.Sprintf("volume size must match the regex %s", volumeSizeRegex)))
+	}
+
 	return allErrs
 }
 
@@ -2454,6 +3237,13 @@ func ValidateClusterAutoscalerOptions(caOptions *core.ClusterAutoscalerOptions,
 	if maxNodeProvisionTime := caOptions.MaxNodeProvisionTime; maxNodeProvisionTime != nil && maxNodeProvisionTime.Duration < 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxNodeProvisionTime"), *maxNodeProvisionTime, "can not be negative"))
 	}
+	for resource, quantity := range caOptions.CapacityHints {
+		resourcePath := fldPath.Child("capacityHints", resource.String())
+		for _, msg := range validation.IsQualifiedName(resource.String()) {
+			allErrs = append(allErrs, field.Invalid(resourcePath, resource, msg))
+		}
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(resource.String(), quantity, resourcePath)...)
+	}
 
 	return allErrs
 }
@@ -3082,6 +3872,7 @@ func ValidateSystemComponents(systemComponents *core.SystemComponents, fldPath *
 	}
 
 	allErrs = append(allErrs, validateCoreDNS(systemComponents.CoreDNS, fldPath.Child("coreDNS"))...)
+	allErrs = append(allErrs, validateVolumeSnapshots(systemComponents.VolumeSnapshots, fldPath.Child("volumeSnapshots"))...)
 
 	return allErrs
 }
@@ -3104,6 +3895,101 @@ func validateCoreDNS(coreDNS *core.CoreDNS, fldPath *field.Path) field.ErrorList
 	return allErrs
 }
 
+var availableVolumeSnapshotDeletionPolicies = sets.New(
+	string(core.VolumeSnapshotDeletionPolicyDelete),
+	string(core.VolumeSnapshotDeletionPolicyRetain),
+)
+
+// validateVolumeSnapshots validates the given volume snapshot settings.
+func validateVolumeSnapshots(volumeSnapshots *core.VolumeSnapshots, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if volumeSnapshots == nil {
+		return allErrs
+	}
+
+	var (
+		classNames            = sets.New[string]()
+		driversWithDefaultSet = sets.New[string]()
+	)
+
+	for i, class := range volumeSnapshots.Classes {
+		idxPath := fldPath.Child("classes").Index(i)
+
+		if len(class.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must be provided"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(class.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), class.Name, msg))
+			}
+			if classNames.Has(class.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), class.Name))
+			}
+			classNames.Insert(class.Name)
+		}
+
+		if len(class.Driver) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("driver"), "driver must be provided"))
+		}
+
+		if class.DeletionPolicy != nil && !availableVolumeSnapshotDeletionPolicies.Has(string(*class.DeletionPolicy)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("deletionPolicy"), *class.DeletionPolicy, sets.List(availableVolumeSnapshotDeletionPolicies)))
+		}
+
+		if ptr.Deref(class.Default, false) {
+			if driversWithDefaultSet.Has(class.Driver) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("default"), fmt.Sprintf("only one default class is allowed per driver, but there are multiple for driver %q", class.Driver)))
+			}
+			driversWithDefaultSet.Insert(class.Driver)
+		}
+	}
+
+	policyNames := sets.New[string]()
+	for i, policy := range volumeSnapshots.Policies {
+		idxPath := fldPath.Child("policies").Index(i)
+
+		if len(policy.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must be provided"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(policy.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), policy.Name, msg))
+			}
+			if policyNames.Has(policy.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), policy.Name))
+			}
+			policyNames.Insert(policy.Name)
+		}
+
+		if _, err := cron.ParseStandard(policy.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("schedule"), policy.Schedule, fmt.Sprintf("not a valid cron spec: %v", err)))
+		}
+
+		if len(policy.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "namespace must be provided"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(policy.Namespace) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("namespace"), policy.Namespace, msg))
+			}
+		}
+
+		if policy.Selector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(policy.Selector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("selector"))...)
+		}
+
+		if policy.VolumeSnapshotClassName != nil {
+			for _, msg := range validation.IsDNS1123Subdomain(*policy.VolumeSnapshotClassName) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("volumeSnapshotClassName"), *policy.VolumeSnapshotClassName, msg))
+			}
+		}
+
+		if policy.Retention != nil && *policy.Retention < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("retention"), *policy.Retention, "retention must be at least 1"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateFinalizersOnCreation validates the finalizers of a Shoot object.
 func ValidateFinalizersOnCreation(finalizers []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -803,6 +803,69 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Field": Equal("spec.addons.nginxIngress.externalTrafficPolicy"),
 				}))))
 			})
+
+			It("should allow valid load balancer annotations for nginx-ingress", func() {
+				shoot.Spec.Addons.NginxIngress.LoadBalancerAnnotations = map[string]string{"service.beta.kubernetes.io/aws-load-balancer-type": "nlb"}
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid load balancer annotations for nginx-ingress", func() {
+				shoot.Spec.Addons.NginxIngress.LoadBalancerAnnotations = map[string]string{"no/slash/allowed": "foo"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.addons.nginxIngress.loadBalancerAnnotations"),
+				}))))
+			})
+
+			It("should allow valid autoscaling settings for nginx-ingress", func() {
+				shoot.Spec.Addons.NginxIngress.Autoscaling = &core.NginxIngressAutoscaling{
+					MinReplicas:                    ptr.To[int32](2),
+					MaxReplicas:                    4,
+					TargetCPUUtilizationPercentage: ptr.To[int32](70),
+				}
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid autoscaling settings for nginx-ingress", func() {
+				shoot.Spec.Addons.NginxIngress.Autoscaling = &core.NginxIngressAutoscaling{
+					MinReplicas:                    ptr.To[int32](0),
+					MaxReplicas:                    -1,
+					TargetCPUUtilizationPercentage: ptr.To[int32](101),
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.addons.nginxIngress.autoscaling.minReplicas"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.addons.nginxIngress.autoscaling.maxReplicas"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.addons.nginxIngress.autoscaling.targetCPUUtilizationPercentage"),
+					})),
+				))
+			})
+
+			It("should forbid maxReplicas lower than the default minReplicas for nginx-ingress", func() {
+				shoot.Spec.Addons.NginxIngress.Autoscaling = &core.NginxIngressAutoscaling{MaxReplicas: 0}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.addons.nginxIngress.autoscaling.maxReplicas"),
+				}))))
+			})
 		})
 
 		It("should forbid unsupported specification (provider independent)", func() {
@@ -1982,6 +2045,21 @@ var _ = Describe("Shoot Validation Tests", func() {
 					Entry("invalid negative MaxNodeProvisionTime", core.ClusterAutoscalerOptions{
 						MaxNodeProvisionTime: ptr.To(negativeDuration),
 					}, ConsistOf(field.Invalid(field.NewPath("maxNodeProvisionTime"), negativeDuration, "can not be negative"))),
+					Entry("valid with CapacityHints", core.ClusterAutoscalerOptions{
+						CapacityHints: corev1.ResourceList{"cpu": resource.MustParse("4"), "nvidia.com/gpu": resource.MustParse("1")},
+					}, BeEmpty()),
+					Entry("invalid negative CapacityHints", core.ClusterAutoscalerOptions{
+						CapacityHints: corev1.ResourceList{"cpu": resource.MustParse("-4")},
+					}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("capacityHints.cpu"),
+					})))),
+					Entry("invalid CapacityHints resource name", core.ClusterAutoscalerOptions{
+						CapacityHints: corev1.ResourceList{"foo/bar/baz": resource.MustParse("1")},
+					}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("capacityHints.foo/bar/baz"),
+					})))),
 				)
 			})
 		})
@@ -2261,6 +2339,88 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))))
 			})
 
+			Context("apiServerRecord", func() {
+				It("should allow a valid API server record configuration", func() {
+					shoot.Spec.DNS.APIServerRecord = &core.DNSAPIServerRecord{
+						TTLs: []core.DNSRecordTTL{{RecordType: "A", Seconds: 60}, {RecordType: "CNAME", Seconds: 300}},
+						Failover: &core.DNSFailover{
+							Values: []string{"1.2.3.4", "2001:db8::1"},
+							HealthCheck: core.DNSHealthCheck{
+								Protocol: ptr.To(core.DNSHealthCheckProtocolHTTPS),
+								Port:     ptr.To[int32](443),
+								Path:     ptr.To("/healthz"),
+							},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid invalid TTLs", func() {
+					shoot.Spec.DNS.APIServerRecord = &core.DNSAPIServerRecord{
+						TTLs: []core.DNSRecordTTL{{RecordType: "A", Seconds: 60}, {RecordType: "A", Seconds: 60}, {RecordType: "TXT", Seconds: 60}, {RecordType: "AAAA", Seconds: 0}},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.dns.apiServerRecord.ttls[1].recordType"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.dns.apiServerRecord.ttls[2].recordType"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.apiServerRecord.ttls[3].seconds"),
+					}))
+				})
+
+				It("should forbid an invalid failover configuration", func() {
+					shoot.Spec.DNS.APIServerRecord = &core.DNSAPIServerRecord{
+						Failover: &core.DNSFailover{
+							Values: []string{"1.2.3.4", "foo_bar"},
+							HealthCheck: core.DNSHealthCheck{
+								Protocol:         ptr.To(core.DNSHealthCheckProtocolTCP),
+								Port:             ptr.To[int32](0),
+								Path:             ptr.To("/healthz"),
+								IntervalSeconds:  ptr.To[int32](0),
+								FailureThreshold: ptr.To[int32](-1),
+							},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.apiServerRecord.failover.values[1]"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.apiServerRecord.failover.values"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.apiServerRecord.failover.healthCheck.port"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.dns.apiServerRecord.failover.healthCheck.path"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.apiServerRecord.failover.healthCheck.intervalSeconds"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.apiServerRecord.failover.healthCheck.failureThreshold"),
+					}))
+				})
+
+				It("should forbid failover without a managed primary DNS provider", func() {
+					shoot.Spec.DNS.Providers = nil
+					shoot.Spec.DNS.APIServerRecord = &core.DNSAPIServerRecord{
+						Failover: &core.DNSFailover{Values: []string{"1.2.3.4"}},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.dns.apiServerRecord.failover"),
+					}))
+				})
+			})
+
 			It("should allow WorkloadIdentity credentials", func() {
 				shoot.Spec.DNS.Providers[0].CredentialsRef = &dnsWorkloadIdentityRef
 
@@ -3286,6 +3446,79 @@ var _ = Describe("Shoot Validation Tests", func() {
 					}))))
 				})
 
+				It("should allow valid projected token expirations", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
+						ProjectedTokenExpiration: &metav1.Duration{Duration: time.Hour},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				DescribeTable("should forbid invalid projected token expirations",
+					func(duration time.Duration, detail string) {
+						shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
+							ProjectedTokenExpiration: &metav1.Duration{Duration: duration},
+						}
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.kubernetes.kubeAPIServer.serviceAccountConfig.projectedTokenExpiration"),
+							"Detail": Equal(detail),
+						}))))
+					},
+					Entry("too low", 5*time.Minute, "must be at least 10m"),
+					Entry("too high", 800*time.Hour, "must be at most 720h (30d)"),
+				)
+
+				Context("issuer update", func() {
+					BeforeEach(func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
+							Issuer: ptr.To("https://old.issuer.example"),
+						}
+					})
+
+					It("should forbid changing the issuer without accepting the previous issuer", func() {
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig.Issuer = ptr.To("https://new.issuer.example")
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.serviceAccountConfig.issuer"),
+						}))))
+					})
+
+					It("should forbid removing the issuer without accepting the previous issuer", func() {
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = nil
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.serviceAccountConfig.issuer"),
+						}))))
+					})
+
+					It("should allow changing the issuer when accepting the previous issuer", func() {
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
+							Issuer:          ptr.To("https://new.issuer.example"),
+							AcceptedIssuers: []string{"https://old.issuer.example"},
+						}
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+					})
+
+					It("should allow removing the previous issuer from the accepted issuers afterwards", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
+							Issuer:          ptr.To("https://new.issuer.example"),
+							AcceptedIssuers: []string{"https://old.issuer.example"},
+						}
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig.AcceptedIssuers = nil
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+					})
+				})
+
 				It("should allow invalid accepted issuer URLs to be updated with valid ones on shoot update", func() {
 					shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
 						AcceptedIssuers: []string{"http://issuer.com"},
@@ -3361,6 +3594,63 @@ var _ = Describe("Shoot Validation Tests", func() {
 				})
 			})
 
+			Context("access restrictions", func() {
+				It("should allow valid source ranges", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.AccessRestrictions = &core.APIServerAccessRestrictions{
+						SourceRanges: []string{"10.0.0.0/8", "2001:db8::/32"},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid invalid and duplicate source ranges", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.AccessRestrictions = &core.APIServerAccessRestrictions{
+						SourceRanges: []string{"10.0.0.0/8", "foo", "10.0.0.0/8"},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.accessRestrictions.sourceRanges[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.accessRestrictions.sourceRanges[2]"),
+						})),
+					))
+				})
+			})
+
+			Context("node connectivity mode", func() {
+				It("should allow supported node connectivity modes", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.NodeConnectivityMode = ptr.To(core.NodeConnectivityModeKonnectivity)
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+
+					shoot.Spec.Kubernetes.KubeAPIServer.NodeConnectivityMode = ptr.To(core.NodeConnectivityModeVPN)
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid unsupported node connectivity modes", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.NodeConnectivityMode = ptr.To(core.NodeConnectivityMode("foo"))
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.nodeConnectivityMode"),
+					}))))
+				})
+
+				It("should forbid the Konnectivity mode for workerless shoots", func() {
+					shoot.Spec.Provider.Workers = []core.Worker{}
+					shoot.Spec.Kubernetes.KubeAPIServer.NodeConnectivityMode = ptr.To(core.NodeConnectivityModeKonnectivity)
+
+					Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.kubernetes.kubeAPIServer.nodeConnectivityMode"),
+						"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
+					}))))
+				})
+			})
+
 			It("should not allow to specify a negative event ttl duration", func() {
 				shoot.Spec.Kubernetes.KubeAPIServer.EventTTL = &metav1.Duration{Duration: -1}
 
@@ -3517,6 +3807,54 @@ var _ = Describe("Shoot Validation Tests", func() {
 						"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
 					}))))
 				})
+
+				It("should prevent setting nodeEviction", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager.NodeEviction = &core.NodeEvictionConfig{Rate: ptr.To(0.5)}
+
+					errorList := ValidateShoot(shoot)
+					Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.kubernetes.kubeControllerManager.nodeEviction"),
+						"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
+					}))))
+				})
+
+				It("should prevent setting workers for workload controllers", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager.ControllerWorkers = &core.ControllerWorkersConfig{
+						Deployment:  ptr.To[int32](10),
+						ReplicaSet:  ptr.To[int32](10),
+						StatefulSet: ptr.To[int32](10),
+					}
+
+					errorList := ValidateShoot(shoot)
+					Expect(errorList).To(ContainElements(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeControllerManager.controllerWorkers.deployment"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeControllerManager.controllerWorkers.replicaSet"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeControllerManager.controllerWorkers.statefulSet"),
+						})),
+					))
+				})
+
+				It("should allow disabling the namespace, resource quota and service account token controllers", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager.ControllerWorkers = &core.ControllerWorkersConfig{
+						Namespace:           ptr.To[int32](0),
+						ResourceQuota:       ptr.To[int32](0),
+						ServiceAccountToken: ptr.To[int32](0),
+					}
+
+					errorList := ValidateShoot(shoot)
+					Expect(errorList).NotTo(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Field": HavePrefix("spec.kubernetes.kubeControllerManager.controllerWorkers"),
+					}))))
+				})
 			})
 
 			It("should forbid unsupported HPA configuration", func() {
@@ -3843,6 +4181,90 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Field": Equal("spec.kubernetes.kubeControllerManager.nodeMonitorGracePeriod"),
 				}))))
 			})
+
+			It("should allow valid controller workers and node eviction settings", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager.ControllerWorkers = &core.ControllerWorkersConfig{
+					Deployment:          ptr.To[int32](20),
+					ReplicaSet:          ptr.To[int32](20),
+					StatefulSet:         ptr.To[int32](5),
+					Endpoint:            ptr.To[int32](5),
+					ServiceEndpoint:     ptr.To[int32](5),
+					GarbageCollector:    ptr.To[int32](10),
+					Namespace:           ptr.To[int32](10),
+					ResourceQuota:       ptr.To[int32](5),
+					ServiceAccountToken: ptr.To[int32](5),
+				}
+				shoot.Spec.Kubernetes.KubeControllerManager.NodeEviction = &core.NodeEvictionConfig{
+					Rate:                      ptr.To(0.2),
+					SecondaryRate:             ptr.To(0.0),
+					LargeClusterSizeThreshold: ptr.To[int32](100),
+					UnhealthyZoneThreshold:    ptr.To(0.7),
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should prevent setting invalid controller workers", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager.ControllerWorkers = &core.ControllerWorkersConfig{
+					Deployment:          ptr.To[int32](0),
+					Endpoint:            ptr.To[int32](-1),
+					Namespace:           ptr.To[int32](0),
+					ServiceAccountToken: ptr.To[int32](-1),
+				}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.kubernetes.kubeControllerManager.controllerWorkers.deployment"),
+						"Detail": Equal("number of workers must be greater than 0"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.kubernetes.kubeControllerManager.controllerWorkers.endpoint"),
+						"Detail": Equal("number of workers must be greater than 0"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.kubernetes.kubeControllerManager.controllerWorkers.namespace"),
+						"Detail": Equal("controller can only be disabled for workerless shoots"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.kubernetes.kubeControllerManager.controllerWorkers.serviceAccountToken"),
+						"Detail": Equal("number of workers must not be negative"),
+					})),
+				))
+			})
+
+			It("should prevent setting invalid node eviction settings", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager.NodeEviction = &core.NodeEvictionConfig{
+					Rate:                      ptr.To(0.0),
+					SecondaryRate:             ptr.To(-0.1),
+					LargeClusterSizeThreshold: ptr.To[int32](0),
+					UnhealthyZoneThreshold:    ptr.To(1.5),
+				}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeControllerManager.nodeEviction.rate"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeControllerManager.nodeEviction.secondaryRate"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeControllerManager.nodeEviction.largeClusterSizeThreshold"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeControllerManager.nodeEviction.unhealthyZoneThreshold"),
+					})),
+				))
+			})
 		})
 
 		Context("KubeScheduler validation", func() {
@@ -3933,6 +4355,131 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Detail":   Equal("must be positive"),
 				}))))
 			})
+
+			Context("additional profiles", func() {
+				It("should succeed when setting valid profiles", func() {
+					shoot.Spec.Kubernetes.KubeScheduler.Profiles = []core.SchedulerProfile{
+						{SchedulerName: "no-scoring"},
+						{
+							SchedulerName: "image-locality",
+							Plugins: &core.SchedulerPlugins{
+								Filter: &core.SchedulerPluginSet{
+									Disabled: []core.SchedulerPlugin{{Name: "NodePorts"}},
+								},
+								Score: &core.SchedulerPluginSet{
+									Enabled:  []core.SchedulerPlugin{{Name: "ImageLocality", Weight: ptr.To[int32](10)}},
+									Disabled: []core.SchedulerPlugin{{Name: "*"}},
+								},
+							},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should fail when using invalid, reserved or duplicate scheduler names", func() {
+					shoot.Spec.Kubernetes.KubeScheduler.Profiles = []core.SchedulerProfile{
+						{SchedulerName: ""},
+						{SchedulerName: "default-scheduler"},
+						{SchedulerName: "bin-packing-scheduler"},
+						{SchedulerName: "Foo_Bar"},
+						{SchedulerName: "foo"},
+						{SchedulerName: "foo"},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].schedulerName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[1].schedulerName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[2].schedulerName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[3].schedulerName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[5].schedulerName"),
+						})),
+					))
+				})
+
+				It("should fail when configuring invalid plugins", func() {
+					shoot.Spec.Kubernetes.KubeScheduler.Profiles = []core.SchedulerProfile{{
+						SchedulerName: "foo",
+						Plugins: &core.SchedulerPlugins{
+							Filter: &core.SchedulerPluginSet{
+								Enabled:  []core.SchedulerPlugin{{Name: "NodeAffinity", Weight: ptr.To[int32](1)}, {Name: "ImageLocality"}},
+								Disabled: []core.SchedulerPlugin{{Name: "Foo"}},
+							},
+							Score: &core.SchedulerPluginSet{
+								Enabled:  []core.SchedulerPlugin{{Name: "NodeAffinity", Weight: ptr.To[int32](0)}, {Name: "NodeAffinity"}},
+								Disabled: []core.SchedulerPlugin{{Name: "TaintToleration", Weight: ptr.To[int32](1)}},
+							},
+						},
+					}}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].plugins.filter.enabled[0].weight"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].plugins.filter.enabled[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].plugins.filter.disabled[0].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].plugins.score.enabled[0].weight"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].plugins.score.enabled[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].plugins.score.disabled[0].weight"),
+						})),
+					))
+				})
+
+				It("should only allow plugins supported by the Kubernetes version", func() {
+					shoot.Spec.Kubernetes.KubeScheduler.Profiles = []core.SchedulerProfile{{
+						SchedulerName: "foo",
+						Plugins: &core.SchedulerPlugins{
+							Filter: &core.SchedulerPluginSet{
+								Enabled: []core.SchedulerPlugin{{Name: "DynamicResources"}},
+							},
+						},
+					}}
+
+					shoot.Spec.Kubernetes.Version = "1.33.0"
+					Expect(ValidateShoot(shoot)).To(ContainElement(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeScheduler.profiles[0].plugins.filter.enabled[0].name"),
+						})),
+					))
+
+					shoot.Spec.Kubernetes.Version = "1.34.0"
+					Expect(ValidateShoot(shoot)).NotTo(ContainElement(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Field": HavePrefix("spec.kubernetes.kubeScheduler"),
+						})),
+					))
+				})
+			})
 		})
 
 		Context("KubeProxy validation", func() {
@@ -4453,11 +5000,173 @@ var _ = Describe("Shoot Validation Tests", func() {
 							"cpu":    resource.MustParse("8"),
 							"memory": resource.MustParse("32Gi"),
 						},
-						RecommenderUpdateWorkerCount: ptr.To[int64](42),
+						RecommenderUpdateWorkerCount: ptr.To[int64](42),
+					},
+					BeEmpty(),
+				),
+			)
+		})
+
+		Context("image overrides annotation", func() {
+			It("should allow valid image overrides", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootImageOverrides, "kube-apiserver=registry.example.com/kube-apiserver:v1.33.1-hotfix")
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid image overrides", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootImageOverrides, "kube-apiserver")
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.gardener.cloud/image-overrides]"),
+				}))))
+			})
+		})
+
+		Context("monitoring retention annotation", func() {
+			It("should allow a valid monitoring retention", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootMonitoringRetention, "7d")
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			DescribeTable("should forbid invalid monitoring retentions",
+				func(value string) {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootMonitoringRetention, value)
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("metadata.annotations[shoot.gardener.cloud/monitoring-retention]"),
+					}))))
+				},
+
+				Entry("no duration", "foo"),
+				Entry("zero duration", "0d"),
+			)
+		})
+
+		Context("WorkloadDefaults validation", func() {
+			It("should allow valid workload defaults", func() {
+				shoot.Spec.Kubernetes.WorkloadDefaults = &core.WorkloadDefaults{
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+						MaxSkew:           1,
+						TopologyKey:       corev1.LabelTopologyZone,
+						WhenUnsatisfiable: corev1.ScheduleAnyway,
+						MatchLabelKeys:    []string{"pod-template-hash"},
+					}},
+					Affinity: &corev1.Affinity{
+						PodAntiAffinity: &corev1.PodAntiAffinity{
+							PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+								Weight:          100,
+								PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: corev1.LabelHostname},
+							}},
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should prevent setting workload defaults for workerless shoots", func() {
+				shoot.Spec.Provider.Workers = []core.Worker{}
+				shoot.Spec.Kubernetes.WorkloadDefaults = &core.WorkloadDefaults{}
+
+				Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.kubernetes.workloadDefaults"),
+					"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
+				}))))
+			})
+
+			It("should forbid invalid topology spread constraints", func() {
+				shoot.Spec.Kubernetes.WorkloadDefaults = &core.WorkloadDefaults{
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{
+							MaxSkew:           0,
+							TopologyKey:       corev1.LabelTopologyZone,
+							WhenUnsatisfiable: "Foo",
+							MinDomains:        ptr.To[int32](0),
+						},
+						{
+							MaxSkew:           1,
+							TopologyKey:       corev1.LabelTopologyZone,
+							WhenUnsatisfiable: corev1.DoNotSchedule,
+							LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar?"}},
+						},
+						{
+							MaxSkew:           1,
+							WhenUnsatisfiable: corev1.DoNotSchedule,
+							MatchLabelKeys:    []string{"foo/bar/baz"},
+						},
 					},
-					BeEmpty(),
-				),
-			)
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.workloadDefaults.topologySpreadConstraints[0].maxSkew"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.workloadDefaults.topologySpreadConstraints[0].minDomains"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.kubernetes.workloadDefaults.topologySpreadConstraints[0].whenUnsatisfiable"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.kubernetes.workloadDefaults.topologySpreadConstraints[1].topologyKey"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.workloadDefaults.topologySpreadConstraints[1].labelSelector.matchLabels"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.workloadDefaults.topologySpreadConstraints[2].topologyKey"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.workloadDefaults.topologySpreadConstraints[2].matchLabelKeys[0]"),
+					})),
+				))
+			})
+
+			It("should forbid invalid affinities", func() {
+				shoot.Spec.Kubernetes.WorkloadDefaults = &core.WorkloadDefaults{
+					Affinity: &corev1.Affinity{
+						NodeAffinity: &corev1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{},
+						},
+						PodAffinity: &corev1.PodAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{}},
+						},
+						PodAntiAffinity: &corev1.PodAntiAffinity{
+							PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+								Weight:          101,
+								PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: corev1.LabelHostname},
+							}},
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.workloadDefaults.affinity.nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.workloadDefaults.affinity.podAffinity.requiredDuringSchedulingIgnoredDuringExecution[0].topologyKey"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.workloadDefaults.affinity.podAntiAffinity.preferredDuringSchedulingIgnoredDuringExecution[0].weight"),
+					})),
+				))
+			})
 		})
 
 		Context("AuditConfig validation", func() {
@@ -4834,7 +5543,7 @@ var _ = Describe("Shoot Validation Tests", func() {
 
 		Context("networking section", func() {
 			Context("Workerless Shoots", func() {
-				It("should forbid setting networking.type, networking.providerConfig, networking.pods, networking.nodes", func() {
+				It("should forbid setting networking.type, networking.providerConfig, networking.pods, networking.nodes, networking.egress", func() {
 					shoot.Spec.Provider.Workers = nil
 					shoot.Spec.SecretBindingName = nil
 					shoot.Spec.Addons = nil
@@ -4848,6 +5557,7 @@ var _ = Describe("Shoot Validation Tests", func() {
 						Nodes:      ptr.To("0.0.0.0/0"),
 						Services:   ptr.To("0.0.0.0/0"),
 						IPFamilies: []core.IPFamily{core.IPFamilyIPv4},
+						Egress:     &core.Egress{},
 					}
 
 					errorList := ValidateShoot(shoot)
@@ -4868,6 +5578,124 @@ var _ = Describe("Shoot Validation Tests", func() {
 						"Type":   Equal(field.ErrorTypeForbidden),
 						"Field":  Equal("spec.networking.nodes"),
 						"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
+					}, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.networking.egress"),
+						"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
+					}))
+				})
+			})
+
+			Context("egress", func() {
+				It("should allow a valid egress configuration", func() {
+					shoot.Spec.Networking.Egress = &core.Egress{
+						IPPools: []core.EgressIPPool{
+							{Name: "pool-a", Count: ptr.To[int32](2)},
+							{Name: "pool-b", Addresses: []string{"203.0.113.1", "2001:db8::1"}},
+						},
+						NamespaceSelections: []core.EgressNamespaceSelection{
+							{IPPool: "pool-a", NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+							{IPPool: "pool-b"},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid invalid egress IP pools", func() {
+					shoot.Spec.Networking.Egress = &core.Egress{
+						IPPools: []core.EgressIPPool{
+							{Name: "", Count: ptr.To[int32](1)},
+							{Name: "Foo_Bar", Count: ptr.To[int32](0)},
+							{Name: "pool"},
+							{Name: "pool", Count: ptr.To[int32](1), Addresses: []string{"203.0.113.1"}},
+							{Name: "other", Addresses: []string{"foo", "203.0.113.1", "203.0.113.1"}},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.networking.egress.ipPools[0].name"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.egress.ipPools[1].name"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.egress.ipPools[1].count"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.networking.egress.ipPools[2]"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.networking.egress.ipPools[3].name"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.networking.egress.ipPools[3]"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.egress.ipPools[4].addresses[0]"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.networking.egress.ipPools[4].addresses[2]"),
+					}))
+				})
+
+				It("should forbid invalid namespace selections", func() {
+					shoot.Spec.Networking.Egress = &core.Egress{
+						IPPools: []core.EgressIPPool{{Name: "pool", Count: ptr.To[int32](1)}},
+						NamespaceSelections: []core.EgressNamespaceSelection{
+							{IPPool: ""},
+							{IPPool: "unknown"},
+							{IPPool: "pool", NamespaceSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Foo"}}}},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.networking.egress.namespaceSelections[0].ipPool"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeNotFound),
+						"Field": Equal("spec.networking.egress.namespaceSelections[1].ipPool"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.egress.namespaceSelections[2].namespaceSelector.matchExpressions[0].operator"),
+					}))
+				})
+
+				It("should allow a valid egress filter", func() {
+					shoot.Spec.Networking.Egress = &core.Egress{
+						Filter: &core.EgressFilter{
+							AllowList: []string{"10.1.0.0/16", "2001:db8::/64"},
+							DenyList:  []string{"1.2.3.4/32"},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid invalid egress filter entries", func() {
+					shoot.Spec.Networking.Egress = &core.Egress{
+						Filter: &core.EgressFilter{
+							AllowList: []string{"10.1.0.0/16", "10.1.0.0/16", "foo", "10.1.2.3/16"},
+							DenyList:  []string{"10.1.0.0/16", "1.2.3.4"},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.networking.egress.filter.allowList[1]"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.egress.filter.allowList[2]"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.egress.filter.allowList[3]"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.egress.filter.denyList[0]"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.egress.filter.denyList[1]"),
 					}))
 				})
 			})
@@ -5180,6 +6008,125 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})
 		})
 
+		Context("cleanup section", func() {
+			It("should allow valid cleanup policies", func() {
+				shoot.Spec.Cleanup = &core.Cleanup{
+					Webhooks: &core.CleanupPolicy{
+						FinalizeGracePeriod: &metav1.Duration{Duration: time.Minute},
+						ForceFinalize:       []metav1.GroupKind{{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"}},
+					},
+					KubernetesResources: &core.CleanupPolicy{
+						ForceFinalize: []metav1.GroupKind{{Kind: "Service"}, {Group: "apps", Kind: "Deployment"}},
+					},
+					VolumeSnapshots: &core.CleanupPolicy{FinalizeGracePeriod: &metav1.Duration{}},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid negative finalize grace periods", func() {
+				shoot.Spec.Cleanup = &core.Cleanup{
+					Webhooks: &core.CleanupPolicy{FinalizeGracePeriod: &metav1.Duration{Duration: -time.Minute}},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.cleanup.webhooks.finalizeGracePeriod"),
+				}))))
+			})
+
+			It("should forbid too long finalize grace periods", func() {
+				shoot.Spec.Cleanup = &core.Cleanup{
+					ExtendedAPIs: &core.CleanupPolicy{FinalizeGracePeriod: &metav1.Duration{Duration: 25 * time.Hour}},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.cleanup.extendedAPIs.finalizeGracePeriod"),
+				}))))
+			})
+
+			It("should forbid unsupported and duplicate kinds to be force-finalized", func() {
+				shoot.Spec.Cleanup = &core.Cleanup{
+					KubernetesResources: &core.CleanupPolicy{
+						ForceFinalize: []metav1.GroupKind{{Kind: "Node"}, {Kind: "Service"}, {Kind: "Service"}},
+					},
+					VolumeSnapshots: &core.CleanupPolicy{
+						ForceFinalize: []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cleanup.kubernetesResources.forceFinalize[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.cleanup.kubernetesResources.forceFinalize[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cleanup.volumeSnapshots.forceFinalize[0]"),
+					})),
+				))
+			})
+		})
+
+		Context("managed addons section", func() {
+			It("should allow valid managed addons", func() {
+				shoot.Spec.ManagedAddons = []core.ManagedAddon{
+					{Name: "nginx-ingress", Version: ptr.To("~1.12")},
+					{Name: "kubernetes-dashboard"},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid and duplicate names", func() {
+				shoot.Spec.ManagedAddons = []core.ManagedAddon{
+					{Name: ""},
+					{Name: "Foo_Bar"},
+					{Name: "foo"},
+					{Name: "foo"},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.managedAddons[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.managedAddons[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.managedAddons[3].name"),
+					})),
+				))
+			})
+
+			It("should forbid invalid version constraints", func() {
+				shoot.Spec.ManagedAddons = []core.ManagedAddon{{Name: "foo", Version: ptr.To("foo")}}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.managedAddons[0].version"),
+				}))))
+			})
+
+			It("should forbid managed addons for workerless shoots", func() {
+				shoot.Spec.Provider.Workers = nil
+				shoot.Spec.ManagedAddons = []core.ManagedAddon{{Name: "foo"}}
+
+				Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.managedAddons"),
+				}))))
+			})
+		})
+
 		Context("maintenance section", func() {
 			It("should forbid invalid formats for the time window begin and end values", func() {
 				shoot.Spec.Maintenance.TimeWindow.Begin = "invalidformat"
@@ -5401,6 +6348,88 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Entry("incorrect core dns autoscaler", &core.SystemComponents{CoreDNS: &core.CoreDNS{Autoscaling: &core.CoreDNSAutoscaling{Mode: "dummy"}}}, false, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type": Equal(field.ErrorTypeNotSupported),
 				})))),
+				Entry("valid volume snapshots", &core.SystemComponents{VolumeSnapshots: &core.VolumeSnapshots{
+					Classes: []core.VolumeSnapshotClass{
+						{Name: "default", Driver: "disk.csi.foo.com", DeletionPolicy: ptr.To(core.VolumeSnapshotDeletionPolicyDelete), Default: ptr.To(true)},
+						{Name: "retain", Driver: "disk.csi.foo.com", DeletionPolicy: ptr.To(core.VolumeSnapshotDeletionPolicyRetain)},
+					},
+					Policies: []core.VolumeSnapshotPolicy{{
+						Name:                    "daily",
+						Schedule:                "0 2 * * *",
+						Namespace:               "default",
+						Selector:                &metav1.LabelSelector{MatchLabels: map[string]string{"backup": "true"}},
+						VolumeSnapshotClassName: ptr.To("retain"),
+						Retention:               ptr.To[int32](3),
+					}},
+				}}, false, BeEmpty()),
+				Entry("invalid volume snapshot classes", &core.SystemComponents{VolumeSnapshots: &core.VolumeSnapshots{
+					Classes: []core.VolumeSnapshotClass{
+						{Name: "default", Driver: "disk.csi.foo.com", Default: ptr.To(true)},
+						{Name: "default", Driver: "disk.csi.foo.com", DeletionPolicy: ptr.To(core.VolumeSnapshotDeletionPolicy("foo")), Default: ptr.To(true)},
+						{Name: "Foo_Bar"},
+					},
+				}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("volumeSnapshots.classes[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("volumeSnapshots.classes[1].deletionPolicy"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("volumeSnapshots.classes[1].default"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("volumeSnapshots.classes[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("volumeSnapshots.classes[2].driver"),
+					})),
+				)),
+				Entry("invalid volume snapshot policies", &core.SystemComponents{VolumeSnapshots: &core.VolumeSnapshots{
+					Policies: []core.VolumeSnapshotPolicy{
+						{Name: "daily", Schedule: "0 2 * * *", Namespace: "default"},
+						{Name: "daily", Schedule: "foo", Namespace: "Foo", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar/baz"}}, Retention: ptr.To[int32](0)},
+						{},
+					},
+				}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("volumeSnapshots.policies[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("volumeSnapshots.policies[1].schedule"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("volumeSnapshots.policies[1].namespace"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("volumeSnapshots.policies[1].selector.matchLabels"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("volumeSnapshots.policies[1].retention"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("volumeSnapshots.policies[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("volumeSnapshots.policies[2].schedule"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("volumeSnapshots.policies[2].namespace"),
+					})),
+				)),
 			)
 		})
 
@@ -7362,6 +8391,18 @@ var _ = Describe("Shoot Validation Tests", func() {
 					Entry("maintain with other operation", "rotate-ssh-keypair; maintain", "maintain"),
 				)
 
+				It("should reject the compute-control-plane-drift operation together with other operations", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "compute-control-plane-drift")
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "compute-control-plane-drift;rotate-ssh-keypair")
+					Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": ContainSubstring("operation 'compute-control-plane-drift' is not permitted to be run in parallel with other operations"),
+					}))))
+				})
+
 				It("should return an error on first not allowed to be run in parallel operation", func() {
 					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "retry;reconcile;maintain")
 					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
@@ -8326,6 +9367,32 @@ var _ = Describe("Shoot Validation Tests", func() {
 			}))))
 		})
 
+		DescribeTable("accelerators validation", func(vendor core.AcceleratorVendor, matcher gomegatypes.GomegaMatcher) {
+			worker := core.Worker{
+				Name: "worker-name",
+				Machine: core.Machine{
+					Type: "large",
+					Image: &core.ShootMachineImage{
+						Name:    "image-name",
+						Version: "1.0.0",
+					},
+					Architecture: ptr.To("amd64"),
+				},
+				MaxSurge:       ptr.To(intstr.FromInt32(1)),
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				Accelerators:   &core.WorkerAccelerators{Vendor: vendor, ManagedDriver: ptr.To(true)},
+			}
+
+			Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, shootNamespace, providerType, nil, false)).To(matcher)
+		},
+			Entry("nvidia", core.AcceleratorVendorNVIDIA, BeEmpty()),
+			Entry("amd", core.AcceleratorVendorAMD, BeEmpty()),
+			Entry("unsupported vendor", core.AcceleratorVendor("intel"), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("accelerators.vendor"),
+			})))),
+		)
+
 		It("should reject if volume size does not match size regex", func() {
 			maxSurge := intstr.FromInt32(1)
 			maxUnavailable := intstr.FromInt32(0)
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateShootDebugPodRequest validates a ShootDebugPodRequest.
+func ValidateShootDebugPodRequest(req *core.ShootDebugPodRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if len(req.Spec.Reason) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("reason"), "must provide a reason for the debug pod"))
+	} else if len(req.Spec.Reason) > 256 {
+		allErrs = append(allErrs, field.TooLong(specPath.Child("reason"), req.Spec.Reason, 256))
+	}
+
+	const min = 10 * time.Minute
+	if req.Spec.ExpirationSeconds < int64(min.Seconds()) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("expirationSeconds"), req.Spec.ExpirationSeconds, "may not specify a duration less than 10 minutes"))
+	}
+
+	return allErrs
+}
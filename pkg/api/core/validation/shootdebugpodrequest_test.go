@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener/pkg/api/core/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var _ = Describe("ShootDebugPodRequest Validation Tests", func() {
+	var req *core.ShootDebugPodRequest
+
+	BeforeEach(func() {
+		req = &core.ShootDebugPodRequest{
+			Spec: core.ShootDebugPodRequestSpec{
+				Reason:            "investigate etcd performance",
+				ExpirationSeconds: 3600,
+			},
+		}
+	})
+
+	It("should allow valid requests", func() {
+		Expect(ValidateShootDebugPodRequest(req)).To(BeEmpty())
+	})
+
+	It("should forbid requests without reason", func() {
+		req.Spec.Reason = ""
+
+		Expect(ValidateShootDebugPodRequest(req)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.reason"),
+			})),
+		))
+	})
+
+	It("should forbid too long reasons", func() {
+		req.Spec.Reason = strings.Repeat("a", 257)
+
+		Expect(ValidateShootDebugPodRequest(req)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeTooLong),
+				"Field": Equal("spec.reason"),
+			})),
+		))
+	})
+
+	It("should forbid too short expiration durations", func() {
+		req.Spec.ExpirationSeconds = 60
+
+		Expect(ValidateShootDebugPodRequest(req)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.expirationSeconds"),
+			})),
+		))
+	})
+})
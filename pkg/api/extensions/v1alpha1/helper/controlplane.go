@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// InjectComponentIntoPodSpec injects the sidecars and volumes of the given injection into the given pod spec, and the
+// environment variables and volume mounts into the container with the given name. Entries whose names (mount paths
+// for volume mounts) are already used in the pod spec are not injected, i.e., the component's own configuration always
+// takes precedence.
+func InjectComponentIntoPodSpec(podSpec *corev1.PodSpec, containerName string, injection *extensionsv1alpha1.ComponentInjection) {
+	if injection == nil {
+		return
+	}
+
+	if idx := slices.IndexFunc(podSpec.Containers, func(container corev1.Container) bool { return container.Name == containerName }); idx >= 0 {
+		container := &podSpec.Containers[idx]
+		container.Env = appendMissing(container.Env, injection.Env, func(env corev1.EnvVar) string { return env.Name })
+		container.VolumeMounts = appendMissing(container.VolumeMounts, injection.VolumeMounts, func(volumeMount corev1.VolumeMount) string { return volumeMount.MountPath })
+	}
+
+	podSpec.Containers = appendMissing(podSpec.Containers, injection.Sidecars, func(container corev1.Container) string { return container.Name })
+	podSpec.Volumes = appendMissing(podSpec.Volumes, injection.Volumes, func(volume corev1.Volume) string { return volume.Name })
+}
+
+func appendMissing[T any](existing, additional []T, key func(T) string) []T {
+	for _, item := range additional {
+		if !slices.ContainsFunc(existing, func(e T) bool { return key(e) == key(item) }) {
+			existing = append(existing, item)
+		}
+	}
+	return existing
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	. "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("ControlPlane", func() {
+	Describe("#InjectComponentIntoPodSpec", func() {
+		var podSpec *corev1.PodSpec
+
+		BeforeEach(func() {
+			podSpec = &corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:         "main",
+						Env:          []corev1.EnvVar{{Name: "FOO", Value: "main"}},
+						VolumeMounts: []corev1.VolumeMount{{Name: "config", MountPath: "/etc/config"}},
+					},
+					{Name: "other"},
+				},
+				Volumes: []corev1.Volume{{Name: "config"}},
+			}
+		})
+
+		It("should do nothing if there is no injection", func() {
+			expected := podSpec.DeepCopy()
+
+			InjectComponentIntoPodSpec(podSpec, "main", nil)
+			Expect(podSpec).To(Equal(expected))
+		})
+
+		It("should inject the missing entries only", func() {
+			InjectComponentIntoPodSpec(podSpec, "main", &extensionsv1alpha1.ComponentInjection{
+				Sidecars: []corev1.Container{{Name: "other", Image: "other"}, {Name: "sidecar"}},
+				Env:      []corev1.EnvVar{{Name: "FOO", Value: "injected"}, {Name: "BAR", Value: "injected"}},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "injected", MountPath: "/etc/config"},
+					{Name: "injected", MountPath: "/etc/injected"},
+				},
+				Volumes: []corev1.Volume{{Name: "config", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}, {Name: "injected"}},
+			})
+
+			Expect(podSpec).To(Equal(&corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "main",
+						Env:  []corev1.EnvVar{{Name: "FOO", Value: "main"}, {Name: "BAR", Value: "injected"}},
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: "config", MountPath: "/etc/config"},
+							{Name: "injected", MountPath: "/etc/injected"},
+						},
+					},
+					{Name: "other"},
+					{Name: "sidecar"},
+				},
+				Volumes: []corev1.Volume{{Name: "config"}, {Name: "injected"}},
+			}))
+		})
+	})
+})
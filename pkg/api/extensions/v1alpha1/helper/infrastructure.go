@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// GetInfrastructureDrift returns the result of the last drift detection of the given Infrastructure. It returns nil if
+// the drift was not detected since the last operation on the Infrastructure, as the result is outdated then.
+func GetInfrastructureDrift(infrastructure *extensionsv1alpha1.Infrastructure) *extensionsv1alpha1.InfrastructureDrift {
+	drift := infrastructure.Status.Drift
+	if drift == nil {
+		return nil
+	}
+
+	if lastOperation := infrastructure.Status.LastOperation; lastOperation != nil && drift.LastDetectionTime.Before(&lastOperation.LastUpdateTime) {
+		return nil
+	}
+
+	return drift
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Helper", func() {
+	Describe("#GetInfrastructureDrift", func() {
+		var (
+			now            = metav1.NewTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+			infrastructure *extensionsv1alpha1.Infrastructure
+		)
+
+		BeforeEach(func() {
+			infrastructure = &extensionsv1alpha1.Infrastructure{
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						LastOperation: &gardencorev1beta1.LastOperation{LastUpdateTime: now},
+					},
+				},
+			}
+		})
+
+		It("should return nil if the drift was never detected", func() {
+			Expect(GetInfrastructureDrift(infrastructure)).To(BeNil())
+		})
+
+		It("should return nil if the drift was detected before the last operation", func() {
+			infrastructure.Status.Drift = &extensionsv1alpha1.InfrastructureDrift{
+				State:             extensionsv1alpha1.InfrastructureDriftStateDrifted,
+				LastDetectionTime: metav1.NewTime(now.Add(-time.Minute)),
+			}
+
+			Expect(GetInfrastructureDrift(infrastructure)).To(BeNil())
+		})
+
+		It("should return the drift if it was detected after the last operation", func() {
+			infrastructure.Status.Drift = &extensionsv1alpha1.InfrastructureDrift{
+				State:             extensionsv1alpha1.InfrastructureDriftStateInSync,
+				LastDetectionTime: metav1.NewTime(now.Add(time.Minute)),
+			}
+
+			Expect(GetInfrastructureDrift(infrastructure)).To(Equal(infrastructure.Status.Drift))
+		})
+
+		It("should return the drift if there is no last operation", func() {
+			infrastructure.Status.LastOperation = nil
+			infrastructure.Status.Drift = &extensionsv1alpha1.InfrastructureDrift{
+				State:             extensionsv1alpha1.InfrastructureDriftStateDrifted,
+				LastDetectionTime: now,
+			}
+
+			Expect(GetInfrastructureDrift(infrastructure)).To(Equal(infrastructure.Status.Drift))
+		})
+	})
+})
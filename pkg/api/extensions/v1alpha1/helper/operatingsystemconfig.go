@@ -5,6 +5,12 @@
 package helper
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -23,3 +29,52 @@ func FilePathsFrom(files []extensionsv1alpha1.File) []string {
 
 	return out
 }
+
+// SortBootstrapSteps returns the given bootstrap steps ordered such that every step is placed after all steps it depends
+// on. Apart from that, the original order is preserved as far as possible. An error is returned if a dependency refers to an
+// unknown step or if the dependencies form a cycle.
+func SortBootstrapSteps(steps []extensionsv1alpha1.BootstrapStep) ([]extensionsv1alpha1.BootstrapStep, error) {
+	known := sets.New[string]()
+	for _, step := range steps {
+		known.Insert(step.Name)
+	}
+
+	for _, step := range steps {
+		for _, dependency := range step.DependsOn {
+			if !known.Has(dependency) {
+				return nil, fmt.Errorf("bootstrap step %q depends on unknown step %q", step.Name, dependency)
+			}
+		}
+	}
+
+	var (
+		sorted  = make([]extensionsv1alpha1.BootstrapStep, 0, len(steps))
+		done    = sets.New[string]()
+		pending = slices.Clone(steps)
+	)
+
+	for len(pending) > 0 {
+		var remaining []extensionsv1alpha1.BootstrapStep
+
+		for _, step := range pending {
+			if done.HasAll(step.DependsOn...) {
+				sorted = append(sorted, step)
+				done.Insert(step.Name)
+			} else {
+				remaining = append(remaining, step)
+			}
+		}
+
+		if len(remaining) == len(pending) {
+			var names []string
+			for _, step := range remaining {
+				names = append(names, step.Name)
+			}
+			return nil, fmt.Errorf("bootstrap steps have cyclic dependencies: %s", strings.Join(names, ", "))
+		}
+
+		pending = remaining
+	}
+
+	return sorted, nil
+}
@@ -40,4 +40,39 @@ var _ = Describe("Helper", func() {
 			Expect(FilePathsFrom([]extensionsv1alpha1.File{file1, file2})).To(ConsistOf("foo", "bar"))
 		})
 	})
+
+	Describe("#SortBootstrapSteps", func() {
+		It("should return an empty list when no steps are given", func() {
+			Expect(SortBootstrapSteps(nil)).To(BeEmpty())
+		})
+
+		It("should order the steps according to their dependencies", func() {
+			steps := []extensionsv1alpha1.BootstrapStep{
+				{Name: "c", UnitName: "c.service", DependsOn: []string{"b"}},
+				{Name: "a", UnitName: "a.service"},
+				{Name: "b", UnitName: "b.service", DependsOn: []string{"a"}},
+				{Name: "d", UnitName: "d.service"},
+			}
+
+			sorted, err := SortBootstrapSteps(steps)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sorted).To(Equal([]extensionsv1alpha1.BootstrapStep{steps[1], steps[2], steps[3], steps[0]}))
+		})
+
+		It("should return an error when a dependency is unknown", func() {
+			_, err := SortBootstrapSteps([]extensionsv1alpha1.BootstrapStep{
+				{Name: "a", UnitName: "a.service", DependsOn: []string{"foo"}},
+			})
+			Expect(err).To(MatchError(`bootstrap step "a" depends on unknown step "foo"`))
+		})
+
+		It("should return an error when the dependencies are cyclic", func() {
+			_, err := SortBootstrapSteps([]extensionsv1alpha1.BootstrapStep{
+				{Name: "a", UnitName: "a.service", DependsOn: []string{"b"}},
+				{Name: "b", UnitName: "b.service", DependsOn: []string{"a"}},
+				{Name: "c", UnitName: "c.service"},
+			})
+			Expect(err).To(MatchError("bootstrap steps have cyclic dependencies: a, b"))
+		})
+	})
 })
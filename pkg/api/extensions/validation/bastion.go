@@ -52,6 +52,15 @@ func ValidateBastionSpec(spec *extensionsv1alpha1.BastionSpec, fldPath *field.Pa
 		allErrs = append(allErrs, field.Required(fldPath.Child("ingress"), "field is required"))
 	}
 
+	if spec.SessionRecording != nil {
+		if len(spec.SessionRecording.BucketName) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("sessionRecording", "bucketName"), "field is required"))
+		}
+		if len(spec.SessionRecording.SecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("sessionRecording", "secretRef", "name"), "field is required"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -66,6 +75,7 @@ func ValidateBastionSpecUpdate(new, old *extensionsv1alpha1.BastionSpec, deletio
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Type, old.Type, fldPath.Child("type"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.UserData, old.UserData, fldPath.Child("userData"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.SessionRecording, old.SessionRecording, fldPath.Child("sessionRecording"))...)
 
 	return allErrs
 }
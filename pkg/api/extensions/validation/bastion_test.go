@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -68,6 +69,20 @@ var _ = Describe("Bastion validation tests", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should forbid incomplete session recording configuration", func() {
+			bastion.Spec.SessionRecording = &extensionsv1alpha1.BastionSessionRecording{}
+
+			errorList := ValidateBastion(bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.sessionRecording.bucketName"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.sessionRecording.secretRef.name"),
+			}))))
+		})
 	})
 
 	Describe("#ValidBastionUpdate", func() {
@@ -104,6 +119,21 @@ var _ = Describe("Bastion validation tests", func() {
 			}))))
 		})
 
+		It("should prevent updating the session recording", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.SessionRecording = &extensionsv1alpha1.BastionSessionRecording{
+				BucketName: "bucket",
+				SecretRef:  corev1.SecretReference{Name: "entry-secret", Namespace: "garden"},
+			}
+
+			errorList := ValidateBastionUpdate(newBastion, bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.sessionRecording"),
+			}))))
+		})
+
 		It("should allow updating the ingress", func() {
 			newBastion := prepareBastionForUpdate(bastion)
 			newBastion.Spec.Ingress[0].IPBlock.CIDR = "8.8.8.8/8"
@@ -93,6 +93,15 @@ func ValidateDNSRecordSpec(spec *extensionsv1alpha1.DNSRecordSpec, fldPath *fiel
 		}
 	}
 
+	if spec.Failover != nil {
+		if spec.RecordType == extensionsv1alpha1.DNSRecordTypeTXT {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("failover"), "failover is not supported for TXT records"))
+		}
+		if len(spec.Failover.Values) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("failover", "values"), "field is required"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -14,6 +14,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/api/extensions/validation"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -174,6 +175,23 @@ var _ = Describe("DNSRecord validation tests", func() {
 			}))))
 		})
 
+		It("should forbid failover without values", func() {
+			dns.Spec.Failover = &gardencorev1beta1.DNSFailover{}
+
+			errorList := ValidateDNSRecord(dns)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.failover.values"),
+			}))))
+		})
+
+		It("should allow failover with values", func() {
+			dns.Spec.Failover = &gardencorev1beta1.DNSFailover{Values: []string{"5.6.7.8"}}
+
+			Expect(ValidateDNSRecord(dns)).To(BeEmpty())
+		})
+
 		It("should allow valid resources (type A)", func() {
 			errorList := ValidateDNSRecord(dns)
 
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/api/core/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// validateEgress validates the egress configuration passed to extensions with the same rules as the Shoot API.
+func validateEgress(egress *gardencorev1beta1.Egress, fldPath *field.Path) field.ErrorList {
+	coreEgress := &core.Egress{}
+	if err := gardencorev1beta1.Convert_v1beta1_Egress_To_core_Egress(egress, coreEgress, nil); err != nil {
+		return field.ErrorList{field.InternalError(fldPath, err)}
+	}
+
+	return validation.ValidateEgress(coreEgress, fldPath)
+}
@@ -52,6 +52,10 @@ func ValidateInfrastructureSpec(spec *extensionsv1alpha1.InfrastructureSpec, fld
 		allErrs = append(allErrs, field.Required(fldPath.Child("secretRef", "name"), "field is required"))
 	}
 
+	if spec.Egress != nil {
+		allErrs = append(allErrs, validateEgress(spec.Egress, fldPath.Child("egress"))...)
+	}
+
 	return allErrs
 }
 
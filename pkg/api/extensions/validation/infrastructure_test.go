@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	. "github.com/gardener/gardener/pkg/api/extensions/validation"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -67,6 +68,17 @@ var _ = Describe("Infrastructure validation tests", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should forbid an invalid egress configuration", func() {
+			infra.Spec.Egress = &gardencorev1beta1.Egress{
+				IPPools: []gardencorev1beta1.EgressIPPool{{Name: "pool", Addresses: []string{"foo"}}},
+			}
+
+			Expect(ValidateInfrastructure(infra)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.egress.ipPools[0].addresses[0]"),
+			}))))
+		})
 	})
 
 	Describe("#ValidInfrastructureUpdate", func() {
@@ -78,6 +78,10 @@ func ValidateNetworkSpec(spec *extensionsv1alpha1.NetworkSpec, fldPath *field.Pa
 	if len(spec.IPFamilies) != 1 || spec.IPFamilies[0] != extensionsv1alpha1.IPFamilyIPv6 {
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(cidrs, false)...)
 	}
+
+	if spec.Egress != nil {
+		allErrs = append(allErrs, validateEgress(spec.Egress, fldPath.Child("egress"))...)
+	}
 	return allErrs
 }
 
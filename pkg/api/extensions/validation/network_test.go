@@ -11,8 +11,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/api/extensions/validation"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -185,6 +187,32 @@ var _ = Describe("Network validation tests", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 		})
+
+		Context("egress", func() {
+			It("should allow a valid egress configuration", func() {
+				network.Spec.Egress = &gardencorev1beta1.Egress{
+					IPPools:             []gardencorev1beta1.EgressIPPool{{Name: "pool", Count: ptr.To[int32](2)}},
+					NamespaceSelections: []gardencorev1beta1.EgressNamespaceSelection{{IPPool: "pool"}},
+				}
+
+				Expect(ValidateNetwork(network)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid egress configuration", func() {
+				network.Spec.Egress = &gardencorev1beta1.Egress{
+					IPPools:             []gardencorev1beta1.EgressIPPool{{Name: "pool"}},
+					NamespaceSelections: []gardencorev1beta1.EgressNamespaceSelection{{IPPool: "foo"}},
+				}
+
+				Expect(ValidateNetwork(network)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.egress.ipPools[0]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("spec.egress.namespaceSelections[0].ipPool"),
+				}))))
+			})
+		})
 	})
 
 	Describe("#ValidateNetworkUpdate", func() {
@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -36,6 +37,7 @@ func ValidateOperatingSystemConfig(osc *extensionsv1alpha1.OperatingSystemConfig
 	allErrs = append(allErrs, ValidateOperatingSystemConfigStatus(&osc.Status, pathsFromFiles, field.NewPath("status"))...)
 
 	allErrs = append(allErrs, validateFileDuplicates(osc)...)
+	allErrs = append(allErrs, validateBootstrapSteps(osc)...)
 
 	return allErrs
 }
@@ -205,9 +207,18 @@ var availablePluginPathOperations = sets.New(extensionsv1alpha1.AddPluginPathOpe
 func validateContainerdPluginConfigs(config *extensionsv1alpha1.ContainerdConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	duplicatePath := sets.Set[string]{}
 	for i, p := range config.Plugins {
 		idxPath := fldPath.Index(i)
 
+		// Contributions for the same path must be merged (e.g., via the webhook helpers in the extensions library) instead
+		// of being listed multiple times, otherwise the last entry would silently win.
+		if key := strings.Join(p.Path, "."); duplicatePath.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("path"), p.Path))
+		} else {
+			duplicatePath.Insert(key)
+		}
+
 		if p.Op != nil && !availablePluginPathOperations.Has(*p.Op) {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("op"), *p.Op, availablePluginPathOperations.UnsortedList()))
 		}
@@ -263,6 +274,56 @@ func ValidateUnits(units []extensionsv1alpha1.Unit, pathsFromFiles sets.Set[stri
 	return allErrs
 }
 
+func validateBootstrapSteps(osc *extensionsv1alpha1.OperatingSystemConfig) field.ErrorList {
+	var (
+		allErrs   = field.ErrorList{}
+		fldPath   = field.NewPath("status", "extensionBootstrapSteps")
+		unitNames = sets.New[string]()
+		stepNames = sets.New[string]()
+	)
+
+	for _, unit := range append(osc.Spec.Units, osc.Status.ExtensionUnits...) {
+		unitNames.Insert(unit.Name)
+	}
+
+	for i, step := range osc.Status.ExtensionBootstrapSteps {
+		idxPath := fldPath.Index(i)
+
+		if len(step.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field is required"))
+		} else if stepNames.Has(step.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), step.Name))
+		}
+		stepNames.Insert(step.Name)
+
+		if len(step.UnitName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("unitName"), "field is required"))
+		} else if !unitNames.Has(step.UnitName) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("unitName"), step.UnitName, "unit name must refer to a unit in spec.units or status.extensionUnits"))
+		}
+	}
+
+	for i, step := range osc.Status.ExtensionBootstrapSteps {
+		for j, dependency := range step.DependsOn {
+			jdxPath := fldPath.Index(i).Child("dependsOn").Index(j)
+
+			if dependency == step.Name {
+				allErrs = append(allErrs, field.Invalid(jdxPath, dependency, "bootstrap step must not depend on itself"))
+			} else if !stepNames.Has(dependency) {
+				allErrs = append(allErrs, field.Invalid(jdxPath, dependency, "dependency must refer to another bootstrap step"))
+			}
+		}
+	}
+
+	if len(allErrs) == 0 {
+		if _, err := extensionsv1alpha1helper.SortBootstrapSteps(osc.Status.ExtensionBootstrapSteps); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, nil, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 func validateFileDuplicates(osc *extensionsv1alpha1.OperatingSystemConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -575,6 +575,20 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			}))))
 		})
 
+		It("should forbid OperatingSystemConfig with duplicate plugin paths", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.CRIConfig.Containerd.Plugins = []extensionsv1alpha1.PluginConfig{
+				{Path: []string{"foo", "bar"}},
+				{Path: []string{"foo"}},
+				{Path: []string{"foo", "bar"}},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.criConfig.containerd.plugins[2].path"),
+			}))))
+		})
+
 		It("should forbid OperatingSystemConfig with invalid plugin values", func() {
 			oscCopy := osc.DeepCopy()
 			oscCopy.Spec.CRIConfig.Containerd.Plugins = []extensionsv1alpha1.PluginConfig{
@@ -610,6 +624,63 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			}))))
 		})
 
+		It("should forbid OperatingSystemConfig resources with invalid bootstrap steps", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Status.ExtensionUnits = []extensionsv1alpha1.Unit{{Name: "bar"}}
+			oscCopy.Status.ExtensionBootstrapSteps = []extensionsv1alpha1.BootstrapStep{
+				{UnitName: "foo"},
+				{Name: "step1", DependsOn: []string{"step1", "unknown"}},
+				{Name: "step1", UnitName: "baz"},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.extensionBootstrapSteps[0].name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.extensionBootstrapSteps[1].unitName"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("status.extensionBootstrapSteps[2].name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.extensionBootstrapSteps[2].unitName"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.extensionBootstrapSteps[1].dependsOn[0]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.extensionBootstrapSteps[1].dependsOn[1]"),
+				})),
+			))
+		})
+
+		It("should forbid OperatingSystemConfig resources with cyclic bootstrap step dependencies", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Status.ExtensionBootstrapSteps = []extensionsv1alpha1.BootstrapStep{
+				{Name: "step1", UnitName: "foo", DependsOn: []string{"step2"}},
+				{Name: "step2", UnitName: "foo", DependsOn: []string{"step1"}},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("status.extensionBootstrapSteps"),
+				"Detail": ContainSubstring("cyclic dependencies"),
+			}))))
+		})
+
+		It("should allow valid bootstrap steps", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Status.ExtensionUnits = []extensionsv1alpha1.Unit{{Name: "bar"}}
+			oscCopy.Status.ExtensionBootstrapSteps = []extensionsv1alpha1.BootstrapStep{
+				{Name: "step2", UnitName: "bar", DependsOn: []string{"step1"}},
+				{Name: "step1", UnitName: "foo"},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(BeEmpty())
+		})
+
 		It("should allow valid osc resources", func() {
 			errorList := ValidateOperatingSystemConfig(osc)
 
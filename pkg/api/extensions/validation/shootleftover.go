@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-test/deep"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ValidateShootLeftover validates a ShootLeftover object.
+func ValidateShootLeftover(sl *extensionsv1alpha1.ShootLeftover) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&sl.ObjectMeta, false, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootLeftoverSpec(&sl.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootLeftoverUpdate validates a ShootLeftover object before an update.
+func ValidateShootLeftoverUpdate(new, old *extensionsv1alpha1.ShootLeftover) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootLeftoverSpecUpdate(&new.Spec, &old.Spec, new.DeletionTimestamp != nil, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateShootLeftover(new)...)
+
+	return allErrs
+}
+
+// ValidateShootLeftoverSpec validates the specification of a ShootLeftover object.
+func ValidateShootLeftoverSpec(spec *extensionsv1alpha1.ShootLeftoverSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Type) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "field is required"))
+	}
+
+	if len(spec.Region) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("region"), "field is required"))
+	}
+
+	if len(spec.TechnicalID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("technicalID"), "field is required"))
+	}
+
+	if len(spec.SecretRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretRef", "name"), "field is required"))
+	}
+
+	return allErrs
+}
+
+// ValidateShootLeftoverSpecUpdate validates the spec of a ShootLeftover object before an update.
+func ValidateShootLeftoverSpecUpdate(new, old *extensionsv1alpha1.ShootLeftoverSpec, deletionTimestampSet bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if deletionTimestampSet && !apiequality.Semantic.DeepEqual(new, old) {
+		diff := deep.Equal(new, old)
+		return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf("cannot update shoot leftover spec if deletion timestamp is set. Requested changes: %s", strings.Join(diff, ",")))}
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Type, old.Type, fldPath.Child("type"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Region, old.Region, fldPath.Child("region"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.TechnicalID, old.TechnicalID, fldPath.Child("technicalID"))...)
+
+	return allErrs
+}
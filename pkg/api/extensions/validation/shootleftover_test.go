@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener/pkg/api/extensions/validation"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("ShootLeftover validation tests", func() {
+	var sl *extensionsv1alpha1.ShootLeftover
+
+	BeforeEach(func() {
+		sl = &extensionsv1alpha1.ShootLeftover{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "shoot--foo--bar--1234",
+			},
+			Spec: extensionsv1alpha1.ShootLeftoverSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type: "provider",
+				},
+				Region:      "region",
+				TechnicalID: "shoot--foo--bar",
+				SecretRef: corev1.SecretReference{
+					Name:      "test",
+					Namespace: "garden",
+				},
+			},
+		}
+	})
+
+	Describe("#ValidShootLeftover", func() {
+		It("should forbid empty ShootLeftover resources", func() {
+			errorList := ValidateShootLeftover(&extensionsv1alpha1.ShootLeftover{})
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.type"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.region"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.technicalID"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.secretRef.name"),
+			}))))
+		})
+
+		It("should allow valid ShootLeftover resources", func() {
+			errorList := ValidateShootLeftover(sl)
+
+			Expect(errorList).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidShootLeftoverUpdate", func() {
+		It("should prevent updating anything if deletion time stamp is set", func() {
+			now := metav1.Now()
+			sl.DeletionTimestamp = &now
+
+			newShootLeftover := prepareShootLeftoverForUpdate(sl)
+			newShootLeftover.DeletionTimestamp = &now
+			newShootLeftover.Spec.Cleanup = true
+
+			errorList := ValidateShootLeftoverUpdate(newShootLeftover, sl)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("spec"),
+				"Detail": Equal("cannot update shoot leftover spec if deletion timestamp is set. Requested changes: Cleanup: true != false"),
+			}))))
+		})
+
+		It("should prevent updating the type, region, and technical ID", func() {
+			newShootLeftover := prepareShootLeftoverForUpdate(sl)
+			newShootLeftover.Spec.Type = "changed-type"
+			newShootLeftover.Spec.Region = "changed-region"
+			newShootLeftover.Spec.TechnicalID = "changed-technical-id"
+
+			errorList := ValidateShootLeftoverUpdate(newShootLeftover, sl)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.type"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.region"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.technicalID"),
+			}))))
+		})
+
+		It("should allow enabling the cleanup and updating the referenced secret", func() {
+			newShootLeftover := prepareShootLeftoverForUpdate(sl)
+			newShootLeftover.Spec.Cleanup = true
+			newShootLeftover.Spec.SecretRef.Name = "changed-secretref-name"
+
+			errorList := ValidateShootLeftoverUpdate(newShootLeftover, sl)
+
+			Expect(errorList).To(BeEmpty())
+		})
+	})
+})
+
+func prepareShootLeftoverForUpdate(obj *extensionsv1alpha1.ShootLeftover) *extensionsv1alpha1.ShootLeftover {
+	newObj := obj.DeepCopy()
+	newObj.ResourceVersion = "1"
+	return newObj
+}
@@ -65,6 +65,10 @@ func ValidateBastionSpec(spec *operations.BastionSpec, fldPath *field.Path) fiel
 		}
 	}
 
+	if spec.IdleTimeout != nil && spec.IdleTimeout.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeout"), spec.IdleTimeout.Duration.String(), "idle timeout must be at least 1m"))
+	}
+
 	return allErrs
 }
 
@@ -74,6 +78,8 @@ func ValidateBastionSpecUpdate(newSpec, oldSpec *operations.BastionSpec, fldPath
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ShootRef.Name, oldSpec.ShootRef.Name, fldPath.Child("shootRef.name"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SSHPublicKey, oldSpec.SSHPublicKey, fldPath.Child("sshPublicKey"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.IdleTimeout, oldSpec.IdleTimeout, fldPath.Child("idleTimeout"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SessionRecording, oldSpec.SessionRecording, fldPath.Child("sessionRecording"))...)
 
 	return allErrs
 }
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -116,6 +118,24 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should allow Bastion specification with idle timeout and session recording", func() {
+			bastion.Spec.IdleTimeout = &metav1.Duration{Duration: 15 * time.Minute}
+			bastion.Spec.SessionRecording = &operations.BastionSessionRecording{Enabled: true}
+
+			Expect(ValidateBastion(bastion)).To(BeEmpty())
+		})
+
+		It("should forbid Bastion specification with too short idle timeout", func() {
+			bastion.Spec.IdleTimeout = &metav1.Duration{Duration: 30 * time.Second}
+
+			errorList := ValidateBastion(bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.idleTimeout"),
+			}))))
+		})
+
 		It("should forbid changing Shoot ref", func() {
 			newBastion := prepareBastionForUpdate(bastion)
 			newBastion.Spec.ShootRef.Name = "another-shoot"
@@ -139,6 +159,25 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.sshPublicKey"),
 			}))))
 		})
+
+		It("should forbid changing idle timeout and session recording", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.IdleTimeout = &metav1.Duration{Duration: 15 * time.Minute}
+			newBastion.Spec.SessionRecording = &operations.BastionSessionRecording{Enabled: true}
+
+			errorList := ValidateBastionUpdate(newBastion, bastion)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.idleTimeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.sessionRecording"),
+				})),
+			))
+		})
 	})
 })
 
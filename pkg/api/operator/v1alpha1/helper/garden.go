@@ -207,6 +207,45 @@ func GetETCDMainBackup(garden *operatorv1alpha1.Garden) *operatorv1alpha1.Backup
 	return nil
 }
 
+// GetETCDMainRestoreMode returns the restore mode configured for the etcd main backup of the given garden object. It
+// defaults to 'Drill' if not configured.
+func GetETCDMainRestoreMode(garden *operatorv1alpha1.Garden) operatorv1alpha1.RestoreMode {
+	if backup := GetETCDMainBackup(garden); backup != nil && backup.RestoreMode != nil {
+		return *backup.RestoreMode
+	}
+	return operatorv1alpha1.RestoreModeDrill
+}
+
+// IsETCDMainRestoreInProgress returns true when the lastInitiationTime in the .status.backup.restore field is newer
+// than the lastCompletionTime. This is also true if the lastCompletionTime is unset.
+func IsETCDMainRestoreInProgress(gardenStatus operatorv1alpha1.GardenStatus) bool {
+	if gardenStatus.Backup == nil ||
+		gardenStatus.Backup.Restore == nil ||
+		gardenStatus.Backup.Restore.LastInitiationTime == nil {
+		return false
+	}
+
+	return gardenStatus.Backup.Restore.LastCompletionTime == nil ||
+		gardenStatus.Backup.Restore.LastCompletionTime.Before(gardenStatus.Backup.Restore.LastInitiationTime)
+}
+
+// MutateETCDMainRestore mutates the .status.backup.restore field based on the provided mutation function. If the field
+// is nil then it is initialized.
+func MutateETCDMainRestore(garden *operatorv1alpha1.Garden, f func(*operatorv1alpha1.BackupRestoreStatus)) {
+	if f == nil {
+		return
+	}
+
+	if garden.Status.Backup == nil {
+		garden.Status.Backup = &operatorv1alpha1.BackupStatus{}
+	}
+	if garden.Status.Backup.Restore == nil {
+		garden.Status.Backup.Restore = &operatorv1alpha1.BackupRestoreStatus{}
+	}
+
+	f(garden.Status.Backup.Restore)
+}
+
 // GetDNSProviders returns the DNS providers for the given garden object or nil if non are configured.
 func GetDNSProviders(garden *operatorv1alpha1.Garden) []operatorv1alpha1.DNSProvider {
 	if garden != nil && garden.Spec.DNS != nil {
@@ -256,6 +256,49 @@ var _ = Describe("helper", func() {
 		Entry("with backup config", &operatorv1alpha1.Garden{Spec: operatorv1alpha1.GardenSpec{VirtualCluster: operatorv1alpha1.VirtualCluster{ETCD: &operatorv1alpha1.ETCD{Main: &operatorv1alpha1.ETCDMain{Backup: &operatorv1alpha1.Backup{Provider: "test"}}}}}}, &operatorv1alpha1.Backup{Provider: "test"}),
 	)
 
+	DescribeTable("#GetETCDMainRestoreMode",
+		func(garden *operatorv1alpha1.Garden, expected operatorv1alpha1.RestoreMode) {
+			Expect(GetETCDMainRestoreMode(garden)).To(Equal(expected))
+		},
+		Entry("no backup config", &operatorv1alpha1.Garden{}, operatorv1alpha1.RestoreModeDrill),
+		Entry("no restore mode", &operatorv1alpha1.Garden{Spec: operatorv1alpha1.GardenSpec{VirtualCluster: operatorv1alpha1.VirtualCluster{ETCD: &operatorv1alpha1.ETCD{Main: &operatorv1alpha1.ETCDMain{Backup: &operatorv1alpha1.Backup{}}}}}}, operatorv1alpha1.RestoreModeDrill),
+		Entry("restore mode set", &operatorv1alpha1.Garden{Spec: operatorv1alpha1.GardenSpec{VirtualCluster: operatorv1alpha1.VirtualCluster{ETCD: &operatorv1alpha1.ETCD{Main: &operatorv1alpha1.ETCDMain{Backup: &operatorv1alpha1.Backup{RestoreMode: ptr.To(operatorv1alpha1.RestoreModeRecovery)}}}}}}, operatorv1alpha1.RestoreModeRecovery),
+	)
+
+	DescribeTable("#IsETCDMainRestoreInProgress",
+		func(status operatorv1alpha1.GardenStatus, matcher gomegatypes.GomegaMatcher) {
+			Expect(IsETCDMainRestoreInProgress(status)).To(matcher)
+		},
+
+		Entry("backup status nil", operatorv1alpha1.GardenStatus{}, BeFalse()),
+		Entry("restore status nil", operatorv1alpha1.GardenStatus{Backup: &operatorv1alpha1.BackupStatus{}}, BeFalse()),
+		Entry("lastInitiationTime nil", operatorv1alpha1.GardenStatus{Backup: &operatorv1alpha1.BackupStatus{Restore: &operatorv1alpha1.BackupRestoreStatus{}}}, BeFalse()),
+		Entry("lastCompletionTime nil", operatorv1alpha1.GardenStatus{Backup: &operatorv1alpha1.BackupStatus{Restore: &operatorv1alpha1.BackupRestoreStatus{LastInitiationTime: &metav1.Time{Time: metav1.Now().Time}}}}, BeTrue()),
+		Entry("lastCompletionTime before lastInitiationTime", operatorv1alpha1.GardenStatus{Backup: &operatorv1alpha1.BackupStatus{Restore: &operatorv1alpha1.BackupRestoreStatus{LastInitiationTime: &metav1.Time{Time: metav1.Now().Time}, LastCompletionTime: &metav1.Time{Time: metav1.Now().Add(-time.Minute)}}}}, BeTrue()),
+		Entry("lastCompletionTime after lastInitiationTime", operatorv1alpha1.GardenStatus{Backup: &operatorv1alpha1.BackupStatus{Restore: &operatorv1alpha1.BackupRestoreStatus{LastInitiationTime: &metav1.Time{Time: metav1.Now().Time}, LastCompletionTime: &metav1.Time{Time: metav1.Now().Add(time.Minute)}}}}, BeFalse()),
+	)
+
+	Describe("#MutateETCDMainRestore", func() {
+		It("should do nothing when mutate function is nil", func() {
+			garden := &operatorv1alpha1.Garden{}
+			MutateETCDMainRestore(garden, nil)
+			Expect(garden.Status.Backup).To(BeNil())
+		})
+
+		DescribeTable("mutate function not nil",
+			func(garden *operatorv1alpha1.Garden, lastInitiationTime metav1.Time) {
+				MutateETCDMainRestore(garden, func(restore *operatorv1alpha1.BackupRestoreStatus) {
+					restore.LastInitiationTime = &lastInitiationTime
+				})
+				Expect(garden.Status.Backup.Restore.LastInitiationTime).To(PointTo(Equal(lastInitiationTime)))
+			},
+
+			Entry("backup nil", &operatorv1alpha1.Garden{}, metav1.Now()),
+			Entry("restore nil", &operatorv1alpha1.Garden{Status: operatorv1alpha1.GardenStatus{Backup: &operatorv1alpha1.BackupStatus{}}}, metav1.Now()),
+			Entry("restore non-nil", &operatorv1alpha1.Garden{Status: operatorv1alpha1.GardenStatus{Backup: &operatorv1alpha1.BackupStatus{Restore: &operatorv1alpha1.BackupRestoreStatus{}}}}, metav1.Now()),
+		)
+	})
+
 	DescribeTable("#GetDNSProviders",
 		func(garden *operatorv1alpha1.Garden, expected []operatorv1alpha1.DNSProvider) {
 			Expect(GetDNSProviders(garden)).To(Equal(expected))
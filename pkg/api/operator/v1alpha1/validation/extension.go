@@ -29,6 +29,7 @@ func validateExtensionSpec(spec operatorv1alpha1.ExtensionSpec, fldPath *field.P
 
 	allErrs = append(allErrs, validateDeployment(spec.Deployment, fldPath.Child("deployment"))...)
 	allErrs = append(allErrs, validateControllerResources(spec.Resources, fldPath.Child("resources"))...)
+	allErrs = append(allErrs, validateErrorCodeDefinitions(spec.ErrorCodes, fldPath.Child("errorCodes"))...)
 
 	return allErrs
 }
@@ -120,6 +121,24 @@ func validateControllerResources(resources []gardencorev1beta1.ControllerResourc
 	return allErrs
 }
 
+func validateErrorCodeDefinitions(definitions []gardencorev1beta1.ErrorCodeDefinition, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	coreDefinitions := make([]gardencore.ErrorCodeDefinition, 0, len(definitions))
+	for _, definition := range definitions {
+		coreDefinition := &gardencore.ErrorCodeDefinition{}
+		if err := gardenCoreScheme.Convert(&definition, coreDefinition, nil); err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, err))
+			return allErrs
+		}
+		coreDefinitions = append(coreDefinitions, *coreDefinition)
+	}
+
+	allErrs = append(allErrs, gardencorevalidation.ValidateErrorCodeDefinitions(coreDefinitions, fldPath)...)
+
+	return allErrs
+}
+
 // ValidateExtensionUpdate contains functionality for performing extended validation of an Extension object under update which
 // is not possible with standard CRD validation, see https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definitions/#validation-rules.
 func ValidateExtensionUpdate(oldExtension, newExtension *operatorv1alpha1.Extension) field.ErrorList {
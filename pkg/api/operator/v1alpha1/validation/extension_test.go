@@ -361,4 +361,21 @@ func validateExtensionTests(test func() field.ErrorList, extension func() *opera
 			))
 		})
 	})
+
+	Context("Error Codes", func() {
+		It("should return no errors for valid error code definitions", func() {
+			extension().Spec.ErrorCodes = []gardencorev1beta1.ErrorCodeDefinition{{Code: "ERR_FOO", Description: "foo"}}
+
+			Expect(test()).To(BeEmpty())
+		})
+
+		It("should return an error when a well-known error code is redefined", func() {
+			extension().Spec.ErrorCodes = []gardencorev1beta1.ErrorCodeDefinition{{Code: gardencorev1beta1.ErrorConfigurationProblem, Description: "foo"}}
+
+			Expect(test()).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.errorCodes[0].code"),
+			}))))
+		})
+	})
 }
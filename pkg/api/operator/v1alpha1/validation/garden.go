@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/robfig/cron"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -84,6 +85,7 @@ func ValidateGardenWithOps(garden *operatorv1alpha1.Garden, extensions []operato
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, validateOperation(helper.GetGardenerOperations(garden.Annotations), garden, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, featuresvalidation.ValidateFeatureGateOverrides(garden.Annotations, field.NewPath("metadata", "annotations"))...)
 	allErrs = append(allErrs, validateDNS(garden.Spec.DNS, field.NewPath("spec", "dns"))...)
 	allErrs = append(allErrs, validateExtensions(garden.Spec.Extensions, extensions, field.NewPath("spec", "extensions"))...)
 	allErrs = append(allErrs, validateRuntimeCluster(garden.Spec.DNS, garden.Spec.RuntimeCluster, helper.HighAvailabilityEnabled(garden), field.NewPath("spec", "runtimeCluster"))...)
@@ -364,10 +366,21 @@ func validateVirtualCluster(dns *operatorv1alpha1.DNSManagement, virtualCluster
 
 	if virtualCluster.ETCD != nil && virtualCluster.ETCD.Main != nil {
 		allErrs = append(allErrs, validateETCDAutoscaling(virtualCluster.ETCD.Main.Autoscaling, corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("300M")}, fldPath.Child("etcd", "main", "autoscaling"))...)
+		allErrs = append(allErrs, validateETCDQuota(virtualCluster.ETCD.Main.Quota, virtualCluster.ETCD.Main.Storage, fldPath.Child("etcd", "main", "quota"))...)
+		allErrs = append(allErrs, validateETCDBackup(virtualCluster.ETCD.Main.Backup, fldPath.Child("etcd", "main", "backup"))...)
+	}
+
+	if virtualCluster.Maintenance.AutoRotation != nil && virtualCluster.Maintenance.AutoRotation.Credentials != nil {
+		credentials := virtualCluster.Maintenance.AutoRotation.Credentials
+		credentialsPath := fldPath.Child("maintenance", "autoRotation", "credentials")
+
+		allErrs = append(allErrs, validatePhasedRotationConfig(credentials.CertificateAuthorities, credentialsPath.Child("certificateAuthorities"))...)
+		allErrs = append(allErrs, validatePhasedRotationConfig(credentials.ServiceAccountKey, credentialsPath.Child("serviceAccountKey"))...)
 	}
 
 	if virtualCluster.ETCD != nil && virtualCluster.ETCD.Events != nil {
 		allErrs = append(allErrs, validateETCDAutoscaling(virtualCluster.ETCD.Events.Autoscaling, corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("60M")}, fldPath.Child("etcd", "events", "autoscaling"))...)
+		allErrs = append(allErrs, validateETCDQuota(virtualCluster.ETCD.Events.Quota, virtualCluster.ETCD.Events.Storage, fldPath.Child("etcd", "events", "quota"))...)
 	}
 
 	if err := kubernetesversion.CheckIfSupported(virtualCluster.Kubernetes.Version); err != nil {
@@ -410,6 +423,10 @@ func validateVirtualCluster(dns *operatorv1alpha1.DNSManagement, virtualCluster
 		}
 
 		allErrs = append(allErrs, gardencorevalidation.ValidateKubeControllerManager(coreKubeControllerManagerConfig, nil, virtualCluster.Kubernetes.Version, true, path)...)
+
+		if kubeControllerManager.ControllerWorkers != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("controllerWorkers"), "controller workers of the virtual garden kube-controller-manager are managed by gardener-operator"))
+		}
 	}
 
 	allErrs = append(allErrs, validateGardener(dns, virtualCluster.Gardener, virtualCluster.Kubernetes, fldPath.Child("gardener"))...)
@@ -465,6 +482,64 @@ func validateETCDAutoscaling(autoscaling *gardencorev1beta1.ControlPlaneAutoscal
 	return allErrs
 }
 
+func validateETCDQuota(quota *resource.Quantity, storage *operatorv1alpha1.Storage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if quota == nil {
+		return allErrs
+	}
+
+	if quota.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, quota.String(), "quota must be positive"))
+	} else if storage != nil && storage.Capacity != nil && quota.Cmp(*storage.Capacity) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, quota.String(), fmt.Sprintf("quota must not exceed the storage capacity (%s)", storage.Capacity.String())))
+	}
+
+	return allErrs
+}
+
+func validateETCDBackup(backup *operatorv1alpha1.Backup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if backup == nil {
+		return allErrs
+	}
+
+	if backup.FullSnapshotSchedule != nil {
+		if _, err := cron.ParseStandard(*backup.FullSnapshotSchedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("fullSnapshotSchedule"), *backup.FullSnapshotSchedule, fmt.Sprintf("not a valid cron spec: %v", err)))
+		}
+	}
+
+	if backup.DeltaSnapshotRetentionPeriod != nil && backup.DeltaSnapshotRetentionPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deltaSnapshotRetentionPeriod"), backup.DeltaSnapshotRetentionPeriod.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+func validatePhasedRotationConfig(config *operatorv1alpha1.MaintenancePhasedRotationConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	if config.RotationPeriod.Duration < 24*time.Hour || config.RotationPeriod.Duration > 730*24*time.Hour {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rotationPeriod"), config.RotationPeriod.Duration.String(), "value must be between 24h and 730d"))
+	}
+
+	if config.CompletionDelay != nil {
+		if config.CompletionDelay.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("completionDelay"), config.CompletionDelay.Duration.String(), "value must not be negative"))
+		} else if config.CompletionDelay.Duration >= config.RotationPeriod.Duration {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("completionDelay"), config.CompletionDelay.Duration.String(), "value must be less than the rotation period"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateGardener(dns *operatorv1alpha1.DNSManagement, gardener operatorv1alpha1.Gardener, kubernetes operatorv1alpha1.Kubernetes, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -852,6 +927,17 @@ func validateOperationContext(operations []string, garden *operatorv1alpha1.Gard
 				allErrs = append(allErrs, field.Forbidden(fldPath, "cannot start workload identity key rotation if .status.credentials.rotation.workloadIdentityKey.phase is not 'Completed'"))
 			}
 
+		case operatorv1alpha1.OperationRestoreETCDMain:
+			if garden.DeletionTimestamp != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath, "cannot restore main etcd if garden has deletion timestamp"))
+			}
+			if helper.GetETCDMainBackup(garden) == nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath, "cannot restore main etcd if .spec.virtualCluster.etcd.main.backup is not configured"))
+			}
+			if helper.IsETCDMainRestoreInProgress(garden.Status) {
+				allErrs = append(allErrs, field.Forbidden(fldPath, "cannot restore main etcd if a previous restore is still in progress"))
+			}
+
 		case operatorv1alpha1.OperationRotateWorkloadIdentityKeyComplete:
 			if garden.DeletionTimestamp != nil {
 				allErrs = append(allErrs, field.Forbidden(fldPath, "cannot complete workload identity key rotation if garden has deletion timestamp"))
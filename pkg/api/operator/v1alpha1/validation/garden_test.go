@@ -129,6 +129,7 @@ var _ = Describe("Validation Tests", func() {
 				Entry("start Observability key rotation", "rotate-observability-credentials"),
 				Entry("start WorkloadIdentity key rotation", "rotate-workload-identity-key-start"),
 				Entry("complete WorkloadIdentity key rotation", "rotate-workload-identity-key-complete"),
+				Entry("restore main etcd", "restore-etcd-main"),
 			)
 
 			DescribeTable("starting rotation of all credentials",
@@ -1183,6 +1184,44 @@ var _ = Describe("Validation Tests", func() {
 				}),
 			)
 
+			DescribeTable("restoring main etcd",
+				func(allowed bool, backup *operatorv1alpha1.Backup, status operatorv1alpha1.GardenStatus) {
+					metav1.SetMetaDataAnnotation(&garden.ObjectMeta, "gardener.cloud/operation", "restore-etcd-main")
+					if backup != nil {
+						garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{Main: &operatorv1alpha1.ETCDMain{Backup: backup}}
+					}
+					garden.Status = status
+
+					matcher := BeEmpty()
+					if !allowed {
+						matcher = ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("metadata.annotations[gardener.cloud/operation]"),
+						})))
+					}
+
+					Expect(ValidateGarden(garden, extensions)).To(matcher)
+				},
+
+				Entry("backup is not configured", false, nil, operatorv1alpha1.GardenStatus{}),
+				Entry("backup is configured", true, &operatorv1alpha1.Backup{Provider: "local"}, operatorv1alpha1.GardenStatus{}),
+				Entry("previous restore is still in progress", false, &operatorv1alpha1.Backup{Provider: "local"}, operatorv1alpha1.GardenStatus{
+					Backup: &operatorv1alpha1.BackupStatus{
+						Restore: &operatorv1alpha1.BackupRestoreStatus{
+							LastInitiationTime: &metav1.Time{Time: time.Now()},
+						},
+					},
+				}),
+				Entry("previous restore is completed", true, &operatorv1alpha1.Backup{Provider: "local"}, operatorv1alpha1.GardenStatus{
+					Backup: &operatorv1alpha1.BackupStatus{
+						Restore: &operatorv1alpha1.BackupRestoreStatus{
+							LastInitiationTime: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+							LastCompletionTime: &metav1.Time{Time: time.Now()},
+						},
+					},
+				}),
+			)
+
 			DescribeTable("multiple operations",
 				func(operations string, matcher gomegatypes.GomegaMatcher) {
 					metav1.SetMetaDataAnnotation(&garden.ObjectMeta, "gardener.cloud/operation", operations)
@@ -1214,6 +1253,23 @@ var _ = Describe("Validation Tests", func() {
 			)
 		})
 
+		Context("feature gate overrides annotation", func() {
+			It("should allow valid overrides", func() {
+				metav1.SetMetaDataAnnotation(&garden.ObjectMeta, "features.gardener.cloud/overrides", "DefaultSeccompProfile=true")
+
+				Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
+			})
+
+			It("should forbid invalid overrides", func() {
+				metav1.SetMetaDataAnnotation(&garden.ObjectMeta, "features.gardener.cloud/overrides", "DefaultSeccompProfile")
+
+				Expect(ValidateGarden(garden, extensions)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[features.gardener.cloud/overrides]"),
+				}))))
+			})
+		})
+
 		Context("extensions", func() {
 			BeforeEach(func() {
 				garden.Spec.Extensions = []operatorv1alpha1.GardenExtension{
@@ -1631,6 +1687,135 @@ var _ = Describe("Validation Tests", func() {
 
 					Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
 				})
+
+				It("should succeed if valid quotas are configured", func() {
+					garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main: &operatorv1alpha1.ETCDMain{
+							Quota:   ptr.To(resource.MustParse("16Gi")),
+							Storage: &operatorv1alpha1.Storage{Capacity: ptr.To(resource.MustParse("32Gi"))},
+						},
+						Events: &operatorv1alpha1.ETCDEvents{
+							Quota: ptr.To(resource.MustParse("4Gi")),
+						},
+					}
+
+					Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
+				})
+
+				It("should complain if invalid quotas are configured", func() {
+					garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main: &operatorv1alpha1.ETCDMain{
+							Quota:   ptr.To(resource.MustParse("16Gi")),
+							Storage: &operatorv1alpha1.Storage{Capacity: ptr.To(resource.MustParse("10Gi"))},
+						},
+						Events: &operatorv1alpha1.ETCDEvents{
+							Quota: ptr.To(resource.MustParse("0")),
+						},
+					}
+
+					Expect(ValidateGarden(garden, extensions)).To(ContainElements(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.virtualCluster.etcd.main.quota"),
+							"Detail": Equal("quota must not exceed the storage capacity (10Gi)"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.virtualCluster.etcd.events.quota"),
+							"Detail": Equal("quota must be positive"),
+						})),
+					))
+				})
+
+				It("should succeed if a valid backup configuration is provided", func() {
+					garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main: &operatorv1alpha1.ETCDMain{
+							Backup: &operatorv1alpha1.Backup{
+								Provider:                     "local",
+								FullSnapshotSchedule:         ptr.To("0 */6 * * *"),
+								DeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+								RestoreMode:                  ptr.To(operatorv1alpha1.RestoreModeRecovery),
+							},
+						},
+					}
+
+					Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
+				})
+
+				It("should complain if an invalid backup configuration is provided", func() {
+					garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+						Main: &operatorv1alpha1.ETCDMain{
+							Backup: &operatorv1alpha1.Backup{
+								Provider:                     "local",
+								FullSnapshotSchedule:         ptr.To("foo"),
+								DeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: -time.Hour},
+							},
+						},
+					}
+
+					Expect(ValidateGarden(garden, extensions)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.virtualCluster.etcd.main.backup.fullSnapshotSchedule"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.virtualCluster.etcd.main.backup.deltaSnapshotRetentionPeriod"),
+							"Detail": Equal("must be positive"),
+						})),
+					))
+				})
+			})
+
+			Context("Maintenance", func() {
+				It("should succeed if a valid auto rotation configuration is provided", func() {
+					garden.Spec.VirtualCluster.Maintenance.AutoRotation = &operatorv1alpha1.MaintenanceAutoRotation{
+						Credentials: &operatorv1alpha1.MaintenanceCredentialsAutoRotation{
+							CertificateAuthorities: &operatorv1alpha1.MaintenancePhasedRotationConfig{
+								RotationPeriod:  metav1.Duration{Duration: 365 * 24 * time.Hour},
+								CompletionDelay: &metav1.Duration{Duration: 14 * 24 * time.Hour},
+							},
+							ServiceAccountKey: &operatorv1alpha1.MaintenancePhasedRotationConfig{
+								RotationPeriod: metav1.Duration{Duration: 90 * 24 * time.Hour},
+							},
+						},
+					}
+
+					Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
+				})
+
+				It("should complain if an invalid auto rotation configuration is provided", func() {
+					garden.Spec.VirtualCluster.Maintenance.AutoRotation = &operatorv1alpha1.MaintenanceAutoRotation{
+						Credentials: &operatorv1alpha1.MaintenanceCredentialsAutoRotation{
+							CertificateAuthorities: &operatorv1alpha1.MaintenancePhasedRotationConfig{
+								RotationPeriod:  metav1.Duration{Duration: 30 * 24 * time.Hour},
+								CompletionDelay: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+							},
+							ServiceAccountKey: &operatorv1alpha1.MaintenancePhasedRotationConfig{
+								RotationPeriod:  metav1.Duration{Duration: time.Hour},
+								CompletionDelay: &metav1.Duration{Duration: -time.Hour},
+							},
+						},
+					}
+
+					Expect(ValidateGarden(garden, extensions)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.virtualCluster.maintenance.autoRotation.credentials.certificateAuthorities.completionDelay"),
+							"Detail": Equal("value must be less than the rotation period"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.virtualCluster.maintenance.autoRotation.credentials.serviceAccountKey.rotationPeriod"),
+							"Detail": Equal("value must be between 24h and 730d"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.virtualCluster.maintenance.autoRotation.credentials.serviceAccountKey.completionDelay"),
+							"Detail": Equal("value must not be negative"),
+						})),
+					))
+				})
 			})
 
 			Context("Networking", func() {
@@ -2709,6 +2894,23 @@ var _ = Describe("Validation Tests", func() {
 						Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
 					})
 				})
+
+				Context("kubeControllerManager", func() {
+					It("should forbid configuring controller workers", func() {
+						garden.Spec.VirtualCluster.Kubernetes.KubeControllerManager = &operatorv1alpha1.KubeControllerManagerConfig{
+							KubeControllerManagerConfig: &gardencorev1beta1.KubeControllerManagerConfig{
+								ControllerWorkers: &gardencorev1beta1.ControllerWorkersConfig{GarbageCollector: ptr.To[int32](100)},
+							},
+						}
+
+						Expect(ValidateGarden(garden, extensions)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.virtualCluster.kubernetes.kubeControllerManager.controllerWorkers"),
+							})),
+						))
+					})
+				})
 			})
 		})
 
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// RenderSeedTemplate applies the given overrides as JSON merge patch (RFC 7386) to the given seed template and returns
+// the result. The seed name is always cleared since it is determined by the ManagedSeed.
+func RenderSeedTemplate(template *gardencorev1beta1.SeedTemplate, overrides *runtime.RawExtension) (*gardencorev1beta1.SeedTemplate, error) {
+	rendered := template.DeepCopy()
+
+	if overrides != nil && len(overrides.Raw) > 0 {
+		original, err := json.Marshal(template)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal seed template: %w", err)
+		}
+
+		patched, err := jsonpatch.MergePatch(original, overrides.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply overrides to seed template: %w", err)
+		}
+
+		rendered = &gardencorev1beta1.SeedTemplate{}
+		if err := json.Unmarshal(patched, rendered); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal rendered seed template: %w", err)
+		}
+	}
+
+	rendered.Name = ""
+	return rendered, nil
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/gardener/gardener/pkg/api/seedmanagement/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Helper", func() {
+	Describe("#RenderSeedTemplate", func() {
+		var template *gardencorev1beta1.SeedTemplate
+
+		BeforeEach(func() {
+			template = &gardencorev1beta1.SeedTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "seed",
+					Labels: map[string]string{"foo": "bar", "bar": "baz"},
+				},
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{
+						Type:   "local",
+						Region: "local",
+						Zones:  []string{"a", "b"},
+					},
+				},
+			}
+		})
+
+		It("should return the template without name if there are no overrides", func() {
+			rendered, err := RenderSeedTemplate(template, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := template.DeepCopy()
+			expected.Name = ""
+			Expect(rendered).To(Equal(expected))
+			Expect(template.Name).To(Equal("seed"))
+		})
+
+		It("should apply the overrides as JSON merge patch", func() {
+			rendered, err := RenderSeedTemplate(template, &runtime.RawExtension{Raw: []byte(`{"metadata":{"labels":{"bar":null,"baz":"qux"}},"spec":{"provider":{"zones":["c"]}}}`)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rendered.Name).To(BeEmpty())
+			Expect(rendered.Labels).To(Equal(map[string]string{"foo": "bar", "baz": "qux"}))
+			Expect(rendered.Spec.Provider).To(Equal(gardencorev1beta1.SeedProvider{
+				Type:   "local",
+				Region: "local",
+				Zones:  []string{"c"},
+			}))
+		})
+
+		It("should fail if the overrides are not valid JSON", func() {
+			_, err := RenderSeedTemplate(template, &runtime.RawExtension{Raw: []byte(`{`)})
+			Expect(err).To(MatchError(ContainSubstring("failed to apply overrides to seed template")))
+		})
+	})
+})
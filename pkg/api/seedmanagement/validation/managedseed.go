@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 	"slices"
 
@@ -149,6 +150,28 @@ func validateGardenlet(gardenlet *seedmanagement.GardenletConfig, fldPath *field
 		}
 	}
 
+	if gardenlet.SeedTemplateRef != nil {
+		allErrs = append(allErrs, validateSeedTemplateReference(gardenlet.SeedTemplateRef, fldPath.Child("seedTemplateRef"))...)
+	}
+
+	return allErrs
+}
+
+func validateSeedTemplateReference(ref *seedmanagement.SeedTemplateReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ref.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "seed template name is required"))
+	}
+
+	if ref.Overrides != nil {
+		// The overrides are applied as JSON merge patch, hence they must be a JSON object.
+		var patch map[string]any
+		if err := json.Unmarshal(ref.Overrides.Raw, &patch); err != nil || patch == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("overrides"), string(ref.Overrides.Raw), "overrides must be a JSON object"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -11,6 +11,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
@@ -360,6 +361,34 @@ var _ = Describe("ManagedSeed Validation Tests", func() {
 					})),
 				))
 			})
+
+			It("should allow a valid seed template reference", func() {
+				managedSeed.Spec.Gardenlet.SeedTemplateRef = &seedmanagement.SeedTemplateReference{
+					Name:      "template",
+					Overrides: &runtime.RawExtension{Raw: []byte(`{"spec":{"provider":{"zones":["a"]}}}`)},
+				}
+
+				Expect(ValidateManagedSeed(managedSeed)).To(BeEmpty())
+			})
+
+			It("should forbid invalid seed template references", func() {
+				managedSeed.Spec.Gardenlet.SeedTemplateRef = &seedmanagement.SeedTemplateReference{
+					Overrides: &runtime.RawExtension{Raw: []byte(`["foo"]`)},
+				}
+
+				errorList := ValidateManagedSeed(managedSeed)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.gardenlet.seedTemplateRef.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.gardenlet.seedTemplateRef.overrides"),
+					})),
+				))
+			})
 		})
 	})
 
@@ -154,6 +154,11 @@ func validateRollingUpdateStrategy(rus *seedmanagement.RollingUpdateStrategy, fl
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*rus.Partition), fldPath.Child("partition"))...)
 	}
 
+	// Ensure maxSurge is non-negative if specified
+	if rus.MaxSurge != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*rus.MaxSurge), fldPath.Child("maxSurge"))...)
+	}
+
 	return allErrs
 }
 
@@ -193,7 +198,10 @@ func ValidateManagedSeedTemplateForManagedSeedSet(template *seedmanagement.Manag
 			return allErrs
 		}
 		if gardenletConfig.SeedConfig == nil {
-			allErrs = append(allErrs, field.Required(configPath.Child("seedConfig"), "seedConfig is required"))
+			// The seedConfig is rendered from the referenced SeedTemplate, if any.
+			if template.Spec.Gardenlet.SeedTemplateRef == nil {
+				allErrs = append(allErrs, field.Required(configPath.Child("seedConfig"), "seedConfig is required"))
+			}
 		} else {
 			allErrs = append(allErrs, validateTemplateLabels(&gardenletConfig.SeedConfig.ObjectMeta, selector, configPath.Child("seedConfig").Child("metadata"))...)
 		}
@@ -18,6 +18,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/api/seedmanagement/validation"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/seedmanagement"
@@ -173,9 +174,10 @@ var _ = Describe("ManagedSeedSet Validation Tests", func() {
 			),
 		)
 
-		It("should forbid negative replicas, updateStrategy.rollingUpdate.partition, and revisionHistoryLimit", func() {
+		It("should forbid negative replicas, updateStrategy.rollingUpdate.partition, updateStrategy.rollingUpdate.maxSurge, and revisionHistoryLimit", func() {
 			managedSeedSet.Spec.Replicas = ptr.To(int32(-1))
 			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.Partition = ptr.To(int32(-1))
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.MaxSurge = ptr.To(int32(-1))
 			managedSeedSet.Spec.RevisionHistoryLimit = ptr.To(int32(-1))
 
 			errorList := ValidateManagedSeedSet(managedSeedSet)
@@ -189,6 +191,10 @@ var _ = Describe("ManagedSeedSet Validation Tests", func() {
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal("spec.updateStrategy.rollingUpdate.partition"),
 				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.updateStrategy.rollingUpdate.maxSurge"),
+				})),
 				PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal("spec.revisionHistoryLimit"),
@@ -306,6 +312,28 @@ var _ = Describe("ManagedSeedSet Validation Tests", func() {
 			))
 		})
 
+		It("should require the seedConfig in the template if no seed template is referenced", func() {
+			managedSeedSet.Spec.Template.Spec.Gardenlet = seedmanagement.GardenletConfig{
+				Config: &gardenletconfigv1alpha1.GardenletConfiguration{},
+			}
+
+			Expect(ValidateManagedSeedSet(managedSeedSet)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.template.spec.gardenlet.config.seedConfig"),
+				})),
+			))
+		})
+
+		It("should allow templates without seedConfig if a seed template is referenced", func() {
+			managedSeedSet.Spec.Template.Spec.Gardenlet = seedmanagement.GardenletConfig{
+				Config:          &gardenletconfigv1alpha1.GardenletConfiguration{},
+				SeedTemplateRef: &seedmanagement.SeedTemplateReference{Name: "template"},
+			}
+
+			Expect(ValidateManagedSeedSet(managedSeedSet)).To(BeEmpty())
+		})
+
 		It("should forbid empty or invalid fields in shootTemplate", func() {
 			shootCopy := shoot.DeepCopy()
 			shootCopy.Spec.Provider.Type = ""
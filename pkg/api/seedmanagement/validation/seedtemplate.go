@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	gardencorevalidation "github.com/gardener/gardener/pkg/api/core/validation"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement"
+)
+
+// ValidateSeedTemplate validates a SeedTemplate object.
+func ValidateSeedTemplate(seedTemplate *seedmanagement.SeedTemplate) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&seedTemplate.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateSeedTemplateTemplate(&seedTemplate.Template, field.NewPath("template"))...)
+
+	return allErrs
+}
+
+// ValidateSeedTemplateUpdate validates a SeedTemplate object before an update.
+func ValidateSeedTemplateUpdate(newSeedTemplate, oldSeedTemplate *seedmanagement.SeedTemplate) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newSeedTemplate.ObjectMeta, &oldSeedTemplate.ObjectMeta, field.NewPath("metadata"))...)
+	// The template is rendered into the seed configuration of all referencing ManagedSeeds, hence it must not change
+	// fields which are immutable for seeds.
+	allErrs = append(allErrs, gardencorevalidation.ValidateSeedTemplateUpdate(&newSeedTemplate.Template, &oldSeedTemplate.Template, field.NewPath("template"))...)
+	allErrs = append(allErrs, ValidateSeedTemplate(newSeedTemplate)...)
+
+	return allErrs
+}
+
+func validateSeedTemplateTemplate(template *gardencore.SeedTemplate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Ensure name is not specified since it will be set by the ManagedSeed controller
+	if template.Name != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("metadata", "name"), "seed name is forbidden"))
+	}
+
+	allErrs = append(allErrs, gardencorevalidation.ValidateSeedTemplate(template, fldPath)...)
+
+	return allErrs
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/api/seedmanagement/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement"
+)
+
+var _ = Describe("SeedTemplate Validation Tests", func() {
+	var seedTemplate *seedmanagement.SeedTemplate
+
+	BeforeEach(func() {
+		seedTemplate = &seedmanagement.SeedTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "template",
+			},
+			Template: core.SeedTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"foo": "bar"},
+				},
+				Spec: core.SeedSpec{
+					DNS: core.SeedDNS{
+						Internal: &core.SeedDNSProviderConfig{
+							Type:   "foo",
+							Domain: "internal.example.com",
+							CredentialsRef: corev1.ObjectReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "internal-secret",
+								Namespace:  "garden",
+							},
+						},
+					},
+					Networks: core.SeedNetworks{
+						Nodes: ptr.To("10.250.0.0/16"),
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateSeedTemplate", func() {
+		It("should allow valid resources", func() {
+			Expect(ValidateSeedTemplate(seedTemplate)).To(BeEmpty())
+		})
+
+		It("should forbid invalid metadata", func() {
+			seedTemplate.Name = "template.foo"
+			seedTemplate.Namespace = "garden"
+
+			Expect(ValidateSeedTemplate(seedTemplate)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("metadata.namespace"),
+				})),
+			))
+		})
+
+		It("should forbid a seed name and invalid fields in the template", func() {
+			seedTemplate.Template.Name = "seed"
+			seedTemplate.Template.Spec.Networks.Nodes = ptr.To("foo")
+
+			Expect(ValidateSeedTemplate(seedTemplate)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("template.metadata.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("template.spec.networks.nodes"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateSeedTemplateUpdate", func() {
+		var newSeedTemplate *seedmanagement.SeedTemplate
+
+		BeforeEach(func() {
+			seedTemplate.ResourceVersion = "1"
+			newSeedTemplate = seedTemplate.DeepCopy()
+		})
+
+		It("should allow valid updates", func() {
+			newSeedTemplate.Template.Labels["bar"] = "baz"
+
+			Expect(ValidateSeedTemplateUpdate(newSeedTemplate, seedTemplate)).To(BeEmpty())
+		})
+
+		It("should forbid changes to fields which are immutable for seeds", func() {
+			newSeedTemplate.Template.Spec.Networks.Nodes = ptr.To("10.251.0.0/16")
+
+			Expect(ValidateSeedTemplateUpdate(newSeedTemplate, seedTemplate)).To(ContainElement(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("template.spec.networks.nodes"),
+				})),
+			))
+		})
+	})
+})
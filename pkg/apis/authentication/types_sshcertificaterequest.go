@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authentication
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHCertificateRequest can be used to request a short-lived SSH certificate for accessing the nodes of a Shoot
+// cluster.
+type SSHCertificateRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec is the specification of the SSHCertificateRequest.
+	Spec SSHCertificateRequestSpec
+	// Status is the status of the SSHCertificateRequest.
+	Status SSHCertificateRequestStatus
+}
+
+// SSHCertificateRequestSpec contains the public key to be signed and the expiration time of the certificate.
+type SSHCertificateRequestSpec struct {
+	// PublicKey is the SSH public key in the OpenSSH authorized_keys format which should be signed.
+	PublicKey string
+	// ExpirationSeconds is the requested validity duration of the certificate. The certificate issuer may return a
+	// certificate with a different validity duration so a client needs to check the 'expirationTimestamp' field in a
+	// response.
+	// Defaults to 1 hour.
+	ExpirationSeconds int64
+}
+
+// SSHCertificateRequestStatus is the status of the SSHCertificateRequest containing the signed certificate and its
+// expiration.
+type SSHCertificateRequestStatus struct {
+	// Certificate is the signed SSH certificate in the OpenSSH authorized_keys format.
+	Certificate string
+	// Username is the name of the user on the nodes the certificate is valid for.
+	Username string
+	// ExpirationTimestamp is the expiration timestamp of the returned certificate.
+	ExpirationTimestamp metav1.Time
+}
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/utils/ptr"
+)
+
+// SetDefaults_SSHCertificateRequestSpec sets default values for SSHCertificateRequestSpec objects.
+func SetDefaults_SSHCertificateRequestSpec(obj *SSHCertificateRequestSpec) {
+	if obj.ExpirationSeconds == nil {
+		obj.ExpirationSeconds = ptr.To(int64(60 * 60))
+	}
+}
@@ -15,6 +15,12 @@ func (*AdminKubeconfigRequestSpec) ProtoMessage() {}
 
 func (*AdminKubeconfigRequestStatus) ProtoMessage() {}
 
+func (*SSHCertificateRequest) ProtoMessage() {}
+
+func (*SSHCertificateRequestSpec) ProtoMessage() {}
+
+func (*SSHCertificateRequestStatus) ProtoMessage() {}
+
 func (*ViewerKubeconfigRequest) ProtoMessage() {}
 
 func (*ViewerKubeconfigRequestSpec) ProtoMessage() {}
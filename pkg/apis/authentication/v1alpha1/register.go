@@ -38,6 +38,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&AdminKubeconfigRequest{},
 		&ViewerKubeconfigRequest{},
+		&SSHCertificateRequest{},
 	)
 
 	return nil
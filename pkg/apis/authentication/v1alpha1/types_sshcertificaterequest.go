@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHCertificateRequest can be used to request a short-lived SSH certificate for accessing
+// the nodes of a Shoot cluster.
+type SSHCertificateRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of the SSHCertificateRequest.
+	Spec SSHCertificateRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the SSHCertificateRequest.
+	Status SSHCertificateRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// SSHCertificateRequestSpec contains the public key to be signed and the expiration
+// time of the certificate.
+type SSHCertificateRequestSpec struct {
+	// PublicKey is the SSH public key in the OpenSSH authorized_keys format which should
+	// be signed.
+	PublicKey string `json:"publicKey" protobuf:"bytes,1,opt,name=publicKey"`
+	// ExpirationSeconds is the requested validity duration of the certificate. The
+	// certificate issuer may return a certificate with a different validity duration so a
+	// client needs to check the 'expirationTimestamp' field in a response.
+	// Defaults to 1 hour.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty" protobuf:"varint,2,opt,name=expirationSeconds"`
+}
+
+// SSHCertificateRequestStatus is the status of the SSHCertificateRequest containing
+// the signed certificate and its expiration.
+type SSHCertificateRequestStatus struct {
+	// Certificate is the signed SSH certificate in the OpenSSH authorized_keys format.
+	Certificate string `json:"certificate" protobuf:"bytes,1,opt,name=certificate"`
+	// Username is the name of the user on the nodes the certificate is valid for.
+	Username string `json:"username" protobuf:"bytes,2,opt,name=username"`
+	// ExpirationTimestamp is the expiration timestamp of the returned certificate.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp" protobuf:"bytes,3,opt,name=expirationTimestamp"`
+}
@@ -11,6 +11,7 @@ package v1alpha1
 
 import (
 	authentication "github.com/gardener/gardener/pkg/apis/authentication"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -22,6 +23,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*SSHCertificateRequest)(nil), (*authentication.SSHCertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SSHCertificateRequest_To_authentication_SSHCertificateRequest(a.(*SSHCertificateRequest), b.(*authentication.SSHCertificateRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*authentication.SSHCertificateRequest)(nil), (*SSHCertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_authentication_SSHCertificateRequest_To_v1alpha1_SSHCertificateRequest(a.(*authentication.SSHCertificateRequest), b.(*SSHCertificateRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHCertificateRequestSpec)(nil), (*authentication.SSHCertificateRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SSHCertificateRequestSpec_To_authentication_SSHCertificateRequestSpec(a.(*SSHCertificateRequestSpec), b.(*authentication.SSHCertificateRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*authentication.SSHCertificateRequestSpec)(nil), (*SSHCertificateRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_authentication_SSHCertificateRequestSpec_To_v1alpha1_SSHCertificateRequestSpec(a.(*authentication.SSHCertificateRequestSpec), b.(*SSHCertificateRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHCertificateRequestStatus)(nil), (*authentication.SSHCertificateRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SSHCertificateRequestStatus_To_authentication_SSHCertificateRequestStatus(a.(*SSHCertificateRequestStatus), b.(*authentication.SSHCertificateRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*authentication.SSHCertificateRequestStatus)(nil), (*SSHCertificateRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_authentication_SSHCertificateRequestStatus_To_v1alpha1_SSHCertificateRequestStatus(a.(*authentication.SSHCertificateRequestStatus), b.(*SSHCertificateRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*authentication.KubeconfigRequest)(nil), (*AdminKubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_authentication_KubeconfigRequest_To_v1alpha1_AdminKubeconfigRequest(a.(*authentication.KubeconfigRequest), b.(*AdminKubeconfigRequest), scope)
 	}); err != nil {
@@ -44,3 +75,85 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}
 	return nil
 }
+
+func autoConvert_v1alpha1_SSHCertificateRequest_To_authentication_SSHCertificateRequest(in *SSHCertificateRequest, out *authentication.SSHCertificateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_SSHCertificateRequestSpec_To_authentication_SSHCertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SSHCertificateRequestStatus_To_authentication_SSHCertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SSHCertificateRequest_To_authentication_SSHCertificateRequest is an autogenerated conversion function.
+func Convert_v1alpha1_SSHCertificateRequest_To_authentication_SSHCertificateRequest(in *SSHCertificateRequest, out *authentication.SSHCertificateRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SSHCertificateRequest_To_authentication_SSHCertificateRequest(in, out, s)
+}
+
+func autoConvert_authentication_SSHCertificateRequest_To_v1alpha1_SSHCertificateRequest(in *authentication.SSHCertificateRequest, out *SSHCertificateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_authentication_SSHCertificateRequestSpec_To_v1alpha1_SSHCertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_authentication_SSHCertificateRequestStatus_To_v1alpha1_SSHCertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_authentication_SSHCertificateRequest_To_v1alpha1_SSHCertificateRequest is an autogenerated conversion function.
+func Convert_authentication_SSHCertificateRequest_To_v1alpha1_SSHCertificateRequest(in *authentication.SSHCertificateRequest, out *SSHCertificateRequest, s conversion.Scope) error {
+	return autoConvert_authentication_SSHCertificateRequest_To_v1alpha1_SSHCertificateRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_SSHCertificateRequestSpec_To_authentication_SSHCertificateRequestSpec(in *SSHCertificateRequestSpec, out *authentication.SSHCertificateRequestSpec, s conversion.Scope) error {
+	out.PublicKey = in.PublicKey
+	if err := v1.Convert_Pointer_int64_To_int64(&in.ExpirationSeconds, &out.ExpirationSeconds, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SSHCertificateRequestSpec_To_authentication_SSHCertificateRequestSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SSHCertificateRequestSpec_To_authentication_SSHCertificateRequestSpec(in *SSHCertificateRequestSpec, out *authentication.SSHCertificateRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SSHCertificateRequestSpec_To_authentication_SSHCertificateRequestSpec(in, out, s)
+}
+
+func autoConvert_authentication_SSHCertificateRequestSpec_To_v1alpha1_SSHCertificateRequestSpec(in *authentication.SSHCertificateRequestSpec, out *SSHCertificateRequestSpec, s conversion.Scope) error {
+	out.PublicKey = in.PublicKey
+	if err := v1.Convert_int64_To_Pointer_int64(&in.ExpirationSeconds, &out.ExpirationSeconds, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_authentication_SSHCertificateRequestSpec_To_v1alpha1_SSHCertificateRequestSpec is an autogenerated conversion function.
+func Convert_authentication_SSHCertificateRequestSpec_To_v1alpha1_SSHCertificateRequestSpec(in *authentication.SSHCertificateRequestSpec, out *SSHCertificateRequestSpec, s conversion.Scope) error {
+	return autoConvert_authentication_SSHCertificateRequestSpec_To_v1alpha1_SSHCertificateRequestSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SSHCertificateRequestStatus_To_authentication_SSHCertificateRequestStatus(in *SSHCertificateRequestStatus, out *authentication.SSHCertificateRequestStatus, s conversion.Scope) error {
+	out.Certificate = in.Certificate
+	out.Username = in.Username
+	out.ExpirationTimestamp = in.ExpirationTimestamp
+	return nil
+}
+
+// Convert_v1alpha1_SSHCertificateRequestStatus_To_authentication_SSHCertificateRequestStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SSHCertificateRequestStatus_To_authentication_SSHCertificateRequestStatus(in *SSHCertificateRequestStatus, out *authentication.SSHCertificateRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SSHCertificateRequestStatus_To_authentication_SSHCertificateRequestStatus(in, out, s)
+}
+
+func autoConvert_authentication_SSHCertificateRequestStatus_To_v1alpha1_SSHCertificateRequestStatus(in *authentication.SSHCertificateRequestStatus, out *SSHCertificateRequestStatus, s conversion.Scope) error {
+	out.Certificate = in.Certificate
+	out.Username = in.Username
+	out.ExpirationTimestamp = in.ExpirationTimestamp
+	return nil
+}
+
+// Convert_authentication_SSHCertificateRequestStatus_To_v1alpha1_SSHCertificateRequestStatus is an autogenerated conversion function.
+func Convert_authentication_SSHCertificateRequestStatus_To_v1alpha1_SSHCertificateRequestStatus(in *authentication.SSHCertificateRequestStatus, out *SSHCertificateRequestStatus, s conversion.Scope) error {
+	return autoConvert_authentication_SSHCertificateRequestStatus_To_v1alpha1_SSHCertificateRequestStatus(in, out, s)
+}
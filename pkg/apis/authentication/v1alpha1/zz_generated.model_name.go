@@ -24,6 +24,21 @@ func (in AdminKubeconfigRequestStatus) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.authentication.v1alpha1.AdminKubeconfigRequestStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SSHCertificateRequest) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.authentication.v1alpha1.SSHCertificateRequest"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SSHCertificateRequestSpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.authentication.v1alpha1.SSHCertificateRequestSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SSHCertificateRequestStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.authentication.v1alpha1.SSHCertificateRequestStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ViewerKubeconfigRequest) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.authentication.v1alpha1.ViewerKubeconfigRequest"
@@ -78,3 +78,64 @@ func (in *KubeconfigRequestStatus) DeepCopy() *KubeconfigRequestStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCertificateRequest) DeepCopyInto(out *SSHCertificateRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateRequest.
+func (in *SSHCertificateRequest) DeepCopy() *SSHCertificateRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHCertificateRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSHCertificateRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCertificateRequestSpec) DeepCopyInto(out *SSHCertificateRequestSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateRequestSpec.
+func (in *SSHCertificateRequestSpec) DeepCopy() *SSHCertificateRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHCertificateRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCertificateRequestStatus) DeepCopyInto(out *SSHCertificateRequestStatus) {
+	*out = *in
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateRequestStatus.
+func (in *SSHCertificateRequestStatus) DeepCopy() *SSHCertificateRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHCertificateRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
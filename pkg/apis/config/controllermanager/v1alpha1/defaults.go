@@ -104,6 +104,12 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 			Duration: 12 * time.Hour,
 		}
 	}
+	if obj.StaleLockPeriodDays == nil {
+		obj.StaleLockPeriodDays = ptr.To(0)
+	}
+	if obj.StaleSignals == nil {
+		obj.StaleSignals = &ProjectStaleSignals{}
+	}
 
 	for i, quota := range obj.Quotas {
 		if quota.ProjectSelector == nil {
@@ -112,6 +118,16 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 	}
 }
 
+// SetDefaults_ProjectStaleSignals sets defaults for the ProjectStaleSignals.
+func SetDefaults_ProjectStaleSignals(obj *ProjectStaleSignals) {
+	if obj.APIActivity == nil {
+		obj.APIActivity = ptr.To(true)
+	}
+	if obj.MemberLogins == nil {
+		obj.MemberLogins = ptr.To(true)
+	}
+}
+
 // SetDefaults_ServerConfiguration sets defaults for the ServerConfiguration.
 func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.HealthProbes == nil {
@@ -229,6 +245,13 @@ func SetDefaults_SeedReferenceControllerConfiguration(obj *SeedReferenceControll
 	}
 }
 
+// SetDefaults_SeedTemplateControllerConfiguration sets defaults for the SeedTemplateControllerConfiguration.
+func SetDefaults_SeedTemplateControllerConfiguration(obj *SeedTemplateControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+}
+
 // SetDefaults_ShootHibernationControllerConfiguration sets defaults for the ShootHibernationControllerConfiguration.
 func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -275,6 +298,39 @@ func SetDefaults_ShootConditionsControllerConfiguration(obj *ShootConditionsCont
 	}
 }
 
+// SetDefaults_CertificateExpiryControllerConfiguration sets defaults for the CertificateExpiryControllerConfiguration.
+func SetDefaults_CertificateExpiryControllerConfiguration(obj *CertificateExpiryControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.ExpiryWindow == nil {
+		obj.ExpiryWindow = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	}
+}
+
+// SetDefaults_ShootForceUpgradeControllerConfiguration sets defaults for the ShootForceUpgradeControllerConfiguration.
+func SetDefaults_ShootForceUpgradeControllerConfiguration(obj *ShootForceUpgradeControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.BatchSize == nil {
+		obj.BatchSize = ptr.To(5)
+	}
+	if obj.NotificationLeadTime == nil {
+		obj.NotificationLeadTime = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+	}
+	if obj.MaxNotificationLeadTime == nil {
+		obj.MaxNotificationLeadTime = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	}
+}
+
+// SetDefaults_BusinessHours sets defaults for the BusinessHours.
+func SetDefaults_BusinessHours(obj *BusinessHours) {
+	if obj.Location == nil {
+		obj.Location = ptr.To("UTC")
+	}
+}
+
 // SetDefaults_EventControllerConfiguration sets defaults for the EventControllerConfiguration.
 func SetDefaults_EventControllerConfiguration(obj *EventControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -363,6 +419,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.SeedReference == nil {
 		obj.SeedReference = &SeedReferenceControllerConfiguration{}
 	}
+	if obj.SeedTemplate == nil {
+		obj.SeedTemplate = &SeedTemplateControllerConfiguration{}
+	}
 	if obj.ShootQuota == nil {
 		obj.ShootQuota = &ShootQuotaControllerConfiguration{}
 	}
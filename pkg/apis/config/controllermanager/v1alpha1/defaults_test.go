@@ -183,6 +183,11 @@ var _ = Describe("Defaults", func() {
 				StaleSyncPeriod: &metav1.Duration{
 					Duration: 12 * time.Hour,
 				},
+				StaleLockPeriodDays: ptr.To(0),
+				StaleSignals: &ProjectStaleSignals{
+					APIActivity:  ptr.To(true),
+					MemberLogins: ptr.To(true),
+				},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -224,6 +229,11 @@ var _ = Describe("Defaults", func() {
 						StaleSyncPeriod: &metav1.Duration{
 							Duration: 12 * time.Hour,
 						},
+						StaleLockPeriodDays: ptr.To(7),
+						StaleSignals: &ProjectStaleSignals{
+							APIActivity:  ptr.To(false),
+							MemberLogins: ptr.To(false),
+						},
 					},
 				},
 			}
@@ -575,6 +585,31 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedTemplateControllerConfiguration defaulting", func() {
+		It("should default SeedTemplateControllerConfiguration correctly", func() {
+			expected := &SeedTemplateControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedTemplate).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedTemplate: &SeedTemplateControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+					},
+				},
+			}
+			expected := obj.Controllers.SeedTemplate.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedTemplate).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootHibernationControllerConfiguration defaulting", func() {
 		It("should default ShootHibernationControllerConfiguration correctly", func() {
 			expected := &ShootHibernationControllerConfiguration{
@@ -710,6 +745,92 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("CertificateExpiryControllerConfiguration defaulting", func() {
+		It("should default CertificateExpiryControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					CertificateExpiry: &CertificateExpiryControllerConfiguration{},
+				},
+			}
+			expected := &CertificateExpiryControllerConfiguration{
+				SyncPeriod:   &metav1.Duration{Duration: time.Hour},
+				ExpiryWindow: &metav1.Duration{Duration: 720 * time.Hour},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.CertificateExpiry).To(Equal(expected))
+		})
+
+		It("should not default CertificateExpiryControllerConfiguration if not set", func() {
+			var expected *CertificateExpiryControllerConfiguration
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.CertificateExpiry).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					CertificateExpiry: &CertificateExpiryControllerConfiguration{
+						SyncPeriod:   &metav1.Duration{Duration: 2 * time.Hour},
+						ExpiryWindow: &metav1.Duration{Duration: 48 * time.Hour},
+					},
+				},
+			}
+			expected := obj.Controllers.CertificateExpiry.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.CertificateExpiry).To(Equal(expected))
+		})
+	})
+
+	Describe("ShootForceUpgradeControllerConfiguration defaulting", func() {
+		It("should default ShootForceUpgradeControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootForceUpgrade: &ShootForceUpgradeControllerConfiguration{
+						BusinessHours: &BusinessHours{Begin: "08:00", End: "18:00"},
+					},
+				},
+			}
+			expected := &ShootForceUpgradeControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+				BatchSize:               ptr.To(5),
+				NotificationLeadTime:    &metav1.Duration{Duration: 168 * time.Hour},
+				MaxNotificationLeadTime: &metav1.Duration{Duration: 720 * time.Hour},
+				BusinessHours:           &BusinessHours{Begin: "08:00", End: "18:00", Location: ptr.To("UTC")},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootForceUpgrade).To(Equal(expected))
+		})
+
+		It("should not default ShootForceUpgradeControllerConfiguration if not set", func() {
+			var expected *ShootForceUpgradeControllerConfiguration
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootForceUpgrade).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootForceUpgrade: &ShootForceUpgradeControllerConfiguration{
+						SyncPeriod:              &metav1.Duration{Duration: 2 * time.Hour},
+						BatchSize:               ptr.To(10),
+						NotificationLeadTime:    &metav1.Duration{Duration: 24 * time.Hour},
+						MaxNotificationLeadTime: &metav1.Duration{Duration: 48 * time.Hour},
+						BusinessHours:           &BusinessHours{Begin: "08:00", End: "18:00", Location: ptr.To("Europe/Berlin")},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootForceUpgrade.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootForceUpgrade).To(Equal(expected))
+		})
+	})
+
 	Describe("EventControllerConfiguration defaulting", func() {
 		It("should default EventControllerConfiguration correctly if set", func() {
 			obj = &ControllerManagerConfiguration{
@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
@@ -46,6 +47,10 @@ type ControllerManagerControllerConfiguration struct {
 	// Bastion defines the configuration of the Bastion controller.
 	// +optional
 	Bastion *BastionControllerConfiguration `json:"bastion,omitempty"`
+	// CertificateExpiry defines the configuration of the CertificateExpiry controller. If unset, the certificate expiry
+	// controller will be disabled.
+	// +optional
+	CertificateExpiry *CertificateExpiryControllerConfiguration `json:"certificateExpiry,omitempty"`
 	// CertificateSigningRequest defines the configuration of the CertificateSigningRequest controller.
 	// +optional
 	CertificateSigningRequest *CertificateSigningRequestControllerConfiguration `json:"certificateSigningRequest,omitempty"`
@@ -91,6 +96,9 @@ type ControllerManagerControllerConfiguration struct {
 	// SeedReference defines the configuration of the SeedReference controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	SeedReference *SeedReferenceControllerConfiguration `json:"seedReference,omitempty"`
+	// SeedTemplate defines the configuration of the SeedTemplate controller.
+	// +optional
+	SeedTemplate *SeedTemplateControllerConfiguration `json:"seedTemplate,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -110,6 +118,10 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	// +optional
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration `json:"shootStatusLabel,omitempty"`
+	// ShootForceUpgrade defines the configuration of the ShootForceUpgrade controller. If unset, the controller is
+	// disabled and expired Kubernetes versions are force-updated in the next maintenance time windows of the Shoots.
+	// +optional
+	ShootForceUpgrade *ShootForceUpgradeControllerConfiguration `json:"shootForceUpgrade,omitempty"`
 	// ShootMigration defines the configuration of the ShootMigration controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	ShootMigration *ShootMigrationControllerConfiguration `json:"shootMigration,omitempty"`
@@ -141,6 +153,21 @@ type CertificateSigningRequestControllerConfiguration struct {
 	// events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SeedAttestation configures the verification of attestation evidence for CertificateSigningRequests of seed clients
+	// which were requested via bootstrap tokens. If set, such requests are only approved if the gardenlet proves the
+	// identity of the machine it is running on.
+	// +optional
+	SeedAttestation *SeedAttestation `json:"seedAttestation,omitempty"`
+}
+
+// SeedAttestation contains configuration for the verification of attestation evidence of gardenlets registering seeds.
+type SeedAttestation struct {
+	// Verifier is the name of the attestation verifier plugin, e.g. `tpm`. It must match the name of the attestation
+	// provider configured for the gardenlets. The plugin must be compiled into gardener-controller-manager.
+	Verifier string `json:"verifier"`
+	// Config is the plugin-specific configuration of the attestation verifier.
+	// +optional
+	Config *runtime.RawExtension `json:"config,omitempty"`
 }
 
 // CloudProfileControllerConfiguration defines the configuration of the CloudProfile
@@ -179,6 +206,17 @@ type ControllerRegistrationControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// CertificateExpiryControllerConfiguration defines the configuration of the CertificateExpiry controller.
+type CertificateExpiryControllerConfiguration struct {
+	// SyncPeriod is the duration how often the certificates in the garden cluster are scanned (defaults to `1h`).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ExpiryWindow is the duration before the expiration of a certificate in which events are created if no rotation
+	// of the certificate was initiated (defaults to `720h`).
+	// +optional
+	ExpiryWindow *metav1.Duration `json:"expiryWindow,omitempty"`
+}
+
 // EventControllerConfiguration defines the configuration of the Event controller.
 type EventControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
@@ -224,6 +262,28 @@ type ProjectControllerConfiguration struct {
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	// +optional
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// StaleLockPeriodDays is the number of days before the auto-deletion of a stale `Project` during which it is locked,
+	// i.e., no new `Shoot`s can be created in it. Locking is disabled if set to 0.
+	// +optional
+	StaleLockPeriodDays *int `json:"staleLockPeriodDays,omitempty"`
+	// StaleSignals configures the signals which are considered in addition to the resources in the `Project` namespace
+	// to determine whether a `Project` is still actively used.
+	// +optional
+	StaleSignals *ProjectStaleSignals `json:"staleSignals,omitempty"`
+}
+
+// ProjectStaleSignals configures the signals which are considered to determine whether a `Project` is still actively
+// used.
+type ProjectStaleSignals struct {
+	// APIActivity specifies whether a `Project` is considered in use if its last activity timestamp is not older than
+	// the minimum lifetime. Defaults to true.
+	// +optional
+	APIActivity *bool `json:"apiActivity,omitempty"`
+	// MemberLogins specifies whether a `Project` is considered in use if one of its members logged in within the minimum
+	// lifetime. The time of the last login is read from the `project.gardener.cloud/last-member-login-timestamp`
+	// annotation which is expected to be maintained by an authentication hook. Defaults to true.
+	// +optional
+	MemberLogins *bool `json:"memberLogins,omitempty"`
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -324,6 +384,15 @@ type SeedReferenceControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// SeedTemplateControllerConfiguration defines the configuration of the
+// SeedTemplate controller.
+type SeedTemplateControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -339,6 +408,40 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
 }
 
+// ShootForceUpgradeControllerConfiguration defines the configuration of the ShootForceUpgrade controller.
+type ShootForceUpgradeControllerConfiguration struct {
+	// SyncPeriod is the duration between two waves of force upgrades (defaults to `1h`).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// BatchSize is the maximum number of Shoots per Seed whose force upgrade is triggered in one wave (defaults to `5`).
+	// +optional
+	BatchSize *int `json:"batchSize,omitempty"`
+	// NotificationLeadTime is the minimum duration between notifying the project members about the upcoming force
+	// upgrade of a Shoot and triggering it (defaults to `168h`). Projects can increase it up to MaxNotificationLeadTime
+	// via the `shoot.gardener.cloud/force-upgrade-notification-lead-time` annotation.
+	// +optional
+	NotificationLeadTime *metav1.Duration `json:"notificationLeadTime,omitempty"`
+	// MaxNotificationLeadTime is the maximum notification lead time which can be configured by projects (defaults to
+	// `720h`).
+	// +optional
+	MaxNotificationLeadTime *metav1.Duration `json:"maxNotificationLeadTime,omitempty"`
+	// BusinessHours are the hours on weekdays during which the force upgrade of Shoots with a highly available control
+	// plane is not triggered. If unset, such Shoots are upgraded at any time.
+	// +optional
+	BusinessHours *BusinessHours `json:"businessHours,omitempty"`
+}
+
+// BusinessHours are the hours from Monday to Friday during which disruptive operations should be avoided.
+type BusinessHours struct {
+	// Begin is the beginning of the business hours in the format `HH:MM`.
+	Begin string `json:"begin"`
+	// End is the end of the business hours in the format `HH:MM`.
+	End string `json:"end"`
+	// Location is the name of the time zone (e.g., `Europe/Berlin`) the business hours refer to (defaults to `UTC`).
+	// +optional
+	Location *string `json:"location,omitempty"`
+}
+
 // ShootQuotaControllerConfiguration defines the configuration of the
 // ShootQuota controller.
 type ShootQuotaControllerConfiguration struct {
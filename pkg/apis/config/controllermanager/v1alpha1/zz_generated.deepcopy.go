@@ -41,6 +41,53 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BusinessHours) DeepCopyInto(out *BusinessHours) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BusinessHours.
+func (in *BusinessHours) DeepCopy() *BusinessHours {
+	if in == nil {
+		return nil
+	}
+	out := new(BusinessHours)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateExpiryControllerConfiguration) DeepCopyInto(out *CertificateExpiryControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExpiryWindow != nil {
+		in, out := &in.ExpiryWindow, &out.ExpiryWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExpiryControllerConfiguration.
+func (in *CertificateExpiryControllerConfiguration) DeepCopy() *CertificateExpiryControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateExpiryControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateSigningRequestControllerConfiguration) DeepCopyInto(out *CertificateSigningRequestControllerConfiguration) {
 	*out = *in
@@ -49,6 +96,11 @@ func (in *CertificateSigningRequestControllerConfiguration) DeepCopyInto(out *Ce
 		*out = new(int)
 		**out = **in
 	}
+	if in.SeedAttestation != nil {
+		in, out := &in.SeedAttestation, &out.SeedAttestation
+		*out = new(SeedAttestation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -174,6 +226,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(BastionControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CertificateExpiry != nil {
+		in, out := &in.CertificateExpiry, &out.CertificateExpiry
+		*out = new(CertificateExpiryControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CertificateSigningRequest != nil {
 		in, out := &in.CertificateSigningRequest, &out.CertificateSigningRequest
 		*out = new(CertificateSigningRequestControllerConfiguration)
@@ -249,6 +306,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedReferenceControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedTemplate != nil {
+		in, out := &in.SeedTemplate, &out.SeedTemplate
+		*out = new(SeedTemplateControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
@@ -276,6 +338,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootStatusLabelControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootForceUpgrade != nil {
+		in, out := &in.ShootForceUpgrade, &out.ShootForceUpgrade
+		*out = new(ShootForceUpgradeControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootMigration != nil {
 		in, out := &in.ShootMigration, &out.ShootMigration
 		*out = new(ShootMigrationControllerConfiguration)
@@ -476,6 +543,16 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.StaleLockPeriodDays != nil {
+		in, out := &in.StaleLockPeriodDays, &out.StaleLockPeriodDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.StaleSignals != nil {
+		in, out := &in.StaleSignals, &out.StaleSignals
+		*out = new(ProjectStaleSignals)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -489,6 +566,32 @@ func (in *ProjectControllerConfiguration) DeepCopy() *ProjectControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStaleSignals) DeepCopyInto(out *ProjectStaleSignals) {
+	*out = *in
+	if in.APIActivity != nil {
+		in, out := &in.APIActivity, &out.APIActivity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MemberLogins != nil {
+		in, out := &in.MemberLogins, &out.MemberLogins
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStaleSignals.
+func (in *ProjectStaleSignals) DeepCopy() *ProjectStaleSignals {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStaleSignals)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaConfiguration) DeepCopyInto(out *QuotaConfiguration) {
 	*out = *in
@@ -553,6 +656,27 @@ func (in *SecretBindingControllerConfiguration) DeepCopy() *SecretBindingControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedAttestation) DeepCopyInto(out *SeedAttestation) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedAttestation.
+func (in *SeedAttestation) DeepCopy() *SeedAttestation {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedAttestation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedBackupBucketsCheckControllerConfiguration) DeepCopyInto(out *SeedBackupBucketsCheckControllerConfiguration) {
 	*out = *in
@@ -672,6 +796,27 @@ func (in *SeedReferenceControllerConfiguration) DeepCopy() *SeedReferenceControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedTemplateControllerConfiguration) DeepCopyInto(out *SeedTemplateControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedTemplateControllerConfiguration.
+func (in *SeedTemplateControllerConfiguration) DeepCopy() *SeedTemplateControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedTemplateControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -735,6 +880,47 @@ func (in *ShootConditionsControllerConfiguration) DeepCopy() *ShootConditionsCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootForceUpgradeControllerConfiguration) DeepCopyInto(out *ShootForceUpgradeControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.NotificationLeadTime != nil {
+		in, out := &in.NotificationLeadTime, &out.NotificationLeadTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxNotificationLeadTime != nil {
+		in, out := &in.MaxNotificationLeadTime, &out.MaxNotificationLeadTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BusinessHours != nil {
+		in, out := &in.BusinessHours, &out.BusinessHours
+		*out = new(BusinessHours)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootForceUpgradeControllerConfiguration.
+func (in *ShootForceUpgradeControllerConfiguration) DeepCopy() *ShootForceUpgradeControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootForceUpgradeControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootHibernationControllerConfiguration) DeepCopyInto(out *ShootHibernationControllerConfiguration) {
 	*out = *in
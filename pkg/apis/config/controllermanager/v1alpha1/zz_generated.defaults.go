@@ -30,6 +30,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.Bastion != nil {
 		SetDefaults_BastionControllerConfiguration(in.Controllers.Bastion)
 	}
+	if in.Controllers.CertificateExpiry != nil {
+		SetDefaults_CertificateExpiryControllerConfiguration(in.Controllers.CertificateExpiry)
+	}
 	if in.Controllers.CertificateSigningRequest != nil {
 		SetDefaults_CertificateSigningRequestControllerConfiguration(in.Controllers.CertificateSigningRequest)
 	}
@@ -53,6 +56,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	}
 	if in.Controllers.Project != nil {
 		SetDefaults_ProjectControllerConfiguration(in.Controllers.Project)
+		if in.Controllers.Project.StaleSignals != nil {
+			SetDefaults_ProjectStaleSignals(in.Controllers.Project.StaleSignals)
+		}
 	}
 	if in.Controllers.Quota != nil {
 		SetDefaults_QuotaControllerConfiguration(in.Controllers.Quota)
@@ -75,6 +81,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.SeedReference != nil {
 		SetDefaults_SeedReferenceControllerConfiguration(in.Controllers.SeedReference)
 	}
+	if in.Controllers.SeedTemplate != nil {
+		SetDefaults_SeedTemplateControllerConfiguration(in.Controllers.SeedTemplate)
+	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
@@ -92,6 +101,12 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ShootStatusLabel != nil {
 		SetDefaults_ShootStatusLabelControllerConfiguration(in.Controllers.ShootStatusLabel)
 	}
+	if in.Controllers.ShootForceUpgrade != nil {
+		SetDefaults_ShootForceUpgradeControllerConfiguration(in.Controllers.ShootForceUpgrade)
+		if in.Controllers.ShootForceUpgrade.BusinessHours != nil {
+			SetDefaults_BusinessHours(in.Controllers.ShootForceUpgrade.BusinessHours)
+		}
+	}
 	if in.Controllers.ShootMigration != nil {
 		SetDefaults_ShootMigrationControllerConfiguration(in.Controllers.ShootMigration)
 	}
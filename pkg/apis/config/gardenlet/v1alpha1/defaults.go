@@ -115,6 +115,19 @@ func SetDefaults_GardenClientConnection(obj *GardenClientConnection) {
 	if obj.KubeconfigValidity == nil {
 		obj.KubeconfigValidity = &KubeconfigValidity{}
 	}
+	if obj.ObjectCache == nil {
+		obj.ObjectCache = &GardenObjectCache{}
+	}
+}
+
+// SetDefaults_GardenObjectCache sets defaults for the garden object cache.
+func SetDefaults_GardenObjectCache(obj *GardenObjectCache) {
+	if obj.MaxIdleTime == nil {
+		obj.MaxIdleTime = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.CacheSecrets == nil {
+		obj.CacheSecrets = ptr.To(false)
+	}
 }
 
 // SetDefaults_KubeconfigValidity sets defaults for the controller objects.
@@ -162,9 +175,15 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.SeedCare == nil {
 		obj.SeedCare = &SeedCareControllerConfiguration{}
 	}
+	if obj.SeedCRD == nil {
+		obj.SeedCRD = &SeedCRDControllerConfiguration{}
+	}
 	if obj.ShootState == nil {
 		obj.ShootState = &ShootStateControllerConfiguration{}
 	}
+	if obj.ShootDebugPod == nil {
+		obj.ShootDebugPod = &ShootDebugPodControllerConfiguration{}
+	}
 	if obj.NetworkPolicy == nil {
 		obj.NetworkPolicy = &NetworkPolicyControllerConfiguration{}
 	}
@@ -308,6 +327,21 @@ func SetDefaults_SeedControllerConfiguration(obj *SeedControllerConfiguration) {
 	}
 }
 
+// SetDefaults_IstioCanaryUpgrade sets defaults for the istio canary upgrade configuration.
+func SetDefaults_IstioCanaryUpgrade(obj *IstioCanaryUpgrade) {
+	if obj.IngressGatewayPercentage == nil {
+		obj.IngressGatewayPercentage = ptr.To[int32](25)
+	}
+
+	if obj.VerificationPeriod == nil {
+		obj.VerificationPeriod = &metav1.Duration{Duration: 30 * time.Minute}
+	}
+
+	if obj.FailureThreshold == nil {
+		obj.FailureThreshold = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
 // SetDefaults_SeedCareControllerConfiguration sets defaults for the seed care controller.
 func SetDefaults_SeedCareControllerConfiguration(obj *SeedCareControllerConfiguration) {
 	if obj.SyncPeriod == nil {
@@ -316,6 +350,13 @@ func SetDefaults_SeedCareControllerConfiguration(obj *SeedCareControllerConfigur
 	}
 }
 
+// SetDefaults_SeedCRDControllerConfiguration sets defaults for the seed CRD controller.
+func SetDefaults_SeedCRDControllerConfiguration(obj *SeedCRDControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
 // SetDefaults_ShootControllerConfiguration sets defaults for the shoot controller.
 func SetDefaults_ShootControllerConfiguration(obj *ShootControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -366,6 +407,21 @@ func SetDefaults_ShootCareControllerConfiguration(obj *ShootCareControllerConfig
 	}
 }
 
+// SetDefaults_ShootRemediationRule sets defaults for the remediation rules of the shoot care controller.
+func SetDefaults_ShootRemediationRule(obj *ShootRemediationRule) {
+	if obj.Threshold == nil {
+		obj.Threshold = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
+// SetDefaults_APIServerAvailabilityProbing sets defaults for the API server availability probing of the shoot care
+// controller.
+func SetDefaults_APIServerAvailabilityProbing(obj *APIServerAvailabilityProbing) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
 // SetDefaults_StaleExtensionHealthChecks sets defaults for the stale extension health checks.
 func SetDefaults_StaleExtensionHealthChecks(obj *StaleExtensionHealthChecks) {
 	if obj.Threshold == nil {
@@ -374,6 +430,17 @@ func SetDefaults_StaleExtensionHealthChecks(obj *StaleExtensionHealthChecks) {
 	}
 }
 
+// SetDefaults_SeedCapacityPressure sets defaults for the capacity pressure check of the seed care controller.
+func SetDefaults_SeedCapacityPressure(obj *SeedCapacityPressure) {
+	if obj.Threshold == nil {
+		obj.Threshold = ptr.To[int32](80)
+	}
+
+	if obj.PredictionHorizon == nil {
+		obj.PredictionHorizon = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
 // SetDefaults_ShootStateControllerConfiguration sets defaults for the shoot state controller.
 func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -384,6 +451,71 @@ func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConf
 	}
 }
 
+// SetDefaults_ShootEventMirrorControllerConfiguration sets defaults for the shoot event mirror controller.
+func SetDefaults_ShootEventMirrorControllerConfiguration(obj *ShootEventMirrorControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if len(obj.Rules) == 0 {
+		obj.Rules = []ShootEventMirrorRule{
+			{Name: "etcd-quota", Message: ptr.To(`(?i)(database space exceeded|NOSPACE)`)},
+			{Name: "out-of-memory", Message: ptr.To(`(?i)(OOMKill|out of memory)`)},
+			{Name: "failed-webhook-call", Message: ptr.To(`failed calling webhook`)},
+		}
+	}
+	if obj.DeduplicationWindow == nil {
+		obj.DeduplicationWindow = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.MaxEventsPerHour == nil {
+		obj.MaxEventsPerHour = ptr.To[int32](30)
+	}
+}
+
+// SetDefaults_ShootLeftoverControllerConfiguration sets defaults for the shoot leftover controller.
+func SetDefaults_ShootLeftoverControllerConfiguration(obj *ShootLeftoverControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.RetentionPeriod == nil {
+		obj.RetentionPeriod = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	}
+	if obj.Cleanup == nil {
+		obj.Cleanup = ptr.To(false)
+	}
+}
+
+// SetDefaults_ShootAvailabilityReportControllerConfiguration sets defaults for the shoot availability report
+// controller.
+func SetDefaults_ShootAvailabilityReportControllerConfiguration(obj *ShootAvailabilityReportControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.MaxReports == nil {
+		obj.MaxReports = ptr.To[int32](12)
+	}
+}
+
+// SetDefaults_ShootInfrastructureDriftControllerConfiguration sets defaults for the shoot infrastructure drift
+// controller.
+func SetDefaults_ShootInfrastructureDriftControllerConfiguration(obj *ShootInfrastructureDriftControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.ReconcileOnlyOnDrift == nil {
+		obj.ReconcileOnlyOnDrift = ptr.To(false)
+	}
+}
+
+// SetDefaults_ShootDebugPodControllerConfiguration sets defaults for the shoot debug pod controller.
+func SetDefaults_ShootDebugPodControllerConfiguration(obj *ShootDebugPodControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+}
+
 // SetDefaults_NetworkPolicyControllerConfiguration sets defaults for the network policy controller.
 func SetDefaults_NetworkPolicyControllerConfiguration(obj *NetworkPolicyControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
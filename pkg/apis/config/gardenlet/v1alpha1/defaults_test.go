@@ -45,7 +45,9 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Shoot).NotTo(BeNil())
 			Expect(obj.Controllers.ShootCare).NotTo(BeNil())
 			Expect(obj.Controllers.SeedCare).NotTo(BeNil())
+			Expect(obj.Controllers.SeedCRD).NotTo(BeNil())
 			Expect(obj.Controllers.ShootState).NotTo(BeNil())
+			Expect(obj.Controllers.ShootDebugPod).NotTo(BeNil())
 			Expect(obj.Controllers.ManagedSeed).NotTo(BeNil())
 			Expect(obj.LeaderElection).NotTo(BeNil())
 			Expect(obj.LogLevel).To(Equal(config.LogLevelInfo))
@@ -120,6 +122,32 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("GardenObjectCache defaulting", func() {
+		It("should default the garden object cache", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.GardenClientConnection.ObjectCache).To(Equal(&GardenObjectCache{
+				MaxIdleTime:  &metav1.Duration{Duration: 10 * time.Minute},
+				CacheSecrets: ptr.To(false),
+			}))
+		})
+
+		It("should not overwrite already set values for the garden object cache", func() {
+			obj.GardenClientConnection = &GardenClientConnection{
+				ObjectCache: &GardenObjectCache{
+					MaxIdleTime:  &metav1.Duration{Duration: time.Hour},
+					CacheSecrets: ptr.To(true),
+				},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.GardenClientConnection.ObjectCache).To(Equal(&GardenObjectCache{
+				MaxIdleTime:  &metav1.Duration{Duration: time.Hour},
+				CacheSecrets: ptr.To(true),
+			}))
+		})
+	})
+
 	Describe("SeedClientConnection defaulting", func() {
 		It("should default the seed client connection", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -338,6 +366,35 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("IstioCanaryUpgrade defaulting", func() {
+		It("should default the istio canary upgrade configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				Seed: &SeedControllerConfiguration{IstioCanaryUpgrade: &IstioCanaryUpgrade{Enabled: true}},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.Seed.IstioCanaryUpgrade.IngressGatewayPercentage).To(PointTo(Equal(int32(25))))
+			Expect(obj.Controllers.Seed.IstioCanaryUpgrade.VerificationPeriod).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Minute})))
+			Expect(obj.Controllers.Seed.IstioCanaryUpgrade.FailureThreshold).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+		})
+
+		It("should not overwrite already set values for the istio canary upgrade configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				Seed: &SeedControllerConfiguration{IstioCanaryUpgrade: &IstioCanaryUpgrade{
+					Enabled:                  true,
+					IngressGatewayPercentage: ptr.To[int32](50),
+					VerificationPeriod:       &metav1.Duration{Duration: time.Hour},
+					FailureThreshold:         &metav1.Duration{Duration: 20 * time.Minute},
+				}},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.Seed.IstioCanaryUpgrade.IngressGatewayPercentage).To(PointTo(Equal(int32(50))))
+			Expect(obj.Controllers.Seed.IstioCanaryUpgrade.VerificationPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Controllers.Seed.IstioCanaryUpgrade.FailureThreshold).To(PointTo(Equal(metav1.Duration{Duration: 20 * time.Minute})))
+		})
+	})
+
 	Describe("SeedCareControllerConfiguration defaulting", func() {
 		It("should default the seed care controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -354,6 +411,34 @@ var _ = Describe("Defaults", func() {
 
 			Expect(obj.Controllers.SeedCare.SyncPeriod).To(PointTo(Equal(syncPeriod)))
 		})
+
+		It("should default the capacity pressure configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				SeedCare: &SeedCareControllerConfiguration{CapacityPressure: &SeedCapacityPressure{Enabled: true}},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedCare.CapacityPressure.Threshold).To(PointTo(Equal(int32(80))))
+			Expect(obj.Controllers.SeedCare.CapacityPressure.PredictionHorizon).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+	})
+
+	Describe("SeedCRDControllerConfiguration defaulting", func() {
+		It("should default the seed CRD controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedCRD.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+
+		It("should not overwrite already set values for the seed CRD controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: 10 * time.Minute}
+			obj.Controllers = &GardenletControllerConfiguration{
+				SeedCRD: &SeedCRDControllerConfiguration{SyncPeriod: &syncPeriod},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedCRD.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+		})
 	})
 
 	Describe("ShootControllerConfiguration defaulting", func() {
@@ -439,6 +524,39 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootRemediationRule defaulting", func() {
+		It("should default the threshold of the remediation rules", func() {
+			threshold := metav1.Duration{Duration: 2 * time.Minute}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					Remediation: &ShootRemediation{Rules: []ShootRemediationRule{
+						{Action: ShootRemediationActionRestartCrashLoopingPods},
+						{Action: ShootRemediationActionRetriggerFailedExtensions, Threshold: &threshold},
+					}},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.Remediation.Rules[0].Threshold).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+			Expect(obj.Controllers.ShootCare.Remediation.Rules[1].Threshold).To(PointTo(Equal(threshold)))
+		})
+	})
+
+	Describe("APIServerAvailabilityProbing defaulting", func() {
+		It("should default the timeout of the API server availability probing", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					APIServerAvailabilityProbing: &APIServerAvailabilityProbing{Enabled: true},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.APIServerAvailabilityProbing.Timeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+		})
+	})
+
 	Describe("ShootStateControllerConfiguration defaulting", func() {
 		It("should default the shoot state controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -463,6 +581,168 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootEventMirrorControllerConfiguration defaulting", func() {
+		It("should not enable the shoot event mirror controller by default", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootEventMirror).To(BeNil())
+		})
+
+		It("should default the shoot event mirror controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootEventMirror: &ShootEventMirrorControllerConfiguration{},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootEventMirror.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootEventMirror.Rules).To(HaveLen(3))
+			Expect(obj.Controllers.ShootEventMirror.DeduplicationWindow).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Controllers.ShootEventMirror.MaxEventsPerHour).To(PointTo(Equal(int32(30))))
+		})
+
+		It("should not overwrite already set values for the shoot event mirror controller configuration", func() {
+			rules := []ShootEventMirrorRule{{Name: "foo", Reason: ptr.To("Foo")}}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootEventMirror: &ShootEventMirrorControllerConfiguration{
+					ConcurrentSyncs:     ptr.To(10),
+					Rules:               rules,
+					DeduplicationWindow: &metav1.Duration{Duration: time.Minute},
+					MaxEventsPerHour:    ptr.To[int32](5),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootEventMirror.ConcurrentSyncs).To(PointTo(Equal(10)))
+			Expect(obj.Controllers.ShootEventMirror.Rules).To(Equal(rules))
+			Expect(obj.Controllers.ShootEventMirror.DeduplicationWindow).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.Controllers.ShootEventMirror.MaxEventsPerHour).To(PointTo(Equal(int32(5))))
+		})
+	})
+
+	Describe("ShootLeftoverControllerConfiguration defaulting", func() {
+		It("should not enable the shoot leftover controller by default", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootLeftover).To(BeNil())
+		})
+
+		It("should default the shoot leftover controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootLeftover: &ShootLeftoverControllerConfiguration{},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootLeftover.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Controllers.ShootLeftover.RetentionPeriod).To(PointTo(Equal(metav1.Duration{Duration: 720 * time.Hour})))
+			Expect(obj.Controllers.ShootLeftover.Cleanup).To(PointTo(BeFalse()))
+		})
+
+		It("should not overwrite already set values for the shoot leftover controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootLeftover: &ShootLeftoverControllerConfiguration{
+					SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+					RetentionPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+					Cleanup:         ptr.To(true),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootLeftover.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.Controllers.ShootLeftover.RetentionPeriod).To(PointTo(Equal(metav1.Duration{Duration: 24 * time.Hour})))
+			Expect(obj.Controllers.ShootLeftover.Cleanup).To(PointTo(BeTrue()))
+		})
+	})
+
+	Describe("ShootInfrastructureDriftControllerConfiguration defaulting", func() {
+		It("should not enable the shoot infrastructure drift controller by default", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootInfrastructureDrift).To(BeNil())
+		})
+
+		It("should default the shoot infrastructure drift controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootInfrastructureDrift: &ShootInfrastructureDriftControllerConfiguration{},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootInfrastructureDrift.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootInfrastructureDrift.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Controllers.ShootInfrastructureDrift.ReconcileOnlyOnDrift).To(PointTo(BeFalse()))
+		})
+
+		It("should not overwrite already set values for the shoot infrastructure drift controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootInfrastructureDrift: &ShootInfrastructureDriftControllerConfiguration{
+					ConcurrentSyncs:      ptr.To(1),
+					SyncPeriod:           &metav1.Duration{Duration: time.Minute},
+					ReconcileOnlyOnDrift: ptr.To(true),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootInfrastructureDrift.ConcurrentSyncs).To(PointTo(Equal(1)))
+			Expect(obj.Controllers.ShootInfrastructureDrift.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.Controllers.ShootInfrastructureDrift.ReconcileOnlyOnDrift).To(PointTo(BeTrue()))
+		})
+	})
+
+	Describe("ShootAvailabilityReportControllerConfiguration defaulting", func() {
+		It("should not enable the shoot availability report controller by default", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootAvailabilityReport).To(BeNil())
+		})
+
+		It("should default the shoot availability report controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootAvailabilityReport: &ShootAvailabilityReportControllerConfiguration{},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootAvailabilityReport.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+			Expect(obj.Controllers.ShootAvailabilityReport.MaxReports).To(PointTo(Equal(int32(12))))
+		})
+
+		It("should not overwrite already set values for the shoot availability report controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootAvailabilityReport: &ShootAvailabilityReportControllerConfiguration{
+					SyncPeriod: &metav1.Duration{Duration: time.Minute},
+					MaxReports: ptr.To[int32](3),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootAvailabilityReport.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.Controllers.ShootAvailabilityReport.MaxReports).To(PointTo(Equal(int32(3))))
+		})
+	})
+
+	Describe("ShootDebugPodControllerConfiguration defaulting", func() {
+		It("should default the shoot debug pod controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootDebugPod.ConcurrentSyncs).To(PointTo(Equal(5)))
+		})
+
+		It("should not overwrite already set values for the shoot debug pod controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootDebugPod: &ShootDebugPodControllerConfiguration{ConcurrentSyncs: ptr.To(10)},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootDebugPod.ConcurrentSyncs).To(PointTo(Equal(10)))
+		})
+	})
+
 	Describe("NetworkPolicyControllerConfiguration defaulting", func() {
 		It("should default the network policy controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -92,6 +93,10 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// OfflineBundle is optional and configures a bundle mounted into the gardenlet pod from which image vector overrides
+	// and Helm charts are loaded. This allows running gardenlets in air-gapped seeds without access to public registries.
+	// +optional
+	OfflineBundle *OfflineBundle `json:"offlineBundle,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -124,6 +129,39 @@ type GardenClientConnection struct {
 	// secrets.
 	// +optional
 	KubeconfigValidity *KubeconfigValidity `json:"kubeconfigValidity,omitempty"`
+	// BootstrapAttestation configures the attestation evidence gardenlet attaches to the CertificateSigningRequest it
+	// creates during bootstrapping. It is required if gardener-controller-manager is configured to verify the identity of
+	// the machines seeds are registered from.
+	// +optional
+	BootstrapAttestation *BootstrapAttestation `json:"bootstrapAttestation,omitempty"`
+	// ObjectCache configures the caches gardenlet uses for reading individual objects from the garden cluster which it
+	// is not permitted to list or watch on cluster level (e.g., CloudProfiles, ExposureClasses, or Secrets).
+	// +optional
+	ObjectCache *GardenObjectCache `json:"objectCache,omitempty"`
+}
+
+// GardenObjectCache contains configuration for the caches of individual objects read from the garden cluster. Each
+// such cache watches a single object, i.e., it is invalidated as soon as the object changes.
+type GardenObjectCache struct {
+	// MaxIdleTime is the duration after which the cache for an individual object is closed if the object was not read
+	// anymore. Defaults to 10m.
+	// +optional
+	MaxIdleTime *metav1.Duration `json:"maxIdleTime,omitempty"`
+	// CacheSecrets enables caching of Secrets outside the seed namespace (e.g., cloud provider credentials of shoots).
+	// If disabled, such Secrets are always read directly from the garden cluster. Defaults to false.
+	// +optional
+	CacheSecrets *bool `json:"cacheSecrets,omitempty"`
+}
+
+// BootstrapAttestation contains configuration for the attestation of the machine gardenlet is running on during
+// bootstrapping.
+type BootstrapAttestation struct {
+	// Provider is the name of the attestation provider plugin creating the evidence, e.g. `tpm`. The plugin must be
+	// compiled into gardenlet.
+	Provider string `json:"provider"`
+	// Config is the plugin-specific configuration of the attestation provider.
+	// +optional
+	Config *runtime.RawExtension `json:"config,omitempty"`
 }
 
 // KubeconfigValidity allows configuring certain settings related to the validity and rotation of kubeconfig secrets.
@@ -188,6 +226,9 @@ type GardenletControllerConfiguration struct {
 	// SeedCare defines the configuration of the SeedCare controller.
 	// +optional
 	SeedCare *SeedCareControllerConfiguration `json:"seedCare,omitempty"`
+	// SeedCRD defines the configuration of the SeedCRD controller.
+	// +optional
+	SeedCRD *SeedCRDControllerConfiguration `json:"seedCRD,omitempty"`
 	// Shoot defines the configuration of the Shoot controller.
 	// +optional
 	Shoot *ShootControllerConfiguration `json:"shoot,omitempty"`
@@ -200,6 +241,29 @@ type GardenletControllerConfiguration struct {
 	// ShootStatus defines the configuration of the ShootStatus controller.
 	// +optional
 	ShootStatus *ShootStatusControllerConfiguration `json:"shootStatus,omitempty"`
+	// ShootEventMirror defines the configuration of the ShootEventMirror controller. The controller is disabled if this
+	// field is not set.
+	// +optional
+	ShootEventMirror *ShootEventMirrorControllerConfiguration `json:"shootEventMirror,omitempty"`
+	// ShootLeftover defines the configuration of the ShootLeftover controller. If set, gardenlet creates a ShootLeftover
+	// extension resource when a shoot is deleted so that provider extensions can detect resources which were left
+	// behind. The controller is disabled if this field is not set.
+	// +optional
+	ShootLeftover *ShootLeftoverControllerConfiguration `json:"shootLeftover,omitempty"`
+	// ShootAvailabilityReport defines the configuration of the ShootAvailabilityReport controller. If set, gardenlet
+	// maintains monthly reports of the availability of the shoot API servers in the shoot status. The controller is
+	// disabled if this field is not set or if the API server availability probing of the shoot care controller is not
+	// enabled.
+	// +optional
+	ShootAvailabilityReport *ShootAvailabilityReportControllerConfiguration `json:"shootAvailabilityReport,omitempty"`
+	// ShootInfrastructureDrift defines the configuration of the ShootInfrastructureDrift controller. If set, gardenlet
+	// periodically asks the Infrastructure extensions to detect whether the infrastructure of the shoots deviates from
+	// its desired state. The controller is disabled if this field is not set.
+	// +optional
+	ShootInfrastructureDrift *ShootInfrastructureDriftControllerConfiguration `json:"shootInfrastructureDrift,omitempty"`
+	// ShootDebugPod defines the configuration of the ShootDebugPod controller.
+	// +optional
+	ShootDebugPod *ShootDebugPodControllerConfiguration `json:"shootDebugPod,omitempty"`
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller
 	// +optional
 	NetworkPolicy *NetworkPolicyControllerConfiguration `json:"networkPolicy,omitempty"`
@@ -247,6 +311,14 @@ type BastionControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// DefaultIdleTimeout is the duration after which SSH connections to bastion hosts without any activity are closed
+	// if the Bastion does not specify an idle timeout. If not set, connections are not closed due to inactivity.
+	// +optional
+	DefaultIdleTimeout *metav1.Duration `json:"defaultIdleTimeout,omitempty"`
+	// DefaultSessionRecording specifies whether SSH sessions on bastion hosts are recorded if the Bastion does not
+	// configure session recording explicitly.
+	// +optional
+	DefaultSessionRecording *bool `json:"defaultSessionRecording,omitempty"`
 }
 
 // ControllerInstallationControllerConfiguration defines the configuration of the
@@ -294,6 +366,33 @@ type SeedControllerConfiguration struct {
 	// Defaults to 10
 	// +optional
 	LeaseResyncMissThreshold *int32 `json:"leaseResyncMissThreshold,omitempty"`
+	// IstioCanaryUpgrade contains the configuration for canary upgrades of the istio control plane in the seed cluster.
+	// +optional
+	IstioCanaryUpgrade *IstioCanaryUpgrade `json:"istioCanaryUpgrade,omitempty"`
+}
+
+// IstioCanaryUpgrade contains the configuration for canary upgrades of the istio control plane in the seed cluster.
+type IstioCanaryUpgrade struct {
+	// Enabled controls whether a new istio version is first rolled out to a subset of the istio ingress gateways
+	// (canary phase) before all istio ingress gateways are switched to it. If the canary does not become healthy, it is
+	// rolled back automatically. If disabled, istio is upgraded in place.
+	Enabled bool `json:"enabled"`
+	// IngressGatewayPercentage is the percentage of istio ingress gateways which are switched to the new istio version
+	// during the canary phase. At least one istio ingress gateway is always switched.
+	// Defaults to 25.
+	// +optional
+	IngressGatewayPercentage *int32 `json:"ingressGatewayPercentage,omitempty"`
+	// VerificationPeriod is the minimum duration of the canary phase. The health of the canary is sampled throughout
+	// this period. The new istio version is promoted once this duration has passed, the canary is healthy, and the
+	// majority of the samples were healthy.
+	// Defaults to 30m.
+	// +optional
+	VerificationPeriod *metav1.Duration `json:"verificationPeriod,omitempty"`
+	// FailureThreshold is the duration for which the canary must be unhealthy continuously before the new istio version
+	// is rolled back. The health of the canary is sampled at least twice within this duration.
+	// Defaults to 10m.
+	// +optional
+	FailureThreshold *metav1.Duration `json:"failureThreshold,omitempty"`
 }
 
 // ShootControllerConfiguration defines the configuration of the Shoot
@@ -328,6 +427,34 @@ type ShootControllerConfiguration struct {
 	// Default: 120s
 	// +optional
 	DNSEntryTTLSeconds *int64 `json:"dnsEntryTTLSeconds,omitempty"`
+	// ControlPlaneMigration contains the configuration for control plane migrations of shoots.
+	// +optional
+	ControlPlaneMigration *ShootControlPlaneMigration `json:"controlPlaneMigration,omitempty"`
+	// ImageOverrides contains the configuration for overriding images of individual components of single shoots.
+	// +optional
+	ImageOverrides *ShootImageOverrides `json:"imageOverrides,omitempty"`
+}
+
+// ShootImageOverrides contains the configuration for overriding images of individual components of single shoots via
+// the `shoot.gardener.cloud/image-overrides` annotation.
+type ShootImageOverrides struct {
+	// AllowedImages is the list of names of images (as found in the image vector) which may be overridden for single
+	// shoots. Overrides for images not contained in this list are rejected. If empty, image overrides are disabled.
+	// +optional
+	AllowedImages []string `json:"allowedImages,omitempty"`
+}
+
+// ShootControlPlaneMigration contains the configuration for control plane migrations of shoots.
+type ShootControlPlaneMigration struct {
+	// DNSCutoverTTLSeconds is the TTL in seconds that is used for the DNS records of a shoot while its control plane is
+	// migrated to another seed. Before the control plane is torn down on the source seed, the TTL of the DNS records is
+	// lowered to this value and the previous TTL is awaited, so that clients pick up the addresses of the destination
+	// seed quickly once the DNS records have been restored there. The regular TTL is applied again with the first
+	// reconciliation after the migration. If not set, the TTL is not changed during control plane migrations.
+	// Note that this does not keep the control plane available during the cutover, i.e., the kube-apiserver of the shoot
+	// never runs on both seeds at the same time.
+	// +optional
+	DNSCutoverTTLSeconds *int64 `json:"dnsCutoverTTLSeconds,omitempty"`
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -358,6 +485,80 @@ type ShootCareControllerConfiguration struct {
 	// is enabled.
 	// +optional
 	WebhookRemediatorEnabled *bool `json:"webhookRemediatorEnabled,omitempty"`
+	// Remediation defines the configuration of the automatic remediation of well-known unhealthy states of shoot
+	// clusters. If not specified, no remediation actions are performed.
+	// +optional
+	Remediation *ShootRemediation `json:"remediation,omitempty"`
+	// APIServerAvailabilityProbing defines the configuration of the probing of the external endpoint of the shoot API
+	// servers. If enabled, the result is reported in the `APIServerAvailability` condition of the shoots.
+	// +optional
+	APIServerAvailabilityProbing *APIServerAvailabilityProbing `json:"apiServerAvailabilityProbing,omitempty"`
+}
+
+// APIServerAvailabilityProbing defines the configuration of the probing of the external endpoint of the shoot API
+// servers.
+type APIServerAvailabilityProbing struct {
+	// Enabled specifies whether the probing is enabled.
+	Enabled bool `json:"enabled"`
+	// Timeout is the timeout of a single probe.
+	// Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// ExternalProber is an optional prober outside of the seed cluster which additionally probes the endpoint. If not
+	// specified, the endpoint is only probed from the seed.
+	// +optional
+	ExternalProber *ExternalAPIServerProber `json:"externalProber,omitempty"`
+}
+
+// ExternalAPIServerProber defines a prober outside of the seed cluster which is compatible with the probe endpoint of
+// the Prometheus blackbox exporter.
+type ExternalAPIServerProber struct {
+	// URL is the URL of the probe endpoint, e.g. `https://prober.example.com/probe`. The endpoint to probe is passed as
+	// `target` query parameter, and the prober must report the result with the `probe_success` metric.
+	URL string `json:"url"`
+	// Module is the name of the prober module which is passed as `module` query parameter.
+	// +optional
+	Module *string `json:"module,omitempty"`
+}
+
+// ShootRemediation defines the configuration of the automatic remediation of well-known unhealthy states of shoot
+// clusters.
+type ShootRemediation struct {
+	// Rules is the list of remediation rules. Only the actions for which a rule is configured are performed.
+	Rules []ShootRemediationRule `json:"rules"`
+}
+
+// ShootRemediationRule defines a remediation action and when it is performed.
+type ShootRemediationRule struct {
+	// Action is the remediation action.
+	Action ShootRemediationAction `json:"action"`
+	// Threshold is the duration for which an unhealthy state must persist before the action is performed.
+	// Defaults to 10m.
+	// +optional
+	Threshold *metav1.Duration `json:"threshold,omitempty"`
+}
+
+// ShootRemediationAction is a type for remediation actions of the shoot care controller.
+type ShootRemediationAction string
+
+const (
+	// ShootRemediationActionRestartCrashLoopingPods is a remediation action which restarts crash-looping pods in the
+	// control plane namespace of the shoot.
+	ShootRemediationActionRestartCrashLoopingPods ShootRemediationAction = "RestartCrashLoopingPods"
+	// ShootRemediationActionRetriggerFailedExtensions is a remediation action which triggers a new reconciliation of
+	// extension resources whose last operation failed.
+	ShootRemediationActionRetriggerFailedExtensions ShootRemediationAction = "RetriggerFailedExtensions"
+	// ShootRemediationActionKickStuckWebhooks is a remediation action which restarts the pods serving webhooks in the
+	// shoot cluster which fail closed and have no ready endpoints.
+	ShootRemediationActionKickStuckWebhooks ShootRemediationAction = "KickStuckWebhooks"
+)
+
+// SeedCRDControllerConfiguration defines the configuration of the SeedCRD controller.
+type SeedCRDControllerConfiguration struct {
+	// SyncPeriod is the duration how often the custom resource definitions in the seed cluster are checked for
+	// conflicts and their ManagedResources are reconciled.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -370,6 +571,64 @@ type SeedCareControllerConfiguration struct {
 	// ConditionThresholds defines the condition threshold per condition type.
 	// +optional
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
+	// CapacityPressure defines the configuration of the check for capacity pressure of the seed cluster.
+	// +optional
+	CapacityPressure *SeedCapacityPressure `json:"capacityPressure,omitempty"`
+	// ImageVerification defines the configuration of the verification of the container images deployed by gardenlet.
+	// +optional
+	ImageVerification *SeedImageVerification `json:"imageVerification,omitempty"`
+}
+
+// SeedCapacityPressure defines the configuration of the check for capacity pressure of the seed cluster.
+type SeedCapacityPressure struct {
+	// Enabled specifies whether the `SeedCapacityPressure` condition is maintained.
+	Enabled bool `json:"enabled"`
+	// Threshold is the percentage of the allocatable CPU or memory of the seed's nodes which may be requested by pods
+	// before the seed is considered to be under capacity pressure. Defaults to 80.
+	// +optional
+	Threshold *int32 `json:"threshold,omitempty"`
+	// PredictionHorizon is the duration for which the growth of the requested resources is extrapolated. If the
+	// threshold is expected to be exceeded within this duration, the seed is considered to be under capacity pressure
+	// as well. Defaults to 1h.
+	// +optional
+	PredictionHorizon *metav1.Duration `json:"predictionHorizon,omitempty"`
+}
+
+// SeedImageVerification defines the configuration of the verification of the container images deployed by gardenlet.
+// Images are verified by checking their cosign signatures. Images which cannot be verified are reported in the
+// `SeedImagesVerified` condition.
+type SeedImageVerification struct {
+	// Enabled specifies whether the `SeedImagesVerified` condition is maintained.
+	Enabled bool `json:"enabled"`
+	// PublicKeys is a list of PEM-encoded public keys. Signatures created with the corresponding private keys are
+	// accepted.
+	// +optional
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// FulcioRoots is a list of PEM-encoded Fulcio root certificates. Keyless signatures are only accepted if their
+	// signing certificate chains up to one of these certificates. If set, KeylessIdentities and RekorPublicKeys are
+	// required.
+	// +optional
+	FulcioRoots []string `json:"fulcioRoots,omitempty"`
+	// KeylessIdentities is a list of identities which are accepted as signers of keyless signatures. The signing
+	// certificate must have been issued to one of these identities.
+	// +optional
+	KeylessIdentities []KeylessIdentity `json:"keylessIdentities,omitempty"`
+	// RekorPublicKeys is a list of PEM-encoded public keys of the Rekor transparency log. Keyless signatures are only
+	// accepted if they were recorded in the transparency log while the signing certificate was valid.
+	// +optional
+	RekorPublicKeys []string `json:"rekorPublicKeys,omitempty"`
+	// RequirePinnedDigests specifies whether images which are not pinned to a digest are reported as unverifiable.
+	// Otherwise, the digest of such images is resolved via the registry.
+	// +optional
+	RequirePinnedDigests bool `json:"requirePinnedDigests,omitempty"`
+}
+
+// KeylessIdentity is an identity which is accepted as signer of keyless signatures.
+type KeylessIdentity struct {
+	// Issuer is the OIDC issuer which authenticated the signer, e.g. `https://token.actions.githubusercontent.com`.
+	Issuer string `json:"issuer"`
+	// Subject is the subject alternative name (an email address or URI) of the signing certificate.
+	Subject string `json:"subject"`
 }
 
 // ShootStateControllerConfiguration defines the configuration of the ShootState controller.
@@ -390,6 +649,94 @@ type ShootStatusControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootEventMirrorControllerConfiguration defines the configuration of the ShootEventMirror controller.
+type ShootEventMirrorControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// Rules selects the events in the control plane namespaces of shoots which are mirrored to the Shoot objects in the
+	// garden cluster. An event is mirrored if it matches at least one rule.
+	// Defaults to rules matching etcd quota alarms, out-of-memory kills, and failed webhook calls.
+	// +optional
+	Rules []ShootEventMirrorRule `json:"rules,omitempty"`
+	// DeduplicationWindow is the duration in which events with the same reason and message for the same object are
+	// mirrored only once.
+	// Defaults to 1h.
+	// +optional
+	DeduplicationWindow *metav1.Duration `json:"deduplicationWindow,omitempty"`
+	// MaxEventsPerHour is the maximum number of events which are mirrored to a single Shoot per hour.
+	// Defaults to 30.
+	// +optional
+	MaxEventsPerHour *int32 `json:"maxEventsPerHour,omitempty"`
+}
+
+// ShootEventMirrorRule selects events which are mirrored to the Shoot objects in the garden cluster. If both reason and
+// message are specified, an event must match both of them.
+type ShootEventMirrorRule struct {
+	// Name is the name of the rule. It is added to the mirrored events to indicate why they were mirrored.
+	Name string `json:"name"`
+	// Reason is a regular expression which must match the reason of the event.
+	// +optional
+	Reason *string `json:"reason,omitempty"`
+	// Message is a regular expression which must match the message of the event.
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// ShootLeftoverControllerConfiguration defines the configuration of the ShootLeftover controller.
+type ShootLeftoverControllerConfiguration struct {
+	// SyncPeriod is the duration how often the existing ShootLeftover resources are checked for garbage collection.
+	// Defaults to 1h.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// RetentionPeriod is the duration after which ShootLeftover resources are deleted, even if leftover resources were
+	// detected. ShootLeftover resources for which no leftover resources were detected are deleted right away.
+	// Defaults to 720h.
+	// +optional
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty"`
+	// Cleanup specifies whether provider extensions shall delete the detected leftover resources. If false, they are
+	// only reported in the status of the ShootLeftover resources.
+	// Defaults to false.
+	// +optional
+	Cleanup *bool `json:"cleanup,omitempty"`
+}
+
+// ShootAvailabilityReportControllerConfiguration defines the configuration of the ShootAvailabilityReport controller.
+type ShootAvailabilityReportControllerConfiguration struct {
+	// SyncPeriod is the duration how often the availability reports are updated.
+	// Defaults to 10m.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MaxReports is the maximum number of monthly reports kept in the shoot status.
+	// Defaults to 12.
+	// +optional
+	MaxReports *int32 `json:"maxReports,omitempty"`
+}
+
+// ShootInfrastructureDriftControllerConfiguration defines the configuration of the ShootInfrastructureDrift controller.
+type ShootInfrastructureDriftControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the drift of the infrastructure of shoots is detected.
+	// Defaults to 1h.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ReconcileOnlyOnDrift specifies whether the infrastructure of shoots is only reconciled if its specification
+	// changed or if the last drift detection did not confirm that it is in sync. Otherwise, it is reconciled with every
+	// maintenance operation as usual.
+	// Defaults to false.
+	// +optional
+	ReconcileOnlyOnDrift *bool `json:"reconcileOnlyOnDrift,omitempty"`
+}
+
+// ShootDebugPodControllerConfiguration defines the configuration of the ShootDebugPod controller.
+type ShootDebugPodControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -699,6 +1046,12 @@ type ExposureClassHandler struct {
 	// an exposure class handler.
 	// +optional
 	SNI *SNI `json:"sni,omitempty"`
+	// IstioTLSTermination controls whether the ingress gateway of the exposure class handler terminates TLS for the
+	// kube-apiservers of the shoots using this exposure class and originates new TLS connections with client
+	// certificates to them (instead of TCP passthrough). If set, it takes precedence over the seed setting
+	// Seed.spec.settings.istioTLSTermination and the IstioTLSTermination feature gate.
+	// +optional
+	IstioTLSTermination *bool `json:"istioTLSTermination,omitempty"`
 }
 
 // LoadBalancerServiceConfig contains configuration which is used to configure the underlying
@@ -767,6 +1120,25 @@ var DefaultCentralValiStorage = resource.MustParse("100Gi")
 // DefaultCentralVictoriaLogsStorage is a default value for garden/victoria-logs's storage.
 var DefaultCentralVictoriaLogsStorage = resource.MustParse("100Gi")
 
+// OfflineBundle contains the configuration of a bundle with image vector overrides and Helm charts. The bundle is either
+// a (gzip-compressed) tarball or a directory in the OCI image layout containing an artifact with the tarball as layer.
+// The tarball contains the following (optional) files:
+//   - `imagevector/containers.yaml`: image vector override for the container images
+//   - `imagevector/charts.yaml`: image vector override for the Helm chart images
+//   - `imagevector/components.yaml`: component-specific image vector overrides
+//   - `charts/index.yaml`: list of Helm charts (`ref` and `path` in the tarball) served instead of pulling them from
+//     their OCI repository
+type OfflineBundle struct {
+	// Path is the path of the mounted bundle.
+	Path string `json:"path"`
+	// SignaturePath is the path of the detached signature of the bundle tarball. The signature is created over the
+	// SHA-256 digest of the tarball with RSA (PKCS #1 v1.5) or ECDSA keys (e.g., via `openssl dgst -sha256 -sign`), or over
+	// the tarball itself with Ed25519 keys. It is either stored in binary or in base64 encoding.
+	SignaturePath string `json:"signaturePath"`
+	// PublicKeyPath is the path of the PEM-encoded public key used for verifying the signature of the bundle.
+	PublicKeyPath string `json:"publicKeyPath"`
+}
+
 // NodeToleration contains information about node toleration options.
 type NodeToleration struct {
 	// DefaultNotReadyTolerationSeconds specifies the seconds for the `node.kubernetes.io/not-ready` toleration that
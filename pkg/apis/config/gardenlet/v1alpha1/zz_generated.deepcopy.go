@@ -19,6 +19,32 @@ import (
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerAvailabilityProbing) DeepCopyInto(out *APIServerAvailabilityProbing) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExternalProber != nil {
+		in, out := &in.ExternalProber, &out.ExternalProber
+		*out = new(ExternalAPIServerProber)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerAvailabilityProbing.
+func (in *APIServerAvailabilityProbing) DeepCopy() *APIServerAvailabilityProbing {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerAvailabilityProbing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupBucketControllerConfiguration) DeepCopyInto(out *BackupBucketControllerConfiguration) {
 	*out = *in
@@ -120,6 +146,16 @@ func (in *BastionControllerConfiguration) DeepCopyInto(out *BastionControllerCon
 		*out = new(int)
 		**out = **in
 	}
+	if in.DefaultIdleTimeout != nil {
+		in, out := &in.DefaultIdleTimeout, &out.DefaultIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DefaultSessionRecording != nil {
+		in, out := &in.DefaultSessionRecording, &out.DefaultSessionRecording
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -133,6 +169,27 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapAttestation) DeepCopyInto(out *BootstrapAttestation) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapAttestation.
+func (in *BootstrapAttestation) DeepCopy() *BootstrapAttestation {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapAttestation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -343,6 +400,11 @@ func (in *ExposureClassHandler) DeepCopyInto(out *ExposureClassHandler) {
 		*out = new(SNI)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IstioTLSTermination != nil {
+		in, out := &in.IstioTLSTermination, &out.IstioTLSTermination
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -356,6 +418,27 @@ func (in *ExposureClassHandler) DeepCopy() *ExposureClassHandler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAPIServerProber) DeepCopyInto(out *ExternalAPIServerProber) {
+	*out = *in
+	if in.Module != nil {
+		in, out := &in.Module, &out.Module
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAPIServerProber.
+func (in *ExternalAPIServerProber) DeepCopy() *ExternalAPIServerProber {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAPIServerProber)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenClientConnection) DeepCopyInto(out *GardenClientConnection) {
 	*out = *in
@@ -385,6 +468,16 @@ func (in *GardenClientConnection) DeepCopyInto(out *GardenClientConnection) {
 		*out = new(KubeconfigValidity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BootstrapAttestation != nil {
+		in, out := &in.BootstrapAttestation, &out.BootstrapAttestation
+		*out = new(BootstrapAttestation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectCache != nil {
+		in, out := &in.ObjectCache, &out.ObjectCache
+		*out = new(GardenObjectCache)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -398,6 +491,32 @@ func (in *GardenClientConnection) DeepCopy() *GardenClientConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenObjectCache) DeepCopyInto(out *GardenObjectCache) {
+	*out = *in
+	if in.MaxIdleTime != nil {
+		in, out := &in.MaxIdleTime, &out.MaxIdleTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CacheSecrets != nil {
+		in, out := &in.CacheSecrets, &out.CacheSecrets
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenObjectCache.
+func (in *GardenObjectCache) DeepCopy() *GardenObjectCache {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenObjectCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenVali) DeepCopyInto(out *GardenVali) {
 	*out = *in
@@ -524,6 +643,11 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OfflineBundle != nil {
+		in, out := &in.OfflineBundle, &out.OfflineBundle
+		*out = new(OfflineBundle)
+		**out = **in
+	}
 	return
 }
 
@@ -593,6 +717,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(SeedCareControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedCRD != nil {
+		in, out := &in.SeedCRD, &out.SeedCRD
+		*out = new(SeedCRDControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootControllerConfiguration)
@@ -613,6 +742,31 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ShootStatusControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootEventMirror != nil {
+		in, out := &in.ShootEventMirror, &out.ShootEventMirror
+		*out = new(ShootEventMirrorControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootLeftover != nil {
+		in, out := &in.ShootLeftover, &out.ShootLeftover
+		*out = new(ShootLeftoverControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootAvailabilityReport != nil {
+		in, out := &in.ShootAvailabilityReport, &out.ShootAvailabilityReport
+		*out = new(ShootAvailabilityReportControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootInfrastructureDrift != nil {
+		in, out := &in.ShootInfrastructureDrift, &out.ShootInfrastructureDrift
+		*out = new(ShootInfrastructureDriftControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootDebugPod != nil {
+		in, out := &in.ShootDebugPod, &out.ShootDebugPod
+		*out = new(ShootDebugPodControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NetworkPolicy != nil {
 		in, out := &in.NetworkPolicy, &out.NetworkPolicy
 		*out = new(NetworkPolicyControllerConfiguration)
@@ -672,6 +826,53 @@ func (in *GardenletObjectControllerConfiguration) DeepCopy() *GardenletObjectCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioCanaryUpgrade) DeepCopyInto(out *IstioCanaryUpgrade) {
+	*out = *in
+	if in.IngressGatewayPercentage != nil {
+		in, out := &in.IngressGatewayPercentage, &out.IngressGatewayPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.VerificationPeriod != nil {
+		in, out := &in.VerificationPeriod, &out.VerificationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioCanaryUpgrade.
+func (in *IstioCanaryUpgrade) DeepCopy() *IstioCanaryUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioCanaryUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeylessIdentity) DeepCopyInto(out *KeylessIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeylessIdentity.
+func (in *KeylessIdentity) DeepCopy() *KeylessIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(KeylessIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeconfigValidity) DeepCopyInto(out *KubeconfigValidity) {
 	*out = *in
@@ -888,6 +1089,22 @@ func (in *NodeToleration) DeepCopy() *NodeToleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OfflineBundle) DeepCopyInto(out *OfflineBundle) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OfflineBundle.
+func (in *OfflineBundle) DeepCopy() *OfflineBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(OfflineBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteWriteMonitoringConfig) DeepCopyInto(out *RemoteWriteMonitoringConfig) {
 	*out = *in
@@ -998,6 +1215,53 @@ func (in *SNIIngress) DeepCopy() *SNIIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCRDControllerConfiguration) DeepCopyInto(out *SeedCRDControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCRDControllerConfiguration.
+func (in *SeedCRDControllerConfiguration) DeepCopy() *SeedCRDControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedCRDControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCapacityPressure) DeepCopyInto(out *SeedCapacityPressure) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PredictionHorizon != nil {
+		in, out := &in.PredictionHorizon, &out.PredictionHorizon
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCapacityPressure.
+func (in *SeedCapacityPressure) DeepCopy() *SeedCapacityPressure {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedCapacityPressure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerConfiguration) {
 	*out = *in
@@ -1011,6 +1275,16 @@ func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerC
 		*out = make([]ConditionThreshold, len(*in))
 		copy(*out, *in)
 	}
+	if in.CapacityPressure != nil {
+		in, out := &in.CapacityPressure, &out.CapacityPressure
+		*out = new(SeedCapacityPressure)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageVerification != nil {
+		in, out := &in.ImageVerification, &out.ImageVerification
+		*out = new(SeedImageVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1076,6 +1350,11 @@ func (in *SeedControllerConfiguration) DeepCopyInto(out *SeedControllerConfigura
 		*out = new(int32)
 		**out = **in
 	}
+	if in.IstioCanaryUpgrade != nil {
+		in, out := &in.IstioCanaryUpgrade, &out.IstioCanaryUpgrade
+		*out = new(IstioCanaryUpgrade)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1089,6 +1368,42 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedImageVerification) DeepCopyInto(out *SeedImageVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FulcioRoots != nil {
+		in, out := &in.FulcioRoots, &out.FulcioRoots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeylessIdentities != nil {
+		in, out := &in.KeylessIdentities, &out.KeylessIdentities
+		*out = make([]KeylessIdentity, len(*in))
+		copy(*out, *in)
+	}
+	if in.RekorPublicKeys != nil {
+		in, out := &in.RekorPublicKeys, &out.RekorPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedImageVerification.
+func (in *SeedImageVerification) DeepCopy() *SeedImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -1131,6 +1446,32 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAvailabilityReportControllerConfiguration) DeepCopyInto(out *ShootAvailabilityReportControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxReports != nil {
+		in, out := &in.MaxReports, &out.MaxReports
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAvailabilityReportControllerConfiguration.
+func (in *ShootAvailabilityReportControllerConfiguration) DeepCopy() *ShootAvailabilityReportControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAvailabilityReportControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControllerConfiguration) {
 	*out = *in
@@ -1164,6 +1505,16 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(ShootRemediation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIServerAvailabilityProbing != nil {
+		in, out := &in.APIServerAvailabilityProbing, &out.APIServerAvailabilityProbing
+		*out = new(APIServerAvailabilityProbing)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1194,6 +1545,27 @@ func (in *ShootClientConnection) DeepCopy() *ShootClientConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootControlPlaneMigration) DeepCopyInto(out *ShootControlPlaneMigration) {
+	*out = *in
+	if in.DNSCutoverTTLSeconds != nil {
+		in, out := &in.DNSCutoverTTLSeconds, &out.DNSCutoverTTLSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootControlPlaneMigration.
+func (in *ShootControlPlaneMigration) DeepCopy() *ShootControlPlaneMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootControlPlaneMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfiguration) {
 	*out = *in
@@ -1232,6 +1604,16 @@ func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfigu
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ControlPlaneMigration != nil {
+		in, out := &in.ControlPlaneMigration, &out.ControlPlaneMigration
+		*out = new(ShootControlPlaneMigration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageOverrides != nil {
+		in, out := &in.ImageOverrides, &out.ImageOverrides
+		*out = new(ShootImageOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1245,6 +1627,27 @@ func (in *ShootControllerConfiguration) DeepCopy() *ShootControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootDebugPodControllerConfiguration) DeepCopyInto(out *ShootDebugPodControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootDebugPodControllerConfiguration.
+func (in *ShootDebugPodControllerConfiguration) DeepCopy() *ShootDebugPodControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootDebugPodControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootEventLogging) DeepCopyInto(out *ShootEventLogging) {
 	*out = *in
@@ -1266,6 +1669,153 @@ func (in *ShootEventLogging) DeepCopy() *ShootEventLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootEventMirrorControllerConfiguration) DeepCopyInto(out *ShootEventMirrorControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ShootEventMirrorRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeduplicationWindow != nil {
+		in, out := &in.DeduplicationWindow, &out.DeduplicationWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxEventsPerHour != nil {
+		in, out := &in.MaxEventsPerHour, &out.MaxEventsPerHour
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootEventMirrorControllerConfiguration.
+func (in *ShootEventMirrorControllerConfiguration) DeepCopy() *ShootEventMirrorControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootEventMirrorControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootEventMirrorRule) DeepCopyInto(out *ShootEventMirrorRule) {
+	*out = *in
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootEventMirrorRule.
+func (in *ShootEventMirrorRule) DeepCopy() *ShootEventMirrorRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootEventMirrorRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootImageOverrides) DeepCopyInto(out *ShootImageOverrides) {
+	*out = *in
+	if in.AllowedImages != nil {
+		in, out := &in.AllowedImages, &out.AllowedImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootImageOverrides.
+func (in *ShootImageOverrides) DeepCopy() *ShootImageOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootImageOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootInfrastructureDriftControllerConfiguration) DeepCopyInto(out *ShootInfrastructureDriftControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ReconcileOnlyOnDrift != nil {
+		in, out := &in.ReconcileOnlyOnDrift, &out.ReconcileOnlyOnDrift
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootInfrastructureDriftControllerConfiguration.
+func (in *ShootInfrastructureDriftControllerConfiguration) DeepCopy() *ShootInfrastructureDriftControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootInfrastructureDriftControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootLeftoverControllerConfiguration) DeepCopyInto(out *ShootLeftoverControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Cleanup != nil {
+		in, out := &in.Cleanup, &out.Cleanup
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootLeftoverControllerConfiguration.
+func (in *ShootLeftoverControllerConfiguration) DeepCopy() *ShootLeftoverControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootLeftoverControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMonitoringConfig) DeepCopyInto(out *ShootMonitoringConfig) {
 	*out = *in
@@ -1320,6 +1870,50 @@ func (in *ShootNodeLogging) DeepCopy() *ShootNodeLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRemediation) DeepCopyInto(out *ShootRemediation) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ShootRemediationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRemediation.
+func (in *ShootRemediation) DeepCopy() *ShootRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRemediationRule) DeepCopyInto(out *ShootRemediationRule) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRemediationRule.
+func (in *ShootRemediationRule) DeepCopy() *ShootRemediationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRemediationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootStateControllerConfiguration) DeepCopyInto(out *ShootStateControllerConfiguration) {
 	*out = *in
@@ -29,6 +29,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		if in.GardenClientConnection.KubeconfigValidity != nil {
 			SetDefaults_KubeconfigValidity(in.GardenClientConnection.KubeconfigValidity)
 		}
+		if in.GardenClientConnection.ObjectCache != nil {
+			SetDefaults_GardenObjectCache(in.GardenClientConnection.ObjectCache)
+		}
 	}
 	if in.SeedClientConnection != nil {
 		SetDefaults_ClientConnectionConfiguration(&in.SeedClientConnection.ClientConnectionConfiguration)
@@ -61,9 +64,18 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		}
 		if in.Controllers.Seed != nil {
 			SetDefaults_SeedControllerConfiguration(in.Controllers.Seed)
+			if in.Controllers.Seed.IstioCanaryUpgrade != nil {
+				SetDefaults_IstioCanaryUpgrade(in.Controllers.Seed.IstioCanaryUpgrade)
+			}
 		}
 		if in.Controllers.SeedCare != nil {
 			SetDefaults_SeedCareControllerConfiguration(in.Controllers.SeedCare)
+			if in.Controllers.SeedCare.CapacityPressure != nil {
+				SetDefaults_SeedCapacityPressure(in.Controllers.SeedCare.CapacityPressure)
+			}
+		}
+		if in.Controllers.SeedCRD != nil {
+			SetDefaults_SeedCRDControllerConfiguration(in.Controllers.SeedCRD)
 		}
 		if in.Controllers.Shoot != nil {
 			SetDefaults_ShootControllerConfiguration(in.Controllers.Shoot)
@@ -73,10 +85,34 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			if in.Controllers.ShootCare.StaleExtensionHealthChecks != nil {
 				SetDefaults_StaleExtensionHealthChecks(in.Controllers.ShootCare.StaleExtensionHealthChecks)
 			}
+			if in.Controllers.ShootCare.Remediation != nil {
+				for i := range in.Controllers.ShootCare.Remediation.Rules {
+					a := &in.Controllers.ShootCare.Remediation.Rules[i]
+					SetDefaults_ShootRemediationRule(a)
+				}
+			}
+			if in.Controllers.ShootCare.APIServerAvailabilityProbing != nil {
+				SetDefaults_APIServerAvailabilityProbing(in.Controllers.ShootCare.APIServerAvailabilityProbing)
+			}
 		}
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
 		}
+		if in.Controllers.ShootEventMirror != nil {
+			SetDefaults_ShootEventMirrorControllerConfiguration(in.Controllers.ShootEventMirror)
+		}
+		if in.Controllers.ShootLeftover != nil {
+			SetDefaults_ShootLeftoverControllerConfiguration(in.Controllers.ShootLeftover)
+		}
+		if in.Controllers.ShootAvailabilityReport != nil {
+			SetDefaults_ShootAvailabilityReportControllerConfiguration(in.Controllers.ShootAvailabilityReport)
+		}
+		if in.Controllers.ShootInfrastructureDrift != nil {
+			SetDefaults_ShootInfrastructureDriftControllerConfiguration(in.Controllers.ShootInfrastructureDrift)
+		}
+		if in.Controllers.ShootDebugPod != nil {
+			SetDefaults_ShootDebugPodControllerConfiguration(in.Controllers.ShootDebugPod)
+		}
 		if in.Controllers.NetworkPolicy != nil {
 			SetDefaults_NetworkPolicyControllerConfiguration(in.Controllers.NetworkPolicy)
 		}
@@ -49,6 +49,18 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+	// AnnotationKeyBootstrapSteps is a constant for an annotation key on a Node describing the status of the bootstrap
+	// steps of the last reconciled operating system configuration. The value is a comma-separated list of
+	// '<step-name>=<state>' pairs.
+	AnnotationKeyBootstrapSteps = "node-agent.gardener.cloud/bootstrap-steps"
+	// AnnotationKeyChecksumBootstrapSteps is a constant for an annotation key on a Node describing the checksum of the
+	// operating system configuration for which the status in the AnnotationKeyBootstrapSteps annotation was reported.
+	AnnotationKeyChecksumBootstrapSteps = "checksum/bootstrap-steps"
+	// AnnotationKeyApplyStatus is a constant for an annotation key on a Node describing the status of the files and
+	// units which had to be applied for the last operating system configuration that could not be applied successfully.
+	// The value is a comma-separated list of '<file|unit>:<path|name>=<state>' pairs. The annotation is removed once
+	// the operating system configuration has been applied successfully.
+	AnnotationKeyApplyStatus = "node-agent.gardener.cloud/apply-status"
 )
 
 // OSVersionRegex is a regular expression to match operating system versions.
@@ -99,6 +99,16 @@ func SetDefaults_GardenCareControllerConfiguration(obj *GardenCareControllerConf
 	}
 }
 
+// SetDefaults_GardenETCDCapacityControllerConfiguration sets defaults for the GardenETCDCapacityControllerConfiguration object.
+func SetDefaults_GardenETCDCapacityControllerConfiguration(obj *GardenETCDCapacityControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.QuotaUtilizationThresholdPercentage == nil {
+		obj.QuotaUtilizationThresholdPercentage = ptr.To[int32](80)
+	}
+}
+
 // SetDefaults_GardenletDeployerControllerConfig sets defaults for the GardenletDeployerControllerConfig object.
 func SetDefaults_GardenletDeployerControllerConfig(obj *GardenletDeployerControllerConfig) {
 	if obj.ConcurrentSyncs == nil {
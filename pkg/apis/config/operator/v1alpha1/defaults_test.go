@@ -261,6 +261,31 @@ var _ = Describe("Defaults", func() {
 			})
 		})
 
+		Describe("GardenETCDCapacity controller defaulting", func() {
+			It("should default the GardenETCDCapacity controller config", func() {
+				SetObjectDefaults_OperatorConfiguration(obj)
+
+				Expect(obj.Controllers.GardenETCDCapacity.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+				Expect(obj.Controllers.GardenETCDCapacity.QuotaUtilizationThresholdPercentage).To(PointTo(Equal(int32(80))))
+			})
+
+			It("should not overwrite already set values for GardenETCDCapacity controller config", func() {
+				obj = &OperatorConfiguration{
+					Controllers: ControllerConfiguration{
+						GardenETCDCapacity: GardenETCDCapacityControllerConfiguration{
+							SyncPeriod:                          &metav1.Duration{Duration: time.Second},
+							QuotaUtilizationThresholdPercentage: ptr.To[int32](50),
+						},
+					},
+				}
+
+				SetObjectDefaults_OperatorConfiguration(obj)
+
+				Expect(obj.Controllers.GardenETCDCapacity.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+				Expect(obj.Controllers.GardenETCDCapacity.QuotaUtilizationThresholdPercentage).To(PointTo(Equal(int32(50))))
+			})
+		})
+
 		Describe("Extension controller defaulting", func() {
 			It("should default the Extension controller config", func() {
 				SetObjectDefaults_OperatorConfiguration(obj)
@@ -60,6 +60,8 @@ type ControllerConfiguration struct {
 	Garden GardenControllerConfig `json:"garden"`
 	// GardenCare is the configuration for the garden care controller
 	GardenCare GardenCareControllerConfiguration `json:"gardenCare"`
+	// GardenETCDCapacity is the configuration for the garden etcd capacity controller.
+	GardenETCDCapacity GardenETCDCapacityControllerConfiguration `json:"gardenETCDCapacity"`
 	// GardenletDeployer is the configuration for the gardenlet deployer controller.
 	GardenletDeployer GardenletDeployerControllerConfig `json:"gardenletDeployer"`
 	// NetworkPolicy is the configuration for the NetworkPolicy controller.
@@ -87,6 +89,17 @@ type GardenCareControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// GardenETCDCapacityControllerConfiguration defines the configuration of the GardenETCDCapacity controller.
+type GardenETCDCapacityControllerConfiguration struct {
+	// SyncPeriod is the duration how often the DB size of the virtual garden etcds is checked.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// QuotaUtilizationThresholdPercentage is the percentage of the etcd quota (quota-backend-bytes) above which the
+	// DB size is considered critical and recommendations for the etcd settings are reported. Defaults to 80.
+	// +optional
+	QuotaUtilizationThresholdPercentage *int32 `json:"quotaUtilizationThresholdPercentage,omitempty"`
+}
+
 // GardenControllerConfig is the configuration for the garden controller.
 type GardenControllerConfig struct {
 	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
@@ -38,6 +38,7 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
 	in.Garden.DeepCopyInto(&out.Garden)
 	in.GardenCare.DeepCopyInto(&out.GardenCare)
+	in.GardenETCDCapacity.DeepCopyInto(&out.GardenETCDCapacity)
 	in.GardenletDeployer.DeepCopyInto(&out.GardenletDeployer)
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	in.VPAEvictionRequirements.DeepCopyInto(&out.VPAEvictionRequirements)
@@ -178,6 +179,32 @@ func (in *GardenCareControllerConfiguration) DeepCopy() *GardenCareControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenETCDCapacityControllerConfiguration) DeepCopyInto(out *GardenETCDCapacityControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.QuotaUtilizationThresholdPercentage != nil {
+		in, out := &in.QuotaUtilizationThresholdPercentage, &out.QuotaUtilizationThresholdPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenETCDCapacityControllerConfiguration.
+func (in *GardenETCDCapacityControllerConfiguration) DeepCopy() *GardenETCDCapacityControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenETCDCapacityControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenControllerConfig) DeepCopyInto(out *GardenControllerConfig) {
 	*out = *in
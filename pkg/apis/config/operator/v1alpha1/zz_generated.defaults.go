@@ -29,6 +29,7 @@ func SetObjectDefaults_OperatorConfiguration(in *OperatorConfiguration) {
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_GardenControllerConfig(&in.Controllers.Garden)
 	SetDefaults_GardenCareControllerConfiguration(&in.Controllers.GardenCare)
+	SetDefaults_GardenETCDCapacityControllerConfiguration(&in.Controllers.GardenETCDCapacity)
 	SetDefaults_GardenletDeployerControllerConfig(&in.Controllers.GardenletDeployer)
 	SetDefaults_ExtensionControllerConfiguration(&in.Controllers.Extension)
 	SetDefaults_ExtensionCareControllerConfiguration(&in.Controllers.ExtensionCare)
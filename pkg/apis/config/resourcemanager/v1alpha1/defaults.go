@@ -98,6 +98,20 @@ func SetDefaults_CSRApproverControllerConfig(obj *CSRApproverControllerConfig) {
 	}
 }
 
+// SetDefaults_NodeAddressVerification sets defaults for the NodeAddressVerification object.
+func SetDefaults_NodeAddressVerification(obj *NodeAddressVerification) {
+	if obj.Backend == "" {
+		obj.Backend = NodeAddressVerificationBackendNode
+	}
+}
+
+// SetDefaults_NodeAddressVerificationWebhook sets defaults for the NodeAddressVerificationWebhook object.
+func SetDefaults_NodeAddressVerificationWebhook(obj *NodeAddressVerificationWebhook) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
 // SetDefaults_GarbageCollectorControllerConfig sets defaults for the GarbageCollectorControllerConfig object.
 func SetDefaults_GarbageCollectorControllerConfig(obj *GarbageCollectorControllerConfig) {
 	if obj.Enabled && obj.SyncPeriod == nil {
@@ -145,6 +159,13 @@ func SetDefaults_TokenRequestorControllerConfig(obj *TokenRequestorControllerCon
 	}
 }
 
+// SetDefaults_VolumeSnapshotPolicyControllerConfig sets defaults for the VolumeSnapshotPolicyControllerConfig object.
+func SetDefaults_VolumeSnapshotPolicyControllerConfig(obj *VolumeSnapshotPolicyControllerConfig) {
+	if obj.Enabled && obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(1)
+	}
+}
+
 // SetDefaults_NodeCriticalComponentsControllerConfig sets defaults for the NodeCriticalComponentsControllerConfig object.
 func SetDefaults_NodeCriticalComponentsControllerConfig(obj *NodeCriticalComponentsControllerConfig) {
 	if obj.Enabled {
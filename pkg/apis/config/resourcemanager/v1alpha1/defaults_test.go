@@ -230,6 +230,20 @@ var _ = Describe("ResourceManager defaulting", func() {
 
 			Expect(obj.Controllers.CSRApprover.ConcurrentSyncs).To(PointTo(Equal(2)))
 		})
+
+		It("should default the node address verification", func() {
+			obj.Controllers.CSRApprover = CSRApproverControllerConfig{
+				Enabled: true,
+				NodeAddressVerification: &NodeAddressVerification{
+					Webhook: &NodeAddressVerificationWebhook{},
+				},
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.CSRApprover.NodeAddressVerification.Backend).To(Equal(NodeAddressVerificationBackendNode))
+			Expect(obj.Controllers.CSRApprover.NodeAddressVerification.Webhook.Timeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+		})
 	})
 
 	Describe("GarbageCollectorControllerConfig defaulting", func() {
@@ -377,6 +391,26 @@ var _ = Describe("ResourceManager defaulting", func() {
 		})
 	})
 
+	Describe("VolumeSnapshotPolicyControllerConfig defaulting", func() {
+		It("should not default the VolumeSnapshotPolicyControllerConfig because it is disabled", func() {
+			obj.Controllers.VolumeSnapshotPolicy = VolumeSnapshotPolicyControllerConfig{}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.VolumeSnapshotPolicy.ConcurrentSyncs).To(BeNil())
+		})
+
+		It("should default the VolumeSnapshotPolicyControllerConfig because it is enabled", func() {
+			obj.Controllers.VolumeSnapshotPolicy = VolumeSnapshotPolicyControllerConfig{
+				Enabled: true,
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.VolumeSnapshotPolicy.ConcurrentSyncs).To(PointTo(Equal(1)))
+		})
+	})
+
 	Describe("NodeCriticalComponentsControllerConfig defaulting", func() {
 		It("should not default the NodeCriticalComponentsControllerConfig because it is disabled", func() {
 			obj.Controllers.NodeCriticalComponents = NodeCriticalComponentsControllerConfig{}
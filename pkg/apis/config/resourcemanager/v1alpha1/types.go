@@ -114,6 +114,8 @@ type ResourceManagerControllerConfiguration struct {
 	NodeAgentReconciliationDelay NodeAgentReconciliationDelayControllerConfig `json:"nodeAgentReconciliationDelay"`
 	// TokenRequestor is the configuration for the token-requestor controller.
 	TokenRequestor TokenRequestorControllerConfig `json:"tokenRequestor"`
+	// VolumeSnapshotPolicy is the configuration for the volume snapshot policy controller.
+	VolumeSnapshotPolicy VolumeSnapshotPolicyControllerConfig `json:"volumeSnapshotPolicy"`
 }
 
 // CSRApproverControllerConfig is the configuration for the csr-approver controller.
@@ -126,6 +128,52 @@ type CSRApproverControllerConfig struct {
 	// MachineNamespace is the namespace in the source cluster in which the Machine objects are stored.
 	// +optional
 	MachineNamespace *string `json:"machineNamespace,omitempty"`
+	// NodeAddressVerification is the configuration for verifying the DNS names and IP addresses requested in kubelet
+	// server certificate CSRs.
+	// +optional
+	NodeAddressVerification *NodeAddressVerification `json:"nodeAddressVerification,omitempty"`
+}
+
+// NodeAddressVerificationBackend is a backend for verifying the DNS names and IP addresses requested in kubelet server
+// certificate CSRs.
+type NodeAddressVerificationBackend string
+
+const (
+	// NodeAddressVerificationBackendNode verifies that the requested DNS names and IP addresses match the addresses in
+	// the status of the Node object.
+	NodeAddressVerificationBackendNode NodeAddressVerificationBackend = "Node"
+	// NodeAddressVerificationBackendMachine verifies that the requested DNS names and IP addresses match the addresses
+	// in the status of the Machine object of the node.
+	NodeAddressVerificationBackendMachine NodeAddressVerificationBackend = "Machine"
+	// NodeAddressVerificationBackendWebhook delegates the verification of the requested DNS names and IP addresses to
+	// an external webhook, e.g., served by a provider extension which looks up the addresses via the cloud API.
+	NodeAddressVerificationBackendWebhook NodeAddressVerificationBackend = "Webhook"
+)
+
+// NodeAddressVerification is the configuration for verifying the DNS names and IP addresses requested in kubelet
+// server certificate CSRs.
+type NodeAddressVerification struct {
+	// Backend is the backend used for the verification. Possible values are `Node`, `Machine` and `Webhook`.
+	// Defaults to `Node`.
+	// +optional
+	Backend NodeAddressVerificationBackend `json:"backend,omitempty"`
+	// Webhook is the configuration of the webhook used by the `Webhook` backend.
+	// +optional
+	Webhook *NodeAddressVerificationWebhook `json:"webhook,omitempty"`
+}
+
+// NodeAddressVerificationWebhook is the configuration of a webhook verifying the DNS names and IP addresses requested
+// in kubelet server certificate CSRs.
+type NodeAddressVerificationWebhook struct {
+	// URL is the HTTPS URL of the webhook.
+	URL string `json:"url"`
+	// CABundle is a PEM encoded CA bundle used to verify the webhook's server certificate. If it is not set, the
+	// system trust roots are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// Timeout is the timeout for requests to the webhook. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // GarbageCollectorControllerConfig is the configuration for the garbage-collector controller.
@@ -135,6 +183,9 @@ type GarbageCollectorControllerConfig struct {
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// DryRun defines whether the controller only logs the objects which would be collected instead of deleting them.
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
 }
 
 // HealthControllerConfig is the configuration for the health controller.
@@ -145,6 +196,26 @@ type HealthControllerConfig struct {
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// CustomHealthChecks is a list of health checks defined via CEL expressions for objects of kinds which are not
+	// covered by the built-in health checks, e.g., custom resources of other operators. A custom health check takes
+	// precedence over the built-in health check of the respective kind.
+	// +optional
+	CustomHealthChecks []CustomHealthCheck `json:"customHealthChecks,omitempty"`
+}
+
+// CustomHealthCheck is a health check defined via a CEL expression for objects of a specific kind.
+type CustomHealthCheck struct {
+	// Group is the API group of the checked objects. Leave empty for the core API group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the checked objects.
+	Kind string `json:"kind"`
+	// Expression is a CEL expression which can access the checked object via the `object` variable. It must evaluate
+	// to true if the object is healthy.
+	Expression string `json:"expression"`
+	// Message is the message which is reported if the expression evaluates to false.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
@@ -201,6 +272,15 @@ type TokenRequestorControllerConfig struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// VolumeSnapshotPolicyControllerConfig is the configuration for the volume snapshot policy controller.
+type VolumeSnapshotPolicyControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool `json:"enabled"`
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // NodeCriticalComponentsControllerConfig is the configuration for the node critical components controller.
 type NodeCriticalComponentsControllerConfig struct {
 	// Enabled defines whether this controller is enabled.
@@ -235,6 +315,8 @@ type ResourceManagerWebhookConfiguration struct {
 	ExtensionValidation ExtensionValidation `json:"extensionValidation"`
 	// HighAvailabilityConfig is the configuration for the high-availability-config webhook.
 	HighAvailabilityConfig HighAvailabilityConfigWebhookConfig `json:"highAvailabilityConfig"`
+	// ImageOverrides is the configuration for the image-overrides webhook.
+	ImageOverrides ImageOverridesWebhookConfig `json:"imageOverrides"`
 	// KubernetesServiceHost is the configuration for the kubernetes-service-host webhook.
 	KubernetesServiceHost KubernetesServiceHostWebhookConfig `json:"kubernetesServiceHost"`
 	// SystemComponentsConfig is the configuration for the system-components-config webhook.
@@ -253,12 +335,18 @@ type ResourceManagerWebhookConfiguration struct {
 	SeccompProfile SeccompProfileWebhookConfig `json:"seccompProfile"`
 	// VPAInPlaceUpdates is the configuration for the vpa-in-place-updates webhook.
 	VPAInPlaceUpdates VPAInPlaceUpdatesConfig `json:"vpaInPlaceUpdates"`
+	// WorkloadDefaults is the configuration for the workload-defaults webhook.
+	WorkloadDefaults WorkloadDefaultsWebhookConfig `json:"workloadDefaults"`
 }
 
 // CRDDeletionProtection is the configuration for the crd-deletion-protection webhook.
 type CRDDeletionProtection struct {
 	// Enabled defines whether this webhook is enabled.
 	Enabled bool `json:"enabled"`
+	// CheckExistingResources defines whether the deletion of protected CustomResourceDefinitions is also denied as
+	// long as custom resources of them still exist in any namespace, even if the deletion is confirmed.
+	// +optional
+	CheckExistingResources bool `json:"checkExistingResources,omitempty"`
 }
 
 // EndpointSliceHintsWebhookConfig is the configuration for the endpoint-slice-hints webhook.
@@ -321,8 +409,26 @@ type PodSchedulerNameWebhookConfig struct {
 	// Enabled defines whether this webhook is enabled.
 	Enabled bool `json:"enabled"`
 	// SchedulerName is the name of the scheduler that should be written into the .spec.schedulerName of pod resources.
+	// It is used for all pods which are not matched by any of the rules.
 	// +optional
 	SchedulerName *string `json:"schedulerName,omitempty"`
+	// Rules is a list of rules which allow using different scheduler names for specific pods. The rules are evaluated
+	// in order and the scheduler name of the first matching rule is used. If no rule matches, SchedulerName is used.
+	// +optional
+	Rules []PodSchedulerNameRule `json:"rules,omitempty"`
+}
+
+// PodSchedulerNameRule configures the scheduler name for pods matching the given selectors.
+type PodSchedulerNameRule struct {
+	// SchedulerName is the name of the scheduler that should be written into the .spec.schedulerName of matching pod
+	// resources.
+	SchedulerName string `json:"schedulerName"`
+	// NamespaceSelector is the label selector for namespaces of matching pods. If not set, pods in all namespaces match.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// PodSelector is the label selector for matching pods. If not set, all pods match.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
 }
 
 // PodTopologySpreadConstraintsWebhookConfig is the configuration for the pod-topology-spread-constraints webhook.
@@ -364,6 +470,27 @@ type VPAInPlaceUpdatesConfig struct {
 	Enabled bool `json:"enabled"`
 }
 
+// ImageOverridesWebhookConfig is the configuration for the image-overrides webhook.
+type ImageOverridesWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// WorkloadDefaultsWebhookConfig is the configuration for the workload-defaults webhook.
+type WorkloadDefaultsWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool `json:"enabled"`
+	// TopologySpreadConstraints are the topology spread constraints that should be added to pods which do not specify
+	// any. If the label selector of a constraint is not set, the labels of the respective pod are used.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Affinity is the affinity that should be added to pods which do not specify the respective node affinity, pod
+	// affinity or pod anti-affinity. If the label selector of a pod (anti-)affinity term is not set, the labels of the
+	// respective pod are used.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+}
+
 const (
 	// DefaultResourceClass is used as resource class if no class is specified on the command line.
 	DefaultResourceClass = "resources"
@@ -45,6 +45,11 @@ func (in *CSRApproverControllerConfig) DeepCopyInto(out *CSRApproverControllerCo
 		*out = new(string)
 		**out = **in
 	}
+	if in.NodeAddressVerification != nil {
+		in, out := &in.NodeAddressVerification, &out.NodeAddressVerification
+		*out = new(NodeAddressVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -85,6 +90,22 @@ func (in *ClientConnection) DeepCopy() *ClientConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomHealthCheck) DeepCopyInto(out *CustomHealthCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomHealthCheck.
+func (in *CustomHealthCheck) DeepCopy() *CustomHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointSliceHintsWebhookConfig) DeepCopyInto(out *EndpointSliceHintsWebhookConfig) {
 	*out = *in
@@ -125,6 +146,11 @@ func (in *GarbageCollectorControllerConfig) DeepCopyInto(out *GarbageCollectorCo
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -169,6 +195,11 @@ func (in *HealthControllerConfig) DeepCopyInto(out *HealthControllerConfig) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.CustomHealthChecks != nil {
+		in, out := &in.CustomHealthChecks, &out.CustomHealthChecks
+		*out = make([]CustomHealthCheck, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -208,6 +239,22 @@ func (in *HighAvailabilityConfigWebhookConfig) DeepCopy() *HighAvailabilityConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageOverridesWebhookConfig) DeepCopyInto(out *ImageOverridesWebhookConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOverridesWebhookConfig.
+func (in *ImageOverridesWebhookConfig) DeepCopy() *ImageOverridesWebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageOverridesWebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressControllerSelector) DeepCopyInto(out *IngressControllerSelector) {
 	*out = *in
@@ -310,6 +357,53 @@ func (in *NetworkPolicyControllerConfig) DeepCopy() *NetworkPolicyControllerConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAddressVerification) DeepCopyInto(out *NodeAddressVerification) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(NodeAddressVerificationWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAddressVerification.
+func (in *NodeAddressVerification) DeepCopy() *NodeAddressVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAddressVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAddressVerificationWebhook) DeepCopyInto(out *NodeAddressVerificationWebhook) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAddressVerificationWebhook.
+func (in *NodeAddressVerificationWebhook) DeepCopy() *NodeAddressVerificationWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAddressVerificationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAgentAuthorizerWebhookConfig) DeepCopyInto(out *NodeAgentAuthorizerWebhookConfig) {
 	*out = *in
@@ -404,6 +498,32 @@ func (in *PodKubeAPIServerLoadBalancingWebhookConfig) DeepCopy() *PodKubeAPIServ
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSchedulerNameRule) DeepCopyInto(out *PodSchedulerNameRule) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSchedulerNameRule.
+func (in *PodSchedulerNameRule) DeepCopy() *PodSchedulerNameRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSchedulerNameRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSchedulerNameWebhookConfig) DeepCopyInto(out *PodSchedulerNameWebhookConfig) {
 	*out = *in
@@ -412,6 +532,13 @@ func (in *PodSchedulerNameWebhookConfig) DeepCopyInto(out *PodSchedulerNameWebho
 		*out = new(string)
 		**out = **in
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]PodSchedulerNameRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -523,6 +650,7 @@ func (in *ResourceManagerControllerConfiguration) DeepCopyInto(out *ResourceMana
 	in.NodeCriticalComponents.DeepCopyInto(&out.NodeCriticalComponents)
 	in.NodeAgentReconciliationDelay.DeepCopyInto(&out.NodeAgentReconciliationDelay)
 	in.TokenRequestor.DeepCopyInto(&out.TokenRequestor)
+	in.VolumeSnapshotPolicy.DeepCopyInto(&out.VolumeSnapshotPolicy)
 	return
 }
 
@@ -543,6 +671,7 @@ func (in *ResourceManagerWebhookConfiguration) DeepCopyInto(out *ResourceManager
 	out.EndpointSliceHints = in.EndpointSliceHints
 	out.ExtensionValidation = in.ExtensionValidation
 	in.HighAvailabilityConfig.DeepCopyInto(&out.HighAvailabilityConfig)
+	out.ImageOverrides = in.ImageOverrides
 	out.KubernetesServiceHost = in.KubernetesServiceHost
 	in.SystemComponentsConfig.DeepCopyInto(&out.SystemComponentsConfig)
 	out.PodKubeAPIServerLoadBalancing = in.PodKubeAPIServerLoadBalancing
@@ -552,6 +681,7 @@ func (in *ResourceManagerWebhookConfiguration) DeepCopyInto(out *ResourceManager
 	in.NodeAgentAuthorizer.DeepCopyInto(&out.NodeAgentAuthorizer)
 	out.SeccompProfile = in.SeccompProfile
 	out.VPAInPlaceUpdates = in.VPAInPlaceUpdates
+	in.WorkloadDefaults.DeepCopyInto(&out.WorkloadDefaults)
 	return
 }
 
@@ -713,3 +843,52 @@ func (in *VPAInPlaceUpdatesConfig) DeepCopy() *VPAInPlaceUpdatesConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotPolicyControllerConfig) DeepCopyInto(out *VolumeSnapshotPolicyControllerConfig) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotPolicyControllerConfig.
+func (in *VolumeSnapshotPolicyControllerConfig) DeepCopy() *VolumeSnapshotPolicyControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotPolicyControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadDefaultsWebhookConfig) DeepCopyInto(out *WorkloadDefaultsWebhookConfig) {
+	*out = *in
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadDefaultsWebhookConfig.
+func (in *WorkloadDefaultsWebhookConfig) DeepCopy() *WorkloadDefaultsWebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadDefaultsWebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -37,11 +37,18 @@ func SetObjectDefaults_ResourceManagerConfiguration(in *ResourceManagerConfigura
 	SetDefaults_GarbageCollectorControllerConfig(&in.Controllers.GarbageCollector)
 	SetDefaults_HealthControllerConfig(&in.Controllers.Health)
 	SetDefaults_CSRApproverControllerConfig(&in.Controllers.CSRApprover)
+	if in.Controllers.CSRApprover.NodeAddressVerification != nil {
+		SetDefaults_NodeAddressVerification(in.Controllers.CSRApprover.NodeAddressVerification)
+		if in.Controllers.CSRApprover.NodeAddressVerification.Webhook != nil {
+			SetDefaults_NodeAddressVerificationWebhook(in.Controllers.CSRApprover.NodeAddressVerification.Webhook)
+		}
+	}
 	SetDefaults_ManagedResourceControllerConfig(&in.Controllers.ManagedResource)
 	SetDefaults_NetworkPolicyControllerConfig(&in.Controllers.NetworkPolicy)
 	SetDefaults_NodeCriticalComponentsControllerConfig(&in.Controllers.NodeCriticalComponents)
 	SetDefaults_NodeAgentReconciliationDelayControllerConfig(&in.Controllers.NodeAgentReconciliationDelay)
 	SetDefaults_TokenRequestorControllerConfig(&in.Controllers.TokenRequestor)
+	SetDefaults_VolumeSnapshotPolicyControllerConfig(&in.Controllers.VolumeSnapshotPolicy)
 	SetDefaults_PodSchedulerNameWebhookConfig(&in.Webhooks.PodSchedulerName)
 	SetDefaults_ProjectedTokenMountWebhookConfig(&in.Webhooks.ProjectedTokenMount)
 }
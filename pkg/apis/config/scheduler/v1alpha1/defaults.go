@@ -5,6 +5,9 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 
 	"github.com/gardener/gardener/pkg/apis/config"
@@ -46,6 +49,18 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 	if obj.Shoot.ConcurrentSyncs == 0 {
 		obj.Shoot.ConcurrentSyncs = 5
 	}
+
+	if obj.SeedDrain == nil {
+		obj.SeedDrain = &SeedDrainSchedulerConfiguration{}
+	}
+
+	if obj.SeedDrain.ConcurrentSyncs == 0 {
+		obj.SeedDrain.ConcurrentSyncs = 2
+	}
+
+	if obj.SeedDrain.SyncPeriod == nil {
+		obj.SeedDrain.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
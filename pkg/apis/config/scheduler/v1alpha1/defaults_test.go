@@ -60,6 +60,10 @@ var _ = Describe("Defaults", func() {
 					ConcurrentSyncs: 5,
 					Strategy:        schedulerconfigv1alpha1.Default,
 				},
+				SeedDrain: &schedulerconfigv1alpha1.SeedDrainSchedulerConfiguration{
+					ConcurrentSyncs: 2,
+					SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+				},
 			}))
 		})
 
@@ -73,6 +77,10 @@ var _ = Describe("Defaults", func() {
 						ConcurrentSyncs: 6,
 						Strategy:        schedulerconfigv1alpha1.MinimalDistance,
 					},
+					SeedDrain: &schedulerconfigv1alpha1.SeedDrainSchedulerConfiguration{
+						ConcurrentSyncs: 4,
+						SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+					},
 				},
 			}
 
@@ -86,6 +94,10 @@ var _ = Describe("Defaults", func() {
 					ConcurrentSyncs: 6,
 					Strategy:        schedulerconfigv1alpha1.MinimalDistance,
 				},
+				SeedDrain: &schedulerconfigv1alpha1.SeedDrainSchedulerConfiguration{
+					ConcurrentSyncs: 4,
+					SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+				},
 			}))
 		})
 	})
@@ -68,6 +68,9 @@ type SchedulerControllerConfiguration struct {
 	// Shoot defines the configuration of the Shoot controller.
 	// +optional
 	Shoot *ShootSchedulerConfiguration `json:"shoot,omitempty"`
+	// SeedDrain defines the configuration of the SeedDrain controller.
+	// +optional
+	SeedDrain *SeedDrainSchedulerConfiguration `json:"seedDrain,omitempty"`
 }
 
 // BackupBucketSchedulerConfiguration defines the configuration of the BackupBucket to Seed
@@ -88,6 +91,18 @@ type ShootSchedulerConfiguration struct {
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
 }
 
+// SeedDrainSchedulerConfiguration defines the configuration of the controller which migrates shoots away from
+// seeds which are being drained.
+type SeedDrainSchedulerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// SyncPeriod is the duration how often seeds which are being drained are reconciled in order to migrate the
+	// next batch of shoots.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // ServerConfiguration contains details for the HTTP(S) servers.
 type ServerConfiguration struct {
 	// HealthProbes is the configuration for serving the healthz and readyz endpoints.
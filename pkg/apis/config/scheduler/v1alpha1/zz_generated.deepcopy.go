@@ -10,6 +10,7 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -88,6 +89,11 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 		*out = new(ShootSchedulerConfiguration)
 		**out = **in
 	}
+	if in.SeedDrain != nil {
+		in, out := &in.SeedDrain, &out.SeedDrain
+		*out = new(SeedDrainSchedulerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -101,6 +107,27 @@ func (in *SchedulerControllerConfiguration) DeepCopy() *SchedulerControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainSchedulerConfiguration) DeepCopyInto(out *SeedDrainSchedulerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainSchedulerConfiguration.
+func (in *SeedDrainSchedulerConfiguration) DeepCopy() *SeedDrainSchedulerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainSchedulerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -51,6 +51,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ExposureClassList{},
 		&InternalSecret{},
 		&InternalSecretList{},
+		&MaintenanceFreeze{},
+		&MaintenanceFreezeList{},
 		&NamespacedCloudProfile{},
 		&NamespacedCloudProfileList{},
 		&Project{},
@@ -65,6 +67,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ShootStateList{},
 		&Shoot{},
 		&ShootList{},
+		&ShootFootprintRequest{},
+		&ShootImpactRequest{},
+		&ShootDebugPodRequest{},
 	)
 
 	return nil
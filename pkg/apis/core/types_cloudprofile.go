@@ -78,6 +78,12 @@ type CloudProfileSpec struct {
 	// The order of values for a given capability is relevant. The most important value is listed first.
 	// During maintenance upgrades, the image that matches most capabilities will be selected.
 	MachineCapabilities []CapabilityDefinition
+	// ShootValidationRules contains CEL validation rules which must be satisfied by Shoot clusters using this CloudProfile.
+	// See https://github.com/gardener/gardener/blob/master/docs/usage/shoot/shoot_validation_rules.md.
+	ShootValidationRules []ShootValidationRule
+	// VersionLifecyclePolicy declares automatic transitions of the classifications of the Kubernetes and machine image
+	// versions which are applied by the gardener-controller-manager.
+	VersionLifecyclePolicy *VersionLifecyclePolicy
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
@@ -272,6 +278,30 @@ type BastionMachineType struct {
 	Name string
 }
 
+// VersionLifecyclePolicy declares automatic transitions of the classifications of versions in a CloudProfile.
+type VersionLifecyclePolicy struct {
+	// Kubernetes contains the classification transitions for the Kubernetes versions.
+	Kubernetes *VersionClassificationTransitions
+	// MachineImages contains the classification transitions for the versions of all machine images.
+	MachineImages *VersionClassificationTransitions
+	// DryRun indicates that due transitions are only reported via events instead of being applied to the CloudProfile.
+	DryRun *bool
+}
+
+// VersionClassificationTransitions contains the durations after which versions transition to their next
+// classification. The durations are measured from the time the version was observed in its current classification.
+// Versions using lifecycle stages are not affected.
+type VersionClassificationTransitions struct {
+	// SupportAfter is the duration after which preview versions are classified as supported.
+	SupportAfter *metav1.Duration
+	// DeprecateAfter is the duration after which supported versions are classified as deprecated. The latest supported
+	// version is never deprecated.
+	DeprecateAfter *metav1.Duration
+	// ExpireAfter is the duration after which deprecated versions expire. When a version is deprecated, its expiration
+	// date is set accordingly unless it is already set. The latest version never expires.
+	ExpireAfter *metav1.Duration
+}
+
 // CloudProfileStatus contains the status of the cloud profile.
 type CloudProfileStatus struct {
 	// Kubernetes contains the status information for kubernetes.
@@ -300,6 +330,8 @@ type ExpirableVersionStatus struct {
 	Version string
 	// Classification reflects the current state in the classification lifecycle.
 	Classification VersionClassification
+	// LastTransitionTime is the time the version was first observed in its current classification.
+	LastTransitionTime *metav1.Time
 }
 
 // Limits configures operational limits for Shoot clusters using this CloudProfile.
@@ -309,6 +341,20 @@ type Limits struct {
 	MaxNodesTotal *int32
 }
 
+// ShootValidationRule is a CEL validation rule which is evaluated when Shoot clusters using the CloudProfile are created
+// or updated.
+type ShootValidationRule struct {
+	// Name is the unique name of the rule.
+	Name string
+	// Expression is the CEL expression which must evaluate to true for the Shoot to be admitted. The variable `shoot`
+	// contains the Shoot in version core.gardener.cloud/v1beta1, the variable `oldShoot` contains the Shoot before the
+	// update (it is null when the Shoot is created).
+	Expression string
+	// Message is the error message returned when the expression evaluates to false. Defaults to a message containing the
+	// name of the rule.
+	Message *string
+}
+
 const (
 	// VolumeClassStandard is a constant for the standard volume class.
 	VolumeClassStandard string = "standard"
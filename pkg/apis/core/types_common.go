@@ -30,6 +30,11 @@ const (
 	ErrorRetryableInfraDependencies ErrorCode = "ERR_RETRYABLE_INFRA_DEPENDENCIES"
 	// ErrorInfraResourcesDepleted indicates that the last error occurred due to depleted resource in the infrastructure.
 	ErrorInfraResourcesDepleted ErrorCode = "ERR_INFRA_RESOURCES_DEPLETED"
+	// ErrorInfraImageNotFound indicates that the last error occurred due to a machine image which could not be found in the infrastructure.
+	// It is classified as a non-retryable error code.
+	ErrorInfraImageNotFound ErrorCode = "ERR_INFRA_IMAGE_NOT_FOUND"
+	// ErrorInfraIPAddressesExhausted indicates that the last error occurred due to exhausted IP addresses in the infrastructure networks.
+	ErrorInfraIPAddressesExhausted ErrorCode = "ERR_INFRA_IP_ADDRESSES_EXHAUSTED"
 	// ErrorCleanupClusterResources indicates that the last error occurred due to resources in the cluster that are stuck in deletion.
 	ErrorCleanupClusterResources ErrorCode = "ERR_CLEANUP_CLUSTER_RESOURCES"
 	// ErrorConfigurationProblem indicates that the last error occurred due to a configuration problem.
@@ -43,6 +48,20 @@ const (
 	ErrorProblematicWebhook ErrorCode = "ERR_PROBLEMATIC_WEBHOOK"
 )
 
+// ErrorCodeDefinition describes an error code which is not well-known to Gardener but may be reported by an extension.
+type ErrorCodeDefinition struct {
+	// Code is the error code. It must start with `ERR_` and consist of upper case letters, digits and underscores only.
+	Code ErrorCode
+	// Description is a human readable description of the error code.
+	Description string
+	// UserError indicates that the error can be remediated by the user (e.g., by refreshing expired infrastructure
+	// credentials) and does not require a Gardener operator.
+	UserError bool
+	// NonRetryable indicates that an automatic retry would not help fixing the problem. Operations failing with such
+	// an error are not retried.
+	NonRetryable bool
+}
+
 // LastError indicates the last occurred error for an operation on a resource.
 type LastError struct {
 	// A human readable message indicating details about the last error.
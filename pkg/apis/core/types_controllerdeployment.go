@@ -62,6 +62,17 @@ type HelmControllerDeployment struct {
 	Values *apiextensionsv1.JSON
 	// OCIRepository defines where to pull the chart.
 	OCIRepository *OCIRepository
+	// ValuesOverlays is a list of chart values which are merged into the values for seeds matching the respective seed
+	// selector. The overlays are merged in the order of the list, i.e., later overlays take precedence.
+	ValuesOverlays []HelmValuesOverlay
+}
+
+// HelmValuesOverlay contains chart values which are merged into the values for seeds matching the seed selector.
+type HelmValuesOverlay struct {
+	// SeedSelector is a label selector for seeds. An empty selector matches all seeds.
+	SeedSelector *metav1.LabelSelector
+	// Values are the chart values which are merged into the values for matching seeds.
+	Values *apiextensionsv1.JSON
 }
 
 // OCIRepository configures where to pull an OCI Artifact, that could contain for example a Helm Chart.
@@ -73,4 +73,7 @@ const (
 	// ControllerInstallationRequired is a condition type for indicating that the respective extension controller is
 	// still required on the seed cluster as corresponding extension resources still exist.
 	ControllerInstallationRequired ConditionType = "Required"
+	// ControllerInstallationDependenciesSatisfied is a condition type for indicating whether all ControllerRegistrations
+	// the respective extension controller depends on are installed.
+	ControllerInstallationDependenciesSatisfied ConditionType = "DependenciesSatisfied"
 )
@@ -42,6 +42,19 @@ type ControllerRegistrationSpec struct {
 	Resources []ControllerResource
 	// Deployment contains information for how this controller is deployed.
 	Deployment *ControllerRegistrationDeployment
+	// ErrorCodes is a list of error codes which may be reported by this controller in addition to the well-known error
+	// codes of Gardener.
+	ErrorCodes []ErrorCodeDefinition
+	// Dependencies is a list of other ControllerRegistrations this controller depends on. Whenever this controller is
+	// required for a seed or shoot, its dependencies are required as well, and it is only installed after all of its
+	// dependencies have been installed successfully.
+	Dependencies []ControllerRegistrationDependency
+}
+
+// ControllerRegistrationDependency is a reference to another ControllerRegistration a controller depends on.
+type ControllerRegistrationDependency struct {
+	// Name is the name of the ControllerRegistration.
+	Name string
 }
 
 // ClusterType defines the type of cluster.
@@ -84,8 +97,19 @@ type ControllerResource struct {
 	// If compatibility is not specified, it will be defaulted to 'shoot'.
 	// This field can only be set for resources of kind "Extension".
 	ClusterCompatibility []ClusterType
+	// Capabilities is a list of optional features of the resource kind which are supported by the controller.
+	Capabilities []ControllerResourceCapability
 }
 
+// ControllerResourceCapability is an optional feature of an extension resource kind supported by a controller.
+type ControllerResourceCapability string
+
+const (
+	// ControllerResourceCapabilityDNSFailover is a capability of DNSRecord controllers indicating that they support
+	// health-checked secondary targets of DNS records.
+	ControllerResourceCapabilityDNSFailover ControllerResourceCapability = "DNSFailover"
+)
+
 // DeploymentRef contains information about `ControllerDeployment` references.
 type DeploymentRef struct {
 	// Name is the name of the `ControllerDeployment` that is being referred to.
@@ -102,6 +126,31 @@ type ControllerRegistrationDeployment struct {
 	SeedSelector *metav1.LabelSelector
 	// DeploymentRefs holds references to `ControllerDeployments`. Only one element is supported currently.
 	DeploymentRefs []DeploymentRef
+	// Rollout configures how a change of the referenced `ControllerDeployment` is rolled out across seeds. If not set,
+	// changes are rolled out to all seeds at once.
+	Rollout *ControllerDeploymentRollout
+}
+
+// ControllerDeploymentRollout contains the configuration for rolling out a change of the referenced
+// `ControllerDeployment` across seeds.
+type ControllerDeploymentRollout struct {
+	// Canary configures a canary rollout. When the `ControllerRegistration` is switched to another
+	// `ControllerDeployment`, the new `ControllerDeployment` is rolled out to the canary seeds first. It is only rolled
+	// out to the remaining seeds after it has been verified successfully on the canary seeds, otherwise the rollout is
+	// held.
+	Canary *ControllerDeploymentCanaryRollout
+}
+
+// ControllerDeploymentCanaryRollout contains the configuration for a canary rollout of a `ControllerDeployment`.
+type ControllerDeploymentCanaryRollout struct {
+	// SeedSelector is a label selector for the canary seeds.
+	SeedSelector metav1.LabelSelector
+	// VerificationDuration is the duration for which the extension must be installed and healthy on all canary seeds
+	// before it is rolled out to the remaining seeds.
+	VerificationDuration *metav1.Duration
+	// MinShootReconcileSuccessRate is the minimum percentage of successful shoot reconciliations on the canary seeds
+	// since the new `ControllerDeployment` was rolled out to them.
+	MinShootReconcileSuccessRate *int32
 }
 
 // ControllerDeploymentPolicy is a string alias.
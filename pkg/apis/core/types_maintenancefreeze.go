@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenanceFreeze represents time ranges during which the maintenance of Shoots is paused.
+type MaintenanceFreeze struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec contains the specification of the MaintenanceFreeze.
+	Spec MaintenanceFreezeSpec
+	// Status contains the status of the MaintenanceFreeze.
+	Status MaintenanceFreezeStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenanceFreezeList is a collection of MaintenanceFreezes.
+type MaintenanceFreezeList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+
+	// Items is the list of MaintenanceFreezes.
+	Items []MaintenanceFreeze
+}
+
+// MaintenanceFreezeSpec is the specification of a MaintenanceFreeze.
+type MaintenanceFreezeSpec struct {
+	// Windows are the time ranges during which the maintenance of the selected Shoots is paused.
+	Windows []MaintenanceFreezeWindow
+	// ProjectSelector is an optional label selector for Projects whose Shoots are affected by the MaintenanceFreeze.
+	// If it is not set, Shoots of all Projects are affected.
+	ProjectSelector *metav1.LabelSelector
+	// SeedSelector is an optional label selector for Seeds whose Shoots are affected by the MaintenanceFreeze.
+	// If it is not set, Shoots on all Seeds are affected.
+	SeedSelector *metav1.LabelSelector
+}
+
+// MaintenanceFreezeWindow is a time range during which the maintenance of Shoots is paused.
+type MaintenanceFreezeWindow struct {
+	// Begin is the beginning of the time range.
+	Begin metav1.Time
+	// End is the end of the time range.
+	End metav1.Time
+}
+
+// MaintenanceFreezeStatus is the status of a MaintenanceFreeze.
+type MaintenanceFreezeStatus struct {
+	// DeferredShoots contains the Shoots whose maintenance was deferred because of the MaintenanceFreeze.
+	DeferredShoots []DeferredShootMaintenance
+}
+
+// DeferredShootMaintenance contains information about a Shoot whose maintenance was deferred.
+type DeferredShootMaintenance struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string
+	// Name is the name of the Shoot.
+	Name string
+	// LastDeferredTime is the last time the maintenance of the Shoot was deferred.
+	LastDeferredTime metav1.Time
+}
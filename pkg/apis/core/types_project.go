@@ -63,6 +63,9 @@ type ProjectSpec struct {
 	Tolerations *ProjectTolerations
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	DualApprovalForDeletion []DualApprovalForDeletion
+	// ShootDefaults contains default values for Shoots in this project. They are applied when Shoots are created and
+	// the respective fields are not set.
+	ShootDefaults *ProjectShootDefaults
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -105,6 +108,45 @@ type Toleration struct {
 	Key string
 	// Value is the toleration value corresponding to the toleration key.
 	Value *string
+	// ExpirationTimestamp is the timestamp after which the toleration is no longer considered, i.e., the seed taints it
+	// tolerates are effective again for scheduling. This is only supported for shoot tolerations.
+	ExpirationTimestamp *metav1.Time
+}
+
+// ProjectShootDefaults contains default values for Shoots in a project.
+type ProjectShootDefaults struct {
+	// Maintenance contains defaults for the maintenance configuration of Shoots.
+	Maintenance *ProjectShootMaintenanceDefaults
+	// Hibernation contains defaults for the hibernation configuration of Shoots.
+	Hibernation *ProjectShootHibernationDefaults
+	// Workers contains defaults for the worker pools of Shoots.
+	Workers *ProjectShootWorkerDefaults
+}
+
+// ProjectShootMaintenanceDefaults contains defaults for the maintenance configuration of Shoots.
+type ProjectShootMaintenanceDefaults struct {
+	// TimeWindow is the default maintenance time window of Shoots.
+	TimeWindow *MaintenanceTimeWindow
+	// ConfineSpecUpdateRollout is the default for `.spec.maintenance.confineSpecUpdateRollout` of Shoots.
+	ConfineSpecUpdateRollout *bool
+}
+
+// ProjectShootHibernationDefaults contains defaults for the hibernation configuration of Shoots.
+type ProjectShootHibernationDefaults struct {
+	// Schedules are the default hibernation schedules of Shoots. They are only applied if a Shoot does not specify any
+	// hibernation schedule.
+	Schedules []HibernationSchedule
+}
+
+// ProjectShootWorkerDefaults contains defaults for the worker pools of Shoots.
+type ProjectShootWorkerDefaults struct {
+	// MachineImage is the default machine image of worker pools. If the version is omitted, the latest supported version
+	// of the image in the CloudProfile is used.
+	MachineImage *ShootMachineImage
+	// Volume is the default root volume of worker pools.
+	Volume *Volume
+	// Labels are added to the labels of worker pools. Labels specified in the worker pools take precedence.
+	Labels map[string]string
 }
 
 // DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
@@ -99,6 +99,18 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// Drain contains information about the progress of draining the seed.
+	Drain *SeedDrainStatus
+}
+
+// SeedDrainStatus contains information about the progress of draining a seed.
+type SeedDrainStatus struct {
+	// RemainingShoots is the number of shoots which are still scheduled onto the seed.
+	RemainingShoots int32
+	// MigratingShoots is the number of shoots which are currently being migrated away from the seed.
+	MigratingShoots int32
+	// LastUpdateTime is the time at which the drain progress was last updated.
+	LastUpdateTime metav1.Time
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
@@ -168,6 +180,16 @@ type Ingress struct {
 	Domain string
 	// Controller configures a Gardener managed Ingress Controller listening on the ingressDomain
 	Controller IngressController
+	// WildcardCertificate configures that the wildcard certificate for the ingress domain is requested from a
+	// certificate issuer (e.g., an ACME issuer provided by an extension) instead of being provided as static secret.
+	WildcardCertificate *IngressWildcardCertificate
+}
+
+// IngressWildcardCertificate configures how the wildcard certificate for the ingress domain is requested.
+type IngressWildcardCertificate struct {
+	// IssuerName is the name of the issuer used for requesting the certificate. If not set, the default issuer of the
+	// certificate controller is used.
+	IssuerName *string
 }
 
 // IngressController enables a Gardener managed Ingress Controller listening on the ingressDomain
@@ -236,6 +258,15 @@ type SeedSettings struct {
 	// rather than randomly selected from seed zones.
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#zone-selection.
 	ZoneSelection *SeedSettingZoneSelection
+	// IstioTLSTermination controls whether the istio ingress gateways of the seed terminate TLS for the shoot
+	// kube-apiservers and originate new TLS connections with client certificates to them (instead of TCP passthrough).
+	// See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#istio-tls-termination.
+	IstioTLSTermination *SeedSettingIstioTLSTermination
+	// Drain controls the controlled evacuation of all shoots from the seed, e.g. before it is decommissioned.
+	Drain *SeedSettingDrain
+	// ControlPlaneResourceQuota controls the ResourceQuotas and LimitRanges in the control plane namespaces of shoots.
+	// See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#control-plane-resource-quota.
+	ControlPlaneResourceQuota *SeedSettingControlPlaneResourceQuota
 }
 
 // SeedSettingZoneSelection controls whether shoot control plane zone placement is derived
@@ -281,6 +312,10 @@ type SeedSettingScheduling struct {
 	// Visible controls whether the gardener-scheduler shall consider this seed when scheduling shoots. Invisible seeds
 	// are not considered by the scheduler.
 	Visible bool
+	// Profile is the scheduling profile used for shoot control plane pods in the seed. When set to `bin-packing`, a
+	// dedicated kube-scheduler that bin-packs shoot control plane pods is deployed into the seed. Defaults to
+	// `balanced`.
+	Profile *SchedulingProfile
 }
 
 // SeedSettingLoadBalancerServices controls certain settings for services of type load balancer that are created in the
@@ -330,6 +365,20 @@ type LoadBalancerServicesProxyProtocol struct {
 	// The option allows a migration from non-ProxyProtocol to ProxyProtocol without downtime (depending on the infrastructure).
 	// Defaults to false.
 	Allowed bool
+	// TLVs is a list of type-length-value (TLV) fields of PROXY protocol v2 headers which are parsed by the istio ingress
+	// gateways, e.g., VPC endpoint IDs added by cloud load balancers for private links. The values are stored as dynamic
+	// metadata of the connection and are added as `X-Gardener-Proxy-Protocol-<name>` request headers to requests to
+	// kube-apiservers if the TLS connections are terminated by the istio ingress gateways.
+	TLVs []ProxyProtocolTLV
+}
+
+// ProxyProtocolTLV is a type-length-value (TLV) field of PROXY protocol v2 headers.
+type ProxyProtocolTLV struct {
+	// Type is the type of the TLV, e.g., 234 (0xEA) for AWS VPC endpoint IDs. It must be in the range [0,255].
+	Type int32
+	// Name is the name under which the value of the TLV is surfaced. It must consist of lower case alphanumeric
+	// characters or '-' and be at most 32 characters long.
+	Name string
 }
 
 // SeedSettingLoadBalancerServicesZonalIngress controls the deployment of ingress gateways per availability zone.
@@ -387,6 +436,52 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool
 }
 
+// SeedSettingIstioTLSTermination controls whether the istio ingress gateways of the seed terminate TLS for the shoot
+// kube-apiservers and originate new TLS connections with client certificates to them.
+type SeedSettingIstioTLSTermination struct {
+	// Enabled controls whether TLS termination and upstream TLS origination is enabled for all shoots on the seed.
+	// Individual shoots can still opt out via the `shoot.gardener.cloud/disable-istio-tls-termination` annotation.
+	Enabled bool
+}
+
+// SeedSettingDrain controls the controlled evacuation of all shoots from the seed.
+type SeedSettingDrain struct {
+	// Enabled controls whether the seed is drained. If true, no new shoots can be scheduled onto the seed, and the
+	// control planes of all shoots hosted on it are migrated to other seeds determined by the gardener-scheduler.
+	Enabled bool
+	// MaxConcurrentMigrations is the maximum number of shoots that are migrated away from the seed at the same time.
+	MaxConcurrentMigrations *int32
+}
+
+// SeedSettingControlPlaneResourceQuota controls the ResourceQuotas and LimitRanges in the control plane namespaces of
+// shoots.
+type SeedSettingControlPlaneResourceQuota struct {
+	// Enabled controls whether a ResourceQuota and a LimitRange are created in the control plane namespaces of shoots.
+	Enabled bool
+	// SizeClasses are the size classes of shoot control planes. A shoot is assigned to the first size class whose
+	// maximum number of nodes is greater than or equal to the sum of the maximum numbers of nodes of its worker pools.
+	// If no size class matches, the last one is used.
+	SizeClasses []ControlPlaneSizeClass
+}
+
+// ControlPlaneSizeClass describes the resource constraints for the control plane namespaces of shoots of a certain
+// size.
+type ControlPlaneSizeClass struct {
+	// Name is the name of the size class.
+	Name string
+	// MaxNodes is the maximum number of nodes of shoots in this size class. If not set, the size class applies to
+	// shoots of any size.
+	MaxNodes *int32
+	// Quota is the hard limit for the aggregated resource consumption in the control plane namespace.
+	Quota corev1.ResourceList
+	// ContainerMax is the maximum amount of resources a single container in the control plane namespace can request or
+	// be limited to. Containers without limits are limited to this amount.
+	ContainerMax corev1.ResourceList
+	// ContainerDefaultRequest is the amount of resources requested by containers in the control plane namespace which
+	// do not specify requests.
+	ContainerDefaultRequest corev1.ResourceList
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -120,6 +120,11 @@ type ShootSpec struct {
 	CredentialsBindingName *string
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	AccessRestrictions []AccessRestrictionWithOptions
+	// Cleanup contains the policies for cleaning up the resources in the shoot cluster when it is deleted.
+	Cleanup *Cleanup
+	// ManagedAddons contains the addons which are deployed into the shoot cluster. The addons are selected from the
+	// addon catalogs provided by extensions.
+	ManagedAddons []ManagedAddon
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -179,6 +184,70 @@ type ShootStatus struct {
 	InPlaceUpdates *InPlaceUpdatesStatus
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout
+	// Inventory contains an aggregated summary of the machines and nodes of the Shoot's worker pools. It is maintained
+	// by gardenlet based on the machine objects in the seed cluster.
+	Inventory *ShootInventory
+	// ReconciliationTimestamps contains the timestamps of the relevant phases of the last reconciliations of the Shoot.
+	// The list is ordered from the oldest to the newest reconciliation and is limited to the last few entries.
+	ReconciliationTimestamps []ShootReconciliationTimestamps
+	// AvailabilityReports contains the monthly reports of the availability of the Shoot's API server. They are only
+	// maintained if the API server availability probing is enabled by the gardenlet. The list is ordered from the
+	// oldest to the newest month and is limited to the last few entries.
+	AvailabilityReports []ShootAvailabilityReport
+	// DebugPods contains the debug pods which have been requested by operators via the `shoots/debugpod` subresource.
+	// The gardenlet creates the pods in the control plane namespace of the Shoot and removes them together with the
+	// entries after they have expired.
+	DebugPods []ShootDebugPod
+}
+
+// ShootReconciliationTimestamps contains the timestamps of the relevant phases of a Shoot reconciliation.
+type ShootReconciliationTimestamps struct {
+	// Type is the type of the operation (Create, Reconcile, Restore).
+	Type LastOperationType
+	// FlowStarted is the time when the reconciliation flow was started.
+	FlowStarted metav1.Time
+	// InfrastructureReady is the time when the infrastructure was reported ready.
+	InfrastructureReady *metav1.Time
+	// ControlPlaneReady is the time when the control plane was reported ready.
+	ControlPlaneReady *metav1.Time
+	// NodesReady is the time when the worker nodes were reported ready.
+	NodesReady *metav1.Time
+	// Finished is the time when the reconciliation flow finished, either successfully or with an error.
+	Finished *metav1.Time
+	// State is the final state of the reconciliation (Succeeded, Error).
+	State LastOperationState
+}
+
+// ShootAvailabilityReport contains the availability of the Shoot's API server in a calendar month.
+type ShootAvailabilityReport struct {
+	// Period is the calendar month (UTC) of the report in the format 'YYYY-MM'.
+	Period string
+	// Downtime is the accounted downtime of the API server outside of the maintenance time window.
+	Downtime metav1.Duration
+	// MaintenanceDowntime is the accounted downtime of the API server within the maintenance time window. It is not
+	// considered for the availability.
+	MaintenanceDowntime metav1.Duration
+	// Availability is the availability of the API server in percent, e.g. '99.950'. For the current month, it refers
+	// to the part of the month until the downtime last changed, see LastUpdateTime.
+	Availability string
+	// Final indicates whether the month has ended, i.e., the report does not change anymore.
+	Final bool
+	// LastUpdateTime is the time when the report was last updated.
+	LastUpdateTime metav1.Time
+}
+
+// ShootDebugPod contains information about a debug pod in the control plane namespace of the Shoot.
+type ShootDebugPod struct {
+	// Name is the name of the debug pod.
+	Name string
+	// RequestedBy is the name of the user who requested the debug pod.
+	RequestedBy string
+	// Reason is the justification given by the user who requested the debug pod.
+	Reason string
+	// CreationTimestamp is the time when the debug pod was requested.
+	CreationTimestamp metav1.Time
+	// ExpirationTimestamp is the time after which the debug pod is deleted.
+	ExpirationTimestamp metav1.Time
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -285,6 +354,47 @@ type ManualWorkerPoolRollout struct {
 	PendingWorkersRollouts []PendingWorkersRollout
 }
 
+// ShootInventory contains an aggregated summary of the machines and nodes of the Shoot's worker pools.
+type ShootInventory struct {
+	// WorkerPools contains the inventory of the worker pools.
+	WorkerPools []WorkerPoolInventory
+}
+
+// WorkerPoolInventory contains an aggregated summary of the machines and nodes of a worker pool.
+type WorkerPoolInventory struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Machines is the number of machines of the worker pool.
+	Machines int32
+	// Nodes is the number of machines of the worker pool which have joined the cluster as nodes.
+	Nodes int32
+	// MachinePhases contains the number of machines per machine phase.
+	MachinePhases []MachinePhaseCount
+	// Zones contains the number of machines and nodes per zone.
+	Zones []ZoneInventory
+	// OutdatedNodes is the number of nodes which have not yet applied the latest operating system config. It is not
+	// set if the nodes of the Shoot could not be inspected.
+	OutdatedNodes *int32
+}
+
+// MachinePhaseCount contains the number of machines in a certain phase.
+type MachinePhaseCount struct {
+	// Phase is the machine phase, e.g. `Pending`, `Running`, or `Failed`.
+	Phase string
+	// Count is the number of machines in this phase.
+	Count int32
+}
+
+// ZoneInventory contains the number of machines and nodes of a worker pool in a zone.
+type ZoneInventory struct {
+	// Name is the name of the zone.
+	Name string
+	// Machines is the number of machines in this zone.
+	Machines int32
+	// Nodes is the number of machines in this zone which have joined the cluster as nodes.
+	Nodes int32
+}
+
 // ShootKubeconfigRotation contains information about the kubeconfig credential rotation.
 type ShootKubeconfigRotation struct {
 	// LastInitiationTime is the most recent time when the kubeconfig credential rotation was initiated.
@@ -432,6 +542,32 @@ type NginxIngress struct {
 	// ExternalTrafficPolicy controls the `.spec.externalTrafficPolicy` value of the load balancer `Service`
 	// exposing the nginx-ingress. Defaults to `Cluster`.
 	ExternalTrafficPolicy *corev1.ServiceExternalTrafficPolicy
+	// LoadBalancerAnnotations are additional annotations for the load balancer `Service` exposing the nginx-ingress,
+	// e.g., to configure the load balancer of the cloud provider.
+	LoadBalancerAnnotations map[string]string
+	// Autoscaling contains the settings for the horizontal autoscaling of the nginx-ingress controller. If not set, the
+	// controller runs with a single replica.
+	Autoscaling *NginxIngressAutoscaling
+}
+
+// NginxIngressAutoscaling contains the settings for the horizontal autoscaling of the nginx-ingress controller.
+type NginxIngressAutoscaling struct {
+	// MinReplicas is the minimum number of replicas of the nginx-ingress controller. Defaults to `1`.
+	MinReplicas *int32
+	// MaxReplicas is the maximum number of replicas of the nginx-ingress controller.
+	MaxReplicas int32
+	// TargetCPUUtilizationPercentage is the average CPU utilization of the nginx-ingress controller pods at which
+	// the controller is scaled out. Defaults to `80`.
+	TargetCPUUtilizationPercentage *int32
+}
+
+// ManagedAddon selects an addon from the addon catalogs provided by extensions which is deployed into the shoot cluster.
+type ManagedAddon struct {
+	// Name is the name of the addon in the addon catalog.
+	Name string
+	// Version is a semantic version constraint for the addon, e.g. `~1.2` or `>= 1.0, < 2.0`. The highest version of
+	// the addon satisfying the constraint is deployed. If not set, the highest available version is deployed.
+	Version *string
 }
 
 // ControlPlane holds information about the general settings for the control plane of a shoot.
@@ -441,6 +577,28 @@ type ControlPlane struct {
 	HighAvailability *HighAvailability
 }
 
+// Cleanup contains the policies for cleaning up the resources in the shoot cluster when it is deleted.
+type Cleanup struct {
+	// Webhooks is the cleanup policy for ValidatingWebhookConfigurations and MutatingWebhookConfigurations.
+	Webhooks *CleanupPolicy
+	// ExtendedAPIs is the cleanup policy for APIServices and CustomResourceDefinitions.
+	ExtendedAPIs *CleanupPolicy
+	// KubernetesResources is the cleanup policy for workloads, Services and PersistentVolumeClaims.
+	KubernetesResources *CleanupPolicy
+	// VolumeSnapshots is the cleanup policy for VolumeSnapshots and VolumeSnapshotContents.
+	VolumeSnapshots *CleanupPolicy
+}
+
+// CleanupPolicy contains the policy for cleaning up a class of resources in the shoot cluster.
+type CleanupPolicy struct {
+	// FinalizeGracePeriod is the duration after which the finalizers of the resources which are still present are
+	// forcefully removed. If not set, the default of the respective cleanup step is used.
+	FinalizeGracePeriod *metav1.Duration
+	// ForceFinalize is a list of resource kinds whose finalizers are forcefully removed right after their deletion
+	// was requested. Only kinds which are handled by the respective cleanup step are considered.
+	ForceFinalize []metav1.GroupKind
+}
+
 // DNS holds information about the provider, the hosted zone id and the domain.
 type DNS struct {
 	// Domain is the external available domain of the Shoot cluster. This domain will be written into the
@@ -452,7 +610,60 @@ type DNS struct {
 	// Deprecated: Configuring multiple DNS providers is deprecated and will be forbidden in a future release.
 	// Please use the DNS extension provider config (e.g. shoot-dns-service) for additional providers.
 	Providers []DNSProvider
-}
+	// APIServerRecord contains settings for the DNS record of the external domain of the API server.
+	APIServerRecord *DNSAPIServerRecord
+}
+
+// DNSAPIServerRecord contains settings for the DNS record of the external domain of the API server.
+type DNSAPIServerRecord struct {
+	// TTLs is a list of TTLs per DNS record type. The type of the DNS record depends on the address of the API server's
+	// load balancer. If no TTL is configured for the used record type, the default TTL of gardenlet is used.
+	TTLs []DNSRecordTTL
+	// Failover contains the configuration for health-checked secondary targets of the DNS record. It requires a primary
+	// DNS provider whose DNSRecord extension supports the "DNSFailover" capability.
+	Failover *DNSFailover
+}
+
+// DNSRecordTTL contains the TTL for a DNS record type.
+type DNSRecordTTL struct {
+	// RecordType is the DNS record type. Supported values are "A", "AAAA", and "CNAME".
+	RecordType string
+	// Seconds is the time to live in seconds.
+	Seconds int64
+}
+
+// DNSFailover contains the configuration for health-checked secondary targets of a DNS record.
+type DNSFailover struct {
+	// Values is a list of IP addresses or a single hostname which are served by the DNS record if the health check of
+	// the primary target fails.
+	Values []string
+	// HealthCheck contains the configuration of the health check of the primary target.
+	HealthCheck DNSHealthCheck
+}
+
+// DNSHealthCheck contains the configuration of a health check for a DNS record target.
+type DNSHealthCheck struct {
+	// Protocol is the protocol used for the health check. Supported values are "HTTPS" and "TCP".
+	Protocol *DNSHealthCheckProtocol
+	// Port is the port used for the health check.
+	Port *int32
+	// Path is the path used for HTTPS health checks.
+	Path *string
+	// IntervalSeconds is the interval between two health checks in seconds.
+	IntervalSeconds *int32
+	// FailureThreshold is the number of consecutive failed health checks after which the secondary targets are served.
+	FailureThreshold *int32
+}
+
+// DNSHealthCheckProtocol is a protocol used for DNS health checks.
+type DNSHealthCheckProtocol string
+
+const (
+	// DNSHealthCheckProtocolHTTPS is a constant for HTTPS health checks.
+	DNSHealthCheckProtocolHTTPS DNSHealthCheckProtocol = "HTTPS"
+	// DNSHealthCheckProtocolTCP is a constant for TCP health checks.
+	DNSHealthCheckProtocolTCP DNSHealthCheckProtocol = "TCP"
+)
 
 // TODO(timuthy): Rework the 'DNSProvider' struct and deprecated fields in the scope of https://github.com/gardener/gardener/issues/9176.
 
@@ -547,6 +758,19 @@ type Kubernetes struct {
 	VerticalPodAutoscaler *VerticalPodAutoscaler
 	// ETCD contains configuration for etcds of the shoot cluster.
 	ETCD *ETCD
+	// WorkloadDefaults contains defaults which are injected into the pods of user workloads in the shoot cluster.
+	WorkloadDefaults *WorkloadDefaults
+}
+
+// WorkloadDefaults contains defaults which are injected into the pods of user workloads in the shoot cluster.
+type WorkloadDefaults struct {
+	// TopologySpreadConstraints are added to pods which do not specify any topology spread constraints. If the label
+	// selector of a constraint is not set, the labels of the respective pod are used.
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint
+	// Affinity contains the node affinity, pod affinity and pod anti-affinity which are added to pods which do not
+	// specify the respective affinity. If the label selector of a pod (anti-)affinity term is not set, the labels of the
+	// respective pod are used.
+	Affinity *corev1.Affinity
 }
 
 // ETCD contains configuration for etcds of the shoot cluster.
@@ -779,6 +1003,30 @@ type KubeAPIServerConfig struct {
 	StructuredAuthorization *StructuredAuthorization
 	// Autoscaling contains auto-scaling configuration options for the kube-apiserver.
 	Autoscaling *ControlPlaneAutoscaling
+	// AccessRestrictions contains restrictions for accessing the kube-apiserver via its load balancer.
+	AccessRestrictions *APIServerAccessRestrictions
+	// NodeConnectivityMode is the mode used by the kube-apiserver to connect to nodes, pods and services of the shoot
+	// cluster, e.g., for `kubectl logs/exec/port-forward` or for webhooks served inside the cluster.
+	NodeConnectivityMode *NodeConnectivityMode
+}
+
+// NodeConnectivityMode is the mode used by the kube-apiserver to connect to the shoot cluster network.
+type NodeConnectivityMode string
+
+const (
+	// NodeConnectivityModeVPN tunnels the traffic from the kube-apiserver to the shoot cluster network via the VPN
+	// (vpn-seed-server in the control plane, vpn-shoot in the shoot cluster).
+	NodeConnectivityModeVPN NodeConnectivityMode = "VPN"
+	// NodeConnectivityModeKonnectivity tunnels the traffic from the kube-apiserver to the shoot cluster network via a
+	// konnectivity-server sidecar in the kube-apiserver pods and konnectivity-agents in the shoot cluster.
+	NodeConnectivityModeKonnectivity NodeConnectivityMode = "Konnectivity"
+)
+
+// APIServerAccessRestrictions contains restrictions for accessing the kube-apiserver.
+type APIServerAccessRestrictions struct {
+	// SourceRanges is a list of CIDRs which are allowed to access the kube-apiserver via its load balancer.
+	// Connections from all other client IPs are rejected. If empty, access is not restricted.
+	SourceRanges []string
 }
 
 // ControlPlaneAutoscaling contains auto-scaling configuration options for control-plane components.
@@ -846,6 +1094,11 @@ type ServiceAccountConfig struct {
 	// These values are not used to generate new service account tokens. Only useful when service account tokens are also
 	// issued by another external system or a change of the current issuer that is used for generating tokens is being performed.
 	AcceptedIssuers []string
+	// ProjectedTokenExpiration is the validity duration of the projected service account tokens which are automatically
+	// mounted into the pods of system components running in the shoot cluster. Individual pods can overwrite it with
+	// the `projected-token-mount.resources.gardener.cloud/expiration-seconds` annotation.
+	// This field must be within [10m,30d]. Defaults to 12h.
+	ProjectedTokenExpiration *metav1.Duration
 }
 
 // AuditConfig contains settings for audit of the api server
@@ -984,6 +1237,54 @@ type KubeControllerManagerConfig struct {
 	PodEvictionTimeout *metav1.Duration
 	// NodeMonitorGracePeriod defines the grace period before an unresponsive node is marked unhealthy.
 	NodeMonitorGracePeriod *metav1.Duration
+	// ControllerWorkers contains the number of workers for individual controllers of the kube-controller-manager.
+	ControllerWorkers *ControllerWorkersConfig
+	// NodeEviction contains settings for the eviction of pods from unhealthy nodes performed by the node lifecycle
+	// controller. It replaces the deprecated `podEvictionTimeout` field, see its description for more details.
+	NodeEviction *NodeEvictionConfig
+}
+
+// ControllerWorkersConfig contains the number of workers for individual controllers of the kube-controller-manager.
+// Gardener uses its own defaults for fields which are not set.
+type ControllerWorkersConfig struct {
+	// Deployment is the number of workers for the Deployment controller.
+	Deployment *int32
+	// ReplicaSet is the number of workers for the ReplicaSet controller.
+	ReplicaSet *int32
+	// StatefulSet is the number of workers for the StatefulSet controller.
+	StatefulSet *int32
+	// Endpoint is the number of workers for the Endpoint controller.
+	Endpoint *int32
+	// ServiceEndpoint is the number of workers for the EndpointSlice controller.
+	ServiceEndpoint *int32
+	// GarbageCollector is the number of workers for the garbage collector controller.
+	GarbageCollector *int32
+	// Namespace is the number of workers for the Namespace controller. For workerless shoots, it can be set to '0' in
+	// order to disable the controller.
+	Namespace *int32
+	// ResourceQuota is the number of workers for the ResourceQuota controller. For workerless shoots, it can be set to
+	// '0' in order to disable the controller.
+	ResourceQuota *int32
+	// ServiceAccountToken is the number of workers for the ServiceAccount token controller. For workerless shoots, it
+	// can be set to '0' in order to disable the controller.
+	ServiceAccountToken *int32
+}
+
+// NodeEvictionConfig contains settings for the eviction of pods from unhealthy nodes performed by the node lifecycle
+// controller of the kube-controller-manager.
+// Note: Descriptions were taken from the Kubernetes documentation.
+type NodeEvictionConfig struct {
+	// Rate is the number of nodes per second on which pods are deleted in case of node failure when a zone is healthy.
+	Rate *float64
+	// SecondaryRate is the number of nodes per second on which pods are deleted in case of node failure when a zone is
+	// unhealthy. This value is implicitly overridden to 0 if the cluster size is smaller than `largeClusterSizeThreshold`.
+	SecondaryRate *float64
+	// LargeClusterSizeThreshold is the number of nodes from which the node lifecycle controller treats the cluster as
+	// large for the eviction logic purposes.
+	LargeClusterSizeThreshold *int32
+	// UnhealthyZoneThreshold is the fraction of nodes in a zone which needs to be not ready for the zone to be treated
+	// as unhealthy.
+	UnhealthyZoneThreshold *float64
 }
 
 // HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
@@ -1014,6 +1315,44 @@ type KubeSchedulerConfig struct {
 	// Profile configures the scheduling profile for the cluster.
 	// If not specified, the used profile is "balanced" (provides the default kube-scheduler behavior).
 	Profile *SchedulingProfile
+	// Profiles contains additional scheduler profiles which are served by the kube-scheduler next to the profiles
+	// managed by Gardener. Pods can select a profile by setting `spec.schedulerName` to the name of the profile.
+	Profiles []SchedulerProfile
+}
+
+// SchedulerProfile is an additional scheduler profile of the kube-scheduler.
+// Note: The structure mirrors the `KubeSchedulerProfile` of the `KubeSchedulerConfiguration` API.
+type SchedulerProfile struct {
+	// SchedulerName is the name of the scheduler profile. It must not collide with the names of the profiles managed by
+	// Gardener, i.e., `default-scheduler` and `bin-packing-scheduler`.
+	SchedulerName string
+	// Plugins specifies the plugins that should be enabled or disabled in addition to the default plugins.
+	Plugins *SchedulerPlugins
+}
+
+// SchedulerPlugins contains the plugins which should be enabled or disabled per extension point.
+type SchedulerPlugins struct {
+	// Filter is a list of plugins that should be invoked when filtering out nodes that cannot run the Pod.
+	Filter *SchedulerPluginSet
+	// Score is a list of plugins that should be invoked when ranking nodes that have passed the filtering phase.
+	Score *SchedulerPluginSet
+}
+
+// SchedulerPluginSet specifies enabled and disabled plugins for an extension point.
+type SchedulerPluginSet struct {
+	// Enabled specifies plugins that should be enabled in addition to the default plugins. If a default plugin is also
+	// configured here, its weight is overridden.
+	Enabled []SchedulerPlugin
+	// Disabled specifies default plugins that should be disabled. All default plugins can be disabled by using `*`.
+	Disabled []SchedulerPlugin
+}
+
+// SchedulerPlugin specifies a plugin name and its weight.
+type SchedulerPlugin struct {
+	// Name is the name of the plugin.
+	Name string
+	// Weight is the weight of the plugin. It is only applicable for score plugins.
+	Weight *int32
 }
 
 // SchedulingProfile is a string alias used for scheduling profile values.
@@ -1247,6 +1586,50 @@ type Networking struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/development/ipv6.md.
 	// Defaults to ["IPv4"].
 	IPFamilies []IPFamily
+	// Egress contains configuration for the egress traffic of the shoot cluster. It is passed to the provider extensions
+	// which implement it on the respective infrastructure.
+	Egress *Egress
+}
+
+// Egress contains configuration for the egress traffic of the shoot cluster.
+type Egress struct {
+	// IPPools is a list of static egress IP pools which should be provisioned for the shoot cluster.
+	IPPools []EgressIPPool
+	// NamespaceSelections is a list of rules selecting the egress IP pool for the traffic of namespaces. Traffic of
+	// namespaces which are not selected by any rule leaves the cluster via the default egress path of the infrastructure.
+	NamespaceSelections []EgressNamespaceSelection
+	// Filter contains user-maintained exceptions for the egress filter of the shoot cluster. They are consumed by
+	// networking filter extensions via the Cluster resource.
+	Filter *EgressFilter
+}
+
+// EgressFilter contains user-maintained exceptions for the egress filter of the shoot cluster.
+type EgressFilter struct {
+	// AllowList is a list of CIDRs to which egress traffic is allowed even if they are blocked by the filter list of
+	// the egress filter.
+	AllowList []string
+	// DenyList is a list of CIDRs to which egress traffic is denied in addition to the filter list of the egress
+	// filter.
+	DenyList []string
+}
+
+// EgressIPPool is a pool of static egress IP addresses.
+type EgressIPPool struct {
+	// Name is the name of the pool.
+	Name string
+	// Count is the number of static egress IP addresses which are allocated for the pool by the provider extension.
+	// Either Count or Addresses must be set.
+	Count *int32
+	// Addresses is a list of pre-allocated IP addresses the pool consists of. Either Count or Addresses must be set.
+	Addresses []string
+}
+
+// EgressNamespaceSelection selects the egress IP pool for the traffic of namespaces.
+type EgressNamespaceSelection struct {
+	// IPPool is the name of the egress IP pool.
+	IPPool string
+	// NamespaceSelector selects the namespaces whose egress traffic leaves the cluster via the egress IP pool.
+	NamespaceSelector metav1.LabelSelector
 }
 
 const (
@@ -1409,8 +1792,30 @@ type Worker struct {
 	// ControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
 	// This is only relevant for self-hosted shoot clusters.
 	ControlPlane *WorkerControlPlane
+	// Accelerators contains the configuration for hardware accelerators (e.g., GPUs) attached to the machines of this
+	// worker pool.
+	Accelerators *WorkerAccelerators
 }
 
+// WorkerAccelerators contains the configuration for hardware accelerators attached to the machines of a worker pool.
+type WorkerAccelerators struct {
+	// Vendor is the vendor of the accelerators.
+	Vendor AcceleratorVendor
+	// ManagedDriver specifies whether Gardener deploys and manages the driver and the device plugin for the accelerators
+	// on the nodes of this worker pool.
+	ManagedDriver *bool
+}
+
+// AcceleratorVendor is the vendor of hardware accelerators.
+type AcceleratorVendor string
+
+const (
+	// AcceleratorVendorNVIDIA is the vendor for NVIDIA GPUs.
+	AcceleratorVendorNVIDIA AcceleratorVendor = "nvidia"
+	// AcceleratorVendorAMD is the vendor for AMD GPUs.
+	AcceleratorVendorAMD AcceleratorVendor = "amd"
+)
+
 // WorkerControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
 type WorkerControlPlane struct {
 	// Backup holds the object store configuration for the backups of shoot (currently only etcd).
@@ -1470,6 +1875,10 @@ type ClusterAutoscalerOptions struct {
 	ScaleDownUnreadyTime *metav1.Duration
 	// MaxNodeProvisionTime defines how long CA waits for node to be provisioned.
 	MaxNodeProvisionTime *metav1.Duration
+	// CapacityHints are the expected resources of the machines of this worker pool. They are propagated to the node
+	// template which is used by the cluster-autoscaler when scaling up the worker pool from zero and take precedence over
+	// the resources of the machine type in the CloudProfile.
+	CapacityHints corev1.ResourceList
 }
 
 // MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.
@@ -1606,6 +2015,8 @@ type SystemComponents struct {
 	CoreDNS *CoreDNS
 	// NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 	NodeLocalDNS *NodeLocalDNS
+	// VolumeSnapshots contains the settings of the managed volume snapshots of user workloads in the Shoot cluster.
+	VolumeSnapshots *VolumeSnapshots
 }
 
 // CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1655,6 +2066,59 @@ type NodeLocalDNS struct {
 	DisableForwardToUpstreamDNS *bool
 }
 
+// VolumeSnapshots contains the settings of the managed volume snapshots of user workloads in the Shoot cluster.
+type VolumeSnapshots struct {
+	// Classes is a list of VolumeSnapshotClasses which shall be created in the Shoot cluster.
+	Classes []VolumeSnapshotClass
+	// Policies is a list of policies for taking scheduled snapshots of PersistentVolumeClaims in the Shoot cluster.
+	Policies []VolumeSnapshotPolicy
+}
+
+// VolumeSnapshotClass contains the settings of a VolumeSnapshotClass in the Shoot cluster.
+type VolumeSnapshotClass struct {
+	// Name is the name of the VolumeSnapshotClass.
+	Name string
+	// Driver is the name of the CSI driver which handles the snapshots of this class.
+	Driver string
+	// DeletionPolicy determines whether the snapshot in the storage backend is deleted together with the
+	// VolumeSnapshot. Possible values are 'Delete' and 'Retain'.
+	DeletionPolicy *VolumeSnapshotDeletionPolicy
+	// Default specifies whether this class is the default VolumeSnapshotClass of the CSI driver.
+	Default *bool
+	// Parameters are driver-specific parameters of the VolumeSnapshotClass.
+	Parameters map[string]string
+}
+
+// VolumeSnapshotDeletionPolicy is a type for the deletion policy of VolumeSnapshotClasses.
+type VolumeSnapshotDeletionPolicy string
+
+const (
+	// VolumeSnapshotDeletionPolicyDelete means that the snapshot in the storage backend is deleted together with the
+	// VolumeSnapshot.
+	VolumeSnapshotDeletionPolicyDelete VolumeSnapshotDeletionPolicy = "Delete"
+	// VolumeSnapshotDeletionPolicyRetain means that the snapshot in the storage backend is kept when the VolumeSnapshot
+	// is deleted.
+	VolumeSnapshotDeletionPolicyRetain VolumeSnapshotDeletionPolicy = "Retain"
+)
+
+// VolumeSnapshotPolicy contains the settings for taking scheduled snapshots of PersistentVolumeClaims.
+type VolumeSnapshotPolicy struct {
+	// Name is the name of the policy.
+	Name string
+	// Schedule is the cron schedule (in UTC) at which the snapshots are taken.
+	Schedule string
+	// Namespace is the namespace of the PersistentVolumeClaims.
+	Namespace string
+	// Selector is a label selector for the PersistentVolumeClaims. If not specified, all PersistentVolumeClaims in the
+	// namespace are selected.
+	Selector *metav1.LabelSelector
+	// VolumeSnapshotClassName is the name of the VolumeSnapshotClass used for the snapshots. If not specified, the
+	// default class of the CSI driver is used.
+	VolumeSnapshotClassName *string
+	// Retention is the number of snapshots which are kept per PersistentVolumeClaim. Older snapshots are deleted.
+	Retention *int32
+}
+
 const (
 	// ShootEventImageVersionMaintenance indicates that a maintenance operation regarding the image version has been performed.
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
@@ -1673,6 +2137,9 @@ const (
 const (
 	// ShootAPIServerAvailable is a constant for a condition type indicating that the Shoot cluster's API server is available.
 	ShootAPIServerAvailable ConditionType = "APIServerAvailable"
+	// ShootAPIServerAvailability is a constant for a condition type indicating whether the Shoot cluster's API server is
+	// reachable via its external endpoint from all configured vantage points.
+	ShootAPIServerAvailability ConditionType = "APIServerAvailability"
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the health of core control plane components.
 	ShootControlPlaneHealthy ConditionType = "ControlPlaneHealthy"
 	// ShootObservabilityComponentsHealthy is a constant for a condition type indicating the health of observability components.
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootDebugPodRequest can be used by operators to request a time-boxed debug pod in the control plane namespace of a
+// Shoot cluster.
+type ShootDebugPodRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec is the specification of the ShootDebugPodRequest.
+	Spec ShootDebugPodRequestSpec
+	// Status is the status of the ShootDebugPodRequest.
+	Status ShootDebugPodRequestStatus
+}
+
+// ShootDebugPodRequestSpec contains the specification of the requested debug pod.
+type ShootDebugPodRequestSpec struct {
+	// Reason is the justification for the debug pod. It is recorded in the Shoot status and on the debug pod.
+	Reason string
+	// ExpirationSeconds is the requested lifetime of the debug pod. The gardener-apiserver may cap the lifetime, so a
+	// client needs to check the 'expirationTimestamp' field in a response.
+	ExpirationSeconds int64
+}
+
+// ShootDebugPodRequestStatus is the status of the ShootDebugPodRequest.
+type ShootDebugPodRequestStatus struct {
+	// PodName is the name of the debug pod.
+	PodName string
+	// Namespace is the control plane namespace of the Shoot in the seed cluster in which the debug pod is created.
+	Namespace string
+	// ExpirationTimestamp is the time after which the debug pod is deleted.
+	ExpirationTimestamp metav1.Time
+}
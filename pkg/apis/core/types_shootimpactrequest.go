@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootImpactRequest can be used to predict the operations which would result from applying a proposed specification
+// to an existing Shoot cluster, e.g., node rollouts or restarts of control plane components.
+type ShootImpactRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec is the specification of the ShootImpactRequest.
+	Spec ShootImpactRequestSpec
+	// Status is the status of the ShootImpactRequest.
+	Status ShootImpactRequestStatus
+}
+
+// ShootImpactRequestSpec contains the proposed Shoot specification.
+type ShootImpactRequestSpec struct {
+	// Shoot is the proposed specification of the Shoot. It is compared with the specification of the existing Shoot.
+	Shoot ShootSpec
+}
+
+// ShootImpactRequestStatus is the status of the ShootImpactRequest containing the predicted operations.
+type ShootImpactRequestStatus struct {
+	// Operations are the operations which would result from applying the proposed specification.
+	Operations []ShootImpactOperation
+}
+
+// ShootImpactOperation is an operation which would result from applying a proposed Shoot specification.
+type ShootImpactOperation struct {
+	// Type is the type of the operation.
+	Type ShootImpactOperationType
+	// Target is the name of the affected worker pool or control plane component.
+	Target string
+	// Reasons are the changes of the specification which cause the operation.
+	Reasons []string
+}
+
+// ShootImpactOperationType is a type for operations which would result from applying a proposed Shoot specification.
+type ShootImpactOperationType string
+
+const (
+	// ShootImpactOperationNodeRollout indicates that all nodes of a worker pool are replaced by new nodes.
+	ShootImpactOperationNodeRollout ShootImpactOperationType = "NodeRollout"
+	// ShootImpactOperationNodeInPlaceUpdate indicates that all nodes of a worker pool are updated in-place.
+	ShootImpactOperationNodeInPlaceUpdate ShootImpactOperationType = "NodeInPlaceUpdate"
+	// ShootImpactOperationWorkerPoolDeletion indicates that a worker pool and all of its nodes are deleted.
+	ShootImpactOperationWorkerPoolDeletion ShootImpactOperationType = "WorkerPoolDeletion"
+	// ShootImpactOperationComponentRestart indicates that all replicas of a control plane component are restarted.
+	ShootImpactOperationComponentRestart ShootImpactOperationType = "ComponentRestart"
+	// ShootImpactOperationETCDResize indicates that the number of members of the etcd clusters is changed.
+	ShootImpactOperationETCDResize ShootImpactOperationType = "ETCDResize"
+	// ShootImpactOperationHibernation indicates that the Shoot is hibernated or woken up.
+	ShootImpactOperationHibernation ShootImpactOperationType = "Hibernation"
+)
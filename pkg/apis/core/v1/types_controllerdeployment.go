@@ -55,6 +55,19 @@ type HelmControllerDeployment struct {
 	// OCIRepository defines where to pull the chart.
 	// +optional
 	OCIRepository *OCIRepository `json:"ociRepository,omitempty" protobuf:"bytes,3,opt,name=ociRepository"`
+	// ValuesOverlays is a list of chart values which are merged into the values for seeds matching the respective seed
+	// selector. The overlays are merged in the order of the list, i.e., later overlays take precedence.
+	// +optional
+	ValuesOverlays []HelmValuesOverlay `json:"valuesOverlays,omitempty" protobuf:"bytes,4,rep,name=valuesOverlays"`
+}
+
+// HelmValuesOverlay contains chart values which are merged into the values for seeds matching the seed selector.
+type HelmValuesOverlay struct {
+	// SeedSelector is a label selector for seeds. An empty selector matches all seeds.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty" protobuf:"bytes,1,opt,name=seedSelector"`
+	// Values are the chart values which are merged into the values for matching seeds.
+	Values *apiextensionsv1.JSON `json:"values" protobuf:"bytes,2,opt,name=values"`
 }
 
 // OCIRepository configures where to pull an OCI Artifact, that could contain for example a Helm Chart.
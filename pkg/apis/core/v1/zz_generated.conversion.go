@@ -15,6 +15,7 @@ import (
 	core "github.com/gardener/gardener/pkg/apis/core"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -46,6 +47,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HelmValuesOverlay)(nil), (*core.HelmValuesOverlay)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HelmValuesOverlay_To_core_HelmValuesOverlay(a.(*HelmValuesOverlay), b.(*core.HelmValuesOverlay), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HelmValuesOverlay)(nil), (*HelmValuesOverlay)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HelmValuesOverlay_To_v1_HelmValuesOverlay(a.(*core.HelmValuesOverlay), b.(*HelmValuesOverlay), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OCIRepository)(nil), (*core.OCIRepository)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_OCIRepository_To_core_OCIRepository(a.(*OCIRepository), b.(*core.OCIRepository), scope)
 	}); err != nil {
@@ -131,6 +142,7 @@ func autoConvert_v1_HelmControllerDeployment_To_core_HelmControllerDeployment(in
 	out.RawChart = *(*[]byte)(unsafe.Pointer(&in.RawChart))
 	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
 	out.OCIRepository = (*core.OCIRepository)(unsafe.Pointer(in.OCIRepository))
+	out.ValuesOverlays = *(*[]core.HelmValuesOverlay)(unsafe.Pointer(&in.ValuesOverlays))
 	return nil
 }
 
@@ -143,6 +155,7 @@ func autoConvert_core_HelmControllerDeployment_To_v1_HelmControllerDeployment(in
 	out.RawChart = *(*[]byte)(unsafe.Pointer(&in.RawChart))
 	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
 	out.OCIRepository = (*OCIRepository)(unsafe.Pointer(in.OCIRepository))
+	out.ValuesOverlays = *(*[]HelmValuesOverlay)(unsafe.Pointer(&in.ValuesOverlays))
 	return nil
 }
 
@@ -151,6 +164,28 @@ func Convert_core_HelmControllerDeployment_To_v1_HelmControllerDeployment(in *co
 	return autoConvert_core_HelmControllerDeployment_To_v1_HelmControllerDeployment(in, out, s)
 }
 
+func autoConvert_v1_HelmValuesOverlay_To_core_HelmValuesOverlay(in *HelmValuesOverlay, out *core.HelmValuesOverlay, s conversion.Scope) error {
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_v1_HelmValuesOverlay_To_core_HelmValuesOverlay is an autogenerated conversion function.
+func Convert_v1_HelmValuesOverlay_To_core_HelmValuesOverlay(in *HelmValuesOverlay, out *core.HelmValuesOverlay, s conversion.Scope) error {
+	return autoConvert_v1_HelmValuesOverlay_To_core_HelmValuesOverlay(in, out, s)
+}
+
+func autoConvert_core_HelmValuesOverlay_To_v1_HelmValuesOverlay(in *core.HelmValuesOverlay, out *HelmValuesOverlay, s conversion.Scope) error {
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_core_HelmValuesOverlay_To_v1_HelmValuesOverlay is an autogenerated conversion function.
+func Convert_core_HelmValuesOverlay_To_v1_HelmValuesOverlay(in *core.HelmValuesOverlay, out *HelmValuesOverlay, s conversion.Scope) error {
+	return autoConvert_core_HelmValuesOverlay_To_v1_HelmValuesOverlay(in, out, s)
+}
+
 func autoConvert_v1_OCIRepository_To_core_OCIRepository(in *OCIRepository, out *core.OCIRepository, s conversion.Scope) error {
 	out.Ref = (*string)(unsafe.Pointer(in.Ref))
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
@@ -12,6 +12,7 @@ package v1
 import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -102,6 +103,13 @@ func (in *HelmControllerDeployment) DeepCopyInto(out *HelmControllerDeployment)
 		*out = new(OCIRepository)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ValuesOverlays != nil {
+		in, out := &in.ValuesOverlays, &out.ValuesOverlays
+		*out = make([]HelmValuesOverlay, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -115,6 +123,32 @@ func (in *HelmControllerDeployment) DeepCopy() *HelmControllerDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmValuesOverlay) DeepCopyInto(out *HelmValuesOverlay) {
+	*out = *in
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValuesOverlay.
+func (in *HelmValuesOverlay) DeepCopy() *HelmValuesOverlay {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmValuesOverlay)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCIRepository) DeepCopyInto(out *OCIRepository) {
 	*out = *in
@@ -24,6 +24,11 @@ func (in HelmControllerDeployment) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1.HelmControllerDeployment"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in HelmValuesOverlay) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1.HelmValuesOverlay"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in OCIRepository) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1.OCIRepository"
@@ -51,6 +51,9 @@ const (
 	// SecretNameSSHKeyPair is a constant for the name of a Kubernetes secret object that contains the SSH key pair
 	// (public and private key) that can be used to SSH into the shoot nodes.
 	SecretNameSSHKeyPair = "ssh-keypair" // #nosec G101 -- No credential.
+	// SecretNameCASSH is a constant for the name of a Kubernetes secret object that contains the SSH certificate
+	// authority used to sign short-lived SSH certificates for accessing the shoot nodes.
+	SecretNameCASSH = "ca-ssh"
 	// SecretNameServiceAccountKey is a constant for the name of a Kubernetes secret object that contains a
 	// PEM-encoded private RSA or ECDSA key used by the Kube Controller Manager to sign service account tokens.
 	SecretNameServiceAccountKey = "service-account-key"
@@ -250,6 +253,10 @@ const (
 	// GardenerOperationRenewKubeconfig is a constant for the value of the operation annotation to renew the gardenlet's
 	// kubeconfig secret.
 	GardenerOperationRenewKubeconfig = "renew-kubeconfig"
+	// GardenerOperationDetectDrift is a constant for the value of the operation annotation asking an extension
+	// controller to detect whether the actual state of the resources it manages deviates from the desired state without
+	// changing them.
+	GardenerOperationDetectDrift = "detect-drift"
 
 	// GardenRole is a constant for a label that describes a role.
 	GardenRole = "gardener.cloud/role"
@@ -291,6 +298,8 @@ const (
 	GardenRoleCAClient = "ca-client"
 	// GardenRoleSSHKeyPair is the value of the GardenRole key indicating type 'ssh-keypair'.
 	GardenRoleSSHKeyPair = "ssh-keypair"
+	// GardenRoleCASSH is the value of the GardenRole key indicating type 'ca-ssh'.
+	GardenRoleCASSH = "ca-ssh"
 	// GardenRoleDefaultDomain is the value of the GardenRole key indicating type 'default-domain'.
 	GardenRoleDefaultDomain = "default-domain"
 	// GardenRoleInternalDomain is the value of the GardenRole key indicating type 'internal-domain'.
@@ -395,6 +404,9 @@ const (
 	// ShootOperationForceInPlaceUpdate is a constant for the value of the operation annotation that must be set
 	// to forcibly trigger an in-place update when a previous update is still in progress.
 	ShootOperationForceInPlaceUpdate = "force-in-place-update"
+	// ShootOperationComputeControlPlaneDrift is a constant for an annotation on a Shoot indicating that the gardenlet
+	// shall compute the drift between the desired and the live state of the control plane objects in the seed cluster.
+	ShootOperationComputeControlPlaneDrift = "compute-control-plane-drift"
 	// OperationRotateCredentialsStart is a constant for an annotation indicating that the rotation of all credentials
 	// shall be started. This includes CAs, certificates, kubeconfigs, SSH keypairs, observability credentials, and
 	// ServiceAccount signing key.
@@ -497,6 +509,13 @@ const (
 	// LabelPrefixMonitoringDataSource is the prefix of a label key on ConfigMaps for indicating that the data contains
 	// a datasource.
 	LabelPrefixMonitoringDataSource = "datasource.monitoring.gardener.cloud/"
+	// LabelManagedAddonName is the key of a label on Secrets in the garden namespace of seed clusters indicating that the
+	// data contains the manifests of a managed addon provided by an extension. The value is the name of the addon. The
+	// label is also added to the ManagedResources of the managed addons deployed into shoot clusters.
+	LabelManagedAddonName = "addon.gardener.cloud/name"
+	// LabelManagedAddonVersion is the key of a label on the Secrets of managed addons. The value is the semantic version
+	// of the addon.
+	LabelManagedAddonVersion = "addon.gardener.cloud/version"
 	// LabelKeyCustomLoggingResource is the key of the label which is used from the operator to select the CustomResources which will be imported in the FluentBit configuration.
 	// TODO(nickytd): the label key has to be migrated to "fluentbit.gardener.cloud/type".
 	LabelKeyCustomLoggingResource = "fluentbit.gardener/type"
@@ -733,6 +752,11 @@ const (
 	// AnnotationShootSkipCleanup is a key for an annotation on a Shoot resource that declares that the clean up steps should be skipped when the
 	// cluster is deleted. Concretely, this will skip everything except the deletion of (load balancer) services and persistent volume resources.
 	AnnotationShootSkipCleanup = "shoot.gardener.cloud/skip-cleanup"
+	// AnnotationShootAggressiveCleanup is a key for an annotation on a Shoot resource that enables the aggressive cleanup
+	// mode when the cluster is deleted. Concretely, the cleanup of extended APIs, load balancers, volumes and the remaining
+	// Kubernetes resources runs in parallel, all resources are finalized after five minutes at the latest, and the
+	// provider extension is asked to sweep orphaned infrastructure resources before the infrastructure is deleted.
+	AnnotationShootAggressiveCleanup = "shoot.gardener.cloud/aggressive-cleanup"
 	// AnnotationShootSkipReadiness is a key for an annotation on a Shoot resource that instructs the shoot flow to skip readiness steps during reconciliation.
 	AnnotationShootSkipReadiness = "shoot.gardener.cloud/skip-readiness"
 	// AnnotationShootCleanupWebhooksFinalizeGracePeriodSeconds is a key for an annotation on a Shoot resource that
@@ -751,6 +775,16 @@ const (
 	// AnnotationShootCleanupKubernetesResourcesStartTime is a key for an annotation on a shoot Namespace
 	// that records the start time of the 'cleanup Kubernetes resources' step.
 	AnnotationShootCleanupKubernetesResourcesStartTime = "shoot.gardener.cloud/cleanup-kubernetes-resources-start-time"
+	// AnnotationShootDNSCutoverPreviousTTLExpiry is a key for an annotation on a DNSRecord resource that records the
+	// time when the previous TTL expires after the TTL was lowered for the cutover of a control plane migration.
+	AnnotationShootDNSCutoverPreviousTTLExpiry = "shoot.gardener.cloud/dns-cutover-previous-ttl-expiry"
+	// AnnotationShootForceUpgradeNotificationLeadTime is a key for an annotation on a Project resource that declares the
+	// minimum duration between notifying the project members about the upcoming force upgrade of a Shoot to a
+	// non-expired Kubernetes version and triggering it.
+	AnnotationShootForceUpgradeNotificationLeadTime = "shoot.gardener.cloud/force-upgrade-notification-lead-time"
+	// AnnotationShootForceUpgradeNotifiedAt is a key for an annotation on a Shoot resource that records the time when the
+	// project members were notified about the upcoming force upgrade of the Shoot.
+	AnnotationShootForceUpgradeNotifiedAt = "shoot.gardener.cloud/force-upgrade-notified-at"
 	// AnnotationShootCloudConfigExecutionMaxDelaySeconds is a key for an annotation on a Shoot resource that declares
 	// the maximum delay in seconds when potentially updated cloud-config user data is executed on the worker nodes.
 	// Concretely, the gardener-node-agent systemd service running on all worker nodes will wait
@@ -760,6 +794,16 @@ const (
 	// Note that changing this value only applies to new nodes. Existing nodes which already computed their individual
 	// delays will not recompute it.
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
+	// AnnotationShootImageOverrides is a key for an annotation on a Shoot resource that declares overrides for images of
+	// individual control plane components of this shoot. The value is a comma-separated list of `<image-name>=<image>`
+	// pairs, where `<image-name>` is the name of the image in the image vector. Setting or changing this annotation
+	// requires the `override-images` custom verb for shoots, and gardenlet only applies overrides for images which are
+	// allowed in its configuration.
+	AnnotationShootImageOverrides = "shoot.gardener.cloud/image-overrides"
+	// AnnotationShootMonitoringRetention is a key for an annotation on a Shoot resource that declares the retention of the
+	// monitoring data of the shoot's Prometheus as a Prometheus duration, e.g., `7d`. If it is not set, the monitoring data
+	// is retained for 30 days.
+	AnnotationShootMonitoringRetention = "shoot.gardener.cloud/monitoring-retention"
 
 	// AnnotationAuthenticationIssuer is the key for an annotation applied to a Shoot which specifies
 	// if the shoot's issuer is managed by Gardener.
@@ -821,6 +865,18 @@ const (
 	// EventResourceReferenced indicates that the resource deletion is in waiting mode because the resource is still
 	// being referenced by at least one other resource (e.g. a SecretBinding is still referenced by a Shoot)
 	EventResourceReferenced = "ResourceReferenced"
+	// EventVersionClassificationTransition indicates that the classification of a version in a CloudProfile is
+	// transitioned according to its version lifecycle policy.
+	EventVersionClassificationTransition = "VersionClassificationTransition"
+	// EventForceUpgradeScheduled indicates that the force upgrade of a Shoot to a non-expired Kubernetes version is
+	// scheduled.
+	EventForceUpgradeScheduled = "ForceUpgradeScheduled"
+	// EventForceUpgradeTriggered indicates that the force upgrade of a Shoot to a non-expired Kubernetes version is
+	// triggered.
+	EventForceUpgradeTriggered = "ForceUpgradeTriggered"
+	// EventSSHCertificateIssued indicates that a short-lived SSH certificate for accessing the nodes of a Shoot was
+	// issued via the shoots/ssh subresource.
+	EventSSHCertificateIssued = "SSHCertificateIssued"
 
 	// ReferencedResourcesPrefix is the prefix used when copying referenced resources to the Shoot namespace in the Seed,
 	// to avoid naming collisions with resources managed by Gardener.
@@ -891,6 +947,21 @@ const (
 	// skipped by the stale project controller. If the project has already configured stale timestamps in its status
 	// then they will be reset.
 	ProjectSkipStaleCheck = "project.gardener.cloud/skip-stale-check"
+	// ProjectSkipStaleCleanup is the key of an annotation on a project that opts it out of the staged cleanup of stale
+	// projects. The project is still marked as stale, but it is never locked or auto-deleted.
+	ProjectSkipStaleCleanup = "project.gardener.cloud/skip-stale-cleanup"
+	// ProjectLastMemberLoginTimestamp is the key of an annotation on a project whose value holds the time (RFC3339) when
+	// a member of the project logged in for the last time. It is expected to be maintained by an authentication hook.
+	ProjectLastMemberLoginTimestamp = "project.gardener.cloud/last-member-login-timestamp"
+	// ProjectStaleCleanupStage is the key of a label on a project whose value holds the stage of the cleanup of the stale
+	// project.
+	ProjectStaleCleanupStage = "project.gardener.cloud/stale-cleanup-stage"
+	// ProjectStaleCleanupStageNotified is a constant for a label value of ProjectStaleCleanupStage indicating that the
+	// members of the stale project were notified about its upcoming auto-deletion.
+	ProjectStaleCleanupStageNotified = "notified"
+	// ProjectStaleCleanupStageLocked is a constant for a label value of ProjectStaleCleanupStage indicating that the
+	// stale project is locked, i.e., no new shoots can be created in it.
+	ProjectStaleCleanupStageLocked = "locked"
 	// NamespaceProject is the key of an annotation on namespace whose value holds the project uid.
 	NamespaceProject = "namespace.gardener.cloud/project"
 	// NamespaceKeepAfterProjectDeletion is a constant for an annotation on a `Namespace` resource that states that it
@@ -1099,6 +1170,8 @@ const (
 	GardenPurposeMachineClass = "machineclass"
 	// GardenPurposeShootStaticManifest is a constant for the 'shoot-static-manifest' value in a label.
 	GardenPurposeShootStaticManifest = "shoot-static-manifest"
+	// GardenPurposeShootLeftover is a constant for the 'shootleftover' value in a label.
+	GardenPurposeShootLeftover = "shootleftover"
 
 	// LabelInjectGardenKubeconfig is a constant for a label on workload resources that indicates that a kubeconfig to
 	// the garden cluster should be injected.
@@ -57,3 +57,14 @@ func SetDefaults_ControllerRegistrationDeployment(obj *ControllerRegistrationDep
 		obj.Policy = &p
 	}
 }
+
+// SetDefaults_ControllerDeploymentCanaryRollout sets default values for ControllerDeploymentCanaryRollout objects.
+func SetDefaults_ControllerDeploymentCanaryRollout(obj *ControllerDeploymentCanaryRollout) {
+	if obj.VerificationDuration == nil {
+		obj.VerificationDuration = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+
+	if obj.MinShootReconcileSuccessRate == nil {
+		obj.MinShootReconcileSuccessRate = ptr.To[int32](100)
+	}
+}
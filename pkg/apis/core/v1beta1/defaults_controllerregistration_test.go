@@ -163,4 +163,27 @@ var _ = Describe("ControllerRegistration defaulting", func() {
 			Expect(obj.Spec.Deployment.Policy).To(PointTo(BeEquivalentTo("Always")))
 		})
 	})
+
+	Describe("ControllerDeploymentCanaryRollout defaulting", func() {
+		BeforeEach(func() {
+			obj.Spec.Deployment.Rollout = &ControllerDeploymentRollout{Canary: &ControllerDeploymentCanaryRollout{}}
+		})
+
+		It("should default the verification duration and minimum shoot reconcile success rate", func() {
+			SetObjectDefaults_ControllerRegistration(obj)
+
+			Expect(obj.Spec.Deployment.Rollout.Canary.VerificationDuration).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+			Expect(obj.Spec.Deployment.Rollout.Canary.MinShootReconcileSuccessRate).To(PointTo(Equal(int32(100))))
+		})
+
+		It("should not overwrite the verification duration and minimum shoot reconcile success rate", func() {
+			obj.Spec.Deployment.Rollout.Canary.VerificationDuration = &metav1.Duration{Duration: time.Hour}
+			obj.Spec.Deployment.Rollout.Canary.MinShootReconcileSuccessRate = ptr.To[int32](90)
+
+			SetObjectDefaults_ControllerRegistration(obj)
+
+			Expect(obj.Spec.Deployment.Rollout.Canary.VerificationDuration).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Spec.Deployment.Rollout.Canary.MinShootReconcileSuccessRate).To(PointTo(Equal(int32(90))))
+		})
+	})
 })
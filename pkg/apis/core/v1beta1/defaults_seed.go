@@ -74,6 +74,13 @@ func SetDefaults_SeedSettingLoadBalancerServices(obj *SeedSettingLoadBalancerSer
 	}
 }
 
+// SetDefaults_SeedSettingDrain sets defaults for SeedSettingDrain objects.
+func SetDefaults_SeedSettingDrain(obj *SeedSettingDrain) {
+	if obj.MaxConcurrentMigrations == nil {
+		obj.MaxConcurrentMigrations = ptr.To[int32](5)
+	}
+}
+
 func setDefaults_ExcessCapacityReservationConfig(excessCapacityReservation *SeedSettingExcessCapacityReservation) {
 	excessCapacityReservation.Configs = []SeedSettingExcessCapacityReservationConfig{
 		// This roughly corresponds to a single, moderately large control-plane.
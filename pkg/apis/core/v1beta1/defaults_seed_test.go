@@ -158,4 +158,26 @@ var _ = Describe("Seed defaulting", func() {
 			Expect(obj.Spec.Settings.DependencyWatchdog.Prober.Enabled).To(Equal(dwdProberEnabled))
 		})
 	})
+
+	Describe("SeedSettingDrain defaulting", func() {
+		It("should default the max concurrent migrations", func() {
+			obj.Spec.Settings = &SeedSettings{
+				Drain: &SeedSettingDrain{Enabled: true},
+			}
+
+			SetObjectDefaults_Seed(obj)
+
+			Expect(obj.Spec.Settings.Drain.MaxConcurrentMigrations).To(PointTo(Equal(int32(5))))
+		})
+
+		It("should not overwrite the already set max concurrent migrations", func() {
+			obj.Spec.Settings = &SeedSettings{
+				Drain: &SeedSettingDrain{Enabled: true, MaxConcurrentMigrations: ptr.To[int32](2)},
+			}
+
+			SetObjectDefaults_Seed(obj)
+
+			Expect(obj.Spec.Settings.Drain.MaxConcurrentMigrations).To(PointTo(Equal(int32(2))))
+		})
+	})
 })
@@ -14,7 +14,6 @@ import (
 	"k8s.io/utils/ptr"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
-	"github.com/gardener/gardener/pkg/apis/utils/timewindow"
 )
 
 // SetDefaults_Shoot sets default values for Shoot objects.
@@ -259,17 +258,6 @@ func SetDefaults_Networking(obj *Networking) {
 	}
 }
 
-// SetDefaults_Maintenance sets default values for Maintenance objects.
-func SetDefaults_Maintenance(obj *Maintenance) {
-	if obj.TimeWindow == nil {
-		mt := timewindow.RandomMaintenanceTimeWindow()
-		obj.TimeWindow = &MaintenanceTimeWindow{
-			Begin: mt.Begin().Formatted(),
-			End:   mt.End().Formatted(),
-		}
-	}
-}
-
 // SetDefaults_VerticalPodAutoscaler sets default values for VerticalPodAutoscaler objects.
 func SetDefaults_VerticalPodAutoscaler(obj *VerticalPodAutoscaler) {
 	if obj.EvictAfterOOMThreshold == nil {
@@ -366,6 +354,13 @@ func SetDefaults_Worker(obj *Worker) {
 	}
 }
 
+// SetDefaults_WorkerAccelerators sets default values for WorkerAccelerators objects.
+func SetDefaults_WorkerAccelerators(obj *WorkerAccelerators) {
+	if obj.ManagedDriver == nil {
+		obj.ManagedDriver = ptr.To(true)
+	}
+}
+
 // SetDefaults_ClusterAutoscaler sets default values for ClusterAutoscaler object.
 func SetDefaults_ClusterAutoscaler(obj *ClusterAutoscaler) {
 	if obj.ScaleDownDelayAfterAdd == nil {
@@ -434,6 +429,39 @@ func SetDefaults_NginxIngress(obj *NginxIngress) {
 	}
 }
 
+// SetDefaults_DNSHealthCheck sets default values for DNSHealthCheck objects.
+func SetDefaults_DNSHealthCheck(obj *DNSHealthCheck) {
+	if obj.Protocol == nil {
+		obj.Protocol = ptr.To(DNSHealthCheckProtocolHTTPS)
+	}
+	if obj.Port == nil {
+		obj.Port = ptr.To[int32](443)
+	}
+	if obj.Path == nil && *obj.Protocol == DNSHealthCheckProtocolHTTPS {
+		obj.Path = ptr.To("/healthz")
+	}
+	if obj.IntervalSeconds == nil {
+		obj.IntervalSeconds = ptr.To[int32](30)
+	}
+	if obj.FailureThreshold == nil {
+		obj.FailureThreshold = ptr.To[int32](3)
+	}
+}
+
+// SetDefaults_VolumeSnapshotClass sets default values for VolumeSnapshotClass objects.
+func SetDefaults_VolumeSnapshotClass(obj *VolumeSnapshotClass) {
+	if obj.DeletionPolicy == nil {
+		obj.DeletionPolicy = ptr.To(VolumeSnapshotDeletionPolicyDelete)
+	}
+}
+
+// SetDefaults_VolumeSnapshotPolicy sets default values for VolumeSnapshotPolicy objects.
+func SetDefaults_VolumeSnapshotPolicy(obj *VolumeSnapshotPolicy) {
+	if obj.Retention == nil {
+		obj.Retention = ptr.To[int32](7)
+	}
+}
+
 // Helper functions
 
 func calculateDefaultNodeCIDRMaskSize(shoot *ShootSpec) *int32 {
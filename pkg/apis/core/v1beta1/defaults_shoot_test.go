@@ -631,12 +631,10 @@ var _ = Describe("Shoot defaulting", func() {
 			obj.Spec.Maintenance = nil
 		})
 
-		It("should default the maintenance timeWindow field", func() {
+		It("should not default the maintenance timeWindow field", func() {
 			SetObjectDefaults_Shoot(obj)
 
-			Expect(obj.Spec.Maintenance.TimeWindow).NotTo(BeNil())
-			Expect(obj.Spec.Maintenance.TimeWindow.Begin).To(HaveSuffix("0000+0000"))
-			Expect(obj.Spec.Maintenance.TimeWindow.End).To(HaveSuffix("0000+0000"))
+			Expect(obj.Spec.Maintenance.TimeWindow).To(BeNil())
 		})
 
 		It("should default both KubernetesVersion and MachineImageVersion field for shoot with workers", func() {
@@ -932,6 +930,22 @@ var _ = Describe("Shoot defaulting", func() {
 			Expect(obj.Spec.SystemComponents.CoreDNS.Autoscaling.Mode).To(Equal(CoreDNSAutoscalingModeHorizontal))
 		})
 
+		It("should default the volume snapshot classes and policies", func() {
+			obj.Spec.SystemComponents = &SystemComponents{
+				VolumeSnapshots: &VolumeSnapshots{
+					Classes:  []VolumeSnapshotClass{{Name: "foo"}, {Name: "bar", DeletionPolicy: ptr.To(VolumeSnapshotDeletionPolicyRetain)}},
+					Policies: []VolumeSnapshotPolicy{{Name: "foo"}, {Name: "bar", Retention: ptr.To[int32](2)}},
+				},
+			}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.SystemComponents.VolumeSnapshots.Classes[0].DeletionPolicy).To(PointTo(Equal(VolumeSnapshotDeletionPolicyDelete)))
+			Expect(obj.Spec.SystemComponents.VolumeSnapshots.Classes[1].DeletionPolicy).To(PointTo(Equal(VolumeSnapshotDeletionPolicyRetain)))
+			Expect(obj.Spec.SystemComponents.VolumeSnapshots.Policies[0].Retention).To(PointTo(Equal(int32(7))))
+			Expect(obj.Spec.SystemComponents.VolumeSnapshots.Policies[1].Retention).To(PointTo(Equal(int32(2))))
+		})
+
 		It("should not default the system components for workerless Shoot", func() {
 			obj.Spec.Provider.Workers = nil
 
@@ -1041,6 +1055,16 @@ var _ = Describe("Shoot defaulting", func() {
 			Expect(obj.Spec.Provider.Workers[2].MachineControllerManagerSettings).NotTo(BeNil())
 			Expect(obj.Spec.Provider.Workers[2].MachineControllerManagerSettings.DisableHealthTimeout).To(PointTo(BeTrue()))
 		})
+
+		It("should default the managed driver of the accelerators", func() {
+			obj.Spec.Provider.Workers[0].Accelerators = &WorkerAccelerators{Vendor: AcceleratorVendorNVIDIA}
+			obj.Spec.Provider.Workers[1].Accelerators = &WorkerAccelerators{Vendor: AcceleratorVendorAMD, ManagedDriver: ptr.To(false)}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].Accelerators.ManagedDriver).To(PointTo(BeTrue()))
+			Expect(obj.Spec.Provider.Workers[1].Accelerators.ManagedDriver).To(PointTo(BeFalse()))
+		})
 	})
 
 	Describe("ClusterAutoscaler defaulting", func() {
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"k8s.io/utils/ptr"
+)
+
+// SetDefaults_ShootDebugPodRequestSpec sets default values for ShootDebugPodRequestSpec objects.
+func SetDefaults_ShootDebugPodRequestSpec(obj *ShootDebugPodRequestSpec) {
+	if obj.ExpirationSeconds == nil {
+		obj.ExpirationSeconds = ptr.To(int64(60 * 60))
+	}
+}
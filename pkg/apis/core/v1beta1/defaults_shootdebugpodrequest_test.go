@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("ShootDebugPodRequest defaulting", func() {
+	var obj *ShootDebugPodRequest
+
+	BeforeEach(func() {
+		obj = &ShootDebugPodRequest{}
+	})
+
+	Describe("ExpirationSeconds defaulting", func() {
+		It("should default expirationSeconds field", func() {
+			SetObjectDefaults_ShootDebugPodRequest(obj)
+
+			Expect(obj.Spec.ExpirationSeconds).To(PointTo(Equal(int64(60 * 60))))
+		})
+
+		It("should not default expirationSeconds field if it is already set", func() {
+			obj.Spec.ExpirationSeconds = ptr.To(int64(10 * 60))
+
+			SetObjectDefaults_ShootDebugPodRequest(obj)
+
+			Expect(obj.Spec.ExpirationSeconds).To(PointTo(Equal(int64(10 * 60))))
+		})
+	})
+})
@@ -9,6 +9,8 @@
 
 package v1beta1
 
+func (*APIServerAccessRestrictions) ProtoMessage() {}
+
 func (*APIServerLogging) ProtoMessage() {}
 
 func (*APIServerRequests) ProtoMessage() {}
@@ -67,6 +69,10 @@ func (*CapabilityDefinition) ProtoMessage() {}
 
 func (*CapabilityValues) ProtoMessage() {}
 
+func (*Cleanup) ProtoMessage() {}
+
+func (*CleanupPolicy) ProtoMessage() {}
+
 func (*CloudProfile) ProtoMessage() {}
 
 func (*CloudProfileList) ProtoMessage() {}
@@ -91,10 +97,18 @@ func (*ControlPlane) ProtoMessage() {}
 
 func (*ControlPlaneAutoscaling) ProtoMessage() {}
 
+func (*ControlPlaneComponentFootprint) ProtoMessage() {}
+
+func (*ControlPlaneSizeClass) ProtoMessage() {}
+
 func (*ControllerDeployment) ProtoMessage() {}
 
+func (*ControllerDeploymentCanaryRollout) ProtoMessage() {}
+
 func (*ControllerDeploymentList) ProtoMessage() {}
 
+func (*ControllerDeploymentRollout) ProtoMessage() {}
+
 func (*ControllerInstallation) ProtoMessage() {}
 
 func (*ControllerInstallationList) ProtoMessage() {}
@@ -105,6 +119,8 @@ func (*ControllerInstallationStatus) ProtoMessage() {}
 
 func (*ControllerRegistration) ProtoMessage() {}
 
+func (*ControllerRegistrationDependency) ProtoMessage() {}
+
 func (*ControllerRegistrationDeployment) ProtoMessage() {}
 
 func (*ControllerRegistrationList) ProtoMessage() {}
@@ -115,6 +131,8 @@ func (*ControllerResource) ProtoMessage() {}
 
 func (*ControllerResourceLifecycle) ProtoMessage() {}
 
+func (*ControllerWorkersConfig) ProtoMessage() {}
+
 func (*CoreDNS) ProtoMessage() {}
 
 func (*CoreDNSAutoscaling) ProtoMessage() {}
@@ -123,14 +141,24 @@ func (*CoreDNSRewriting) ProtoMessage() {}
 
 func (*DNS) ProtoMessage() {}
 
+func (*DNSAPIServerRecord) ProtoMessage() {}
+
 func (*DNSExposure) ProtoMessage() {}
 
+func (*DNSFailover) ProtoMessage() {}
+
+func (*DNSHealthCheck) ProtoMessage() {}
+
 func (*DNSIncludeExclude) ProtoMessage() {}
 
 func (*DNSProvider) ProtoMessage() {}
 
+func (*DNSRecordTTL) ProtoMessage() {}
+
 func (*DataVolume) ProtoMessage() {}
 
+func (*DeferredShootMaintenance) ProtoMessage() {}
+
 func (*DeploymentRef) ProtoMessage() {}
 
 func (*DualApprovalForDeletion) ProtoMessage() {}
@@ -141,6 +169,14 @@ func (*ETCDConfig) ProtoMessage() {}
 
 func (*ETCDEncryptionKeyRotation) ProtoMessage() {}
 
+func (*Egress) ProtoMessage() {}
+
+func (*EgressFilter) ProtoMessage() {}
+
+func (*EgressIPPool) ProtoMessage() {}
+
+func (*EgressNamespaceSelection) ProtoMessage() {}
+
 func (*EncryptionAtRest) ProtoMessage() {}
 
 func (*EncryptionConfig) ProtoMessage() {}
@@ -149,6 +185,8 @@ func (*EncryptionProvider) ProtoMessage() {}
 
 func (*EncryptionProviderStatus) ProtoMessage() {}
 
+func (*ErrorCodeDefinition) ProtoMessage() {}
+
 func (*ExpirableVersion) ProtoMessage() {}
 
 func (*ExpirableVersionStatus) ProtoMessage() {}
@@ -175,6 +213,8 @@ func (*GardenerResourceData) ProtoMessage() {}
 
 func (*HelmControllerDeployment) ProtoMessage() {}
 
+func (*HelmValuesOverlay) ProtoMessage() {}
+
 func (*Hibernation) ProtoMessage() {}
 
 func (*HibernationSchedule) ProtoMessage() {}
@@ -191,6 +231,8 @@ func (*Ingress) ProtoMessage() {}
 
 func (*IngressController) ProtoMessage() {}
 
+func (*IngressWildcardCertificate) ProtoMessage() {}
+
 func (*InternalSecret) ProtoMessage() {}
 
 func (*InternalSecretList) ProtoMessage() {}
@@ -247,6 +289,8 @@ func (*MachineImageStatus) ProtoMessage() {}
 
 func (*MachineImageVersion) ProtoMessage() {}
 
+func (*MachinePhaseCount) ProtoMessage() {}
+
 func (*MachineType) ProtoMessage() {}
 
 func (*MachineTypeStorage) ProtoMessage() {}
@@ -259,10 +303,22 @@ func (*MaintenanceAutoUpdate) ProtoMessage() {}
 
 func (*MaintenanceCredentialsAutoRotation) ProtoMessage() {}
 
+func (*MaintenanceFreeze) ProtoMessage() {}
+
+func (*MaintenanceFreezeList) ProtoMessage() {}
+
+func (*MaintenanceFreezeSpec) ProtoMessage() {}
+
+func (*MaintenanceFreezeStatus) ProtoMessage() {}
+
+func (*MaintenanceFreezeWindow) ProtoMessage() {}
+
 func (*MaintenanceRotationConfig) ProtoMessage() {}
 
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 
+func (*ManagedAddon) ProtoMessage() {}
+
 func (*ManualWorkerPoolRollout) ProtoMessage() {}
 
 func (*MemorySwapConfiguration) ProtoMessage() {}
@@ -285,6 +341,10 @@ func (*NetworkingStatus) ProtoMessage() {}
 
 func (*NginxIngress) ProtoMessage() {}
 
+func (*NginxIngressAutoscaling) ProtoMessage() {}
+
+func (*NodeEvictionConfig) ProtoMessage() {}
+
 func (*NodeLocalDNS) ProtoMessage() {}
 
 func (*OCIRepository) ProtoMessage() {}
@@ -305,6 +365,14 @@ func (*ProjectList) ProtoMessage() {}
 
 func (*ProjectMember) ProtoMessage() {}
 
+func (*ProjectShootDefaults) ProtoMessage() {}
+
+func (*ProjectShootHibernationDefaults) ProtoMessage() {}
+
+func (*ProjectShootMaintenanceDefaults) ProtoMessage() {}
+
+func (*ProjectShootWorkerDefaults) ProtoMessage() {}
+
 func (*ProjectSpec) ProtoMessage() {}
 
 func (*ProjectStatus) ProtoMessage() {}
@@ -313,6 +381,8 @@ func (*ProjectTolerations) ProtoMessage() {}
 
 func (*Provider) ProtoMessage() {}
 
+func (*ProxyProtocolTLV) ProtoMessage() {}
+
 func (*Quota) ProtoMessage() {}
 
 func (*QuotaList) ProtoMessage() {}
@@ -327,6 +397,14 @@ func (*ResourceWatchCacheSize) ProtoMessage() {}
 
 func (*SSHAccess) ProtoMessage() {}
 
+func (*SchedulerPlugin) ProtoMessage() {}
+
+func (*SchedulerPluginSet) ProtoMessage() {}
+
+func (*SchedulerPlugins) ProtoMessage() {}
+
+func (*SchedulerProfile) ProtoMessage() {}
+
 func (*SecretBinding) ProtoMessage() {}
 
 func (*SecretBindingList) ProtoMessage() {}
@@ -341,6 +419,8 @@ func (*SeedDNSProvider) ProtoMessage() {}
 
 func (*SeedDNSProviderConfig) ProtoMessage() {}
 
+func (*SeedDrainStatus) ProtoMessage() {}
+
 func (*SeedList) ProtoMessage() {}
 
 func (*SeedNetworks) ProtoMessage() {}
@@ -349,16 +429,22 @@ func (*SeedProvider) ProtoMessage() {}
 
 func (*SeedSelector) ProtoMessage() {}
 
+func (*SeedSettingControlPlaneResourceQuota) ProtoMessage() {}
+
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 
+func (*SeedSettingDrain) ProtoMessage() {}
+
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 
+func (*SeedSettingIstioTLSTermination) ProtoMessage() {}
+
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 
 func (*SeedSettingLoadBalancerServicesZonalIngress) ProtoMessage() {}
@@ -395,10 +481,38 @@ func (*Shoot) ProtoMessage() {}
 
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 
+func (*ShootAvailabilityReport) ProtoMessage() {}
+
+func (*ShootCostEstimate) ProtoMessage() {}
+
 func (*ShootCredentials) ProtoMessage() {}
 
 func (*ShootCredentialsRotation) ProtoMessage() {}
 
+func (*ShootDebugPod) ProtoMessage() {}
+
+func (*ShootDebugPodRequest) ProtoMessage() {}
+
+func (*ShootDebugPodRequestSpec) ProtoMessage() {}
+
+func (*ShootDebugPodRequestStatus) ProtoMessage() {}
+
+func (*ShootFootprintRequest) ProtoMessage() {}
+
+func (*ShootFootprintRequestSpec) ProtoMessage() {}
+
+func (*ShootFootprintRequestStatus) ProtoMessage() {}
+
+func (*ShootImpactOperation) ProtoMessage() {}
+
+func (*ShootImpactRequest) ProtoMessage() {}
+
+func (*ShootImpactRequestSpec) ProtoMessage() {}
+
+func (*ShootImpactRequestStatus) ProtoMessage() {}
+
+func (*ShootInventory) ProtoMessage() {}
+
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 
 func (*ShootList) ProtoMessage() {}
@@ -407,6 +521,8 @@ func (*ShootMachineImage) ProtoMessage() {}
 
 func (*ShootNetworks) ProtoMessage() {}
 
+func (*ShootReconciliationTimestamps) ProtoMessage() {}
+
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 
 func (*ShootSpec) ProtoMessage() {}
@@ -421,6 +537,8 @@ func (*ShootStatus) ProtoMessage() {}
 
 func (*ShootTemplate) ProtoMessage() {}
 
+func (*ShootValidationRule) ProtoMessage() {}
+
 func (*StructuredAuthentication) ProtoMessage() {}
 
 func (*StructuredAuthorization) ProtoMessage() {}
@@ -429,20 +547,38 @@ func (*SystemComponents) ProtoMessage() {}
 
 func (*Toleration) ProtoMessage() {}
 
+func (*VersionClassificationTransitions) ProtoMessage() {}
+
+func (*VersionLifecyclePolicy) ProtoMessage() {}
+
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 
 func (*Volume) ProtoMessage() {}
 
+func (*VolumeSnapshotClass) ProtoMessage() {}
+
+func (*VolumeSnapshotPolicy) ProtoMessage() {}
+
+func (*VolumeSnapshots) ProtoMessage() {}
+
 func (*VolumeType) ProtoMessage() {}
 
 func (*WatchCacheSizes) ProtoMessage() {}
 
 func (*Worker) ProtoMessage() {}
 
+func (*WorkerAccelerators) ProtoMessage() {}
+
 func (*WorkerControlPlane) ProtoMessage() {}
 
 func (*WorkerKubernetes) ProtoMessage() {}
 
+func (*WorkerPoolInventory) ProtoMessage() {}
+
 func (*WorkerSystemComponents) ProtoMessage() {}
 
 func (*WorkersSettings) ProtoMessage() {}
+
+func (*WorkloadDefaults) ProtoMessage() {}
+
+func (*ZoneInventory) ProtoMessage() {}
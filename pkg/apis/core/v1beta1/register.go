@@ -53,6 +53,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ExposureClassList{},
 		&InternalSecret{},
 		&InternalSecretList{},
+		&MaintenanceFreeze{},
+		&MaintenanceFreezeList{},
 		&NamespacedCloudProfile{},
 		&NamespacedCloudProfileList{},
 		&Project{},
@@ -65,6 +67,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SeedList{},
 		&Shoot{},
 		&ShootList{},
+		&ShootFootprintRequest{},
+		&ShootImpactRequest{},
+		&ShootDebugPodRequest{},
 		&ShootState{},
 		&ShootStateList{},
 	)
@@ -97,6 +97,17 @@ type CloudProfileSpec struct {
 	// During maintenance upgrades, the image that matches most capabilities will be selected.
 	// +optional
 	MachineCapabilities []CapabilityDefinition `json:"machineCapabilities,omitempty" protobuf:"bytes,12,rep,name=machineCapabilities"`
+	// ShootValidationRules contains CEL validation rules which must be satisfied by Shoot clusters using this CloudProfile.
+	// See https://github.com/gardener/gardener/blob/master/docs/usage/shoot/shoot_validation_rules.md.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	ShootValidationRules []ShootValidationRule `json:"shootValidationRules,omitempty" patchMergeKey:"name" patchStrategy:"merge" protobuf:"bytes,13,rep,name=shootValidationRules"`
+	// VersionLifecyclePolicy declares automatic transitions of the classifications of the Kubernetes and machine image
+	// versions which are applied by the gardener-controller-manager.
+	// See https://github.com/gardener/gardener/blob/master/docs/usage/shoot-operations/shoot_versions.md#version-lifecycle-policies.
+	// +optional
+	VersionLifecyclePolicy *VersionLifecyclePolicy `json:"versionLifecyclePolicy,omitempty" protobuf:"bytes,14,opt,name=versionLifecyclePolicy"`
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
@@ -321,6 +332,51 @@ type Limits struct {
 	MaxNodesTotal *int32 `json:"maxNodesTotal,omitempty" protobuf:"varint,1,opt,name=maxNodesTotal"`
 }
 
+// ShootValidationRule is a CEL validation rule which is evaluated when Shoot clusters using the CloudProfile are created
+// or updated.
+type ShootValidationRule struct {
+	// Name is the unique name of the rule.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Expression is the CEL expression which must evaluate to true for the Shoot to be admitted. The variable `shoot`
+	// contains the Shoot in version core.gardener.cloud/v1beta1, the variable `oldShoot` contains the Shoot before the
+	// update (it is null when the Shoot is created).
+	Expression string `json:"expression" protobuf:"bytes,2,opt,name=expression"`
+	// Message is the error message returned when the expression evaluates to false. Defaults to a message containing the
+	// name of the rule.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+}
+
+// VersionLifecyclePolicy declares automatic transitions of the classifications of versions in a CloudProfile.
+type VersionLifecyclePolicy struct {
+	// Kubernetes contains the classification transitions for the Kubernetes versions.
+	// +optional
+	Kubernetes *VersionClassificationTransitions `json:"kubernetes,omitempty" protobuf:"bytes,1,opt,name=kubernetes"`
+	// MachineImages contains the classification transitions for the versions of all machine images.
+	// +optional
+	MachineImages *VersionClassificationTransitions `json:"machineImages,omitempty" protobuf:"bytes,2,opt,name=machineImages"`
+	// DryRun indicates that due transitions are only reported via events instead of being applied to the CloudProfile.
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty" protobuf:"varint,3,opt,name=dryRun"`
+}
+
+// VersionClassificationTransitions contains the durations after which versions transition to their next
+// classification. The durations are measured from the time the version was observed in its current classification.
+// Versions using lifecycle stages are not affected.
+type VersionClassificationTransitions struct {
+	// SupportAfter is the duration after which preview versions are classified as supported.
+	// +optional
+	SupportAfter *metav1.Duration `json:"supportAfter,omitempty" protobuf:"bytes,1,opt,name=supportAfter"`
+	// DeprecateAfter is the duration after which supported versions are classified as deprecated. The latest supported
+	// version is never deprecated.
+	// +optional
+	DeprecateAfter *metav1.Duration `json:"deprecateAfter,omitempty" protobuf:"bytes,2,opt,name=deprecateAfter"`
+	// ExpireAfter is the duration after which deprecated versions expire. When a version is deprecated, its expiration
+	// date is set accordingly unless it is already set. The latest version never expires.
+	// +optional
+	ExpireAfter *metav1.Duration `json:"expireAfter,omitempty" protobuf:"bytes,3,opt,name=expireAfter"`
+}
+
 // CloudProfileStatus contains the status of the cloud profile.
 type CloudProfileStatus struct {
 	// Kubernetes contains the status information for kubernetes.
@@ -353,6 +409,9 @@ type ExpirableVersionStatus struct {
 	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
 	// Classification reflects the current state in the classification lifecycle.
 	Classification VersionClassification `json:"classification" protobuf:"bytes,2,opt,name=classification,casttype=VersionClassification"`
+	// LastTransitionTime is the time the version was first observed in its current classification.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
 }
 
 const (
@@ -30,6 +30,11 @@ const (
 	ErrorRetryableInfraDependencies ErrorCode = "ERR_RETRYABLE_INFRA_DEPENDENCIES"
 	// ErrorInfraResourcesDepleted indicates that the last error occurred due to depleted resource in the infrastructure.
 	ErrorInfraResourcesDepleted ErrorCode = "ERR_INFRA_RESOURCES_DEPLETED"
+	// ErrorInfraImageNotFound indicates that the last error occurred due to a machine image which could not be found in the infrastructure.
+	// It is classified as a non-retryable error code.
+	ErrorInfraImageNotFound ErrorCode = "ERR_INFRA_IMAGE_NOT_FOUND"
+	// ErrorInfraIPAddressesExhausted indicates that the last error occurred due to exhausted IP addresses in the infrastructure networks.
+	ErrorInfraIPAddressesExhausted ErrorCode = "ERR_INFRA_IP_ADDRESSES_EXHAUSTED"
 	// ErrorCleanupClusterResources indicates that the last error occurred due to resources in the cluster that are stuck in deletion.
 	ErrorCleanupClusterResources ErrorCode = "ERR_CLEANUP_CLUSTER_RESOURCES"
 	// ErrorConfigurationProblem indicates that the last error occurred due to a configuration problem.
@@ -43,6 +48,22 @@ const (
 	ErrorProblematicWebhook ErrorCode = "ERR_PROBLEMATIC_WEBHOOK"
 )
 
+// ErrorCodeDefinition describes an error code which is not well-known to Gardener but may be reported by an extension.
+type ErrorCodeDefinition struct {
+	// Code is the error code. It must start with `ERR_` and consist of upper case letters, digits and underscores only.
+	Code ErrorCode `json:"code" protobuf:"bytes,1,opt,name=code,casttype=ErrorCode"`
+	// Description is a human readable description of the error code.
+	Description string `json:"description" protobuf:"bytes,2,opt,name=description"`
+	// UserError indicates that the error can be remediated by the user (e.g., by refreshing expired infrastructure
+	// credentials) and does not require a Gardener operator.
+	// +optional
+	UserError bool `json:"userError,omitempty" protobuf:"varint,3,opt,name=userError"`
+	// NonRetryable indicates that an automatic retry would not help fixing the problem. Operations failing with such
+	// an error are not retried.
+	// +optional
+	NonRetryable bool `json:"nonRetryable,omitempty" protobuf:"varint,4,opt,name=nonRetryable"`
+}
+
 // LastError indicates the last occurred error for an operation on a resource.
 type LastError struct {
 	// A human readable message indicating details about the last error.
@@ -145,6 +166,8 @@ const (
 	EventMigrationPrepared = "MigrationPrepared"
 	// EventMigrationPreparationFailed indicates that the Migration preparation failed.
 	EventMigrationPreparationFailed = "MigrationPreparationFailed"
+	// EventRemediated indicates that a remediation action was performed.
+	EventRemediated = "Remediated"
 
 	// EventActionReconcile describes an event action for reconciliation.
 	EventActionReconcile = "Reconcile"
@@ -154,6 +177,8 @@ const (
 	EventActionMigrate = "Migrate"
 	// EventActionHealthCheck describes an event action for health checks.
 	EventActionHealthCheck = "HealthCheck"
+	// EventActionRemediate describes an event action for remediation.
+	EventActionRemediate = "Remediate"
 )
 
 // HighAvailability specifies the configuration settings for high availability for a resource. Typical
@@ -45,6 +45,21 @@ type ControllerRegistrationSpec struct {
 	// Deployment contains information for how this controller is deployed.
 	// +optional
 	Deployment *ControllerRegistrationDeployment `json:"deployment,omitempty" protobuf:"bytes,2,opt,name=deployment"`
+	// ErrorCodes is a list of error codes which may be reported by this controller in addition to the well-known error
+	// codes of Gardener.
+	// +optional
+	ErrorCodes []ErrorCodeDefinition `json:"errorCodes,omitempty" protobuf:"bytes,3,rep,name=errorCodes"`
+	// Dependencies is a list of other ControllerRegistrations this controller depends on. Whenever this controller is
+	// required for a seed or shoot, its dependencies are required as well, and it is only installed after all of its
+	// dependencies have been installed successfully.
+	// +optional
+	Dependencies []ControllerRegistrationDependency `json:"dependencies,omitempty" protobuf:"bytes,4,rep,name=dependencies"`
+}
+
+// ControllerRegistrationDependency is a reference to another ControllerRegistration a controller depends on.
+type ControllerRegistrationDependency struct {
+	// Name is the name of the ControllerRegistration.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
 
 // ClusterType defines the type of cluster.
@@ -97,8 +112,21 @@ type ControllerResource struct {
 	// This field can only be set for resources of kind "Extension".
 	// +optional
 	ClusterCompatibility []ClusterType `json:"clusterCompatibility,omitempty" protobuf:"bytes,9,rep,name=clusterCompatibility,casttype=ClusterType"`
+	// Capabilities is a list of optional features of the resource kind which are supported by the controller.
+	// Currently, only "DNSFailover" is supported for resources of kind "DNSRecord".
+	// +optional
+	Capabilities []ControllerResourceCapability `json:"capabilities,omitempty" protobuf:"bytes,10,rep,name=capabilities,casttype=ControllerResourceCapability"`
 }
 
+// ControllerResourceCapability is an optional feature of an extension resource kind supported by a controller.
+type ControllerResourceCapability string
+
+const (
+	// ControllerResourceCapabilityDNSFailover is a capability of DNSRecord controllers indicating that they support
+	// health-checked secondary targets of DNS records.
+	ControllerResourceCapabilityDNSFailover ControllerResourceCapability = "DNSFailover"
+)
+
 // DeploymentRef contains information about `ControllerDeployment` references.
 type DeploymentRef struct {
 	// Name is the name of the `ControllerDeployment` that is being referred to.
@@ -118,6 +146,35 @@ type ControllerRegistrationDeployment struct {
 	// DeploymentRefs holds references to `ControllerDeployments`. Only one element is supported currently.
 	// +optional
 	DeploymentRefs []DeploymentRef `json:"deploymentRefs,omitempty" protobuf:"bytes,5,opt,name=deploymentRefs"`
+	// Rollout configures how a change of the referenced `ControllerDeployment` is rolled out across seeds. If not set,
+	// changes are rolled out to all seeds at once.
+	// +optional
+	Rollout *ControllerDeploymentRollout `json:"rollout,omitempty" protobuf:"bytes,6,opt,name=rollout"`
+}
+
+// ControllerDeploymentRollout contains the configuration for rolling out a change of the referenced
+// `ControllerDeployment` across seeds.
+type ControllerDeploymentRollout struct {
+	// Canary configures a canary rollout. When the `ControllerRegistration` is switched to another
+	// `ControllerDeployment`, the new `ControllerDeployment` is rolled out to the canary seeds first. It is only rolled
+	// out to the remaining seeds after it has been verified successfully on the canary seeds, otherwise the rollout is
+	// held.
+	// +optional
+	Canary *ControllerDeploymentCanaryRollout `json:"canary,omitempty" protobuf:"bytes,1,opt,name=canary"`
+}
+
+// ControllerDeploymentCanaryRollout contains the configuration for a canary rollout of a `ControllerDeployment`.
+type ControllerDeploymentCanaryRollout struct {
+	// SeedSelector is a label selector for the canary seeds.
+	SeedSelector metav1.LabelSelector `json:"seedSelector" protobuf:"bytes,1,opt,name=seedSelector"`
+	// VerificationDuration is the duration for which the extension must be installed and healthy on all canary seeds
+	// before it is rolled out to the remaining seeds. Defaults to 10m.
+	// +optional
+	VerificationDuration *metav1.Duration `json:"verificationDuration,omitempty" protobuf:"bytes,2,opt,name=verificationDuration"`
+	// MinShootReconcileSuccessRate is the minimum percentage of successful shoot reconciliations on the canary seeds
+	// since the new `ControllerDeployment` was rolled out to them. Defaults to 100.
+	// +optional
+	MinShootReconcileSuccessRate *int32 `json:"minShootReconcileSuccessRate,omitempty" protobuf:"varint,3,opt,name=minShootReconcileSuccessRate"`
 }
 
 // ControllerDeploymentPolicy is a string alias.
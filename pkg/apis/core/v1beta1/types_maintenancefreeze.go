@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenanceFreeze represents time ranges during which the maintenance of Shoots is paused.
+type MaintenanceFreeze struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec contains the specification of the MaintenanceFreeze.
+	Spec MaintenanceFreezeSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the status of the MaintenanceFreeze.
+	// +optional
+	Status MaintenanceFreezeStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenanceFreezeList is a collection of MaintenanceFreezes.
+type MaintenanceFreezeList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Items is the list of MaintenanceFreezes.
+	Items []MaintenanceFreeze `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// MaintenanceFreezeSpec is the specification of a MaintenanceFreeze.
+type MaintenanceFreezeSpec struct {
+	// Windows are the time ranges during which the maintenance of the selected Shoots is paused.
+	Windows []MaintenanceFreezeWindow `json:"windows" protobuf:"bytes,1,rep,name=windows"`
+	// ProjectSelector is an optional label selector for Projects whose Shoots are affected by the MaintenanceFreeze.
+	// If it is not set, Shoots of all Projects are affected.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty" protobuf:"bytes,2,opt,name=projectSelector"`
+	// SeedSelector is an optional label selector for Seeds whose Shoots are affected by the MaintenanceFreeze.
+	// If it is not set, Shoots on all Seeds are affected.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty" protobuf:"bytes,3,opt,name=seedSelector"`
+}
+
+// MaintenanceFreezeWindow is a time range during which the maintenance of Shoots is paused.
+type MaintenanceFreezeWindow struct {
+	// Begin is the beginning of the time range.
+	Begin metav1.Time `json:"begin" protobuf:"bytes,1,opt,name=begin"`
+	// End is the end of the time range.
+	End metav1.Time `json:"end" protobuf:"bytes,2,opt,name=end"`
+}
+
+// MaintenanceFreezeStatus is the status of a MaintenanceFreeze.
+type MaintenanceFreezeStatus struct {
+	// DeferredShoots contains the Shoots whose maintenance was deferred because of the MaintenanceFreeze.
+	// +optional
+	DeferredShoots []DeferredShootMaintenance `json:"deferredShoots,omitempty" protobuf:"bytes,1,rep,name=deferredShoots"`
+}
+
+// DeferredShootMaintenance contains information about a Shoot whose maintenance was deferred.
+type DeferredShootMaintenance struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// LastDeferredTime is the last time the maintenance of the Shoot was deferred.
+	LastDeferredTime metav1.Time `json:"lastDeferredTime" protobuf:"bytes,3,opt,name=lastDeferredTime"`
+}
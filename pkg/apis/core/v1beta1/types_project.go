@@ -79,6 +79,10 @@ type ProjectSpec struct {
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	// +optional
 	DualApprovalForDeletion []DualApprovalForDeletion `json:"dualApprovalForDeletion,omitempty" protobuf:"bytes,8,opt,name=dualApprovalForDeletion"`
+	// ShootDefaults contains default values for Shoots in this project. They are applied when Shoots are created and
+	// the respective fields are not set.
+	// +optional
+	ShootDefaults *ProjectShootDefaults `json:"shootDefaults,omitempty" protobuf:"bytes,9,opt,name=shootDefaults"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -138,6 +142,55 @@ type Toleration struct {
 	// Value is the toleration value corresponding to the toleration key.
 	// +optional
 	Value *string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// ExpirationTimestamp is the timestamp after which the toleration is no longer considered, i.e., the seed taints it
+	// tolerates are effective again for scheduling. This is only supported for shoot tolerations.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty" protobuf:"bytes,3,opt,name=expirationTimestamp"`
+}
+
+// ProjectShootDefaults contains default values for Shoots in a project.
+type ProjectShootDefaults struct {
+	// Maintenance contains defaults for the maintenance configuration of Shoots.
+	// +optional
+	Maintenance *ProjectShootMaintenanceDefaults `json:"maintenance,omitempty" protobuf:"bytes,1,opt,name=maintenance"`
+	// Hibernation contains defaults for the hibernation configuration of Shoots.
+	// +optional
+	Hibernation *ProjectShootHibernationDefaults `json:"hibernation,omitempty" protobuf:"bytes,2,opt,name=hibernation"`
+	// Workers contains defaults for the worker pools of Shoots.
+	// +optional
+	Workers *ProjectShootWorkerDefaults `json:"workers,omitempty" protobuf:"bytes,3,opt,name=workers"`
+}
+
+// ProjectShootMaintenanceDefaults contains defaults for the maintenance configuration of Shoots.
+type ProjectShootMaintenanceDefaults struct {
+	// TimeWindow is the default maintenance time window of Shoots.
+	// +optional
+	TimeWindow *MaintenanceTimeWindow `json:"timeWindow,omitempty" protobuf:"bytes,1,opt,name=timeWindow"`
+	// ConfineSpecUpdateRollout is the default for `.spec.maintenance.confineSpecUpdateRollout` of Shoots.
+	// +optional
+	ConfineSpecUpdateRollout *bool `json:"confineSpecUpdateRollout,omitempty" protobuf:"varint,2,opt,name=confineSpecUpdateRollout"`
+}
+
+// ProjectShootHibernationDefaults contains defaults for the hibernation configuration of Shoots.
+type ProjectShootHibernationDefaults struct {
+	// Schedules are the default hibernation schedules of Shoots. They are only applied if a Shoot does not specify any
+	// hibernation schedule.
+	// +optional
+	Schedules []HibernationSchedule `json:"schedules,omitempty" protobuf:"bytes,1,rep,name=schedules"`
+}
+
+// ProjectShootWorkerDefaults contains defaults for the worker pools of Shoots.
+type ProjectShootWorkerDefaults struct {
+	// MachineImage is the default machine image of worker pools. If the version is omitted, the latest supported version
+	// of the image in the CloudProfile is used.
+	// +optional
+	MachineImage *ShootMachineImage `json:"machineImage,omitempty" protobuf:"bytes,1,opt,name=machineImage"`
+	// Volume is the default root volume of worker pools.
+	// +optional
+	Volume *Volume `json:"volume,omitempty" protobuf:"bytes,2,opt,name=volume"`
+	// Labels are added to the labels of worker pools. Labels specified in the worker pools take precedence.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
 }
 
 // DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
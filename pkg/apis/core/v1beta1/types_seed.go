@@ -125,6 +125,19 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// Drain contains information about the progress of draining the seed.
+	// +optional
+	Drain *SeedDrainStatus `json:"drain,omitempty" protobuf:"bytes,10,opt,name=drain"`
+}
+
+// SeedDrainStatus contains information about the progress of draining a seed.
+type SeedDrainStatus struct {
+	// RemainingShoots is the number of shoots which are still scheduled onto the seed.
+	RemainingShoots int32 `json:"remainingShoots" protobuf:"varint,1,opt,name=remainingShoots"`
+	// MigratingShoots is the number of shoots which are currently being migrated away from the seed.
+	MigratingShoots int32 `json:"migratingShoots" protobuf:"varint,2,opt,name=migratingShoots"`
+	// LastUpdateTime is the time at which the drain progress was last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,3,opt,name=lastUpdateTime"`
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
@@ -217,6 +230,20 @@ type Ingress struct {
 	Domain string `json:"domain" protobuf:"bytes,1,name=domain"`
 	// Controller configures a Gardener managed Ingress Controller listening on the ingressDomain
 	Controller IngressController `json:"controller" protobuf:"bytes,2,name=controller"`
+	// WildcardCertificate configures that the wildcard certificate for the ingress domain is requested from a
+	// certificate issuer (e.g., an ACME issuer provided by an extension) instead of being provided as static secret.
+	// +optional
+	WildcardCertificate *IngressWildcardCertificate `json:"wildcardCertificate,omitempty" protobuf:"bytes,3,opt,name=wildcardCertificate"`
+}
+
+// IngressWildcardCertificate configures how the wildcard certificate for the ingress domain is requested.
+// The certificate is requested via a `cert.gardener.cloud/v1alpha1.Certificate` resource in the `garden` namespace
+// of the seed cluster which must be served by an extension or by cert-management.
+type IngressWildcardCertificate struct {
+	// IssuerName is the name of the issuer used for requesting the certificate. If not set, the default issuer of the
+	// certificate controller is used.
+	// +optional
+	IssuerName *string `json:"issuerName,omitempty" protobuf:"bytes,1,opt,name=issuerName"`
 }
 
 // IngressController enables a Gardener managed Ingress Controller listening on the ingressDomain
@@ -310,6 +337,18 @@ type SeedSettings struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#zone-selection.
 	// +optional
 	ZoneSelection *SeedSettingZoneSelection `json:"zoneSelection,omitempty" protobuf:"bytes,9,opt,name=zoneSelection"`
+	// IstioTLSTermination controls whether the istio ingress gateways of the seed terminate TLS for the shoot
+	// kube-apiservers and originate new TLS connections with client certificates to them (instead of TCP passthrough).
+	// See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#istio-tls-termination.
+	// +optional
+	IstioTLSTermination *SeedSettingIstioTLSTermination `json:"istioTLSTermination,omitempty" protobuf:"bytes,10,opt,name=istioTLSTermination"`
+	// Drain controls the controlled evacuation of all shoots from the seed, e.g. before it is decommissioned.
+	// +optional
+	Drain *SeedSettingDrain `json:"drain,omitempty" protobuf:"bytes,11,opt,name=drain"`
+	// ControlPlaneResourceQuota controls the ResourceQuotas and LimitRanges in the control plane namespaces of shoots.
+	// See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#control-plane-resource-quota.
+	// +optional
+	ControlPlaneResourceQuota *SeedSettingControlPlaneResourceQuota `json:"controlPlaneResourceQuota,omitempty" protobuf:"bytes,12,opt,name=controlPlaneResourceQuota"`
 }
 
 // SeedSettingZoneSelection controls whether shoot control plane zone placement is derived
@@ -360,6 +399,11 @@ type SeedSettingScheduling struct {
 	// Visible controls whether the gardener-scheduler shall consider this seed when scheduling shoots. Invisible seeds
 	// are not considered by the scheduler.
 	Visible bool `json:"visible" protobuf:"bytes,1,opt,name=visible"`
+	// Profile is the scheduling profile used for shoot control plane pods in the seed. When set to `bin-packing`, a
+	// dedicated kube-scheduler that bin-packs shoot control plane pods is deployed into the seed. Defaults to
+	// `balanced`.
+	// +optional
+	Profile *SchedulingProfile `json:"profile,omitempty" protobuf:"bytes,2,opt,name=profile,casttype=SchedulingProfile"`
 }
 
 // SeedSettingLoadBalancerServices controls certain settings for services of type load balancer that are created in the
@@ -418,6 +462,21 @@ type LoadBalancerServicesProxyProtocol struct {
 	// The option allows a migration from non-ProxyProtocol to ProxyProtocol without downtime (depending on the infrastructure).
 	// Defaults to false.
 	Allowed bool `json:"allowed" protobuf:"bytes,1,opt,name=allowed"`
+	// TLVs is a list of type-length-value (TLV) fields of PROXY protocol v2 headers which are parsed by the istio ingress
+	// gateways, e.g., VPC endpoint IDs added by cloud load balancers for private links. The values are stored as dynamic
+	// metadata of the connection and are added as `X-Gardener-Proxy-Protocol-<name>` request headers to requests to
+	// kube-apiservers if the TLS connections are terminated by the istio ingress gateways.
+	// +optional
+	TLVs []ProxyProtocolTLV `json:"tlvs,omitempty" protobuf:"bytes,2,rep,name=tlvs"`
+}
+
+// ProxyProtocolTLV is a type-length-value (TLV) field of PROXY protocol v2 headers.
+type ProxyProtocolTLV struct {
+	// Type is the type of the TLV, e.g., 234 (0xEA) for AWS VPC endpoint IDs. It must be in the range [0,255].
+	Type int32 `json:"type" protobuf:"varint,1,opt,name=type"`
+	// Name is the name under which the value of the TLV is surfaced. It must consist of lower case alphanumeric
+	// characters or '-' and be at most 32 characters long.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
 }
 
 // SeedSettingLoadBalancerServicesZonalIngress controls the deployment of ingress gateways per availability zone.
@@ -488,6 +547,58 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool `json:"enabled" protobuf:"bytes,1,opt,name=enabled"`
 }
 
+// SeedSettingIstioTLSTermination controls whether the istio ingress gateways of the seed terminate TLS for the shoot
+// kube-apiservers and originate new TLS connections with client certificates to them.
+type SeedSettingIstioTLSTermination struct {
+	// Enabled controls whether TLS termination and upstream TLS origination is enabled for all shoots on the seed.
+	// Individual shoots can still opt out via the `shoot.gardener.cloud/disable-istio-tls-termination` annotation.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+}
+
+// SeedSettingDrain controls the controlled evacuation of all shoots from the seed.
+type SeedSettingDrain struct {
+	// Enabled controls whether the seed is drained. If true, no new shoots can be scheduled onto the seed, and the
+	// control planes of all shoots hosted on it are migrated to other seeds determined by the gardener-scheduler.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+	// MaxConcurrentMigrations is the maximum number of shoots that are migrated away from the seed at the same time.
+	// Defaults to 5.
+	// +optional
+	MaxConcurrentMigrations *int32 `json:"maxConcurrentMigrations,omitempty" protobuf:"varint,2,opt,name=maxConcurrentMigrations"`
+}
+
+// SeedSettingControlPlaneResourceQuota controls the ResourceQuotas and LimitRanges in the control plane namespaces of
+// shoots.
+type SeedSettingControlPlaneResourceQuota struct {
+	// Enabled controls whether a ResourceQuota and a LimitRange are created in the control plane namespaces of shoots.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+	// SizeClasses are the size classes of shoot control planes. A shoot is assigned to the first size class whose
+	// maximum number of nodes is greater than or equal to the sum of the maximum numbers of nodes of its worker pools.
+	// If no size class matches, the last one is used.
+	// +optional
+	SizeClasses []ControlPlaneSizeClass `json:"sizeClasses,omitempty" protobuf:"bytes,2,rep,name=sizeClasses"`
+}
+
+// ControlPlaneSizeClass describes the resource constraints for the control plane namespaces of shoots of a certain
+// size.
+type ControlPlaneSizeClass struct {
+	// Name is the name of the size class.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// MaxNodes is the maximum number of nodes of shoots in this size class. If not set, the size class applies to
+	// shoots of any size.
+	// +optional
+	MaxNodes *int32 `json:"maxNodes,omitempty" protobuf:"varint,2,opt,name=maxNodes"`
+	// Quota is the hard limit for the aggregated resource consumption in the control plane namespace.
+	Quota corev1.ResourceList `json:"quota" protobuf:"bytes,3,rep,name=quota,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// ContainerMax is the maximum amount of resources a single container in the control plane namespace can request or
+	// be limited to. Containers without limits are limited to this amount.
+	// +optional
+	ContainerMax corev1.ResourceList `json:"containerMax,omitempty" protobuf:"bytes,4,rep,name=containerMax,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// ContainerDefaultRequest is the amount of resources requested by containers in the control plane namespace which
+	// do not specify requests.
+	// +optional
+	ContainerDefaultRequest corev1.ResourceList `json:"containerDefaultRequest,omitempty" protobuf:"bytes,5,rep,name=containerDefaultRequest,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -532,6 +643,18 @@ const (
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
 	// SeedEmergencyStopShootReconciliations is a constant for a condition type indicating disabled shoot reconciliations.
 	SeedEmergencyStopShootReconciliations ConditionType = "EmergencyStopShootReconciliations"
+	// SeedCapacityPressure is a constant for a condition type indicating that the resources requested in the seed
+	// cluster exceed or are expected to exceed a threshold of the allocatable resources.
+	SeedCapacityPressure ConditionType = "SeedCapacityPressure"
+	// SeedImagesVerified is a constant for a condition type indicating whether the signatures of the container images
+	// deployed by gardenlet could be verified.
+	SeedImagesVerified ConditionType = "SeedImagesVerified"
+	// SeedIngressWildcardCertificateReady is a constant for a condition type indicating whether the wildcard certificate
+	// for the ingress domain requested from a certificate issuer is ready.
+	SeedIngressWildcardCertificateReady ConditionType = "SeedIngressWildcardCertificateReady"
+	// SeedCustomResourceDefinitionsReady is a constant for a condition type indicating whether the custom resource
+	// definitions managed by gardenlet in the seed cluster are free of conflicts with other actors.
+	SeedCustomResourceDefinitionsReady ConditionType = "SeedCustomResourceDefinitionsReady"
 )
 
 // Resource constants for Gardener object types
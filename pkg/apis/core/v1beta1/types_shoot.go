@@ -66,6 +66,7 @@ type ShootSpec struct {
 	// Addons contains information about enabled/disabled addons and their configuration.
 	//
 	// Deprecated: This field is deprecated. Enabling addons will be forbidden starting from Kubernetes 1.35.
+	// Use `ManagedAddons` instead.
 	// +optional
 	Addons *Addons `json:"addons,omitempty" protobuf:"bytes,1,opt,name=addons"` // TODO(timuthy): Drop this field when support for Kubernetes 1.34 is dropped.
 	// CloudProfileName is a name of a CloudProfile object.
@@ -150,6 +151,13 @@ type ShootSpec struct {
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	// +optional
 	AccessRestrictions []AccessRestrictionWithOptions `json:"accessRestrictions,omitempty" protobuf:"bytes,24,rep,name=accessRestrictions"`
+	// Cleanup contains the policies for cleaning up the resources in the shoot cluster when it is deleted.
+	// +optional
+	Cleanup *Cleanup `json:"cleanup,omitempty" protobuf:"bytes,25,opt,name=cleanup"`
+	// ManagedAddons contains the addons which are deployed into the shoot cluster. The addons are selected from the
+	// addon catalogs provided by extensions.
+	// +optional
+	ManagedAddons []ManagedAddon `json:"managedAddons,omitempty" protobuf:"bytes,26,rep,name=managedAddons"`
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -232,6 +240,80 @@ type ShootStatus struct {
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	// +optional
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout `json:"manualWorkerPoolRollout,omitempty" protobuf:"bytes,21,opt,name=manualWorkerPoolRollout"`
+	// Inventory contains an aggregated summary of the machines and nodes of the Shoot's worker pools. It is maintained
+	// by gardenlet based on the machine objects in the seed cluster.
+	// +optional
+	Inventory *ShootInventory `json:"inventory,omitempty" protobuf:"bytes,22,opt,name=inventory"`
+	// ReconciliationTimestamps contains the timestamps of the relevant phases of the last reconciliations of the Shoot.
+	// The list is ordered from the oldest to the newest reconciliation and is limited to the last few entries.
+	// +optional
+	ReconciliationTimestamps []ShootReconciliationTimestamps `json:"reconciliationTimestamps,omitempty" protobuf:"bytes,23,rep,name=reconciliationTimestamps"`
+	// AvailabilityReports contains the monthly reports of the availability of the Shoot's API server. They are only
+	// maintained if the API server availability probing is enabled by the gardenlet. The list is ordered from the
+	// oldest to the newest month and is limited to the last few entries.
+	// +optional
+	AvailabilityReports []ShootAvailabilityReport `json:"availabilityReports,omitempty" protobuf:"bytes,24,rep,name=availabilityReports"`
+	// DebugPods contains the debug pods which have been requested by operators via the `shoots/debugpod` subresource.
+	// The gardenlet creates the pods in the control plane namespace of the Shoot and removes them together with the
+	// entries after they have expired.
+	// +optional
+	DebugPods []ShootDebugPod `json:"debugPods,omitempty" protobuf:"bytes,25,rep,name=debugPods"`
+}
+
+// ShootReconciliationTimestamps contains the timestamps of the relevant phases of a Shoot reconciliation.
+type ShootReconciliationTimestamps struct {
+	// Type is the type of the operation (Create, Reconcile, Restore).
+	Type LastOperationType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=LastOperationType"`
+	// FlowStarted is the time when the reconciliation flow was started.
+	FlowStarted metav1.Time `json:"flowStarted" protobuf:"bytes,2,opt,name=flowStarted"`
+	// InfrastructureReady is the time when the infrastructure was reported ready.
+	// +optional
+	InfrastructureReady *metav1.Time `json:"infrastructureReady,omitempty" protobuf:"bytes,3,opt,name=infrastructureReady"`
+	// ControlPlaneReady is the time when the control plane was reported ready.
+	// +optional
+	ControlPlaneReady *metav1.Time `json:"controlPlaneReady,omitempty" protobuf:"bytes,4,opt,name=controlPlaneReady"`
+	// NodesReady is the time when the worker nodes were reported ready.
+	// +optional
+	NodesReady *metav1.Time `json:"nodesReady,omitempty" protobuf:"bytes,5,opt,name=nodesReady"`
+	// Finished is the time when the reconciliation flow finished, either successfully or with an error.
+	// +optional
+	Finished *metav1.Time `json:"finished,omitempty" protobuf:"bytes,6,opt,name=finished"`
+	// State is the final state of the reconciliation (Succeeded, Error).
+	// +optional
+	State LastOperationState `json:"state,omitempty" protobuf:"bytes,7,opt,name=state,casttype=LastOperationState"`
+}
+
+// ShootAvailabilityReport contains the availability of the Shoot's API server in a calendar month.
+type ShootAvailabilityReport struct {
+	// Period is the calendar month (UTC) of the report in the format 'YYYY-MM'.
+	Period string `json:"period" protobuf:"bytes,1,opt,name=period"`
+	// Downtime is the accounted downtime of the API server outside of the maintenance time window.
+	Downtime metav1.Duration `json:"downtime" protobuf:"bytes,2,opt,name=downtime"`
+	// MaintenanceDowntime is the accounted downtime of the API server within the maintenance time window. It is not
+	// considered for the availability.
+	MaintenanceDowntime metav1.Duration `json:"maintenanceDowntime" protobuf:"bytes,3,opt,name=maintenanceDowntime"`
+	// Availability is the availability of the API server in percent, e.g. '99.950'. For the current month, it refers
+	// to the part of the month until the downtime last changed, see LastUpdateTime.
+	Availability string `json:"availability" protobuf:"bytes,4,opt,name=availability"`
+	// Final indicates whether the month has ended, i.e., the report does not change anymore.
+	// +optional
+	Final bool `json:"final,omitempty" protobuf:"varint,5,opt,name=final"`
+	// LastUpdateTime is the time when the report was last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,6,opt,name=lastUpdateTime"`
+}
+
+// ShootDebugPod contains information about a debug pod in the control plane namespace of the Shoot.
+type ShootDebugPod struct {
+	// Name is the name of the debug pod.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// RequestedBy is the name of the user who requested the debug pod.
+	RequestedBy string `json:"requestedBy" protobuf:"bytes,2,opt,name=requestedBy"`
+	// Reason is the justification given by the user who requested the debug pod.
+	Reason string `json:"reason" protobuf:"bytes,3,opt,name=reason"`
+	// CreationTimestamp is the time when the debug pod was requested.
+	CreationTimestamp metav1.Time `json:"creationTimestamp" protobuf:"bytes,4,opt,name=creationTimestamp"`
+	// ExpirationTimestamp is the time after which the debug pod is deleted.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp" protobuf:"bytes,5,opt,name=expirationTimestamp"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -364,6 +446,51 @@ type ManualWorkerPoolRollout struct {
 	PendingWorkersRollouts []PendingWorkersRollout `json:"pendingWorkersRollouts,omitempty" protobuf:"bytes,1,rep,name=pendingWorkersRollouts"`
 }
 
+// ShootInventory contains an aggregated summary of the machines and nodes of the Shoot's worker pools.
+type ShootInventory struct {
+	// WorkerPools contains the inventory of the worker pools.
+	// +optional
+	WorkerPools []WorkerPoolInventory `json:"workerPools,omitempty" protobuf:"bytes,1,rep,name=workerPools"`
+}
+
+// WorkerPoolInventory contains an aggregated summary of the machines and nodes of a worker pool.
+type WorkerPoolInventory struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Machines is the number of machines of the worker pool.
+	Machines int32 `json:"machines" protobuf:"varint,2,opt,name=machines"`
+	// Nodes is the number of machines of the worker pool which have joined the cluster as nodes.
+	Nodes int32 `json:"nodes" protobuf:"varint,3,opt,name=nodes"`
+	// MachinePhases contains the number of machines per machine phase.
+	// +optional
+	MachinePhases []MachinePhaseCount `json:"machinePhases,omitempty" protobuf:"bytes,4,rep,name=machinePhases"`
+	// Zones contains the number of machines and nodes per zone.
+	// +optional
+	Zones []ZoneInventory `json:"zones,omitempty" protobuf:"bytes,5,rep,name=zones"`
+	// OutdatedNodes is the number of nodes which have not yet applied the latest operating system config. It is not
+	// set if the nodes of the Shoot could not be inspected.
+	// +optional
+	OutdatedNodes *int32 `json:"outdatedNodes,omitempty" protobuf:"varint,6,opt,name=outdatedNodes"`
+}
+
+// MachinePhaseCount contains the number of machines in a certain phase.
+type MachinePhaseCount struct {
+	// Phase is the machine phase, e.g. `Pending`, `Running`, or `Failed`.
+	Phase string `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Count is the number of machines in this phase.
+	Count int32 `json:"count" protobuf:"varint,2,opt,name=count"`
+}
+
+// ZoneInventory contains the number of machines and nodes of a worker pool in a zone.
+type ZoneInventory struct {
+	// Name is the name of the zone.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Machines is the number of machines in this zone.
+	Machines int32 `json:"machines" protobuf:"varint,2,opt,name=machines"`
+	// Nodes is the number of machines in this zone which have joined the cluster as nodes.
+	Nodes int32 `json:"nodes" protobuf:"varint,3,opt,name=nodes"`
+}
+
 // ShootKubeconfigRotation contains information about the kubeconfig credential rotation.
 type ShootKubeconfigRotation struct {
 	// LastInitiationTime is the most recent time when the kubeconfig credential rotation was initiated.
@@ -535,6 +662,37 @@ type NginxIngress struct {
 	// exposing the nginx-ingress. Defaults to `Cluster`.
 	// +optional
 	ExternalTrafficPolicy *corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty" protobuf:"bytes,4,opt,name=externalTrafficPolicy,casttype=k8s.io/api/core/v1.ServiceExternalTrafficPolicy"`
+	// LoadBalancerAnnotations are additional annotations for the load balancer `Service` exposing the nginx-ingress,
+	// e.g., to configure the load balancer of the cloud provider.
+	// +optional
+	LoadBalancerAnnotations map[string]string `json:"loadBalancerAnnotations,omitempty" protobuf:"bytes,5,rep,name=loadBalancerAnnotations"`
+	// Autoscaling contains the settings for the horizontal autoscaling of the nginx-ingress controller. If not set, the
+	// controller runs with a single replica.
+	// +optional
+	Autoscaling *NginxIngressAutoscaling `json:"autoscaling,omitempty" protobuf:"bytes,6,opt,name=autoscaling"`
+}
+
+// NginxIngressAutoscaling contains the settings for the horizontal autoscaling of the nginx-ingress controller.
+type NginxIngressAutoscaling struct {
+	// MinReplicas is the minimum number of replicas of the nginx-ingress controller. Defaults to `1`.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty" protobuf:"varint,1,opt,name=minReplicas"`
+	// MaxReplicas is the maximum number of replicas of the nginx-ingress controller.
+	MaxReplicas int32 `json:"maxReplicas" protobuf:"varint,2,opt,name=maxReplicas"`
+	// TargetCPUUtilizationPercentage is the average CPU utilization of the nginx-ingress controller pods at which
+	// the controller is scaled out. Defaults to `80`.
+	// +optional
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty" protobuf:"varint,3,opt,name=targetCPUUtilizationPercentage"`
+}
+
+// ManagedAddon selects an addon from the addon catalogs provided by extensions which is deployed into the shoot cluster.
+type ManagedAddon struct {
+	// Name is the name of the addon in the addon catalog.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Version is a semantic version constraint for the addon, e.g. `~1.2` or `>= 1.0, < 2.0`. The highest version of
+	// the addon satisfying the constraint is deployed. If not set, the highest available version is deployed.
+	// +optional
+	Version *string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
 }
 
 // ControlPlane holds information about the general settings for the control plane of a shoot.
@@ -545,6 +703,34 @@ type ControlPlane struct {
 	HighAvailability *HighAvailability `json:"highAvailability,omitempty" protobuf:"bytes,1,name=highAvailability"`
 }
 
+// Cleanup contains the policies for cleaning up the resources in the shoot cluster when it is deleted.
+type Cleanup struct {
+	// Webhooks is the cleanup policy for ValidatingWebhookConfigurations and MutatingWebhookConfigurations.
+	// +optional
+	Webhooks *CleanupPolicy `json:"webhooks,omitempty" protobuf:"bytes,1,opt,name=webhooks"`
+	// ExtendedAPIs is the cleanup policy for APIServices and CustomResourceDefinitions.
+	// +optional
+	ExtendedAPIs *CleanupPolicy `json:"extendedAPIs,omitempty" protobuf:"bytes,2,opt,name=extendedAPIs"`
+	// KubernetesResources is the cleanup policy for workloads, Services and PersistentVolumeClaims.
+	// +optional
+	KubernetesResources *CleanupPolicy `json:"kubernetesResources,omitempty" protobuf:"bytes,3,opt,name=kubernetesResources"`
+	// VolumeSnapshots is the cleanup policy for VolumeSnapshots and VolumeSnapshotContents.
+	// +optional
+	VolumeSnapshots *CleanupPolicy `json:"volumeSnapshots,omitempty" protobuf:"bytes,4,opt,name=volumeSnapshots"`
+}
+
+// CleanupPolicy contains the policy for cleaning up a class of resources in the shoot cluster.
+type CleanupPolicy struct {
+	// FinalizeGracePeriod is the duration after which the finalizers of the resources which are still present are
+	// forcefully removed. If not set, the default of the respective cleanup step is used.
+	// +optional
+	FinalizeGracePeriod *metav1.Duration `json:"finalizeGracePeriod,omitempty" protobuf:"bytes,1,opt,name=finalizeGracePeriod"`
+	// ForceFinalize is a list of resource kinds whose finalizers are forcefully removed right after their deletion
+	// was requested. Only kinds which are handled by the respective cleanup step are considered.
+	// +optional
+	ForceFinalize []metav1.GroupKind `json:"forceFinalize,omitempty" protobuf:"bytes,2,rep,name=forceFinalize"`
+}
+
 // DNS holds information about the provider, the hosted zone id and the domain.
 type DNS struct {
 	// Domain is the external available domain of the Shoot cluster. This domain will be written into the
@@ -558,8 +744,71 @@ type DNS struct {
 	// Please use the DNS extension provider config (e.g. shoot-dns-service) for additional providers.
 	// +optional
 	Providers []DNSProvider `json:"providers,omitempty" protobuf:"bytes,2,rep,name=providers"`
+	// APIServerRecord contains settings for the DNS record of the external domain of the API server.
+	// +optional
+	APIServerRecord *DNSAPIServerRecord `json:"apiServerRecord,omitempty" protobuf:"bytes,3,opt,name=apiServerRecord"`
 }
 
+// DNSAPIServerRecord contains settings for the DNS record of the external domain of the API server.
+type DNSAPIServerRecord struct {
+	// TTLs is a list of TTLs per DNS record type. The type of the DNS record depends on the address of the API server's
+	// load balancer. If no TTL is configured for the used record type, the default TTL of gardenlet is used.
+	// +optional
+	TTLs []DNSRecordTTL `json:"ttls,omitempty" protobuf:"bytes,1,rep,name=ttls"`
+	// Failover contains the configuration for health-checked secondary targets of the DNS record. It requires a primary
+	// DNS provider whose DNSRecord extension supports the "DNSFailover" capability.
+	// +optional
+	Failover *DNSFailover `json:"failover,omitempty" protobuf:"bytes,2,opt,name=failover"`
+}
+
+// DNSRecordTTL contains the TTL for a DNS record type.
+type DNSRecordTTL struct {
+	// RecordType is the DNS record type. Supported values are "A", "AAAA", and "CNAME".
+	RecordType string `json:"recordType" protobuf:"bytes,1,opt,name=recordType"`
+	// Seconds is the time to live in seconds.
+	Seconds int64 `json:"seconds" protobuf:"varint,2,opt,name=seconds"`
+}
+
+// DNSFailover contains the configuration for health-checked secondary targets of a DNS record.
+type DNSFailover struct {
+	// Values is a list of IP addresses or a single hostname which are served by the DNS record if the health check of
+	// the primary target fails.
+	Values []string `json:"values" protobuf:"bytes,1,rep,name=values"`
+	// HealthCheck contains the configuration of the health check of the primary target.
+	// +optional
+	HealthCheck DNSHealthCheck `json:"healthCheck" protobuf:"bytes,2,opt,name=healthCheck"`
+}
+
+// DNSHealthCheck contains the configuration of a health check for a DNS record target.
+type DNSHealthCheck struct {
+	// Protocol is the protocol used for the health check. Supported values are "HTTPS" and "TCP". Defaults to "HTTPS".
+	// +optional
+	Protocol *DNSHealthCheckProtocol `json:"protocol,omitempty" protobuf:"bytes,1,opt,name=protocol,casttype=DNSHealthCheckProtocol"`
+	// Port is the port used for the health check. Defaults to 443.
+	// +optional
+	Port *int32 `json:"port,omitempty" protobuf:"varint,2,opt,name=port"`
+	// Path is the path used for HTTPS health checks. Defaults to "/healthz" for the "HTTPS" protocol.
+	// +optional
+	Path *string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// IntervalSeconds is the interval between two health checks in seconds. Defaults to 30.
+	// +optional
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty" protobuf:"varint,4,opt,name=intervalSeconds"`
+	// FailureThreshold is the number of consecutive failed health checks after which the secondary targets are served.
+	// Defaults to 3.
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty" protobuf:"varint,5,opt,name=failureThreshold"`
+}
+
+// DNSHealthCheckProtocol is a protocol used for DNS health checks.
+type DNSHealthCheckProtocol string
+
+const (
+	// DNSHealthCheckProtocolHTTPS is a constant for HTTPS health checks.
+	DNSHealthCheckProtocolHTTPS DNSHealthCheckProtocol = "HTTPS"
+	// DNSHealthCheckProtocolTCP is a constant for TCP health checks.
+	DNSHealthCheckProtocolTCP DNSHealthCheckProtocol = "TCP"
+)
+
 // TODO(timuthy): Rework the 'DNSProvider' struct and deprecated fields in the scope of https://github.com/gardener/gardener/issues/9176.
 
 // DNSProvider contains information about a DNS provider.
@@ -682,6 +931,23 @@ type Kubernetes struct {
 	// ETCD contains configuration for etcds of the shoot cluster.
 	// +optional
 	ETCD *ETCD `json:"etcd,omitempty" protobuf:"bytes,11,opt,name=etcd"`
+	// WorkloadDefaults contains defaults which are injected into the pods of user workloads in the shoot cluster.
+	// Pods in the kube-system namespace and pods managed by Gardener are not considered.
+	// +optional
+	WorkloadDefaults *WorkloadDefaults `json:"workloadDefaults,omitempty" protobuf:"bytes,12,opt,name=workloadDefaults"`
+}
+
+// WorkloadDefaults contains defaults which are injected into the pods of user workloads in the shoot cluster.
+type WorkloadDefaults struct {
+	// TopologySpreadConstraints are added to pods which do not specify any topology spread constraints. If the label
+	// selector of a constraint is not set, the labels of the respective pod are used.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty" protobuf:"bytes,1,rep,name=topologySpreadConstraints"`
+	// Affinity contains the node affinity, pod affinity and pod anti-affinity which are added to pods which do not
+	// specify the respective affinity. If the label selector of a pod (anti-)affinity term is not set, the labels of the
+	// respective pod are used.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty" protobuf:"bytes,2,opt,name=affinity"`
 }
 
 // ETCD contains configuration for etcds of the shoot cluster.
@@ -1032,6 +1298,34 @@ type KubeAPIServerConfig struct {
 	// Autoscaling contains auto-scaling configuration options for the kube-apiserver.
 	// +optional
 	Autoscaling *ControlPlaneAutoscaling `json:"autoscaling,omitempty" protobuf:"bytes,19,opt,name=autoscaling"`
+	// AccessRestrictions contains restrictions for accessing the kube-apiserver via its load balancer.
+	// +optional
+	AccessRestrictions *APIServerAccessRestrictions `json:"accessRestrictions,omitempty" protobuf:"bytes,20,opt,name=accessRestrictions"`
+	// NodeConnectivityMode is the mode used by the kube-apiserver to connect to nodes, pods and services of the shoot
+	// cluster, e.g., for `kubectl logs/exec/port-forward` or for webhooks served inside the cluster. Possible values are
+	// `VPN` and `Konnectivity`. Defaults to `VPN`.
+	// +optional
+	NodeConnectivityMode *NodeConnectivityMode `json:"nodeConnectivityMode,omitempty" protobuf:"bytes,21,opt,name=nodeConnectivityMode,casttype=NodeConnectivityMode"`
+}
+
+// NodeConnectivityMode is the mode used by the kube-apiserver to connect to the shoot cluster network.
+type NodeConnectivityMode string
+
+const (
+	// NodeConnectivityModeVPN tunnels the traffic from the kube-apiserver to the shoot cluster network via the VPN
+	// (vpn-seed-server in the control plane, vpn-shoot in the shoot cluster).
+	NodeConnectivityModeVPN NodeConnectivityMode = "VPN"
+	// NodeConnectivityModeKonnectivity tunnels the traffic from the kube-apiserver to the shoot cluster network via a
+	// konnectivity-server sidecar in the kube-apiserver pods and konnectivity-agents in the shoot cluster.
+	NodeConnectivityModeKonnectivity NodeConnectivityMode = "Konnectivity"
+)
+
+// APIServerAccessRestrictions contains restrictions for accessing the kube-apiserver.
+type APIServerAccessRestrictions struct {
+	// SourceRanges is a list of CIDRs which are allowed to access the kube-apiserver via its load balancer.
+	// Connections from all other client IPs are rejected. If empty, access is not restricted.
+	// +optional
+	SourceRanges []string `json:"sourceRanges,omitempty" protobuf:"bytes,1,rep,name=sourceRanges"`
 }
 
 // ControlPlaneAutoscaling contains auto-scaling configuration options for control-plane components.
@@ -1116,6 +1410,12 @@ type ServiceAccountConfig struct {
 	// issued by another external system or a change of the current issuer that is used for generating tokens is being performed.
 	// +optional
 	AcceptedIssuers []string `json:"acceptedIssuers,omitempty" protobuf:"bytes,5,opt,name=acceptedIssuers"`
+	// ProjectedTokenExpiration is the validity duration of the projected service account tokens which are automatically
+	// mounted into the pods of system components running in the shoot cluster. Individual pods can overwrite it with
+	// the `projected-token-mount.resources.gardener.cloud/expiration-seconds` annotation.
+	// This field must be within [10m,30d]. Defaults to 12h.
+	// +optional
+	ProjectedTokenExpiration *metav1.Duration `json:"projectedTokenExpiration,omitempty" protobuf:"bytes,6,opt,name=projectedTokenExpiration"`
 }
 
 // AuditConfig contains settings for audit of the api server
@@ -1278,6 +1578,69 @@ type KubeControllerManagerConfig struct {
 	// NodeCIDRMaskSizeIPv6 defines the mask size for node cidr in cluster (default is 64). This field is immutable.
 	// +optional
 	NodeCIDRMaskSizeIPv6 *int32 `json:"nodeCIDRMaskSizeIPv6,omitempty" protobuf:"varint,6,opt,name=nodeCIDRMaskSizeIPv6"`
+	// ControllerWorkers contains the number of workers for individual controllers of the kube-controller-manager.
+	// +optional
+	ControllerWorkers *ControllerWorkersConfig `json:"controllerWorkers,omitempty" protobuf:"bytes,7,opt,name=controllerWorkers"`
+	// NodeEviction contains settings for the eviction of pods from unhealthy nodes performed by the node lifecycle
+	// controller. It replaces the deprecated `podEvictionTimeout` field, see its description for more details.
+	// +optional
+	NodeEviction *NodeEvictionConfig `json:"nodeEviction,omitempty" protobuf:"bytes,8,opt,name=nodeEviction"`
+}
+
+// ControllerWorkersConfig contains the number of workers for individual controllers of the kube-controller-manager.
+// Gardener uses its own defaults for fields which are not set.
+type ControllerWorkersConfig struct {
+	// Deployment is the number of workers for the Deployment controller.
+	// +optional
+	Deployment *int32 `json:"deployment,omitempty" protobuf:"varint,1,opt,name=deployment"`
+	// ReplicaSet is the number of workers for the ReplicaSet controller.
+	// +optional
+	ReplicaSet *int32 `json:"replicaSet,omitempty" protobuf:"varint,2,opt,name=replicaSet"`
+	// StatefulSet is the number of workers for the StatefulSet controller.
+	// +optional
+	StatefulSet *int32 `json:"statefulSet,omitempty" protobuf:"varint,3,opt,name=statefulSet"`
+	// Endpoint is the number of workers for the Endpoint controller.
+	// +optional
+	Endpoint *int32 `json:"endpoint,omitempty" protobuf:"varint,4,opt,name=endpoint"`
+	// ServiceEndpoint is the number of workers for the EndpointSlice controller.
+	// +optional
+	ServiceEndpoint *int32 `json:"serviceEndpoint,omitempty" protobuf:"varint,5,opt,name=serviceEndpoint"`
+	// GarbageCollector is the number of workers for the garbage collector controller.
+	// +optional
+	GarbageCollector *int32 `json:"garbageCollector,omitempty" protobuf:"varint,6,opt,name=garbageCollector"`
+	// Namespace is the number of workers for the Namespace controller. For workerless shoots, it can be set to '0' in
+	// order to disable the controller.
+	// +optional
+	Namespace *int32 `json:"namespace,omitempty" protobuf:"varint,7,opt,name=namespace"`
+	// ResourceQuota is the number of workers for the ResourceQuota controller. For workerless shoots, it can be set to
+	// '0' in order to disable the controller.
+	// +optional
+	ResourceQuota *int32 `json:"resourceQuota,omitempty" protobuf:"varint,8,opt,name=resourceQuota"`
+	// ServiceAccountToken is the number of workers for the ServiceAccount token controller. For workerless shoots, it
+	// can be set to '0' in order to disable the controller.
+	// +optional
+	ServiceAccountToken *int32 `json:"serviceAccountToken,omitempty" protobuf:"varint,9,opt,name=serviceAccountToken"`
+}
+
+// NodeEvictionConfig contains settings for the eviction of pods from unhealthy nodes performed by the node lifecycle
+// controller of the kube-controller-manager.
+// Note: Descriptions were taken from the Kubernetes documentation.
+type NodeEvictionConfig struct {
+	// Rate is the number of nodes per second on which pods are deleted in case of node failure when a zone is healthy.
+	// +optional
+	Rate *float64 `json:"rate,omitempty" protobuf:"fixed64,1,opt,name=rate"`
+	// SecondaryRate is the number of nodes per second on which pods are deleted in case of node failure when a zone is
+	// unhealthy. This value is implicitly overridden to 0 if the cluster size is smaller than `largeClusterSizeThreshold`.
+	// +optional
+	SecondaryRate *float64 `json:"secondaryRate,omitempty" protobuf:"fixed64,2,opt,name=secondaryRate"`
+	// LargeClusterSizeThreshold is the number of nodes from which the node lifecycle controller treats the cluster as
+	// large for the eviction logic purposes.
+	// +optional
+	LargeClusterSizeThreshold *int32 `json:"largeClusterSizeThreshold,omitempty" protobuf:"varint,3,opt,name=largeClusterSizeThreshold"`
+	// UnhealthyZoneThreshold is the fraction of nodes in a zone which needs to be not ready for the zone to be treated
+	// as unhealthy.
+	// +optional
+	UnhealthyZoneThreshold *float64 `json:"unhealthyZoneThreshold,omitempty" protobuf:"fixed64,4,opt,name=unhealthyZoneThreshold"`
 }
 
 // HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
@@ -1328,6 +1691,51 @@ type KubeSchedulerConfig struct {
 	// If not specified, the used profile is "balanced" (provides the default kube-scheduler behavior).
 	// +optional
 	Profile *SchedulingProfile `json:"profile,omitempty" protobuf:"bytes,3,opt,name=profile,casttype=SchedulingProfile"`
+	// Profiles contains additional scheduler profiles which are served by the kube-scheduler next to the profiles
+	// managed by Gardener. Pods can select a profile by setting `spec.schedulerName` to the name of the profile.
+	// +optional
+	Profiles []SchedulerProfile `json:"profiles,omitempty" protobuf:"bytes,4,rep,name=profiles"`
+}
+
+// SchedulerProfile is an additional scheduler profile of the kube-scheduler.
+// Note: The structure mirrors the `KubeSchedulerProfile` of the `KubeSchedulerConfiguration` API.
+type SchedulerProfile struct {
+	// SchedulerName is the name of the scheduler profile. It must not collide with the names of the profiles managed by
+	// Gardener, i.e., `default-scheduler` and `bin-packing-scheduler`.
+	SchedulerName string `json:"schedulerName" protobuf:"bytes,1,opt,name=schedulerName"`
+	// Plugins specifies the plugins that should be enabled or disabled in addition to the default plugins.
+	// +optional
+	Plugins *SchedulerPlugins `json:"plugins,omitempty" protobuf:"bytes,2,opt,name=plugins"`
+}
+
+// SchedulerPlugins contains the plugins which should be enabled or disabled per extension point.
+type SchedulerPlugins struct {
+	// Filter is a list of plugins that should be invoked when filtering out nodes that cannot run the Pod.
+	// +optional
+	Filter *SchedulerPluginSet `json:"filter,omitempty" protobuf:"bytes,1,opt,name=filter"`
+	// Score is a list of plugins that should be invoked when ranking nodes that have passed the filtering phase.
+	// +optional
+	Score *SchedulerPluginSet `json:"score,omitempty" protobuf:"bytes,2,opt,name=score"`
+}
+
+// SchedulerPluginSet specifies enabled and disabled plugins for an extension point.
+type SchedulerPluginSet struct {
+	// Enabled specifies plugins that should be enabled in addition to the default plugins. If a default plugin is also
+	// configured here, its weight is overridden.
+	// +optional
+	Enabled []SchedulerPlugin `json:"enabled,omitempty" protobuf:"bytes,1,rep,name=enabled"`
+	// Disabled specifies default plugins that should be disabled. All default plugins can be disabled by using `*`.
+	// +optional
+	Disabled []SchedulerPlugin `json:"disabled,omitempty" protobuf:"bytes,2,rep,name=disabled"`
+}
+
+// SchedulerPlugin specifies a plugin name and its weight.
+type SchedulerPlugin struct {
+	// Name is the name of the plugin.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Weight is the weight of the plugin. It is only applicable for score plugins.
+	// +optional
+	Weight *int32 `json:"weight,omitempty" protobuf:"varint,2,opt,name=weight"`
 }
 
 // SchedulingProfile is a string alias used for scheduling profile values.
@@ -1628,6 +2036,58 @@ type Networking struct {
 	// Defaults to ["IPv4"].
 	// +optional
 	IPFamilies []IPFamily `json:"ipFamilies,omitempty" protobuf:"bytes,6,rep,name=ipFamilies,casttype=IPFamily"`
+	// Egress contains configuration for the egress traffic of the shoot cluster. It is passed to the provider extensions
+	// which implement it on the respective infrastructure.
+	// +optional
+	Egress *Egress `json:"egress,omitempty" protobuf:"bytes,7,opt,name=egress"`
+}
+
+// Egress contains configuration for the egress traffic of the shoot cluster.
+type Egress struct {
+	// IPPools is a list of static egress IP pools which should be provisioned for the shoot cluster.
+	// +optional
+	IPPools []EgressIPPool `json:"ipPools,omitempty" protobuf:"bytes,1,rep,name=ipPools"`
+	// NamespaceSelections is a list of rules selecting the egress IP pool for the traffic of namespaces. Traffic of
+	// namespaces which are not selected by any rule leaves the cluster via the default egress path of the infrastructure.
+	// +optional
+	NamespaceSelections []EgressNamespaceSelection `json:"namespaceSelections,omitempty" protobuf:"bytes,2,rep,name=namespaceSelections"`
+	// Filter contains user-maintained exceptions for the egress filter of the shoot cluster. They are consumed by
+	// networking filter extensions via the Cluster resource.
+	// +optional
+	Filter *EgressFilter `json:"filter,omitempty" protobuf:"bytes,3,opt,name=filter"`
+}
+
+// EgressFilter contains user-maintained exceptions for the egress filter of the shoot cluster.
+type EgressFilter struct {
+	// AllowList is a list of CIDRs to which egress traffic is allowed even if they are blocked by the filter list of
+	// the egress filter.
+	// +optional
+	AllowList []string `json:"allowList,omitempty" protobuf:"bytes,1,rep,name=allowList"`
+	// DenyList is a list of CIDRs to which egress traffic is denied in addition to the filter list of the egress
+	// filter.
+	// +optional
+	DenyList []string `json:"denyList,omitempty" protobuf:"bytes,2,rep,name=denyList"`
+}
+
+// EgressIPPool is a pool of static egress IP addresses.
+type EgressIPPool struct {
+	// Name is the name of the pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Count is the number of static egress IP addresses which are allocated for the pool by the provider extension.
+	// Either Count or Addresses must be set.
+	// +optional
+	Count *int32 `json:"count,omitempty" protobuf:"varint,2,opt,name=count"`
+	// Addresses is a list of pre-allocated IP addresses the pool consists of. Either Count or Addresses must be set.
+	// +optional
+	Addresses []string `json:"addresses,omitempty" protobuf:"bytes,3,rep,name=addresses"`
+}
+
+// EgressNamespaceSelection selects the egress IP pool for the traffic of namespaces.
+type EgressNamespaceSelection struct {
+	// IPPool is the name of the egress IP pool.
+	IPPool string `json:"ipPool" protobuf:"bytes,1,opt,name=ipPool"`
+	// NamespaceSelector selects the namespaces whose egress traffic leaves the cluster via the egress IP pool.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector" protobuf:"bytes,2,opt,name=namespaceSelector"`
 }
 
 const (
@@ -1832,8 +2292,33 @@ type Worker struct {
 	// This is only relevant for self-hosted shoot clusters.
 	// +optional
 	ControlPlane *WorkerControlPlane `json:"controlPlane,omitempty" protobuf:"bytes,24,opt,name=controlPlane"`
+	// Accelerators contains the configuration for hardware accelerators (e.g., GPUs) attached to the machines of this
+	// worker pool.
+	// +optional
+	Accelerators *WorkerAccelerators `json:"accelerators,omitempty" protobuf:"bytes,25,opt,name=accelerators"`
+}
+
+// WorkerAccelerators contains the configuration for hardware accelerators attached to the machines of a worker pool.
+type WorkerAccelerators struct {
+	// Vendor is the vendor of the accelerators. Supported values are `nvidia` and `amd`.
+	Vendor AcceleratorVendor `json:"vendor" protobuf:"bytes,1,opt,name=vendor,casttype=AcceleratorVendor"`
+	// ManagedDriver specifies whether Gardener deploys and manages the driver and the device plugin for the accelerators
+	// on the nodes of this worker pool. The versions are selected based on the machine image of the worker pool.
+	// Defaults to `true`.
+	// +optional
+	ManagedDriver *bool `json:"managedDriver,omitempty" protobuf:"varint,2,opt,name=managedDriver"`
 }
 
+// AcceleratorVendor is the vendor of hardware accelerators.
+type AcceleratorVendor string
+
+const (
+	// AcceleratorVendorNVIDIA is the vendor for NVIDIA GPUs.
+	AcceleratorVendorNVIDIA AcceleratorVendor = "nvidia"
+	// AcceleratorVendorAMD is the vendor for AMD GPUs.
+	AcceleratorVendorAMD AcceleratorVendor = "amd"
+)
+
 // WorkerControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
 type WorkerControlPlane struct {
 	// Backup holds the object store configuration for the backups of shoot (currently only etcd).
@@ -1904,6 +2389,11 @@ type ClusterAutoscalerOptions struct {
 	// MaxNodeProvisionTime defines how long CA waits for node to be provisioned.
 	// +optional
 	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty" protobuf:"bytes,5,opt,name=maxNodeProvisionTime"`
+	// CapacityHints are the expected resources of the machines of this worker pool (e.g., extended resources like GPUs).
+	// They are propagated to the node template which is used by the cluster-autoscaler when scaling up the worker pool
+	// from zero and take precedence over the resources of the machine type in the CloudProfile.
+	// +optional
+	CapacityHints corev1.ResourceList `json:"capacityHints,omitempty" protobuf:"bytes,6,rep,name=capacityHints,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 }
 
 // MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.
@@ -2070,6 +2560,9 @@ type SystemComponents struct {
 	// NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 	// +optional
 	NodeLocalDNS *NodeLocalDNS `json:"nodeLocalDNS,omitempty" protobuf:"bytes,2,opt,name=nodeLocalDNS"`
+	// VolumeSnapshots contains the settings of the managed volume snapshots of user workloads in the Shoot cluster.
+	// +optional
+	VolumeSnapshots *VolumeSnapshots `json:"volumeSnapshots,omitempty" protobuf:"bytes,3,opt,name=volumeSnapshots"`
 }
 
 // CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
@@ -2124,9 +2617,73 @@ type NodeLocalDNS struct {
 	DisableForwardToUpstreamDNS *bool `json:"disableForwardToUpstreamDNS,omitempty" protobuf:"varint,4,opt,name=disableForwardToUpstreamDNS"`
 }
 
+// VolumeSnapshots contains the settings of the managed volume snapshots of user workloads in the Shoot cluster.
+type VolumeSnapshots struct {
+	// Classes is a list of VolumeSnapshotClasses which shall be created in the Shoot cluster.
+	// +optional
+	Classes []VolumeSnapshotClass `json:"classes,omitempty" protobuf:"bytes,1,rep,name=classes"`
+	// Policies is a list of policies for taking scheduled snapshots of PersistentVolumeClaims in the Shoot cluster.
+	// +optional
+	Policies []VolumeSnapshotPolicy `json:"policies,omitempty" protobuf:"bytes,2,rep,name=policies"`
+}
+
+// VolumeSnapshotClass contains the settings of a VolumeSnapshotClass in the Shoot cluster.
+type VolumeSnapshotClass struct {
+	// Name is the name of the VolumeSnapshotClass.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Driver is the name of the CSI driver which handles the snapshots of this class.
+	Driver string `json:"driver" protobuf:"bytes,2,opt,name=driver"`
+	// DeletionPolicy determines whether the snapshot in the storage backend is deleted together with the
+	// VolumeSnapshot. Possible values are 'Delete' and 'Retain'. Defaults to 'Delete'.
+	// +optional
+	DeletionPolicy *VolumeSnapshotDeletionPolicy `json:"deletionPolicy,omitempty" protobuf:"bytes,3,opt,name=deletionPolicy,casttype=VolumeSnapshotDeletionPolicy"`
+	// Default specifies whether this class is the default VolumeSnapshotClass of the CSI driver.
+	// +optional
+	Default *bool `json:"default,omitempty" protobuf:"varint,4,opt,name=default"`
+	// Parameters are driver-specific parameters of the VolumeSnapshotClass.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty" protobuf:"bytes,5,rep,name=parameters"`
+}
+
+// VolumeSnapshotDeletionPolicy is a type for the deletion policy of VolumeSnapshotClasses.
+type VolumeSnapshotDeletionPolicy string
+
+const (
+	// VolumeSnapshotDeletionPolicyDelete means that the snapshot in the storage backend is deleted together with the
+	// VolumeSnapshot.
+	VolumeSnapshotDeletionPolicyDelete VolumeSnapshotDeletionPolicy = "Delete"
+	// VolumeSnapshotDeletionPolicyRetain means that the snapshot in the storage backend is kept when the VolumeSnapshot
+	// is deleted.
+	VolumeSnapshotDeletionPolicyRetain VolumeSnapshotDeletionPolicy = "Retain"
+)
+
+// VolumeSnapshotPolicy contains the settings for taking scheduled snapshots of PersistentVolumeClaims.
+type VolumeSnapshotPolicy struct {
+	// Name is the name of the policy.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Schedule is the cron schedule (in UTC) at which the snapshots are taken.
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Namespace is the namespace of the PersistentVolumeClaims.
+	Namespace string `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
+	// Selector is a label selector for the PersistentVolumeClaims. If not specified, all PersistentVolumeClaims in the
+	// namespace are selected.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,4,opt,name=selector"`
+	// VolumeSnapshotClassName is the name of the VolumeSnapshotClass used for the snapshots. If not specified, the
+	// default class of the CSI driver is used.
+	// +optional
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty" protobuf:"bytes,5,opt,name=volumeSnapshotClassName"`
+	// Retention is the number of snapshots which are kept per PersistentVolumeClaim. Older snapshots are deleted.
+	// Defaults to 7.
+	// +optional
+	Retention *int32 `json:"retention,omitempty" protobuf:"varint,6,opt,name=retention"`
+}
+
 const (
 	// ShootMaintenanceFailed indicates that a shoot maintenance operation failed.
 	ShootMaintenanceFailed = "MaintenanceFailed"
+	// ShootMaintenanceDeferred indicates that a shoot maintenance operation was deferred due to a maintenance freeze.
+	ShootMaintenanceDeferred = "MaintenanceDeferred"
 	// ShootEventImageVersionMaintenance indicates that a maintenance operation regarding the image version has been performed.
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
 	// ShootEventK8sVersionMaintenance indicates that a maintenance operation regarding the K8s version has been performed.
@@ -2139,11 +2696,16 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventEgressFilterUpdated indicates that the egress filter exceptions of the shoot have been updated.
+	ShootEventEgressFilterUpdated = "EgressFilterUpdated"
 )
 
 const (
 	// ShootAPIServerAvailable is a constant for a condition type indicating that the Shoot cluster's API server is available.
 	ShootAPIServerAvailable ConditionType = "APIServerAvailable"
+	// ShootAPIServerAvailability is a constant for a condition type indicating whether the Shoot cluster's API server is
+	// reachable via its external endpoint from all configured vantage points.
+	ShootAPIServerAvailability ConditionType = "APIServerAvailability"
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the health of core control plane components.
 	ShootControlPlaneHealthy ConditionType = "ControlPlaneHealthy"
 	// ShootObservabilityComponentsHealthy is a constant for a condition type indicating the health of observability components.
@@ -2174,6 +2736,12 @@ const (
 	ShootDNSServiceMigrationReady ConditionType = "DNSServiceMigrationReady"
 	// ShootUsesUnifiedHTTPProxyPort is a constant for a condition type indicating whether the new http-proxy port is consumed from istio.
 	ShootUsesUnifiedHTTPProxyPort ConditionType = "UsesUnifiedHTTPProxyPort"
+	// ShootImageOverridesActive is a constant for a condition type indicating that images of control plane components
+	// are overridden via the `shoot.gardener.cloud/image-overrides` annotation.
+	ShootImageOverridesActive ConditionType = "ImageOverridesActive"
+	// ShootInfrastructureDrifted is a constant for a condition type indicating whether the infrastructure of the Shoot
+	// cluster deviates from its desired state.
+	ShootInfrastructureDrifted ConditionType = "InfrastructureDrifted"
 )
 
 // ShootPurpose is a type alias for string.
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootDebugPodRequest can be used by operators to request a time-boxed debug pod in the control plane namespace of a
+// Shoot cluster.
+type ShootDebugPodRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of the ShootDebugPodRequest.
+	Spec ShootDebugPodRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the ShootDebugPodRequest.
+	Status ShootDebugPodRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ShootDebugPodRequestSpec contains the specification of the requested debug pod.
+type ShootDebugPodRequestSpec struct {
+	// Reason is the justification for the debug pod. It is recorded in the Shoot status and on the debug pod.
+	Reason string `json:"reason" protobuf:"bytes,1,opt,name=reason"`
+	// ExpirationSeconds is the requested lifetime of the debug pod. The gardener-apiserver may cap the lifetime, so a
+	// client needs to check the 'expirationTimestamp' field in a response.
+	// Defaults to 1 hour.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty" protobuf:"varint,2,opt,name=expirationSeconds"`
+}
+
+// ShootDebugPodRequestStatus is the status of the ShootDebugPodRequest.
+type ShootDebugPodRequestStatus struct {
+	// PodName is the name of the debug pod.
+	PodName string `json:"podName" protobuf:"bytes,1,opt,name=podName"`
+	// Namespace is the control plane namespace of the Shoot in the seed cluster in which the debug pod is created.
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// ExpirationTimestamp is the time after which the debug pod is deleted.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp" protobuf:"bytes,3,opt,name=expirationTimestamp"`
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootFootprintRequest can be used to compute the estimated resource footprint of the control plane of a Shoot
+// cluster, optionally together with a provider-specific cost estimate.
+type ShootFootprintRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of the ShootFootprintRequest.
+	Spec ShootFootprintRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the ShootFootprintRequest.
+	Status ShootFootprintRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ShootFootprintRequestSpec contains the Shoot specification for which the footprint shall be computed.
+type ShootFootprintRequestSpec struct {
+	// Shoot is the specification of the Shoot for which the footprint shall be computed. This allows computing the
+	// footprint of a Shoot before it is created. If not set, the specification of the existing Shoot is used.
+	// +optional
+	Shoot *ShootSpec `json:"shoot,omitempty" protobuf:"bytes,1,opt,name=shoot"`
+}
+
+// ShootFootprintRequestStatus is the status of the ShootFootprintRequest containing the computed footprint.
+type ShootFootprintRequestStatus struct {
+	// Components contains the estimated resource footprint of the individual control plane components.
+	// +optional
+	Components []ControlPlaneComponentFootprint `json:"components,omitempty" protobuf:"bytes,1,rep,name=components"`
+	// Total is the sum of the resource requests of all control plane components.
+	// +optional
+	Total corev1.ResourceList `json:"total,omitempty" protobuf:"bytes,2,rep,name=total"`
+	// CostEstimate is an optional provider-specific cost estimate for the Shoot. It is not computed by the
+	// gardener-apiserver but can be filled by extensions via a mutating admission webhook for the shoots/footprint
+	// subresource.
+	// +optional
+	CostEstimate *ShootCostEstimate `json:"costEstimate,omitempty" protobuf:"bytes,3,opt,name=costEstimate"`
+}
+
+// ControlPlaneComponentFootprint contains the estimated resource footprint of a control plane component.
+type ControlPlaneComponentFootprint struct {
+	// Name is the name of the control plane component.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Replicas is the estimated number of replicas of the control plane component.
+	Replicas int32 `json:"replicas" protobuf:"varint,2,opt,name=replicas"`
+	// Requests are the estimated resource requests of all replicas of the control plane component.
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty" protobuf:"bytes,3,rep,name=requests"`
+}
+
+// ShootCostEstimate contains a provider-specific cost estimate for a Shoot.
+type ShootCostEstimate struct {
+	// Currency is the ISO 4217 code of the currency of the estimate.
+	Currency string `json:"currency" protobuf:"bytes,1,opt,name=currency"`
+	// MonthlyAmount is the estimated monthly cost as decimal number.
+	MonthlyAmount string `json:"monthlyAmount" protobuf:"bytes,2,opt,name=monthlyAmount"`
+	// Description is an optional human-readable description of the estimate, e.g., which resources are included.
+	// +optional
+	Description *string `json:"description,omitempty" protobuf:"bytes,3,opt,name=description"`
+}
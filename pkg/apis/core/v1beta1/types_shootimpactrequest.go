@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootImpactRequest can be used to predict the operations which would result from applying a proposed specification
+// to an existing Shoot cluster, e.g., node rollouts or restarts of control plane components.
+type ShootImpactRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of the ShootImpactRequest.
+	Spec ShootImpactRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the ShootImpactRequest.
+	Status ShootImpactRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ShootImpactRequestSpec contains the proposed Shoot specification.
+type ShootImpactRequestSpec struct {
+	// Shoot is the proposed specification of the Shoot. It is compared with the specification of the existing Shoot.
+	Shoot ShootSpec `json:"shoot" protobuf:"bytes,1,opt,name=shoot"`
+}
+
+// ShootImpactRequestStatus is the status of the ShootImpactRequest containing the predicted operations.
+type ShootImpactRequestStatus struct {
+	// Operations are the operations which would result from applying the proposed specification.
+	// +optional
+	Operations []ShootImpactOperation `json:"operations,omitempty" protobuf:"bytes,1,rep,name=operations"`
+}
+
+// ShootImpactOperation is an operation which would result from applying a proposed Shoot specification.
+type ShootImpactOperation struct {
+	// Type is the type of the operation.
+	Type ShootImpactOperationType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=ShootImpactOperationType"`
+	// Target is the name of the affected worker pool or control plane component.
+	Target string `json:"target" protobuf:"bytes,2,opt,name=target"`
+	// Reasons are the changes of the specification which cause the operation.
+	// +optional
+	Reasons []string `json:"reasons,omitempty" protobuf:"bytes,3,rep,name=reasons"`
+}
+
+// ShootImpactOperationType is a type for operations which would result from applying a proposed Shoot specification.
+type ShootImpactOperationType string
+
+const (
+	// ShootImpactOperationNodeRollout indicates that all nodes of a worker pool are replaced by new nodes.
+	ShootImpactOperationNodeRollout ShootImpactOperationType = "NodeRollout"
+	// ShootImpactOperationNodeInPlaceUpdate indicates that all nodes of a worker pool are updated in-place.
+	ShootImpactOperationNodeInPlaceUpdate ShootImpactOperationType = "NodeInPlaceUpdate"
+	// ShootImpactOperationWorkerPoolDeletion indicates that a worker pool and all of its nodes are deleted.
+	ShootImpactOperationWorkerPoolDeletion ShootImpactOperationType = "WorkerPoolDeletion"
+	// ShootImpactOperationComponentRestart indicates that all replicas of a control plane component are restarted.
+	ShootImpactOperationComponentRestart ShootImpactOperationType = "ComponentRestart"
+	// ShootImpactOperationETCDResize indicates that the number of members of the etcd clusters is changed.
+	ShootImpactOperationETCDResize ShootImpactOperationType = "ETCDResize"
+	// ShootImpactOperationHibernation indicates that the Shoot is hibernated or woken up.
+	ShootImpactOperationHibernation ShootImpactOperationType = "Hibernation"
+)
@@ -32,6 +32,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*APIServerAccessRestrictions)(nil), (*core.APIServerAccessRestrictions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIServerAccessRestrictions_To_core_APIServerAccessRestrictions(a.(*APIServerAccessRestrictions), b.(*core.APIServerAccessRestrictions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.APIServerAccessRestrictions)(nil), (*APIServerAccessRestrictions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_APIServerAccessRestrictions_To_v1beta1_APIServerAccessRestrictions(a.(*core.APIServerAccessRestrictions), b.(*APIServerAccessRestrictions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*APIServerLogging)(nil), (*core.APIServerLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_APIServerLogging_To_core_APIServerLogging(a.(*APIServerLogging), b.(*core.APIServerLogging), scope)
 	}); err != nil {
@@ -312,6 +322,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Cleanup)(nil), (*core.Cleanup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Cleanup_To_core_Cleanup(a.(*Cleanup), b.(*core.Cleanup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Cleanup)(nil), (*Cleanup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Cleanup_To_v1beta1_Cleanup(a.(*core.Cleanup), b.(*Cleanup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CleanupPolicy)(nil), (*core.CleanupPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CleanupPolicy_To_core_CleanupPolicy(a.(*CleanupPolicy), b.(*core.CleanupPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CleanupPolicy)(nil), (*CleanupPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CleanupPolicy_To_v1beta1_CleanupPolicy(a.(*core.CleanupPolicy), b.(*CleanupPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfile)(nil), (*core.CloudProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CloudProfile_To_core_CloudProfile(a.(*CloudProfile), b.(*core.CloudProfile), scope)
 	}); err != nil {
@@ -432,6 +462,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneComponentFootprint)(nil), (*core.ControlPlaneComponentFootprint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneComponentFootprint_To_core_ControlPlaneComponentFootprint(a.(*ControlPlaneComponentFootprint), b.(*core.ControlPlaneComponentFootprint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControlPlaneComponentFootprint)(nil), (*ControlPlaneComponentFootprint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControlPlaneComponentFootprint_To_v1beta1_ControlPlaneComponentFootprint(a.(*core.ControlPlaneComponentFootprint), b.(*ControlPlaneComponentFootprint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneSizeClass)(nil), (*core.ControlPlaneSizeClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneSizeClass_To_core_ControlPlaneSizeClass(a.(*ControlPlaneSizeClass), b.(*core.ControlPlaneSizeClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControlPlaneSizeClass)(nil), (*ControlPlaneSizeClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControlPlaneSizeClass_To_v1beta1_ControlPlaneSizeClass(a.(*core.ControlPlaneSizeClass), b.(*ControlPlaneSizeClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentCanaryRollout)(nil), (*core.ControllerDeploymentCanaryRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControllerDeploymentCanaryRollout_To_core_ControllerDeploymentCanaryRollout(a.(*ControllerDeploymentCanaryRollout), b.(*core.ControllerDeploymentCanaryRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerDeploymentCanaryRollout)(nil), (*ControllerDeploymentCanaryRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerDeploymentCanaryRollout_To_v1beta1_ControllerDeploymentCanaryRollout(a.(*core.ControllerDeploymentCanaryRollout), b.(*ControllerDeploymentCanaryRollout), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentList)(nil), (*core.ControllerDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerDeploymentList_To_core_ControllerDeploymentList(a.(*ControllerDeploymentList), b.(*core.ControllerDeploymentList), scope)
 	}); err != nil {
@@ -442,6 +502,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentRollout)(nil), (*core.ControllerDeploymentRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControllerDeploymentRollout_To_core_ControllerDeploymentRollout(a.(*ControllerDeploymentRollout), b.(*core.ControllerDeploymentRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerDeploymentRollout)(nil), (*ControllerDeploymentRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerDeploymentRollout_To_v1beta1_ControllerDeploymentRollout(a.(*core.ControllerDeploymentRollout), b.(*ControllerDeploymentRollout), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerInstallation)(nil), (*core.ControllerInstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerInstallation_To_core_ControllerInstallation(a.(*ControllerInstallation), b.(*core.ControllerInstallation), scope)
 	}); err != nil {
@@ -492,6 +562,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRegistrationDependency)(nil), (*core.ControllerRegistrationDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControllerRegistrationDependency_To_core_ControllerRegistrationDependency(a.(*ControllerRegistrationDependency), b.(*core.ControllerRegistrationDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRegistrationDependency)(nil), (*ControllerRegistrationDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRegistrationDependency_To_v1beta1_ControllerRegistrationDependency(a.(*core.ControllerRegistrationDependency), b.(*ControllerRegistrationDependency), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerRegistrationDeployment)(nil), (*core.ControllerRegistrationDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerRegistrationDeployment_To_core_ControllerRegistrationDeployment(a.(*ControllerRegistrationDeployment), b.(*core.ControllerRegistrationDeployment), scope)
 	}); err != nil {
@@ -542,6 +622,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerWorkersConfig)(nil), (*core.ControllerWorkersConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControllerWorkersConfig_To_core_ControllerWorkersConfig(a.(*ControllerWorkersConfig), b.(*core.ControllerWorkersConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerWorkersConfig)(nil), (*ControllerWorkersConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerWorkersConfig_To_v1beta1_ControllerWorkersConfig(a.(*core.ControllerWorkersConfig), b.(*ControllerWorkersConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CoreDNS)(nil), (*core.CoreDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CoreDNS_To_core_CoreDNS(a.(*CoreDNS), b.(*core.CoreDNS), scope)
 	}); err != nil {
@@ -582,6 +672,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSAPIServerRecord)(nil), (*core.DNSAPIServerRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DNSAPIServerRecord_To_core_DNSAPIServerRecord(a.(*DNSAPIServerRecord), b.(*core.DNSAPIServerRecord), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DNSAPIServerRecord)(nil), (*DNSAPIServerRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DNSAPIServerRecord_To_v1beta1_DNSAPIServerRecord(a.(*core.DNSAPIServerRecord), b.(*DNSAPIServerRecord), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNSExposure)(nil), (*core.DNSExposure)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNSExposure_To_core_DNSExposure(a.(*DNSExposure), b.(*core.DNSExposure), scope)
 	}); err != nil {
@@ -592,6 +692,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSFailover)(nil), (*core.DNSFailover)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DNSFailover_To_core_DNSFailover(a.(*DNSFailover), b.(*core.DNSFailover), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DNSFailover)(nil), (*DNSFailover)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DNSFailover_To_v1beta1_DNSFailover(a.(*core.DNSFailover), b.(*DNSFailover), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSHealthCheck)(nil), (*core.DNSHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DNSHealthCheck_To_core_DNSHealthCheck(a.(*DNSHealthCheck), b.(*core.DNSHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DNSHealthCheck)(nil), (*DNSHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DNSHealthCheck_To_v1beta1_DNSHealthCheck(a.(*core.DNSHealthCheck), b.(*DNSHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNSIncludeExclude)(nil), (*core.DNSIncludeExclude)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNSIncludeExclude_To_core_DNSIncludeExclude(a.(*DNSIncludeExclude), b.(*core.DNSIncludeExclude), scope)
 	}); err != nil {
@@ -612,6 +732,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordTTL)(nil), (*core.DNSRecordTTL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DNSRecordTTL_To_core_DNSRecordTTL(a.(*DNSRecordTTL), b.(*core.DNSRecordTTL), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DNSRecordTTL)(nil), (*DNSRecordTTL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DNSRecordTTL_To_v1beta1_DNSRecordTTL(a.(*core.DNSRecordTTL), b.(*DNSRecordTTL), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*core.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DataVolume_To_core_DataVolume(a.(*DataVolume), b.(*core.DataVolume), scope)
 	}); err != nil {
@@ -622,6 +752,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeferredShootMaintenance)(nil), (*core.DeferredShootMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DeferredShootMaintenance_To_core_DeferredShootMaintenance(a.(*DeferredShootMaintenance), b.(*core.DeferredShootMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeferredShootMaintenance)(nil), (*DeferredShootMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeferredShootMaintenance_To_v1beta1_DeferredShootMaintenance(a.(*core.DeferredShootMaintenance), b.(*DeferredShootMaintenance), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeploymentRef)(nil), (*core.DeploymentRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DeploymentRef_To_core_DeploymentRef(a.(*DeploymentRef), b.(*core.DeploymentRef), scope)
 	}); err != nil {
@@ -672,6 +812,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Egress)(nil), (*core.Egress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Egress_To_core_Egress(a.(*Egress), b.(*core.Egress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Egress)(nil), (*Egress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Egress_To_v1beta1_Egress(a.(*core.Egress), b.(*Egress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EgressFilter)(nil), (*core.EgressFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EgressFilter_To_core_EgressFilter(a.(*EgressFilter), b.(*core.EgressFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EgressFilter)(nil), (*EgressFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EgressFilter_To_v1beta1_EgressFilter(a.(*core.EgressFilter), b.(*EgressFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EgressIPPool)(nil), (*core.EgressIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EgressIPPool_To_core_EgressIPPool(a.(*EgressIPPool), b.(*core.EgressIPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EgressIPPool)(nil), (*EgressIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EgressIPPool_To_v1beta1_EgressIPPool(a.(*core.EgressIPPool), b.(*EgressIPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EgressNamespaceSelection)(nil), (*core.EgressNamespaceSelection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EgressNamespaceSelection_To_core_EgressNamespaceSelection(a.(*EgressNamespaceSelection), b.(*core.EgressNamespaceSelection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EgressNamespaceSelection)(nil), (*EgressNamespaceSelection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EgressNamespaceSelection_To_v1beta1_EgressNamespaceSelection(a.(*core.EgressNamespaceSelection), b.(*EgressNamespaceSelection), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EncryptionAtRest)(nil), (*core.EncryptionAtRest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_EncryptionAtRest_To_core_EncryptionAtRest(a.(*EncryptionAtRest), b.(*core.EncryptionAtRest), scope)
 	}); err != nil {
@@ -712,6 +892,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ErrorCodeDefinition)(nil), (*core.ErrorCodeDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ErrorCodeDefinition_To_core_ErrorCodeDefinition(a.(*ErrorCodeDefinition), b.(*core.ErrorCodeDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ErrorCodeDefinition)(nil), (*ErrorCodeDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ErrorCodeDefinition_To_v1beta1_ErrorCodeDefinition(a.(*core.ErrorCodeDefinition), b.(*ErrorCodeDefinition), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExpirableVersion)(nil), (*core.ExpirableVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(a.(*ExpirableVersion), b.(*core.ExpirableVersion), scope)
 	}); err != nil {
@@ -832,6 +1022,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HelmValuesOverlay)(nil), (*core.HelmValuesOverlay)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HelmValuesOverlay_To_core_HelmValuesOverlay(a.(*HelmValuesOverlay), b.(*core.HelmValuesOverlay), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HelmValuesOverlay)(nil), (*HelmValuesOverlay)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HelmValuesOverlay_To_v1beta1_HelmValuesOverlay(a.(*core.HelmValuesOverlay), b.(*HelmValuesOverlay), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Hibernation)(nil), (*core.Hibernation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Hibernation_To_core_Hibernation(a.(*Hibernation), b.(*core.Hibernation), scope)
 	}); err != nil {
@@ -912,6 +1112,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IngressWildcardCertificate)(nil), (*core.IngressWildcardCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IngressWildcardCertificate_To_core_IngressWildcardCertificate(a.(*IngressWildcardCertificate), b.(*core.IngressWildcardCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IngressWildcardCertificate)(nil), (*IngressWildcardCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IngressWildcardCertificate_To_v1beta1_IngressWildcardCertificate(a.(*core.IngressWildcardCertificate), b.(*IngressWildcardCertificate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*core.InternalSecret)(nil), (*InternalSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_core_InternalSecret_To_v1beta1_InternalSecret(a.(*core.InternalSecret), b.(*InternalSecret), scope)
 	}); err != nil {
@@ -1187,6 +1397,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachinePhaseCount)(nil), (*core.MachinePhaseCount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachinePhaseCount_To_core_MachinePhaseCount(a.(*MachinePhaseCount), b.(*core.MachinePhaseCount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachinePhaseCount)(nil), (*MachinePhaseCount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachinePhaseCount_To_v1beta1_MachinePhaseCount(a.(*core.MachinePhaseCount), b.(*MachinePhaseCount), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineType)(nil), (*core.MachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineType_To_core_MachineType(a.(*MachineType), b.(*core.MachineType), scope)
 	}); err != nil {
@@ -1247,6 +1467,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreeze)(nil), (*core.MaintenanceFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(a.(*MaintenanceFreeze), b.(*core.MaintenanceFreeze), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreeze)(nil), (*MaintenanceFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(a.(*core.MaintenanceFreeze), b.(*MaintenanceFreeze), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreezeList)(nil), (*core.MaintenanceFreezeList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreezeList_To_core_MaintenanceFreezeList(a.(*MaintenanceFreezeList), b.(*core.MaintenanceFreezeList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreezeList)(nil), (*MaintenanceFreezeList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreezeList_To_v1beta1_MaintenanceFreezeList(a.(*core.MaintenanceFreezeList), b.(*MaintenanceFreezeList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreezeSpec)(nil), (*core.MaintenanceFreezeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec(a.(*MaintenanceFreezeSpec), b.(*core.MaintenanceFreezeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreezeSpec)(nil), (*MaintenanceFreezeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec(a.(*core.MaintenanceFreezeSpec), b.(*MaintenanceFreezeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreezeStatus)(nil), (*core.MaintenanceFreezeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreezeStatus_To_core_MaintenanceFreezeStatus(a.(*MaintenanceFreezeStatus), b.(*core.MaintenanceFreezeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreezeStatus)(nil), (*MaintenanceFreezeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreezeStatus_To_v1beta1_MaintenanceFreezeStatus(a.(*core.MaintenanceFreezeStatus), b.(*MaintenanceFreezeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreezeWindow)(nil), (*core.MaintenanceFreezeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreezeWindow_To_core_MaintenanceFreezeWindow(a.(*MaintenanceFreezeWindow), b.(*core.MaintenanceFreezeWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreezeWindow)(nil), (*MaintenanceFreezeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreezeWindow_To_v1beta1_MaintenanceFreezeWindow(a.(*core.MaintenanceFreezeWindow), b.(*MaintenanceFreezeWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceRotationConfig)(nil), (*core.MaintenanceRotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceRotationConfig_To_core_MaintenanceRotationConfig(a.(*MaintenanceRotationConfig), b.(*core.MaintenanceRotationConfig), scope)
 	}); err != nil {
@@ -1267,6 +1537,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedAddon)(nil), (*core.ManagedAddon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedAddon_To_core_ManagedAddon(a.(*ManagedAddon), b.(*core.ManagedAddon), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ManagedAddon)(nil), (*ManagedAddon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ManagedAddon_To_v1beta1_ManagedAddon(a.(*core.ManagedAddon), b.(*ManagedAddon), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ManualWorkerPoolRollout)(nil), (*core.ManualWorkerPoolRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ManualWorkerPoolRollout_To_core_ManualWorkerPoolRollout(a.(*ManualWorkerPoolRollout), b.(*core.ManualWorkerPoolRollout), scope)
 	}); err != nil {
@@ -1377,6 +1657,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NginxIngressAutoscaling)(nil), (*core.NginxIngressAutoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NginxIngressAutoscaling_To_core_NginxIngressAutoscaling(a.(*NginxIngressAutoscaling), b.(*core.NginxIngressAutoscaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NginxIngressAutoscaling)(nil), (*NginxIngressAutoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NginxIngressAutoscaling_To_v1beta1_NginxIngressAutoscaling(a.(*core.NginxIngressAutoscaling), b.(*NginxIngressAutoscaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeEvictionConfig)(nil), (*core.NodeEvictionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NodeEvictionConfig_To_core_NodeEvictionConfig(a.(*NodeEvictionConfig), b.(*core.NodeEvictionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NodeEvictionConfig)(nil), (*NodeEvictionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NodeEvictionConfig_To_v1beta1_NodeEvictionConfig(a.(*core.NodeEvictionConfig), b.(*NodeEvictionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeLocalDNS)(nil), (*core.NodeLocalDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS(a.(*NodeLocalDNS), b.(*core.NodeLocalDNS), scope)
 	}); err != nil {
@@ -1467,6 +1767,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectShootDefaults)(nil), (*core.ProjectShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults(a.(*ProjectShootDefaults), b.(*core.ProjectShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectShootDefaults)(nil), (*ProjectShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults(a.(*core.ProjectShootDefaults), b.(*ProjectShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectShootHibernationDefaults)(nil), (*core.ProjectShootHibernationDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectShootHibernationDefaults_To_core_ProjectShootHibernationDefaults(a.(*ProjectShootHibernationDefaults), b.(*core.ProjectShootHibernationDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectShootHibernationDefaults)(nil), (*ProjectShootHibernationDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectShootHibernationDefaults_To_v1beta1_ProjectShootHibernationDefaults(a.(*core.ProjectShootHibernationDefaults), b.(*ProjectShootHibernationDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectShootMaintenanceDefaults)(nil), (*core.ProjectShootMaintenanceDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectShootMaintenanceDefaults_To_core_ProjectShootMaintenanceDefaults(a.(*ProjectShootMaintenanceDefaults), b.(*core.ProjectShootMaintenanceDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectShootMaintenanceDefaults)(nil), (*ProjectShootMaintenanceDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectShootMaintenanceDefaults_To_v1beta1_ProjectShootMaintenanceDefaults(a.(*core.ProjectShootMaintenanceDefaults), b.(*ProjectShootMaintenanceDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectShootWorkerDefaults)(nil), (*core.ProjectShootWorkerDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectShootWorkerDefaults_To_core_ProjectShootWorkerDefaults(a.(*ProjectShootWorkerDefaults), b.(*core.ProjectShootWorkerDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectShootWorkerDefaults)(nil), (*ProjectShootWorkerDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectShootWorkerDefaults_To_v1beta1_ProjectShootWorkerDefaults(a.(*core.ProjectShootWorkerDefaults), b.(*ProjectShootWorkerDefaults), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectStatus)(nil), (*core.ProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectStatus_To_core_ProjectStatus(a.(*ProjectStatus), b.(*core.ProjectStatus), scope)
 	}); err != nil {
@@ -1497,6 +1837,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProxyProtocolTLV)(nil), (*core.ProxyProtocolTLV)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProxyProtocolTLV_To_core_ProxyProtocolTLV(a.(*ProxyProtocolTLV), b.(*core.ProxyProtocolTLV), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProxyProtocolTLV)(nil), (*ProxyProtocolTLV)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProxyProtocolTLV_To_v1beta1_ProxyProtocolTLV(a.(*core.ProxyProtocolTLV), b.(*ProxyProtocolTLV), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Quota)(nil), (*core.Quota)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Quota_To_core_Quota(a.(*Quota), b.(*core.Quota), scope)
 	}); err != nil {
@@ -1567,6 +1917,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SchedulerPlugin)(nil), (*core.SchedulerPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SchedulerPlugin_To_core_SchedulerPlugin(a.(*SchedulerPlugin), b.(*core.SchedulerPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SchedulerPlugin)(nil), (*SchedulerPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SchedulerPlugin_To_v1beta1_SchedulerPlugin(a.(*core.SchedulerPlugin), b.(*SchedulerPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulerPluginSet)(nil), (*core.SchedulerPluginSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SchedulerPluginSet_To_core_SchedulerPluginSet(a.(*SchedulerPluginSet), b.(*core.SchedulerPluginSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SchedulerPluginSet)(nil), (*SchedulerPluginSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SchedulerPluginSet_To_v1beta1_SchedulerPluginSet(a.(*core.SchedulerPluginSet), b.(*SchedulerPluginSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulerPlugins)(nil), (*core.SchedulerPlugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SchedulerPlugins_To_core_SchedulerPlugins(a.(*SchedulerPlugins), b.(*core.SchedulerPlugins), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SchedulerPlugins)(nil), (*SchedulerPlugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SchedulerPlugins_To_v1beta1_SchedulerPlugins(a.(*core.SchedulerPlugins), b.(*SchedulerPlugins), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulerProfile)(nil), (*core.SchedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SchedulerProfile_To_core_SchedulerProfile(a.(*SchedulerProfile), b.(*core.SchedulerProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SchedulerProfile)(nil), (*SchedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SchedulerProfile_To_v1beta1_SchedulerProfile(a.(*core.SchedulerProfile), b.(*SchedulerProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretBinding)(nil), (*core.SecretBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SecretBinding_To_core_SecretBinding(a.(*SecretBinding), b.(*core.SecretBinding), scope)
 	}); err != nil {
@@ -1637,6 +2027,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedDrainStatus)(nil), (*core.SeedDrainStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(a.(*SeedDrainStatus), b.(*core.SeedDrainStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedDrainStatus)(nil), (*SeedDrainStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(a.(*core.SeedDrainStatus), b.(*SeedDrainStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedList)(nil), (*core.SeedList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedList_To_core_SeedList(a.(*SeedList), b.(*core.SeedList), scope)
 	}); err != nil {
@@ -1677,6 +2077,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingControlPlaneResourceQuota)(nil), (*core.SeedSettingControlPlaneResourceQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingControlPlaneResourceQuota_To_core_SeedSettingControlPlaneResourceQuota(a.(*SeedSettingControlPlaneResourceQuota), b.(*core.SeedSettingControlPlaneResourceQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingControlPlaneResourceQuota)(nil), (*SeedSettingControlPlaneResourceQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingControlPlaneResourceQuota_To_v1beta1_SeedSettingControlPlaneResourceQuota(a.(*core.SeedSettingControlPlaneResourceQuota), b.(*SeedSettingControlPlaneResourceQuota), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSettingDependencyWatchdog)(nil), (*core.SeedSettingDependencyWatchdog)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(a.(*SeedSettingDependencyWatchdog), b.(*core.SeedSettingDependencyWatchdog), scope)
 	}); err != nil {
@@ -1707,6 +2117,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingDrain)(nil), (*core.SeedSettingDrain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingDrain_To_core_SeedSettingDrain(a.(*SeedSettingDrain), b.(*core.SeedSettingDrain), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingDrain)(nil), (*SeedSettingDrain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingDrain_To_v1beta1_SeedSettingDrain(a.(*core.SeedSettingDrain), b.(*SeedSettingDrain), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSettingExcessCapacityReservation)(nil), (*core.SeedSettingExcessCapacityReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSettingExcessCapacityReservation_To_core_SeedSettingExcessCapacityReservation(a.(*SeedSettingExcessCapacityReservation), b.(*core.SeedSettingExcessCapacityReservation), scope)
 	}); err != nil {
@@ -1727,13 +2147,23 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SeedSettingLoadBalancerServices)(nil), (*core.SeedSettingLoadBalancerServices)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_SeedSettingLoadBalancerServices_To_core_SeedSettingLoadBalancerServices(a.(*SeedSettingLoadBalancerServices), b.(*core.SeedSettingLoadBalancerServices), scope)
+	if err := s.AddGeneratedConversionFunc((*SeedSettingIstioTLSTermination)(nil), (*core.SeedSettingIstioTLSTermination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingIstioTLSTermination_To_core_SeedSettingIstioTLSTermination(a.(*SeedSettingIstioTLSTermination), b.(*core.SeedSettingIstioTLSTermination), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.SeedSettingLoadBalancerServices)(nil), (*SeedSettingLoadBalancerServices)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_SeedSettingLoadBalancerServices_To_v1beta1_SeedSettingLoadBalancerServices(a.(*core.SeedSettingLoadBalancerServices), b.(*SeedSettingLoadBalancerServices), scope)
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingIstioTLSTermination)(nil), (*SeedSettingIstioTLSTermination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingIstioTLSTermination_To_v1beta1_SeedSettingIstioTLSTermination(a.(*core.SeedSettingIstioTLSTermination), b.(*SeedSettingIstioTLSTermination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingLoadBalancerServices)(nil), (*core.SeedSettingLoadBalancerServices)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingLoadBalancerServices_To_core_SeedSettingLoadBalancerServices(a.(*SeedSettingLoadBalancerServices), b.(*core.SeedSettingLoadBalancerServices), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingLoadBalancerServices)(nil), (*SeedSettingLoadBalancerServices)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingLoadBalancerServices_To_v1beta1_SeedSettingLoadBalancerServices(a.(*core.SeedSettingLoadBalancerServices), b.(*SeedSettingLoadBalancerServices), scope)
 	}); err != nil {
 		return err
 	}
@@ -1907,6 +2337,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootAvailabilityReport)(nil), (*core.ShootAvailabilityReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootAvailabilityReport_To_core_ShootAvailabilityReport(a.(*ShootAvailabilityReport), b.(*core.ShootAvailabilityReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootAvailabilityReport)(nil), (*ShootAvailabilityReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootAvailabilityReport_To_v1beta1_ShootAvailabilityReport(a.(*core.ShootAvailabilityReport), b.(*ShootAvailabilityReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCostEstimate)(nil), (*core.ShootCostEstimate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(a.(*ShootCostEstimate), b.(*core.ShootCostEstimate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootCostEstimate)(nil), (*ShootCostEstimate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(a.(*core.ShootCostEstimate), b.(*ShootCostEstimate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCredentials)(nil), (*core.ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(a.(*ShootCredentials), b.(*core.ShootCredentials), scope)
 	}); err != nil {
@@ -1927,6 +2377,126 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootDebugPod)(nil), (*core.ShootDebugPod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootDebugPod_To_core_ShootDebugPod(a.(*ShootDebugPod), b.(*core.ShootDebugPod), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootDebugPod)(nil), (*ShootDebugPod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootDebugPod_To_v1beta1_ShootDebugPod(a.(*core.ShootDebugPod), b.(*ShootDebugPod), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootDebugPodRequest)(nil), (*core.ShootDebugPodRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootDebugPodRequest_To_core_ShootDebugPodRequest(a.(*ShootDebugPodRequest), b.(*core.ShootDebugPodRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootDebugPodRequest)(nil), (*ShootDebugPodRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootDebugPodRequest_To_v1beta1_ShootDebugPodRequest(a.(*core.ShootDebugPodRequest), b.(*ShootDebugPodRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootDebugPodRequestSpec)(nil), (*core.ShootDebugPodRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootDebugPodRequestSpec_To_core_ShootDebugPodRequestSpec(a.(*ShootDebugPodRequestSpec), b.(*core.ShootDebugPodRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootDebugPodRequestSpec)(nil), (*ShootDebugPodRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootDebugPodRequestSpec_To_v1beta1_ShootDebugPodRequestSpec(a.(*core.ShootDebugPodRequestSpec), b.(*ShootDebugPodRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootDebugPodRequestStatus)(nil), (*core.ShootDebugPodRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootDebugPodRequestStatus_To_core_ShootDebugPodRequestStatus(a.(*ShootDebugPodRequestStatus), b.(*core.ShootDebugPodRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootDebugPodRequestStatus)(nil), (*ShootDebugPodRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootDebugPodRequestStatus_To_v1beta1_ShootDebugPodRequestStatus(a.(*core.ShootDebugPodRequestStatus), b.(*ShootDebugPodRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootFootprintRequest)(nil), (*core.ShootFootprintRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootFootprintRequest_To_core_ShootFootprintRequest(a.(*ShootFootprintRequest), b.(*core.ShootFootprintRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootFootprintRequest)(nil), (*ShootFootprintRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootFootprintRequest_To_v1beta1_ShootFootprintRequest(a.(*core.ShootFootprintRequest), b.(*ShootFootprintRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootFootprintRequestSpec)(nil), (*core.ShootFootprintRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootFootprintRequestSpec_To_core_ShootFootprintRequestSpec(a.(*ShootFootprintRequestSpec), b.(*core.ShootFootprintRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootFootprintRequestSpec)(nil), (*ShootFootprintRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootFootprintRequestSpec_To_v1beta1_ShootFootprintRequestSpec(a.(*core.ShootFootprintRequestSpec), b.(*ShootFootprintRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootFootprintRequestStatus)(nil), (*core.ShootFootprintRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootFootprintRequestStatus_To_core_ShootFootprintRequestStatus(a.(*ShootFootprintRequestStatus), b.(*core.ShootFootprintRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootFootprintRequestStatus)(nil), (*ShootFootprintRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootFootprintRequestStatus_To_v1beta1_ShootFootprintRequestStatus(a.(*core.ShootFootprintRequestStatus), b.(*ShootFootprintRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootImpactOperation)(nil), (*core.ShootImpactOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootImpactOperation_To_core_ShootImpactOperation(a.(*ShootImpactOperation), b.(*core.ShootImpactOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootImpactOperation)(nil), (*ShootImpactOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootImpactOperation_To_v1beta1_ShootImpactOperation(a.(*core.ShootImpactOperation), b.(*ShootImpactOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootImpactRequest)(nil), (*core.ShootImpactRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootImpactRequest_To_core_ShootImpactRequest(a.(*ShootImpactRequest), b.(*core.ShootImpactRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootImpactRequest)(nil), (*ShootImpactRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootImpactRequest_To_v1beta1_ShootImpactRequest(a.(*core.ShootImpactRequest), b.(*ShootImpactRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootImpactRequestSpec)(nil), (*core.ShootImpactRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootImpactRequestSpec_To_core_ShootImpactRequestSpec(a.(*ShootImpactRequestSpec), b.(*core.ShootImpactRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootImpactRequestSpec)(nil), (*ShootImpactRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootImpactRequestSpec_To_v1beta1_ShootImpactRequestSpec(a.(*core.ShootImpactRequestSpec), b.(*ShootImpactRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootImpactRequestStatus)(nil), (*core.ShootImpactRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootImpactRequestStatus_To_core_ShootImpactRequestStatus(a.(*ShootImpactRequestStatus), b.(*core.ShootImpactRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootImpactRequestStatus)(nil), (*ShootImpactRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootImpactRequestStatus_To_v1beta1_ShootImpactRequestStatus(a.(*core.ShootImpactRequestStatus), b.(*ShootImpactRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootInventory)(nil), (*core.ShootInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootInventory_To_core_ShootInventory(a.(*ShootInventory), b.(*core.ShootInventory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootInventory)(nil), (*ShootInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootInventory_To_v1beta1_ShootInventory(a.(*core.ShootInventory), b.(*ShootInventory), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootKubeconfigRotation)(nil), (*core.ShootKubeconfigRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(a.(*ShootKubeconfigRotation), b.(*core.ShootKubeconfigRotation), scope)
 	}); err != nil {
@@ -1967,6 +2537,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootReconciliationTimestamps)(nil), (*core.ShootReconciliationTimestamps)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootReconciliationTimestamps_To_core_ShootReconciliationTimestamps(a.(*ShootReconciliationTimestamps), b.(*core.ShootReconciliationTimestamps), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootReconciliationTimestamps)(nil), (*ShootReconciliationTimestamps)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootReconciliationTimestamps_To_v1beta1_ShootReconciliationTimestamps(a.(*core.ShootReconciliationTimestamps), b.(*ShootReconciliationTimestamps), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootSSHKeypairRotation)(nil), (*core.ShootSSHKeypairRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(a.(*ShootSSHKeypairRotation), b.(*core.ShootSSHKeypairRotation), scope)
 	}); err != nil {
@@ -2037,6 +2617,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootValidationRule)(nil), (*core.ShootValidationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootValidationRule_To_core_ShootValidationRule(a.(*ShootValidationRule), b.(*core.ShootValidationRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootValidationRule)(nil), (*ShootValidationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootValidationRule_To_v1beta1_ShootValidationRule(a.(*core.ShootValidationRule), b.(*ShootValidationRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StructuredAuthentication)(nil), (*core.StructuredAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(a.(*StructuredAuthentication), b.(*core.StructuredAuthentication), scope)
 	}); err != nil {
@@ -2077,6 +2667,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VersionClassificationTransitions)(nil), (*core.VersionClassificationTransitions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionClassificationTransitions_To_core_VersionClassificationTransitions(a.(*VersionClassificationTransitions), b.(*core.VersionClassificationTransitions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionClassificationTransitions)(nil), (*VersionClassificationTransitions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionClassificationTransitions_To_v1beta1_VersionClassificationTransitions(a.(*core.VersionClassificationTransitions), b.(*VersionClassificationTransitions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionLifecyclePolicy)(nil), (*core.VersionLifecyclePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionLifecyclePolicy_To_core_VersionLifecyclePolicy(a.(*VersionLifecyclePolicy), b.(*core.VersionLifecyclePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionLifecyclePolicy)(nil), (*VersionLifecyclePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionLifecyclePolicy_To_v1beta1_VersionLifecyclePolicy(a.(*core.VersionLifecyclePolicy), b.(*VersionLifecyclePolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscaler)(nil), (*core.VerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(a.(*VerticalPodAutoscaler), b.(*core.VerticalPodAutoscaler), scope)
 	}); err != nil {
@@ -2097,6 +2707,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VolumeSnapshotClass)(nil), (*core.VolumeSnapshotClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VolumeSnapshotClass_To_core_VolumeSnapshotClass(a.(*VolumeSnapshotClass), b.(*core.VolumeSnapshotClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VolumeSnapshotClass)(nil), (*VolumeSnapshotClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VolumeSnapshotClass_To_v1beta1_VolumeSnapshotClass(a.(*core.VolumeSnapshotClass), b.(*VolumeSnapshotClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeSnapshotPolicy)(nil), (*core.VolumeSnapshotPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VolumeSnapshotPolicy_To_core_VolumeSnapshotPolicy(a.(*VolumeSnapshotPolicy), b.(*core.VolumeSnapshotPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VolumeSnapshotPolicy)(nil), (*VolumeSnapshotPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VolumeSnapshotPolicy_To_v1beta1_VolumeSnapshotPolicy(a.(*core.VolumeSnapshotPolicy), b.(*VolumeSnapshotPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeSnapshots)(nil), (*core.VolumeSnapshots)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VolumeSnapshots_To_core_VolumeSnapshots(a.(*VolumeSnapshots), b.(*core.VolumeSnapshots), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VolumeSnapshots)(nil), (*VolumeSnapshots)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VolumeSnapshots_To_v1beta1_VolumeSnapshots(a.(*core.VolumeSnapshots), b.(*VolumeSnapshots), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VolumeType)(nil), (*core.VolumeType)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VolumeType_To_core_VolumeType(a.(*VolumeType), b.(*core.VolumeType), scope)
 	}); err != nil {
@@ -2127,6 +2767,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerAccelerators)(nil), (*core.WorkerAccelerators)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerAccelerators_To_core_WorkerAccelerators(a.(*WorkerAccelerators), b.(*core.WorkerAccelerators), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerAccelerators)(nil), (*WorkerAccelerators)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerAccelerators_To_v1beta1_WorkerAccelerators(a.(*core.WorkerAccelerators), b.(*WorkerAccelerators), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerControlPlane)(nil), (*core.WorkerControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerControlPlane_To_core_WorkerControlPlane(a.(*WorkerControlPlane), b.(*core.WorkerControlPlane), scope)
 	}); err != nil {
@@ -2147,6 +2797,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolInventory)(nil), (*core.WorkerPoolInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolInventory_To_core_WorkerPoolInventory(a.(*WorkerPoolInventory), b.(*core.WorkerPoolInventory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolInventory)(nil), (*WorkerPoolInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolInventory_To_v1beta1_WorkerPoolInventory(a.(*core.WorkerPoolInventory), b.(*WorkerPoolInventory), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
 	}); err != nil {
@@ -2167,6 +2827,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadDefaults)(nil), (*core.WorkloadDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkloadDefaults_To_core_WorkloadDefaults(a.(*WorkloadDefaults), b.(*core.WorkloadDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkloadDefaults)(nil), (*WorkloadDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkloadDefaults_To_v1beta1_WorkloadDefaults(a.(*core.WorkloadDefaults), b.(*WorkloadDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneInventory)(nil), (*core.ZoneInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ZoneInventory_To_core_ZoneInventory(a.(*ZoneInventory), b.(*core.ZoneInventory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ZoneInventory)(nil), (*ZoneInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ZoneInventory_To_v1beta1_ZoneInventory(a.(*core.ZoneInventory), b.(*ZoneInventory), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*core.ControllerDeployment)(nil), (*ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_core_ControllerDeployment_To_v1beta1_ControllerDeployment(a.(*core.ControllerDeployment), b.(*ControllerDeployment), scope)
 	}); err != nil {
@@ -2215,6 +2895,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1beta1_APIServerAccessRestrictions_To_core_APIServerAccessRestrictions(in *APIServerAccessRestrictions, out *core.APIServerAccessRestrictions, s conversion.Scope) error {
+	out.SourceRanges = *(*[]string)(unsafe.Pointer(&in.SourceRanges))
+	return nil
+}
+
+// Convert_v1beta1_APIServerAccessRestrictions_To_core_APIServerAccessRestrictions is an autogenerated conversion function.
+func Convert_v1beta1_APIServerAccessRestrictions_To_core_APIServerAccessRestrictions(in *APIServerAccessRestrictions, out *core.APIServerAccessRestrictions, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIServerAccessRestrictions_To_core_APIServerAccessRestrictions(in, out, s)
+}
+
+func autoConvert_core_APIServerAccessRestrictions_To_v1beta1_APIServerAccessRestrictions(in *core.APIServerAccessRestrictions, out *APIServerAccessRestrictions, s conversion.Scope) error {
+	out.SourceRanges = *(*[]string)(unsafe.Pointer(&in.SourceRanges))
+	return nil
+}
+
+// Convert_core_APIServerAccessRestrictions_To_v1beta1_APIServerAccessRestrictions is an autogenerated conversion function.
+func Convert_core_APIServerAccessRestrictions_To_v1beta1_APIServerAccessRestrictions(in *core.APIServerAccessRestrictions, out *APIServerAccessRestrictions, s conversion.Scope) error {
+	return autoConvert_core_APIServerAccessRestrictions_To_v1beta1_APIServerAccessRestrictions(in, out, s)
+}
+
 func autoConvert_v1beta1_APIServerLogging_To_core_APIServerLogging(in *APIServerLogging, out *core.APIServerLogging, s conversion.Scope) error {
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	out.HTTPAccessVerbosity = (*int32)(unsafe.Pointer(in.HTTPAccessVerbosity))
@@ -2885,6 +3585,54 @@ func Convert_core_CapabilityDefinition_To_v1beta1_CapabilityDefinition(in *core.
 	return autoConvert_core_CapabilityDefinition_To_v1beta1_CapabilityDefinition(in, out, s)
 }
 
+func autoConvert_v1beta1_Cleanup_To_core_Cleanup(in *Cleanup, out *core.Cleanup, s conversion.Scope) error {
+	out.Webhooks = (*core.CleanupPolicy)(unsafe.Pointer(in.Webhooks))
+	out.ExtendedAPIs = (*core.CleanupPolicy)(unsafe.Pointer(in.ExtendedAPIs))
+	out.KubernetesResources = (*core.CleanupPolicy)(unsafe.Pointer(in.KubernetesResources))
+	out.VolumeSnapshots = (*core.CleanupPolicy)(unsafe.Pointer(in.VolumeSnapshots))
+	return nil
+}
+
+// Convert_v1beta1_Cleanup_To_core_Cleanup is an autogenerated conversion function.
+func Convert_v1beta1_Cleanup_To_core_Cleanup(in *Cleanup, out *core.Cleanup, s conversion.Scope) error {
+	return autoConvert_v1beta1_Cleanup_To_core_Cleanup(in, out, s)
+}
+
+func autoConvert_core_Cleanup_To_v1beta1_Cleanup(in *core.Cleanup, out *Cleanup, s conversion.Scope) error {
+	out.Webhooks = (*CleanupPolicy)(unsafe.Pointer(in.Webhooks))
+	out.ExtendedAPIs = (*CleanupPolicy)(unsafe.Pointer(in.ExtendedAPIs))
+	out.KubernetesResources = (*CleanupPolicy)(unsafe.Pointer(in.KubernetesResources))
+	out.VolumeSnapshots = (*CleanupPolicy)(unsafe.Pointer(in.VolumeSnapshots))
+	return nil
+}
+
+// Convert_core_Cleanup_To_v1beta1_Cleanup is an autogenerated conversion function.
+func Convert_core_Cleanup_To_v1beta1_Cleanup(in *core.Cleanup, out *Cleanup, s conversion.Scope) error {
+	return autoConvert_core_Cleanup_To_v1beta1_Cleanup(in, out, s)
+}
+
+func autoConvert_v1beta1_CleanupPolicy_To_core_CleanupPolicy(in *CleanupPolicy, out *core.CleanupPolicy, s conversion.Scope) error {
+	out.FinalizeGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.FinalizeGracePeriod))
+	out.ForceFinalize = *(*[]metav1.GroupKind)(unsafe.Pointer(&in.ForceFinalize))
+	return nil
+}
+
+// Convert_v1beta1_CleanupPolicy_To_core_CleanupPolicy is an autogenerated conversion function.
+func Convert_v1beta1_CleanupPolicy_To_core_CleanupPolicy(in *CleanupPolicy, out *core.CleanupPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_CleanupPolicy_To_core_CleanupPolicy(in, out, s)
+}
+
+func autoConvert_core_CleanupPolicy_To_v1beta1_CleanupPolicy(in *core.CleanupPolicy, out *CleanupPolicy, s conversion.Scope) error {
+	out.FinalizeGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.FinalizeGracePeriod))
+	out.ForceFinalize = *(*[]metav1.GroupKind)(unsafe.Pointer(&in.ForceFinalize))
+	return nil
+}
+
+// Convert_core_CleanupPolicy_To_v1beta1_CleanupPolicy is an autogenerated conversion function.
+func Convert_core_CleanupPolicy_To_v1beta1_CleanupPolicy(in *core.CleanupPolicy, out *CleanupPolicy, s conversion.Scope) error {
+	return autoConvert_core_CleanupPolicy_To_v1beta1_CleanupPolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_CloudProfile_To_core_CloudProfile(in *CloudProfile, out *core.CloudProfile, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2996,6 +3744,8 @@ func autoConvert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(in *CloudProf
 	out.Bastion = (*core.Bastion)(unsafe.Pointer(in.Bastion))
 	out.Limits = (*core.Limits)(unsafe.Pointer(in.Limits))
 	out.MachineCapabilities = *(*[]core.CapabilityDefinition)(unsafe.Pointer(&in.MachineCapabilities))
+	out.ShootValidationRules = *(*[]core.ShootValidationRule)(unsafe.Pointer(&in.ShootValidationRules))
+	out.VersionLifecyclePolicy = (*core.VersionLifecyclePolicy)(unsafe.Pointer(in.VersionLifecyclePolicy))
 	return nil
 }
 
@@ -3019,6 +3769,8 @@ func autoConvert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.Clou
 	out.Bastion = (*Bastion)(unsafe.Pointer(in.Bastion))
 	out.Limits = (*Limits)(unsafe.Pointer(in.Limits))
 	out.MachineCapabilities = *(*[]CapabilityDefinition)(unsafe.Pointer(&in.MachineCapabilities))
+	out.ShootValidationRules = *(*[]ShootValidationRule)(unsafe.Pointer(&in.ShootValidationRules))
+	out.VersionLifecyclePolicy = (*VersionLifecyclePolicy)(unsafe.Pointer(in.VersionLifecyclePolicy))
 	return nil
 }
 
@@ -3115,6 +3867,7 @@ func autoConvert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptio
 	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
 	out.ScaleDownUnreadyTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
 	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.CapacityHints = *(*v1.ResourceList)(unsafe.Pointer(&in.CapacityHints))
 	return nil
 }
 
@@ -3129,6 +3882,7 @@ func autoConvert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptio
 	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
 	out.ScaleDownUnreadyTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
 	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.CapacityHints = *(*v1.ResourceList)(unsafe.Pointer(&in.CapacityHints))
 	return nil
 }
 
@@ -3231,6 +3985,58 @@ func Convert_core_ControlPlaneAutoscaling_To_v1beta1_ControlPlaneAutoscaling(in
 	return autoConvert_core_ControlPlaneAutoscaling_To_v1beta1_ControlPlaneAutoscaling(in, out, s)
 }
 
+func autoConvert_v1beta1_ControlPlaneComponentFootprint_To_core_ControlPlaneComponentFootprint(in *ControlPlaneComponentFootprint, out *core.ControlPlaneComponentFootprint, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Replicas = in.Replicas
+	out.Requests = *(*v1.ResourceList)(unsafe.Pointer(&in.Requests))
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneComponentFootprint_To_core_ControlPlaneComponentFootprint is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneComponentFootprint_To_core_ControlPlaneComponentFootprint(in *ControlPlaneComponentFootprint, out *core.ControlPlaneComponentFootprint, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneComponentFootprint_To_core_ControlPlaneComponentFootprint(in, out, s)
+}
+
+func autoConvert_core_ControlPlaneComponentFootprint_To_v1beta1_ControlPlaneComponentFootprint(in *core.ControlPlaneComponentFootprint, out *ControlPlaneComponentFootprint, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Replicas = in.Replicas
+	out.Requests = *(*v1.ResourceList)(unsafe.Pointer(&in.Requests))
+	return nil
+}
+
+// Convert_core_ControlPlaneComponentFootprint_To_v1beta1_ControlPlaneComponentFootprint is an autogenerated conversion function.
+func Convert_core_ControlPlaneComponentFootprint_To_v1beta1_ControlPlaneComponentFootprint(in *core.ControlPlaneComponentFootprint, out *ControlPlaneComponentFootprint, s conversion.Scope) error {
+	return autoConvert_core_ControlPlaneComponentFootprint_To_v1beta1_ControlPlaneComponentFootprint(in, out, s)
+}
+
+func autoConvert_v1beta1_ControlPlaneSizeClass_To_core_ControlPlaneSizeClass(in *ControlPlaneSizeClass, out *core.ControlPlaneSizeClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MaxNodes = (*int32)(unsafe.Pointer(in.MaxNodes))
+	out.Quota = *(*v1.ResourceList)(unsafe.Pointer(&in.Quota))
+	out.ContainerMax = *(*v1.ResourceList)(unsafe.Pointer(&in.ContainerMax))
+	out.ContainerDefaultRequest = *(*v1.ResourceList)(unsafe.Pointer(&in.ContainerDefaultRequest))
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneSizeClass_To_core_ControlPlaneSizeClass is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneSizeClass_To_core_ControlPlaneSizeClass(in *ControlPlaneSizeClass, out *core.ControlPlaneSizeClass, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneSizeClass_To_core_ControlPlaneSizeClass(in, out, s)
+}
+
+func autoConvert_core_ControlPlaneSizeClass_To_v1beta1_ControlPlaneSizeClass(in *core.ControlPlaneSizeClass, out *ControlPlaneSizeClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MaxNodes = (*int32)(unsafe.Pointer(in.MaxNodes))
+	out.Quota = *(*v1.ResourceList)(unsafe.Pointer(&in.Quota))
+	out.ContainerMax = *(*v1.ResourceList)(unsafe.Pointer(&in.ContainerMax))
+	out.ContainerDefaultRequest = *(*v1.ResourceList)(unsafe.Pointer(&in.ContainerDefaultRequest))
+	return nil
+}
+
+// Convert_core_ControlPlaneSizeClass_To_v1beta1_ControlPlaneSizeClass is an autogenerated conversion function.
+func Convert_core_ControlPlaneSizeClass_To_v1beta1_ControlPlaneSizeClass(in *core.ControlPlaneSizeClass, out *ControlPlaneSizeClass, s conversion.Scope) error {
+	return autoConvert_core_ControlPlaneSizeClass_To_v1beta1_ControlPlaneSizeClass(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Type = in.Type
@@ -3252,6 +4058,30 @@ func autoConvert_core_ControllerDeployment_To_v1beta1_ControllerDeployment(in *c
 	return nil
 }
 
+func autoConvert_v1beta1_ControllerDeploymentCanaryRollout_To_core_ControllerDeploymentCanaryRollout(in *ControllerDeploymentCanaryRollout, out *core.ControllerDeploymentCanaryRollout, s conversion.Scope) error {
+	out.SeedSelector = in.SeedSelector
+	out.VerificationDuration = (*metav1.Duration)(unsafe.Pointer(in.VerificationDuration))
+	out.MinShootReconcileSuccessRate = (*int32)(unsafe.Pointer(in.MinShootReconcileSuccessRate))
+	return nil
+}
+
+// Convert_v1beta1_ControllerDeploymentCanaryRollout_To_core_ControllerDeploymentCanaryRollout is an autogenerated conversion function.
+func Convert_v1beta1_ControllerDeploymentCanaryRollout_To_core_ControllerDeploymentCanaryRollout(in *ControllerDeploymentCanaryRollout, out *core.ControllerDeploymentCanaryRollout, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControllerDeploymentCanaryRollout_To_core_ControllerDeploymentCanaryRollout(in, out, s)
+}
+
+func autoConvert_core_ControllerDeploymentCanaryRollout_To_v1beta1_ControllerDeploymentCanaryRollout(in *core.ControllerDeploymentCanaryRollout, out *ControllerDeploymentCanaryRollout, s conversion.Scope) error {
+	out.SeedSelector = in.SeedSelector
+	out.VerificationDuration = (*metav1.Duration)(unsafe.Pointer(in.VerificationDuration))
+	out.MinShootReconcileSuccessRate = (*int32)(unsafe.Pointer(in.MinShootReconcileSuccessRate))
+	return nil
+}
+
+// Convert_core_ControllerDeploymentCanaryRollout_To_v1beta1_ControllerDeploymentCanaryRollout is an autogenerated conversion function.
+func Convert_core_ControllerDeploymentCanaryRollout_To_v1beta1_ControllerDeploymentCanaryRollout(in *core.ControllerDeploymentCanaryRollout, out *ControllerDeploymentCanaryRollout, s conversion.Scope) error {
+	return autoConvert_core_ControllerDeploymentCanaryRollout_To_v1beta1_ControllerDeploymentCanaryRollout(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerDeploymentList_To_core_ControllerDeploymentList(in *ControllerDeploymentList, out *core.ControllerDeploymentList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -3294,6 +4124,26 @@ func Convert_core_ControllerDeploymentList_To_v1beta1_ControllerDeploymentList(i
 	return autoConvert_core_ControllerDeploymentList_To_v1beta1_ControllerDeploymentList(in, out, s)
 }
 
+func autoConvert_v1beta1_ControllerDeploymentRollout_To_core_ControllerDeploymentRollout(in *ControllerDeploymentRollout, out *core.ControllerDeploymentRollout, s conversion.Scope) error {
+	out.Canary = (*core.ControllerDeploymentCanaryRollout)(unsafe.Pointer(in.Canary))
+	return nil
+}
+
+// Convert_v1beta1_ControllerDeploymentRollout_To_core_ControllerDeploymentRollout is an autogenerated conversion function.
+func Convert_v1beta1_ControllerDeploymentRollout_To_core_ControllerDeploymentRollout(in *ControllerDeploymentRollout, out *core.ControllerDeploymentRollout, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControllerDeploymentRollout_To_core_ControllerDeploymentRollout(in, out, s)
+}
+
+func autoConvert_core_ControllerDeploymentRollout_To_v1beta1_ControllerDeploymentRollout(in *core.ControllerDeploymentRollout, out *ControllerDeploymentRollout, s conversion.Scope) error {
+	out.Canary = (*ControllerDeploymentCanaryRollout)(unsafe.Pointer(in.Canary))
+	return nil
+}
+
+// Convert_core_ControllerDeploymentRollout_To_v1beta1_ControllerDeploymentRollout is an autogenerated conversion function.
+func Convert_core_ControllerDeploymentRollout_To_v1beta1_ControllerDeploymentRollout(in *core.ControllerDeploymentRollout, out *ControllerDeploymentRollout, s conversion.Scope) error {
+	return autoConvert_core_ControllerDeploymentRollout_To_v1beta1_ControllerDeploymentRollout(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerInstallation_To_core_ControllerInstallation(in *ControllerInstallation, out *core.ControllerInstallation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ControllerInstallationSpec_To_core_ControllerInstallationSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -3422,10 +4272,31 @@ func Convert_core_ControllerRegistration_To_v1beta1_ControllerRegistration(in *c
 	return autoConvert_core_ControllerRegistration_To_v1beta1_ControllerRegistration(in, out, s)
 }
 
+func autoConvert_v1beta1_ControllerRegistrationDependency_To_core_ControllerRegistrationDependency(in *ControllerRegistrationDependency, out *core.ControllerRegistrationDependency, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_ControllerRegistrationDependency_To_core_ControllerRegistrationDependency is an autogenerated conversion function.
+func Convert_v1beta1_ControllerRegistrationDependency_To_core_ControllerRegistrationDependency(in *ControllerRegistrationDependency, out *core.ControllerRegistrationDependency, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControllerRegistrationDependency_To_core_ControllerRegistrationDependency(in, out, s)
+}
+
+func autoConvert_core_ControllerRegistrationDependency_To_v1beta1_ControllerRegistrationDependency(in *core.ControllerRegistrationDependency, out *ControllerRegistrationDependency, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_core_ControllerRegistrationDependency_To_v1beta1_ControllerRegistrationDependency is an autogenerated conversion function.
+func Convert_core_ControllerRegistrationDependency_To_v1beta1_ControllerRegistrationDependency(in *core.ControllerRegistrationDependency, out *ControllerRegistrationDependency, s conversion.Scope) error {
+	return autoConvert_core_ControllerRegistrationDependency_To_v1beta1_ControllerRegistrationDependency(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerRegistrationDeployment_To_core_ControllerRegistrationDeployment(in *ControllerRegistrationDeployment, out *core.ControllerRegistrationDeployment, s conversion.Scope) error {
 	out.Policy = (*core.ControllerDeploymentPolicy)(unsafe.Pointer(in.Policy))
 	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.DeploymentRefs = *(*[]core.DeploymentRef)(unsafe.Pointer(&in.DeploymentRefs))
+	out.Rollout = (*core.ControllerDeploymentRollout)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -3438,6 +4309,7 @@ func autoConvert_core_ControllerRegistrationDeployment_To_v1beta1_ControllerRegi
 	out.Policy = (*ControllerDeploymentPolicy)(unsafe.Pointer(in.Policy))
 	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.DeploymentRefs = *(*[]DeploymentRef)(unsafe.Pointer(&in.DeploymentRefs))
+	out.Rollout = (*ControllerDeploymentRollout)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -3471,6 +4343,8 @@ func Convert_core_ControllerRegistrationList_To_v1beta1_ControllerRegistrationLi
 func autoConvert_v1beta1_ControllerRegistrationSpec_To_core_ControllerRegistrationSpec(in *ControllerRegistrationSpec, out *core.ControllerRegistrationSpec, s conversion.Scope) error {
 	out.Resources = *(*[]core.ControllerResource)(unsafe.Pointer(&in.Resources))
 	out.Deployment = (*core.ControllerRegistrationDeployment)(unsafe.Pointer(in.Deployment))
+	out.ErrorCodes = *(*[]core.ErrorCodeDefinition)(unsafe.Pointer(&in.ErrorCodes))
+	out.Dependencies = *(*[]core.ControllerRegistrationDependency)(unsafe.Pointer(&in.Dependencies))
 	return nil
 }
 
@@ -3482,6 +4356,8 @@ func Convert_v1beta1_ControllerRegistrationSpec_To_core_ControllerRegistrationSp
 func autoConvert_core_ControllerRegistrationSpec_To_v1beta1_ControllerRegistrationSpec(in *core.ControllerRegistrationSpec, out *ControllerRegistrationSpec, s conversion.Scope) error {
 	out.Resources = *(*[]ControllerResource)(unsafe.Pointer(&in.Resources))
 	out.Deployment = (*ControllerRegistrationDeployment)(unsafe.Pointer(in.Deployment))
+	out.ErrorCodes = *(*[]ErrorCodeDefinition)(unsafe.Pointer(&in.ErrorCodes))
+	out.Dependencies = *(*[]ControllerRegistrationDependency)(unsafe.Pointer(&in.Dependencies))
 	return nil
 }
 
@@ -3499,6 +4375,7 @@ func autoConvert_v1beta1_ControllerResource_To_core_ControllerResource(in *Contr
 	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
 	out.AutoEnable = *(*[]core.ClusterType)(unsafe.Pointer(&in.AutoEnable))
 	out.ClusterCompatibility = *(*[]core.ClusterType)(unsafe.Pointer(&in.ClusterCompatibility))
+	out.Capabilities = *(*[]core.ControllerResourceCapability)(unsafe.Pointer(&in.Capabilities))
 	return nil
 }
 
@@ -3516,6 +4393,7 @@ func autoConvert_core_ControllerResource_To_v1beta1_ControllerResource(in *core.
 	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
 	out.AutoEnable = *(*[]ClusterType)(unsafe.Pointer(&in.AutoEnable))
 	out.ClusterCompatibility = *(*[]ClusterType)(unsafe.Pointer(&in.ClusterCompatibility))
+	out.Capabilities = *(*[]ControllerResourceCapability)(unsafe.Pointer(&in.Capabilities))
 	return nil
 }
 
@@ -3548,6 +4426,42 @@ func Convert_core_ControllerResourceLifecycle_To_v1beta1_ControllerResourceLifec
 	return autoConvert_core_ControllerResourceLifecycle_To_v1beta1_ControllerResourceLifecycle(in, out, s)
 }
 
+func autoConvert_v1beta1_ControllerWorkersConfig_To_core_ControllerWorkersConfig(in *ControllerWorkersConfig, out *core.ControllerWorkersConfig, s conversion.Scope) error {
+	out.Deployment = (*int32)(unsafe.Pointer(in.Deployment))
+	out.ReplicaSet = (*int32)(unsafe.Pointer(in.ReplicaSet))
+	out.StatefulSet = (*int32)(unsafe.Pointer(in.StatefulSet))
+	out.Endpoint = (*int32)(unsafe.Pointer(in.Endpoint))
+	out.ServiceEndpoint = (*int32)(unsafe.Pointer(in.ServiceEndpoint))
+	out.GarbageCollector = (*int32)(unsafe.Pointer(in.GarbageCollector))
+	out.Namespace = (*int32)(unsafe.Pointer(in.Namespace))
+	out.ResourceQuota = (*int32)(unsafe.Pointer(in.ResourceQuota))
+	out.ServiceAccountToken = (*int32)(unsafe.Pointer(in.ServiceAccountToken))
+	return nil
+}
+
+// Convert_v1beta1_ControllerWorkersConfig_To_core_ControllerWorkersConfig is an autogenerated conversion function.
+func Convert_v1beta1_ControllerWorkersConfig_To_core_ControllerWorkersConfig(in *ControllerWorkersConfig, out *core.ControllerWorkersConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControllerWorkersConfig_To_core_ControllerWorkersConfig(in, out, s)
+}
+
+func autoConvert_core_ControllerWorkersConfig_To_v1beta1_ControllerWorkersConfig(in *core.ControllerWorkersConfig, out *ControllerWorkersConfig, s conversion.Scope) error {
+	out.Deployment = (*int32)(unsafe.Pointer(in.Deployment))
+	out.ReplicaSet = (*int32)(unsafe.Pointer(in.ReplicaSet))
+	out.StatefulSet = (*int32)(unsafe.Pointer(in.StatefulSet))
+	out.Endpoint = (*int32)(unsafe.Pointer(in.Endpoint))
+	out.ServiceEndpoint = (*int32)(unsafe.Pointer(in.ServiceEndpoint))
+	out.GarbageCollector = (*int32)(unsafe.Pointer(in.GarbageCollector))
+	out.Namespace = (*int32)(unsafe.Pointer(in.Namespace))
+	out.ResourceQuota = (*int32)(unsafe.Pointer(in.ResourceQuota))
+	out.ServiceAccountToken = (*int32)(unsafe.Pointer(in.ServiceAccountToken))
+	return nil
+}
+
+// Convert_core_ControllerWorkersConfig_To_v1beta1_ControllerWorkersConfig is an autogenerated conversion function.
+func Convert_core_ControllerWorkersConfig_To_v1beta1_ControllerWorkersConfig(in *core.ControllerWorkersConfig, out *ControllerWorkersConfig, s conversion.Scope) error {
+	return autoConvert_core_ControllerWorkersConfig_To_v1beta1_ControllerWorkersConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_CoreDNS_To_core_CoreDNS(in *CoreDNS, out *core.CoreDNS, s conversion.Scope) error {
 	out.Autoscaling = (*core.CoreDNSAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	out.Rewriting = (*core.CoreDNSRewriting)(unsafe.Pointer(in.Rewriting))
@@ -3613,6 +4527,7 @@ func Convert_core_CoreDNSRewriting_To_v1beta1_CoreDNSRewriting(in *core.CoreDNSR
 func autoConvert_v1beta1_DNS_To_core_DNS(in *DNS, out *core.DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.Providers = *(*[]core.DNSProvider)(unsafe.Pointer(&in.Providers))
+	out.APIServerRecord = (*core.DNSAPIServerRecord)(unsafe.Pointer(in.APIServerRecord))
 	return nil
 }
 
@@ -3624,6 +4539,7 @@ func Convert_v1beta1_DNS_To_core_DNS(in *DNS, out *core.DNS, s conversion.Scope)
 func autoConvert_core_DNS_To_v1beta1_DNS(in *core.DNS, out *DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.Providers = *(*[]DNSProvider)(unsafe.Pointer(&in.Providers))
+	out.APIServerRecord = (*DNSAPIServerRecord)(unsafe.Pointer(in.APIServerRecord))
 	return nil
 }
 
@@ -3632,6 +4548,28 @@ func Convert_core_DNS_To_v1beta1_DNS(in *core.DNS, out *DNS, s conversion.Scope)
 	return autoConvert_core_DNS_To_v1beta1_DNS(in, out, s)
 }
 
+func autoConvert_v1beta1_DNSAPIServerRecord_To_core_DNSAPIServerRecord(in *DNSAPIServerRecord, out *core.DNSAPIServerRecord, s conversion.Scope) error {
+	out.TTLs = *(*[]core.DNSRecordTTL)(unsafe.Pointer(&in.TTLs))
+	out.Failover = (*core.DNSFailover)(unsafe.Pointer(in.Failover))
+	return nil
+}
+
+// Convert_v1beta1_DNSAPIServerRecord_To_core_DNSAPIServerRecord is an autogenerated conversion function.
+func Convert_v1beta1_DNSAPIServerRecord_To_core_DNSAPIServerRecord(in *DNSAPIServerRecord, out *core.DNSAPIServerRecord, s conversion.Scope) error {
+	return autoConvert_v1beta1_DNSAPIServerRecord_To_core_DNSAPIServerRecord(in, out, s)
+}
+
+func autoConvert_core_DNSAPIServerRecord_To_v1beta1_DNSAPIServerRecord(in *core.DNSAPIServerRecord, out *DNSAPIServerRecord, s conversion.Scope) error {
+	out.TTLs = *(*[]DNSRecordTTL)(unsafe.Pointer(&in.TTLs))
+	out.Failover = (*DNSFailover)(unsafe.Pointer(in.Failover))
+	return nil
+}
+
+// Convert_core_DNSAPIServerRecord_To_v1beta1_DNSAPIServerRecord is an autogenerated conversion function.
+func Convert_core_DNSAPIServerRecord_To_v1beta1_DNSAPIServerRecord(in *core.DNSAPIServerRecord, out *DNSAPIServerRecord, s conversion.Scope) error {
+	return autoConvert_core_DNSAPIServerRecord_To_v1beta1_DNSAPIServerRecord(in, out, s)
+}
+
 func autoConvert_v1beta1_DNSExposure_To_core_DNSExposure(in *DNSExposure, out *core.DNSExposure, s conversion.Scope) error {
 	return nil
 }
@@ -3650,6 +4588,60 @@ func Convert_core_DNSExposure_To_v1beta1_DNSExposure(in *core.DNSExposure, out *
 	return autoConvert_core_DNSExposure_To_v1beta1_DNSExposure(in, out, s)
 }
 
+func autoConvert_v1beta1_DNSFailover_To_core_DNSFailover(in *DNSFailover, out *core.DNSFailover, s conversion.Scope) error {
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	if err := Convert_v1beta1_DNSHealthCheck_To_core_DNSHealthCheck(&in.HealthCheck, &out.HealthCheck, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_DNSFailover_To_core_DNSFailover is an autogenerated conversion function.
+func Convert_v1beta1_DNSFailover_To_core_DNSFailover(in *DNSFailover, out *core.DNSFailover, s conversion.Scope) error {
+	return autoConvert_v1beta1_DNSFailover_To_core_DNSFailover(in, out, s)
+}
+
+func autoConvert_core_DNSFailover_To_v1beta1_DNSFailover(in *core.DNSFailover, out *DNSFailover, s conversion.Scope) error {
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	if err := Convert_core_DNSHealthCheck_To_v1beta1_DNSHealthCheck(&in.HealthCheck, &out.HealthCheck, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_DNSFailover_To_v1beta1_DNSFailover is an autogenerated conversion function.
+func Convert_core_DNSFailover_To_v1beta1_DNSFailover(in *core.DNSFailover, out *DNSFailover, s conversion.Scope) error {
+	return autoConvert_core_DNSFailover_To_v1beta1_DNSFailover(in, out, s)
+}
+
+func autoConvert_v1beta1_DNSHealthCheck_To_core_DNSHealthCheck(in *DNSHealthCheck, out *core.DNSHealthCheck, s conversion.Scope) error {
+	out.Protocol = (*core.DNSHealthCheckProtocol)(unsafe.Pointer(in.Protocol))
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.IntervalSeconds = (*int32)(unsafe.Pointer(in.IntervalSeconds))
+	out.FailureThreshold = (*int32)(unsafe.Pointer(in.FailureThreshold))
+	return nil
+}
+
+// Convert_v1beta1_DNSHealthCheck_To_core_DNSHealthCheck is an autogenerated conversion function.
+func Convert_v1beta1_DNSHealthCheck_To_core_DNSHealthCheck(in *DNSHealthCheck, out *core.DNSHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1beta1_DNSHealthCheck_To_core_DNSHealthCheck(in, out, s)
+}
+
+func autoConvert_core_DNSHealthCheck_To_v1beta1_DNSHealthCheck(in *core.DNSHealthCheck, out *DNSHealthCheck, s conversion.Scope) error {
+	out.Protocol = (*DNSHealthCheckProtocol)(unsafe.Pointer(in.Protocol))
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.IntervalSeconds = (*int32)(unsafe.Pointer(in.IntervalSeconds))
+	out.FailureThreshold = (*int32)(unsafe.Pointer(in.FailureThreshold))
+	return nil
+}
+
+// Convert_core_DNSHealthCheck_To_v1beta1_DNSHealthCheck is an autogenerated conversion function.
+func Convert_core_DNSHealthCheck_To_v1beta1_DNSHealthCheck(in *core.DNSHealthCheck, out *DNSHealthCheck, s conversion.Scope) error {
+	return autoConvert_core_DNSHealthCheck_To_v1beta1_DNSHealthCheck(in, out, s)
+}
+
 func autoConvert_v1beta1_DNSIncludeExclude_To_core_DNSIncludeExclude(in *DNSIncludeExclude, out *core.DNSIncludeExclude, s conversion.Scope) error {
 	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
 	out.Exclude = *(*[]string)(unsafe.Pointer(&in.Exclude))
@@ -3702,6 +4694,28 @@ func Convert_core_DNSProvider_To_v1beta1_DNSProvider(in *core.DNSProvider, out *
 	return autoConvert_core_DNSProvider_To_v1beta1_DNSProvider(in, out, s)
 }
 
+func autoConvert_v1beta1_DNSRecordTTL_To_core_DNSRecordTTL(in *DNSRecordTTL, out *core.DNSRecordTTL, s conversion.Scope) error {
+	out.RecordType = in.RecordType
+	out.Seconds = in.Seconds
+	return nil
+}
+
+// Convert_v1beta1_DNSRecordTTL_To_core_DNSRecordTTL is an autogenerated conversion function.
+func Convert_v1beta1_DNSRecordTTL_To_core_DNSRecordTTL(in *DNSRecordTTL, out *core.DNSRecordTTL, s conversion.Scope) error {
+	return autoConvert_v1beta1_DNSRecordTTL_To_core_DNSRecordTTL(in, out, s)
+}
+
+func autoConvert_core_DNSRecordTTL_To_v1beta1_DNSRecordTTL(in *core.DNSRecordTTL, out *DNSRecordTTL, s conversion.Scope) error {
+	out.RecordType = in.RecordType
+	out.Seconds = in.Seconds
+	return nil
+}
+
+// Convert_core_DNSRecordTTL_To_v1beta1_DNSRecordTTL is an autogenerated conversion function.
+func Convert_core_DNSRecordTTL_To_v1beta1_DNSRecordTTL(in *core.DNSRecordTTL, out *DNSRecordTTL, s conversion.Scope) error {
+	return autoConvert_core_DNSRecordTTL_To_v1beta1_DNSRecordTTL(in, out, s)
+}
+
 func autoConvert_v1beta1_DataVolume_To_core_DataVolume(in *DataVolume, out *core.DataVolume, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Type = (*string)(unsafe.Pointer(in.Type))
@@ -3728,6 +4742,30 @@ func Convert_core_DataVolume_To_v1beta1_DataVolume(in *core.DataVolume, out *Dat
 	return autoConvert_core_DataVolume_To_v1beta1_DataVolume(in, out, s)
 }
 
+func autoConvert_v1beta1_DeferredShootMaintenance_To_core_DeferredShootMaintenance(in *DeferredShootMaintenance, out *core.DeferredShootMaintenance, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.LastDeferredTime = in.LastDeferredTime
+	return nil
+}
+
+// Convert_v1beta1_DeferredShootMaintenance_To_core_DeferredShootMaintenance is an autogenerated conversion function.
+func Convert_v1beta1_DeferredShootMaintenance_To_core_DeferredShootMaintenance(in *DeferredShootMaintenance, out *core.DeferredShootMaintenance, s conversion.Scope) error {
+	return autoConvert_v1beta1_DeferredShootMaintenance_To_core_DeferredShootMaintenance(in, out, s)
+}
+
+func autoConvert_core_DeferredShootMaintenance_To_v1beta1_DeferredShootMaintenance(in *core.DeferredShootMaintenance, out *DeferredShootMaintenance, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.LastDeferredTime = in.LastDeferredTime
+	return nil
+}
+
+// Convert_core_DeferredShootMaintenance_To_v1beta1_DeferredShootMaintenance is an autogenerated conversion function.
+func Convert_core_DeferredShootMaintenance_To_v1beta1_DeferredShootMaintenance(in *core.DeferredShootMaintenance, out *DeferredShootMaintenance, s conversion.Scope) error {
+	return autoConvert_core_DeferredShootMaintenance_To_v1beta1_DeferredShootMaintenance(in, out, s)
+}
+
 func autoConvert_v1beta1_DeploymentRef_To_core_DeploymentRef(in *DeploymentRef, out *core.DeploymentRef, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -3844,6 +4882,98 @@ func Convert_core_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation
 	return autoConvert_core_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_Egress_To_core_Egress(in *Egress, out *core.Egress, s conversion.Scope) error {
+	out.IPPools = *(*[]core.EgressIPPool)(unsafe.Pointer(&in.IPPools))
+	out.NamespaceSelections = *(*[]core.EgressNamespaceSelection)(unsafe.Pointer(&in.NamespaceSelections))
+	out.Filter = (*core.EgressFilter)(unsafe.Pointer(in.Filter))
+	return nil
+}
+
+// Convert_v1beta1_Egress_To_core_Egress is an autogenerated conversion function.
+func Convert_v1beta1_Egress_To_core_Egress(in *Egress, out *core.Egress, s conversion.Scope) error {
+	return autoConvert_v1beta1_Egress_To_core_Egress(in, out, s)
+}
+
+func autoConvert_core_Egress_To_v1beta1_Egress(in *core.Egress, out *Egress, s conversion.Scope) error {
+	out.IPPools = *(*[]EgressIPPool)(unsafe.Pointer(&in.IPPools))
+	out.NamespaceSelections = *(*[]EgressNamespaceSelection)(unsafe.Pointer(&in.NamespaceSelections))
+	out.Filter = (*EgressFilter)(unsafe.Pointer(in.Filter))
+	return nil
+}
+
+// Convert_core_Egress_To_v1beta1_Egress is an autogenerated conversion function.
+func Convert_core_Egress_To_v1beta1_Egress(in *core.Egress, out *Egress, s conversion.Scope) error {
+	return autoConvert_core_Egress_To_v1beta1_Egress(in, out, s)
+}
+
+func autoConvert_v1beta1_EgressFilter_To_core_EgressFilter(in *EgressFilter, out *core.EgressFilter, s conversion.Scope) error {
+	out.AllowList = *(*[]string)(unsafe.Pointer(&in.AllowList))
+	out.DenyList = *(*[]string)(unsafe.Pointer(&in.DenyList))
+	return nil
+}
+
+// Convert_v1beta1_EgressFilter_To_core_EgressFilter is an autogenerated conversion function.
+func Convert_v1beta1_EgressFilter_To_core_EgressFilter(in *EgressFilter, out *core.EgressFilter, s conversion.Scope) error {
+	return autoConvert_v1beta1_EgressFilter_To_core_EgressFilter(in, out, s)
+}
+
+func autoConvert_core_EgressFilter_To_v1beta1_EgressFilter(in *core.EgressFilter, out *EgressFilter, s conversion.Scope) error {
+	out.AllowList = *(*[]string)(unsafe.Pointer(&in.AllowList))
+	out.DenyList = *(*[]string)(unsafe.Pointer(&in.DenyList))
+	return nil
+}
+
+// Convert_core_EgressFilter_To_v1beta1_EgressFilter is an autogenerated conversion function.
+func Convert_core_EgressFilter_To_v1beta1_EgressFilter(in *core.EgressFilter, out *EgressFilter, s conversion.Scope) error {
+	return autoConvert_core_EgressFilter_To_v1beta1_EgressFilter(in, out, s)
+}
+
+func autoConvert_v1beta1_EgressIPPool_To_core_EgressIPPool(in *EgressIPPool, out *core.EgressIPPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Count = (*int32)(unsafe.Pointer(in.Count))
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	return nil
+}
+
+// Convert_v1beta1_EgressIPPool_To_core_EgressIPPool is an autogenerated conversion function.
+func Convert_v1beta1_EgressIPPool_To_core_EgressIPPool(in *EgressIPPool, out *core.EgressIPPool, s conversion.Scope) error {
+	return autoConvert_v1beta1_EgressIPPool_To_core_EgressIPPool(in, out, s)
+}
+
+func autoConvert_core_EgressIPPool_To_v1beta1_EgressIPPool(in *core.EgressIPPool, out *EgressIPPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Count = (*int32)(unsafe.Pointer(in.Count))
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	return nil
+}
+
+// Convert_core_EgressIPPool_To_v1beta1_EgressIPPool is an autogenerated conversion function.
+func Convert_core_EgressIPPool_To_v1beta1_EgressIPPool(in *core.EgressIPPool, out *EgressIPPool, s conversion.Scope) error {
+	return autoConvert_core_EgressIPPool_To_v1beta1_EgressIPPool(in, out, s)
+}
+
+func autoConvert_v1beta1_EgressNamespaceSelection_To_core_EgressNamespaceSelection(in *EgressNamespaceSelection, out *core.EgressNamespaceSelection, s conversion.Scope) error {
+	out.IPPool = in.IPPool
+	out.NamespaceSelector = in.NamespaceSelector
+	return nil
+}
+
+// Convert_v1beta1_EgressNamespaceSelection_To_core_EgressNamespaceSelection is an autogenerated conversion function.
+func Convert_v1beta1_EgressNamespaceSelection_To_core_EgressNamespaceSelection(in *EgressNamespaceSelection, out *core.EgressNamespaceSelection, s conversion.Scope) error {
+	return autoConvert_v1beta1_EgressNamespaceSelection_To_core_EgressNamespaceSelection(in, out, s)
+}
+
+func autoConvert_core_EgressNamespaceSelection_To_v1beta1_EgressNamespaceSelection(in *core.EgressNamespaceSelection, out *EgressNamespaceSelection, s conversion.Scope) error {
+	out.IPPool = in.IPPool
+	out.NamespaceSelector = in.NamespaceSelector
+	return nil
+}
+
+// Convert_core_EgressNamespaceSelection_To_v1beta1_EgressNamespaceSelection is an autogenerated conversion function.
+func Convert_core_EgressNamespaceSelection_To_v1beta1_EgressNamespaceSelection(in *core.EgressNamespaceSelection, out *EgressNamespaceSelection, s conversion.Scope) error {
+	return autoConvert_core_EgressNamespaceSelection_To_v1beta1_EgressNamespaceSelection(in, out, s)
+}
+
 func autoConvert_v1beta1_EncryptionAtRest_To_core_EncryptionAtRest(in *EncryptionAtRest, out *core.EncryptionAtRest, s conversion.Scope) error {
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
 	if err := Convert_v1beta1_EncryptionProviderStatus_To_core_EncryptionProviderStatus(&in.Provider, &out.Provider, s); err != nil {
@@ -3936,6 +5066,32 @@ func Convert_core_EncryptionProviderStatus_To_v1beta1_EncryptionProviderStatus(i
 	return autoConvert_core_EncryptionProviderStatus_To_v1beta1_EncryptionProviderStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ErrorCodeDefinition_To_core_ErrorCodeDefinition(in *ErrorCodeDefinition, out *core.ErrorCodeDefinition, s conversion.Scope) error {
+	out.Code = core.ErrorCode(in.Code)
+	out.Description = in.Description
+	out.UserError = in.UserError
+	out.NonRetryable = in.NonRetryable
+	return nil
+}
+
+// Convert_v1beta1_ErrorCodeDefinition_To_core_ErrorCodeDefinition is an autogenerated conversion function.
+func Convert_v1beta1_ErrorCodeDefinition_To_core_ErrorCodeDefinition(in *ErrorCodeDefinition, out *core.ErrorCodeDefinition, s conversion.Scope) error {
+	return autoConvert_v1beta1_ErrorCodeDefinition_To_core_ErrorCodeDefinition(in, out, s)
+}
+
+func autoConvert_core_ErrorCodeDefinition_To_v1beta1_ErrorCodeDefinition(in *core.ErrorCodeDefinition, out *ErrorCodeDefinition, s conversion.Scope) error {
+	out.Code = ErrorCode(in.Code)
+	out.Description = in.Description
+	out.UserError = in.UserError
+	out.NonRetryable = in.NonRetryable
+	return nil
+}
+
+// Convert_core_ErrorCodeDefinition_To_v1beta1_ErrorCodeDefinition is an autogenerated conversion function.
+func Convert_core_ErrorCodeDefinition_To_v1beta1_ErrorCodeDefinition(in *core.ErrorCodeDefinition, out *ErrorCodeDefinition, s conversion.Scope) error {
+	return autoConvert_core_ErrorCodeDefinition_To_v1beta1_ErrorCodeDefinition(in, out, s)
+}
+
 func autoConvert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(in *ExpirableVersion, out *core.ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
 	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
@@ -3965,6 +5121,7 @@ func Convert_core_ExpirableVersion_To_v1beta1_ExpirableVersion(in *core.Expirabl
 func autoConvert_v1beta1_ExpirableVersionStatus_To_core_ExpirableVersionStatus(in *ExpirableVersionStatus, out *core.ExpirableVersionStatus, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Classification = core.VersionClassification(in.Classification)
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	return nil
 }
 
@@ -3976,6 +5133,7 @@ func Convert_v1beta1_ExpirableVersionStatus_To_core_ExpirableVersionStatus(in *E
 func autoConvert_core_ExpirableVersionStatus_To_v1beta1_ExpirableVersionStatus(in *core.ExpirableVersionStatus, out *ExpirableVersionStatus, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Classification = VersionClassification(in.Classification)
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	return nil
 }
 
@@ -4222,6 +5380,7 @@ func autoConvert_v1beta1_HelmControllerDeployment_To_core_HelmControllerDeployme
 	// WARNING: in.Chart requires manual conversion: does not exist in peer-type
 	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
 	out.OCIRepository = (*core.OCIRepository)(unsafe.Pointer(in.OCIRepository))
+	out.ValuesOverlays = *(*[]core.HelmValuesOverlay)(unsafe.Pointer(&in.ValuesOverlays))
 	return nil
 }
 
@@ -4229,9 +5388,32 @@ func autoConvert_core_HelmControllerDeployment_To_v1beta1_HelmControllerDeployme
 	// WARNING: in.RawChart requires manual conversion: does not exist in peer-type
 	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
 	out.OCIRepository = (*OCIRepository)(unsafe.Pointer(in.OCIRepository))
+	out.ValuesOverlays = *(*[]HelmValuesOverlay)(unsafe.Pointer(&in.ValuesOverlays))
+	return nil
+}
+
+func autoConvert_v1beta1_HelmValuesOverlay_To_core_HelmValuesOverlay(in *HelmValuesOverlay, out *core.HelmValuesOverlay, s conversion.Scope) error {
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_v1beta1_HelmValuesOverlay_To_core_HelmValuesOverlay is an autogenerated conversion function.
+func Convert_v1beta1_HelmValuesOverlay_To_core_HelmValuesOverlay(in *HelmValuesOverlay, out *core.HelmValuesOverlay, s conversion.Scope) error {
+	return autoConvert_v1beta1_HelmValuesOverlay_To_core_HelmValuesOverlay(in, out, s)
+}
+
+func autoConvert_core_HelmValuesOverlay_To_v1beta1_HelmValuesOverlay(in *core.HelmValuesOverlay, out *HelmValuesOverlay, s conversion.Scope) error {
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
 	return nil
 }
 
+// Convert_core_HelmValuesOverlay_To_v1beta1_HelmValuesOverlay is an autogenerated conversion function.
+func Convert_core_HelmValuesOverlay_To_v1beta1_HelmValuesOverlay(in *core.HelmValuesOverlay, out *HelmValuesOverlay, s conversion.Scope) error {
+	return autoConvert_core_HelmValuesOverlay_To_v1beta1_HelmValuesOverlay(in, out, s)
+}
+
 func autoConvert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
@@ -4377,6 +5559,7 @@ func autoConvert_v1beta1_Ingress_To_core_Ingress(in *Ingress, out *core.Ingress,
 	if err := Convert_v1beta1_IngressController_To_core_IngressController(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.WildcardCertificate = (*core.IngressWildcardCertificate)(unsafe.Pointer(in.WildcardCertificate))
 	return nil
 }
 
@@ -4390,6 +5573,7 @@ func autoConvert_core_Ingress_To_v1beta1_Ingress(in *core.Ingress, out *Ingress,
 	if err := Convert_core_IngressController_To_v1beta1_IngressController(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.WildcardCertificate = (*IngressWildcardCertificate)(unsafe.Pointer(in.WildcardCertificate))
 	return nil
 }
 
@@ -4420,6 +5604,26 @@ func Convert_core_IngressController_To_v1beta1_IngressController(in *core.Ingres
 	return autoConvert_core_IngressController_To_v1beta1_IngressController(in, out, s)
 }
 
+func autoConvert_v1beta1_IngressWildcardCertificate_To_core_IngressWildcardCertificate(in *IngressWildcardCertificate, out *core.IngressWildcardCertificate, s conversion.Scope) error {
+	out.IssuerName = (*string)(unsafe.Pointer(in.IssuerName))
+	return nil
+}
+
+// Convert_v1beta1_IngressWildcardCertificate_To_core_IngressWildcardCertificate is an autogenerated conversion function.
+func Convert_v1beta1_IngressWildcardCertificate_To_core_IngressWildcardCertificate(in *IngressWildcardCertificate, out *core.IngressWildcardCertificate, s conversion.Scope) error {
+	return autoConvert_v1beta1_IngressWildcardCertificate_To_core_IngressWildcardCertificate(in, out, s)
+}
+
+func autoConvert_core_IngressWildcardCertificate_To_v1beta1_IngressWildcardCertificate(in *core.IngressWildcardCertificate, out *IngressWildcardCertificate, s conversion.Scope) error {
+	out.IssuerName = (*string)(unsafe.Pointer(in.IssuerName))
+	return nil
+}
+
+// Convert_core_IngressWildcardCertificate_To_v1beta1_IngressWildcardCertificate is an autogenerated conversion function.
+func Convert_core_IngressWildcardCertificate_To_v1beta1_IngressWildcardCertificate(in *core.IngressWildcardCertificate, out *IngressWildcardCertificate, s conversion.Scope) error {
+	return autoConvert_core_IngressWildcardCertificate_To_v1beta1_IngressWildcardCertificate(in, out, s)
+}
+
 func autoConvert_v1beta1_InternalSecret_To_core_InternalSecret(in *InternalSecret, out *core.InternalSecret, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Immutable = (*bool)(unsafe.Pointer(in.Immutable))
@@ -4515,6 +5719,8 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *Kub
 	out.StructuredAuthentication = (*core.StructuredAuthentication)(unsafe.Pointer(in.StructuredAuthentication))
 	out.StructuredAuthorization = (*core.StructuredAuthorization)(unsafe.Pointer(in.StructuredAuthorization))
 	out.Autoscaling = (*core.ControlPlaneAutoscaling)(unsafe.Pointer(in.Autoscaling))
+	out.AccessRestrictions = (*core.APIServerAccessRestrictions)(unsafe.Pointer(in.AccessRestrictions))
+	out.NodeConnectivityMode = (*core.NodeConnectivityMode)(unsafe.Pointer(in.NodeConnectivityMode))
 	return nil
 }
 
@@ -4554,6 +5760,8 @@ func autoConvert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *cor
 	out.StructuredAuthentication = (*StructuredAuthentication)(unsafe.Pointer(in.StructuredAuthentication))
 	out.StructuredAuthorization = (*StructuredAuthorization)(unsafe.Pointer(in.StructuredAuthorization))
 	out.Autoscaling = (*ControlPlaneAutoscaling)(unsafe.Pointer(in.Autoscaling))
+	out.AccessRestrictions = (*APIServerAccessRestrictions)(unsafe.Pointer(in.AccessRestrictions))
+	out.NodeConnectivityMode = (*NodeConnectivityMode)(unsafe.Pointer(in.NodeConnectivityMode))
 	return nil
 }
 
@@ -4571,6 +5779,8 @@ func autoConvert_v1beta1_KubeControllerManagerConfig_To_core_KubeControllerManag
 	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
 	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
 	out.NodeCIDRMaskSizeIPv6 = (*int32)(unsafe.Pointer(in.NodeCIDRMaskSizeIPv6))
+	out.ControllerWorkers = (*core.ControllerWorkersConfig)(unsafe.Pointer(in.ControllerWorkers))
+	out.NodeEviction = (*core.NodeEvictionConfig)(unsafe.Pointer(in.NodeEviction))
 	return nil
 }
 
@@ -4588,6 +5798,8 @@ func autoConvert_core_KubeControllerManagerConfig_To_v1beta1_KubeControllerManag
 	out.NodeCIDRMaskSizeIPv6 = (*int32)(unsafe.Pointer(in.NodeCIDRMaskSizeIPv6))
 	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
 	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.ControllerWorkers = (*ControllerWorkersConfig)(unsafe.Pointer(in.ControllerWorkers))
+	out.NodeEviction = (*NodeEvictionConfig)(unsafe.Pointer(in.NodeEviction))
 	return nil
 }
 
@@ -4630,6 +5842,7 @@ func autoConvert_v1beta1_KubeSchedulerConfig_To_core_KubeSchedulerConfig(in *Kub
 	}
 	out.KubeMaxPDVols = (*string)(unsafe.Pointer(in.KubeMaxPDVols))
 	out.Profile = (*core.SchedulingProfile)(unsafe.Pointer(in.Profile))
+	out.Profiles = *(*[]core.SchedulerProfile)(unsafe.Pointer(&in.Profiles))
 	return nil
 }
 
@@ -4644,6 +5857,7 @@ func autoConvert_core_KubeSchedulerConfig_To_v1beta1_KubeSchedulerConfig(in *cor
 	}
 	out.KubeMaxPDVols = (*string)(unsafe.Pointer(in.KubeMaxPDVols))
 	out.Profile = (*SchedulingProfile)(unsafe.Pointer(in.Profile))
+	out.Profiles = *(*[]SchedulerProfile)(unsafe.Pointer(&in.Profiles))
 	return nil
 }
 
@@ -4882,6 +6096,7 @@ func autoConvert_v1beta1_Kubernetes_To_core_Kubernetes(in *Kubernetes, out *core
 	out.Version = in.Version
 	out.VerticalPodAutoscaler = (*core.VerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.ETCD = (*core.ETCD)(unsafe.Pointer(in.ETCD))
+	out.WorkloadDefaults = (*core.WorkloadDefaults)(unsafe.Pointer(in.WorkloadDefaults))
 	return nil
 }
 
@@ -4932,6 +6147,7 @@ func autoConvert_core_Kubernetes_To_v1beta1_Kubernetes(in *core.Kubernetes, out
 	out.Version = in.Version
 	out.VerticalPodAutoscaler = (*VerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.ETCD = (*ETCD)(unsafe.Pointer(in.ETCD))
+	out.WorkloadDefaults = (*WorkloadDefaults)(unsafe.Pointer(in.WorkloadDefaults))
 	return nil
 }
 
@@ -5150,6 +6366,7 @@ func Convert_core_Limits_To_v1beta1_Limits(in *core.Limits, out *Limits, s conve
 
 func autoConvert_v1beta1_LoadBalancerServicesProxyProtocol_To_core_LoadBalancerServicesProxyProtocol(in *LoadBalancerServicesProxyProtocol, out *core.LoadBalancerServicesProxyProtocol, s conversion.Scope) error {
 	out.Allowed = in.Allowed
+	out.TLVs = *(*[]core.ProxyProtocolTLV)(unsafe.Pointer(&in.TLVs))
 	return nil
 }
 
@@ -5160,6 +6377,7 @@ func Convert_v1beta1_LoadBalancerServicesProxyProtocol_To_core_LoadBalancerServi
 
 func autoConvert_core_LoadBalancerServicesProxyProtocol_To_v1beta1_LoadBalancerServicesProxyProtocol(in *core.LoadBalancerServicesProxyProtocol, out *LoadBalancerServicesProxyProtocol, s conversion.Scope) error {
 	out.Allowed = in.Allowed
+	out.TLVs = *(*[]ProxyProtocolTLV)(unsafe.Pointer(&in.TLVs))
 	return nil
 }
 
@@ -5340,6 +6558,28 @@ func Convert_core_MachineImageVersion_To_v1beta1_MachineImageVersion(in *core.Ma
 	return autoConvert_core_MachineImageVersion_To_v1beta1_MachineImageVersion(in, out, s)
 }
 
+func autoConvert_v1beta1_MachinePhaseCount_To_core_MachinePhaseCount(in *MachinePhaseCount, out *core.MachinePhaseCount, s conversion.Scope) error {
+	out.Phase = in.Phase
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1beta1_MachinePhaseCount_To_core_MachinePhaseCount is an autogenerated conversion function.
+func Convert_v1beta1_MachinePhaseCount_To_core_MachinePhaseCount(in *MachinePhaseCount, out *core.MachinePhaseCount, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachinePhaseCount_To_core_MachinePhaseCount(in, out, s)
+}
+
+func autoConvert_core_MachinePhaseCount_To_v1beta1_MachinePhaseCount(in *core.MachinePhaseCount, out *MachinePhaseCount, s conversion.Scope) error {
+	out.Phase = in.Phase
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_core_MachinePhaseCount_To_v1beta1_MachinePhaseCount is an autogenerated conversion function.
+func Convert_core_MachinePhaseCount_To_v1beta1_MachinePhaseCount(in *core.MachinePhaseCount, out *MachinePhaseCount, s conversion.Scope) error {
+	return autoConvert_core_MachinePhaseCount_To_v1beta1_MachinePhaseCount(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *core.MachineType, s conversion.Scope) error {
 	out.CPU = in.CPU
 	out.GPU = in.GPU
@@ -5494,6 +6734,126 @@ func Convert_core_MaintenanceCredentialsAutoRotation_To_v1beta1_MaintenanceCrede
 	return autoConvert_core_MaintenanceCredentialsAutoRotation_To_v1beta1_MaintenanceCredentialsAutoRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in *MaintenanceFreeze, out *core.MaintenanceFreeze, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_MaintenanceFreezeStatus_To_core_MaintenanceFreezeStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in *MaintenanceFreeze, out *core.MaintenanceFreeze, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in *core.MaintenanceFreeze, out *MaintenanceFreeze, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_MaintenanceFreezeStatus_To_v1beta1_MaintenanceFreezeStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze is an autogenerated conversion function.
+func Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in *core.MaintenanceFreeze, out *MaintenanceFreeze, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceFreezeList_To_core_MaintenanceFreezeList(in *MaintenanceFreezeList, out *core.MaintenanceFreezeList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.MaintenanceFreeze)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreezeList_To_core_MaintenanceFreezeList is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreezeList_To_core_MaintenanceFreezeList(in *MaintenanceFreezeList, out *core.MaintenanceFreezeList, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreezeList_To_core_MaintenanceFreezeList(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreezeList_To_v1beta1_MaintenanceFreezeList(in *core.MaintenanceFreezeList, out *MaintenanceFreezeList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]MaintenanceFreeze)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_MaintenanceFreezeList_To_v1beta1_MaintenanceFreezeList is an autogenerated conversion function.
+func Convert_core_MaintenanceFreezeList_To_v1beta1_MaintenanceFreezeList(in *core.MaintenanceFreezeList, out *MaintenanceFreezeList, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreezeList_To_v1beta1_MaintenanceFreezeList(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec(in *MaintenanceFreezeSpec, out *core.MaintenanceFreezeSpec, s conversion.Scope) error {
+	out.Windows = *(*[]core.MaintenanceFreezeWindow)(unsafe.Pointer(&in.Windows))
+	out.ProjectSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec(in *MaintenanceFreezeSpec, out *core.MaintenanceFreezeSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreezeSpec_To_core_MaintenanceFreezeSpec(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec(in *core.MaintenanceFreezeSpec, out *MaintenanceFreezeSpec, s conversion.Scope) error {
+	out.Windows = *(*[]MaintenanceFreezeWindow)(unsafe.Pointer(&in.Windows))
+	out.ProjectSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	return nil
+}
+
+// Convert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec is an autogenerated conversion function.
+func Convert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec(in *core.MaintenanceFreezeSpec, out *MaintenanceFreezeSpec, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreezeSpec_To_v1beta1_MaintenanceFreezeSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceFreezeStatus_To_core_MaintenanceFreezeStatus(in *MaintenanceFreezeStatus, out *core.MaintenanceFreezeStatus, s conversion.Scope) error {
+	out.DeferredShoots = *(*[]core.DeferredShootMaintenance)(unsafe.Pointer(&in.DeferredShoots))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreezeStatus_To_core_MaintenanceFreezeStatus is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreezeStatus_To_core_MaintenanceFreezeStatus(in *MaintenanceFreezeStatus, out *core.MaintenanceFreezeStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreezeStatus_To_core_MaintenanceFreezeStatus(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreezeStatus_To_v1beta1_MaintenanceFreezeStatus(in *core.MaintenanceFreezeStatus, out *MaintenanceFreezeStatus, s conversion.Scope) error {
+	out.DeferredShoots = *(*[]DeferredShootMaintenance)(unsafe.Pointer(&in.DeferredShoots))
+	return nil
+}
+
+// Convert_core_MaintenanceFreezeStatus_To_v1beta1_MaintenanceFreezeStatus is an autogenerated conversion function.
+func Convert_core_MaintenanceFreezeStatus_To_v1beta1_MaintenanceFreezeStatus(in *core.MaintenanceFreezeStatus, out *MaintenanceFreezeStatus, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreezeStatus_To_v1beta1_MaintenanceFreezeStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceFreezeWindow_To_core_MaintenanceFreezeWindow(in *MaintenanceFreezeWindow, out *core.MaintenanceFreezeWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreezeWindow_To_core_MaintenanceFreezeWindow is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreezeWindow_To_core_MaintenanceFreezeWindow(in *MaintenanceFreezeWindow, out *core.MaintenanceFreezeWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreezeWindow_To_core_MaintenanceFreezeWindow(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreezeWindow_To_v1beta1_MaintenanceFreezeWindow(in *core.MaintenanceFreezeWindow, out *MaintenanceFreezeWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_core_MaintenanceFreezeWindow_To_v1beta1_MaintenanceFreezeWindow is an autogenerated conversion function.
+func Convert_core_MaintenanceFreezeWindow_To_v1beta1_MaintenanceFreezeWindow(in *core.MaintenanceFreezeWindow, out *MaintenanceFreezeWindow, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreezeWindow_To_v1beta1_MaintenanceFreezeWindow(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceRotationConfig_To_core_MaintenanceRotationConfig(in *MaintenanceRotationConfig, out *core.MaintenanceRotationConfig, s conversion.Scope) error {
 	out.RotationPeriod = (*metav1.Duration)(unsafe.Pointer(in.RotationPeriod))
 	return nil
@@ -5536,6 +6896,28 @@ func Convert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *cor
 	return autoConvert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in, out, s)
 }
 
+func autoConvert_v1beta1_ManagedAddon_To_core_ManagedAddon(in *ManagedAddon, out *core.ManagedAddon, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = (*string)(unsafe.Pointer(in.Version))
+	return nil
+}
+
+// Convert_v1beta1_ManagedAddon_To_core_ManagedAddon is an autogenerated conversion function.
+func Convert_v1beta1_ManagedAddon_To_core_ManagedAddon(in *ManagedAddon, out *core.ManagedAddon, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedAddon_To_core_ManagedAddon(in, out, s)
+}
+
+func autoConvert_core_ManagedAddon_To_v1beta1_ManagedAddon(in *core.ManagedAddon, out *ManagedAddon, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = (*string)(unsafe.Pointer(in.Version))
+	return nil
+}
+
+// Convert_core_ManagedAddon_To_v1beta1_ManagedAddon is an autogenerated conversion function.
+func Convert_core_ManagedAddon_To_v1beta1_ManagedAddon(in *core.ManagedAddon, out *ManagedAddon, s conversion.Scope) error {
+	return autoConvert_core_ManagedAddon_To_v1beta1_ManagedAddon(in, out, s)
+}
+
 func autoConvert_v1beta1_ManualWorkerPoolRollout_To_core_ManualWorkerPoolRollout(in *ManualWorkerPoolRollout, out *core.ManualWorkerPoolRollout, s conversion.Scope) error {
 	out.PendingWorkersRollouts = *(*[]core.PendingWorkersRollout)(unsafe.Pointer(&in.PendingWorkersRollouts))
 	return nil
@@ -5743,6 +7125,7 @@ func autoConvert_v1beta1_Networking_To_core_Networking(in *Networking, out *core
 	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
 	out.IPFamilies = *(*[]core.IPFamily)(unsafe.Pointer(&in.IPFamilies))
+	out.Egress = (*core.Egress)(unsafe.Pointer(in.Egress))
 	return nil
 }
 
@@ -5758,6 +7141,7 @@ func autoConvert_core_Networking_To_v1beta1_Networking(in *core.Networking, out
 	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
 	out.IPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.IPFamilies))
+	out.Egress = (*Egress)(unsafe.Pointer(in.Egress))
 	return nil
 }
 
@@ -5799,6 +7183,8 @@ func autoConvert_v1beta1_NginxIngress_To_core_NginxIngress(in *NginxIngress, out
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
 	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.LoadBalancerAnnotations = *(*map[string]string)(unsafe.Pointer(&in.LoadBalancerAnnotations))
+	out.Autoscaling = (*core.NginxIngressAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	return nil
 }
 
@@ -5814,6 +7200,8 @@ func autoConvert_core_NginxIngress_To_v1beta1_NginxIngress(in *core.NginxIngress
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
 	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.LoadBalancerAnnotations = *(*map[string]string)(unsafe.Pointer(&in.LoadBalancerAnnotations))
+	out.Autoscaling = (*NginxIngressAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	return nil
 }
 
@@ -5822,6 +7210,56 @@ func Convert_core_NginxIngress_To_v1beta1_NginxIngress(in *core.NginxIngress, ou
 	return autoConvert_core_NginxIngress_To_v1beta1_NginxIngress(in, out, s)
 }
 
+func autoConvert_v1beta1_NginxIngressAutoscaling_To_core_NginxIngressAutoscaling(in *NginxIngressAutoscaling, out *core.NginxIngressAutoscaling, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = in.MaxReplicas
+	out.TargetCPUUtilizationPercentage = (*int32)(unsafe.Pointer(in.TargetCPUUtilizationPercentage))
+	return nil
+}
+
+// Convert_v1beta1_NginxIngressAutoscaling_To_core_NginxIngressAutoscaling is an autogenerated conversion function.
+func Convert_v1beta1_NginxIngressAutoscaling_To_core_NginxIngressAutoscaling(in *NginxIngressAutoscaling, out *core.NginxIngressAutoscaling, s conversion.Scope) error {
+	return autoConvert_v1beta1_NginxIngressAutoscaling_To_core_NginxIngressAutoscaling(in, out, s)
+}
+
+func autoConvert_core_NginxIngressAutoscaling_To_v1beta1_NginxIngressAutoscaling(in *core.NginxIngressAutoscaling, out *NginxIngressAutoscaling, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = in.MaxReplicas
+	out.TargetCPUUtilizationPercentage = (*int32)(unsafe.Pointer(in.TargetCPUUtilizationPercentage))
+	return nil
+}
+
+// Convert_core_NginxIngressAutoscaling_To_v1beta1_NginxIngressAutoscaling is an autogenerated conversion function.
+func Convert_core_NginxIngressAutoscaling_To_v1beta1_NginxIngressAutoscaling(in *core.NginxIngressAutoscaling, out *NginxIngressAutoscaling, s conversion.Scope) error {
+	return autoConvert_core_NginxIngressAutoscaling_To_v1beta1_NginxIngressAutoscaling(in, out, s)
+}
+
+func autoConvert_v1beta1_NodeEvictionConfig_To_core_NodeEvictionConfig(in *NodeEvictionConfig, out *core.NodeEvictionConfig, s conversion.Scope) error {
+	out.Rate = (*float64)(unsafe.Pointer(in.Rate))
+	out.SecondaryRate = (*float64)(unsafe.Pointer(in.SecondaryRate))
+	out.LargeClusterSizeThreshold = (*int32)(unsafe.Pointer(in.LargeClusterSizeThreshold))
+	out.UnhealthyZoneThreshold = (*float64)(unsafe.Pointer(in.UnhealthyZoneThreshold))
+	return nil
+}
+
+// Convert_v1beta1_NodeEvictionConfig_To_core_NodeEvictionConfig is an autogenerated conversion function.
+func Convert_v1beta1_NodeEvictionConfig_To_core_NodeEvictionConfig(in *NodeEvictionConfig, out *core.NodeEvictionConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_NodeEvictionConfig_To_core_NodeEvictionConfig(in, out, s)
+}
+
+func autoConvert_core_NodeEvictionConfig_To_v1beta1_NodeEvictionConfig(in *core.NodeEvictionConfig, out *NodeEvictionConfig, s conversion.Scope) error {
+	out.Rate = (*float64)(unsafe.Pointer(in.Rate))
+	out.SecondaryRate = (*float64)(unsafe.Pointer(in.SecondaryRate))
+	out.LargeClusterSizeThreshold = (*int32)(unsafe.Pointer(in.LargeClusterSizeThreshold))
+	out.UnhealthyZoneThreshold = (*float64)(unsafe.Pointer(in.UnhealthyZoneThreshold))
+	return nil
+}
+
+// Convert_core_NodeEvictionConfig_To_v1beta1_NodeEvictionConfig is an autogenerated conversion function.
+func Convert_core_NodeEvictionConfig_To_v1beta1_NodeEvictionConfig(in *core.NodeEvictionConfig, out *NodeEvictionConfig, s conversion.Scope) error {
+	return autoConvert_core_NodeEvictionConfig_To_v1beta1_NodeEvictionConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS(in *NodeLocalDNS, out *core.NodeLocalDNS, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.ForceTCPToClusterDNS = (*bool)(unsafe.Pointer(in.ForceTCPToClusterDNS))
@@ -6091,16 +7529,138 @@ func autoConvert_core_ProjectMember_To_v1beta1_ProjectMember(in *core.ProjectMem
 	return nil
 }
 
-func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *core.ProjectSpec, s conversion.Scope) error {
-	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
-	out.Description = (*string)(unsafe.Pointer(in.Description))
-	out.Owner = (*rbacv1.Subject)(unsafe.Pointer(in.Owner))
-	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]core.ProjectMember, len(*in))
-		for i := range *in {
-			if err := Convert_v1beta1_ProjectMember_To_core_ProjectMember(&(*in)[i], &(*out)[i], s); err != nil {
+func autoConvert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults(in *ProjectShootDefaults, out *core.ProjectShootDefaults, s conversion.Scope) error {
+	out.Maintenance = (*core.ProjectShootMaintenanceDefaults)(unsafe.Pointer(in.Maintenance))
+	out.Hibernation = (*core.ProjectShootHibernationDefaults)(unsafe.Pointer(in.Hibernation))
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(core.ProjectShootWorkerDefaults)
+		if err := Convert_v1beta1_ProjectShootWorkerDefaults_To_core_ProjectShootWorkerDefaults(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Workers = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults is an autogenerated conversion function.
+func Convert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults(in *ProjectShootDefaults, out *core.ProjectShootDefaults, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults(in, out, s)
+}
+
+func autoConvert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults(in *core.ProjectShootDefaults, out *ProjectShootDefaults, s conversion.Scope) error {
+	out.Maintenance = (*ProjectShootMaintenanceDefaults)(unsafe.Pointer(in.Maintenance))
+	out.Hibernation = (*ProjectShootHibernationDefaults)(unsafe.Pointer(in.Hibernation))
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(ProjectShootWorkerDefaults)
+		if err := Convert_core_ProjectShootWorkerDefaults_To_v1beta1_ProjectShootWorkerDefaults(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Workers = nil
+	}
+	return nil
+}
+
+// Convert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults is an autogenerated conversion function.
+func Convert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults(in *core.ProjectShootDefaults, out *ProjectShootDefaults, s conversion.Scope) error {
+	return autoConvert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectShootHibernationDefaults_To_core_ProjectShootHibernationDefaults(in *ProjectShootHibernationDefaults, out *core.ProjectShootHibernationDefaults, s conversion.Scope) error {
+	out.Schedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	return nil
+}
+
+// Convert_v1beta1_ProjectShootHibernationDefaults_To_core_ProjectShootHibernationDefaults is an autogenerated conversion function.
+func Convert_v1beta1_ProjectShootHibernationDefaults_To_core_ProjectShootHibernationDefaults(in *ProjectShootHibernationDefaults, out *core.ProjectShootHibernationDefaults, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectShootHibernationDefaults_To_core_ProjectShootHibernationDefaults(in, out, s)
+}
+
+func autoConvert_core_ProjectShootHibernationDefaults_To_v1beta1_ProjectShootHibernationDefaults(in *core.ProjectShootHibernationDefaults, out *ProjectShootHibernationDefaults, s conversion.Scope) error {
+	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	return nil
+}
+
+// Convert_core_ProjectShootHibernationDefaults_To_v1beta1_ProjectShootHibernationDefaults is an autogenerated conversion function.
+func Convert_core_ProjectShootHibernationDefaults_To_v1beta1_ProjectShootHibernationDefaults(in *core.ProjectShootHibernationDefaults, out *ProjectShootHibernationDefaults, s conversion.Scope) error {
+	return autoConvert_core_ProjectShootHibernationDefaults_To_v1beta1_ProjectShootHibernationDefaults(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectShootMaintenanceDefaults_To_core_ProjectShootMaintenanceDefaults(in *ProjectShootMaintenanceDefaults, out *core.ProjectShootMaintenanceDefaults, s conversion.Scope) error {
+	out.TimeWindow = (*core.MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
+	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
+	return nil
+}
+
+// Convert_v1beta1_ProjectShootMaintenanceDefaults_To_core_ProjectShootMaintenanceDefaults is an autogenerated conversion function.
+func Convert_v1beta1_ProjectShootMaintenanceDefaults_To_core_ProjectShootMaintenanceDefaults(in *ProjectShootMaintenanceDefaults, out *core.ProjectShootMaintenanceDefaults, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectShootMaintenanceDefaults_To_core_ProjectShootMaintenanceDefaults(in, out, s)
+}
+
+func autoConvert_core_ProjectShootMaintenanceDefaults_To_v1beta1_ProjectShootMaintenanceDefaults(in *core.ProjectShootMaintenanceDefaults, out *ProjectShootMaintenanceDefaults, s conversion.Scope) error {
+	out.TimeWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
+	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
+	return nil
+}
+
+// Convert_core_ProjectShootMaintenanceDefaults_To_v1beta1_ProjectShootMaintenanceDefaults is an autogenerated conversion function.
+func Convert_core_ProjectShootMaintenanceDefaults_To_v1beta1_ProjectShootMaintenanceDefaults(in *core.ProjectShootMaintenanceDefaults, out *ProjectShootMaintenanceDefaults, s conversion.Scope) error {
+	return autoConvert_core_ProjectShootMaintenanceDefaults_To_v1beta1_ProjectShootMaintenanceDefaults(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectShootWorkerDefaults_To_core_ProjectShootWorkerDefaults(in *ProjectShootWorkerDefaults, out *core.ProjectShootWorkerDefaults, s conversion.Scope) error {
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		*out = new(core.ShootMachineImage)
+		if err := Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MachineImage = nil
+	}
+	out.Volume = (*core.Volume)(unsafe.Pointer(in.Volume))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_v1beta1_ProjectShootWorkerDefaults_To_core_ProjectShootWorkerDefaults is an autogenerated conversion function.
+func Convert_v1beta1_ProjectShootWorkerDefaults_To_core_ProjectShootWorkerDefaults(in *ProjectShootWorkerDefaults, out *core.ProjectShootWorkerDefaults, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectShootWorkerDefaults_To_core_ProjectShootWorkerDefaults(in, out, s)
+}
+
+func autoConvert_core_ProjectShootWorkerDefaults_To_v1beta1_ProjectShootWorkerDefaults(in *core.ProjectShootWorkerDefaults, out *ProjectShootWorkerDefaults, s conversion.Scope) error {
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		*out = new(ShootMachineImage)
+		if err := Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MachineImage = nil
+	}
+	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_core_ProjectShootWorkerDefaults_To_v1beta1_ProjectShootWorkerDefaults is an autogenerated conversion function.
+func Convert_core_ProjectShootWorkerDefaults_To_v1beta1_ProjectShootWorkerDefaults(in *core.ProjectShootWorkerDefaults, out *ProjectShootWorkerDefaults, s conversion.Scope) error {
+	return autoConvert_core_ProjectShootWorkerDefaults_To_v1beta1_ProjectShootWorkerDefaults(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *core.ProjectSpec, s conversion.Scope) error {
+	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	out.Owner = (*rbacv1.Subject)(unsafe.Pointer(in.Owner))
+	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]core.ProjectMember, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ProjectMember_To_core_ProjectMember(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
@@ -6110,6 +7670,15 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]core.DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	if in.ShootDefaults != nil {
+		in, out := &in.ShootDefaults, &out.ShootDefaults
+		*out = new(core.ProjectShootDefaults)
+		if err := Convert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ShootDefaults = nil
+	}
 	return nil
 }
 
@@ -6132,6 +7701,15 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	if in.ShootDefaults != nil {
+		in, out := &in.ShootDefaults, &out.ShootDefaults
+		*out = new(ProjectShootDefaults)
+		if err := Convert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ShootDefaults = nil
+	}
 	return nil
 }
 
@@ -6233,6 +7811,28 @@ func Convert_core_Provider_To_v1beta1_Provider(in *core.Provider, out *Provider,
 	return autoConvert_core_Provider_To_v1beta1_Provider(in, out, s)
 }
 
+func autoConvert_v1beta1_ProxyProtocolTLV_To_core_ProxyProtocolTLV(in *ProxyProtocolTLV, out *core.ProxyProtocolTLV, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_ProxyProtocolTLV_To_core_ProxyProtocolTLV is an autogenerated conversion function.
+func Convert_v1beta1_ProxyProtocolTLV_To_core_ProxyProtocolTLV(in *ProxyProtocolTLV, out *core.ProxyProtocolTLV, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProxyProtocolTLV_To_core_ProxyProtocolTLV(in, out, s)
+}
+
+func autoConvert_core_ProxyProtocolTLV_To_v1beta1_ProxyProtocolTLV(in *core.ProxyProtocolTLV, out *ProxyProtocolTLV, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_core_ProxyProtocolTLV_To_v1beta1_ProxyProtocolTLV is an autogenerated conversion function.
+func Convert_core_ProxyProtocolTLV_To_v1beta1_ProxyProtocolTLV(in *core.ProxyProtocolTLV, out *ProxyProtocolTLV, s conversion.Scope) error {
+	return autoConvert_core_ProxyProtocolTLV_To_v1beta1_ProxyProtocolTLV(in, out, s)
+}
+
 func autoConvert_v1beta1_Quota_To_core_Quota(in *Quota, out *core.Quota, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -6397,6 +7997,94 @@ func Convert_core_SSHAccess_To_v1beta1_SSHAccess(in *core.SSHAccess, out *SSHAcc
 	return autoConvert_core_SSHAccess_To_v1beta1_SSHAccess(in, out, s)
 }
 
+func autoConvert_v1beta1_SchedulerPlugin_To_core_SchedulerPlugin(in *SchedulerPlugin, out *core.SchedulerPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	return nil
+}
+
+// Convert_v1beta1_SchedulerPlugin_To_core_SchedulerPlugin is an autogenerated conversion function.
+func Convert_v1beta1_SchedulerPlugin_To_core_SchedulerPlugin(in *SchedulerPlugin, out *core.SchedulerPlugin, s conversion.Scope) error {
+	return autoConvert_v1beta1_SchedulerPlugin_To_core_SchedulerPlugin(in, out, s)
+}
+
+func autoConvert_core_SchedulerPlugin_To_v1beta1_SchedulerPlugin(in *core.SchedulerPlugin, out *SchedulerPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	return nil
+}
+
+// Convert_core_SchedulerPlugin_To_v1beta1_SchedulerPlugin is an autogenerated conversion function.
+func Convert_core_SchedulerPlugin_To_v1beta1_SchedulerPlugin(in *core.SchedulerPlugin, out *SchedulerPlugin, s conversion.Scope) error {
+	return autoConvert_core_SchedulerPlugin_To_v1beta1_SchedulerPlugin(in, out, s)
+}
+
+func autoConvert_v1beta1_SchedulerPluginSet_To_core_SchedulerPluginSet(in *SchedulerPluginSet, out *core.SchedulerPluginSet, s conversion.Scope) error {
+	out.Enabled = *(*[]core.SchedulerPlugin)(unsafe.Pointer(&in.Enabled))
+	out.Disabled = *(*[]core.SchedulerPlugin)(unsafe.Pointer(&in.Disabled))
+	return nil
+}
+
+// Convert_v1beta1_SchedulerPluginSet_To_core_SchedulerPluginSet is an autogenerated conversion function.
+func Convert_v1beta1_SchedulerPluginSet_To_core_SchedulerPluginSet(in *SchedulerPluginSet, out *core.SchedulerPluginSet, s conversion.Scope) error {
+	return autoConvert_v1beta1_SchedulerPluginSet_To_core_SchedulerPluginSet(in, out, s)
+}
+
+func autoConvert_core_SchedulerPluginSet_To_v1beta1_SchedulerPluginSet(in *core.SchedulerPluginSet, out *SchedulerPluginSet, s conversion.Scope) error {
+	out.Enabled = *(*[]SchedulerPlugin)(unsafe.Pointer(&in.Enabled))
+	out.Disabled = *(*[]SchedulerPlugin)(unsafe.Pointer(&in.Disabled))
+	return nil
+}
+
+// Convert_core_SchedulerPluginSet_To_v1beta1_SchedulerPluginSet is an autogenerated conversion function.
+func Convert_core_SchedulerPluginSet_To_v1beta1_SchedulerPluginSet(in *core.SchedulerPluginSet, out *SchedulerPluginSet, s conversion.Scope) error {
+	return autoConvert_core_SchedulerPluginSet_To_v1beta1_SchedulerPluginSet(in, out, s)
+}
+
+func autoConvert_v1beta1_SchedulerPlugins_To_core_SchedulerPlugins(in *SchedulerPlugins, out *core.SchedulerPlugins, s conversion.Scope) error {
+	out.Filter = (*core.SchedulerPluginSet)(unsafe.Pointer(in.Filter))
+	out.Score = (*core.SchedulerPluginSet)(unsafe.Pointer(in.Score))
+	return nil
+}
+
+// Convert_v1beta1_SchedulerPlugins_To_core_SchedulerPlugins is an autogenerated conversion function.
+func Convert_v1beta1_SchedulerPlugins_To_core_SchedulerPlugins(in *SchedulerPlugins, out *core.SchedulerPlugins, s conversion.Scope) error {
+	return autoConvert_v1beta1_SchedulerPlugins_To_core_SchedulerPlugins(in, out, s)
+}
+
+func autoConvert_core_SchedulerPlugins_To_v1beta1_SchedulerPlugins(in *core.SchedulerPlugins, out *SchedulerPlugins, s conversion.Scope) error {
+	out.Filter = (*SchedulerPluginSet)(unsafe.Pointer(in.Filter))
+	out.Score = (*SchedulerPluginSet)(unsafe.Pointer(in.Score))
+	return nil
+}
+
+// Convert_core_SchedulerPlugins_To_v1beta1_SchedulerPlugins is an autogenerated conversion function.
+func Convert_core_SchedulerPlugins_To_v1beta1_SchedulerPlugins(in *core.SchedulerPlugins, out *SchedulerPlugins, s conversion.Scope) error {
+	return autoConvert_core_SchedulerPlugins_To_v1beta1_SchedulerPlugins(in, out, s)
+}
+
+func autoConvert_v1beta1_SchedulerProfile_To_core_SchedulerProfile(in *SchedulerProfile, out *core.SchedulerProfile, s conversion.Scope) error {
+	out.SchedulerName = in.SchedulerName
+	out.Plugins = (*core.SchedulerPlugins)(unsafe.Pointer(in.Plugins))
+	return nil
+}
+
+// Convert_v1beta1_SchedulerProfile_To_core_SchedulerProfile is an autogenerated conversion function.
+func Convert_v1beta1_SchedulerProfile_To_core_SchedulerProfile(in *SchedulerProfile, out *core.SchedulerProfile, s conversion.Scope) error {
+	return autoConvert_v1beta1_SchedulerProfile_To_core_SchedulerProfile(in, out, s)
+}
+
+func autoConvert_core_SchedulerProfile_To_v1beta1_SchedulerProfile(in *core.SchedulerProfile, out *SchedulerProfile, s conversion.Scope) error {
+	out.SchedulerName = in.SchedulerName
+	out.Plugins = (*SchedulerPlugins)(unsafe.Pointer(in.Plugins))
+	return nil
+}
+
+// Convert_core_SchedulerProfile_To_v1beta1_SchedulerProfile is an autogenerated conversion function.
+func Convert_core_SchedulerProfile_To_v1beta1_SchedulerProfile(in *core.SchedulerProfile, out *SchedulerProfile, s conversion.Scope) error {
+	return autoConvert_core_SchedulerProfile_To_v1beta1_SchedulerProfile(in, out, s)
+}
+
 func autoConvert_v1beta1_SecretBinding_To_core_SecretBinding(in *SecretBinding, out *core.SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
@@ -6571,6 +8259,30 @@ func Convert_core_SeedDNSProviderConfig_To_v1beta1_SeedDNSProviderConfig(in *cor
 	return autoConvert_core_SeedDNSProviderConfig_To_v1beta1_SeedDNSProviderConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in *SeedDrainStatus, out *core.SeedDrainStatus, s conversion.Scope) error {
+	out.RemainingShoots = in.RemainingShoots
+	out.MigratingShoots = in.MigratingShoots
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus is an autogenerated conversion function.
+func Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in *SeedDrainStatus, out *core.SeedDrainStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in, out, s)
+}
+
+func autoConvert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in *core.SeedDrainStatus, out *SeedDrainStatus, s conversion.Scope) error {
+	out.RemainingShoots = in.RemainingShoots
+	out.MigratingShoots = in.MigratingShoots
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus is an autogenerated conversion function.
+func Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in *core.SeedDrainStatus, out *SeedDrainStatus, s conversion.Scope) error {
+	return autoConvert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedList_To_core_SeedList(in *SeedList, out *core.SeedList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -6691,6 +8403,28 @@ func Convert_core_SeedSelector_To_v1beta1_SeedSelector(in *core.SeedSelector, ou
 	return autoConvert_core_SeedSelector_To_v1beta1_SeedSelector(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingControlPlaneResourceQuota_To_core_SeedSettingControlPlaneResourceQuota(in *SeedSettingControlPlaneResourceQuota, out *core.SeedSettingControlPlaneResourceQuota, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SizeClasses = *(*[]core.ControlPlaneSizeClass)(unsafe.Pointer(&in.SizeClasses))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingControlPlaneResourceQuota_To_core_SeedSettingControlPlaneResourceQuota is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingControlPlaneResourceQuota_To_core_SeedSettingControlPlaneResourceQuota(in *SeedSettingControlPlaneResourceQuota, out *core.SeedSettingControlPlaneResourceQuota, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingControlPlaneResourceQuota_To_core_SeedSettingControlPlaneResourceQuota(in, out, s)
+}
+
+func autoConvert_core_SeedSettingControlPlaneResourceQuota_To_v1beta1_SeedSettingControlPlaneResourceQuota(in *core.SeedSettingControlPlaneResourceQuota, out *SeedSettingControlPlaneResourceQuota, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SizeClasses = *(*[]ControlPlaneSizeClass)(unsafe.Pointer(&in.SizeClasses))
+	return nil
+}
+
+// Convert_core_SeedSettingControlPlaneResourceQuota_To_v1beta1_SeedSettingControlPlaneResourceQuota is an autogenerated conversion function.
+func Convert_core_SeedSettingControlPlaneResourceQuota_To_v1beta1_SeedSettingControlPlaneResourceQuota(in *core.SeedSettingControlPlaneResourceQuota, out *SeedSettingControlPlaneResourceQuota, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingControlPlaneResourceQuota_To_v1beta1_SeedSettingControlPlaneResourceQuota(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(in *SeedSettingDependencyWatchdog, out *core.SeedSettingDependencyWatchdog, s conversion.Scope) error {
 	out.Weeder = (*core.SeedSettingDependencyWatchdogWeeder)(unsafe.Pointer(in.Weeder))
 	out.Prober = (*core.SeedSettingDependencyWatchdogProber)(unsafe.Pointer(in.Prober))
@@ -6753,6 +8487,28 @@ func Convert_core_SeedSettingDependencyWatchdogWeeder_To_v1beta1_SeedSettingDepe
 	return autoConvert_core_SeedSettingDependencyWatchdogWeeder_To_v1beta1_SeedSettingDependencyWatchdogWeeder(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingDrain_To_core_SeedSettingDrain(in *SeedSettingDrain, out *core.SeedSettingDrain, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MaxConcurrentMigrations = (*int32)(unsafe.Pointer(in.MaxConcurrentMigrations))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingDrain_To_core_SeedSettingDrain is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingDrain_To_core_SeedSettingDrain(in *SeedSettingDrain, out *core.SeedSettingDrain, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingDrain_To_core_SeedSettingDrain(in, out, s)
+}
+
+func autoConvert_core_SeedSettingDrain_To_v1beta1_SeedSettingDrain(in *core.SeedSettingDrain, out *SeedSettingDrain, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MaxConcurrentMigrations = (*int32)(unsafe.Pointer(in.MaxConcurrentMigrations))
+	return nil
+}
+
+// Convert_core_SeedSettingDrain_To_v1beta1_SeedSettingDrain is an autogenerated conversion function.
+func Convert_core_SeedSettingDrain_To_v1beta1_SeedSettingDrain(in *core.SeedSettingDrain, out *SeedSettingDrain, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingDrain_To_v1beta1_SeedSettingDrain(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingExcessCapacityReservation_To_core_SeedSettingExcessCapacityReservation(in *SeedSettingExcessCapacityReservation, out *core.SeedSettingExcessCapacityReservation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Configs = *(*[]core.SeedSettingExcessCapacityReservationConfig)(unsafe.Pointer(&in.Configs))
@@ -6799,6 +8555,26 @@ func Convert_core_SeedSettingExcessCapacityReservationConfig_To_v1beta1_SeedSett
 	return autoConvert_core_SeedSettingExcessCapacityReservationConfig_To_v1beta1_SeedSettingExcessCapacityReservationConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingIstioTLSTermination_To_core_SeedSettingIstioTLSTermination(in *SeedSettingIstioTLSTermination, out *core.SeedSettingIstioTLSTermination, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingIstioTLSTermination_To_core_SeedSettingIstioTLSTermination is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingIstioTLSTermination_To_core_SeedSettingIstioTLSTermination(in *SeedSettingIstioTLSTermination, out *core.SeedSettingIstioTLSTermination, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingIstioTLSTermination_To_core_SeedSettingIstioTLSTermination(in, out, s)
+}
+
+func autoConvert_core_SeedSettingIstioTLSTermination_To_v1beta1_SeedSettingIstioTLSTermination(in *core.SeedSettingIstioTLSTermination, out *SeedSettingIstioTLSTermination, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_core_SeedSettingIstioTLSTermination_To_v1beta1_SeedSettingIstioTLSTermination is an autogenerated conversion function.
+func Convert_core_SeedSettingIstioTLSTermination_To_v1beta1_SeedSettingIstioTLSTermination(in *core.SeedSettingIstioTLSTermination, out *SeedSettingIstioTLSTermination, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingIstioTLSTermination_To_v1beta1_SeedSettingIstioTLSTermination(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingLoadBalancerServices_To_core_SeedSettingLoadBalancerServices(in *SeedSettingLoadBalancerServices, out *core.SeedSettingLoadBalancerServices, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
@@ -6877,6 +8653,7 @@ func Convert_core_SeedSettingLoadBalancerServicesZones_To_v1beta1_SeedSettingLoa
 
 func autoConvert_v1beta1_SeedSettingScheduling_To_core_SeedSettingScheduling(in *SeedSettingScheduling, out *core.SeedSettingScheduling, s conversion.Scope) error {
 	out.Visible = in.Visible
+	out.Profile = (*core.SchedulingProfile)(unsafe.Pointer(in.Profile))
 	return nil
 }
 
@@ -6887,6 +8664,7 @@ func Convert_v1beta1_SeedSettingScheduling_To_core_SeedSettingScheduling(in *See
 
 func autoConvert_core_SeedSettingScheduling_To_v1beta1_SeedSettingScheduling(in *core.SeedSettingScheduling, out *SeedSettingScheduling, s conversion.Scope) error {
 	out.Visible = in.Visible
+	out.Profile = (*SchedulingProfile)(unsafe.Pointer(in.Profile))
 	return nil
 }
 
@@ -6967,6 +8745,9 @@ func autoConvert_v1beta1_SeedSettings_To_core_SeedSettings(in *SeedSettings, out
 	out.DependencyWatchdog = (*core.SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*core.SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
 	out.ZoneSelection = (*core.SeedSettingZoneSelection)(unsafe.Pointer(in.ZoneSelection))
+	out.IstioTLSTermination = (*core.SeedSettingIstioTLSTermination)(unsafe.Pointer(in.IstioTLSTermination))
+	out.Drain = (*core.SeedSettingDrain)(unsafe.Pointer(in.Drain))
+	out.ControlPlaneResourceQuota = (*core.SeedSettingControlPlaneResourceQuota)(unsafe.Pointer(in.ControlPlaneResourceQuota))
 	return nil
 }
 
@@ -6983,6 +8764,9 @@ func autoConvert_core_SeedSettings_To_v1beta1_SeedSettings(in *core.SeedSettings
 	out.DependencyWatchdog = (*SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
 	out.ZoneSelection = (*SeedSettingZoneSelection)(unsafe.Pointer(in.ZoneSelection))
+	out.IstioTLSTermination = (*SeedSettingIstioTLSTermination)(unsafe.Pointer(in.IstioTLSTermination))
+	out.Drain = (*SeedSettingDrain)(unsafe.Pointer(in.Drain))
+	out.ControlPlaneResourceQuota = (*SeedSettingControlPlaneResourceQuota)(unsafe.Pointer(in.ControlPlaneResourceQuota))
 	return nil
 }
 
@@ -7053,6 +8837,7 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Drain = (*core.SeedDrainStatus)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -7071,6 +8856,7 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Drain = (*SeedDrainStatus)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -7176,6 +8962,7 @@ func autoConvert_v1beta1_ServiceAccountConfig_To_core_ServiceAccountConfig(in *S
 	out.ExtendTokenExpiration = (*bool)(unsafe.Pointer(in.ExtendTokenExpiration))
 	out.MaxTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
 	out.AcceptedIssuers = *(*[]string)(unsafe.Pointer(&in.AcceptedIssuers))
+	out.ProjectedTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.ProjectedTokenExpiration))
 	return nil
 }
 
@@ -7189,6 +8976,7 @@ func autoConvert_core_ServiceAccountConfig_To_v1beta1_ServiceAccountConfig(in *c
 	out.ExtendTokenExpiration = (*bool)(unsafe.Pointer(in.ExtendTokenExpiration))
 	out.MaxTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
 	out.AcceptedIssuers = *(*[]string)(unsafe.Pointer(&in.AcceptedIssuers))
+	out.ProjectedTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.ProjectedTokenExpiration))
 	return nil
 }
 
@@ -7283,6 +9071,60 @@ func Convert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in *c
 	return autoConvert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootAvailabilityReport_To_core_ShootAvailabilityReport(in *ShootAvailabilityReport, out *core.ShootAvailabilityReport, s conversion.Scope) error {
+	out.Period = in.Period
+	out.Downtime = in.Downtime
+	out.MaintenanceDowntime = in.MaintenanceDowntime
+	out.Availability = in.Availability
+	out.Final = in.Final
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ShootAvailabilityReport_To_core_ShootAvailabilityReport is an autogenerated conversion function.
+func Convert_v1beta1_ShootAvailabilityReport_To_core_ShootAvailabilityReport(in *ShootAvailabilityReport, out *core.ShootAvailabilityReport, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootAvailabilityReport_To_core_ShootAvailabilityReport(in, out, s)
+}
+
+func autoConvert_core_ShootAvailabilityReport_To_v1beta1_ShootAvailabilityReport(in *core.ShootAvailabilityReport, out *ShootAvailabilityReport, s conversion.Scope) error {
+	out.Period = in.Period
+	out.Downtime = in.Downtime
+	out.MaintenanceDowntime = in.MaintenanceDowntime
+	out.Availability = in.Availability
+	out.Final = in.Final
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ShootAvailabilityReport_To_v1beta1_ShootAvailabilityReport is an autogenerated conversion function.
+func Convert_core_ShootAvailabilityReport_To_v1beta1_ShootAvailabilityReport(in *core.ShootAvailabilityReport, out *ShootAvailabilityReport, s conversion.Scope) error {
+	return autoConvert_core_ShootAvailabilityReport_To_v1beta1_ShootAvailabilityReport(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(in *ShootCostEstimate, out *core.ShootCostEstimate, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.MonthlyAmount = in.MonthlyAmount
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate is an autogenerated conversion function.
+func Convert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(in *ShootCostEstimate, out *core.ShootCostEstimate, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(in, out, s)
+}
+
+func autoConvert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(in *core.ShootCostEstimate, out *ShootCostEstimate, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.MonthlyAmount = in.MonthlyAmount
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate is an autogenerated conversion function.
+func Convert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(in *core.ShootCostEstimate, out *ShootCostEstimate, s conversion.Scope) error {
+	return autoConvert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
 	out.EncryptionAtRest = (*core.EncryptionAtRest)(unsafe.Pointer(in.EncryptionAtRest))
@@ -7333,40 +9175,362 @@ func Convert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(i
 	return autoConvert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in, out, s)
 }
 
-func autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+func autoConvert_v1beta1_ShootDebugPod_To_core_ShootDebugPod(in *ShootDebugPod, out *core.ShootDebugPod, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RequestedBy = in.RequestedBy
+	out.Reason = in.Reason
+	out.CreationTimestamp = in.CreationTimestamp
+	out.ExpirationTimestamp = in.ExpirationTimestamp
 	return nil
 }
 
-// Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation is an autogenerated conversion function.
-func Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
-	return autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in, out, s)
+// Convert_v1beta1_ShootDebugPod_To_core_ShootDebugPod is an autogenerated conversion function.
+func Convert_v1beta1_ShootDebugPod_To_core_ShootDebugPod(in *ShootDebugPod, out *core.ShootDebugPod, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootDebugPod_To_core_ShootDebugPod(in, out, s)
 }
 
-func autoConvert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in *core.ShootKubeconfigRotation, out *ShootKubeconfigRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+func autoConvert_core_ShootDebugPod_To_v1beta1_ShootDebugPod(in *core.ShootDebugPod, out *ShootDebugPod, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RequestedBy = in.RequestedBy
+	out.Reason = in.Reason
+	out.CreationTimestamp = in.CreationTimestamp
+	out.ExpirationTimestamp = in.ExpirationTimestamp
 	return nil
 }
 
-// Convert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation is an autogenerated conversion function.
-func Convert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in *core.ShootKubeconfigRotation, out *ShootKubeconfigRotation, s conversion.Scope) error {
-	return autoConvert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in, out, s)
+// Convert_core_ShootDebugPod_To_v1beta1_ShootDebugPod is an autogenerated conversion function.
+func Convert_core_ShootDebugPod_To_v1beta1_ShootDebugPod(in *core.ShootDebugPod, out *ShootDebugPod, s conversion.Scope) error {
+	return autoConvert_core_ShootDebugPod_To_v1beta1_ShootDebugPod(in, out, s)
 }
 
-func autoConvert_v1beta1_ShootList_To_core_ShootList(in *ShootList, out *core.ShootList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]core.Shoot, len(*in))
-		for i := range *in {
-			if err := Convert_v1beta1_Shoot_To_core_Shoot(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
+func autoConvert_v1beta1_ShootDebugPodRequest_To_core_ShootDebugPodRequest(in *ShootDebugPodRequest, out *core.ShootDebugPodRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootDebugPodRequestSpec_To_core_ShootDebugPodRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ShootDebugPodRequestStatus_To_core_ShootDebugPodRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootDebugPodRequest_To_core_ShootDebugPodRequest is an autogenerated conversion function.
+func Convert_v1beta1_ShootDebugPodRequest_To_core_ShootDebugPodRequest(in *ShootDebugPodRequest, out *core.ShootDebugPodRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootDebugPodRequest_To_core_ShootDebugPodRequest(in, out, s)
+}
+
+func autoConvert_core_ShootDebugPodRequest_To_v1beta1_ShootDebugPodRequest(in *core.ShootDebugPodRequest, out *ShootDebugPodRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ShootDebugPodRequestSpec_To_v1beta1_ShootDebugPodRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_ShootDebugPodRequestStatus_To_v1beta1_ShootDebugPodRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootDebugPodRequest_To_v1beta1_ShootDebugPodRequest is an autogenerated conversion function.
+func Convert_core_ShootDebugPodRequest_To_v1beta1_ShootDebugPodRequest(in *core.ShootDebugPodRequest, out *ShootDebugPodRequest, s conversion.Scope) error {
+	return autoConvert_core_ShootDebugPodRequest_To_v1beta1_ShootDebugPodRequest(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootDebugPodRequestSpec_To_core_ShootDebugPodRequestSpec(in *ShootDebugPodRequestSpec, out *core.ShootDebugPodRequestSpec, s conversion.Scope) error {
+	out.Reason = in.Reason
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.ExpirationSeconds, &out.ExpirationSeconds, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootDebugPodRequestSpec_To_core_ShootDebugPodRequestSpec is an autogenerated conversion function.
+func Convert_v1beta1_ShootDebugPodRequestSpec_To_core_ShootDebugPodRequestSpec(in *ShootDebugPodRequestSpec, out *core.ShootDebugPodRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootDebugPodRequestSpec_To_core_ShootDebugPodRequestSpec(in, out, s)
+}
+
+func autoConvert_core_ShootDebugPodRequestSpec_To_v1beta1_ShootDebugPodRequestSpec(in *core.ShootDebugPodRequestSpec, out *ShootDebugPodRequestSpec, s conversion.Scope) error {
+	out.Reason = in.Reason
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.ExpirationSeconds, &out.ExpirationSeconds, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootDebugPodRequestSpec_To_v1beta1_ShootDebugPodRequestSpec is an autogenerated conversion function.
+func Convert_core_ShootDebugPodRequestSpec_To_v1beta1_ShootDebugPodRequestSpec(in *core.ShootDebugPodRequestSpec, out *ShootDebugPodRequestSpec, s conversion.Scope) error {
+	return autoConvert_core_ShootDebugPodRequestSpec_To_v1beta1_ShootDebugPodRequestSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootDebugPodRequestStatus_To_core_ShootDebugPodRequestStatus(in *ShootDebugPodRequestStatus, out *core.ShootDebugPodRequestStatus, s conversion.Scope) error {
+	out.PodName = in.PodName
+	out.Namespace = in.Namespace
+	out.ExpirationTimestamp = in.ExpirationTimestamp
+	return nil
+}
+
+// Convert_v1beta1_ShootDebugPodRequestStatus_To_core_ShootDebugPodRequestStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootDebugPodRequestStatus_To_core_ShootDebugPodRequestStatus(in *ShootDebugPodRequestStatus, out *core.ShootDebugPodRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootDebugPodRequestStatus_To_core_ShootDebugPodRequestStatus(in, out, s)
+}
+
+func autoConvert_core_ShootDebugPodRequestStatus_To_v1beta1_ShootDebugPodRequestStatus(in *core.ShootDebugPodRequestStatus, out *ShootDebugPodRequestStatus, s conversion.Scope) error {
+	out.PodName = in.PodName
+	out.Namespace = in.Namespace
+	out.ExpirationTimestamp = in.ExpirationTimestamp
+	return nil
+}
+
+// Convert_core_ShootDebugPodRequestStatus_To_v1beta1_ShootDebugPodRequestStatus is an autogenerated conversion function.
+func Convert_core_ShootDebugPodRequestStatus_To_v1beta1_ShootDebugPodRequestStatus(in *core.ShootDebugPodRequestStatus, out *ShootDebugPodRequestStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootDebugPodRequestStatus_To_v1beta1_ShootDebugPodRequestStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootFootprintRequest_To_core_ShootFootprintRequest(in *ShootFootprintRequest, out *core.ShootFootprintRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootFootprintRequestSpec_To_core_ShootFootprintRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ShootFootprintRequestStatus_To_core_ShootFootprintRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootFootprintRequest_To_core_ShootFootprintRequest is an autogenerated conversion function.
+func Convert_v1beta1_ShootFootprintRequest_To_core_ShootFootprintRequest(in *ShootFootprintRequest, out *core.ShootFootprintRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootFootprintRequest_To_core_ShootFootprintRequest(in, out, s)
+}
+
+func autoConvert_core_ShootFootprintRequest_To_v1beta1_ShootFootprintRequest(in *core.ShootFootprintRequest, out *ShootFootprintRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ShootFootprintRequestSpec_To_v1beta1_ShootFootprintRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_ShootFootprintRequestStatus_To_v1beta1_ShootFootprintRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootFootprintRequest_To_v1beta1_ShootFootprintRequest is an autogenerated conversion function.
+func Convert_core_ShootFootprintRequest_To_v1beta1_ShootFootprintRequest(in *core.ShootFootprintRequest, out *ShootFootprintRequest, s conversion.Scope) error {
+	return autoConvert_core_ShootFootprintRequest_To_v1beta1_ShootFootprintRequest(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootFootprintRequestSpec_To_core_ShootFootprintRequestSpec(in *ShootFootprintRequestSpec, out *core.ShootFootprintRequestSpec, s conversion.Scope) error {
+	if in.Shoot != nil {
+		in, out := &in.Shoot, &out.Shoot
+		*out = new(core.ShootSpec)
+		if err := Convert_v1beta1_ShootSpec_To_core_ShootSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Shoot = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootFootprintRequestSpec_To_core_ShootFootprintRequestSpec is an autogenerated conversion function.
+func Convert_v1beta1_ShootFootprintRequestSpec_To_core_ShootFootprintRequestSpec(in *ShootFootprintRequestSpec, out *core.ShootFootprintRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootFootprintRequestSpec_To_core_ShootFootprintRequestSpec(in, out, s)
+}
+
+func autoConvert_core_ShootFootprintRequestSpec_To_v1beta1_ShootFootprintRequestSpec(in *core.ShootFootprintRequestSpec, out *ShootFootprintRequestSpec, s conversion.Scope) error {
+	if in.Shoot != nil {
+		in, out := &in.Shoot, &out.Shoot
+		*out = new(ShootSpec)
+		if err := Convert_core_ShootSpec_To_v1beta1_ShootSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Shoot = nil
+	}
+	return nil
+}
+
+// Convert_core_ShootFootprintRequestSpec_To_v1beta1_ShootFootprintRequestSpec is an autogenerated conversion function.
+func Convert_core_ShootFootprintRequestSpec_To_v1beta1_ShootFootprintRequestSpec(in *core.ShootFootprintRequestSpec, out *ShootFootprintRequestSpec, s conversion.Scope) error {
+	return autoConvert_core_ShootFootprintRequestSpec_To_v1beta1_ShootFootprintRequestSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootFootprintRequestStatus_To_core_ShootFootprintRequestStatus(in *ShootFootprintRequestStatus, out *core.ShootFootprintRequestStatus, s conversion.Scope) error {
+	out.Components = *(*[]core.ControlPlaneComponentFootprint)(unsafe.Pointer(&in.Components))
+	out.Total = *(*v1.ResourceList)(unsafe.Pointer(&in.Total))
+	out.CostEstimate = (*core.ShootCostEstimate)(unsafe.Pointer(in.CostEstimate))
+	return nil
+}
+
+// Convert_v1beta1_ShootFootprintRequestStatus_To_core_ShootFootprintRequestStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootFootprintRequestStatus_To_core_ShootFootprintRequestStatus(in *ShootFootprintRequestStatus, out *core.ShootFootprintRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootFootprintRequestStatus_To_core_ShootFootprintRequestStatus(in, out, s)
+}
+
+func autoConvert_core_ShootFootprintRequestStatus_To_v1beta1_ShootFootprintRequestStatus(in *core.ShootFootprintRequestStatus, out *ShootFootprintRequestStatus, s conversion.Scope) error {
+	out.Components = *(*[]ControlPlaneComponentFootprint)(unsafe.Pointer(&in.Components))
+	out.Total = *(*v1.ResourceList)(unsafe.Pointer(&in.Total))
+	out.CostEstimate = (*ShootCostEstimate)(unsafe.Pointer(in.CostEstimate))
+	return nil
+}
+
+// Convert_core_ShootFootprintRequestStatus_To_v1beta1_ShootFootprintRequestStatus is an autogenerated conversion function.
+func Convert_core_ShootFootprintRequestStatus_To_v1beta1_ShootFootprintRequestStatus(in *core.ShootFootprintRequestStatus, out *ShootFootprintRequestStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootFootprintRequestStatus_To_v1beta1_ShootFootprintRequestStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootImpactOperation_To_core_ShootImpactOperation(in *ShootImpactOperation, out *core.ShootImpactOperation, s conversion.Scope) error {
+	out.Type = core.ShootImpactOperationType(in.Type)
+	out.Target = in.Target
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	return nil
+}
+
+// Convert_v1beta1_ShootImpactOperation_To_core_ShootImpactOperation is an autogenerated conversion function.
+func Convert_v1beta1_ShootImpactOperation_To_core_ShootImpactOperation(in *ShootImpactOperation, out *core.ShootImpactOperation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootImpactOperation_To_core_ShootImpactOperation(in, out, s)
+}
+
+func autoConvert_core_ShootImpactOperation_To_v1beta1_ShootImpactOperation(in *core.ShootImpactOperation, out *ShootImpactOperation, s conversion.Scope) error {
+	out.Type = ShootImpactOperationType(in.Type)
+	out.Target = in.Target
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	return nil
+}
+
+// Convert_core_ShootImpactOperation_To_v1beta1_ShootImpactOperation is an autogenerated conversion function.
+func Convert_core_ShootImpactOperation_To_v1beta1_ShootImpactOperation(in *core.ShootImpactOperation, out *ShootImpactOperation, s conversion.Scope) error {
+	return autoConvert_core_ShootImpactOperation_To_v1beta1_ShootImpactOperation(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootImpactRequest_To_core_ShootImpactRequest(in *ShootImpactRequest, out *core.ShootImpactRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootImpactRequestSpec_To_core_ShootImpactRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ShootImpactRequestStatus_To_core_ShootImpactRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootImpactRequest_To_core_ShootImpactRequest is an autogenerated conversion function.
+func Convert_v1beta1_ShootImpactRequest_To_core_ShootImpactRequest(in *ShootImpactRequest, out *core.ShootImpactRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootImpactRequest_To_core_ShootImpactRequest(in, out, s)
+}
+
+func autoConvert_core_ShootImpactRequest_To_v1beta1_ShootImpactRequest(in *core.ShootImpactRequest, out *ShootImpactRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ShootImpactRequestSpec_To_v1beta1_ShootImpactRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_ShootImpactRequestStatus_To_v1beta1_ShootImpactRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootImpactRequest_To_v1beta1_ShootImpactRequest is an autogenerated conversion function.
+func Convert_core_ShootImpactRequest_To_v1beta1_ShootImpactRequest(in *core.ShootImpactRequest, out *ShootImpactRequest, s conversion.Scope) error {
+	return autoConvert_core_ShootImpactRequest_To_v1beta1_ShootImpactRequest(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootImpactRequestSpec_To_core_ShootImpactRequestSpec(in *ShootImpactRequestSpec, out *core.ShootImpactRequestSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_ShootSpec_To_core_ShootSpec(&in.Shoot, &out.Shoot, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootImpactRequestSpec_To_core_ShootImpactRequestSpec is an autogenerated conversion function.
+func Convert_v1beta1_ShootImpactRequestSpec_To_core_ShootImpactRequestSpec(in *ShootImpactRequestSpec, out *core.ShootImpactRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootImpactRequestSpec_To_core_ShootImpactRequestSpec(in, out, s)
+}
+
+func autoConvert_core_ShootImpactRequestSpec_To_v1beta1_ShootImpactRequestSpec(in *core.ShootImpactRequestSpec, out *ShootImpactRequestSpec, s conversion.Scope) error {
+	if err := Convert_core_ShootSpec_To_v1beta1_ShootSpec(&in.Shoot, &out.Shoot, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootImpactRequestSpec_To_v1beta1_ShootImpactRequestSpec is an autogenerated conversion function.
+func Convert_core_ShootImpactRequestSpec_To_v1beta1_ShootImpactRequestSpec(in *core.ShootImpactRequestSpec, out *ShootImpactRequestSpec, s conversion.Scope) error {
+	return autoConvert_core_ShootImpactRequestSpec_To_v1beta1_ShootImpactRequestSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootImpactRequestStatus_To_core_ShootImpactRequestStatus(in *ShootImpactRequestStatus, out *core.ShootImpactRequestStatus, s conversion.Scope) error {
+	out.Operations = *(*[]core.ShootImpactOperation)(unsafe.Pointer(&in.Operations))
+	return nil
+}
+
+// Convert_v1beta1_ShootImpactRequestStatus_To_core_ShootImpactRequestStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootImpactRequestStatus_To_core_ShootImpactRequestStatus(in *ShootImpactRequestStatus, out *core.ShootImpactRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootImpactRequestStatus_To_core_ShootImpactRequestStatus(in, out, s)
+}
+
+func autoConvert_core_ShootImpactRequestStatus_To_v1beta1_ShootImpactRequestStatus(in *core.ShootImpactRequestStatus, out *ShootImpactRequestStatus, s conversion.Scope) error {
+	out.Operations = *(*[]ShootImpactOperation)(unsafe.Pointer(&in.Operations))
+	return nil
+}
+
+// Convert_core_ShootImpactRequestStatus_To_v1beta1_ShootImpactRequestStatus is an autogenerated conversion function.
+func Convert_core_ShootImpactRequestStatus_To_v1beta1_ShootImpactRequestStatus(in *core.ShootImpactRequestStatus, out *ShootImpactRequestStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootImpactRequestStatus_To_v1beta1_ShootImpactRequestStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootInventory_To_core_ShootInventory(in *ShootInventory, out *core.ShootInventory, s conversion.Scope) error {
+	out.WorkerPools = *(*[]core.WorkerPoolInventory)(unsafe.Pointer(&in.WorkerPools))
+	return nil
+}
+
+// Convert_v1beta1_ShootInventory_To_core_ShootInventory is an autogenerated conversion function.
+func Convert_v1beta1_ShootInventory_To_core_ShootInventory(in *ShootInventory, out *core.ShootInventory, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootInventory_To_core_ShootInventory(in, out, s)
+}
+
+func autoConvert_core_ShootInventory_To_v1beta1_ShootInventory(in *core.ShootInventory, out *ShootInventory, s conversion.Scope) error {
+	out.WorkerPools = *(*[]WorkerPoolInventory)(unsafe.Pointer(&in.WorkerPools))
+	return nil
+}
+
+// Convert_core_ShootInventory_To_v1beta1_ShootInventory is an autogenerated conversion function.
+func Convert_core_ShootInventory_To_v1beta1_ShootInventory(in *core.ShootInventory, out *ShootInventory, s conversion.Scope) error {
+	return autoConvert_core_ShootInventory_To_v1beta1_ShootInventory(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
+	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation is an autogenerated conversion function.
+func Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in, out, s)
+}
+
+func autoConvert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in *core.ShootKubeconfigRotation, out *ShootKubeconfigRotation, s conversion.Scope) error {
+	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation is an autogenerated conversion function.
+func Convert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in *core.ShootKubeconfigRotation, out *ShootKubeconfigRotation, s conversion.Scope) error {
+	return autoConvert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootList_To_core_ShootList(in *ShootList, out *core.ShootList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]core.Shoot, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Shoot_To_core_Shoot(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
 	}
 	return nil
 }
@@ -7447,6 +9611,38 @@ func Convert_core_ShootNetworks_To_v1beta1_ShootNetworks(in *core.ShootNetworks,
 	return autoConvert_core_ShootNetworks_To_v1beta1_ShootNetworks(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootReconciliationTimestamps_To_core_ShootReconciliationTimestamps(in *ShootReconciliationTimestamps, out *core.ShootReconciliationTimestamps, s conversion.Scope) error {
+	out.Type = core.LastOperationType(in.Type)
+	out.FlowStarted = in.FlowStarted
+	out.InfrastructureReady = (*metav1.Time)(unsafe.Pointer(in.InfrastructureReady))
+	out.ControlPlaneReady = (*metav1.Time)(unsafe.Pointer(in.ControlPlaneReady))
+	out.NodesReady = (*metav1.Time)(unsafe.Pointer(in.NodesReady))
+	out.Finished = (*metav1.Time)(unsafe.Pointer(in.Finished))
+	out.State = core.LastOperationState(in.State)
+	return nil
+}
+
+// Convert_v1beta1_ShootReconciliationTimestamps_To_core_ShootReconciliationTimestamps is an autogenerated conversion function.
+func Convert_v1beta1_ShootReconciliationTimestamps_To_core_ShootReconciliationTimestamps(in *ShootReconciliationTimestamps, out *core.ShootReconciliationTimestamps, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootReconciliationTimestamps_To_core_ShootReconciliationTimestamps(in, out, s)
+}
+
+func autoConvert_core_ShootReconciliationTimestamps_To_v1beta1_ShootReconciliationTimestamps(in *core.ShootReconciliationTimestamps, out *ShootReconciliationTimestamps, s conversion.Scope) error {
+	out.Type = LastOperationType(in.Type)
+	out.FlowStarted = in.FlowStarted
+	out.InfrastructureReady = (*metav1.Time)(unsafe.Pointer(in.InfrastructureReady))
+	out.ControlPlaneReady = (*metav1.Time)(unsafe.Pointer(in.ControlPlaneReady))
+	out.NodesReady = (*metav1.Time)(unsafe.Pointer(in.NodesReady))
+	out.Finished = (*metav1.Time)(unsafe.Pointer(in.Finished))
+	out.State = LastOperationState(in.State)
+	return nil
+}
+
+// Convert_core_ShootReconciliationTimestamps_To_v1beta1_ShootReconciliationTimestamps is an autogenerated conversion function.
+func Convert_core_ShootReconciliationTimestamps_To_v1beta1_ShootReconciliationTimestamps(in *core.ShootReconciliationTimestamps, out *ShootReconciliationTimestamps, s conversion.Scope) error {
+	return autoConvert_core_ShootReconciliationTimestamps_To_v1beta1_ShootReconciliationTimestamps(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(in *ShootSSHKeypairRotation, out *core.ShootSSHKeypairRotation, s conversion.Scope) error {
 	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -7498,6 +9694,8 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]core.AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.Cleanup = (*core.Cleanup)(unsafe.Pointer(in.Cleanup))
+	out.ManagedAddons = *(*[]core.ManagedAddon)(unsafe.Pointer(&in.ManagedAddons))
 	return nil
 }
 
@@ -7535,6 +9733,8 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.CloudProfile = (*CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.Cleanup = (*Cleanup)(unsafe.Pointer(in.Cleanup))
+	out.ManagedAddons = *(*[]ManagedAddon)(unsafe.Pointer(&in.ManagedAddons))
 	return nil
 }
 
@@ -7639,6 +9839,10 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*core.InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*core.ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.Inventory = (*core.ShootInventory)(unsafe.Pointer(in.Inventory))
+	out.ReconciliationTimestamps = *(*[]core.ShootReconciliationTimestamps)(unsafe.Pointer(&in.ReconciliationTimestamps))
+	out.AvailabilityReports = *(*[]core.ShootAvailabilityReport)(unsafe.Pointer(&in.AvailabilityReports))
+	out.DebugPods = *(*[]core.ShootDebugPod)(unsafe.Pointer(&in.DebugPods))
 	return nil
 }
 
@@ -7671,6 +9875,10 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.Inventory = (*ShootInventory)(unsafe.Pointer(in.Inventory))
+	out.ReconciliationTimestamps = *(*[]ShootReconciliationTimestamps)(unsafe.Pointer(&in.ReconciliationTimestamps))
+	out.AvailabilityReports = *(*[]ShootAvailabilityReport)(unsafe.Pointer(&in.AvailabilityReports))
+	out.DebugPods = *(*[]ShootDebugPod)(unsafe.Pointer(&in.DebugPods))
 	return nil
 }
 
@@ -7705,6 +9913,30 @@ func Convert_core_ShootTemplate_To_v1beta1_ShootTemplate(in *core.ShootTemplate,
 	return autoConvert_core_ShootTemplate_To_v1beta1_ShootTemplate(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootValidationRule_To_core_ShootValidationRule(in *ShootValidationRule, out *core.ShootValidationRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Expression = in.Expression
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_v1beta1_ShootValidationRule_To_core_ShootValidationRule is an autogenerated conversion function.
+func Convert_v1beta1_ShootValidationRule_To_core_ShootValidationRule(in *ShootValidationRule, out *core.ShootValidationRule, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootValidationRule_To_core_ShootValidationRule(in, out, s)
+}
+
+func autoConvert_core_ShootValidationRule_To_v1beta1_ShootValidationRule(in *core.ShootValidationRule, out *ShootValidationRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Expression = in.Expression
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_core_ShootValidationRule_To_v1beta1_ShootValidationRule is an autogenerated conversion function.
+func Convert_core_ShootValidationRule_To_v1beta1_ShootValidationRule(in *core.ShootValidationRule, out *ShootValidationRule, s conversion.Scope) error {
+	return autoConvert_core_ShootValidationRule_To_v1beta1_ShootValidationRule(in, out, s)
+}
+
 func autoConvert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(in *StructuredAuthentication, out *core.StructuredAuthentication, s conversion.Scope) error {
 	out.ConfigMapName = in.ConfigMapName
 	return nil
@@ -7750,6 +9982,7 @@ func Convert_core_StructuredAuthorization_To_v1beta1_StructuredAuthorization(in
 func autoConvert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemComponents, out *core.SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*core.CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*core.NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.VolumeSnapshots = (*core.VolumeSnapshots)(unsafe.Pointer(in.VolumeSnapshots))
 	return nil
 }
 
@@ -7761,6 +9994,7 @@ func Convert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemCompone
 func autoConvert_core_SystemComponents_To_v1beta1_SystemComponents(in *core.SystemComponents, out *SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.VolumeSnapshots = (*VolumeSnapshots)(unsafe.Pointer(in.VolumeSnapshots))
 	return nil
 }
 
@@ -7772,6 +10006,7 @@ func Convert_core_SystemComponents_To_v1beta1_SystemComponents(in *core.SystemCo
 func autoConvert_v1beta1_Toleration_To_core_Toleration(in *Toleration, out *core.Toleration, s conversion.Scope) error {
 	out.Key = in.Key
 	out.Value = (*string)(unsafe.Pointer(in.Value))
+	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	return nil
 }
 
@@ -7783,6 +10018,7 @@ func Convert_v1beta1_Toleration_To_core_Toleration(in *Toleration, out *core.Tol
 func autoConvert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Toleration, s conversion.Scope) error {
 	out.Key = in.Key
 	out.Value = (*string)(unsafe.Pointer(in.Value))
+	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	return nil
 }
 
@@ -7791,6 +10027,54 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_VersionClassificationTransitions_To_core_VersionClassificationTransitions(in *VersionClassificationTransitions, out *core.VersionClassificationTransitions, s conversion.Scope) error {
+	out.SupportAfter = (*metav1.Duration)(unsafe.Pointer(in.SupportAfter))
+	out.DeprecateAfter = (*metav1.Duration)(unsafe.Pointer(in.DeprecateAfter))
+	out.ExpireAfter = (*metav1.Duration)(unsafe.Pointer(in.ExpireAfter))
+	return nil
+}
+
+// Convert_v1beta1_VersionClassificationTransitions_To_core_VersionClassificationTransitions is an autogenerated conversion function.
+func Convert_v1beta1_VersionClassificationTransitions_To_core_VersionClassificationTransitions(in *VersionClassificationTransitions, out *core.VersionClassificationTransitions, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionClassificationTransitions_To_core_VersionClassificationTransitions(in, out, s)
+}
+
+func autoConvert_core_VersionClassificationTransitions_To_v1beta1_VersionClassificationTransitions(in *core.VersionClassificationTransitions, out *VersionClassificationTransitions, s conversion.Scope) error {
+	out.SupportAfter = (*metav1.Duration)(unsafe.Pointer(in.SupportAfter))
+	out.DeprecateAfter = (*metav1.Duration)(unsafe.Pointer(in.DeprecateAfter))
+	out.ExpireAfter = (*metav1.Duration)(unsafe.Pointer(in.ExpireAfter))
+	return nil
+}
+
+// Convert_core_VersionClassificationTransitions_To_v1beta1_VersionClassificationTransitions is an autogenerated conversion function.
+func Convert_core_VersionClassificationTransitions_To_v1beta1_VersionClassificationTransitions(in *core.VersionClassificationTransitions, out *VersionClassificationTransitions, s conversion.Scope) error {
+	return autoConvert_core_VersionClassificationTransitions_To_v1beta1_VersionClassificationTransitions(in, out, s)
+}
+
+func autoConvert_v1beta1_VersionLifecyclePolicy_To_core_VersionLifecyclePolicy(in *VersionLifecyclePolicy, out *core.VersionLifecyclePolicy, s conversion.Scope) error {
+	out.Kubernetes = (*core.VersionClassificationTransitions)(unsafe.Pointer(in.Kubernetes))
+	out.MachineImages = (*core.VersionClassificationTransitions)(unsafe.Pointer(in.MachineImages))
+	out.DryRun = (*bool)(unsafe.Pointer(in.DryRun))
+	return nil
+}
+
+// Convert_v1beta1_VersionLifecyclePolicy_To_core_VersionLifecyclePolicy is an autogenerated conversion function.
+func Convert_v1beta1_VersionLifecyclePolicy_To_core_VersionLifecyclePolicy(in *VersionLifecyclePolicy, out *core.VersionLifecyclePolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionLifecyclePolicy_To_core_VersionLifecyclePolicy(in, out, s)
+}
+
+func autoConvert_core_VersionLifecyclePolicy_To_v1beta1_VersionLifecyclePolicy(in *core.VersionLifecyclePolicy, out *VersionLifecyclePolicy, s conversion.Scope) error {
+	out.Kubernetes = (*VersionClassificationTransitions)(unsafe.Pointer(in.Kubernetes))
+	out.MachineImages = (*VersionClassificationTransitions)(unsafe.Pointer(in.MachineImages))
+	out.DryRun = (*bool)(unsafe.Pointer(in.DryRun))
+	return nil
+}
+
+// Convert_core_VersionLifecyclePolicy_To_v1beta1_VersionLifecyclePolicy is an autogenerated conversion function.
+func Convert_core_VersionLifecyclePolicy_To_v1beta1_VersionLifecyclePolicy(in *core.VersionLifecyclePolicy, out *VersionLifecyclePolicy, s conversion.Scope) error {
+	return autoConvert_core_VersionLifecyclePolicy_To_v1beta1_VersionLifecyclePolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
@@ -7877,6 +10161,86 @@ func Convert_core_Volume_To_v1beta1_Volume(in *core.Volume, out *Volume, s conve
 	return autoConvert_core_Volume_To_v1beta1_Volume(in, out, s)
 }
 
+func autoConvert_v1beta1_VolumeSnapshotClass_To_core_VolumeSnapshotClass(in *VolumeSnapshotClass, out *core.VolumeSnapshotClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Driver = in.Driver
+	out.DeletionPolicy = (*core.VolumeSnapshotDeletionPolicy)(unsafe.Pointer(in.DeletionPolicy))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_v1beta1_VolumeSnapshotClass_To_core_VolumeSnapshotClass is an autogenerated conversion function.
+func Convert_v1beta1_VolumeSnapshotClass_To_core_VolumeSnapshotClass(in *VolumeSnapshotClass, out *core.VolumeSnapshotClass, s conversion.Scope) error {
+	return autoConvert_v1beta1_VolumeSnapshotClass_To_core_VolumeSnapshotClass(in, out, s)
+}
+
+func autoConvert_core_VolumeSnapshotClass_To_v1beta1_VolumeSnapshotClass(in *core.VolumeSnapshotClass, out *VolumeSnapshotClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Driver = in.Driver
+	out.DeletionPolicy = (*VolumeSnapshotDeletionPolicy)(unsafe.Pointer(in.DeletionPolicy))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_core_VolumeSnapshotClass_To_v1beta1_VolumeSnapshotClass is an autogenerated conversion function.
+func Convert_core_VolumeSnapshotClass_To_v1beta1_VolumeSnapshotClass(in *core.VolumeSnapshotClass, out *VolumeSnapshotClass, s conversion.Scope) error {
+	return autoConvert_core_VolumeSnapshotClass_To_v1beta1_VolumeSnapshotClass(in, out, s)
+}
+
+func autoConvert_v1beta1_VolumeSnapshotPolicy_To_core_VolumeSnapshotPolicy(in *VolumeSnapshotPolicy, out *core.VolumeSnapshotPolicy, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Schedule = in.Schedule
+	out.Namespace = in.Namespace
+	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.VolumeSnapshotClassName = (*string)(unsafe.Pointer(in.VolumeSnapshotClassName))
+	out.Retention = (*int32)(unsafe.Pointer(in.Retention))
+	return nil
+}
+
+// Convert_v1beta1_VolumeSnapshotPolicy_To_core_VolumeSnapshotPolicy is an autogenerated conversion function.
+func Convert_v1beta1_VolumeSnapshotPolicy_To_core_VolumeSnapshotPolicy(in *VolumeSnapshotPolicy, out *core.VolumeSnapshotPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_VolumeSnapshotPolicy_To_core_VolumeSnapshotPolicy(in, out, s)
+}
+
+func autoConvert_core_VolumeSnapshotPolicy_To_v1beta1_VolumeSnapshotPolicy(in *core.VolumeSnapshotPolicy, out *VolumeSnapshotPolicy, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Schedule = in.Schedule
+	out.Namespace = in.Namespace
+	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.VolumeSnapshotClassName = (*string)(unsafe.Pointer(in.VolumeSnapshotClassName))
+	out.Retention = (*int32)(unsafe.Pointer(in.Retention))
+	return nil
+}
+
+// Convert_core_VolumeSnapshotPolicy_To_v1beta1_VolumeSnapshotPolicy is an autogenerated conversion function.
+func Convert_core_VolumeSnapshotPolicy_To_v1beta1_VolumeSnapshotPolicy(in *core.VolumeSnapshotPolicy, out *VolumeSnapshotPolicy, s conversion.Scope) error {
+	return autoConvert_core_VolumeSnapshotPolicy_To_v1beta1_VolumeSnapshotPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_VolumeSnapshots_To_core_VolumeSnapshots(in *VolumeSnapshots, out *core.VolumeSnapshots, s conversion.Scope) error {
+	out.Classes = *(*[]core.VolumeSnapshotClass)(unsafe.Pointer(&in.Classes))
+	out.Policies = *(*[]core.VolumeSnapshotPolicy)(unsafe.Pointer(&in.Policies))
+	return nil
+}
+
+// Convert_v1beta1_VolumeSnapshots_To_core_VolumeSnapshots is an autogenerated conversion function.
+func Convert_v1beta1_VolumeSnapshots_To_core_VolumeSnapshots(in *VolumeSnapshots, out *core.VolumeSnapshots, s conversion.Scope) error {
+	return autoConvert_v1beta1_VolumeSnapshots_To_core_VolumeSnapshots(in, out, s)
+}
+
+func autoConvert_core_VolumeSnapshots_To_v1beta1_VolumeSnapshots(in *core.VolumeSnapshots, out *VolumeSnapshots, s conversion.Scope) error {
+	out.Classes = *(*[]VolumeSnapshotClass)(unsafe.Pointer(&in.Classes))
+	out.Policies = *(*[]VolumeSnapshotPolicy)(unsafe.Pointer(&in.Policies))
+	return nil
+}
+
+// Convert_core_VolumeSnapshots_To_v1beta1_VolumeSnapshots is an autogenerated conversion function.
+func Convert_core_VolumeSnapshots_To_v1beta1_VolumeSnapshots(in *core.VolumeSnapshots, out *VolumeSnapshots, s conversion.Scope) error {
+	return autoConvert_core_VolumeSnapshots_To_v1beta1_VolumeSnapshots(in, out, s)
+}
+
 func autoConvert_v1beta1_VolumeType_To_core_VolumeType(in *VolumeType, out *core.VolumeType, s conversion.Scope) error {
 	out.Class = in.Class
 	out.Name = in.Name
@@ -7960,6 +10324,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.UpdateStrategy = (*core.MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.ControlPlane = (*core.WorkerControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.Accelerators = (*core.WorkerAccelerators)(unsafe.Pointer(in.Accelerators))
 	return nil
 }
 
@@ -8003,6 +10368,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.UpdateStrategy = (*MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.ControlPlane = (*WorkerControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.Accelerators = (*WorkerAccelerators)(unsafe.Pointer(in.Accelerators))
 	return nil
 }
 
@@ -8011,6 +10377,28 @@ func Convert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s conve
 	return autoConvert_core_Worker_To_v1beta1_Worker(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerAccelerators_To_core_WorkerAccelerators(in *WorkerAccelerators, out *core.WorkerAccelerators, s conversion.Scope) error {
+	out.Vendor = core.AcceleratorVendor(in.Vendor)
+	out.ManagedDriver = (*bool)(unsafe.Pointer(in.ManagedDriver))
+	return nil
+}
+
+// Convert_v1beta1_WorkerAccelerators_To_core_WorkerAccelerators is an autogenerated conversion function.
+func Convert_v1beta1_WorkerAccelerators_To_core_WorkerAccelerators(in *WorkerAccelerators, out *core.WorkerAccelerators, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerAccelerators_To_core_WorkerAccelerators(in, out, s)
+}
+
+func autoConvert_core_WorkerAccelerators_To_v1beta1_WorkerAccelerators(in *core.WorkerAccelerators, out *WorkerAccelerators, s conversion.Scope) error {
+	out.Vendor = AcceleratorVendor(in.Vendor)
+	out.ManagedDriver = (*bool)(unsafe.Pointer(in.ManagedDriver))
+	return nil
+}
+
+// Convert_core_WorkerAccelerators_To_v1beta1_WorkerAccelerators is an autogenerated conversion function.
+func Convert_core_WorkerAccelerators_To_v1beta1_WorkerAccelerators(in *core.WorkerAccelerators, out *WorkerAccelerators, s conversion.Scope) error {
+	return autoConvert_core_WorkerAccelerators_To_v1beta1_WorkerAccelerators(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerControlPlane_To_core_WorkerControlPlane(in *WorkerControlPlane, out *core.WorkerControlPlane, s conversion.Scope) error {
 	out.Backup = (*core.Backup)(unsafe.Pointer(in.Backup))
 	out.Exposure = (*core.Exposure)(unsafe.Pointer(in.Exposure))
@@ -8071,6 +10459,36 @@ func Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in *core.WorkerKu
 	return autoConvert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerPoolInventory_To_core_WorkerPoolInventory(in *WorkerPoolInventory, out *core.WorkerPoolInventory, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Machines = in.Machines
+	out.Nodes = in.Nodes
+	out.MachinePhases = *(*[]core.MachinePhaseCount)(unsafe.Pointer(&in.MachinePhases))
+	out.Zones = *(*[]core.ZoneInventory)(unsafe.Pointer(&in.Zones))
+	out.OutdatedNodes = (*int32)(unsafe.Pointer(in.OutdatedNodes))
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolInventory_To_core_WorkerPoolInventory is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolInventory_To_core_WorkerPoolInventory(in *WorkerPoolInventory, out *core.WorkerPoolInventory, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolInventory_To_core_WorkerPoolInventory(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolInventory_To_v1beta1_WorkerPoolInventory(in *core.WorkerPoolInventory, out *WorkerPoolInventory, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Machines = in.Machines
+	out.Nodes = in.Nodes
+	out.MachinePhases = *(*[]MachinePhaseCount)(unsafe.Pointer(&in.MachinePhases))
+	out.Zones = *(*[]ZoneInventory)(unsafe.Pointer(&in.Zones))
+	out.OutdatedNodes = (*int32)(unsafe.Pointer(in.OutdatedNodes))
+	return nil
+}
+
+// Convert_core_WorkerPoolInventory_To_v1beta1_WorkerPoolInventory is an autogenerated conversion function.
+func Convert_core_WorkerPoolInventory_To_v1beta1_WorkerPoolInventory(in *core.WorkerPoolInventory, out *WorkerPoolInventory, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolInventory_To_v1beta1_WorkerPoolInventory(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *WorkerSystemComponents, out *core.WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
 	return nil
@@ -8110,3 +10528,49 @@ func autoConvert_core_WorkersSettings_To_v1beta1_WorkersSettings(in *core.Worker
 func Convert_core_WorkersSettings_To_v1beta1_WorkersSettings(in *core.WorkersSettings, out *WorkersSettings, s conversion.Scope) error {
 	return autoConvert_core_WorkersSettings_To_v1beta1_WorkersSettings(in, out, s)
 }
+
+func autoConvert_v1beta1_WorkloadDefaults_To_core_WorkloadDefaults(in *WorkloadDefaults, out *core.WorkloadDefaults, s conversion.Scope) error {
+	out.TopologySpreadConstraints = *(*[]v1.TopologySpreadConstraint)(unsafe.Pointer(&in.TopologySpreadConstraints))
+	out.Affinity = (*v1.Affinity)(unsafe.Pointer(in.Affinity))
+	return nil
+}
+
+// Convert_v1beta1_WorkloadDefaults_To_core_WorkloadDefaults is an autogenerated conversion function.
+func Convert_v1beta1_WorkloadDefaults_To_core_WorkloadDefaults(in *WorkloadDefaults, out *core.WorkloadDefaults, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkloadDefaults_To_core_WorkloadDefaults(in, out, s)
+}
+
+func autoConvert_core_WorkloadDefaults_To_v1beta1_WorkloadDefaults(in *core.WorkloadDefaults, out *WorkloadDefaults, s conversion.Scope) error {
+	out.TopologySpreadConstraints = *(*[]v1.TopologySpreadConstraint)(unsafe.Pointer(&in.TopologySpreadConstraints))
+	out.Affinity = (*v1.Affinity)(unsafe.Pointer(in.Affinity))
+	return nil
+}
+
+// Convert_core_WorkloadDefaults_To_v1beta1_WorkloadDefaults is an autogenerated conversion function.
+func Convert_core_WorkloadDefaults_To_v1beta1_WorkloadDefaults(in *core.WorkloadDefaults, out *WorkloadDefaults, s conversion.Scope) error {
+	return autoConvert_core_WorkloadDefaults_To_v1beta1_WorkloadDefaults(in, out, s)
+}
+
+func autoConvert_v1beta1_ZoneInventory_To_core_ZoneInventory(in *ZoneInventory, out *core.ZoneInventory, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Machines = in.Machines
+	out.Nodes = in.Nodes
+	return nil
+}
+
+// Convert_v1beta1_ZoneInventory_To_core_ZoneInventory is an autogenerated conversion function.
+func Convert_v1beta1_ZoneInventory_To_core_ZoneInventory(in *ZoneInventory, out *core.ZoneInventory, s conversion.Scope) error {
+	return autoConvert_v1beta1_ZoneInventory_To_core_ZoneInventory(in, out, s)
+}
+
+func autoConvert_core_ZoneInventory_To_v1beta1_ZoneInventory(in *core.ZoneInventory, out *ZoneInventory, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Machines = in.Machines
+	out.Nodes = in.Nodes
+	return nil
+}
+
+// Convert_core_ZoneInventory_To_v1beta1_ZoneInventory is an autogenerated conversion function.
+func Convert_core_ZoneInventory_To_v1beta1_ZoneInventory(in *core.ZoneInventory, out *ZoneInventory, s conversion.Scope) error {
+	return autoConvert_core_ZoneInventory_To_v1beta1_ZoneInventory(in, out, s)
+}
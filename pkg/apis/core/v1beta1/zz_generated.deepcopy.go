@@ -19,6 +19,27 @@ import (
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerAccessRestrictions) DeepCopyInto(out *APIServerAccessRestrictions) {
+	*out = *in
+	if in.SourceRanges != nil {
+		in, out := &in.SourceRanges, &out.SourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerAccessRestrictions.
+func (in *APIServerAccessRestrictions) DeepCopy() *APIServerAccessRestrictions {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerAccessRestrictions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerLogging) DeepCopyInto(out *APIServerLogging) {
 	*out = *in
@@ -788,6 +809,68 @@ func (in CapabilityValues) DeepCopy() CapabilityValues {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cleanup) DeepCopyInto(out *Cleanup) {
+	*out = *in
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = new(CleanupPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtendedAPIs != nil {
+		in, out := &in.ExtendedAPIs, &out.ExtendedAPIs
+		*out = new(CleanupPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResources != nil {
+		in, out := &in.KubernetesResources, &out.KubernetesResources
+		*out = new(CleanupPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeSnapshots != nil {
+		in, out := &in.VolumeSnapshots, &out.VolumeSnapshots
+		*out = new(CleanupPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cleanup.
+func (in *Cleanup) DeepCopy() *Cleanup {
+	if in == nil {
+		return nil
+	}
+	out := new(Cleanup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupPolicy) DeepCopyInto(out *CleanupPolicy) {
+	*out = *in
+	if in.FinalizeGracePeriod != nil {
+		in, out := &in.FinalizeGracePeriod, &out.FinalizeGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ForceFinalize != nil {
+		in, out := &in.ForceFinalize, &out.ForceFinalize
+		*out = make([]metav1.GroupKind, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupPolicy.
+func (in *CleanupPolicy) DeepCopy() *CleanupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProfile) DeepCopyInto(out *CloudProfile) {
 	*out = *in
@@ -950,6 +1033,18 @@ func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ShootValidationRules != nil {
+		in, out := &in.ShootValidationRules, &out.ShootValidationRules
+		*out = make([]ShootValidationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VersionLifecyclePolicy != nil {
+		in, out := &in.VersionLifecyclePolicy, &out.VersionLifecyclePolicy
+		*out = new(VersionLifecyclePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1140,6 +1235,13 @@ func (in *ClusterAutoscalerOptions) DeepCopyInto(out *ClusterAutoscalerOptions)
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.CapacityHints != nil {
+		in, out := &in.CapacityHints, &out.CapacityHints
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -1241,6 +1343,71 @@ func (in *ControlPlaneAutoscaling) DeepCopy() *ControlPlaneAutoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneComponentFootprint) DeepCopyInto(out *ControlPlaneComponentFootprint) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneComponentFootprint.
+func (in *ControlPlaneComponentFootprint) DeepCopy() *ControlPlaneComponentFootprint {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneComponentFootprint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneSizeClass) DeepCopyInto(out *ControlPlaneSizeClass) {
+	*out = *in
+	if in.MaxNodes != nil {
+		in, out := &in.MaxNodes, &out.MaxNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ContainerMax != nil {
+		in, out := &in.ContainerMax, &out.ContainerMax
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ContainerDefaultRequest != nil {
+		in, out := &in.ContainerDefaultRequest, &out.ContainerDefaultRequest
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneSizeClass.
+func (in *ControlPlaneSizeClass) DeepCopy() *ControlPlaneSizeClass {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneSizeClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 	*out = *in
@@ -1273,6 +1440,33 @@ func (in *ControllerDeployment) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerDeploymentCanaryRollout) DeepCopyInto(out *ControllerDeploymentCanaryRollout) {
+	*out = *in
+	in.SeedSelector.DeepCopyInto(&out.SeedSelector)
+	if in.VerificationDuration != nil {
+		in, out := &in.VerificationDuration, &out.VerificationDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MinShootReconcileSuccessRate != nil {
+		in, out := &in.MinShootReconcileSuccessRate, &out.MinShootReconcileSuccessRate
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerDeploymentCanaryRollout.
+func (in *ControllerDeploymentCanaryRollout) DeepCopy() *ControllerDeploymentCanaryRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerDeploymentCanaryRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerDeploymentList) DeepCopyInto(out *ControllerDeploymentList) {
 	*out = *in
@@ -1306,6 +1500,27 @@ func (in *ControllerDeploymentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerDeploymentRollout) DeepCopyInto(out *ControllerDeploymentRollout) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(ControllerDeploymentCanaryRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerDeploymentRollout.
+func (in *ControllerDeploymentRollout) DeepCopy() *ControllerDeploymentRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerDeploymentRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerInstallation) DeepCopyInto(out *ControllerInstallation) {
 	*out = *in
@@ -1454,6 +1669,22 @@ func (in *ControllerRegistration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerRegistrationDependency) DeepCopyInto(out *ControllerRegistrationDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerRegistrationDependency.
+func (in *ControllerRegistrationDependency) DeepCopy() *ControllerRegistrationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerRegistrationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerRegistrationDeployment) DeepCopyInto(out *ControllerRegistrationDeployment) {
 	*out = *in
@@ -1472,6 +1703,11 @@ func (in *ControllerRegistrationDeployment) DeepCopyInto(out *ControllerRegistra
 		*out = make([]DeploymentRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ControllerDeploymentRollout)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1533,6 +1769,16 @@ func (in *ControllerRegistrationSpec) DeepCopyInto(out *ControllerRegistrationSp
 		*out = new(ControllerRegistrationDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ErrorCodes != nil {
+		in, out := &in.ErrorCodes, &out.ErrorCodes
+		*out = make([]ErrorCodeDefinition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ControllerRegistrationDependency, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1579,6 +1825,11 @@ func (in *ControllerResource) DeepCopyInto(out *ControllerResource) {
 		*out = make([]ClusterType, len(*in))
 		copy(*out, *in)
 	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]ControllerResourceCapability, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1623,6 +1874,67 @@ func (in *ControllerResourceLifecycle) DeepCopy() *ControllerResourceLifecycle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerWorkersConfig) DeepCopyInto(out *ControllerWorkersConfig) {
+	*out = *in
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplicaSet != nil {
+		in, out := &in.ReplicaSet, &out.ReplicaSet
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServiceEndpoint != nil {
+		in, out := &in.ServiceEndpoint, &out.ServiceEndpoint
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GarbageCollector != nil {
+		in, out := &in.GarbageCollector, &out.GarbageCollector
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerWorkersConfig.
+func (in *ControllerWorkersConfig) DeepCopy() *ControllerWorkersConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerWorkersConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoreDNS) DeepCopyInto(out *CoreDNS) {
 	*out = *in
@@ -1701,6 +2013,11 @@ func (in *DNS) DeepCopyInto(out *DNS) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.APIServerRecord != nil {
+		in, out := &in.APIServerRecord, &out.APIServerRecord
+		*out = new(DNSAPIServerRecord)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1714,6 +2031,32 @@ func (in *DNS) DeepCopy() *DNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSAPIServerRecord) DeepCopyInto(out *DNSAPIServerRecord) {
+	*out = *in
+	if in.TTLs != nil {
+		in, out := &in.TTLs, &out.TTLs
+		*out = make([]DNSRecordTTL, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(DNSFailover)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSAPIServerRecord.
+func (in *DNSAPIServerRecord) DeepCopy() *DNSAPIServerRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSAPIServerRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSExposure) DeepCopyInto(out *DNSExposure) {
 	*out = *in
@@ -1731,52 +2074,115 @@ func (in *DNSExposure) DeepCopy() *DNSExposure {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSIncludeExclude) DeepCopyInto(out *DNSIncludeExclude) {
+func (in *DNSFailover) DeepCopyInto(out *DNSFailover) {
 	*out = *in
-	if in.Include != nil {
-		in, out := &in.Include, &out.Include
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Exclude != nil {
-		in, out := &in.Exclude, &out.Exclude
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.HealthCheck.DeepCopyInto(&out.HealthCheck)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSIncludeExclude.
-func (in *DNSIncludeExclude) DeepCopy() *DNSIncludeExclude {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSFailover.
+func (in *DNSFailover) DeepCopy() *DNSFailover {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSIncludeExclude)
+	out := new(DNSFailover)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSProvider) DeepCopyInto(out *DNSProvider) {
+func (in *DNSHealthCheck) DeepCopyInto(out *DNSHealthCheck) {
 	*out = *in
-	if in.Domains != nil {
-		in, out := &in.Domains, &out.Domains
-		*out = new(DNSIncludeExclude)
-		(*in).DeepCopyInto(*out)
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(DNSHealthCheckProtocol)
+		**out = **in
 	}
-	if in.Primary != nil {
-		in, out := &in.Primary, &out.Primary
-		*out = new(bool)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
 		**out = **in
 	}
-	if in.SecretName != nil {
-		in, out := &in.SecretName, &out.SecretName
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
 		*out = new(string)
 		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSHealthCheck.
+func (in *DNSHealthCheck) DeepCopy() *DNSHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSIncludeExclude) DeepCopyInto(out *DNSIncludeExclude) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSIncludeExclude.
+func (in *DNSIncludeExclude) DeepCopy() *DNSIncludeExclude {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSIncludeExclude)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSProvider) DeepCopyInto(out *DNSProvider) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = new(DNSIncludeExclude)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
 		**out = **in
 	}
 	if in.Zones != nil {
@@ -1802,6 +2208,22 @@ func (in *DNSProvider) DeepCopy() *DNSProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordTTL) DeepCopyInto(out *DNSRecordTTL) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordTTL.
+func (in *DNSRecordTTL) DeepCopy() *DNSRecordTTL {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordTTL)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolume) DeepCopyInto(out *DataVolume) {
 	*out = *in
@@ -1828,6 +2250,23 @@ func (in *DataVolume) DeepCopy() *DataVolume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeferredShootMaintenance) DeepCopyInto(out *DeferredShootMaintenance) {
+	*out = *in
+	in.LastDeferredTime.DeepCopyInto(&out.LastDeferredTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeferredShootMaintenance.
+func (in *DeferredShootMaintenance) DeepCopy() *DeferredShootMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(DeferredShootMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentRef) DeepCopyInto(out *DeploymentRef) {
 	*out = *in
@@ -1950,6 +2389,110 @@ func (in *ETCDEncryptionKeyRotation) DeepCopy() *ETCDEncryptionKeyRotation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Egress) DeepCopyInto(out *Egress) {
+	*out = *in
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]EgressIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NamespaceSelections != nil {
+		in, out := &in.NamespaceSelections, &out.NamespaceSelections
+		*out = make([]EgressNamespaceSelection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EgressFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Egress.
+func (in *Egress) DeepCopy() *Egress {
+	if in == nil {
+		return nil
+	}
+	out := new(Egress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressFilter) DeepCopyInto(out *EgressFilter) {
+	*out = *in
+	if in.AllowList != nil {
+		in, out := &in.AllowList, &out.AllowList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DenyList != nil {
+		in, out := &in.DenyList, &out.DenyList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressFilter.
+func (in *EgressFilter) DeepCopy() *EgressFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressIPPool) DeepCopyInto(out *EgressIPPool) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressIPPool.
+func (in *EgressIPPool) DeepCopy() *EgressIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressNamespaceSelection) DeepCopyInto(out *EgressNamespaceSelection) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressNamespaceSelection.
+func (in *EgressNamespaceSelection) DeepCopy() *EgressNamespaceSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressNamespaceSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionAtRest) DeepCopyInto(out *EncryptionAtRest) {
 	*out = *in
@@ -2031,6 +2574,22 @@ func (in *EncryptionProviderStatus) DeepCopy() *EncryptionProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorCodeDefinition) DeepCopyInto(out *ErrorCodeDefinition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorCodeDefinition.
+func (in *ErrorCodeDefinition) DeepCopy() *ErrorCodeDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorCodeDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExpirableVersion) DeepCopyInto(out *ExpirableVersion) {
 	*out = *in
@@ -2066,6 +2625,10 @@ func (in *ExpirableVersion) DeepCopy() *ExpirableVersion {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExpirableVersionStatus) DeepCopyInto(out *ExpirableVersionStatus) {
 	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2359,6 +2922,13 @@ func (in *HelmControllerDeployment) DeepCopyInto(out *HelmControllerDeployment)
 		*out = new(OCIRepository)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ValuesOverlays != nil {
+		in, out := &in.ValuesOverlays, &out.ValuesOverlays
+		*out = make([]HelmValuesOverlay, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2372,6 +2942,32 @@ func (in *HelmControllerDeployment) DeepCopy() *HelmControllerDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmValuesOverlay) DeepCopyInto(out *HelmValuesOverlay) {
+	*out = *in
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValuesOverlay.
+func (in *HelmValuesOverlay) DeepCopy() *HelmValuesOverlay {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmValuesOverlay)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Hibernation) DeepCopyInto(out *Hibernation) {
 	*out = *in
@@ -2535,6 +3131,11 @@ func (in *InPlaceUpdatesStatus) DeepCopy() *InPlaceUpdatesStatus {
 func (in *Ingress) DeepCopyInto(out *Ingress) {
 	*out = *in
 	in.Controller.DeepCopyInto(&out.Controller)
+	if in.WildcardCertificate != nil {
+		in, out := &in.WildcardCertificate, &out.WildcardCertificate
+		*out = new(IngressWildcardCertificate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2569,6 +3170,27 @@ func (in *IngressController) DeepCopy() *IngressController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressWildcardCertificate) DeepCopyInto(out *IngressWildcardCertificate) {
+	*out = *in
+	if in.IssuerName != nil {
+		in, out := &in.IssuerName, &out.IssuerName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressWildcardCertificate.
+func (in *IngressWildcardCertificate) DeepCopy() *IngressWildcardCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressWildcardCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InternalSecret) DeepCopyInto(out *InternalSecret) {
 	*out = *in
@@ -2748,6 +3370,16 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(ControlPlaneAutoscaling)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AccessRestrictions != nil {
+		in, out := &in.AccessRestrictions, &out.AccessRestrictions
+		*out = new(APIServerAccessRestrictions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeConnectivityMode != nil {
+		in, out := &in.NodeConnectivityMode, &out.NodeConnectivityMode
+		*out = new(NodeConnectivityMode)
+		**out = **in
+	}
 	return
 }
 
@@ -2790,6 +3422,16 @@ func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerCo
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ControllerWorkers != nil {
+		in, out := &in.ControllerWorkers, &out.ControllerWorkers
+		*out = new(ControllerWorkersConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeEviction != nil {
+		in, out := &in.NodeEviction, &out.NodeEviction
+		*out = new(NodeEvictionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2844,6 +3486,13 @@ func (in *KubeSchedulerConfig) DeepCopyInto(out *KubeSchedulerConfig) {
 		*out = new(SchedulingProfile)
 		**out = **in
 	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]SchedulerProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3211,6 +3860,11 @@ func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 		*out = new(ETCD)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WorkloadDefaults != nil {
+		in, out := &in.WorkloadDefaults, &out.WorkloadDefaults
+		*out = new(WorkloadDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3298,7 +3952,9 @@ func (in *KubernetesStatus) DeepCopyInto(out *KubernetesStatus) {
 	if in.Versions != nil {
 		in, out := &in.Versions, &out.Versions
 		*out = make([]ExpirableVersionStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -3426,6 +4082,11 @@ func (in *Limits) DeepCopy() *Limits {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerServicesProxyProtocol) DeepCopyInto(out *LoadBalancerServicesProxyProtocol) {
 	*out = *in
+	if in.TLVs != nil {
+		in, out := &in.TLVs, &out.TLVs
+		*out = make([]ProxyProtocolTLV, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3581,7 +4242,9 @@ func (in *MachineImageStatus) DeepCopyInto(out *MachineImageStatus) {
 	if in.Versions != nil {
 		in, out := &in.Versions, &out.Versions
 		*out = make([]ExpirableVersionStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -3642,6 +4305,22 @@ func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePhaseCount) DeepCopyInto(out *MachinePhaseCount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePhaseCount.
+func (in *MachinePhaseCount) DeepCopy() *MachinePhaseCount {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePhaseCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineType) DeepCopyInto(out *MachineType) {
 	*out = *in
@@ -3832,12 +4511,147 @@ func (in *MaintenanceCredentialsAutoRotation) DeepCopy() *MaintenanceCredentials
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceRotationConfig) DeepCopyInto(out *MaintenanceRotationConfig) {
+func (in *MaintenanceFreeze) DeepCopyInto(out *MaintenanceFreeze) {
 	*out = *in
-	if in.RotationPeriod != nil {
-		in, out := &in.RotationPeriod, &out.RotationPeriod
-		*out = new(metav1.Duration)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreeze.
+func (in *MaintenanceFreeze) DeepCopy() *MaintenanceFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceFreeze) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreezeList) DeepCopyInto(out *MaintenanceFreezeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MaintenanceFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreezeList.
+func (in *MaintenanceFreezeList) DeepCopy() *MaintenanceFreezeList {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreezeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceFreezeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreezeSpec) DeepCopyInto(out *MaintenanceFreezeSpec) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceFreezeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreezeSpec.
+func (in *MaintenanceFreezeSpec) DeepCopy() *MaintenanceFreezeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreezeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreezeStatus) DeepCopyInto(out *MaintenanceFreezeStatus) {
+	*out = *in
+	if in.DeferredShoots != nil {
+		in, out := &in.DeferredShoots, &out.DeferredShoots
+		*out = make([]DeferredShootMaintenance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreezeStatus.
+func (in *MaintenanceFreezeStatus) DeepCopy() *MaintenanceFreezeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreezeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreezeWindow) DeepCopyInto(out *MaintenanceFreezeWindow) {
+	*out = *in
+	in.Begin.DeepCopyInto(&out.Begin)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreezeWindow.
+func (in *MaintenanceFreezeWindow) DeepCopy() *MaintenanceFreezeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreezeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceRotationConfig) DeepCopyInto(out *MaintenanceRotationConfig) {
+	*out = *in
+	if in.RotationPeriod != nil {
+		in, out := &in.RotationPeriod, &out.RotationPeriod
+		*out = new(metav1.Duration)
+		**out = **in
 	}
 	return
 }
@@ -3868,6 +4682,27 @@ func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedAddon) DeepCopyInto(out *ManagedAddon) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedAddon.
+func (in *ManagedAddon) DeepCopy() *ManagedAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManualWorkerPoolRollout) DeepCopyInto(out *ManualWorkerPoolRollout) {
 	*out = *in
@@ -4119,6 +4954,11 @@ func (in *Networking) DeepCopyInto(out *Networking) {
 		*out = make([]IPFamily, len(*in))
 		copy(*out, *in)
 	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(Egress)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4189,6 +5029,18 @@ func (in *NginxIngress) DeepCopyInto(out *NginxIngress) {
 		*out = new(v1.ServiceExternalTrafficPolicy)
 		**out = **in
 	}
+	if in.LoadBalancerAnnotations != nil {
+		in, out := &in.LoadBalancerAnnotations, &out.LoadBalancerAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(NginxIngressAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4202,6 +5054,68 @@ func (in *NginxIngress) DeepCopy() *NginxIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxIngressAutoscaling) DeepCopyInto(out *NginxIngressAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxIngressAutoscaling.
+func (in *NginxIngressAutoscaling) DeepCopy() *NginxIngressAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxIngressAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeEvictionConfig) DeepCopyInto(out *NodeEvictionConfig) {
+	*out = *in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(float64)
+		**out = **in
+	}
+	if in.SecondaryRate != nil {
+		in, out := &in.SecondaryRate, &out.SecondaryRate
+		*out = new(float64)
+		**out = **in
+	}
+	if in.LargeClusterSizeThreshold != nil {
+		in, out := &in.LargeClusterSizeThreshold, &out.LargeClusterSizeThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnhealthyZoneThreshold != nil {
+		in, out := &in.UnhealthyZoneThreshold, &out.UnhealthyZoneThreshold
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeEvictionConfig.
+func (in *NodeEvictionConfig) DeepCopy() *NodeEvictionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeEvictionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeLocalDNS) DeepCopyInto(out *NodeLocalDNS) {
 	*out = *in
@@ -4529,101 +5443,219 @@ func (in *ProjectMember) DeepCopy() *ProjectMember {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *ProjectShootDefaults) DeepCopyInto(out *ProjectShootDefaults) {
 	*out = *in
-	if in.CreatedBy != nil {
-		in, out := &in.CreatedBy, &out.CreatedBy
-		*out = new(rbacv1.Subject)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Owner != nil {
-		in, out := &in.Owner, &out.Owner
-		*out = new(rbacv1.Subject)
-		**out = **in
-	}
-	if in.Purpose != nil {
-		in, out := &in.Purpose, &out.Purpose
-		*out = new(string)
-		**out = **in
-	}
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]ProjectMember, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Namespace != nil {
-		in, out := &in.Namespace, &out.Namespace
-		*out = new(string)
-		**out = **in
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(ProjectShootMaintenanceDefaults)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = new(ProjectTolerations)
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(ProjectShootHibernationDefaults)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DualApprovalForDeletion != nil {
-		in, out := &in.DualApprovalForDeletion, &out.DualApprovalForDeletion
-		*out = make([]DualApprovalForDeletion, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(ProjectShootWorkerDefaults)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShootDefaults.
+func (in *ProjectShootDefaults) DeepCopy() *ProjectShootDefaults {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(ProjectShootDefaults)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *ProjectShootHibernationDefaults) DeepCopyInto(out *ProjectShootHibernationDefaults) {
 	*out = *in
-	if in.StaleSinceTimestamp != nil {
-		in, out := &in.StaleSinceTimestamp, &out.StaleSinceTimestamp
-		*out = (*in).DeepCopy()
-	}
-	if in.StaleAutoDeleteTimestamp != nil {
-		in, out := &in.StaleAutoDeleteTimestamp, &out.StaleAutoDeleteTimestamp
-		*out = (*in).DeepCopy()
-	}
-	if in.LastActivityTimestamp != nil {
-		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
-		*out = (*in).DeepCopy()
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]HibernationSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShootHibernationDefaults.
+func (in *ProjectShootHibernationDefaults) DeepCopy() *ProjectShootHibernationDefaults {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(ProjectShootHibernationDefaults)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectTolerations) DeepCopyInto(out *ProjectTolerations) {
+func (in *ProjectShootMaintenanceDefaults) DeepCopyInto(out *ProjectShootMaintenanceDefaults) {
 	*out = *in
-	if in.Defaults != nil {
-		in, out := &in.Defaults, &out.Defaults
-		*out = make([]Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.TimeWindow != nil {
+		in, out := &in.TimeWindow, &out.TimeWindow
+		*out = new(MaintenanceTimeWindow)
+		**out = **in
+	}
+	if in.ConfineSpecUpdateRollout != nil {
+		in, out := &in.ConfineSpecUpdateRollout, &out.ConfineSpecUpdateRollout
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShootMaintenanceDefaults.
+func (in *ProjectShootMaintenanceDefaults) DeepCopy() *ProjectShootMaintenanceDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShootMaintenanceDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShootWorkerDefaults) DeepCopyInto(out *ProjectShootWorkerDefaults) {
+	*out = *in
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		*out = new(ShootMachineImage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(Volume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShootWorkerDefaults.
+func (in *ProjectShootWorkerDefaults) DeepCopy() *ProjectShootWorkerDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShootWorkerDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+	*out = *in
+	if in.CreatedBy != nil {
+		in, out := &in.CreatedBy, &out.CreatedBy
+		*out = new(rbacv1.Subject)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(rbacv1.Subject)
+		**out = **in
+	}
+	if in.Purpose != nil {
+		in, out := &in.Purpose, &out.Purpose
+		*out = new(string)
+		**out = **in
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]ProjectMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = new(ProjectTolerations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DualApprovalForDeletion != nil {
+		in, out := &in.DualApprovalForDeletion, &out.DualApprovalForDeletion
+		*out = make([]DualApprovalForDeletion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ShootDefaults != nil {
+		in, out := &in.ShootDefaults, &out.ShootDefaults
+		*out = new(ProjectShootDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+	*out = *in
+	if in.StaleSinceTimestamp != nil {
+		in, out := &in.StaleSinceTimestamp, &out.StaleSinceTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StaleAutoDeleteTimestamp != nil {
+		in, out := &in.StaleAutoDeleteTimestamp, &out.StaleAutoDeleteTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.LastActivityTimestamp != nil {
+		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTolerations) DeepCopyInto(out *ProjectTolerations) {
+	*out = *in
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = make([]Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.Whitelist != nil {
@@ -4684,6 +5716,22 @@ func (in *Provider) DeepCopy() *Provider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyProtocolTLV) DeepCopyInto(out *ProxyProtocolTLV) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyProtocolTLV.
+func (in *ProxyProtocolTLV) DeepCopy() *ProxyProtocolTLV {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyProtocolTLV)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Quota) DeepCopyInto(out *Quota) {
 	*out = *in
@@ -4863,6 +5911,104 @@ func (in *SSHAccess) DeepCopy() *SSHAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPlugin) DeepCopyInto(out *SchedulerPlugin) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPlugin.
+func (in *SchedulerPlugin) DeepCopy() *SchedulerPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPluginSet) DeepCopyInto(out *SchedulerPluginSet) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = make([]SchedulerPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = make([]SchedulerPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPluginSet.
+func (in *SchedulerPluginSet) DeepCopy() *SchedulerPluginSet {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPluginSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPlugins) DeepCopyInto(out *SchedulerPlugins) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(SchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = new(SchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPlugins.
+func (in *SchedulerPlugins) DeepCopy() *SchedulerPlugins {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPlugins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerProfile) DeepCopyInto(out *SchedulerProfile) {
+	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = new(SchedulerPlugins)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerProfile.
+func (in *SchedulerProfile) DeepCopy() *SchedulerProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 	*out = *in
@@ -5054,6 +6200,23 @@ func (in *SeedDNSProviderConfig) DeepCopy() *SeedDNSProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainStatus) DeepCopyInto(out *SeedDrainStatus) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainStatus.
+func (in *SeedDrainStatus) DeepCopy() *SeedDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedList) DeepCopyInto(out *SeedList) {
 	*out = *in
@@ -5171,6 +6334,29 @@ func (in *SeedSelector) DeepCopy() *SeedSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingControlPlaneResourceQuota) DeepCopyInto(out *SeedSettingControlPlaneResourceQuota) {
+	*out = *in
+	if in.SizeClasses != nil {
+		in, out := &in.SizeClasses, &out.SizeClasses
+		*out = make([]ControlPlaneSizeClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingControlPlaneResourceQuota.
+func (in *SeedSettingControlPlaneResourceQuota) DeepCopy() *SeedSettingControlPlaneResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingControlPlaneResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingDependencyWatchdog) DeepCopyInto(out *SeedSettingDependencyWatchdog) {
 	*out = *in
@@ -5229,6 +6415,27 @@ func (in *SeedSettingDependencyWatchdogWeeder) DeepCopy() *SeedSettingDependency
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingDrain) DeepCopyInto(out *SeedSettingDrain) {
+	*out = *in
+	if in.MaxConcurrentMigrations != nil {
+		in, out := &in.MaxConcurrentMigrations, &out.MaxConcurrentMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingDrain.
+func (in *SeedSettingDrain) DeepCopy() *SeedSettingDrain {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingDrain)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingExcessCapacityReservation) DeepCopyInto(out *SeedSettingExcessCapacityReservation) {
 	*out = *in
@@ -5284,12 +6491,28 @@ func (in *SeedSettingExcessCapacityReservationConfig) DeepCopyInto(out *SeedSett
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingExcessCapacityReservationConfig.
-func (in *SeedSettingExcessCapacityReservationConfig) DeepCopy() *SeedSettingExcessCapacityReservationConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingExcessCapacityReservationConfig.
+func (in *SeedSettingExcessCapacityReservationConfig) DeepCopy() *SeedSettingExcessCapacityReservationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingExcessCapacityReservationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingIstioTLSTermination) DeepCopyInto(out *SeedSettingIstioTLSTermination) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingIstioTLSTermination.
+func (in *SeedSettingIstioTLSTermination) DeepCopy() *SeedSettingIstioTLSTermination {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingExcessCapacityReservationConfig)
+	out := new(SeedSettingIstioTLSTermination)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5319,7 +6542,7 @@ func (in *SeedSettingLoadBalancerServices) DeepCopyInto(out *SeedSettingLoadBala
 	if in.ProxyProtocol != nil {
 		in, out := &in.ProxyProtocol, &out.ProxyProtocol
 		*out = new(LoadBalancerServicesProxyProtocol)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ZonalIngress != nil {
 		in, out := &in.ZonalIngress, &out.ZonalIngress
@@ -5383,7 +6606,7 @@ func (in *SeedSettingLoadBalancerServicesZones) DeepCopyInto(out *SeedSettingLoa
 	if in.ProxyProtocol != nil {
 		in, out := &in.ProxyProtocol, &out.ProxyProtocol
 		*out = new(LoadBalancerServicesProxyProtocol)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -5401,6 +6624,11 @@ func (in *SeedSettingLoadBalancerServicesZones) DeepCopy() *SeedSettingLoadBalan
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingScheduling) DeepCopyInto(out *SeedSettingScheduling) {
 	*out = *in
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(SchedulingProfile)
+		**out = **in
+	}
 	return
 }
 
@@ -5487,7 +6715,7 @@ func (in *SeedSettings) DeepCopyInto(out *SeedSettings) {
 	if in.Scheduling != nil {
 		in, out := &in.Scheduling, &out.Scheduling
 		*out = new(SeedSettingScheduling)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.LoadBalancerServices != nil {
 		in, out := &in.LoadBalancerServices, &out.LoadBalancerServices
@@ -5514,6 +6742,21 @@ func (in *SeedSettings) DeepCopyInto(out *SeedSettings) {
 		*out = new(SeedSettingZoneSelection)
 		**out = **in
 	}
+	if in.IstioTLSTermination != nil {
+		in, out := &in.IstioTLSTermination, &out.IstioTLSTermination
+		*out = new(SeedSettingIstioTLSTermination)
+		**out = **in
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(SeedSettingDrain)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneResourceQuota != nil {
+		in, out := &in.ControlPlaneResourceQuota, &out.ControlPlaneResourceQuota
+		*out = new(SeedSettingControlPlaneResourceQuota)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5638,6 +6881,11 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 		*out = new(LastOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(SeedDrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5755,6 +7003,11 @@ func (in *ServiceAccountConfig) DeepCopyInto(out *ServiceAccountConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProjectedTokenExpiration != nil {
+		in, out := &in.ProjectedTokenExpiration, &out.ProjectedTokenExpiration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -5856,6 +7109,46 @@ func (in *ShootAdvertisedAddress) DeepCopy() *ShootAdvertisedAddress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAvailabilityReport) DeepCopyInto(out *ShootAvailabilityReport) {
+	*out = *in
+	out.Downtime = in.Downtime
+	out.MaintenanceDowntime = in.MaintenanceDowntime
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAvailabilityReport.
+func (in *ShootAvailabilityReport) DeepCopy() *ShootAvailabilityReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAvailabilityReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCostEstimate) DeepCopyInto(out *ShootCostEstimate) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCostEstimate.
+func (in *ShootCostEstimate) DeepCopy() *ShootCostEstimate {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCostEstimate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCredentials) DeepCopyInto(out *ShootCredentials) {
 	*out = *in
@@ -5905,20 +7198,300 @@ func (in *ShootCredentialsRotation) DeepCopyInto(out *ShootCredentialsRotation)
 		*out = new(ServiceAccountKeyRotation)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ETCDEncryptionKey != nil {
-		in, out := &in.ETCDEncryptionKey, &out.ETCDEncryptionKey
-		*out = new(ETCDEncryptionKeyRotation)
-		(*in).DeepCopyInto(*out)
+	if in.ETCDEncryptionKey != nil {
+		in, out := &in.ETCDEncryptionKey, &out.ETCDEncryptionKey
+		*out = new(ETCDEncryptionKeyRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCredentialsRotation.
+func (in *ShootCredentialsRotation) DeepCopy() *ShootCredentialsRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCredentialsRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootDebugPod) DeepCopyInto(out *ShootDebugPod) {
+	*out = *in
+	in.CreationTimestamp.DeepCopyInto(&out.CreationTimestamp)
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootDebugPod.
+func (in *ShootDebugPod) DeepCopy() *ShootDebugPod {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootDebugPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootDebugPodRequest) DeepCopyInto(out *ShootDebugPodRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootDebugPodRequest.
+func (in *ShootDebugPodRequest) DeepCopy() *ShootDebugPodRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootDebugPodRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootDebugPodRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootDebugPodRequestSpec) DeepCopyInto(out *ShootDebugPodRequestSpec) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootDebugPodRequestSpec.
+func (in *ShootDebugPodRequestSpec) DeepCopy() *ShootDebugPodRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootDebugPodRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootDebugPodRequestStatus) DeepCopyInto(out *ShootDebugPodRequestStatus) {
+	*out = *in
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootDebugPodRequestStatus.
+func (in *ShootDebugPodRequestStatus) DeepCopy() *ShootDebugPodRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootDebugPodRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootFootprintRequest) DeepCopyInto(out *ShootFootprintRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootFootprintRequest.
+func (in *ShootFootprintRequest) DeepCopy() *ShootFootprintRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootFootprintRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootFootprintRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootFootprintRequestSpec) DeepCopyInto(out *ShootFootprintRequestSpec) {
+	*out = *in
+	if in.Shoot != nil {
+		in, out := &in.Shoot, &out.Shoot
+		*out = new(ShootSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootFootprintRequestSpec.
+func (in *ShootFootprintRequestSpec) DeepCopy() *ShootFootprintRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootFootprintRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootFootprintRequestStatus) DeepCopyInto(out *ShootFootprintRequestStatus) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ControlPlaneComponentFootprint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Total != nil {
+		in, out := &in.Total, &out.Total
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.CostEstimate != nil {
+		in, out := &in.CostEstimate, &out.CostEstimate
+		*out = new(ShootCostEstimate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootFootprintRequestStatus.
+func (in *ShootFootprintRequestStatus) DeepCopy() *ShootFootprintRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootFootprintRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootImpactOperation) DeepCopyInto(out *ShootImpactOperation) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootImpactOperation.
+func (in *ShootImpactOperation) DeepCopy() *ShootImpactOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootImpactOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootImpactRequest) DeepCopyInto(out *ShootImpactRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootImpactRequest.
+func (in *ShootImpactRequest) DeepCopy() *ShootImpactRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootImpactRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootImpactRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootImpactRequestSpec) DeepCopyInto(out *ShootImpactRequestSpec) {
+	*out = *in
+	in.Shoot.DeepCopyInto(&out.Shoot)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootImpactRequestSpec.
+func (in *ShootImpactRequestSpec) DeepCopy() *ShootImpactRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootImpactRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootImpactRequestStatus) DeepCopyInto(out *ShootImpactRequestStatus) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]ShootImpactOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootImpactRequestStatus.
+func (in *ShootImpactRequestStatus) DeepCopy() *ShootImpactRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootImpactRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootInventory) DeepCopyInto(out *ShootInventory) {
+	*out = *in
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPoolInventory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCredentialsRotation.
-func (in *ShootCredentialsRotation) DeepCopy() *ShootCredentialsRotation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootInventory.
+func (in *ShootInventory) DeepCopy() *ShootInventory {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootCredentialsRotation)
+	out := new(ShootInventory)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -6032,6 +7605,39 @@ func (in *ShootNetworks) DeepCopy() *ShootNetworks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootReconciliationTimestamps) DeepCopyInto(out *ShootReconciliationTimestamps) {
+	*out = *in
+	in.FlowStarted.DeepCopyInto(&out.FlowStarted)
+	if in.InfrastructureReady != nil {
+		in, out := &in.InfrastructureReady, &out.InfrastructureReady
+		*out = (*in).DeepCopy()
+	}
+	if in.ControlPlaneReady != nil {
+		in, out := &in.ControlPlaneReady, &out.ControlPlaneReady
+		*out = (*in).DeepCopy()
+	}
+	if in.NodesReady != nil {
+		in, out := &in.NodesReady, &out.NodesReady
+		*out = (*in).DeepCopy()
+	}
+	if in.Finished != nil {
+		in, out := &in.Finished, &out.Finished
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootReconciliationTimestamps.
+func (in *ShootReconciliationTimestamps) DeepCopy() *ShootReconciliationTimestamps {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootReconciliationTimestamps)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSSHKeypairRotation) DeepCopyInto(out *ShootSSHKeypairRotation) {
 	*out = *in
@@ -6172,6 +7778,18 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Cleanup != nil {
+		in, out := &in.Cleanup, &out.Cleanup
+		*out = new(Cleanup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedAddons != nil {
+		in, out := &in.ManagedAddons, &out.ManagedAddons
+		*out = make([]ManagedAddon, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6371,6 +7989,32 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(ManualWorkerPoolRollout)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = new(ShootInventory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReconciliationTimestamps != nil {
+		in, out := &in.ReconciliationTimestamps, &out.ReconciliationTimestamps
+		*out = make([]ShootReconciliationTimestamps, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AvailabilityReports != nil {
+		in, out := &in.AvailabilityReports, &out.AvailabilityReports
+		*out = make([]ShootAvailabilityReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DebugPods != nil {
+		in, out := &in.DebugPods, &out.DebugPods
+		*out = make([]ShootDebugPod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6402,6 +8046,27 @@ func (in *ShootTemplate) DeepCopy() *ShootTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootValidationRule) DeepCopyInto(out *ShootValidationRule) {
+	*out = *in
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootValidationRule.
+func (in *ShootValidationRule) DeepCopy() *ShootValidationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootValidationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StructuredAuthentication) DeepCopyInto(out *StructuredAuthentication) {
 	*out = *in
@@ -6452,6 +8117,11 @@ func (in *SystemComponents) DeepCopyInto(out *SystemComponents) {
 		*out = new(NodeLocalDNS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VolumeSnapshots != nil {
+		in, out := &in.VolumeSnapshots, &out.VolumeSnapshots
+		*out = new(VolumeSnapshots)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6473,6 +8143,10 @@ func (in *Toleration) DeepCopyInto(out *Toleration) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExpirationTimestamp != nil {
+		in, out := &in.ExpirationTimestamp, &out.ExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -6486,6 +8160,68 @@ func (in *Toleration) DeepCopy() *Toleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionClassificationTransitions) DeepCopyInto(out *VersionClassificationTransitions) {
+	*out = *in
+	if in.SupportAfter != nil {
+		in, out := &in.SupportAfter, &out.SupportAfter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DeprecateAfter != nil {
+		in, out := &in.DeprecateAfter, &out.DeprecateAfter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ExpireAfter != nil {
+		in, out := &in.ExpireAfter, &out.ExpireAfter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionClassificationTransitions.
+func (in *VersionClassificationTransitions) DeepCopy() *VersionClassificationTransitions {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionClassificationTransitions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionLifecyclePolicy) DeepCopyInto(out *VersionLifecyclePolicy) {
+	*out = *in
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(VersionClassificationTransitions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = new(VersionClassificationTransitions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionLifecyclePolicy.
+func (in *VersionLifecyclePolicy) DeepCopy() *VersionLifecyclePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionLifecyclePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	*out = *in
@@ -6637,6 +8373,100 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotClass) DeepCopyInto(out *VolumeSnapshotClass) {
+	*out = *in
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(VolumeSnapshotDeletionPolicy)
+		**out = **in
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotClass.
+func (in *VolumeSnapshotClass) DeepCopy() *VolumeSnapshotClass {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotPolicy) DeepCopyInto(out *VolumeSnapshotPolicy) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeSnapshotClassName != nil {
+		in, out := &in.VolumeSnapshotClassName, &out.VolumeSnapshotClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotPolicy.
+func (in *VolumeSnapshotPolicy) DeepCopy() *VolumeSnapshotPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshots) DeepCopyInto(out *VolumeSnapshots) {
+	*out = *in
+	if in.Classes != nil {
+		in, out := &in.Classes, &out.Classes
+		*out = make([]VolumeSnapshotClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]VolumeSnapshotPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshots.
+func (in *VolumeSnapshots) DeepCopy() *VolumeSnapshots {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshots)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeType) DeepCopyInto(out *VolumeType) {
 	*out = *in
@@ -6805,6 +8635,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(WorkerControlPlane)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Accelerators != nil {
+		in, out := &in.Accelerators, &out.Accelerators
+		*out = new(WorkerAccelerators)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6818,6 +8653,27 @@ func (in *Worker) DeepCopy() *Worker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerAccelerators) DeepCopyInto(out *WorkerAccelerators) {
+	*out = *in
+	if in.ManagedDriver != nil {
+		in, out := &in.ManagedDriver, &out.ManagedDriver
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerAccelerators.
+func (in *WorkerAccelerators) DeepCopy() *WorkerAccelerators {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerAccelerators)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerControlPlane) DeepCopyInto(out *WorkerControlPlane) {
 	*out = *in
@@ -6870,6 +8726,37 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolInventory) DeepCopyInto(out *WorkerPoolInventory) {
+	*out = *in
+	if in.MachinePhases != nil {
+		in, out := &in.MachinePhases, &out.MachinePhases
+		*out = make([]MachinePhaseCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]ZoneInventory, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutdatedNodes != nil {
+		in, out := &in.OutdatedNodes, &out.OutdatedNodes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolInventory.
+func (in *WorkerPoolInventory) DeepCopy() *WorkerPoolInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSystemComponents) DeepCopyInto(out *WorkerSystemComponents) {
 	*out = *in
@@ -6906,3 +8793,47 @@ func (in *WorkersSettings) DeepCopy() *WorkersSettings {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadDefaults) DeepCopyInto(out *WorkloadDefaults) {
+	*out = *in
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadDefaults.
+func (in *WorkloadDefaults) DeepCopy() *WorkloadDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneInventory) DeepCopyInto(out *ZoneInventory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneInventory.
+func (in *ZoneInventory) DeepCopy() *ZoneInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneInventory)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -30,6 +30,9 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&Seed{}, func(obj interface{}) { SetObjectDefaults_Seed(obj.(*Seed)) })
 	scheme.AddTypeDefaultingFunc(&SeedList{}, func(obj interface{}) { SetObjectDefaults_SeedList(obj.(*SeedList)) })
 	scheme.AddTypeDefaultingFunc(&Shoot{}, func(obj interface{}) { SetObjectDefaults_Shoot(obj.(*Shoot)) })
+	scheme.AddTypeDefaultingFunc(&ShootDebugPodRequest{}, func(obj interface{}) { SetObjectDefaults_ShootDebugPodRequest(obj.(*ShootDebugPodRequest)) })
+	scheme.AddTypeDefaultingFunc(&ShootFootprintRequest{}, func(obj interface{}) { SetObjectDefaults_ShootFootprintRequest(obj.(*ShootFootprintRequest)) })
+	scheme.AddTypeDefaultingFunc(&ShootImpactRequest{}, func(obj interface{}) { SetObjectDefaults_ShootImpactRequest(obj.(*ShootImpactRequest)) })
 	scheme.AddTypeDefaultingFunc(&ShootList{}, func(obj interface{}) { SetObjectDefaults_ShootList(obj.(*ShootList)) })
 	return nil
 }
@@ -71,6 +74,11 @@ func SetObjectDefaults_ControllerRegistration(in *ControllerRegistration) {
 	}
 	if in.Spec.Deployment != nil {
 		SetDefaults_ControllerRegistrationDeployment(in.Spec.Deployment)
+		if in.Spec.Deployment.Rollout != nil {
+			if in.Spec.Deployment.Rollout.Canary != nil {
+				SetDefaults_ControllerDeploymentCanaryRollout(in.Spec.Deployment.Rollout.Canary)
+			}
+		}
 	}
 }
 
@@ -130,6 +138,9 @@ func SetObjectDefaults_Seed(in *Seed) {
 		if in.Spec.Settings.DependencyWatchdog != nil {
 			SetDefaults_SeedSettingDependencyWatchdog(in.Spec.Settings.DependencyWatchdog)
 		}
+		if in.Spec.Settings.Drain != nil {
+			SetDefaults_SeedSettingDrain(in.Spec.Settings.Drain)
+		}
 	}
 }
 
@@ -147,6 +158,13 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 			SetDefaults_NginxIngress(in.Spec.Addons.NginxIngress)
 		}
 	}
+	if in.Spec.DNS != nil {
+		if in.Spec.DNS.APIServerRecord != nil {
+			if in.Spec.DNS.APIServerRecord.Failover != nil {
+				SetDefaults_DNSHealthCheck(&in.Spec.DNS.APIServerRecord.Failover.HealthCheck)
+			}
+		}
+	}
 	if in.Spec.Kubernetes.ClusterAutoscaler != nil {
 		SetDefaults_ClusterAutoscaler(in.Spec.Kubernetes.ClusterAutoscaler)
 	}
@@ -160,7 +178,6 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 		SetDefaults_Networking(in.Spec.Networking)
 	}
 	if in.Spec.Maintenance != nil {
-		SetDefaults_Maintenance(in.Spec.Maintenance)
 		if in.Spec.Maintenance.AutoRotation != nil {
 			if in.Spec.Maintenance.AutoRotation.Credentials != nil {
 				if in.Spec.Maintenance.AutoRotation.Credentials.Observability != nil {
@@ -178,6 +195,149 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 	for i := range in.Spec.Provider.Workers {
 		a := &in.Spec.Provider.Workers[i]
 		SetDefaults_Worker(a)
+		if a.Accelerators != nil {
+			SetDefaults_WorkerAccelerators(a.Accelerators)
+		}
+	}
+	if in.Spec.SystemComponents != nil {
+		if in.Spec.SystemComponents.VolumeSnapshots != nil {
+			for i := range in.Spec.SystemComponents.VolumeSnapshots.Classes {
+				a := &in.Spec.SystemComponents.VolumeSnapshots.Classes[i]
+				SetDefaults_VolumeSnapshotClass(a)
+			}
+			for i := range in.Spec.SystemComponents.VolumeSnapshots.Policies {
+				a := &in.Spec.SystemComponents.VolumeSnapshots.Policies[i]
+				SetDefaults_VolumeSnapshotPolicy(a)
+			}
+		}
+	}
+}
+
+func SetObjectDefaults_ShootDebugPodRequest(in *ShootDebugPodRequest) {
+	SetDefaults_ShootDebugPodRequestSpec(&in.Spec)
+}
+
+func SetObjectDefaults_ShootFootprintRequest(in *ShootFootprintRequest) {
+	if in.Spec.Shoot != nil {
+		if in.Spec.Shoot.Addons != nil {
+			if in.Spec.Shoot.Addons.NginxIngress != nil {
+				SetDefaults_NginxIngress(in.Spec.Shoot.Addons.NginxIngress)
+			}
+		}
+		if in.Spec.Shoot.DNS != nil {
+			if in.Spec.Shoot.DNS.APIServerRecord != nil {
+				if in.Spec.Shoot.DNS.APIServerRecord.Failover != nil {
+					SetDefaults_DNSHealthCheck(&in.Spec.Shoot.DNS.APIServerRecord.Failover.HealthCheck)
+				}
+			}
+		}
+		if in.Spec.Shoot.Kubernetes.ClusterAutoscaler != nil {
+			SetDefaults_ClusterAutoscaler(in.Spec.Shoot.Kubernetes.ClusterAutoscaler)
+		}
+		if in.Spec.Shoot.Kubernetes.KubeAPIServer != nil {
+			SetDefaults_KubeAPIServerConfig(in.Spec.Shoot.Kubernetes.KubeAPIServer)
+		}
+		if in.Spec.Shoot.Kubernetes.VerticalPodAutoscaler != nil {
+			SetDefaults_VerticalPodAutoscaler(in.Spec.Shoot.Kubernetes.VerticalPodAutoscaler)
+		}
+		if in.Spec.Shoot.Networking != nil {
+			SetDefaults_Networking(in.Spec.Shoot.Networking)
+		}
+		if in.Spec.Shoot.Maintenance != nil {
+			if in.Spec.Shoot.Maintenance.AutoRotation != nil {
+				if in.Spec.Shoot.Maintenance.AutoRotation.Credentials != nil {
+					if in.Spec.Shoot.Maintenance.AutoRotation.Credentials.Observability != nil {
+						SetDefaults_MaintenanceRotationConfig(in.Spec.Shoot.Maintenance.AutoRotation.Credentials.Observability)
+					}
+					if in.Spec.Shoot.Maintenance.AutoRotation.Credentials.SSHKeypair != nil {
+						SetDefaults_MaintenanceRotationConfig(in.Spec.Shoot.Maintenance.AutoRotation.Credentials.SSHKeypair)
+					}
+					if in.Spec.Shoot.Maintenance.AutoRotation.Credentials.ETCDEncryptionKey != nil {
+						SetDefaults_MaintenanceRotationConfig(in.Spec.Shoot.Maintenance.AutoRotation.Credentials.ETCDEncryptionKey)
+					}
+				}
+			}
+		}
+		for i := range in.Spec.Shoot.Provider.Workers {
+			a := &in.Spec.Shoot.Provider.Workers[i]
+			SetDefaults_Worker(a)
+			if a.Accelerators != nil {
+				SetDefaults_WorkerAccelerators(a.Accelerators)
+			}
+		}
+		if in.Spec.Shoot.SystemComponents != nil {
+			if in.Spec.Shoot.SystemComponents.VolumeSnapshots != nil {
+				for i := range in.Spec.Shoot.SystemComponents.VolumeSnapshots.Classes {
+					a := &in.Spec.Shoot.SystemComponents.VolumeSnapshots.Classes[i]
+					SetDefaults_VolumeSnapshotClass(a)
+				}
+				for i := range in.Spec.Shoot.SystemComponents.VolumeSnapshots.Policies {
+					a := &in.Spec.Shoot.SystemComponents.VolumeSnapshots.Policies[i]
+					SetDefaults_VolumeSnapshotPolicy(a)
+				}
+			}
+		}
+	}
+}
+
+func SetObjectDefaults_ShootImpactRequest(in *ShootImpactRequest) {
+	if in.Spec.Shoot.Addons != nil {
+		if in.Spec.Shoot.Addons.NginxIngress != nil {
+			SetDefaults_NginxIngress(in.Spec.Shoot.Addons.NginxIngress)
+		}
+	}
+	if in.Spec.Shoot.DNS != nil {
+		if in.Spec.Shoot.DNS.APIServerRecord != nil {
+			if in.Spec.Shoot.DNS.APIServerRecord.Failover != nil {
+				SetDefaults_DNSHealthCheck(&in.Spec.Shoot.DNS.APIServerRecord.Failover.HealthCheck)
+			}
+		}
+	}
+	if in.Spec.Shoot.Kubernetes.ClusterAutoscaler != nil {
+		SetDefaults_ClusterAutoscaler(in.Spec.Shoot.Kubernetes.ClusterAutoscaler)
+	}
+	if in.Spec.Shoot.Kubernetes.KubeAPIServer != nil {
+		SetDefaults_KubeAPIServerConfig(in.Spec.Shoot.Kubernetes.KubeAPIServer)
+	}
+	if in.Spec.Shoot.Kubernetes.VerticalPodAutoscaler != nil {
+		SetDefaults_VerticalPodAutoscaler(in.Spec.Shoot.Kubernetes.VerticalPodAutoscaler)
+	}
+	if in.Spec.Shoot.Networking != nil {
+		SetDefaults_Networking(in.Spec.Shoot.Networking)
+	}
+	if in.Spec.Shoot.Maintenance != nil {
+		if in.Spec.Shoot.Maintenance.AutoRotation != nil {
+			if in.Spec.Shoot.Maintenance.AutoRotation.Credentials != nil {
+				if in.Spec.Shoot.Maintenance.AutoRotation.Credentials.Observability != nil {
+					SetDefaults_MaintenanceRotationConfig(in.Spec.Shoot.Maintenance.AutoRotation.Credentials.Observability)
+				}
+				if in.Spec.Shoot.Maintenance.AutoRotation.Credentials.SSHKeypair != nil {
+					SetDefaults_MaintenanceRotationConfig(in.Spec.Shoot.Maintenance.AutoRotation.Credentials.SSHKeypair)
+				}
+				if in.Spec.Shoot.Maintenance.AutoRotation.Credentials.ETCDEncryptionKey != nil {
+					SetDefaults_MaintenanceRotationConfig(in.Spec.Shoot.Maintenance.AutoRotation.Credentials.ETCDEncryptionKey)
+				}
+			}
+		}
+	}
+	for i := range in.Spec.Shoot.Provider.Workers {
+		a := &in.Spec.Shoot.Provider.Workers[i]
+		SetDefaults_Worker(a)
+		if a.Accelerators != nil {
+			SetDefaults_WorkerAccelerators(a.Accelerators)
+		}
+	}
+	if in.Spec.Shoot.SystemComponents != nil {
+		if in.Spec.Shoot.SystemComponents.VolumeSnapshots != nil {
+			for i := range in.Spec.Shoot.SystemComponents.VolumeSnapshots.Classes {
+				a := &in.Spec.Shoot.SystemComponents.VolumeSnapshots.Classes[i]
+				SetDefaults_VolumeSnapshotClass(a)
+			}
+			for i := range in.Spec.Shoot.SystemComponents.VolumeSnapshots.Policies {
+				a := &in.Spec.Shoot.SystemComponents.VolumeSnapshots.Policies[i]
+				SetDefaults_VolumeSnapshotPolicy(a)
+			}
+		}
 	}
 }
 
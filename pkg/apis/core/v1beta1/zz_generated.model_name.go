@@ -9,6 +9,11 @@
 
 package v1beta1
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in APIServerAccessRestrictions) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.APIServerAccessRestrictions"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in APIServerLogging) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.APIServerLogging"
@@ -149,6 +154,16 @@ func (in CapabilityDefinition) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.CapabilityDefinition"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in Cleanup) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Cleanup"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in CleanupPolicy) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.CleanupPolicy"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in CloudProfile) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.CloudProfile"
@@ -209,16 +224,36 @@ func (in ControlPlaneAutoscaling) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneAutoscaling"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ControlPlaneComponentFootprint) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneComponentFootprint"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ControlPlaneSizeClass) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneSizeClass"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ControllerDeployment) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerDeployment"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ControllerDeploymentCanaryRollout) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerDeploymentCanaryRollout"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ControllerDeploymentList) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerDeploymentList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ControllerDeploymentRollout) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerDeploymentRollout"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ControllerInstallation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerInstallation"
@@ -244,6 +279,11 @@ func (in ControllerRegistration) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistration"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ControllerRegistrationDependency) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistrationDependency"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ControllerRegistrationDeployment) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerRegistrationDeployment"
@@ -269,6 +309,11 @@ func (in ControllerResourceLifecycle) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerResourceLifecycle"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ControllerWorkersConfig) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerWorkersConfig"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in CoreDNS) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.CoreDNS"
@@ -289,11 +334,26 @@ func (in DNS) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DNS"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in DNSAPIServerRecord) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DNSAPIServerRecord"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in DNSExposure) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DNSExposure"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in DNSFailover) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DNSFailover"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in DNSHealthCheck) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DNSHealthCheck"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in DNSIncludeExclude) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DNSIncludeExclude"
@@ -304,11 +364,21 @@ func (in DNSProvider) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DNSProvider"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in DNSRecordTTL) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DNSRecordTTL"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in DataVolume) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DataVolume"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in DeferredShootMaintenance) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DeferredShootMaintenance"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in DeploymentRef) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.DeploymentRef"
@@ -334,6 +404,26 @@ func (in ETCDEncryptionKeyRotation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ETCDEncryptionKeyRotation"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in Egress) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Egress"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in EgressFilter) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.EgressFilter"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in EgressIPPool) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.EgressIPPool"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in EgressNamespaceSelection) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.EgressNamespaceSelection"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in EncryptionAtRest) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.EncryptionAtRest"
@@ -354,6 +444,11 @@ func (in EncryptionProviderStatus) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.EncryptionProviderStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ErrorCodeDefinition) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ErrorCodeDefinition"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ExpirableVersion) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ExpirableVersion"
@@ -419,6 +514,11 @@ func (in HelmControllerDeployment) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.HelmControllerDeployment"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in HelmValuesOverlay) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.HelmValuesOverlay"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Hibernation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Hibernation"
@@ -459,6 +559,11 @@ func (in IngressController) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.IngressController"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in IngressWildcardCertificate) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.IngressWildcardCertificate"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in InternalSecret) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.InternalSecret"
@@ -599,6 +704,11 @@ func (in MachineImageVersion) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MachineImageVersion"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachinePhaseCount) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MachinePhaseCount"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MachineType) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MachineType"
@@ -629,6 +739,31 @@ func (in MaintenanceCredentialsAutoRotation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceCredentialsAutoRotation"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MaintenanceFreeze) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreeze"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MaintenanceFreezeList) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreezeList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MaintenanceFreezeSpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreezeSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MaintenanceFreezeStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreezeStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MaintenanceFreezeWindow) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreezeWindow"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MaintenanceRotationConfig) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceRotationConfig"
@@ -639,6 +774,11 @@ func (in MaintenanceTimeWindow) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeWindow"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ManagedAddon) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ManagedAddon"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ManualWorkerPoolRollout) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ManualWorkerPoolRollout"
@@ -694,6 +834,16 @@ func (in NginxIngress) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.NginxIngress"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NginxIngressAutoscaling) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.NginxIngressAutoscaling"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in NodeEvictionConfig) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.NodeEvictionConfig"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NodeLocalDNS) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.NodeLocalDNS"
@@ -744,6 +894,26 @@ func (in ProjectMember) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectMember"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ProjectShootDefaults) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectShootDefaults"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ProjectShootHibernationDefaults) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectShootHibernationDefaults"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ProjectShootMaintenanceDefaults) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectShootMaintenanceDefaults"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ProjectShootWorkerDefaults) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectShootWorkerDefaults"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ProjectSpec) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectSpec"
@@ -764,6 +934,11 @@ func (in Provider) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Provider"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ProxyProtocolTLV) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProxyProtocolTLV"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Quota) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Quota"
@@ -799,6 +974,26 @@ func (in SSHAccess) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SSHAccess"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SchedulerPlugin) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SchedulerPlugin"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SchedulerPluginSet) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SchedulerPluginSet"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SchedulerPlugins) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SchedulerPlugins"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SchedulerProfile) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SchedulerProfile"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SecretBinding) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SecretBinding"
@@ -834,6 +1029,11 @@ func (in SeedDNSProviderConfig) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedDNSProviderConfig"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedDrainStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedDrainStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SeedList) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedList"
@@ -854,6 +1054,11 @@ func (in SeedSelector) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSelector"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedSettingControlPlaneResourceQuota) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingControlPlaneResourceQuota"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SeedSettingDependencyWatchdog) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingDependencyWatchdog"
@@ -869,6 +1074,11 @@ func (in SeedSettingDependencyWatchdogWeeder) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingDependencyWatchdogWeeder"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedSettingDrain) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingDrain"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SeedSettingExcessCapacityReservation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingExcessCapacityReservation"
@@ -879,6 +1089,11 @@ func (in SeedSettingExcessCapacityReservationConfig) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingExcessCapacityReservationConfig"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedSettingIstioTLSTermination) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingIstioTLSTermination"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SeedSettingLoadBalancerServices) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingLoadBalancerServices"
@@ -969,6 +1184,16 @@ func (in ShootAdvertisedAddress) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootAdvertisedAddress"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootAvailabilityReport) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootAvailabilityReport"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootCostEstimate) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootCostEstimate"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ShootCredentials) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentials"
@@ -979,6 +1204,66 @@ func (in ShootCredentialsRotation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentialsRotation"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootDebugPod) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootDebugPod"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootDebugPodRequest) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootDebugPodRequest"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootDebugPodRequestSpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootDebugPodRequestSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootDebugPodRequestStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootDebugPodRequestStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootFootprintRequest) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootFootprintRequest"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootFootprintRequestSpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootFootprintRequestSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootFootprintRequestStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootFootprintRequestStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootImpactOperation) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootImpactOperation"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootImpactRequest) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootImpactRequest"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootImpactRequestSpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootImpactRequestSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootImpactRequestStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootImpactRequestStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootInventory) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootInventory"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ShootKubeconfigRotation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootKubeconfigRotation"
@@ -999,6 +1284,11 @@ func (in ShootNetworks) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootNetworks"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootReconciliationTimestamps) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootReconciliationTimestamps"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ShootSSHKeypairRotation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootSSHKeypairRotation"
@@ -1034,6 +1324,11 @@ func (in ShootTemplate) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootTemplate"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootValidationRule) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootValidationRule"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in StructuredAuthentication) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthentication"
@@ -1054,6 +1349,16 @@ func (in Toleration) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Toleration"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VersionClassificationTransitions) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.VersionClassificationTransitions"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VersionLifecyclePolicy) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.VersionLifecyclePolicy"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in VerticalPodAutoscaler) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler"
@@ -1064,6 +1369,21 @@ func (in Volume) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Volume"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotClass) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.VolumeSnapshotClass"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotPolicy) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.VolumeSnapshotPolicy"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshots) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.VolumeSnapshots"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in VolumeType) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.VolumeType"
@@ -1079,6 +1399,11 @@ func (in Worker) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Worker"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkerAccelerators) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkerAccelerators"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in WorkerControlPlane) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkerControlPlane"
@@ -1089,6 +1414,11 @@ func (in WorkerKubernetes) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkerKubernetes"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkerPoolInventory) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkerPoolInventory"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in WorkerSystemComponents) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkerSystemComponents"
@@ -1098,3 +1428,13 @@ func (in WorkerSystemComponents) OpenAPIModelName() string {
 func (in WorkersSettings) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkersSettings"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkloadDefaults) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkloadDefaults"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ZoneInventory) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ZoneInventory"
+}
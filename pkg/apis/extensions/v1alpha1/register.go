@@ -61,6 +61,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&WorkerList{},
 		&SelfHostedShootExposure{},
 		&SelfHostedShootExposureList{},
+		&ShootLeftover{},
+		&ShootLeftoverList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
@@ -25,6 +25,7 @@ var AllExtensionKinds = sets.New(
 	NetworkResource,
 	OperatingSystemConfigResource,
 	SelfHostedShootExposureResource,
+	ShootLeftoverResource,
 	WorkerResource,
 )
 
@@ -59,6 +59,22 @@ type BastionSpec struct {
 	UserData []byte `json:"userData"`
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy `json:"ingress"`
+	// SessionRecording contains the storage location for recorded SSH sessions. If set, the bastion instance records
+	// SSH sessions to the /var/log/gardener-bastion-sessions directory and the extension is responsible for uploading
+	// them to the given bucket.
+	// This field is immutable.
+	// +optional
+	SessionRecording *BastionSessionRecording `json:"sessionRecording,omitempty"`
+}
+
+// BastionSessionRecording contains the storage location for recorded SSH sessions of a bastion host.
+type BastionSessionRecording struct {
+	// BucketName is the name of the backup bucket of the shoot to which the recorded sessions are uploaded.
+	BucketName string `json:"bucketName"`
+	// Prefix is the object prefix within the bucket under which the recorded sessions are stored.
+	Prefix string `json:"prefix"`
+	// SecretRef is a reference to a secret with the credentials for accessing the bucket.
+	SecretRef corev1.SecretReference `json:"secretRef"`
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
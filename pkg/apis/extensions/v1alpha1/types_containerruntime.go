@@ -86,4 +86,9 @@ type ContainerRuntimeWorkerPool struct {
 type ContainerRuntimeStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
 	DefaultStatus `json:",inline"`
+	// RuntimeHandler is the name of the CRI runtime handler the extension configured on the nodes of the worker pool.
+	// If set, Gardener manages a RuntimeClass named after the container runtime type in the shoot cluster which uses
+	// this handler and selects the nodes of all worker pools the container runtime is enabled for.
+	// +optional
+	RuntimeHandler *string `json:"runtimeHandler,omitempty"`
 }
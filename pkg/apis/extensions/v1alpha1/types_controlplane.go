@@ -78,4 +78,36 @@ type ControlPlaneSpec struct {
 type ControlPlaneStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
 	DefaultStatus `json:",inline"`
+
+	// ComponentInjections contains sidecars, environment variables, and volumes which gardenlet shall inject into the
+	// control plane components it deploys. Extensions should use it instead of mutating these components via webhooks.
+	// +optional
+	ComponentInjections *ControlPlaneComponentInjections `json:"componentInjections,omitempty"`
+}
+
+// ControlPlaneComponentInjections contains the injections for the control plane components deployed by gardenlet.
+type ControlPlaneComponentInjections struct {
+	// KubeAPIServer contains the injections for the kube-apiserver.
+	// +optional
+	KubeAPIServer *ComponentInjection `json:"kubeAPIServer,omitempty"`
+	// KubeControllerManager contains the injections for the kube-controller-manager.
+	// +optional
+	KubeControllerManager *ComponentInjection `json:"kubeControllerManager,omitempty"`
+}
+
+// ComponentInjection contains sidecars, environment variables, and volumes to inject into the pods of a control plane
+// component. Entries whose names are already used by the component are not injected.
+type ComponentInjection struct {
+	// Sidecars are containers added to the pods of the component.
+	// +optional
+	Sidecars []corev1.Container `json:"sidecars,omitempty"`
+	// Env are environment variables added to the main container of the component.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// VolumeMounts are volume mounts added to the main container of the component.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// Volumes are volumes added to the pods of the component.
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
 }
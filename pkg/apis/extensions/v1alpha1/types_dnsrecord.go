@@ -7,6 +7,8 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 var _ Object = (*DNSRecord)(nil)
@@ -82,6 +84,10 @@ type DNSRecordSpec struct {
 	// TTL is the time to live in seconds. Defaults to 120.
 	// +optional
 	TTL *int64 `json:"ttl,omitempty"`
+	// Failover contains the configuration for health-checked secondary targets of the DNS record. It is only set for
+	// providers whose ControllerRegistration advertises the "DNSFailover" capability for the DNSRecord kind.
+	// +optional
+	Failover *gardencorev1beta1.DNSFailover `json:"failover,omitempty"`
 }
 
 // DNSRecordStatus is the status of a DNSRecord resource.
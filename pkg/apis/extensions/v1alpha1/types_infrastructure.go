@@ -7,6 +7,8 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 var _ Object = (*Infrastructure)(nil)
@@ -70,6 +72,10 @@ type InfrastructureSpec struct {
 	// SSHPublicKey is the public SSH key that should be used with this infrastructure.
 	// +optional
 	SSHPublicKey []byte `json:"sshPublicKey,omitempty"`
+	// Egress contains the egress configuration of the shoot cluster. Infrastructure extensions are expected to provision
+	// the static egress IP pools.
+	// +optional
+	Egress *gardencorev1beta1.Egress `json:"egress,omitempty"`
 }
 
 // InfrastructureStatus is the status for an Infrastructure resource.
@@ -89,8 +95,72 @@ type InfrastructureStatus struct {
 	// Networking contains information about cluster networking such as CIDRs.
 	// +optional
 	Networking *InfrastructureStatusNetworking `json:"networking,omitempty"`
+	// ResourceStatuses contains the provisioning status of the individual infrastructure resources (e.g., VPC, subnets, NAT
+	// gateways, security groups) managed by the acting extension controller. It helps to diagnose failed reconciliations.
+	// +optional
+	ResourceStatuses []InfrastructureResourceStatus `json:"resourceStatuses,omitempty"`
+	// Drift contains the result of the last drift detection which was requested with the 'detect-drift' operation
+	// annotation.
+	// +optional
+	Drift *InfrastructureDrift `json:"drift,omitempty"`
 }
 
+// InfrastructureDrift contains the result of a drift detection of the infrastructure.
+type InfrastructureDrift struct {
+	// State is the result of the drift detection.
+	State InfrastructureDriftState `json:"state"`
+	// Description contains details about the detected drift, e.g., which infrastructure resources deviate from the
+	// desired state, or why the drift could not be detected.
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// LastDetectionTime is the last time the drift was detected.
+	LastDetectionTime metav1.Time `json:"lastDetectionTime"`
+}
+
+// InfrastructureDriftState is the result of a drift detection of the infrastructure.
+type InfrastructureDriftState string
+
+const (
+	// InfrastructureDriftStateInSync indicates that the infrastructure resources match the desired state.
+	InfrastructureDriftStateInSync InfrastructureDriftState = "InSync"
+	// InfrastructureDriftStateDrifted indicates that the infrastructure resources deviate from the desired state.
+	InfrastructureDriftStateDrifted InfrastructureDriftState = "Drifted"
+	// InfrastructureDriftStateUnknown indicates that the drift could not be detected, e.g., because the extension does
+	// not support drift detection.
+	InfrastructureDriftStateUnknown InfrastructureDriftState = "Unknown"
+)
+
+// InfrastructureResourceStatus contains the provisioning status of an individual infrastructure resource.
+type InfrastructureResourceStatus struct {
+	// Kind is the kind of the resource, e.g., VPC, Subnet, NATGateway, or SecurityGroup.
+	Kind string `json:"kind"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+	// ID is the provider-specific identifier of the resource.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// State is the provisioning state of the resource.
+	State InfrastructureResourceState `json:"state"`
+	// Message contains details about the state of the resource, e.g., why its provisioning failed.
+	// +optional
+	Message *string `json:"message,omitempty"`
+	// LastUpdateTime is the last time the state of the resource was updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// InfrastructureResourceState is the provisioning state of an infrastructure resource.
+type InfrastructureResourceState string
+
+const (
+	// InfrastructureResourceStatePending indicates that the resource is still being provisioned.
+	InfrastructureResourceStatePending InfrastructureResourceState = "Pending"
+	// InfrastructureResourceStateReady indicates that the resource has been provisioned successfully.
+	InfrastructureResourceStateReady InfrastructureResourceState = "Ready"
+	// InfrastructureResourceStateFailed indicates that the provisioning of the resource failed.
+	InfrastructureResourceStateFailed InfrastructureResourceState = "Failed"
+)
+
 // InfrastructureStatusNetworking is a structure containing information about the node, service and pod network ranges.
 type InfrastructureStatusNetworking struct {
 	// Pods are the CIDRs of the pod network.
@@ -6,6 +6,8 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 var _ Object = (*Network)(nil)
@@ -71,6 +73,10 @@ type NetworkSpec struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/development/ipv6.md
 	// +optional
 	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
+	// Egress contains the egress configuration of the shoot cluster. Network extensions are expected to route the
+	// traffic of the selected namespaces via the respective egress IP pools.
+	// +optional
+	Egress *gardencorev1beta1.Egress `json:"egress,omitempty"`
 }
 
 // NetworkStatus is the status for an Network resource.
@@ -209,6 +209,12 @@ type OperatingSystemConfigStatus struct {
 	// +patchStrategy=merge
 	// +optional
 	ExtensionFiles []File `json:"extensionFiles,omitempty" patchMergeKey:"path" patchStrategy:"merge"`
+	// ExtensionBootstrapSteps is a list of additional bootstrap steps provided by the extension. They are executed by
+	// gardener-node-agent after all files and units have been applied, respecting the declared dependencies.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	ExtensionBootstrapSteps []BootstrapStep `json:"extensionBootstrapSteps,omitempty" patchMergeKey:"name" patchStrategy:"merge"`
 	// CloudConfig is a structure for containing the generated output for the given operating system
 	// config spec. It contains a reference to a secret as the result may contain confidential data.
 	// After Gardener v1.112, this will be only set for OperatingSystemConfigs with purpose 'provision'.
@@ -219,6 +225,21 @@ type OperatingSystemConfigStatus struct {
 	InPlaceUpdates *InPlaceUpdatesStatus `json:"inPlaceUpdates,omitempty"`
 }
 
+// BootstrapStep is an additional step executed by gardener-node-agent when reconciling the operating system
+// configuration. It allows operating system extensions to hook custom logic into the node reconciliation without
+// forking gardener-node-agent.
+type BootstrapStep struct {
+	// Name is the name of the bootstrap step.
+	Name string `json:"name"`
+	// UnitName is the name of the unit performing the step. The unit is started by gardener-node-agent, hence it is
+	// typically a unit of type 'oneshot'. It must refer to a unit in the units or extension units of the
+	// OperatingSystemConfig. The unit must be idempotent since it is started on every change of the configuration.
+	UnitName string `json:"unitName"`
+	// DependsOn is a list of names of other bootstrap steps which must have succeeded before this step is executed.
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
 // CloudConfig contains the generated output for the given operating system
 // config spec. It contains a reference to a secret as the result may contain confidential data.
 type CloudConfig struct {
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ Object = (*ShootLeftover)(nil)
+
+// ShootLeftoverResource is a constant for the name of the ShootLeftover resource.
+const ShootLeftoverResource = "ShootLeftover"
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster,path=shootleftovers,singular=shootleftover
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Type,JSONPath=".spec.type",type=string,description="The type of the cloud provider for this resource."
+// +kubebuilder:printcolumn:name=Region,JSONPath=".spec.region",type=string,description="The region in which the resources of the deleted shoot were located."
+// +kubebuilder:printcolumn:name=Cleanup,JSONPath=".spec.cleanup",type=boolean,description="Whether detected leftover resources are deleted automatically."
+// +kubebuilder:printcolumn:name=State,JSONPath=".status.lastOperation.state",type=string,description="status of the last operation, one of Aborted, Processing, Succeeded, Error, Failed"
+// +kubebuilder:printcolumn:name=Age,JSONPath=".metadata.creationTimestamp",type=date,description="creation timestamp"
+
+// ShootLeftover is a specification for detecting (and optionally cleaning up) provider resources which were left
+// behind after the deletion of a shoot cluster.
+type ShootLeftover struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the ShootLeftover.
+	// If the object's deletion timestamp is set, this field is immutable.
+	Spec ShootLeftoverSpec `json:"spec"`
+	// +optional
+	Status ShootLeftoverStatus `json:"status"`
+}
+
+// GetExtensionSpec implements Object.
+func (s *ShootLeftover) GetExtensionSpec() Spec {
+	return &s.Spec
+}
+
+// GetExtensionStatus implements Object.
+func (s *ShootLeftover) GetExtensionStatus() Status {
+	return &s.Status
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootLeftoverList is a list of ShootLeftover resources.
+type ShootLeftoverList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ShootLeftover.
+	Items []ShootLeftover `json:"items"`
+}
+
+// ShootLeftoverSpec is the spec for a ShootLeftover resource.
+type ShootLeftoverSpec struct {
+	// DefaultSpec is a structure containing common fields used by all extension resources.
+	DefaultSpec `json:",inline"`
+
+	// Region is the region in which the resources of the deleted shoot were located. This field is immutable.
+	Region string `json:"region"`
+	// TechnicalID is the technical ID of the deleted shoot. Provider resources are usually named or tagged with it.
+	// This field is immutable.
+	TechnicalID string `json:"technicalID"`
+	// SecretRef is a reference to a secret that contains the credentials to access the infrastructure account of the
+	// deleted shoot.
+	SecretRef corev1.SecretReference `json:"secretRef"`
+	// Cleanup specifies whether detected leftover resources shall be deleted by the extension controller. If false,
+	// the resources are only reported in the status.
+	// +optional
+	Cleanup bool `json:"cleanup,omitempty"`
+}
+
+// ShootLeftoverStatus is the status for a ShootLeftover resource.
+type ShootLeftoverStatus struct {
+	// DefaultStatus is a structure containing common fields used by all extension resources.
+	DefaultStatus `json:",inline"`
+
+	// Resources is the list of provider resources which still exist although the shoot was deleted.
+	// +optional
+	Resources []LeftoverResource `json:"resources,omitempty"`
+	// LastScanTime is the point in time when the infrastructure account was last scanned for leftover resources.
+	// +optional
+	LastScanTime *metav1.Time `json:"lastScanTime,omitempty"`
+}
+
+// LeftoverResource is a provider resource which was left behind after the deletion of a shoot cluster.
+type LeftoverResource struct {
+	// Kind is the provider-specific kind of the resource, e.g. `LoadBalancer`, `Disk`, or `IPAddress`.
+	Kind string `json:"kind"`
+	// ID is the provider-specific identifier of the resource.
+	ID string `json:"id"`
+	// Name is the name of the resource.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Description contains further information about the resource, e.g. why it is considered a leftover or why it
+	// could not be deleted.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
@@ -297,6 +297,23 @@ func (in *BastionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSessionRecording) DeepCopyInto(out *BastionSessionRecording) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSessionRecording.
+func (in *BastionSessionRecording) DeepCopy() *BastionSessionRecording {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSessionRecording)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 	*out = *in
@@ -313,6 +330,11 @@ func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SessionRecording != nil {
+		in, out := &in.SessionRecording, &out.SessionRecording
+		*out = new(BastionSessionRecording)
+		**out = **in
+	}
 	return
 }
 
@@ -348,6 +370,27 @@ func (in *BastionStatus) DeepCopy() *BastionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapStep) DeepCopyInto(out *BootstrapStep) {
+	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapStep.
+func (in *BootstrapStep) DeepCopy() *BootstrapStep {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CARotation) DeepCopyInto(out *CARotation) {
 	*out = *in
@@ -531,6 +574,50 @@ func (in *ClusterSpec) DeepCopy() *ClusterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentInjection) DeepCopyInto(out *ComponentInjection) {
+	*out = *in
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentInjection.
+func (in *ComponentInjection) DeepCopy() *ComponentInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerRuntime) DeepCopyInto(out *ContainerRuntime) {
 	*out = *in
@@ -614,6 +701,11 @@ func (in *ContainerRuntimeSpec) DeepCopy() *ContainerRuntimeSpec {
 func (in *ContainerRuntimeStatus) DeepCopyInto(out *ContainerRuntimeStatus) {
 	*out = *in
 	in.DefaultStatus.DeepCopyInto(&out.DefaultStatus)
+	if in.RuntimeHandler != nil {
+		in, out := &in.RuntimeHandler, &out.RuntimeHandler
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -702,6 +794,32 @@ func (in *ControlPlane) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneComponentInjections) DeepCopyInto(out *ControlPlaneComponentInjections) {
+	*out = *in
+	if in.KubeAPIServer != nil {
+		in, out := &in.KubeAPIServer, &out.KubeAPIServer
+		*out = new(ComponentInjection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeControllerManager != nil {
+		in, out := &in.KubeControllerManager, &out.KubeControllerManager
+		*out = new(ComponentInjection)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneComponentInjections.
+func (in *ControlPlaneComponentInjections) DeepCopy() *ControlPlaneComponentInjections {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneComponentInjections)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneEndpoint) DeepCopyInto(out *ControlPlaneEndpoint) {
 	*out = *in
@@ -783,6 +901,11 @@ func (in *ControlPlaneSpec) DeepCopy() *ControlPlaneSpec {
 func (in *ControlPlaneStatus) DeepCopyInto(out *ControlPlaneStatus) {
 	*out = *in
 	in.DefaultStatus.DeepCopyInto(&out.DefaultStatus)
+	if in.ComponentInjections != nil {
+		in, out := &in.ComponentInjections, &out.ComponentInjections
+		*out = new(ControlPlaneComponentInjections)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -908,6 +1031,11 @@ func (in *DNSRecordSpec) DeepCopyInto(out *DNSRecordSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(v1beta1.DNSFailover)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1358,6 +1486,28 @@ func (in *Infrastructure) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureDrift) DeepCopyInto(out *InfrastructureDrift) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	in.LastDetectionTime.DeepCopyInto(&out.LastDetectionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureDrift.
+func (in *InfrastructureDrift) DeepCopy() *InfrastructureDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfrastructureList) DeepCopyInto(out *InfrastructureList) {
 	*out = *in
@@ -1391,6 +1541,36 @@ func (in *InfrastructureList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureResourceStatus) DeepCopyInto(out *InfrastructureResourceStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureResourceStatus.
+func (in *InfrastructureResourceStatus) DeepCopy() *InfrastructureResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfrastructureSpec) DeepCopyInto(out *InfrastructureSpec) {
 	*out = *in
@@ -1401,6 +1581,11 @@ func (in *InfrastructureSpec) DeepCopyInto(out *InfrastructureSpec) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(v1beta1.Egress)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1433,6 +1618,18 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 		*out = new(InfrastructureStatusNetworking)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourceStatuses != nil {
+		in, out := &in.ResourceStatuses, &out.ResourceStatuses
+		*out = make([]InfrastructureResourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(InfrastructureDrift)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1477,6 +1674,32 @@ func (in *InfrastructureStatusNetworking) DeepCopy() *InfrastructureStatusNetwor
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeftoverResource) DeepCopyInto(out *LeftoverResource) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeftoverResource.
+func (in *LeftoverResource) DeepCopy() *LeftoverResource {
+	if in == nil {
+		return nil
+	}
+	out := new(LeftoverResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeployment) DeepCopyInto(out *MachineDeployment) {
 	*out = *in
@@ -1584,6 +1807,11 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 		*out = make([]IPFamily, len(*in))
 		copy(*out, *in)
 	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(v1beta1.Egress)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1790,6 +2018,13 @@ func (in *OperatingSystemConfigStatus) DeepCopyInto(out *OperatingSystemConfigSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExtensionBootstrapSteps != nil {
+		in, out := &in.ExtensionBootstrapSteps, &out.ExtensionBootstrapSteps
+		*out = make([]BootstrapStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CloudConfig != nil {
 		in, out := &in.CloudConfig, &out.CloudConfig
 		*out = new(CloudConfig)
@@ -2042,6 +2277,113 @@ func (in *ServiceAccountKeyRotation) DeepCopy() *ServiceAccountKeyRotation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootLeftover) DeepCopyInto(out *ShootLeftover) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootLeftover.
+func (in *ShootLeftover) DeepCopy() *ShootLeftover {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootLeftover)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootLeftover) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootLeftoverList) DeepCopyInto(out *ShootLeftoverList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShootLeftover, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootLeftoverList.
+func (in *ShootLeftoverList) DeepCopy() *ShootLeftoverList {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootLeftoverList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootLeftoverList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootLeftoverSpec) DeepCopyInto(out *ShootLeftoverSpec) {
+	*out = *in
+	in.DefaultSpec.DeepCopyInto(&out.DefaultSpec)
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootLeftoverSpec.
+func (in *ShootLeftoverSpec) DeepCopy() *ShootLeftoverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootLeftoverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootLeftoverStatus) DeepCopyInto(out *ShootLeftoverStatus) {
+	*out = *in
+	in.DefaultStatus.DeepCopyInto(&out.DefaultStatus)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]LeftoverResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastScanTime != nil {
+		in, out := &in.LastScanTime, &out.LastScanTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootLeftoverStatus.
+func (in *ShootLeftoverStatus) DeepCopy() *ShootLeftoverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootLeftoverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Unit) DeepCopyInto(out *Unit) {
 	*out = *in
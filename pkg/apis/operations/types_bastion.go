@@ -52,6 +52,18 @@ type BastionSpec struct {
 	SSHPublicKey string
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy
+	// IdleTimeout is the duration after which SSH connections to the bastion host without any activity are closed.
+	// If not set, the default configured by the Gardener operator is used. This field is immutable.
+	IdleTimeout *metav1.Duration
+	// SessionRecording configures the recording of SSH sessions on the bastion host. If not set, the default
+	// configured by the Gardener operator is used. This field is immutable.
+	SessionRecording *BastionSessionRecording
+}
+
+// BastionSessionRecording contains the configuration for recording SSH sessions on the bastion host.
+type BastionSessionRecording struct {
+	// Enabled specifies whether SSH sessions are recorded and uploaded to the backup bucket of the shoot.
+	Enabled bool
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
@@ -15,6 +15,8 @@ func (*BastionIngressPolicy) ProtoMessage() {}
 
 func (*BastionList) ProtoMessage() {}
 
+func (*BastionSessionRecording) ProtoMessage() {}
+
 func (*BastionSpec) ProtoMessage() {}
 
 func (*BastionStatus) ProtoMessage() {}
@@ -62,6 +62,20 @@ type BastionSpec struct {
 	SSHPublicKey string `json:"sshPublicKey" protobuf:"bytes,4,opt,name=sshPublicKey"`
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy `json:"ingress" protobuf:"bytes,5,opt,name=ingress"`
+	// IdleTimeout is the duration after which SSH connections to the bastion host without any activity are closed.
+	// If not set, the default configured by the Gardener operator is used. This field is immutable.
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty" protobuf:"bytes,6,opt,name=idleTimeout"`
+	// SessionRecording configures the recording of SSH sessions on the bastion host. If not set, the default
+	// configured by the Gardener operator is used. This field is immutable.
+	// +optional
+	SessionRecording *BastionSessionRecording `json:"sessionRecording,omitempty" protobuf:"bytes,7,opt,name=sessionRecording"`
+}
+
+// BastionSessionRecording contains the configuration for recording SSH sessions on the bastion host.
+type BastionSessionRecording struct {
+	// Enabled specifies whether SSH sessions are recorded and uploaded to the backup bucket of the shoot.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
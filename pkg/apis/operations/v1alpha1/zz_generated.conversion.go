@@ -15,8 +15,8 @@ import (
 	core "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operations "github.com/gardener/gardener/pkg/apis/operations"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -58,6 +58,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionSessionRecording)(nil), (*operations.BastionSessionRecording)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionSessionRecording_To_operations_BastionSessionRecording(a.(*BastionSessionRecording), b.(*operations.BastionSessionRecording), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionSessionRecording)(nil), (*BastionSessionRecording)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionSessionRecording_To_v1alpha1_BastionSessionRecording(a.(*operations.BastionSessionRecording), b.(*BastionSessionRecording), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionSpec)(nil), (*operations.BastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionSpec_To_operations_BastionSpec(a.(*BastionSpec), b.(*operations.BastionSpec), scope)
 	}); err != nil {
@@ -155,12 +165,34 @@ func Convert_operations_BastionList_To_v1alpha1_BastionList(in *operations.Basti
 	return autoConvert_operations_BastionList_To_v1alpha1_BastionList(in, out, s)
 }
 
+func autoConvert_v1alpha1_BastionSessionRecording_To_operations_BastionSessionRecording(in *BastionSessionRecording, out *operations.BastionSessionRecording, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_BastionSessionRecording_To_operations_BastionSessionRecording is an autogenerated conversion function.
+func Convert_v1alpha1_BastionSessionRecording_To_operations_BastionSessionRecording(in *BastionSessionRecording, out *operations.BastionSessionRecording, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionSessionRecording_To_operations_BastionSessionRecording(in, out, s)
+}
+
+func autoConvert_operations_BastionSessionRecording_To_v1alpha1_BastionSessionRecording(in *operations.BastionSessionRecording, out *BastionSessionRecording, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_operations_BastionSessionRecording_To_v1alpha1_BastionSessionRecording is an autogenerated conversion function.
+func Convert_operations_BastionSessionRecording_To_v1alpha1_BastionSessionRecording(in *operations.BastionSessionRecording, out *BastionSessionRecording, s conversion.Scope) error {
+	return autoConvert_operations_BastionSessionRecording_To_v1alpha1_BastionSessionRecording(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionSpec_To_operations_BastionSpec(in *BastionSpec, out *operations.BastionSpec, s conversion.Scope) error {
 	out.ShootRef = in.ShootRef
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
 	out.SSHPublicKey = in.SSHPublicKey
 	out.Ingress = *(*[]operations.BastionIngressPolicy)(unsafe.Pointer(&in.Ingress))
+	out.IdleTimeout = (*v1.Duration)(unsafe.Pointer(in.IdleTimeout))
+	out.SessionRecording = (*operations.BastionSessionRecording)(unsafe.Pointer(in.SessionRecording))
 	return nil
 }
 
@@ -175,6 +207,8 @@ func autoConvert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.B
 	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
 	out.SSHPublicKey = in.SSHPublicKey
 	out.Ingress = *(*[]BastionIngressPolicy)(unsafe.Pointer(&in.Ingress))
+	out.IdleTimeout = (*v1.Duration)(unsafe.Pointer(in.IdleTimeout))
+	out.SessionRecording = (*BastionSessionRecording)(unsafe.Pointer(in.SessionRecording))
 	return nil
 }
 
@@ -184,10 +218,10 @@ func Convert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.Basti
 }
 
 func autoConvert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatus, out *operations.BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
 	return nil
 }
@@ -198,10 +232,10 @@ func Convert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatu
 }
 
 func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operations.BastionStatus, out *BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
 	return nil
 }
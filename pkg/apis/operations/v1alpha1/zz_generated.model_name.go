@@ -24,6 +24,11 @@ func (in BastionList) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.BastionList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in BastionSessionRecording) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSessionRecording"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in BastionSpec) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSpec"
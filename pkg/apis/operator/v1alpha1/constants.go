@@ -32,6 +32,9 @@ const (
 	// OperationRotateWorkloadIdentityKeyComplete is a constant for an annotation on a Shoot indicating that the
 	// rotation of the workload identity signing key shall be completed.
 	OperationRotateWorkloadIdentityKeyComplete = "rotate-workload-identity-key-complete"
+	// OperationRestoreETCDMain is a constant for an annotation on a Garden indicating that the main etcd of the
+	// virtual garden shall be restored from its backups.
+	OperationRestoreETCDMain = "restore-etcd-main"
 
 	// VirtualGardenNamePrefix is a constant to prefix various resource names for the virtual garden.
 	VirtualGardenNamePrefix = "virtual-garden-"
@@ -54,6 +54,10 @@ type ExtensionSpec struct {
 	// Deployment contains deployment configuration for an extension and it's admission controller.
 	// +optional
 	Deployment *Deployment `json:"deployment,omitempty"`
+	// ErrorCodes is a list of error codes which may be reported by the extension in addition to the well-known error
+	// codes of Gardener.
+	// +optional
+	ErrorCodes []gardencorev1beta1.ErrorCodeDefinition `json:"errorCodes,omitempty"`
 }
 
 // Deployment specifies how an extension can be installed for a Gardener landscape. It includes the specification
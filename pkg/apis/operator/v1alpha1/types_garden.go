@@ -269,6 +269,9 @@ type ETCDMain struct {
 	// Backup contains the object store configuration for backups for the virtual garden etcd.
 	// +optional
 	Backup *Backup `json:"backup,omitempty"`
+	// Quota is the DB size limit (quota-backend-bytes) of etcd. It must not exceed the storage capacity. Defaults to 8Gi.
+	// +optional
+	Quota *resource.Quantity `json:"quota,omitempty"`
 	// Storage contains storage configuration.
 	// +optional
 	Storage *Storage `json:"storage,omitempty"`
@@ -279,6 +282,9 @@ type ETCDEvents struct {
 	// Autoscaling contains auto-scaling configuration options for etcd.
 	// +optional
 	Autoscaling *gardencorev1beta1.ControlPlaneAutoscaling `json:"autoscaling,omitempty"`
+	// Quota is the DB size limit (quota-backend-bytes) of etcd. It must not exceed the storage capacity. Defaults to 8Gi.
+	// +optional
+	Quota *resource.Quantity `json:"quota,omitempty"`
 	// Storage contains storage configuration.
 	// +optional
 	Storage *Storage `json:"storage,omitempty"`
@@ -316,12 +322,69 @@ type Backup struct {
 	// SecretRef is a reference to a Secret object containing the cloud provider credentials for the object store where
 	// backups should be stored. It should have enough privileges to manipulate the objects as well as buckets.
 	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+	// FullSnapshotSchedule is the cron schedule for taking full snapshots of the virtual garden etcd. If not provided,
+	// a schedule within the maintenance time window of the Garden is computed.
+	// +optional
+	FullSnapshotSchedule *string `json:"fullSnapshotSchedule,omitempty"`
+	// DeltaSnapshotRetentionPeriod is the retention period for delta snapshots of the virtual garden etcd.
+	// +optional
+	DeltaSnapshotRetentionPeriod *metav1.Duration `json:"deltaSnapshotRetentionPeriod,omitempty"`
+	// RestoreMode is the mode in which a restore of the virtual garden etcd is performed when requested via the
+	// 'restore-etcd-main' operation annotation. In 'Drill' mode, a full snapshot is taken right before the etcd data is
+	// wiped, so that no data is lost. In 'Recovery' mode, the etcd is restored from the latest existing backups without
+	// taking a snapshot first, which is required if the current data is corrupted. Defaults to 'Drill'.
+	// +kubebuilder:validation:Enum=Drill;Recovery
+	// +optional
+	RestoreMode *RestoreMode `json:"restoreMode,omitempty"`
 }
 
+// RestoreMode is the mode in which a restore of the virtual garden etcd is performed.
+type RestoreMode string
+
+const (
+	// RestoreModeDrill is the restore mode in which a full snapshot is taken before the etcd data is wiped.
+	RestoreModeDrill RestoreMode = "Drill"
+	// RestoreModeRecovery is the restore mode in which the etcd data is restored from the latest existing backups.
+	RestoreModeRecovery RestoreMode = "Recovery"
+)
+
 // Maintenance contains information about the time window for maintenance operations.
 type Maintenance struct {
 	// TimeWindow contains information about the time window for maintenance operations.
 	TimeWindow gardencorev1beta1.MaintenanceTimeWindow `json:"timeWindow"`
+	// AutoRotation contains information about which rotations should be automatically performed.
+	// +optional
+	AutoRotation *MaintenanceAutoRotation `json:"autoRotation,omitempty"`
+}
+
+// MaintenanceAutoRotation contains information about which rotations should be automatically performed.
+type MaintenanceAutoRotation struct {
+	// Credentials contains information about which credentials should be automatically rotated.
+	// +optional
+	Credentials *MaintenanceCredentialsAutoRotation `json:"credentials,omitempty"`
+}
+
+// MaintenanceCredentialsAutoRotation contains information about which credentials should be automatically rotated.
+type MaintenanceCredentialsAutoRotation struct {
+	// CertificateAuthorities configures the automatic rotation for the certificate authorities.
+	// +optional
+	CertificateAuthorities *MaintenancePhasedRotationConfig `json:"certificateAuthorities,omitempty"`
+	// ServiceAccountKey configures the automatic rotation for the service account signing key.
+	// +optional
+	ServiceAccountKey *MaintenancePhasedRotationConfig `json:"serviceAccountKey,omitempty"`
+}
+
+// MaintenancePhasedRotationConfig contains configuration for the automatic rotation of credentials which are rotated
+// in two phases. Both the start and the completion of the rotation are only performed within the maintenance time
+// window.
+type MaintenancePhasedRotationConfig struct {
+	// RotationPeriod is the period between a completed rotation and the start of a new rotation. The allowed rotation
+	// period is between 24h and 730d.
+	RotationPeriod metav1.Duration `json:"rotationPeriod"`
+	// CompletionDelay is the minimum period between the end of the preparation phase and the automatic completion of
+	// the rotation. Clients must adopt the new credentials within this period (default: 7d).
+	// +optional
+	CompletionDelay *metav1.Duration `json:"completionDelay,omitempty"`
 }
 
 // ControlPlane holds information about the general settings for the control plane of the virtual garden cluster.
@@ -798,6 +861,34 @@ type GardenStatus struct {
 	// This field will be removed in favor of `status.credentials.encryptionAtRest.resources`.
 	// +optional
 	EncryptedResources []string `json:"encryptedResources,omitempty"`
+	// Backup contains information about the backups of the virtual garden etcd.
+	// +optional
+	Backup *BackupStatus `json:"backup,omitempty"`
+}
+
+// BackupStatus contains information about the backups of the virtual garden etcd.
+type BackupStatus struct {
+	// LastFullSnapshotTime is the time when the last full snapshot of the virtual garden etcd was taken.
+	// +optional
+	LastFullSnapshotTime *metav1.Time `json:"lastFullSnapshotTime,omitempty"`
+	// LastDeltaSnapshotTime is the time when the last delta snapshot of the virtual garden etcd was taken.
+	// +optional
+	LastDeltaSnapshotTime *metav1.Time `json:"lastDeltaSnapshotTime,omitempty"`
+	// Restore contains information about the restore of the virtual garden etcd.
+	// +optional
+	Restore *BackupRestoreStatus `json:"restore,omitempty"`
+}
+
+// BackupRestoreStatus contains information about the restore of the virtual garden etcd.
+type BackupRestoreStatus struct {
+	// Mode is the mode in which the last restore was performed.
+	Mode RestoreMode `json:"mode"`
+	// LastInitiationTime is the most recent time when a restore was initiated.
+	// +optional
+	LastInitiationTime *metav1.Time `json:"lastInitiationTime,omitempty"`
+	// LastCompletionTime is the most recent time when a restore was successfully completed.
+	// +optional
+	LastCompletionTime *metav1.Time `json:"lastCompletionTime,omitempty"`
 }
 
 // Credentials contains information about the virtual garden cluster credentials.
@@ -876,6 +967,9 @@ const (
 	VirtualGardenAPIServerAvailable gardencorev1beta1.ConditionType = "VirtualGardenAPIServerAvailable"
 	// ObservabilityComponentsHealthy is a constant for a condition type indicating the health of observability components.
 	ObservabilityComponentsHealthy gardencorev1beta1.ConditionType = v1beta1constants.ObservabilityComponentsHealthy
+	// VirtualGardenETCDCapacity is a constant for a condition type indicating whether the DB sizes of the virtual
+	// garden's etcds are sufficiently below their quotas.
+	VirtualGardenETCDCapacity gardencorev1beta1.ConditionType = "VirtualGardenETCDCapacity"
 )
 
 // AvailableOperationAnnotations is the set of available operation annotations for Garden resources.
@@ -893,6 +987,7 @@ var AvailableOperationAnnotations = sets.New(
 	v1beta1constants.OperationRotateCredentialsComplete,
 	OperationRotateWorkloadIdentityKeyStart,
 	OperationRotateWorkloadIdentityKeyComplete,
+	OperationRestoreETCDMain,
 )
 
 // FinalizerName is the name of the finalizer used by gardener-operator.
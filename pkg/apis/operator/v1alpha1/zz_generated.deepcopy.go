@@ -142,6 +142,21 @@ func (in *Backup) DeepCopyInto(out *Backup) {
 		**out = **in
 	}
 	out.SecretRef = in.SecretRef
+	if in.FullSnapshotSchedule != nil {
+		in, out := &in.FullSnapshotSchedule, &out.FullSnapshotSchedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeltaSnapshotRetentionPeriod != nil {
+		in, out := &in.DeltaSnapshotRetentionPeriod, &out.DeltaSnapshotRetentionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RestoreMode != nil {
+		in, out := &in.RestoreMode, &out.RestoreMode
+		*out = new(RestoreMode)
+		**out = **in
+	}
 	return
 }
 
@@ -155,6 +170,59 @@ func (in *Backup) DeepCopy() *Backup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRestoreStatus) DeepCopyInto(out *BackupRestoreStatus) {
+	*out = *in
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRestoreStatus.
+func (in *BackupRestoreStatus) DeepCopy() *BackupRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
+	*out = *in
+	if in.LastFullSnapshotTime != nil {
+		in, out := &in.LastFullSnapshotTime, &out.LastFullSnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDeltaSnapshotTime != nil {
+		in, out := &in.LastDeltaSnapshotTime, &out.LastDeltaSnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(BackupRestoreStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
+func (in *BackupStatus) DeepCopy() *BackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
 	*out = *in
@@ -541,6 +609,11 @@ func (in *ETCDEvents) DeepCopyInto(out *ETCDEvents) {
 		*out = new(v1beta1.ControlPlaneAutoscaling)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
 		*out = new(Storage)
@@ -572,6 +645,11 @@ func (in *ETCDMain) DeepCopyInto(out *ETCDMain) {
 		*out = new(Backup)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
 		*out = new(Storage)
@@ -767,6 +845,11 @@ func (in *ExtensionSpec) DeepCopyInto(out *ExtensionSpec) {
 		*out = new(Deployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ErrorCodes != nil {
+		in, out := &in.ErrorCodes, &out.ErrorCodes
+		*out = make([]v1beta1.ErrorCodeDefinition, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -955,6 +1038,11 @@ func (in *GardenStatus) DeepCopyInto(out *GardenStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1416,6 +1504,11 @@ func (in *Kubernetes) DeepCopy() *Kubernetes {
 func (in *Maintenance) DeepCopyInto(out *Maintenance) {
 	*out = *in
 	out.TimeWindow = in.TimeWindow
+	if in.AutoRotation != nil {
+		in, out := &in.AutoRotation, &out.AutoRotation
+		*out = new(MaintenanceAutoRotation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1429,6 +1522,75 @@ func (in *Maintenance) DeepCopy() *Maintenance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceAutoRotation) DeepCopyInto(out *MaintenanceAutoRotation) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(MaintenanceCredentialsAutoRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceAutoRotation.
+func (in *MaintenanceAutoRotation) DeepCopy() *MaintenanceAutoRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceAutoRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceCredentialsAutoRotation) DeepCopyInto(out *MaintenanceCredentialsAutoRotation) {
+	*out = *in
+	if in.CertificateAuthorities != nil {
+		in, out := &in.CertificateAuthorities, &out.CertificateAuthorities
+		*out = new(MaintenancePhasedRotationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountKey != nil {
+		in, out := &in.ServiceAccountKey, &out.ServiceAccountKey
+		*out = new(MaintenancePhasedRotationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceCredentialsAutoRotation.
+func (in *MaintenanceCredentialsAutoRotation) DeepCopy() *MaintenanceCredentialsAutoRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceCredentialsAutoRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePhasedRotationConfig) DeepCopyInto(out *MaintenancePhasedRotationConfig) {
+	*out = *in
+	out.RotationPeriod = in.RotationPeriod
+	if in.CompletionDelay != nil {
+		in, out := &in.CompletionDelay, &out.CompletionDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePhasedRotationConfig.
+func (in *MaintenancePhasedRotationConfig) DeepCopy() *MaintenancePhasedRotationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePhasedRotationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Networking) DeepCopyInto(out *Networking) {
 	*out = *in
@@ -1815,7 +1977,7 @@ func (in *VirtualCluster) DeepCopyInto(out *VirtualCluster) {
 	}
 	in.Gardener.DeepCopyInto(&out.Gardener)
 	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
-	out.Maintenance = in.Maintenance
+	in.Maintenance.DeepCopyInto(&out.Maintenance)
 	in.Networking.DeepCopyInto(&out.Networking)
 	return
 }
@@ -38,6 +38,10 @@ const (
 	// true then the controller will keep the resource requests and limits in Pod templates (e.g. in a
 	// DeploymentSpec) during updates to the resource. This applies for all containers.
 	PreserveResources = "resources.gardener.cloud/preserve-resources"
+	// PreserveFields is a constant for an annotation on a resource managed by a ManagedResource. Its value is a
+	// comma-separated list of field paths (e.g. `spec.minReadySeconds`) whose values the controller keeps during updates
+	// to the resource. The path segments are separated by dots and may only address fields of nested objects.
+	PreserveFields = "resources.gardener.cloud/preserve-fields"
 	// OriginAnnotation is a constant for an annotation on a resource managed by a ManagedResource.
 	// It is set by the ManagedResource controller to the key of the owning ManagedResource, optionally prefixed with the
 	// clusterID.
@@ -108,6 +112,13 @@ const (
 	// ProjectedTokenExpirationSeconds is a constant for an annotation on a Pod which overwrites the default token expiration
 	// seconds for the automatic mount of a projected ServiceAccount token.
 	ProjectedTokenExpirationSeconds = "projected-token-mount.resources.gardener.cloud/expiration-seconds"
+	// ProjectedTokenKubeconfigs is a constant for an annotation on a Pod which contains a JSON-encoded list of
+	// kubeconfigs for further clusters that shall be mounted into the Pod. Each kubeconfig uses a projected
+	// ServiceAccount token with a dedicated audience.
+	ProjectedTokenKubeconfigs = "projected-token-mount.resources.gardener.cloud/kubeconfigs"
+	// ProjectedTokenKubeconfigPrefix is a prefix for annotations on a Pod which contain the generated kubeconfigs for
+	// the entries of the ProjectedTokenKubeconfigs annotation. They are projected into the Pod via the downward API.
+	ProjectedTokenKubeconfigPrefix = "projected-token-mount.resources.gardener.cloud/kubeconfig-"
 
 	// HighAvailabilityConfigConsider is a constant for a label on a Namespace which indicates that the workload
 	// resources in this namespace should be considered by the HA config webhook.
@@ -155,6 +166,18 @@ const (
 	// adding the pod-template-hash selector to the topology spread constraint.
 	PodTopologySpreadConstraintsSkip = "topology-spread-constraints.resources.gardener.cloud/skip"
 
+	// WorkloadDefaultsSkip is a constant for a label on a Pod or a Namespace which indicates that the Pod (or all Pods
+	// in the Namespace) should not be considered for adding the default topology spread constraints and affinity.
+	WorkloadDefaultsSkip = "workload-defaults.resources.gardener.cloud/skip"
+
+	// ImageOverridesConsider is a constant for a label on a Namespace which indicates that the workload resources in
+	// this namespace should be considered by the image-overrides webhook.
+	ImageOverridesConsider = "image-overrides.resources.gardener.cloud/consider"
+	// ImageOverrides is a constant for an annotation on a Namespace which contains a JSON-encoded map from image
+	// repositories to the images which should be used instead for all containers of workload resources in this
+	// namespace.
+	ImageOverrides = "image-overrides.resources.gardener.cloud/images"
+
 	// EndpointSliceHintsConsider is a constant for a label on an Service which indicates that the EndpointSlices of the
 	// Service should be considered by the EndpointSlice hints webhook. This label is added to the Service object, Kubernetes
 	// maintains the Service label as EndpointSlice label. Finally, the EndpointSlice hints webhook mutates EndpointSlice resources
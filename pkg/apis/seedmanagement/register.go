@@ -41,6 +41,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ManagedSeedList{},
 		&ManagedSeedSet{},
 		&ManagedSeedSetList{},
+		&SeedTemplate{},
+		&SeedTemplateList{},
 	)
 
 	return nil
@@ -79,6 +79,17 @@ type GardenletConfig struct {
 	// MergeWithParent specifies whether the GardenletConfiguration of the parent gardenlet
 	// should be merged with the specified GardenletConfiguration. Defaults to true. This field is immutable.
 	MergeWithParent *bool
+	// SeedTemplateRef references a SeedTemplate whose seed template (patched with the given overrides) is rendered into
+	// the seedConfig of the GardenletConfiguration.
+	SeedTemplateRef *SeedTemplateReference
+}
+
+// SeedTemplateReference references a SeedTemplate and optionally overrides parts of its seed template.
+type SeedTemplateReference struct {
+	// Name is the name of the referenced SeedTemplate.
+	Name string
+	// Overrides is a JSON merge patch (RFC 7386) which is applied to the seed template of the referenced SeedTemplate.
+	Overrides *runtime.RawExtension
 }
 
 // GardenletDeployment specifies certain gardenlet deployment parameters, such as the number of replicas,
@@ -84,6 +84,16 @@ const (
 type RollingUpdateStrategy struct {
 	// Partition indicates the ordinal at which the ManagedSeedSet should be partitioned. Defaults to 0.
 	Partition *int32
+	// MaxSurge is the maximum number of replicas that can be created above the desired number of replicas while
+	// replicas marked for replacement are replaced. If greater than 0, a replacement replica is provisioned and ready
+	// before the replica it replaces is drained and deleted. Defaults to 0.
+	MaxSurge *int32
+	// ZoneAwareOrdering indicates whether replicas marked for replacement are replaced zone by zone, so that only
+	// a single zone is affected at a time. Defaults to false.
+	ZoneAwareOrdering *bool
+	// RescheduleShoots indicates whether shoots scheduled on a replica marked for replacement are automatically
+	// rescheduled to another ready replica before the replica is deleted. Defaults to false.
+	RescheduleShoots *bool
 }
 
 // ManagedSeedSetStatus represents the current state of a ManagedSeedSet.
@@ -140,6 +150,8 @@ const (
 	SeedNotReadyReason PendingReplicaReason = "SeedNotReady"
 	// ShootNotHealthyReason indicates that the replica's shoot is not healthy.
 	ShootNotHealthyReason PendingReplicaReason = "ShootNotHealthy"
+	// ShootsReschedulingReason indicates that the shoots scheduled on the replica's seed are being rescheduled.
+	ShootsReschedulingReason PendingReplicaReason = "ShootsRescheduling"
 )
 
 // PendingReplica contains information about a replica that is currently pending creation, update, or deletion.
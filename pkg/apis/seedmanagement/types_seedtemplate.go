@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedmanagement
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SeedTemplate holds a seed template which can be referenced by ManagedSeeds (and ManagedSeedSets) in order to inherit
+// a common seed specification.
+type SeedTemplate struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Template is the seed template (metadata and specification) inherited by the referencing ManagedSeeds.
+	Template gardencore.SeedTemplate
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SeedTemplateList is a list of SeedTemplate objects.
+type SeedTemplateList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+
+	// Items is the list of SeedTemplates.
+	Items []SeedTemplate
+}
@@ -8,4 +8,10 @@ const (
 	// AnnotationProtectFromDeletion is a constant for an annotation on a replica of a ManagedSeedSet
 	// (either ManagedSeed or Shoot) to protect it from deletion..
 	AnnotationProtectFromDeletion = "seedmanagement.gardener.cloud/protect-from-deletion"
+	// AnnotationReplace is a constant for an annotation on the Shoot of a ManagedSeedSet replica to mark the replica
+	// for replacement.
+	AnnotationReplace = "seedmanagement.gardener.cloud/replace"
+	// AnnotationSeedTemplateGeneration is a constant for an annotation on a ManagedSeed referencing a SeedTemplate. It
+	// contains the generation of the SeedTemplate which was last rendered into the ManagedSeed.
+	AnnotationSeedTemplateGeneration = "seedmanagement.gardener.cloud/seed-template-generation"
 )
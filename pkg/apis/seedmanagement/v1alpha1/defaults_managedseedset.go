@@ -41,4 +41,19 @@ func SetDefaults_RollingUpdateStrategy(obj *RollingUpdateStrategy) {
 	if obj.Partition == nil {
 		obj.Partition = ptr.To[int32](0)
 	}
+
+	// Set default max surge
+	if obj.MaxSurge == nil {
+		obj.MaxSurge = ptr.To[int32](0)
+	}
+
+	// Set default zone-aware ordering
+	if obj.ZoneAwareOrdering == nil {
+		obj.ZoneAwareOrdering = ptr.To(false)
+	}
+
+	// Set default shoot rescheduling
+	if obj.RescheduleShoots == nil {
+		obj.RescheduleShoots = ptr.To(false)
+	}
 }
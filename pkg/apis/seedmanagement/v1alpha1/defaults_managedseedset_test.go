@@ -64,27 +64,36 @@ var _ = Describe("Defaults", func() {
 	})
 
 	Describe("RollingUpdateStrategy defaulting", func() {
-		It("should default partition to 0", func() {
+		It("should default partition, max surge, zone-aware ordering and shoot rescheduling", func() {
 			obj.Spec.UpdateStrategy = &UpdateStrategy{
 				RollingUpdate: &RollingUpdateStrategy{},
 			}
 			SetObjectDefaults_ManagedSeedSet(obj)
 
 			Expect(obj.Spec.UpdateStrategy.RollingUpdate).To(Equal(&RollingUpdateStrategy{
-				Partition: ptr.To[int32](0),
+				Partition:         ptr.To[int32](0),
+				MaxSurge:          ptr.To[int32](0),
+				ZoneAwareOrdering: ptr.To(false),
+				RescheduleShoots:  ptr.To(false),
 			}))
 		})
 
 		It("should not overwrote the already set values for RollingUpdateStrategy", func() {
 			obj.Spec.UpdateStrategy = &UpdateStrategy{
 				RollingUpdate: &RollingUpdateStrategy{
-					Partition: ptr.To[int32](1),
+					Partition:         ptr.To[int32](1),
+					MaxSurge:          ptr.To[int32](2),
+					ZoneAwareOrdering: ptr.To(true),
+					RescheduleShoots:  ptr.To(true),
 				},
 			}
 			SetObjectDefaults_ManagedSeedSet(obj)
 
 			Expect(obj.Spec.UpdateStrategy.RollingUpdate).To(Equal(&RollingUpdateStrategy{
-				Partition: ptr.To[int32](1),
+				Partition:         ptr.To[int32](1),
+				MaxSurge:          ptr.To[int32](2),
+				ZoneAwareOrdering: ptr.To(true),
+				RescheduleShoots:  ptr.To(true),
 			}))
 		})
 	})
@@ -49,6 +49,12 @@ func (*PendingReplica) ProtoMessage() {}
 
 func (*RollingUpdateStrategy) ProtoMessage() {}
 
+func (*SeedTemplate) ProtoMessage() {}
+
+func (*SeedTemplateList) ProtoMessage() {}
+
+func (*SeedTemplateReference) ProtoMessage() {}
+
 func (*Shoot) ProtoMessage() {}
 
 func (*UpdateStrategy) ProtoMessage() {}
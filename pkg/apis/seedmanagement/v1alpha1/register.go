@@ -43,6 +43,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ManagedSeedList{},
 		&ManagedSeedSet{},
 		&ManagedSeedSetList{},
+		&SeedTemplate{},
+		&SeedTemplateList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
@@ -94,6 +94,19 @@ type GardenletConfig struct {
 	// should be merged with the specified GardenletConfiguration. Defaults to true. This field is immutable.
 	// +optional
 	MergeWithParent *bool `json:"mergeWithParent,omitempty" protobuf:"varint,4,opt,name=mergeWithParent"`
+	// SeedTemplateRef references a SeedTemplate whose seed template (patched with the given overrides) is rendered into
+	// the seedConfig of the GardenletConfiguration.
+	// +optional
+	SeedTemplateRef *SeedTemplateReference `json:"seedTemplateRef,omitempty" protobuf:"bytes,5,opt,name=seedTemplateRef"`
+}
+
+// SeedTemplateReference references a SeedTemplate and optionally overrides parts of its seed template.
+type SeedTemplateReference struct {
+	// Name is the name of the referenced SeedTemplate.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Overrides is a JSON merge patch (RFC 7386) which is applied to the seed template of the referenced SeedTemplate.
+	// +optional
+	Overrides *runtime.RawExtension `json:"overrides,omitempty" protobuf:"bytes,2,opt,name=overrides"`
 }
 
 // GardenletDeployment specifies certain gardenlet deployment parameters, such as the number of replicas,
@@ -94,6 +94,19 @@ type RollingUpdateStrategy struct {
 	// Partition indicates the ordinal at which the ManagedSeedSet should be partitioned. Defaults to 0.
 	// +optional
 	Partition *int32 `json:"partition,omitempty" protobuf:"varint,1,opt,name=partition"`
+	// MaxSurge is the maximum number of replicas that can be created above the desired number of replicas while
+	// replicas marked for replacement are replaced. If greater than 0, a replacement replica is provisioned and ready
+	// before the replica it replaces is drained and deleted. Defaults to 0.
+	// +optional
+	MaxSurge *int32 `json:"maxSurge,omitempty" protobuf:"varint,2,opt,name=maxSurge"`
+	// ZoneAwareOrdering indicates whether replicas marked for replacement are replaced zone by zone, so that only
+	// a single zone is affected at a time. Defaults to false.
+	// +optional
+	ZoneAwareOrdering *bool `json:"zoneAwareOrdering,omitempty" protobuf:"varint,3,opt,name=zoneAwareOrdering"`
+	// RescheduleShoots indicates whether shoots scheduled on a replica marked for replacement are automatically
+	// rescheduled to another ready replica before the replica is deleted. Defaults to false.
+	// +optional
+	RescheduleShoots *bool `json:"rescheduleShoots,omitempty" protobuf:"varint,4,opt,name=rescheduleShoots"`
 }
 
 // ManagedSeedSetStatus represents the current state of a ManagedSeedSet.
@@ -155,6 +168,8 @@ const (
 	SeedNotReadyReason PendingReplicaReason = "SeedNotReady"
 	// ShootNotHealthyReason indicates that the replica's shoot is not healthy.
 	ShootNotHealthyReason PendingReplicaReason = "ShootNotHealthy"
+	// ShootsReschedulingReason indicates that the shoots scheduled on the replica's seed are being rescheduled.
+	ShootsReschedulingReason PendingReplicaReason = "ShootsRescheduling"
 )
 
 // PendingReplica contains information about a replica that is currently pending creation, update, or deletion.
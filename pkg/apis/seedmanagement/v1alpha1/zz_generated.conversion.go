@@ -208,6 +208,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedTemplate)(nil), (*seedmanagement.SeedTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedTemplate_To_seedmanagement_SeedTemplate(a.(*SeedTemplate), b.(*seedmanagement.SeedTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.SeedTemplate)(nil), (*SeedTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_SeedTemplate_To_v1alpha1_SeedTemplate(a.(*seedmanagement.SeedTemplate), b.(*SeedTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedTemplateList)(nil), (*seedmanagement.SeedTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedTemplateList_To_seedmanagement_SeedTemplateList(a.(*SeedTemplateList), b.(*seedmanagement.SeedTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.SeedTemplateList)(nil), (*SeedTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_SeedTemplateList_To_v1alpha1_SeedTemplateList(a.(*seedmanagement.SeedTemplateList), b.(*SeedTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedTemplateReference)(nil), (*seedmanagement.SeedTemplateReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedTemplateReference_To_seedmanagement_SeedTemplateReference(a.(*SeedTemplateReference), b.(*seedmanagement.SeedTemplateReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.SeedTemplateReference)(nil), (*SeedTemplateReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_SeedTemplateReference_To_v1alpha1_SeedTemplateReference(a.(*seedmanagement.SeedTemplateReference), b.(*SeedTemplateReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Shoot)(nil), (*seedmanagement.Shoot)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Shoot_To_seedmanagement_Shoot(a.(*Shoot), b.(*seedmanagement.Shoot), scope)
 	}); err != nil {
@@ -320,6 +350,7 @@ func autoConvert_v1alpha1_GardenletConfig_To_seedmanagement_GardenletConfig(in *
 	}
 	out.Bootstrap = (*seedmanagement.Bootstrap)(unsafe.Pointer(in.Bootstrap))
 	out.MergeWithParent = (*bool)(unsafe.Pointer(in.MergeWithParent))
+	out.SeedTemplateRef = (*seedmanagement.SeedTemplateReference)(unsafe.Pointer(in.SeedTemplateRef))
 	return nil
 }
 
@@ -330,6 +361,7 @@ func autoConvert_seedmanagement_GardenletConfig_To_v1alpha1_GardenletConfig(in *
 	}
 	out.Bootstrap = (*Bootstrap)(unsafe.Pointer(in.Bootstrap))
 	out.MergeWithParent = (*bool)(unsafe.Pointer(in.MergeWithParent))
+	out.SeedTemplateRef = (*SeedTemplateReference)(unsafe.Pointer(in.SeedTemplateRef))
 	return nil
 }
 
@@ -869,6 +901,9 @@ func Convert_seedmanagement_PendingReplica_To_v1alpha1_PendingReplica(in *seedma
 
 func autoConvert_v1alpha1_RollingUpdateStrategy_To_seedmanagement_RollingUpdateStrategy(in *RollingUpdateStrategy, out *seedmanagement.RollingUpdateStrategy, s conversion.Scope) error {
 	out.Partition = (*int32)(unsafe.Pointer(in.Partition))
+	out.MaxSurge = (*int32)(unsafe.Pointer(in.MaxSurge))
+	out.ZoneAwareOrdering = (*bool)(unsafe.Pointer(in.ZoneAwareOrdering))
+	out.RescheduleShoots = (*bool)(unsafe.Pointer(in.RescheduleShoots))
 	return nil
 }
 
@@ -879,6 +914,9 @@ func Convert_v1alpha1_RollingUpdateStrategy_To_seedmanagement_RollingUpdateStrat
 
 func autoConvert_seedmanagement_RollingUpdateStrategy_To_v1alpha1_RollingUpdateStrategy(in *seedmanagement.RollingUpdateStrategy, out *RollingUpdateStrategy, s conversion.Scope) error {
 	out.Partition = (*int32)(unsafe.Pointer(in.Partition))
+	out.MaxSurge = (*int32)(unsafe.Pointer(in.MaxSurge))
+	out.ZoneAwareOrdering = (*bool)(unsafe.Pointer(in.ZoneAwareOrdering))
+	out.RescheduleShoots = (*bool)(unsafe.Pointer(in.RescheduleShoots))
 	return nil
 }
 
@@ -887,6 +925,96 @@ func Convert_seedmanagement_RollingUpdateStrategy_To_v1alpha1_RollingUpdateStrat
 	return autoConvert_seedmanagement_RollingUpdateStrategy_To_v1alpha1_RollingUpdateStrategy(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedTemplate_To_seedmanagement_SeedTemplate(in *SeedTemplate, out *seedmanagement.SeedTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_SeedTemplate_To_core_SeedTemplate(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SeedTemplate_To_seedmanagement_SeedTemplate is an autogenerated conversion function.
+func Convert_v1alpha1_SeedTemplate_To_seedmanagement_SeedTemplate(in *SeedTemplate, out *seedmanagement.SeedTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedTemplate_To_seedmanagement_SeedTemplate(in, out, s)
+}
+
+func autoConvert_seedmanagement_SeedTemplate_To_v1alpha1_SeedTemplate(in *seedmanagement.SeedTemplate, out *SeedTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_SeedTemplate_To_v1beta1_SeedTemplate(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_seedmanagement_SeedTemplate_To_v1alpha1_SeedTemplate is an autogenerated conversion function.
+func Convert_seedmanagement_SeedTemplate_To_v1alpha1_SeedTemplate(in *seedmanagement.SeedTemplate, out *SeedTemplate, s conversion.Scope) error {
+	return autoConvert_seedmanagement_SeedTemplate_To_v1alpha1_SeedTemplate(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedTemplateList_To_seedmanagement_SeedTemplateList(in *SeedTemplateList, out *seedmanagement.SeedTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]seedmanagement.SeedTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_SeedTemplate_To_seedmanagement_SeedTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SeedTemplateList_To_seedmanagement_SeedTemplateList is an autogenerated conversion function.
+func Convert_v1alpha1_SeedTemplateList_To_seedmanagement_SeedTemplateList(in *SeedTemplateList, out *seedmanagement.SeedTemplateList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedTemplateList_To_seedmanagement_SeedTemplateList(in, out, s)
+}
+
+func autoConvert_seedmanagement_SeedTemplateList_To_v1alpha1_SeedTemplateList(in *seedmanagement.SeedTemplateList, out *SeedTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SeedTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_seedmanagement_SeedTemplate_To_v1alpha1_SeedTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_seedmanagement_SeedTemplateList_To_v1alpha1_SeedTemplateList is an autogenerated conversion function.
+func Convert_seedmanagement_SeedTemplateList_To_v1alpha1_SeedTemplateList(in *seedmanagement.SeedTemplateList, out *SeedTemplateList, s conversion.Scope) error {
+	return autoConvert_seedmanagement_SeedTemplateList_To_v1alpha1_SeedTemplateList(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedTemplateReference_To_seedmanagement_SeedTemplateReference(in *SeedTemplateReference, out *seedmanagement.SeedTemplateReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Overrides = (*runtime.RawExtension)(unsafe.Pointer(in.Overrides))
+	return nil
+}
+
+// Convert_v1alpha1_SeedTemplateReference_To_seedmanagement_SeedTemplateReference is an autogenerated conversion function.
+func Convert_v1alpha1_SeedTemplateReference_To_seedmanagement_SeedTemplateReference(in *SeedTemplateReference, out *seedmanagement.SeedTemplateReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedTemplateReference_To_seedmanagement_SeedTemplateReference(in, out, s)
+}
+
+func autoConvert_seedmanagement_SeedTemplateReference_To_v1alpha1_SeedTemplateReference(in *seedmanagement.SeedTemplateReference, out *SeedTemplateReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Overrides = (*runtime.RawExtension)(unsafe.Pointer(in.Overrides))
+	return nil
+}
+
+// Convert_seedmanagement_SeedTemplateReference_To_v1alpha1_SeedTemplateReference is an autogenerated conversion function.
+func Convert_seedmanagement_SeedTemplateReference_To_v1alpha1_SeedTemplateReference(in *seedmanagement.SeedTemplateReference, out *SeedTemplateReference, s conversion.Scope) error {
+	return autoConvert_seedmanagement_SeedTemplateReference_To_v1alpha1_SeedTemplateReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_Shoot_To_seedmanagement_Shoot(in *Shoot, out *seedmanagement.Shoot, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -109,6 +109,21 @@ func (in RollingUpdateStrategy) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.RollingUpdateStrategy"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedTemplate) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.SeedTemplate"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedTemplateList) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.SeedTemplateList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedTemplateReference) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.SeedTemplateReference"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Shoot) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.Shoot"
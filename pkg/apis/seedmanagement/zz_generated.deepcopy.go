@@ -64,6 +64,11 @@ func (in *GardenletConfig) DeepCopyInto(out *GardenletConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SeedTemplateRef != nil {
+		in, out := &in.SeedTemplateRef, &out.SeedTemplateRef
+		*out = new(SeedTemplateReference)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -599,6 +604,21 @@ func (in *RollingUpdateStrategy) DeepCopyInto(out *RollingUpdateStrategy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ZoneAwareOrdering != nil {
+		in, out := &in.ZoneAwareOrdering, &out.ZoneAwareOrdering
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RescheduleShoots != nil {
+		in, out := &in.RescheduleShoots, &out.RescheduleShoots
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -612,6 +632,87 @@ func (in *RollingUpdateStrategy) DeepCopy() *RollingUpdateStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedTemplate) DeepCopyInto(out *SeedTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedTemplate.
+func (in *SeedTemplate) DeepCopy() *SeedTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SeedTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedTemplateList) DeepCopyInto(out *SeedTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SeedTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedTemplateList.
+func (in *SeedTemplateList) DeepCopy() *SeedTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SeedTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedTemplateReference) DeepCopyInto(out *SeedTemplateReference) {
+	*out = *in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedTemplateReference.
+func (in *SeedTemplateReference) DeepCopy() *SeedTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Shoot) DeepCopyInto(out *Shoot) {
 	*out = *in
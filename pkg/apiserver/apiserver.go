@@ -15,9 +15,13 @@ import (
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	kubeinformers "k8s.io/client-go/informers"
 	clientauthorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/keyutil"
+	"k8s.io/utils/clock"
 
+	"github.com/gardener/gardener/pkg/apiserver/ratelimit"
 	corerest "github.com/gardener/gardener/pkg/apiserver/registry/core/rest"
+	shootstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shoot/storage"
 	operationsrest "github.com/gardener/gardener/pkg/apiserver/registry/operations/rest"
 	securityrest "github.com/gardener/gardener/pkg/apiserver/registry/security/rest"
 	seedmanagementrest "github.com/gardener/gardener/pkg/apiserver/registry/seedmanagement/rest"
@@ -31,11 +35,16 @@ import (
 type ExtraConfig struct {
 	AdminKubeconfigMaxExpiration       time.Duration
 	ViewerKubeconfigMaxExpiration      time.Duration
+	SSHCertificateMaxExpiration        time.Duration
+	DebugPodMaxExpiration              time.Duration
 	CredentialsRotationInterval        time.Duration
 	WorkloadIdentityTokenIssuer        string
 	WorkloadIdentityTokenMinExpiration time.Duration
 	WorkloadIdentityTokenMaxExpiration time.Duration
 	WorkloadIdentitySigningKey         any
+	AdminKubeconfigProjectRateLimit    ratelimit.Config
+	ViewerKubeconfigProjectRateLimit   ratelimit.Config
+	ShootCreationProjectRateLimit      ratelimit.Config
 }
 
 // Config contains Gardener API server configuration.
@@ -45,6 +54,7 @@ type Config struct {
 	KubeInformerFactory   kubeinformers.SharedInformerFactory
 	CoreInformerFactory   gardencoreinformers.SharedInformerFactory
 	SubjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface
+	EventRecorder         record.EventRecorder
 }
 
 // GardenerServer contains state for a Gardener API server.
@@ -59,6 +69,7 @@ type completedConfig struct {
 	kubeInformerFactory   kubeinformers.SharedInformerFactory
 	coreInformerFactory   gardencoreinformers.SharedInformerFactory
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface
+	eventRecorder         record.EventRecorder
 }
 
 // CompletedConfig contains completed Gardener API server configuration.
@@ -74,6 +85,7 @@ func (cfg *Config) Complete() CompletedConfig {
 		cfg.KubeInformerFactory,
 		cfg.CoreInformerFactory,
 		cfg.SubjectAccessReviewer,
+		cfg.EventRecorder,
 	}
 
 	return CompletedConfig{&c}
@@ -104,10 +116,18 @@ func (c completedConfig) New() (*GardenerServer, error) {
 		coreAPIGroupInfo = (corerest.StorageProvider{
 			AdminKubeconfigMaxExpiration:  c.ExtraConfig.AdminKubeconfigMaxExpiration,
 			ViewerKubeconfigMaxExpiration: c.ExtraConfig.ViewerKubeconfigMaxExpiration,
+			SSHCertificateMaxExpiration:   c.ExtraConfig.SSHCertificateMaxExpiration,
+			DebugPodMaxExpiration:         c.ExtraConfig.DebugPodMaxExpiration,
 			CredentialsRotationInterval:   c.ExtraConfig.CredentialsRotationInterval,
 			KubeInformerFactory:           c.kubeInformerFactory,
 			CoreInformerFactory:           c.coreInformerFactory,
 			SubjectAccessReviewer:         c.subjectAccessReviewer,
+			EventRecorder:                 c.eventRecorder,
+			ShootProjectRateLimiters: shootstore.ProjectRateLimiters{
+				AdminKubeconfig:  newProjectRateLimiter("shoots/adminkubeconfig", c.ExtraConfig.AdminKubeconfigProjectRateLimit),
+				ViewerKubeconfig: newProjectRateLimiter("shoots/viewerkubeconfig", c.ExtraConfig.ViewerKubeconfigProjectRateLimit),
+				Creation:         newProjectRateLimiter("shoots", c.ExtraConfig.ShootCreationProjectRateLimit),
+			},
 		}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		seedManagementAPIGroupInfo = (seedmanagementrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		settingsAPIGroupInfo       = (settingsrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
@@ -125,16 +145,25 @@ func (c completedConfig) New() (*GardenerServer, error) {
 	return s, nil
 }
 
+func newProjectRateLimiter(resource string, config ratelimit.Config) *ratelimit.ProjectRateLimiter {
+	return ratelimit.NewProjectRateLimiter(resource, config.QPS, config.Burst, clock.RealClock{})
+}
+
 // ExtraOptions is used for providing additional options to the Gardener API Server
 type ExtraOptions struct {
 	ClusterIdentity                    string
 	AdminKubeconfigMaxExpiration       time.Duration
 	ViewerKubeconfigMaxExpiration      time.Duration
+	SSHCertificateMaxExpiration        time.Duration
+	DebugPodMaxExpiration              time.Duration
 	CredentialsRotationInterval        time.Duration
 	WorkloadIdentityTokenIssuer        string
 	WorkloadIdentityTokenMinExpiration time.Duration
 	WorkloadIdentityTokenMaxExpiration time.Duration
 	WorkloadIdentitySigningKeyFile     string
+	AdminKubeconfigProjectRateLimit    ratelimit.Config
+	ViewerKubeconfigProjectRateLimit   ratelimit.Config
+	ShootCreationProjectRateLimit      ratelimit.Config
 
 	LogLevel  string
 	LogFormat string
@@ -157,6 +186,16 @@ func (o *ExtraOptions) Validate() []error {
 		allErrors = append(allErrors, errors.New("--shoot-viewer-kubeconfig-max-expiration must be between 1 hour and 2^32 seconds"))
 	}
 
+	if o.SSHCertificateMaxExpiration < 10*time.Minute ||
+		o.SSHCertificateMaxExpiration > time.Duration(1<<32)*time.Second {
+		allErrors = append(allErrors, errors.New("--shoot-ssh-certificate-max-expiration must be between 10 minutes and 2^32 seconds"))
+	}
+
+	if o.DebugPodMaxExpiration < 10*time.Minute ||
+		o.DebugPodMaxExpiration > time.Duration(1<<32)*time.Second {
+		allErrors = append(allErrors, errors.New("--shoot-debug-pod-max-expiration must be between 10 minutes and 2^32 seconds"))
+	}
+
 	if o.CredentialsRotationInterval < 24*time.Hour ||
 		o.CredentialsRotationInterval > time.Duration(1<<32)*time.Second {
 		allErrors = append(allErrors, errors.New("--shoot-credentials-rotation-interval must be between 24 hours and 2^32 seconds"))
@@ -183,6 +222,19 @@ func (o *ExtraOptions) Validate() []error {
 		}
 	}
 
+	for flagPrefix, config := range map[string]ratelimit.Config{
+		"--shoot-admin-kubeconfig-project-rate-limit":  o.AdminKubeconfigProjectRateLimit,
+		"--shoot-viewer-kubeconfig-project-rate-limit": o.ViewerKubeconfigProjectRateLimit,
+		"--shoot-creation-project-rate-limit":          o.ShootCreationProjectRateLimit,
+	} {
+		if config.QPS < 0 {
+			allErrors = append(allErrors, fmt.Errorf("%s-qps must not be negative", flagPrefix))
+		}
+		if config.QPS > 0 && config.Burst < 1 {
+			allErrors = append(allErrors, fmt.Errorf("%s-burst must be at least 1", flagPrefix))
+		}
+	}
+
 	if !sets.New(logger.AllLogLevels...).Has(o.LogLevel) {
 		allErrors = append(allErrors, fmt.Errorf("invalid --log-level: %s", o.LogLevel))
 	}
@@ -199,11 +251,19 @@ func (o *ExtraOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.ClusterIdentity, "cluster-identity", o.ClusterIdentity, "This flag is used for specifying the identity of the Garden cluster")
 	fs.DurationVar(&o.AdminKubeconfigMaxExpiration, "shoot-admin-kubeconfig-max-expiration", time.Hour*24, "The maximum validity duration of a credential requested to a Shoot by an AdminKubeconfigRequest. If an otherwise valid AdminKubeconfigRequest with a validity duration larger than this value is requested, a credential will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.ViewerKubeconfigMaxExpiration, "shoot-viewer-kubeconfig-max-expiration", time.Hour*24, "The maximum validity duration of a credential requested to a Shoot by an ViewerKubeconfigRequest. If an otherwise valid ViewerKubeconfigRequest with a validity duration larger than this value is requested, a credential will be issued with a validity duration of this value.")
+	fs.DurationVar(&o.SSHCertificateMaxExpiration, "shoot-ssh-certificate-max-expiration", time.Hour*8, "The maximum validity duration of an SSH certificate requested to a Shoot by an SSHCertificateRequest. If an otherwise valid SSHCertificateRequest with a validity duration larger than this value is requested, a certificate will be issued with a validity duration of this value.")
+	fs.DurationVar(&o.DebugPodMaxExpiration, "shoot-debug-pod-max-expiration", time.Hour*4, "The maximum lifetime of a debug pod requested for a Shoot by a ShootDebugPodRequest. If an otherwise valid ShootDebugPodRequest with a lifetime larger than this value is requested, the debug pod will be deleted after this duration.")
 	fs.DurationVar(&o.CredentialsRotationInterval, "shoot-credentials-rotation-interval", time.Hour*24*90, "The duration after the initial shoot creation or the last credentials rotation when a client warning for the next credentials rotation is issued.")
 	fs.StringVar(&o.WorkloadIdentityTokenIssuer, "workload-identity-token-issuer", o.WorkloadIdentityTokenIssuer, "The issuer identifier of the workload identity tokens set in the 'iss' claim. If set, it must be a valid URL")
 	fs.DurationVar(&o.WorkloadIdentityTokenMinExpiration, "workload-identity-token-min-expiration", time.Hour, "The minimum validity duration of a workload identity token. If an otherwise valid TokenRequest with a validity duration less than this value is requested, a token will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.WorkloadIdentityTokenMaxExpiration, "workload-identity-token-max-expiration", time.Hour*48, "The maximum validity duration of a workload identity token. If an otherwise valid TokenRequest with a validity duration greater than this value is requested, a token will be issued with a validity duration of this value.")
 	fs.StringVar(&o.WorkloadIdentitySigningKeyFile, "workload-identity-signing-key-file", o.WorkloadIdentitySigningKeyFile, "Path to the file that contains the current private key of the workload identity token issuer. The issuer will sign issued ID tokens with this private key.")
+	fs.Float32Var(&o.AdminKubeconfigProjectRateLimit.QPS, "shoot-admin-kubeconfig-project-rate-limit-qps", 0, "The number of AdminKubeconfigRequests per second which are allowed per project. Requests exceeding the limit are rejected with '429 Too Many Requests'. Requests are not limited if set to 0.")
+	fs.IntVar(&o.AdminKubeconfigProjectRateLimit.Burst, "shoot-admin-kubeconfig-project-rate-limit-burst", 10, "The maximum number of AdminKubeconfigRequests which are allowed at once per project.")
+	fs.Float32Var(&o.ViewerKubeconfigProjectRateLimit.QPS, "shoot-viewer-kubeconfig-project-rate-limit-qps", 0, "The number of ViewerKubeconfigRequests per second which are allowed per project. Requests exceeding the limit are rejected with '429 Too Many Requests'. Requests are not limited if set to 0.")
+	fs.IntVar(&o.ViewerKubeconfigProjectRateLimit.Burst, "shoot-viewer-kubeconfig-project-rate-limit-burst", 10, "The maximum number of ViewerKubeconfigRequests which are allowed at once per project.")
+	fs.Float32Var(&o.ShootCreationProjectRateLimit.QPS, "shoot-creation-project-rate-limit-qps", 0, "The number of Shoot creations per second which are allowed per project. Requests exceeding the limit are rejected with '429 Too Many Requests'. Requests are not limited if set to 0.")
+	fs.IntVar(&o.ShootCreationProjectRateLimit.Burst, "shoot-creation-project-rate-limit-burst", 10, "The maximum number of Shoot creations which are allowed at once per project.")
 
 	fs.StringVar(&o.LogLevel, "log-level", "info", "The level/severity for the logs. Must be one of [info,debug,error]")
 	fs.StringVar(&o.LogFormat, "log-format", "json", "The format for the logs. Must be one of [json,text]")
@@ -213,10 +273,15 @@ func (o *ExtraOptions) AddFlags(fs *pflag.FlagSet) {
 func (o *ExtraOptions) ApplyTo(c *Config) error {
 	c.ExtraConfig.AdminKubeconfigMaxExpiration = o.AdminKubeconfigMaxExpiration
 	c.ExtraConfig.ViewerKubeconfigMaxExpiration = o.ViewerKubeconfigMaxExpiration
+	c.ExtraConfig.SSHCertificateMaxExpiration = o.SSHCertificateMaxExpiration
+	c.ExtraConfig.DebugPodMaxExpiration = o.DebugPodMaxExpiration
 	c.ExtraConfig.CredentialsRotationInterval = o.CredentialsRotationInterval
 	c.ExtraConfig.WorkloadIdentityTokenIssuer = o.WorkloadIdentityTokenIssuer
 	c.ExtraConfig.WorkloadIdentityTokenMinExpiration = o.WorkloadIdentityTokenMinExpiration
 	c.ExtraConfig.WorkloadIdentityTokenMaxExpiration = o.WorkloadIdentityTokenMaxExpiration
+	c.ExtraConfig.AdminKubeconfigProjectRateLimit = o.AdminKubeconfigProjectRateLimit
+	c.ExtraConfig.ViewerKubeconfigProjectRateLimit = o.ViewerKubeconfigProjectRateLimit
+	c.ExtraConfig.ShootCreationProjectRateLimit = o.ShootCreationProjectRateLimit
 
 	if len(o.WorkloadIdentitySigningKeyFile) != 0 {
 		signingKey, err := keyutil.PrivateKeyFromFile(o.WorkloadIdentitySigningKeyFile)
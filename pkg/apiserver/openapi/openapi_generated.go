@@ -36,13 +36,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1alpha1.AdminKubeconfigRequest{}.OpenAPIModelName():                      schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequest(ref),
 		v1alpha1.AdminKubeconfigRequestSpec{}.OpenAPIModelName():                  schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestSpec(ref),
 		v1alpha1.AdminKubeconfigRequestStatus{}.OpenAPIModelName():                schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestStatus(ref),
+		v1alpha1.SSHCertificateRequest{}.OpenAPIModelName():                       schema_pkg_apis_authentication_v1alpha1_SSHCertificateRequest(ref),
+		v1alpha1.SSHCertificateRequestSpec{}.OpenAPIModelName():                   schema_pkg_apis_authentication_v1alpha1_SSHCertificateRequestSpec(ref),
+		v1alpha1.SSHCertificateRequestStatus{}.OpenAPIModelName():                 schema_pkg_apis_authentication_v1alpha1_SSHCertificateRequestStatus(ref),
 		v1alpha1.ViewerKubeconfigRequest{}.OpenAPIModelName():                     schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequest(ref),
 		v1alpha1.ViewerKubeconfigRequestSpec{}.OpenAPIModelName():                 schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestSpec(ref),
 		v1alpha1.ViewerKubeconfigRequestStatus{}.OpenAPIModelName():               schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestStatus(ref),
 		v1.ControllerDeployment{}.OpenAPIModelName():                              schema_pkg_apis_core_v1_ControllerDeployment(ref),
 		v1.ControllerDeploymentList{}.OpenAPIModelName():                          schema_pkg_apis_core_v1_ControllerDeploymentList(ref),
 		v1.HelmControllerDeployment{}.OpenAPIModelName():                          schema_pkg_apis_core_v1_HelmControllerDeployment(ref),
+		v1.HelmValuesOverlay{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1_HelmValuesOverlay(ref),
 		v1.OCIRepository{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1_OCIRepository(ref),
+		v1beta1.APIServerAccessRestrictions{}.OpenAPIModelName():                  schema_pkg_apis_core_v1beta1_APIServerAccessRestrictions(ref),
 		v1beta1.APIServerLogging{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_APIServerLogging(ref),
 		v1beta1.APIServerRequests{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_APIServerRequests(ref),
 		v1beta1.AccessRestriction{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_AccessRestriction(ref),
@@ -71,6 +76,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.CARotation{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_CARotation(ref),
 		v1beta1.CRI{}.OpenAPIModelName():                                          schema_pkg_apis_core_v1beta1_CRI(ref),
 		v1beta1.CapabilityDefinition{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_CapabilityDefinition(ref),
+		v1beta1.Cleanup{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_Cleanup(ref),
+		v1beta1.CleanupPolicy{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_CleanupPolicy(ref),
 		v1beta1.CloudProfile{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_CloudProfile(ref),
 		v1beta1.CloudProfileList{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_CloudProfileList(ref),
 		v1beta1.CloudProfileMachineControllerManagerSettings{}.OpenAPIModelName(): schema_pkg_apis_core_v1beta1_CloudProfileMachineControllerManagerSettings(ref),
@@ -83,35 +90,51 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.ContainerRuntime{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_ContainerRuntime(ref),
 		v1beta1.ControlPlane{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_ControlPlane(ref),
 		v1beta1.ControlPlaneAutoscaling{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ControlPlaneAutoscaling(ref),
+		v1beta1.ControlPlaneComponentFootprint{}.OpenAPIModelName():               schema_pkg_apis_core_v1beta1_ControlPlaneComponentFootprint(ref),
+		v1beta1.ControlPlaneSizeClass{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_ControlPlaneSizeClass(ref),
 		v1beta1.ControllerDeployment{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_ControllerDeployment(ref),
+		v1beta1.ControllerDeploymentCanaryRollout{}.OpenAPIModelName():            schema_pkg_apis_core_v1beta1_ControllerDeploymentCanaryRollout(ref),
 		v1beta1.ControllerDeploymentList{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_ControllerDeploymentList(ref),
+		v1beta1.ControllerDeploymentRollout{}.OpenAPIModelName():                  schema_pkg_apis_core_v1beta1_ControllerDeploymentRollout(ref),
 		v1beta1.ControllerInstallation{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_ControllerInstallation(ref),
 		v1beta1.ControllerInstallationList{}.OpenAPIModelName():                   schema_pkg_apis_core_v1beta1_ControllerInstallationList(ref),
 		v1beta1.ControllerInstallationSpec{}.OpenAPIModelName():                   schema_pkg_apis_core_v1beta1_ControllerInstallationSpec(ref),
 		v1beta1.ControllerInstallationStatus{}.OpenAPIModelName():                 schema_pkg_apis_core_v1beta1_ControllerInstallationStatus(ref),
 		v1beta1.ControllerRegistration{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_ControllerRegistration(ref),
+		v1beta1.ControllerRegistrationDependency{}.OpenAPIModelName():             schema_pkg_apis_core_v1beta1_ControllerRegistrationDependency(ref),
 		v1beta1.ControllerRegistrationDeployment{}.OpenAPIModelName():             schema_pkg_apis_core_v1beta1_ControllerRegistrationDeployment(ref),
 		v1beta1.ControllerRegistrationList{}.OpenAPIModelName():                   schema_pkg_apis_core_v1beta1_ControllerRegistrationList(ref),
 		v1beta1.ControllerRegistrationSpec{}.OpenAPIModelName():                   schema_pkg_apis_core_v1beta1_ControllerRegistrationSpec(ref),
 		v1beta1.ControllerResource{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_ControllerResource(ref),
 		v1beta1.ControllerResourceLifecycle{}.OpenAPIModelName():                  schema_pkg_apis_core_v1beta1_ControllerResourceLifecycle(ref),
+		v1beta1.ControllerWorkersConfig{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ControllerWorkersConfig(ref),
 		v1beta1.CoreDNS{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_CoreDNS(ref),
 		v1beta1.CoreDNSAutoscaling{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref),
 		v1beta1.CoreDNSRewriting{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref),
 		v1beta1.DNS{}.OpenAPIModelName():                                          schema_pkg_apis_core_v1beta1_DNS(ref),
+		v1beta1.DNSAPIServerRecord{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_DNSAPIServerRecord(ref),
 		v1beta1.DNSExposure{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_DNSExposure(ref),
+		v1beta1.DNSFailover{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_DNSFailover(ref),
+		v1beta1.DNSHealthCheck{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_DNSHealthCheck(ref),
 		v1beta1.DNSIncludeExclude{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_DNSIncludeExclude(ref),
 		v1beta1.DNSProvider{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_DNSProvider(ref),
+		v1beta1.DNSRecordTTL{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_DNSRecordTTL(ref),
 		v1beta1.DataVolume{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_DataVolume(ref),
+		v1beta1.DeferredShootMaintenance{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_DeferredShootMaintenance(ref),
 		v1beta1.DeploymentRef{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_DeploymentRef(ref),
 		v1beta1.DualApprovalForDeletion{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_DualApprovalForDeletion(ref),
 		v1beta1.ETCD{}.OpenAPIModelName():                                         schema_pkg_apis_core_v1beta1_ETCD(ref),
 		v1beta1.ETCDConfig{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_ETCDConfig(ref),
 		v1beta1.ETCDEncryptionKeyRotation{}.OpenAPIModelName():                    schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref),
+		v1beta1.Egress{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Egress(ref),
+		v1beta1.EgressFilter{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_EgressFilter(ref),
+		v1beta1.EgressIPPool{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_EgressIPPool(ref),
+		v1beta1.EgressNamespaceSelection{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_EgressNamespaceSelection(ref),
 		v1beta1.EncryptionAtRest{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_EncryptionAtRest(ref),
 		v1beta1.EncryptionConfig{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_EncryptionConfig(ref),
 		v1beta1.EncryptionProvider{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_EncryptionProvider(ref),
 		v1beta1.EncryptionProviderStatus{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_EncryptionProviderStatus(ref),
+		v1beta1.ErrorCodeDefinition{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_ErrorCodeDefinition(ref),
 		v1beta1.ExpirableVersion{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_ExpirableVersion(ref),
 		v1beta1.ExpirableVersionStatus{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_ExpirableVersionStatus(ref),
 		v1beta1.Exposure{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_Exposure(ref),
@@ -125,6 +148,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.Gardener{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_Gardener(ref),
 		v1beta1.GardenerResourceData{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_GardenerResourceData(ref),
 		v1beta1.HelmControllerDeployment{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_HelmControllerDeployment(ref),
+		v1beta1.HelmValuesOverlay{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_HelmValuesOverlay(ref),
 		v1beta1.Hibernation{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_Hibernation(ref),
 		v1beta1.HibernationSchedule{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
 		v1beta1.HighAvailability{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_HighAvailability(ref),
@@ -133,6 +157,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.InPlaceUpdatesStatus{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_InPlaceUpdatesStatus(ref),
 		v1beta1.Ingress{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_Ingress(ref),
 		v1beta1.IngressController{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_IngressController(ref),
+		v1beta1.IngressWildcardCertificate{}.OpenAPIModelName():                   schema_pkg_apis_core_v1beta1_IngressWildcardCertificate(ref),
 		v1beta1.InternalSecret{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_InternalSecret(ref),
 		v1beta1.InternalSecretList{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_InternalSecretList(ref),
 		v1beta1.KubeAPIServerConfig{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref),
@@ -161,14 +186,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.MachineImageFlavor{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_MachineImageFlavor(ref),
 		v1beta1.MachineImageStatus{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_MachineImageStatus(ref),
 		v1beta1.MachineImageVersion{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_MachineImageVersion(ref),
+		v1beta1.MachinePhaseCount{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_MachinePhaseCount(ref),
 		v1beta1.MachineType{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_MachineType(ref),
 		v1beta1.MachineTypeStorage{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_MachineTypeStorage(ref),
 		v1beta1.Maintenance{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_Maintenance(ref),
 		v1beta1.MaintenanceAutoRotation{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_MaintenanceAutoRotation(ref),
 		v1beta1.MaintenanceAutoUpdate{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
 		v1beta1.MaintenanceCredentialsAutoRotation{}.OpenAPIModelName():           schema_pkg_apis_core_v1beta1_MaintenanceCredentialsAutoRotation(ref),
+		v1beta1.MaintenanceFreeze{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_MaintenanceFreeze(ref),
+		v1beta1.MaintenanceFreezeList{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_MaintenanceFreezeList(ref),
+		v1beta1.MaintenanceFreezeSpec{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_MaintenanceFreezeSpec(ref),
+		v1beta1.MaintenanceFreezeStatus{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_MaintenanceFreezeStatus(ref),
+		v1beta1.MaintenanceFreezeWindow{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_MaintenanceFreezeWindow(ref),
 		v1beta1.MaintenanceRotationConfig{}.OpenAPIModelName():                    schema_pkg_apis_core_v1beta1_MaintenanceRotationConfig(ref),
 		v1beta1.MaintenanceTimeWindow{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
+		v1beta1.ManagedAddon{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_ManagedAddon(ref),
 		v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ManualWorkerPoolRollout(ref),
 		v1beta1.MemorySwapConfiguration{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
 		v1beta1.Monitoring{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_Monitoring(ref),
@@ -180,6 +212,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.Networking{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_Networking(ref),
 		v1beta1.NetworkingStatus{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_NetworkingStatus(ref),
 		v1beta1.NginxIngress{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_NginxIngress(ref),
+		v1beta1.NginxIngressAutoscaling{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_NginxIngressAutoscaling(ref),
+		v1beta1.NodeEvictionConfig{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_NodeEvictionConfig(ref),
 		v1beta1.NodeLocalDNS{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref),
 		v1beta1.OCIRepository{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_OCIRepository(ref),
 		v1beta1.OIDCConfig{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_OIDCConfig(ref),
@@ -190,10 +224,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.Project{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_Project(ref),
 		v1beta1.ProjectList{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ProjectList(ref),
 		v1beta1.ProjectMember{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ProjectMember(ref),
+		v1beta1.ProjectShootDefaults{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_ProjectShootDefaults(ref),
+		v1beta1.ProjectShootHibernationDefaults{}.OpenAPIModelName():              schema_pkg_apis_core_v1beta1_ProjectShootHibernationDefaults(ref),
+		v1beta1.ProjectShootMaintenanceDefaults{}.OpenAPIModelName():              schema_pkg_apis_core_v1beta1_ProjectShootMaintenanceDefaults(ref),
+		v1beta1.ProjectShootWorkerDefaults{}.OpenAPIModelName():                   schema_pkg_apis_core_v1beta1_ProjectShootWorkerDefaults(ref),
 		v1beta1.ProjectSpec{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ProjectSpec(ref),
 		v1beta1.ProjectStatus{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ProjectStatus(ref),
 		v1beta1.ProjectTolerations{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_ProjectTolerations(ref),
 		v1beta1.Provider{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_Provider(ref),
+		v1beta1.ProxyProtocolTLV{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_ProxyProtocolTLV(ref),
 		v1beta1.Quota{}.OpenAPIModelName():                                        schema_pkg_apis_core_v1beta1_Quota(ref),
 		v1beta1.QuotaList{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_QuotaList(ref),
 		v1beta1.QuotaSpec{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_QuotaSpec(ref),
@@ -201,6 +240,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.ResourceData{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_ResourceData(ref),
 		v1beta1.ResourceWatchCacheSize{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref),
 		v1beta1.SSHAccess{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_SSHAccess(ref),
+		v1beta1.SchedulerPlugin{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_SchedulerPlugin(ref),
+		v1beta1.SchedulerPluginSet{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_SchedulerPluginSet(ref),
+		v1beta1.SchedulerPlugins{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_SchedulerPlugins(ref),
+		v1beta1.SchedulerProfile{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_SchedulerProfile(ref),
 		v1beta1.SecretBinding{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_SecretBinding(ref),
 		v1beta1.SecretBindingList{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_SecretBindingList(ref),
 		v1beta1.SecretBindingProvider{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_SecretBindingProvider(ref),
@@ -208,15 +251,19 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.SeedDNS{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_SeedDNS(ref),
 		v1beta1.SeedDNSProvider{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref),
 		v1beta1.SeedDNSProviderConfig{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_SeedDNSProviderConfig(ref),
+		v1beta1.SeedDrainStatus{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_SeedDrainStatus(ref),
 		v1beta1.SeedList{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_SeedList(ref),
 		v1beta1.SeedNetworks{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedNetworks(ref),
 		v1beta1.SeedProvider{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedProvider(ref),
 		v1beta1.SeedSelector{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedSelector(ref),
+		v1beta1.SeedSettingControlPlaneResourceQuota{}.OpenAPIModelName():         schema_pkg_apis_core_v1beta1_SeedSettingControlPlaneResourceQuota(ref),
 		v1beta1.SeedSettingDependencyWatchdog{}.OpenAPIModelName():                schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref),
 		v1beta1.SeedSettingDependencyWatchdogProber{}.OpenAPIModelName():          schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogProber(ref),
 		v1beta1.SeedSettingDependencyWatchdogWeeder{}.OpenAPIModelName():          schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref),
+		v1beta1.SeedSettingDrain{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_SeedSettingDrain(ref),
 		v1beta1.SeedSettingExcessCapacityReservation{}.OpenAPIModelName():         schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservation(ref),
 		v1beta1.SeedSettingExcessCapacityReservationConfig{}.OpenAPIModelName():   schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservationConfig(ref),
+		v1beta1.SeedSettingIstioTLSTermination{}.OpenAPIModelName():               schema_pkg_apis_core_v1beta1_SeedSettingIstioTLSTermination(ref),
 		v1beta1.SeedSettingLoadBalancerServices{}.OpenAPIModelName():              schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServices(ref),
 		v1beta1.SeedSettingLoadBalancerServicesZonalIngress{}.OpenAPIModelName():  schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServicesZonalIngress(ref),
 		v1beta1.SeedSettingLoadBalancerServicesZones{}.OpenAPIModelName():         schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServicesZones(ref),
@@ -235,12 +282,27 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.ServiceAccountKeyRotation{}.OpenAPIModelName():                    schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref),
 		v1beta1.Shoot{}.OpenAPIModelName():                                        schema_pkg_apis_core_v1beta1_Shoot(ref),
 		v1beta1.ShootAdvertisedAddress{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref),
+		v1beta1.ShootAvailabilityReport{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ShootAvailabilityReport(ref),
+		v1beta1.ShootCostEstimate{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_ShootCostEstimate(ref),
 		v1beta1.ShootCredentials{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_ShootCredentials(ref),
 		v1beta1.ShootCredentialsRotation{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref),
+		v1beta1.ShootDebugPod{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ShootDebugPod(ref),
+		v1beta1.ShootDebugPodRequest{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_ShootDebugPodRequest(ref),
+		v1beta1.ShootDebugPodRequestSpec{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_ShootDebugPodRequestSpec(ref),
+		v1beta1.ShootDebugPodRequestStatus{}.OpenAPIModelName():                   schema_pkg_apis_core_v1beta1_ShootDebugPodRequestStatus(ref),
+		v1beta1.ShootFootprintRequest{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_ShootFootprintRequest(ref),
+		v1beta1.ShootFootprintRequestSpec{}.OpenAPIModelName():                    schema_pkg_apis_core_v1beta1_ShootFootprintRequestSpec(ref),
+		v1beta1.ShootFootprintRequestStatus{}.OpenAPIModelName():                  schema_pkg_apis_core_v1beta1_ShootFootprintRequestStatus(ref),
+		v1beta1.ShootImpactOperation{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_ShootImpactOperation(ref),
+		v1beta1.ShootImpactRequest{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_ShootImpactRequest(ref),
+		v1beta1.ShootImpactRequestSpec{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_ShootImpactRequestSpec(ref),
+		v1beta1.ShootImpactRequestStatus{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_ShootImpactRequestStatus(ref),
+		v1beta1.ShootInventory{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_ShootInventory(ref),
 		v1beta1.ShootKubeconfigRotation{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref),
 		v1beta1.ShootList{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_ShootList(ref),
 		v1beta1.ShootMachineImage{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_ShootMachineImage(ref),
 		v1beta1.ShootNetworks{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ShootNetworks(ref),
+		v1beta1.ShootReconciliationTimestamps{}.OpenAPIModelName():                schema_pkg_apis_core_v1beta1_ShootReconciliationTimestamps(ref),
 		v1beta1.ShootSSHKeypairRotation{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref),
 		v1beta1.ShootSpec{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_ShootSpec(ref),
 		v1beta1.ShootState{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_ShootState(ref),
@@ -248,22 +310,33 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.ShootStateSpec{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_ShootStateSpec(ref),
 		v1beta1.ShootStatus{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ShootStatus(ref),
 		v1beta1.ShootTemplate{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ShootTemplate(ref),
+		v1beta1.ShootValidationRule{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_ShootValidationRule(ref),
 		v1beta1.StructuredAuthentication{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref),
 		v1beta1.StructuredAuthorization{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_StructuredAuthorization(ref),
 		v1beta1.SystemComponents{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_SystemComponents(ref),
 		v1beta1.Toleration{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_Toleration(ref),
+		v1beta1.VersionClassificationTransitions{}.OpenAPIModelName():             schema_pkg_apis_core_v1beta1_VersionClassificationTransitions(ref),
+		v1beta1.VersionLifecyclePolicy{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_VersionLifecyclePolicy(ref),
 		v1beta1.VerticalPodAutoscaler{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref),
 		v1beta1.Volume{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Volume(ref),
+		v1beta1.VolumeSnapshotClass{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_VolumeSnapshotClass(ref),
+		v1beta1.VolumeSnapshotPolicy{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_VolumeSnapshotPolicy(ref),
+		v1beta1.VolumeSnapshots{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_VolumeSnapshots(ref),
 		v1beta1.VolumeType{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_VolumeType(ref),
 		v1beta1.WatchCacheSizes{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref),
 		v1beta1.Worker{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Worker(ref),
+		v1beta1.WorkerAccelerators{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_WorkerAccelerators(ref),
 		v1beta1.WorkerControlPlane{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_WorkerControlPlane(ref),
 		v1beta1.WorkerKubernetes{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
+		v1beta1.WorkerPoolInventory{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_WorkerPoolInventory(ref),
 		v1beta1.WorkerSystemComponents{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
 		v1beta1.WorkersSettings{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
+		v1beta1.WorkloadDefaults{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_WorkloadDefaults(ref),
+		v1beta1.ZoneInventory{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ZoneInventory(ref),
 		operationsv1alpha1.Bastion{}.OpenAPIModelName():                           schema_pkg_apis_operations_v1alpha1_Bastion(ref),
 		operationsv1alpha1.BastionIngressPolicy{}.OpenAPIModelName():              schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref),
 		operationsv1alpha1.BastionList{}.OpenAPIModelName():                       schema_pkg_apis_operations_v1alpha1_BastionList(ref),
+		operationsv1alpha1.BastionSessionRecording{}.OpenAPIModelName():           schema_pkg_apis_operations_v1alpha1_BastionSessionRecording(ref),
 		operationsv1alpha1.BastionSpec{}.OpenAPIModelName():                       schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
 		operationsv1alpha1.BastionStatus{}.OpenAPIModelName():                     schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
 		securityv1alpha1.ContextObject{}.OpenAPIModelName():                       schema_pkg_apis_security_v1alpha1_ContextObject(ref),
@@ -298,6 +371,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		seedmanagementv1alpha1.ManagedSeedTemplate{}.OpenAPIModelName():           schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedTemplate(ref),
 		seedmanagementv1alpha1.PendingReplica{}.OpenAPIModelName():                schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref),
 		seedmanagementv1alpha1.RollingUpdateStrategy{}.OpenAPIModelName():         schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref),
+		seedmanagementv1alpha1.SeedTemplate{}.OpenAPIModelName():                  schema_pkg_apis_seedmanagement_v1alpha1_SeedTemplate(ref),
+		seedmanagementv1alpha1.SeedTemplateList{}.OpenAPIModelName():              schema_pkg_apis_seedmanagement_v1alpha1_SeedTemplateList(ref),
+		seedmanagementv1alpha1.SeedTemplateReference{}.OpenAPIModelName():         schema_pkg_apis_seedmanagement_v1alpha1_SeedTemplateReference(ref),
 		seedmanagementv1alpha1.Shoot{}.OpenAPIModelName():                         schema_pkg_apis_seedmanagement_v1alpha1_Shoot(ref),
 		seedmanagementv1alpha1.UpdateStrategy{}.OpenAPIModelName():                schema_pkg_apis_seedmanagement_v1alpha1_UpdateStrategy(ref),
 		settingsv1alpha1.ClusterOpenIDConnectPreset{}.OpenAPIModelName():          schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref),
@@ -798,6 +874,124 @@ func schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestStatus(ref co
 	}
 }
 
+func schema_pkg_apis_authentication_v1alpha1_SSHCertificateRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SSHCertificateRequest can be used to request a short-lived SSH certificate for accessing the nodes of a Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the SSHCertificateRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1alpha1.SSHCertificateRequestSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the SSHCertificateRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1alpha1.SSHCertificateRequestStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"spec", "status"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.SSHCertificateRequestSpec{}.OpenAPIModelName(), v1alpha1.SSHCertificateRequestStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_authentication_v1alpha1_SSHCertificateRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SSHCertificateRequestSpec contains the public key to be signed and the expiration time of the certificate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"publicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicKey is the SSH public key in the OpenSSH authorized_keys format which should be signed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationSeconds is the requested validity duration of the certificate. The certificate issuer may return a certificate with a different validity duration so a client needs to check the 'expirationTimestamp' field in a response. Defaults to 1 hour.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"publicKey"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_authentication_v1alpha1_SSHCertificateRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SSHCertificateRequestStatus is the status of the SSHCertificateRequest containing the signed certificate and its expiration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Certificate is the signed SSH certificate in the OpenSSH authorized_keys format.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the name of the user on the nodes the certificate is valid for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the expiration timestamp of the returned certificate.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"certificate", "username", "expirationTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1024,11 +1218,53 @@ func schema_pkg_apis_core_v1_HelmControllerDeployment(ref common.ReferenceCallba
 							Ref:         ref(v1.OCIRepository{}.OpenAPIModelName()),
 						},
 					},
+					"valuesOverlays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuesOverlays is a list of chart values which are merged into the values for seeds matching the respective seed selector. The overlays are merged in the order of the list, i.e., later overlays take precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1.HelmValuesOverlay{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1.HelmValuesOverlay{}.OpenAPIModelName(), v1.OCIRepository{}.OpenAPIModelName(), apiextensionsv1.JSON{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1_HelmValuesOverlay(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmValuesOverlay contains chart values which are merged into the values for seeds matching the seed selector.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"seedSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedSelector is a label selector for seeds. An empty selector matches all seeds.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are the chart values which are merged into the values for matching seeds.",
+							Ref:         ref(apiextensionsv1.JSON{}.OpenAPIModelName()),
+						},
+					},
 				},
+				Required: []string{"values"},
 			},
 		},
 		Dependencies: []string{
-			v1.OCIRepository{}.OpenAPIModelName(), apiextensionsv1.JSON{}.OpenAPIModelName()},
+			apiextensionsv1.JSON{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
 	}
 }
 
@@ -1087,6 +1323,34 @@ func schema_pkg_apis_core_v1_OCIRepository(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_APIServerAccessRestrictions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerAccessRestrictions contains restrictions for accessing the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceRanges is a list of CIDRs which are allowed to access the kube-apiserver via its load balancer. Connections from all other client IPs are rejected. If empty, access is not restricted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_APIServerLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2114,6 +2378,80 @@ func schema_pkg_apis_core_v1beta1_CapabilityDefinition(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_Cleanup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Cleanup contains the policies for cleaning up the resources in the shoot cluster when it is deleted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"webhooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhooks is the cleanup policy for ValidatingWebhookConfigurations and MutatingWebhookConfigurations.",
+							Ref:         ref(v1beta1.CleanupPolicy{}.OpenAPIModelName()),
+						},
+					},
+					"extendedAPIs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExtendedAPIs is the cleanup policy for APIServices and CustomResourceDefinitions.",
+							Ref:         ref(v1beta1.CleanupPolicy{}.OpenAPIModelName()),
+						},
+					},
+					"kubernetesResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesResources is the cleanup policy for workloads, Services and PersistentVolumeClaims.",
+							Ref:         ref(v1beta1.CleanupPolicy{}.OpenAPIModelName()),
+						},
+					},
+					"volumeSnapshots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSnapshots is the cleanup policy for VolumeSnapshots and VolumeSnapshotContents.",
+							Ref:         ref(v1beta1.CleanupPolicy{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.CleanupPolicy{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CleanupPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CleanupPolicy contains the policy for cleaning up a class of resources in the shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"finalizeGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FinalizeGracePeriod is the duration after which the finalizers of the resources which are still present are forcefully removed. If not set, the default of the respective cleanup step is used.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"forceFinalize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForceFinalize is a list of resource kinds whose finalizers are forcefully removed right after their deletion was requested. Only kinds which are handled by the respective cleanup step are considered.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(metav1.GroupKind{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName(), metav1.GroupKind{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_CloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2413,12 +2751,38 @@ func schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"shootValidationRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootValidationRules contains CEL validation rules which must be satisfied by Shoot clusters using this CloudProfile. See https://github.com/gardener/gardener/blob/master/docs/usage/shoot/shoot_validation_rules.md.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ShootValidationRule{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"versionLifecyclePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionLifecyclePolicy declares automatic transitions of the classifications of the Kubernetes and machine image versions which are applied by the gardener-controller-manager. See https://github.com/gardener/gardener/blob/master/docs/usage/shoot-operations/shoot_versions.md#version-lifecycle-policies.",
+							Ref:         ref(v1beta1.VersionLifecyclePolicy{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"kubernetes", "machineImages", "machineTypes", "regions", "type"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Bastion{}.OpenAPIModelName(), v1beta1.CapabilityDefinition{}.OpenAPIModelName(), v1beta1.KubernetesSettings{}.OpenAPIModelName(), v1beta1.Limits{}.OpenAPIModelName(), v1beta1.MachineImage{}.OpenAPIModelName(), v1beta1.MachineType{}.OpenAPIModelName(), v1beta1.Region{}.OpenAPIModelName(), v1beta1.SeedSelector{}.OpenAPIModelName(), v1beta1.VolumeType{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
+			v1beta1.Bastion{}.OpenAPIModelName(), v1beta1.CapabilityDefinition{}.OpenAPIModelName(), v1beta1.KubernetesSettings{}.OpenAPIModelName(), v1beta1.Limits{}.OpenAPIModelName(), v1beta1.MachineImage{}.OpenAPIModelName(), v1beta1.MachineType{}.OpenAPIModelName(), v1beta1.Region{}.OpenAPIModelName(), v1beta1.SeedSelector{}.OpenAPIModelName(), v1beta1.ShootValidationRule{}.OpenAPIModelName(), v1beta1.VersionLifecyclePolicy{}.OpenAPIModelName(), v1beta1.VolumeType{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
@@ -2672,11 +3036,25 @@ func schema_pkg_apis_core_v1beta1_ClusterAutoscalerOptions(ref common.ReferenceC
 							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
 						},
 					},
+					"capacityHints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CapacityHints are the expected resources of the machines of this worker pool (e.g., extended resources like GPUs). They are propagated to the node template which is used by the cluster-autoscaler when scaling up the worker pool from zero and take precedence over the resources of the machine type in the CloudProfile.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			metav1.Duration{}.OpenAPIModelName()},
+			resource.Quantity{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -2836,30 +3214,149 @@ func schema_pkg_apis_core_v1beta1_ControlPlaneAutoscaling(ref common.ReferenceCa
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ControlPlaneComponentFootprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ControllerDeployment contains information about how this controller is deployed.",
+				Description: "ControlPlaneComponentFootprint contains the estimated resource footprint of a control plane component.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the control plane component.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"replicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Replicas is the estimated number of replicas of the control plane component.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"metadata": {
+					"requests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
+							Description: "Requests are the estimated resource requests of all replicas of the control plane component.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "replicas"},
+			},
+		},
+		Dependencies: []string{
+			resource.Quantity{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControlPlaneSizeClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlaneSizeClass describes the resource constraints for the control plane namespaces of shoots of a certain size.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the size class.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxNodes is the maximum number of nodes of shoots in this size class. If not set, the size class applies to shoots of any size.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quota is the hard limit for the aggregated resource consumption in the control plane namespace.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"containerMax": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerMax is the maximum amount of resources a single container in the control plane namespace can request or be limited to. Containers without limits are limited to this amount.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"containerDefaultRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerDefaultRequest is the amount of resources requested by containers in the control plane namespace which do not specify requests.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "quota"},
+			},
+		},
+		Dependencies: []string{
+			resource.Quantity{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerDeployment contains information about how this controller is deployed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
 							Default:     map[string]interface{}{},
 							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
 						},
@@ -2894,6 +3391,42 @@ func schema_pkg_apis_core_v1beta1_ControllerDeployment(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ControllerDeploymentCanaryRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerDeploymentCanaryRollout contains the configuration for a canary rollout of a `ControllerDeployment`.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"seedSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedSelector is a label selector for the canary seeds.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"verificationDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerificationDuration is the duration for which the extension must be installed and healthy on all canary seeds before it is rolled out to the remaining seeds. Defaults to 10m.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"minShootReconcileSuccessRate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinShootReconcileSuccessRate is the minimum percentage of successful shoot reconciliations on the canary seeds since the new `ControllerDeployment` was rolled out to them. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"seedSelector"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ControllerDeploymentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2945,6 +3478,27 @@ func schema_pkg_apis_core_v1beta1_ControllerDeploymentList(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ControllerDeploymentRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerDeploymentRollout contains the configuration for rolling out a change of the referenced `ControllerDeployment` across seeds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary configures a canary rollout. When the `ControllerRegistration` is switched to another `ControllerDeployment`, the new `ControllerDeployment` is rolled out to the canary seeds first. It is only rolled out to the remaining seeds after it has been verified successfully on the canary seeds, otherwise the rollout is held.",
+							Ref:         ref(v1beta1.ControllerDeploymentCanaryRollout{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ControllerDeploymentCanaryRollout{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ControllerInstallation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3171,6 +3725,28 @@ func schema_pkg_apis_core_v1beta1_ControllerRegistration(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ControllerRegistrationDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerRegistrationDependency is a reference to another ControllerRegistration a controller depends on.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the ControllerRegistration.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ControllerRegistrationDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3205,11 +3781,17 @@ func schema_pkg_apis_core_v1beta1_ControllerRegistrationDeployment(ref common.Re
 							},
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout configures how a change of the referenced `ControllerDeployment` is rolled out across seeds. If not set, changes are rolled out to all seeds at once.",
+							Ref:         ref(v1beta1.ControllerDeploymentRollout{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.DeploymentRef{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
+			v1beta1.ControllerDeploymentRollout{}.OpenAPIModelName(), v1beta1.DeploymentRef{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
 	}
 }
 
@@ -3291,11 +3873,39 @@ func schema_pkg_apis_core_v1beta1_ControllerRegistrationSpec(ref common.Referenc
 							Ref:         ref(v1beta1.ControllerRegistrationDeployment{}.OpenAPIModelName()),
 						},
 					},
+					"errorCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorCodes is a list of error codes which may be reported by this controller in addition to the well-known error codes of Gardener.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ErrorCodeDefinition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"dependencies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependencies is a list of other ControllerRegistrations this controller depends on. Whenever this controller is required for a seed or shoot, its dependencies are required as well, and it is only installed after all of its dependencies have been installed successfully.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ControllerRegistrationDependency{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.ControllerRegistrationDeployment{}.OpenAPIModelName(), v1beta1.ControllerResource{}.OpenAPIModelName()},
+			v1beta1.ControllerRegistrationDependency{}.OpenAPIModelName(), v1beta1.ControllerRegistrationDeployment{}.OpenAPIModelName(), v1beta1.ControllerResource{}.OpenAPIModelName(), v1beta1.ErrorCodeDefinition{}.OpenAPIModelName()},
 	}
 }
 
@@ -3378,6 +3988,21 @@ func schema_pkg_apis_core_v1beta1_ControllerResource(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities is a list of optional features of the resource kind which are supported by the controller. Currently, only \"DNSFailover\" is supported for resources of kind \"DNSRecord\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"kind", "type"},
 			},
@@ -3421,65 +4046,141 @@ func schema_pkg_apis_core_v1beta1_ControllerResourceLifecycle(ref common.Referen
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_CoreDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ControllerWorkersConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.",
+				Description: "ControllerWorkersConfig contains the number of workers for individual controllers of the kube-controller-manager. Gardener uses its own defaults for fields which are not set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"autoscaling": {
+					"deployment": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Autoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.",
-							Ref:         ref(v1beta1.CoreDNSAutoscaling{}.OpenAPIModelName()),
+							Description: "Deployment is the number of workers for the Deployment controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"rewriting": {
+					"replicaSet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rewriting contains the setting related to rewriting of requests, which are obviously incorrect due to the unnecessary application of the search path.",
-							Ref:         ref(v1beta1.CoreDNSRewriting{}.OpenAPIModelName()),
+							Description: "ReplicaSet is the number of workers for the ReplicaSet controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			v1beta1.CoreDNSAutoscaling{}.OpenAPIModelName(), v1beta1.CoreDNSRewriting{}.OpenAPIModelName()},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "CoreDNSAutoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"mode": {
+					"statefulSet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The mode of the autoscaling to be used for the Core DNS components running in the data plane of the Shoot cluster. Supported values are `horizontal` and `cluster-proportional`.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "StatefulSet is the number of workers for the StatefulSet controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-				},
-				Required: []string{"mode"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "CoreDNSRewriting contains the setting related to rewriting requests, which are obviously incorrect due to the unnecessary application of the search path.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"commonSuffixes": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CommonSuffixes are expected to be the suffix of a fully qualified domain name. Each suffix should contain at least one or two dots ('.') to prevent accidental clashes.",
+							Description: "Endpoint is the number of workers for the Endpoint controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"serviceEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceEndpoint is the number of workers for the EndpointSlice controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"garbageCollector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GarbageCollector is the number of workers for the garbage collector controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the number of workers for the Namespace controller. For workerless shoots, it can be set to '0' in order to disable the controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resourceQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceQuota is the number of workers for the ResourceQuota controller. For workerless shoots, it can be set to '0' in order to disable the controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"serviceAccountToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountToken is the number of workers for the ServiceAccount token controller. For workerless shoots, it can be set to '0' in order to disable the controller.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CoreDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"autoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.",
+							Ref:         ref(v1beta1.CoreDNSAutoscaling{}.OpenAPIModelName()),
+						},
+					},
+					"rewriting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rewriting contains the setting related to rewriting of requests, which are obviously incorrect due to the unnecessary application of the search path.",
+							Ref:         ref(v1beta1.CoreDNSRewriting{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.CoreDNSAutoscaling{}.OpenAPIModelName(), v1beta1.CoreDNSRewriting{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSAutoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The mode of the autoscaling to be used for the Core DNS components running in the data plane of the Shoot cluster. Supported values are `horizontal` and `cluster-proportional`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"mode"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSRewriting contains the setting related to rewriting requests, which are obviously incorrect due to the unnecessary application of the search path.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"commonSuffixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CommonSuffixes are expected to be the suffix of a fully qualified domain name. Each suffix should contain at least one or two dots ('.') to prevent accidental clashes.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -3526,11 +4227,52 @@ func schema_pkg_apis_core_v1beta1_DNS(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"apiServerRecord": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIServerRecord contains settings for the DNS record of the external domain of the API server.",
+							Ref:         ref(v1beta1.DNSAPIServerRecord{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.DNSAPIServerRecord{}.OpenAPIModelName(), v1beta1.DNSProvider{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_DNSAPIServerRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSAPIServerRecord contains settings for the DNS record of the external domain of the API server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLs is a list of TTLs per DNS record type. The type of the DNS record depends on the address of the API server's load balancer. If no TTL is configured for the used record type, the default TTL of gardenlet is used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.DNSRecordTTL{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"failover": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failover contains the configuration for health-checked secondary targets of the DNS record. It requires a primary DNS provider whose DNSRecord extension supports the \"DNSFailover\" capability.",
+							Ref:         ref(v1beta1.DNSFailover{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.DNSProvider{}.OpenAPIModelName()},
+			v1beta1.DNSFailover{}.OpenAPIModelName(), v1beta1.DNSRecordTTL{}.OpenAPIModelName()},
 	}
 }
 
@@ -3545,6 +4287,92 @@ func schema_pkg_apis_core_v1beta1_DNSExposure(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_DNSFailover(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSFailover contains the configuration for health-checked secondary targets of a DNS record.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values is a list of IP addresses or a single hostname which are served by the DNS record if the health check of the primary target fails.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"healthCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthCheck contains the configuration of the health check of the primary target.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.DNSHealthCheck{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"values"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.DNSHealthCheck{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_DNSHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSHealthCheck contains the configuration of a health check for a DNS record target.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is the protocol used for the health check. Supported values are \"HTTPS\" and \"TCP\". Defaults to \"HTTPS\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port used for the health check. Defaults to 443.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path used for HTTPS health checks. Defaults to \"/healthz\" for the \"HTTPS\" protocol.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"intervalSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IntervalSeconds is the interval between two health checks in seconds. Defaults to 30.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failureThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureThreshold is the number of consecutive failed health checks after which the secondary targets are served. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_DNSIncludeExclude(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3642,6 +4470,36 @@ func schema_pkg_apis_core_v1beta1_DNSProvider(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_DNSRecordTTL(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSRecordTTL contains the TTL for a DNS record type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"recordType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecordType is the DNS record type. Supported values are \"A\", \"AAAA\", and \"CNAME\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"seconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Seconds is the time to live in seconds.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"recordType", "seconds"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_DataVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3686,6 +4544,44 @@ func schema_pkg_apis_core_v1beta1_DataVolume(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_DeferredShootMaintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeferredShootMaintenance contains information about a Shoot whose maintenance was deferred.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the Shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the Shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastDeferredTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastDeferredTime is the last time the maintenance of the Shoot was deferred.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"namespace", "name", "lastDeferredTime"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_DeploymentRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3849,22 +4745,189 @@ func schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref common.Reference
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_EncryptionAtRest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_Egress(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EncryptionAtRest contains information about Shoot data encryption at rest.",
+				Description: "Egress contains configuration for the egress traffic of the shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resources": {
+					"ipPools": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources is the list of resources in the Shoot which are currently encrypted. Secrets are encrypted by default and are not part of the list. See https://github.com/gardener/gardener/blob/master/docs/usage/security/etcd_encryption_config.md for more details.",
+							Description: "IPPools is a list of static egress IP pools which should be provisioned for the shoot cluster.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.EgressIPPool{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"namespaceSelections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelections is a list of rules selecting the egress IP pool for the traffic of namespaces. Traffic of namespaces which are not selected by any rule leaves the cluster via the default egress path of the infrastructure.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.EgressNamespaceSelection{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter contains user-maintained exceptions for the egress filter of the shoot cluster. They are consumed by networking filter extensions via the Cluster resource.",
+							Ref:         ref(v1beta1.EgressFilter{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.EgressFilter{}.OpenAPIModelName(), v1beta1.EgressIPPool{}.OpenAPIModelName(), v1beta1.EgressNamespaceSelection{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_EgressFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressFilter contains user-maintained exceptions for the egress filter of the shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allowList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowList is a list of CIDRs to which egress traffic is allowed even if they are blocked by the filter list of the egress filter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"denyList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DenyList is a list of CIDRs to which egress traffic is denied in addition to the filter list of the egress filter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_EgressIPPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressIPPool is a pool of static egress IP addresses.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of static egress IP addresses which are allocated for the pool by the provider extension. Either Count or Addresses must be set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"addresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addresses is a list of pre-allocated IP addresses the pool consists of. Either Count or Addresses must be set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_EgressNamespaceSelection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressNamespaceSelection selects the egress IP pool for the traffic of namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPPool is the name of the egress IP pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces whose egress traffic leaves the cluster via the egress IP pool.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"ipPool", "namespaceSelector"},
+			},
+		},
+		Dependencies: []string{
+			metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_EncryptionAtRest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EncryptionAtRest contains information about Shoot data encryption at rest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources is the list of resources in the Shoot which are currently encrypted. Secrets are encrypted by default and are not part of the list. See https://github.com/gardener/gardener/blob/master/docs/usage/security/etcd_encryption_config.md for more details.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
 										Format:  "",
 									},
 								},
@@ -3967,6 +5030,50 @@ func schema_pkg_apis_core_v1beta1_EncryptionProviderStatus(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ErrorCodeDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ErrorCodeDefinition describes an error code which is not well-known to Gardener but may be reported by an extension.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"code": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Code is the error code. It must start with `ERR_` and consist of upper case letters, digits and underscores only.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human readable description of the error code.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserError indicates that the error can be remediated by the user (e.g., by refreshing expired infrastructure credentials) and does not require a Gardener operator.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nonRetryable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NonRetryable indicates that an automatic retry would not help fixing the problem. Operations failing with such an error are not retried.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"code", "description"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ExpirableVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4041,10 +5148,18 @@ func schema_pkg_apis_core_v1beta1_ExpirableVersionStatus(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the time the version was first observed in its current classification.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"version", "classification"},
 			},
 		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -4481,11 +5596,53 @@ func schema_pkg_apis_core_v1beta1_HelmControllerDeployment(ref common.ReferenceC
 							Ref:         ref(v1beta1.OCIRepository{}.OpenAPIModelName()),
 						},
 					},
+					"valuesOverlays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuesOverlays is a list of chart values which are merged into the values for seeds matching the respective seed selector. The overlays are merged in the order of the list, i.e., later overlays take precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.HelmValuesOverlay{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.HelmValuesOverlay{}.OpenAPIModelName(), v1beta1.OCIRepository{}.OpenAPIModelName(), apiextensionsv1.JSON{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_HelmValuesOverlay(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmValuesOverlay contains chart values which are merged into the values for seeds matching the seed selector.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"seedSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedSelector is a label selector for seeds. An empty selector matches all seeds.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are the chart values which are merged into the values for matching seeds.",
+							Ref:         ref(apiextensionsv1.JSON{}.OpenAPIModelName()),
+						},
+					},
 				},
+				Required: []string{"values"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.OCIRepository{}.OpenAPIModelName(), apiextensionsv1.JSON{}.OpenAPIModelName()},
+			apiextensionsv1.JSON{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
 	}
 }
 
@@ -4700,12 +5857,18 @@ func schema_pkg_apis_core_v1beta1_Ingress(ref common.ReferenceCallback) common.O
 							Ref:         ref(v1beta1.IngressController{}.OpenAPIModelName()),
 						},
 					},
+					"wildcardCertificate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WildcardCertificate configures that the wildcard certificate for the ingress domain is requested from a certificate issuer (e.g., an ACME issuer provided by an extension) instead of being provided as static secret.",
+							Ref:         ref(v1beta1.IngressWildcardCertificate{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"domain", "controller"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.IngressController{}.OpenAPIModelName()},
+			v1beta1.IngressController{}.OpenAPIModelName(), v1beta1.IngressWildcardCertificate{}.OpenAPIModelName()},
 	}
 }
 
@@ -4739,6 +5902,26 @@ func schema_pkg_apis_core_v1beta1_IngressController(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_IngressWildcardCertificate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IngressWildcardCertificate configures how the wildcard certificate for the ingress domain is requested. The certificate is requested via a `cert.gardener.cloud/v1alpha1.Certificate` resource in the `garden` namespace of the seed cluster which must be served by an extension or by cert-management.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"issuerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IssuerName is the name of the issuer used for requesting the certificate. If not set, the default issuer of the certificate controller is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_InternalSecret(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5032,11 +6215,24 @@ func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallba
 							Ref:         ref(v1beta1.ControlPlaneAutoscaling{}.OpenAPIModelName()),
 						},
 					},
+					"accessRestrictions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessRestrictions contains restrictions for accessing the kube-apiserver via its load balancer.",
+							Ref:         ref(v1beta1.APIServerAccessRestrictions{}.OpenAPIModelName()),
+						},
+					},
+					"nodeConnectivityMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeConnectivityMode is the mode used by the kube-apiserver to connect to nodes, pods and services of the shoot cluster, e.g., for `kubectl logs/exec/port-forward` or for webhooks served inside the cluster. Possible values are `VPN` and `Konnectivity`. Defaults to `VPN`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.APIServerLogging{}.OpenAPIModelName(), v1beta1.APIServerRequests{}.OpenAPIModelName(), v1beta1.AdmissionPlugin{}.OpenAPIModelName(), v1beta1.AuditConfig{}.OpenAPIModelName(), v1beta1.ControlPlaneAutoscaling{}.OpenAPIModelName(), v1beta1.EncryptionConfig{}.OpenAPIModelName(), v1beta1.OIDCConfig{}.OpenAPIModelName(), v1beta1.ServiceAccountConfig{}.OpenAPIModelName(), v1beta1.StructuredAuthentication{}.OpenAPIModelName(), v1beta1.StructuredAuthorization{}.OpenAPIModelName(), v1beta1.WatchCacheSizes{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
+			v1beta1.APIServerAccessRestrictions{}.OpenAPIModelName(), v1beta1.APIServerLogging{}.OpenAPIModelName(), v1beta1.APIServerRequests{}.OpenAPIModelName(), v1beta1.AdmissionPlugin{}.OpenAPIModelName(), v1beta1.AuditConfig{}.OpenAPIModelName(), v1beta1.ControlPlaneAutoscaling{}.OpenAPIModelName(), v1beta1.EncryptionConfig{}.OpenAPIModelName(), v1beta1.OIDCConfig{}.OpenAPIModelName(), v1beta1.ServiceAccountConfig{}.OpenAPIModelName(), v1beta1.StructuredAuthentication{}.OpenAPIModelName(), v1beta1.StructuredAuthorization{}.OpenAPIModelName(), v1beta1.WatchCacheSizes{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -5095,11 +6291,23 @@ func schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref common.Referen
 							Format:      "int32",
 						},
 					},
+					"controllerWorkers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControllerWorkers contains the number of workers for individual controllers of the kube-controller-manager.",
+							Ref:         ref(v1beta1.ControllerWorkersConfig{}.OpenAPIModelName()),
+						},
+					},
+					"nodeEviction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeEviction contains settings for the eviction of pods from unhealthy nodes performed by the node lifecycle controller. It replaces the deprecated `podEvictionTimeout` field, see its description for more details.",
+							Ref:         ref(v1beta1.NodeEvictionConfig{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.HorizontalPodAutoscalerConfig{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
+			v1beta1.ControllerWorkersConfig{}.OpenAPIModelName(), v1beta1.HorizontalPodAutoscalerConfig{}.OpenAPIModelName(), v1beta1.NodeEvictionConfig{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -5183,9 +6391,25 @@ func schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
+					"profiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profiles contains additional scheduler profiles which are served by the kube-scheduler next to the profiles managed by Gardener. Pods can select a profile by setting `spec.schedulerName` to the name of the profile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.SchedulerProfile{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.SchedulerProfile{}.OpenAPIModelName()},
 	}
 }
 
@@ -5636,11 +6860,17 @@ func schema_pkg_apis_core_v1beta1_Kubernetes(ref common.ReferenceCallback) commo
 							Ref:         ref(v1beta1.ETCD{}.OpenAPIModelName()),
 						},
 					},
+					"workloadDefaults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkloadDefaults contains defaults which are injected into the pods of user workloads in the shoot cluster. Pods in the kube-system namespace and pods managed by Gardener are not considered.",
+							Ref:         ref(v1beta1.WorkloadDefaults{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.ClusterAutoscaler{}.OpenAPIModelName(), v1beta1.ETCD{}.OpenAPIModelName(), v1beta1.KubeAPIServerConfig{}.OpenAPIModelName(), v1beta1.KubeControllerManagerConfig{}.OpenAPIModelName(), v1beta1.KubeProxyConfig{}.OpenAPIModelName(), v1beta1.KubeSchedulerConfig{}.OpenAPIModelName(), v1beta1.KubeletConfig{}.OpenAPIModelName(), v1beta1.VerticalPodAutoscaler{}.OpenAPIModelName()},
+			v1beta1.ClusterAutoscaler{}.OpenAPIModelName(), v1beta1.ETCD{}.OpenAPIModelName(), v1beta1.KubeAPIServerConfig{}.OpenAPIModelName(), v1beta1.KubeControllerManagerConfig{}.OpenAPIModelName(), v1beta1.KubeProxyConfig{}.OpenAPIModelName(), v1beta1.KubeSchedulerConfig{}.OpenAPIModelName(), v1beta1.KubeletConfig{}.OpenAPIModelName(), v1beta1.VerticalPodAutoscaler{}.OpenAPIModelName(), v1beta1.WorkloadDefaults{}.OpenAPIModelName()},
 	}
 }
 
@@ -5982,10 +7212,26 @@ func schema_pkg_apis_core_v1beta1_LoadBalancerServicesProxyProtocol(ref common.R
 							Format:      "",
 						},
 					},
+					"tlvs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLVs is a list of type-length-value (TLV) fields of PROXY protocol v2 headers which are parsed by the istio ingress gateways, e.g., VPC endpoint IDs added by cloud load balancers for private links. The values are stored as dynamic metadata of the connection and are added as `X-Gardener-Proxy-Protocol-<name>` request headers to requests to kube-apiservers if the TLS connections are terminated by the istio ingress gateways.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ProxyProtocolTLV{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"allowed"},
 			},
 		},
+		Dependencies: []string{
+			v1beta1.ProxyProtocolTLV{}.OpenAPIModelName()},
 	}
 }
 
@@ -6300,6 +7546,36 @@ func schema_pkg_apis_core_v1beta1_MachineImageVersion(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MachinePhaseCount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachinePhaseCount contains the number of machines in a certain phase.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the machine phase, e.g. `Pending`, `Running`, or `Failed`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of machines in this phase.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"phase", "count"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6558,6 +7834,207 @@ func schema_pkg_apis_core_v1beta1_MaintenanceCredentialsAutoRotation(ref common.
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MaintenanceFreeze(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceFreeze represents time ranges during which the maintenance of Shoots is paused.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of the MaintenanceFreeze.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.MaintenanceFreezeSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status of the MaintenanceFreeze.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.MaintenanceFreezeStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.MaintenanceFreezeSpec{}.OpenAPIModelName(), v1beta1.MaintenanceFreezeStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceFreezeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceFreezeList is a collection of MaintenanceFreezes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of MaintenanceFreezes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.MaintenanceFreeze{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.MaintenanceFreeze{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceFreezeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceFreezeSpec is the specification of a MaintenanceFreeze.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"windows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows are the time ranges during which the maintenance of the selected Shoots is paused.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.MaintenanceFreezeWindow{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"projectSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectSelector is an optional label selector for Projects whose Shoots are affected by the MaintenanceFreeze. If it is not set, Shoots of all Projects are affected.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"seedSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedSelector is an optional label selector for Seeds whose Shoots are affected by the MaintenanceFreeze. If it is not set, Shoots on all Seeds are affected.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"windows"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.MaintenanceFreezeWindow{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceFreezeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceFreezeStatus is the status of a MaintenanceFreeze.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deferredShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeferredShoots contains the Shoots whose maintenance was deferred because of the MaintenanceFreeze.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.DeferredShootMaintenance{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.DeferredShootMaintenance{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceFreezeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceFreezeWindow is a time range during which the maintenance of Shoots is paused.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is the beginning of the time range.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end of the time range.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"begin", "end"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MaintenanceRotationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6609,6 +8086,35 @@ func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ManagedAddon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManagedAddon selects an addon from the addon catalogs provided by extensions which is deployed into the shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the addon in the addon catalog.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is a semantic version constraint for the addon, e.g. `~1.2` or `>= 1.0, < 2.0`. The highest version of the addon satisfying the constraint is deployed. If not set, the highest available version is deployed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ManualWorkerPoolRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7004,11 +8510,17 @@ func schema_pkg_apis_core_v1beta1_Networking(ref common.ReferenceCallback) commo
 							},
 						},
 					},
+					"egress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Egress contains configuration for the egress traffic of the shoot cluster. It is passed to the provider extensions which implement it on the respective infrastructure.",
+							Ref:         ref(v1beta1.Egress{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			runtime.RawExtension{}.OpenAPIModelName()},
+			v1beta1.Egress{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
@@ -7139,10 +8651,111 @@ func schema_pkg_apis_core_v1beta1_NginxIngress(ref common.ReferenceCallback) com
 							Enum:        []interface{}{"Cluster", "Local"},
 						},
 					},
-				},
+					"loadBalancerAnnotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadBalancerAnnotations are additional annotations for the load balancer `Service` exposing the nginx-ingress, e.g., to configure the load balancer of the cloud provider.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"autoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscaling contains the settings for the horizontal autoscaling of the nginx-ingress controller. If not set, the controller runs with a single replica.",
+							Ref:         ref(v1beta1.NginxIngressAutoscaling{}.OpenAPIModelName()),
+						},
+					},
+				},
 				Required: []string{"enabled"},
 			},
 		},
+		Dependencies: []string{
+			v1beta1.NginxIngressAutoscaling{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NginxIngressAutoscaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NginxIngressAutoscaling contains the settings for the horizontal autoscaling of the nginx-ingress controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the minimum number of replicas of the nginx-ingress controller. Defaults to `1`.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the maximum number of replicas of the nginx-ingress controller.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targetCPUUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetCPUUtilizationPercentage is the average CPU utilization of the nginx-ingress controller pods at which the controller is scaled out. Defaults to `80`.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"maxReplicas"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NodeEvictionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeEvictionConfig contains settings for the eviction of pods from unhealthy nodes performed by the node lifecycle controller of the kube-controller-manager. Note: Descriptions were taken from the Kubernetes documentation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rate is the number of nodes per second on which pods are deleted in case of node failure when a zone is healthy.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"secondaryRate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecondaryRate is the number of nodes per second on which pods are deleted in case of node failure when a zone is unhealthy. This value is implicitly overridden to 0 if the cluster size is smaller than `largeClusterSizeThreshold`.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"largeClusterSizeThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LargeClusterSizeThreshold is the number of nodes from which the node lifecycle controller treats the cluster as large for the eviction logic purposes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"unhealthyZoneThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnhealthyZoneThreshold is the fraction of nodes in a zone which needs to be not ready for the zone to be treated as unhealthy.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -7649,6 +9262,139 @@ func schema_pkg_apis_core_v1beta1_ProjectMember(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ProjectShootDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectShootDefaults contains default values for Shoots in a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maintenance contains defaults for the maintenance configuration of Shoots.",
+							Ref:         ref(v1beta1.ProjectShootMaintenanceDefaults{}.OpenAPIModelName()),
+						},
+					},
+					"hibernation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hibernation contains defaults for the hibernation configuration of Shoots.",
+							Ref:         ref(v1beta1.ProjectShootHibernationDefaults{}.OpenAPIModelName()),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers contains defaults for the worker pools of Shoots.",
+							Ref:         ref(v1beta1.ProjectShootWorkerDefaults{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ProjectShootHibernationDefaults{}.OpenAPIModelName(), v1beta1.ProjectShootMaintenanceDefaults{}.OpenAPIModelName(), v1beta1.ProjectShootWorkerDefaults{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ProjectShootHibernationDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectShootHibernationDefaults contains defaults for the hibernation configuration of Shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules are the default hibernation schedules of Shoots. They are only applied if a Shoot does not specify any hibernation schedule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.HibernationSchedule{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.HibernationSchedule{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ProjectShootMaintenanceDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectShootMaintenanceDefaults contains defaults for the maintenance configuration of Shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeWindow is the default maintenance time window of Shoots.",
+							Ref:         ref(v1beta1.MaintenanceTimeWindow{}.OpenAPIModelName()),
+						},
+					},
+					"confineSpecUpdateRollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfineSpecUpdateRollout is the default for `.spec.maintenance.confineSpecUpdateRollout` of Shoots.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.MaintenanceTimeWindow{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ProjectShootWorkerDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectShootWorkerDefaults contains defaults for the worker pools of Shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImage is the default machine image of worker pools. If the version is omitted, the latest supported version of the image in the CloudProfile is used.",
+							Ref:         ref(v1beta1.ShootMachineImage{}.OpenAPIModelName()),
+						},
+					},
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume is the default root volume of worker pools.",
+							Ref:         ref(v1beta1.Volume{}.OpenAPIModelName()),
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are added to the labels of worker pools. Labels specified in the worker pools take precedence.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ShootMachineImage{}.OpenAPIModelName(), v1beta1.Volume{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7723,11 +9469,17 @@ func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"shootDefaults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootDefaults contains default values for Shoots in this project. They are applied when Shoots are created and the respective fields are not set.",
+							Ref:         ref(v1beta1.ProjectShootDefaults{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.DualApprovalForDeletion{}.OpenAPIModelName(), v1beta1.ProjectMember{}.OpenAPIModelName(), v1beta1.ProjectTolerations{}.OpenAPIModelName(), rbacv1.Subject{}.OpenAPIModelName()},
+			v1beta1.DualApprovalForDeletion{}.OpenAPIModelName(), v1beta1.ProjectMember{}.OpenAPIModelName(), v1beta1.ProjectShootDefaults{}.OpenAPIModelName(), v1beta1.ProjectTolerations{}.OpenAPIModelName(), rbacv1.Subject{}.OpenAPIModelName()},
 	}
 }
 
@@ -7895,6 +9647,36 @@ func schema_pkg_apis_core_v1beta1_Provider(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ProxyProtocolTLV(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProxyProtocolTLV is a type-length-value (TLV) field of PROXY protocol v2 headers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the TLV, e.g., 234 (0xEA) for AWS VPC endpoint IDs. It must be in the range [0,255].",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name under which the value of the TLV is surfaced. It must consist of lower case alphanumeric characters or '-' and be at most 32 characters long.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Quota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8211,37 +9993,166 @@ func schema_pkg_apis_core_v1beta1_SSHAccess(ref common.ReferenceCallback) common
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SchedulerPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretBinding represents a binding to a secret in the same or another namespace.\n\nDeprecated: Use CredentialsBinding instead. See https://github.com/gardener/gardener/blob/master/docs/usage/shoot-operations/secretbinding-to-credentialsbinding-migration.md for migration instructions.",
+				Description: "SchedulerPlugin specifies a plugin name and its weight.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name is the name of the plugin.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+							Description: "Weight is the weight of the plugin. It is only applicable for score plugins.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"secretRef": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SchedulerPluginSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SchedulerPluginSet specifies enabled and disabled plugins for an extension point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a secret object in the same or another namespace. This field is immutable.",
+							Description: "Enabled specifies plugins that should be enabled in addition to the default plugins. If a default plugin is also configured here, its weight is overridden.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.SchedulerPlugin{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled specifies default plugins that should be disabled. All default plugins can be disabled by using `*`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.SchedulerPlugin{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.SchedulerPlugin{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SchedulerPlugins(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SchedulerPlugins contains the plugins which should be enabled or disabled per extension point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter is a list of plugins that should be invoked when filtering out nodes that cannot run the Pod.",
+							Ref:         ref(v1beta1.SchedulerPluginSet{}.OpenAPIModelName()),
+						},
+					},
+					"score": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Score is a list of plugins that should be invoked when ranking nodes that have passed the filtering phase.",
+							Ref:         ref(v1beta1.SchedulerPluginSet{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.SchedulerPluginSet{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SchedulerProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SchedulerProfile is an additional scheduler profile of the kube-scheduler. Note: The structure mirrors the `KubeSchedulerProfile` of the `KubeSchedulerConfiguration` API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedulerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulerName is the name of the scheduler profile. It must not collide with the names of the profiles managed by Gardener, i.e., `default-scheduler` and `bin-packing-scheduler`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"plugins": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugins specifies the plugins that should be enabled or disabled in addition to the default plugins.",
+							Ref:         ref(v1beta1.SchedulerPlugins{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"schedulerName"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.SchedulerPlugins{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretBinding represents a binding to a secret in the same or another namespace.\n\nDeprecated: Use CredentialsBinding instead. See https://github.com/gardener/gardener/blob/master/docs/usage/shoot-operations/secretbinding-to-credentialsbinding-migration.md for migration instructions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret object in the same or another namespace. This field is immutable.",
 							Default:     map[string]interface{}{},
 							Ref:         ref(corev1.SecretReference{}.OpenAPIModelName()),
 						},
@@ -8522,6 +10433,44 @@ func schema_pkg_apis_core_v1beta1_SeedDNSProviderConfig(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedDrainStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedDrainStatus contains information about the progress of draining a seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"remainingShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemainingShoots is the number of shoots which are still scheduled onto the seed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"migratingShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigratingShoots is the number of shoots which are currently being migrated away from the seed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time at which the drain progress was last updated.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"remainingShoots", "migratingShoots", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8766,6 +10715,44 @@ func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedSettingControlPlaneResourceQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingControlPlaneResourceQuota controls the ResourceQuotas and LimitRanges in the control plane namespaces of shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled controls whether a ResourceQuota and a LimitRange are created in the control plane namespaces of shoots.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sizeClasses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SizeClasses are the size classes of shoot control planes. A shoot is assigned to the first size class whose maximum number of nodes is greater than or equal to the sum of the maximum numbers of nodes of its worker pools. If no size class matches, the last one is used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ControlPlaneSizeClass{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ControlPlaneSizeClass{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8837,6 +10824,35 @@ func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref common
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedSettingDrain(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingDrain controls the controlled evacuation of all shoots from the seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled controls whether the seed is drained. If true, no new shoots can be scheduled onto the seed, and the control planes of all shoots hosted on it are migrated to other seeds determined by the gardener-scheduler.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"maxConcurrentMigrations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrentMigrations is the maximum number of shoots that are migrated away from the seed at the same time. Defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8933,6 +10949,28 @@ func schema_pkg_apis_core_v1beta1_SeedSettingExcessCapacityReservationConfig(ref
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedSettingIstioTLSTermination(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingIstioTLSTermination controls whether the istio ingress gateways of the seed terminate TLS for the shoot kube-apiservers and originate new TLS connections with client certificates to them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled controls whether TLS termination and upstream TLS origination is enabled for all shoots on the seed. Individual shoots can still opt out via the `shoot.gardener.cloud/disable-istio-tls-termination` annotation.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedSettingLoadBalancerServices(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9094,6 +11132,13 @@ func schema_pkg_apis_core_v1beta1_SeedSettingScheduling(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile is the scheduling profile used for shoot control plane pods in the seed. When set to `bin-packing`, a dedicated kube-scheduler that bin-packs shoot control plane pods is deployed into the seed. Defaults to `balanced`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"visible"},
 			},
@@ -9248,11 +11293,29 @@ func schema_pkg_apis_core_v1beta1_SeedSettings(ref common.ReferenceCallback) com
 							Ref:         ref(v1beta1.SeedSettingZoneSelection{}.OpenAPIModelName()),
 						},
 					},
+					"istioTLSTermination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IstioTLSTermination controls whether the istio ingress gateways of the seed terminate TLS for the shoot kube-apiservers and originate new TLS connections with client certificates to them (instead of TCP passthrough). See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#istio-tls-termination.",
+							Ref:         ref(v1beta1.SeedSettingIstioTLSTermination{}.OpenAPIModelName()),
+						},
+					},
+					"drain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Drain controls the controlled evacuation of all shoots from the seed, e.g. before it is decommissioned.",
+							Ref:         ref(v1beta1.SeedSettingDrain{}.OpenAPIModelName()),
+						},
+					},
+					"controlPlaneResourceQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlaneResourceQuota controls the ResourceQuotas and LimitRanges in the control plane namespaces of shoots. See https://github.com/gardener/gardener/blob/master/docs/operations/seed_settings.md#control-plane-resource-quota.",
+							Ref:         ref(v1beta1.SeedSettingControlPlaneResourceQuota{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.SeedSettingDependencyWatchdog{}.OpenAPIModelName(), v1beta1.SeedSettingExcessCapacityReservation{}.OpenAPIModelName(), v1beta1.SeedSettingLoadBalancerServices{}.OpenAPIModelName(), v1beta1.SeedSettingScheduling{}.OpenAPIModelName(), v1beta1.SeedSettingTopologyAwareRouting{}.OpenAPIModelName(), v1beta1.SeedSettingVerticalPodAutoscaler{}.OpenAPIModelName(), v1beta1.SeedSettingZoneSelection{}.OpenAPIModelName()},
+			v1beta1.SeedSettingControlPlaneResourceQuota{}.OpenAPIModelName(), v1beta1.SeedSettingDependencyWatchdog{}.OpenAPIModelName(), v1beta1.SeedSettingDrain{}.OpenAPIModelName(), v1beta1.SeedSettingExcessCapacityReservation{}.OpenAPIModelName(), v1beta1.SeedSettingIstioTLSTermination{}.OpenAPIModelName(), v1beta1.SeedSettingLoadBalancerServices{}.OpenAPIModelName(), v1beta1.SeedSettingScheduling{}.OpenAPIModelName(), v1beta1.SeedSettingTopologyAwareRouting{}.OpenAPIModelName(), v1beta1.SeedSettingVerticalPodAutoscaler{}.OpenAPIModelName(), v1beta1.SeedSettingZoneSelection{}.OpenAPIModelName()},
 	}
 }
 
@@ -9467,11 +11530,17 @@ func schema_pkg_apis_core_v1beta1_SeedStatus(ref common.ReferenceCallback) commo
 							Ref:         ref(v1beta1.LastOperation{}.OpenAPIModelName()),
 						},
 					},
+					"drain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Drain contains information about the progress of draining the seed.",
+							Ref:         ref(v1beta1.SeedDrainStatus{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.SeedDrainStatus{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -9646,6 +11715,12 @@ func schema_pkg_apis_core_v1beta1_ServiceAccountConfig(ref common.ReferenceCallb
 							},
 						},
 					},
+					"projectedTokenExpiration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectedTokenExpiration is the validity duration of the projected service account tokens which are automatically mounted into the pods of system components running in the shoot cluster. Individual pods can overwrite it with the `projected-token-mount.resources.gardener.cloud/expiration-seconds` annotation. This field must be within [10m,30d]. Defaults to 12h.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
@@ -9803,75 +11878,637 @@ func schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref common.ReferenceCal
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ShootAvailabilityReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootCredentials contains information about the shoot credentials.",
+				Description: "ShootAvailabilityReport contains the availability of the Shoot's API server in a calendar month.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"rotation": {
+					"period": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rotation contains information about the credential rotations.",
-							Ref:         ref(v1beta1.ShootCredentialsRotation{}.OpenAPIModelName()),
+							Description: "Period is the calendar month (UTC) of the report in the format 'YYYY-MM'.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"encryptionAtRest": {
+					"downtime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EncryptionAtRest contains information about Shoot data encryption at rest.",
-							Ref:         ref(v1beta1.EncryptionAtRest{}.OpenAPIModelName()),
+							Description: "Downtime is the accounted downtime of the API server outside of the maintenance time window.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			v1beta1.EncryptionAtRest{}.OpenAPIModelName(), v1beta1.ShootCredentialsRotation{}.OpenAPIModelName()},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ShootCredentialsRotation contains information about the rotation of credentials.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"certificateAuthorities": {
+					"maintenanceDowntime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CertificateAuthorities contains information about the certificate authority credential rotation.",
-							Ref:         ref(v1beta1.CARotation{}.OpenAPIModelName()),
+							Description: "MaintenanceDowntime is the accounted downtime of the API server within the maintenance time window. It is not considered for the availability.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Availability is the availability of the API server in percent, e.g. '99.950'. For the current month, it refers to the part of the month until the downtime last changed, see LastUpdateTime.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"final": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Final indicates whether the month has ended, i.e., the report does not change anymore.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the report was last updated.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"period", "downtime", "maintenanceDowntime", "availability", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootCostEstimate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCostEstimate contains a provider-specific cost estimate for a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"currency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currency is the ISO 4217 code of the currency of the estimate.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"monthlyAmount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonthlyAmount is the estimated monthly cost as decimal number.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is an optional human-readable description of the estimate, e.g., which resources are included.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"currency", "monthlyAmount"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentials contains information about the shoot credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation contains information about the credential rotations.",
+							Ref:         ref(v1beta1.ShootCredentialsRotation{}.OpenAPIModelName()),
+						},
+					},
+					"encryptionAtRest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EncryptionAtRest contains information about Shoot data encryption at rest.",
+							Ref:         ref(v1beta1.EncryptionAtRest{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.EncryptionAtRest{}.OpenAPIModelName(), v1beta1.ShootCredentialsRotation{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentialsRotation contains information about the rotation of credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificateAuthorities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthorities contains information about the certificate authority credential rotation.",
+							Ref:         ref(v1beta1.CARotation{}.OpenAPIModelName()),
+						},
+					},
+					"sshKeypair": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHKeypair contains information about the ssh-keypair credential rotation.",
+							Ref:         ref(v1beta1.ShootSSHKeypairRotation{}.OpenAPIModelName()),
+						},
+					},
+					"observability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Observability contains information about the observability credential rotation.",
+							Ref:         ref(v1beta1.ObservabilityRotation{}.OpenAPIModelName()),
+						},
+					},
+					"serviceAccountKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountKey contains information about the service account key credential rotation.",
+							Ref:         ref(v1beta1.ServiceAccountKeyRotation{}.OpenAPIModelName()),
+						},
+					},
+					"etcdEncryptionKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCDEncryptionKey contains information about the ETCD encryption key credential rotation.",
+							Ref:         ref(v1beta1.ETCDEncryptionKeyRotation{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.CARotation{}.OpenAPIModelName(), v1beta1.ETCDEncryptionKeyRotation{}.OpenAPIModelName(), v1beta1.ObservabilityRotation{}.OpenAPIModelName(), v1beta1.ServiceAccountKeyRotation{}.OpenAPIModelName(), v1beta1.ShootSSHKeypairRotation{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootDebugPod(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootDebugPod contains information about a debug pod in the control plane namespace of the Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the debug pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestedBy is the name of the user who requested the debug pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the justification given by the user who requested the debug pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreationTimestamp is the time when the debug pod was requested.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the time after which the debug pod is deleted.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"name", "requestedBy", "reason", "creationTimestamp", "expirationTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootDebugPodRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootDebugPodRequest can be used by operators to request a time-boxed debug pod in the control plane namespace of a Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the ShootDebugPodRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.ShootDebugPodRequestSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the ShootDebugPodRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.ShootDebugPodRequestStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"spec", "status"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ShootDebugPodRequestSpec{}.OpenAPIModelName(), v1beta1.ShootDebugPodRequestStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootDebugPodRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootDebugPodRequestSpec contains the specification of the requested debug pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the justification for the debug pod. It is recorded in the Shoot status and on the debug pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationSeconds is the requested lifetime of the debug pod. The gardener-apiserver may cap the lifetime, so a client needs to check the 'expirationTimestamp' field in a response. Defaults to 1 hour.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"reason"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootDebugPodRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootDebugPodRequestStatus is the status of the ShootDebugPodRequest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodName is the name of the debug pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the control plane namespace of the Shoot in the seed cluster in which the debug pod is created.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the time after which the debug pod is deleted.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"podName", "namespace", "expirationTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootFootprintRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootFootprintRequest can be used to compute the estimated resource footprint of the control plane of a Shoot cluster, optionally together with a provider-specific cost estimate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the ShootFootprintRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.ShootFootprintRequestSpec{}.OpenAPIModelName()),
 						},
 					},
-					"sshKeypair": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSHKeypair contains information about the ssh-keypair credential rotation.",
-							Ref:         ref(v1beta1.ShootSSHKeypairRotation{}.OpenAPIModelName()),
+							Description: "Status is the status of the ShootFootprintRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.ShootFootprintRequestStatus{}.OpenAPIModelName()),
 						},
 					},
-					"observability": {
+				},
+				Required: []string{"spec", "status"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ShootFootprintRequestSpec{}.OpenAPIModelName(), v1beta1.ShootFootprintRequestStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootFootprintRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootFootprintRequestSpec contains the Shoot specification for which the footprint shall be computed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shoot": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Observability contains information about the observability credential rotation.",
-							Ref:         ref(v1beta1.ObservabilityRotation{}.OpenAPIModelName()),
+							Description: "Shoot is the specification of the Shoot for which the footprint shall be computed. This allows computing the footprint of a Shoot before it is created. If not set, the specification of the existing Shoot is used.",
+							Ref:         ref(v1beta1.ShootSpec{}.OpenAPIModelName()),
 						},
 					},
-					"serviceAccountKey": {
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ShootSpec{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootFootprintRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootFootprintRequestStatus is the status of the ShootFootprintRequest containing the computed footprint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"components": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountKey contains information about the service account key credential rotation.",
-							Ref:         ref(v1beta1.ServiceAccountKeyRotation{}.OpenAPIModelName()),
+							Description: "Components contains the estimated resource footprint of the individual control plane components.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ControlPlaneComponentFootprint{}.OpenAPIModelName()),
+									},
+								},
+							},
 						},
 					},
-					"etcdEncryptionKey": {
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ETCDEncryptionKey contains information about the ETCD encryption key credential rotation.",
-							Ref:         ref(v1beta1.ETCDEncryptionKeyRotation{}.OpenAPIModelName()),
+							Description: "Total is the sum of the resource requests of all control plane components.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"costEstimate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CostEstimate is an optional provider-specific cost estimate for the Shoot. It is not computed by the gardener-apiserver but can be filled by extensions via a mutating admission webhook for the shoots/footprint subresource.",
+							Ref:         ref(v1beta1.ShootCostEstimate{}.OpenAPIModelName()),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.CARotation{}.OpenAPIModelName(), v1beta1.ETCDEncryptionKeyRotation{}.OpenAPIModelName(), v1beta1.ObservabilityRotation{}.OpenAPIModelName(), v1beta1.ServiceAccountKeyRotation{}.OpenAPIModelName(), v1beta1.ShootSSHKeypairRotation{}.OpenAPIModelName()},
+			v1beta1.ControlPlaneComponentFootprint{}.OpenAPIModelName(), v1beta1.ShootCostEstimate{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootImpactOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootImpactOperation is an operation which would result from applying a proposed Shoot specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the operation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the name of the affected worker pool or control plane component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reasons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reasons are the changes of the specification which cause the operation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type", "target"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootImpactRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootImpactRequest can be used to predict the operations which would result from applying a proposed specification to an existing Shoot cluster, e.g., node rollouts or restarts of control plane components.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the ShootImpactRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.ShootImpactRequestSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the ShootImpactRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.ShootImpactRequestStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"spec", "status"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ShootImpactRequestSpec{}.OpenAPIModelName(), v1beta1.ShootImpactRequestStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootImpactRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootImpactRequestSpec contains the proposed Shoot specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shoot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shoot is the proposed specification of the Shoot. It is compared with the specification of the existing Shoot.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.ShootSpec{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"shoot"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ShootSpec{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootImpactRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootImpactRequestStatus is the status of the ShootImpactRequest containing the predicted operations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"operations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operations are the operations which would result from applying the proposed specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ShootImpactOperation{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ShootImpactOperation{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootInventory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootInventory contains an aggregated summary of the machines and nodes of the Shoot's worker pools.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workerPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPools contains the inventory of the worker pools.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.WorkerPoolInventory{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.WorkerPoolInventory{}.OpenAPIModelName()},
 	}
 }
 
@@ -10017,6 +12654,67 @@ func schema_pkg_apis_core_v1beta1_ShootNetworks(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootReconciliationTimestamps(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootReconciliationTimestamps contains the timestamps of the relevant phases of a Shoot reconciliation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the operation (Create, Reconcile, Restore).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flowStarted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowStarted is the time when the reconciliation flow was started.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"infrastructureReady": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InfrastructureReady is the time when the infrastructure was reported ready.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"controlPlaneReady": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlaneReady is the time when the control plane was reported ready.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"nodesReady": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodesReady is the time when the worker nodes were reported ready.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"finished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Finished is the time when the reconciliation flow finished, either successfully or with an error.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the final state of the reconciliation (Succeeded, Error).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "flowStarted"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10053,7 +12751,7 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 				Properties: map[string]spec.Schema{
 					"addons": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Addons contains information about enabled/disabled addons and their configuration.\n\nDeprecated: This field is deprecated. Enabling addons will be forbidden starting from Kubernetes 1.35.",
+							Description: "Addons contains information about enabled/disabled addons and their configuration.\n\nDeprecated: This field is deprecated. Enabling addons will be forbidden starting from Kubernetes 1.35. Use `ManagedAddons` instead.",
 							Ref:         ref(v1beta1.Addons{}.OpenAPIModelName()),
 						},
 					},
@@ -10244,12 +12942,32 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
+					"cleanup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cleanup contains the policies for cleaning up the resources in the shoot cluster when it is deleted.",
+							Ref:         ref(v1beta1.Cleanup{}.OpenAPIModelName()),
+						},
+					},
+					"managedAddons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedAddons contains the addons which are deployed into the shoot cluster. The addons are selected from the addon catalogs provided by extensions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ManagedAddon{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"kubernetes", "provider", "region"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.AccessRestrictionWithOptions{}.OpenAPIModelName(), v1beta1.Addons{}.OpenAPIModelName(), v1beta1.CloudProfileReference{}.OpenAPIModelName(), v1beta1.ControlPlane{}.OpenAPIModelName(), v1beta1.DNS{}.OpenAPIModelName(), v1beta1.Extension{}.OpenAPIModelName(), v1beta1.Hibernation{}.OpenAPIModelName(), v1beta1.Kubernetes{}.OpenAPIModelName(), v1beta1.Maintenance{}.OpenAPIModelName(), v1beta1.Monitoring{}.OpenAPIModelName(), v1beta1.NamedResourceReference{}.OpenAPIModelName(), v1beta1.Networking{}.OpenAPIModelName(), v1beta1.Provider{}.OpenAPIModelName(), v1beta1.SeedSelector{}.OpenAPIModelName(), v1beta1.SystemComponents{}.OpenAPIModelName(), v1beta1.Toleration{}.OpenAPIModelName()},
+			v1beta1.AccessRestrictionWithOptions{}.OpenAPIModelName(), v1beta1.Addons{}.OpenAPIModelName(), v1beta1.Cleanup{}.OpenAPIModelName(), v1beta1.CloudProfileReference{}.OpenAPIModelName(), v1beta1.ControlPlane{}.OpenAPIModelName(), v1beta1.DNS{}.OpenAPIModelName(), v1beta1.Extension{}.OpenAPIModelName(), v1beta1.Hibernation{}.OpenAPIModelName(), v1beta1.Kubernetes{}.OpenAPIModelName(), v1beta1.Maintenance{}.OpenAPIModelName(), v1beta1.ManagedAddon{}.OpenAPIModelName(), v1beta1.Monitoring{}.OpenAPIModelName(), v1beta1.NamedResourceReference{}.OpenAPIModelName(), v1beta1.Networking{}.OpenAPIModelName(), v1beta1.Provider{}.OpenAPIModelName(), v1beta1.SeedSelector{}.OpenAPIModelName(), v1beta1.SystemComponents{}.OpenAPIModelName(), v1beta1.Toleration{}.OpenAPIModelName()},
 	}
 }
 
@@ -10612,12 +13330,60 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							Ref:         ref(v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName()),
 						},
 					},
+					"inventory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inventory contains an aggregated summary of the machines and nodes of the Shoot's worker pools. It is maintained by gardenlet based on the machine objects in the seed cluster.",
+							Ref:         ref(v1beta1.ShootInventory{}.OpenAPIModelName()),
+						},
+					},
+					"reconciliationTimestamps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconciliationTimestamps contains the timestamps of the relevant phases of the last reconciliations of the Shoot. The list is ordered from the oldest to the newest reconciliation and is limited to the last few entries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ShootReconciliationTimestamps{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"availabilityReports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailabilityReports contains the monthly reports of the availability of the Shoot's API server. They are only maintained if the API server availability probing is enabled by the gardenlet. The list is ordered from the oldest to the newest month and is limited to the last few entries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ShootAvailabilityReport{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"debugPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DebugPods contains the debug pods which have been requested by operators via the `shoots/debugpod` subresource. The gardenlet creates the pods in the control plane namespace of the Shoot and removes them together with the entries after they have expired.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ShootDebugPod{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.InPlaceUpdatesStatus{}.OpenAPIModelName(), v1beta1.LastError{}.OpenAPIModelName(), v1beta1.LastMaintenance{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName(), v1beta1.NetworkingStatus{}.OpenAPIModelName(), v1beta1.ShootAdvertisedAddress{}.OpenAPIModelName(), v1beta1.ShootCredentials{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.InPlaceUpdatesStatus{}.OpenAPIModelName(), v1beta1.LastError{}.OpenAPIModelName(), v1beta1.LastMaintenance{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName(), v1beta1.NetworkingStatus{}.OpenAPIModelName(), v1beta1.ShootAdvertisedAddress{}.OpenAPIModelName(), v1beta1.ShootAvailabilityReport{}.OpenAPIModelName(), v1beta1.ShootCredentials{}.OpenAPIModelName(), v1beta1.ShootDebugPod{}.OpenAPIModelName(), v1beta1.ShootInventory{}.OpenAPIModelName(), v1beta1.ShootReconciliationTimestamps{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -10650,6 +13416,43 @@ func schema_pkg_apis_core_v1beta1_ShootTemplate(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootValidationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootValidationRule is a CEL validation rule which is evaluated when Shoot clusters using the CloudProfile are created or updated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the rule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is the CEL expression which must evaluate to true for the Shoot to be admitted. The variable `shoot` contains the Shoot in version core.gardener.cloud/v1beta1, the variable `oldShoot` contains the Shoot before the update (it is null when the Shoot is created).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the error message returned when the expression evaluates to false. Defaults to a message containing the name of the rule.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "expression"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10729,11 +13532,17 @@ func schema_pkg_apis_core_v1beta1_SystemComponents(ref common.ReferenceCallback)
 							Ref:         ref(v1beta1.NodeLocalDNS{}.OpenAPIModelName()),
 						},
 					},
+					"volumeSnapshots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSnapshots contains the settings of the managed volume snapshots of user workloads in the Shoot cluster.",
+							Ref:         ref(v1beta1.VolumeSnapshots{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.CoreDNS{}.OpenAPIModelName(), v1beta1.NodeLocalDNS{}.OpenAPIModelName()},
+			v1beta1.CoreDNS{}.OpenAPIModelName(), v1beta1.NodeLocalDNS{}.OpenAPIModelName(), v1beta1.VolumeSnapshots{}.OpenAPIModelName()},
 	}
 }
 
@@ -10759,10 +13568,85 @@ func schema_pkg_apis_core_v1beta1_Toleration(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the timestamp after which the toleration is no longer considered, i.e., the seed taints it tolerates are effective again for scheduling. This is only supported for shoot tolerations.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"key"},
 			},
 		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VersionClassificationTransitions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionClassificationTransitions contains the durations after which versions transition to their next classification. The durations are measured from the time the version was observed in its current classification. Versions using lifecycle stages are not affected.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"supportAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SupportAfter is the duration after which preview versions are classified as supported.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"deprecateAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeprecateAfter is the duration after which supported versions are classified as deprecated. The latest supported version is never deprecated.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"expireAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpireAfter is the duration after which deprecated versions expire. When a version is deprecated, its expiration date is set accordingly unless it is already set. The latest version never expires.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VersionLifecyclePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionLifecyclePolicy declares automatic transitions of the classifications of versions in a CloudProfile.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes contains the classification transitions for the Kubernetes versions.",
+							Ref:         ref(v1beta1.VersionClassificationTransitions{}.OpenAPIModelName()),
+						},
+					},
+					"machineImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImages contains the classification transitions for the versions of all machine images.",
+							Ref:         ref(v1beta1.VersionClassificationTransitions{}.OpenAPIModelName()),
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun indicates that due transitions are only reported via events instead of being applied to the CloudProfile.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.VersionClassificationTransitions{}.OpenAPIModelName()},
 	}
 }
 
@@ -10871,115 +13755,278 @@ func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCall
 					},
 					"cpuHistogramDecayHalfLife": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CPUHistogramDecayHalfLife is the amount of time it takes a historical CPU usage sample to lose half of its weight. (default: 24h)",
-							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+							Description: "CPUHistogramDecayHalfLife is the amount of time it takes a historical CPU usage sample to lose half of its weight. (default: 24h)",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"memoryHistogramDecayHalfLife": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryHistogramDecayHalfLife is the amount of time it takes a historical memory usage sample to lose half of its weight. (default: 24h)",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"memoryAggregationInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryAggregationInterval is the length of a single interval, for which the peak memory usage is computed. (default: 24h)",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"memoryAggregationIntervalCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryAggregationIntervalCount is the number of consecutive memory-aggregation-intervals which make up the MemoryAggregationWindowLength which in turn is the period for memory usage aggregation by VPA. In other words, `MemoryAggregationWindowLength = memory-aggregation-interval * memory-aggregation-interval-count`. (default: 8)",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"featureGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: false,
+										Type:    []string{"boolean"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"maxAllowed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAllowed specifies the global maximum allowed (maximum amount of resources) that vpa-recommender can recommend for a container. The VerticalPodAutoscaler-level maximum allowed takes precedence over the global maximum allowed. For more information, see https://github.com/kubernetes/autoscaler/blob/master/vertical-pod-autoscaler/docs/examples.md#specifying-global-maximum-allowed-resources-to-prevent-pods-from-being-unschedulable.\n\nDefaults to nil (no maximum).",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"recommenderUpdateWorkerCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecommenderUpdateWorkerCount is the number of workers used in the vpa-recommender for updating VPAs and VPACheckpoints in parallel. (default: 10)",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+		Dependencies: []string{
+			resource.Quantity{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Volume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Volume contains information about the volume type, size, and encryption.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the volume to make it referenceable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSize is the size of the volume.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"encrypted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encrypted determines if the volume should be encrypted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"size"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VolumeSnapshotClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotClass contains the settings of a VolumeSnapshotClass in the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the VolumeSnapshotClass.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"memoryHistogramDecayHalfLife": {
+					"driver": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MemoryHistogramDecayHalfLife is the amount of time it takes a historical memory usage sample to lose half of its weight. (default: 24h)",
-							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+							Description: "Driver is the name of the CSI driver which handles the snapshots of this class.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"memoryAggregationInterval": {
+					"deletionPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MemoryAggregationInterval is the length of a single interval, for which the peak memory usage is computed. (default: 24h)",
-							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+							Description: "DeletionPolicy determines whether the snapshot in the storage backend is deleted together with the VolumeSnapshot. Possible values are 'Delete' and 'Retain'. Defaults to 'Delete'.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"memoryAggregationIntervalCount": {
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MemoryAggregationIntervalCount is the number of consecutive memory-aggregation-intervals which make up the MemoryAggregationWindowLength which in turn is the period for memory usage aggregation by VPA. In other words, `MemoryAggregationWindowLength = memory-aggregation-interval * memory-aggregation-interval-count`. (default: 8)",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Default specifies whether this class is the default VolumeSnapshotClass of the CSI driver.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"featureGates": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
+							Description: "Parameters are driver-specific parameters of the VolumeSnapshotClass.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: false,
-										Type:    []string{"boolean"},
+										Default: "",
+										Type:    []string{"string"},
 										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"maxAllowed": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxAllowed specifies the global maximum allowed (maximum amount of resources) that vpa-recommender can recommend for a container. The VerticalPodAutoscaler-level maximum allowed takes precedence over the global maximum allowed. For more information, see https://github.com/kubernetes/autoscaler/blob/master/vertical-pod-autoscaler/docs/examples.md#specifying-global-maximum-allowed-resources-to-prevent-pods-from-being-unschedulable.\n\nDefaults to nil (no maximum).",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
-									},
-								},
-							},
-						},
-					},
-					"recommenderUpdateWorkerCount": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RecommenderUpdateWorkerCount is the number of workers used in the vpa-recommender for updating VPAs and VPACheckpoints in parallel. (default: 10)",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"name", "driver"},
 			},
 		},
-		Dependencies: []string{
-			resource.Quantity{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Volume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_VolumeSnapshotPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Volume contains information about the volume type, size, and encryption.",
+				Description: "VolumeSnapshotPolicy contains the settings for taking scheduled snapshots of PersistentVolumeClaims.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the volume to make it referenceable.",
+							Description: "Name is the name of the policy.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the volume.",
+							Description: "Schedule is the cron schedule (in UTC) at which the snapshots are taken.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"size": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the size of the volume.",
+							Description: "Namespace is the namespace of the PersistentVolumeClaims.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"encrypted": {
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Encrypted determines if the volume should be encrypted.",
-							Type:        []string{"boolean"},
+							Description: "Selector is a label selector for the PersistentVolumeClaims. If not specified, all PersistentVolumeClaims in the namespace are selected.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"volumeSnapshotClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSnapshotClassName is the name of the VolumeSnapshotClass used for the snapshots. If not specified, the default class of the CSI driver is used.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention is the number of snapshots which are kept per PersistentVolumeClaim. Older snapshots are deleted. Defaults to 7.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "schedule", "namespace"},
+			},
+		},
+		Dependencies: []string{
+			metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VolumeSnapshots(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshots contains the settings of the managed volume snapshots of user workloads in the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"classes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Classes is a list of VolumeSnapshotClasses which shall be created in the Shoot cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.VolumeSnapshotClass{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"policies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policies is a list of policies for taking scheduled snapshots of PersistentVolumeClaims in the Shoot cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.VolumeSnapshotPolicy{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"size"},
 			},
 		},
+		Dependencies: []string{
+			v1beta1.VolumeSnapshotClass{}.OpenAPIModelName(), v1beta1.VolumeSnapshotPolicy{}.OpenAPIModelName()},
 	}
 }
 
@@ -11275,116 +14322,299 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							Format:      "",
 						},
 					},
-					"controlPlane": {
+					"controlPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlane specifies that the shoot cluster control plane components should be running in this worker pool. This is only relevant for self-hosted shoot clusters.",
+							Ref:         ref(v1beta1.WorkerControlPlane{}.OpenAPIModelName()),
+						},
+					},
+					"accelerators": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Accelerators contains the configuration for hardware accelerators (e.g., GPUs) attached to the machines of this worker pool.",
+							Ref:         ref(v1beta1.WorkerAccelerators{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"name", "machine", "maximum", "minimum"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.CRI{}.OpenAPIModelName(), v1beta1.ClusterAutoscalerOptions{}.OpenAPIModelName(), v1beta1.DataVolume{}.OpenAPIModelName(), v1beta1.Machine{}.OpenAPIModelName(), v1beta1.MachineControllerManagerSettings{}.OpenAPIModelName(), v1beta1.Volume{}.OpenAPIModelName(), v1beta1.WorkerAccelerators{}.OpenAPIModelName(), v1beta1.WorkerControlPlane{}.OpenAPIModelName(), v1beta1.WorkerKubernetes{}.OpenAPIModelName(), v1beta1.WorkerSystemComponents{}.OpenAPIModelName(), corev1.Taint{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName(), intstr.IntOrString{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerAccelerators(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerAccelerators contains the configuration for hardware accelerators attached to the machines of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vendor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vendor is the vendor of the accelerators. Supported values are `nvidia` and `amd`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"managedDriver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedDriver specifies whether Gardener deploys and manages the driver and the device plugin for the accelerators on the nodes of this worker pool. The versions are selected based on the machine image of the worker pool. Defaults to `true`.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"vendor"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerControlPlane(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup holds the object store configuration for the backups of shoot (currently only etcd). If it is not specified, then there won't be any backups taken.",
+							Ref:         ref(v1beta1.Backup{}.OpenAPIModelName()),
+						},
+					},
+					"exposure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exposure holds the exposure configuration for the shoot (either `extension` or `dns` or omitted/empty).",
+							Ref:         ref(v1beta1.Exposure{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.Backup{}.OpenAPIModelName(), v1beta1.Exposure{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration settings for all kubelets of this worker pool. If set, all `spec.kubernetes.kubelet` settings will be overwritten for this worker pool (no merge of settings).",
+							Ref:         ref(v1beta1.KubeletConfig{}.OpenAPIModelName()),
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the semantic Kubernetes version to use for the Kubelet in this Worker Group. If not specified the kubelet version is derived from the global shoot cluster kubernetes version. version must be equal or lower than the version of the shoot kubernetes version. Only one minor version difference to other worker groups and global kubernetes version is allowed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.KubeletConfig{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerPoolInventory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPoolInventory contains an aggregated summary of the machines and nodes of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Machines is the number of machines of the worker pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the number of machines of the worker pool which have joined the cluster as nodes.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"machinePhases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachinePhases contains the number of machines per machine phase.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.MachinePhaseCount{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones contains the number of machines and nodes per zone.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ZoneInventory{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"outdatedNodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ControlPlane specifies that the shoot cluster control plane components should be running in this worker pool. This is only relevant for self-hosted shoot clusters.",
-							Ref:         ref(v1beta1.WorkerControlPlane{}.OpenAPIModelName()),
+							Description: "OutdatedNodes is the number of nodes which have not yet applied the latest operating system config. It is not set if the nodes of the Shoot could not be inspected.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"name", "machine", "maximum", "minimum"},
+				Required: []string{"name", "machines", "nodes"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.CRI{}.OpenAPIModelName(), v1beta1.ClusterAutoscalerOptions{}.OpenAPIModelName(), v1beta1.DataVolume{}.OpenAPIModelName(), v1beta1.Machine{}.OpenAPIModelName(), v1beta1.MachineControllerManagerSettings{}.OpenAPIModelName(), v1beta1.Volume{}.OpenAPIModelName(), v1beta1.WorkerControlPlane{}.OpenAPIModelName(), v1beta1.WorkerKubernetes{}.OpenAPIModelName(), v1beta1.WorkerSystemComponents{}.OpenAPIModelName(), corev1.Taint{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName(), intstr.IntOrString{}.OpenAPIModelName()},
+			v1beta1.MachinePhaseCount{}.OpenAPIModelName(), v1beta1.ZoneInventory{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_WorkerControlPlane(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkerControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.",
+				Description: "WorkerSystemComponents contains configuration for system components related to this worker pool",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"backup": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Backup holds the object store configuration for the backups of shoot (currently only etcd). If it is not specified, then there won't be any backups taken.",
-							Ref:         ref(v1beta1.Backup{}.OpenAPIModelName()),
-						},
-					},
-					"exposure": {
+					"allow": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exposure holds the exposure configuration for the shoot (either `extension` or `dns` or omitted/empty).",
-							Ref:         ref(v1beta1.Exposure{}.OpenAPIModelName()),
+							Description: "Allow determines whether the pool should be allowed to host system components or not (defaults to true)",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"allow"},
 			},
 		},
-		Dependencies: []string{
-			v1beta1.Backup{}.OpenAPIModelName(), v1beta1.Exposure{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_WorkersSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.",
+				Description: "WorkersSettings contains settings for all workers.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubelet": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for all kubelets of this worker pool. If set, all `spec.kubernetes.kubelet` settings will be overwritten for this worker pool (no merge of settings).",
-							Ref:         ref(v1beta1.KubeletConfig{}.OpenAPIModelName()),
-						},
-					},
-					"version": {
+					"sshAccess": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the semantic Kubernetes version to use for the Kubelet in this Worker Group. If not specified the kubelet version is derived from the global shoot cluster kubernetes version. version must be equal or lower than the version of the shoot kubernetes version. Only one minor version difference to other worker groups and global kubernetes version is allowed.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SSHAccess contains settings regarding ssh access to the worker nodes.",
+							Ref:         ref(v1beta1.SSHAccess{}.OpenAPIModelName()),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.KubeletConfig{}.OpenAPIModelName()},
+			v1beta1.SSHAccess{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_WorkloadDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkerSystemComponents contains configuration for system components related to this worker pool",
+				Description: "WorkloadDefaults contains defaults which are injected into the pods of user workloads in the shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"allow": {
+					"topologySpreadConstraints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allow determines whether the pool should be allowed to host system components or not (defaults to true)",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "TopologySpreadConstraints are added to pods which do not specify any topology spread constraints. If the label selector of a constraint is not set, the labels of the respective pod are used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(corev1.TopologySpreadConstraint{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Affinity contains the node affinity, pod affinity and pod anti-affinity which are added to pods which do not specify the respective affinity. If the label selector of a pod (anti-)affinity term is not set, the labels of the respective pod are used.",
+							Ref:         ref(corev1.Affinity{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"allow"},
 			},
 		},
+		Dependencies: []string{
+			corev1.Affinity{}.OpenAPIModelName(), corev1.TopologySpreadConstraint{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_WorkersSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ZoneInventory(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkersSettings contains settings for all workers.",
+				Description: "ZoneInventory contains the number of machines and nodes of a worker pool in a zone.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sshAccess": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSHAccess contains settings regarding ssh access to the worker nodes.",
-							Ref:         ref(v1beta1.SSHAccess{}.OpenAPIModelName()),
+							Description: "Name is the name of the zone.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Machines is the number of machines in this zone.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the number of machines in this zone which have joined the cluster as nodes.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"name", "machines", "nodes"},
 			},
 		},
-		Dependencies: []string{
-			v1beta1.SSHAccess{}.OpenAPIModelName()},
 	}
 }
 
@@ -11513,6 +14743,28 @@ func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_operations_v1alpha1_BastionSessionRecording(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionSessionRecording contains the configuration for recording SSH sessions on the bastion host.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled specifies whether SSH sessions are recorded and uploaded to the backup bucket of the shoot.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11563,12 +14815,24 @@ func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"idleTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleTimeout is the duration after which SSH connections to the bastion host without any activity are closed. If not set, the default configured by the Gardener operator is used. This field is immutable.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"sessionRecording": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionRecording configures the recording of SSH sessions on the bastion host. If not set, the default configured by the Gardener operator is used. This field is immutable.",
+							Ref:         ref(operationsv1alpha1.BastionSessionRecording{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"shootRef", "sshPublicKey", "ingress"},
 			},
 		},
 		Dependencies: []string{
-			operationsv1alpha1.BastionIngressPolicy{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName()},
+			operationsv1alpha1.BastionIngressPolicy{}.OpenAPIModelName(), operationsv1alpha1.BastionSessionRecording{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -12207,11 +15471,17 @@ func schema_pkg_apis_seedmanagement_v1alpha1_GardenletConfig(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"seedTemplateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedTemplateRef references a SeedTemplate whose seed template (patched with the given overrides) is rendered into the seedConfig of the GardenletConfiguration.",
+							Ref:         ref(seedmanagementv1alpha1.SeedTemplateReference{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			seedmanagementv1alpha1.GardenletDeployment{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
+			seedmanagementv1alpha1.GardenletDeployment{}.OpenAPIModelName(), seedmanagementv1alpha1.SeedTemplateReference{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
@@ -13219,9 +16489,155 @@ func schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref common.Re
 							Format:      "int32",
 						},
 					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is the maximum number of replicas that can be created above the desired number of replicas while replicas marked for replacement are replaced. If greater than 0, a replacement replica is provisioned and ready before the replica it replaces is drained and deleted. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"zoneAwareOrdering": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneAwareOrdering indicates whether replicas marked for replacement are replaced zone by zone, so that only a single zone is affected at a time. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"rescheduleShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RescheduleShoots indicates whether shoots scheduled on a replica marked for replacement are automatically rescheduled to another ready replica before the replica is deleted. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_SeedTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedTemplate holds a seed template which can be referenced by ManagedSeeds (and ManagedSeedSets) in order to inherit a common seed specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the seed template (metadata and specification) inherited by the referencing ManagedSeeds.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.SeedTemplate{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"template"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.SeedTemplate{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_SeedTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedTemplateList is a list of SeedTemplate objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of SeedTemplates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(seedmanagementv1alpha1.SeedTemplate{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			seedmanagementv1alpha1.SeedTemplate{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_SeedTemplateReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedTemplateReference references a SeedTemplate and optionally overrides parts of its seed template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the referenced SeedTemplate.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"overrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overrides is a JSON merge patch (RFC 7386) which is applied to the seed template of the referenced SeedTemplate.",
+							Ref:         ref(runtime.RawExtension{}.OpenAPIModelName()),
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
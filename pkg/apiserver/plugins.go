@@ -31,6 +31,7 @@ import (
 	shootnodelocaldns "github.com/gardener/gardener/plugin/pkg/shoot/nodelocaldns"
 	"github.com/gardener/gardener/plugin/pkg/shoot/oidc/clusteropenidconnectpreset"
 	"github.com/gardener/gardener/plugin/pkg/shoot/oidc/openidconnectpreset"
+	shootpurposeprofile "github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 	shootresourcereservation "github.com/gardener/gardener/plugin/pkg/shoot/resourcereservation"
 	shoottolerationrestriction "github.com/gardener/gardener/plugin/pkg/shoot/tolerationrestriction"
@@ -53,6 +54,7 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	shootmanagedseed.Register(plugins)
 	shootnodelocaldns.Register(plugins)
 	shootdnsrewriting.Register(plugins)
+	shootpurposeprofile.Register(plugins)
 	shootmutator.Register(plugins)
 	shootvalidator.Register(plugins)
 	seedvalidator.Register(plugins)
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+var rateLimitedRequestsTotal = metrics.NewCounterVec(
+	&metrics.CounterOpts{
+		Namespace:      "gardener_apiserver",
+		Name:           "project_rate_limited_requests_total",
+		Help:           "Number of requests which were rejected because they exceeded the rate limit of the project.",
+		StabilityLevel: metrics.ALPHA,
+	},
+	[]string{"resource", "namespace"},
+)
+
+func init() {
+	legacyregistry.MustRegister(rateLimitedRequestsTotal)
+}
+
+// Config contains the configuration of a ProjectRateLimiter.
+type Config struct {
+	// QPS is the number of requests per second which are allowed per project. Requests are not limited if it is not
+	// positive.
+	QPS float32
+	// Burst is the maximum number of requests which are allowed at once per project.
+	Burst int
+}
+
+// ProjectRateLimiter limits the rate of requests for a resource per project namespace with a token bucket. A nil
+// ProjectRateLimiter does not limit requests.
+type ProjectRateLimiter struct {
+	resource string
+	qps      float32
+	burst    int
+	clock    flowcontrol.Clock
+
+	lock     sync.Mutex
+	limiters map[string]flowcontrol.RateLimiter
+}
+
+// NewProjectRateLimiter returns a new ProjectRateLimiter for the given resource which allows `qps` requests per second
+// with bursts of at most `burst` requests per project namespace. It returns nil, i.e., requests are not limited, if
+// `qps` is not positive.
+func NewProjectRateLimiter(resource string, qps float32, burst int, clock flowcontrol.Clock) *ProjectRateLimiter {
+	if qps <= 0 {
+		return nil
+	}
+
+	return &ProjectRateLimiter{
+		resource: resource,
+		qps:      qps,
+		burst:    burst,
+		clock:    clock,
+		limiters: make(map[string]flowcontrol.RateLimiter),
+	}
+}
+
+// Allow returns a `429 Too Many Requests` error if the request in the given namespace exceeds the rate limit.
+func (p *ProjectRateLimiter) Allow(namespace string) error {
+	if p == nil {
+		return nil
+	}
+
+	if p.limiterFor(namespace).TryAccept() {
+		return nil
+	}
+
+	rateLimitedRequestsTotal.WithLabelValues(p.resource, namespace).Inc()
+	return apierrors.NewTooManyRequests(
+		fmt.Sprintf("rate limit for %s in namespace %q exceeded, please try again later", p.resource, namespace),
+		int(math.Ceil(1/float64(p.qps))),
+	)
+}
+
+func (p *ProjectRateLimiter) limiterFor(namespace string) flowcontrol.RateLimiter {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	limiter, ok := p.limiters[namespace]
+	if !ok {
+		limiter = flowcontrol.NewTokenBucketRateLimiterWithClock(p.qps, p.burst, p.clock)
+		p.limiters[namespace] = limiter
+	}
+
+	return limiter
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/apiserver/ratelimit"
+)
+
+var _ = Describe("ProjectRateLimiter", func() {
+	var fakeClock *testclock.FakeClock
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+	})
+
+	It("should not limit requests if no rate limit is configured", func() {
+		rateLimiter := NewProjectRateLimiter("shoots", 0, 1, fakeClock)
+		Expect(rateLimiter).To(BeNil())
+
+		for range 10 {
+			Expect(rateLimiter.Allow("garden-foo")).To(Succeed())
+		}
+	})
+
+	It("should limit requests per namespace", func() {
+		rateLimiter := NewProjectRateLimiter("shoots", 0.5, 2, fakeClock)
+
+		Expect(rateLimiter.Allow("garden-foo")).To(Succeed())
+		Expect(rateLimiter.Allow("garden-foo")).To(Succeed())
+
+		err := rateLimiter.Allow("garden-foo")
+		Expect(apierrors.IsTooManyRequests(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring(`rate limit for shoots in namespace "garden-foo" exceeded`)))
+		retryAfter, ok := apierrors.SuggestsClientDelay(err)
+		Expect(ok).To(BeTrue())
+		Expect(retryAfter).To(Equal(2))
+
+		By("allowing requests in other namespaces")
+		Expect(rateLimiter.Allow("garden-bar")).To(Succeed())
+
+		By("refilling the bucket over time")
+		fakeClock.Step(2 * time.Second)
+		Expect(rateLimiter.Allow("garden-foo")).To(Succeed())
+		Expect(apierrors.IsTooManyRequests(rateLimiter.Allow("garden-foo"))).To(BeTrue())
+	})
+})
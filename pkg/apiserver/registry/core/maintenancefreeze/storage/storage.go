@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apiserver/registry/core/maintenancefreeze"
+)
+
+// REST implements a RESTStorage for MaintenanceFreeze.
+type REST struct {
+	*genericregistry.Store
+}
+
+// MaintenanceFreezeStorage implements the storage for MaintenanceFreezes.
+type MaintenanceFreezeStorage struct {
+	MaintenanceFreeze *REST
+	Status            *StatusREST
+}
+
+// NewStorage creates a new MaintenanceFreezeStorage object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) MaintenanceFreezeStorage {
+	maintenanceFreezeRest, maintenanceFreezeStatusRest := NewREST(optsGetter)
+
+	return MaintenanceFreezeStorage{
+		MaintenanceFreeze: maintenanceFreezeRest,
+		Status:            maintenanceFreezeStatusRest,
+	}
+}
+
+// NewREST returns a RESTStorage object that will work with MaintenanceFreeze objects.
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &core.MaintenanceFreeze{} },
+		NewListFunc:               func() runtime.Object { return &core.MaintenanceFreezeList{} },
+		DefaultQualifiedResource:  core.Resource("maintenancefreezes"),
+		SingularQualifiedResource: core.Resource("maintenancefreeze"),
+		EnableGarbageCollection:   true,
+
+		CreateStrategy: maintenancefreeze.Strategy,
+		UpdateStrategy: maintenancefreeze.Strategy,
+		DeleteStrategy: maintenancefreeze.Strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = maintenancefreeze.StatusStrategy
+	return &REST{store}, &StatusREST{store: &statusStore}
+}
+
+// Implement ShortNamesProvider
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"mfreeze"}
+}
+
+// StatusREST implements the REST endpoint for changing the status of a MaintenanceFreeze.
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &StatusREST{}
+	_ rest.Getter  = &StatusREST{}
+	_ rest.Updater = &StatusREST{}
+)
+
+// New creates a new (empty) internal MaintenanceFreeze object.
+func (r *StatusREST) New() runtime.Object {
+	return &core.MaintenanceFreeze{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *StatusREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancefreeze
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/api/core/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+type maintenanceFreezeStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for MaintenanceFreezes.
+var Strategy = maintenanceFreezeStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (maintenanceFreezeStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (maintenanceFreezeStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	maintenanceFreeze := obj.(*core.MaintenanceFreeze)
+
+	maintenanceFreeze.Generation = 1
+	maintenanceFreeze.Status = core.MaintenanceFreezeStatus{}
+}
+
+func (maintenanceFreezeStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newMaintenanceFreeze := obj.(*core.MaintenanceFreeze)
+	oldMaintenanceFreeze := old.(*core.MaintenanceFreeze)
+	newMaintenanceFreeze.Status = oldMaintenanceFreeze.Status
+
+	if !apiequality.Semantic.DeepEqual(oldMaintenanceFreeze.Spec, newMaintenanceFreeze.Spec) {
+		newMaintenanceFreeze.Generation = oldMaintenanceFreeze.Generation + 1
+	}
+}
+
+func (maintenanceFreezeStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	maintenanceFreeze := obj.(*core.MaintenanceFreeze)
+	return validation.ValidateMaintenanceFreeze(maintenanceFreeze)
+}
+
+func (maintenanceFreezeStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (maintenanceFreezeStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (maintenanceFreezeStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	newMaintenanceFreeze := newObj.(*core.MaintenanceFreeze)
+	oldMaintenanceFreeze := oldObj.(*core.MaintenanceFreeze)
+	return validation.ValidateMaintenanceFreezeUpdate(newMaintenanceFreeze, oldMaintenanceFreeze)
+}
+
+func (maintenanceFreezeStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (maintenanceFreezeStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (maintenanceFreezeStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type maintenanceFreezeStatusStrategy struct {
+	maintenanceFreezeStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of MaintenanceFreezes.
+var StatusStrategy = maintenanceFreezeStatusStrategy{Strategy}
+
+func (maintenanceFreezeStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newMaintenanceFreeze := obj.(*core.MaintenanceFreeze)
+	oldMaintenanceFreeze := old.(*core.MaintenanceFreeze)
+	newMaintenanceFreeze.Spec = oldMaintenanceFreeze.Spec
+}
+
+func (maintenanceFreezeStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateMaintenanceFreezeStatusUpdate(obj.(*core.MaintenanceFreeze), old.(*core.MaintenanceFreeze))
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancefreeze_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apiserver/registry/core/maintenancefreeze"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx               = context.Background()
+		maintenanceFreeze *core.MaintenanceFreeze
+	)
+
+	BeforeEach(func() {
+		maintenanceFreeze = &core.MaintenanceFreeze{
+			ObjectMeta: metav1.ObjectMeta{Name: "year-end", Generation: 1},
+			Spec: core.MaintenanceFreezeSpec{
+				Windows: []core.MaintenanceFreezeWindow{{
+					Begin: metav1.NewTime(time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC)),
+					End:   metav1.NewTime(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)),
+				}},
+			},
+			Status: core.MaintenanceFreezeStatus{
+				DeferredShoots: []core.DeferredShootMaintenance{{Namespace: "garden-foo", Name: "bar"}},
+			},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should reset the status and set the generation", func() {
+			maintenanceFreeze.Generation = 0
+
+			maintenancefreeze.Strategy.PrepareForCreate(ctx, maintenanceFreeze)
+
+			Expect(maintenanceFreeze.Generation).To(Equal(int64(1)))
+			Expect(maintenanceFreeze.Status).To(Equal(core.MaintenanceFreezeStatus{}))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		It("should keep the status and not increase the generation if the spec is unchanged", func() {
+			newMaintenanceFreeze := maintenanceFreeze.DeepCopy()
+			newMaintenanceFreeze.Status = core.MaintenanceFreezeStatus{}
+			newMaintenanceFreeze.Labels = map[string]string{"foo": "bar"}
+
+			maintenancefreeze.Strategy.PrepareForUpdate(ctx, newMaintenanceFreeze, maintenanceFreeze)
+
+			Expect(newMaintenanceFreeze.Generation).To(Equal(int64(1)))
+			Expect(newMaintenanceFreeze.Status).To(Equal(maintenanceFreeze.Status))
+		})
+
+		It("should increase the generation if the spec is changed", func() {
+			newMaintenanceFreeze := maintenanceFreeze.DeepCopy()
+			newMaintenanceFreeze.Spec.SeedSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}
+
+			maintenancefreeze.Strategy.PrepareForUpdate(ctx, newMaintenanceFreeze, maintenanceFreeze)
+
+			Expect(newMaintenanceFreeze.Generation).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("#StatusStrategy.PrepareForUpdate", func() {
+		It("should keep the spec", func() {
+			newMaintenanceFreeze := maintenanceFreeze.DeepCopy()
+			newMaintenanceFreeze.Spec.Windows = nil
+			newMaintenanceFreeze.Status.DeferredShoots = nil
+
+			maintenancefreeze.StatusStrategy.PrepareForUpdate(ctx, newMaintenanceFreeze, maintenanceFreeze)
+
+			Expect(newMaintenanceFreeze.Spec).To(Equal(maintenanceFreeze.Spec))
+			Expect(newMaintenanceFreeze.Status.DeferredShoots).To(BeEmpty())
+		})
+	})
+})
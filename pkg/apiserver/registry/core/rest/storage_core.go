@@ -13,6 +13,7 @@ import (
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	kubeinformers "k8s.io/client-go/informers"
 	clientauthorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -26,6 +27,7 @@ import (
 	controllerregistrationstore "github.com/gardener/gardener/pkg/apiserver/registry/core/controllerregistration/storage"
 	exposureclassstore "github.com/gardener/gardener/pkg/apiserver/registry/core/exposureclass/storage"
 	internalsecretstore "github.com/gardener/gardener/pkg/apiserver/registry/core/internalsecret/storage"
+	maintenancefreezestore "github.com/gardener/gardener/pkg/apiserver/registry/core/maintenancefreeze/storage"
 	namespacedcloudprofilestore "github.com/gardener/gardener/pkg/apiserver/registry/core/namespacedcloudprofile/storage"
 	projectstore "github.com/gardener/gardener/pkg/apiserver/registry/core/project/storage"
 	quotastore "github.com/gardener/gardener/pkg/apiserver/registry/core/quota/storage"
@@ -40,10 +42,14 @@ import (
 type StorageProvider struct {
 	AdminKubeconfigMaxExpiration  time.Duration
 	ViewerKubeconfigMaxExpiration time.Duration
+	SSHCertificateMaxExpiration   time.Duration
+	DebugPodMaxExpiration         time.Duration
 	CredentialsRotationInterval   time.Duration
 	KubeInformerFactory           kubeinformers.SharedInformerFactory
 	CoreInformerFactory           gardencoreinformers.SharedInformerFactory
 	SubjectAccessReviewer         clientauthorizationv1.SubjectAccessReviewInterface
+	EventRecorder                 record.EventRecorder
+	ShootProjectRateLimiters      shootstore.ProjectRateLimiters
 }
 
 // NewRESTStorage creates a new API group info object and registers the v1beta1 core storage.
@@ -102,6 +108,10 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 
 	storage["internalsecrets"] = internalsecretstore.NewREST(restOptionsGetter)
 
+	maintenanceFreezeStorage := maintenancefreezestore.NewStorage(restOptionsGetter)
+	storage["maintenancefreezes"] = maintenanceFreezeStorage.MaintenanceFreeze
+	storage["maintenancefreezes/status"] = maintenanceFreezeStorage.Status
+
 	projectStorage := projectstore.NewStorage(restOptionsGetter)
 	storage["projects"] = projectStorage.Project
 	storage["projects/status"] = projectStorage.Status
@@ -126,14 +136,22 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 		p.KubeInformerFactory.Core().V1().ConfigMaps().Lister(),
 		p.AdminKubeconfigMaxExpiration,
 		p.ViewerKubeconfigMaxExpiration,
+		p.SSHCertificateMaxExpiration,
+		p.DebugPodMaxExpiration,
 		p.CredentialsRotationInterval,
 		p.SubjectAccessReviewer,
+		p.EventRecorder,
+		p.ShootProjectRateLimiters,
 	)
 	storage["shoots"] = shootStorage.Shoot
 	storage["shoots/status"] = shootStorage.Status
 	storage["shoots/binding"] = shootStorage.Binding
 	storage["shoots/adminkubeconfig"] = shootStorage.AdminKubeconfig
 	storage["shoots/viewerkubeconfig"] = shootStorage.ViewerKubeconfig
+	storage["shoots/ssh"] = shootStorage.SSHCertificate
+	storage["shoots/footprint"] = shootStorage.Footprint
+	storage["shoots/impact"] = shootStorage.Impact
+	storage["shoots/debugpod"] = shootStorage.DebugPod
 
 	return storage
 }
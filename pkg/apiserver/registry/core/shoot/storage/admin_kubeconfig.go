@@ -18,6 +18,7 @@ import (
 
 	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apiserver/ratelimit"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
 )
 
@@ -29,6 +30,7 @@ func NewAdminKubeconfigREST(
 	configMapLister kubecorev1listers.ConfigMapLister,
 	maxExpiration time.Duration,
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface,
+	rateLimiter *ratelimit.ProjectRateLimiter,
 ) *KubeconfigREST {
 	return &KubeconfigREST{
 		secretLister:          secretLister,
@@ -37,6 +39,7 @@ func NewAdminKubeconfigREST(
 		subjectAccessReviewer: subjectAccessReviewer,
 		shootStorage:          shootGetter,
 		maxExpirationSeconds:  int64(maxExpiration.Seconds()),
+		rateLimiter:           rateLimiter,
 
 		gvk: schema.GroupVersionKind{
 			Group:   authenticationv1alpha1.SchemeGroupVersion.Group,
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/clock"
+
+	corevalidation "github.com/gardener/gardener/pkg/api/core/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// MaxActiveDebugPods is the maximum number of debug pods which may exist for a Shoot at the same time.
+const MaxActiveDebugPods = 5
+
+// DebugPodREST implements a RESTStorage for shoot debug pod requests.
+type DebugPodREST struct {
+	shootStatusStorage   updater
+	maxExpirationSeconds int64
+	clock                clock.Clock
+}
+
+type updater interface {
+	Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error)
+}
+
+var (
+	_ = rest.NamedCreater(&DebugPodREST{})
+	_ = rest.GroupVersionKindProvider(&DebugPodREST{})
+)
+
+// NewDebugPodREST returns a new DebugPodREST.
+func NewDebugPodREST(shootStatusUpdater updater, maxExpiration time.Duration) *DebugPodREST {
+	return &DebugPodREST{
+		shootStatusStorage:   shootStatusUpdater,
+		maxExpirationSeconds: int64(maxExpiration.Seconds()),
+		clock:                clock.RealClock{},
+	}
+}
+
+// New returns an instance of the object.
+func (r *DebugPodREST) New() runtime.Object {
+	return &core.ShootDebugPodRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *DebugPodREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create records a debug pod for the shoot in its status. The gardenlet responsible for the shoot creates the pod in
+// the control plane namespace and deletes it again after it has expired. The requesting user and the given reason are
+// kept in the status of the shoot and in the audit log of the gardener-apiserver.
+func (r *DebugPodREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	debugPodRequest, ok := obj.(*core.ShootDebugPodRequest)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a ShootDebugPodRequest: %#v", obj))
+	}
+
+	if errs := corevalidation.ValidateShootDebugPodRequest(debugPodRequest); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(r.groupKind(), "", errs)
+	}
+
+	userInfo, ok := genericapirequest.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("no user in context")
+	}
+
+	if r.maxExpirationSeconds > 0 && debugPodRequest.Spec.ExpirationSeconds > r.maxExpirationSeconds {
+		debugPodRequest.Spec.ExpirationSeconds = r.maxExpirationSeconds
+	}
+
+	var (
+		now      = r.clock.Now().Truncate(time.Second)
+		debugPod = core.ShootDebugPod{
+			Name:                "debug-" + utilrand.String(5),
+			RequestedBy:         userInfo.GetName(),
+			Reason:              debugPodRequest.Spec.Reason,
+			CreationTimestamp:   metav1.Time{Time: now},
+			ExpirationTimestamp: metav1.Time{Time: now.Add(time.Duration(debugPodRequest.Spec.ExpirationSeconds) * time.Second)},
+		}
+		namespace string
+	)
+
+	if _, _, err := r.shootStatusStorage.Update(ctx, name, rest.DefaultUpdatedObjectInfo(nil, func(_ context.Context, _, oldObj runtime.Object) (runtime.Object, error) {
+		oldShoot, ok := oldObj.(*core.Shoot)
+		if !ok {
+			return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", oldObj))
+		}
+		shoot := oldShoot.DeepCopy()
+
+		if shoot.DeletionTimestamp != nil {
+			return nil, apierrors.NewConflict(core.Resource("shoots"), shoot.Name, fmt.Errorf("shoot is being deleted"))
+		}
+		if shoot.Spec.SeedName == nil || len(shoot.Status.TechnicalID) == 0 {
+			fieldErr := field.Required(field.NewPath("spec", "seedName"), "debug pods can only be requested for shoots which are scheduled to a seed")
+			return nil, apierrors.NewInvalid(r.groupKind(), shoot.Name, field.ErrorList{fieldErr})
+		}
+
+		var activeDebugPods int
+		for _, existing := range shoot.Status.DebugPods {
+			if existing.ExpirationTimestamp.Time.After(now) {
+				activeDebugPods++
+			}
+		}
+		if activeDebugPods >= MaxActiveDebugPods {
+			return nil, apierrors.NewTooManyRequests(fmt.Sprintf("shoot already has %d active debug pods", activeDebugPods), 0)
+		}
+
+		namespace = shoot.Status.TechnicalID
+		shoot.Status.DebugPods = append(shoot.Status.DebugPods, debugPod)
+		return shoot, nil
+	}), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{}); err != nil {
+		return nil, err
+	}
+
+	debugPodRequest.Status = core.ShootDebugPodRequestStatus{
+		PodName:             debugPod.Name,
+		Namespace:           namespace,
+		ExpirationTimestamp: debugPod.ExpirationTimestamp,
+	}
+
+	return debugPodRequest, nil
+}
+
+// GroupVersionKind returns the GVK for the shoot debug pod request type.
+func (r *DebugPodREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return gardencorev1beta1.SchemeGroupVersion.WithKind("ShootDebugPodRequest")
+}
+
+func (r *DebugPodREST) groupKind() schema.GroupKind {
+	return core.Kind("ShootDebugPodRequest")
+}
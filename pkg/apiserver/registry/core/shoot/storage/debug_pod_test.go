@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	registryrest "k8s.io/apiserver/pkg/registry/rest"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+)
+
+var _ = Describe("DebugPod", func() {
+	var (
+		ctx  context.Context
+		name = "test-shoot"
+		ns   = "test-ns"
+		now  = time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+
+		createValidation registryrest.ValidateObjectFunc
+
+		shoot        *gardencore.Shoot
+		shootUpdater *fakeUpdater
+		obj          *gardencore.ShootDebugPodRequest
+
+		debugPodREST *DebugPodREST
+	)
+
+	BeforeEach(func() {
+		ctx = genericapirequest.WithUser(context.TODO(), &user.DefaultInfo{Name: "operator"})
+		createValidation = nil
+
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec:       gardencore.ShootSpec{SeedName: ptr.To("seed")},
+			Status:     gardencore.ShootStatus{TechnicalID: "shoot--test--test-shoot"},
+		}
+		shootUpdater = &fakeUpdater{obj: shoot}
+		obj = &gardencore.ShootDebugPodRequest{Spec: gardencore.ShootDebugPodRequestSpec{
+			Reason:            "investigate etcd latency",
+			ExpirationSeconds: 3600,
+		}}
+
+		debugPodREST = NewDebugPodREST(shootUpdater, 2*time.Hour)
+		debugPodREST.clock = testclock.NewFakeClock(now)
+	})
+
+	Context("request fails", func() {
+		var (
+			actual runtime.Object
+			err    error
+		)
+
+		AfterEach(func() {
+			actual, err = debugPodREST.Create(ctx, name, obj, createValidation, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(actual).To(BeNil())
+		})
+
+		It("returns an error if create validation fails", func() {
+			createValidation = func(_ context.Context, _ runtime.Object) error {
+				return errors.New("some error")
+			}
+		})
+
+		It("returns an error if the request is invalid", func() {
+			obj.Spec.Reason = ""
+		})
+
+		It("returns an error if there is no user in the context", func() {
+			ctx = context.TODO()
+		})
+
+		It("returns an error if the shoot cannot be updated", func() {
+			shootUpdater.err = errors.New("can't update shoot")
+		})
+
+		It("returns an error if the shoot is not scheduled", func() {
+			shoot.Spec.SeedName = nil
+		})
+
+		It("returns an error if the shoot is being deleted", func() {
+			shoot.DeletionTimestamp = &metav1.Time{Time: now}
+		})
+
+		It("returns an error if the shoot already has too many active debug pods", func() {
+			for range MaxActiveDebugPods {
+				shoot.Status.DebugPods = append(shoot.Status.DebugPods, gardencore.ShootDebugPod{ExpirationTimestamp: metav1.Time{Time: now.Add(time.Minute)}})
+			}
+		})
+	})
+
+	It("should record the debug pod in the shoot status", func() {
+		actual, err := debugPodREST.Create(ctx, name, obj, createValidation, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		debugPodRequest, ok := actual.(*gardencore.ShootDebugPodRequest)
+		Expect(ok).To(BeTrue())
+		Expect(debugPodRequest.Status.PodName).To(HavePrefix("debug-"))
+		Expect(debugPodRequest.Status.Namespace).To(Equal("shoot--test--test-shoot"))
+		Expect(debugPodRequest.Status.ExpirationTimestamp.Time).To(Equal(now.Add(time.Hour)))
+
+		Expect(shootUpdater.obj.(*gardencore.Shoot).Status.DebugPods).To(ConsistOf(gardencore.ShootDebugPod{
+			Name:                debugPodRequest.Status.PodName,
+			RequestedBy:         "operator",
+			Reason:              "investigate etcd latency",
+			CreationTimestamp:   metav1.Time{Time: now},
+			ExpirationTimestamp: metav1.Time{Time: now.Add(time.Hour)},
+		}))
+	})
+
+	It("should cap the expiration at the maximum", func() {
+		obj.Spec.ExpirationSeconds = 24 * 60 * 60
+
+		actual, err := debugPodREST.Create(ctx, name, obj, createValidation, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actual.(*gardencore.ShootDebugPodRequest).Status.ExpirationTimestamp.Time).To(Equal(now.Add(2 * time.Hour)))
+	})
+
+	It("should not count expired debug pods", func() {
+		for range MaxActiveDebugPods {
+			shoot.Status.DebugPods = append(shoot.Status.DebugPods, gardencore.ShootDebugPod{ExpirationTimestamp: metav1.Time{Time: now.Add(-time.Minute)}})
+		}
+
+		_, err := debugPodREST.Create(ctx, name, obj, createValidation, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shootUpdater.obj.(*gardencore.Shoot).Status.DebugPods).To(HaveLen(MaxActiveDebugPods + 1))
+	})
+
+	It("should return a conflict error if the shoot is being deleted", func() {
+		shoot.DeletionTimestamp = &metav1.Time{Time: now}
+
+		_, err := debugPodREST.Create(ctx, name, obj, createValidation, nil)
+		Expect(apierrors.IsConflict(err)).To(BeTrue())
+	})
+})
+
+type fakeUpdater struct {
+	obj runtime.Object
+	err error
+}
+
+func (f *fakeUpdater) Update(ctx context.Context, _ string, objInfo registryrest.UpdatedObjectInfo, _ registryrest.ValidateObjectFunc, _ registryrest.ValidateObjectUpdateFunc, _ bool, _ *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	if f.err != nil {
+		return nil, false, f.err
+	}
+
+	obj, err := objInfo.UpdatedObject(ctx, f.obj)
+	if err != nil {
+		return nil, false, err
+	}
+
+	f.obj = obj
+	return obj, false, nil
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/api/core/helper"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// FootprintREST implements a RESTStorage for shoot footprint requests.
+type FootprintREST struct {
+	shootStorage getter
+}
+
+var (
+	_ = rest.NamedCreater(&FootprintREST{})
+	_ = rest.GroupVersionKindProvider(&FootprintREST{})
+)
+
+// NewFootprintREST returns a new FootprintREST.
+func NewFootprintREST(shootGetter getter) *FootprintREST {
+	return &FootprintREST{shootStorage: shootGetter}
+}
+
+// New returns an instance of the object.
+func (r *FootprintREST) New() runtime.Object {
+	return &core.ShootFootprintRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *FootprintREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create returns a shoot footprint request with the estimated resource footprint of the control plane of the shoot.
+// If the request contains a shoot specification, the footprint is computed for it, otherwise the specification of
+// the existing shoot is used. A cost estimate which was added to the request by a mutating admission webhook is
+// preserved.
+func (r *FootprintREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	footprintRequest, ok := obj.(*core.ShootFootprintRequest)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a ShootFootprintRequest: %#v", obj))
+	}
+
+	shootSpec := footprintRequest.Spec.Shoot
+	if shootSpec == nil {
+		shoot, err := getShoot(ctx, r.shootStorage, name)
+		if err != nil {
+			return nil, err
+		}
+		shootSpec = &shoot.Spec
+	}
+
+	footprintRequest.Status.Components = ComputeControlPlaneFootprint(shootSpec)
+	footprintRequest.Status.Total = corev1.ResourceList{}
+	for _, component := range footprintRequest.Status.Components {
+		for resourceName, quantity := range component.Requests {
+			total := footprintRequest.Status.Total[resourceName]
+			total.Add(quantity)
+			footprintRequest.Status.Total[resourceName] = total
+		}
+	}
+
+	return footprintRequest, nil
+}
+
+// GroupVersionKind returns the GVK for the shoot footprint request type.
+func (r *FootprintREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return gardencorev1beta1.SchemeGroupVersion.WithKind("ShootFootprintRequest")
+}
+
+type componentFootprint struct {
+	name                  string
+	cpu, cpuPerNode       string
+	memory, memoryPerNode string
+	replicas, replicasHA  int32
+}
+
+func (f componentFootprint) estimate(maxNodes int64, highAvailability bool) core.ControlPlaneComponentFootprint {
+	replicas := f.replicas
+	if highAvailability {
+		replicas = f.replicasHA
+	}
+
+	return core.ControlPlaneComponentFootprint{
+		Name:     f.name,
+		Replicas: replicas,
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    scaledQuantity(f.cpu, f.cpuPerNode, maxNodes, replicas),
+			corev1.ResourceMemory: scaledQuantity(f.memory, f.memoryPerNode, maxNodes, replicas),
+		},
+	}
+}
+
+func scaledQuantity(base, perNode string, nodes int64, replicas int32) resource.Quantity {
+	quantity := resource.MustParse(perNode)
+	quantity.Mul(nodes)
+	quantity.Add(resource.MustParse(base))
+	quantity.Mul(int64(replicas))
+	return quantity
+}
+
+var (
+	controlPlaneComponentFootprints = []componentFootprint{
+		{name: v1beta1constants.DeploymentNameKubeAPIServer, cpu: "250m", cpuPerNode: "10m", memory: "512Mi", memoryPerNode: "20Mi", replicas: 2, replicasHA: 3},
+		{name: v1beta1constants.ETCDMain, cpu: "200m", cpuPerNode: "5m", memory: "300Mi", memoryPerNode: "10Mi", replicas: 1, replicasHA: 3},
+		{name: v1beta1constants.ETCDEvents, cpu: "100m", cpuPerNode: "2m", memory: "120Mi", memoryPerNode: "2Mi", replicas: 1, replicasHA: 3},
+		{name: v1beta1constants.DeploymentNameKubeControllerManager, cpu: "100m", cpuPerNode: "2m", memory: "128Mi", memoryPerNode: "5Mi", replicas: 1, replicasHA: 2},
+		{name: v1beta1constants.DeploymentNameGardenerResourceManager, cpu: "50m", cpuPerNode: "0", memory: "128Mi", memoryPerNode: "1Mi", replicas: 2, replicasHA: 2},
+	}
+
+	workerControlPlaneComponentFootprints = []componentFootprint{
+		{name: v1beta1constants.DeploymentNameKubeScheduler, cpu: "50m", cpuPerNode: "1m", memory: "64Mi", memoryPerNode: "2Mi", replicas: 1, replicasHA: 2},
+		{name: v1beta1constants.DeploymentNameMachineControllerManager, cpu: "50m", cpuPerNode: "1m", memory: "64Mi", memoryPerNode: "1Mi", replicas: 1, replicasHA: 1},
+		{name: v1beta1constants.DeploymentNameVPNSeedServer, cpu: "50m", cpuPerNode: "0", memory: "64Mi", memoryPerNode: "0", replicas: 1, replicasHA: 2},
+	}
+
+	clusterAutoscalerFootprint = componentFootprint{name: v1beta1constants.DeploymentNameClusterAutoscaler, cpu: "50m", cpuPerNode: "1m", memory: "64Mi", memoryPerNode: "1Mi", replicas: 1, replicasHA: 1}
+)
+
+// ComputeControlPlaneFootprint estimates the resource requests of the control plane components of a shoot with the
+// given specification. The estimate scales with the maximum number of nodes of all worker pools and the configured
+// failure tolerance of the control plane. It does not consider hibernation and components of extensions.
+func ComputeControlPlaneFootprint(shootSpec *core.ShootSpec) []core.ControlPlaneComponentFootprint {
+	var (
+		shoot            = &core.Shoot{Spec: *shootSpec}
+		highAvailability = helper.IsHAControlPlaneConfigured(shoot)
+		footprints       = append([]componentFootprint{}, controlPlaneComponentFootprints...)
+		maxNodes         int64
+		autoscaling      bool
+	)
+
+	for _, worker := range shootSpec.Provider.Workers {
+		maxNodes += int64(worker.Maximum)
+		if worker.Maximum > worker.Minimum {
+			autoscaling = true
+		}
+	}
+
+	if !helper.IsWorkerless(shoot) {
+		footprints = append(footprints, workerControlPlaneComponentFootprints...)
+		if autoscaling {
+			footprints = append(footprints, clusterAutoscalerFootprint)
+		}
+	}
+
+	components := make([]core.ControlPlaneComponentFootprint, 0, len(footprints))
+	for _, f := range footprints {
+		components = append(components, f.estimate(maxNodes, highAvailability))
+	}
+	return components
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	registryrest "k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+)
+
+var _ = Describe("Footprint", func() {
+	var (
+		ctx  = context.TODO()
+		name = "test-shoot"
+		ns   = "test-ns"
+
+		createValidation registryrest.ValidateObjectFunc
+
+		shoot       *gardencore.Shoot
+		shootGetter *fakeGetter
+		obj         *gardencore.ShootFootprintRequest
+
+		footprintREST *FootprintREST
+	)
+
+	BeforeEach(func() {
+		createValidation = nil
+
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec: gardencore.ShootSpec{
+				Provider: gardencore.Provider{
+					Workers: []gardencore.Worker{{Name: "worker", Minimum: 1, Maximum: 10}},
+				},
+			},
+		}
+		shootGetter = &fakeGetter{obj: shoot}
+		obj = &gardencore.ShootFootprintRequest{}
+
+		footprintREST = NewFootprintREST(shootGetter)
+	})
+
+	componentNames := func(result runtime.Object) []string {
+		var names []string
+		for _, component := range result.(*gardencore.ShootFootprintRequest).Status.Components {
+			names = append(names, component.Name)
+		}
+		return names
+	}
+
+	Context("request fails", func() {
+		var (
+			actual runtime.Object
+			err    error
+		)
+
+		AfterEach(func() {
+			actual, err = footprintREST.Create(ctx, name, obj, createValidation, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(actual).To(BeNil())
+		})
+
+		It("returns an error if create validation fails", func() {
+			createValidation = func(_ context.Context, _ runtime.Object) error {
+				return errors.New("some error")
+			}
+		})
+
+		It("returns an error if it cannot get the shoot", func() {
+			shootGetter.err = errors.New("can't get shoot")
+		})
+
+		It("returns an error if it cannot convert the object to a shoot", func() {
+			shootGetter.obj = &corev1.Pod{}
+		})
+	})
+
+	Context("request succeeds", func() {
+		It("should compute the footprint of the existing shoot", func() {
+			result, err := footprintREST.Create(ctx, name, obj, createValidation, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(componentNames(result)).To(ConsistOf(
+				"kube-apiserver",
+				"etcd-main",
+				"etcd-events",
+				"kube-controller-manager",
+				"gardener-resource-manager",
+				"kube-scheduler",
+				"machine-controller-manager",
+				"vpn-seed-server",
+				"cluster-autoscaler",
+			))
+
+			status := result.(*gardencore.ShootFootprintRequest).Status
+			Expect(status.Components[0].Replicas).To(Equal(int32(2)))
+			Expect(status.Components[0].Requests.Cpu().String()).To(Equal("700m"))
+			Expect(status.Components[0].Requests.Memory().String()).To(Equal("1424Mi"))
+
+			var totalCPU, totalMemory resource.Quantity
+			for _, component := range status.Components {
+				totalCPU.Add(component.Requests[corev1.ResourceCPU])
+				totalMemory.Add(component.Requests[corev1.ResourceMemory])
+			}
+			Expect(status.Total.Cpu().Cmp(totalCPU)).To(BeZero())
+			Expect(status.Total.Memory().Cmp(totalMemory)).To(BeZero())
+		})
+
+		It("should compute the footprint of the given shoot specification without reading the shoot", func() {
+			shootGetter.err = errors.New("can't get shoot")
+			obj.Spec.Shoot = &gardencore.ShootSpec{
+				ControlPlane: &gardencore.ControlPlane{HighAvailability: &gardencore.HighAvailability{
+					FailureTolerance: gardencore.FailureTolerance{Type: gardencore.FailureToleranceTypeZone},
+				}},
+			}
+
+			result, err := footprintREST.Create(ctx, name, obj, createValidation, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(componentNames(result)).To(ConsistOf(
+				"kube-apiserver",
+				"etcd-main",
+				"etcd-events",
+				"kube-controller-manager",
+				"gardener-resource-manager",
+			))
+
+			status := result.(*gardencore.ShootFootprintRequest).Status
+			Expect(status.Components[1].Replicas).To(Equal(int32(3)))
+			Expect(status.Components[1].Requests.Cpu().String()).To(Equal("600m"))
+			Expect(status.Components[1].Requests.Memory().String()).To(Equal("900Mi"))
+		})
+
+		It("should not add the cluster-autoscaler if no worker pool is autoscaled", func() {
+			shoot.Spec.Provider.Workers[0].Minimum = 10
+
+			result, err := footprintREST.Create(ctx, name, obj, createValidation, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(componentNames(result)).NotTo(ContainElement("cluster-autoscaler"))
+		})
+
+		It("should preserve a cost estimate added by an admission webhook", func() {
+			costEstimate := &gardencore.ShootCostEstimate{Currency: "EUR", MonthlyAmount: "123.45", Description: ptr.To("control plane only")}
+			obj.Status.CostEstimate = costEstimate
+
+			result, err := footprintREST.Create(ctx, name, obj, createValidation, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.(*gardencore.ShootFootprintRequest).Status.CostEstimate).To(Equal(costEstimate))
+		})
+	})
+})
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/api/core/helper"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// ImpactREST implements a RESTStorage for shoot impact requests.
+type ImpactREST struct {
+	shootStorage getter
+}
+
+var (
+	_ = rest.NamedCreater(&ImpactREST{})
+	_ = rest.GroupVersionKindProvider(&ImpactREST{})
+)
+
+// NewImpactREST returns a new ImpactREST.
+func NewImpactREST(shootGetter getter) *ImpactREST {
+	return &ImpactREST{shootStorage: shootGetter}
+}
+
+// New returns an instance of the object.
+func (r *ImpactREST) New() runtime.Object {
+	return &core.ShootImpactRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *ImpactREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create returns a shoot impact request with the operations which would result from applying the proposed
+// specification to the existing shoot. The shoot itself is not changed.
+func (r *ImpactREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	impactRequest, ok := obj.(*core.ShootImpactRequest)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a ShootImpactRequest: %#v", obj))
+	}
+
+	shoot, err := getShoot(ctx, r.shootStorage, name)
+	if err != nil {
+		return nil, err
+	}
+
+	impactRequest.Status.Operations = ComputeShootImpact(&shoot.Spec, &impactRequest.Spec.Shoot)
+
+	return impactRequest, nil
+}
+
+// GroupVersionKind returns the GVK for the shoot impact request type.
+func (r *ImpactREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return gardencorev1beta1.SchemeGroupVersion.WithKind("ShootImpactRequest")
+}
+
+// ComputeShootImpact returns the operations which would result from changing the specification of a shoot from
+// oldSpec to newSpec. It only considers changes whose effect is known to Gardener, i.e., changes of provider-specific
+// configuration are reported as potential reasons for node rollouts but their effect is not evaluated.
+func ComputeShootImpact(oldSpec, newSpec *core.ShootSpec) []core.ShootImpactOperation {
+	var (
+		oldShoot   = &core.Shoot{Spec: *oldSpec}
+		newShoot   = &core.Shoot{Spec: *newSpec}
+		operations []core.ShootImpactOperation
+	)
+
+	if oldHibernated, newHibernated := helper.HibernationIsEnabled(oldShoot), helper.HibernationIsEnabled(newShoot); oldHibernated != newHibernated {
+		reason := "hibernation is disabled, the control plane and all worker nodes are started"
+		if newHibernated {
+			reason = "hibernation is enabled, the control plane and all worker nodes are shut down"
+		}
+		operations = append(operations, core.ShootImpactOperation{Type: core.ShootImpactOperationHibernation, Target: "shoot", Reasons: []string{reason}})
+	}
+
+	if oldHA, newHA := helper.IsHAControlPlaneConfigured(oldShoot), helper.IsHAControlPlaneConfigured(newShoot); oldHA != newHA {
+		reason := "control plane high availability is disabled, the number of etcd members is decreased from 3 to 1"
+		if newHA {
+			reason = "control plane high availability is enabled, the number of etcd members is increased from 1 to 3"
+		}
+		for _, etcd := range []string{v1beta1constants.ETCDMain, v1beta1constants.ETCDEvents} {
+			operations = append(operations, core.ShootImpactOperation{Type: core.ShootImpactOperationETCDResize, Target: etcd, Reasons: []string{reason}})
+		}
+	}
+
+	var versionReasons []string
+	if oldSpec.Kubernetes.Version != newSpec.Kubernetes.Version {
+		versionReasons = append(versionReasons, fmt.Sprintf("Kubernetes version is changed from %s to %s", oldSpec.Kubernetes.Version, newSpec.Kubernetes.Version))
+	}
+
+	for _, component := range []struct {
+		name    string
+		changed bool
+	}{
+		{name: v1beta1constants.DeploymentNameKubeAPIServer, changed: !apiequality.Semantic.DeepEqual(oldSpec.Kubernetes.KubeAPIServer, newSpec.Kubernetes.KubeAPIServer)},
+		{name: v1beta1constants.DeploymentNameKubeControllerManager, changed: !apiequality.Semantic.DeepEqual(oldSpec.Kubernetes.KubeControllerManager, newSpec.Kubernetes.KubeControllerManager)},
+		{name: v1beta1constants.DeploymentNameKubeScheduler, changed: !helper.IsWorkerless(newShoot) && !apiequality.Semantic.DeepEqual(oldSpec.Kubernetes.KubeScheduler, newSpec.Kubernetes.KubeScheduler)},
+	} {
+		reasons := append([]string{}, versionReasons...)
+		if component.changed {
+			reasons = append(reasons, fmt.Sprintf("configuration of %s is changed", component.name))
+		}
+		if len(reasons) > 0 {
+			operations = append(operations, core.ShootImpactOperation{Type: core.ShootImpactOperationComponentRestart, Target: component.name, Reasons: reasons})
+		}
+	}
+
+	for _, oldWorker := range oldSpec.Provider.Workers {
+		newWorker := helper.FindWorkerByName(newSpec.Provider.Workers, oldWorker.Name)
+		if newWorker == nil {
+			operations = append(operations, core.ShootImpactOperation{Type: core.ShootImpactOperationWorkerPoolDeletion, Target: oldWorker.Name, Reasons: []string{"worker pool is removed"}})
+			continue
+		}
+
+		inPlace := helper.IsUpdateStrategyInPlace(newWorker.UpdateStrategy)
+		if reasons := workerPoolUpdateReasons(oldSpec, newSpec, oldWorker, *newWorker, inPlace); len(reasons) > 0 {
+			operationType := core.ShootImpactOperationNodeRollout
+			if inPlace {
+				operationType = core.ShootImpactOperationNodeInPlaceUpdate
+			}
+			operations = append(operations, core.ShootImpactOperation{Type: operationType, Target: oldWorker.Name, Reasons: reasons})
+		}
+	}
+
+	return operations
+}
+
+// workerPoolUpdateReasons returns the changes of the given worker pool which cause its nodes to be updated. For worker
+// pools with a rolling update strategy, this follows the computation of the operating system config key, i.e., only
+// changes of the minor Kubernetes version cause a rollout. For in-place updates, any Kubernetes version change causes
+// an update.
+func workerPoolUpdateReasons(oldSpec, newSpec *core.ShootSpec, oldWorker, newWorker core.Worker, inPlace bool) []string {
+	var reasons []string
+
+	if oldWorker.Machine.Type != newWorker.Machine.Type {
+		reasons = append(reasons, fmt.Sprintf("machine type is changed from %s to %s", oldWorker.Machine.Type, newWorker.Machine.Type))
+	}
+
+	if oldImage, newImage := machineImage(oldWorker.Machine.Image), machineImage(newWorker.Machine.Image); oldImage != newImage {
+		reasons = append(reasons, fmt.Sprintf("machine image is changed from %s to %s", oldImage, newImage))
+	}
+
+	if !apiequality.Semantic.DeepEqual(oldWorker.Volume, newWorker.Volume) {
+		reasons = append(reasons, "root volume is changed")
+	}
+
+	if !apiequality.Semantic.DeepEqual(oldWorker.CRI, newWorker.CRI) {
+		reasons = append(reasons, "container runtime is changed")
+	}
+
+	oldVersion, newVersion := effectiveKubernetesVersion(oldSpec, oldWorker), effectiveKubernetesVersion(newSpec, newWorker)
+	if (inPlace && oldVersion != newVersion) || (!inPlace && !sameMinorVersion(oldVersion, newVersion)) {
+		reasons = append(reasons, fmt.Sprintf("Kubernetes version of the worker pool is changed from %s to %s", oldVersion, newVersion))
+	}
+
+	oldKubelet := helper.CalculateEffectiveKubeletConfiguration(oldSpec.Kubernetes.Kubelet, oldWorker.Kubernetes)
+	newKubelet := helper.CalculateEffectiveKubeletConfiguration(newSpec.Kubernetes.Kubelet, newWorker.Kubernetes)
+	if !apiequality.Semantic.DeepEqual(nodeRelevantKubeletConfiguration(oldKubelet), nodeRelevantKubeletConfiguration(newKubelet)) {
+		reasons = append(reasons, "kubelet resource reservations, eviction thresholds or CPU manager policy are changed")
+	}
+
+	if !apiequality.Semantic.DeepEqual(oldWorker.ProviderConfig, newWorker.ProviderConfig) {
+		reasons = append(reasons, "provider configuration is changed, whether this causes a node update depends on the provider extension")
+	}
+
+	return reasons
+}
+
+func machineImage(image *core.ShootMachineImage) string {
+	if image == nil {
+		return ""
+	}
+	return image.Name + "@" + image.Version
+}
+
+func effectiveKubernetesVersion(spec *core.ShootSpec, worker core.Worker) string {
+	if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+		return *worker.Kubernetes.Version
+	}
+	return spec.Kubernetes.Version
+}
+
+func sameMinorVersion(oldVersion, newVersion string) bool {
+	oldSemver, err := semver.NewVersion(oldVersion)
+	if err != nil {
+		return oldVersion == newVersion
+	}
+	newSemver, err := semver.NewVersion(newVersion)
+	if err != nil {
+		return oldVersion == newVersion
+	}
+	return oldSemver.Major() == newSemver.Major() && oldSemver.Minor() == newSemver.Minor()
+}
+
+// nodeRelevantKubeletConfiguration returns the subset of the kubelet configuration which is part of the operating
+// system config key, see `CalculateDataStringForKubeletConfiguration`.
+func nodeRelevantKubeletConfiguration(kubelet *core.KubeletConfig) *core.KubeletConfig {
+	if kubelet == nil {
+		return &core.KubeletConfig{}
+	}
+
+	return &core.KubeletConfig{
+		KubeReserved:     kubelet.KubeReserved,
+		SystemReserved:   kubelet.SystemReserved,
+		EvictionHard:     kubelet.EvictionHard,
+		CPUManagerPolicy: kubelet.CPUManagerPolicy,
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	registryrest "k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+)
+
+var _ = Describe("Impact", func() {
+	var (
+		ctx  = context.TODO()
+		name = "test-shoot"
+		ns   = "test-ns"
+
+		createValidation registryrest.ValidateObjectFunc
+
+		shoot       *gardencore.Shoot
+		shootGetter *fakeGetter
+		obj         *gardencore.ShootImpactRequest
+
+		impactREST *ImpactREST
+	)
+
+	BeforeEach(func() {
+		createValidation = nil
+
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec: gardencore.ShootSpec{
+				Kubernetes: gardencore.Kubernetes{Version: "1.33.1"},
+				Provider: gardencore.Provider{
+					Workers: []gardencore.Worker{
+						{
+							Name:    "worker1",
+							Machine: gardencore.Machine{Type: "large", Image: &gardencore.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"}},
+						},
+						{
+							Name:           "worker2",
+							Machine:        gardencore.Machine{Type: "small", Image: &gardencore.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"}},
+							UpdateStrategy: ptr.To(gardencore.AutoInPlaceUpdate),
+						},
+					},
+				},
+			},
+		}
+		shootGetter = &fakeGetter{obj: shoot}
+		obj = &gardencore.ShootImpactRequest{Spec: gardencore.ShootImpactRequestSpec{Shoot: *shoot.Spec.DeepCopy()}}
+
+		impactREST = NewImpactREST(shootGetter)
+	})
+
+	Context("request fails", func() {
+		var (
+			actual runtime.Object
+			err    error
+		)
+
+		AfterEach(func() {
+			actual, err = impactREST.Create(ctx, name, obj, createValidation, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(actual).To(BeNil())
+		})
+
+		It("returns an error if create validation fails", func() {
+			createValidation = func(_ context.Context, _ runtime.Object) error {
+				return errors.New("some error")
+			}
+		})
+
+		It("returns an error if it cannot get the shoot", func() {
+			shootGetter.err = errors.New("can't get shoot")
+		})
+
+		It("returns an error if it cannot convert the object to a shoot", func() {
+			shootGetter.obj = &corev1.Pod{}
+		})
+	})
+
+	Context("request succeeds", func() {
+		impact := func() []gardencore.ShootImpactOperation {
+			result, err := impactREST.Create(ctx, name, obj, createValidation, nil)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return result.(*gardencore.ShootImpactRequest).Status.Operations
+		}
+
+		It("should not return any operation if the specification is unchanged", func() {
+			Expect(impact()).To(BeEmpty())
+		})
+
+		It("should return restarts of the control plane components and in-place updates for a patch version update", func() {
+			obj.Spec.Shoot.Kubernetes.Version = "1.33.2"
+
+			reasons := []string{"Kubernetes version is changed from 1.33.1 to 1.33.2"}
+			Expect(impact()).To(ConsistOf(
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationComponentRestart, Target: "kube-apiserver", Reasons: reasons},
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationComponentRestart, Target: "kube-controller-manager", Reasons: reasons},
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationComponentRestart, Target: "kube-scheduler", Reasons: reasons},
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationNodeInPlaceUpdate, Target: "worker2", Reasons: []string{"Kubernetes version of the worker pool is changed from 1.33.1 to 1.33.2"}},
+			))
+		})
+
+		It("should return a node rollout for a minor version update of a worker pool", func() {
+			obj.Spec.Shoot.Provider.Workers[0].Kubernetes = &gardencore.WorkerKubernetes{Version: ptr.To("1.32.0")}
+
+			Expect(impact()).To(ConsistOf(
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationNodeRollout, Target: "worker1", Reasons: []string{"Kubernetes version of the worker pool is changed from 1.33.1 to 1.32.0"}},
+			))
+		})
+
+		It("should return a node rollout for changes of the machine, volume, and kubelet configuration", func() {
+			worker := &obj.Spec.Shoot.Provider.Workers[0]
+			worker.Machine.Type = "xlarge"
+			worker.Machine.Image.Version = "2.0.0"
+			worker.Volume = &gardencore.Volume{VolumeSize: "50Gi"}
+			worker.Kubernetes = &gardencore.WorkerKubernetes{Kubelet: &gardencore.KubeletConfig{
+				KubeReserved: &gardencore.KubeletConfigReserved{CPU: ptr.To(resource.MustParse("100m"))},
+			}}
+
+			Expect(impact()).To(ConsistOf(
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationNodeRollout, Target: "worker1", Reasons: []string{
+					"machine type is changed from large to xlarge",
+					"machine image is changed from gardenlinux@1.0.0 to gardenlinux@2.0.0",
+					"root volume is changed",
+					"kubelet resource reservations, eviction thresholds or CPU manager policy are changed",
+				}},
+			))
+		})
+
+		It("should not return a node rollout for kubelet changes which are not part of the node configuration key", func() {
+			obj.Spec.Shoot.Kubernetes.Kubelet = &gardencore.KubeletConfig{MaxPods: ptr.To[int32](50)}
+
+			Expect(impact()).To(BeEmpty())
+		})
+
+		It("should return worker pool deletions", func() {
+			obj.Spec.Shoot.Provider.Workers = obj.Spec.Shoot.Provider.Workers[:1]
+
+			Expect(impact()).To(ConsistOf(
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationWorkerPoolDeletion, Target: "worker2", Reasons: []string{"worker pool is removed"}},
+			))
+		})
+
+		It("should return a restart of kube-apiserver if its configuration is changed", func() {
+			obj.Spec.Shoot.Kubernetes.KubeAPIServer = &gardencore.KubeAPIServerConfig{EnableAnonymousAuthentication: ptr.To(false)}
+
+			Expect(impact()).To(ConsistOf(
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationComponentRestart, Target: "kube-apiserver", Reasons: []string{"configuration of kube-apiserver is changed"}},
+			))
+		})
+
+		It("should return etcd resizes if high availability is enabled", func() {
+			obj.Spec.Shoot.ControlPlane = &gardencore.ControlPlane{HighAvailability: &gardencore.HighAvailability{
+				FailureTolerance: gardencore.FailureTolerance{Type: gardencore.FailureToleranceTypeZone},
+			}}
+
+			reasons := []string{"control plane high availability is enabled, the number of etcd members is increased from 1 to 3"}
+			Expect(impact()).To(ConsistOf(
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationETCDResize, Target: "etcd-main", Reasons: reasons},
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationETCDResize, Target: "etcd-events", Reasons: reasons},
+			))
+		})
+
+		It("should return the hibernation", func() {
+			obj.Spec.Shoot.Hibernation = &gardencore.Hibernation{Enabled: ptr.To(true)}
+
+			Expect(impact()).To(ConsistOf(
+				gardencore.ShootImpactOperation{Type: gardencore.ShootImpactOperationHibernation, Target: "shoot", Reasons: []string{"hibernation is enabled, the control plane and all worker nodes are shut down"}},
+			))
+		})
+
+		It("should ignore operations provided in the request", func() {
+			obj.Status.Operations = []gardencore.ShootImpactOperation{{Type: gardencore.ShootImpactOperationNodeRollout, Target: "worker1"}}
+
+			Expect(impact()).To(BeEmpty())
+		})
+	})
+})
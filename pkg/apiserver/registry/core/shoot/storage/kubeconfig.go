@@ -27,6 +27,7 @@ import (
 	authenticationapi "github.com/gardener/gardener/pkg/apis/authentication"
 	"github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apiserver/ratelimit"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/secrets"
@@ -41,6 +42,7 @@ type KubeconfigREST struct {
 	shootStorage          getter
 	maxExpirationSeconds  int64
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface
+	rateLimiter           *ratelimit.ProjectRateLimiter
 
 	gvk            schema.GroupVersionKind
 	newObjectFunc  func() runtime.Object
@@ -84,6 +86,10 @@ func (r *KubeconfigREST) Create(ctx context.Context, name string, obj runtime.Ob
 		return nil, apierrors.NewInvalid(r.gvk.GroupKind(), "", errs)
 	}
 
+	if err := r.rateLimiter.Allow(genericapirequest.NamespaceValue(ctx)); err != nil {
+		return nil, err
+	}
+
 	userInfo, ok := genericapirequest.UserFrom(ctx)
 	if !ok {
 		return nil, apierrors.NewBadRequest("no user in context")
@@ -95,16 +101,11 @@ func (r *KubeconfigREST) Create(ctx context.Context, name string, obj runtime.Ob
 	}
 
 	// prepare: get shoot object
-	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	shoot, err := getShoot(ctx, r.shootStorage, name)
 	if err != nil {
 		return nil, err
 	}
 
-	shoot, ok := shootObj.(*core.Shoot)
-	if !ok {
-		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
-	}
-
 	// filter only addresses that actually advertise the kube-apiserver
 	// it is possible that the list of addresses also include URLs like the shoot's issuer URL
 	var kubeAPIServerAddresses []core.ShootAdvertisedAddress
@@ -215,6 +216,27 @@ type getter interface {
 	Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error)
 }
 
+// getShoot reads the Shoot with the given name for serving a subresource request. Subresource requests do not carry a
+// resource version, hence the Shoot is read with resourceVersion "0" which is served from the watch cache instead of
+// performing a quorum read from etcd for every request. This matches the staleness of the listers used for reading the
+// referenced secrets and config maps. Only if the Shoot is not (yet) known to the watch cache, a quorum read is
+// performed to not fail requests for Shoots which were created just now.
+func getShoot(ctx context.Context, shootGetter getter, name string) (*core.Shoot, error) {
+	shootObj, err := shootGetter.Get(ctx, name, &metav1.GetOptions{ResourceVersion: "0"})
+	if apierrors.IsNotFound(err) {
+		shootObj, err = shootGetter.Get(ctx, name, &metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+	return shoot, nil
+}
+
 func convertToAuthorizationExtraValue(extra map[string][]string) map[string]authorizationv1.ExtraValue {
 	if extra == nil {
 		return nil
@@ -31,13 +31,14 @@ import (
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apiserver/ratelimit"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 func kubeconfigTests(
-	newKubeconfigREST func(getter, kubecorev1listers.SecretLister, gardencorev1beta1listers.InternalSecretLister, kubecorev1listers.ConfigMapLister, time.Duration, clientauthorizationv1.SubjectAccessReviewInterface) *KubeconfigREST,
+	newKubeconfigREST func(getter, kubecorev1listers.SecretLister, gardencorev1beta1listers.InternalSecretLister, kubecorev1listers.ConfigMapLister, time.Duration, clientauthorizationv1.SubjectAccessReviewInterface, *ratelimit.ProjectRateLimiter) *KubeconfigREST,
 	newObjectFunc func() runtime.Object,
 	setExpirationSeconds func(runtime.Object, *int64),
 	getExpirationTimestamp func(runtime.Object) metav1.Time,
@@ -178,7 +179,7 @@ lIwEl8tStnO9u1JUK4w1e+lC37zI2v5k4WMQmJcolUEMwmZjnCR/
 
 		obj = newObjectFunc()
 
-		kcREST = newKubeconfigREST(shootGetter, secretLister, internalSecretLister, configMapLister, time.Hour, subjectAccessReviewer)
+		kcREST = newKubeconfigREST(shootGetter, secretLister, internalSecretLister, configMapLister, time.Hour, subjectAccessReviewer, nil)
 
 		ctx = request.WithUser(context.Background(), &user.DefaultInfo{
 			Name: userName,
@@ -262,6 +263,22 @@ lIwEl8tStnO9u1JUK4w1e+lC37zI2v5k4WMQmJcolUEMwmZjnCR/
 		})
 	})
 
+	Context("rate limiting", func() {
+		BeforeEach(func() {
+			ctx = request.WithNamespace(ctx, namespace)
+			kcREST.rateLimiter = ratelimit.NewProjectRateLimiter("shoots/kubeconfig", 1, 1, testclock.NewFakeClock(time.Now()))
+		})
+
+		It("returns an error if the rate limit of the project is exceeded", func() {
+			_, err := kcREST.Create(ctx, name, obj, createValidation, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			actual, err := kcREST.Create(ctx, name, newObjectFunc(), createValidation, nil)
+			Expect(apierrors.IsTooManyRequests(err)).To(BeTrue())
+			Expect(actual).To(BeNil())
+		})
+	})
+
 	Context("request succeeds", func() {
 		DescribeTable("should successfully issue kubeconfig", func(sar fakeSubjectAccessReviewer, organizationMatcher gomegatypes.GomegaMatcher) {
 			kcREST.subjectAccessReviewer = &sar
@@ -345,12 +362,57 @@ lIwEl8tStnO9u1JUK4w1e+lC37zI2v5k4WMQmJcolUEMwmZjnCR/
 	})
 }
 
+var _ = Describe("#getShoot", func() {
+	var (
+		ctx   = context.TODO()
+		shoot = &gardencore.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+	)
+
+	It("should read the shoot from the watch cache", func() {
+		shootGetter := &fakeGetter{obj: shoot}
+
+		Expect(getShoot(ctx, shootGetter, "foo")).To(Equal(shoot))
+		Expect(shootGetter.resourceVersions).To(Equal([]string{"0"}))
+	})
+
+	It("should perform a quorum read if the shoot is not found in the watch cache", func() {
+		shootGetter := &fakeGetter{obj: shoot, notFoundInCache: true}
+
+		Expect(getShoot(ctx, shootGetter, "foo")).To(Equal(shoot))
+		Expect(shootGetter.resourceVersions).To(Equal([]string{"0", ""}))
+	})
+
+	It("should return the error of the quorum read", func() {
+		shootGetter := &fakeGetter{err: apierrors.NewNotFound(gardencore.Resource("shoots"), "foo")}
+
+		_, err := getShoot(ctx, shootGetter, "foo")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(shootGetter.resourceVersions).To(Equal([]string{"0", ""}))
+	})
+
+	It("should not perform a quorum read for other errors", func() {
+		shootGetter := &fakeGetter{err: errors.New("fake")}
+
+		_, err := getShoot(ctx, shootGetter, "foo")
+		Expect(err).To(MatchError("fake"))
+		Expect(shootGetter.resourceVersions).To(Equal([]string{"0"}))
+	})
+})
+
 type fakeGetter struct {
 	obj runtime.Object
 	err error
+
+	// notFoundInCache simulates a shoot which is not yet known to the watch cache.
+	notFoundInCache  bool
+	resourceVersions []string
 }
 
-func (f *fakeGetter) Get(_ context.Context, _ string, _ *metav1.GetOptions) (runtime.Object, error) {
+func (f *fakeGetter) Get(_ context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	f.resourceVersions = append(f.resourceVersions, options.ResourceVersion)
+	if f.notFoundInCache && options.ResourceVersion == "0" {
+		return nil, apierrors.NewNotFound(gardencore.Resource("shoots"), name)
+	}
 	return f.obj, f.err
 }
 
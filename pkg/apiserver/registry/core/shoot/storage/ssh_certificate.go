@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/gardener/gardener/pkg/api"
+	authenticationvalidation "github.com/gardener/gardener/pkg/api/authentication/validation"
+	"github.com/gardener/gardener/pkg/api/core/helper"
+	authenticationapi "github.com/gardener/gardener/pkg/apis/authentication"
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// SSHUserName is the name of the user on the shoot nodes for which SSH certificates are issued.
+const SSHUserName = "gardener"
+
+// sshCertificateClockSkew is the duration by which the start of the validity period of SSH certificates is moved into
+// the past to allow some clock skew between the gardener-apiserver and the shoot nodes.
+const sshCertificateClockSkew = time.Minute
+
+// SSHCertificateREST implements a RESTStorage for SSH certificate requests.
+type SSHCertificateREST struct {
+	internalSecretLister gardencorev1beta1listers.InternalSecretLister
+	shootStorage         getter
+	maxExpirationSeconds int64
+	eventRecorder        record.EventRecorder
+}
+
+var (
+	_ = rest.NamedCreater(&SSHCertificateREST{})
+	_ = rest.GroupVersionKindProvider(&SSHCertificateREST{})
+)
+
+// NewSSHCertificateREST returns a new SSHCertificateREST.
+func NewSSHCertificateREST(
+	shootGetter getter,
+	internalSecretLister gardencorev1beta1listers.InternalSecretLister,
+	maxExpiration time.Duration,
+	eventRecorder record.EventRecorder,
+) *SSHCertificateREST {
+	return &SSHCertificateREST{
+		internalSecretLister: internalSecretLister,
+		shootStorage:         shootGetter,
+		maxExpirationSeconds: int64(maxExpiration.Seconds()),
+		eventRecorder:        eventRecorder,
+	}
+}
+
+// New returns an instance of the object.
+func (r *SSHCertificateREST) New() runtime.Object {
+	return &authenticationv1alpha1.SSHCertificateRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *SSHCertificateREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create returns an SSH certificate request with a short-lived user certificate for the given public key. The
+// certificate is signed by the SSH certificate authority of the shoot which is trusted by sshd on all shoot nodes.
+// Every issuance is recorded as an event for the shoot.
+func (r *SSHCertificateREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	sshCertificateRequest := &authenticationapi.SSHCertificateRequest{}
+	if err := api.Scheme.Convert(obj, sshCertificateRequest, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", obj, sshCertificateRequest, err)
+	}
+
+	if errs := authenticationvalidation.ValidateSSHCertificateRequest(sshCertificateRequest); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(r.groupKind(), "", errs)
+	}
+
+	userInfo, ok := genericapirequest.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("no user in context")
+	}
+
+	// prepare: get shoot object
+	shoot, err := getShoot(ctx, r.shootStorage, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !helper.ShootEnablesSSHAccess(shoot) {
+		fieldErr := field.Forbidden(field.NewPath("spec", "provider", "workersSettings", "sshAccess", "enabled"), "SSH access is not enabled for this shoot")
+		return nil, apierrors.NewInvalid(r.groupKind(), shoot.Name, field.ErrorList{fieldErr})
+	}
+
+	// prepare: get SSH certificate authority
+	caSSHSecret, err := r.internalSecretLister.InternalSecrets(shoot.Namespace).Get(gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixCASSH))
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not get SSH CA secret: %w", err))
+	}
+
+	signer, err := ssh.ParsePrivateKey(caSSHSecret.Data[secrets.DataKeyRSAPrivateKey])
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not load SSH CA private key from secret: %w", err))
+	}
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(sshCertificateRequest.Spec.PublicKey))
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("could not parse public key: %v", err))
+	}
+
+	// generate certificate
+	if r.maxExpirationSeconds > 0 && sshCertificateRequest.Spec.ExpirationSeconds > r.maxExpirationSeconds {
+		sshCertificateRequest.Spec.ExpirationSeconds = r.maxExpirationSeconds
+	}
+
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not generate certificate serial: %w", err))
+	}
+
+	var (
+		// The clock skew is part of the validity period, so that the certificate is never valid for longer than the
+		// requested (and capped) expiration.
+		validAfter  = secrets.Clock.Now().Add(-sshCertificateClockSkew)
+		validBefore = validAfter.Add(time.Duration(sshCertificateRequest.Spec.ExpirationSeconds) * time.Second)
+		certificate = &ssh.Certificate{
+			Key:      publicKey,
+			Serial:   serial,
+			CertType: ssh.UserCert,
+			// The key id is logged by sshd for every login, hence it allows correlating sessions on the nodes with the
+			// issuance recorded in the audit log of the gardener-apiserver.
+			KeyId:           fmt.Sprintf("%s/%s:%s:%d", shoot.Namespace, shoot.Name, userInfo.GetName(), serial),
+			ValidPrincipals: []string{SSHUserName},
+			ValidAfter:      uint64(validAfter.Unix()),  // #nosec G115 -- Unix time is positive.
+			ValidBefore:     uint64(validBefore.Unix()), // #nosec G115 -- Unix time is positive.
+			Permissions: ssh.Permissions{
+				Extensions: map[string]string{
+					"permit-pty":              "",
+					"permit-port-forwarding":  "",
+					"permit-agent-forwarding": "",
+				},
+			},
+		}
+	)
+
+	if err := certificate.SignCert(rand.Reader, signer); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not sign SSH certificate: %w", err))
+	}
+
+	r.eventRecorder.Eventf(shootReference(shoot), corev1.EventTypeNormal, v1beta1constants.EventSSHCertificateIssued,
+		"Issued SSH certificate with serial %d for principal %q to user %q, valid until %s", serial, SSHUserName, userInfo.GetName(), time.Unix(validBefore.Unix(), 0).UTC().Format(time.RFC3339))
+
+	// return generated certificate in status
+	sshCertificateRequest.Status.Certificate = string(ssh.MarshalAuthorizedKey(certificate))
+	sshCertificateRequest.Status.Username = SSHUserName
+	sshCertificateRequest.Status.ExpirationTimestamp = metav1.Time{Time: time.Unix(validBefore.Unix(), 0)}
+
+	if err := api.Scheme.Convert(sshCertificateRequest, obj, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", sshCertificateRequest, obj, err)
+	}
+
+	return obj, nil
+}
+
+// GroupVersionKind returns the GVK for the SSH certificate request type.
+func (r *SSHCertificateREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return authenticationv1alpha1.SchemeGroupVersion.WithKind("SSHCertificateRequest")
+}
+
+func (r *SSHCertificateREST) groupKind() schema.GroupKind {
+	return authenticationv1alpha1.SchemeGroupVersion.WithKind("SSHCertificateRequest").GroupKind()
+}
+
+// shootReference returns a reference to the given shoot for recording events. The internal shoot type is not
+// registered with a version known to clients, hence the reference is built for the v1beta1 version.
+func shootReference(shoot *core.Shoot) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion:      gardencorev1beta1.SchemeGroupVersion.String(),
+		Kind:            "Shoot",
+		Namespace:       shoot.Namespace,
+		Name:            shoot.Name,
+		UID:             shoot.UID,
+		ResourceVersion: shoot.ResourceVersion,
+	}
+}
+
+func randomSerial() (uint64, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(b[:]), nil
+}
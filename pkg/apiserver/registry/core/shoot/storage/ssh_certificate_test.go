@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	registryrest "k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("SSH Certificate", func() {
+	var (
+		ctx  context.Context
+		now  = time.Unix(1000, 0)
+		name = "test-shoot"
+		ns   = "test-ns"
+
+		createValidation registryrest.ValidateObjectFunc
+
+		caSSHSecret          *gardencorev1beta1.InternalSecret
+		caSigner             ssh.Signer
+		shoot                *gardencore.Shoot
+		shootGetter          *fakeGetter
+		internalSecretLister *fakeInternalSecretLister
+
+		publicKey ssh.PublicKey
+		obj       *authenticationv1alpha1.SSHCertificateRequest
+
+		fakeRecorder *record.FakeRecorder
+		sshREST      *SSHCertificateREST
+	)
+
+	BeforeEach(func() {
+		createValidation = nil
+
+		caSSH, err := (&secretsutils.RSASecretConfig{Name: "ca-ssh", Bits: 2048, UsedForSSH: true}).Generate()
+		Expect(err).NotTo(HaveOccurred())
+		caSSHSecret = &gardencorev1beta1.InternalSecret{
+			ObjectMeta: metav1.ObjectMeta{Name: name + ".ca-ssh", Namespace: ns},
+			Data:       caSSH.SecretData(),
+		}
+		caSigner, err = ssh.ParsePrivateKey(caSSHSecret.Data["id_rsa"])
+		Expect(err).NotTo(HaveOccurred())
+
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec: gardencore.ShootSpec{
+				Provider: gardencore.Provider{
+					Workers: []gardencore.Worker{{Name: "worker"}},
+				},
+			},
+		}
+
+		shootGetter = &fakeGetter{obj: shoot}
+		internalSecretLister = &fakeInternalSecretLister{obj: caSSHSecret}
+
+		pub, _, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		publicKey, err = ssh.NewPublicKey(pub)
+		Expect(err).NotTo(HaveOccurred())
+
+		obj = &authenticationv1alpha1.SSHCertificateRequest{
+			Spec: authenticationv1alpha1.SSHCertificateRequestSpec{
+				PublicKey:         string(ssh.MarshalAuthorizedKey(publicKey)),
+				ExpirationSeconds: ptr.To(int64(time.Hour.Seconds())),
+			},
+		}
+
+		fakeRecorder = record.NewFakeRecorder(1)
+		sshREST = NewSSHCertificateREST(shootGetter, internalSecretLister, 2*time.Hour, fakeRecorder)
+
+		ctx = request.WithUser(context.Background(), &user.DefaultInfo{Name: "foo@bar.com"})
+
+		DeferCleanup(test.WithVar(&secretsutils.Clock, testclock.NewFakeClock(now)))
+	})
+
+	Context("request fails", func() {
+		var (
+			actual runtime.Object
+			err    error
+		)
+
+		AfterEach(func() {
+			actual, err = sshREST.Create(ctx, name, obj, createValidation, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(actual).To(BeNil())
+			Expect(fakeRecorder.Events).To(BeEmpty())
+		})
+
+		It("returns an error if create validation fails", func() {
+			createValidation = func(_ context.Context, _ runtime.Object) error {
+				return errors.New("some error")
+			}
+		})
+
+		It("returns an error if validation fails", func() {
+			obj.Spec.PublicKey = "not-a-key"
+		})
+
+		It("returns an error if there is no user in the context", func() {
+			ctx = context.TODO()
+		})
+
+		It("returns an error if it cannot get the shoot", func() {
+			shootGetter.err = errors.New("can't get shoot")
+		})
+
+		It("returns an error if it cannot convert the object to a shoot", func() {
+			shootGetter.obj = &corev1.Pod{}
+		})
+
+		It("returns an error if SSH access is disabled for the shoot", func() {
+			shoot.Spec.Provider.WorkersSettings = &gardencore.WorkersSettings{SSHAccess: &gardencore.SSHAccess{Enabled: false}}
+		})
+
+		It("returns an error if the shoot is workerless", func() {
+			shoot.Spec.Provider.Workers = nil
+		})
+
+		It("returns an error if the ca-ssh secret doesn't exist", func() {
+			internalSecretLister.err = apierrors.NewNotFound(gardencore.Resource("internalsecrets"), caSSHSecret.Name)
+		})
+
+		It("returns an error if the ca-ssh secret is missing the private key", func() {
+			delete(caSSHSecret.Data, "id_rsa")
+		})
+	})
+
+	Context("request succeeds", func() {
+		parseCertificate := func(result runtime.Object) *ssh.Certificate {
+			req, ok := result.(*authenticationv1alpha1.SSHCertificateRequest)
+			Expect(ok).To(BeTrue())
+
+			key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(req.Status.Certificate))
+			Expect(err).NotTo(HaveOccurred())
+			cert, ok := key.(*ssh.Certificate)
+			Expect(ok).To(BeTrue())
+			return cert
+		}
+
+		It("should issue a user certificate signed by the SSH certificate authority", func() {
+			result, err := sshREST.Create(ctx, name, obj, createValidation, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			req := result.(*authenticationv1alpha1.SSHCertificateRequest)
+			Expect(req.Status.Username).To(Equal("gardener"))
+			Expect(req.Status.ExpirationTimestamp.Time).To(Equal(now.Add(59 * time.Minute)))
+
+			cert := parseCertificate(result)
+			Expect(cert.CertType).To(Equal(uint32(ssh.UserCert)))
+			Expect(cert.Key.Marshal()).To(Equal(publicKey.Marshal()))
+			Expect(cert.SignatureKey.Marshal()).To(Equal(caSigner.PublicKey().Marshal()))
+			Expect(cert.ValidPrincipals).To(ConsistOf("gardener"))
+			Expect(cert.KeyId).To(HavePrefix("test-ns/test-shoot:foo@bar.com:"))
+			Expect(cert.ValidAfter).To(Equal(uint64(now.Add(-time.Minute).Unix())))
+			Expect(cert.ValidBefore).To(Equal(uint64(now.Add(59 * time.Minute).Unix())))
+			Expect(cert.Permissions.Extensions).To(HaveKey("permit-pty"))
+
+			checker := &ssh.CertChecker{
+				IsUserAuthority: func(auth ssh.PublicKey) bool {
+					return string(auth.Marshal()) == string(caSigner.PublicKey().Marshal())
+				},
+				Clock: func() time.Time { return now },
+			}
+			Expect(checker.CheckCert("gardener", cert)).To(Succeed())
+		})
+
+		It("should record the issuance as event for the shoot", func() {
+			result, err := sshREST.Create(ctx, name, obj, createValidation, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeRecorder.Events).To(Receive(Equal(fmt.Sprintf(
+				`Normal SSHCertificateIssued Issued SSH certificate with serial %d for principal "gardener" to user "foo@bar.com", valid until 1970-01-01T01:15:40Z`,
+				parseCertificate(result).Serial,
+			))))
+		})
+
+		It("should cap the validity at the configured maximum expiration", func() {
+			obj.Spec.ExpirationSeconds = ptr.To(int64((5 * time.Hour).Seconds()))
+
+			result, err := sshREST.Create(ctx, name, obj, createValidation, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.(*authenticationv1alpha1.SSHCertificateRequest).Status.ExpirationTimestamp.Time).To(Equal(now.Add(2*time.Hour - time.Minute)))
+			cert := parseCertificate(result)
+			Expect(time.Duration(cert.ValidBefore-cert.ValidAfter) * time.Second).To(Equal(2 * time.Hour))
+		})
+
+		It("should not issue certificates valid for longer than the requested expiration", func() {
+			obj.Spec.ExpirationSeconds = ptr.To(int64((10 * time.Minute).Seconds()))
+
+			result, err := sshREST.Create(ctx, name, obj, createValidation, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			cert := parseCertificate(result)
+			Expect(time.Duration(cert.ValidBefore-cert.ValidAfter) * time.Second).To(Equal(10 * time.Minute))
+		})
+	})
+})
@@ -10,14 +10,18 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/apiserver/pkg/storage"
 	clientauthorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apiserver/ratelimit"
 	"github.com/gardener/gardener/pkg/apiserver/registry/core/shoot"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
 )
@@ -25,6 +29,16 @@ import (
 // REST implements a RESTStorage for shoots against etcd
 type REST struct {
 	*genericregistry.Store
+
+	creationRateLimiter *ratelimit.ProjectRateLimiter
+}
+
+// ProjectRateLimiters contains the rate limiters for expensive Shoot requests per project. Requests are not limited if
+// the respective rate limiter is nil.
+type ProjectRateLimiters struct {
+	AdminKubeconfig  *ratelimit.ProjectRateLimiter
+	ViewerKubeconfig *ratelimit.ProjectRateLimiter
+	Creation         *ratelimit.ProjectRateLimiter
 }
 
 // ShootStorage implements the storage for Shoots and all their subresources.
@@ -33,6 +47,10 @@ type ShootStorage struct {
 	Status           *StatusREST
 	AdminKubeconfig  *KubeconfigREST
 	ViewerKubeconfig *KubeconfigREST
+	SSHCertificate   *SSHCertificateREST
+	Footprint        *FootprintREST
+	Impact           *ImpactREST
+	DebugPod         *DebugPodREST
 	Binding          *BindingREST
 }
 
@@ -44,22 +62,30 @@ func NewStorage(
 	configMapLister kubecorev1listers.ConfigMapLister,
 	adminKubeconfigMaxExpiration time.Duration,
 	viewerKubeconfigMaxExpiration time.Duration,
+	sshCertificateMaxExpiration time.Duration,
+	debugPodMaxExpiration time.Duration,
 	credentialsRotationInterval time.Duration,
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface,
+	eventRecorder record.EventRecorder,
+	rateLimiters ProjectRateLimiters,
 ) ShootStorage {
-	shootRest, shootStatusRest, bindingREST := NewREST(optsGetter, credentialsRotationInterval)
+	shootRest, shootStatusRest, bindingREST := NewREST(optsGetter, credentialsRotationInterval, rateLimiters.Creation)
 
 	return ShootStorage{
 		Shoot:            shootRest,
 		Status:           shootStatusRest,
 		Binding:          bindingREST,
-		AdminKubeconfig:  NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration, subjectAccessReviewer),
-		ViewerKubeconfig: NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration, subjectAccessReviewer),
+		AdminKubeconfig:  NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration, subjectAccessReviewer, rateLimiters.AdminKubeconfig),
+		ViewerKubeconfig: NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration, subjectAccessReviewer, rateLimiters.ViewerKubeconfig),
+		SSHCertificate:   NewSSHCertificateREST(shootRest, internalSecretLister, sshCertificateMaxExpiration, eventRecorder),
+		Footprint:        NewFootprintREST(shootRest),
+		Impact:           NewImpactREST(shootRest),
+		DebugPod:         NewDebugPodREST(shootStatusRest, debugPodMaxExpiration),
 	}
 }
 
 // NewREST returns a RESTStorage object that will work against shoots.
-func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval time.Duration) (*REST, *StatusREST, *BindingREST) {
+func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval time.Duration, creationRateLimiter *ratelimit.ProjectRateLimiter) (*REST, *StatusREST, *BindingREST) {
 	var (
 		shootStrategy = shoot.NewStrategy(credentialsRotationInterval)
 		store         = &genericregistry.Store{
@@ -81,6 +107,10 @@ func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval t
 			RESTOptions: optsGetter,
 			AttrFunc:    shoot.GetAttrs,
 			TriggerFunc: map[string]storage.IndexerFunc{core.ShootSeedName: shoot.SeedNameTriggerFunc},
+			Indexers: &cache.Indexers{
+				storage.FieldIndex(core.ShootSeedName):       shoot.SeedNameIndexFunc,
+				storage.FieldIndex(core.ShootStatusSeedName): shoot.StatusSeedNameIndexFunc,
+			},
 		}
 	)
 
@@ -92,7 +122,16 @@ func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval t
 	statusStore.UpdateStrategy = shoot.NewStatusStrategy()
 	bindingStore := *store
 	bindingStore.UpdateStrategy = shoot.NewBindingStrategy()
-	return &REST{store}, &StatusREST{store: &statusStore}, &BindingREST{store: &bindingStore}
+	return &REST{Store: store, creationRateLimiter: creationRateLimiter}, &StatusREST{store: &statusStore}, &BindingREST{store: &bindingStore}
+}
+
+// Create creates a new Shoot unless the rate limit for Shoot creations in its project is exceeded.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if err := r.creationRateLimiter.Allow(genericapirequest.NamespaceValue(ctx)); err != nil {
+		return nil, err
+	}
+
+	return r.Store.Create(ctx, obj, createValidation, options)
 }
 
 // Implement CategoriesProvider
@@ -18,6 +18,7 @@ import (
 
 	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apiserver/ratelimit"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
 )
 
@@ -29,6 +30,7 @@ func NewViewerKubeconfigREST(
 	configMapLister kubecorev1listers.ConfigMapLister,
 	maxExpiration time.Duration,
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface,
+	rateLimiter *ratelimit.ProjectRateLimiter,
 ) *KubeconfigREST {
 	return &KubeconfigREST{
 		secretLister:          secretLister,
@@ -36,6 +38,7 @@ func NewViewerKubeconfigREST(
 		configMapLister:       configMapLister,
 		shootStorage:          shootGetter,
 		maxExpirationSeconds:  int64(maxExpiration.Seconds()),
+		rateLimiter:           rateLimiter,
 		subjectAccessReviewer: subjectAccessReviewer,
 
 		gvk: schema.GroupVersionKind{
@@ -31,6 +31,7 @@ import (
 	"github.com/gardener/gardener/pkg/api/core/validation"
 	"github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/utils/timewindow"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -63,6 +64,8 @@ func (shootStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
 	gardenerutils.SyncCloudProfileFields(nil, newShoot)
 
 	SyncDNSProviderCredentials(newShoot)
+
+	DefaultMaintenanceTimeWindow(nil, newShoot)
 }
 
 func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
@@ -81,6 +84,8 @@ func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object
 
 	SyncDNSProviderCredentials(newShoot)
 
+	DefaultMaintenanceTimeWindow(oldShoot, newShoot)
+
 	if mustIncreaseGeneration(oldShoot, newShoot) {
 		newShoot.Generation = oldShoot.Generation + 1
 	}
@@ -185,6 +190,11 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 					// The annotation will be removed later by gardenlet once the in-place update is finished.
 					// The generation will be increased if there really is a spec change in the object.
 					mustIncrease, mustRemoveOperationAnnotation = false, false
+
+				case v1beta1constants.ShootOperationComputeControlPlaneDrift:
+					// The annotation will be removed by gardenlet once the drift report is published. Computing the
+					// drift does not change the shoot, hence the generation is not increased.
+					mustIncrease, mustRemoveOperationAnnotation = false, false
 				}
 
 				if strings.HasPrefix(operation, v1beta1constants.OperationRotateRolloutWorkers) ||
@@ -410,7 +420,7 @@ func MatchShoot(label labels.Selector, field fields.Selector) storage.SelectionP
 		Label:       label,
 		Field:       field,
 		GetAttrs:    GetAttrs,
-		IndexFields: []string{core.ShootSeedName},
+		IndexFields: []string{core.ShootSeedName, core.ShootStatusSeedName},
 	}
 }
 
@@ -424,6 +434,26 @@ func SeedNameTriggerFunc(obj runtime.Object) string {
 	return getSeedName(shoot)
 }
 
+// SeedNameIndexFunc returns spec.seedName of given Shoot.
+func SeedNameIndexFunc(obj any) ([]string, error) {
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *core.Shoot but got %T", obj)
+	}
+
+	return []string{getSeedName(shoot)}, nil
+}
+
+// StatusSeedNameIndexFunc returns status.seedName of given Shoot.
+func StatusSeedNameIndexFunc(obj any) ([]string, error) {
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *core.Shoot but got %T", obj)
+	}
+
+	return []string{getStatusSeedName(shoot)}, nil
+}
+
 func getSeedName(shoot *core.Shoot) string {
 	if shoot.Spec.SeedName == nil {
 		return ""
@@ -492,6 +522,31 @@ func SyncDNSProviderCredentials(shoot *core.Shoot) {
 	}
 }
 
+// DefaultMaintenanceTimeWindow sets the maintenance time window of the Shoot if it is not specified. Updates keep the
+// time window of the old Shoot, new Shoots get a random time window.
+// The defaulting happens here and not in the API defaults so that mutating admission plugins like `ShootMutator` can
+// still distinguish whether the user specified a time window.
+func DefaultMaintenanceTimeWindow(oldShoot, shoot *core.Shoot) {
+	if shoot.Spec.Maintenance != nil && shoot.Spec.Maintenance.TimeWindow != nil {
+		return
+	}
+
+	var timeWindow *core.MaintenanceTimeWindow
+	if oldShoot == nil {
+		mt := timewindow.RandomMaintenanceTimeWindow()
+		timeWindow = &core.MaintenanceTimeWindow{Begin: mt.Begin().Formatted(), End: mt.End().Formatted()}
+	} else if oldShoot.Spec.Maintenance != nil && oldShoot.Spec.Maintenance.TimeWindow != nil {
+		timeWindow = oldShoot.Spec.Maintenance.TimeWindow.DeepCopy()
+	} else {
+		return
+	}
+
+	if shoot.Spec.Maintenance == nil {
+		shoot.Spec.Maintenance = &core.Maintenance{}
+	}
+	shoot.Spec.Maintenance.TimeWindow = timeWindow
+}
+
 // SyncEncryptedProviderStatus ensures the status fields shoot.spec.kubernetes.kubeAPIServer.encryptionConfig.provider.type
 // and shoot.status.credentials.encryptionAtRest.providerType are in sync, when status provider type in not set.
 // TODO(AleksandarSavchev): Remove this function after v1.137 has been released.
@@ -167,6 +167,24 @@ var _ = Describe("Strategy", func() {
 				Expect(shoot.Spec.DNS.Providers[0].SecretName).To(BeNil())
 			})
 		})
+
+		Context("maintenance time window", func() {
+			It("should default a random maintenance time window", func() {
+				shoot := &core.Shoot{}
+
+				strategy.PrepareForCreate(ctx, shoot)
+				Expect(shoot.Spec.Maintenance.TimeWindow).NotTo(BeNil())
+				Expect(shoot.Spec.Maintenance.TimeWindow.Begin).To(HaveSuffix("0000+0000"))
+				Expect(shoot.Spec.Maintenance.TimeWindow.End).To(HaveSuffix("0000+0000"))
+			})
+
+			It("should not overwrite the specified maintenance time window", func() {
+				shoot := &core.Shoot{Spec: core.ShootSpec{Maintenance: &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}}}}
+
+				strategy.PrepareForCreate(ctx, shoot)
+				Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}))
+			})
+		})
 	})
 
 	Describe("#PrepareForUpdate", func() {
@@ -728,6 +746,13 @@ var _ = Describe("Strategy", func() {
 					[]string{v1beta1constants.ShootOperationForceInPlaceUpdate},
 				),
 
+				Entry("compute-control-plane-drift",
+					v1beta1constants.ShootOperationComputeControlPlaneDrift,
+					nil,
+					false,
+					[]string{v1beta1constants.ShootOperationComputeControlPlaneDrift},
+				),
+
 				Entry("reconcile and rotate-etcd-encryption-key",
 					fmt.Sprintf("%s;%s", v1beta1constants.GardenerOperationReconcile, v1beta1constants.OperationRotateETCDEncryptionKey),
 					nil,
@@ -830,6 +855,24 @@ var _ = Describe("Strategy", func() {
 				Expect(newShoot.Generation).To(Equal(oldShoot.Generation))
 			})
 		})
+
+		Context("maintenance time window", func() {
+			It("should keep the maintenance time window of the old shoot if it is not specified", func() {
+				oldShoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}}
+				newShoot.Spec.Maintenance = nil
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+				Expect(newShoot.Spec.Maintenance.TimeWindow).To(Equal(oldShoot.Spec.Maintenance.TimeWindow))
+			})
+
+			It("should not overwrite the specified maintenance time window", func() {
+				oldShoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}}
+				newShoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}}
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+				Expect(newShoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}))
+			})
+		})
 	})
 
 	Describe("#Canonicalize", func() {
@@ -1305,7 +1348,34 @@ var _ = Describe("MatchShoot", func() {
 
 		Expect(result.Label).To(Equal(ls))
 		Expect(result.Field).To(Equal(fs))
-		Expect(result.IndexFields).To(ConsistOf(core.ShootSeedName))
+		Expect(result.IndexFields).To(ConsistOf(core.ShootSeedName, core.ShootStatusSeedName))
+	})
+})
+
+var _ = Describe("SeedNameIndexFunc", func() {
+	It("should return spec.seedName", func() {
+		Expect(SeedNameIndexFunc(createNewShootObject("foo"))).To(ConsistOf("foo"))
+	})
+
+	It("should return an error for other objects", func() {
+		_, err := SeedNameIndexFunc(&core.Seed{})
+		Expect(err).To(MatchError(ContainSubstring("expected *core.Shoot")))
+	})
+})
+
+var _ = Describe("StatusSeedNameIndexFunc", func() {
+	It("should return status.seedName", func() {
+		shoot := createNewShootObject("foo")
+		shoot.Status.SeedName = ptr.To("bar")
+
+		Expect(StatusSeedNameIndexFunc(shoot)).To(ConsistOf("bar"))
+	})
+
+	It("should return an empty value if status.seedName is not set", func() {
+		shoot := createNewShootObject("foo")
+		shoot.Status.SeedName = nil
+
+		Expect(StatusSeedNameIndexFunc(shoot)).To(ConsistOf(""))
 	})
 })
 
@@ -16,6 +16,7 @@ import (
 	gardenletstore "github.com/gardener/gardener/pkg/apiserver/registry/seedmanagement/gardenlet/storage"
 	managedseedstore "github.com/gardener/gardener/pkg/apiserver/registry/seedmanagement/managedseed/storage"
 	managedseedsetstore "github.com/gardener/gardener/pkg/apiserver/registry/seedmanagement/managedseedset/storage"
+	seedtemplatestore "github.com/gardener/gardener/pkg/apiserver/registry/seedmanagement/seedtemplate/storage"
 )
 
 // StorageProvider is an empty struct.
@@ -39,6 +40,7 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	gardenletStorage := gardenletstore.NewStorage(restOptionsGetter)
 	managedSeedStorage := managedseedstore.NewStorage(restOptionsGetter)
 	managedSeedSetStorage := managedseedsetstore.NewStorage(restOptionsGetter)
+	seedTemplateStorage := seedtemplatestore.NewStorage(restOptionsGetter)
 
 	storage["gardenlets"] = gardenletStorage.Gardenlet
 	storage["gardenlets/status"] = gardenletStorage.Status
@@ -47,6 +49,7 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storage["managedseedsets"] = managedSeedSetStorage.ManagedSeedSet
 	storage["managedseedsets/status"] = managedSeedSetStorage.Status
 	storage["managedseedsets/scale"] = managedSeedSetStorage.Scale
+	storage["seedtemplates"] = seedTemplateStorage.SeedTemplate
 
 	return storage
 }
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/seedmanagement"
+	"github.com/gardener/gardener/pkg/apiserver/registry/seedmanagement/seedtemplate"
+)
+
+// REST implements a RESTStorage for SeedTemplate.
+type REST struct {
+	*genericregistry.Store
+}
+
+// SeedTemplateStorage implements the storage for SeedTemplates.
+type SeedTemplateStorage struct {
+	SeedTemplate *REST
+}
+
+// NewStorage creates a new SeedTemplateStorage object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) SeedTemplateStorage {
+	return SeedTemplateStorage{
+		SeedTemplate: NewREST(optsGetter),
+	}
+}
+
+// NewREST returns a RESTStorage object that will work with SeedTemplate objects.
+func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
+	strategy := seedtemplate.NewStrategy()
+
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &seedmanagement.SeedTemplate{} },
+		NewListFunc:               func() runtime.Object { return &seedmanagement.SeedTemplateList{} },
+		DefaultQualifiedResource:  seedmanagement.Resource("seedtemplates"),
+		SingularQualifiedResource: seedmanagement.Resource("seedtemplate"),
+		EnableGarbageCollection:   true,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+	options := &generic.StoreOptions{
+		RESTOptions: optsGetter,
+	}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+
+	return &REST{store}
+}
+
+// Implement ShortNamesProvider
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"seedtpl"}
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedtemplate
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/api/seedmanagement/validation"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement"
+)
+
+type seedTemplateStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy return a storage strategy for seedtemplates.
+func NewStrategy() seedTemplateStrategy {
+	return seedTemplateStrategy{
+		api.Scheme,
+		names.SimpleNameGenerator,
+	}
+}
+
+func (seedTemplateStrategy) NamespaceScoped() bool { return false }
+
+func (seedTemplateStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	seedTemplate := obj.(*seedmanagement.SeedTemplate)
+
+	seedTemplate.Generation = 1
+}
+
+func (seedTemplateStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newSeedTemplate := obj.(*seedmanagement.SeedTemplate)
+	oldSeedTemplate := old.(*seedmanagement.SeedTemplate)
+
+	if mustIncreaseGeneration(oldSeedTemplate, newSeedTemplate) {
+		newSeedTemplate.Generation = oldSeedTemplate.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldSeedTemplate, newSeedTemplate *seedmanagement.SeedTemplate) bool {
+	// The template changes.
+	if !apiequality.Semantic.DeepEqual(oldSeedTemplate.Template, newSeedTemplate.Template) {
+		return true
+	}
+
+	// The deletion timestamp was set.
+	if oldSeedTemplate.DeletionTimestamp == nil && newSeedTemplate.DeletionTimestamp != nil {
+		return true
+	}
+
+	return false
+}
+
+func (seedTemplateStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	seedTemplate := obj.(*seedmanagement.SeedTemplate)
+	return validation.ValidateSeedTemplate(seedTemplate)
+}
+
+func (seedTemplateStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldSeedTemplate, newSeedTemplate := oldObj.(*seedmanagement.SeedTemplate), newObj.(*seedmanagement.SeedTemplate)
+	return validation.ValidateSeedTemplateUpdate(newSeedTemplate, oldSeedTemplate)
+}
+
+func (seedTemplateStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (seedTemplateStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (seedTemplateStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (seedTemplateStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+func (seedTemplateStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
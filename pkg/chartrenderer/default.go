@@ -34,11 +34,24 @@ const notesFileSuffix = "NOTES.txt"
 type chartRenderer struct {
 	renderer     *engine.Engine
 	capabilities *chartutil.Capabilities
+	strict       bool
+}
+
+// Option is an option for creating a chart renderer.
+type Option func(*chartRenderer)
+
+// WithStrictValues enables the strict rendering mode. In this mode, rendering fails if the provided values contain
+// keys which are neither declared in the values.yaml nor in the values.schema.json of the chart. This helps to catch
+// typos in values structs (e.g., in unit tests) which would otherwise produce silently wrong manifests.
+func WithStrictValues() Option {
+	return func(r *chartRenderer) {
+		r.strict = true
+	}
 }
 
 // NewForConfig creates a new ChartRenderer object. It requires a Kubernetes client as input which will be
 // injected in the Tiller environment.
-func NewForConfig(cfg *rest.Config) (Interface, error) {
+func NewForConfig(cfg *rest.Config, opts ...Option) (Interface, error) {
 	disc, err := discovery.NewDiscoveryClientForConfig(cfg)
 	if err != nil {
 		return nil, err
@@ -49,12 +62,12 @@ func NewForConfig(cfg *rest.Config) (Interface, error) {
 		return nil, fmt.Errorf("failed to get kubernetes server version %w", err)
 	}
 
-	return NewWithServerVersion(sv), nil
+	return NewWithServerVersion(sv, opts...), nil
 }
 
 // NewWithServerVersion creates a new chart renderer with the given server version.
-func NewWithServerVersion(serverVersion *version.Info) Interface {
-	return &chartRenderer{
+func NewWithServerVersion(serverVersion *version.Info, opts ...Option) Interface {
+	renderer := &chartRenderer{
 		renderer: &engine.Engine{},
 		capabilities: &chartutil.Capabilities{KubeVersion: chartutil.KubeVersion{
 			Version: serverVersion.GitVersion,
@@ -62,6 +75,12 @@ func NewWithServerVersion(serverVersion *version.Info) Interface {
 			Minor:   serverVersion.Minor,
 		}},
 	}
+
+	for _, opt := range opts {
+		opt(renderer)
+	}
+
+	return renderer
 }
 
 // RenderArchive loads the chart from the given location <chartPath> and calls the renderRelease() function
@@ -99,6 +118,16 @@ func (r *chartRenderer) renderRelease(chart *helmchart.Chart, releaseName, names
 		return nil, fmt.Errorf("failed to process chart %s: %w", chart.Metadata.Name, err)
 	}
 
+	if r.strict {
+		unknownKeys, err := unknownValueKeys(chart, valuesCopy)
+		if err != nil {
+			return nil, err
+		}
+		if len(unknownKeys) > 0 {
+			return nil, fmt.Errorf("values for chart %s contain unknown keys: %s", chart.Metadata.Name, strings.Join(unknownKeys, ", "))
+		}
+	}
+
 	caps := r.capabilities
 	revision := 1
 	options := chartutil.ReleaseOptions{
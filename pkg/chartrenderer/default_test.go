@@ -60,7 +60,7 @@ rules:
 # No actual functionality is implemented here.`
 )
 
-//go:embed testdata/alpine/*
+//go:embed testdata/alpine/* testdata/strict/*
 var embeddedFS embed.FS
 
 var _ = Describe("ChartRenderer", func() {
@@ -93,6 +93,42 @@ var _ = Describe("ChartRenderer", func() {
 		})
 	})
 
+	Describe("#WithStrictValues", func() {
+		var strictChartPath = filepath.Join("testdata", "strict")
+
+		BeforeEach(func() {
+			renderer = chartrenderer.NewWithServerVersion(&version.Info{}, chartrenderer.WithStrictValues())
+		})
+
+		It("should render the chart if all keys are declared in the default values or the schema", func() {
+			chart, err := renderer.RenderEmbeddedFS(embeddedFS, strictChartPath, "strict", "default", map[string]any{
+				"name":        "foo",
+				"replicas":    2,
+				"config":      map[string]any{"logLevel": "debug", "logFormat": "json"},
+				"labels":      map[string]any{"foo": "bar"},
+				"annotations": map[string]any{"bar": "baz"},
+				"global":      map[string]any{"anything": true},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chart.FileContent("configmap.yaml")).To(ContainSubstring("name: foo"))
+		})
+
+		It("should fail if the values contain unknown keys", func() {
+			_, err := renderer.RenderEmbeddedFS(embeddedFS, strictChartPath, "strict", "default", map[string]any{
+				"replica": 2,
+				"config":  map[string]any{"loglevel": "debug"},
+			})
+			Expect(err).To(MatchError("values for chart strict contain unknown keys: config.loglevel, replica"))
+		})
+
+		It("should not fail for unknown keys if the strict mode is disabled", func() {
+			renderer = chartrenderer.NewWithServerVersion(&version.Info{})
+
+			_, err := renderer.RenderEmbeddedFS(embeddedFS, strictChartPath, "strict", "default", map[string]any{"replica": 2})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
 	Describe("#FileContent", func() {
 		It("should return empty string when template file is missing", func() {
 			chart, err := renderer.RenderEmbeddedFS(embeddedFS, alpineChartPath, "alpine", "default", map[string]string{})
@@ -23,5 +23,7 @@ func (f FactoryFunc) NewForConfig(config *rest.Config) (Interface, error) {
 
 // DefaultFactory returns the default Factory.
 func DefaultFactory() Factory {
-	return FactoryFunc(NewForConfig)
+	return FactoryFunc(func(config *rest.Config) (Interface, error) {
+		return NewForConfig(config)
+	})
 }
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartrenderer
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	helmchart "helm.sh/helm/v3/pkg/chart"
+)
+
+// unknownValueKeys returns the paths of all keys in the given values which are neither declared in the default values
+// (values.yaml) nor in the values schema (values.schema.json) of the given chart or its subcharts. Maps which are
+// empty or null in the default values and which have no declared properties in the schema are considered free-form,
+// i.e., arbitrary keys are allowed below them. The same applies if the schema explicitly allows additional properties.
+func unknownValueKeys(chart *helmchart.Chart, values map[string]any) ([]string, error) {
+	var schema map[string]any
+	if len(chart.Schema) > 0 {
+		if err := json.Unmarshal(chart.Schema, &schema); err != nil {
+			return nil, fmt.Errorf("failed to parse values schema of chart %s: %w", chart.Metadata.Name, err)
+		}
+	}
+
+	subcharts := make(map[string]*helmchart.Chart, len(chart.Dependencies()))
+	for _, subchart := range chart.Dependencies() {
+		subcharts[subchart.Name()] = subchart
+	}
+
+	var (
+		ownValues = make(map[string]any, len(values))
+		unknown   []string
+	)
+
+	for key, value := range values {
+		if key == "global" {
+			continue
+		}
+
+		subchart, ok := subcharts[key]
+		if !ok {
+			ownValues[key] = value
+			continue
+		}
+
+		if subchartValues, ok := value.(map[string]any); ok {
+			subchartUnknown, err := unknownValueKeys(subchart, subchartValues)
+			if err != nil {
+				return nil, err
+			}
+			for _, k := range subchartUnknown {
+				unknown = append(unknown, key+"."+k)
+			}
+		}
+	}
+
+	unknown = append(unknown, unknownKeys("", ownValues, chart.Values, schema)...)
+	slices.Sort(unknown)
+	return unknown, nil
+}
+
+func unknownKeys(path string, values, defaults, schema map[string]any) []string {
+	properties, _ := schema["properties"].(map[string]any)
+	if len(defaults) == 0 && len(properties) == 0 {
+		return nil
+	}
+	if additionalProperties, ok := schema["additionalProperties"]; ok && additionalProperties != false {
+		return nil
+	}
+
+	var unknown []string
+	for key, value := range values {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+
+		defaultValue, declaredInDefaults := defaults[key]
+		propertySchema, declaredInSchema := properties[key]
+		if !declaredInDefaults && !declaredInSchema {
+			unknown = append(unknown, keyPath)
+			continue
+		}
+
+		nestedValues, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		nestedDefaults, _ := defaultValue.(map[string]any)
+		nestedSchema, _ := propertySchema.(map[string]any)
+
+		unknown = append(unknown, unknownKeys(keyPath, nestedValues, nestedDefaults, nestedSchema)...)
+	}
+
+	return unknown
+}
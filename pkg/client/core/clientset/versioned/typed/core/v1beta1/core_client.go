@@ -24,6 +24,7 @@ type CoreV1beta1Interface interface {
 	ControllerRegistrationsGetter
 	ExposureClassesGetter
 	InternalSecretsGetter
+	MaintenanceFreezesGetter
 	NamespacedCloudProfilesGetter
 	ProjectsGetter
 	QuotasGetter
@@ -70,6 +71,10 @@ func (c *CoreV1beta1Client) InternalSecrets(namespace string) InternalSecretInte
 	return newInternalSecrets(c, namespace)
 }
 
+func (c *CoreV1beta1Client) MaintenanceFreezes() MaintenanceFreezeInterface {
+	return newMaintenanceFreezes(c)
+}
+
 func (c *CoreV1beta1Client) NamespacedCloudProfiles(namespace string) NamespacedCloudProfileInterface {
 	return newNamespacedCloudProfiles(c, namespace)
 }
@@ -28,6 +28,8 @@ type Interface interface {
 	ExposureClasses() ExposureClassInformer
 	// InternalSecrets returns a InternalSecretInformer.
 	InternalSecrets() InternalSecretInformer
+	// MaintenanceFreezes returns a MaintenanceFreezeInformer.
+	MaintenanceFreezes() MaintenanceFreezeInformer
 	// NamespacedCloudProfiles returns a NamespacedCloudProfileInformer.
 	NamespacedCloudProfiles() NamespacedCloudProfileInformer
 	// Projects returns a ProjectInformer.
@@ -95,6 +97,11 @@ func (v *version) InternalSecrets() InternalSecretInformer {
 	return &internalSecretInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// MaintenanceFreezes returns a MaintenanceFreezeInformer.
+func (v *version) MaintenanceFreezes() MaintenanceFreezeInformer {
+	return &maintenanceFreezeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // NamespacedCloudProfiles returns a NamespacedCloudProfileInformer.
 func (v *version) NamespacedCloudProfiles() NamespacedCloudProfileInformer {
 	return &namespacedCloudProfileInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -46,6 +46,10 @@ type InternalSecretListerExpansion interface{}
 // InternalSecretNamespaceLister.
 type InternalSecretNamespaceListerExpansion interface{}
 
+// MaintenanceFreezeListerExpansion allows custom methods to be added to
+// MaintenanceFreezeLister.
+type MaintenanceFreezeListerExpansion interface{}
+
 // NamespacedCloudProfileListerExpansion allows custom methods to be added to
 // NamespacedCloudProfileLister.
 type NamespacedCloudProfileListerExpansion interface{}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardener"
+	metricsSubsystem = "single_object_cache"
+
+	resultHit  = "hit"
+	resultMiss = "miss"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	// singleObjectCacheObjects defines the gauge single_object_cache_objects.
+	singleObjectCacheObjects = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "objects",
+			Help:      "Number of individual objects which are currently cached and watched.",
+		},
+		[]string{"group", "kind"},
+	)
+	// singleObjectCacheReadsTotal defines the counter single_object_cache_reads_total.
+	singleObjectCacheReadsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "reads_total",
+			Help:      "Number of reads served by the cache. A miss means that a new watch had to be started for the object.",
+		},
+		[]string{"group", "kind", "result"},
+	)
+	// singleObjectCacheEvictionsTotal defines the counter single_object_cache_evictions_total.
+	singleObjectCacheEvictionsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "evictions_total",
+			Help:      "Number of cached objects which were evicted because they were not read for the max idle time.",
+		},
+		[]string{"group", "kind"},
+	)
+	// singleObjectCacheStalenessSeconds defines the gauge single_object_cache_staleness_seconds.
+	singleObjectCacheStalenessSeconds = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "staleness_seconds",
+			Help:      "Maximum time since any of the cached objects was last updated by a watch event. It is refreshed with every garbage collection run.",
+		},
+		[]string{"group", "kind"},
+	)
+)
@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -49,6 +50,7 @@ type objectCache struct {
 	cache          cache.Cache
 	cancel         context.CancelFunc
 	lastAccessTime atomic.Value
+	lastEventTime  atomic.Value
 }
 
 // NewSingleObject creates a new instance of the singleObject cache.Cache implementation.
@@ -56,6 +58,8 @@ type objectCache struct {
 // given `maxIdleTime`. A new cache for a particular object is added or re-added as soon as the caches `Get()` function
 // is invoked. Please note that object types are not differentiated by this cache (only object keys), i.e. it must not
 // be used with mixed GVKs.
+// The cache exposes metrics about the number of cached objects, cache hits and misses, evictions, and the time since
+// the cached objects were last updated by a watch event.
 func NewSingleObject(
 	log logr.Logger,
 	restConfig *rest.Config,
@@ -96,6 +100,11 @@ func (s *singleObject) Start(ctx context.Context) error {
 		s.lock.Lock()
 		defer s.lock.Unlock()
 
+		var staleness time.Duration
+		defer func() {
+			singleObjectCacheStalenessSeconds.WithLabelValues(s.gvk.Group, s.gvk.Kind).Set(staleness.Seconds())
+		}()
+
 		for key, objCache := range s.store {
 			var (
 				lastAccessTime, ok = (objCache.lastAccessTime.Load()).(*time.Time)
@@ -109,6 +118,10 @@ func (s *singleObject) Start(ctx context.Context) error {
 
 			if !ok || lastAccessTime == nil || !now.After(lastAccessTime.Add(s.maxIdleTime)) {
 				log.V(1).Info("Cache was accessed recently, no need to close it")
+
+				if lastEventTime, ok := (objCache.lastEventTime.Load()).(*time.Time); ok && lastEventTime != nil {
+					staleness = max(staleness, now.Sub(*lastEventTime))
+				}
 				continue
 			}
 
@@ -116,6 +129,8 @@ func (s *singleObject) Start(ctx context.Context) error {
 			log.V(1).Info("Cache was not accessed recently, closing it")
 			objCache.cancel()
 			delete(s.store, key)
+			singleObjectCacheObjects.WithLabelValues(s.gvk.Group, s.gvk.Kind).Dec()
+			singleObjectCacheEvictionsTotal.WithLabelValues(s.gvk.Group, s.gvk.Kind).Inc()
 		}
 	}, s.garbageCollectionInterval, ctx.Done())
 
@@ -171,6 +186,7 @@ func (s *singleObject) getOrCreateCache(key client.ObjectKey) (cache.Cache, erro
 
 	if !found {
 		log.V(1).Info("Cache not found, creating it")
+		singleObjectCacheReadsTotal.WithLabelValues(s.gvk.Group, s.gvk.Kind, resultMiss).Inc()
 
 		var err error
 
@@ -180,6 +196,7 @@ func (s *singleObject) getOrCreateCache(key client.ObjectKey) (cache.Cache, erro
 		}
 	} else {
 		log.V(1).Info("Cache found, accessing it")
+		singleObjectCacheReadsTotal.WithLabelValues(s.gvk.Group, s.gvk.Kind, resultHit).Inc()
 	}
 
 	now := s.clock.Now().UTC()
@@ -234,18 +251,37 @@ func (s *singleObject) createAndStartCache(log logr.Logger, key client.ObjectKey
 	// Hence, when we newly start a cache here, we need to perform a call on such cache to make it starting the
 	// underlying informer. This is blocking because it implicitly waits for this informer to be synced. That's why we
 	// use a context with a small timeout, especially to exit early in case of any permission errors.
-	if _, err := cache.GetInformerForKind(waitForSyncCtx, s.gvk); err != nil {
+	informer, err := cache.GetInformerForKind(waitForSyncCtx, s.gvk)
+	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("failed getting informer: %w", err)
 	}
 
-	log.V(1).Info("Cache was synced successfully")
-	s.store[key] = &objectCache{
+	objCache := &objectCache{
 		cache:  cache,
 		cancel: cancel,
 	}
 
-	return s.store[key], nil
+	// Remember when the object was last changed by a watch event to allow detecting stale caches.
+	recordEvent := func() {
+		now := s.clock.Now().UTC()
+		objCache.lastEventTime.Store(&now)
+	}
+	recordEvent()
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ any) { recordEvent() },
+		UpdateFunc: func(_, _ any) { recordEvent() },
+		DeleteFunc: func(_ any) { recordEvent() },
+	}); err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed adding event handler to informer: %w", err)
+	}
+
+	log.V(1).Info("Cache was synced successfully")
+	s.store[key] = objCache
+	singleObjectCacheObjects.WithLabelValues(s.gvk.Group, s.gvk.Kind).Inc()
+
+	return objCache, nil
 }
 
 func (s *singleObject) WaitForCacheSync(ctx context.Context) bool {
@@ -6,6 +6,7 @@ package cache_test
 
 import (
 	"context"
+	"maps"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -21,6 +22,8 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	. "github.com/gardener/gardener/pkg/client/kubernetes/cache"
 	mockcache "github.com/gardener/gardener/third_party/mock/controller-runtime/cache"
@@ -124,6 +127,56 @@ var _ = Describe("SingleObject", func() {
 		})
 	})
 
+	Describe("metrics", func() {
+		var (
+			informer *controllertest.FakeInformer
+			labels   = map[string]string{"group": "", "kind": "Secret"}
+		)
+
+		BeforeEach(func() {
+			informer = &controllertest.FakeInformer{}
+
+			mockCache.EXPECT().Start(gomock.Any())
+			mockCache.EXPECT().WaitForCacheSync(gomock.Any()).Return(true)
+			mockCache.EXPECT().GetInformerForKind(gomock.Any(), gvk).Return(informer, nil)
+			mockCache.EXPECT().Get(ctx, key, obj).Times(2)
+		})
+
+		It("should record reads, cached objects, evictions, and staleness", func() {
+			var (
+				misses    = metricValue("gardener_single_object_cache_reads_total", withLabel(labels, "result", "miss"))
+				hits      = metricValue("gardener_single_object_cache_reads_total", withLabel(labels, "result", "hit"))
+				objects   = metricValue("gardener_single_object_cache_objects", labels)
+				evictions = metricValue("gardener_single_object_cache_evictions_total", labels)
+			)
+
+			Expect(singleObjectCache.Get(ctx, key, obj)).To(Succeed())
+			Expect(singleObjectCache.Get(ctx, key, obj)).To(Succeed())
+
+			Expect(metricValue("gardener_single_object_cache_reads_total", withLabel(labels, "result", "miss"))).To(Equal(misses + 1))
+			Expect(metricValue("gardener_single_object_cache_reads_total", withLabel(labels, "result", "hit"))).To(Equal(hits + 1))
+			Expect(metricValue("gardener_single_object_cache_objects", labels)).To(Equal(objects + 1))
+
+			By("reporting the time since the last watch event")
+			clock.Step(maxIdleTime / 2)
+			Eventually(func() float64 {
+				return metricValue("gardener_single_object_cache_staleness_seconds", labels)
+			}).Should(Equal((maxIdleTime / 2).Seconds()))
+
+			informer.Add(obj)
+			Eventually(func() float64 {
+				return metricValue("gardener_single_object_cache_staleness_seconds", labels)
+			}).Should(BeZero())
+
+			By("evicting the idle cache")
+			clock.Step(2 * maxIdleTime)
+			Eventually(func() float64 {
+				return metricValue("gardener_single_object_cache_evictions_total", labels)
+			}).Should(Equal(evictions + 1))
+			Expect(metricValue("gardener_single_object_cache_objects", labels)).To(Equal(objects))
+		})
+	})
+
 	Describe("#GetInformer", func() {
 		It("should delegate call to stored cache", func() {
 			testCache(parentCtx, mockCache, gvk, clock, maxIdleTime,
@@ -189,7 +242,7 @@ func testCache(
 		return nil
 	})
 	mockCache.EXPECT().WaitForCacheSync(gomock.AssignableToTypeOf(waitForSyncCtx)).Return(true)
-	mockCache.EXPECT().GetInformerForKind(gomock.AssignableToTypeOf(waitForSyncCtx), gvk)
+	mockCache.EXPECT().GetInformerForKind(gomock.AssignableToTypeOf(waitForSyncCtx), gvk).Return(&controllertest.FakeInformer{}, nil)
 	setupExpectation()
 	ExpectWithOffset(1, testCall()).To(Succeed())
 	EventuallyWithOffset(1, startChan).Should(Receive())
@@ -214,7 +267,7 @@ func testCache(
 		return nil
 	})
 	mockCache.EXPECT().WaitForCacheSync(gomock.AssignableToTypeOf(waitForSyncCtx)).Return(true)
-	mockCache.EXPECT().GetInformerForKind(gomock.AssignableToTypeOf(waitForSyncCtx), gvk)
+	mockCache.EXPECT().GetInformerForKind(gomock.AssignableToTypeOf(waitForSyncCtx), gvk).Return(&controllertest.FakeInformer{}, nil)
 	setupExpectation()
 	ExpectWithOffset(1, testCall()).To(Succeed())
 	EventuallyWithOffset(1, startChan2).Should(Receive())
@@ -224,3 +277,36 @@ func testCache(
 		return parentCtx.Done()
 	}).ShouldNot(BeClosed())
 }
+
+func metricValue(name string, labels map[string]string) float64 {
+	families, err := runtimemetrics.Registry.Gather()
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+	metrics:
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if labels[label.GetName()] != label.GetValue() {
+					continue metrics
+				}
+			}
+
+			if metric.GetCounter() != nil {
+				return metric.GetCounter().GetValue()
+			}
+			return metric.GetGauge().GetValue()
+		}
+	}
+
+	return 0
+}
+
+func withLabel(labels map[string]string, name, value string) map[string]string {
+	result := maps.Clone(labels)
+	result[name] = value
+	return result
+}
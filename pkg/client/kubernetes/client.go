@@ -11,6 +11,7 @@ import (
 	"slices"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -256,6 +257,8 @@ func newClientSet(conf *Config) (Interface, error) {
 		return nil, err
 	}
 
+	conf.restConfig = applyRequestOptions(conf)
+
 	var (
 		runtimeAPIReader = conf.runtimeAPIReader
 		runtimeClient    = conf.runtimeClient
@@ -369,15 +372,20 @@ var _ client.Client = &FallbackClient{}
 
 // FallbackClient holds a `client.Client` and a `client.Reader` which is meant as a fallback
 // in case the kind of an object is configured in `KindToNamespaces` but the namespace isn't.
+// Optionally, `KindToReader` can configure a dedicated reader per kind (e.g., a read-through cache) which is preferred
+// over `Reader` in this case.
 type FallbackClient struct {
 	client.Client
 
 	Reader           client.Reader
 	KindToNamespaces map[string]sets.Set[string]
+	KindToReader     map[string]client.Reader
 }
 
 // Get retrieves an obj for a given object key from the Kubernetes Cluster.
 // `client.Reader` is used in case the kind of an object is configured in `KindToNamespaces` but the namespace isn't.
+// If a dedicated reader is configured for the kind in `KindToReader`, it is tried first and `client.Reader` is only used
+// if it fails with an error other than `NotFound`.
 func (d *FallbackClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
 	gvk, err := apiutil.GVKForObject(obj, d.Scheme())
 	if err != nil {
@@ -390,6 +398,11 @@ func (d *FallbackClient) Get(ctx context.Context, key client.ObjectKey, obj clie
 	// If there are specific namespaces for this kind in the cache and the object's namespace is not cached,
 	// use the API reader to get the object.
 	if ok && !namespaces.Has(obj.GetNamespace()) {
+		if reader, ok := d.KindToReader[gvk.Kind]; ok {
+			if err := reader.Get(ctx, key, obj, opts...); err == nil || apierrors.IsNotFound(err) {
+				return err
+			}
+		}
 		return d.Reader.Get(ctx, key, obj, opts...)
 	}
 
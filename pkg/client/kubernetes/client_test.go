@@ -5,12 +5,22 @@
 package kubernetes_test
 
 import (
+	"context"
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("Client", func() {
@@ -296,4 +306,78 @@ var _ = Describe("Client", func() {
 			),
 		)
 	})
+
+	Describe("FallbackClient", func() {
+		var (
+			ctx = context.Background()
+
+			cachedClient   client.Client
+			fallbackReader client.Client
+			kindReader     client.Client
+			kindReaderErr  error
+			fallbackClient *kubernetes.FallbackClient
+
+			secret *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			cachedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			fallbackReader = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			kindReaderErr = nil
+			kindReader = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if kindReaderErr != nil {
+						return kindReaderErr
+					}
+					return c.Get(ctx, key, obj, opts...)
+				},
+			}).Build()
+
+			fallbackClient = &kubernetes.FallbackClient{
+				Client:           cachedClient,
+				Reader:           fallbackReader,
+				KindToNamespaces: map[string]sets.Set[string]{"Secret": sets.New("seed-foo")},
+			}
+
+			secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "garden-foo"}}
+		})
+
+		It("should read objects in the configured namespaces from the cache", func() {
+			secret.Namespace = "seed-foo"
+			Expect(cachedClient.Create(ctx, secret)).To(Succeed())
+
+			Expect(fallbackClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+		})
+
+		It("should read objects in other namespaces with the fallback reader", func() {
+			Expect(fallbackReader.Create(ctx, secret)).To(Succeed())
+
+			Expect(fallbackClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+		})
+
+		Context("with dedicated reader for the kind", func() {
+			BeforeEach(func() {
+				fallbackClient.KindToReader = map[string]client.Reader{"Secret": kindReader}
+			})
+
+			It("should read objects in other namespaces with the dedicated reader", func() {
+				Expect(kindReader.Create(ctx, secret)).To(Succeed())
+
+				Expect(fallbackClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			})
+
+			It("should not fall back if the dedicated reader does not find the object", func() {
+				Expect(fallbackReader.Create(ctx, secret)).To(Succeed())
+
+				Expect(fallbackClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(BeNotFoundError())
+			})
+
+			It("should fall back if the dedicated reader fails", func() {
+				Expect(fallbackReader.Create(ctx, secret)).To(Succeed())
+				kindReaderErr = fmt.Errorf("fake")
+
+				Expect(fallbackClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			})
+		})
+	})
 })
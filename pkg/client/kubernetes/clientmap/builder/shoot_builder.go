@@ -11,6 +11,7 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 )
 
@@ -20,6 +21,7 @@ type ShootClientMapBuilder struct {
 	gardenClient           client.Client
 	seedClient             client.Client
 	clientConnectionConfig *componentbaseconfigv1alpha1.ClientConnectionConfiguration
+	clientSetOptions       []kubernetes.ConfigFunc
 }
 
 // NewShootClientMapBuilder constructs a new ShootClientMapBuilder.
@@ -45,6 +47,12 @@ func (b *ShootClientMapBuilder) WithClientConnectionConfig(cfg *componentbasecon
 	return b
 }
 
+// WithClientSetOptions sets additional options that should be applied to ClientSets created by this ClientMap.
+func (b *ShootClientMapBuilder) WithClientSetOptions(fns ...kubernetes.ConfigFunc) *ShootClientMapBuilder {
+	b.clientSetOptions = append(b.clientSetOptions, fns...)
+	return b
+}
+
 // Build builds the ShootClientMap using the provided attributes.
 func (b *ShootClientMapBuilder) Build(log logr.Logger) (clientmap.ClientMap, error) {
 	if b.gardenClient == nil {
@@ -61,5 +69,6 @@ func (b *ShootClientMapBuilder) Build(log logr.Logger) (clientmap.ClientMap, err
 		GardenClient:           b.gardenClient,
 		SeedClient:             b.seedClient,
 		ClientConnectionConfig: *b.clientConnectionConfig,
+		ClientSetOptions:       b.clientSetOptions,
 	}), nil
 }
@@ -11,6 +11,8 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 )
 
 var _ = Describe("ShootClientMapBuilder", func() {
@@ -47,6 +49,15 @@ var _ = Describe("ShootClientMapBuilder", func() {
 		})
 	})
 
+	Describe("#WithClientSetOptions", func() {
+		It("should be correctly set by WithClientSetOptions", func() {
+			builder := NewShootClientMapBuilder().
+				WithClientSetOptions(kubernetes.WithUserAgentSuffix("foo")).
+				WithClientSetOptions(kubernetes.WithRateLimiterMetrics("bar"))
+			Expect(builder.clientSetOptions).To(HaveLen(2))
+		})
+	})
+
 	Describe("#Build", func() {
 		It("should fail if garden client was not set", func() {
 			clientMap, err := NewShootClientMapBuilder().Build(logr.Discard())
@@ -26,6 +26,17 @@ const waitForCacheSyncTimeout = 5 * time.Minute
 // after creating a new ClientSet before checking if it should be refreshed.
 var MaxRefreshInterval = 5 * time.Second
 
+var (
+	// HealthCheckInterval is the interval in which started ClientSets are health-checked if the ClientSetFactory
+	// implements HealthCheck.
+	HealthCheckInterval = time.Minute
+	// HealthCheckFailureThreshold is the number of consecutive failed health checks after which a ClientSet is
+	// invalidated.
+	HealthCheckFailureThreshold = 3
+)
+
+const healthCheckTimeout = 10 * time.Second
+
 // GenericClientMap is a generic implementation of clientmap.ClientMap, which can be used by specific ClientMap
 // implementations to reuse the core logic for storing, requesting, invalidating and starting ClientSets. Specific
 // implementations only need to provide a ClientSetFactory that can produce new ClientSets for the respective keys
@@ -92,6 +103,7 @@ func (cm *GenericClientMap) GetClient(ctx context.Context, key ClientSetKey) (ku
 
 				if hash != entry.hash {
 					cm.log.Info("Refreshing ClientSet due to changed ClientSetHash", "key", key.Key(), "oldHash", entry.hash, "newHash", hash)
+					recordInvalidation(invalidationReasonHashChanged)
 					return true, nil
 				}
 
@@ -175,8 +187,14 @@ func (cm *GenericClientMap) InvalidateClient(key ClientSetKey) error {
 	cm.lock.Lock()
 	defer cm.lock.Unlock()
 
+	return cm.invalidateClient(key, nil)
+}
+
+// invalidateClient removes the ClientSet identified by the given key from the ClientMap. If expectedEntry is set, the
+// ClientSet is only removed if it is still the one contained in the ClientMap. The caller must hold the lock.
+func (cm *GenericClientMap) invalidateClient(key ClientSetKey, expectedEntry *clientMapEntry) error {
 	entry, found := cm.clientSets[key]
-	if !found {
+	if !found || (expectedEntry != nil && entry != expectedEntry) {
 		return nil
 	}
 
@@ -237,6 +255,10 @@ func (cm *GenericClientMap) startClientSet(key ClientSetKey, entry *clientMapEnt
 
 	entry.clientSet.Start(clientSetContext)
 
+	if healthCheck, ok := cm.factory.(HealthCheck); ok {
+		go cm.healthCheckClientSet(clientSetContext, key, entry, healthCheck)
+	}
+
 	// limit the amount of time to wait for a cache sync, as this can block controller worker routines
 	// and we don't want to block all workers if it takes a long time to sync some caches
 	waitContext, cancel := context.WithTimeout(clientSetContext, waitForCacheSyncTimeout)
@@ -252,6 +274,64 @@ func (cm *GenericClientMap) startClientSet(key ClientSetKey, entry *clientMapEnt
 	return nil
 }
 
+// healthCheckClientSet periodically checks whether the ClientSetHash of the given entry has changed (e.g. because the
+// CA of the cluster was rotated) and whether the ClientSet can still reach its cluster. Stale ClientSets are invalidated
+// so that their connections are closed and the next call to GetClient creates a new ClientSet which resolves the
+// cluster's endpoint again. It returns when the given context is cancelled, i.e. when the entry is invalidated.
+func (cm *GenericClientMap) healthCheckClientSet(ctx context.Context, key ClientSetKey, entry *clientMapEntry, healthCheck HealthCheck) {
+	log := cm.log.WithValues("key", key.Key())
+
+	failures := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-cm.clock.After(HealthCheckInterval):
+		}
+
+		reason := func() string {
+			checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+
+			hash, err := cm.factory.CalculateClientSetHash(checkCtx, key)
+			if err != nil {
+				log.Error(err, "Failed to calculate new hash for ClientSet")
+			} else if hash != entry.hash {
+				log.Info("Invalidating ClientSet due to changed ClientSetHash", "oldHash", entry.hash, "newHash", hash)
+				return invalidationReasonHashChanged
+			}
+
+			if err := healthCheck.HealthCheckClient(checkCtx, key, entry.clientSet); err != nil {
+				failures++
+				log.Info("Health check of ClientSet failed", "failures", failures, "error", err.Error())
+				if failures >= HealthCheckFailureThreshold {
+					return invalidationReasonUnhealthy
+				}
+				return ""
+			}
+
+			failures = 0
+			return ""
+		}()
+
+		if reason == "" {
+			continue
+		}
+
+		if err := func() error {
+			cm.lock.Lock()
+			defer cm.lock.Unlock()
+			return cm.invalidateClient(key, entry)
+		}(); err != nil {
+			log.Error(err, "Failed invalidating stale ClientSet")
+			continue
+		}
+
+		recordInvalidation(reason)
+		return
+	}
+}
+
 func waitForClientSetCacheSync(ctx context.Context, entry *clientMapEntry) bool {
 	// don't need a lock here, as caller already holds lock
 	if entry.synced {
@@ -18,6 +18,7 @@ import (
 	testclock "k8s.io/utils/clock/testing"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
 	mockclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/mock"
@@ -230,6 +231,60 @@ var _ = Describe("GenericClientMap", func() {
 			})
 		})
 
+		Context("health checks", func() {
+			var (
+				healthCheckFactory *healthCheckingFactory
+				clientSetStopCh    <-chan struct{}
+			)
+
+			BeforeEach(func() {
+				healthCheckFactory = &healthCheckingFactory{MockClientSetFactory: factory}
+				cm = NewGenericClientMap(healthCheckFactory, logr.Discard(), fakeClock)
+				Expect(cm.Start(ctx)).To(Succeed())
+
+				factory.EXPECT().NewClientSet(ctx, key).Return(cs, "hash1", nil)
+				cs.EXPECT().Start(gomock.Any()).Do(func(ctx context.Context) {
+					clientSetStopCh = ctx.Done()
+				})
+				cs.EXPECT().WaitForCacheSync(gomock.Any()).Return(true)
+				Expect(cm.GetClient(ctx, key)).To(BeIdenticalTo(cs))
+			})
+
+			It("should invalidate the ClientSet when its hash has changed", func() {
+				factory.EXPECT().CalculateClientSetHash(gomock.Any(), key).Return("hash2", nil)
+
+				Eventually(fakeClock.HasWaiters).Should(BeTrue())
+				fakeClock.Step(HealthCheckInterval)
+
+				Eventually(clientSetStopCh).Should(BeClosed())
+			})
+
+			It("should invalidate the ClientSet when it repeatedly fails the health check", func() {
+				factory.EXPECT().CalculateClientSetHash(gomock.Any(), key).Return("hash1", nil).Times(HealthCheckFailureThreshold)
+				healthCheckFactory.err = errors.New("fake")
+
+				for range HealthCheckFailureThreshold {
+					Consistently(clientSetStopCh).ShouldNot(BeClosed())
+					Eventually(fakeClock.HasWaiters).Should(BeTrue())
+					fakeClock.Step(HealthCheckInterval)
+				}
+
+				Eventually(clientSetStopCh).Should(BeClosed())
+			})
+
+			It("should not invalidate a healthy ClientSet", func() {
+				factory.EXPECT().CalculateClientSetHash(gomock.Any(), key).Return("hash1", nil).Times(HealthCheckFailureThreshold + 1)
+
+				for range HealthCheckFailureThreshold + 1 {
+					Eventually(fakeClock.HasWaiters).Should(BeTrue())
+					fakeClock.Step(HealthCheckInterval)
+				}
+
+				Eventually(fakeClock.HasWaiters).Should(BeTrue())
+				Expect(clientSetStopCh).NotTo(BeClosed())
+			})
+		})
+
 		Context("#Start", func() {
 			It("should do nothing if the ClientMap is empty", func() {
 				Expect(cm.Start(ctx)).To(Succeed())
@@ -260,3 +315,12 @@ var _ = Describe("GenericClientMap", func() {
 		})
 	})
 })
+
+type healthCheckingFactory struct {
+	*mockclientmap.MockClientSetFactory
+	err error
+}
+
+func (f *healthCheckingFactory) HealthCheckClient(_ context.Context, _ ClientSetKey, _ kubernetes.Interface) error {
+	return f.err
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clientmap
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	invalidationReasonHashChanged = "hash_changed"
+	invalidationReasonUnhealthy   = "unhealthy"
+)
+
+var (
+	registerOnce = make(chan struct{})
+
+	staleClientSetInvalidations *prometheus.CounterVec
+)
+
+// RegisterMetrics registers the metrics for the ClientMaps on the passed registry.
+// This function can only be called once.
+// If this function is not called, no metrics are collected in this package.
+func RegisterMetrics(r prometheus.Registerer) {
+	close(registerOnce) // Metrics can only be registered once on a registry.
+
+	staleClientSetInvalidations = promauto.With(r).NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "clientmap",
+			Name:      "stale_clientset_invalidations_total",
+			Help:      "Number of ClientSets which were invalidated because they were stale. The value of the label 'reason' can either be 'hash_changed' or 'unhealthy'.",
+		},
+		[]string{
+			"reason",
+		},
+	)
+}
+
+func recordInvalidation(reason string) {
+	if staleClientSetInvalidations == nil {
+		return
+	}
+	staleClientSetInvalidations.WithLabelValues(reason).Inc()
+}
@@ -7,9 +7,13 @@ package clientmap
 import (
 	"context"
 	"fmt"
+	"net"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/connrotation"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +35,7 @@ type shootClientMap struct {
 func NewShootClientMap(log logr.Logger, factory *ShootClientSetFactory) ClientMap {
 	logger := log.WithValues("clientmap", "ShootClientMap")
 	factory.clientKeyToControlPlaneNamespace = make(map[ShootClientSetKey]string)
+	factory.clientKeyToDialer = make(map[ShootClientSetKey]*connrotation.Dialer)
 	factory.log = logger
 	return &shootClientMap{
 		ClientMap: NewGenericClientMap(factory, logger, clock.RealClock{}),
@@ -45,11 +50,20 @@ type ShootClientSetFactory struct {
 	SeedClient client.Client
 	// ClientConnectionConfiguration is the configuration that will be used by created ClientSets.
 	ClientConnectionConfig componentbaseconfigv1alpha1.ClientConnectionConfiguration
+	// ClientSetOptions are additional options applied to created ClientSets, e.g. a user agent suffix or rate limiter
+	// metrics.
+	ClientSetOptions []kubernetes.ConfigFunc
 
 	// log is a logger for logging entries related to creating Shoot ClientSets.
 	log logr.Logger
 
 	clientKeyToControlPlaneNamespace map[ShootClientSetKey]string
+	// clientKeyToDialer contains the dialers of the ClientSets, which are used to close their connections when they are
+	// invalidated. Otherwise, connections to an outdated endpoint of the shoot's kube-apiserver (e.g. after the SNI
+	// endpoint of the istio ingress gateway changed) could linger.
+	clientKeyToDialer map[ShootClientSetKey]*connrotation.Dialer
+	// lock guards concurrent access to clientKeyToDialer.
+	lock sync.Mutex
 }
 
 // CalculateClientSetHash calculates a SHA256 hash of the kubeconfig in the 'gardener' secret in the Shoot's control
@@ -80,15 +94,22 @@ func (f *ShootClientSetFactory) NewClientSet(ctx context.Context, k ClientSetKey
 		return nil, "", fmt.Errorf("token for shoot kubeconfig was not populated yet")
 	}
 
-	clientSet, err := NewClientFromSecretObject(kubeconfigSecret,
+	dialer := connrotation.NewDialer((&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext)
+
+	clientSet, err := NewClientFromSecretObject(kubeconfigSecret, append([]kubernetes.ConfigFunc{
 		kubernetes.WithClientConnectionOptions(f.ClientConnectionConfig),
 		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.ShootScheme}),
 		kubernetes.WithDisabledCachedClient(),
-	)
+		kubernetes.WithDialer(dialer.DialContext),
+	}, f.ClientSetOptions...)...)
 	if err != nil {
 		return nil, "", err
 	}
 
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.clientKeyToDialer[k.(ShootClientSetKey)] = dialer
+
 	return clientSet, hash, nil
 }
 
@@ -120,9 +141,24 @@ func (f *ShootClientSetFactory) InvalidateClient(k ClientSetKey) error {
 		return fmt.Errorf("unsupported ClientSetKey: expected %T got %T", ShootClientSetKey{}, k)
 	}
 	delete(f.clientKeyToControlPlaneNamespace, key)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if dialer, ok := f.clientKeyToDialer[key]; ok {
+		dialer.CloseAll()
+		delete(f.clientKeyToDialer, key)
+	}
+
 	return nil
 }
 
+var _ HealthCheck = &ShootClientSetFactory{}
+
+// HealthCheckClient checks whether the shoot's kube-apiserver is reachable and healthy with the given ClientSet.
+func (f *ShootClientSetFactory) HealthCheckClient(ctx context.Context, _ ClientSetKey, clientSet kubernetes.Interface) error {
+	return clientSet.RESTClient().Get().AbsPath("/healthz").Do(ctx).Error()
+}
+
 func (f *ShootClientSetFactory) controlPlaneNamespaceFromCache(key ShootClientSetKey) (string, error) {
 	namespace, ok := f.clientKeyToControlPlaneNamespace[key]
 	if !ok {
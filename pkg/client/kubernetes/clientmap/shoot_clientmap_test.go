@@ -138,7 +138,9 @@ var _ = Describe("ShootClientMap", func() {
 			NewClientFromSecretObject = func(secret *corev1.Secret, fns ...kubernetes.ConfigFunc) (kubernetes.Interface, error) {
 				Expect(secret.Namespace).To(Equal(shoot.Status.TechnicalID))
 				Expect(secret.Name).To(Equal("gardener-internal"))
-				Expect(fns).To(ConsistOfConfigFuncs(
+				// the last ConfigFunc sets the dialer of the ClientSet which can't be compared by value
+				Expect(fns).To(HaveLen(4))
+				Expect(fns[:3]).To(ConsistOfConfigFuncs(
 					kubernetes.WithClientConnectionOptions(clientConnectionConfig),
 					kubernetes.WithClientOptions(clientOptions),
 					kubernetes.WithDisabledCachedClient(),
@@ -193,7 +195,9 @@ var _ = Describe("ShootClientMap", func() {
 			NewClientFromSecretObject = func(secret *corev1.Secret, fns ...kubernetes.ConfigFunc) (kubernetes.Interface, error) {
 				Expect(secret.Namespace).To(Equal(shoot.Status.TechnicalID))
 				Expect(secret.Name).To(Equal("gardener-internal"))
-				Expect(fns).To(ConsistOfConfigFuncs(
+				// the last ConfigFunc sets the dialer of the ClientSet which can't be compared by value
+				Expect(fns).To(HaveLen(4))
+				Expect(fns[:3]).To(ConsistOfConfigFuncs(
 					kubernetes.WithClientConnectionOptions(clientConnectionConfig),
 					kubernetes.WithClientOptions(clientOptions),
 					kubernetes.WithDisabledCachedClient(),
@@ -211,6 +215,46 @@ var _ = Describe("ShootClientMap", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cs).To(BeIdenticalTo(fakeCS))
 		})
+
+		It("should apply the additional ClientSet options", func() {
+			factory.ClientSetOptions = []kubernetes.ConfigFunc{
+				kubernetes.WithUserAgentSuffix("shoot-client"),
+				kubernetes.WithRateLimiterMetrics("shoot"),
+			}
+
+			fakeCS := fakekubernetes.NewClientSet()
+			gomock.InOrder(
+				mockGardenClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: shoot.Name}, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+						shoot.DeepCopyInto(obj.(*gardencorev1beta1.Shoot))
+						return nil
+					}),
+				mockSeedClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: shoot.Status.TechnicalID, Name: "gardener-internal"}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+					DoAndReturn(func(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+						(&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      key.Name,
+								Namespace: key.Namespace,
+							},
+							Data: dataWithPopulatedToken(),
+						}).DeepCopyInto(obj.(*corev1.Secret))
+						return nil
+					}),
+			)
+
+			NewClientFromSecretObject = func(_ *corev1.Secret, fns ...kubernetes.ConfigFunc) (kubernetes.Interface, error) {
+				Expect(fns).To(HaveLen(6))
+				Expect(fns[4:]).To(ConsistOfConfigFuncs(
+					kubernetes.WithUserAgentSuffix("shoot-client"),
+					kubernetes.WithRateLimiterMetrics("shoot"),
+				))
+				return fakeCS, nil
+			}
+
+			cs, err := cm.GetClient(ctx, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cs).To(BeIdenticalTo(fakeCS))
+		})
 	})
 
 	Context("#CalculateClientSetHash", func() {
@@ -16,6 +16,14 @@ type Invalidate interface {
 	InvalidateClient(key ClientSetKey) error
 }
 
+// HealthCheck is an interface implemented by ClientSetFactories whose ClientSets should be health-checked periodically
+// after the ClientMap has been started. ClientSets which fail the health check repeatedly or whose ClientSetHash has
+// changed are invalidated, so that they are recreated with fresh connections on the next call to GetClient.
+type HealthCheck interface {
+	// HealthCheckClient checks whether the given ClientSet can still reach its cluster.
+	HealthCheckClient(ctx context.Context, key ClientSetKey, clientSet kubernetes.Interface) error
+}
+
 // A ClientMap is a collection of kubernetes ClientSets, which can be used to dynamically create and lookup different
 // ClientSets during runtime. ClientSets are identified by a ClientSetKey, which can have different forms, as there
 // are different kinds of ClientMaps (for example one for Seed clients and one for Shoot clients).
@@ -5,7 +5,10 @@
 package kubernetes
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net"
 	"time"
 
 	"k8s.io/client-go/rest"
@@ -28,6 +31,10 @@ type Config struct {
 	disableCache      bool
 	allowedUserFields []string
 	clientConfig      clientcmd.ClientConfig
+
+	userAgentSuffix        string
+	requestPriority        RequestPriority
+	rateLimiterMetricsName string
 }
 
 // NewConfig returns a new Config with an empty REST config to allow testing ConfigFuncs without exporting
@@ -86,6 +93,19 @@ func WithClientConnectionOptions(cfg componentbaseconfigv1alpha1.ClientConnectio
 	}
 }
 
+// WithDialer returns a ConfigFunc that sets the passed dial function on the REST config. Setting a custom dial function
+// opts the client set out of client-go's shared transport cache, so that it uses its own connections which can be closed
+// independently of other client sets (e.g. via a `connrotation.Dialer`).
+func WithDialer(dial func(ctx context.Context, network, address string) (net.Conn, error)) ConfigFunc {
+	return func(config *Config) error {
+		if config.restConfig == nil {
+			return errors.New("REST config must be set before setting the dialer")
+		}
+		config.restConfig.Dial = dial
+		return nil
+	}
+}
+
 // WithClientOptions returns a ConfigFunc that sets the passed Options on the Config object.
 func WithClientOptions(opt client.Options) ConfigFunc {
 	return func(config *Config) error {
@@ -134,3 +154,40 @@ func WithClientConfig(clientConfig clientcmd.ClientConfig) ConfigFunc {
 		return nil
 	}
 }
+
+// WithUserAgentSuffix returns a ConfigFunc that appends the given suffix, e.g. the name of the controller using the
+// client set, to the user agent of all requests. This allows attributing requests to controllers in the audit logs of
+// the API server. Note that the user agent does not influence the API server's priority and fairness, as flow schemas
+// only match on the requesting user and the requested resources.
+func WithUserAgentSuffix(suffix string) ConfigFunc {
+	return func(config *Config) error {
+		config.userAgentSuffix = suffix
+		return nil
+	}
+}
+
+// WithRequestPriority returns a ConfigFunc that bounds the client-side QPS and burst of the client set to the share of
+// the REST config's QPS and burst granted by the given priority. The priority is only applied on the client side, i.e.,
+// it is not sent to the API server and does not influence the flow schema its priority and fairness assigns.
+func WithRequestPriority(priority RequestPriority) ConfigFunc {
+	return func(config *Config) error {
+		if _, ok := requestPriorityShares[priority]; !ok {
+			return fmt.Errorf("unsupported request priority %q", priority)
+		}
+		config.requestPriority = priority
+		return nil
+	}
+}
+
+// WithRateLimiterMetrics returns a ConfigFunc that records metrics about the client-side rate limiting and the flow
+// schemas assigned by the API server's priority and fairness for all requests of the client set under the given name.
+// The metrics are only collected if RegisterMetrics was called.
+func WithRateLimiterMetrics(name string) ConfigFunc {
+	return func(config *Config) error {
+		if name == "" {
+			return errors.New("name for rate limiter metrics must not be empty")
+		}
+		config.rateLimiterMetricsName = name
+		return nil
+	}
+}
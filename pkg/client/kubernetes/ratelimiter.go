@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// RequestPriority is the client-side priority of the requests of a client set. It determines the share of the QPS and
+// burst of the REST config which is granted to the client set.
+type RequestPriority string
+
+const (
+	// RequestPriorityHigh grants the full QPS and burst of the REST config to the client set.
+	RequestPriorityHigh RequestPriority = "high"
+	// RequestPriorityNormal grants half of the QPS and burst of the REST config to the client set.
+	RequestPriorityNormal RequestPriority = "normal"
+	// RequestPriorityLow grants a fifth of the QPS and burst of the REST config to the client set.
+	RequestPriorityLow RequestPriority = "low"
+)
+
+var requestPriorityShares = map[RequestPriority]float32{
+	RequestPriorityHigh:   1,
+	RequestPriorityNormal: 0.5,
+	RequestPriorityLow:    0.2,
+}
+
+const clientMetricsNamespace = "gardener_client"
+
+var (
+	registerClientMetricsOnce = make(chan struct{})
+
+	rateLimiterWaitSeconds *prometheus.HistogramVec
+	requestsTotal          *prometheus.CounterVec
+)
+
+// RegisterMetrics registers the metrics of client sets configured with WithRateLimiterMetrics on the passed registry.
+// This function can only be called once.
+// If this function is not called, no metrics are collected in this package.
+func RegisterMetrics(r prometheus.Registerer) {
+	close(registerClientMetricsOnce) // Metrics can only be registered once on a registry.
+
+	factory := promauto.With(r)
+
+	rateLimiterWaitSeconds = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: clientMetricsNamespace,
+			Name:      "rate_limiter_wait_seconds",
+			Help:      "Duration requests of a client set waited for the client-side rate limiter.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+		},
+		[]string{
+			"client",
+		},
+	)
+
+	requestsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: clientMetricsNamespace,
+			Name:      "requests_total",
+			Help:      "Requests of a client set by the UIDs of the flow schema and priority level which the API server's priority and fairness assigned to them.",
+		},
+		[]string{
+			"client",
+			"flow_schema_uid",
+			"priority_level_uid",
+		},
+	)
+}
+
+// NewMeteredRateLimiter returns a rate limiter which records the time waited for the given rate limiter in the
+// metrics of the client with the given name.
+func NewMeteredRateLimiter(name string, rateLimiter flowcontrol.RateLimiter) flowcontrol.RateLimiter {
+	return &meteredRateLimiter{RateLimiter: rateLimiter, name: name}
+}
+
+type meteredRateLimiter struct {
+	flowcontrol.RateLimiter
+	name string
+}
+
+func (m *meteredRateLimiter) Accept() {
+	defer m.observe(time.Now())
+	m.RateLimiter.Accept()
+}
+
+func (m *meteredRateLimiter) Wait(ctx context.Context) error {
+	defer m.observe(time.Now())
+	return m.RateLimiter.Wait(ctx)
+}
+
+func (m *meteredRateLimiter) observe(start time.Time) {
+	if rateLimiterWaitSeconds == nil {
+		return
+	}
+	rateLimiterWaitSeconds.WithLabelValues(m.name).Observe(time.Since(start).Seconds())
+}
+
+// flowSchemaRecorder records the flow schema and priority level which the API server's priority and fairness assigned
+// to the requests of a client set. The API server returns their UIDs in the response headers.
+type flowSchemaRecorder struct {
+	delegate http.RoundTripper
+	name     string
+}
+
+func (f *flowSchemaRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := f.delegate.RoundTrip(req)
+	if err != nil || requestsTotal == nil {
+		return resp, err
+	}
+
+	requestsTotal.WithLabelValues(
+		f.name,
+		resp.Header.Get(flowcontrolv1.ResponseHeaderMatchedFlowSchemaUID),
+		resp.Header.Get(flowcontrolv1.ResponseHeaderMatchedPriorityLevelConfigurationUID),
+	).Inc()
+
+	return resp, nil
+}
+
+// ApplyRequestOptions returns the given REST config with the user agent suffix, the request priority and the rate
+// limiter metrics configured by the given ConfigFuncs applied. The given REST config is not modified. It allows using
+// these options for clients which are not created via NewWithConfig, e.g., the clients of controller-runtime clusters.
+// All other options are ignored.
+func ApplyRequestOptions(restConfig *rest.Config, fns ...ConfigFunc) (*rest.Config, error) {
+	conf := &Config{restConfig: restConfig}
+	for _, f := range fns {
+		if err := f(conf); err != nil {
+			return nil, err
+		}
+	}
+
+	return applyRequestOptions(conf), nil
+}
+
+// applyRequestOptions returns a copy of the REST config of the given Config with the user agent suffix, the request
+// priority and the rate limiter metrics applied. The REST config is returned as is if none of them is configured.
+func applyRequestOptions(conf *Config) *rest.Config {
+	if conf.userAgentSuffix == "" && conf.requestPriority == "" && conf.rateLimiterMetricsName == "" {
+		return conf.restConfig
+	}
+
+	restConfig := rest.CopyConfig(conf.restConfig)
+
+	if conf.userAgentSuffix != "" {
+		userAgent := restConfig.UserAgent
+		if userAgent == "" {
+			userAgent = rest.DefaultKubernetesUserAgent()
+		}
+		restConfig.UserAgent = userAgent + " " + conf.userAgentSuffix
+	}
+
+	if share, ok := requestPriorityShares[conf.requestPriority]; ok && restConfig.RateLimiter == nil && restConfig.QPS >= 0 {
+		qps, burst := qpsAndBurst(restConfig)
+		restConfig.QPS = qps * share
+		restConfig.Burst = max(1, int(float32(burst)*share))
+	}
+
+	if conf.rateLimiterMetricsName != "" {
+		// Share a single rate limiter between all clients of the client set, so that the waiting time of all requests is
+		// recorded.
+		rateLimiter := restConfig.RateLimiter
+		if rateLimiter == nil && restConfig.QPS >= 0 {
+			rateLimiter = flowcontrol.NewTokenBucketRateLimiter(qpsAndBurst(restConfig))
+		}
+		if rateLimiter != nil {
+			restConfig.RateLimiter = NewMeteredRateLimiter(conf.rateLimiterMetricsName, rateLimiter)
+		}
+
+		restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return &flowSchemaRecorder{delegate: rt, name: conf.rateLimiterMetricsName}
+		})
+	}
+
+	return restConfig
+}
+
+// qpsAndBurst returns the QPS and burst of the given REST config with the defaults of client-go applied.
+func qpsAndBurst(restConfig *rest.Config) (float32, int) {
+	qps, burst := restConfig.QPS, restConfig.Burst
+	if qps == 0 {
+		qps = rest.DefaultQPS
+	}
+	if burst == 0 {
+		burst = rest.DefaultBurst
+	}
+	return qps, burst
+}
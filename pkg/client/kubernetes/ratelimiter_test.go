@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var registry = prometheus.NewRegistry()
+
+var _ = BeforeSuite(func() {
+	kubernetes.RegisterMetrics(registry)
+})
+
+var _ = Describe("RateLimiter", func() {
+	Describe("#NewMeteredRateLimiter", func() {
+		It("should record the waiting time of the rate limiter", func() {
+			rateLimiter := kubernetes.NewMeteredRateLimiter("test", flowcontrol.NewTokenBucketRateLimiter(100, 10))
+
+			Expect(rateLimiter.Wait(context.Background())).To(Succeed())
+			rateLimiter.Accept()
+
+			Expect(testutil.CollectAndCount(registry, "gardener_client_rate_limiter_wait_seconds")).To(Equal(1))
+			Expect(rateLimiter.QPS()).To(Equal(float32(100)))
+		})
+	})
+
+	Describe("#ApplyRequestOptions", func() {
+		var restConfig *rest.Config
+
+		BeforeEach(func() {
+			restConfig = &rest.Config{Host: "https://foo", UserAgent: "gardenlet", QPS: 100, Burst: 130}
+		})
+
+		It("should return the REST config as is if no request option is configured", func() {
+			Expect(kubernetes.ApplyRequestOptions(restConfig, kubernetes.WithAllowedUserFields([]string{"token"}))).To(BeIdenticalTo(restConfig))
+		})
+
+		It("should append the user agent suffix without modifying the given REST config", func() {
+			config, err := kubernetes.ApplyRequestOptions(restConfig, kubernetes.WithUserAgentSuffix("shoot-client"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.UserAgent).To(Equal("gardenlet shoot-client"))
+			Expect(restConfig.UserAgent).To(Equal("gardenlet"))
+		})
+
+		It("should append the user agent suffix to the default user agent", func() {
+			restConfig.UserAgent = ""
+
+			config, err := kubernetes.ApplyRequestOptions(restConfig, kubernetes.WithUserAgentSuffix("shoot-client"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.UserAgent).To(Equal(rest.DefaultKubernetesUserAgent() + " shoot-client"))
+		})
+
+		It("should grant the share of the QPS and burst of the request priority", func() {
+			config, err := kubernetes.ApplyRequestOptions(restConfig, kubernetes.WithRequestPriority(kubernetes.RequestPriorityLow))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.QPS).To(BeNumerically("~", 20, 0.001))
+			Expect(config.Burst).To(Equal(26))
+			Expect(restConfig.QPS).To(Equal(float32(100)))
+		})
+
+		It("should grant the share of the default QPS and burst of client-go if none is configured", func() {
+			restConfig.QPS, restConfig.Burst = 0, 0
+
+			config, err := kubernetes.ApplyRequestOptions(restConfig, kubernetes.WithRequestPriority(kubernetes.RequestPriorityNormal))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.QPS).To(Equal(rest.DefaultQPS / 2))
+			Expect(config.Burst).To(Equal(rest.DefaultBurst / 2))
+		})
+
+		It("should not change the QPS and burst if a rate limiter is configured", func() {
+			rateLimiter := flowcontrol.NewTokenBucketRateLimiter(10, 20)
+			restConfig.RateLimiter = rateLimiter
+
+			config, err := kubernetes.ApplyRequestOptions(restConfig, kubernetes.WithRequestPriority(kubernetes.RequestPriorityLow))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.QPS).To(Equal(float32(100)))
+			Expect(config.Burst).To(Equal(130))
+			Expect(config.RateLimiter).To(BeIdenticalTo(rateLimiter))
+		})
+
+		It("should configure a metered rate limiter with the granted QPS and burst", func() {
+			config, err := kubernetes.ApplyRequestOptions(restConfig,
+				kubernetes.WithRequestPriority(kubernetes.RequestPriorityNormal),
+				kubernetes.WithRateLimiterMetrics("test"),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.RateLimiter).NotTo(BeNil())
+			Expect(config.RateLimiter.QPS()).To(Equal(float32(50)))
+			Expect(config.WrapTransport).NotTo(BeNil())
+			Expect(restConfig.RateLimiter).To(BeNil())
+			Expect(restConfig.WrapTransport).To(BeNil())
+		})
+
+		It("should record the flow schema and priority level assigned to the requests", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set(flowcontrolv1.ResponseHeaderMatchedFlowSchemaUID, "flow-schema")
+				w.Header().Set(flowcontrolv1.ResponseHeaderMatchedPriorityLevelConfigurationUID, "priority-level")
+			}))
+			DeferCleanup(server.Close)
+			restConfig.Host = server.URL
+
+			config, err := kubernetes.ApplyRequestOptions(restConfig, kubernetes.WithRateLimiterMetrics("recorder"))
+			Expect(err).NotTo(HaveOccurred())
+			httpClient, err := rest.HTTPClientFor(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := httpClient.Get(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+
+			Expect(testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP gardener_client_requests_total Requests of a client set by the UIDs of the flow schema and priority level which the API server's priority and fairness assigned to them.
+# TYPE gardener_client_requests_total counter
+gardener_client_requests_total{client="recorder",flow_schema_uid="flow-schema",priority_level_uid="priority-level"} 1
+`), "gardener_client_requests_total")).To(Succeed())
+		})
+
+		It("should wrap a configured rate limiter", func() {
+			restConfig.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(10, 20)
+
+			config, err := kubernetes.ApplyRequestOptions(restConfig, kubernetes.WithRateLimiterMetrics("test"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.RateLimiter).NotTo(BeIdenticalTo(restConfig.RateLimiter))
+			Expect(config.RateLimiter.QPS()).To(Equal(float32(10)))
+		})
+
+		It("should return an error if an option is invalid", func() {
+			_, err := kubernetes.ApplyRequestOptions(restConfig, kubernetes.WithRequestPriority("urgent"))
+			Expect(err).To(MatchError(`unsupported request priority "urgent"`))
+		})
+	})
+
+	Describe("#WithRequestPriority", func() {
+		It("should accept a known priority", func() {
+			Expect(kubernetes.WithRequestPriority(kubernetes.RequestPriorityLow)(kubernetes.NewConfig())).To(Succeed())
+		})
+
+		It("should reject an unknown priority", func() {
+			Expect(kubernetes.WithRequestPriority("urgent")(kubernetes.NewConfig())).To(MatchError(`unsupported request priority "urgent"`))
+		})
+	})
+
+	Describe("#WithRateLimiterMetrics", func() {
+		It("should reject an empty name", func() {
+			Expect(kubernetes.WithRateLimiterMetrics("")(kubernetes.NewConfig())).To(MatchError("name for rate limiter metrics must not be empty"))
+		})
+	})
+})
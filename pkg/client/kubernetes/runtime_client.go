@@ -88,8 +88,9 @@ func AggregatorCacheFunc(newCache cache.NewCacheFunc, typeToNewCache map[client.
 	}
 }
 
-// SingleObjectCacheFunc returns a cache.NewCacheFunc for the SingleObject implementation.
-func SingleObjectCacheFunc(log logr.Logger, scheme *runtime.Scheme, obj client.Object) cache.NewCacheFunc {
+// SingleObjectCacheFunc returns a cache.NewCacheFunc for the SingleObject implementation. The caches for individual
+// objects are closed when they were not accessed for the given max idle time.
+func SingleObjectCacheFunc(log logr.Logger, scheme *runtime.Scheme, obj client.Object, maxIdleTime time.Duration) cache.NewCacheFunc {
 	return func(restConfig *rest.Config, options cache.Options) (cache.Cache, error) {
 		gvk, err := apiutil.GVKForObject(obj, scheme)
 		if err != nil {
@@ -100,6 +101,6 @@ func SingleObjectCacheFunc(log logr.Logger, scheme *runtime.Scheme, obj client.O
 			WithName("single-object-cache").
 			WithValues("groupVersion", gvk.GroupVersion().String(), "kind", gvk.Kind)
 
-		return kubernetescache.NewSingleObject(logger, restConfig, cache.New, options, gvk, clock.RealClock{}, 10*time.Minute, time.Minute), nil
+		return kubernetescache.NewSingleObject(logger, restConfig, cache.New, options, gvk, clock.RealClock{}, maxIdleTime, time.Minute), nil
 	}
 }
@@ -10,6 +10,7 @@ import (
 	victoriametricsv1 "github.com/VictoriaMetrics/operator/api/operator/v1"
 	victoriametricsv1beta1 "github.com/VictoriaMetrics/operator/api/operator/v1beta1"
 	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2"
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
 	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
@@ -121,6 +122,7 @@ var (
 		opentelemetryv1beta1.AddToScheme,
 		victoriametricsv1beta1.AddToScheme,
 		victoriametricsv1.AddToScheme,
+		certv1alpha1.AddToScheme,
 	)
 
 	shootSchemeBuilder = runtime.NewSchemeBuilder(
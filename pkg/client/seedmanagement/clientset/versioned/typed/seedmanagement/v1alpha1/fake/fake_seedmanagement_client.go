@@ -28,6 +28,10 @@ func (c *FakeSeedmanagementV1alpha1) ManagedSeedSets(namespace string) v1alpha1.
 	return newFakeManagedSeedSets(c, namespace)
 }
 
+func (c *FakeSeedmanagementV1alpha1) SeedTemplates() v1alpha1.SeedTemplateInterface {
+	return newFakeSeedTemplates(c)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSeedmanagementV1alpha1) RESTClient() rest.Interface {
@@ -11,3 +11,5 @@ type GardenletExpansion interface{}
 type ManagedSeedExpansion interface{}
 
 type ManagedSeedSetExpansion interface{}
+
+type SeedTemplateExpansion interface{}
@@ -19,6 +19,7 @@ type SeedmanagementV1alpha1Interface interface {
 	GardenletsGetter
 	ManagedSeedsGetter
 	ManagedSeedSetsGetter
+	SeedTemplatesGetter
 }
 
 // SeedmanagementV1alpha1Client is used to interact with features provided by the seedmanagement.gardener.cloud group.
@@ -38,6 +39,10 @@ func (c *SeedmanagementV1alpha1Client) ManagedSeedSets(namespace string) Managed
 	return newManagedSeedSets(c, namespace)
 }
 
+func (c *SeedmanagementV1alpha1Client) SeedTemplates() SeedTemplateInterface {
+	return newSeedTemplates(c)
+}
+
 // NewForConfig creates a new SeedmanagementV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -47,6 +47,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Seedmanagement().V1alpha1().ManagedSeeds().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("managedseedsets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Seedmanagement().V1alpha1().ManagedSeedSets().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("seedtemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Seedmanagement().V1alpha1().SeedTemplates().Informer()}, nil
 
 	}
 
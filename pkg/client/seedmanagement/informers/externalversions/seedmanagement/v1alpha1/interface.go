@@ -18,6 +18,8 @@ type Interface interface {
 	ManagedSeeds() ManagedSeedInformer
 	// ManagedSeedSets returns a ManagedSeedSetInformer.
 	ManagedSeedSets() ManagedSeedSetInformer
+	// SeedTemplates returns a SeedTemplateInformer.
+	SeedTemplates() SeedTemplateInformer
 }
 
 type version struct {
@@ -45,3 +47,8 @@ func (v *version) ManagedSeeds() ManagedSeedInformer {
 func (v *version) ManagedSeedSets() ManagedSeedSetInformer {
 	return &managedSeedSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// SeedTemplates returns a SeedTemplateInformer.
+func (v *version) SeedTemplates() SeedTemplateInformer {
+	return &seedTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
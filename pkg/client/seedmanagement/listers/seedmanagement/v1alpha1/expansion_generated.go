@@ -29,3 +29,7 @@ type ManagedSeedSetListerExpansion interface{}
 // ManagedSeedSetNamespaceListerExpansion allows custom methods to be added to
 // ManagedSeedSetNamespaceLister.
 type ManagedSeedSetNamespaceListerExpansion interface{}
+
+// SeedTemplateListerExpansion allows custom methods to be added to
+// SeedTemplateLister.
+type SeedTemplateListerExpansion interface{}
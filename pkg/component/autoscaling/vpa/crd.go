@@ -12,7 +12,6 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/crddeployer"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 )
@@ -34,21 +33,21 @@ func init() {
 }
 
 type vpaCRD struct {
-	component.DeployWaiter
+	crddeployer.Interface
 
 	registry *managedresources.Registry
 }
 
 // NewCRD can be used to deploy the CRD definitions for the Kubernetes Vertical Pod Autoscaler.
-func NewCRD(client client.Client, registry *managedresources.Registry) (component.DeployWaiter, error) {
-	crdDeployer, err := crddeployer.New(client, slices.Sorted(maps.Values(crdResources)), false)
+func NewCRD(client client.Client, registry *managedresources.Registry, opts ...crddeployer.Option) (crddeployer.Interface, error) {
+	crdDeployer, err := crddeployer.New(client, slices.Sorted(maps.Values(crdResources)), false, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	return &vpaCRD{
-		DeployWaiter: crdDeployer,
-		registry:     registry,
+		Interface: crdDeployer,
+		registry:  registry,
 	}, nil
 }
 
@@ -60,7 +59,7 @@ func (v *vpaCRD) Deploy(ctx context.Context) error {
 		}
 		return nil
 	} else {
-		return v.DeployWaiter.Deploy(ctx)
+		return v.Interface.Deploy(ctx)
 	}
 }
 
@@ -70,6 +69,6 @@ func (v *vpaCRD) Destroy(ctx context.Context) error {
 		// as the actual deployment happens in another component.
 		return nil
 	} else {
-		return v.DeployWaiter.Destroy(ctx)
+		return v.Interface.Destroy(ctx)
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -22,15 +23,34 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
+// Interface is a DeployWaiter for CRDs which additionally exposes the desired CRDs.
+type Interface interface {
+	component.DeployWaiter
+	// CustomResourceDefinitions returns the desired CRDs, sorted by name.
+	CustomResourceDefinitions() []*apiextensionsv1.CustomResourceDefinition
+}
+
+// Option is a function that configures the CRD deployer.
+type Option func(*crdDeployer)
+
+// CreateOnly configures the CRD deployer to only create CRDs which do not exist yet. Existing CRDs are left untouched,
+// e.g., because their lifecycle is managed by a different controller after they have been bootstrapped.
+func CreateOnly() Option {
+	return func(c *crdDeployer) {
+		c.createOnly = true
+	}
+}
+
 // crdDeployer is a DeployWaiter that can deploy CRDs and wait for them to be ready.
 type crdDeployer struct {
 	client             client.Client
 	crdNameToCRD       map[string]*apiextensionsv1.CustomResourceDefinition
 	deletionProtection bool
+	createOnly         bool
 }
 
 // New returns a new instance of DeployWaiter for CRDs.
-func New(client client.Client, manifests []string, deletionProtection bool) (component.DeployWaiter, error) {
+func New(client client.Client, manifests []string, deletionProtection bool, opts ...Option) (Interface, error) {
 	// Split manifests into individual object manifests, in case multiple CRDs are provided in a single string.
 	var splitManifests []string
 	for _, manifest := range manifests {
@@ -42,11 +62,34 @@ func New(client client.Client, manifests []string, deletionProtection bool) (com
 		return nil, err
 	}
 
-	return &crdDeployer{
+	c := &crdDeployer{
 		client:             client,
 		crdNameToCRD:       crdNameToCRD,
 		deletionProtection: deletionProtection,
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// CustomResourceDefinitions returns the desired CRDs, sorted by name.
+func (c *crdDeployer) CustomResourceDefinitions() []*apiextensionsv1.CustomResourceDefinition {
+	out := make([]*apiextensionsv1.CustomResourceDefinition, 0, len(c.crdNameToCRD))
+	for _, name := range slices.Sorted(maps.Keys(c.crdNameToCRD)) {
+		out = append(out, c.desiredCRD(c.crdNameToCRD[name]))
+	}
+	return out
+}
+
+func (c *crdDeployer) desiredCRD(crd *apiextensionsv1.CustomResourceDefinition) *apiextensionsv1.CustomResourceDefinition {
+	desired := crd.DeepCopy()
+	if c.deletionProtection {
+		metav1.SetMetaDataLabel(&desired.ObjectMeta, gardenerutils.DeletionProtected, "true")
+	}
+	return desired
 }
 
 // Deploy deploys the CRDs.
@@ -61,6 +104,13 @@ func (c *crdDeployer) Deploy(ctx context.Context) error {
 				},
 			}
 
+			if c.createOnly {
+				if err := c.client.Create(ctx, c.desiredCRD(desiredCRD)); err != nil && !apierrors.IsAlreadyExists(err) {
+					return err
+				}
+				return nil
+			}
+
 			_, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, crd,
 				func() error {
 					crd.Labels = desiredCRD.Labels
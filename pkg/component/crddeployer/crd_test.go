@@ -178,6 +178,64 @@ spec:
 		})
 	})
 
+	Describe("#Deploy with CreateOnly option", func() {
+		It("should create missing CRDs but not update existing ones", func() {
+			crdDeployer, err := New(testClient, []string{crd3}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crdDeployer.Deploy(ctx)).To(Succeed())
+
+			crd3Updated := `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: ` + crd3Name + `
+spec:
+  group: gardener.cloud
+  names:
+    kind: foo
+  scope: Namespaced
+  versions:
+  - name: v1beta1
+    served: true
+    storage: true
+`
+
+			crdDeployer, err = New(testClient, []string{crd1, crd3Updated}, true, CreateOnly())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crdDeployer.Deploy(ctx)).To(Succeed())
+
+			actualCRD := &apiextensionsv1.CustomResourceDefinition{}
+			Expect(testClient.Get(ctx, client.ObjectKey{Name: crd1Name}, actualCRD)).To(Succeed())
+			Expect(actualCRD.Labels).To(HaveKeyWithValue("gardener.cloud/deletion-protected", "true"))
+
+			Expect(testClient.Get(ctx, client.ObjectKey{Name: crd3Name}, actualCRD)).To(Succeed())
+			Expect(actualCRD.Labels).NotTo(HaveKey("gardener.cloud/deletion-protected"))
+			Expect(actualCRD.Spec.Versions).To(HaveLen(1))
+			Expect(actualCRD.Spec.Versions[0].Name).To(Equal("v1alpha1"))
+		})
+	})
+
+	Describe("#CustomResourceDefinitions", func() {
+		It("should return the desired CRDs sorted by name", func() {
+			crdDeployer, err := New(testClient, []string{crd2, crd1}, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			crds := crdDeployer.CustomResourceDefinitions()
+			Expect(crds).To(HaveLen(2))
+			Expect(crds[0].Name).To(Equal(crd1Name))
+			Expect(crds[0].Labels).To(BeEmpty())
+			Expect(crds[1].Name).To(Equal("yourresources.mygroup.example.com"))
+		})
+
+		It("should add the deletion protection label if configured", func() {
+			crdDeployer, err := New(testClient, []string{crd1}, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(crdDeployer.CustomResourceDefinitions()).To(ConsistOf(
+				HaveField("ObjectMeta.Labels", HaveKeyWithValue("gardener.cloud/deletion-protected", "true")),
+			))
+		})
+	})
+
 	Describe("#Destroy", func() {
 		It("should destroy a CRD", func() {
 			actualCRD := &apiextensionsv1.CustomResourceDefinition{}
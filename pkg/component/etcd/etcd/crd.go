@@ -13,15 +13,14 @@ import (
 	druidcorecrds "github.com/gardener/etcd-druid/api/core/v1alpha1/crds"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/crddeployer"
 )
 
 // NewCRD can be used to deploy the CRD definitions for all CRDs defined by etcd-druid.
-func NewCRD(client client.Client, k8sVersion *semver.Version) (component.DeployWaiter, error) {
+func NewCRD(client client.Client, k8sVersion *semver.Version, opts ...crddeployer.Option) (crddeployer.Interface, error) {
 	crdYAMLs, err := druidcorecrds.GetAll(k8sVersion.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get etcd-druid CRDs for Kubernetes version %s: %w", k8sVersion, err)
 	}
-	return crddeployer.New(client, slices.Collect(maps.Values(crdYAMLs)), true)
+	return crddeployer.New(client, slices.Collect(maps.Values(crdYAMLs)), true, opts...)
 }
@@ -154,6 +154,7 @@ type Values struct {
 	Replicas                    *int32
 	StorageCapacity             string
 	StorageClassName            *string
+	Quota                       *resource.Quantity
 	DefragmentationSchedule     *string
 	CARotationPhase             gardencorev1beta1.CredentialsRotationPhase
 	Autoscaling                 AutoscalingConfig
@@ -376,7 +377,7 @@ func (e *etcd) Deploy(ctx context.Context) error {
 			WrapperPort:             ptr.To(e.defaultPortOrEtcdEventsStaticPodPort(etcdconstants.PortEtcdWrapper, etcdconstants.StaticPodPortEtcdEventsWrapper)),
 			Metrics:                 &metrics,
 			DefragmentationSchedule: e.computeDefragmentationSchedule(existingEtcd),
-			Quota:                   ptr.To(resource.MustParse("8Gi")),
+			Quota:                   ptr.To(ptr.Deref(e.values.Quota, resource.MustParse("8Gi"))),
 			ClientService: &druidcorev1alpha1.ClientService{
 				Annotations:         clientService.Annotations,
 				Labels:              clientService.Labels,
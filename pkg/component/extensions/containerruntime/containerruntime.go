@@ -7,9 +7,12 @@ package containerruntime
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
+	nodev1 "k8s.io/api/node/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,10 +20,12 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
 )
 
 const (
@@ -31,6 +36,10 @@ const (
 	// DefaultTimeout is the default timeout and defines how long Gardener should wait
 	// for a successful reconciliation of a containerruntime resource.
 	DefaultTimeout = 3 * time.Minute
+
+	// ManagedResourceNameRuntimeClasses is the name of the ManagedResource containing the RuntimeClasses for the
+	// container runtimes.
+	ManagedResourceNameRuntimeClasses = "shoot-core-runtimeclasses"
 )
 
 // TimeNow returns the current time. Exposed for testing.
@@ -43,6 +52,9 @@ type Interface interface {
 	DeleteStaleResources(ctx context.Context) error
 	// WaitCleanupStaleResources waits until all unused ContainerRuntime resources are cleaned up.
 	WaitCleanupStaleResources(ctx context.Context) error
+	// DeployRuntimeClasses deploys a RuntimeClass into the shoot cluster for each container runtime type whose
+	// ContainerRuntime resources report a runtime handler.
+	DeployRuntimeClasses(ctx context.Context) error
 }
 
 // Values contains the values used to create a ContainerRuntime resources.
@@ -213,6 +225,58 @@ func (c *containerRuntime) waitCleanup(ctx context.Context, wantedContainerRunti
 	)
 }
 
+// DeployRuntimeClasses deploys a RuntimeClass into the shoot cluster for each container runtime type whose
+// ContainerRuntime resources report a runtime handler. The RuntimeClasses select the nodes of all worker pools the
+// container runtime is enabled for. If no runtime handler is reported, the RuntimeClasses are removed.
+func (c *containerRuntime) DeployRuntimeClasses(ctx context.Context) error {
+	containerRuntimeList := &extensionsv1alpha1.ContainerRuntimeList{}
+	if err := c.client.List(ctx, containerRuntimeList, client.InNamespace(c.values.Namespace)); err != nil {
+		return fmt.Errorf("failed listing ContainerRuntime resources: %w", err)
+	}
+
+	var (
+		wantedContainerRuntimeNames = c.getWantedContainerRuntimeNames()
+		runtimeHandlers             = make(map[string]string)
+	)
+
+	for _, cr := range containerRuntimeList.Items {
+		if !wantedContainerRuntimeNames.Has(cr.Name) || cr.DeletionTimestamp != nil || cr.Status.RuntimeHandler == nil {
+			continue
+		}
+
+		if handler, ok := runtimeHandlers[cr.Spec.Type]; ok && handler != *cr.Status.RuntimeHandler {
+			return fmt.Errorf("ContainerRuntime resources of type %q report different runtime handlers: %q and %q", cr.Spec.Type, handler, *cr.Status.RuntimeHandler)
+		}
+		runtimeHandlers[cr.Spec.Type] = *cr.Status.RuntimeHandler
+	}
+
+	if len(runtimeHandlers) == 0 {
+		return managedresources.DeleteForShoot(ctx, c.client, c.values.Namespace, ManagedResourceNameRuntimeClasses)
+	}
+
+	var (
+		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+		objects  []client.Object
+	)
+
+	for _, criType := range slices.Sorted(maps.Keys(runtimeHandlers)) {
+		objects = append(objects, &nodev1.RuntimeClass{
+			ObjectMeta: metav1.ObjectMeta{Name: criType},
+			Handler:    runtimeHandlers[criType],
+			Scheduling: &nodev1.Scheduling{
+				NodeSelector: map[string]string{fmt.Sprintf(extensionsv1alpha1.ContainerRuntimeNameWorkerLabel, criType): "true"},
+			},
+		})
+	}
+
+	data, err := registry.AddAllAndSerialize(objects...)
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForShoot(ctx, c.client, c.values.Namespace, ManagedResourceNameRuntimeClasses, managedresources.LabelValueGardener, false, data)
+}
+
 // getWantedContainerRuntimeNames returns the names of all container runtime resources, that are currently needed based
 // on the configured worker pools.
 func (c *containerRuntime) getWantedContainerRuntimeNames() sets.Set[string] {
@@ -12,18 +12,23 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
+	nodev1 "k8s.io/api/node/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/extensions/containerruntime"
 	"github.com/gardener/gardener/pkg/extensions"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -64,9 +69,7 @@ var _ = Describe("#ContainerRuntime", func() {
 		ctx = context.TODO()
 		log = logr.Discard()
 
-		s := runtime.NewScheme()
-		Expect(extensionsv1alpha1.AddToScheme(s)).NotTo(HaveOccurred())
-		c = fake.NewClientBuilder().WithScheme(s).Build()
+		c = fake.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
 
 		workers = make([]gardencorev1beta1.Worker, 0, len(workerNames))
 
@@ -480,6 +483,65 @@ var _ = Describe("#ContainerRuntime", func() {
 		})
 	})
 
+	Describe("#DeployRuntimeClasses", func() {
+		var (
+			consistOf       func(...client.Object) gomegatypes.GomegaMatcher
+			managedResource *resourcesv1alpha1.ManagedResource
+		)
+
+		BeforeEach(func() {
+			consistOf = NewManagedResourceConsistOfObjectsMatcher(c)
+			managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "shoot-core-runtimeclasses", Namespace: namespace}}
+		})
+
+		It("should deploy a RuntimeClass for each container runtime type reporting a runtime handler", func() {
+			for _, e := range expected {
+				if e.Spec.Type == "type1" {
+					e.Status.RuntimeHandler = ptr.To("handler1")
+				}
+				Expect(c.Create(ctx, e)).To(Succeed())
+			}
+
+			staleContainerRuntime := expected[0].DeepCopy()
+			staleContainerRuntime.ResourceVersion = ""
+			staleContainerRuntime.Name = fmt.Sprintf("%s-%s", "new-type", workerNames[0])
+			staleContainerRuntime.Spec.Type = "new-type"
+			staleContainerRuntime.Status.RuntimeHandler = ptr.To("stale")
+			Expect(c.Create(ctx, staleContainerRuntime)).To(Succeed())
+
+			Expect(defaultDepWaiter.DeployRuntimeClasses(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource).To(consistOf(&nodev1.RuntimeClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "type1"},
+				Handler:    "handler1",
+				Scheduling: &nodev1.Scheduling{
+					NodeSelector: map[string]string{"containerruntime.worker.gardener.cloud/type1": "true"},
+				},
+			}))
+		})
+
+		It("should return an error if the runtime handlers of a container runtime type differ", func() {
+			for i, e := range expected {
+				e.Status.RuntimeHandler = ptr.To(fmt.Sprintf("handler%d", i))
+				Expect(c.Create(ctx, e)).To(Succeed())
+			}
+
+			Expect(defaultDepWaiter.DeployRuntimeClasses(ctx)).To(MatchError(ContainSubstring("report different runtime handlers")))
+		})
+
+		It("should delete the RuntimeClasses if no runtime handler is reported", func() {
+			Expect(c.Create(ctx, managedResource)).To(Succeed())
+			for _, e := range expected {
+				Expect(c.Create(ctx, e)).To(Succeed())
+			}
+
+			Expect(defaultDepWaiter.DeployRuntimeClasses(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+
 	Describe("#WaitCleanupStaleResources", func() {
 		It("should not return error if all resources are gone", func() {
 			Expect(defaultDepWaiter.WaitCleanupStaleResources(ctx)).To(Succeed())
@@ -69,6 +69,20 @@ func (mr *MockInterfaceMockRecorder) Deploy(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockInterface)(nil).Deploy), ctx)
 }
 
+// DeployRuntimeClasses mocks base method.
+func (m *MockInterface) DeployRuntimeClasses(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployRuntimeClasses", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployRuntimeClasses indicates an expected call of DeployRuntimeClasses.
+func (mr *MockInterfaceMockRecorder) DeployRuntimeClasses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployRuntimeClasses", reflect.TypeOf((*MockInterface)(nil).DeployRuntimeClasses), ctx)
+}
+
 // Destroy mocks base method.
 func (m *MockInterface) Destroy(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,6 +42,7 @@ type Interface interface {
 	component.DeployMigrateWaiter
 	SetInfrastructureProviderStatus(*runtime.RawExtension)
 	ProviderStatus() *runtime.RawExtension
+	ComponentInjections(context.Context) (*extensionsv1alpha1.ControlPlaneComponentInjections, error)
 }
 
 // Values contains the values used to create an ControlPlane resources.
@@ -216,3 +218,17 @@ func (c *controlPlane) SetInfrastructureProviderStatus(status *runtime.RawExtens
 func (c *controlPlane) ProviderStatus() *runtime.RawExtension {
 	return c.providerStatus
 }
+
+// ComponentInjections reads the ControlPlane resource and returns the injections for the control plane components
+// reported in its status. It returns nil if the ControlPlane resource does not exist (yet).
+func (c *controlPlane) ComponentInjections(ctx context.Context) (*extensionsv1alpha1.ControlPlaneComponentInjections, error) {
+	controlPlane := &extensionsv1alpha1.ControlPlane{}
+	if err := c.client.Get(ctx, client.ObjectKeyFromObject(c.controlPlane), controlPlane); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return controlPlane.Status.ComponentInjections, nil
+}
@@ -351,4 +351,24 @@ var _ = Describe("ControlPlane", func() {
 			Expect(defaultDepWaiter.WaitMigrate(ctx)).To(Succeed(), "controlplane is ready, should not return an error")
 		})
 	})
+
+	Describe("#ComponentInjections", func() {
+		It("should return nil if the resource does not exist", func() {
+			Expect(defaultDepWaiter.ComponentInjections(ctx)).To(BeNil())
+		})
+
+		It("should return the component injections from the status", func() {
+			componentInjections := &extensionsv1alpha1.ControlPlaneComponentInjections{
+				KubeAPIServer: &extensionsv1alpha1.ComponentInjection{
+					Env: []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+				},
+			}
+
+			obj := cp.DeepCopy()
+			obj.Status.ComponentInjections = componentInjections
+			Expect(c.Create(ctx, obj)).To(Succeed(), "creating controlplane succeeds")
+
+			Expect(defaultDepWaiter.ComponentInjections(ctx)).To(Equal(componentInjections))
+		})
+	})
 })
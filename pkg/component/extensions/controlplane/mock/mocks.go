@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gomock "go.uber.org/mock/gomock"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -42,6 +43,21 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// ComponentInjections mocks base method.
+func (m *MockInterface) ComponentInjections(arg0 context.Context) (*v1alpha1.ControlPlaneComponentInjections, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComponentInjections", arg0)
+	ret0, _ := ret[0].(*v1alpha1.ControlPlaneComponentInjections)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComponentInjections indicates an expected call of ComponentInjections.
+func (mr *MockInterfaceMockRecorder) ComponentInjections(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComponentInjections", reflect.TypeOf((*MockInterface)(nil).ComponentInjections), arg0)
+}
+
 // Deploy mocks base method.
 func (m *MockInterface) Deploy(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -38,6 +38,8 @@ var (
 	operatingSystemConfigCRD string
 	//go:embed assets/crd-extensions.gardener.cloud_selfhostedshootexposures.yaml
 	selfHostedShootExposureCRD string
+	//go:embed assets/crd-extensions.gardener.cloud_shootleftovers.yaml
+	shootLeftoverCRD string
 	//go:embed assets/crd-extensions.gardener.cloud_workers.yaml
 	workerCRD string
 )
@@ -62,6 +64,7 @@ func NewCRD(client client.Client, includeGeneralCRDs, includeShootCRDs bool) (co
 			networkCRD,
 			operatingSystemConfigCRD,
 			selfHostedShootExposureCRD,
+			shootLeftoverCRD,
 			workerCRD,
 		}
 	)
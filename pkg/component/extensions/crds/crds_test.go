@@ -65,6 +65,7 @@ var _ = Describe("#CRDs", func() {
 			Entry("Network", "networks.extensions.gardener.cloud"),
 			Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud"),
 			Entry("SelfHostedShootExposure", "selfhostedshootexposures.extensions.gardener.cloud"),
+			Entry("ShootLeftover", "shootleftovers.extensions.gardener.cloud"),
 			Entry("Worker", "workers.extensions.gardener.cloud"),
 		)
 
@@ -88,6 +89,7 @@ var _ = Describe("#CRDs", func() {
 			Entry("Network", "networks.extensions.gardener.cloud"),
 			Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud"),
 			Entry("SelfHostedShootExposure", "selfhostedshootexposures.extensions.gardener.cloud"),
+			Entry("ShootLeftover", "shootleftovers.extensions.gardener.cloud"),
 			Entry("Worker", "workers.extensions.gardener.cloud"),
 		)
 	})
@@ -115,6 +117,7 @@ var _ = Describe("#CRDs", func() {
 			Entry("Network", "networks.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("SelfHostedShootExposure", "selfhostedshootexposures.extensions.gardener.cloud", BeNotFoundError()),
+			Entry("ShootLeftover", "shootleftovers.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("Worker", "workers.extensions.gardener.cloud", BeNotFoundError()),
 		)
 
@@ -138,6 +141,7 @@ var _ = Describe("#CRDs", func() {
 			Entry("Network", "networks.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("SelfHostedShootExposure", "selfhostedshootexposures.extensions.gardener.cloud", BeNotFoundError()),
+			Entry("ShootLeftover", "shootleftovers.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("Worker", "workers.extensions.gardener.cloud", BeNotFoundError()),
 		)
 	})
@@ -165,6 +169,7 @@ var _ = Describe("#CRDs", func() {
 			Entry("Network", "networks.extensions.gardener.cloud", Succeed()),
 			Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud", Succeed()),
 			Entry("SelfHostedShootExposure", "selfhostedshootexposures.extensions.gardener.cloud", Succeed()),
+			Entry("ShootLeftover", "shootleftovers.extensions.gardener.cloud", Succeed()),
 			Entry("Worker", "workers.extensions.gardener.cloud", Succeed()),
 		)
 
@@ -188,6 +193,7 @@ var _ = Describe("#CRDs", func() {
 			Entry("Network", "networks.extensions.gardener.cloud", Succeed()),
 			Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud", Succeed()),
 			Entry("SelfHostedShootExposure", "selfhostedshootexposures.extensions.gardener.cloud", Succeed()),
+			Entry("ShootLeftover", "shootleftovers.extensions.gardener.cloud", Succeed()),
 			Entry("Worker", "workers.extensions.gardener.cloud", Succeed()),
 		)
 	})
@@ -213,6 +219,7 @@ var _ = Describe("#CRDs", func() {
 			Expect(c.Get(ctx, client.ObjectKey{Name: "networks.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "operatingsystemconfigs.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "selfhostedshootexposures.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "shootleftovers.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "workers.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 		})
 
@@ -233,6 +240,7 @@ var _ = Describe("#CRDs", func() {
 			Expect(c.Get(ctx, client.ObjectKey{Name: "networks.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "operatingsystemconfigs.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "selfhostedshootexposures.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "shootleftovers.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "workers.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 		})
 
@@ -252,6 +260,7 @@ var _ = Describe("#CRDs", func() {
 			Expect(c.Get(ctx, client.ObjectKey{Name: "networks.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "operatingsystemconfigs.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "selfhostedshootexposures.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "shootleftovers.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "workers.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 		})
 	})
@@ -84,6 +84,10 @@ type Values struct {
 	Values []string
 	// TTL is the time to live in seconds of the DNSRecord.
 	TTL *int64
+	// TTLs overrides TTL for specific record types of the DNSRecord.
+	TTLs map[extensionsv1alpha1.DNSRecordType]int64
+	// Failover is the configuration for health-checked secondary targets of the DNSRecord.
+	Failover *gardencorev1beta1.DNSFailover
 	// IPStack is the indication of the IP stack used for the DNSRecord. It can be ipv4, ipv6 or dual-stack.
 	IPStack string
 	// Labels is a set of labels that should be applied to the DNSRecord resource.
@@ -176,7 +180,8 @@ func (d *dnsRecord) deploy(ctx context.Context, operation string) (extensionsv1a
 			Name:       d.values.DNSName,
 			RecordType: d.values.RecordType,
 			Values:     d.values.Values,
-			TTL:        d.values.TTL,
+			TTL:        d.ttl(),
+			Failover:   d.values.Failover,
 		}
 
 		return nil
@@ -341,7 +346,16 @@ func (d *dnsRecord) valuesDontMatchDNSRecord() bool {
 	return d.values.SecretName != d.dnsRecord.Spec.SecretRef.Name ||
 		!ptr.Equal(d.values.Zone, d.dnsRecord.Spec.Zone) ||
 		!reflect.DeepEqual(d.values.Values, d.dnsRecord.Spec.Values) ||
-		!ptr.Equal(d.values.TTL, d.dnsRecord.Spec.TTL)
+		!ptr.Equal(d.ttl(), d.dnsRecord.Spec.TTL) ||
+		!reflect.DeepEqual(d.values.Failover, d.dnsRecord.Spec.Failover)
+}
+
+// ttl returns the TTL configured for the record type of the DNSRecord or the default TTL otherwise.
+func (d *dnsRecord) ttl() *int64 {
+	if ttl, ok := d.values.TTLs[d.values.RecordType]; ok {
+		return &ttl
+	}
+	return d.values.TTL
 }
 
 func (d *dnsRecord) lastOperationNotSuccessful() bool {
@@ -687,6 +687,12 @@ var _ = Describe("DNSRecord", func() {
 				Entry("zone changes", func() { values.Zone = ptr.To("new-zone") }, func() { expectedDNSRecord.Spec.Zone = ptr.To("new-zone") }),
 				Entry("values changes", func() { values.Values = []string{"8.8.8.8"} }, func() { expectedDNSRecord.Spec.Values = []string{"8.8.8.8"} }),
 				Entry("TTL changes", func() { values.TTL = ptr.To[int64](1337) }, func() { expectedDNSRecord.Spec.TTL = ptr.To[int64](1337) }),
+				Entry("TTL for record type changes", func() {
+					values.TTLs = map[extensionsv1alpha1.DNSRecordType]int64{extensionsv1alpha1.DNSRecordTypeA: 60, extensionsv1alpha1.DNSRecordTypeCNAME: 300}
+				}, func() { expectedDNSRecord.Spec.TTL = ptr.To[int64](60) }),
+				Entry("failover changes", func() {
+					values.Failover = &gardencorev1beta1.DNSFailover{Values: []string{"5.6.7.8"}}
+				}, func() { expectedDNSRecord.Spec.Failover = &gardencorev1beta1.DNSFailover{Values: []string{"5.6.7.8"}} }),
 				Entry("zone is nil", func() { values.Zone = nil }, func() { expectedDNSRecord.Spec.Zone = nil }),
 			)
 		})
@@ -6,21 +6,27 @@ package infrastructure
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 )
 
 const (
@@ -69,11 +75,17 @@ type Values struct {
 	Region string
 	// SSHPublicKey is the to-be-used SSH public key of the shoot.
 	SSHPublicKey []byte
+	// Egress is the egress configuration of the shoot.
+	Egress *gardencorev1beta1.Egress
 	// AnnotateOperation indicates if the Infrastructure resource shall be annotated with the
 	// respective "gardener.cloud/operation" (forcing a reconciliation or restoration). If this is false
 	// then the Infrastructure object will be created/updated but the extension controller will not
 	// act upon it.
 	AnnotateOperation bool
+	// ReconcileOnlyOnDrift indicates that the Infrastructure resource shall only be annotated with the "reconcile"
+	// operation if its specification changes or if the last drift detection did not confirm that the infrastructure is
+	// in sync, even if AnnotateOperation is true.
+	ReconcileOnlyOnDrift bool
 }
 
 // New creates a new instance of Interface.
@@ -133,25 +145,28 @@ func (i *infrastructure) deploy(ctx context.Context, operation string) (extensio
 	}
 
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, i.client, i.infrastructure, func() error {
-		if i.values.AnnotateOperation || i.lastOperationNotSuccessful() || i.isTimestampInvalidOrAfterLastUpdateTime() {
-			// Check if gardener timestamp is in an invalid format or is after status.LastOperation.LastUpdateTime.
-			// If that is the case health checks for the infrastructure will fail so we request a reconciliation to correct the current state.
-			metav1.SetMetaDataAnnotation(&i.infrastructure.ObjectMeta, v1beta1constants.GardenerOperation, operation)
-			metav1.SetMetaDataAnnotation(&i.infrastructure.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
-		}
-
-		i.infrastructure.Spec = extensionsv1alpha1.InfrastructureSpec{
+		spec := extensionsv1alpha1.InfrastructureSpec{
 			DefaultSpec: extensionsv1alpha1.DefaultSpec{
 				Type:           i.values.Type,
 				ProviderConfig: providerConfig,
 			},
 			Region:       i.values.Region,
 			SSHPublicKey: i.values.SSHPublicKey,
+			Egress:       i.values.Egress,
 			SecretRef: corev1.SecretReference{
 				Name:      v1beta1constants.SecretNameCloudProvider,
 				Namespace: i.infrastructure.Namespace,
 			},
 		}
+
+		if i.annotateOperation(operation, spec) || i.lastOperationNotSuccessful() || i.isTimestampInvalidOrAfterLastUpdateTime() {
+			// Check if gardener timestamp is in an invalid format or is after status.LastOperation.LastUpdateTime.
+			// If that is the case health checks for the infrastructure will fail so we request a reconciliation to correct the current state.
+			metav1.SetMetaDataAnnotation(&i.infrastructure.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+			metav1.SetMetaDataAnnotation(&i.infrastructure.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+		}
+
+		i.infrastructure.Spec = spec
 		return nil
 	})
 
@@ -189,10 +204,11 @@ func (i *infrastructure) Destroy(ctx context.Context) error {
 
 // Wait waits until the Infrastructure resource is ready.
 func (i *infrastructure) Wait(ctx context.Context) error {
-	return extensions.WaitUntilExtensionObjectReady(
+	return extensions.WaitUntilObjectReadyWithHealthFunction(
 		ctx,
 		i.client,
 		i.log,
+		checkInfrastructure,
 		i.infrastructure,
 		extensionsv1alpha1.InfrastructureResource,
 		i.waitInterval,
@@ -291,6 +307,84 @@ func (i *infrastructure) extractStatus(status extensionsv1alpha1.InfrastructureS
 	copy(i.egressCIDRs, status.EgressCIDRs)
 }
 
+// checkInfrastructure checks whether the given Infrastructure is healthy like any other extension object. If it is not,
+// the infrastructure resources whose provisioning failed according to the Infrastructure status are added to the
+// returned error, so that they are surfaced in the Shoot status.
+func checkInfrastructure(obj client.Object) error {
+	err := health.CheckExtensionObject(obj)
+	if err == nil {
+		return nil
+	}
+
+	infrastructure, ok := obj.(*extensionsv1alpha1.Infrastructure)
+	if !ok {
+		return err
+	}
+
+	var failedResources []string
+	for _, resource := range infrastructure.Status.ResourceStatuses {
+		if resource.State != extensionsv1alpha1.InfrastructureResourceStateFailed {
+			continue
+		}
+
+		failedResource := fmt.Sprintf("%s %q", resource.Kind, resource.Name)
+		if resource.Message != nil {
+			failedResource += ": " + *resource.Message
+		}
+		failedResources = append(failedResources, failedResource)
+	}
+
+	if len(failedResources) == 0 {
+		return err
+	}
+	return fmt.Errorf("%w (failed infrastructure resources: %s)", err, strings.Join(failedResources, "; "))
+}
+
+// annotateOperation returns whether the Infrastructure resource shall be annotated with the given operation. If the
+// infrastructure shall only be reconciled on drift, the "reconcile" operation is skipped as long as the given spec
+// does not change and the last drift detection confirmed that the infrastructure is in sync.
+func (i *infrastructure) annotateOperation(operation string, spec extensionsv1alpha1.InfrastructureSpec) bool {
+	if !i.values.AnnotateOperation {
+		return false
+	}
+
+	if !i.values.ReconcileOnlyOnDrift || operation != v1beta1constants.GardenerOperationReconcile {
+		return true
+	}
+
+	if drift := extensionsv1alpha1helper.GetInfrastructureDrift(i.infrastructure); specEqual(i.infrastructure.Spec, spec) && drift != nil && drift.State == extensionsv1alpha1.InfrastructureDriftStateInSync {
+		i.log.Info("Skipping reconciliation of infrastructure since its specification did not change and no drift was detected")
+		return false
+	}
+
+	return true
+}
+
+// specEqual returns whether the given specs are equal. The provider configs are compared after decoding them since the
+// API server does not necessarily preserve the formatting of the raw JSON.
+func specEqual(a, b extensionsv1alpha1.InfrastructureSpec) bool {
+	aProviderConfig, bProviderConfig := a.ProviderConfig, b.ProviderConfig
+	a.ProviderConfig, b.ProviderConfig = nil, nil
+
+	if !apiequality.Semantic.DeepEqual(a, b) {
+		return false
+	}
+
+	if aProviderConfig == nil || bProviderConfig == nil {
+		return aProviderConfig == nil && bProviderConfig == nil
+	}
+
+	var aValue, bValue any
+	if err := json.Unmarshal(aProviderConfig.Raw, &aValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(bProviderConfig.Raw, &bValue); err != nil {
+		return false
+	}
+
+	return apiequality.Semantic.DeepEqual(aValue, bValue)
+}
+
 func (i *infrastructure) lastOperationNotSuccessful() bool {
 	return i.infrastructure.Status.LastOperation != nil && i.infrastructure.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded
 }
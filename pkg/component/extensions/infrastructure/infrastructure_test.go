@@ -55,6 +55,7 @@ var _ = Describe("#Interface", func() {
 		now     time.Time
 
 		region         string
+		egress         *gardencorev1beta1.Egress
 		sshPublicKey   []byte
 		providerConfig *runtime.RawExtension
 		providerStatus *runtime.RawExtension
@@ -84,6 +85,9 @@ var _ = Describe("#Interface", func() {
 		c = fake.NewClientBuilder().WithScheme(s).Build()
 
 		region = "europe"
+		egress = &gardencorev1beta1.Egress{
+			IPPools: []gardencorev1beta1.EgressIPPool{{Name: "pool", Addresses: []string{"203.0.113.1"}}},
+		}
 		sshPublicKey = []byte("secure")
 		providerConfig = &runtime.RawExtension{Raw: []byte(`{"very":"provider-specific"}`)}
 		providerStatus = &runtime.RawExtension{Raw: []byte(`{"very":"provider-specific-status"}`)}
@@ -98,6 +102,7 @@ var _ = Describe("#Interface", func() {
 			Type:           providerType,
 			ProviderConfig: providerConfig,
 			Region:         region,
+			Egress:         egress,
 		}
 
 		empty = &extensionsv1alpha1.Infrastructure{
@@ -123,6 +128,7 @@ var _ = Describe("#Interface", func() {
 				},
 				Region:       region,
 				SSHPublicKey: sshPublicKey,
+				Egress:       egress,
 				SecretRef: corev1.SecretReference{
 					Name:      v1beta1constants.SecretNameCloudProvider,
 					Namespace: namespace,
@@ -255,6 +261,73 @@ var _ = Describe("#Interface", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(deployedInfra).To(DeepEqual(expected))
 		})
+
+		Context("ReconcileOnlyOnDrift=true", func() {
+			var existingInfra *extensionsv1alpha1.Infrastructure
+
+			BeforeEach(func() {
+				DeferCleanup(test.WithVars(
+					&infrastructure.TimeNow, mockNow.Do,
+				))
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				existingInfra = expected.DeepCopy()
+				existingInfra.ResourceVersion = ""
+				delete(existingInfra.Annotations, v1beta1constants.GardenerOperation)
+				metav1.SetMetaDataAnnotation(&existingInfra.ObjectMeta, v1beta1constants.GardenerTimestamp, now.UTC().Add(-time.Minute).Format(time.RFC3339Nano))
+				// The API server does not necessarily preserve the formatting of the provider config.
+				existingInfra.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{ "very": "provider-specific" }`)}
+				existingInfra.Status.LastOperation = &gardencorev1beta1.LastOperation{
+					State:          gardencorev1beta1.LastOperationStateSucceeded,
+					LastUpdateTime: metav1.NewTime(now.UTC().Add(-30 * time.Second)),
+				}
+				existingInfra.Status.Drift = &extensionsv1alpha1.InfrastructureDrift{
+					State:             extensionsv1alpha1.InfrastructureDriftStateInSync,
+					LastDetectionTime: metav1.NewTime(now.UTC()),
+				}
+
+				values.AnnotateOperation = true
+				values.ReconcileOnlyOnDrift = true
+				deployWaiter = infrastructure.New(log, c, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+				deployWaiter.SetSSHPublicKey(sshPublicKey)
+			})
+
+			It("should not add the operation annotation if the spec did not change and no drift was detected", func() {
+				Expect(c.Create(ctx, existingInfra)).To(Succeed())
+				Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(existingInfra), existingInfra)).To(Succeed())
+				Expect(existingInfra.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			})
+
+			It("should add the operation annotation if a drift was detected", func() {
+				existingInfra.Status.Drift.State = extensionsv1alpha1.InfrastructureDriftStateDrifted
+				Expect(c.Create(ctx, existingInfra)).To(Succeed())
+				Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(existingInfra), existingInfra)).To(Succeed())
+				Expect(existingInfra.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+			})
+
+			It("should add the operation annotation if the drift was detected before the last reconciliation", func() {
+				existingInfra.Status.Drift.LastDetectionTime = metav1.NewTime(now.UTC().Add(-time.Hour))
+				Expect(c.Create(ctx, existingInfra)).To(Succeed())
+				Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(existingInfra), existingInfra)).To(Succeed())
+				Expect(existingInfra.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+			})
+
+			It("should add the operation annotation if the spec changed", func() {
+				existingInfra.Spec.Region = "asia"
+				Expect(c.Create(ctx, existingInfra)).To(Succeed())
+				Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(existingInfra), existingInfra)).To(Succeed())
+				Expect(existingInfra.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+				Expect(existingInfra.Spec.Region).To(Equal(region))
+			})
+		})
 	})
 
 	Describe("#Wait", func() {
@@ -271,6 +344,21 @@ var _ = Describe("#Interface", func() {
 			Expect(deployWaiter.Wait(ctx)).To(MatchError(ContainSubstring("error during reconciliation: Some error")))
 		})
 
+		It("should return error containing the failed infrastructure resources when it's not ready", func() {
+			expected.Status.LastError = &gardencorev1beta1.LastError{
+				Description: "Some error",
+			}
+			expected.Status.ResourceStatuses = []extensionsv1alpha1.InfrastructureResourceStatus{
+				{Kind: "VPC", Name: "vpc", State: extensionsv1alpha1.InfrastructureResourceStateReady},
+				{Kind: "Subnet", Name: "nodes", State: extensionsv1alpha1.InfrastructureResourceStateFailed, Message: ptr.To("quota exceeded")},
+				{Kind: "NATGateway", Name: "nat", State: extensionsv1alpha1.InfrastructureResourceStateFailed},
+				{Kind: "SecurityGroup", Name: "nodes", State: extensionsv1alpha1.InfrastructureResourceStatePending},
+			}
+
+			Expect(c.Create(ctx, expected)).To(Succeed(), "creating infrastructure succeeds")
+			Expect(deployWaiter.Wait(ctx)).To(MatchError(ContainSubstring(`error during reconciliation: Some error (failed infrastructure resources: Subnet "nodes": quota exceeded; NATGateway "nat")`)))
+		})
+
 		It("should return error if we haven't observed the latest timestamp annotation", func() {
 			defer test.WithVars(
 				&infrastructure.TimeNow, mockNow.Do,
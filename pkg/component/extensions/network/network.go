@@ -59,6 +59,8 @@ type Values struct {
 	PodCIDRs []net.IPNet
 	// ServiceCIDRs are the Shoot's service CIDRs in the Shoot VPC
 	ServiceCIDRs []net.IPNet
+	// Egress is the egress configuration of the Shoot.
+	Egress *v1beta1.Egress
 }
 
 // New creates a new instance of DeployWaiter for a Network.
@@ -193,6 +195,7 @@ func (n *network) deploy(ctx context.Context, operation string) (extensionsv1alp
 			IPFamilies:  n.values.IPFamilies,
 			PodCIDR:     getCIDRforSpec(n.values.IPFamilies, n.values.PodCIDRs),
 			ServiceCIDR: getCIDRforSpec(n.values.IPFamilies, n.values.ServiceCIDRs),
+			Egress:      n.values.Egress,
 		}
 
 		return nil
@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -183,6 +184,22 @@ var _ = Describe("#Network", func() {
 				testFunc()
 			})
 		})
+
+		Context("with egress configuration", func() {
+			BeforeEach(func() {
+				egress := &gardencorev1beta1.Egress{
+					IPPools:             []gardencorev1beta1.EgressIPPool{{Name: "pool", Count: ptr.To[int32](2)}},
+					NamespaceSelections: []gardencorev1beta1.EgressNamespaceSelection{{IPPool: "pool"}},
+				}
+
+				values.Egress = egress
+				expected.Spec.Egress = egress
+			})
+
+			It("should create correct Network", func() {
+				testFunc()
+			})
+		})
 	})
 
 	Describe("#Wait", func() {
@@ -120,6 +120,7 @@ var _ = Describe("controlPlaneBootstrap", func() {
 					HaveField("Path", "/var/lib/gardener-user-authorized-keys"),
 					HaveField("Content.Inline.Data", utils.EncodeBase64([]byte(sshPublicKey))),
 				),
+				HaveField("Path", "/var/lib/gardener-user-trusted-user-ca-keys"),
 				And(
 					HaveField("Path", nodeinit.GardenadmPathDownloadScript),
 					HaveField("Permissions", ptr.To(uint32(0755))),
@@ -65,6 +65,7 @@ WantedBy=multi-user.target`),
 					HaveField("Path", "/var/lib/gardener-user-authorized-keys"),
 					HaveField("Content.Inline.Data", utils.EncodeBase64([]byte(sshPublicKey))),
 				),
+				HaveField("Path", "/var/lib/gardener-user-trusted-user-ca-keys"),
 				extensionsv1alpha1.File{
 					Path:        "/var/lib/gardenadm/download.sh",
 					Permissions: ptr.To[uint32](0755),
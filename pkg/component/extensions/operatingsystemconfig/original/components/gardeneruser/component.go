@@ -45,6 +45,10 @@ const (
 
 	// pathAuthorizedSSHKeys is the new file that can contain multiple SSH public keys.
 	pathAuthorizedSSHKeys = "/var/lib/gardener-user-authorized-keys"
+
+	// pathTrustedUserCAKeys is the file that contains the public keys of the SSH certificate authorities which are
+	// trusted by sshd for signing user certificates.
+	pathTrustedUserCAKeys = "/var/lib/gardener-user-trusted-user-ca-keys"
 )
 
 type component struct{}
@@ -63,11 +67,13 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 	if err := tpl.Execute(&script, map[string]any{
 		"pathPublicSSHKey":      pathPublicSSHKey,
 		"pathAuthorizedSSHKeys": pathAuthorizedSSHKeys,
+		"pathTrustedUserCAKeys": pathTrustedUserCAKeys,
 	}); err != nil {
 		return nil, nil, err
 	}
 
 	authorizedKeys := strings.Join(ctx.SSHPublicKeys, "\n")
+	trustedUserCAKeys := strings.Join(ctx.SSHCAPublicKeys, "\n")
 
 	return []extensionsv1alpha1.Unit{
 			{
@@ -87,6 +93,7 @@ ExecStart=` + pathScript + `
 				Enable: ptr.To(true),
 				Content: ptr.To(`[Path]
 PathChanged=` + pathAuthorizedSSHKeys + `
+PathChanged=` + pathTrustedUserCAKeys + `
 [Install]
 WantedBy=multi-user.target
 `),
@@ -103,6 +110,16 @@ WantedBy=multi-user.target
 					},
 				},
 			},
+			{
+				Path:        pathTrustedUserCAKeys,
+				Permissions: ptr.To[uint32](0644),
+				Content: extensionsv1alpha1.FileContent{
+					Inline: &extensionsv1alpha1.FileContentInline{
+						Encoding: "b64",
+						Data:     utils.EncodeBase64([]byte(trustedUserCAKeys)),
+					},
+				},
+			},
 			{
 				Path:        pathScript,
 				Permissions: ptr.To[uint32](0755),
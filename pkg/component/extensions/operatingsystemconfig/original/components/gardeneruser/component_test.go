@@ -28,11 +28,15 @@ var _ = Describe("Component", func() {
 				"another-not-encoded-key",
 				"the-last-key-i-promise",
 			}
+			sshCAPublicKeys = []string{
+				"ssh-rsa current-ca",
+				"ssh-rsa old-ca",
+			}
 		)
 
 		BeforeEach(func() {
 			component = New()
-			ctx = components.Context{SSHPublicKeys: sshPublicKeys}
+			ctx = components.Context{SSHPublicKeys: sshPublicKeys, SSHCAPublicKeys: sshCAPublicKeys}
 		})
 
 		It("should return the expected units and files", func() {
@@ -57,6 +61,7 @@ ExecStart=/var/lib/gardener-user/run.sh
 					Enable: ptr.To(true),
 					Content: ptr.To(`[Path]
 PathChanged=/var/lib/gardener-user-authorized-keys
+PathChanged=/var/lib/gardener-user-trusted-user-ca-keys
 [Install]
 WantedBy=multi-user.target
 `),
@@ -73,6 +78,16 @@ WantedBy=multi-user.target
 						},
 					},
 				},
+				extensionsv1alpha1.File{
+					Path:        "/var/lib/gardener-user-trusted-user-ca-keys",
+					Permissions: ptr.To[uint32](0644),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte(strings.Join(sshCAPublicKeys, "\n"))),
+						},
+					},
+				},
 				extensionsv1alpha1.File{
 					Path:        "/var/lib/gardener-user/run.sh",
 					Permissions: ptr.To[uint32](0755),
@@ -93,6 +108,8 @@ const script = `#!/bin/bash -eu
 DIR_SSH="/home/gardener/.ssh"
 PATH_AUTHORIZED_KEYS="$DIR_SSH/authorized_keys"
 PATH_SUDOERS="/etc/sudoers.d/99-gardener-user"
+PATH_TRUSTED_USER_CA_KEYS="/etc/ssh/gardener-user-trusted-user-ca-keys"
+PATH_SSHD_CONFIG="/etc/ssh/sshd_config"
 USERNAME="gardener"
 
 # create user if missing
@@ -103,6 +120,20 @@ mkdir -p $DIR_SSH
 cp -f "/var/lib/gardener-user-authorized-keys" $PATH_AUTHORIZED_KEYS
 chown $USERNAME:$USERNAME $PATH_AUTHORIZED_KEYS
 
+# configure sshd to trust user certificates signed by the SSH certificate authorities
+if [ -s "/var/lib/gardener-user-trusted-user-ca-keys" ]; then
+  cp -f "/var/lib/gardener-user-trusted-user-ca-keys" $PATH_TRUSTED_USER_CA_KEYS
+  chmod 0644 $PATH_TRUSTED_USER_CA_KEYS
+  if ! grep -q "^TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS" $PATH_SSHD_CONFIG; then
+    sed -i "1i TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS" $PATH_SSHD_CONFIG
+    systemctl reload sshd.service || systemctl reload ssh.service || true
+  fi
+elif [ -f "$PATH_TRUSTED_USER_CA_KEYS" ]; then
+  sed -i "\\|^TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS|d" $PATH_SSHD_CONFIG
+  rm -f $PATH_TRUSTED_USER_CA_KEYS
+  systemctl reload sshd.service || systemctl reload ssh.service || true
+fi
+
 # remove unused legacy file
 if [ -f "/var/lib/gardener-user-ssh.key" ]; then
   rm -f "/var/lib/gardener-user-ssh.key"
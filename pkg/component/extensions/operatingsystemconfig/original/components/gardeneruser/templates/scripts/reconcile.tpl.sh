@@ -3,6 +3,8 @@
 DIR_SSH="/home/gardener/.ssh"
 PATH_AUTHORIZED_KEYS="$DIR_SSH/authorized_keys"
 PATH_SUDOERS="/etc/sudoers.d/99-gardener-user"
+PATH_TRUSTED_USER_CA_KEYS="/etc/ssh/gardener-user-trusted-user-ca-keys"
+PATH_SSHD_CONFIG="/etc/ssh/sshd_config"
 USERNAME="gardener"
 
 # create user if missing
@@ -13,6 +15,20 @@ mkdir -p $DIR_SSH
 cp -f "{{ .pathAuthorizedSSHKeys }}" $PATH_AUTHORIZED_KEYS
 chown $USERNAME:$USERNAME $PATH_AUTHORIZED_KEYS
 
+# configure sshd to trust user certificates signed by the SSH certificate authorities
+if [ -s "{{ .pathTrustedUserCAKeys }}" ]; then
+  cp -f "{{ .pathTrustedUserCAKeys }}" $PATH_TRUSTED_USER_CA_KEYS
+  chmod 0644 $PATH_TRUSTED_USER_CA_KEYS
+  if ! grep -q "^TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS" $PATH_SSHD_CONFIG; then
+    sed -i "1i TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS" $PATH_SSHD_CONFIG
+    systemctl reload sshd.service || systemctl reload ssh.service || true
+  fi
+elif [ -f "$PATH_TRUSTED_USER_CA_KEYS" ]; then
+  sed -i "\\|^TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS|d" $PATH_SSHD_CONFIG
+  rm -f $PATH_TRUSTED_USER_CA_KEYS
+  systemctl reload sshd.service || systemctl reload ssh.service || true
+fi
+
 # remove unused legacy file
 if [ -f "{{ .pathPublicSSHKey }}" ]; then
   rm -f "{{ .pathPublicSSHKey }}"
@@ -42,9 +42,10 @@ func OperatingSystemConfigSecret(
 			InPlaceUpdates: osc.Spec.InPlaceUpdates,
 		},
 		Status: extensionsv1alpha1.OperatingSystemConfigStatus{
-			ExtensionUnits: osc.Status.ExtensionUnits,
-			ExtensionFiles: osc.Status.ExtensionFiles,
-			InPlaceUpdates: osc.Status.InPlaceUpdates,
+			ExtensionUnits:          osc.Status.ExtensionUnits,
+			ExtensionFiles:          osc.Status.ExtensionFiles,
+			ExtensionBootstrapSteps: osc.Status.ExtensionBootstrapSteps,
+			InPlaceUpdates:          osc.Status.InPlaceUpdates,
 		},
 	}
 
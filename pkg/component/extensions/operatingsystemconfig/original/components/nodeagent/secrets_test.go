@@ -146,6 +146,23 @@ status:
 			}))
 		})
 
+		It("should include the bootstrap steps provided by the extension", func() {
+			osc.Status.ExtensionBootstrapSteps = []extensionsv1alpha1.BootstrapStep{{
+				Name:      "some-step",
+				UnitName:  "some-other-unit.service",
+				DependsOn: []string{"some-other-step"},
+			}}
+
+			secret, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(secret.Data["osc.yaml"])).To(ContainSubstring(`  extensionBootstrapSteps:
+  - dependsOn:
+    - some-other-step
+    name: some-step
+    unitName: some-other-unit.service
+`))
+		})
+
 		It("should preserve secretRef when resolveSecretRefs is false", func() {
 			secret, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName, false)
 			Expect(err).NotTo(HaveOccurred())
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootleftover
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+)
+
+// SecretNamePrefix is the prefix for the names of the secrets in the garden namespace of the seed which contain the
+// infrastructure credentials for the ShootLeftover resources. The prefix is followed by the name of the ShootLeftover.
+const SecretNamePrefix = "shootleftover-"
+
+// Values contains the values used to create a ShootLeftover resource.
+type Values struct {
+	// Name is the name of the ShootLeftover extension.
+	Name string
+	// Type is the type of ShootLeftover plugin/extension.
+	Type string
+	// ProviderConfig contains the provider config for the ShootLeftover extension.
+	ProviderConfig *runtime.RawExtension
+	// Region is the infrastructure region of the deleted shoot.
+	Region string
+	// TechnicalID is the technical ID of the deleted shoot.
+	TechnicalID string
+	// SecretRef is a reference to a secret with the infrastructure credentials.
+	SecretRef corev1.SecretReference
+	// Cleanup specifies whether detected leftover resources shall be deleted by the extension.
+	Cleanup bool
+	// ShootName is the name of the deleted shoot.
+	ShootName string
+	// ShootNamespace is the namespace of the deleted shoot in the garden cluster.
+	ShootNamespace string
+}
+
+// New creates a new instance of component.Deployer for ShootLeftovers.
+func New(client client.Client, clock clock.Clock, values *Values) component.Deployer {
+	return &shootLeftover{
+		client: client,
+		clock:  clock,
+		values: values,
+
+		shootLeftover: &extensionsv1alpha1.ShootLeftover{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: values.Name,
+			},
+		},
+	}
+}
+
+type shootLeftover struct {
+	client client.Client
+	clock  clock.Clock
+	values *Values
+
+	shootLeftover *extensionsv1alpha1.ShootLeftover
+}
+
+// Deploy uses the seed client to create or update the ShootLeftover custom resource in the Seed.
+func (s *shootLeftover) Deploy(ctx context.Context) error {
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, s.client, s.shootLeftover, func() error {
+		metav1.SetMetaDataAnnotation(&s.shootLeftover.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		metav1.SetMetaDataAnnotation(&s.shootLeftover.ObjectMeta, v1beta1constants.GardenerTimestamp, s.clock.Now().UTC().Format(time.RFC3339Nano))
+		metav1.SetMetaDataLabel(&s.shootLeftover.ObjectMeta, v1beta1constants.LabelShootName, s.values.ShootName)
+		metav1.SetMetaDataLabel(&s.shootLeftover.ObjectMeta, v1beta1constants.LabelShootNamespace, s.values.ShootNamespace)
+
+		s.shootLeftover.Spec = extensionsv1alpha1.ShootLeftoverSpec{
+			DefaultSpec: extensionsv1alpha1.DefaultSpec{
+				Type:           s.values.Type,
+				ProviderConfig: s.values.ProviderConfig,
+			},
+			Region:      s.values.Region,
+			TechnicalID: s.values.TechnicalID,
+			SecretRef:   s.values.SecretRef,
+			Cleanup:     s.values.Cleanup,
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// Destroy deletes the ShootLeftover custom resource.
+func (s *shootLeftover) Destroy(ctx context.Context) error {
+	return extensions.DeleteExtensionObject(
+		ctx,
+		s.client,
+		s.shootLeftover,
+	)
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootleftover_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/extensions/shootleftover"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("#ShootLeftover", func() {
+	var (
+		ctx       context.Context
+		c         client.Client
+		fakeClock *testclock.FakeClock
+		values    *shootleftover.Values
+		expected  *extensionsv1alpha1.ShootLeftover
+		deployer  component.Deployer
+
+		name           = "shoot--foo--bar--uid"
+		providerType   = "foo"
+		providerConfig = &runtime.RawExtension{Raw: []byte(`{"bar":"foo"}`)}
+		secretRef      = corev1.SecretReference{Name: "shootleftover-shoot--foo--bar--uid", Namespace: "garden"}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		s := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+		c = fake.NewClientBuilder().WithScheme(s).Build()
+
+		values = &shootleftover.Values{
+			Name:           name,
+			Type:           providerType,
+			ProviderConfig: providerConfig,
+			Region:         "region",
+			TechnicalID:    "shoot--foo--bar",
+			SecretRef:      secretRef,
+			Cleanup:        true,
+			ShootName:      "bar",
+			ShootNamespace: "garden-foo",
+		}
+
+		expected = &extensionsv1alpha1.ShootLeftover{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Annotations: map[string]string{
+					v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile,
+					v1beta1constants.GardenerTimestamp: fakeClock.Now().UTC().Format(time.RFC3339Nano),
+				},
+				Labels: map[string]string{
+					v1beta1constants.LabelShootName:      "bar",
+					v1beta1constants.LabelShootNamespace: "garden-foo",
+				},
+				ResourceVersion: "1",
+			},
+			Spec: extensionsv1alpha1.ShootLeftoverSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           providerType,
+					ProviderConfig: providerConfig,
+				},
+				Region:      "region",
+				TechnicalID: "shoot--foo--bar",
+				SecretRef:   secretRef,
+				Cleanup:     true,
+			},
+		}
+
+		deployer = shootleftover.New(c, fakeClock, values)
+	})
+
+	Describe("#Deploy", func() {
+		It("should create the ShootLeftover", func() {
+			Expect(deployer.Deploy(ctx)).To(Succeed())
+
+			actual := &extensionsv1alpha1.ShootLeftover{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name}, actual)).To(Succeed())
+			Expect(actual).To(DeepEqual(expected))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should not return an error when it's not found", func() {
+			Expect(deployer.Destroy(ctx)).To(Succeed())
+		})
+
+		It("should delete the ShootLeftover", func() {
+			Expect(deployer.Deploy(ctx)).To(Succeed())
+			Expect(deployer.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: name}, &extensionsv1alpha1.ShootLeftover{})).To(BeNotFoundError())
+		})
+	})
+})
@@ -210,19 +210,21 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 		}
 
 		nodeTemplate, machineTypeName := w.findNodeTemplateAndMachineTypeByPoolName(obj, workerPool.Name)
-		if nodeTemplate == nil || machineTypeName != workerPool.Machine.Type {
+		if machineType != nil {
 			// initializing nodeTemplate by fetching details from cloudprofile, if present there
-			if machineType != nil {
-				nodeTemplate = &extensionsv1alpha1.NodeTemplate{
-					Capacity: corev1.ResourceList{
-						corev1.ResourceCPU:    machineType.CPU,
-						"gpu":                 machineType.GPU,
-						corev1.ResourceMemory: machineType.Memory,
-					},
-				}
-			} else {
-				nodeTemplate = nil
+			nodeTemplate = &extensionsv1alpha1.NodeTemplate{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    machineType.CPU,
+					"gpu":                 machineType.GPU,
+					corev1.ResourceMemory: machineType.Memory,
+				},
 			}
+		} else if machineTypeName != workerPool.Machine.Type {
+			nodeTemplate = nil
+		}
+
+		if workerPool.ClusterAutoscaler != nil && len(workerPool.ClusterAutoscaler.CapacityHints) > 0 {
+			nodeTemplate = withCapacityHints(nodeTemplate, workerPool.ClusterAutoscaler.CapacityHints)
 		}
 
 		var autoscalerOptions *extensionsv1alpha1.ClusterAutoscalerOptions
@@ -442,6 +444,26 @@ func (w *worker) MachineDeployments() []extensionsv1alpha1.MachineDeployment {
 	return w.machineDeployments
 }
 
+// withCapacityHints returns a copy of the given node template whose capacity is overwritten with the given capacity
+// hints. This allows the cluster-autoscaler to scale up worker pools from zero for resources which are not known from
+// the machine type in the CloudProfile, e.g., extended resources.
+func withCapacityHints(nodeTemplate *extensionsv1alpha1.NodeTemplate, capacityHints corev1.ResourceList) *extensionsv1alpha1.NodeTemplate {
+	if nodeTemplate == nil {
+		nodeTemplate = &extensionsv1alpha1.NodeTemplate{}
+	} else {
+		nodeTemplate = nodeTemplate.DeepCopy()
+	}
+
+	if nodeTemplate.Capacity == nil {
+		nodeTemplate.Capacity = make(corev1.ResourceList, len(capacityHints))
+	}
+	for resourceName, quantity := range capacityHints {
+		nodeTemplate.Capacity[resourceName] = quantity
+	}
+
+	return nodeTemplate
+}
+
 func (w *worker) findNodeTemplateAndMachineTypeByPoolName(obj *extensionsv1alpha1.Worker, poolName string) (*extensionsv1alpha1.NodeTemplate, string) {
 	for _, pool := range obj.Spec.Pools {
 		if pool.Name == poolName {
@@ -491,6 +491,44 @@ var _ = Describe("Worker", func() {
 			}))
 		})
 
+		It("should overwrite the nodeTemplate with the capacity hints", func() {
+			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			newValues := *values
+			newValues.Workers = []gardencorev1beta1.Worker{
+				*values.Workers[0].DeepCopy(),
+				*values.Workers[1].DeepCopy(),
+			}
+			newValues.Workers[0].ClusterAutoscaler = &gardencorev1beta1.ClusterAutoscalerOptions{
+				CapacityHints: corev1.ResourceList{
+					"memory":         resource.MustParse("1Ti"),
+					"nvidia.com/gpu": resource.MustParse("4"),
+				},
+			}
+			newValues.MachineTypes = []gardencorev1beta1.MachineType{machineTypes[0]}
+
+			existingWorker := w.DeepCopy()
+			existingWorker.Spec.Pools = wSpec.DeepCopy().Pools
+			existingWorker.Spec.Pools[0].NodeTemplate.Capacity["example.com/removed-hint"] = resource.MustParse("1")
+			Expect(c.Create(ctx, existingWorker)).To(Succeed(), "creating worker succeeds")
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+
+			Expect(obj.Spec.Pools[0].NodeTemplate).To(Equal(&extensionsv1alpha1.NodeTemplate{
+				Capacity: corev1.ResourceList{
+					"cpu":            machineTypes[0].CPU,
+					"gpu":            machineTypes[0].GPU,
+					"memory":         resource.MustParse("1Ti"),
+					"nvidia.com/gpu": resource.MustParse("4"),
+				},
+			}))
+		})
+
 		It("should successfully deploy the Worker resource with cluster autoscaler options when present", func() {
 			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
 			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
@@ -149,7 +149,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{"*"},
-					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update", "manage-members", "modify-spec-tolerations-whitelist", "modify-spec-kubernetes", "modify-spec-machineimages", "modify-spec-providerconfig", "mark-self-hosted"},
+					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update", "manage-members", "modify-spec-tolerations-whitelist", "modify-spec-kubernetes", "modify-spec-machineimages", "modify-spec-providerconfig", "mark-self-hosted", "override-images"},
 				},
 				{
 					APIGroups: []string{
@@ -344,6 +344,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{
 						"cloudprofiles",
 						"exposureclasses",
+						"maintenancefreezes",
 						"seeds",
 					},
 					Verbs: []string{"get", "list", "watch"},
@@ -481,6 +482,9 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/ssh",
+						"shoots/footprint",
+						"shoots/impact",
 					},
 					Verbs: []string{"create"},
 				},
@@ -594,8 +598,12 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				},
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
-					Resources: []string{"shoots/viewerkubeconfig"},
-					Verbs:     []string{"create"},
+					Resources: []string{
+						"shoots/viewerkubeconfig",
+						"shoots/footprint",
+						"shoots/impact",
+					},
+					Verbs: []string{"create"},
 				},
 			},
 		}
@@ -170,7 +170,7 @@ var _ = Describe("Virtual", func() {
 				{
 					APIGroups: []string{"core.gardener.cloud"},
 					Resources: []string{"*"},
-					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update", "manage-members", "modify-spec-tolerations-whitelist", "modify-spec-kubernetes", "modify-spec-machineimages", "modify-spec-providerconfig", "mark-self-hosted"},
+					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update", "manage-members", "modify-spec-tolerations-whitelist", "modify-spec-kubernetes", "modify-spec-machineimages", "modify-spec-providerconfig", "mark-self-hosted", "override-images"},
 				},
 				{
 					APIGroups: []string{
@@ -364,6 +364,7 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{
 						"cloudprofiles",
 						"exposureclasses",
+						"maintenancefreezes",
 						"seeds",
 					},
 					Verbs: []string{"get", "list", "watch"},
@@ -500,6 +501,9 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/ssh",
+						"shoots/footprint",
+						"shoots/impact",
 					},
 					Verbs: []string{"create"},
 				},
@@ -611,8 +615,12 @@ var _ = Describe("Virtual", func() {
 				},
 				{
 					APIGroups: []string{"core.gardener.cloud"},
-					Resources: []string{"shoots/viewerkubeconfig"},
-					Verbs:     []string{"create"},
+					Resources: []string{
+						"shoots/viewerkubeconfig",
+						"shoots/footprint",
+						"shoots/impact",
+					},
+					Verbs: []string{"create"},
 				},
 			},
 		}
@@ -61,6 +61,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/endpointslicehints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/extensionvalidation"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/highavailabilityconfig"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/imageoverrides"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/kubernetesservicehost"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podkubeapiserverloadbalancing"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podschedulername"
@@ -69,6 +70,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/seccompprofile"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/systemcomponentsconfig"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/vpainplaceupdates"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/workloaddefaults"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -247,6 +249,8 @@ type Values struct {
 	ConcurrentSyncs *int
 	// HighAvailabilityConfigWebhookEnabled controls whether the high availability config webhook is enabled.
 	HighAvailabilityConfigWebhookEnabled bool
+	// ImageOverridesWebhookEnabled controls whether the image overrides webhook is enabled.
+	ImageOverridesWebhookEnabled bool
 	// DefaultNotReadyTolerationSeconds indicates the tolerationSeconds of the toleration for notReady:NoExecute
 	DefaultNotReadyToleration *int64
 	// DefaultUnreachableTolerationSeconds indicates the tolerationSeconds of the toleration for unreachable:NoExecute
@@ -298,7 +302,8 @@ type Values struct {
 	WatchedNamespace *string
 	// RuntimeKubernetesVersion is the Kubernetes version of the runtime cluster.
 	RuntimeKubernetesVersion *semver.Version
-	// SchedulingProfile is the kube-scheduler profile configured for the Shoot.
+	// SchedulingProfile is the kube-scheduler profile configured for the Shoot, or for the shoot control planes in
+	// case of a Seed.
 	SchedulingProfile *gardencorev1beta1.SchedulingProfile
 	// DefaultSeccompProfileEnabled specifies if the defaulting seccomp profile webhook of GRM should be enabled or not.
 	DefaultSeccompProfileEnabled bool
@@ -309,6 +314,9 @@ type Values struct {
 	KubernetesServiceHost *string
 	// PodTopologySpreadConstraintsEnabled specifies if the pod's TSC should be mutated to support rolling updates.
 	PodTopologySpreadConstraintsEnabled bool
+	// ProjectedTokenExpirationSeconds is the number of seconds until projected service account tokens mounted by the
+	// projected-token-mount webhook expire.
+	ProjectedTokenExpirationSeconds *int64
 	// FailureToleranceType determines the failure tolerance type for the resource manager deployment.
 	FailureToleranceType *gardencorev1beta1.FailureToleranceType
 	// Zones is number of availability zones.
@@ -333,6 +341,10 @@ type Values struct {
 	PodKubeAPIServerLoadBalancingWebhook PodKubeAPIServerLoadBalancingWebhook
 	// VPAInPlaceUpdatesEnabled specifies if a vpa-in-place-pod-vertical-scaling webhook should be enabled.
 	VPAInPlaceUpdatesEnabled bool
+	// WorkloadDefaults contains the topology spread constraints and affinity which are injected into the pods of user
+	// workloads in the target cluster. If it is non-nil, the GRM's workload-defaults webhook will be enabled. This value
+	// is only applicable for the GRM that is deployed in the Shoot control plane (when ResponsibilityMode=ForShootOrVirtualGarden).
+	WorkloadDefaults *gardencorev1beta1.WorkloadDefaults
 }
 
 // PodKubeAPIServerLoadBalancingWebhook specifies the settings of pod-kube-apiserver-load-balancing webhook.
@@ -596,6 +608,9 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 				DefaultNotReadyTolerationSeconds:    r.values.DefaultNotReadyToleration,
 				DefaultUnreachableTolerationSeconds: r.values.DefaultUnreachableToleration,
 			},
+			ImageOverrides: resourcemanagerconfigv1alpha1.ImageOverridesWebhookConfig{
+				Enabled: r.values.ImageOverridesWebhookEnabled,
+			},
 			PodKubeAPIServerLoadBalancing: resourcemanagerconfigv1alpha1.PodKubeAPIServerLoadBalancingWebhookConfig{
 				Enabled: r.values.PodKubeAPIServerLoadBalancingWebhook.Enabled,
 			},
@@ -603,7 +618,8 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 				Enabled: r.values.PodTopologySpreadConstraintsEnabled,
 			},
 			ProjectedTokenMount: resourcemanagerconfigv1alpha1.ProjectedTokenMountWebhookConfig{
-				Enabled: true,
+				Enabled:           true,
+				ExpirationSeconds: r.values.ProjectedTokenExpirationSeconds,
 			},
 			NodeAgentAuthorizer: resourcemanagerconfigv1alpha1.NodeAgentAuthorizerWebhookConfig{
 				Enabled:                r.values.NodeAgentAuthorizerEnabled,
@@ -688,6 +704,15 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 		}
 
 		config.Controllers.NodeCriticalComponents.Enabled = true
+		config.Controllers.VolumeSnapshotPolicy.Enabled = true
+
+		if r.values.WorkloadDefaults != nil {
+			config.Webhooks.WorkloadDefaults = resourcemanagerconfigv1alpha1.WorkloadDefaultsWebhookConfig{
+				Enabled:                   true,
+				TopologySpreadConstraints: r.values.WorkloadDefaults.TopologySpreadConstraints,
+				Affinity:                  r.values.WorkloadDefaults.Affinity,
+			}
+		}
 	}
 
 	// this function should be called at the last to make sure we disable
@@ -1330,9 +1355,18 @@ func (r *resourceManager) newMutatingWebhookConfigurationWebhooks(
 		webhooks = append(webhooks, NewHighAvailabilityConfigMutatingWebhook(namespaceSelector, objectSelector, secretServerCA, buildClientConfigFn))
 	}
 
+	if r.values.ImageOverridesWebhookEnabled {
+		webhooks = append(webhooks, NewImageOverridesMutatingWebhook(secretServerCA, buildClientConfigFn))
+	}
+
 	if r.values.SchedulingProfile != nil && *r.values.SchedulingProfile == gardencorev1beta1.SchedulingProfileBinPacking {
-		// pod scheduler name webhook should be active on all namespaces
-		webhooks = append(webhooks, NewPodSchedulerNameMutatingWebhook(&metav1.LabelSelector{}, secretServerCA, buildClientConfigFn))
+		// pod scheduler name webhook should be active on all namespaces of shoots, and only on the shoot control plane
+		// namespaces of seeds
+		podSchedulerNameNamespaceSelector := &metav1.LabelSelector{}
+		if r.values.ResponsibilityMode == ForRuntime {
+			podSchedulerNameNamespaceSelector.MatchLabels = map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}
+		}
+		webhooks = append(webhooks, NewPodSchedulerNameMutatingWebhook(podSchedulerNameNamespaceSelector, secretServerCA, buildClientConfigFn))
 	}
 
 	if r.values.DefaultSeccompProfileEnabled {
@@ -1351,6 +1385,10 @@ func (r *resourceManager) newMutatingWebhookConfigurationWebhooks(
 
 	if r.values.ResponsibilityMode == ForShootOrVirtualGarden {
 		webhooks = append(webhooks, NewSystemComponentsConfigMutatingWebhook(namespaceSelector, objectSelector, secretServerCA, buildClientConfigFn))
+
+		if r.values.WorkloadDefaults != nil {
+			webhooks = append(webhooks, NewWorkloadDefaultsMutatingWebhook(secretServerCA, buildClientConfigFn))
+		}
 	}
 
 	if r.values.EndpointSliceHintsEnabled {
@@ -1437,6 +1475,7 @@ func NewCRDDeletionProtectionValidatingWebhooks(secretServerCA *corev1.Secret, b
 							"networks",
 							"operatingsystemconfigs",
 							"selfhostedshootexposures",
+							"shootleftovers",
 							"workers",
 						},
 					},
@@ -1572,6 +1611,15 @@ func NewExtensionValidationValidatingWebhooks(secretServerCA *corev1.Secret, bui
 			},
 			path: extensionvalidation.WebhookPathSelfHostedShootExposure,
 		},
+		{
+			resource: "shootleftovers",
+			rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{extensionsv1alpha1.SchemeGroupVersion.Group},
+				APIVersions: []string{extensionsv1alpha1.SchemeGroupVersion.Version},
+				Resources:   []string{"shootleftovers"},
+			},
+			path: extensionvalidation.WebhookPathShootLeftover,
+		},
 		{
 			resource: "workers",
 			rule: admissionregistrationv1.Rule{
@@ -1860,6 +1908,57 @@ func NewSystemComponentsConfigMutatingWebhook(namespaceSelector, objectSelector
 	}
 }
 
+// NewWorkloadDefaultsMutatingWebhook returns the workload-defaults mutating webhook for the resourcemanager component
+// for reuse between the component and integration tests. In contrast to the other webhooks, it only considers pods of
+// user workloads, i.e., pods outside the system namespaces which are not managed by Gardener.
+func NewWorkloadDefaultsMutatingWebhook(secretServerCA *corev1.Secret, buildClientConfigFn func(*corev1.Secret, string) admissionregistrationv1.WebhookClientConfig) admissionregistrationv1.MutatingWebhook {
+	return admissionregistrationv1.MutatingWebhook{
+		Name: "workload-defaults.resources.gardener.cloud",
+		Rules: []admissionregistrationv1.RuleWithOperations{{
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{corev1.GroupName},
+				APIVersions: []string{corev1.SchemeGroupVersion.Version},
+				Resources:   []string{"pods"},
+			},
+			Operations: []admissionregistrationv1.OperationType{
+				admissionregistrationv1.Create,
+			},
+		}},
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      corev1.LabelMetadataName,
+					Operator: metav1.LabelSelectorOpNotIn,
+					Values:   []string{metav1.NamespaceSystem, v1beta1constants.KubernetesDashboardNamespace},
+				},
+				{
+					Key:      resourcesv1alpha1.WorkloadDefaultsSkip,
+					Operator: metav1.LabelSelectorOpDoesNotExist,
+				},
+			},
+		},
+		ObjectSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      resourcesv1alpha1.ManagedBy,
+					Operator: metav1.LabelSelectorOpNotIn,
+					Values:   []string{resourcesv1alpha1.GardenerManager},
+				},
+				{
+					Key:      resourcesv1alpha1.WorkloadDefaultsSkip,
+					Operator: metav1.LabelSelectorOpDoesNotExist,
+				},
+			},
+		},
+		ClientConfig:            buildClientConfigFn(secretServerCA, workloaddefaults.WebhookPath),
+		AdmissionReviewVersions: []string{admissionv1beta1.SchemeGroupVersion.Version, admissionv1.SchemeGroupVersion.Version},
+		FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+		MatchPolicy:             ptr.To(admissionregistrationv1.Exact),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		TimeoutSeconds:          ptr.To[int32](10),
+	}
+}
+
 // NewHighAvailabilityConfigMutatingWebhook returns the high-availability-config mutating webhook for the
 // resourcemanager component for reuse between the component and integration tests.
 func NewHighAvailabilityConfigMutatingWebhook(namespaceSelector, objectSelector *metav1.LabelSelector, secretServerCA *corev1.Secret, buildClientConfigFn func(*corev1.Secret, string) admissionregistrationv1.WebhookClientConfig) admissionregistrationv1.MutatingWebhook {
@@ -1918,6 +2017,32 @@ func NewHighAvailabilityConfigMutatingWebhook(namespaceSelector, objectSelector
 	}
 }
 
+// NewImageOverridesMutatingWebhook returns the image-overrides mutating webhook for the resourcemanager component for
+// reuse between the component and integration tests.
+func NewImageOverridesMutatingWebhook(secretServerCA *corev1.Secret, buildClientConfigFn func(*corev1.Secret, string) admissionregistrationv1.WebhookClientConfig) admissionregistrationv1.MutatingWebhook {
+	return admissionregistrationv1.MutatingWebhook{
+		Name: "image-overrides.resources.gardener.cloud",
+		Rules: []admissionregistrationv1.RuleWithOperations{{
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{appsv1.GroupName},
+				APIVersions: []string{appsv1.SchemeGroupVersion.Version},
+				Resources:   []string{"deployments", "statefulsets", "daemonsets"},
+			},
+			Operations: []admissionregistrationv1.OperationType{
+				admissionregistrationv1.Create,
+				admissionregistrationv1.Update,
+			},
+		}},
+		NamespaceSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{resourcesv1alpha1.ImageOverridesConsider: "true"}},
+		ClientConfig:            buildClientConfigFn(secretServerCA, imageoverrides.WebhookPath),
+		AdmissionReviewVersions: []string{admissionv1beta1.SchemeGroupVersion.Version, admissionv1.SchemeGroupVersion.Version},
+		FailurePolicy:           ptr.To(admissionregistrationv1.Fail),
+		MatchPolicy:             ptr.To(admissionregistrationv1.Equivalent),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		TimeoutSeconds:          ptr.To[int32](10),
+	}
+}
+
 // NewInPlaceUpdatesWebhook returns the VerticalPodAutoscaler mutating webhook for the resourcemanager component for reuse
 // between the component and integration tests.
 func NewInPlaceUpdatesWebhook(
@@ -2186,6 +2311,7 @@ func disableControllersAndWebhooksForWorkerlessShoot(config *resourcemanagerconf
 	// disable unneeded controllers
 	config.Controllers.CSRApprover.Enabled = false
 	config.Controllers.NodeCriticalComponents.Enabled = false
+	config.Controllers.VolumeSnapshotPolicy.Enabled = false
 
 	// disable unneeded webhooks
 	config.Webhooks.PodSchedulerName.Enabled = false
@@ -2194,6 +2320,7 @@ func disableControllersAndWebhooksForWorkerlessShoot(config *resourcemanagerconf
 	config.Webhooks.HighAvailabilityConfig.Enabled = false
 	config.Webhooks.PodTopologySpreadConstraints.Enabled = false
 	config.Webhooks.KubernetesServiceHost.Enabled = false
+	config.Webhooks.WorkloadDefaults.Enabled = false
 }
 
 func (r *resourceManager) healthPort() int32 {
@@ -137,6 +137,7 @@ var _ = Describe("ResourceManager", func() {
 		managedResourceSecret                                *corev1.Secret
 		managedResource                                      *resourcesv1alpha1.ManagedResource
 		matchLabelKeysInPodTopologySpreadFeatureGateDisabled bool
+		workloadDefaults                                     *gardencorev1beta1.WorkloadDefaults
 	)
 
 	BeforeEach(func() {
@@ -337,6 +338,7 @@ var _ = Describe("ResourceManager", func() {
 			DefaultSeccompProfileEnabled:              false,
 			EndpointSliceHintsEnabled:                 false,
 			PodTopologySpreadConstraintsEnabled:       true,
+			ProjectedTokenExpirationSeconds:           ptr.To[int64](3600),
 			VPAInPlaceUpdatesEnabled:                  true,
 			LogLevel:                                  "info",
 			LogFormat:                                 "json",
@@ -372,6 +374,7 @@ var _ = Describe("ResourceManager", func() {
 		resourceManager.SetSecrets(secrets)
 
 		matchLabelKeysInPodTopologySpreadFeatureGateDisabled = true
+		workloadDefaults = nil
 		serviceAccount = &corev1.ServiceAccount{
 			ObjectMeta: metav1.ObjectMeta{Name: "gardener-resource-manager",
 				Namespace: deployNamespace,
@@ -458,7 +461,8 @@ var _ = Describe("ResourceManager", func() {
 						Enabled: !isWorkerless && matchLabelKeysInPodTopologySpreadFeatureGateDisabled,
 					},
 					ProjectedTokenMount: resourcemanagerconfigv1alpha1.ProjectedTokenMountWebhookConfig{
-						Enabled: !isWorkerless,
+						Enabled:           !isWorkerless,
+						ExpirationSeconds: cfg.ProjectedTokenExpirationSeconds,
 					},
 					SystemComponentsConfig: resourcemanagerconfigv1alpha1.SystemComponentsConfigWebhookConfig{
 						Enabled: false,
@@ -513,6 +517,7 @@ var _ = Describe("ResourceManager", func() {
 
 			if responsibilityMode == ForRuntime {
 				config.Webhooks.EndpointSliceHints.Enabled = true
+				config.Webhooks.ImageOverrides.Enabled = true
 				config.Webhooks.PodKubeAPIServerLoadBalancing.Enabled = true
 			}
 
@@ -533,6 +538,15 @@ var _ = Describe("ResourceManager", func() {
 					},
 				}
 				config.Controllers.NodeCriticalComponents.Enabled = !isWorkerless
+				config.Controllers.VolumeSnapshotPolicy.Enabled = !isWorkerless
+
+				if workloadDefaults != nil {
+					config.Webhooks.WorkloadDefaults = resourcemanagerconfigv1alpha1.WorkloadDefaultsWebhookConfig{
+						Enabled:                   !isWorkerless,
+						TopologySpreadConstraints: workloadDefaults.TopologySpreadConstraints,
+						Affinity:                  workloadDefaults.Affinity,
+					}
+				}
 			}
 
 			data, err := runtime.Encode(codec, config)
@@ -1047,6 +1061,37 @@ var _ = Describe("ResourceManager", func() {
 				})
 			}
 
+			if responsibilityMode == ForRuntime {
+				obj.Webhooks = append(obj.Webhooks, admissionregistrationv1.MutatingWebhook{
+					Name: "image-overrides.resources.gardener.cloud",
+					Rules: []admissionregistrationv1.RuleWithOperations{{
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"apps"},
+							APIVersions: []string{"v1"},
+							Resources:   []string{"deployments", "statefulsets", "daemonsets"},
+						},
+						Operations: []admissionregistrationv1.OperationType{"CREATE", "UPDATE"},
+					}},
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"image-overrides.resources.gardener.cloud/consider": "true",
+						},
+					},
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{
+						Service: &admissionregistrationv1.ServiceReference{
+							Name:      "gardener-resource-manager",
+							Namespace: deployNamespace,
+							Path:      ptr.To("/webhooks/image-overrides"),
+						},
+					},
+					AdmissionReviewVersions: []string{"v1beta1", "v1"},
+					FailurePolicy:           &failurePolicyFail,
+					MatchPolicy:             &matchPolicyEquivalent,
+					SideEffects:             &sideEffect,
+					TimeoutSeconds:          ptr.To[int32](10),
+				})
+			}
+
 			obj.Webhooks = append(obj.Webhooks,
 				admissionregistrationv1.MutatingWebhook{
 					Name: "seccomp-profile.resources.gardener.cloud",
@@ -1558,6 +1603,49 @@ webhooks:
     - pods
   sideEffects: None
   timeoutSeconds: 10`
+			if workloadDefaults != nil {
+				out += `
+- admissionReviewVersions:
+  - v1beta1
+  - v1
+  clientConfig:
+    url: https://gardener-resource-manager.` + deployNamespace + `:443/webhooks/workload-defaults
+  failurePolicy: Ignore
+  matchPolicy: Exact
+  name: workload-defaults.resources.gardener.cloud
+  namespaceSelector:
+    matchExpressions:
+    - key: kubernetes.io/metadata.name
+      operator: NotIn
+      values:
+      - kube-system
+      - kubernetes-dashboard
+    - key: workload-defaults.resources.gardener.cloud/skip
+      operator: DoesNotExist
+  objectSelector:
+    matchExpressions:
+    - key: resources.gardener.cloud/managed-by
+      operator: NotIn
+      values:
+      - gardener
+    - key: workload-defaults.resources.gardener.cloud/skip
+      operator: DoesNotExist
+    - key: static-pod
+      operator: NotIn
+      values:
+      - "true"
+  rules:
+  - apiGroups:
+    - ""
+    apiVersions:
+    - v1
+    operations:
+    - CREATE
+    resources:
+    - pods
+  sideEffects: None
+  timeoutSeconds: 10`
+			}
 			if matchLabelKeysInPodTopologySpreadFeatureGateDisabled {
 				out += `
 - admissionReviewVersions:
@@ -1718,6 +1806,7 @@ subjects:
 									"networks",
 									"operatingsystemconfigs",
 									"selfhostedshootexposures",
+									"shootleftovers",
 									"workers",
 								},
 							},
@@ -2050,6 +2139,32 @@ subjects:
 					SideEffects:             &sideEffect,
 					TimeoutSeconds:          ptr.To[int32](10),
 				},
+				{
+					Name: "validation.extensions.shootleftovers.resources.gardener.cloud",
+					Rules: []admissionregistrationv1.RuleWithOperations{
+						{
+							Rule: admissionregistrationv1.Rule{
+								APIGroups:   []string{"extensions.gardener.cloud"},
+								APIVersions: []string{"v1alpha1"},
+								Resources:   []string{"shootleftovers"},
+							},
+							Operations: []admissionregistrationv1.OperationType{"CREATE", "UPDATE"},
+						},
+					},
+					FailurePolicy:     &failurePolicyFail,
+					NamespaceSelector: &metav1.LabelSelector{},
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{
+						Service: &admissionregistrationv1.ServiceReference{
+							Name:      "gardener-resource-manager",
+							Namespace: deployNamespace,
+							Path:      ptr.To("/validate-extensions-gardener-cloud-v1alpha1-shootleftover"),
+						},
+					},
+					AdmissionReviewVersions: []string{"v1beta1", "v1"},
+					MatchPolicy:             &matchPolicyExact,
+					SideEffects:             &sideEffect,
+					TimeoutSeconds:          ptr.To[int32](10),
+				},
 				{
 					Name: "validation.extensions.workers.resources.gardener.cloud",
 					Rules: []admissionregistrationv1.RuleWithOperations{
@@ -2283,6 +2398,63 @@ subjects:
 						Expect(resourceManager.Deploy(ctx)).To(Succeed())
 					})
 				})
+
+				Context("workload defaults are configured (WorkloadDefaults webhook should be enabled)", func() {
+					JustBeforeEach(func() {
+						matchLabelKeysInPodTopologySpreadFeatureGateDisabled = false
+						cfg.PodTopologySpreadConstraintsEnabled = false
+						workloadDefaults = &gardencorev1beta1.WorkloadDefaults{
+							TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+								MaxSkew:           1,
+								TopologyKey:       corev1.LabelTopologyZone,
+								WhenUnsatisfiable: corev1.ScheduleAnyway,
+							}},
+						}
+						cfg.WorkloadDefaults = workloadDefaults
+
+						configMap = configMapFor(&watchedNamespace, ForShootOrVirtualGarden, false, false)
+						deployment = deploymentFor(configMap.Name, true, nil, false)
+
+						resourceManager = New(c, deployNamespace, sm, cfg)
+						resourceManager.SetSecrets(secrets)
+
+						compressedData, err := test.BrotliCompressionForManifests(mutatingWebhookConfigurationYAML(), clusterRoleBindingTargetYAML)
+						Expect(err).NotTo(HaveOccurred())
+
+						managedResourceSecret = &corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "managedresource-shoot-core-gardener-resource-manager",
+								Namespace: deployNamespace,
+							},
+							Type: corev1.SecretTypeOpaque,
+							Data: map[string][]byte{
+								"data.yaml.br": compressedData,
+							},
+						}
+						utilruntime.Must(kubernetesutils.MakeUnique(managedResourceSecret))
+
+						managedResource.Spec.SecretRefs = []corev1.LocalObjectReference{
+							{Name: managedResourceSecret.Name},
+						}
+
+						utilruntime.Must(references.InjectAnnotations(managedResource))
+					})
+
+					It("should successfully deploy all resources (w/ shoot access secret)", func() {
+						gomock.InOrder(
+							c.EXPECT().Get(ctx, client.ObjectKey{Namespace: deployNamespace, Name: managedResourceSecret.Name}, gomock.AssignableToTypeOf(&corev1.Secret{})),
+							c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&corev1.Secret{})).Do(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) {
+								Expect(obj).To(DeepEqual(managedResourceSecret))
+							}),
+							c.EXPECT().Get(ctx, client.ObjectKey{Namespace: deployNamespace, Name: "shoot-core-gardener-resource-manager"}, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})),
+							c.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Do(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) {
+								Expect(obj).To(DeepEqual(managedResource))
+							}),
+						)
+
+						Expect(resourceManager.Deploy(ctx)).To(Succeed())
+					})
+				})
 			})
 
 			Context("should successfully deploy all resources (w/ bootstrap kubeconfig)", func() {
@@ -2598,6 +2770,7 @@ subjects:
 				cfg.ResponsibilityMode = ForRuntime
 				cfg.PodKubeAPIServerLoadBalancingWebhook.Enabled = true
 				cfg.VPAInPlaceUpdatesEnabled = true
+				cfg.ImageOverridesWebhookEnabled = true
 				resourceManager = New(c, deployNamespace, sm, cfg)
 				resourceManager.SetSecrets(secrets)
 			})
@@ -61,6 +61,13 @@ func (g *gardenerScheduler) clusterRole() *rbacv1.ClusterRole {
 				},
 				Verbs: []string{"update"},
 			},
+			{
+				APIGroups: []string{gardencorev1beta1.GroupName},
+				Resources: []string{
+					"seeds/status",
+				},
+				Verbs: []string{"patch", "update"},
+			},
 			{
 				APIGroups: []string{coordinationv1beta1.GroupName},
 				Resources: []string{
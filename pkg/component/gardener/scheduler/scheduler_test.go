@@ -258,6 +258,13 @@ var _ = Describe("GardenerScheduler", func() {
 					},
 					Verbs: []string{"update"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{
+						"seeds/status",
+					},
+					Verbs: []string{"patch", "update"},
+				},
 				{
 					APIGroups: []string{coordinationv1beta1.GroupName},
 					Resources: []string{
@@ -31,6 +31,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/apiserver"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/garden"
@@ -48,6 +49,9 @@ const (
 	SecretNameServerCert = "kube-apiserver"
 	// ServicePortName is the name of the port in the service.
 	ServicePortName = "kube-apiserver"
+	// ServicePortNameKonnectivity is the name of the port in the service on which the konnectivity-server accepts
+	// connections from the konnectivity-agents.
+	ServicePortNameKonnectivity = "konnectivity"
 	// UserNameVPNSeedClient is the user name for the HA vpn-seed-client components (used as common name in its client certificate)
 	UserNameVPNSeedClient = "vpn-seed-client"
 
@@ -81,6 +85,8 @@ type Interface interface {
 	SetServiceAccountConfig(ServiceAccountConfig)
 	// SetSNIConfig sets the SNI field in the Values of the deployer.
 	SetSNIConfig(SNIConfig)
+	// SetComponentInjection sets the ComponentInjection field in the Values of the deployer.
+	SetComponentInjection(*extensionsv1alpha1.ComponentInjection)
 }
 
 // Values contains configuration values for the kube-apiserver resources.
@@ -100,6 +106,9 @@ type Values struct {
 	AuthorizationWebhooks []AuthorizationWebhook
 	// Autoscaling contains information for configuring autoscaling settings for the API server.
 	Autoscaling AutoscalingConfig
+	// ComponentInjection contains sidecars, environment variables, and volumes requested by extensions to be injected
+	// into the kube-apiserver pods.
+	ComponentInjection *extensionsv1alpha1.ComponentInjection
 	// DefaultNotReadyTolerationSeconds indicates the tolerationSeconds of the toleration for notReady:NoExecute
 	// that is added by default to every pod that does not already have such a toleration (flag `--default-not-ready-toleration-seconds`).
 	DefaultNotReadyTolerationSeconds *int64
@@ -114,6 +123,8 @@ type Values struct {
 	Images Images
 	// IsWorkerless specifies whether the cluster managed by this API server has worker nodes.
 	IsWorkerless bool
+	// Konnectivity contains information for configuring the konnectivity-server sidecar of the kube-apiserver.
+	Konnectivity KonnectivityConfig
 	// NamePrefix is the prefix for the resource names.
 	NamePrefix string
 	// OIDC contains information for configuring OIDC settings for the kube-apiserver.
@@ -188,6 +199,15 @@ type Images struct {
 	VPNClient string
 	// EnvoyProxy is the image name of the envoy-proxy.
 	EnvoyProxy string
+	// KonnectivityServer is the container image for the konnectivity-server.
+	KonnectivityServer string
+}
+
+// KonnectivityConfig contains information for configuring the konnectivity-server sidecar of the kube-apiserver.
+type KonnectivityConfig struct {
+	// Enabled states whether the konnectivity-server sidecar is deployed and used by the kube-apiserver to connect to
+	// the cluster network. It is mutually exclusive with the VPN.
+	Enabled bool
 }
 
 // VPNConfig contains information for configuring the VPN settings for the kube-apiserver.
@@ -399,6 +419,11 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	secretKonnectivityServer, err := k.reconcileSecretKonnectivityServer(ctx)
+	if err != nil {
+		return err
+	}
+
 	tlsSNISecrets, err := k.reconcileTLSSNISecrets(ctx)
 	if err != nil {
 		return err
@@ -451,6 +476,7 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		secretHTTPProxy,
 		secretHAVPNSeedClient,
 		secretHAVPNClientSeedTLSAuth,
+		secretKonnectivityServer,
 		secretAuditWebhookKubeconfig,
 		secretAuthenticationWebhookKubeconfig,
 		secretAuthorizationWebhooksKubeconfigs,
@@ -638,6 +664,10 @@ func (k *kubeAPIServer) SetSNIConfig(config SNIConfig) {
 	k.values.SNI = config
 }
 
+func (k *kubeAPIServer) SetComponentInjection(injection *extensionsv1alpha1.ComponentInjection) {
+	k.values.ComponentInjection = injection
+}
+
 func (k *kubeAPIServer) prometheusAccessSecretName() string {
 	if k.values.NamePrefix != "" {
 		return garden.AccessSecretName
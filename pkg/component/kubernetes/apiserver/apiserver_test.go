@@ -2621,6 +2621,40 @@ kind: AuthorizationConfiguration
 					}))
 				})
 
+				It("should successfully deploy the configmap resource when Konnectivity is enabled", func() {
+					kapi = New(kubernetesInterface, namespace, sm, Values{
+						Values: apiserver.Values{
+							RuntimeVersion: runtimeVersion,
+						},
+						Version:      version,
+						Konnectivity: KonnectivityConfig{Enabled: true},
+					})
+
+					configMapEgressSelector = &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-egress-selector-config", Namespace: namespace},
+						Data: map[string]string{"egress-selector-configuration.yaml": `apiVersion: apiserver.k8s.io/v1alpha1
+egressSelections:
+- connection:
+    proxyProtocol: GRPC
+    transport:
+      uds:
+        udsName: /etc/srv/kubernetes/konnectivity-server/konnectivity-server.socket
+  name: cluster
+- connection:
+    proxyProtocol: Direct
+  name: controlplane
+- connection:
+    proxyProtocol: Direct
+  name: etcd
+kind: EgressSelectorConfiguration
+`},
+					}
+					Expect(kubernetesutils.MakeUnique(configMapEgressSelector)).To(Succeed())
+
+					Expect(kapi.Deploy(ctx)).To(Succeed())
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapEgressSelector), configMapEgressSelector)).To(Succeed())
+				})
+
 				DescribeTable("do nothing",
 					func(vpnConfig VPNConfig) {
 						kapi = New(kubernetesInterface, namespace, sm, Values{
@@ -3810,6 +3844,66 @@ kind: AuthorizationConfiguration
 					))
 				})
 
+				It("should have the kube-apiserver container and the konnectivity-server sidecar with the expected spec when Konnectivity is enabled", func() {
+					values.Konnectivity = KonnectivityConfig{Enabled: true}
+					values.Images.KonnectivityServer = "konnectivity-server:v1.2.3"
+					values.Autoscaling.MaxReplicas = 4
+					kapi = New(kubernetesInterface, namespace, sm, values)
+					deployAndRead()
+
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement(
+						"--egress-selector-config-file=/etc/kubernetes/egress/egress-selector-configuration.yaml",
+					))
+					Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElements(
+						corev1.VolumeMount{
+							Name:      "konnectivity-uds",
+							MountPath: "/etc/srv/kubernetes/konnectivity-server",
+						},
+						corev1.VolumeMount{
+							Name:      "egress-selection-config",
+							MountPath: "/etc/kubernetes/egress",
+						},
+					))
+					Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{"Name": Equal("http-proxy")})))
+
+					Expect(deployment.Spec.Template.Spec.Containers).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Name":    Equal("konnectivity-server"),
+						"Image":   Equal("konnectivity-server:v1.2.3"),
+						"Command": Equal([]string{"/proxy-server"}),
+						"Args": Equal([]string{
+							"--uds-name=/etc/srv/kubernetes/konnectivity-server/konnectivity-server.socket",
+							"--delete-existing-uds-file=true",
+							"--mode=grpc",
+							"--server-port=0",
+							"--agent-port=8132",
+							"--admin-port=8133",
+							"--health-port=8134",
+							"--cluster-cert=/srv/kubernetes/konnectivity-server/tls.crt",
+							"--cluster-key=/srv/kubernetes/konnectivity-server/tls.key",
+							"--cluster-ca-cert=/srv/kubernetes/ca-vpn/bundle.crt",
+							"--server-count=4",
+							"--proxy-strategies=destHost,default",
+							"--keepalive-time=1h",
+						}),
+						"Ports": Equal([]corev1.ContainerPort{{Name: "agent", ContainerPort: 8132, Protocol: corev1.ProtocolTCP}}),
+						"VolumeMounts": Equal([]corev1.VolumeMount{
+							{Name: "konnectivity-uds", MountPath: "/etc/srv/kubernetes/konnectivity-server"},
+							{Name: "ca-vpn", MountPath: "/srv/kubernetes/ca-vpn"},
+							{Name: "konnectivity-server", MountPath: "/srv/kubernetes/konnectivity-server"},
+						}),
+					})))
+
+					Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElements(
+						corev1.Volume{
+							Name:         "konnectivity-uds",
+							VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+						},
+						MatchFields(IgnoreExtras, Fields{"Name": Equal("egress-selection-config")}),
+						MatchFields(IgnoreExtras, Fields{"Name": Equal("ca-vpn")}),
+						MatchFields(IgnoreExtras, Fields{"Name": Equal("konnectivity-server")}),
+					))
+				})
+
 				It("should have the kube-apiserver container with the expected spec when VPN and HA is enabled", func() {
 					values.VPN = VPNConfig{Enabled: true, HighAvailabilityEnabled: true, PodNetworkCIDRs: []net.IPNet{{IP: net.ParseIP("9.8.7.6"), Mask: net.CIDRMask(24, 32)}}}
 					kapi = New(kubernetesInterface, namespace, sm, values)
@@ -37,36 +37,52 @@ func (k *kubeAPIServer) emptyConfigMap(name string) *corev1.ConfigMap {
 }
 
 func (k *kubeAPIServer) reconcileConfigMapEgressSelector(ctx context.Context, configMap *corev1.ConfigMap) error {
-	if !k.values.VPN.Enabled {
+	var clusterConnection apiserverv1alpha1.Connection
+
+	switch {
+	case k.values.Konnectivity.Enabled:
+		clusterConnection = apiserverv1alpha1.Connection{
+			ProxyProtocol: apiserverv1alpha1.ProtocolGRPC,
+			Transport: &apiserverv1alpha1.Transport{
+				UDS: &apiserverv1alpha1.UDSTransport{
+					UDSName: fmt.Sprintf("%s/%s", volumeMountPathKonnectivityUDS, fileNameKonnectivityUDS),
+				},
+			},
+		}
+
+	case k.values.VPN.Enabled:
+		proxyHost := vpnseedserver.ServiceName
+		proxyPort := vpnseedserver.EnvoyPort
+		if k.values.VPN.HighAvailabilityEnabled {
+			proxyHost = EnvoyHostHAVPN
+			proxyPort = EnvoyPortHAVPN
+		}
+
+		clusterConnection = apiserverv1alpha1.Connection{
+			ProxyProtocol: apiserverv1alpha1.ProtocolHTTPConnect,
+			Transport: &apiserverv1alpha1.Transport{
+				TCP: &apiserverv1alpha1.TCPTransport{
+					URL: fmt.Sprintf("https://%s:%d", proxyHost, proxyPort),
+					TLSConfig: &apiserverv1alpha1.TLSConfig{
+						CABundle:   fmt.Sprintf("%s/%s", volumeMountPathCAVPN, secretsutils.DataKeyCertificateBundle),
+						ClientCert: fmt.Sprintf("%s/%s", volumeMountPathHTTPProxyClient, secretsutils.DataKeyCertificate),
+						ClientKey:  fmt.Sprintf("%s/%s", volumeMountPathHTTPProxyClient, secretsutils.DataKeyPrivateKey),
+					},
+				},
+			},
+		}
+
+	default:
 		// We don't delete the configmap here as we don't know its name (as it's unique). Instead, we rely on the usual
 		// garbage collection for unique secrets/configmaps.
 		return nil
 	}
 
-	proxyHost := vpnseedserver.ServiceName
-	proxyPort := vpnseedserver.EnvoyPort
-	if k.values.VPN.HighAvailabilityEnabled {
-		proxyHost = EnvoyHostHAVPN
-		proxyPort = EnvoyPortHAVPN
-	}
-
 	egressSelectorConfig := &apiserverv1alpha1.EgressSelectorConfiguration{
 		EgressSelections: []apiserverv1alpha1.EgressSelection{
 			{
-				Name: "cluster",
-				Connection: apiserverv1alpha1.Connection{
-					ProxyProtocol: apiserverv1alpha1.ProtocolHTTPConnect,
-					Transport: &apiserverv1alpha1.Transport{
-						TCP: &apiserverv1alpha1.TCPTransport{
-							URL: fmt.Sprintf("https://%s:%d", proxyHost, proxyPort),
-							TLSConfig: &apiserverv1alpha1.TLSConfig{
-								CABundle:   fmt.Sprintf("%s/%s", volumeMountPathCAVPN, secretsutils.DataKeyCertificateBundle),
-								ClientCert: fmt.Sprintf("%s/%s", volumeMountPathHTTPProxyClient, secretsutils.DataKeyCertificate),
-								ClientKey:  fmt.Sprintf("%s/%s", volumeMountPathHTTPProxyClient, secretsutils.DataKeyPrivateKey),
-							},
-						},
-					},
-				},
+				Name:       "cluster",
+				Connection: clusterConnection,
 			},
 			{
 				Name:       "controlplane",
@@ -7,6 +7,12 @@ package constants
 const (
 	// Port is the port exposed by the kube-apiserver.
 	Port = 443
+	// KonnectivityServerAgentPort is the port exposed by the konnectivity-server sidecar of the kube-apiserver on which
+	// it accepts connections from the konnectivity-agents.
+	KonnectivityServerAgentPort = 8132
+	// KonnectivityServerHost is the hostname used by the konnectivity-agents to connect to the konnectivity-server. It is
+	// contained in the SANs of the konnectivity-server's certificate.
+	KonnectivityServerHost = "konnectivity-server"
 	// RequestHeaderGroup is the header key for the group headers.
 	RequestHeaderGroup = "X-Remote-Group"
 	// RequestHeaderUserName is the header key for the username headers.
@@ -20,6 +20,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/ptr"
 
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/apiserver"
@@ -40,16 +41,21 @@ import (
 )
 
 const (
-	secretNameKubeAPIServerToKubelet = "kube-apiserver-kubelet"           // #nosec G101 -- No credential.
-	secretNameKubeAggregator         = "kube-aggregator"                  // #nosec G101 -- No credential.
-	secretNameHTTPProxyClient        = "kube-apiserver-http-proxy-client" // #nosec G101 -- No credential.
-	secretNameHTTPProxy              = "kube-apiserver-http-proxy"        // #nosec G101 -- No credential.
-	secretNameHAVPNSeedClient        = "vpn-seed-client"                  // #nosec G101 -- No credential.
+	secretNameKubeAPIServerToKubelet = "kube-apiserver-kubelet"             // #nosec G101 -- No credential.
+	secretNameKubeAggregator         = "kube-aggregator"                    // #nosec G101 -- No credential.
+	secretNameHTTPProxyClient        = "kube-apiserver-http-proxy-client"   // #nosec G101 -- No credential.
+	secretNameHTTPProxy              = "kube-apiserver-http-proxy"          // #nosec G101 -- No credential.
+	secretNameHAVPNSeedClient        = "vpn-seed-client"                    // #nosec G101 -- No credential.
+	secretNameKonnectivityServer     = "kube-apiserver-konnectivity-server" // #nosec G101 -- No credential.
 
 	// ContainerNameKubeAPIServer is the name of the kube-apiserver container.
-	ContainerNameKubeAPIServer     = "kube-apiserver"
-	containerNameVPNPathController = "vpn-path-controller"
-	containerNameVPNSeedClient     = "vpn-client"
+	ContainerNameKubeAPIServer      = "kube-apiserver"
+	containerNameVPNPathController  = "vpn-path-controller"
+	containerNameVPNSeedClient      = "vpn-client"
+	containerNameKonnectivityServer = "konnectivity-server"
+
+	konnectivityServerAdminPort  = 8133
+	konnectivityServerHealthPort = 8134
 
 	// EnvoyPortHAVPN is the port exposed by the envoy proxy on which it receives http proxy/connect requests.
 	EnvoyPortHAVPN = 9443
@@ -77,6 +83,8 @@ const (
 	volumeNameDevNetTun                       = "dev-net-tun"
 	volumeNameEnvoyConfig                     = "envoy-config"
 	volumeNameCerts                           = "certs"
+	volumeNameKonnectivityServer              = "konnectivity-server"
+	volumeNameKonnectivityUDS                 = "konnectivity-uds"
 
 	volumeMountPathAuthenticationWebhookKubeconfig = "/etc/kubernetes/webhook/authentication"
 	volumeMountPathCA                              = "/srv/kubernetes/ca"
@@ -96,8 +104,11 @@ const (
 	volumeMountPathAPIServerAccess                 = "/var/run/secrets/kubernetes.io/serviceaccount"
 	volumeMountPathVPNSeedTLSAuth                  = "/srv/secrets/tlsauth"
 	volumeMountPathDevNetTun                       = "/dev/net/tun"
+	volumeMountPathKonnectivityServer              = "/srv/kubernetes/konnectivity-server"
+	volumeMountPathKonnectivityUDS                 = "/etc/srv/kubernetes/konnectivity-server"
 
-	fileNameCABundle = "ca.crt"
+	fileNameCABundle        = "ca.crt"
+	fileNameKonnectivityUDS = "konnectivity-server.socket"
 )
 
 func (k *kubeAPIServer) emptyDeployment() *appsv1.Deployment {
@@ -126,6 +137,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 	secretHTTPProxy *corev1.Secret,
 	secretHAVPNSeedClient *corev1.Secret,
 	secretHAVPNSeedClientSeedTLSAuth *corev1.Secret,
+	secretKonnectivityServer *corev1.Secret,
 	secretAuditWebhookKubeconfig *corev1.Secret,
 	secretAuthenticationWebhookKubeconfig *corev1.Secret,
 	secretAuthorizationWebhooksKubeconfigs *corev1.Secret,
@@ -393,10 +405,15 @@ func (k *kubeAPIServer) reconcileDeployment(
 		if err := k.handleVPNSettings(deployment, serviceAccount, configMapEgressSelector, configMapEnvoyConfig, secretHTTPProxyClient, secretHTTPProxy, secretHAVPNSeedClient, secretHAVPNSeedClientSeedTLSAuth); err != nil {
 			return err
 		}
+		if err := k.handleKonnectivitySettings(deployment, configMapEgressSelector, secretKonnectivityServer); err != nil {
+			return err
+		}
 		if err := k.handleKubeletSettings(deployment, secretKubeletClient); err != nil {
 			return err
 		}
 
+		extensionsv1alpha1helper.InjectComponentIntoPodSpec(&deployment.Spec.Template.Spec, ContainerNameKubeAPIServer, k.values.ComponentInjection)
+
 		utilruntime.Must(references.InjectAnnotations(deployment))
 		return nil
 	})
@@ -1052,6 +1069,148 @@ func (k *kubeAPIServer) vpnSeedEnvoyProxyContainer() *corev1.Container {
 	return envoy.GetEnvoyProxyContainer(k.values.Images.EnvoyProxy)
 }
 
+func (k *kubeAPIServer) handleKonnectivitySettings(
+	deployment *appsv1.Deployment,
+	configMapEgressSelector *corev1.ConfigMap,
+	secretKonnectivityServer *corev1.Secret,
+) error {
+	if !k.values.Konnectivity.Enabled {
+		return nil
+	}
+
+	secretCAVPN, found := k.secretsManager.Get(v1beta1constants.SecretNameCAVPN)
+	if !found {
+		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAVPN)
+	}
+
+	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--egress-selector-config-file=%s/%s", volumeMountPathEgressSelector, configMapEgressSelectorDataKey))
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, []corev1.VolumeMount{
+		{
+			Name:      volumeNameKonnectivityUDS,
+			MountPath: volumeMountPathKonnectivityUDS,
+		},
+		{
+			Name:      volumeNameEgressSelector,
+			MountPath: volumeMountPathEgressSelector,
+		},
+	}...)
+	deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, *k.konnectivityServerContainer())
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, []corev1.Volume{
+		{
+			Name: volumeNameKonnectivityUDS,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+		{
+			Name: volumeNameEgressSelector,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: configMapEgressSelector.Name,
+					},
+				},
+			},
+		},
+		{
+			Name: volumeNameCAVPN,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretCAVPN.Name,
+				},
+			},
+		},
+		{
+			Name: volumeNameKonnectivityServer,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  secretKonnectivityServer.Name,
+					DefaultMode: ptr.To[int32](0640),
+				},
+			},
+		},
+	}...)
+
+	return nil
+}
+
+func (k *kubeAPIServer) konnectivityServerContainer() *corev1.Container {
+	// The konnectivity-agents open one connection per konnectivity-server instance, hence each server must know how
+	// many instances exist at most. Otherwise, agents might never connect to newly created kube-apiserver replicas.
+	serverCount := k.values.Autoscaling.MaxReplicas
+	if serverCount < 1 {
+		serverCount = 1
+	}
+
+	return &corev1.Container{
+		Name:            containerNameKonnectivityServer,
+		Image:           k.values.Images.KonnectivityServer,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"/proxy-server"},
+		Args: []string{
+			fmt.Sprintf("--uds-name=%s/%s", volumeMountPathKonnectivityUDS, fileNameKonnectivityUDS),
+			"--delete-existing-uds-file=true",
+			"--mode=grpc",
+			"--server-port=0",
+			fmt.Sprintf("--agent-port=%d", kubeapiserverconstants.KonnectivityServerAgentPort),
+			fmt.Sprintf("--admin-port=%d", konnectivityServerAdminPort),
+			fmt.Sprintf("--health-port=%d", konnectivityServerHealthPort),
+			fmt.Sprintf("--cluster-cert=%s/%s", volumeMountPathKonnectivityServer, secrets.DataKeyCertificate),
+			fmt.Sprintf("--cluster-key=%s/%s", volumeMountPathKonnectivityServer, secrets.DataKeyPrivateKey),
+			fmt.Sprintf("--cluster-ca-cert=%s/%s", volumeMountPathCAVPN, secrets.DataKeyCertificateBundle),
+			fmt.Sprintf("--server-count=%d", serverCount),
+			"--proxy-strategies=destHost,default",
+			"--keepalive-time=1h",
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "agent",
+				ContainerPort: kubeapiserverconstants.KonnectivityServerAgentPort,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		LivenessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/healthz",
+					Scheme: corev1.URISchemeHTTP,
+					Port:   intstr.FromInt32(konnectivityServerHealthPort),
+				},
+			},
+			InitialDelaySeconds: 10,
+			TimeoutSeconds:      15,
+			PeriodSeconds:       10,
+			SuccessThreshold:    1,
+			FailureThreshold:    3,
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("20M"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      volumeNameKonnectivityUDS,
+				MountPath: volumeMountPathKonnectivityUDS,
+			},
+			{
+				Name:      volumeNameCAVPN,
+				MountPath: volumeMountPathCAVPN,
+			},
+			{
+				Name:      volumeNameKonnectivityServer,
+				MountPath: volumeMountPathKonnectivityServer,
+			},
+		},
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+	}
+}
+
 func (k *kubeAPIServer) handleServiceAccountSigningKeySettings(deployment *appsv1.Deployment) {
 	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--service-account-signing-key-file=%s/%s", volumeMountPathServiceAccountKey, secrets.DataKeyRSAPrivateKey))
 	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--service-account-key-file=%s/%s", volumeMountPathServiceAccountKeyBundle, secrets.DataKeyPrivateKeyBundle))
@@ -14,6 +14,7 @@ import (
 	net "net"
 	reflect "reflect"
 
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	apiserver "github.com/gardener/gardener/pkg/component/apiserver"
 	apiserver0 "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	logr "github.com/go-logr/logr"
@@ -149,6 +150,18 @@ func (mr *MockInterfaceMockRecorder) SetAutoscalingReplicas(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoscalingReplicas", reflect.TypeOf((*MockInterface)(nil).SetAutoscalingReplicas), arg0)
 }
 
+// SetComponentInjection mocks base method.
+func (m *MockInterface) SetComponentInjection(arg0 *v1alpha1.ComponentInjection) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetComponentInjection", arg0)
+}
+
+// SetComponentInjection indicates an expected call of SetComponentInjection.
+func (mr *MockInterfaceMockRecorder) SetComponentInjection(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetComponentInjection", reflect.TypeOf((*MockInterface)(nil).SetComponentInjection), arg0)
+}
+
 // SetETCDEncryptionConfig mocks base method.
 func (m *MockInterface) SetETCDEncryptionConfig(arg0 apiserver.ETCDEncryptionConfig) {
 	m.ctrl.T.Helper()
@@ -21,6 +21,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component/apiserver"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
 	vpnseedserver "github.com/gardener/gardener/pkg/component/networking/vpn/seedserver"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
@@ -226,6 +227,21 @@ func (k *kubeAPIServer) reconcileSecretHTTPProxy(ctx context.Context) (*corev1.S
 	}, secretsmanager.SignedByCA(v1beta1constants.SecretNameCAVPN), secretsmanager.Rotate(secretsmanager.InPlace))
 }
 
+// server cert presented to the konnectivity-agents by the konnectivity-server
+func (k *kubeAPIServer) reconcileSecretKonnectivityServer(ctx context.Context) (*corev1.Secret, error) {
+	if !k.values.Konnectivity.Enabled {
+		return nil, nil
+	}
+
+	return k.secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+		Name:                        secretNameKonnectivityServer,
+		CommonName:                  kubeapiserverconstants.KonnectivityServerHost,
+		CertType:                    secretsutils.ServerCert,
+		DNSNames:                    []string{kubeapiserverconstants.KonnectivityServerHost},
+		SkipPublishingCACertificate: true,
+	}, secretsmanager.SignedByCA(v1beta1constants.SecretNameCAVPN), secretsmanager.Rotate(secretsmanager.InPlace))
+}
+
 func (k *kubeAPIServer) reconcileSecretHAVPNSeedClient(ctx context.Context) (*corev1.Secret, error) {
 	if !k.values.VPN.Enabled || !k.values.VPN.HighAvailabilityEnabled {
 		return nil, nil
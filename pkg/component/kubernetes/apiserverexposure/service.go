@@ -49,6 +49,9 @@ type ServiceValues struct {
 	TopologyAwareRoutingEnabled bool
 	// RuntimeKubernetesVersion is the Kubernetes version of the runtime cluster.
 	RuntimeKubernetesVersion *semver.Version
+	// KonnectivityEnabled indicates whether the port of the konnectivity-server sidecar shall be exposed in addition to
+	// the kube-apiserver port.
+	KonnectivityEnabled bool
 }
 
 // serviceValues configure the kube-apiserver service.
@@ -59,6 +62,7 @@ type serviceValues struct {
 	nameSuffix                  string
 	topologyAwareRoutingEnabled bool
 	runtimeKubernetesVersion    *semver.Version
+	konnectivityEnabled         bool
 }
 
 // NewService creates a new instance of DeployWaiter for the Service used to expose the kube-apiserver.
@@ -97,6 +101,7 @@ func NewService(
 		internalValues.nameSuffix = values.NameSuffix
 		internalValues.topologyAwareRoutingEnabled = values.TopologyAwareRoutingEnabled
 		internalValues.runtimeKubernetesVersion = values.RuntimeKubernetesVersion
+		internalValues.konnectivityEnabled = values.KonnectivityEnabled
 	}
 
 	return &service{
@@ -160,16 +165,26 @@ func (s *service) Deploy(ctx context.Context) error {
 			obj.Labels[kubeapiserver.LabelMetricsScrapeTarget] = "true"
 		}
 
-		obj.Spec.Type = corev1.ServiceTypeClusterIP
-		obj.Spec.Selector = getLabels()
-		obj.Spec.Ports = kubernetesutils.ReconcileServicePorts(obj.Spec.Ports, []corev1.ServicePort{
+		ports := []corev1.ServicePort{
 			{
 				Name:       kubeapiserver.ServicePortName,
 				Protocol:   corev1.ProtocolTCP,
 				Port:       kubeapiserverconstants.Port,
 				TargetPort: intstr.FromInt32(kubeapiserverconstants.Port),
 			},
-		}, corev1.ServiceTypeClusterIP)
+		}
+		if s.values.konnectivityEnabled {
+			ports = append(ports, corev1.ServicePort{
+				Name:       kubeapiserver.ServicePortNameKonnectivity,
+				Protocol:   corev1.ProtocolTCP,
+				Port:       kubeapiserverconstants.KonnectivityServerAgentPort,
+				TargetPort: intstr.FromInt32(kubeapiserverconstants.KonnectivityServerAgentPort),
+			})
+		}
+
+		obj.Spec.Type = corev1.ServiceTypeClusterIP
+		obj.Spec.Selector = getLabels()
+		obj.Spec.Ports = kubernetesutils.ReconcileServicePorts(obj.Spec.Ports, ports, corev1.ServiceTypeClusterIP)
 		obj.Spec.IPFamilyPolicy = ptr.To(corev1.IPFamilyPolicyPreferDualStack)
 
 		return nil
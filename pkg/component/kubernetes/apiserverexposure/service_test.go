@@ -286,6 +286,24 @@ var _ = Describe("#Service", func() {
 		assertService()
 	})
 
+	Context("when konnectivity is enabled", func() {
+		BeforeEach(func() {
+			values.KonnectivityEnabled = true
+
+			expected.Annotations = utils.MergeStringMaps(map[string]string{
+				"networking.istio.io/exportTo": "*",
+			}, netpolAnnotations())
+			expected.Spec.Ports = append(expected.Spec.Ports, corev1.ServicePort{
+				Name:       "konnectivity",
+				Port:       8132,
+				Protocol:   corev1.ProtocolTCP,
+				TargetPort: intstr.FromInt32(8132),
+			})
+		})
+
+		assertService()
+	})
+
 	Context("when the service has custom annotations", func() {
 		BeforeEach(func() {
 			customAnnotations = map[string]string{"foo": "bar"}
@@ -9,6 +9,7 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"slices"
 	"strings"
 	"text/template"
 	"time"
@@ -49,6 +50,9 @@ const (
 	AuthenticationDynamicMetadataKey = "authenticated-kube-apiserver-host"
 	// IstioTLSTerminationEnvoyFilterSuffix is the suffix for the envoy filter used for TLS termination.
 	IstioTLSTerminationEnvoyFilterSuffix = "-istio-tls-termination"
+	// SourceRangesAuthorizationPolicySuffix is the suffix for the authorization policy used to restrict the client IPs
+	// which are allowed to access the kube-apiserver.
+	SourceRangesAuthorizationPolicySuffix = "-source-ranges"
 
 	// authenticationDynamicMetadataKeyAPIServerProxy is the key used to configure the istio envoy filter for the APIServer proxy.
 	authenticationDynamicMetadataKeyAPIServerProxy = "authenticated-shoot"
@@ -86,6 +90,9 @@ var (
 	//go:embed templates/envoyfilter-istio-tls-termination.yaml
 	envoyFilterIstioTLSTerminationTemplateContent string
 	envoyFilterIstioTLSTerminationTemplate        *template.Template
+	//go:embed templates/authorizationpolicy-source-ranges.yaml
+	authorizationPolicySourceRangesTemplateContent string
+	authorizationPolicySourceRangesTemplate        *template.Template
 )
 
 func init() {
@@ -99,6 +106,11 @@ func init() {
 		Funcs(sprig.TxtFuncMap()).
 		Parse(envoyFilterIstioTLSTerminationTemplateContent),
 	)
+	authorizationPolicySourceRangesTemplate = template.Must(template.
+		New("authorization-policy-source-ranges").
+		Funcs(sprig.TxtFuncMap()).
+		Parse(authorizationPolicySourceRangesTemplateContent),
+	)
 }
 
 // SNIValues configure the kube-apiserver service SNI.
@@ -108,6 +120,9 @@ type SNIValues struct {
 	IstioIngressGateway   IstioIngressGateway
 	IstioTLSTermination   bool
 	WildcardConfiguration *WildcardConfiguration
+	// SourceRanges are the CIDRs of the clients which are allowed to access the kube-apiserver via the SNI hosts. If
+	// empty, access is not restricted.
+	SourceRanges []string
 }
 
 // APIServerProxy contains values for the APIServer proxy protocol configuration.
@@ -195,6 +210,16 @@ type envoyFilterIstioTLSTerminationTemplateValues struct {
 	ConnectionUpgradeRouteName       string
 }
 
+type authorizationPolicySourceRangesTemplateValues struct {
+	Hosts                    []string
+	SourceRanges             []string
+	IngressGatewayLabels     map[string]string
+	Name                     string
+	Namespace                string
+	ControlPlaneNamespace    string
+	ControlPlaneNamespaceUID string
+}
+
 type istioGatewayConfiguration struct {
 	istioIngressGateway   IstioIngressGateway
 	hosts                 []string
@@ -310,7 +335,44 @@ func (s *sni) Deploy(ctx context.Context) error {
 			filename := fmt.Sprintf("envoyfilter__%s__%s.yaml", envoyFilter.Namespace, envoyFilter.Name)
 			registry.AddSerialized(filename, envoyFilterIstioTLSTermination.Bytes())
 		}
+	}
+
+	if len(values.SourceRanges) > 0 {
+		for _, configuration := range istioGatewayConfigurations {
+			var (
+				authorizationPolicySourceRanges bytes.Buffer
+
+				allHosts        = slices.Clone(configuration.hosts)
+				policyName      = s.namespace + SourceRangesAuthorizationPolicySuffix
+				policyNamespace = configuration.istioIngressGateway.Namespace
+			)
+
+			if configuration.wildcardConfiguration != nil {
+				allHosts = append(allHosts, configuration.wildcardConfiguration.Hosts...)
+			}
+
+			if len(allHosts) == 0 {
+				continue
+			}
+
+			if err := authorizationPolicySourceRangesTemplate.Execute(&authorizationPolicySourceRanges, authorizationPolicySourceRangesTemplateValues{
+				Hosts:                    allHosts,
+				SourceRanges:             values.SourceRanges,
+				IngressGatewayLabels:     configuration.istioIngressGateway.Labels,
+				Name:                     policyName,
+				Namespace:                policyNamespace,
+				ControlPlaneNamespace:    namespace.Name,
+				ControlPlaneNamespaceUID: string(namespace.UID),
+			}); err != nil {
+				return err
+			}
+
+			filename := fmt.Sprintf("authorizationpolicy__%s__%s.yaml", policyNamespace, policyName)
+			registry.AddSerialized(filename, authorizationPolicySourceRanges.Bytes())
+		}
+	}
 
+	if values.IstioTLSTermination || len(values.SourceRanges) > 0 {
 		serializedObjects, err := registry.SerializedObjects()
 		if err != nil {
 			return err
@@ -58,6 +58,7 @@ var _ = Describe("#SNI", func() {
 		wildcardHosts               []string
 		wildcardTLSSecret           corev1.Secret
 		wildcardIstioIngressGateway *IstioIngressGateway
+		sourceRanges                []string
 
 		expectedDestinationRule                                  *istionetworkingv1beta1.DestinationRule
 		expectedGateway                                          *istionetworkingv1beta1.Gateway
@@ -108,6 +109,7 @@ var _ = Describe("#SNI", func() {
 			Labels:    istioWildcardLabels,
 			Namespace: istioWildcardNamespace,
 		}
+		sourceRanges = nil
 
 		sm = fakesecretsmanager.New(c, namespace)
 
@@ -338,6 +340,7 @@ var _ = Describe("#SNI", func() {
 				},
 				IstioTLSTermination:   istioTLSTermination,
 				WildcardConfiguration: wildcardConfiguration,
+				SourceRanges:          sourceRanges,
 			}
 			return val
 		})
@@ -748,6 +751,93 @@ var _ = Describe("#SNI", func() {
 		})
 	})
 
+	Describe("#Deploy with source ranges", func() {
+		expectedAuthorizationPolicy := func(name, namespace string, labels map[string]string, hosts ...string) string {
+			var labelLines, hostLines string
+			for k, v := range labels {
+				labelLines += "      " + k + ": " + v + "\n"
+			}
+			for _, host := range hosts {
+				hostLines += "      - " + host + "\n"
+			}
+
+			return `apiVersion: security.istio.io/v1
+kind: AuthorizationPolicy
+metadata:
+  name: ` + name + `
+  namespace: ` + namespace + `
+  ownerReferences:
+  - apiVersion: v1
+    blockOwnerDeletion: true
+    kind: Namespace
+    name: test-namespace
+    uid: foo
+spec:
+  selector:
+    matchLabels:
+` + labelLines + `  action: DENY
+  rules:
+  - from:
+    - source:
+        notRemoteIpBlocks:
+        - 10.0.0.0/8
+        - 2001:db8::/32
+    when:
+    - key: connection.sni
+      values:
+` + hostLines
+		}
+
+		manifests := func(mrData []byte) []string {
+			var manifests []string
+			for mrDataSet := range strings.SplitSeq(string(mrData), "---\n") {
+				if mrDataSet != "" {
+					manifests = append(manifests, mrDataSet)
+				}
+			}
+			return manifests
+		}
+
+		BeforeEach(func() {
+			sourceRanges = []string{"10.0.0.0/8", "2001:db8::/32"}
+		})
+
+		It("should deploy an authorization policy restricting the source ranges", func() {
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			mrData := validateManagedResourceAndGetData(ctx, c, expectedManagedResourceSNI)
+			Expect(manifests(mrData)).To(ConsistOf(
+				expectedAuthorizationPolicy("test-namespace-source-ranges", istioNamespace, istioLabels, hosts...),
+			))
+		})
+
+		It("should deploy an authorization policy per ingress gateway if a dedicated wildcard gateway is configured", func() {
+			wildcardConfiguration = &WildcardConfiguration{
+				IstioIngressGateway: wildcardIstioIngressGateway,
+				TLSSecret:           wildcardTLSSecret,
+				Hosts:               wildcardHosts,
+			}
+
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			mrData := validateManagedResourceAndGetData(ctx, c, expectedManagedResourceSNI)
+			Expect(manifests(mrData)).To(ConsistOf(
+				expectedAuthorizationPolicy("test-namespace-source-ranges", istioNamespace, istioLabels, hosts...),
+				expectedAuthorizationPolicy("test-namespace-source-ranges", istioWildcardNamespace, istioWildcardLabels, wildcardHosts...),
+			))
+		})
+
+		It("should delete the managed resource when the source ranges are removed", func() {
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(expectedManagedResourceSNI), &resourcesv1alpha1.ManagedResource{})).To(Succeed())
+
+			sourceRanges = nil
+
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(expectedManagedResourceSNI), &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		})
+	})
+
 	It("should succeed destroying", func() {
 		istioTLSTermination = true
 
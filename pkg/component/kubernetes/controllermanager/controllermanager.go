@@ -28,8 +28,10 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
@@ -85,6 +87,8 @@ type Interface interface {
 	SetServiceNetworks([]net.IPNet)
 	// SetPodNetworks sets the pod CIDRs of the shoot network.
 	SetPodNetworks([]net.IPNet)
+	// SetComponentInjection sets the sidecars, environment variables, and volumes to inject into the pods.
+	SetComponentInjection(*extensionsv1alpha1.ComponentInjection)
 }
 
 // New creates a new instance of DeployWaiter for the kube-controller-manager.
@@ -147,6 +151,9 @@ type Values struct {
 	RuntimeConfig map[string]bool
 	// ManagedResourceLabels are labels added to the ManagedResource.
 	ManagedResourceLabels map[string]string
+	// ComponentInjection contains sidecars, environment variables, and volumes requested by extensions to be injected
+	// into the kube-controller-manager pods.
+	ComponentInjection *extensionsv1alpha1.ComponentInjection
 }
 
 // ControllerWorkers is used for configuring the workers for controllers.
@@ -174,6 +181,32 @@ type ControllerWorkers struct {
 	ServiceAccountToken *int
 }
 
+// ControllerWorkersFromConfig returns the ControllerWorkers configured in the given kube-controller-manager config.
+func ControllerWorkersFromConfig(config *gardencorev1beta1.KubeControllerManagerConfig) ControllerWorkers {
+	if config == nil || config.ControllerWorkers == nil {
+		return ControllerWorkers{}
+	}
+
+	toInt := func(v *int32) *int {
+		if v == nil {
+			return nil
+		}
+		return ptr.To(int(*v))
+	}
+
+	return ControllerWorkers{
+		StatefulSet:         toInt(config.ControllerWorkers.StatefulSet),
+		Deployment:          toInt(config.ControllerWorkers.Deployment),
+		ReplicaSet:          toInt(config.ControllerWorkers.ReplicaSet),
+		Endpoint:            toInt(config.ControllerWorkers.Endpoint),
+		GarbageCollector:    toInt(config.ControllerWorkers.GarbageCollector),
+		Namespace:           toInt(config.ControllerWorkers.Namespace),
+		ResourceQuota:       toInt(config.ControllerWorkers.ResourceQuota),
+		ServiceEndpoint:     toInt(config.ControllerWorkers.ServiceEndpoint),
+		ServiceAccountToken: toInt(config.ControllerWorkers.ServiceAccountToken),
+	}
+}
+
 // ControllerSyncPeriods is used for configuring the sync periods for controllers.
 type ControllerSyncPeriods struct {
 	// ResourceQuota is the sync period for the ResourceQuota controller.
@@ -431,6 +464,8 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 			})
 		}
 
+		extensionsv1alpha1helper.InjectComponentIntoPodSpec(&deployment.Spec.Template.Spec, containerName, k.values.ComponentInjection)
+
 		utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, genericTokenKubeconfigSecret.Name, shootAccessSecret.Secret.Name))
 		return nil
 	}); err != nil {
@@ -582,6 +617,10 @@ func (k *kubeControllerManager) SetServiceNetworks(services []net.IPNet) {
 	k.values.ServiceNetworks = services
 }
 
+func (k *kubeControllerManager) SetComponentInjection(injection *extensionsv1alpha1.ComponentInjection) {
+	k.values.ComponentInjection = injection
+}
+
 func (k *kubeControllerManager) emptyVPA() *vpaautoscalingv1.VerticalPodAutoscaler {
 	return &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: k.values.NamePrefix + "kube-controller-manager-vpa", Namespace: k.namespace}}
 }
@@ -717,6 +756,21 @@ func (k *kubeControllerManager) computeCommand(port int32) []string {
 			fmt.Sprintf("--node-monitor-grace-period=%s", nodeMonitorGracePeriod.Duration),
 		)
 
+		if nodeEviction := k.values.Config.NodeEviction; nodeEviction != nil {
+			if v := nodeEviction.Rate; v != nil {
+				command = append(command, fmt.Sprintf("--node-eviction-rate=%v", *v))
+			}
+			if v := nodeEviction.SecondaryRate; v != nil {
+				command = append(command, fmt.Sprintf("--secondary-node-eviction-rate=%v", *v))
+			}
+			if v := nodeEviction.LargeClusterSizeThreshold; v != nil {
+				command = append(command, fmt.Sprintf("--large-cluster-size-threshold=%d", *v))
+			}
+			if v := nodeEviction.UnhealthyZoneThreshold; v != nil {
+				command = append(command, fmt.Sprintf("--unhealthy-zone-threshold=%v", *v))
+			}
+		}
+
 		command = append(command,
 			fmt.Sprintf("--concurrent-deployment-syncs=%d", ptr.Deref(k.values.ControllerWorkers.Deployment, defaultControllerWorkersDeployment)),
 			fmt.Sprintf("--concurrent-replicaset-syncs=%d", ptr.Deref(k.values.ControllerWorkers.ReplicaSet, defaultControllerWorkersReplicaSet)),
@@ -31,6 +31,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
@@ -360,6 +361,7 @@ var _ = Describe("KubeControllerManager", func() {
 										config.NodeCIDRMaskSize,
 										config.NodeCIDRMaskSizeIPv6,
 										config.NodeMonitorGracePeriod,
+										config.NodeEviction,
 										namespace,
 										isWorkerless,
 										serviceCIDRs,
@@ -517,6 +519,12 @@ namespace: kube-system
 		configWithNodeCIDRMaskSizeIPv6   = &gardencorev1beta1.KubeControllerManagerConfig{NodeCIDRMaskSizeIPv6: ptr.To[int32](80)}
 		configWithPodEvictionTimeout     = &gardencorev1beta1.KubeControllerManagerConfig{PodEvictionTimeout: &podEvictionTimeout}
 		configWithNodeMonitorGracePeriod = &gardencorev1beta1.KubeControllerManagerConfig{NodeMonitorGracePeriod: &nodeMonitorGracePeriod}
+		configWithNodeEviction           = &gardencorev1beta1.KubeControllerManagerConfig{NodeEviction: &gardencorev1beta1.NodeEvictionConfig{
+			Rate:                      ptr.To(0.2),
+			SecondaryRate:             ptr.To(0.02),
+			LargeClusterSizeThreshold: ptr.To[int32](100),
+			UnhealthyZoneThreshold:    ptr.To(0.6),
+		}}
 	)
 
 	BeforeEach(func() {
@@ -677,6 +685,7 @@ namespace: kube-system
 			Entry("with NodeCIDRMaskSizeIPv6", configWithNodeCIDRMaskSizeIPv6, false, runtimeKubernetesVersion),
 			Entry("with PodEvictionTimeout", configWithPodEvictionTimeout, false, runtimeKubernetesVersion),
 			Entry("with NodeMonitorGracePeriod", configWithNodeMonitorGracePeriod, false, runtimeKubernetesVersion),
+			Entry("with NodeEviction", configWithNodeEviction, false, runtimeKubernetesVersion),
 		)
 
 		DescribeTable("success tests for various kubernetes versions (workerless shoot)",
@@ -873,6 +882,28 @@ namespace: kube-system
 				Expect(actualPrometheusRule).To(DeepEqual(expectedPrometheusRule))
 			})
 		})
+
+		It("should inject the requested sidecars, environment variables, and volumes", func() {
+			kubeControllerManager.SetComponentInjection(&extensionsv1alpha1.ComponentInjection{
+				Sidecars:     []corev1.Container{{Name: "sidecar", Image: "sidecar:v1"}},
+				Env:          []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+				VolumeMounts: []corev1.VolumeMount{{Name: "injected", MountPath: "/var/run/injected"}},
+				Volumes:      []corev1.Volume{{Name: "injected", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}},
+			})
+			Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+			actualDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(actualDeployment), actualDeployment)).To(Succeed())
+
+			podSpec := actualDeployment.Spec.Template.Spec
+			Expect(podSpec.Containers).To(HaveLen(2))
+			Expect(podSpec.Containers[0].Name).To(Equal("kube-controller-manager"))
+			Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "FOO", Value: "bar"}))
+			Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "injected", MountPath: "/var/run/injected"}))
+			Expect(podSpec.Containers[1].Name).To(Equal("sidecar"))
+			Expect(podSpec.Containers[1].Image).To(Equal("sidecar:v1"))
+			Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{Name: "injected", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}))
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -982,6 +1013,27 @@ namespace: kube-system
 			Expect(kubeControllerManager.WaitCleanup(ctx)).To(Succeed())
 		})
 	})
+
+	Describe("#ControllerWorkersFromConfig", func() {
+		It("should return empty controller workers if nothing is configured", func() {
+			Expect(ControllerWorkersFromConfig(nil)).To(Equal(ControllerWorkers{}))
+			Expect(ControllerWorkersFromConfig(&gardencorev1beta1.KubeControllerManagerConfig{})).To(Equal(ControllerWorkers{}))
+		})
+
+		It("should return the configured controller workers", func() {
+			Expect(ControllerWorkersFromConfig(&gardencorev1beta1.KubeControllerManagerConfig{
+				ControllerWorkers: &gardencorev1beta1.ControllerWorkersConfig{
+					Deployment:          ptr.To[int32](20),
+					GarbageCollector:    ptr.To[int32](10),
+					ServiceAccountToken: ptr.To[int32](0),
+				},
+			})).To(Equal(ControllerWorkers{
+				Deployment:          ptr.To(20),
+				GarbageCollector:    ptr.To(10),
+				ServiceAccountToken: ptr.To(0),
+			}))
+		})
+	})
 })
 
 // Utility functions
@@ -992,6 +1044,7 @@ func commandForKubernetesVersion(
 	nodeCIDRMaskSize *int32,
 	nodeCIDRMaskSizeIPv6 *int32,
 	nodeMonitorGracePeriod *metav1.Duration,
+	nodeEviction *gardencorev1beta1.NodeEvictionConfig,
 	clusterName string,
 	isWorkerless bool,
 	serviceNetwork, podNetwork []net.IPNet,
@@ -1047,6 +1100,15 @@ func commandForKubernetesVersion(
 			fmt.Sprintf("--node-monitor-grace-period=%s", nodeMonitorGracePeriodSetting),
 		)
 
+		if nodeEviction != nil {
+			command = append(command,
+				fmt.Sprintf("--node-eviction-rate=%v", *nodeEviction.Rate),
+				fmt.Sprintf("--secondary-node-eviction-rate=%v", *nodeEviction.SecondaryRate),
+				fmt.Sprintf("--large-cluster-size-threshold=%d", *nodeEviction.LargeClusterSizeThreshold),
+				fmt.Sprintf("--unhealthy-zone-threshold=%v", *nodeEviction.UnhealthyZoneThreshold),
+			)
+		}
+
 		if v := controllerWorkers.Deployment; v == nil {
 			command = append(command, "--concurrent-deployment-syncs=50")
 		} else {
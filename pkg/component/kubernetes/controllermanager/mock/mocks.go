@@ -14,6 +14,7 @@ import (
 	net "net"
 	reflect "reflect"
 
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gomock "go.uber.org/mock/gomock"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -70,6 +71,18 @@ func (mr *MockInterfaceMockRecorder) Destroy(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInterface)(nil).Destroy), ctx)
 }
 
+// SetComponentInjection mocks base method.
+func (m *MockInterface) SetComponentInjection(arg0 *v1alpha1.ComponentInjection) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetComponentInjection", arg0)
+}
+
+// SetComponentInjection indicates an expected call of SetComponentInjection.
+func (mr *MockInterfaceMockRecorder) SetComponentInjection(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetComponentInjection", reflect.TypeOf((*MockInterface)(nil).SetComponentInjection), arg0)
+}
+
 // SetPodNetworks mocks base method.
 func (m *MockInterface) SetPodNetworks(arg0 []net.IPNet) {
 	m.ctrl.T.Helper()
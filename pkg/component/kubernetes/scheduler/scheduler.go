@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"text/template"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -24,6 +25,7 @@ import (
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -73,9 +75,10 @@ clientConnection:
   kubeconfig: ` + gardenerutils.PathGenericKubeconfig + `
 leaderElection:
   leaderElect: true
-{{- if eq .profile "bin-packing" }}
+{{- if or (eq .profile "bin-packing") .additionalProfiles }}
 profiles:
 - schedulerName: ` + corev1.DefaultSchedulerName + `
+{{- if eq .profile "bin-packing" }}
 - schedulerName: ` + BinPackingSchedulerName + `
   pluginConfig:
   - name: NodeResourcesFit
@@ -86,6 +89,10 @@ profiles:
     score:
       disabled:
       - name: NodeResourcesBalancedAllocation
+{{- end }}
+{{- if .additionalProfiles }}
+{{ .additionalProfiles }}
+{{- end }}
 {{- end }}`
 )
 
@@ -576,10 +583,20 @@ func (k *kubeScheduler) computeComponentConfig() (string, error) {
 
 	var (
 		componentConfigYAML bytes.Buffer
-		values              = map[string]string{
+		values              = map[string]any{
 			"profile": string(profile),
 		}
 	)
+
+	// The additional profiles mirror the structure of the `KubeSchedulerProfile` API, hence they can be rendered as they are.
+	if k.config != nil && len(k.config.Profiles) > 0 {
+		additionalProfiles, err := yaml.Marshal(k.config.Profiles)
+		if err != nil {
+			return "", fmt.Errorf("failed marshalling additional scheduler profiles: %w", err)
+		}
+		values["additionalProfiles"] = strings.TrimSuffix(string(additionalProfiles), "\n")
+	}
+
 	if err := componentConfigTemplate.Execute(&componentConfigYAML, values); err != nil {
 		return "", err
 	}
@@ -601,7 +618,7 @@ func (k *kubeScheduler) computeCommand(port int32) []string {
 		fmt.Sprintf("--secure-port=%d", port),
 	)
 
-	if k.config != nil {
+	if k.config != nil && len(k.config.FeatureGates) > 0 {
 		command = append(command, kubernetesutils.FeatureGatesToCommandLineParameter(k.config.FeatureGates))
 	}
 
@@ -59,6 +59,23 @@ var _ = Describe("KubeScheduler", func() {
 			},
 			Profile: &profileBinPacking,
 		}
+		configAdditionalProfiles = &gardencorev1beta1.KubeSchedulerConfig{
+			Profiles: []gardencorev1beta1.SchedulerProfile{
+				{SchedulerName: "foo"},
+				{
+					SchedulerName: "image-locality",
+					Plugins: &gardencorev1beta1.SchedulerPlugins{
+						Filter: &gardencorev1beta1.SchedulerPluginSet{
+							Disabled: []gardencorev1beta1.SchedulerPlugin{{Name: "NodePorts"}},
+						},
+						Score: &gardencorev1beta1.SchedulerPluginSet{
+							Enabled:  []gardencorev1beta1.SchedulerPlugin{{Name: "ImageLocality", Weight: ptr.To[int32](10)}},
+							Disabled: []gardencorev1beta1.SchedulerPlugin{{Name: "*"}},
+						},
+					},
+				},
+			},
+		}
 		consistOf func(...client.Object) types.GomegaMatcher
 
 		secretNameClientCA = "ca-client"
@@ -607,6 +624,7 @@ var _ = Describe("KubeScheduler", func() {
 
 			Entry("w/o config", configEmpty, "testdata/component-config.yaml"),
 			Entry("w/ full config", configFull, "testdata/component-config-bin-packing.yaml"),
+			Entry("w/ additional profiles", configAdditionalProfiles, "testdata/component-config-additional-profiles.yaml"),
 		)
 	})
 
@@ -27,6 +27,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
 	"github.com/gardener/gardener/pkg/component/kubernetes/apiserverexposure"
 	vpnseedserver "github.com/gardener/gardener/pkg/component/networking/vpn/seedserver"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
@@ -80,6 +81,9 @@ type Values struct {
 	SidecarImage        string
 	DNSLookupFamily     string
 	IstioTLSTermination bool
+	// KonnectivityEnabled indicates whether the konnectivity-server port of the kube-apiserver shall be exposed on the
+	// advertised IP address, too.
+	KonnectivityEnabled bool
 
 	advertiseIPAddress string
 }
@@ -217,12 +221,15 @@ func (a *apiserverProxy) computeResourcesData() (map[string][]byte, error) {
 	}
 
 	if err := tplEnvoy.Execute(&envoyYAML, map[string]any{
-		"advertiseIPAddress":             a.values.advertiseIPAddress,
-		"dnsLookupFamily":                a.values.DNSLookupFamily,
-		"adminPort":                      adminPort,
-		"proxySeedServerHost":            a.values.ProxySeedServerHost,
-		"proxySeedServerPort":            seedServerPort,
-		"gardenerDestinationHeaderValue": gardenerDestinationHeaderValue,
+		"advertiseIPAddress":                 a.values.advertiseIPAddress,
+		"dnsLookupFamily":                    a.values.DNSLookupFamily,
+		"adminPort":                          adminPort,
+		"proxySeedServerHost":                a.values.ProxySeedServerHost,
+		"proxySeedServerPort":                seedServerPort,
+		"gardenerDestinationHeaderValue":     gardenerDestinationHeaderValue,
+		"konnectivityEnabled":                a.values.KonnectivityEnabled,
+		"konnectivityServerPort":             kubeapiserverconstants.KonnectivityServerAgentPort,
+		"konnectivityDestinationHeaderValue": fmt.Sprintf("outbound|%d||kube-apiserver.%s.svc.cluster.local", kubeapiserverconstants.KonnectivityServerAgentPort, a.namespace),
 		// TODO(hown3d): Drop with RemoveHTTPProxyLegacyPort feature gate
 		"reversedVPNHeaderValue": gardenerDestinationHeaderValue,
 	}); err != nil {
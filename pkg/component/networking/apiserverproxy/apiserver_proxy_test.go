@@ -252,7 +252,7 @@ var _ = Describe("APIServerProxy", func() {
 			utilruntime.Must(references.InjectAnnotations(expectedMr))
 			Expect(managedResource).To(DeepEqual(expectedMr))
 			Expect(managedResource).To(consistOf(
-				getConfigYAML(hash, values.DNSLookupFamily, advertiseIPAddress, reversedVPNHeaderValue),
+				getConfigYAML(hash, values.DNSLookupFamily, advertiseIPAddress, reversedVPNHeaderValue, values.KonnectivityEnabled),
 				getDaemonSet(hash, advertiseIPAddress),
 				service,
 				serviceAccount,
@@ -297,6 +297,16 @@ var _ = Describe("APIServerProxy", func() {
 				testFunc("9e555b69")
 			})
 		})
+
+		Context("Konnectivity", func() {
+			BeforeEach(func() {
+				values.KonnectivityEnabled = true
+			})
+
+			It("should deploy the managed resource successfully", func() {
+				testFunc("870ff3e8")
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -409,7 +419,39 @@ var _ = Describe("APIServerProxy", func() {
 	})
 })
 
-func getConfigYAML(hash, dnsLookUpFamily, advertiseIPAddress, xGardenerDestination string) *corev1.ConfigMap {
+func getConfigYAML(hash, dnsLookUpFamily, advertiseIPAddress, xGardenerDestination string, konnectivityEnabled bool) *corev1.ConfigMap {
+	var konnectivityListener string
+	if konnectivityEnabled {
+		konnectivityListener = `
+  - name: konnectivity_server
+    address:
+      socket_address:
+        address: ` + advertiseIPAddress + `
+        port_value: 8132
+    per_connection_buffer_limit_bytes: 32768 # 32 KiB
+    filter_chains:
+    - filters:
+      - name: envoy.filters.network.tcp_proxy
+        typed_config:
+          "@type": type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+          stat_prefix: konnectivity_server
+          cluster: kube_apiserver
+          tunneling_config:
+            # hostname is irrelevant as it will be dropped by envoy, we still need it for the configuration though
+            hostname: "api.internal.local.:8132"
+            headers_to_add:
+            - header:
+                key: X-Gardener-Destination
+                value: "outbound|8132||kube-apiserver.shoot--internal--internal.svc.cluster.local"
+          access_log:
+          - name: envoy.access_loggers.stdout
+            typed_config:
+              "@type": type.googleapis.com/envoy.extensions.access_loggers.stream.v3.StdoutAccessLog
+              log_format:
+                text_format_source:
+                  inline_string: "[%START_TIME%] %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% rx %BYTES_SENT% tx %DURATION%ms \"%DOWNSTREAM_REMOTE_ADDRESS%\" \"%UPSTREAM_HOST%\"\n"`
+	}
+
 	return &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "apiserver-proxy-config-" + hash,
@@ -494,7 +536,7 @@ static_resources:
               "@type": type.googleapis.com/envoy.extensions.access_loggers.stream.v3.StdoutAccessLog
               log_format:
                 text_format_source:
-                  inline_string: "[%START_TIME%] %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% rx %BYTES_SENT% tx %DURATION%ms \"%DOWNSTREAM_REMOTE_ADDRESS%\" \"%UPSTREAM_HOST%\"\n"
+                  inline_string: "[%START_TIME%] %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% rx %BYTES_SENT% tx %DURATION%ms \"%DOWNSTREAM_REMOTE_ADDRESS%\" \"%UPSTREAM_HOST%\"\n"` + konnectivityListener + `
   - name: metrics
     address:
       socket_address:
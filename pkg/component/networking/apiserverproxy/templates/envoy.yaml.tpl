@@ -69,6 +69,35 @@ static_resources:
               log_format:
                 text_format_source:
                   inline_string: "[%START_TIME%] %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% rx %BYTES_SENT% tx %DURATION%ms \"%DOWNSTREAM_REMOTE_ADDRESS%\" \"%UPSTREAM_HOST%\"\n"
+{{- if .konnectivityEnabled }}
+  - name: konnectivity_server
+    address:
+      socket_address:
+        address: {{ .advertiseIPAddress }}
+        port_value: {{ .konnectivityServerPort }}
+    per_connection_buffer_limit_bytes: 32768 # 32 KiB
+    filter_chains:
+    - filters:
+      - name: envoy.filters.network.tcp_proxy
+        typed_config:
+          "@type": type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+          stat_prefix: konnectivity_server
+          cluster: kube_apiserver
+          tunneling_config:
+            # hostname is irrelevant as it will be dropped by envoy, we still need it for the configuration though
+            hostname: "{{ .proxySeedServerHost }}:{{ .konnectivityServerPort }}"
+            headers_to_add:
+            - header:
+                key: X-Gardener-Destination
+                value: "{{ .konnectivityDestinationHeaderValue }}"
+          access_log:
+          - name: envoy.access_loggers.stdout
+            typed_config:
+              "@type": type.googleapis.com/envoy.extensions.access_loggers.stream.v3.StdoutAccessLog
+              log_format:
+                text_format_source:
+                  inline_string: "[%START_TIME%] %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% rx %BYTES_SENT% tx %DURATION%ms \"%DOWNSTREAM_REMOTE_ADDRESS%\" \"%UPSTREAM_HOST%\"\n"
+{{- end }}
   - name: metrics
     address:
       socket_address:
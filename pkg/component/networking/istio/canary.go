@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package istio
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+const (
+	// AnnotationStableRevision is the annotation on the istio-system namespace which contains the istio revision all
+	// ingress gateways are running with outside of canary upgrades.
+	AnnotationStableRevision = "istio.gardener.cloud/stable-revision"
+	// AnnotationStableIstiodImage is the annotation on the istio-system namespace which contains the istiod image of the
+	// stable revision.
+	AnnotationStableIstiodImage = "istio.gardener.cloud/stable-istiod-image"
+	// AnnotationStableProxyImage is the annotation on the istio-system namespace which contains the ingress gateway image
+	// of the stable revision.
+	AnnotationStableProxyImage = "istio.gardener.cloud/stable-proxy-image"
+	// AnnotationCanaryRevision is the annotation on the istio-system namespace which contains the istio revision that is
+	// currently rolled out to the canary ingress gateways.
+	AnnotationCanaryRevision = "istio.gardener.cloud/canary-revision"
+	// AnnotationCanaryStartTime is the annotation on the istio-system namespace which contains the time the canary phase
+	// of the canary revision was started.
+	AnnotationCanaryStartTime = "istio.gardener.cloud/canary-start-time"
+	// AnnotationCanaryHealthSamples is the annotation on the istio-system namespace which contains the number of
+	// unhealthy and the total number of health samples of the canary taken during the canary phase, e.g. `1/5`.
+	AnnotationCanaryHealthSamples = "istio.gardener.cloud/canary-health-samples"
+	// AnnotationCanaryUnhealthySince is the annotation on the istio-system namespace which contains the time since the
+	// canary is unhealthy continuously. It is not present if the last health sample of the canary was healthy.
+	AnnotationCanaryUnhealthySince = "istio.gardener.cloud/canary-unhealthy-since"
+	// AnnotationRolledBackRevision is the annotation on the istio-system namespace which contains the istio revision
+	// whose canary upgrade was rolled back. The revision is not rolled out again as long as the annotation is present.
+	AnnotationRolledBackRevision = "istio.gardener.cloud/rolled-back-revision"
+
+	// managedResourceIstioSystemCanaryName is the name of the ManagedResource containing the istiod resources of the
+	// canary revision.
+	managedResourceIstioSystemCanaryName = "istio-system-canary"
+	// ingressGatewayDeploymentName is the name of the ingress gateway deployments.
+	ingressGatewayDeploymentName = "istio-ingressgateway"
+)
+
+// CanaryUpgradeValues contains configuration values for canary upgrades of the istio control plane.
+type CanaryUpgradeValues struct {
+	// Revision is the istio revision of the configured istiod and ingress gateway images, e.g. `1-27-8`.
+	Revision string
+	// IngressGatewayPercentage is the percentage of ingress gateways which are switched to a new revision during the
+	// canary phase. At least one ingress gateway is always switched.
+	IngressGatewayPercentage int
+	// VerificationPeriod is the minimum duration of the canary phase. The new revision is promoted afterwards if the
+	// canary is healthy and the majority of the health samples taken during the canary phase were healthy.
+	VerificationPeriod time.Duration
+	// FailureThreshold is the duration for which the canary must be unhealthy continuously before the new revision is
+	// rolled back.
+	FailureThreshold time.Duration
+	// Clock is used to determine the duration of the canary phase.
+	Clock clock.Clock
+}
+
+// revisions describes which istio revisions are deployed.
+type revisions struct {
+	// stableIstiodImage and stableProxyImage are the images of the stable revision. They differ from the configured
+	// images during the canary phase and after a rollback.
+	stableIstiodImage string
+	stableProxyImage  string
+	// canaryRevision is the revision rolled out to the ingress gateways in canaryNamespaces. It is empty outside of the
+	// canary phase.
+	canaryRevision   string
+	canaryNamespaces sets.Set[string]
+	// annotations are the annotations which are maintained on the istio-system namespace.
+	annotations map[string]string
+}
+
+// SampleInterval returns the interval in which the health of the canary should be sampled during the canary phase, so
+// that a sustained failure is detected in time.
+func (c *CanaryUpgradeValues) SampleInterval() time.Duration {
+	return c.FailureThreshold / 2
+}
+
+// CanaryUpgradeInProgress returns whether a canary upgrade of the istio control plane in the given namespace is in
+// progress.
+func CanaryUpgradeInProgress(ctx context.Context, c client.Client, namespace string) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	_, ok := ns.Annotations[AnnotationCanaryRevision]
+	return ok, nil
+}
+
+// canaryHealth is the health of the canary sampled throughout the canary phase.
+type canaryHealth struct {
+	unhealthySamples int
+	totalSamples     int
+	unhealthySince   *time.Time
+}
+
+func parseCanaryHealth(annotations map[string]string) canaryHealth {
+	var h canaryHealth
+	if _, err := fmt.Sscanf(annotations[AnnotationCanaryHealthSamples], "%d/%d", &h.unhealthySamples, &h.totalSamples); err != nil {
+		h = canaryHealth{}
+	}
+	if unhealthySince, err := time.Parse(time.RFC3339, annotations[AnnotationCanaryUnhealthySince]); err == nil {
+		h.unhealthySince = &unhealthySince
+	}
+	return h
+}
+
+// sample adds a health sample of the canary.
+func (h *canaryHealth) sample(healthy bool, now time.Time) {
+	h.totalSamples++
+	if healthy {
+		h.unhealthySince = nil
+		return
+	}
+
+	h.unhealthySamples++
+	if h.unhealthySince == nil {
+		h.unhealthySince = &now
+	}
+}
+
+// failedSustainedly returns whether the canary has been unhealthy continuously for at least the given threshold.
+func (h *canaryHealth) failedSustainedly(now time.Time, threshold time.Duration) bool {
+	return h.unhealthySince != nil && now.Sub(*h.unhealthySince) >= threshold
+}
+
+// mostlyHealthy returns whether the canary is healthy and the majority of its health samples were healthy.
+func (h *canaryHealth) mostlyHealthy() bool {
+	return h.unhealthySince == nil && h.totalSamples > 0 && 2*h.unhealthySamples < h.totalSamples
+}
+
+func (h *canaryHealth) setAnnotations(annotations map[string]string) {
+	annotations[AnnotationCanaryHealthSamples] = fmt.Sprintf("%d/%d", h.unhealthySamples, h.totalSamples)
+	if h.unhealthySince != nil {
+		annotations[AnnotationCanaryUnhealthySince] = h.unhealthySince.Format(time.RFC3339)
+	}
+}
+
+// computeRevisions determines the istio revisions to deploy based on the state persisted on the istio-system namespace
+// and advances the canary upgrade if necessary, i.e., it starts, promotes, or rolls back the canary. The health of the
+// canary is sampled whenever this function is called during the canary phase. The canary is rolled back as soon as it
+// is unhealthy continuously for the failure threshold. It is promoted once the verification period has passed if it is
+// healthy and the majority of the health samples were healthy.
+func (i *istiod) computeRevisions(ctx context.Context) (*revisions, error) {
+	var (
+		canaryUpgrade = i.values.Istiod.CanaryUpgrade
+		desired       = canaryUpgrade.Revision
+		istiodImage   = i.values.Istiod.Image
+		proxyImage    string
+	)
+
+	if len(i.values.IngressGateway) > 0 {
+		proxyImage = i.values.IngressGateway[0].Image
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := i.client.Get(ctx, client.ObjectKey{Name: i.values.Istiod.Namespace}, namespace); err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	var (
+		annotations      = namespace.Annotations
+		stable           = annotations[AnnotationStableRevision]
+		rolledBack       = annotations[AnnotationRolledBackRevision]
+		stableRevisionOf = func(revision, istiodImage, proxyImage string) *revisions {
+			r := &revisions{
+				stableIstiodImage: istiodImage,
+				stableProxyImage:  proxyImage,
+				annotations: map[string]string{
+					AnnotationStableRevision:    revision,
+					AnnotationStableIstiodImage: istiodImage,
+					AnnotationStableProxyImage:  proxyImage,
+				},
+			}
+			if rolledBack != "" && rolledBack == desired {
+				r.annotations[AnnotationRolledBackRevision] = rolledBack
+			}
+			return r
+		}
+	)
+
+	if stable == "" || stable == desired {
+		return stableRevisionOf(desired, istiodImage, proxyImage), nil
+	}
+
+	if rolledBack == desired {
+		return stableRevisionOf(stable, annotations[AnnotationStableIstiodImage], annotations[AnnotationStableProxyImage]), nil
+	}
+
+	var (
+		now              = canaryUpgrade.Clock.Now().UTC()
+		canaryNamespaces = i.canaryNamespaces()
+		samples          canaryHealth
+	)
+
+	startTime, err := time.Parse(time.RFC3339, annotations[AnnotationCanaryStartTime])
+	if annotations[AnnotationCanaryRevision] != desired || err != nil {
+		// The canary phase starts now, hence the canary is not deployed yet and there is nothing to sample.
+		startTime = now
+	} else {
+		samples = parseCanaryHealth(annotations)
+		samples.sample(i.checkCanary(ctx, desired, canaryNamespaces) == nil, now)
+
+		if samples.failedSustainedly(now, canaryUpgrade.FailureThreshold) {
+			r := stableRevisionOf(stable, annotations[AnnotationStableIstiodImage], annotations[AnnotationStableProxyImage])
+			r.annotations[AnnotationRolledBackRevision] = desired
+			return r, nil
+		}
+
+		if now.Sub(startTime) >= canaryUpgrade.VerificationPeriod && samples.mostlyHealthy() {
+			return stableRevisionOf(desired, istiodImage, proxyImage), nil
+		}
+	}
+
+	r := stableRevisionOf(stable, annotations[AnnotationStableIstiodImage], annotations[AnnotationStableProxyImage])
+	r.canaryRevision = desired
+	r.canaryNamespaces = canaryNamespaces
+	r.annotations[AnnotationCanaryRevision] = desired
+	r.annotations[AnnotationCanaryStartTime] = startTime.Format(time.RFC3339)
+	samples.setAnnotations(r.annotations)
+	return r, nil
+}
+
+// canaryNamespaces returns the namespaces of the ingress gateways which are switched to a new revision during the
+// canary phase. They are chosen deterministically based on the namespace names.
+func (i *istiod) canaryNamespaces() sets.Set[string] {
+	var namespaces []string
+	for _, ingressGateway := range i.values.IngressGateway {
+		namespaces = append(namespaces, ingressGateway.Namespace)
+	}
+	slices.Sort(namespaces)
+
+	count := max(1, (len(namespaces)*i.values.Istiod.CanaryUpgrade.IngressGatewayPercentage+99)/100)
+	return sets.New(namespaces[:min(count, len(namespaces))]...)
+}
+
+// checkCanary checks whether the istiod deployment of the canary revision and the ingress gateway deployments in the
+// given namespaces are healthy.
+func (i *istiod) checkCanary(ctx context.Context, revision string, namespaces sets.Set[string]) error {
+	deployments := []client.ObjectKey{{Namespace: i.values.Istiod.Namespace, Name: IstiodServiceName + "-" + revision}}
+	for _, namespace := range sets.List(namespaces) {
+		deployments = append(deployments, client.ObjectKey{Namespace: namespace, Name: ingressGatewayDeploymentName})
+	}
+
+	for _, key := range deployments {
+		deployment := &appsv1.Deployment{}
+		if err := i.client.Get(ctx, key, deployment); err != nil {
+			return err
+		}
+
+		if err := health.CheckDeployment(deployment); err != nil {
+			return fmt.Errorf("deployment %s is unhealthy: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// ingressGatewayImageAndRevision returns the image and the istiod revision of the given ingress gateway.
+func (i *istiod) ingressGatewayImageAndRevision(ingressGateway IngressGatewayValues) (string, *string) {
+	if i.revisions == nil {
+		return ingressGateway.Image, nil
+	}
+
+	if i.revisions.canaryNamespaces.Has(ingressGateway.Namespace) {
+		return ingressGateway.Image, &i.revisions.canaryRevision
+	}
+
+	return i.revisions.stableProxyImage, nil
+}
+
+func setRevisionAnnotations(namespace *corev1.Namespace, r *revisions) {
+	for _, key := range []string{
+		AnnotationStableRevision,
+		AnnotationStableIstiodImage,
+		AnnotationStableProxyImage,
+		AnnotationCanaryRevision,
+		AnnotationCanaryStartTime,
+		AnnotationCanaryHealthSamples,
+		AnnotationCanaryUnhealthySince,
+		AnnotationRolledBackRevision,
+	} {
+		delete(namespace.Annotations, key)
+	}
+
+	if r == nil {
+		return
+	}
+
+	for key, value := range r.annotations {
+		metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, key, value)
+	}
+}
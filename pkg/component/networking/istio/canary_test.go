@@ -0,0 +1,327 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package istio_test
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/networking/istio"
+	gardenletfeatures "github.com/gardener/gardener/pkg/gardenlet/features"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Canary upgrades", func() {
+	const (
+		istiodNamespace = "istio-system"
+		namespaceA      = "istio-ingress"
+		namespaceB      = "istio-ingress-handler-foo"
+	)
+
+	var (
+		ctx       = context.Background()
+		c         client.Client
+		fakeClock *testclock.FakeClock
+		renderer  chartrenderer.Interface
+
+		newIstio = func(revision string) Interface {
+			var ingressGateways []IngressGatewayValues
+			for _, namespace := range []string{namespaceB, namespaceA} {
+				ingressGateways = append(ingressGateways, IngressGatewayValues{
+					Image:             "proxy:" + revision,
+					TrustDomain:       "cluster.local",
+					IstiodNamespace:   istiodNamespace,
+					Labels:            map[string]string{"app": "istio-ingressgateway"},
+					Namespace:         namespace,
+					PriorityClassName: v1beta1constants.PriorityClassNameSeedSystemCritical,
+					KubernetesVersion: "1.31.1",
+				})
+			}
+
+			return NewIstio(c, renderer, Values{
+				Istiod: IstiodValues{
+					Enabled:           true,
+					Image:             "istiod:" + revision,
+					Namespace:         istiodNamespace,
+					PriorityClassName: v1beta1constants.PriorityClassNameSeedSystemCritical,
+					TrustDomain:       "cluster.local",
+					CanaryUpgrade: &CanaryUpgradeValues{
+						Revision:                 revision,
+						IngressGatewayPercentage: 25,
+						VerificationPeriod:       30 * time.Minute,
+						FailureThreshold:         10 * time.Minute,
+						Clock:                    fakeClock,
+					},
+				},
+				IngressGateway: ingressGateways,
+			})
+		}
+
+		namespaceAnnotations = func() map[string]string {
+			GinkgoHelper()
+			namespace := &corev1.Namespace{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: istiodNamespace}, namespace)).To(Succeed())
+			return namespace.Annotations
+		}
+
+		manifests = func(name string) []string {
+			GinkgoHelper()
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: istiodNamespace, Name: name}, managedResource)).To(Succeed())
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: istiodNamespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			manifests, err := test.ExtractManifestsFromManagedResourceData(secret.Data)
+			Expect(err).NotTo(HaveOccurred())
+			return manifests
+		}
+
+		deploymentManifest = func(managedResourceName, name, namespace string) string {
+			GinkgoHelper()
+			for _, manifest := range manifests(managedResourceName) {
+				if strings.HasPrefix(manifest, "apiVersion: apps/v1\nkind: Deployment\n") && strings.Contains(manifest, "name: "+name+"\n  namespace: "+namespace+"\n") {
+					return manifest
+				}
+			}
+			Fail("deployment " + namespace + "/" + name + " not found")
+			return ""
+		}
+
+		createDeployment = func(namespace, name string, healthy bool) {
+			GinkgoHelper()
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+			}
+			if healthy {
+				deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}
+			}
+			Expect(client.IgnoreNotFound(c.Delete(ctx, deployment))).To(Succeed())
+			Expect(c.Create(ctx, deployment)).To(Succeed())
+		}
+
+		setCanaryHealth = func(healthy bool) {
+			GinkgoHelper()
+			createDeployment(istiodNamespace, "istiod-1-27-0", true)
+			createDeployment(namespaceA, "istio-ingressgateway", healthy)
+		}
+
+		deployAfter = func(d time.Duration) {
+			GinkgoHelper()
+			fakeClock.Step(d)
+			Expect(newIstio("1-27-0").Deploy(ctx)).To(Succeed())
+		}
+	)
+
+	BeforeEach(func() {
+		c = fake.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		renderer = chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.31.1"}, chartrenderer.WithStrictValues())
+
+		gardenletfeatures.RegisterFeatureGates()
+
+		Expect(newIstio("1-26-0").Deploy(ctx)).To(Succeed())
+	})
+
+	It("should record the stable revision on the initial deployment", func() {
+		Expect(namespaceAnnotations()).To(And(
+			HaveKeyWithValue(AnnotationStableRevision, "1-26-0"),
+			HaveKeyWithValue(AnnotationStableIstiodImage, "istiod:1-26-0"),
+			HaveKeyWithValue(AnnotationStableProxyImage, "proxy:1-26-0"),
+			Not(HaveKey(AnnotationCanaryRevision)),
+		))
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: istiodNamespace, Name: "istio-system-canary"}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		Expect(CanaryUpgradeInProgress(ctx, c, istiodNamespace)).To(BeFalse())
+	})
+
+	It("should not report a canary upgrade in progress if the namespace does not exist", func() {
+		Expect(CanaryUpgradeInProgress(ctx, c, "foo")).To(BeFalse())
+	})
+
+	Context("new revision", func() {
+		BeforeEach(func() {
+			Expect(newIstio("1-27-0").Deploy(ctx)).To(Succeed())
+		})
+
+		It("should roll out the new revision to the canary ingress gateways only", func() {
+			Expect(namespaceAnnotations()).To(And(
+				HaveKeyWithValue(AnnotationStableRevision, "1-26-0"),
+				HaveKeyWithValue(AnnotationCanaryRevision, "1-27-0"),
+				HaveKeyWithValue(AnnotationCanaryStartTime, "2025-01-01T00:00:00Z"),
+			))
+			Expect(CanaryUpgradeInProgress(ctx, c, istiodNamespace)).To(BeTrue())
+
+			Expect(deploymentManifest("istio-system", "istiod", istiodNamespace)).To(ContainSubstring("image: \"istiod:1-26-0\""))
+			Expect(deploymentManifest("istio-system-canary", "istiod-1-27-0", istiodNamespace)).To(And(
+				ContainSubstring("image: \"istiod:1-27-0\""),
+				ContainSubstring("istio.io/rev: 1-27-0"),
+			))
+
+			canaryGateway := deploymentManifest("istio", "istio-ingressgateway", namespaceA)
+			Expect(canaryGateway).To(And(
+				ContainSubstring("image: proxy:1-27-0"),
+				ContainSubstring("value: istiod-1-27-0.istio-system.svc:15012"),
+				ContainSubstring("discoveryAddress: istiod-1-27-0.istio-system.svc:15012"),
+			))
+
+			stableGateway := deploymentManifest("istio", "istio-ingressgateway", namespaceB)
+			Expect(stableGateway).To(And(
+				ContainSubstring("image: proxy:1-26-0"),
+				ContainSubstring("value: istiod.istio-system.svc:15012"),
+				Not(ContainSubstring("discoveryAddress")),
+			))
+		})
+
+		It("should keep the canary phase until the verification period has passed and sample the health of the canary", func() {
+			setCanaryHealth(true)
+			deployAfter(10 * time.Minute)
+
+			Expect(namespaceAnnotations()).To(And(
+				HaveKeyWithValue(AnnotationStableRevision, "1-26-0"),
+				HaveKeyWithValue(AnnotationCanaryStartTime, "2025-01-01T00:00:00Z"),
+				HaveKeyWithValue(AnnotationCanaryHealthSamples, "0/1"),
+				Not(HaveKey(AnnotationCanaryUnhealthySince)),
+			))
+
+			setCanaryHealth(false)
+			deployAfter(5 * time.Minute)
+
+			Expect(namespaceAnnotations()).To(And(
+				HaveKeyWithValue(AnnotationCanaryRevision, "1-27-0"),
+				HaveKeyWithValue(AnnotationCanaryHealthSamples, "1/2"),
+				HaveKeyWithValue(AnnotationCanaryUnhealthySince, "2025-01-01T00:15:00Z"),
+			))
+		})
+
+		It("should promote the new revision if the canary is healthy", func() {
+			createDeployment(istiodNamespace, "istiod-1-27-0", true)
+			createDeployment(namespaceA, "istio-ingressgateway", true)
+
+			fakeClock.Step(30 * time.Minute)
+			Expect(newIstio("1-27-0").Deploy(ctx)).To(Succeed())
+
+			Expect(namespaceAnnotations()).To(And(
+				HaveKeyWithValue(AnnotationStableRevision, "1-27-0"),
+				HaveKeyWithValue(AnnotationStableIstiodImage, "istiod:1-27-0"),
+				HaveKeyWithValue(AnnotationStableProxyImage, "proxy:1-27-0"),
+				Not(HaveKey(AnnotationCanaryRevision)),
+				Not(HaveKey(AnnotationRolledBackRevision)),
+			))
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: istiodNamespace, Name: "istio-system-canary"}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+
+			Expect(deploymentManifest("istio-system", "istiod", istiodNamespace)).To(ContainSubstring("image: \"istiod:1-27-0\""))
+			Expect(deploymentManifest("istio", "istio-ingressgateway", namespaceA)).To(ContainSubstring("image: proxy:1-27-0"))
+			Expect(deploymentManifest("istio", "istio-ingressgateway", namespaceB)).To(ContainSubstring("image: proxy:1-27-0"))
+		})
+
+		It("should not roll back the new revision on transient failures of the canary", func() {
+			setCanaryHealth(false)
+			deployAfter(10 * time.Minute)
+			deployAfter(5 * time.Minute)
+
+			setCanaryHealth(true)
+			deployAfter(5 * time.Minute)
+			deployAfter(5 * time.Minute)
+			deployAfter(5 * time.Minute)
+
+			Expect(namespaceAnnotations()).To(And(
+				HaveKeyWithValue(AnnotationStableRevision, "1-27-0"),
+				Not(HaveKey(AnnotationRolledBackRevision)),
+			))
+		})
+
+		It("should not promote the new revision if the canary is unhealthy when the verification period has passed", func() {
+			setCanaryHealth(true)
+			deployAfter(10 * time.Minute)
+			deployAfter(10 * time.Minute)
+
+			setCanaryHealth(false)
+			deployAfter(10 * time.Minute)
+
+			Expect(namespaceAnnotations()).To(And(
+				HaveKeyWithValue(AnnotationStableRevision, "1-26-0"),
+				HaveKeyWithValue(AnnotationCanaryRevision, "1-27-0"),
+				HaveKeyWithValue(AnnotationCanaryHealthSamples, "1/3"),
+			))
+
+			setCanaryHealth(true)
+			deployAfter(5 * time.Minute)
+
+			Expect(namespaceAnnotations()).To(HaveKeyWithValue(AnnotationStableRevision, "1-27-0"))
+		})
+
+		It("should not promote the new revision as long as the majority of the health samples were unhealthy", func() {
+			for _, healthy := range []bool{false, true, false, true, false} {
+				setCanaryHealth(healthy)
+				deployAfter(5 * time.Minute)
+			}
+
+			setCanaryHealth(true)
+			deployAfter(5 * time.Minute)
+
+			Expect(namespaceAnnotations()).To(And(
+				HaveKeyWithValue(AnnotationStableRevision, "1-26-0"),
+				HaveKeyWithValue(AnnotationCanaryHealthSamples, "3/6"),
+			))
+
+			deployAfter(5 * time.Minute)
+
+			Expect(namespaceAnnotations()).To(HaveKeyWithValue(AnnotationStableRevision, "1-27-0"))
+		})
+
+		It("should roll back the new revision if the canary is unhealthy for the failure threshold", func() {
+			setCanaryHealth(false)
+			deployAfter(5 * time.Minute)
+			deployAfter(5 * time.Minute)
+
+			Expect(namespaceAnnotations()).To(And(
+				HaveKeyWithValue(AnnotationCanaryRevision, "1-27-0"),
+				HaveKeyWithValue(AnnotationCanaryUnhealthySince, "2025-01-01T00:05:00Z"),
+			))
+
+			deployAfter(5 * time.Minute)
+
+			Expect(namespaceAnnotations()).To(And(
+				HaveKeyWithValue(AnnotationStableRevision, "1-26-0"),
+				HaveKeyWithValue(AnnotationRolledBackRevision, "1-27-0"),
+				Not(HaveKey(AnnotationCanaryRevision)),
+				Not(HaveKey(AnnotationCanaryHealthSamples)),
+				Not(HaveKey(AnnotationCanaryUnhealthySince)),
+			))
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: istiodNamespace, Name: "istio-system-canary"}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+
+			Expect(deploymentManifest("istio-system", "istiod", istiodNamespace)).To(ContainSubstring("image: \"istiod:1-26-0\""))
+			Expect(deploymentManifest("istio", "istio-ingressgateway", namespaceA)).To(ContainSubstring("image: proxy:1-26-0"))
+
+			By("Keep the stable revision on subsequent deployments")
+			fakeClock.Step(time.Hour)
+			Expect(newIstio("1-27-0").Deploy(ctx)).To(Succeed())
+			Expect(namespaceAnnotations()).To(HaveKeyWithValue(AnnotationRolledBackRevision, "1-27-0"))
+			Expect(deploymentManifest("istio", "istio-ingressgateway", namespaceA)).To(ContainSubstring("image: proxy:1-26-0"))
+
+			By("Start a new canary phase for another revision")
+			Expect(newIstio("1-27-1").Deploy(ctx)).To(Succeed())
+			Expect(namespaceAnnotations()).To(And(
+				HaveKeyWithValue(AnnotationCanaryRevision, "1-27-1"),
+				Not(HaveKey(AnnotationRolledBackRevision)),
+			))
+		})
+	})
+})
@@ -9,7 +9,6 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/crddeployer"
 )
 
@@ -21,6 +20,7 @@ var (
 // NewCRD can be used to deploy istio CRDs.
 func NewCRD(
 	client client.Client,
-) (component.DeployWaiter, error) {
-	return crddeployer.New(client, []string{crds}, false)
+	opts ...crddeployer.Option,
+) (crddeployer.Interface, error) {
+	return crddeployer.New(client, []string{crds}, false, opts...)
 }
@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
@@ -23,6 +24,8 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 )
 
+//go:generate go run ../../../../hack/tools/chart-values-generator --schema charts/istio/istio-ingress/values.schema.json --type ingressGatewayChartValues --output zz_generated.ingress_gateway_values.go
+
 var (
 	//go:embed charts/istio/istio-ingress
 	chartIngress     embed.FS
@@ -46,6 +49,7 @@ type IngressGatewayValues struct {
 	PriorityClassName                  string
 	TrustDomain                        string
 	TerminateLoadBalancerProxyProtocol bool
+	ProxyProtocolTLVs                  []gardencorev1beta1.ProxyProtocolTLV
 	VPNEnabled                         bool
 	Zones                              []string
 	DualStack                          bool
@@ -83,51 +87,48 @@ func (i *istiod) generateIstioIngressGatewayChart(ctx context.Context) (*chartre
 			cpuRequests = "450m"
 		}
 
-		httpProxy := map[string]any{
-			"enabled": istioIngressGateway.VPNEnabled,
-			"legacyPort": map[string]any{
-				"enabled": true,
-				"port":    vpnseedserver.GatewayPort,
-				"header":  "Reversed-VPN",
+		image, istiodRevision := i.ingressGatewayImageAndRevision(istioIngressGateway)
+
+		values := ingressGatewayChartValues{
+			TrustDomain:                        istioIngressGateway.TrustDomain,
+			Labels:                             istioIngressGateway.Labels,
+			NetworkPolicyLabels:                istioIngressGateway.NetworkPolicyLabels,
+			Annotations:                        istioIngressGateway.Annotations,
+			LoadBalancerClass:                  istioIngressGateway.LoadBalancerClass,
+			ExternalTrafficPolicy:              istioIngressGateway.ExternalTrafficPolicy,
+			DualStack:                          istioIngressGateway.DualStack,
+			DeployNamespace:                    false,
+			PriorityClassName:                  istioIngressGateway.PriorityClassName,
+			Ports:                              istioIngressGateway.Ports,
+			Image:                              image,
+			IstiodNamespace:                    istioIngressGateway.IstiodNamespace,
+			IstiodRevision:                     istiodRevision,
+			LoadBalancerIP:                     istioIngressGateway.LoadBalancerIP,
+			ServiceName:                        v1beta1constants.DefaultSNIIngressServiceName,
+			InternalServiceName:                v1beta1constants.InternalSNIIngressServiceName,
+			TerminateLoadBalancerProxyProtocol: istioIngressGateway.TerminateLoadBalancerProxyProtocol,
+			ProxyProtocolTLVs:                  proxyProtocolTLVsChartValues(istioIngressGateway.ProxyProtocolTLVs),
+			TerminateAPIServerTLS:              enableAPIServerTLSTermination,
+			HTTPProxy: ingressGatewayChartValuesHTTPProxy{
+				Enabled: istioIngressGateway.VPNEnabled,
+				LegacyPort: ingressGatewayChartValuesHTTPProxyLegacyPort{
+					Enabled: true,
+					Port:    vpnseedserver.GatewayPort,
+					Header:  "Reversed-VPN",
+				},
 			},
-		}
-
-		values := map[string]any{
-			"trustDomain":                        istioIngressGateway.TrustDomain,
-			"labels":                             istioIngressGateway.Labels,
-			"networkPolicyLabels":                istioIngressGateway.NetworkPolicyLabels,
-			"annotations":                        istioIngressGateway.Annotations,
-			"loadBalancerClass":                  istioIngressGateway.LoadBalancerClass,
-			"externalTrafficPolicy":              istioIngressGateway.ExternalTrafficPolicy,
-			"dualStack":                          istioIngressGateway.DualStack,
-			"deployNamespace":                    false,
-			"priorityClassName":                  istioIngressGateway.PriorityClassName,
-			"ports":                              istioIngressGateway.Ports,
-			"image":                              istioIngressGateway.Image,
-			"istiodNamespace":                    istioIngressGateway.IstiodNamespace,
-			"loadBalancerIP":                     istioIngressGateway.LoadBalancerIP,
-			"serviceName":                        v1beta1constants.DefaultSNIIngressServiceName,
-			"internalServiceName":                v1beta1constants.InternalSNIIngressServiceName,
-			"terminateLoadBalancerProxyProtocol": istioIngressGateway.TerminateLoadBalancerProxyProtocol,
-			"terminateAPIServerTLS":              enableAPIServerTLSTermination,
-			"httpProxy":                          httpProxy,
-			"enforceSpreadAcrossHosts":           istioIngressGateway.EnforceSpreadAcrossHosts,
-			"apiServerRequestHeaderUserName":     kubeapiserverconstants.RequestHeaderUserName,
-			"apiServerRequestHeaderGroup":        kubeapiserverconstants.RequestHeaderGroup,
-			"apiServerAuthenticationDynamicMetadataKey": apiserverexposure.AuthenticationDynamicMetadataKey,
-			"cpuRequests":       cpuRequests,
-			"kubernetesVersion": istioIngressGateway.KubernetesVersion,
-		}
-
-		if istioIngressGateway.MinReplicas != nil {
+			EnforceSpreadAcrossHosts:                  istioIngressGateway.EnforceSpreadAcrossHosts,
+			APIServerRequestHeaderUserName:            kubeapiserverconstants.RequestHeaderUserName,
+			APIServerRequestHeaderGroup:               kubeapiserverconstants.RequestHeaderGroup,
+			APIServerAuthenticationDynamicMetadataKey: apiserverexposure.AuthenticationDynamicMetadataKey,
+			CPURequests:       cpuRequests,
+			KubernetesVersion: istioIngressGateway.KubernetesVersion,
 			// Apply minReplicas here to deploy the Ingress-Gateway with the intended number of replicas from the beginning (creation).
 			// Otherwise, we would need to wait until HPA scales up the deployment which then again can trigger unnecessary rolling updates
 			// when additional configuration is added by registered webhooks, e.g. high-availability-config webhook.
-			values["replicas"] = *istioIngressGateway.MinReplicas
-			values["minReplicas"] = *istioIngressGateway.MinReplicas
-		}
-		if istioIngressGateway.MaxReplicas != nil {
-			values["maxReplicas"] = *istioIngressGateway.MaxReplicas
+			Replicas:    istioIngressGateway.MinReplicas,
+			MinReplicas: istioIngressGateway.MinReplicas,
+			MaxReplicas: istioIngressGateway.MaxReplicas,
 		}
 
 		renderedIngressChart, err := i.chartRenderer.RenderEmbeddedFS(chartIngress, chartPathIngress, releaseName, istioIngressGateway.Namespace, values)
@@ -150,3 +151,11 @@ func addSuffixToManifestsName(charts *chartrenderer.RenderedChart, suffix string
 		charts.Manifests[i].Name = charts.Manifests[i].Name + "/" + suffix + ".yaml"
 	}
 }
+
+func proxyProtocolTLVsChartValues(tlvs []gardencorev1beta1.ProxyProtocolTLV) []ingressGatewayChartValuesProxyProtocolTLVs {
+	var values []ingressGatewayChartValuesProxyProtocolTLVs
+	for _, tlv := range tlvs {
+		values = append(values, ingressGatewayChartValuesProxyProtocolTLVs{Name: tlv.Name, Type: int(tlv.Type)})
+	}
+	return values
+}
@@ -244,6 +244,11 @@ var _ = Describe("istiod", func() {
 			return string(data)
 		}
 
+		istioProxyProtocolEnvoyFilterSNITLVs = func() string {
+			data, _ := os.ReadFile("./test_charts/proxyprotocol_envoyfilter_sni_tlvs.yaml")
+			return string(data)
+		}
+
 		istioProxyProtocolEnvoyFilterVPN = func() string {
 			data, _ := os.ReadFile("./test_charts/proxyprotocol_envoyfilter_vpn.yaml")
 			return string(data)
@@ -316,7 +321,7 @@ var _ = Describe("istiod", func() {
 		expectedMaxReplicas = 9
 
 		c = fake.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
-		renderer = chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.31.1"})
+		renderer = chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.31.1"}, chartrenderer.WithStrictValues())
 
 		gardenletfeatures.RegisterFeatureGates()
 
@@ -480,8 +485,13 @@ var _ = Describe("istiod", func() {
 			}
 
 			if igw[0].TerminateLoadBalancerProxyProtocol {
+				proxyProtocolEnvoyFilterSNI := istioProxyProtocolEnvoyFilterSNI()
+				if len(igw[0].ProxyProtocolTLVs) > 0 {
+					proxyProtocolEnvoyFilterSNI = istioProxyProtocolEnvoyFilterSNITLVs()
+				}
+
 				expectedIstioManifests = append(expectedIstioManifests,
-					istioProxyProtocolEnvoyFilterSNI(),
+					proxyProtocolEnvoyFilterSNI,
 					istioProxyProtocolEnvoyFilterVPN(),
 					istioProxyProtocolEnvoyFilterVPNUnified(),
 				)
@@ -530,6 +540,16 @@ var _ = Describe("istiod", func() {
 			It("should successfully deploy all resources", func() {
 				checkSuccessfulDeployment(nil, nil)
 			})
+
+			Context("with TLVs", func() {
+				BeforeEach(func() {
+					igw[0].ProxyProtocolTLVs = []gardencorev1beta1.ProxyProtocolTLV{{Type: 234, Name: "vpce-id"}}
+				})
+
+				It("should successfully deploy all resources", func() {
+					checkSuccessfulDeployment(nil, nil)
+				})
+			})
 		})
 
 		Context("without proxy protocol termination", func() {
@@ -7,6 +7,7 @@ package istio
 import (
 	"context"
 	"embed"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"time"
@@ -65,6 +66,7 @@ type istiod struct {
 	values        Values
 
 	managedResourceIstioIngressName string
+	revisions                       *revisions
 }
 
 // IstiodValues contains configuration values for the Istiod component.
@@ -83,6 +85,9 @@ type IstiodValues struct {
 	Zones []string
 	// DualStack
 	DualStack bool
+	// CanaryUpgrade contains the configuration for canary upgrades of the istio control plane. If it is nil, `istiod` and
+	// the ingress gateways are upgraded in place.
+	CanaryUpgrade *CanaryUpgradeValues
 }
 
 // Values contains configuration values for the Istio component.
@@ -135,12 +140,22 @@ func (i *istiod) deployIstiod(ctx context.Context) error {
 		metav1.SetMetaDataLabel(&istiodNamespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigConsider, "true")
 		metav1.SetMetaDataLabel(&istiodNamespace.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleIstioSystem)
 		metav1.SetMetaDataAnnotation(&istiodNamespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigZones, strings.Join(i.values.Istiod.Zones, ","))
+		setRevisionAnnotations(istiodNamespace, i.revisions)
 		return nil
 	}); err != nil {
 		return err
 	}
 
-	renderedChart, err := i.generateIstiodChart()
+	if err := i.deployIstiodCanary(ctx); err != nil {
+		return err
+	}
+
+	image := i.values.Istiod.Image
+	if i.revisions != nil {
+		image = i.revisions.stableIstiodImage
+	}
+
+	renderedChart, err := i.generateIstiodChart("", image)
 	if err != nil {
 		return err
 	}
@@ -204,7 +219,36 @@ func (i *istiod) deployIstiod(ctx context.Context) error {
 	return managedresources.CreateForSeed(ctx, i.client, i.values.Istiod.Namespace, managedResourceIstioSystemName, false, serializedObjects)
 }
 
+// deployIstiodCanary deploys the istiod resources of the canary revision during the canary phase of an upgrade and
+// deletes them otherwise.
+func (i *istiod) deployIstiodCanary(ctx context.Context) error {
+	if i.revisions == nil || i.revisions.canaryRevision == "" {
+		return managedresources.DeleteForSeed(ctx, i.client, i.values.Istiod.Namespace, managedResourceIstioSystemCanaryName)
+	}
+
+	renderedChart, err := i.generateIstiodChart(i.revisions.canaryRevision, i.values.Istiod.Image)
+	if err != nil {
+		return err
+	}
+
+	serializedObjects, err := serializeRenderedChartAndRegistry(renderedChart, managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer))
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, i.client, i.values.Istiod.Namespace, managedResourceIstioSystemCanaryName, false, serializedObjects)
+}
+
 func (i *istiod) Deploy(ctx context.Context) error {
+	i.revisions = nil
+	if i.values.Istiod.Enabled && i.values.Istiod.CanaryUpgrade != nil {
+		revisions, err := i.computeRevisions(ctx)
+		if err != nil {
+			return fmt.Errorf("failed computing istio revisions: %w", err)
+		}
+		i.revisions = revisions
+	}
+
 	if err := i.deployIstiod(ctx); err != nil {
 		return err
 	}
@@ -354,7 +398,12 @@ func (i *istiod) Deploy(ctx context.Context) error {
 }
 
 func (i *istiod) Destroy(ctx context.Context) error {
-	for _, mr := range ManagedResourceNames(i.values.Istiod.Enabled, i.values.NamePrefix) {
+	managedResources := ManagedResourceNames(i.values.Istiod.Enabled, i.values.NamePrefix)
+	if i.values.Istiod.Enabled {
+		managedResources = append(managedResources, managedResourceIstioSystemCanaryName)
+	}
+
+	for _, mr := range managedResources {
 		if err := managedresources.DeleteForSeed(ctx, i.client, i.values.Istiod.Namespace, mr); err != nil {
 			return err
 		}
@@ -417,11 +466,19 @@ func (i *istiod) GetValues() Values {
 	return i.values
 }
 
-func (i *istiod) generateIstiodChart() (*chartrenderer.RenderedChart, error) {
+func (i *istiod) generateIstiodChart(revision, image string) (*chartrenderer.RenderedChart, error) {
+	serviceName, labels := IstiodServiceName, getIstiodLabels()
+	if revision != "" {
+		// The pods of other revisions must not be selected by the service, deployment, and pod disruption budget of the
+		// default revision, hence the `istio` label contains the revision.
+		serviceName, labels = IstiodServiceName+"-"+revision, map[string]string{"app": "istiod", "istio": "pilot-" + revision}
+	}
+
 	return i.chartRenderer.RenderEmbeddedFS(chartIstiod, chartPathIstiod, releaseName, i.values.Istiod.Namespace, map[string]any{
-		"serviceName":       IstiodServiceName,
+		"serviceName":       serviceName,
+		"revision":          revision,
 		"trustDomain":       i.values.Istiod.TrustDomain,
-		"labels":            getIstiodLabels(),
+		"labels":            labels,
 		"deployNamespace":   false,
 		"priorityClassName": i.values.Istiod.PriorityClassName,
 		"ports": map[string]any{
@@ -430,7 +487,7 @@ func (i *istiod) generateIstiodChart() (*chartrenderer.RenderedChart, error) {
 		"portsNames": map[string]any{
 			"metrics": istiodServicePortNameMetrics,
 		},
-		"image":     i.values.Istiod.Image,
+		"image":     image,
 		"dualStack": i.values.Istiod.DualStack,
 	})
 }
@@ -0,0 +1,424 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package konnectivity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// TunnelName is the value of the app label of the konnectivity-agent pods which can be used to check the tunnel
+// connection.
+const TunnelName = labelValue
+
+const (
+	labelValue = "konnectivity-agent"
+
+	managedResourceName = "shoot-core-konnectivity-agent"
+	name                = "konnectivity-agent"
+	containerName       = "konnectivity-agent"
+	secretNameClient    = "konnectivity-agent-client" // #nosec G101 -- No credential.
+
+	adminPort  = 8133
+	healthPort = 8134
+
+	volumeName          = "konnectivity-agent"
+	volumeMountPath     = "/srv/secrets/konnectivity-agent"
+	fileNameCABundle    = "ca.crt"
+	fileNameCertificate = secretsutils.DataKeyCertificate
+	fileNamePrivateKey  = secretsutils.DataKeyPrivateKey
+)
+
+// Values is a set of configuration values for the konnectivity-agent component.
+type Values struct {
+	// Image is the container image used for the konnectivity-agent.
+	Image string
+	// VPAEnabled marks whether VerticalPodAutoscaler is enabled for the shoot.
+	VPAEnabled bool
+	// VPAUpdateDisabled indicates whether the vertical pod autoscaler update should be disabled.
+	VPAUpdateDisabled bool
+	// Replicas is the number of konnectivity-agent replicas.
+	Replicas int32
+}
+
+// Interface contains functions for a konnectivity-agent deployer.
+type Interface interface {
+	component.DeployWaiter
+	// SetAdvertiseIPAddress sets the IP address on which the apiserver-proxy exposes the konnectivity-server port of the
+	// kube-apiserver in the shoot cluster.
+	SetAdvertiseIPAddress(string)
+}
+
+// New creates a new instance of DeployWaiter for the konnectivity-agent. The agents run in the shoot cluster and open
+// tunnels to the konnectivity-server sidecars of the kube-apiserver. They are an alternative to the VPN for the
+// connectivity from the control plane to the cluster network.
+func New(
+	client client.Client,
+	namespace string,
+	secretsManager secretsmanager.Interface,
+	values Values,
+) Interface {
+	return &konnectivityAgent{
+		client:         client,
+		namespace:      namespace,
+		secretsManager: secretsManager,
+		values:         values,
+	}
+}
+
+type konnectivityAgent struct {
+	client         client.Client
+	namespace      string
+	secretsManager secretsmanager.Interface
+	values         Values
+
+	advertiseIPAddress string
+}
+
+func (k *konnectivityAgent) Deploy(ctx context.Context) error {
+	if k.advertiseIPAddress == "" {
+		return fmt.Errorf("run SetAdvertiseIPAddress before deploying")
+	}
+
+	secretCA, found := k.secretsManager.Get(v1beta1constants.SecretNameCAVPN)
+	if !found {
+		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAVPN)
+	}
+
+	secretClient, err := k.secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+		Name:                        secretNameClient,
+		CommonName:                  name,
+		CertType:                    secretsutils.ClientCert,
+		SkipPublishingCACertificate: true,
+	}, secretsmanager.SignedByCA(v1beta1constants.SecretNameCAVPN), secretsmanager.Rotate(secretsmanager.InPlace))
+	if err != nil {
+		return err
+	}
+
+	data, err := k.computeResourcesData(secretCA, secretClient)
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForShoot(ctx, k.client, k.namespace, managedResourceName, managedresources.LabelValueGardener, false, data)
+}
+
+func (k *konnectivityAgent) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForShoot(ctx, k.client, k.namespace, managedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (k *konnectivityAgent) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, k.client, k.namespace, managedResourceName)
+}
+
+func (k *konnectivityAgent) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, k.client, k.namespace, managedResourceName)
+}
+
+func (k *konnectivityAgent) SetAdvertiseIPAddress(advertiseIPAddress string) {
+	k.advertiseIPAddress = advertiseIPAddress
+}
+
+func (k *konnectivityAgent) computeResourcesData(secretCAVPN, secretClientVPN *corev1.Secret) (map[string][]byte, error) {
+	var (
+		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+
+		secretCA = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "konnectivity-agent-ca",
+				Namespace: metav1.NamespaceSystem,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: secretCAVPN.Data,
+		}
+		secretClient = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secretNameClient,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: secretClientVPN.Data,
+		}
+	)
+
+	utilruntime.Must(kubernetesutils.MakeUnique(secretCA))
+	utilruntime.Must(kubernetesutils.MakeUnique(secretClient))
+
+	var (
+		vpa *vpaautoscalingv1.VerticalPodAutoscaler
+
+		serviceAccount = &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceSystem,
+				Labels:    getLabels(),
+			},
+			AutomountServiceAccountToken: ptr.To(false),
+		}
+
+		networkPolicy = &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener.cloud--allow-konnectivity-agent",
+				Namespace: metav1.NamespaceSystem,
+				Annotations: map[string]string{
+					v1beta1constants.GardenerDescription: "Allows the konnectivity-agent to communicate with shoot " +
+						"components and to connect to the konnectivity-server in the seed.",
+				},
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: getLabels(),
+				},
+				Egress:      []networkingv1.NetworkPolicyEgressRule{{}},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			},
+		}
+
+		networkPolicyFromSeed = &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener.cloud--allow-from-konnectivity-agent",
+				Namespace: metav1.NamespaceSystem,
+				Annotations: map[string]string{
+					v1beta1constants.GardenerDescription: fmt.Sprintf("Allows Ingress from the control plane via the "+
+						"konnectivity-agent to pods labeled with '%s=%s'.", v1beta1constants.LabelNetworkPolicyShootFromSeed, v1beta1constants.LabelNetworkPolicyAllowed),
+				},
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelNetworkPolicyShootFromSeed: v1beta1constants.LabelNetworkPolicyAllowed}},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From: []networkingv1.NetworkPolicyPeer{{
+						PodSelector: &metav1.LabelSelector{
+							MatchLabels: getLabels(),
+						},
+					}},
+				}},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			},
+		}
+
+		deployment = k.deployment(serviceAccount, secretCA, secretClient)
+	)
+
+	utilruntime.Must(references.InjectAnnotations(deployment))
+
+	if k.values.VPAEnabled {
+		vpaUpdateMode := vpaautoscalingv1.UpdateModeRecreate
+		if k.values.VPAUpdateDisabled {
+			vpaUpdateMode = vpaautoscalingv1.UpdateModeOff
+		}
+
+		vpa = &vpaautoscalingv1.VerticalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Spec: vpaautoscalingv1.VerticalPodAutoscalerSpec{
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "Deployment",
+					Name:       deployment.Name,
+				},
+				UpdatePolicy: &vpaautoscalingv1.PodUpdatePolicy{
+					UpdateMode: &vpaUpdateMode,
+				},
+				ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
+					ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{{
+						ContainerName: vpaautoscalingv1.DefaultContainerResourcePolicy,
+						MinAllowed: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("10Mi"),
+						},
+						ControlledValues: ptr.To(vpaautoscalingv1.ContainerControlledValuesRequestsOnly),
+					}},
+				},
+			},
+		}
+	}
+
+	return registry.AddAllAndSerialize(
+		secretCA,
+		secretClient,
+		serviceAccount,
+		networkPolicy,
+		networkPolicyFromSeed,
+		deployment,
+		vpa,
+	)
+}
+
+func (k *konnectivityAgent) deployment(serviceAccount *corev1.ServiceAccount, secretCA, secretClient *corev1.Secret) *appsv1.Deployment {
+	var (
+		intStrMax  = intstr.FromString("100%")
+		intStrZero = intstr.FromString("0%")
+	)
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceSystem,
+			Labels: map[string]string{
+				v1beta1constants.GardenRole:     v1beta1constants.GardenRoleSystemComponent,
+				v1beta1constants.LabelApp:       labelValue,
+				managedresources.LabelKeyOrigin: managedresources.LabelValueGardener,
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			RevisionHistoryLimit: ptr.To[int32](2),
+			Replicas:             ptr.To(max(k.values.Replicas, 1)),
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxSurge:       &intStrMax,
+					MaxUnavailable: &intStrZero,
+				},
+			},
+			Selector: &metav1.LabelSelector{
+				MatchLabels: getLabels(),
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						v1beta1constants.GardenRole:     v1beta1constants.GardenRoleSystemComponent,
+						v1beta1constants.LabelApp:       labelValue,
+						managedresources.LabelKeyOrigin: managedresources.LabelValueGardener,
+						"type":                          "tunnel",
+					},
+				},
+				Spec: corev1.PodSpec{
+					AutomountServiceAccountToken: ptr.To(false),
+					ServiceAccountName:           serviceAccount.Name,
+					PriorityClassName:            "system-cluster-critical",
+					DNSPolicy:                    corev1.DNSDefault,
+					// The konnectivity-server's certificate is only valid for a well-known host name. The apiserver-proxy
+					// listens on the advertised IP address and tunnels the connections to the konnectivity-server port of
+					// the kube-apiserver in the seed.
+					HostAliases: []corev1.HostAlias{{
+						IP:        k.advertiseIPAddress,
+						Hostnames: []string{kubeapiserverconstants.KonnectivityServerHost},
+					}},
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: ptr.To(true),
+						RunAsUser:    ptr.To[int64](65534),
+						RunAsGroup:   ptr.To[int64](65534),
+						FSGroup:      ptr.To[int64](65534),
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: corev1.SeccompProfileTypeRuntimeDefault,
+						},
+					},
+					Containers: []corev1.Container{{
+						Name:            containerName,
+						Image:           k.values.Image,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Command:         []string{"/proxy-agent"},
+						Args: []string{
+							"--logtostderr=true",
+							fmt.Sprintf("--ca-cert=%s/%s", volumeMountPath, fileNameCABundle),
+							fmt.Sprintf("--agent-cert=%s/%s", volumeMountPath, fileNameCertificate),
+							fmt.Sprintf("--agent-key=%s/%s", volumeMountPath, fileNamePrivateKey),
+							fmt.Sprintf("--proxy-server-host=%s", kubeapiserverconstants.KonnectivityServerHost),
+							fmt.Sprintf("--proxy-server-port=%d", kubeapiserverconstants.KonnectivityServerAgentPort),
+							fmt.Sprintf("--admin-server-port=%d", adminPort),
+							fmt.Sprintf("--health-server-port=%d", healthPort),
+							"--sync-forever=true",
+						},
+						LivenessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{
+									Path: "/healthz",
+									Port: intstr.FromInt32(healthPort),
+								},
+							},
+							InitialDelaySeconds: 15,
+							TimeoutSeconds:      15,
+						},
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("10m"),
+								corev1.ResourceMemory: resource.MustParse("30Mi"),
+							},
+						},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: ptr.To(false),
+						},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      volumeName,
+							MountPath: volumeMountPath,
+						}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: volumeName,
+						VolumeSource: corev1.VolumeSource{
+							Projected: &corev1.ProjectedVolumeSource{
+								DefaultMode: ptr.To[int32](0400),
+								Sources: []corev1.VolumeProjection{
+									{
+										Secret: &corev1.SecretProjection{
+											LocalObjectReference: corev1.LocalObjectReference{Name: secretCA.Name},
+											Items: []corev1.KeyToPath{{
+												Key:  secretsutils.DataKeyCertificateBundle,
+												Path: fileNameCABundle,
+											}},
+										},
+									},
+									{
+										Secret: &corev1.SecretProjection{
+											LocalObjectReference: corev1.LocalObjectReference{Name: secretClient.Name},
+											Items: []corev1.KeyToPath{
+												{
+													Key:  secretsutils.DataKeyCertificate,
+													Path: fileNameCertificate,
+												},
+												{
+													Key:  secretsutils.DataKeyPrivateKey,
+													Path: fileNamePrivateKey,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func getLabels() map[string]string {
+	return map[string]string{v1beta1constants.LabelApp: labelValue}
+}
@@ -0,0 +1,473 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package konnectivity_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/networking/konnectivity"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("KonnectivityAgent", func() {
+	var (
+		ctx                 = context.Background()
+		managedResourceName = "shoot-core-konnectivity-agent"
+		namespace           = "some-namespace"
+		image               = "some-image:some-tag"
+		advertiseIPAddress  = "10.2.170.21"
+
+		c      client.Client
+		sm     secretsmanager.Interface
+		values Values
+		agent  Interface
+
+		contain               func(...client.Object) types.GomegaMatcher
+		managedResource       *resourcesv1alpha1.ManagedResource
+		managedResourceSecret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		sm = fakesecretsmanager.New(c, namespace)
+		contain = NewManagedResourceContainsObjectsMatcher(c)
+
+		values = Values{
+			Image:    image,
+			Replicas: 2,
+		}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      managedResourceName,
+				Namespace: namespace,
+			},
+		}
+		managedResourceSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "managedresource-" + managedResource.Name,
+				Namespace: namespace,
+			},
+		}
+
+		By("Create secrets managed outside of this package for whose secretsmanager.Get() will be called")
+		Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca-vpn", Namespace: namespace}, Data: map[string][]byte{"bundle.crt": []byte("FOOBAR")}})).To(Succeed())
+	})
+
+	Describe("#Deploy", func() {
+		var (
+			networkPolicy = &networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gardener.cloud--allow-konnectivity-agent",
+					Namespace: "kube-system",
+					Annotations: map[string]string{
+						"gardener.cloud/description": "Allows the konnectivity-agent to communicate with shoot components and to connect to the konnectivity-server in the seed.",
+					},
+				},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "konnectivity-agent"}},
+					Egress:      []networkingv1.NetworkPolicyEgressRule{{}},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				},
+			}
+
+			networkPolicyFromSeed = &networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gardener.cloud--allow-from-konnectivity-agent",
+					Namespace: "kube-system",
+					Annotations: map[string]string{
+						"gardener.cloud/description": "Allows Ingress from the control plane via the konnectivity-agent to pods labeled with 'networking.gardener.cloud/from-seed=allowed'.",
+					},
+				},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"networking.gardener.cloud/from-seed": "allowed"}},
+					Ingress: []networkingv1.NetworkPolicyIngressRule{{
+						From: []networkingv1.NetworkPolicyPeer{{
+							PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "konnectivity-agent"}},
+						}},
+					}},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				},
+			}
+
+			serviceAccount = &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "konnectivity-agent",
+					Namespace: "kube-system",
+					Labels:    map[string]string{"app": "konnectivity-agent"},
+				},
+				AutomountServiceAccountToken: ptr.To(false),
+			}
+
+			vpaFor = func(updateMode vpaautoscalingv1.UpdateMode) *vpaautoscalingv1.VerticalPodAutoscaler {
+				return &vpaautoscalingv1.VerticalPodAutoscaler{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "konnectivity-agent",
+						Namespace: "kube-system",
+					},
+					Spec: vpaautoscalingv1.VerticalPodAutoscalerSpec{
+						TargetRef: &autoscalingv1.CrossVersionObjectReference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "konnectivity-agent",
+						},
+						UpdatePolicy: &vpaautoscalingv1.PodUpdatePolicy{UpdateMode: &updateMode},
+						ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
+							ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{{
+								ContainerName:    "*",
+								MinAllowed:       corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("10Mi")},
+								ControlledValues: ptr.To(vpaautoscalingv1.ContainerControlledValuesRequestsOnly),
+							}},
+						},
+					},
+				}
+			}
+
+			deploymentFor = func(secretNameCA, secretNameClient string) *appsv1.Deployment {
+				var (
+					intStrMax  = intstr.FromString("100%")
+					intStrZero = intstr.FromString("0%")
+					labels     = map[string]string{
+						"app":                 "konnectivity-agent",
+						"gardener.cloud/role": "system-component",
+						"origin":              "gardener",
+					}
+				)
+
+				deployment := &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "konnectivity-agent",
+						Namespace: "kube-system",
+						Labels:    labels,
+					},
+					Spec: appsv1.DeploymentSpec{
+						RevisionHistoryLimit: ptr.To[int32](2),
+						Replicas:             ptr.To[int32](2),
+						Strategy: appsv1.DeploymentStrategy{
+							Type: appsv1.RollingUpdateDeploymentStrategyType,
+							RollingUpdate: &appsv1.RollingUpdateDeployment{
+								MaxSurge:       &intStrMax,
+								MaxUnavailable: &intStrZero,
+							},
+						},
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "konnectivity-agent"}},
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{Labels: utils.MergeStringMaps(labels, map[string]string{"type": "tunnel"})},
+							Spec: corev1.PodSpec{
+								AutomountServiceAccountToken: ptr.To(false),
+								ServiceAccountName:           "konnectivity-agent",
+								PriorityClassName:            "system-cluster-critical",
+								DNSPolicy:                    corev1.DNSDefault,
+								HostAliases: []corev1.HostAlias{{
+									IP:        advertiseIPAddress,
+									Hostnames: []string{"konnectivity-server"},
+								}},
+								SecurityContext: &corev1.PodSecurityContext{
+									RunAsNonRoot:   ptr.To(true),
+									RunAsUser:      ptr.To[int64](65534),
+									RunAsGroup:     ptr.To[int64](65534),
+									FSGroup:        ptr.To[int64](65534),
+									SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+								},
+								Containers: []corev1.Container{{
+									Name:            "konnectivity-agent",
+									Image:           image,
+									ImagePullPolicy: corev1.PullIfNotPresent,
+									Command:         []string{"/proxy-agent"},
+									Args: []string{
+										"--logtostderr=true",
+										"--ca-cert=/srv/secrets/konnectivity-agent/ca.crt",
+										"--agent-cert=/srv/secrets/konnectivity-agent/tls.crt",
+										"--agent-key=/srv/secrets/konnectivity-agent/tls.key",
+										"--proxy-server-host=konnectivity-server",
+										"--proxy-server-port=8132",
+										"--admin-server-port=8133",
+										"--health-server-port=8134",
+										"--sync-forever=true",
+									},
+									LivenessProbe: &corev1.Probe{
+										ProbeHandler: corev1.ProbeHandler{
+											HTTPGet: &corev1.HTTPGetAction{
+												Path: "/healthz",
+												Port: intstr.FromInt32(8134),
+											},
+										},
+										InitialDelaySeconds: 15,
+										TimeoutSeconds:      15,
+									},
+									Resources: corev1.ResourceRequirements{
+										Requests: corev1.ResourceList{
+											corev1.ResourceCPU:    resource.MustParse("10m"),
+											corev1.ResourceMemory: resource.MustParse("30Mi"),
+										},
+									},
+									SecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: ptr.To(false)},
+									VolumeMounts: []corev1.VolumeMount{{
+										Name:      "konnectivity-agent",
+										MountPath: "/srv/secrets/konnectivity-agent",
+									}},
+								}},
+								Volumes: []corev1.Volume{{
+									Name: "konnectivity-agent",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To[int32](0400),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{Name: secretNameCA},
+														Items:                []corev1.KeyToPath{{Key: "bundle.crt", Path: "ca.crt"}},
+													},
+												},
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{Name: secretNameClient},
+														Items: []corev1.KeyToPath{
+															{Key: "tls.crt", Path: "tls.crt"},
+															{Key: "tls.key", Path: "tls.key"},
+														},
+													},
+												},
+											},
+										},
+									},
+								}},
+							},
+						},
+					},
+				}
+
+				utilruntime.Must(references.InjectAnnotations(deployment))
+				return deployment
+			}
+		)
+
+		It("should fail if the advertise IP address is not set", func() {
+			agent = New(c, namespace, sm, values)
+			Expect(agent.Deploy(ctx)).To(MatchError(ContainSubstring("run SetAdvertiseIPAddress before deploying")))
+		})
+
+		Context("advertise IP address set", func() {
+			var manifests []string
+
+			JustBeforeEach(func() {
+				agent = New(c, namespace, sm, values)
+				agent.SetAdvertiseIPAddress(advertiseIPAddress)
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+				Expect(agent.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+
+				expectedMr := &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            managedResource.Name,
+						Namespace:       managedResource.Namespace,
+						ResourceVersion: "1",
+						Labels:          map[string]string{"origin": "gardener"},
+					},
+					Spec: resourcesv1alpha1.ManagedResourceSpec{
+						InjectLabels: map[string]string{"shoot.gardener.cloud/no-cleanup": "true"},
+						SecretRefs:   []corev1.LocalObjectReference{{Name: managedResource.Spec.SecretRefs[0].Name}},
+						KeepObjects:  ptr.To(false),
+					},
+				}
+				utilruntime.Must(references.InjectAnnotations(expectedMr))
+				Expect(managedResource).To(DeepEqual(expectedMr))
+
+				managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+				Expect(managedResourceSecret.Type).To(Equal(corev1.SecretTypeOpaque))
+				Expect(managedResourceSecret.Immutable).To(Equal(ptr.To(true)))
+				Expect(managedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
+
+				var err error
+				manifests, err = test.ExtractManifestsFromManagedResourceData(managedResourceSecret.Data)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(managedResource).To(contain(
+					networkPolicy,
+					networkPolicyFromSeed,
+					serviceAccount,
+				))
+			})
+
+			Context("w/o VPA", func() {
+				It("should successfully deploy all resources", func() {
+					Expect(managedResource).To(contain(deploymentFor(expectSecret(manifests, "konnectivity-agent-ca"), expectSecret(manifests, "konnectivity-agent-client"))))
+					Expect(manifests).NotTo(ContainElement(ContainSubstring("kind: VerticalPodAutoscaler")))
+				})
+			})
+
+			Context("w/ VPA", func() {
+				BeforeEach(func() {
+					values.VPAEnabled = true
+				})
+
+				It("should successfully deploy all resources", func() {
+					Expect(managedResource).To(contain(
+						deploymentFor(expectSecret(manifests, "konnectivity-agent-ca"), expectSecret(manifests, "konnectivity-agent-client")),
+						vpaFor(vpaautoscalingv1.UpdateModeRecreate),
+					))
+				})
+
+				Context("w/ VPA update mode set to off", func() {
+					BeforeEach(func() {
+						values.VPAUpdateDisabled = true
+					})
+
+					It("should successfully deploy all resources", func() {
+						Expect(managedResource).To(contain(vpaFor(vpaautoscalingv1.UpdateModeOff)))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should successfully destroy all resources", func() {
+			agent = New(c, namespace, sm, values)
+			Expect(c.Create(ctx, managedResource)).To(Succeed())
+			Expect(c.Create(ctx, managedResourceSecret)).To(Succeed())
+
+			Expect(agent.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(BeNotFoundError())
+		})
+	})
+
+	Context("waiting functions", func() {
+		var fakeOps *retryfake.Ops
+
+		BeforeEach(func() {
+			agent = New(c, namespace, sm, values)
+
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			DeferCleanup(test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			))
+		})
+
+		Describe("#Wait", func() {
+			It("should fail because reading the ManagedResource fails", func() {
+				Expect(agent.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should fail because the ManagedResource doesn't become healthy", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       managedResourceName,
+						Namespace:  namespace,
+						Generation: 1,
+					},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionFalse},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionFalse},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(agent.Wait(ctx)).To(MatchError(ContainSubstring("is not healthy")))
+			})
+
+			It("should successfully wait for the managed resource to become healthy", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       managedResourceName,
+						Namespace:  namespace,
+						Generation: 1,
+					},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(agent.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should fail when the wait for the managed resource deletion times out", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, managedResource)).To(Succeed())
+
+				Expect(agent.WaitCleanup(ctx)).To(MatchError(ContainSubstring("still exists")))
+			})
+
+			It("should not return an error when it's already removed", func() {
+				Expect(agent.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
+
+func expectSecret(manifests []string, name string) string {
+	var secretManifest string
+
+	for _, manifest := range manifests {
+		if strings.Contains(manifest, "kind: Secret") && strings.Contains(manifest, "name: "+name+"-") {
+			secretManifest = manifest
+			break
+		}
+	}
+
+	secret := &corev1.Secret{}
+	Expect(runtime.DecodeInto(newCodec(), []byte(secretManifest), secret)).To(Succeed())
+	Expect(secret.Immutable).To(PointTo(BeTrue()))
+	Expect(secret.Data).NotTo(BeEmpty())
+	Expect(secret.Labels).To(HaveKeyWithValue("resources.gardener.cloud/garbage-collectable-reference", "true"))
+
+	return secret.Name
+}
+
+func newCodec() runtime.Codec {
+	var groupVersions []schema.GroupVersion
+	for k := range kubernetes.ShootScheme.AllKnownTypes() {
+		groupVersions = append(groupVersions, k.GroupVersion())
+	}
+	return kubernetes.ShootCodec.CodecForVersions(kubernetes.ShootSerializer, kubernetes.ShootSerializer, schema.GroupVersions(groupVersions), schema.GroupVersions(groupVersions))
+}
@@ -12,6 +12,7 @@ import (
 	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -97,6 +98,9 @@ type Values struct {
 	ExternalTrafficPolicy corev1.ServiceExternalTrafficPolicy
 	// VPAEnabled marks whether VerticalPodAutoscaler is enabled for the shoot.
 	VPAEnabled bool
+	// Autoscaling contains the settings for the horizontal autoscaling of the nginx-ingress controller. This field is
+	// only considered if the ClusterType is Shoot.
+	Autoscaling *Autoscaling
 	// Domains are the hosts and/or wildcard domains used by all ingress resources exposed by nginx-ingress.
 	Domains []string
 	// IstioIngressGatewayLabels are the labels for identifying the used istio ingress gateway.
@@ -107,6 +111,16 @@ type Values struct {
 	SeedIsGarden bool
 }
 
+// Autoscaling contains the settings for the horizontal autoscaling of the nginx-ingress controller.
+type Autoscaling struct {
+	// MinReplicas is the minimum number of replicas of the nginx-ingress controller.
+	MinReplicas int32
+	// MaxReplicas is the maximum number of replicas of the nginx-ingress controller.
+	MaxReplicas int32
+	// TargetCPUUtilizationPercentage is the average CPU utilization at which the nginx-ingress controller is scaled out.
+	TargetCPUUtilizationPercentage int32
+}
+
 // New creates a new instance of DeployWaiter for nginx-ingress
 func New(
 	client client.Client,
@@ -603,9 +617,10 @@ func (n *nginxIngress) computeResourcesData() (map[string][]byte, error) {
 			},
 		}
 
-		vpa                 *vpaautoscalingv1.VerticalPodAutoscaler
-		podDisruptionBudget *policyv1.PodDisruptionBudget
-		networkPolicy       *networkingv1.NetworkPolicy
+		vpa                     *vpaautoscalingv1.VerticalPodAutoscaler
+		horizontalPodAutoscaler *autoscalingv2.HorizontalPodAutoscaler
+		podDisruptionBudget     *policyv1.PodDisruptionBudget
+		networkPolicy           *networkingv1.NetworkPolicy
 
 		destinationRule *istionetworkingv1beta1.DestinationRule
 		gateway         *istionetworkingv1beta1.Gateway
@@ -706,6 +721,40 @@ func (n *nginxIngress) computeResourcesData() (map[string][]byte, error) {
 				Ingress:     []networkingv1.NetworkPolicyIngressRule{{}},
 			},
 		}
+
+		if n.values.Autoscaling != nil {
+			// Replicas are managed by the HorizontalPodAutoscaler, hence they must not be reverted by the
+			// gardener-resource-manager.
+			deploymentController.Spec.Replicas = ptr.To(n.values.Autoscaling.MinReplicas)
+			metav1.SetMetaDataAnnotation(&deploymentController.ObjectMeta, resourcesv1alpha1.PreserveReplicas, "true")
+
+			horizontalPodAutoscaler = &autoscalingv2.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      deploymentController.Name,
+					Namespace: n.values.TargetNamespace,
+					Labels:    n.getLabels(LabelValueController, false),
+				},
+				Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+					MinReplicas: ptr.To(n.values.Autoscaling.MinReplicas),
+					MaxReplicas: n.values.Autoscaling.MaxReplicas,
+					Metrics: []autoscalingv2.MetricSpec{{
+						Type: autoscalingv2.ResourceMetricSourceType,
+						Resource: &autoscalingv2.ResourceMetricSource{
+							Name: corev1.ResourceCPU,
+							Target: autoscalingv2.MetricTarget{
+								Type:               autoscalingv2.UtilizationMetricType,
+								AverageUtilization: ptr.To(n.values.Autoscaling.TargetCPUUtilizationPercentage),
+							},
+						},
+					}},
+					ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+						APIVersion: appsv1.SchemeGroupVersion.String(),
+						Kind:       "Deployment",
+						Name:       deploymentController.Name,
+					},
+				},
+			}
+		}
 	}
 
 	if n.values.VPAEnabled {
@@ -739,6 +788,12 @@ func (n *nginxIngress) computeResourcesData() (map[string][]byte, error) {
 				},
 			},
 		}
+
+		if horizontalPodAutoscaler != nil {
+			// The HorizontalPodAutoscaler scales based on the CPU utilization, hence the VerticalPodAutoscaler must
+			// only control the memory of the controller to not interfere with it.
+			vpa.Spec.ResourcePolicy.ContainerPolicies[0].ControlledResources = &[]corev1.ResourceName{corev1.ResourceMemory}
+		}
 	}
 
 	objectsToAdd := append(virtualServices, gateway)
@@ -752,6 +807,7 @@ func (n *nginxIngress) computeResourcesData() (map[string][]byte, error) {
 			deploymentController,
 			podDisruptionBudget,
 			vpa,
+			horizontalPodAutoscaler,
 			role,
 			roleBinding,
 			serviceBackend,
@@ -1329,6 +1329,56 @@ spec: {}
 					Expect(manifests).To(ConsistOf(expectedManifests))
 				})
 			})
+
+			Context("w/ autoscaling", func() {
+				BeforeEach(func() {
+					values.VPAEnabled = true
+					values.Autoscaling = &Autoscaling{
+						MinReplicas:                    2,
+						MaxReplicas:                    4,
+						TargetCPUUtilizationPercentage: 70,
+					}
+				})
+
+				It("should successfully deploy the horizontal pod autoscaler", func() {
+					Expect(manifests).To(ContainElement(`apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  labels:
+    app: nginx-ingress
+    component: controller
+    release: addons
+  name: addons-nginx-ingress-controller
+  namespace: kube-system
+spec:
+  maxReplicas: 4
+  metrics:
+  - resource:
+      name: cpu
+      target:
+        averageUtilization: 70
+        type: Utilization
+    type: Resource
+  minReplicas: 2
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: addons-nginx-ingress-controller
+status:
+  currentMetrics: null
+  desiredReplicas: 0
+`))
+					Expect(manifests).To(ContainElement(And(
+						ContainSubstring("kind: Deployment"),
+						ContainSubstring("resources.gardener.cloud/preserve-replicas: \"true\""),
+						ContainSubstring("replicas: 2"),
+					)))
+					Expect(manifests).To(ContainElement(And(
+						ContainSubstring("kind: VerticalPodAutoscaler"),
+						ContainSubstring("controlledResources:\n      - memory"),
+					)))
+				})
+			})
 		})
 
 		Describe("#Destroy", func() {
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plutono
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// LabelAdditionalShootDashboards is the label on ConfigMaps in the garden namespace of the seed which registers
+	// their data as additional dashboards for the Plutono instances of shoots.
+	LabelAdditionalShootDashboards = v1beta1constants.LabelPrefixMonitoringDashboard + "additional-shoot"
+	// AnnotationShootPurposes is the annotation on ConfigMaps registering additional dashboards which contains the
+	// comma-separated list of shoot purposes for which the dashboards are provisioned. If the annotation is not set, the
+	// dashboards are provisioned for all shoots.
+	AnnotationShootPurposes = v1beta1constants.LabelPrefixMonitoringDashboard + "shoot-purposes"
+
+	labelAdditionalDashboardsSource     = v1beta1constants.LabelPrefixMonitoringDashboard + "source"
+	additionalDashboardsConfigMapPrefix = "plutono-additional-dashboards-"
+)
+
+// LoadAdditionalShootDashboards reads the ConfigMaps registering additional dashboards from the given namespace and
+// returns the data of those which are relevant for shoots with the given purpose, keyed by the names of the ConfigMaps.
+func LoadAdditionalShootDashboards(ctx context.Context, c client.Reader, namespace string, purpose gardencorev1beta1.ShootPurpose) (map[string]map[string]string, error) {
+	configMapList := &corev1.ConfigMapList{}
+	if err := c.List(ctx, configMapList, client.InNamespace(namespace), client.MatchingLabels{LabelAdditionalShootDashboards: labelValueTrue}); err != nil {
+		return nil, fmt.Errorf("failed listing ConfigMaps with additional dashboards: %w", err)
+	}
+
+	dashboards := make(map[string]map[string]string, len(configMapList.Items))
+	for _, configMap := range configMapList.Items {
+		if purposes, ok := configMap.Annotations[AnnotationShootPurposes]; ok &&
+			!slices.ContainsFunc(strings.Split(purposes, ","), func(p string) bool { return strings.TrimSpace(p) == string(purpose) }) {
+			continue
+		}
+		dashboards[configMap.Name] = configMap.Data
+	}
+
+	return dashboards, nil
+}
+
+func (p *plutono) deployAdditionalDashboards(ctx context.Context) error {
+	for sourceName, data := range p.values.AdditionalDashboards {
+		dashboards, err := convertToCompactJSON(maps.Clone(data))
+		if err != nil {
+			return fmt.Errorf("failed converting additional dashboards of ConfigMap %s: %w", sourceName, err)
+		}
+
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: additionalDashboardsConfigMapPrefix + sourceName, Namespace: p.namespace}}
+		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, p.client, configMap, func() error {
+			configMap.Labels = utils.MergeStringMaps(getLabels(), map[string]string{
+				p.dashboardLabel():              labelValueTrue,
+				labelAdditionalDashboardsSource: sourceName,
+			})
+			configMap.Data = dashboards
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return p.deleteAdditionalDashboards(ctx, func(sourceName string) bool {
+		_, ok := p.values.AdditionalDashboards[sourceName]
+		return !ok
+	})
+}
+
+func (p *plutono) deleteAdditionalDashboards(ctx context.Context, shouldDelete func(sourceName string) bool) error {
+	configMapList := &corev1.ConfigMapList{}
+	if err := p.client.List(ctx, configMapList, client.InNamespace(p.namespace), client.HasLabels{labelAdditionalDashboardsSource}); err != nil {
+		return fmt.Errorf("failed listing ConfigMaps with additional dashboards: %w", err)
+	}
+
+	var configMapsToDelete []client.Object
+	for _, configMap := range configMapList.Items {
+		if shouldDelete(configMap.Labels[labelAdditionalDashboardsSource]) {
+			configMapsToDelete = append(configMapsToDelete, configMap.DeepCopy())
+		}
+	}
+
+	return kubernetesutils.DeleteObjects(ctx, p.client, configMapsToDelete...)
+}
@@ -68,6 +68,18 @@ func (mr *MockInterfaceMockRecorder) Destroy(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInterface)(nil).Destroy), ctx)
 }
 
+// SetAdditionalDashboards mocks base method.
+func (m *MockInterface) SetAdditionalDashboards(arg0 map[string]map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAdditionalDashboards", arg0)
+}
+
+// SetAdditionalDashboards indicates an expected call of SetAdditionalDashboards.
+func (mr *MockInterfaceMockRecorder) SetAdditionalDashboards(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAdditionalDashboards", reflect.TypeOf((*MockInterface)(nil).SetAdditionalDashboards), arg0)
+}
+
 // SetWildcardCertName mocks base method.
 func (m *MockInterface) SetWildcardCertName(arg0 *string) {
 	m.ctrl.T.Helper()
@@ -93,10 +93,16 @@ type Interface interface {
 	component.DeployWaiter
 	// SetWildcardCertName sets the WildcardCertSecretName components.
 	SetWildcardCertName(*string)
+	// SetAdditionalDashboards sets the additional dashboards registered by operators, keyed by the names of the
+	// ConfigMaps registering them.
+	SetAdditionalDashboards(map[string]map[string]string)
 }
 
 // Values is a set of configuration values for the plutono component.
 type Values struct {
+	// AdditionalDashboards are the additional dashboards registered by operators, keyed by the names of the ConfigMaps
+	// registering them. They are provisioned in addition to the default dashboards.
+	AdditionalDashboards map[string]map[string]string
 	// AuthSecretName is the secret name of plutono credentials.
 	AuthSecretName string
 	// ClusterType specifies the type of the cluster to which plutono is being deployed.
@@ -178,6 +184,10 @@ func (p *plutono) Deploy(ctx context.Context) error {
 		}
 	}
 
+	if err := p.deployAdditionalDashboards(ctx); err != nil {
+		return fmt.Errorf("failed deploying additional dashboards: %w", err)
+	}
+
 	return managedresources.CreateForSeedWithLabels(ctx, p.client, p.namespace, p.managedResourceName(), false, map[string]string{v1beta1constants.LabelCareConditionType: v1beta1constants.ObservabilityComponentsHealthy}, data)
 }
 
@@ -185,6 +195,9 @@ func (p *plutono) Destroy(ctx context.Context) error {
 	if err := kubernetesutils.DeleteObject(ctx, p.client, p.emptyDashboardConfigMap()); err != nil {
 		return fmt.Errorf("failed deleting dashboard ConfigMap: %w", err)
 	}
+	if err := p.deleteAdditionalDashboards(ctx, func(string) bool { return true }); err != nil {
+		return fmt.Errorf("failed deleting additional dashboards: %w", err)
+	}
 	return managedresources.DeleteForSeed(ctx, p.client, p.namespace, p.managedResourceName())
 }
 
@@ -217,6 +230,10 @@ func (p *plutono) SetWildcardCertName(secretName *string) {
 	p.values.WildcardCertName = secretName
 }
 
+func (p *plutono) SetAdditionalDashboards(dashboards map[string]map[string]string) {
+	p.values.AdditionalDashboards = dashboards
+}
+
 func (p *plutono) computeResourcesData(ctx context.Context) (*corev1.ConfigMap, map[string][]byte, error) {
 	registry := managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
 
@@ -767,17 +767,53 @@ status:
 					checkDeployedResources("plutono-dashboards", 26)
 				})
 			})
+
+			Context("w/ additional dashboards", func() {
+				var staleConfigMap *corev1.ConfigMap
+
+				BeforeEach(func() {
+					values.AdditionalDashboards = map[string]map[string]string{"foo": {"foo.json": "title: foo"}}
+
+					staleConfigMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+						Name:      "plutono-additional-dashboards-bar",
+						Namespace: namespace,
+						Labels:    map[string]string{"dashboard.monitoring.gardener.cloud/source": "bar"},
+					}}
+					Expect(c.Create(ctx, staleConfigMap)).To(Succeed())
+				})
+
+				It("should successfully deploy all resources", func() {
+					checkDeployedResources("plutono-dashboards", 34)
+
+					configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "plutono-additional-dashboards-foo", Namespace: namespace}}
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+					Expect(configMap.Labels).To(Equal(map[string]string{
+						"component": "plutono",
+						"dashboard.monitoring.gardener.cloud/shoot":  "true",
+						"dashboard.monitoring.gardener.cloud/source": "foo",
+					}))
+					Expect(configMap.Data).To(Equal(map[string]string{"foo.json": `{"title":"foo"}`}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(staleConfigMap), staleConfigMap)).To(BeNotFoundError())
+				})
+			})
 		})
 	})
 
 	Describe("#Destroy", func() {
 		It("should successfully destroy all resources", func() {
 			dashboardConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "plutono-dashboards", Namespace: namespace}}
+			additionalDashboardsConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      "plutono-additional-dashboards-foo",
+				Namespace: namespace,
+				Labels:    map[string]string{"dashboard.monitoring.gardener.cloud/source": "foo"},
+			}}
 
 			component = New(c, namespace, fakeSecretManager, values)
 			Expect(c.Create(ctx, managedResource)).To(Succeed())
 			Expect(c.Create(ctx, managedResourceSecret)).To(Succeed())
 			Expect(c.Create(ctx, dashboardConfigMap)).To(Succeed())
+			Expect(c.Create(ctx, additionalDashboardsConfigMap)).To(Succeed())
 
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
@@ -788,6 +824,7 @@ status:
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(dashboardConfigMap), dashboardConfigMap)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(additionalDashboardsConfigMap), additionalDashboardsConfigMap)).To(BeNotFoundError())
 		})
 	})
 
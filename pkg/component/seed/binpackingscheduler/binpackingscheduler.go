@@ -0,0 +1,397 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package binpackingscheduler
+
+import (
+	"bytes"
+	"context"
+	"text/template"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	kubescheduler "github.com/gardener/gardener/pkg/component/kubernetes/scheduler"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "bin-packing-scheduler"
+	// SchedulerName is the name of the scheduler profile which bin-packs shoot control plane pods. Pods must specify
+	// it in their .spec.schedulerName to be scheduled by this scheduler.
+	SchedulerName = kubescheduler.BinPackingSchedulerName
+
+	name          = "bin-packing-scheduler"
+	containerName = "kube-scheduler"
+	port          = 10259
+
+	volumeNameConfig      = "config"
+	volumeMountPathConfig = "/var/lib/kube-scheduler-config"
+	dataKeyConfig         = "config.yaml"
+)
+
+var configTemplate = template.Must(template.New("config").Parse(`apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+leaderElection:
+  leaderElect: true
+  resourceName: {{ .name }}
+  resourceNamespace: {{ .namespace }}
+profiles:
+- schedulerName: {{ .schedulerName }}
+  pluginConfig:
+  - name: NodeResourcesFit
+    args:
+      scoringStrategy:
+        type: MostAllocated
+  plugins:
+    score:
+      disabled:
+      - name: NodeResourcesBalancedAllocation
+      enabled:
+      # Soft topology spread constraints (e.g., those of highly available control planes) must still win against
+      # bin-packing, hence their weight is increased. Hard constraints are enforced by the filter phase anyway.
+      - name: PodTopologySpread
+        weight: 5
+`))
+
+// Values is a set of configuration values for the bin-packing scheduler.
+type Values struct {
+	// Image is the container image of the kube-scheduler.
+	Image string
+	// Replicas is the number of replicas.
+	Replicas int32
+}
+
+// New creates a new instance of DeployWaiter for the kube-scheduler which bin-packs shoot control plane pods in the
+// seed.
+func New(
+	client client.Client,
+	namespace string,
+	values Values,
+) component.DeployWaiter {
+	return &binPackingScheduler{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+type binPackingScheduler struct {
+	client    client.Client
+	namespace string
+	values    Values
+}
+
+func (b *binPackingScheduler) Deploy(ctx context.Context) error {
+	registry := managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+
+	configMap, err := b.configMap()
+	if err != nil {
+		return err
+	}
+
+	var (
+		serviceAccount = &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: b.namespace,
+				Labels:    getLabels(),
+			},
+			AutomountServiceAccountToken: ptr.To(false),
+		}
+		subjects = []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccount.Name,
+			Namespace: serviceAccount.Namespace,
+		}}
+
+		clusterRoleBindingKubeScheduler = &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "gardener.cloud:" + name + ":kube-scheduler",
+				Labels: getLabels(),
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     "system:kube-scheduler",
+			},
+			Subjects: subjects,
+		}
+		clusterRoleBindingVolumeScheduler = &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "gardener.cloud:" + name + ":volume-scheduler",
+				Labels: getLabels(),
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     "system:volume-scheduler",
+			},
+			Subjects: subjects,
+		}
+		role = &rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener.cloud:" + name,
+				Namespace: b.namespace,
+				Labels:    getLabels(),
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{"coordination.k8s.io"},
+					Resources: []string{"leases"},
+					Verbs:     []string{"create"},
+				},
+				{
+					APIGroups:     []string{"coordination.k8s.io"},
+					Resources:     []string{"leases"},
+					ResourceNames: []string{name},
+					Verbs:         []string{"get", "watch", "update"},
+				},
+			},
+		}
+		roleBinding = &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener.cloud:" + name,
+				Namespace: b.namespace,
+				Labels:    getLabels(),
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "Role",
+				Name:     role.Name,
+			},
+			Subjects: subjects,
+		}
+		roleBindingAuthenticationReader = &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener.cloud:" + name + ":extension-apiserver-authentication-reader",
+				Namespace: metav1.NamespaceSystem,
+				Labels:    getLabels(),
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "Role",
+				Name:     "extension-apiserver-authentication-reader",
+			},
+			Subjects: subjects,
+		}
+
+		deployment = b.deployment(serviceAccount.Name, configMap.Name)
+
+		podDisruptionBudget = &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: b.namespace,
+				Labels:    getLabels(),
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				MaxUnavailable:             ptr.To(intstr.FromInt32(1)),
+				Selector:                   deployment.Spec.Selector,
+				UnhealthyPodEvictionPolicy: ptr.To(policyv1.AlwaysAllow),
+			},
+		}
+
+		vpa = &vpaautoscalingv1.VerticalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: b.namespace,
+				Labels:    getLabels(),
+			},
+			Spec: vpaautoscalingv1.VerticalPodAutoscalerSpec{
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "Deployment",
+					Name:       deployment.Name,
+				},
+				UpdatePolicy: &vpaautoscalingv1.PodUpdatePolicy{
+					UpdateMode: ptr.To(vpaautoscalingv1.UpdateModeRecreate),
+				},
+				ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
+					ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{
+						{
+							ContainerName:    containerName,
+							ControlledValues: ptr.To(vpaautoscalingv1.ContainerControlledValuesRequestsOnly),
+						},
+						{
+							ContainerName: vpaautoscalingv1.DefaultContainerResourcePolicy,
+							Mode:          ptr.To(vpaautoscalingv1.ContainerScalingModeOff),
+						},
+					},
+				},
+			},
+		}
+	)
+
+	resources, err := registry.AddAllAndSerialize(
+		serviceAccount,
+		clusterRoleBindingKubeScheduler,
+		clusterRoleBindingVolumeScheduler,
+		role,
+		roleBinding,
+		roleBindingAuthenticationReader,
+		configMap,
+		deployment,
+		podDisruptionBudget,
+		vpa,
+	)
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, b.client, b.namespace, ManagedResourceName, false, resources)
+}
+
+func (b *binPackingScheduler) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForSeed(ctx, b.client, b.namespace, ManagedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (b *binPackingScheduler) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, b.client, b.namespace, ManagedResourceName)
+}
+
+func (b *binPackingScheduler) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, b.client, b.namespace, ManagedResourceName)
+}
+
+func (b *binPackingScheduler) configMap() (*corev1.ConfigMap, error) {
+	var config bytes.Buffer
+	if err := configTemplate.Execute(&config, map[string]any{
+		"name":          name,
+		"namespace":     b.namespace,
+		"schedulerName": SchedulerName,
+	}); err != nil {
+		return nil, err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "-config",
+			Namespace: b.namespace,
+			Labels:    getLabels(),
+		},
+		Data: map[string]string{dataKeyConfig: config.String()},
+	}
+	utilruntime.Must(kubernetesutils.MakeUnique(configMap))
+
+	return configMap, nil
+}
+
+func (b *binPackingScheduler) deployment(serviceAccountName, configMapName string) *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: b.namespace,
+			Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+				resourcesv1alpha1.HighAvailabilityConfigType: resourcesv1alpha1.HighAvailabilityConfigTypeController,
+			}),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas:             &b.values.Replicas,
+			RevisionHistoryLimit: ptr.To[int32](2),
+			Selector:             &metav1.LabelSelector{MatchLabels: getLabels()},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+						v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
+						v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer: v1beta1constants.LabelNetworkPolicyAllowed,
+					}),
+				},
+				Spec: corev1.PodSpec{
+					// The scheduler must be able to schedule shoot control plane pods, hence it must not be preempted
+					// by them.
+					PriorityClassName:  v1beta1constants.PriorityClassNameSeedSystem900,
+					ServiceAccountName: serviceAccountName,
+					Containers: []corev1.Container{{
+						Name:            containerName,
+						Image:           b.values.Image,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Command: []string{
+							"/usr/local/bin/kube-scheduler",
+							"--config=" + volumeMountPathConfig + "/" + dataKeyConfig,
+							"--v=2",
+						},
+						LivenessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{
+									Path:   "/healthz",
+									Scheme: corev1.URISchemeHTTPS,
+									Port:   intstr.FromInt32(port),
+								},
+							},
+							SuccessThreshold:    1,
+							FailureThreshold:    2,
+							InitialDelaySeconds: 15,
+							PeriodSeconds:       10,
+							TimeoutSeconds:      15,
+						},
+						Ports: []corev1.ContainerPort{{
+							Name:          "metrics",
+							ContainerPort: port,
+							Protocol:      corev1.ProtocolTCP,
+						}},
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("10m"),
+								corev1.ResourceMemory: resource.MustParse("50Mi"),
+							},
+						},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: ptr.To(false),
+						},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      volumeNameConfig,
+							MountPath: volumeMountPathConfig,
+							ReadOnly:  true,
+						}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: volumeNameConfig,
+						VolumeSource: corev1.VolumeSource{
+							ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	utilruntime.Must(references.InjectAnnotations(deployment))
+
+	return deployment
+}
+
+func getLabels() map[string]string {
+	return map[string]string{v1beta1constants.LabelApp: name}
+}
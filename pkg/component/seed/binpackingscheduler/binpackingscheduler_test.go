@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package binpackingscheduler_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/seed/binpackingscheduler"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("BinPackingScheduler", func() {
+	var (
+		ctx = context.Background()
+
+		namespace = "garden"
+		image     = "some-image:some-tag"
+
+		c         client.Client
+		component component.DeployWaiter
+
+		managedResource *resourcesv1alpha1.ManagedResource
+
+		configMapYAML = `apiVersion: v1
+data:
+  config.yaml: |
+    apiVersion: kubescheduler.config.k8s.io/v1
+    kind: KubeSchedulerConfiguration
+    leaderElection:
+      leaderElect: true
+      resourceName: bin-packing-scheduler
+      resourceNamespace: garden
+    profiles:
+    - schedulerName: bin-packing-scheduler
+      pluginConfig:
+      - name: NodeResourcesFit
+        args:
+          scoringStrategy:
+            type: MostAllocated
+      plugins:
+        score:
+          disabled:
+          - name: NodeResourcesBalancedAllocation
+          enabled:
+          # Soft topology spread constraints (e.g., those of highly available control planes) must still win against
+          # bin-packing, hence their weight is increased. Hard constraints are enforced by the filter phase anyway.
+          - name: PodTopologySpread
+            weight: 5
+immutable: true
+kind: ConfigMap
+metadata:
+  labels:
+    app: bin-packing-scheduler
+    resources.gardener.cloud/garbage-collectable-reference: "true"
+  name: bin-packing-scheduler-config-fc7be9e8
+  namespace: garden
+`
+		deploymentYAML = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    reference.resources.gardener.cloud/configmap-943d6524: bin-packing-scheduler-config-fc7be9e8
+  labels:
+    app: bin-packing-scheduler
+    high-availability-config.resources.gardener.cloud/type: controller
+  name: bin-packing-scheduler
+  namespace: garden
+spec:
+  replicas: 2
+  revisionHistoryLimit: 2
+  selector:
+    matchLabels:
+      app: bin-packing-scheduler
+  strategy: {}
+  template:
+    metadata:
+      annotations:
+        reference.resources.gardener.cloud/configmap-943d6524: bin-packing-scheduler-config-fc7be9e8
+      labels:
+        app: bin-packing-scheduler
+        networking.gardener.cloud/to-dns: allowed
+        networking.gardener.cloud/to-runtime-apiserver: allowed
+    spec:
+      containers:
+      - command:
+        - /usr/local/bin/kube-scheduler
+        - --config=/var/lib/kube-scheduler-config/config.yaml
+        - --v=2
+        image: ` + image + `
+        imagePullPolicy: IfNotPresent
+        livenessProbe:
+          failureThreshold: 2
+          httpGet:
+            path: /healthz
+            port: 10259
+            scheme: HTTPS
+          initialDelaySeconds: 15
+          periodSeconds: 10
+          successThreshold: 1
+          timeoutSeconds: 15
+        name: kube-scheduler
+        ports:
+        - containerPort: 10259
+          name: metrics
+          protocol: TCP
+        resources:
+          requests:
+            cpu: 10m
+            memory: 50Mi
+        securityContext:
+          allowPrivilegeEscalation: false
+        volumeMounts:
+        - mountPath: /var/lib/kube-scheduler-config
+          name: config
+          readOnly: true
+      priorityClassName: gardener-system-900
+      serviceAccountName: bin-packing-scheduler
+      volumes:
+      - configMap:
+          name: bin-packing-scheduler-config-fc7be9e8
+        name: config
+status: {}
+`
+		roleYAML = `apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  labels:
+    app: bin-packing-scheduler
+  name: gardener.cloud:bin-packing-scheduler
+  namespace: garden
+rules:
+- apiGroups:
+  - coordination.k8s.io
+  resources:
+  - leases
+  verbs:
+  - create
+- apiGroups:
+  - coordination.k8s.io
+  resourceNames:
+  - bin-packing-scheduler
+  resources:
+  - leases
+  verbs:
+  - get
+  - watch
+  - update
+`
+		clusterRoleBindingYAML = `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  labels:
+    app: bin-packing-scheduler
+  name: gardener.cloud:bin-packing-scheduler:kube-scheduler
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: system:kube-scheduler
+subjects:
+- kind: ServiceAccount
+  name: bin-packing-scheduler
+  namespace: garden
+`
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		component = New(c, namespace, Values{Image: image, Replicas: 2})
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ManagedResourceName,
+				Namespace: namespace,
+			},
+		}
+	})
+
+	Describe("#Deploy", func() {
+		It("should successfully deploy the resources", func() {
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Spec.Class).To(Equal(ptr.To("seed")))
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+
+			managedResourceSecret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, managedResourceSecret)).To(Succeed())
+
+			manifests, err := test.ExtractManifestsFromManagedResourceData(managedResourceSecret.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifests).To(HaveLen(10))
+			Expect(manifests).To(ContainElements(configMapYAML, deploymentYAML, roleYAML, clusterRoleBindingYAML))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should successfully destroy all resources", func() {
+			Expect(component.Deploy(ctx)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+
+			Expect(component.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+
+	Context("waiting functions", func() {
+		var fakeOps *retryfake.Ops
+
+		BeforeEach(func() {
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			DeferCleanup(test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			))
+		})
+
+		Describe("#Wait", func() {
+			It("should fail because reading the ManagedResource fails", func() {
+				Expect(component.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should successfully wait for the managed resource to become healthy", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       ManagedResourceName,
+						Namespace:  namespace,
+						Generation: 1,
+					},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{
+								Type:   resourcesv1alpha1.ResourcesApplied,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+							{
+								Type:   resourcesv1alpha1.ResourcesHealthy,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(component.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should successfully wait for the deletion", func() {
+				Expect(component.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package crds
+
+import (
+	"context"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+// ManagedResourceNamePrefix is the prefix of the names of the ManagedResources containing the custom resource
+// definitions of a group.
+const ManagedResourceNamePrefix = "seed-crds-"
+
+const (
+	// GroupMachineControllerManager is the group of the custom resource definitions of machine-controller-manager.
+	GroupMachineControllerManager = "machine-controller-manager"
+	// GroupEtcdDruid is the group of the custom resource definitions of etcd-druid.
+	GroupEtcdDruid = "etcd-druid"
+	// GroupIstio is the group of the custom resource definitions of Istio.
+	GroupIstio = "istio"
+	// GroupVPA is the group of the custom resource definitions of the Vertical Pod Autoscaler.
+	GroupVPA = "vpa"
+)
+
+// AllGroups contains all groups of custom resource definitions which are managed via ManagedResources.
+var AllGroups = []string{
+	GroupMachineControllerManager,
+	GroupEtcdDruid,
+	GroupIstio,
+	GroupVPA,
+}
+
+// ManagedResourceName returns the name of the ManagedResource containing the custom resource definitions of the given
+// group.
+func ManagedResourceName(group string) string {
+	return ManagedResourceNamePrefix + group
+}
+
+// Values is a set of configuration values for the custom resource definitions of a group.
+type Values struct {
+	// Group is the name of the group of custom resource definitions.
+	Group string
+	// CustomResourceDefinitions are the custom resource definitions which shall be managed.
+	CustomResourceDefinitions []*apiextensionsv1.CustomResourceDefinition
+}
+
+// New creates a new instance of DeployWaiter for the custom resource definitions of a group. The custom resource
+// definitions are managed via a ManagedResource which keeps its objects, i.e., destroying the component only releases
+// the custom resource definitions but does not delete them.
+func New(
+	client client.Client,
+	namespace string,
+	values Values,
+) component.DeployWaiter {
+	return &crds{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+type crds struct {
+	client    client.Client
+	namespace string
+	values    Values
+}
+
+func (c *crds) Deploy(ctx context.Context) error {
+	registry := managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+
+	for _, crd := range c.values.CustomResourceDefinitions {
+		if err := registry.Add(crd); err != nil {
+			return err
+		}
+	}
+
+	data, err := registry.SerializedObjects()
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, c.client, c.namespace, ManagedResourceName(c.values.Group), true, data)
+}
+
+func (c *crds) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForSeed(ctx, c.client, c.namespace, ManagedResourceName(c.values.Group))
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 5 * time.Minute
+
+func (c *crds) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, c.client, c.namespace, ManagedResourceName(c.values.Group))
+}
+
+func (c *crds) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, c.client, c.namespace, ManagedResourceName(c.values.Group))
+}
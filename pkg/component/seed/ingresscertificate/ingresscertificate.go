@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ingresscertificate
+
+import (
+	"context"
+	"time"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "seed-ingress-wildcard-certificate"
+	// CertificateName is the name of the Certificate resource requesting the wildcard certificate.
+	CertificateName = "seed-ingress-wildcard"
+	// SecretName is the name of the secret the issued wildcard certificate is stored in.
+	SecretName = "seed-ingress-wildcard-certificate"
+)
+
+// Values is a set of configuration values for the ingress wildcard certificate.
+type Values struct {
+	// Domain is the ingress domain of the seed.
+	Domain string
+	// IssuerName is the name of the issuer used for requesting the certificate. If nil, the default issuer of the
+	// certificate controller is used.
+	IssuerName *string
+}
+
+// New creates a new instance of DeployWaiter for the wildcard certificate of the seed ingress domain. The certificate
+// is requested via a `cert.gardener.cloud/v1alpha1.Certificate` resource which is served by an extension or by
+// cert-management. The issued certificate is labeled as control plane wildcard certificate so that it is picked up by
+// the components exposed via the seed ingress domain.
+func New(
+	client client.Client,
+	namespace string,
+	values Values,
+) component.DeployWaiter {
+	return &ingressCertificate{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+type ingressCertificate struct {
+	client    client.Client
+	namespace string
+	values    Values
+}
+
+func (i *ingressCertificate) Deploy(ctx context.Context) error {
+	data, err := i.computeResourcesData()
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, i.client, i.namespace, ManagedResourceName, false, data)
+}
+
+func (i *ingressCertificate) Destroy(ctx context.Context) error {
+	if err := managedresources.DeleteForSeed(ctx, i.client, i.namespace, ManagedResourceName); err != nil {
+		return err
+	}
+
+	// The secret is created by the certificate controller and hence not cleaned up together with the ManagedResource.
+	return kubernetesutils.DeleteObject(ctx, i.client, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SecretName, Namespace: i.namespace}})
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 5 * time.Minute
+
+func (i *ingressCertificate) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, i.client, i.namespace, ManagedResourceName)
+}
+
+func (i *ingressCertificate) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, i.client, i.namespace, ManagedResourceName)
+}
+
+func (i *ingressCertificate) computeResourcesData() (map[string][]byte, error) {
+	var (
+		registry = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+
+		certificate = &certv1alpha1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      CertificateName,
+				Namespace: i.namespace,
+			},
+			Spec: certv1alpha1.CertificateSpec{
+				CommonName: ptr.To("*." + i.values.Domain),
+				SecretRef: &corev1.SecretReference{
+					Name:      SecretName,
+					Namespace: i.namespace,
+				},
+				SecretLabels: map[string]string{
+					v1beta1constants.GardenRole: v1beta1constants.GardenRoleControlPlaneWildcardCert,
+				},
+			},
+		}
+	)
+
+	if i.values.IssuerName != nil {
+		certificate.Spec.IssuerRef = &certv1alpha1.IssuerRef{Name: *i.values.IssuerName}
+	}
+
+	return registry.AddAllAndSerialize(certificate)
+}
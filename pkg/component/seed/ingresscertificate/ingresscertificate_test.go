@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ingresscertificate_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/seed/ingresscertificate"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("IngressCertificate", func() {
+	var (
+		ctx = context.Background()
+
+		namespace = "garden"
+
+		c                  client.Client
+		values             Values
+		ingressCertificate component.DeployWaiter
+
+		managedResource       *resourcesv1alpha1.ManagedResource
+		managedResourceSecret *corev1.Secret
+
+		certificateYAMLFor = func(issuerRef string) string {
+			out := `apiVersion: cert.gardener.cloud/v1alpha1
+kind: Certificate
+metadata:
+  name: seed-ingress-wildcard
+  namespace: garden
+spec:
+  commonName: '*.ingress.seed.example.com'
+`
+			if issuerRef != "" {
+				out += `  issuerRef:
+    name: ` + issuerRef + `
+`
+			}
+			out += `  secretLabels:
+    gardener.cloud/role: controlplane-cert
+  secretRef:
+    name: seed-ingress-wildcard-certificate
+    namespace: garden
+status:
+  state: ""
+`
+			return out
+		}
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		values = Values{Domain: "ingress.seed.example.com"}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "seed-ingress-wildcard-certificate",
+				Namespace: namespace,
+			},
+		}
+		managedResourceSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "managedresource-" + managedResource.Name,
+				Namespace: namespace,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		ingressCertificate = New(c, namespace, values)
+	})
+
+	Describe("#Deploy", func() {
+		var manifests []string
+
+		JustBeforeEach(func() {
+			Expect(ingressCertificate.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			expectedMr := &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            managedResource.Name,
+					Namespace:       managedResource.Namespace,
+					Labels:          map[string]string{"gardener.cloud/role": "seed-system-component"},
+					ResourceVersion: "1",
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					Class: ptr.To("seed"),
+					SecretRefs: []corev1.LocalObjectReference{{
+						Name: managedResource.Spec.SecretRefs[0].Name,
+					}},
+					KeepObjects: ptr.To(false),
+				},
+			}
+			utilruntime.Must(references.InjectAnnotations(expectedMr))
+			Expect(managedResource).To(DeepEqual(expectedMr))
+
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+			var err error
+			manifests, err = test.ExtractManifestsFromManagedResourceData(managedResourceSecret.Data)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should request the certificate from the default issuer", func() {
+			Expect(manifests).To(ConsistOf(certificateYAMLFor("")))
+		})
+
+		Context("with issuer", func() {
+			BeforeEach(func() {
+				values.IssuerName = ptr.To("acme")
+			})
+
+			It("should request the certificate from the configured issuer", func() {
+				Expect(manifests).To(ConsistOf(certificateYAMLFor("acme")))
+			})
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete the managed resource and the issued certificate secret", func() {
+			certificateSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "seed-ingress-wildcard-certificate", Namespace: namespace}}
+			Expect(c.Create(ctx, managedResource)).To(Succeed())
+			Expect(c.Create(ctx, managedResourceSecret)).To(Succeed())
+			Expect(c.Create(ctx, certificateSecret)).To(Succeed())
+
+			Expect(ingressCertificate.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(certificateSecret), certificateSecret)).To(BeNotFoundError())
+		})
+	})
+})
@@ -50,10 +50,12 @@ func NewIstio(
 	serviceExternalIP *string,
 	servicePorts []corev1.ServicePort,
 	terminateLoadBalancerProxyProtocol *bool,
+	proxyProtocolTLVs []gardencorev1beta1.ProxyProtocolTLV,
 	vpnEnabled bool,
 	zones []string,
 	dualStack bool,
 	kubernetesVersion *semver.Version,
+	canaryUpgrade *istio.CanaryUpgradeValues,
 ) (
 	istio.Interface,
 	error,
@@ -73,6 +75,17 @@ func NewIstio(
 		return nil, err
 	}
 
+	if canaryUpgrade != nil {
+		revision := IstioRevision(istiodImage.Tag)
+		if len(validation.IsDNS1123Label(revision)) > 0 {
+			return nil, fmt.Errorf("cannot determine istio revision of image %s for canary upgrades", istiodImage.String())
+		}
+
+		canaryUpgradeWithRevision := *canaryUpgrade
+		canaryUpgradeWithRevision.Revision = revision
+		canaryUpgrade = &canaryUpgradeWithRevision
+	}
+
 	if len(zones) > 1 {
 		// Each availability zone should have at least 2 replicas as on some infrastructures each
 		// zonal load balancer is exposed individually via its own IP address. Therefore, having
@@ -115,6 +128,7 @@ func NewIstio(
 		Namespace:                          namePrefix + ingressNamespace,
 		PriorityClassName:                  priorityClassName,
 		TerminateLoadBalancerProxyProtocol: ptr.Deref(terminateLoadBalancerProxyProtocol, false),
+		ProxyProtocolTLVs:                  proxyProtocolTLVs,
 		VPNEnabled:                         vpnEnabled,
 		DualStack:                          dualStack,
 		EnforceSpreadAcrossHosts:           enforceSpreadAcrossHosts,
@@ -133,6 +147,7 @@ func NewIstio(
 				TrustDomain:       gardencorev1beta1.DefaultDomain,
 				Zones:             zones,
 				DualStack:         dualStack,
+				CanaryUpgrade:     canaryUpgrade,
 			},
 			IngressGateway: []istio.IngressGatewayValues{
 				defaultIngressGatewayConfig,
@@ -158,6 +173,7 @@ func AddIstioIngressGateway(
 	zone *string,
 	dualStack bool,
 	terminateLoadBalancerProxyProtocol *bool,
+	proxyProtocolTLVs []gardencorev1beta1.ProxyProtocolTLV,
 	kubernetesVersion *semver.Version,
 ) error {
 	gatewayValues := istioDeployer.GetValues().IngressGateway
@@ -167,6 +183,9 @@ func AddIstioIngressGateway(
 
 	// Take the first ingress gateway values to create additional gateways
 	templateValues := gatewayValues[0]
+	if proxyProtocolTLVs == nil {
+		proxyProtocolTLVs = templateValues.ProxyProtocolTLVs
+	}
 
 	var (
 		zones                    []string
@@ -209,6 +228,7 @@ func AddIstioIngressGateway(
 		Ports:                              templateValues.Ports,
 		PriorityClassName:                  templateValues.PriorityClassName,
 		TerminateLoadBalancerProxyProtocol: ptr.Deref(terminateLoadBalancerProxyProtocol, templateValues.TerminateLoadBalancerProxyProtocol),
+		ProxyProtocolTLVs:                  proxyProtocolTLVs,
 		TrustDomain:                        gardencorev1beta1.DefaultDomain,
 		VPNEnabled:                         templateValues.VPNEnabled,
 		Zones:                              zones,
@@ -220,6 +240,12 @@ func AddIstioIngressGateway(
 	return nil
 }
 
+// IstioRevision returns the istio revision for the given image tag, e.g. `1-27-8` for `1.27.8-distroless`.
+func IstioRevision(tag *string) string {
+	version, _, _ := strings.Cut(ptr.Deref(tag, ""), "-")
+	return strings.ReplaceAll(version, ".", "-")
+}
+
 // GetIstioNamespaceForZone returns the namespace to use for a given zone.
 // In case the zone name is too long the first five characters of the hash of the zone are used as zone identifiers.
 func GetIstioNamespaceForZone(defaultNamespace string, zone string) string {
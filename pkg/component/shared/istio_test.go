@@ -19,6 +19,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -46,11 +47,13 @@ type istioTestValues struct {
 	serviceExternalIP                  *string
 	servicePorts                       []corev1.ServicePort
 	terminateLoadBalancerProxyProtocol bool
+	proxyProtocolTLVs                  []gardencorev1beta1.ProxyProtocolTLV
 	vpnEnabled                         bool
 	zones                              []string
 	dualStack                          bool
 	enforceSpreadAcrossHosts           bool
 	kubernetesVersion                  *semver.Version
+	canaryUpgrade                      *istio.CanaryUpgradeValues
 }
 
 func createIstio(testValues istioTestValues) istio.Interface {
@@ -78,10 +81,12 @@ func createIstio(testValues istioTestValues) istio.Interface {
 		testValues.serviceExternalIP,
 		testValues.servicePorts,
 		&testValues.terminateLoadBalancerProxyProtocol,
+		testValues.proxyProtocolTLVs,
 		testValues.vpnEnabled,
 		testValues.zones,
 		testValues.dualStack,
 		testValues.kubernetesVersion,
+		testValues.canaryUpgrade,
 	)
 
 	Expect(err).To(Not(HaveOccurred()))
@@ -139,6 +144,7 @@ func checkIstio(istioDeploy istio.Interface, testValues istioTestValues) {
 				Namespace:                          "shared-istio-test-some-istio-ingress",
 				PriorityClassName:                  testValues.priorityClassName,
 				TerminateLoadBalancerProxyProtocol: testValues.terminateLoadBalancerProxyProtocol,
+				ProxyProtocolTLVs:                  testValues.proxyProtocolTLVs,
 				VPNEnabled:                         testValues.vpnEnabled,
 				EnforceSpreadAcrossHosts:           testValues.enforceSpreadAcrossHosts,
 				KubernetesVersion:                  testValues.kubernetesVersion.String(),
@@ -194,6 +200,7 @@ func checkAdditionalIstioGateway(cl client.Client,
 		Namespace:                          namespace,
 		PriorityClassName:                  ingressValues[0].PriorityClassName,
 		TerminateLoadBalancerProxyProtocol: ingressValues[0].TerminateLoadBalancerProxyProtocol,
+		ProxyProtocolTLVs:                  ingressValues[0].ProxyProtocolTLVs,
 		VPNEnabled:                         true,
 		Zones:                              zones,
 		DualStack:                          dualstack,
@@ -204,11 +211,12 @@ func checkAdditionalIstioGateway(cl client.Client,
 
 var _ = Describe("Istio", func() {
 	var (
-		testValues      istioTestValues
-		zones           []string
-		vpnEnabled      bool
-		proxyProtocolLB bool
-		istioDeploy     istio.Interface
+		testValues        istioTestValues
+		zones             []string
+		vpnEnabled        bool
+		proxyProtocolLB   bool
+		proxyProtocolTLVs []gardencorev1beta1.ProxyProtocolTLV
+		istioDeploy       istio.Interface
 	)
 
 	BeforeEach(func() {
@@ -236,6 +244,7 @@ var _ = Describe("Istio", func() {
 			serviceExternalIP:                  ptr.To("1.2.3.4"),
 			servicePorts:                       []corev1.ServicePort{{Port: 443}},
 			terminateLoadBalancerProxyProtocol: proxyProtocolLB,
+			proxyProtocolTLVs:                  proxyProtocolTLVs,
 			vpnEnabled:                         vpnEnabled,
 			zones:                              zones,
 			enforceSpreadAcrossHosts:           false,
@@ -274,6 +283,17 @@ var _ = Describe("Istio", func() {
 			It("should successfully create a new Istio deployer", func() {
 				checkIstio(istioDeploy, testValues)
 			})
+
+			Context("with TLVs", func() {
+				BeforeEach(func() {
+					proxyProtocolTLVs = []gardencorev1beta1.ProxyProtocolTLV{{Type: 234, Name: "vpce-id"}}
+					DeferCleanup(func() { proxyProtocolTLVs = nil })
+				})
+
+				It("should successfully create a new Istio deployer", func() {
+					checkIstio(istioDeploy, testValues)
+				})
+			})
 		})
 
 		Context("without zone", func() {
@@ -375,6 +395,7 @@ var _ = Describe("Istio", func() {
 				zone,
 				false,
 				&proxyProtocolLB,
+				nil,
 				semver.MustParse("1.31.0"))).To(MatchError("at least one ingress gateway must be present before adding further ones"))
 		})
 
@@ -397,6 +418,7 @@ var _ = Describe("Istio", func() {
 					zone,
 					false,
 					&proxyProtocolLB,
+					nil,
 					semver.MustParse("1.31.0"))).To(Succeed())
 
 				checkAdditionalIstioGateway(
@@ -433,6 +455,7 @@ var _ = Describe("Istio", func() {
 					zone,
 					false,
 					&proxyProtocolLB,
+					nil,
 					semver.MustParse("1.31.0"))).To(Succeed())
 
 				checkAdditionalIstioGateway(
@@ -472,6 +495,7 @@ var _ = Describe("Istio", func() {
 						zone,
 						false,
 						&proxyProtocolLB,
+						nil,
 						semver.MustParse("1.31.0"))).To(Succeed())
 
 					checkAdditionalIstioGateway(
@@ -509,6 +533,7 @@ var _ = Describe("Istio", func() {
 					zone,
 					true,
 					&proxyProtocolLB,
+					nil,
 					semver.MustParse("1.31.0"))).To(Succeed())
 
 				checkAdditionalIstioGateway(
@@ -611,6 +636,17 @@ var _ = Describe("Istio", func() {
 		}, []string{"z3"}, false),
 	)
 
+	Describe("#IstioRevision", func() {
+		It("should derive the revision from the image tag", func() {
+			Expect(IstioRevision(ptr.To("1.27.8-distroless"))).To(Equal("1-27-8"))
+			Expect(IstioRevision(ptr.To("1.27.8"))).To(Equal("1-27-8"))
+		})
+
+		It("should return an empty revision if there is no tag", func() {
+			Expect(IstioRevision(nil)).To(BeEmpty())
+		})
+	})
+
 	Describe("#AreZonalGatewaysInUse", func() {
 		var (
 			cl    client.Client
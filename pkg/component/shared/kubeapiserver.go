@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/imagevector"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -92,7 +93,13 @@ func NewKubeAPIServer(
 	kubeapiserver.Interface,
 	error,
 ) {
-	images, err := computeKubeAPIServerImages(runtimeVersion, targetVersion, vpnConfig)
+	// The konnectivity-server is only relevant for clusters with worker nodes whose control plane is not running in the
+	// cluster itself. Otherwise, the kube-apiserver can reach the cluster network directly.
+	konnectivityConfig := kubeapiserver.KonnectivityConfig{
+		Enabled: !isWorkerless && !runsAsStaticPod && v1beta1helper.GetNodeConnectivityMode(apiServerConfig) == gardencorev1beta1.NodeConnectivityModeKonnectivity,
+	}
+
+	images, err := computeKubeAPIServerImages(runtimeVersion, targetVersion, vpnConfig, konnectivityConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +214,7 @@ func NewKubeAPIServer(
 			EventTTL:                            eventTTL,
 			Images:                              images,
 			IsWorkerless:                        isWorkerless,
+			Konnectivity:                        konnectivityConfig,
 			NamePrefix:                          namePrefix,
 			OIDC:                                oidcConfig,
 			PriorityClassName:                   priorityClassName,
@@ -303,6 +311,7 @@ func computeKubeAPIServerImages(
 	runtimeVersion *semver.Version,
 	targetVersion *semver.Version,
 	vpnConfig kubeapiserver.VPNConfig,
+	konnectivityConfig kubeapiserver.KonnectivityConfig,
 ) (
 	kubeapiserver.Images,
 	error,
@@ -331,6 +340,14 @@ func computeKubeAPIServerImages(
 		result.EnvoyProxy = imageEnvoyProxy.String()
 	}
 
+	if konnectivityConfig.Enabled {
+		imageKonnectivityServer, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKonnectivityServer, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
+		if err != nil {
+			return kubeapiserver.Images{}, err
+		}
+		result.KonnectivityServer = imageKonnectivityServer.String()
+	}
+
 	return result, nil
 }
 
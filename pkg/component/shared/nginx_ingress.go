@@ -28,6 +28,7 @@ func NewNginxIngress(
 	vpaEnabled bool,
 	clusterType component.ClusterType,
 	externalTrafficPolicy corev1.ServiceExternalTrafficPolicy,
+	autoscaling *nginxingress.Autoscaling,
 	ingressClass string,
 	domains []string,
 	istioIngressGatewayLabels map[string]string,
@@ -57,6 +58,7 @@ func NewNginxIngress(
 		TargetNamespace:           targetNamespace,
 		ClusterType:               clusterType,
 		ExternalTrafficPolicy:     externalTrafficPolicy,
+		Autoscaling:               autoscaling,
 		Domains:                   domains,
 		IstioIngressGatewayLabels: istioIngressGatewayLabels,
 		SeedIsGarden:              seedIsGarden,
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package accelerators
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "shoot-core-accelerators"
+
+	labelValue = "accelerator-driver"
+
+	containerNameDriverInstaller = "driver-installer"
+	containerNameDevicePlugin    = "device-plugin"
+
+	volumeNameHost            = "host"
+	volumeMountPathHost       = "/host"
+	volumeNameDevicePlugins   = "device-plugins"
+	hostPathDevicePlugins     = "/var/lib/kubelet/device-plugins"
+	volumeNameDev             = "dev"
+	hostPathDev               = "/dev"
+	envMachineImageVersion    = "MACHINE_IMAGE_VERSION"
+	envHostRoot               = "HOST_ROOT"
+	daemonSetNamePrefix       = "accelerator-driver-"
+	labelKeyAcceleratorVendor = "accelerator.gardener.cloud/vendor"
+)
+
+// Interface contains functions for managing the drivers and device plugins of hardware accelerators.
+type Interface interface {
+	component.DeployWaiter
+	// SetWorkerPools sets the worker pools for which the drivers and device plugins shall be deployed.
+	SetWorkerPools([]WorkerPool)
+}
+
+// WorkerPool contains the information about a worker pool whose accelerators are managed.
+type WorkerPool struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Vendor is the vendor of the accelerators.
+	Vendor gardencorev1beta1.AcceleratorVendor
+	// MachineImageVersion is the version of the machine image of the worker pool. It is passed to the driver installer.
+	MachineImageVersion string
+	// DriverInstallerImage is the image of the driver installer matching the machine image of the worker pool.
+	DriverInstallerImage string
+	// DevicePluginImage is the image of the device plugin.
+	DevicePluginImage string
+}
+
+// New creates a new instance of Interface for managing the drivers and device plugins of hardware accelerators.
+func New(client client.Client, namespace string) Interface {
+	return &accelerators{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+type accelerators struct {
+	client      client.Client
+	namespace   string
+	workerPools []WorkerPool
+}
+
+func (a *accelerators) Deploy(ctx context.Context) error {
+	data, err := a.computeResourcesData()
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForShoot(ctx, a.client, a.namespace, ManagedResourceName, managedresources.LabelValueGardener, false, data)
+}
+
+func (a *accelerators) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForShoot(ctx, a.client, a.namespace, ManagedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 5 * time.Minute
+
+func (a *accelerators) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, a.client, a.namespace, ManagedResourceName)
+}
+
+func (a *accelerators) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, a.client, a.namespace, ManagedResourceName)
+}
+
+func (a *accelerators) SetWorkerPools(workerPools []WorkerPool) {
+	a.workerPools = workerPools
+}
+
+func (a *accelerators) computeResourcesData() (map[string][]byte, error) {
+	registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+
+	objects := make([]client.Object, 0, len(a.workerPools))
+	for _, pool := range a.workerPools {
+		objects = append(objects, a.daemonSet(pool))
+	}
+
+	return registry.AddAllAndSerialize(objects...)
+}
+
+func (a *accelerators) daemonSet(pool WorkerPool) *appsv1.DaemonSet {
+	selectorLabels := map[string]string{
+		v1beta1constants.LabelApp:        labelValue,
+		v1beta1constants.LabelWorkerPool: pool.Name,
+	}
+	labels := utils.MergeStringMaps(selectorLabels, map[string]string{
+		v1beta1constants.GardenRole:     v1beta1constants.GardenRoleSystemComponent,
+		managedresources.LabelKeyOrigin: managedresources.LabelValueGardener,
+		labelKeyAcceleratorVendor:       string(pool.Vendor),
+	})
+
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      daemonSetNamePrefix + pool.Name,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector:             &metav1.LabelSelector{MatchLabels: selectorLabels},
+			RevisionHistoryLimit: ptr.To[int32](2),
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{v1beta1constants.LabelWorkerPool: pool.Name},
+					Tolerations: []corev1.Toleration{
+						{
+							Effect:   corev1.TaintEffectNoSchedule,
+							Operator: corev1.TolerationOpExists,
+						},
+						{
+							Effect:   corev1.TaintEffectNoExecute,
+							Operator: corev1.TolerationOpExists,
+						},
+					},
+					HostPID:                      true,
+					PriorityClassName:            "system-node-critical",
+					AutomountServiceAccountToken: ptr.To(false),
+					InitContainers: []corev1.Container{{
+						Name:            containerNameDriverInstaller,
+						Image:           pool.DriverInstallerImage,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Env: []corev1.EnvVar{
+							{Name: envMachineImageVersion, Value: pool.MachineImageVersion},
+							{Name: envHostRoot, Value: volumeMountPathHost},
+						},
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("100m"),
+								corev1.ResourceMemory: resource.MustParse("256Mi"),
+							},
+						},
+						SecurityContext: &corev1.SecurityContext{
+							Privileged: ptr.To(true),
+						},
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: volumeNameHost, MountPath: volumeMountPathHost},
+							{Name: volumeNameDev, MountPath: hostPathDev},
+						},
+					}},
+					Containers: []corev1.Container{{
+						Name:            containerNameDevicePlugin,
+						Image:           pool.DevicePluginImage,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("10m"),
+								corev1.ResourceMemory: resource.MustParse("32Mi"),
+							},
+						},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: ptr.To(false),
+							Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+						},
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: volumeNameDevicePlugins, MountPath: hostPathDevicePlugins},
+							{Name: volumeNameDev, MountPath: hostPathDev, ReadOnly: true},
+						},
+					}},
+					Volumes: []corev1.Volume{
+						{
+							Name:         volumeNameHost,
+							VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}},
+						},
+						{
+							Name:         volumeNameDev,
+							VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: hostPathDev}},
+						},
+						{
+							Name:         volumeNameDevicePlugins,
+							VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: hostPathDevicePlugins}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package accelerators_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/shoot/accelerators"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Accelerators", func() {
+	var (
+		ctx        = context.Background()
+		namespace  = "shoot--foo--bar"
+		fakeClient client.Client
+		consistOf  func(...client.Object) types.GomegaMatcher
+
+		accelerators Interface
+
+		managedResource *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		consistOf = NewManagedResourceConsistOfObjectsMatcher(fakeClient)
+
+		accelerators = New(fakeClient, namespace)
+
+		managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: ManagedResourceName, Namespace: namespace}}
+	})
+
+	daemonSetFor := func(pool WorkerPool) *appsv1.DaemonSet {
+		selectorLabels := map[string]string{
+			"app":                        "accelerator-driver",
+			"worker.gardener.cloud/pool": pool.Name,
+		}
+		labels := map[string]string{
+			"app":                               "accelerator-driver",
+			"worker.gardener.cloud/pool":        pool.Name,
+			"gardener.cloud/role":               "system-component",
+			"origin":                            "gardener",
+			"accelerator.gardener.cloud/vendor": string(pool.Vendor),
+		}
+
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "accelerator-driver-" + pool.Name,
+				Namespace: "kube-system",
+				Labels:    labels,
+			},
+			Spec: appsv1.DaemonSetSpec{
+				Selector:             &metav1.LabelSelector{MatchLabels: selectorLabels},
+				RevisionHistoryLimit: ptr.To[int32](2),
+				UpdateStrategy:       appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: corev1.PodSpec{
+						NodeSelector: map[string]string{"worker.gardener.cloud/pool": pool.Name},
+						Tolerations: []corev1.Toleration{
+							{Effect: corev1.TaintEffectNoSchedule, Operator: corev1.TolerationOpExists},
+							{Effect: corev1.TaintEffectNoExecute, Operator: corev1.TolerationOpExists},
+						},
+						HostPID:                      true,
+						PriorityClassName:            "system-node-critical",
+						AutomountServiceAccountToken: ptr.To(false),
+						InitContainers: []corev1.Container{{
+							Name:            "driver-installer",
+							Image:           pool.DriverInstallerImage,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Env: []corev1.EnvVar{
+								{Name: "MACHINE_IMAGE_VERSION", Value: pool.MachineImageVersion},
+								{Name: "HOST_ROOT", Value: "/host"},
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("100m"),
+									corev1.ResourceMemory: resource.MustParse("256Mi"),
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{Privileged: ptr.To(true)},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "host", MountPath: "/host"},
+								{Name: "dev", MountPath: "/dev"},
+							},
+						}},
+						Containers: []corev1.Container{{
+							Name:            "device-plugin",
+							Image:           pool.DevicePluginImage,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("10m"),
+									corev1.ResourceMemory: resource.MustParse("32Mi"),
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To(false),
+								Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "device-plugins", MountPath: "/var/lib/kubelet/device-plugins"},
+								{Name: "dev", MountPath: "/dev", ReadOnly: true},
+							},
+						}},
+						Volumes: []corev1.Volume{
+							{Name: "host", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}}},
+							{Name: "dev", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/dev"}}},
+							{Name: "device-plugins", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/kubelet/device-plugins"}}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	Describe("#Deploy", func() {
+		It("should deploy a DaemonSet per worker pool", func() {
+			var (
+				nvidiaPool = WorkerPool{
+					Name:                 "gpu-nvidia",
+					Vendor:               gardencorev1beta1.AcceleratorVendorNVIDIA,
+					MachineImageVersion:  "1877.3.0",
+					DriverInstallerImage: "nvidia-driver-installer:1.0.0",
+					DevicePluginImage:    "nvidia-device-plugin:1.0.0",
+				}
+				amdPool = WorkerPool{
+					Name:                 "gpu-amd",
+					Vendor:               gardencorev1beta1.AcceleratorVendorAMD,
+					MachineImageVersion:  "1877.4.0",
+					DriverInstallerImage: "amd-gpu-driver-installer:1.0.0",
+					DevicePluginImage:    "amd-gpu-device-plugin:1.0.0",
+				}
+			)
+
+			accelerators.SetWorkerPools([]WorkerPool{nvidiaPool, amdPool})
+			Expect(accelerators.Deploy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Labels).To(HaveKeyWithValue("origin", "gardener"))
+			Expect(managedResource).To(consistOf(daemonSetFor(nvidiaPool), daemonSetFor(amdPool)))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete the ManagedResource", func() {
+			Expect(accelerators.Deploy(ctx)).To(Succeed())
+			Expect(accelerators.Destroy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+
+	Context("waiting functions", func() {
+		var (
+			fakeOps   *retryfake.Ops
+			resetVars func()
+		)
+
+		BeforeEach(func() {
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			resetVars = test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			)
+		})
+
+		AfterEach(func() {
+			resetVars()
+		})
+
+		Describe("#Wait", func() {
+			It("should fail if the ManagedResource is not healthy", func() {
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{Name: ManagedResourceName, Namespace: namespace, Generation: 1},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionFalse},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionFalse},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(accelerators.Wait(ctx)).To(MatchError(ContainSubstring("is not healthy")))
+			})
+
+			It("should succeed if the ManagedResource is healthy", func() {
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{Name: ManagedResourceName, Namespace: namespace, Generation: 1},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(accelerators.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should succeed if the ManagedResource is deleted", func() {
+				Expect(accelerators.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
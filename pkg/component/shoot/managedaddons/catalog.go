@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedaddons
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// Addon is a version of a managed addon in the addon catalog.
+type Addon struct {
+	// Name is the name of the addon.
+	Name string
+	// Version is the version of the addon.
+	Version *semver.Version
+	// Manifests contains the manifests of the addon which are deployed into the shoot cluster.
+	Manifests map[string][]byte
+
+	secretName string
+}
+
+// Catalog contains the available versions of the managed addons provided by extensions, indexed by the addon names.
+type Catalog map[string][]Addon
+
+// LoadCatalog reads the addon catalog from the Secrets in the garden namespace of the seed cluster which are labeled
+// with `addon.gardener.cloud/name`. Each Secret contains the manifests of one version of an addon, the version is
+// taken from the `addon.gardener.cloud/version` label.
+func LoadCatalog(ctx context.Context, reader client.Reader) (Catalog, error) {
+	secretList := &corev1.SecretList{}
+	if err := reader.List(ctx, secretList, client.InNamespace(v1beta1constants.GardenNamespace), client.HasLabels{v1beta1constants.LabelManagedAddonName}); err != nil {
+		return nil, fmt.Errorf("failed listing addon catalog Secrets: %w", err)
+	}
+
+	catalog := Catalog{}
+	for _, secret := range secretList.Items {
+		name := secret.Labels[v1beta1constants.LabelManagedAddonName]
+
+		version, err := semver.NewVersion(secret.Labels[v1beta1constants.LabelManagedAddonVersion])
+		if err != nil {
+			return nil, fmt.Errorf("secret %s of addon %q has an invalid version label: %w", secret.Name, name, err)
+		}
+
+		for _, addon := range catalog[name] {
+			if addon.Version.Equal(version) {
+				return nil, fmt.Errorf("addon %q in version %s is provided by both secrets %s and %s", name, version, addon.secretName, secret.Name)
+			}
+		}
+
+		catalog[name] = append(catalog[name], Addon{Name: name, Version: version, Manifests: secret.Data, secretName: secret.Name})
+	}
+
+	return catalog, nil
+}
+
+// Resolve returns the addon for each of the given managed addons. The highest version of the addon satisfying the
+// version constraint of the managed addon is selected.
+func (c Catalog) Resolve(managedAddons []gardencorev1beta1.ManagedAddon) ([]Addon, error) {
+	addons := make([]Addon, 0, len(managedAddons))
+
+	for _, managedAddon := range managedAddons {
+		available, ok := c[managedAddon.Name]
+		if !ok {
+			return nil, fmt.Errorf("addon %q is not provided by any extension", managedAddon.Name)
+		}
+
+		constraint := "*"
+		if managedAddon.Version != nil {
+			constraint = *managedAddon.Version
+		}
+
+		constraints, err := semver.NewConstraint(constraint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q for addon %q: %w", constraint, managedAddon.Name, err)
+		}
+
+		var (
+			selected *Addon
+			versions semver.Collection
+		)
+		for _, addon := range available {
+			versions = append(versions, addon.Version)
+			if constraints.Check(addon.Version) && (selected == nil || addon.Version.GreaterThan(selected.Version)) {
+				selected = &addon
+			}
+		}
+
+		if selected == nil {
+			sort.Sort(versions)
+			return nil, fmt.Errorf("no version of addon %q satisfies the constraint %q, available versions: %s", managedAddon.Name, constraint, joinVersions(versions))
+		}
+
+		addons = append(addons, *selected)
+	}
+
+	return addons, nil
+}
+
+func joinVersions(versions []*semver.Version) string {
+	out := make([]string, 0, len(versions))
+	for _, version := range versions {
+		out = append(out, version.String())
+	}
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedaddons_test
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/shoot/managedaddons"
+)
+
+var _ = Describe("Catalog", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+	})
+
+	createSecret := func(namespace, name string, labels map[string]string, data map[string][]byte) {
+		ExpectWithOffset(1, fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Data:       data,
+		})).To(Succeed())
+	}
+
+	addonLabels := func(name, version string) map[string]string {
+		return map[string]string{"addon.gardener.cloud/name": name, "addon.gardener.cloud/version": version}
+	}
+
+	Describe("#LoadCatalog", func() {
+		It("should load the addons from all labeled Secrets in the garden namespace", func() {
+			createSecret("garden", "foo-1", addonLabels("foo", "1.0.0"), map[string][]byte{"foo.yaml": []byte("foo-1")})
+			createSecret("garden", "foo-2", addonLabels("foo", "v2.1"), map[string][]byte{"foo.yaml": []byte("foo-2")})
+			createSecret("garden", "bar", addonLabels("bar", "0.1.0"), map[string][]byte{"bar.yaml": []byte("bar")})
+			createSecret("garden", "other", map[string]string{"foo": "bar"}, nil)
+			createSecret("extension-baz", "baz", addonLabels("baz", "1.0.0"), nil)
+
+			catalog, err := LoadCatalog(ctx, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(catalog).To(HaveKeyWithValue("foo", ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Name": Equal("foo"), "Version": Equal(semver.MustParse("1.0.0")), "Manifests": Equal(map[string][]byte{"foo.yaml": []byte("foo-1")})}),
+				MatchFields(IgnoreExtras, Fields{"Name": Equal("foo"), "Version": Equal(semver.MustParse("v2.1")), "Manifests": Equal(map[string][]byte{"foo.yaml": []byte("foo-2")})}),
+			)))
+			Expect(catalog).To(HaveKeyWithValue("bar", ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Name": Equal("bar"), "Version": Equal(semver.MustParse("0.1.0"))}),
+			)))
+			Expect(catalog).To(HaveLen(2))
+		})
+
+		It("should fail if the version label is invalid", func() {
+			createSecret("garden", "foo", addonLabels("foo", "foo"), nil)
+
+			_, err := LoadCatalog(ctx, fakeClient)
+			Expect(err).To(MatchError(ContainSubstring(`secret foo of addon "foo" has an invalid version label`)))
+		})
+
+		It("should fail if the same version of an addon is provided twice", func() {
+			createSecret("garden", "foo", addonLabels("foo", "1.0.0"), nil)
+			createSecret("garden", "foo-v1", addonLabels("foo", "v1.0.0"), nil)
+
+			_, err := LoadCatalog(ctx, fakeClient)
+			Expect(err).To(MatchError(`addon "foo" in version 1.0.0 is provided by both secrets foo and foo-v1`))
+		})
+	})
+
+	Describe("#Resolve", func() {
+		var catalog Catalog
+
+		BeforeEach(func() {
+			createSecret("garden", "foo-1", addonLabels("foo", "1.0.0"), nil)
+			createSecret("garden", "foo-1-2", addonLabels("foo", "1.2.3"), nil)
+			createSecret("garden", "foo-2", addonLabels("foo", "2.0.0"), nil)
+			createSecret("garden", "bar", addonLabels("bar", "0.1.0"), nil)
+
+			var err error
+			catalog, err = LoadCatalog(ctx, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should select the highest version satisfying the constraints", func() {
+			addons, err := catalog.Resolve([]gardencorev1beta1.ManagedAddon{
+				{Name: "foo", Version: ptr.To("~1")},
+				{Name: "bar"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addons).To(HaveExactElements(
+				MatchFields(IgnoreExtras, Fields{"Name": Equal("foo"), "Version": Equal(semver.MustParse("1.2.3"))}),
+				MatchFields(IgnoreExtras, Fields{"Name": Equal("bar"), "Version": Equal(semver.MustParse("0.1.0"))}),
+			))
+		})
+
+		It("should select the highest version if no constraint is given", func() {
+			addons, err := catalog.Resolve([]gardencorev1beta1.ManagedAddon{{Name: "foo"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addons).To(HaveExactElements(
+				MatchFields(IgnoreExtras, Fields{"Name": Equal("foo"), "Version": Equal(semver.MustParse("2.0.0"))}),
+			))
+		})
+
+		It("should fail if the addon is not provided", func() {
+			_, err := catalog.Resolve([]gardencorev1beta1.ManagedAddon{{Name: "baz"}})
+			Expect(err).To(MatchError(`addon "baz" is not provided by any extension`))
+		})
+
+		It("should fail if no version satisfies the constraint", func() {
+			_, err := catalog.Resolve([]gardencorev1beta1.ManagedAddon{{Name: "foo", Version: ptr.To(">= 3.0")}})
+			Expect(err).To(MatchError(`no version of addon "foo" satisfies the constraint ">= 3.0", available versions: 1.0.0, 1.2.3, 2.0.0`))
+		})
+	})
+})
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedaddons
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+// ManagedResourceNamePrefix is the prefix of the names of the ManagedResources containing the manifests of the managed
+// addons.
+const ManagedResourceNamePrefix = "shoot-managed-addon-"
+
+// Interface contains functions for a managed addons deployer.
+type Interface interface {
+	component.DeployWaiter
+	// SetAddons sets the addons which are deployed into the shoot cluster.
+	SetAddons([]Addon)
+}
+
+// New creates a new instance of DeployWaiter for the managed addons. Each addon is deployed via a separate
+// ManagedResource, the ManagedResources of addons which are no longer desired are deleted.
+func New(client client.Client, namespace string, addons []Addon) Interface {
+	return &managedAddons{
+		client:    client,
+		namespace: namespace,
+		addons:    addons,
+	}
+}
+
+type managedAddons struct {
+	client    client.Client
+	namespace string
+	addons    []Addon
+}
+
+func (m *managedAddons) Deploy(ctx context.Context) error {
+	desired := sets.New[string]()
+
+	for _, addon := range m.addons {
+		name := ManagedResourceNamePrefix + addon.Name
+		desired.Insert(name)
+
+		if err := managedresources.CreateForShootWithLabels(ctx, m.client, m.namespace, name, managedresources.LabelValueGardener, false, map[string]string{v1beta1constants.LabelManagedAddonName: addon.Name}, addon.Manifests); err != nil {
+			return err
+		}
+	}
+
+	return m.deleteManagedResources(ctx, desired)
+}
+
+func (m *managedAddons) Destroy(ctx context.Context) error {
+	return m.deleteManagedResources(ctx, nil)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 5 * time.Minute
+
+func (m *managedAddons) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	var fns []flow.TaskFn
+	for _, addon := range m.addons {
+		name := ManagedResourceNamePrefix + addon.Name
+		fns = append(fns, func(ctx context.Context) error {
+			return managedresources.WaitUntilHealthy(ctx, m.client, m.namespace, name)
+		})
+	}
+
+	return flow.Parallel(fns...)(timeoutCtx)
+}
+
+func (m *managedAddons) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilListDeleted(timeoutCtx, m.client, &resourcesv1alpha1.ManagedResourceList{}, client.InNamespace(m.namespace), client.HasLabels{v1beta1constants.LabelManagedAddonName})
+}
+
+func (m *managedAddons) SetAddons(addons []Addon) {
+	m.addons = addons
+}
+
+// deleteManagedResources deletes the ManagedResources of managed addons whose names are not contained in the given set.
+func (m *managedAddons) deleteManagedResources(ctx context.Context, keep sets.Set[string]) error {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := m.client.List(ctx, managedResourceList, client.InNamespace(m.namespace), client.HasLabels{v1beta1constants.LabelManagedAddonName}); err != nil {
+		return err
+	}
+
+	for _, managedResource := range managedResourceList.Items {
+		if keep.Has(managedResource.Name) {
+			continue
+		}
+
+		if err := managedresources.DeleteForShoot(ctx, m.client, m.namespace, managedResource.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
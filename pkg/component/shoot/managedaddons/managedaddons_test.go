@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedaddons_test
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/shoot/managedaddons"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ManagedAddons", func() {
+	var (
+		ctx        = context.Background()
+		namespace  = "shoot--foo--bar"
+		fakeClient client.Client
+
+		foo, bar Addon
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		foo = Addon{Name: "foo", Version: semver.MustParse("1.0.0"), Manifests: map[string][]byte{"foo.yaml": []byte("foo")}}
+		bar = Addon{Name: "bar", Version: semver.MustParse("2.0.0"), Manifests: map[string][]byte{"bar.yaml": []byte("bar")}}
+	})
+
+	getManagedResource := func(name string) (*resourcesv1alpha1.ManagedResource, error) {
+		managedResource := &resourcesv1alpha1.ManagedResource{}
+		return managedResource, fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, managedResource)
+	}
+
+	Describe("#Deploy", func() {
+		It("should deploy a ManagedResource for each addon", func() {
+			Expect(New(fakeClient, namespace, []Addon{foo, bar}).Deploy(ctx)).To(Succeed())
+
+			managedResource, err := getManagedResource("shoot-managed-addon-foo")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(managedResource.Labels).To(Equal(map[string]string{"origin": "gardener", "addon.gardener.cloud/name": "foo"}))
+			Expect(managedResource.Spec.Class).To(BeNil())
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{"foo.yaml": []byte("foo")}))
+
+			_, err = getManagedResource("shoot-managed-addon-bar")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should delete the ManagedResources of addons which are no longer desired", func() {
+			Expect(New(fakeClient, namespace, []Addon{foo, bar}).Deploy(ctx)).To(Succeed())
+
+			otherManagedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespace}}
+			Expect(fakeClient.Create(ctx, otherManagedResource)).To(Succeed())
+
+			Expect(New(fakeClient, namespace, []Addon{bar}).Deploy(ctx)).To(Succeed())
+
+			_, err := getManagedResource("shoot-managed-addon-foo")
+			Expect(err).To(BeNotFoundError())
+			_, err = getManagedResource("shoot-managed-addon-bar")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = getManagedResource("other")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete the ManagedResources of all addons", func() {
+			managedAddons := New(fakeClient, namespace, []Addon{foo, bar})
+			Expect(managedAddons.Deploy(ctx)).To(Succeed())
+
+			managedAddons.SetAddons(nil)
+			Expect(managedAddons.Destroy(ctx)).To(Succeed())
+
+			managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+			Expect(fakeClient.List(ctx, managedResourceList, client.InNamespace(namespace))).To(Succeed())
+			Expect(managedResourceList.Items).To(BeEmpty())
+		})
+	})
+
+	Context("waiting functions", func() {
+		var (
+			fakeOps   *retryfake.Ops
+			resetVars func()
+		)
+
+		BeforeEach(func() {
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			resetVars = test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			)
+		})
+
+		AfterEach(func() {
+			resetVars()
+		})
+
+		createManagedResource := func(name string, healthy gardencorev1beta1.ConditionStatus) {
+			ExpectWithOffset(1, fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       name,
+					Namespace:  namespace,
+					Generation: 1,
+					Labels:     map[string]string{"addon.gardener.cloud/name": name},
+				},
+				Status: resourcesv1alpha1.ManagedResourceStatus{
+					ObservedGeneration: 1,
+					Conditions: []gardencorev1beta1.Condition{
+						{Type: resourcesv1alpha1.ResourcesApplied, Status: healthy},
+						{Type: resourcesv1alpha1.ResourcesHealthy, Status: healthy},
+					},
+				},
+			})).To(Succeed())
+		}
+
+		Describe("#Wait", func() {
+			It("should fail if a ManagedResource is not healthy", func() {
+				createManagedResource("shoot-managed-addon-foo", gardencorev1beta1.ConditionTrue)
+				createManagedResource("shoot-managed-addon-bar", gardencorev1beta1.ConditionFalse)
+
+				Expect(New(fakeClient, namespace, []Addon{foo, bar}).Wait(ctx)).To(MatchError(ContainSubstring("is not healthy")))
+			})
+
+			It("should succeed if all ManagedResources are healthy", func() {
+				createManagedResource("shoot-managed-addon-foo", gardencorev1beta1.ConditionTrue)
+				createManagedResource("shoot-managed-addon-bar", gardencorev1beta1.ConditionTrue)
+
+				Expect(New(fakeClient, namespace, []Addon{foo, bar}).Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should fail if the deletion of a ManagedResource fails", func() {
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "shoot-managed-addon-foo",
+						Namespace: namespace,
+						Labels:    map[string]string{"addon.gardener.cloud/name": "foo"},
+					},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						Conditions: []gardencorev1beta1.Condition{{
+							Type:    resourcesv1alpha1.ResourcesApplied,
+							Status:  gardencorev1beta1.ConditionFalse,
+							Reason:  resourcesv1alpha1.ConditionDeletionFailed,
+							Message: "finalizer still present",
+						}},
+					},
+				})).To(Succeed())
+
+				Expect(New(fakeClient, namespace, nil).WaitCleanup(ctx)).To(MatchError(ContainSubstring("finalizer still present")))
+			})
+
+			It("should succeed if all ManagedResources are deleted", func() {
+				Expect(New(fakeClient, namespace, nil).WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
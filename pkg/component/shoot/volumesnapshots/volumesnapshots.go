@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package volumesnapshots
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/volumesnapshotpolicy"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "shoot-core-volume-snapshots"
+
+	annotationIsDefaultClass = "snapshot.storage.kubernetes.io/is-default-class"
+)
+
+// Values is a set of configuration values for the managed volume snapshots.
+type Values struct {
+	// Classes are the VolumeSnapshotClasses which are created in the shoot cluster.
+	Classes []gardencorev1beta1.VolumeSnapshotClass
+	// Policies are the policies for taking scheduled snapshots of PersistentVolumeClaims. They are executed by the
+	// volume snapshot policy controller of gardener-resource-manager.
+	Policies []gardencorev1beta1.VolumeSnapshotPolicy
+}
+
+// New creates a new instance of DeployWaiter for the managed volume snapshots.
+func New(
+	client client.Client,
+	namespace string,
+	values Values,
+) component.DeployWaiter {
+	return &volumeSnapshots{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+type volumeSnapshots struct {
+	client    client.Client
+	namespace string
+	values    Values
+}
+
+func (v *volumeSnapshots) Deploy(ctx context.Context) error {
+	data, err := v.computeResourcesData()
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForShoot(ctx, v.client, v.namespace, ManagedResourceName, managedresources.LabelValueGardener, false, data)
+}
+
+func (v *volumeSnapshots) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForShoot(ctx, v.client, v.namespace, ManagedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (v *volumeSnapshots) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, v.client, v.namespace, ManagedResourceName)
+}
+
+func (v *volumeSnapshots) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, v.client, v.namespace, ManagedResourceName)
+}
+
+func (v *volumeSnapshots) computeResourcesData() (map[string][]byte, error) {
+	registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+
+	var objects []client.Object
+	for _, class := range v.values.Classes {
+		volumeSnapshotClass := &volumesnapshotv1.VolumeSnapshotClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   class.Name,
+				Labels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleSystemComponent},
+			},
+			Driver:         class.Driver,
+			DeletionPolicy: volumesnapshotv1.DeletionPolicy(ptr.Deref(class.DeletionPolicy, gardencorev1beta1.VolumeSnapshotDeletionPolicyDelete)),
+			Parameters:     class.Parameters,
+		}
+		if ptr.Deref(class.Default, false) {
+			metav1.SetMetaDataAnnotation(&volumeSnapshotClass.ObjectMeta, annotationIsDefaultClass, strconv.FormatBool(true))
+		}
+		objects = append(objects, volumeSnapshotClass)
+	}
+
+	if len(v.values.Policies) > 0 {
+		policies, err := yaml.Marshal(v.values.Policies)
+		if err != nil {
+			return nil, fmt.Errorf("failed marshalling volume snapshot policies: %w", err)
+		}
+
+		objects = append(objects, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      volumesnapshotpolicy.ConfigMapName,
+				Namespace: metav1.NamespaceSystem,
+				Labels:    map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleSystemComponent},
+			},
+			Data: map[string]string{volumesnapshotpolicy.DataKeyPolicies: string(policies)},
+		})
+	}
+
+	return registry.AddAllAndSerialize(objects...)
+}
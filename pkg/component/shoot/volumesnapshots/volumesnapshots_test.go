@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package volumesnapshots_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/shoot/volumesnapshots"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("VolumeSnapshots", func() {
+	var (
+		ctx        = context.Background()
+		namespace  = "shoot--foo--bar"
+		fakeClient client.Client
+
+		values          Values
+		volumeSnapshots component.DeployWaiter
+
+		managedResource *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		values = Values{
+			Classes: []gardencorev1beta1.VolumeSnapshotClass{{
+				Name:           "default",
+				Driver:         "csi.example.com",
+				DeletionPolicy: ptr.To(gardencorev1beta1.VolumeSnapshotDeletionPolicyRetain),
+				Default:        ptr.To(true),
+				Parameters:     map[string]string{"foo": "bar"},
+			}},
+			Policies: []gardencorev1beta1.VolumeSnapshotPolicy{{
+				Name:      "daily",
+				Schedule:  "0 2 * * *",
+				Namespace: "default",
+				Retention: ptr.To[int32](3),
+			}},
+		}
+		volumeSnapshots = New(fakeClient, namespace, values)
+
+		managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: ManagedResourceName, Namespace: namespace}}
+	})
+
+	Describe("#Deploy", func() {
+		It("should deploy the ManagedResource with the classes and policies", func() {
+			Expect(volumeSnapshots.Deploy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Labels).To(HaveKeyWithValue("origin", "gardener"))
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			manifests, err := test.ExtractManifestsFromManagedResourceData(secret.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifests).To(ConsistOf(`apiVersion: snapshot.storage.k8s.io/v1
+deletionPolicy: Retain
+driver: csi.example.com
+kind: VolumeSnapshotClass
+metadata:
+  annotations:
+    snapshot.storage.kubernetes.io/is-default-class: "true"
+  labels:
+    gardener.cloud/role: system-component
+  name: default
+parameters:
+  foo: bar
+`, `apiVersion: v1
+data:
+  policies.yaml: |
+    - name: daily
+      namespace: default
+      retention: 3
+      schedule: 0 2 * * *
+kind: ConfigMap
+metadata:
+  labels:
+    gardener.cloud/role: system-component
+  name: gardener-volume-snapshot-policies
+  namespace: kube-system
+`))
+		})
+
+		It("should not deploy the policies ConfigMap if there are no policies", func() {
+			values.Policies = nil
+			Expect(New(fakeClient, namespace, values).Deploy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			manifests, err := test.ExtractManifestsFromManagedResourceData(secret.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifests).To(ConsistOf(ContainSubstring("kind: VolumeSnapshotClass")))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete the ManagedResource", func() {
+			Expect(volumeSnapshots.Deploy(ctx)).To(Succeed())
+			Expect(volumeSnapshots.Destroy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+
+	Context("waiting functions", func() {
+		var (
+			fakeOps   *retryfake.Ops
+			resetVars func()
+		)
+
+		BeforeEach(func() {
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			resetVars = test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			)
+		})
+
+		AfterEach(func() {
+			resetVars()
+		})
+
+		Describe("#Wait", func() {
+			It("should fail if the ManagedResource is not healthy", func() {
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{Name: ManagedResourceName, Namespace: namespace, Generation: 1},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionFalse},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionFalse},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(volumeSnapshots.Wait(ctx)).To(MatchError(ContainSubstring("is not healthy")))
+			})
+
+			It("should succeed if the ManagedResource is healthy", func() {
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{Name: ManagedResourceName, Namespace: namespace, Generation: 1},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(volumeSnapshots.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should succeed if the ManagedResource is deleted", func() {
+				Expect(volumeSnapshots.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
@@ -14,6 +14,7 @@ import (
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/bastion"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/certificateexpiry"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/certificatesigningrequest"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerdeployment"
@@ -28,6 +29,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/quota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seedtemplate"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shootstate"
 )
@@ -45,6 +47,14 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *controllermanag
 		return fmt.Errorf("failed adding Bastion controller: %w", err)
 	}
 
+	if config := cfg.Controllers.CertificateExpiry; config != nil {
+		if err := (&certificateexpiry.Reconciler{
+			Config: *config,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding CertificateExpiry controller: %w", err)
+		}
+	}
+
 	if err := (&certificatesigningrequest.Reconciler{
 		CertificatesClient: kubernetesClient.CertificatesV1().CertificateSigningRequests(),
 		Config:             *cfg.Controllers.CertificateSigningRequest,
@@ -123,6 +133,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *controllermanag
 		return fmt.Errorf("failed adding SecretBinding controller: %w", err)
 	}
 
+	if err := (&seedtemplate.Reconciler{
+		Config: *cfg.Controllers.SeedTemplate,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding SeedTemplate controller: %w", err)
+	}
+
 	if err := (&shootstate.Reconciler{
 		Config: *cfg.Controllers.ShootState,
 	}).AddToManager(mgr); err != nil {
@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificateexpiry
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// EventCertificateExpiringSoon is the reason of events created for certificates which expire within the configured
+	// window without an initiated rotation.
+	EventCertificateExpiringSoon = "CertificateExpiringSoon"
+
+	kindConfigMap = "ConfigMap"
+	kindSecret    = "Secret"
+)
+
+// Reconciler periodically scans the certificate authorities and serving certificates managed by Gardener in the garden
+// cluster, publishes their expiration times as metrics, and creates events for certificates which expire within the
+// configured window without an initiated rotation.
+type Reconciler struct {
+	Reader          client.Reader
+	Config          controllermanagerconfigv1alpha1.CertificateExpiryControllerConfiguration
+	Clock           clock.Clock
+	Recorder        events.EventRecorder
+	ExpirationGauge *prometheus.GaugeVec
+}
+
+// Reconcile performs the main reconciliation logic.
+func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	// Series of deleted objects must not be reported anymore, hence the gauge is rebuilt on every run.
+	r.ExpirationGauge.Reset()
+
+	if err := r.checkShootCABundles(ctx, log); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.checkSecretsManagerSecrets(ctx, log); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// checkShootCABundles checks the CA bundles of shoots which are published to the project namespaces. A rotation is
+// considered initiated if the certificate authorities rotation of the respective shoot is in progress.
+func (r *Reconciler) checkShootCABundles(ctx context.Context, log logr.Logger) error {
+	selector, err := labels.NewRequirement(v1beta1constants.GardenRole, selection.In, []string{v1beta1constants.GardenRoleCACluster, v1beta1constants.GardenRoleCAKubelet})
+	if err != nil {
+		return err
+	}
+
+	configMapList := &corev1.ConfigMapList{}
+	if err := r.Reader.List(ctx, configMapList, client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(*selector)}); err != nil {
+		return fmt.Errorf("failed listing CA bundle config maps: %w", err)
+	}
+
+	for _, configMap := range configMapList.Items {
+		shootName, ok := configMap.Labels[v1beta1constants.LabelShootName]
+		if !ok {
+			shootName, _ = gardenerutils.IsShootProjectConfigMap(configMap.Name)
+		}
+
+		notAfter, err := earliestExpiration([]byte(configMap.Data[secretsutils.DataKeyCertificateCA]))
+		if err != nil {
+			log.Info("Failed parsing CA bundle, skipping", "configMap", client.ObjectKeyFromObject(&configMap), "reason", err.Error())
+			continue
+		}
+
+		r.ExpirationGauge.WithLabelValues(configMap.Namespace, configMap.Name, kindConfigMap, shootName).Set(float64(notAfter.Unix()))
+
+		if !r.expiresWithinWindow(notAfter) || shootName == "" {
+			continue
+		}
+
+		shoot := &gardencorev1beta1.Shoot{}
+		if err := r.Reader.Get(ctx, client.ObjectKey{Namespace: configMap.Namespace, Name: shootName}, shoot); err != nil {
+			log.Info("Failed reading shoot of CA bundle, skipping", "configMap", client.ObjectKeyFromObject(&configMap), "reason", err.Error())
+			continue
+		}
+
+		if caRotationInitiated(shoot) {
+			continue
+		}
+
+		log.Info("Certificate authority expires soon and no rotation was initiated", "shoot", client.ObjectKeyFromObject(shoot), "configMap", client.ObjectKeyFromObject(&configMap), "notAfter", notAfter)
+		r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, EventCertificateExpiringSoon, gardencorev1beta1.EventActionReconcile,
+			"Certificate authority %q expires at %s and no rotation was initiated, please start the certificate authorities rotation", configMap.Labels[v1beta1constants.GardenRole], notAfter.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// checkSecretsManagerSecrets checks the certificates in secrets managed by a secrets manager. A rotation is considered
+// initiated if a newer secret for the same secret config exists in the namespace.
+func (r *Reconciler) checkSecretsManagerSecrets(ctx context.Context, log logr.Logger) error {
+	secretList := &corev1.SecretList{}
+	if err := r.Reader.List(ctx, secretList, client.MatchingLabels{secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager}); err != nil {
+		return fmt.Errorf("failed listing secrets managed by secrets manager: %w", err)
+	}
+
+	latestIssuedAt := make(map[string]int64)
+	for _, secret := range secretList.Items {
+		key := secret.Namespace + "/" + secret.Labels[secretsmanager.LabelKeyName]
+		if issuedAt := issuedAtTime(&secret); issuedAt > latestIssuedAt[key] {
+			latestIssuedAt[key] = issuedAt
+		}
+	}
+
+	for _, secret := range secretList.Items {
+		notAfter, ok, err := secretExpiration(&secret)
+		if err != nil {
+			log.Info("Failed determining expiration of secret, skipping", "secret", client.ObjectKeyFromObject(&secret), "reason", err.Error())
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		r.ExpirationGauge.WithLabelValues(secret.Namespace, secret.Name, kindSecret, "").Set(float64(notAfter.Unix()))
+
+		if !r.expiresWithinWindow(notAfter) || issuedAtTime(&secret) < latestIssuedAt[secret.Namespace+"/"+secret.Labels[secretsmanager.LabelKeyName]] {
+			continue
+		}
+
+		log.Info("Certificate expires soon and no rotation was initiated", "secret", client.ObjectKeyFromObject(&secret), "notAfter", notAfter)
+		r.Recorder.Eventf(&secret, nil, corev1.EventTypeWarning, EventCertificateExpiringSoon, gardencorev1beta1.EventActionReconcile,
+			"Certificate expires at %s and no rotation was initiated", notAfter.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func (r *Reconciler) expiresWithinWindow(notAfter time.Time) bool {
+	return notAfter.Sub(r.Clock.Now()) <= r.Config.ExpiryWindow.Duration
+}
+
+func caRotationInitiated(shoot *gardencorev1beta1.Shoot) bool {
+	switch v1beta1helper.GetShootCARotationPhase(shoot.Status.Credentials) {
+	case gardencorev1beta1.RotationPreparing, gardencorev1beta1.RotationPrepared, gardencorev1beta1.RotationCompleting:
+		return true
+	}
+	return false
+}
+
+func issuedAtTime(secret *corev1.Secret) int64 {
+	issuedAt, err := strconv.ParseInt(secret.Labels[secretsmanager.LabelKeyIssuedAtTime], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return issuedAt
+}
+
+// secretExpiration returns the expiration time of the certificate in the given secret. The `valid-until-time` label
+// maintained by the secrets manager is preferred, the certificate data is only parsed if the label is missing. The
+// second return value is false if the secret does not contain a certificate.
+func secretExpiration(secret *corev1.Secret) (time.Time, bool, error) {
+	if value, ok := secret.Labels[secretsmanager.LabelKeyValidUntilTime]; ok {
+		validUntil, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("failed parsing label %q: %w", secretsmanager.LabelKeyValidUntilTime, err)
+		}
+		return time.Unix(validUntil, 0).UTC(), true, nil
+	}
+
+	for _, dataKey := range []string{secretsutils.DataKeyCertificate, secretsutils.DataKeyCertificateCA} {
+		if data, ok := secret.Data[dataKey]; ok {
+			notAfter, err := earliestExpiration(data)
+			if err != nil {
+				return time.Time{}, false, err
+			}
+			return notAfter, true, nil
+		}
+	}
+
+	return time.Time{}, false, nil
+}
+
+// earliestExpiration returns the earliest expiration time of all certificates in the given PEM-encoded bundle.
+func earliestExpiration(bundle []byte) (time.Time, error) {
+	var (
+		notAfter time.Time
+		rest     = bundle
+		block    *pem.Block
+	)
+
+	for {
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed parsing certificate: %w", err)
+		}
+
+		if notAfter.IsZero() || certificate.NotAfter.Before(notAfter) {
+			notAfter = certificate.NotAfter
+		}
+	}
+
+	if notAfter.IsZero() {
+		return time.Time{}, errors.New("no certificate found")
+	}
+
+	return notAfter, nil
+}
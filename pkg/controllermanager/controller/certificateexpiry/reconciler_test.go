@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificateexpiry_test
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/certificateexpiry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *events.FakeRecorder
+		gauge      *prometheus.GaugeVec
+		reconciler *Reconciler
+
+		namespace = "garden-foo"
+		shoot     *gardencorev1beta1.Shoot
+	)
+
+	generateCA := func(validity time.Duration) []byte {
+		certificate, err := (&secretsutils.CertificateSecretConfig{
+			Name:       "ca",
+			CommonName: "ca",
+			CertType:   secretsutils.CACert,
+			Validity:   ptr.To(validity),
+		}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+		return certificate.CertificatePEM
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+		recorder = events.NewFakeRecorder(10)
+		gauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test"}, []string{"namespace", "name", "kind", "shoot"})
+
+		reconciler = &Reconciler{
+			Reader: fakeClient,
+			Config: controllermanagerconfigv1alpha1.CertificateExpiryControllerConfiguration{
+				SyncPeriod:   &metav1.Duration{Duration: time.Hour},
+				ExpiryWindow: &metav1.Duration{Duration: 720 * time.Hour},
+			},
+			Clock:           fakeClock,
+			Recorder:        recorder,
+			ExpirationGauge: gauge,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace}}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	createCABundle := func(validity time.Duration) {
+		Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bar.ca-cluster",
+				Namespace: namespace,
+				Labels: map[string]string{
+					v1beta1constants.GardenRole:     v1beta1constants.GardenRoleCACluster,
+					v1beta1constants.LabelShootName: shoot.Name,
+				},
+			},
+			Data: map[string]string{"ca.crt": string(generateCA(validity))},
+		})).To(Succeed())
+	}
+
+	Context("shoot CA bundles", func() {
+		It("should publish the expiration time and not create an event if the CA expires outside of the window", func() {
+			createCABundle(10 * 365 * 24 * time.Hour)
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(testutil.CollectAndCount(gauge)).To(Equal(1))
+			Expect(testutil.ToFloat64(gauge.WithLabelValues(namespace, "bar.ca-cluster", "ConfigMap", "bar"))).To(BeNumerically(">", float64(fakeClock.Now().Add(720*time.Hour).Unix())))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should create an event on the shoot if the CA expires within the window", func() {
+			createCABundle(24 * time.Hour)
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(recorder.Events).To(Receive(ContainSubstring("Warning CertificateExpiringSoon")))
+		})
+
+		It("should not create an event if the CA rotation was initiated", func() {
+			createCABundle(24 * time.Hour)
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{
+				Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+					CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPrepared},
+				},
+			}
+			Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Context("secrets managed by secrets manager", func() {
+		createSecret := func(name string, issuedAt, validUntil time.Time) {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "garden",
+					Labels: map[string]string{
+						secretsmanager.LabelKeyManagedBy:      secretsmanager.LabelValueSecretsManager,
+						secretsmanager.LabelKeyName:           "ca-gardener",
+						secretsmanager.LabelKeyIssuedAtTime:   strconv.FormatInt(issuedAt.Unix(), 10),
+						secretsmanager.LabelKeyValidUntilTime: strconv.FormatInt(validUntil.Unix(), 10),
+					},
+				},
+			})).To(Succeed())
+		}
+
+		It("should create an event if the latest secret expires within the window", func() {
+			createSecret("ca-gardener-1", fakeClock.Now().Add(-time.Hour), fakeClock.Now().Add(24*time.Hour))
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(testutil.ToFloat64(gauge.WithLabelValues("garden", "ca-gardener-1", "Secret", ""))).To(Equal(float64(fakeClock.Now().Add(24 * time.Hour).Unix())))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Warning CertificateExpiringSoon")))
+		})
+
+		It("should not create an event if a newer secret exists", func() {
+			createSecret("ca-gardener-1", fakeClock.Now().Add(-time.Hour), fakeClock.Now().Add(24*time.Hour))
+			createSecret("ca-gardener-2", fakeClock.Now(), fakeClock.Now().Add(365*24*time.Hour))
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(testutil.CollectAndCount(gauge)).To(Equal(2))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should parse the certificate if the label is missing", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ca-bundle",
+					Namespace: "garden",
+					Labels:    map[string]string{secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager},
+				},
+				Data: map[string][]byte{"ca.crt": generateCA(12 * time.Hour)},
+			})).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(recorder.Events).To(Receive(ContainSubstring("Warning CertificateExpiringSoon")))
+		})
+	})
+})
@@ -5,6 +5,8 @@
 package certificatesigningrequest
 
 import (
+	"fmt"
+
 	certificatesv1 "k8s.io/api/certificates/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/controllerutils"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet/attestation"
 )
 
 // ControllerName is the name of this controller.
@@ -24,6 +27,14 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.Client = mgr.GetClient()
 	}
 
+	if r.Config.SeedAttestation != nil && r.AttestationVerifier == nil {
+		verifier, err := attestation.NewVerifier(r.Config.SeedAttestation.Verifier, mgr.GetAPIReader(), r.Config.SeedAttestation.Config)
+		if err != nil {
+			return fmt.Errorf("failed creating attestation verifier: %w", err)
+		}
+		r.AttestationVerifier = verifier
+	}
+
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
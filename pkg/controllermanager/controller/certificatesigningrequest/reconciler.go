@@ -46,6 +46,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet/attestation"
 )
 
 // Reconciler reconciles CertificateSigningRequest.
@@ -53,6 +54,9 @@ type Reconciler struct {
 	Client             client.Client
 	CertificatesClient certificatesclientv1.CertificateSigningRequestInterface
 	Config             controllermanagerconfigv1alpha1.CertificateSigningRequestControllerConfiguration
+	// AttestationVerifier verifies the attestation evidence of seed client CSRs requested via bootstrap tokens. It must
+	// be set if Config.SeedAttestation is configured.
+	AttestationVerifier attestation.Verifier
 }
 
 // Reconcile performs the main reconciliation logic.
@@ -106,6 +110,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	switch {
 	case isSeedClient:
 		subResource = "seedclient"
+		if r.Config.SeedAttestation != nil && isRequestedViaBootstrapToken(csr) {
+			seedName := strings.TrimPrefix(x509cr.Subject.CommonName, v1beta1constants.SeedUserNamePrefix)
+			if err := attestation.Verify(ctx, r.Config.SeedAttestation.Verifier, r.AttestationVerifier, seedName, csr); err != nil {
+				return reconcile.Result{}, r.denyCSR(ctx, log, csr, fmt.Sprintf("Gardenlet attestation failed: %s", err))
+			}
+			log.Info("Verified attestation evidence of gardenlet", "seedName", seedName)
+		}
 
 	case isShootClient, isGardenadmClient:
 		subResource = "shootclient"
@@ -139,7 +150,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 // name must be used in the CSR's subject as organization and common name, respectively, to ensure that the bootstrap
 // token was created for exactly this shoot.
 func (r *Reconciler) isBootstrapTokenForThisCSR(ctx context.Context, csr *certificatesv1.CertificateSigningRequest) (bool, string, error) {
-	if !strings.HasPrefix(csr.Spec.Username, bootstraptokenapi.BootstrapUserPrefix) || !slices.Contains(csr.Spec.Groups, bootstraptokenapi.BootstrapDefaultGroup) {
+	if !isRequestedViaBootstrapToken(csr) {
 		return false, "CSR does not seem to be requested via a bootstrap token", nil
 	}
 
@@ -154,6 +165,10 @@ func (r *Reconciler) isBootstrapTokenForThisCSR(ctx context.Context, csr *certif
 	return ensureCSRSubjectMatchesBootstrapTokenDescription(shootMeta, csr.Spec.Request)
 }
 
+func isRequestedViaBootstrapToken(csr *certificatesv1.CertificateSigningRequest) bool {
+	return strings.HasPrefix(csr.Spec.Username, bootstraptokenapi.BootstrapUserPrefix) && slices.Contains(csr.Spec.Groups, bootstraptokenapi.BootstrapDefaultGroup)
+}
+
 func ensureCSRSubjectMatchesBootstrapTokenDescription(shootMeta types.NamespacedName, rawCSR []byte) (bool, string, error) {
 	x509cr, err := utils.DecodeCertificateRequest(rawCSR)
 	if err != nil {
@@ -9,6 +9,8 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -27,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	. "github.com/gardener/gardener/pkg/controllermanager/controller/certificatesigningrequest"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
@@ -204,6 +207,94 @@ var _ = Describe("Reconciler", func() {
 		})
 	})
 
+	Context("seedclient csr with attestation", func() {
+		var (
+			bootstrapUsername = bootstraptokenapi.BootstrapUserPrefix + "abcdef"
+			verifier          *fakeVerifier
+		)
+
+		BeforeEach(func() {
+			certificateSubject = &pkix.Name{
+				Organization: []string{v1beta1constants.SeedsGroup},
+				CommonName:   v1beta1constants.SeedUserNamePrefix + "csr-test",
+			}
+			csrData, err := certutil.MakeCSR(privateKey, certificateSubject, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			csr.Spec.Request = csrData
+			csr.Spec.Username = bootstrapUsername
+			csr.Spec.Groups = []string{bootstraptokenapi.BootstrapDefaultGroup}
+			csr.Annotations = map[string]string{
+				"attestation.gardener.cloud/provider": "fake",
+				"attestation.gardener.cloud/evidence": base64.StdEncoding.EncodeToString([]byte("evidence")),
+			}
+
+			c.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&authorizationv1.SubjectAccessReview{})).DoAndReturn(func(_ context.Context, obj *authorizationv1.SubjectAccessReview, _ ...client.CreateOption) error {
+				obj.Status = authorizationv1.SubjectAccessReviewStatus{Allowed: true}
+				return nil
+			}).AnyTimes()
+			c.EXPECT().Get(gomock.Any(), client.ObjectKeyFromObject(csr), gomock.AssignableToTypeOf(&certificatesv1.CertificateSigningRequest{})).DoAndReturn(
+				func(_ context.Context, _ client.ObjectKey, obj *certificatesv1.CertificateSigningRequest, _ ...client.GetOption) error {
+					csr.DeepCopyInto(obj)
+					return nil
+				}).AnyTimes()
+
+			verifier = &fakeVerifier{seedName: "csr-test", evidence: "evidence"}
+			reconciler = &Reconciler{
+				Client:              c,
+				CertificatesClient:  fakeCertificatesClient,
+				Config:              controllermanagerconfigv1alpha1.CertificateSigningRequestControllerConfiguration{SeedAttestation: &controllermanagerconfigv1alpha1.SeedAttestation{Verifier: "fake"}},
+				AttestationVerifier: verifier,
+			}
+		})
+
+		reconcileAndExpectCondition := func(conditionType certificatesv1.RequestConditionType) {
+			GinkgoHelper()
+
+			_, err := fakeCertificatesClient.Create(ctx, csr, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: csr.Name}})
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedCSR, err := fakeCertificatesClient.Get(ctx, csr.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedCSR.Status.Conditions).To(ConsistOf(HaveField("Type", conditionType)))
+		}
+
+		It("should approve the csr when the attestation evidence is valid", func() {
+			reconcileAndExpectCondition(certificatesv1.CertificateApproved)
+			Expect(verifier.certificateRequest).To(Equal(csr.Spec.Request))
+		})
+
+		It("should deny the csr when the attestation evidence is missing", func() {
+			csr.Annotations = nil
+
+			reconcileAndExpectCondition(certificatesv1.CertificateDenied)
+		})
+
+		It("should deny the csr when the attestation evidence was created by another provider", func() {
+			csr.Annotations["attestation.gardener.cloud/provider"] = "other"
+
+			reconcileAndExpectCondition(certificatesv1.CertificateDenied)
+		})
+
+		It("should deny the csr when the attestation evidence cannot be verified", func() {
+			verifier.evidence = "other"
+
+			reconcileAndExpectCondition(certificatesv1.CertificateDenied)
+		})
+
+		It("should not verify the attestation evidence when the csr is not requested via bootstrap token", func() {
+			csr.Spec.Username = "admin"
+			csr.Spec.Groups = nil
+			csr.Annotations = nil
+
+			reconcileAndExpectCondition(certificatesv1.CertificateApproved)
+			Expect(verifier.certificateRequest).To(BeNil())
+		})
+	})
+
 	Context("shootclient csr", func() {
 		var (
 			shootNamespace     = "test-namespace"
@@ -529,3 +620,16 @@ var _ = Describe("Reconciler", func() {
 		})
 	})
 })
+
+type fakeVerifier struct {
+	seedName, evidence string
+	certificateRequest []byte
+}
+
+func (v *fakeVerifier) Verify(_ context.Context, seedName string, certificateRequest, evidence []byte) error {
+	v.certificateRequest = certificateRequest
+	if seedName != v.seedName || string(evidence) != v.evidence {
+		return errors.New("evidence does not match")
+	}
+	return nil
+}
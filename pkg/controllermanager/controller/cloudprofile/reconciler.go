@@ -13,6 +13,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -28,6 +29,7 @@ import (
 type Reconciler struct {
 	Client   client.Client
 	Config   controllermanagerconfigv1alpha1.CloudProfileControllerConfiguration
+	Clock    clock.Clock
 	Recorder events.EventRecorder
 }
 
@@ -97,5 +99,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
-	return reconcile.Result{}, nil
+	requeueAfter, err := r.reconcileVersionLifecycle(ctx, log, cloudProfile)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reconciling version lifecycle: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudprofile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// versionTransition describes a due transition of the classification of a version.
+type versionTransition struct {
+	description    string
+	classification gardencorev1beta1.VersionClassification
+	expirationDate *metav1.Time
+}
+
+// reconcileVersionLifecycle applies the classification transitions declared in the version lifecycle policy of the
+// given CloudProfile and records the time each version was first observed in its current classification in the status.
+// It returns the duration after which the next transition is due, or 0 if no transition is pending.
+func (r *Reconciler) reconcileVersionLifecycle(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (time.Duration, error) {
+	policy := cloudProfile.Spec.VersionLifecyclePolicy
+	if policy == nil {
+		return 0, nil
+	}
+
+	var (
+		now           = r.Clock.Now()
+		dryRun        = ptr.Deref(policy.DryRun, false)
+		desiredSpec   = cloudProfile.Spec.DeepCopy()
+		nextDue       time.Duration
+		transitions   []versionTransition
+		updateNextDue = func(due time.Duration) {
+			if due > 0 && (nextDue == 0 || due < nextDue) {
+				nextDue = due
+			}
+		}
+	)
+
+	kubernetesStatuses := map[string]gardencorev1beta1.ExpirableVersionStatus{}
+	if cloudProfile.Status.Kubernetes != nil {
+		for _, status := range cloudProfile.Status.Kubernetes.Versions {
+			kubernetesStatuses[status.Version] = status
+		}
+	}
+
+	if policy.Kubernetes != nil {
+		kubernetesTransitions, due, err := computeVersionTransitions(desiredSpec.Kubernetes.Versions, kubernetesStatuses, policy.Kubernetes, now)
+		if err != nil {
+			return 0, fmt.Errorf("failed computing transitions for Kubernetes versions: %w", err)
+		}
+		updateNextDue(due)
+
+		for i, version := range desiredSpec.Kubernetes.Versions {
+			if transition, ok := kubernetesTransitions[version.Version]; ok {
+				transition.description = fmt.Sprintf("Kubernetes version %q: %s", version.Version, transition.description)
+				transitions = append(transitions, transition)
+				applyVersionTransition(&desiredSpec.Kubernetes.Versions[i], transition)
+			}
+		}
+	}
+
+	machineImageStatuses := map[string]map[string]gardencorev1beta1.ExpirableVersionStatus{}
+	for _, machineImageStatus := range cloudProfile.Status.MachineImages {
+		machineImageStatuses[machineImageStatus.Name] = map[string]gardencorev1beta1.ExpirableVersionStatus{}
+		for _, status := range machineImageStatus.Versions {
+			machineImageStatuses[machineImageStatus.Name][status.Version] = status
+		}
+	}
+
+	if policy.MachineImages != nil {
+		for i, machineImage := range desiredSpec.MachineImages {
+			versions := make([]gardencorev1beta1.ExpirableVersion, 0, len(machineImage.Versions))
+			for _, version := range machineImage.Versions {
+				versions = append(versions, version.ExpirableVersion)
+			}
+
+			machineImageTransitions, due, err := computeVersionTransitions(versions, machineImageStatuses[machineImage.Name], policy.MachineImages, now)
+			if err != nil {
+				return 0, fmt.Errorf("failed computing transitions for versions of machine image %q: %w", machineImage.Name, err)
+			}
+			updateNextDue(due)
+
+			for j, version := range machineImage.Versions {
+				if transition, ok := machineImageTransitions[version.Version]; ok {
+					transition.description = fmt.Sprintf("Machine image %q version %q: %s", machineImage.Name, version.Version, transition.description)
+					transitions = append(transitions, transition)
+					applyVersionTransition(&desiredSpec.MachineImages[i].Versions[j].ExpirableVersion, transition)
+				}
+			}
+		}
+	}
+
+	for _, transition := range transitions {
+		if dryRun {
+			log.Info("Version classification transition is due but not applied because of dry run mode", "transition", transition.description)
+			r.Recorder.Eventf(cloudProfile, nil, corev1.EventTypeNormal, v1beta1constants.EventVersionClassificationTransition, gardencorev1beta1.EventActionReconcile, "Dry run: %s", transition.description)
+			continue
+		}
+
+		log.Info("Applying version classification transition", "transition", transition.description)
+		r.Recorder.Eventf(cloudProfile, nil, corev1.EventTypeNormal, v1beta1constants.EventVersionClassificationTransition, gardencorev1beta1.EventActionReconcile, transition.description)
+	}
+
+	if !dryRun && len(transitions) > 0 {
+		patch := client.MergeFromWithOptions(cloudProfile.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		cloudProfile.Spec = *desiredSpec
+		if err := r.Client.Patch(ctx, cloudProfile, patch); err != nil {
+			return 0, fmt.Errorf("failed applying version classification transitions: %w", err)
+		}
+	}
+
+	desiredStatus := computeVersionLifecycleStatus(cloudProfile.Spec, kubernetesStatuses, machineImageStatuses, now)
+	if !apiequality.Semantic.DeepEqual(cloudProfile.Status, desiredStatus) {
+		patch := client.MergeFrom(cloudProfile.DeepCopy())
+		cloudProfile.Status = desiredStatus
+		if err := r.Client.Status().Patch(ctx, cloudProfile, patch); err != nil {
+			return 0, fmt.Errorf("failed updating version statuses: %w", err)
+		}
+	}
+
+	return nextDue, nil
+}
+
+// computeVersionTransitions computes the due transitions of the given versions (keyed by version) based on the given
+// transition durations. It also returns the duration after which the next transition is due, or 0 if no transition
+// is pending. Versions using lifecycle stages are not considered.
+func computeVersionTransitions(versions []gardencorev1beta1.ExpirableVersion, statuses map[string]gardencorev1beta1.ExpirableVersionStatus, durations *gardencorev1beta1.VersionClassificationTransitions, now time.Time) (map[string]versionTransition, time.Duration, error) {
+	latestVersion, latestSupportedVersion, err := determineLatestVersions(versions, now)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		transitions = map[string]versionTransition{}
+		nextDue     time.Duration
+	)
+
+	for _, version := range versions {
+		if len(version.Lifecycle) > 0 || isExpired(version, now) {
+			continue
+		}
+
+		var (
+			classification = ptr.Deref(version.Classification, gardencorev1beta1.ClassificationSupported)
+			since          = now
+		)
+
+		if status, ok := statuses[version.Version]; ok && status.Classification == classification && status.LastTransitionTime != nil {
+			since = status.LastTransitionTime.Time
+		}
+
+		var transitionAfter *metav1.Duration
+		switch classification {
+		case gardencorev1beta1.ClassificationPreview:
+			transitionAfter = durations.SupportAfter
+		case gardencorev1beta1.ClassificationSupported:
+			if version.Version != latestSupportedVersion {
+				transitionAfter = durations.DeprecateAfter
+			}
+		case gardencorev1beta1.ClassificationDeprecated:
+			if durations.ExpireAfter != nil && version.ExpirationDate == nil && version.Version != latestVersion {
+				transitions[version.Version] = versionTransition{
+					description:    fmt.Sprintf("set expiration date to %s", since.Add(durations.ExpireAfter.Duration).UTC().Format(time.RFC3339)),
+					classification: classification,
+					expirationDate: &metav1.Time{Time: since.Add(durations.ExpireAfter.Duration)},
+				}
+			}
+			continue
+		}
+
+		if transitionAfter == nil {
+			continue
+		}
+
+		if due := since.Add(transitionAfter.Duration).Sub(now); due > 0 {
+			if nextDue == 0 || due < nextDue {
+				nextDue = due
+			}
+			continue
+		}
+
+		transition := versionTransition{classification: nextClassification(classification)}
+		transition.description = fmt.Sprintf("classify as %s after being %s since %s", transition.classification, classification, since.UTC().Format(time.RFC3339))
+
+		if transition.classification == gardencorev1beta1.ClassificationDeprecated && durations.ExpireAfter != nil && version.ExpirationDate == nil && version.Version != latestVersion {
+			transition.expirationDate = &metav1.Time{Time: now.Add(durations.ExpireAfter.Duration)}
+			transition.description = fmt.Sprintf("%s and set expiration date to %s", transition.description, transition.expirationDate.UTC().Format(time.RFC3339))
+		}
+
+		transitions[version.Version] = transition
+	}
+
+	return transitions, nextDue, nil
+}
+
+func nextClassification(classification gardencorev1beta1.VersionClassification) gardencorev1beta1.VersionClassification {
+	if classification == gardencorev1beta1.ClassificationPreview {
+		return gardencorev1beta1.ClassificationSupported
+	}
+	return gardencorev1beta1.ClassificationDeprecated
+}
+
+func applyVersionTransition(version *gardencorev1beta1.ExpirableVersion, transition versionTransition) {
+	version.Classification = ptr.To(transition.classification)
+	if transition.expirationDate != nil {
+		version.ExpirationDate = transition.expirationDate
+	}
+}
+
+// determineLatestVersions returns the overall latest version and the latest supported version of the given versions.
+func determineLatestVersions(versions []gardencorev1beta1.ExpirableVersion, now time.Time) (string, string, error) {
+	var latest, latestSupported *semver.Version
+
+	for _, version := range versions {
+		v, err := semver.NewVersion(version.Version)
+		if err != nil {
+			return "", "", fmt.Errorf("failed parsing version %q: %w", version.Version, err)
+		}
+
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+		}
+
+		if len(version.Lifecycle) == 0 && !isExpired(version, now) &&
+			ptr.Deref(version.Classification, gardencorev1beta1.ClassificationSupported) == gardencorev1beta1.ClassificationSupported &&
+			(latestSupported == nil || v.GreaterThan(latestSupported)) {
+			latestSupported = v
+		}
+	}
+
+	var latestVersion, latestSupportedVersion string
+	if latest != nil {
+		latestVersion = latest.Original()
+	}
+	if latestSupported != nil {
+		latestSupportedVersion = latestSupported.Original()
+	}
+
+	return latestVersion, latestSupportedVersion, nil
+}
+
+func isExpired(version gardencorev1beta1.ExpirableVersion, now time.Time) bool {
+	return version.ExpirationDate != nil && !now.Before(version.ExpirationDate.Time)
+}
+
+// computeVersionLifecycleStatus computes the status of the versions in the given spec. The last transition time of a
+// version is kept as long as its classification does not change.
+func computeVersionLifecycleStatus(
+	spec gardencorev1beta1.CloudProfileSpec,
+	kubernetesStatuses map[string]gardencorev1beta1.ExpirableVersionStatus,
+	machineImageStatuses map[string]map[string]gardencorev1beta1.ExpirableVersionStatus,
+	now time.Time,
+) gardencorev1beta1.CloudProfileStatus {
+	status := gardencorev1beta1.CloudProfileStatus{
+		Kubernetes: &gardencorev1beta1.KubernetesStatus{},
+	}
+
+	for _, version := range spec.Kubernetes.Versions {
+		status.Kubernetes.Versions = append(status.Kubernetes.Versions, computeVersionStatus(version, kubernetesStatuses[version.Version], now))
+	}
+
+	for _, machineImage := range spec.MachineImages {
+		machineImageStatus := gardencorev1beta1.MachineImageStatus{Name: machineImage.Name}
+		for _, version := range machineImage.Versions {
+			machineImageStatus.Versions = append(machineImageStatus.Versions, computeVersionStatus(version.ExpirableVersion, machineImageStatuses[machineImage.Name][version.Version], now))
+		}
+		status.MachineImages = append(status.MachineImages, machineImageStatus)
+	}
+
+	return status
+}
+
+func computeVersionStatus(version gardencorev1beta1.ExpirableVersion, oldStatus gardencorev1beta1.ExpirableVersionStatus, now time.Time) gardencorev1beta1.ExpirableVersionStatus {
+	classification := v1beta1helper.CurrentLifecycleClassification(version)
+
+	status := gardencorev1beta1.ExpirableVersionStatus{
+		Version:            version.Version,
+		Classification:     classification,
+		LastTransitionTime: &metav1.Time{Time: now},
+	}
+	if oldStatus.Classification == classification && oldStatus.LastTransitionTime != nil {
+		status.LastTransitionTime = oldStatus.LastTransitionTime
+	}
+
+	return status
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudprofile_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile"
+)
+
+var _ = Describe("Version lifecycle", func() {
+	const day = 24 * time.Hour
+
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *events.FakeRecorder
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.CloudProfile{}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		recorder = events.NewFakeRecorder(10)
+		reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock, Recorder: recorder}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.35.0", Classification: ptr.To(gardencorev1beta1.ClassificationPreview)},
+						{Version: "1.34.0", Classification: ptr.To(gardencorev1beta1.ClassificationSupported)},
+						{Version: "1.33.0"},
+					},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name: "image",
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "2.0.0", Classification: ptr.To(gardencorev1beta1.ClassificationPreview)}},
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", Classification: ptr.To(gardencorev1beta1.ClassificationDeprecated)}},
+					},
+				}},
+			},
+		}
+	})
+
+	reconcileCloudProfile := func() reconcile.Result {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cloudProfile)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cloudProfile), cloudProfile)).To(Succeed())
+		return result
+	}
+
+	versionStatus := func(version string, classification gardencorev1beta1.VersionClassification, lastTransitionTime time.Time) gardencorev1beta1.ExpirableVersionStatus {
+		return gardencorev1beta1.ExpirableVersionStatus{Version: version, Classification: classification, LastTransitionTime: &metav1.Time{Time: lastTransitionTime}}
+	}
+
+	It("should do nothing if no version lifecycle policy is set", func() {
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+		Expect(reconcileCloudProfile()).To(Equal(reconcile.Result{}))
+		Expect(cloudProfile.Status).To(Equal(gardencorev1beta1.CloudProfileStatus{}))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	Context("with version lifecycle policy", func() {
+		BeforeEach(func() {
+			cloudProfile.Spec.VersionLifecyclePolicy = &gardencorev1beta1.VersionLifecyclePolicy{
+				Kubernetes: &gardencorev1beta1.VersionClassificationTransitions{
+					SupportAfter:   &metav1.Duration{Duration: 7 * day},
+					DeprecateAfter: &metav1.Duration{Duration: 30 * day},
+					ExpireAfter:    &metav1.Duration{Duration: 14 * day},
+				},
+				MachineImages: &gardencorev1beta1.VersionClassificationTransitions{
+					SupportAfter: &metav1.Duration{Duration: 3 * day},
+					ExpireAfter:  &metav1.Duration{Duration: 10 * day},
+				},
+			}
+		})
+
+		It("should record the first observation of the versions and requeue until the next transition", func() {
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+			Expect(reconcileCloudProfile()).To(Equal(reconcile.Result{RequeueAfter: 3 * day}))
+
+			Expect(cloudProfile.Status.Kubernetes.Versions).To(Equal([]gardencorev1beta1.ExpirableVersionStatus{
+				versionStatus("1.35.0", gardencorev1beta1.ClassificationPreview, fakeClock.Now()),
+				versionStatus("1.34.0", gardencorev1beta1.ClassificationSupported, fakeClock.Now()),
+				versionStatus("1.33.0", gardencorev1beta1.ClassificationSupported, fakeClock.Now()),
+			}))
+			Expect(cloudProfile.Status.MachineImages).To(ConsistOf(gardencorev1beta1.MachineImageStatus{
+				Name: "image",
+				Versions: []gardencorev1beta1.ExpirableVersionStatus{
+					versionStatus("2.0.0", gardencorev1beta1.ClassificationPreview, fakeClock.Now()),
+					versionStatus("1.0.0", gardencorev1beta1.ClassificationDeprecated, fakeClock.Now()),
+				},
+			}))
+
+			By("Set expiration date of already deprecated machine image version")
+			Expect(cloudProfile.Spec.MachineImages[0].Versions[1].ExpirationDate).To(Equal(&metav1.Time{Time: fakeClock.Now().Add(10 * day)}))
+			Expect(recorder.Events).To(Receive(ContainSubstring(`Machine image "image" version "1.0.0": set expiration date`)))
+		})
+
+		It("should apply due transitions", func() {
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(reconcileCloudProfile()).To(Equal(reconcile.Result{RequeueAfter: 3 * day}))
+			Eventually(recorder.Events).Should(Receive())
+
+			fakeClock.Step(7 * day)
+			Expect(reconcileCloudProfile()).To(Equal(reconcile.Result{RequeueAfter: 23 * day}))
+
+			Expect(cloudProfile.Spec.Kubernetes.Versions[0].Classification).To(Equal(ptr.To(gardencorev1beta1.ClassificationSupported)))
+			Expect(cloudProfile.Spec.MachineImages[0].Versions[0].Classification).To(Equal(ptr.To(gardencorev1beta1.ClassificationSupported)))
+			Expect(recorder.Events).To(Receive(ContainSubstring(`Kubernetes version "1.35.0": classify as supported`)))
+			Expect(recorder.Events).To(Receive(ContainSubstring(`Machine image "image" version "2.0.0": classify as supported`)))
+			Expect(cloudProfile.Status.Kubernetes.Versions[0]).To(Equal(versionStatus("1.35.0", gardencorev1beta1.ClassificationSupported, fakeClock.Now())))
+
+			fakeClock.Step(23 * day)
+			Expect(reconcileCloudProfile()).To(Equal(reconcile.Result{}))
+
+			By("Never deprecate the latest supported version")
+			Expect(cloudProfile.Spec.Kubernetes.Versions[0].Classification).To(Equal(ptr.To(gardencorev1beta1.ClassificationSupported)))
+			Expect(cloudProfile.Spec.Kubernetes.Versions[1].Classification).To(Equal(ptr.To(gardencorev1beta1.ClassificationDeprecated)))
+			Expect(cloudProfile.Spec.Kubernetes.Versions[1].ExpirationDate).To(Equal(&metav1.Time{Time: fakeClock.Now().Add(14 * day)}))
+			Expect(cloudProfile.Spec.Kubernetes.Versions[2].Classification).To(Equal(ptr.To(gardencorev1beta1.ClassificationDeprecated)))
+			Expect(cloudProfile.Spec.Kubernetes.Versions[2].ExpirationDate).To(Equal(&metav1.Time{Time: fakeClock.Now().Add(14 * day)}))
+		})
+
+		It("should only report due transitions in dry run mode", func() {
+			cloudProfile.Spec.VersionLifecyclePolicy.DryRun = ptr.To(true)
+			cloudProfile.Status.Kubernetes = &gardencorev1beta1.KubernetesStatus{Versions: []gardencorev1beta1.ExpirableVersionStatus{
+				versionStatus("1.35.0", gardencorev1beta1.ClassificationPreview, fakeClock.Now().Add(-8*day)),
+			}}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeClient.Status().Update(ctx, cloudProfile)).To(Succeed())
+
+			reconcileCloudProfile()
+
+			Expect(cloudProfile.Spec.Kubernetes.Versions[0].Classification).To(Equal(ptr.To(gardencorev1beta1.ClassificationPreview)))
+			Expect(cloudProfile.Spec.MachineImages[0].Versions[1].ExpirationDate).To(BeNil())
+			Expect(recorder.Events).To(Receive(ContainSubstring(`Dry run: Kubernetes version "1.35.0": classify as supported`)))
+			Expect(recorder.Events).To(Receive(ContainSubstring(`Dry run: Machine image "image" version "1.0.0": set expiration date`)))
+		})
+
+		It("should not transition versions using lifecycle stages", func() {
+			cloudProfile.Spec.Kubernetes.Versions[0] = gardencorev1beta1.ExpirableVersion{
+				Version: "1.35.0",
+				Lifecycle: []gardencorev1beta1.LifecycleStage{
+					{Classification: gardencorev1beta1.ClassificationPreview},
+				},
+			}
+			cloudProfile.Status.Kubernetes = &gardencorev1beta1.KubernetesStatus{Versions: []gardencorev1beta1.ExpirableVersionStatus{
+				versionStatus("1.35.0", gardencorev1beta1.ClassificationPreview, fakeClock.Now().Add(-8*day)),
+			}}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeClient.Status().Update(ctx, cloudProfile)).To(Succeed())
+
+			reconcileCloudProfile()
+
+			Expect(cloudProfile.Spec.Kubernetes.Versions[0].Classification).To(BeNil())
+			Expect(cloudProfile.Status.Kubernetes.Versions[0]).To(Equal(versionStatus("1.35.0", gardencorev1beta1.ClassificationPreview, fakeClock.Now().Add(-8*day))))
+		})
+	})
+})
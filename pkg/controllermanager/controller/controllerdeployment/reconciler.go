@@ -71,6 +71,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			return reconcile.Result{}, fmt.Errorf("cannot remove finalizer of ControllerDeployment %q because still found ControllerRegistrations: %+v", controllerDeployment.Name, sets.List(controllerRegistrations))
 		}
 
+		// ControllerInstallations might still reference a previous ControllerDeployment of a ControllerRegistration
+		// while the rollout of its current ControllerDeployment is held.
+		controllerInstallationList := &gardencorev1beta1.ControllerInstallationList{}
+		if err := r.Client.List(ctx, controllerInstallationList); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		controllerInstallations := sets.New[string]()
+		for _, controllerInstallation := range controllerInstallationList.Items {
+			if deploymentRef := controllerInstallation.Spec.DeploymentRef; deploymentRef != nil && deploymentRef.Name == controllerDeployment.Name {
+				controllerInstallations.Insert(controllerInstallation.Name)
+			}
+		}
+
+		if controllerInstallations.Len() > 0 {
+			return reconcile.Result{}, fmt.Errorf("cannot remove finalizer of ControllerDeployment %q because still found ControllerInstallations: %+v", controllerDeployment.Name, sets.List(controllerInstallations))
+		}
+
 		if controllerutil.ContainsFinalizer(controllerDeployment, FinalizerName) {
 			log.Info("Removing finalizer")
 			if err := controllerutils.RemoveFinalizers(ctx, r.Client, controllerDeployment, FinalizerName); err != nil {
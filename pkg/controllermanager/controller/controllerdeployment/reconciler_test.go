@@ -9,6 +9,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -113,6 +114,20 @@ var _ = Describe("Controller", func() {
 			Expect(err).To(MatchError(ContainSubstring("cannot remove finalizer of ControllerDeployment %q because still found ControllerRegistrations: [%s %s]", controllerDeployment.Name, controllerRegistration.Name, controllerRegistration2.Name)))
 		})
 
+		It("should return error because ControllerInstallation referencing ControllerDeployment exists", func() {
+			controllerInstallation := &gardencorev1beta1.ControllerInstallation{
+				ObjectMeta: metav1.ObjectMeta{Name: "installation"},
+				Spec: gardencorev1beta1.ControllerInstallationSpec{
+					DeploymentRef: &corev1.ObjectReference{Name: controllerDeployment.Name},
+				},
+			}
+			Expect(fakeClient.Create(ctx, controllerInstallation)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: controllerDeploymentName}})
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).To(MatchError(ContainSubstring("cannot remove finalizer of ControllerDeployment %q because still found ControllerInstallations: [%s]", controllerDeployment.Name, controllerInstallation.Name)))
+		})
+
 		It("should remove the finalizer because no ControllerRegistration is referencing the ControllerDeployment", func() {
 			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: controllerDeploymentName}})
 			Expect(result).To(Equal(reconcile.Result{}))
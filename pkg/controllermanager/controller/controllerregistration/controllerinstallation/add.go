@@ -98,7 +98,8 @@ func BackupEntryPredicate(kind Kind) predicate.Predicate {
 }
 
 // ControllerInstallationPredicate returns true for all ControllerInstallation 'create' events. For updates, it only
-// returns true when the Required condition's status has changed. For other events, false is returned.
+// returns true when the Required or Installed condition's status has changed. The latter is relevant for
+// ControllerInstallations of ControllerRegistrations which depend on it. For other events, false is returned.
 func ControllerInstallationPredicate(kind Kind) predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
@@ -128,7 +129,8 @@ func ControllerInstallationPredicate(kind Kind) predicate.Predicate {
 				return false
 			}
 
-			return v1beta1helper.IsControllerInstallationRequired(*oldControllerInstallation) != v1beta1helper.IsControllerInstallationRequired(*controllerInstallation)
+			return v1beta1helper.IsControllerInstallationRequired(*oldControllerInstallation) != v1beta1helper.IsControllerInstallationRequired(*controllerInstallation) ||
+				isControllerInstallationInstalled(oldControllerInstallation) != isControllerInstallationInstalled(controllerInstallation)
 		},
 		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
 		GenericFunc: func(_ event.GenericEvent) bool { return false },
@@ -626,6 +626,15 @@ var _ = Describe("Add", func() {
 					controllerInstallation.Status.Conditions = nil
 					Expect(p.Update(event.UpdateEvent{ObjectNew: controllerInstallation, ObjectOld: oldControllerInstallation})).To(BeTrue())
 				})
+
+				It("should return true because Installed condition changed", func() {
+					controllerInstallation.Status.Conditions = []gardencorev1beta1.Condition{
+						{Type: gardencorev1beta1.ControllerInstallationInstalled, Status: gardencorev1beta1.ConditionFalse},
+					}
+					oldControllerInstallation := controllerInstallation.DeepCopy()
+					controllerInstallation.Status.Conditions[0].Status = gardencorev1beta1.ConditionTrue
+					Expect(p.Update(event.UpdateEvent{ObjectNew: controllerInstallation, ObjectOld: oldControllerInstallation})).To(BeTrue())
+				})
 			})
 
 			Describe("#Delete", func() {
@@ -8,7 +8,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -16,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -43,6 +46,14 @@ const (
 	// RegistrationSpecHash is a constant for a label on `ControllerInstallation`s (similar to `pod-template-hash` on
 	// Pod`s).
 	RegistrationSpecHash = "registration-spec-hash"
+	// DeploymentRolloutTimestamp is a constant for an annotation on `ControllerInstallation`s which contains the time
+	// when the referenced `ControllerDeployment` was changed the last time. It is only maintained for
+	// `ControllerRegistration`s configuring a canary rollout.
+	DeploymentRolloutTimestamp = "deployment-rollout-timestamp"
+
+	// RequeueDurationWhenRolloutHeld is the duration after which a Seed is reconciled again if the rollout of a
+	// `ControllerDeployment` to it is held until the `ControllerDeployment` has been verified on the canary seeds.
+	RequeueDurationWhenRolloutHeld = time.Minute
 )
 
 // Kind is a string alias.
@@ -64,6 +75,7 @@ type Reconciler struct {
 	Client              client.Client
 	NewTargetObjectFunc func() client.Object
 	Kind                Kind
+	Clock               clock.Clock
 }
 
 // Reconcile performs the main reconciliation logic.
@@ -144,7 +156,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, err
 	}
 
-	if err := deployNeededInstallations(ctx, log, r.Client, obj, r.Kind, wantedControllerRegistrationNames, controllerRegistrations, registrationNameToInstallation); err != nil {
+	rolloutHeld, err := deployNeededInstallations(ctx, log, r.Client, r.Clock, obj, r.Kind, wantedControllerRegistrationNames, controllerRegistrations, registrationNameToInstallation, controllerInstallationList)
+	if err != nil {
 		return reconcile.Result{}, err
 	}
 
@@ -152,6 +165,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, err
 	}
 
+	if err := updateDependenciesSatisfiedConditions(ctx, r.Client, r.Clock, wantedControllerRegistrationNames, controllerRegistrations, registrationNameToInstallation); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if rolloutHeld {
+		return reconcile.Result{RequeueAfter: RequeueDurationWhenRolloutHeld}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -363,6 +384,7 @@ func computeWantedControllerRegistrationNames(
 	}
 
 	wantedControllerRegistrationNames.Insert(sets.List(installedAndRequiredRegistrationNames(controllerInstallationList, obj, kind))...)
+	wantedControllerRegistrationNames.Insert(sets.List(dependenciesOf(wantedControllerRegistrationNames, controllerRegistrations))...)
 
 	if kind == ShootKind {
 		return wantedControllerRegistrationNames, nil
@@ -371,6 +393,36 @@ func computeWantedControllerRegistrationNames(
 	return controllerRegistrationNamesWithMatchingSeedLabelSelector(wantedControllerRegistrationNames.UnsortedList(), controllerRegistrations, obj.GetLabels())
 }
 
+// dependenciesOf computes the names of all existing ControllerRegistrations the given ControllerRegistrations
+// (transitively) depend on.
+func dependenciesOf(names sets.Set[string], controllerRegistrations map[string]controllerRegistration) sets.Set[string] {
+	var (
+		dependencies = sets.New[string]()
+		queue        = sets.List(names)
+	)
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		controllerRegistration, ok := controllerRegistrations[name]
+		if !ok {
+			continue
+		}
+
+		for _, dependency := range controllerRegistration.obj.Spec.Dependencies {
+			if _, ok := controllerRegistrations[dependency.Name]; !ok || names.Has(dependency.Name) || dependencies.Has(dependency.Name) {
+				continue
+			}
+
+			dependencies.Insert(dependency.Name)
+			queue = append(queue, dependency.Name)
+		}
+	}
+
+	return dependencies
+}
+
 func installedAndRequiredRegistrationNames(controllerInstallationList *gardencorev1beta1.ControllerInstallationList, obj client.Object, kind Kind) sets.Set[string] {
 	requiredControllerRegistrationNames := sets.New[string]()
 	for _, controllerInstallation := range controllerInstallationList.Items {
@@ -417,16 +469,25 @@ func computeRegistrationNameToInstallationMap(
 // deployNeededInstallations takes the list of required names of ControllerRegistrations, a mapping of ControllerRegistration
 // names to their actual objects, and another mapping of ControllerRegistration names to existing ControllerInstallations. It
 // creates or update ControllerInstallation objects for that reference the given seed and the various desired ControllerRegistrations.
+// It returns true if the rollout of a changed ControllerDeployment to the given seed is held because it has not been
+// verified on the canary seeds yet.
 func deployNeededInstallations(
 	ctx context.Context,
 	log logr.Logger,
 	c client.Client,
+	clock clock.Clock,
 	obj client.Object,
 	kind Kind,
 	wantedControllerRegistrations sets.Set[string],
 	controllerRegistrations map[string]controllerRegistration,
 	registrationNameToInstallation map[string]*gardencorev1beta1.ControllerInstallation,
-) error {
+	controllerInstallationList *gardencorev1beta1.ControllerInstallationList,
+) (
+	bool,
+	error,
+) {
+	var rolloutHeld bool
+
 	for _, registrationName := range wantedControllerRegistrations.UnsortedList() {
 		registrationLog := log.WithValues("controllerRegistrationName", registrationName)
 
@@ -451,32 +512,49 @@ func deployNeededInstallations(
 			controllerDeployment = &gardencorev1.ControllerDeployment{}
 
 			if err := c.Get(ctx, client.ObjectKey{Name: controllerRegistration.Spec.Deployment.DeploymentRefs[0].Name}, controllerDeployment); err != nil {
-				return fmt.Errorf("cannot deploy ControllerInstallation because the referenced ControllerDeployment cannot be retrieved: %w", err)
+				return false, fmt.Errorf("cannot deploy ControllerInstallation because the referenced ControllerDeployment cannot be retrieved: %w", err)
 			}
 		}
 
 		existingControllerInstallation := registrationNameToInstallation[registrationName]
 		if existingControllerInstallation != nil && existingControllerInstallation.DeletionTimestamp != nil {
-			return fmt.Errorf("cannot deploy new ControllerInstallation for %q because the deletion of the old ControllerInstallation is still pending", registrationName)
+			return false, fmt.Errorf("cannot deploy new ControllerInstallation for %q because the deletion of the old ControllerInstallation is still pending", registrationName)
 		}
 
-		if err := deployNeededInstallation(ctx, c, obj, kind, controllerDeployment, controllerRegistration, existingControllerInstallation); err != nil {
-			return err
+		if kind == SeedKind && controllerDeployment != nil {
+			previousControllerDeployment, err := controllerDeploymentToKeepDuringCanaryRollout(ctx, registrationLog, c, clock, obj, controllerDeployment, controllerRegistration, existingControllerInstallation, controllerInstallationList)
+			if err != nil {
+				return false, err
+			}
+			if previousControllerDeployment != nil {
+				controllerDeployment = previousControllerDeployment
+				rolloutHeld = true
+			}
 		}
+
+		controllerInstallation, err := deployNeededInstallation(ctx, c, clock, obj, kind, controllerDeployment, controllerRegistration, existingControllerInstallation)
+		if err != nil {
+			return false, err
+		}
+		registrationNameToInstallation[registrationName] = controllerInstallation
 	}
 
-	return nil
+	return rolloutHeld, nil
 }
 
 func deployNeededInstallation(
 	ctx context.Context,
 	c client.Client,
+	clock clock.Clock,
 	obj client.Object,
 	kind Kind,
 	controllerDeployment *gardencorev1.ControllerDeployment,
 	controllerRegistration *gardencorev1beta1.ControllerRegistration,
 	existingControllerInstallation *gardencorev1beta1.ControllerInstallation,
-) error {
+) (
+	*gardencorev1beta1.ControllerInstallation,
+	error,
+) {
 	installationSpec := gardencorev1beta1.ControllerInstallationSpec{
 		RegistrationRef: corev1.ObjectReference{
 			Name:            controllerRegistration.Name,
@@ -554,6 +632,10 @@ func deployNeededInstallation(
 			}
 			deploymentSpecHash := utils.HashForMap(deploymentMap)[:16]
 			metav1.SetMetaDataLabel(&controllerInstallation.ObjectMeta, ControllerDeploymentHash, deploymentSpecHash)
+
+			if canaryRolloutOf(controllerRegistration) != nil && (controllerInstallation.Spec.DeploymentRef == nil || controllerInstallation.Spec.DeploymentRef.Name != controllerDeployment.Name) {
+				metav1.SetMetaDataAnnotation(&controllerInstallation.ObjectMeta, DeploymentRolloutTimestamp, clock.Now().UTC().Format(time.RFC3339))
+			}
 		}
 
 		if podSecurityEnforce, ok := controllerRegistration.Annotations[v1beta1constants.AnnotationPodSecurityEnforce]; ok {
@@ -572,7 +654,7 @@ func deployNeededInstallation(
 		// mutate() func before sending the PATCH. This way we ensure that we have applied our mutations to the latest version.
 		controllerInstallation.Name = existingControllerInstallation.Name
 		_, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, controllerInstallation, mutate)
-		return err
+		return controllerInstallation, err
 	}
 
 	// The installation does not exist yet, hence, we set `GenerateName` which will automatically append a random suffix to
@@ -580,7 +662,72 @@ func deployNeededInstallation(
 	// but only `GenerateName`, thus, we call `Create` directly.
 	controllerInstallation.GenerateName = controllerRegistration.Name + "-"
 	_ = mutate()
-	return c.Create(ctx, controllerInstallation)
+	return controllerInstallation, c.Create(ctx, controllerInstallation)
+}
+
+// updateDependenciesSatisfiedConditions maintains the DependenciesSatisfied condition of all wanted
+// ControllerInstallations whose ControllerRegistrations declare dependencies. The dependencies of a ControllerRegistration
+// are satisfied if they are wanted as well and their ControllerInstallations are reported as installed. gardenlet only
+// installs extension controllers once their dependencies are satisfied.
+func updateDependenciesSatisfiedConditions(
+	ctx context.Context,
+	c client.StatusClient,
+	clock clock.Clock,
+	wantedControllerRegistrationNames sets.Set[string],
+	controllerRegistrations map[string]controllerRegistration,
+	registrationNameToInstallation map[string]*gardencorev1beta1.ControllerInstallation,
+) error {
+	for _, registrationName := range sets.List(wantedControllerRegistrationNames) {
+		controllerInstallation, ok := registrationNameToInstallation[registrationName]
+		if !ok || controllerInstallation.DeletionTimestamp != nil {
+			continue
+		}
+
+		dependencies := controllerRegistrations[registrationName].obj.Spec.Dependencies
+		if len(dependencies) == 0 && v1beta1helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationDependenciesSatisfied) == nil {
+			continue
+		}
+
+		var notAvailable, notInstalled []string
+		for _, dependency := range dependencies {
+			if !wantedControllerRegistrationNames.Has(dependency.Name) {
+				notAvailable = append(notAvailable, dependency.Name)
+				continue
+			}
+
+			if dependencyInstallation, ok := registrationNameToInstallation[dependency.Name]; !ok || !isControllerInstallationInstalled(dependencyInstallation) {
+				notInstalled = append(notInstalled, dependency.Name)
+			}
+		}
+
+		condition := v1beta1helper.GetOrInitConditionWithClock(clock, controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationDependenciesSatisfied)
+		switch {
+		case len(notAvailable) > 0:
+			condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, "DependenciesNotAvailable", fmt.Sprintf("The following ControllerRegistrations this extension depends on do not exist, are being deleted, or do not select this cluster: %s", strings.Join(notAvailable, ", ")))
+		case len(notInstalled) > 0:
+			condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, "DependenciesNotInstalled", fmt.Sprintf("The following ControllerRegistrations this extension depends on are not installed yet: %s", strings.Join(notInstalled, ", ")))
+		default:
+			condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionTrue, "DependenciesSatisfied", "All ControllerRegistrations this extension depends on are installed.")
+		}
+
+		newConditions := v1beta1helper.MergeConditions(controllerInstallation.Status.Conditions, condition)
+		if !v1beta1helper.ConditionsNeedUpdate(controllerInstallation.Status.Conditions, newConditions) {
+			continue
+		}
+
+		patch := client.StrategicMergeFrom(controllerInstallation.DeepCopy())
+		controllerInstallation.Status.Conditions = newConditions
+		if err := c.Status().Patch(ctx, controllerInstallation, patch); err != nil {
+			return fmt.Errorf("failed patching %s condition of ControllerInstallation %s: %w", gardencorev1beta1.ControllerInstallationDependenciesSatisfied, controllerInstallation.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func isControllerInstallationInstalled(controllerInstallation *gardencorev1beta1.ControllerInstallation) bool {
+	condition := v1beta1helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationInstalled)
+	return condition != nil && condition.Status == gardencorev1beta1.ConditionTrue
 }
 
 // deleteUnneededInstallations takes the list of required names of ControllerRegistrations, and another mapping of
@@ -7,6 +7,7 @@ package controllerinstallation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -16,10 +17,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -744,6 +747,113 @@ var _ = Describe("Reconciler", func() {
 			Expect(names).To(Equal(sets.New(controllerRegistration7.Name)))
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should consider the dependencies of wanted registrations transitively", func() {
+			controllerRegistration3.Spec.Dependencies = []gardencorev1beta1.ControllerRegistrationDependency{{Name: "dependency1"}, {Name: "non-existing"}}
+			controllerRegistrations["dependency1"] = controllerRegistration{obj: &gardencorev1beta1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "dependency1"},
+				Spec:       gardencorev1beta1.ControllerRegistrationSpec{Dependencies: []gardencorev1beta1.ControllerRegistrationDependency{{Name: "dependency2"}, {Name: controllerRegistration3.Name}}},
+			}}
+			controllerRegistrations["dependency2"] = controllerRegistration{obj: &gardencorev1beta1.ControllerRegistration{ObjectMeta: metav1.ObjectMeta{Name: "dependency2"}}}
+			controllerRegistrations["unrelated"] = controllerRegistration{obj: &gardencorev1beta1.ControllerRegistration{ObjectMeta: metav1.ObjectMeta{Name: "unrelated"}}}
+
+			wantedKindTypeCombinations := sets.New(extensionsv1alpha1.ControlPlaneResource + "/" + type3)
+
+			names, err := computeWantedControllerRegistrationNames(wantedKindTypeCombinations, controllerInstallationList, controllerRegistrations, 0, seed, SeedKind)
+
+			Expect(names).To(Equal(sets.New(controllerRegistration3.Name, controllerRegistration4.Name, controllerRegistration7.Name, "dependency1", "dependency2")))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#updateDependenciesSatisfiedConditions", func() {
+		var (
+			fakeClock *testclock.FakeClock
+
+			dependent, dependency, independent                                     *gardencorev1beta1.ControllerRegistration
+			dependentInstallation, dependencyInstallation, independentInstallation *gardencorev1beta1.ControllerInstallation
+			registrations                                                          map[string]controllerRegistration
+			registrationNameToInstallation                                         map[string]*gardencorev1beta1.ControllerInstallation
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.ControllerInstallation{}).Build()
+
+			dependency = &gardencorev1beta1.ControllerRegistration{ObjectMeta: metav1.ObjectMeta{Name: "dependency"}}
+			independent = &gardencorev1beta1.ControllerRegistration{ObjectMeta: metav1.ObjectMeta{Name: "independent"}}
+			dependent = &gardencorev1beta1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "dependent"},
+				Spec: gardencorev1beta1.ControllerRegistrationSpec{
+					Dependencies: []gardencorev1beta1.ControllerRegistrationDependency{{Name: dependency.Name}},
+				},
+			}
+			registrations = map[string]controllerRegistration{
+				dependent.Name:   {obj: dependent},
+				dependency.Name:  {obj: dependency},
+				independent.Name: {obj: independent},
+			}
+
+			dependentInstallation = &gardencorev1beta1.ControllerInstallation{ObjectMeta: metav1.ObjectMeta{Name: "dependent-installation"}}
+			dependencyInstallation = &gardencorev1beta1.ControllerInstallation{ObjectMeta: metav1.ObjectMeta{Name: "dependency-installation"}}
+			independentInstallation = &gardencorev1beta1.ControllerInstallation{ObjectMeta: metav1.ObjectMeta{Name: "independent-installation"}}
+			for _, obj := range []*gardencorev1beta1.ControllerInstallation{dependentInstallation, dependencyInstallation, independentInstallation} {
+				Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+			}
+
+			registrationNameToInstallation = map[string]*gardencorev1beta1.ControllerInstallation{
+				dependent.Name:   dependentInstallation,
+				dependency.Name:  dependencyInstallation,
+				independent.Name: independentInstallation,
+			}
+		})
+
+		getCondition := func(obj *gardencorev1beta1.ControllerInstallation) *gardencorev1beta1.Condition {
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+			return v1beta1helper.GetCondition(obj.Status.Conditions, gardencorev1beta1.ControllerInstallationDependenciesSatisfied)
+		}
+
+		It("should report dependencies which are not wanted", func() {
+			Expect(updateDependenciesSatisfiedConditions(ctx, fakeClient, fakeClock, sets.New(dependent.Name, independent.Name), registrations, registrationNameToInstallation)).To(Succeed())
+
+			condition := getCondition(dependentInstallation)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("DependenciesNotAvailable"))
+			Expect(condition.Message).To(ContainSubstring(dependency.Name))
+			Expect(getCondition(independentInstallation)).To(BeNil())
+		})
+
+		It("should report dependencies which are not installed yet", func() {
+			Expect(updateDependenciesSatisfiedConditions(ctx, fakeClient, fakeClock, sets.New(dependent.Name, dependency.Name), registrations, registrationNameToInstallation)).To(Succeed())
+
+			condition := getCondition(dependentInstallation)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("DependenciesNotInstalled"))
+			Expect(getCondition(dependencyInstallation)).To(BeNil())
+		})
+
+		It("should report satisfied dependencies", func() {
+			dependencyInstallation.Status.Conditions = []gardencorev1beta1.Condition{{Type: gardencorev1beta1.ControllerInstallationInstalled, Status: gardencorev1beta1.ConditionTrue}}
+
+			Expect(updateDependenciesSatisfiedConditions(ctx, fakeClient, fakeClock, sets.New(dependent.Name, dependency.Name), registrations, registrationNameToInstallation)).To(Succeed())
+
+			condition := getCondition(dependentInstallation)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("DependenciesSatisfied"))
+		})
+
+		It("should update the condition once dependencies are removed", func() {
+			Expect(updateDependenciesSatisfiedConditions(ctx, fakeClient, fakeClock, sets.New(dependent.Name), registrations, registrationNameToInstallation)).To(Succeed())
+			Expect(getCondition(dependentInstallation).Status).To(Equal(gardencorev1beta1.ConditionFalse))
+
+			dependent.Spec.Dependencies = nil
+
+			Expect(updateDependenciesSatisfiedConditions(ctx, fakeClient, fakeClock, sets.New(dependent.Name), registrations, registrationNameToInstallation)).To(Succeed())
+			Expect(getCondition(dependentInstallation).Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		})
 	})
 
 	Describe("#computeRegistrationNameToInstallationNameMap", func() {
@@ -771,11 +881,13 @@ var _ = Describe("Reconciler", func() {
 		var (
 			ctrl      *gomock.Controller
 			k8sClient *mockclient.MockClient
+			fakeClock *testclock.FakeClock
 		)
 
 		BeforeEach(func() {
 			ctrl = gomock.NewController(GinkgoT())
 			k8sClient = mockclient.NewMockClient(ctrl)
+			fakeClock = testclock.NewFakeClock(time.Now())
 
 			k8sClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Name: controllerDeployment.Name}, gomock.AssignableToTypeOf(&gardencorev1.ControllerDeployment{})).DoAndReturn(
 				func(_ context.Context, _ client.ObjectKey, obj *gardencorev1.ControllerDeployment, _ ...client.GetOption) error {
@@ -804,7 +916,7 @@ var _ = Describe("Reconciler", func() {
 
 				k8sClient.EXPECT().Get(ctx, client.ObjectKey{Name: controllerInstallation2.Name}, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{})).Return(fakeErr)
 
-				err := deployNeededInstallations(ctx, log, k8sClient, seed, SeedKind, wantedControllerRegistrations, controllerRegistrations, registrationNameToInstallation)
+				_, err := deployNeededInstallations(ctx, log, k8sClient, fakeClock, seed, SeedKind, wantedControllerRegistrations, controllerRegistrations, registrationNameToInstallation, &gardencorev1beta1.ControllerInstallationList{})
 
 				Expect(err).To(Equal(fakeErr))
 			})
@@ -820,7 +932,7 @@ var _ = Describe("Reconciler", func() {
 					}
 				)
 
-				err := deployNeededInstallations(ctx, log, k8sClient, seed, SeedKind, wantedControllerRegistrations, controllerRegistrations, registrationNameToInstallation)
+				_, err := deployNeededInstallations(ctx, log, k8sClient, fakeClock, seed, SeedKind, wantedControllerRegistrations, controllerRegistrations, registrationNameToInstallation, &gardencorev1beta1.ControllerInstallationList{})
 
 				Expect(err).To(HaveOccurred())
 			})
@@ -857,7 +969,7 @@ var _ = Describe("Reconciler", func() {
 
 				k8sClient.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}))
 
-				err := deployNeededInstallations(ctx, log, k8sClient, seed, SeedKind, wantedControllerRegistrations, controllerRegistrations, registrationNameToInstallation)
+				_, err := deployNeededInstallations(ctx, log, k8sClient, fakeClock, seed, SeedKind, wantedControllerRegistrations, controllerRegistrations, registrationNameToInstallation, &gardencorev1beta1.ControllerInstallationList{})
 
 				Expect(err).NotTo(HaveOccurred())
 			})
@@ -901,7 +1013,8 @@ var _ = Describe("Reconciler", func() {
 
 				k8sClient.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}))
 
-				Expect(deployNeededInstallations(ctx, log, k8sClient, shoot3, ShootKind, wantedControllerRegistrations, controllerRegistrations, registrationNameToInstallation)).To(Succeed())
+				_, err := deployNeededInstallations(ctx, log, k8sClient, fakeClock, shoot3, ShootKind, wantedControllerRegistrations, controllerRegistrations, registrationNameToInstallation, &gardencorev1beta1.ControllerInstallationList{})
+				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("should not skip the controller registration that is after one in deletion", func() {
@@ -929,7 +1042,7 @@ var _ = Describe("Reconciler", func() {
 				k8sClient.EXPECT().Get(ctx, client.ObjectKey{Name: controllerInstallation2.Name}, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}))
 				k8sClient.EXPECT().Patch(ctx, installation2, gomock.Any())
 
-				err := deployNeededInstallations(ctx, log, k8sClient, seed, SeedKind, wantedControllerRegistrations, registrations, registrationNameToInstallation)
+				_, err := deployNeededInstallations(ctx, log, k8sClient, fakeClock, seed, SeedKind, wantedControllerRegistrations, registrations, registrationNameToInstallation, &gardencorev1beta1.ControllerInstallationList{})
 
 				Expect(err).NotTo(HaveOccurred())
 			})
@@ -951,7 +1064,7 @@ var _ = Describe("Reconciler", func() {
 					}
 				)
 
-				err := deployNeededInstallations(ctx, log, k8sClient, seed, SeedKind, wantedControllerRegistrations, registrations, registrationNameToInstallation)
+				_, err := deployNeededInstallations(ctx, log, k8sClient, fakeClock, seed, SeedKind, wantedControllerRegistrations, registrations, registrationNameToInstallation, &gardencorev1beta1.ControllerInstallationList{})
 
 				Expect(err).NotTo(HaveOccurred())
 			})
@@ -986,7 +1099,7 @@ var _ = Describe("Reconciler", func() {
 				k8sClient.EXPECT().Get(ctx, client.ObjectKey{Name: controllerInstallation2.Name}, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}))
 				k8sClient.EXPECT().Patch(ctx, installation2, gomock.Any())
 
-				err := deployNeededInstallations(ctx, log, k8sClient, seed, SeedKind, wantedControllerRegistrations, registrations, registrationNameToInstallation)
+				_, err := deployNeededInstallations(ctx, log, k8sClient, fakeClock, seed, SeedKind, wantedControllerRegistrations, registrations, registrationNameToInstallation, &gardencorev1beta1.ControllerInstallationList{})
 
 				Expect(err).NotTo(HaveOccurred())
 			})
@@ -1021,7 +1134,7 @@ var _ = Describe("Reconciler", func() {
 				k8sClient.EXPECT().Get(ctx, client.ObjectKey{Name: controllerInstallation2.Name}, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}))
 				k8sClient.EXPECT().Patch(ctx, installation2, gomock.Any())
 
-				err := deployNeededInstallations(ctx, log, k8sClient, seed, SeedKind, wantedControllerRegistrations, registrations, registrationNameToInstallation)
+				_, err := deployNeededInstallations(ctx, log, k8sClient, fakeClock, seed, SeedKind, wantedControllerRegistrations, registrations, registrationNameToInstallation, &gardencorev1beta1.ControllerInstallationList{})
 
 				Expect(err).NotTo(HaveOccurred())
 
@@ -1037,7 +1150,7 @@ var _ = Describe("Reconciler", func() {
 				k8sClient.EXPECT().Get(ctx, client.ObjectKey{Name: controllerInstallation2.Name}, gomock.AssignableToTypeOf(&gardencorev1beta1.ControllerInstallation{}))
 				k8sClient.EXPECT().Patch(ctx, installation2, gomock.Any())
 
-				err = deployNeededInstallations(ctx, log, k8sClient, seed, SeedKind, wantedControllerRegistrations, registrations, registrationNameToInstallation)
+				_, err = deployNeededInstallations(ctx, log, k8sClient, fakeClock, seed, SeedKind, wantedControllerRegistrations, registrations, registrationNameToInstallation, &gardencorev1beta1.ControllerInstallationList{})
 
 				Expect(err).NotTo(HaveOccurred())
 			})
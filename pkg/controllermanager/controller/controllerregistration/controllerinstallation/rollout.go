@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllerinstallation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+func canaryRolloutOf(controllerRegistration *gardencorev1beta1.ControllerRegistration) *gardencorev1beta1.ControllerDeploymentCanaryRollout {
+	if controllerRegistration.Spec.Deployment == nil || controllerRegistration.Spec.Deployment.Rollout == nil {
+		return nil
+	}
+	return controllerRegistration.Spec.Deployment.Rollout.Canary
+}
+
+// controllerDeploymentToKeepDuringCanaryRollout returns the ControllerDeployment currently referenced by the existing
+// ControllerInstallation if the ControllerRegistration configures a canary rollout, the given seed is no canary seed,
+// and the given (new) ControllerDeployment has not been verified on the canary seeds yet. Otherwise, it returns nil.
+func controllerDeploymentToKeepDuringCanaryRollout(
+	ctx context.Context,
+	log logr.Logger,
+	c client.Client,
+	clock clock.Clock,
+	seed client.Object,
+	controllerDeployment *gardencorev1.ControllerDeployment,
+	controllerRegistration *gardencorev1beta1.ControllerRegistration,
+	existingControllerInstallation *gardencorev1beta1.ControllerInstallation,
+	controllerInstallationList *gardencorev1beta1.ControllerInstallationList,
+) (
+	*gardencorev1.ControllerDeployment,
+	error,
+) {
+	canary := canaryRolloutOf(controllerRegistration)
+	if canary == nil ||
+		existingControllerInstallation == nil ||
+		existingControllerInstallation.Spec.DeploymentRef == nil ||
+		existingControllerInstallation.Spec.DeploymentRef.Name == controllerDeployment.Name {
+		return nil, nil
+	}
+
+	canarySeedSelector, err := metav1.LabelSelectorAsSelector(&canary.SeedSelector)
+	if err != nil {
+		return nil, fmt.Errorf("label selector conversion failed for canary seed selector of ControllerRegistration %q: %w", controllerRegistration.Name, err)
+	}
+
+	if canarySeedSelector.Matches(labels.Set(seed.GetLabels())) {
+		return nil, nil
+	}
+
+	verified, reason, err := verifyCanaryRollout(ctx, c, clock, canary, canarySeedSelector, controllerRegistration.Name, controllerDeployment.Name, controllerInstallationList)
+	if err != nil {
+		return nil, fmt.Errorf("failed verifying canary rollout of ControllerDeployment %q: %w", controllerDeployment.Name, err)
+	}
+	if verified {
+		return nil, nil
+	}
+
+	previousControllerDeployment := &gardencorev1.ControllerDeployment{}
+	if err := c.Get(ctx, client.ObjectKey{Name: existingControllerInstallation.Spec.DeploymentRef.Name}, previousControllerDeployment); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed reading previous ControllerDeployment %q: %w", existingControllerInstallation.Spec.DeploymentRef.Name, err)
+		}
+
+		log.Info("Previous ControllerDeployment does not exist anymore, not holding rollout", "controllerDeploymentName", controllerDeployment.Name, "previousControllerDeploymentName", existingControllerInstallation.Spec.DeploymentRef.Name)
+		return nil, nil
+	}
+
+	log.Info("Holding rollout of ControllerDeployment until it has been verified on the canary seeds", "controllerDeploymentName", controllerDeployment.Name, "previousControllerDeploymentName", previousControllerDeployment.Name, "reason", reason)
+	return previousControllerDeployment, nil
+}
+
+// verifyCanaryRollout checks whether the ControllerDeployment with the given name has been rolled out successfully to all
+// canary seeds running the extension. This is the case if the extension has been installed and healthy on all of them
+// for the configured verification duration and if the success rate of shoot reconciliations on them since the rollout
+// is not lower than the configured minimum. If the verification is not successful (yet), the reason is returned.
+func verifyCanaryRollout(
+	ctx context.Context,
+	c client.Reader,
+	clock clock.Clock,
+	canary *gardencorev1beta1.ControllerDeploymentCanaryRollout,
+	canarySeedSelector labels.Selector,
+	controllerRegistrationName string,
+	controllerDeploymentName string,
+	controllerInstallationList *gardencorev1beta1.ControllerInstallationList,
+) (
+	bool,
+	string,
+	error,
+) {
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := c.List(ctx, seedList, client.MatchingLabelsSelector{Selector: canarySeedSelector}); err != nil {
+		return false, "", fmt.Errorf("failed listing canary seeds: %w", err)
+	}
+
+	canarySeedNames := sets.New[string]()
+	for _, seed := range seedList.Items {
+		canarySeedNames.Insert(seed.Name)
+	}
+
+	canarySeedNameToRolloutTime := make(map[string]time.Time)
+	for _, controllerInstallation := range controllerInstallationList.Items {
+		if controllerInstallation.Spec.RegistrationRef.Name != controllerRegistrationName ||
+			controllerInstallation.Spec.SeedRef == nil ||
+			!canarySeedNames.Has(controllerInstallation.Spec.SeedRef.Name) ||
+			controllerInstallation.DeletionTimestamp != nil {
+			continue
+		}
+
+		seedName := controllerInstallation.Spec.SeedRef.Name
+
+		if controllerInstallation.Spec.DeploymentRef == nil || controllerInstallation.Spec.DeploymentRef.Name != controllerDeploymentName {
+			return false, fmt.Sprintf("ControllerDeployment is not rolled out to canary seed %q yet", seedName), nil
+		}
+
+		if !isControllerInstallationInstalled(&controllerInstallation) || !isControllerInstallationHealthy(&controllerInstallation) {
+			return false, fmt.Sprintf("extension is not installed or not healthy on canary seed %q", seedName), nil
+		}
+
+		rolloutTime := controllerInstallation.CreationTimestamp.UTC()
+		if timestamp, ok := controllerInstallation.Annotations[DeploymentRolloutTimestamp]; ok {
+			if t, err := time.Parse(time.RFC3339, timestamp); err == nil {
+				rolloutTime = t
+			}
+		}
+
+		if canary.VerificationDuration != nil {
+			if remaining := rolloutTime.Add(canary.VerificationDuration.Duration).Sub(clock.Now()); remaining > 0 {
+				return false, fmt.Sprintf("verification duration on canary seed %q is not over yet, %s remaining", seedName, remaining.Round(time.Second)), nil
+			}
+		}
+
+		canarySeedNameToRolloutTime[seedName] = rolloutTime
+	}
+
+	if len(canarySeedNameToRolloutTime) == 0 {
+		return false, "extension is not installed on any canary seed", nil
+	}
+
+	var succeeded, finished int
+	for seedName, rolloutTime := range canarySeedNameToRolloutTime {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := c.List(ctx, shootList, client.MatchingFields{core.ShootSeedName: seedName}); err != nil {
+			return false, "", fmt.Errorf("failed listing shoots of canary seed %q: %w", seedName, err)
+		}
+
+		for _, shoot := range shootList.Items {
+			lastOperation := shoot.Status.LastOperation
+			if lastOperation == nil ||
+				lastOperation.LastUpdateTime.Time.Before(rolloutTime) ||
+				(lastOperation.Type != gardencorev1beta1.LastOperationTypeCreate && lastOperation.Type != gardencorev1beta1.LastOperationTypeReconcile) {
+				continue
+			}
+
+			switch lastOperation.State {
+			case gardencorev1beta1.LastOperationStateSucceeded:
+				succeeded++
+				finished++
+			case gardencorev1beta1.LastOperationStateError, gardencorev1beta1.LastOperationStateFailed:
+				finished++
+			}
+		}
+	}
+
+	if minSuccessRate := ptr.Deref(canary.MinShootReconcileSuccessRate, 100); finished > 0 && succeeded*100 < int(minSuccessRate)*finished {
+		return false, fmt.Sprintf("shoot reconcile success rate on canary seeds is %d%% (%d/%d), but at least %d%% is required", succeeded*100/finished, succeeded, finished, minSuccessRate), nil
+	}
+
+	return true, "", nil
+}
+
+func isControllerInstallationHealthy(controllerInstallation *gardencorev1beta1.ControllerInstallation) bool {
+	condition := v1beta1helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationHealthy)
+	return condition != nil && condition.Status == gardencorev1beta1.ConditionTrue
+}
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllerinstallation
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Rollout", func() {
+	var (
+		ctx        = context.TODO()
+		log        = logr.Discard()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+
+		canaryLabels                 = map[string]string{"canary": "true"}
+		verificationDuration         = 10 * time.Minute
+		minShootReconcileSuccessRate int32
+
+		canarySeed, otherSeed                 *gardencorev1beta1.Seed
+		oldDeployment, newDeployment          *gardencorev1.ControllerDeployment
+		registration                          *gardencorev1beta1.ControllerRegistration
+		canaryInstallation, otherInstallation *gardencorev1beta1.ControllerInstallation
+		controllerInstallationList            *gardencorev1beta1.ControllerInstallationList
+
+		newInstallation            func(name, seedName, deploymentName string) *gardencorev1beta1.ControllerInstallation
+		newShootOnCanarySeed       func(name string, state gardencorev1beta1.LastOperationState, lastUpdateTime time.Time) *gardencorev1beta1.Shoot
+		controllerDeploymentToKeep func() (*gardencorev1.ControllerDeployment, error)
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		minShootReconcileSuccessRate = 80
+
+		canarySeed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "canary", Labels: canaryLabels}}
+		otherSeed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+		Expect(fakeClient.Create(ctx, canarySeed)).To(Succeed())
+		Expect(fakeClient.Create(ctx, otherSeed)).To(Succeed())
+
+		oldDeployment = &gardencorev1.ControllerDeployment{ObjectMeta: metav1.ObjectMeta{Name: "ext-v1"}}
+		newDeployment = &gardencorev1.ControllerDeployment{ObjectMeta: metav1.ObjectMeta{Name: "ext-v2"}}
+		Expect(fakeClient.Create(ctx, oldDeployment)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newDeployment)).To(Succeed())
+
+		registration = &gardencorev1beta1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "ext"},
+			Spec: gardencorev1beta1.ControllerRegistrationSpec{
+				Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{
+					DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: newDeployment.Name}},
+					Rollout: &gardencorev1beta1.ControllerDeploymentRollout{
+						Canary: &gardencorev1beta1.ControllerDeploymentCanaryRollout{
+							SeedSelector:                 metav1.LabelSelector{MatchLabels: canaryLabels},
+							VerificationDuration:         &metav1.Duration{Duration: verificationDuration},
+							MinShootReconcileSuccessRate: &minShootReconcileSuccessRate,
+						},
+					},
+				},
+			},
+		}
+
+		newInstallation = func(name, seedName, deploymentName string) *gardencorev1beta1.ControllerInstallation {
+			return &gardencorev1beta1.ControllerInstallation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Annotations: map[string]string{DeploymentRolloutTimestamp: fakeClock.Now().Add(-time.Hour).Format(time.RFC3339)},
+				},
+				Spec: gardencorev1beta1.ControllerInstallationSpec{
+					RegistrationRef: corev1.ObjectReference{Name: registration.Name},
+					SeedRef:         &corev1.ObjectReference{Name: seedName},
+					DeploymentRef:   &corev1.ObjectReference{Name: deploymentName},
+				},
+				Status: gardencorev1beta1.ControllerInstallationStatus{
+					Conditions: []gardencorev1beta1.Condition{
+						{Type: gardencorev1beta1.ControllerInstallationInstalled, Status: gardencorev1beta1.ConditionTrue},
+						{Type: gardencorev1beta1.ControllerInstallationHealthy, Status: gardencorev1beta1.ConditionTrue},
+					},
+				},
+			}
+		}
+
+		canaryInstallation = newInstallation("ext-canary", canarySeed.Name, newDeployment.Name)
+		otherInstallation = newInstallation("ext-other", otherSeed.Name, oldDeployment.Name)
+		controllerInstallationList = &gardencorev1beta1.ControllerInstallationList{}
+
+		newShootOnCanarySeed = func(name string, state gardencorev1beta1.LastOperationState, lastUpdateTime time.Time) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: &canarySeed.Name},
+				Status: gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:           gardencorev1beta1.LastOperationTypeReconcile,
+						State:          state,
+						LastUpdateTime: metav1.NewTime(lastUpdateTime),
+					},
+				},
+			}
+		}
+
+		controllerDeploymentToKeep = func() (*gardencorev1.ControllerDeployment, error) {
+			controllerInstallationList.Items = []gardencorev1beta1.ControllerInstallation{*canaryInstallation, *otherInstallation}
+			return controllerDeploymentToKeepDuringCanaryRollout(ctx, log, fakeClient, fakeClock, otherSeed, newDeployment, registration, otherInstallation, controllerInstallationList)
+		}
+	})
+
+	Describe("#controllerDeploymentToKeepDuringCanaryRollout", func() {
+		It("should not hold the rollout if no canary rollout is configured", func() {
+			registration.Spec.Deployment.Rollout = nil
+			canaryInstallation.Status.Conditions = nil
+
+			Expect(controllerDeploymentToKeep()).To(BeNil())
+		})
+
+		It("should not hold the rollout for canary seeds", func() {
+			Expect(controllerDeploymentToKeepDuringCanaryRollout(ctx, log, fakeClient, fakeClock, canarySeed, newDeployment, registration, newInstallation("ext-canary", canarySeed.Name, oldDeployment.Name), controllerInstallationList)).To(BeNil())
+		})
+
+		It("should not hold the rollout for new installations", func() {
+			Expect(controllerDeploymentToKeepDuringCanaryRollout(ctx, log, fakeClient, fakeClock, otherSeed, newDeployment, registration, nil, controllerInstallationList)).To(BeNil())
+		})
+
+		It("should not hold the rollout if the installation already references the new deployment", func() {
+			otherInstallation.Spec.DeploymentRef.Name = newDeployment.Name
+
+			Expect(controllerDeploymentToKeep()).To(BeNil())
+		})
+
+		It("should not hold the rollout if the deployment was verified on the canary seeds", func() {
+			Expect(fakeClient.Create(ctx, newShootOnCanarySeed("shoot1", gardencorev1beta1.LastOperationStateSucceeded, fakeClock.Now()))).To(Succeed())
+
+			Expect(controllerDeploymentToKeep()).To(BeNil())
+		})
+
+		It("should hold the rollout if the deployment was not rolled out to the canary seeds yet", func() {
+			canaryInstallation.Spec.DeploymentRef.Name = oldDeployment.Name
+
+			Expect(controllerDeploymentToKeep()).To(Equal(oldDeploymentFrom(ctx, fakeClient, oldDeployment)))
+		})
+
+		It("should hold the rollout if the extension is not installed on any canary seed", func() {
+			canaryInstallation.Spec.SeedRef.Name = "unknown"
+
+			Expect(controllerDeploymentToKeep()).To(Equal(oldDeploymentFrom(ctx, fakeClient, oldDeployment)))
+		})
+
+		It("should hold the rollout if the extension is not healthy on a canary seed", func() {
+			canaryInstallation.Status.Conditions[1].Status = gardencorev1beta1.ConditionFalse
+
+			Expect(controllerDeploymentToKeep()).To(Equal(oldDeploymentFrom(ctx, fakeClient, oldDeployment)))
+		})
+
+		It("should hold the rollout if the verification duration is not over yet", func() {
+			canaryInstallation.Annotations[DeploymentRolloutTimestamp] = fakeClock.Now().Add(-verificationDuration / 2).Format(time.RFC3339)
+
+			Expect(controllerDeploymentToKeep()).To(Equal(oldDeploymentFrom(ctx, fakeClient, oldDeployment)))
+
+			fakeClock.Step(verificationDuration / 2)
+			Expect(controllerDeploymentToKeep()).To(BeNil())
+		})
+
+		It("should hold the rollout if the shoot reconcile success rate on the canary seeds is too low", func() {
+			Expect(fakeClient.Create(ctx, newShootOnCanarySeed("shoot1", gardencorev1beta1.LastOperationStateSucceeded, fakeClock.Now()))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newShootOnCanarySeed("shoot2", gardencorev1beta1.LastOperationStateFailed, fakeClock.Now()))).To(Succeed())
+			// Operations before the rollout and operations still in progress are not considered.
+			Expect(fakeClient.Create(ctx, newShootOnCanarySeed("shoot3", gardencorev1beta1.LastOperationStateSucceeded, fakeClock.Now().Add(-2*time.Hour)))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newShootOnCanarySeed("shoot4", gardencorev1beta1.LastOperationStateProcessing, fakeClock.Now()))).To(Succeed())
+
+			Expect(controllerDeploymentToKeep()).To(Equal(oldDeploymentFrom(ctx, fakeClient, oldDeployment)))
+
+			minShootReconcileSuccessRate = 50
+			Expect(controllerDeploymentToKeep()).To(BeNil())
+		})
+
+		It("should not hold the rollout if the previous deployment does not exist anymore", func() {
+			canaryInstallation.Status.Conditions = nil
+			Expect(fakeClient.Delete(ctx, oldDeployment)).To(Succeed())
+
+			Expect(controllerDeploymentToKeep()).To(BeNil())
+		})
+	})
+})
+
+func oldDeploymentFrom(ctx context.Context, c client.Reader, controllerDeployment *gardencorev1.ControllerDeployment) *gardencorev1.ControllerDeployment {
+	out := &gardencorev1.ControllerDeployment{}
+	ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(controllerDeployment), out)).To(Succeed())
+	return out
+}
@@ -11,6 +11,7 @@ import (
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +43,7 @@ func AddToManager(mgr manager.Manager, config controllermanagerconfigv1alpha1.Co
 			Client:              mgr.GetClient(),
 			NewTargetObjectFunc: func() client.Object { return &gardencorev1beta1.Seed{} },
 			Kind:                controllerinstallation.SeedKind,
+			Clock:               clock.RealClock{},
 		}
 	)
 
@@ -10,6 +10,7 @@ import (
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,6 +41,7 @@ func AddToManager(mgr manager.Manager, config controllermanagerconfigv1alpha1.Co
 			Client:              mgr.GetClient(),
 			NewTargetObjectFunc: func() client.Object { return &gardencorev1beta1.Shoot{} },
 			Kind:                controllerinstallation.ShootKind,
+			Clock:               clock.RealClock{},
 		}
 	)
 
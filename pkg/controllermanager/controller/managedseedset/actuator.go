@@ -82,8 +82,8 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 	// Get the pending replica, if any
 	pendingReplica := getPendingReplica(replicas, status)
 
-	// Determine ready, postponed, and deletable replicas
-	var readyReplicas, postponedReplicas, deletableReplicas []Replica
+	// Determine ready, postponed, deletable, and replaceable replicas
+	var readyReplicas, postponedReplicas, deletableReplicas, replaceableReplicas []Replica
 	for _, r := range replicas {
 		if replicaIsReady(r) {
 			readyReplicas = append(readyReplicas, r)
@@ -93,9 +93,12 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 		if r.IsDeletable() {
 			deletableReplicas = append(deletableReplicas, r)
 		}
+		if r.IsMarkedForReplacement() {
+			replaceableReplicas = append(replaceableReplicas, r)
+		}
 		debugReplica(r, log.V(1))
 	}
-	log.V(1).Info("Current replicas of ManagedSeedSet", "readyReplicas", readyReplicas, "postponedReplicas", postponedReplicas, "deletableReplicas", deletableReplicas)
+	log.V(1).Info("Current replicas of ManagedSeedSet", "readyReplicas", readyReplicas, "postponedReplicas", postponedReplicas, "deletableReplicas", deletableReplicas, "replaceableReplicas", replaceableReplicas)
 
 	// Update replicas and readyReplicas in status
 	status.Replicas = int32(len(replicas))           // #nosec G115 -- `ra.replicaGetter.GetReplicas(ctx, managedSeedSet)` returns a line for every ManagedSeeds in the system. This number cannot exceed max int32.
@@ -105,7 +108,8 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 	count := len(replicas)
 	targetCount := 0
 	if managedSeedSet.DeletionTimestamp == nil {
-		targetCount = int(*managedSeedSet.Spec.Replicas)
+		// Replicas marked for replacement are replaced by surge replicas, if allowed by the update strategy
+		targetCount = int(*managedSeedSet.Spec.Replicas) + min(getMaxSurge(managedSeedSet), len(replaceableReplicas))
 	}
 
 	// Determine whether scaling out or in
@@ -113,7 +117,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 
 	// Reconcile the pending replica, if any
 	if pendingReplica != nil {
-		if pending, err := a.reconcileReplica(ctx, log, managedSeedSet, status, pendingReplica, scalingIn); err != nil || pending {
+		if pending, err := a.reconcileReplica(ctx, log, managedSeedSet, status, pendingReplica, scalingIn || pendingReplica.IsMarkedForReplacement()); err != nil || pending {
 			return status, false, err
 		}
 	}
@@ -156,9 +160,18 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 
 	// Reconcile postponed replicas
 	for _, r := range postponedReplicas {
-		if pending, err := a.reconcileReplica(ctx, log, managedSeedSet, status, r, scalingIn); err != nil || pending {
+		if pending, err := a.reconcileReplica(ctx, log, managedSeedSet, status, r, scalingIn || r.IsMarkedForReplacement()); err != nil || pending {
+			return status, false, err
+		}
+	}
+
+	// Replace the next replica marked for replacement, if any
+	if len(replaceableReplicas) > 0 {
+		sortReplicasForReplacement(replaceableReplicas, isZoneAwareOrdering(managedSeedSet))
+		if err := a.replaceReplica(ctx, log, managedSeedSet, status, replaceableReplicas[0], readyReplicas); err != nil {
 			return status, false, err
 		}
+		return status, false, nil
 	}
 
 	log.V(1).Info("Nothing to do")
@@ -182,6 +195,8 @@ const (
 	EventWaitingForManagedSeedRegistered = "WaitingForManagedSeedRegistered"
 	EventWaitingForManagedSeedDeleted    = "WaitingForManagedSeedDeleted"
 	EventWaitingForSeedReady             = "WaitingForSeedReady"
+	EventReschedulingShoots              = "ReschedulingShoots"
+	EventWaitingForShootsRescheduled     = "WaitingForShootsRescheduled"
 )
 
 func (a *actuator) reconcileReplica(
@@ -342,6 +357,54 @@ func (a *actuator) deleteReplica(
 	return nil
 }
 
+func (a *actuator) replaceReplica(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	r Replica,
+	readyReplicas []Replica,
+) error {
+	log = log.WithValues("replica", r.GetObjectKey())
+
+	// Drain the replica's seed before deleting it
+	if r.HasScheduledShoots() {
+		if !isRescheduleShoots(managedSeedSet) {
+			log.Info("Waiting for Shoots to be rescheduled")
+			a.infoEventf(managedSeedSet, EventWaitingForShootsRescheduled, gardencorev1beta1.EventActionReconcile, "Waiting for Shoots scheduled on Seed %s to be rescheduled", r.GetName())
+			updatePendingReplica(status, r.GetName(), seedmanagementv1alpha1.ShootsReschedulingReason, nil)
+			return nil
+		}
+
+		target := getReschedulingTarget(r, readyReplicas, isZoneAwareOrdering(managedSeedSet))
+		if target == nil {
+			return fmt.Errorf("no ready replica found to reschedule Shoots scheduled on Seed %s to", r.GetName())
+		}
+
+		log.Info("Rescheduling Shoots", "targetSeed", target.GetName())
+		a.infoEventf(managedSeedSet, EventReschedulingShoots, gardencorev1beta1.EventActionReconcile, "Rescheduling Shoots from Seed %s to Seed %s", r.GetName(), target.GetName())
+		if err := r.RescheduleShoots(ctx, a.gardenClient, target.GetName()); err != nil {
+			return err
+		}
+		updatePendingReplica(status, r.GetName(), seedmanagementv1alpha1.ShootsReschedulingReason, nil)
+		return nil
+	}
+
+	if !r.IsDeletable() {
+		return fmt.Errorf("replica %s is marked for replacement but protected from deletion", r.GetFullName())
+	}
+
+	if err := a.deleteReplica(ctx, log, managedSeedSet, status, r); err != nil {
+		return err
+	}
+
+	// Decrement ReadyReplicas in status
+	if replicaIsReady(r) {
+		status.ReadyReplicas--
+	}
+	return nil
+}
+
 func (a *actuator) infoEventf(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, reason, action, fmt string, args ...any) {
 	a.recorder.Eventf(managedSeedSet, nil, corev1.EventTypeNormal, reason, action, fmt, args...)
 }
@@ -390,6 +453,69 @@ func getNextOrdinal(replicas []Replica, status *seedmanagementv1alpha1.ManagedSe
 	return status.NextReplicaNumber
 }
 
+func getRollingUpdateStrategy(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) *seedmanagementv1alpha1.RollingUpdateStrategy {
+	if managedSeedSet.Spec.UpdateStrategy == nil {
+		return nil
+	}
+	return managedSeedSet.Spec.UpdateStrategy.RollingUpdate
+}
+
+func getMaxSurge(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) int {
+	if rollingUpdate := getRollingUpdateStrategy(managedSeedSet); rollingUpdate != nil && rollingUpdate.MaxSurge != nil {
+		return int(*rollingUpdate.MaxSurge)
+	}
+	return 0
+}
+
+func isZoneAwareOrdering(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) bool {
+	rollingUpdate := getRollingUpdateStrategy(managedSeedSet)
+	return rollingUpdate != nil && ptr.Deref(rollingUpdate.ZoneAwareOrdering, false)
+}
+
+func isRescheduleShoots(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) bool {
+	rollingUpdate := getRollingUpdateStrategy(managedSeedSet)
+	return rollingUpdate != nil && ptr.Deref(rollingUpdate.RescheduleShoots, false)
+}
+
+// sortReplicasForReplacement sorts the given replicas in the order in which they should be replaced. If zoneAware is
+// true, replicas are grouped by zone so that all replicas of a zone are replaced before moving to the next one.
+// Otherwise, replicas are sorted by ordinal.
+func sortReplicasForReplacement(replicas []Replica, zoneAware bool) {
+	sort.SliceStable(replicas, func(i, j int) bool {
+		if zoneAware {
+			if zi, zj := replicas[i].GetZone(), replicas[j].GetZone(); zi != zj {
+				return zi < zj
+			}
+		}
+		return replicas[i].GetOrdinal() < replicas[j].GetOrdinal()
+	})
+}
+
+// getReschedulingTarget returns the replica to which the shoots scheduled on the given replica should be rescheduled.
+// Only ready replicas that are not marked for replacement are considered, and newer replicas are preferred.
+// If zoneAware is true, replicas in the same zone as the given replica are preferred.
+func getReschedulingTarget(r Replica, readyReplicas []Replica, zoneAware bool) Replica {
+	var target Replica
+	for _, candidate := range readyReplicas {
+		if candidate == r || candidate.IsMarkedForReplacement() {
+			continue
+		}
+		if target == nil || preferReschedulingTarget(candidate, target, r, zoneAware) {
+			target = candidate
+		}
+	}
+	return target
+}
+
+func preferReschedulingTarget(candidate, target, r Replica, zoneAware bool) bool {
+	if zoneAware {
+		if ci, ti := candidate.GetZone() == r.GetZone(), target.GetZone() == r.GetZone(); ci != ti {
+			return ci
+		}
+	}
+	return candidate.GetOrdinal() > target.GetOrdinal()
+}
+
 func replicaIsReady(r Replica) bool {
 	return r.GetStatus() == StatusManagedSeedRegistered && r.IsSeedReady() && r.GetShootHealthStatus() == gardenerutils.ShootStatusHealthy
 }
@@ -431,7 +557,13 @@ func (ap ascendingPriority) Swap(i, j int) {
 }
 
 func (ap ascendingPriority) Less(i, j int) bool {
-	// First compare replica statuses
+	// First compare whether replicas are marked for replacement
+	// Replicas marked for replacement are considered lower priority
+	if vi, vj := ap[i].IsMarkedForReplacement(), ap[j].IsMarkedForReplacement(); vi != vj {
+		return vi
+	}
+
+	// Then, compare replica statuses
 	// Replicas with "less advanced" status are considered lower priority
 	if vi, vj := ap[i].GetStatus(), ap[j].GetStatus(); vi != vj {
 		return vi < vj
@@ -141,6 +141,44 @@ var _ = Describe("Actuator", func() {
 			}
 		}
 
+		expectMarkedReplica = func(r *mockmanagedseedset.MockReplica, ordinal int32, status ReplicaStatus, zone string, hasScheduledShoots, deletable bool) {
+			r.EXPECT().GetName().Return(getReplicaName(ordinal)).AnyTimes()
+			r.EXPECT().GetFullName().Return(getReplicaFullName(ordinal)).AnyTimes()
+			r.EXPECT().GetObjectKey().Return(getReplicaObjectKey(ordinal)).AnyTimes()
+			r.EXPECT().GetOrdinal().Return(ordinal).AnyTimes()
+			r.EXPECT().GetStatus().Return(status).AnyTimes()
+			r.EXPECT().IsSeedReady().Return(status == StatusManagedSeedRegistered).AnyTimes()
+			r.EXPECT().GetShootHealthStatus().Return(gardenerutils.ShootStatusHealthy).AnyTimes()
+			r.EXPECT().IsDeletable().Return(deletable).AnyTimes()
+			r.EXPECT().IsMarkedForReplacement().Return(true).AnyTimes()
+			r.EXPECT().HasScheduledShoots().Return(hasScheduledShoots).AnyTimes()
+			r.EXPECT().GetZone().Return(zone).AnyTimes()
+		}
+		expectReadyReplica = func(r *mockmanagedseedset.MockReplica, ordinal int32, zone string) {
+			r.EXPECT().GetName().Return(getReplicaName(ordinal)).AnyTimes()
+			r.EXPECT().GetFullName().Return(getReplicaFullName(ordinal)).AnyTimes()
+			r.EXPECT().GetObjectKey().Return(getReplicaObjectKey(ordinal)).AnyTimes()
+			r.EXPECT().GetOrdinal().Return(ordinal).AnyTimes()
+			r.EXPECT().GetStatus().Return(StatusManagedSeedRegistered).AnyTimes()
+			r.EXPECT().IsSeedReady().Return(true).AnyTimes()
+			r.EXPECT().GetShootHealthStatus().Return(gardenerutils.ShootStatusHealthy).AnyTimes()
+			r.EXPECT().IsDeletable().Return(true).AnyTimes()
+			r.EXPECT().IsMarkedForReplacement().Return(false).AnyTimes()
+			r.EXPECT().HasScheduledShoots().Return(false).AnyTimes()
+			r.EXPECT().GetZone().Return(zone).AnyTimes()
+		}
+		withRollingUpdate = func(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, maxSurge int32, zoneAwareOrdering, rescheduleShoots bool) *seedmanagementv1alpha1.ManagedSeedSet {
+			managedSeedSet.Spec.UpdateStrategy = &seedmanagementv1alpha1.UpdateStrategy{
+				Type: ptr.To(seedmanagementv1alpha1.RollingUpdateStrategyType),
+				RollingUpdate: &seedmanagementv1alpha1.RollingUpdateStrategy{
+					MaxSurge:          ptr.To(maxSurge),
+					ZoneAwareOrdering: ptr.To(zoneAwareOrdering),
+					RescheduleShoots:  ptr.To(rescheduleShoots),
+				},
+			}
+			return managedSeedSet
+		}
+
 		expectReplica = func(r *mockmanagedseedset.MockReplica, ordinal int32, status ReplicaStatus, seedReady bool, shootStatus gardenerutils.ShootStatus, deletable bool) {
 			r.EXPECT().GetName().Return(getReplicaName(ordinal)).AnyTimes()
 			r.EXPECT().GetFullName().Return(getReplicaFullName(ordinal)).AnyTimes()
@@ -150,6 +188,9 @@ var _ = Describe("Actuator", func() {
 			r.EXPECT().IsSeedReady().Return(seedReady).AnyTimes()
 			r.EXPECT().GetShootHealthStatus().Return(shootStatus).AnyTimes()
 			r.EXPECT().IsDeletable().Return(deletable).AnyTimes()
+			r.EXPECT().IsMarkedForReplacement().Return(false).AnyTimes()
+			r.EXPECT().HasScheduledShoots().Return(!deletable).AnyTimes()
+			r.EXPECT().GetZone().Return("").AnyTimes()
 		}
 	)
 
@@ -529,6 +570,141 @@ var _ = Describe("Actuator", func() {
 			),
 		)
 	})
+
+	Context("replacing replicas", func() {
+		var (
+			r1, r2 *mockmanagedseedset.MockReplica
+
+			expectEvent = func(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, eventType, reason, action, fmt string, args ...any) {
+				recorder.EXPECT().Eventf(managedSeedSet, nil, eventType, reason, action, fmt, args)
+			}
+		)
+
+		BeforeEach(func() {
+			r1 = mockmanagedseedset.NewMockReplica(ctrl)
+			r2 = mockmanagedseedset.NewMockReplica(ctrl)
+		})
+
+		It("should create a surge replica if a replica is marked for replacement and max surge allows it", func() {
+			mss := withRollingUpdate(managedSeedSet(1, 1, "", "", nil), 1, false, false)
+			expectMarkedReplica(r0, 0, StatusManagedSeedRegistered, "", true, false)
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0}, nil)
+			rf.EXPECT().NewReplica(mss, nil, nil, nil, false).Return(r1)
+			r1.EXPECT().CreateShoot(ctx, gc, int32(1)).Return(nil)
+			r1.EXPECT().GetName().Return(getReplicaName(1))
+			expectEvent(mss, corev1.EventTypeNormal, EventCreatingShoot, gardencorev1beta1.EventActionReconcile, "Creating Shoot %s", getReplicaFullName(1))
+
+			s, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(status(2, 1, 2, getReplicaName(1), seedmanagementv1alpha1.ShootReconcilingReason, now, nil)))
+			Expect(removeFinalizer).To(BeFalse())
+		})
+
+		It("should reschedule the shoots of a replica marked for replacement to the surge replica", func() {
+			mss := withRollingUpdate(managedSeedSet(1, 2, "", "", nil), 1, false, true)
+			expectMarkedReplica(r0, 0, StatusManagedSeedRegistered, "", true, false)
+			expectReadyReplica(r1, 1, "")
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+			r0.EXPECT().RescheduleShoots(ctx, gc, getReplicaName(1)).Return(nil)
+			expectEvent(mss, corev1.EventTypeNormal, EventReschedulingShoots, gardencorev1beta1.EventActionReconcile, "Rescheduling Shoots from Seed %s to Seed %s", getReplicaName(0), getReplicaName(1))
+
+			s, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(status(2, 2, 2, getReplicaName(0), seedmanagementv1alpha1.ShootsReschedulingReason, now, nil)))
+			Expect(removeFinalizer).To(BeFalse())
+		})
+
+		It("should wait for the shoots of a replica marked for replacement to be rescheduled if automatic rescheduling is disabled", func() {
+			mss := withRollingUpdate(managedSeedSet(1, 2, "", "", nil), 1, false, false)
+			expectMarkedReplica(r0, 0, StatusManagedSeedRegistered, "", true, false)
+			expectReadyReplica(r1, 1, "")
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+			expectEvent(mss, corev1.EventTypeNormal, EventWaitingForShootsRescheduled, gardencorev1beta1.EventActionReconcile, "Waiting for Shoots scheduled on Seed %s to be rescheduled", getReplicaName(0))
+
+			s, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(status(2, 2, 2, getReplicaName(0), seedmanagementv1alpha1.ShootsReschedulingReason, now, nil)))
+		})
+
+		It("should delete the managed seed of a drained replica marked for replacement", func() {
+			mss := managedSeedSet(2, 2, "", "", nil)
+			expectMarkedReplica(r0, 0, StatusManagedSeedRegistered, "", false, true)
+			expectReadyReplica(r1, 1, "")
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+			r0.EXPECT().DeleteManagedSeed(ctx, gc).Return(nil)
+			expectEvent(mss, corev1.EventTypeNormal, EventDeletingManagedSeed, gardencorev1beta1.EventActionDelete, "Deleting ManagedSeed %s", getReplicaFullName(0))
+
+			s, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(status(2, 1, 2, getReplicaName(0), seedmanagementv1alpha1.ManagedSeedDeletingReason, now, nil)))
+		})
+
+		It("should delete the shoot of a pending replica marked for replacement instead of recreating its managed seed", func() {
+			mss := managedSeedSet(2, 2, getReplicaName(0), seedmanagementv1alpha1.ManagedSeedDeletingReason, nil)
+			expectMarkedReplica(r0, 0, StatusShootReconciled, "", false, true)
+			expectReadyReplica(r1, 1, "")
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+			r0.EXPECT().DeleteShoot(ctx, gc).Return(nil)
+			expectEvent(mss, corev1.EventTypeNormal, EventDeletingShoot, gardencorev1beta1.EventActionDelete, "Deleting Shoot %s", getReplicaFullName(0))
+
+			s, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(status(2, 1, 2, getReplicaName(0), seedmanagementv1alpha1.ShootDeletingReason, now, nil)))
+		})
+
+		It("should replace replicas zone by zone if zone-aware ordering is enabled", func() {
+			mss := withRollingUpdate(managedSeedSet(3, 3, "", "", nil), 0, true, false)
+			expectMarkedReplica(r0, 0, StatusManagedSeedRegistered, "zone-b", false, true)
+			expectMarkedReplica(r1, 1, StatusManagedSeedRegistered, "zone-a", false, true)
+			expectReadyReplica(r2, 2, "zone-a")
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1, r2}, nil)
+			r1.EXPECT().DeleteManagedSeed(ctx, gc).Return(nil)
+			expectEvent(mss, corev1.EventTypeNormal, EventDeletingManagedSeed, gardencorev1beta1.EventActionDelete, "Deleting ManagedSeed %s", getReplicaFullName(1))
+
+			s, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(status(3, 2, 3, getReplicaName(1), seedmanagementv1alpha1.ManagedSeedDeletingReason, now, nil)))
+		})
+
+		It("should prefer a rescheduling target in the same zone if zone-aware ordering is enabled", func() {
+			mss := withRollingUpdate(managedSeedSet(2, 3, "", "", nil), 1, true, true)
+			expectMarkedReplica(r0, 0, StatusManagedSeedRegistered, "zone-a", true, false)
+			expectReadyReplica(r1, 1, "zone-a")
+			expectReadyReplica(r2, 2, "zone-b")
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1, r2}, nil)
+			r0.EXPECT().RescheduleShoots(ctx, gc, getReplicaName(1)).Return(nil)
+			expectEvent(mss, corev1.EventTypeNormal, EventReschedulingShoots, gardencorev1beta1.EventActionReconcile, "Rescheduling Shoots from Seed %s to Seed %s", getReplicaName(0), getReplicaName(1))
+
+			s, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(status(3, 3, 3, getReplicaName(0), seedmanagementv1alpha1.ShootsReschedulingReason, now, nil)))
+		})
+
+		It("should fail if a replica marked for replacement is protected from deletion", func() {
+			mss := managedSeedSet(2, 2, "", "", nil)
+			expectMarkedReplica(r0, 0, StatusManagedSeedRegistered, "", false, false)
+			expectReadyReplica(r1, 1, "")
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+			recorder.EXPECT().Eventf(mss, nil, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, gardencorev1beta1.EventActionReconcile, fmt.Sprintf("replica %s is marked for replacement but protected from deletion", getReplicaFullName(0)))
+
+			s, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).To(MatchError(ContainSubstring("protected from deletion")))
+			Expect(s).To(Equal(status(2, 2, 2, "", "", now, nil)))
+		})
+
+		It("should prefer replicas marked for replacement when scaling in", func() {
+			mss := managedSeedSet(1, 2, "", "", nil)
+			expectReadyReplica(r0, 0, "")
+			expectMarkedReplica(r1, 1, StatusManagedSeedRegistered, "", false, true)
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+			r1.EXPECT().DeleteManagedSeed(ctx, gc).Return(nil)
+			expectEvent(mss, corev1.EventTypeNormal, EventDeletingManagedSeed, gardencorev1beta1.EventActionDelete, "Deleting ManagedSeed %s", getReplicaFullName(1))
+
+			s, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s).To(Equal(status(2, 1, 2, getReplicaName(1), seedmanagementv1alpha1.ManagedSeedDeletingReason, now, nil)))
+		})
+	})
 })
 
 func getReplicaName(ordinal int32) string {
@@ -226,6 +226,34 @@ func (mr *MockReplicaMockRecorder) GetStatus() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockReplica)(nil).GetStatus))
 }
 
+// GetZone mocks base method.
+func (m *MockReplica) GetZone() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetZone")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetZone indicates an expected call of GetZone.
+func (mr *MockReplicaMockRecorder) GetZone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZone", reflect.TypeOf((*MockReplica)(nil).GetZone))
+}
+
+// HasScheduledShoots mocks base method.
+func (m *MockReplica) HasScheduledShoots() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasScheduledShoots")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasScheduledShoots indicates an expected call of HasScheduledShoots.
+func (mr *MockReplicaMockRecorder) HasScheduledShoots() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasScheduledShoots", reflect.TypeOf((*MockReplica)(nil).HasScheduledShoots))
+}
+
 // IsDeletable mocks base method.
 func (m *MockReplica) IsDeletable() bool {
 	m.ctrl.T.Helper()
@@ -240,6 +268,20 @@ func (mr *MockReplicaMockRecorder) IsDeletable() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDeletable", reflect.TypeOf((*MockReplica)(nil).IsDeletable))
 }
 
+// IsMarkedForReplacement mocks base method.
+func (m *MockReplica) IsMarkedForReplacement() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMarkedForReplacement")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsMarkedForReplacement indicates an expected call of IsMarkedForReplacement.
+func (mr *MockReplicaMockRecorder) IsMarkedForReplacement() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMarkedForReplacement", reflect.TypeOf((*MockReplica)(nil).IsMarkedForReplacement))
+}
+
 // IsSeedReady mocks base method.
 func (m *MockReplica) IsSeedReady() bool {
 	m.ctrl.T.Helper()
@@ -254,6 +296,20 @@ func (mr *MockReplicaMockRecorder) IsSeedReady() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSeedReady", reflect.TypeOf((*MockReplica)(nil).IsSeedReady))
 }
 
+// RescheduleShoots mocks base method.
+func (m *MockReplica) RescheduleShoots(ctx context.Context, c client.Client, seedName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleShoots", ctx, c, seedName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RescheduleShoots indicates an expected call of RescheduleShoots.
+func (mr *MockReplicaMockRecorder) RescheduleShoots(ctx, c, seedName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleShoots", reflect.TypeOf((*MockReplica)(nil).RescheduleShoots), ctx, c, seedName)
+}
+
 // RetryShoot mocks base method.
 func (m *MockReplica) RetryShoot(ctx context.Context, c client.Client) error {
 	m.ctrl.T.Helper()
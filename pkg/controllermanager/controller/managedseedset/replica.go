@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
@@ -94,6 +95,13 @@ type Replica interface {
 	// IsDeletable returns true if this replica can be deleted, false otherwise. A replica can be deleted if it has no
 	// scheduled shoots and is not protected by the "protect-from-deletion" annotation.
 	IsDeletable() bool
+	// IsMarkedForReplacement returns true if this replica is marked for replacement via the "replace" annotation on
+	// its shoot, false otherwise.
+	IsMarkedForReplacement() bool
+	// HasScheduledShoots returns true if shoots are scheduled on this replica's seed, false otherwise.
+	HasScheduledShoots() bool
+	// GetZone returns the zone of this replica. If the zone is not known, an empty string is returned.
+	GetZone() string
 	// CreateShoot initializes this replica's shoot and then creates it using the given context and client.
 	CreateShoot(ctx context.Context, c client.Client, ordinal int32) error
 	// CreateManagedSeed initializes this replica's managed seed, and then creates it using the given context and client.
@@ -104,6 +112,9 @@ type Replica interface {
 	DeleteManagedSeed(ctx context.Context, c client.Client) error
 	// RetryShoot retries this replica's shoot using the given context and client.
 	RetryShoot(ctx context.Context, c client.Client) error
+	// RescheduleShoots reschedules all shoots scheduled on this replica's seed to the seed with the given name
+	// using the given context and client.
+	RescheduleShoots(ctx context.Context, c client.Client, seedName string) error
 }
 
 // ReplicaFactory provides a method for creating new replicas.
@@ -238,6 +249,33 @@ func (r *replica) IsDeletable() bool {
 	return !r.hasScheduledShoots && !shootProtected && !managedSeedProtected
 }
 
+// IsMarkedForReplacement returns true if this replica is marked for replacement via the "replace" annotation on
+// its shoot, false otherwise.
+func (r *replica) IsMarkedForReplacement() bool {
+	return r.shoot != nil && kubernetesutils.HasMetaDataAnnotation(r.shoot, seedmanagementv1alpha1constants.AnnotationReplace, "true")
+}
+
+// HasScheduledShoots returns true if shoots are scheduled on this replica's seed, false otherwise.
+func (r *replica) HasScheduledShoots() bool {
+	return r.hasScheduledShoots
+}
+
+// GetZone returns the zone of this replica. This is the first zone of the replica's seed, or the first zone of the
+// replica's shoot workers if the seed doesn't exist. If the zone is not known, an empty string is returned.
+func (r *replica) GetZone() string {
+	if r.seed != nil && len(r.seed.Spec.Provider.Zones) > 0 {
+		return r.seed.Spec.Provider.Zones[0]
+	}
+	if r.shoot != nil {
+		for _, worker := range r.shoot.Spec.Provider.Workers {
+			if len(worker.Zones) > 0 {
+				return worker.Zones[0]
+			}
+		}
+	}
+	return ""
+}
+
 // CreateShoot initializes this replica's shoot and then creates it using the given context and client.
 func (r *replica) CreateShoot(ctx context.Context, c client.Client, ordinal int32) error {
 	if r.shoot == nil {
@@ -286,6 +324,31 @@ func (r *replica) RetryShoot(ctx context.Context, c client.Client) error {
 	return kubernetesutils.SetAnnotationAndUpdate(ctx, c, r.shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationRetry)
 }
 
+// RescheduleShoots reschedules all shoots scheduled on this replica's seed to the seed with the given name
+// using the given context and client.
+func (r *replica) RescheduleShoots(ctx context.Context, c client.Client, seedName string) error {
+	if r.seed == nil {
+		return nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := c.List(ctx, shootList, client.MatchingFields{gardencore.ShootSeedName: r.seed.Name}); err != nil {
+		return err
+	}
+
+	for i := range shootList.Items {
+		shoot := &shootList.Items[i]
+		if shoot.DeletionTimestamp != nil {
+			continue
+		}
+		shoot.Spec.SeedName = &seedName
+		if err := c.SubResource("binding").Update(ctx, shoot); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed rescheduling shoot %s to seed %s: %w", client.ObjectKeyFromObject(shoot), seedName, err)
+		}
+	}
+	return nil
+}
+
 func shootReconcileSucceeded(shoot *gardencorev1beta1.Shoot) bool {
 	lastOp := shoot.Status.LastOperation
 	return shoot.Generation == shoot.Status.ObservedGeneration && shoot.DeletionTimestamp == nil && lastOp != nil &&
@@ -9,6 +9,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
@@ -299,6 +301,40 @@ var _ = Describe("Replica", func() {
 			shoot(nil, "", "", "", false), managedSeed(nil, false, false), true, false),
 	)
 
+	Describe("#IsMarkedForReplacement", func() {
+		It("should return false if the replica has no shoot", func() {
+			Expect(NewReplica(managedSeedSet, nil, nil, nil, false).IsMarkedForReplacement()).To(BeFalse())
+		})
+
+		It("should return false if the shoot is not annotated", func() {
+			Expect(NewReplica(managedSeedSet, shoot(nil, "", "", "", false), nil, nil, false).IsMarkedForReplacement()).To(BeFalse())
+		})
+
+		It("should return true if the shoot is annotated", func() {
+			shoot := shoot(nil, "", "", "", false)
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, seedmanagementv1alpha1constants.AnnotationReplace, "true")
+			Expect(NewReplica(managedSeedSet, shoot, nil, nil, false).IsMarkedForReplacement()).To(BeTrue())
+		})
+	})
+
+	Describe("#GetZone", func() {
+		It("should return an empty string if the zone is not known", func() {
+			Expect(NewReplica(managedSeedSet, shoot(nil, "", "", "", false), nil, seed(nil, true, true, true), false).GetZone()).To(BeEmpty())
+		})
+
+		It("should return the first zone of the seed", func() {
+			seed := seed(nil, true, true, true)
+			seed.Spec.Provider.Zones = []string{"zone-b", "zone-a"}
+			Expect(NewReplica(managedSeedSet, shoot(nil, "", "", "", false), nil, seed, false).GetZone()).To(Equal("zone-b"))
+		})
+
+		It("should return the first zone of the shoot workers if the seed doesn't exist", func() {
+			shoot := shoot(nil, "", "", "", false)
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Name: "a"}, {Name: "b", Zones: []string{"zone-c"}}}
+			Expect(NewReplica(managedSeedSet, shoot, nil, nil, false).GetZone()).To(Equal("zone-c"))
+		})
+	})
+
 	Describe("#CreateShoot", func() {
 		It("should create the shoot", func() {
 			c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(
@@ -431,4 +467,40 @@ var _ = Describe("Replica", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+
+	Describe("#RescheduleShoots", func() {
+		It("should do nothing if the replica has no seed", func() {
+			replica := NewReplica(managedSeedSet, shoot(nil, "", "", "", false), nil, nil, false)
+			Expect(replica.RescheduleShoots(ctx, c, "other")).To(Succeed())
+		})
+
+		It("should bind all scheduled shoots that are not being deleted to the given seed", func() {
+			var (
+				seed         = seed(nil, true, true, true)
+				subResource  = mockclient.NewMockSubResourceClient(ctrl)
+				deletionTime = metav1.Now()
+			)
+
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{}), client.MatchingFields{gardencore.ShootSeedName: seed.Name}).DoAndReturn(
+				func(_ context.Context, shootList *gardencorev1beta1.ShootList, _ ...client.ListOption) error {
+					shootList.Items = []gardencorev1beta1.Shoot{
+						{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}, Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To(seed.Name)}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "baz", Namespace: "bar", DeletionTimestamp: &deletionTime}, Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To(seed.Name)}},
+					}
+					return nil
+				},
+			)
+			c.EXPECT().SubResource("binding").Return(subResource)
+			subResource.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(
+				func(_ context.Context, s *gardencorev1beta1.Shoot, _ ...client.SubResourceUpdateOption) error {
+					Expect(s.Name).To(Equal("foo"))
+					Expect(s.Spec.SeedName).To(PointTo(Equal("other")))
+					return nil
+				},
+			)
+
+			replica := NewReplica(managedSeedSet, shoot(nil, "", "", "", false), nil, seed, true)
+			Expect(replica.RescheduleShoots(ctx, c, "other")).To(Succeed())
+		})
+	})
 })
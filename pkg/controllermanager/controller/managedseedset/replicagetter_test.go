@@ -110,11 +110,11 @@ var _ = Describe("ReplicaGetter", func() {
 	})
 
 	Describe("#GetReplicas", func() {
-		It("should return all existing replicas", func() {
+		var expectReplicaObjects = func() {
 			selector, err := metav1.LabelSelectorAsSelector(&managedSeedSet.Spec.Selector)
 			Expect(err).ToNot(HaveOccurred())
 
-			c.EXPECT().Scheme().Return(kubernetes.GardenScheme)
+			c.EXPECT().Scheme().Return(kubernetes.GardenScheme).AnyTimes()
 			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{}), client.InNamespace(managedSeedSet.Namespace), client.MatchingLabelsSelector{Selector: selector}).DoAndReturn(
 				func(_ context.Context, shootList *gardencorev1beta1.ShootList, _ ...client.ListOption) error {
 					shootList.Items = shoots
@@ -143,19 +143,29 @@ var _ = Describe("ReplicaGetter", func() {
 					return nil
 				},
 			)
-			r.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{}), client.MatchingFields{gardencore.ShootSeedName: seeds[0].Name}, client.Limit(1)).DoAndReturn(
+		}
+
+		var expectScheduledShoots = func(fieldName string, exist bool) {
+			r.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{}), client.MatchingFields{fieldName: seeds[0].Name}, client.Limit(1)).DoAndReturn(
 				func(_ context.Context, shootList *gardencorev1beta1.ShootList, _ ...client.ListOption) error {
-					shootList.Items = []gardencorev1beta1.Shoot{
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name:      "foo",
-								Namespace: "bar",
+					if exist {
+						shootList.Items = []gardencorev1beta1.Shoot{
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      "foo",
+									Namespace: "bar",
+								},
 							},
-						},
+						}
 					}
 					return nil
 				},
 			)
+		}
+
+		It("should return all existing replicas", func() {
+			expectReplicaObjects()
+			expectScheduledShoots(gardencore.ShootSeedName, true)
 
 			result, err := replicaGetter.GetReplicas(ctx, managedSeedSet)
 			Expect(err).ToNot(HaveOccurred())
@@ -165,5 +175,25 @@ var _ = Describe("ReplicaGetter", func() {
 				NewReplica(managedSeedSet, &shoots[2], nil, nil, false),
 			}))
 		})
+
+		It("should consider shoots that are being migrated away from a replica's seed as scheduled", func() {
+			expectReplicaObjects()
+			expectScheduledShoots(gardencore.ShootSeedName, false)
+			expectScheduledShoots(gardencore.ShootStatusSeedName, true)
+
+			result, err := replicaGetter.GetReplicas(ctx, managedSeedSet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result[0]).To(Equal(NewReplica(managedSeedSet, &shoots[0], &managedSeeds[0], &seeds[0], true)))
+		})
+
+		It("should return replicas without scheduled shoots", func() {
+			expectReplicaObjects()
+			expectScheduledShoots(gardencore.ShootSeedName, false)
+			expectScheduledShoots(gardencore.ShootStatusSeedName, false)
+
+			result, err := replicaGetter.GetReplicas(ctx, managedSeedSet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result[0]).To(Equal(NewReplica(managedSeedSet, &shoots[0], &managedSeeds[0], &seeds[0], false)))
+		})
 	})
 })
@@ -15,6 +15,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,11 +30,17 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
+const (
+	eventReasonStaleProjectNotified = "StaleProjectNotified"
+	eventReasonStaleProjectLocked   = "StaleProjectLocked"
+)
+
 // Reconciler reconciles Projects, marks them as stale and auto-deletes them after a certain time if not in-use.
 type Reconciler struct {
-	Client client.Client
-	Config controllermanagerconfigv1alpha1.ProjectControllerConfiguration
-	Clock  clock.Clock
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.ProjectControllerConfiguration
+	Clock    clock.Clock
+	Recorder events.EventRecorder
 }
 
 // Reconcile reconciles Projects, marks them as stale and auto-deletes them after a certain time if not in-use.
@@ -87,11 +94,17 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 	}
 
 	// Skip projects that have been used recently
-	if project.Status.LastActivityTimestamp != nil && project.Status.LastActivityTimestamp.UTC().Add(time.Hour*24*time.Duration(*r.Config.MinimumLifetimeDays)).After(r.Clock.Now().UTC()) {
+	if r.considerAPIActivity() && project.Status.LastActivityTimestamp != nil && project.Status.LastActivityTimestamp.UTC().Add(time.Hour*24*time.Duration(*r.Config.MinimumLifetimeDays)).After(r.Clock.Now().UTC()) {
 		log.Info("Project was used recently and it is not exceeding the configured minimum lifetime, marking Project as not stale", "minimumLifetimeDays", *r.Config.MinimumLifetimeDays, "lastActivityTimestamp", project.Status.LastActivityTimestamp.UTC())
 		return r.markProjectAsNotStale(ctx, project)
 	}
 
+	// Skip projects whose members logged in recently
+	if lastMemberLogin := lastMemberLoginTimestamp(project); r.considerMemberLogins() && lastMemberLogin != nil && lastMemberLogin.Add(time.Hour*24*time.Duration(*r.Config.MinimumLifetimeDays)).After(r.Clock.Now().UTC()) {
+		log.Info("Project member logged in recently and it is not exceeding the configured minimum lifetime, marking Project as not stale", "minimumLifetimeDays", *r.Config.MinimumLifetimeDays, "lastMemberLoginTimestamp", lastMemberLogin.UTC())
+		return r.markProjectAsNotStale(ctx, project)
+	}
+
 	for _, check := range []struct {
 		resource  string
 		checkFunc func(context.Context, string) (bool, error)
@@ -113,8 +126,10 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		}
 	}
 
-	log.Info("Project is not in use by any resource, marking Project as stale")
-	if err := r.markProjectAsStale(ctx, project); err != nil {
+	skipCleanup := kubernetesutils.HasMetaDataAnnotation(project, v1beta1constants.ProjectSkipStaleCleanup, "true")
+
+	log.Info("Project is not in use by any resource, marking Project as stale", "skipCleanup", skipCleanup)
+	if err := r.markProjectAsStale(ctx, project, skipCleanup); err != nil {
 		return err
 	}
 
@@ -123,9 +138,14 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		log = log.WithValues("staleAutoDeleteTimestamp", (*project.Status.StaleAutoDeleteTimestamp).Time)
 	}
 
-	if project.Status.StaleAutoDeleteTimestamp == nil || r.Clock.Now().UTC().Before(project.Status.StaleAutoDeleteTimestamp.UTC()) {
+	if project.Status.StaleAutoDeleteTimestamp == nil {
+		log.Info("Project is stale, but will not be deleted")
+		return r.setCleanupStage(ctx, log, project, "")
+	}
+
+	if r.Clock.Now().UTC().Before(project.Status.StaleAutoDeleteTimestamp.UTC()) {
 		log.Info("Project is stale, but will not be deleted now")
-		return nil
+		return r.setCleanupStage(ctx, log, project, r.cleanupStage(project))
 	}
 
 	log.Info("Deleting Project now because its auto-delete timestamp is exceeded")
@@ -349,21 +369,92 @@ func (r *Reconciler) relevantCredentialsBindingsInUse(ctx context.Context, isCre
 	return r.credentialsBindingInUse(ctx, namespaceToCredentialsBindingNames)
 }
 
+func (r *Reconciler) considerAPIActivity() bool {
+	return r.Config.StaleSignals == nil || ptr.Deref(r.Config.StaleSignals.APIActivity, true)
+}
+
+func (r *Reconciler) considerMemberLogins() bool {
+	return r.Config.StaleSignals == nil || ptr.Deref(r.Config.StaleSignals.MemberLogins, true)
+}
+
+// lastMemberLoginTimestamp returns the time of the last login of a project member which is maintained by an
+// authentication hook. It returns nil if the annotation is not present or cannot be parsed.
+func lastMemberLoginTimestamp(project *gardencorev1beta1.Project) *time.Time {
+	value, ok := project.Annotations[v1beta1constants.ProjectLastMemberLoginTimestamp]
+	if !ok {
+		return nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return &timestamp
+}
+
+// cleanupStage computes the cleanup stage of the given stale project which has an auto-delete timestamp.
+func (r *Reconciler) cleanupStage(project *gardencorev1beta1.Project) string {
+	lockPeriodDays := ptr.Deref(r.Config.StaleLockPeriodDays, 0)
+	if lockPeriodDays > 0 && !r.Clock.Now().UTC().Before(project.Status.StaleAutoDeleteTimestamp.UTC().Add(-time.Hour*24*time.Duration(lockPeriodDays))) {
+		return v1beta1constants.ProjectStaleCleanupStageLocked
+	}
+	return v1beta1constants.ProjectStaleCleanupStageNotified
+}
+
+// setCleanupStage sets the given cleanup stage label on the project (or removes it if the stage is empty) and emits an
+// event to notify the project members when a new stage is reached.
+func (r *Reconciler) setCleanupStage(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project, stage string) error {
+	if project.Labels[v1beta1constants.ProjectStaleCleanupStage] == stage {
+		return nil
+	}
+
+	patch := client.MergeFrom(project.DeepCopy())
+	if stage == "" {
+		delete(project.Labels, v1beta1constants.ProjectStaleCleanupStage)
+	} else {
+		metav1.SetMetaDataLabel(&project.ObjectMeta, v1beta1constants.ProjectStaleCleanupStage, stage)
+	}
+
+	if err := r.Client.Patch(ctx, project, patch); err != nil {
+		return fmt.Errorf("failed setting cleanup stage label: %w", err)
+	}
+
+	switch stage {
+	case v1beta1constants.ProjectStaleCleanupStageNotified:
+		log.Info("Notifying about upcoming auto-deletion of stale Project")
+		r.Recorder.Eventf(project, nil, corev1.EventTypeWarning, eventReasonStaleProjectNotified, gardencorev1beta1.EventActionReconcile,
+			"Project is not actively used and will be deleted automatically after %s unless it is used again", project.Status.StaleAutoDeleteTimestamp.UTC().Format(time.RFC3339))
+	case v1beta1constants.ProjectStaleCleanupStageLocked:
+		log.Info("Locking stale Project")
+		r.Recorder.Eventf(project, nil, corev1.EventTypeWarning, eventReasonStaleProjectLocked, gardencorev1beta1.EventActionReconcile,
+			"Project is not actively used and was locked, no new Shoots can be created. It will be deleted automatically after %s unless it is used again", project.Status.StaleAutoDeleteTimestamp.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
 func (r *Reconciler) markProjectAsNotStale(ctx context.Context, project *gardencorev1beta1.Project) error {
+	if err := r.setCleanupStage(ctx, logr.Discard(), project, ""); err != nil {
+		return err
+	}
+
 	patch := client.MergeFrom(project.DeepCopy())
 	project.Status.StaleSinceTimestamp = nil
 	project.Status.StaleAutoDeleteTimestamp = nil
 	return r.Client.Status().Patch(ctx, project, patch)
 }
 
-func (r *Reconciler) markProjectAsStale(ctx context.Context, project *gardencorev1beta1.Project) error {
+func (r *Reconciler) markProjectAsStale(ctx context.Context, project *gardencorev1beta1.Project, skipCleanup bool) error {
 	patch := client.MergeFrom(project.DeepCopy())
 
 	if project.Status.StaleSinceTimestamp == nil {
 		project.Status.StaleSinceTimestamp = &metav1.Time{Time: r.Clock.Now()}
 	}
 
-	if project.Status.StaleSinceTimestamp.UTC().Add(time.Hour * 24 * time.Duration(*r.Config.StaleGracePeriodDays)).After(r.Clock.Now().UTC()) {
+	if skipCleanup {
+		// Projects which opted out of the cleanup are still marked as stale, but they never get an auto-delete timestamp.
+		project.Status.StaleAutoDeleteTimestamp = nil
+	} else if project.Status.StaleSinceTimestamp.UTC().Add(time.Hour * 24 * time.Duration(*r.Config.StaleGracePeriodDays)).After(r.Clock.Now().UTC()) {
 		// We reset the potentially set auto-delete timestamp here to allow changing the StaleExpirationTimeDays
 		// configuration value and correctly applying the changes to all Projects that had already been assigned
 		// such a timestamp.
@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -71,6 +72,7 @@ var _ = Describe("Reconciler", func() {
 		credentialsBinding    *securityv1alpha1.CredentialsBinding
 		cfg                   controllermanagerconfigv1alpha1.ProjectControllerConfiguration
 		request               reconcile.Request
+		fakeRecorder          *events.FakeRecorder
 
 		reconciler reconcile.Reconciler
 	)
@@ -125,10 +127,13 @@ var _ = Describe("Reconciler", func() {
 		}
 		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: project.Name}}
 
+		fakeRecorder = events.NewFakeRecorder(1)
+
 		reconciler = &Reconciler{
-			Client: k8sGardenRuntimeClient,
-			Config: cfg,
-			Clock:  fakeClock,
+			Client:   k8sGardenRuntimeClient,
+			Config:   cfg,
+			Clock:    fakeClock,
+			Recorder: fakeRecorder,
 		}
 
 		k8sGardenRuntimeClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Name: project.Name}, gomock.AssignableToTypeOf(&gardencorev1beta1.Project{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *gardencorev1beta1.Project, _ ...client.GetOption) error {
@@ -188,6 +193,33 @@ var _ = Describe("Reconciler", func() {
 			Expect(result).To(Succeed())
 		})
 
+		It("should mark the project as 'not stale' because a member logged in within the MinimumLifetimeDays", func() {
+			fakeClock.SetTime(time.Date(2024, 1, minimumLifetimeDays+10, 0, 0, 0, 0, time.UTC))
+
+			project.CreationTimestamp = metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+			project.Annotations = map[string]string{v1beta1constants.ProjectLastMemberLoginTimestamp: time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC).Format(time.RFC3339)}
+
+			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project)
+
+			_, result := reconciler.Reconcile(ctx, request)
+			Expect(result).To(Succeed())
+		})
+
+		It("should remove the cleanup stage label when marking the project as 'not stale'", func() {
+			fakeClock.SetTime(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			project.Labels = map[string]string{v1beta1constants.ProjectStaleCleanupStage: v1beta1constants.ProjectStaleCleanupStageLocked}
+			project.Status.LastActivityTimestamp = &metav1.Time{Time: time.Date(1, 1, minimumLifetimeDays-1, 0, 0, 0, 0, time.UTC)}
+
+			projectWithoutLabel := project.DeepCopy()
+			projectWithoutLabel.Labels = map[string]string{}
+			test.EXPECTPatch(gomock.Any(), k8sGardenRuntimeClient, projectWithoutLabel, project, types.MergePatchType)
+			expectNonStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, projectWithoutLabel)
+
+			_, result := reconciler.Reconcile(ctx, request)
+			Expect(result).To(Succeed())
+		})
+
 		Context("project older than the configured MinimumLifetimeDays", func() {
 			BeforeEach(func() {
 				fakeClock.SetTime(time.Date(1, 1, minimumLifetimeDays+1, 1, 0, 0, 0, time.UTC))
@@ -735,6 +767,75 @@ var _ = Describe("Reconciler", func() {
 					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
 
 					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, &staleSinceTimestamp, &staleAutoDeleteTimestamp, fakeClock)
+					expectCleanupStage(k8sGardenRuntimeClient, project, &staleSinceTimestamp, &staleAutoDeleteTimestamp, v1beta1constants.ProjectStaleCleanupStageNotified)
+
+					_, result := reconciler.Reconcile(ctx, request)
+					Expect(result).To(Succeed())
+					Expect(fakeRecorder.Events).To(Receive(ContainSubstring("StaleProjectNotified")))
+				})
+
+				It("should lock the project if the auto delete timestamp is within the lock period", func() {
+					var (
+						staleSinceTimestamp      = metav1.Time{Time: fakeClock.Now().Add(-24 * time.Hour * time.Duration(staleGracePeriodDays))}
+						staleAutoDeleteTimestamp = metav1.Time{Time: staleSinceTimestamp.Add(24 * time.Hour * time.Duration(staleExpirationTimeDays))}
+					)
+					project.Status.StaleSinceTimestamp = &staleSinceTimestamp
+					project.Labels = map[string]string{v1beta1constants.ProjectStaleCleanupStage: v1beta1constants.ProjectStaleCleanupStageNotified}
+
+					cfg.StaleLockPeriodDays = ptr.To(staleExpirationTimeDays - staleGracePeriodDays)
+					reconciler = &Reconciler{Client: k8sGardenRuntimeClient, Config: cfg, Clock: fakeClock, Recorder: fakeRecorder}
+
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialShootMetaList, client.InNamespace(namespaceName), client.Limit(1))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialBackupEntryMetaList, client.InNamespace(namespaceName), client.Limit(1))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialSecretMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialSecretMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialInternalSecretMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialWorkloadIdentityMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
+
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, &staleSinceTimestamp, &staleAutoDeleteTimestamp, fakeClock)
+					expectCleanupStage(k8sGardenRuntimeClient, project, &staleSinceTimestamp, &staleAutoDeleteTimestamp, v1beta1constants.ProjectStaleCleanupStageLocked)
+
+					_, result := reconciler.Reconcile(ctx, request)
+					Expect(result).To(Succeed())
+					Expect(fakeRecorder.Events).To(Receive(ContainSubstring("StaleProjectLocked")))
+				})
+
+				It("should not set the auto delete timestamp because the project opted out of the cleanup", func() {
+					staleSinceTimestamp := metav1.Time{Time: fakeClock.Now().Add(-24 * time.Hour * time.Duration(staleGracePeriodDays))}
+					project.Status.StaleSinceTimestamp = &staleSinceTimestamp
+					project.Annotations = map[string]string{v1beta1constants.ProjectSkipStaleCleanup: "true"}
+
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialShootMetaList, client.InNamespace(namespaceName), client.Limit(1))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialBackupEntryMetaList, client.InNamespace(namespaceName), client.Limit(1))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialSecretMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialSecretMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialInternalSecretMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialWorkloadIdentityMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
+
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, &staleSinceTimestamp, nil, fakeClock)
+
+					_, result := reconciler.Reconcile(ctx, request)
+					Expect(result).To(Succeed())
+					Expect(fakeRecorder.Events).NotTo(Receive())
+				})
+
+				It("should mark the project as stale although a member logged in recently because member logins are not considered", func() {
+					project.Annotations = map[string]string{v1beta1constants.ProjectLastMemberLoginTimestamp: fakeClock.Now().Format(time.RFC3339)}
+
+					cfg.StaleSignals = &controllermanagerconfigv1alpha1.ProjectStaleSignals{MemberLogins: ptr.To(false)}
+					reconciler = &Reconciler{Client: k8sGardenRuntimeClient, Config: cfg, Clock: fakeClock, Recorder: fakeRecorder}
+
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialShootMetaList, client.InNamespace(namespaceName), client.Limit(1))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialBackupEntryMetaList, client.InNamespace(namespaceName), client.Limit(1))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialSecretMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialSecretMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialInternalSecretMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialWorkloadIdentityMetaList, client.InNamespace(namespaceName))
+					k8sGardenRuntimeClient.EXPECT().List(gomock.Any(), partialQuotaMetaList, client.InNamespace(namespaceName))
+
+					expectStaleMarking(k8sGardenRuntimeClient, mockStatusWriter, project, nil, nil, fakeClock)
 
 					_, result := reconciler.Reconcile(ctx, request)
 					Expect(result).To(Succeed())
@@ -802,3 +903,14 @@ func expectStaleMarking(k8sGardenRuntimeClient *mockclient.MockClient, mockStatu
 
 	test.EXPECTStatusPatch(gomock.Any(), mockStatusWriter, projectPatched, project, types.MergePatchType)
 }
+
+func expectCleanupStage(k8sGardenRuntimeClient *mockclient.MockClient, project *gardencorev1beta1.Project, staleSinceTimestamp, staleAutoDeleteTimestamp *metav1.Time, stage string) {
+	projectStale := project.DeepCopy()
+	projectStale.Status.StaleSinceTimestamp = staleSinceTimestamp
+	projectStale.Status.StaleAutoDeleteTimestamp = staleAutoDeleteTimestamp
+
+	projectPatched := projectStale.DeepCopy()
+	metav1.SetMetaDataLabel(&projectPatched.ObjectMeta, v1beta1constants.ProjectStaleCleanupStage, stage)
+
+	test.EXPECTPatch(gomock.Any(), k8sGardenRuntimeClient, projectPatched, projectStale, types.MergePatchType)
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedtemplate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	seedmanagementv1alpha1constants "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles SeedTemplates. It protects SeedTemplates referenced by ManagedSeeds from deletion and triggers
+// a re-rendering of the seed configuration of all referencing ManagedSeeds when a SeedTemplate changes.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.SeedTemplateControllerConfiguration
+	Recorder events.EventRecorder
+
+	// RateLimiter allows limiting exponential backoff for testing purposes
+	RateLimiter workqueue.TypedRateLimiter[reconcile.Request]
+}
+
+// Reconcile performs the main reconciliation logic.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seedTemplate := &seedmanagementv1alpha1.SeedTemplate{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seedTemplate); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	managedSeeds, err := r.referencingManagedSeeds(ctx, seedTemplate)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if seedTemplate.DeletionTimestamp != nil {
+		if !controllerutil.ContainsFinalizer(seedTemplate, gardencorev1beta1.GardenerName) {
+			return reconcile.Result{}, nil
+		}
+
+		// The finalizer will be only removed if there is no ManagedSeed referencing the seed template anymore.
+		if len(managedSeeds) == 0 {
+			log.Info("No ManagedSeeds are referencing SeedTemplate, deletion accepted")
+
+			log.Info("Removing finalizer")
+			if err := controllerutils.RemoveFinalizers(ctx, r.Client, seedTemplate, gardencorev1beta1.GardenerName); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+			}
+
+			return reconcile.Result{}, nil
+		}
+
+		names := make([]string, 0, len(managedSeeds))
+		for _, managedSeed := range managedSeeds {
+			names = append(names, client.ObjectKeyFromObject(&managedSeed).String())
+		}
+
+		r.Recorder.Eventf(seedTemplate, nil, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, gardencorev1beta1.EventActionReconcile, "Cannot delete SeedTemplate, because it is still referenced by the following ManagedSeeds: %+v", names)
+		return reconcile.Result{}, fmt.Errorf("cannot delete SeedTemplate, because it is still referenced by the following ManagedSeeds: %+v", names)
+	}
+
+	if !controllerutil.ContainsFinalizer(seedTemplate, gardencorev1beta1.GardenerName) {
+		log.Info("Adding finalizer")
+		if err := controllerutils.AddFinalizers(ctx, r.Client, seedTemplate, gardencorev1beta1.GardenerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not add finalizer: %w", err)
+		}
+	}
+
+	// Updating the ManagedSeeds makes the ManagedSeed admission plugin render the current seed template into their seed
+	// configuration.
+	generation := strconv.FormatInt(seedTemplate.Generation, 10)
+	for _, managedSeed := range managedSeeds {
+		if managedSeed.DeletionTimestamp != nil || managedSeed.Annotations[seedmanagementv1alpha1constants.AnnotationSeedTemplateGeneration] == generation {
+			continue
+		}
+
+		log.Info("Rendering SeedTemplate into ManagedSeed", "managedSeed", client.ObjectKeyFromObject(&managedSeed), "generation", seedTemplate.Generation)
+		patch := client.MergeFrom(managedSeed.DeepCopy())
+		metav1.SetMetaDataAnnotation(&managedSeed.ObjectMeta, seedmanagementv1alpha1constants.AnnotationSeedTemplateGeneration, generation)
+		if err := r.Client.Patch(ctx, &managedSeed, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed rendering SeedTemplate into ManagedSeed %s: %w", client.ObjectKeyFromObject(&managedSeed), err)
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) referencingManagedSeeds(ctx context.Context, seedTemplate *seedmanagementv1alpha1.SeedTemplate) ([]seedmanagementv1alpha1.ManagedSeed, error) {
+	managedSeedList := &seedmanagementv1alpha1.ManagedSeedList{}
+	if err := r.Client.List(ctx, managedSeedList); err != nil {
+		return nil, fmt.Errorf("failed listing ManagedSeeds: %w", err)
+	}
+
+	var managedSeeds []seedmanagementv1alpha1.ManagedSeed
+	for _, managedSeed := range managedSeedList.Items {
+		if ref := managedSeed.Spec.Gardenlet.SeedTemplateRef; ref != nil && ref.Name == seedTemplate.Name {
+			managedSeeds = append(managedSeeds, managedSeed)
+		}
+	}
+
+	return managedSeeds, nil
+}
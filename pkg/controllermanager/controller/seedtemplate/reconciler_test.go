@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedtemplate_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seedtemplate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	const finalizerName = "gardener"
+
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler reconcile.Reconciler
+
+		seedTemplate *seedmanagementv1alpha1.SeedTemplate
+		managedSeed  *seedmanagementv1alpha1.ManagedSeed
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{Client: fakeClient, Recorder: &events.FakeRecorder{}}
+
+		seedTemplate = &seedmanagementv1alpha1.SeedTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "template",
+				Generation: 2,
+			},
+		}
+		managedSeed = &seedmanagementv1alpha1.ManagedSeed{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "managedseed",
+				Namespace: "garden",
+			},
+			Spec: seedmanagementv1alpha1.ManagedSeedSpec{
+				Gardenlet: seedmanagementv1alpha1.GardenletConfig{
+					SeedTemplateRef: &seedmanagementv1alpha1.SeedTemplateReference{Name: seedTemplate.Name},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seedTemplate)}
+	})
+
+	It("should return nil because object is not found", func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(result).To(Equal(reconcile.Result{}))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when deletion timestamp is not set", func() {
+		BeforeEach(func() {
+			Expect(fakeClient.Create(ctx, seedTemplate)).To(Succeed())
+		})
+
+		It("should ensure the finalizer", func() {
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seedTemplate), seedTemplate)).To(Succeed())
+			Expect(seedTemplate.GetFinalizers()).To(ConsistOf(finalizerName))
+		})
+
+		It("should annotate the referencing ManagedSeeds with the generation of the SeedTemplate", func() {
+			otherManagedSeed := managedSeed.DeepCopy()
+			otherManagedSeed.Name = "other"
+			otherManagedSeed.Spec.Gardenlet.SeedTemplateRef.Name = "other"
+
+			Expect(fakeClient.Create(ctx, managedSeed)).To(Succeed())
+			Expect(fakeClient.Create(ctx, otherManagedSeed)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedSeed), managedSeed)).To(Succeed())
+			Expect(managedSeed.Annotations).To(HaveKeyWithValue("seedmanagement.gardener.cloud/seed-template-generation", "2"))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(otherManagedSeed), otherManagedSeed)).To(Succeed())
+			Expect(otherManagedSeed.Annotations).NotTo(HaveKey("seedmanagement.gardener.cloud/seed-template-generation"))
+		})
+	})
+
+	Context("when deletion timestamp is set", func() {
+		BeforeEach(func() {
+			seedTemplate.Finalizers = []string{finalizerName}
+			Expect(fakeClient.Create(ctx, seedTemplate)).To(Succeed())
+			Expect(fakeClient.Delete(ctx, seedTemplate)).To(Succeed())
+		})
+
+		It("should remove the finalizer if the SeedTemplate is not referenced", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seedTemplate), seedTemplate)).To(BeNotFoundError())
+		})
+
+		It("should keep the finalizer if the SeedTemplate is still referenced", func() {
+			Expect(fakeClient.Create(ctx, managedSeed)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring("still referenced by the following ManagedSeeds: [garden/managedseed]")))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seedTemplate), seedTemplate)).To(Succeed())
+			Expect(seedTemplate.DeletionTimestamp.Time).To(BeTemporally("<=", time.Now()))
+			Expect(seedTemplate.GetFinalizers()).To(ConsistOf(finalizerName))
+		})
+	})
+})
@@ -11,6 +11,7 @@ import (
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/conditions"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/forceupgrade"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/migration"
@@ -35,11 +36,20 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 	}
 
 	if err := (&maintenance.Reconciler{
-		Config: cfg.Controllers.ShootMaintenance,
+		Config:            cfg.Controllers.ShootMaintenance,
+		DeferForceUpdates: cfg.Controllers.ShootForceUpgrade != nil,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding maintenance reconciler: %w", err)
 	}
 
+	if config := cfg.Controllers.ShootForceUpgrade; config != nil {
+		if err := (&forceupgrade.Reconciler{
+			Config: *config,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding force-upgrade reconciler: %w", err)
+		}
+	}
+
 	if err := (&quota.Reconciler{
 		Config: *cfg.Controllers.ShootQuota,
 	}).AddToManager(mgr); err != nil {
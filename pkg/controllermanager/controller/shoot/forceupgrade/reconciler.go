@@ -0,0 +1,330 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package forceupgrade
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance/helper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler periodically checks all Shoots for expired Kubernetes versions. It notifies the project members about the
+// upcoming force upgrade of affected Shoots and triggers their maintenance in waves, i.e., only a limited number of
+// Shoots per Seed is upgraded at the same time.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.ShootForceUpgradeControllerConfiguration
+	Clock    clock.Clock
+	Recorder events.EventRecorder
+}
+
+type candidate struct {
+	shoot      *gardencorev1beta1.Shoot
+	notifiedAt time.Time
+}
+
+// Reconcile performs the main reconciliation logic.
+func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		now                 = r.Clock.Now()
+		withinBusinessHours = r.withinBusinessHours(now)
+		leadTimes           = make(map[string]time.Duration)
+		seedToInFlight      = make(map[string]int)
+		seedToCandidates    = make(map[string][]candidate)
+	)
+
+	for _, shoot := range shootList.Items {
+		if shoot.DeletionTimestamp != nil {
+			continue
+		}
+
+		var (
+			shootLog = log.WithValues("shoot", client.ObjectKeyFromObject(&shoot))
+			seedName = ptr.Deref(shoot.Spec.SeedName, "")
+		)
+
+		if hasMaintainNowAnnotation(&shoot) {
+			seedToInFlight[seedName]++
+			continue
+		}
+
+		cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, &shoot)
+		if err != nil {
+			shootLog.Error(err, "Failed reading cloud profile, skipping")
+			continue
+		}
+
+		expirationTime := earliestExpirationTime(&shoot, cloudProfile)
+		notifiedAtValue, notified := shoot.Annotations[v1beta1constants.AnnotationShootForceUpgradeNotifiedAt]
+
+		if expirationTime == nil {
+			if !notified {
+				continue
+			}
+			// The force upgrade of this shoot was triggered and is still being rolled out, hence it still counts for the
+			// batch of its seed.
+			if upgradeInProgress(&shoot) {
+				seedToInFlight[seedName]++
+				continue
+			}
+			if err := r.patchNotifiedAtAnnotation(ctx, &shoot, nil); err != nil {
+				return reconcile.Result{}, err
+			}
+			continue
+		}
+
+		leadTime, ok := leadTimes[shoot.Namespace]
+		if !ok {
+			leadTime = r.notificationLeadTime(ctx, shootLog, shoot.Namespace)
+			leadTimes[shoot.Namespace] = leadTime
+		}
+
+		if !notified {
+			if now.Before(expirationTime.Add(-leadTime)) {
+				continue
+			}
+			if err := r.notify(ctx, shootLog, &shoot, *expirationTime, leadTime, now); err != nil {
+				return reconcile.Result{}, err
+			}
+			continue
+		}
+
+		notifiedAt, err := time.Parse(time.RFC3339, notifiedAtValue)
+		if err != nil {
+			shootLog.Info("Failed parsing force upgrade notification time, notifying again", "reason", err.Error())
+			if err := r.notify(ctx, shootLog, &shoot, *expirationTime, leadTime, now); err != nil {
+				return reconcile.Result{}, err
+			}
+			continue
+		}
+
+		if now.Before(*expirationTime) || now.Before(notifiedAt.Add(leadTime)) {
+			continue
+		}
+
+		if withinBusinessHours && v1beta1helper.IsHAControlPlaneConfigured(&shoot) {
+			shootLog.Info("Shoot has a highly available control plane, postponing force upgrade until business hours are over")
+			continue
+		}
+
+		seedToCandidates[seedName] = append(seedToCandidates[seedName], candidate{shoot: shoot.DeepCopy(), notifiedAt: notifiedAt})
+	}
+
+	for _, seedName := range slices.Sorted(maps.Keys(seedToCandidates)) {
+		candidates := seedToCandidates[seedName]
+		// Shoots whose project members were notified first are upgraded first.
+		slices.SortFunc(candidates, func(a, b candidate) int {
+			if c := a.notifiedAt.Compare(b.notifiedAt); c != 0 {
+				return c
+			}
+			return strings.Compare(client.ObjectKeyFromObject(a.shoot).String(), client.ObjectKeyFromObject(b.shoot).String())
+		})
+
+		available := ptr.Deref(r.Config.BatchSize, 0) - seedToInFlight[seedName]
+		for i := 0; available > 0 && i < len(candidates); i++ {
+			shootLog := log.WithValues("shoot", client.ObjectKeyFromObject(candidates[i].shoot))
+
+			// The `maintain` operation annotation bypasses maintenance freezes, hence they must be respected before
+			// triggering the force upgrade.
+			freeze, err := helper.FindActiveMaintenanceFreeze(ctx, r.Client, now, candidates[i].shoot)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed checking for active maintenance freezes: %w", err)
+			}
+			if freeze != nil {
+				if err := helper.DeferMaintenance(ctx, r.Client, r.Recorder, now, candidates[i].shoot, freeze); err != nil {
+					return reconcile.Result{}, err
+				}
+				shootLog.Info("Deferred force upgrade due to active maintenance freeze", "maintenanceFreeze", freeze.Name)
+				continue
+			}
+
+			if err := r.trigger(ctx, shootLog, candidates[i].shoot); err != nil {
+				return reconcile.Result{}, err
+			}
+			available--
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) notify(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, expirationTime time.Time, leadTime time.Duration, now time.Time) error {
+	upgradeTime := expirationTime
+	if earliest := now.Add(leadTime); earliest.After(upgradeTime) {
+		upgradeTime = earliest
+	}
+
+	if err := r.patchNotifiedAtAnnotation(ctx, shoot, &now); err != nil {
+		return err
+	}
+
+	log.Info("Notifying about upcoming force upgrade", "expirationTime", expirationTime, "upgradeTime", upgradeTime)
+	r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, v1beta1constants.EventForceUpgradeScheduled, gardencorev1beta1.EventActionReconcile,
+		"Kubernetes version expires at %s, the Shoot will be force upgraded to a non-expired version at %s at the earliest unless it is upgraded before",
+		expirationTime.UTC().Format(time.RFC3339), upgradeTime.UTC().Format(time.RFC3339))
+	return nil
+}
+
+func (r *Reconciler) trigger(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	operations := append(v1beta1helper.GetShootGardenerOperations(shoot.Annotations), v1beta1constants.ShootOperationMaintain)
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, strings.Join(operations, v1beta1constants.GardenerOperationsSeparator))
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed triggering force upgrade of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	log.Info("Triggered force upgrade")
+	r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, v1beta1constants.EventForceUpgradeTriggered, gardencorev1beta1.EventActionReconcile,
+		"Force upgrade to a non-expired Kubernetes version was triggered")
+	return nil
+}
+
+func (r *Reconciler) patchNotifiedAtAnnotation(ctx context.Context, shoot *gardencorev1beta1.Shoot, notifiedAt *time.Time) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	if notifiedAt == nil {
+		delete(shoot.Annotations, v1beta1constants.AnnotationShootForceUpgradeNotifiedAt)
+	} else {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootForceUpgradeNotifiedAt, notifiedAt.UTC().Format(time.RFC3339))
+	}
+
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed patching annotation %s of shoot %s: %w", v1beta1constants.AnnotationShootForceUpgradeNotifiedAt, client.ObjectKeyFromObject(shoot), err)
+	}
+	return nil
+}
+
+// notificationLeadTime returns the notification lead time for Shoots in the given namespace. Projects can only increase
+// the configured lead time, and only up to the configured maximum.
+func (r *Reconciler) notificationLeadTime(ctx context.Context, log logr.Logger, namespace string) time.Duration {
+	leadTime := r.Config.NotificationLeadTime.Duration
+
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, namespace)
+	if err != nil {
+		log.Info("Failed reading project, using default notification lead time", "reason", err.Error())
+		return leadTime
+	}
+
+	value, ok := project.Annotations[v1beta1constants.AnnotationShootForceUpgradeNotificationLeadTime]
+	if !ok {
+		return leadTime
+	}
+
+	projectLeadTime, err := time.ParseDuration(value)
+	if err != nil {
+		log.Info("Failed parsing notification lead time of project, using default", "project", project.Name, "reason", err.Error())
+		return leadTime
+	}
+
+	return min(max(projectLeadTime, leadTime), r.Config.MaxNotificationLeadTime.Duration)
+}
+
+func (r *Reconciler) withinBusinessHours(now time.Time) bool {
+	businessHours := r.Config.BusinessHours
+	if businessHours == nil {
+		return false
+	}
+
+	location, err := time.LoadLocation(ptr.Deref(businessHours.Location, "UTC"))
+	if err != nil {
+		return false
+	}
+
+	now = now.In(location)
+	if now.Weekday() == time.Saturday || now.Weekday() == time.Sunday {
+		return false
+	}
+
+	begin, err := time.Parse("15:04", businessHours.Begin)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse("15:04", businessHours.End)
+	if err != nil {
+		return false
+	}
+
+	minutes := now.Hour()*60 + now.Minute()
+	return minutes >= begin.Hour()*60+begin.Minute() && minutes < end.Hour()*60+end.Minute()
+}
+
+// earliestExpirationTime returns the earliest expiration time of the Kubernetes versions used by the control plane and
+// the worker pools of the given Shoot. It returns nil if none of the versions has an expiration time. Versions which do
+// not exist in the CloudProfile are ignored since they are updated in the next maintenance time window anyway.
+func earliestExpirationTime(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) *time.Time {
+	versions := []string{shoot.Spec.Kubernetes.Version}
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			versions = append(versions, *worker.Kubernetes.Version)
+		}
+	}
+
+	var earliest *time.Time
+	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
+		if !slices.Contains(versions, version.Version) {
+			continue
+		}
+		if expirationTime := versionExpirationTime(version); expirationTime != nil && (earliest == nil || expirationTime.Before(*earliest)) {
+			earliest = expirationTime
+		}
+	}
+
+	return earliest
+}
+
+func versionExpirationTime(version gardencorev1beta1.ExpirableVersion) *time.Time {
+	if len(version.Lifecycle) == 0 {
+		if version.ExpirationDate == nil {
+			return nil
+		}
+		return &version.ExpirationDate.Time
+	}
+
+	for _, stage := range version.Lifecycle {
+		if stage.Classification == gardencorev1beta1.ClassificationExpired {
+			if stage.StartTime == nil {
+				return &time.Time{}
+			}
+			return &stage.StartTime.Time
+		}
+	}
+
+	return nil
+}
+
+func upgradeInProgress(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Generation != shoot.Status.ObservedGeneration ||
+		(shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateProcessing)
+}
+
+func hasMaintainNowAnnotation(shoot *gardencorev1beta1.Shoot) bool {
+	return slices.Contains(v1beta1helper.GetShootGardenerOperations(shoot.Annotations), v1beta1constants.ShootOperationMaintain)
+}
@@ -0,0 +1,398 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package forceupgrade_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/forceupgrade"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *events.FakeRecorder
+		reconciler *Reconciler
+
+		namespace = "garden-foo"
+		project   *gardencorev1beta1.Project
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}, &gardencorev1beta1.MaintenanceFreeze{}).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+		// Wednesday evening, i.e., outside of the business hours.
+		fakeClock = testclock.NewFakeClock(time.Date(2025, time.June, 11, 20, 0, 0, 0, time.UTC))
+		recorder = events.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ShootForceUpgradeControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+				BatchSize:               ptr.To(2),
+				NotificationLeadTime:    &metav1.Duration{Duration: 168 * time.Hour},
+				MaxNotificationLeadTime: &metav1.Duration{Duration: 720 * time.Hour},
+				BusinessHours: &controllermanagerconfigv1alpha1.BusinessHours{
+					Begin:    "08:00",
+					End:      "18:00",
+					Location: ptr.To("UTC"),
+				},
+			},
+			Clock:    fakeClock,
+			Recorder: recorder,
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.31.0"},
+						{Version: "1.30.0", ExpirationDate: &metav1.Time{Time: fakeClock.Now().Add(48 * time.Hour)}},
+						{Version: "1.29.0", ExpirationDate: &metav1.Time{Time: fakeClock.Now().Add(-time.Hour)}},
+						{Version: "1.28.0", Lifecycle: []gardencorev1beta1.LifecycleStage{
+							{Classification: gardencorev1beta1.ClassificationSupported},
+							{Classification: gardencorev1beta1.ClassificationExpired, StartTime: &metav1.Time{Time: fakeClock.Now().Add(-2 * time.Hour)}},
+						}},
+						{Version: "1.27.0", ExpirationDate: &metav1.Time{Time: fakeClock.Now().Add(400 * time.Hour)}},
+					},
+				},
+			},
+		})).To(Succeed())
+	})
+
+	createShoot := func(name, seedName, version string, notifiedAt *time.Time) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("profile"),
+				Kubernetes:       gardencorev1beta1.Kubernetes{Version: version},
+				SeedName:         &seedName,
+			},
+		}
+		if notifiedAt != nil {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootForceUpgradeNotifiedAt, notifiedAt.UTC().Format(time.RFC3339))
+		}
+		ExpectWithOffset(1, fakeClient.Create(ctx, shoot)).To(Succeed())
+		return shoot
+	}
+
+	reconcileAndExpectSuccess := func() {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, result).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+	}
+
+	expectTriggered := func(shoot *gardencorev1beta1.Shoot, triggered bool) {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		if triggered {
+			ExpectWithOffset(1, shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain))
+		} else {
+			ExpectWithOffset(1, shoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+		}
+	}
+
+	Context("notification", func() {
+		It("should not notify if the Kubernetes version does not expire", func() {
+			shoot := createShoot("shoot", "seed", "1.31.0", nil)
+
+			reconcileAndExpectSuccess()
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(BeEmpty())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not notify if the Kubernetes version expires after the notification lead time", func() {
+			shoot := createShoot("shoot", "seed", "1.27.0", nil)
+
+			reconcileAndExpectSuccess()
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(BeEmpty())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should notify if the Kubernetes version expires within the notification lead time", func() {
+			shoot := createShoot("shoot", "seed", "1.30.0", nil)
+
+			reconcileAndExpectSuccess()
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationShootForceUpgradeNotifiedAt, "2025-06-11T20:00:00Z"))
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+
+			var event string
+			Expect(recorder.Events).To(Receive(&event))
+			Expect(event).To(ContainSubstring(v1beta1constants.EventForceUpgradeScheduled))
+			Expect(event).To(ContainSubstring("expires at 2025-06-13T20:00:00Z"))
+			Expect(event).To(ContainSubstring("at 2025-06-18T20:00:00Z at the earliest"))
+		})
+
+		It("should notify if the Kubernetes version of a worker pool expires within the notification lead time", func() {
+			shoot := createShoot("shoot", "seed", "1.31.0", nil)
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Name: "worker", Kubernetes: &gardencorev1beta1.WorkerKubernetes{Version: ptr.To("1.30.0")}}}
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			reconcileAndExpectSuccess()
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKey(v1beta1constants.AnnotationShootForceUpgradeNotifiedAt))
+		})
+
+		It("should respect the notification lead time configured for the project", func() {
+			metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.AnnotationShootForceUpgradeNotificationLeadTime, "500h")
+			Expect(fakeClient.Update(ctx, project)).To(Succeed())
+			shoot := createShoot("shoot", "seed", "1.27.0", nil)
+
+			reconcileAndExpectSuccess()
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKey(v1beta1constants.AnnotationShootForceUpgradeNotifiedAt))
+		})
+
+		It("should cap the notification lead time configured for the project", func() {
+			metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.AnnotationShootForceUpgradeNotificationLeadTime, "1000h")
+			Expect(fakeClient.Update(ctx, project)).To(Succeed())
+			reconciler.Config.MaxNotificationLeadTime.Duration = 300 * time.Hour
+			shoot := createShoot("shoot", "seed", "1.27.0", nil)
+
+			reconcileAndExpectSuccess()
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(BeEmpty())
+		})
+
+		It("should not allow projects to decrease the notification lead time", func() {
+			metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.AnnotationShootForceUpgradeNotificationLeadTime, "1h")
+			Expect(fakeClient.Update(ctx, project)).To(Succeed())
+			shoot := createShoot("shoot", "seed", "1.30.0", nil)
+
+			reconcileAndExpectSuccess()
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKey(v1beta1constants.AnnotationShootForceUpgradeNotifiedAt))
+		})
+	})
+
+	Context("trigger", func() {
+		It("should notify but not trigger the force upgrade if the Kubernetes version is expired but the project members were not notified", func() {
+			shoot := createShoot("shoot", "seed", "1.29.0", nil)
+
+			reconcileAndExpectSuccess()
+
+			expectTriggered(shoot, false)
+			Expect(shoot.Annotations).To(HaveKey(v1beta1constants.AnnotationShootForceUpgradeNotifiedAt))
+		})
+
+		It("should not trigger the force upgrade if the notification lead time has not passed", func() {
+			shoot := createShoot("shoot", "seed", "1.29.0", ptr.To(fakeClock.Now().Add(-24*time.Hour)))
+
+			reconcileAndExpectSuccess()
+
+			expectTriggered(shoot, false)
+		})
+
+		It("should trigger the force upgrade if the Kubernetes version is expired and the notification lead time has passed", func() {
+			shoot := createShoot("shoot", "seed", "1.28.0", ptr.To(fakeClock.Now().Add(-200*time.Hour)))
+
+			reconcileAndExpectSuccess()
+
+			expectTriggered(shoot, true)
+			var event string
+			Expect(recorder.Events).To(Receive(&event))
+			Expect(event).To(ContainSubstring(v1beta1constants.EventForceUpgradeTriggered))
+		})
+
+		It("should trigger at most the batch size of force upgrades per seed", func() {
+			notifiedAt := fakeClock.Now().Add(-200 * time.Hour)
+			shoot1 := createShoot("shoot1", "seed-a", "1.29.0", ptr.To(notifiedAt.Add(time.Minute)))
+			shoot2 := createShoot("shoot2", "seed-a", "1.29.0", &notifiedAt)
+			shoot3 := createShoot("shoot3", "seed-a", "1.29.0", &notifiedAt)
+			shoot4 := createShoot("shoot4", "seed-b", "1.29.0", &notifiedAt)
+
+			reconcileAndExpectSuccess()
+
+			expectTriggered(shoot1, false)
+			expectTriggered(shoot2, true)
+			expectTriggered(shoot3, true)
+			expectTriggered(shoot4, true)
+		})
+
+		It("should consider force upgrades which are still in progress", func() {
+			notifiedAt := fakeClock.Now().Add(-200 * time.Hour)
+			inProgress := createShoot("in-progress", "seed", "1.31.0", &notifiedAt)
+			inProgress.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateProcessing}
+			Expect(fakeClient.Status().Update(ctx, inProgress)).To(Succeed())
+			maintaining := createShoot("maintaining", "seed", "1.29.0", &notifiedAt)
+			metav1.SetMetaDataAnnotation(&maintaining.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)
+			Expect(fakeClient.Update(ctx, maintaining)).To(Succeed())
+			shoot := createShoot("shoot", "seed", "1.29.0", &notifiedAt)
+
+			reconcileAndExpectSuccess()
+
+			expectTriggered(shoot, false)
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(inProgress), inProgress)).To(Succeed())
+			Expect(inProgress.Annotations).To(HaveKey(v1beta1constants.AnnotationShootForceUpgradeNotifiedAt))
+		})
+
+		It("should remove the notification annotation once the force upgrade is completed", func() {
+			shoot := createShoot("shoot", "seed", "1.31.0", ptr.To(fakeClock.Now().Add(-200*time.Hour)))
+
+			reconcileAndExpectSuccess()
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(BeEmpty())
+		})
+
+		It("should preserve other operations", func() {
+			shoot := createShoot("shoot", "seed", "1.29.0", ptr.To(fakeClock.Now().Add(-200*time.Hour)))
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationRotateSSHKeypair)
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			reconcileAndExpectSuccess()
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationRotateSSHKeypair+";"+v1beta1constants.ShootOperationMaintain))
+		})
+
+		Context("maintenance freeze", func() {
+			createFreeze := func(projectSelector *metav1.LabelSelector) *gardencorev1beta1.MaintenanceFreeze {
+				freeze := &gardencorev1beta1.MaintenanceFreeze{
+					ObjectMeta: metav1.ObjectMeta{Name: "freeze"},
+					Spec: gardencorev1beta1.MaintenanceFreezeSpec{
+						Windows: []gardencorev1beta1.MaintenanceFreezeWindow{{
+							Begin: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+							End:   metav1.NewTime(fakeClock.Now().Add(time.Hour)),
+						}},
+						ProjectSelector: projectSelector,
+					},
+				}
+				ExpectWithOffset(1, fakeClient.Create(ctx, freeze)).To(Succeed())
+				return freeze
+			}
+
+			It("should defer the force upgrade during an active maintenance freeze", func() {
+				freeze := createFreeze(nil)
+				shoot := createShoot("shoot", "seed", "1.29.0", ptr.To(fakeClock.Now().Add(-200*time.Hour)))
+
+				reconcileAndExpectSuccess()
+
+				expectTriggered(shoot, false)
+				Expect(shoot.Status.LastMaintenance.Description).To(Equal(`Maintenance deferred due to maintenance freeze "freeze"`))
+				Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStatePending))
+				Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ShootMaintenanceDeferred)))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(freeze), freeze)).To(Succeed())
+				Expect(freeze.Status.DeferredShoots).To(ConsistOf(And(
+					HaveField("Namespace", namespace),
+					HaveField("Name", "shoot"),
+					HaveField("LastDeferredTime.Time", BeTemporally("==", fakeClock.Now())),
+				)))
+			})
+
+			It("should trigger the force upgrade if the maintenance freeze does not select the shoot", func() {
+				createFreeze(&metav1.LabelSelector{MatchLabels: map[string]string{"frozen": "true"}})
+				shoot := createShoot("shoot", "seed", "1.29.0", ptr.To(fakeClock.Now().Add(-200*time.Hour)))
+
+				reconcileAndExpectSuccess()
+
+				expectTriggered(shoot, true)
+			})
+
+			It("should not count deferred force upgrades for the batch of the seed", func() {
+				createFreeze(&metav1.LabelSelector{MatchLabels: map[string]string{"frozen": "true"}})
+				frozenNamespace := "garden-frozen"
+				Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+					ObjectMeta: metav1.ObjectMeta{Name: "frozen", Labels: map[string]string{"frozen": "true"}},
+					Spec:       gardencorev1beta1.ProjectSpec{Namespace: &frozenNamespace},
+				})).To(Succeed())
+
+				notifiedAt := fakeClock.Now().Add(-200 * time.Hour)
+				frozen := createShoot("frozen", "seed", "1.29.0", &notifiedAt)
+				Expect(fakeClient.Delete(ctx, frozen)).To(Succeed())
+				frozen.ResourceVersion, frozen.Namespace = "", frozenNamespace
+				Expect(fakeClient.Create(ctx, frozen)).To(Succeed())
+				shoot1 := createShoot("shoot1", "seed", "1.29.0", ptr.To(notifiedAt.Add(time.Minute)))
+				shoot2 := createShoot("shoot2", "seed", "1.29.0", ptr.To(notifiedAt.Add(time.Minute)))
+
+				reconcileAndExpectSuccess()
+
+				expectTriggered(frozen, false)
+				expectTriggered(shoot1, true)
+				expectTriggered(shoot2, true)
+			})
+		})
+
+		Context("business hours", func() {
+			var shoot *gardencorev1beta1.Shoot
+
+			BeforeEach(func() {
+				shoot = createShoot("shoot", "seed", "1.29.0", ptr.To(fakeClock.Now().Add(-200*time.Hour)))
+				shoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{HighAvailability: &gardencorev1beta1.HighAvailability{FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone}}}
+				Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+			})
+
+			It("should not trigger the force upgrade of HA shoots during business hours", func() {
+				fakeClock.SetTime(time.Date(2025, time.June, 11, 10, 0, 0, 0, time.UTC))
+
+				reconcileAndExpectSuccess()
+
+				expectTriggered(shoot, false)
+			})
+
+			It("should respect the location of the business hours", func() {
+				reconciler.Config.BusinessHours.Location = ptr.To("America/New_York")
+				fakeClock.SetTime(time.Date(2025, time.June, 11, 20, 0, 0, 0, time.UTC))
+
+				reconcileAndExpectSuccess()
+
+				expectTriggered(shoot, false)
+			})
+
+			It("should trigger the force upgrade of HA shoots outside of business hours", func() {
+				reconcileAndExpectSuccess()
+
+				expectTriggered(shoot, true)
+			})
+
+			It("should trigger the force upgrade of HA shoots on weekends", func() {
+				fakeClock.SetTime(time.Date(2025, time.June, 14, 10, 0, 0, 0, time.UTC))
+
+				reconcileAndExpectSuccess()
+
+				expectTriggered(shoot, true)
+			})
+		})
+	})
+})
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// MaxDeferredShoots is the maximum number of Shoots reported in the status of a MaintenanceFreeze. If more Shoots are
+// deferred, only the most recently deferred ones are reported.
+const MaxDeferredShoots = 100
+
+// FindActiveMaintenanceFreeze returns the first (by name) MaintenanceFreeze which is currently active and selects the
+// given Shoot. It returns nil if there is no such MaintenanceFreeze.
+// The deferred Shoots reported in the status of MaintenanceFreezes which are no longer active are removed on the way.
+func FindActiveMaintenanceFreeze(ctx context.Context, c client.Client, now time.Time, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.MaintenanceFreeze, error) {
+	maintenanceFreezeList := &gardencorev1beta1.MaintenanceFreezeList{}
+	if err := c.List(ctx, maintenanceFreezeList); err != nil {
+		return nil, fmt.Errorf("failed listing MaintenanceFreezes: %w", err)
+	}
+
+	var activeFreezes []gardencorev1beta1.MaintenanceFreeze
+	for _, freeze := range maintenanceFreezeList.Items {
+		if v1beta1helper.IsMaintenanceFreezeActive(&freeze, now) {
+			activeFreezes = append(activeFreezes, freeze)
+			continue
+		}
+
+		if len(freeze.Status.DeferredShoots) > 0 {
+			if err := clearDeferredShoots(ctx, c, &freeze); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(activeFreezes) == 0 {
+		return nil, nil
+	}
+
+	slices.SortFunc(activeFreezes, func(a, b gardencorev1beta1.MaintenanceFreeze) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var projectLabels, seedLabels labels.Set
+
+	for _, freeze := range activeFreezes {
+		if freeze.Spec.ProjectSelector != nil {
+			if projectLabels == nil {
+				project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, c, shoot.Namespace)
+				if err != nil {
+					return nil, fmt.Errorf("failed reading Project for namespace %q: %w", shoot.Namespace, err)
+				}
+				projectLabels = labels.Set(project.Labels)
+			}
+
+			matches, err := selectorMatches(freeze.Spec.ProjectSelector, projectLabels)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing project selector of MaintenanceFreeze %q: %w", freeze.Name, err)
+			}
+			if !matches {
+				continue
+			}
+		}
+
+		if freeze.Spec.SeedSelector != nil {
+			// Shoots which are not yet scheduled to a Seed cannot be selected by a seed selector.
+			if shoot.Spec.SeedName == nil {
+				continue
+			}
+
+			if seedLabels == nil {
+				seed := &gardencorev1beta1.Seed{}
+				if err := c.Get(ctx, client.ObjectKey{Name: *shoot.Spec.SeedName}, seed); err != nil {
+					return nil, fmt.Errorf("failed reading Seed %q: %w", *shoot.Spec.SeedName, err)
+				}
+				seedLabels = labels.Set(seed.Labels)
+			}
+
+			matches, err := selectorMatches(freeze.Spec.SeedSelector, seedLabels)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing seed selector of MaintenanceFreeze %q: %w", freeze.Name, err)
+			}
+			if !matches {
+				continue
+			}
+		}
+
+		return &freeze, nil
+	}
+
+	return nil, nil
+}
+
+func selectorMatches(labelSelector *metav1.LabelSelector, set labels.Set) (bool, error) {
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(set), nil
+}
+
+// DeferMaintenance reports in the status of the given Shoot and MaintenanceFreeze that the maintenance of the Shoot was
+// deferred.
+func DeferMaintenance(ctx context.Context, c client.Client, recorder events.EventRecorder, now time.Time, shoot *gardencorev1beta1.Shoot, freeze *gardencorev1beta1.MaintenanceFreeze) error {
+	deferredTime := metav1.Time{Time: now}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
+		Description:   fmt.Sprintf("Maintenance deferred due to maintenance freeze %q", freeze.Name),
+		TriggeredTime: deferredTime,
+		State:         gardencorev1beta1.LastOperationStatePending,
+	}
+	if err := c.Status().Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed patching last maintenance status of Shoot: %w", err)
+	}
+
+	recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootMaintenanceDeferred, gardencorev1beta1.EventActionReconcile, "Maintenance deferred due to maintenance freeze %q", freeze.Name)
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(freeze), freeze); err != nil {
+			return err
+		}
+
+		patch := client.MergeFromWithOptions(freeze.DeepCopy(), client.MergeFromWithOptimisticLock{})
+
+		// Entries from previous windows of the MaintenanceFreeze are outdated.
+		windowBegin := activeWindowBegin(freeze, now)
+		deferredShoots := slices.DeleteFunc(freeze.Status.DeferredShoots, func(deferredShoot gardencorev1beta1.DeferredShootMaintenance) bool {
+			return deferredShoot.LastDeferredTime.Time.Before(windowBegin) ||
+				(deferredShoot.Namespace == shoot.Namespace && deferredShoot.Name == shoot.Name)
+		})
+		deferredShoots = append(deferredShoots, gardencorev1beta1.DeferredShootMaintenance{
+			Namespace:        shoot.Namespace,
+			Name:             shoot.Name,
+			LastDeferredTime: deferredTime,
+		})
+
+		if len(deferredShoots) > MaxDeferredShoots {
+			slices.SortStableFunc(deferredShoots, func(a, b gardencorev1beta1.DeferredShootMaintenance) int {
+				return b.LastDeferredTime.Compare(a.LastDeferredTime.Time)
+			})
+			deferredShoots = deferredShoots[:MaxDeferredShoots]
+		}
+		freeze.Status.DeferredShoots = deferredShoots
+
+		return c.Status().Patch(ctx, freeze, patch)
+	}); err != nil {
+		return fmt.Errorf("failed reporting deferred maintenance in status of MaintenanceFreeze %q: %w", freeze.Name, err)
+	}
+
+	return nil
+}
+
+// activeWindowBegin returns the earliest begin of the windows of the given MaintenanceFreeze which are active at the
+// given time.
+func activeWindowBegin(freeze *gardencorev1beta1.MaintenanceFreeze, now time.Time) time.Time {
+	begin := now
+	for _, window := range freeze.Spec.Windows {
+		if !now.Before(window.Begin.Time) && now.Before(window.End.Time) && window.Begin.Time.Before(begin) {
+			begin = window.Begin.Time
+		}
+	}
+	return begin
+}
+
+// clearDeferredShoots removes the deferred Shoots from the status of the given MaintenanceFreeze. Conflicts are ignored
+// since they are caused by concurrent workers doing the same.
+func clearDeferredShoots(ctx context.Context, c client.Client, freeze *gardencorev1beta1.MaintenanceFreeze) error {
+	patch := client.MergeFromWithOptions(freeze.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	freeze.Status.DeferredShoots = nil
+	if err := c.Status().Patch(ctx, freeze, patch); client.IgnoreNotFound(err) != nil && !apierrors.IsConflict(err) {
+		return fmt.Errorf("failed clearing deferred Shoots in status of MaintenanceFreeze %q: %w", freeze.Name, err)
+	}
+	return nil
+}
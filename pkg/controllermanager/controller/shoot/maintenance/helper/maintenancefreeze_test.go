@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance/helper"
+)
+
+var _ = Describe("MaintenanceFreeze", func() {
+	Describe("#FindActiveMaintenanceFreeze", func() {
+		var (
+			ctx        context.Context
+			fakeClient client.Client
+			fakeClock  *testclock.FakeClock
+
+			project *gardencorev1beta1.Project
+			seed    *gardencorev1beta1.Seed
+			shoot   *gardencorev1beta1.Shoot
+
+			activeWindow, pastWindow gardencorev1beta1.MaintenanceFreezeWindow
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				WithStatusSubresource(&gardencorev1beta1.MaintenanceFreeze{}).
+				Build()
+			fakeClock = testclock.NewFakeClock(time.Date(2026, 12, 24, 12, 0, 0, 0, time.UTC))
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev", Labels: map[string]string{"tier": "production"}},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-dev")},
+			}
+			seed = &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed", Labels: map[string]string{"region": "eu"}},
+			}
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+			}
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+
+			activeWindow = gardencorev1beta1.MaintenanceFreezeWindow{
+				Begin: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+				End:   metav1.NewTime(fakeClock.Now().Add(time.Hour)),
+			}
+			pastWindow = gardencorev1beta1.MaintenanceFreezeWindow{
+				Begin: metav1.NewTime(fakeClock.Now().Add(-2 * time.Hour)),
+				End:   metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+			}
+		})
+
+		createFreeze := func(name string, window gardencorev1beta1.MaintenanceFreezeWindow, projectSelector, seedSelector *metav1.LabelSelector) {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.MaintenanceFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.MaintenanceFreezeSpec{
+					Windows:         []gardencorev1beta1.MaintenanceFreezeWindow{window},
+					ProjectSelector: projectSelector,
+					SeedSelector:    seedSelector,
+				},
+			})).To(Succeed())
+		}
+
+		It("should return nil if there are no MaintenanceFreezes", func() {
+			Expect(FindActiveMaintenanceFreeze(ctx, fakeClient, fakeClock.Now(), shoot)).To(BeNil())
+		})
+
+		It("should return nil if no MaintenanceFreeze is active", func() {
+			createFreeze("past", pastWindow, nil, nil)
+
+			Expect(FindActiveMaintenanceFreeze(ctx, fakeClient, fakeClock.Now(), shoot)).To(BeNil())
+		})
+
+		It("should remove the deferred Shoots from the status of MaintenanceFreezes which are no longer active", func() {
+			createFreeze("past", pastWindow, nil, nil)
+			createFreeze("active", activeWindow, nil, nil)
+			for _, name := range []string{"past", "active"} {
+				freeze := &gardencorev1beta1.MaintenanceFreeze{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: name}, freeze)).To(Succeed())
+				freeze.Status.DeferredShoots = []gardencorev1beta1.DeferredShootMaintenance{{Namespace: "garden-dev", Name: "shoot", LastDeferredTime: metav1.NewTime(fakeClock.Now())}}
+				Expect(fakeClient.Status().Update(ctx, freeze)).To(Succeed())
+			}
+
+			freeze, err := FindActiveMaintenanceFreeze(ctx, fakeClient, fakeClock.Now(), shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze.Name).To(Equal("active"))
+			Expect(freeze.Status.DeferredShoots).To(HaveLen(1))
+
+			pastFreeze := &gardencorev1beta1.MaintenanceFreeze{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "past"}, pastFreeze)).To(Succeed())
+			Expect(pastFreeze.Status.DeferredShoots).To(BeEmpty())
+		})
+
+		It("should return the first active MaintenanceFreeze without selectors", func() {
+			createFreeze("past", pastWindow, nil, nil)
+			createFreeze("b", activeWindow, nil, nil)
+			createFreeze("a", activeWindow, nil, nil)
+
+			freeze, err := FindActiveMaintenanceFreeze(ctx, fakeClient, fakeClock.Now(), shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze.Name).To(Equal("a"))
+		})
+
+		It("should return the active MaintenanceFreeze if the selectors match", func() {
+			createFreeze("freeze", activeWindow,
+				&metav1.LabelSelector{MatchLabels: map[string]string{"tier": "production"}},
+				&metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+			)
+
+			freeze, err := FindActiveMaintenanceFreeze(ctx, fakeClient, fakeClock.Now(), shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze.Name).To(Equal("freeze"))
+		})
+
+		It("should return nil if the project selector does not match", func() {
+			createFreeze("freeze", activeWindow, &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "development"}}, nil)
+
+			Expect(FindActiveMaintenanceFreeze(ctx, fakeClient, fakeClock.Now(), shoot)).To(BeNil())
+		})
+
+		It("should return nil if the seed selector does not match", func() {
+			createFreeze("freeze", activeWindow, nil, &metav1.LabelSelector{MatchLabels: map[string]string{"region": "us"}})
+
+			Expect(FindActiveMaintenanceFreeze(ctx, fakeClient, fakeClock.Now(), shoot)).To(BeNil())
+		})
+
+		It("should return nil if a seed selector is set and the Shoot is not scheduled", func() {
+			shoot.Spec.SeedName = nil
+			createFreeze("freeze", activeWindow, nil, &metav1.LabelSelector{})
+
+			Expect(FindActiveMaintenanceFreeze(ctx, fakeClient, fakeClock.Now(), shoot)).To(BeNil())
+		})
+	})
+
+	Describe("#DeferMaintenance", func() {
+		var (
+			ctx        context.Context
+			fakeClient client.Client
+			fakeClock  *testclock.FakeClock
+			recorder   *events.FakeRecorder
+
+			shoot  *gardencorev1beta1.Shoot
+			freeze *gardencorev1beta1.MaintenanceFreeze
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithStatusSubresource(&gardencorev1beta1.Shoot{}, &gardencorev1beta1.MaintenanceFreeze{}).
+				Build()
+			fakeClock = testclock.NewFakeClock(time.Date(2026, 12, 24, 12, 0, 0, 0, time.Local))
+			recorder = events.NewFakeRecorder(1)
+
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"}}
+			freeze = &gardencorev1beta1.MaintenanceFreeze{
+				ObjectMeta: metav1.ObjectMeta{Name: "freeze"},
+				Spec: gardencorev1beta1.MaintenanceFreezeSpec{
+					Windows: []gardencorev1beta1.MaintenanceFreezeWindow{{
+						Begin: metav1.NewTime(fakeClock.Now().Add(-2 * time.Hour)),
+						End:   metav1.NewTime(fakeClock.Now().Add(time.Hour)),
+					}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeClient.Create(ctx, freeze)).To(Succeed())
+		})
+
+		It("should report the deferred maintenance in the status of the Shoot and the MaintenanceFreeze", func() {
+			Expect(DeferMaintenance(ctx, fakeClient, recorder, fakeClock.Now(), shoot, freeze)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.LastMaintenance).To(Equal(&gardencorev1beta1.LastMaintenance{
+				Description:   `Maintenance deferred due to maintenance freeze "freeze"`,
+				TriggeredTime: metav1.NewTime(fakeClock.Now()),
+				State:         gardencorev1beta1.LastOperationStatePending,
+			}))
+			Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ShootMaintenanceDeferred)))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(freeze), freeze)).To(Succeed())
+			Expect(freeze.Status.DeferredShoots).To(ConsistOf(gardencorev1beta1.DeferredShootMaintenance{
+				Namespace:        "garden-dev",
+				Name:             "shoot",
+				LastDeferredTime: metav1.NewTime(fakeClock.Now()),
+			}))
+		})
+
+		It("should update an existing entry in the status of the MaintenanceFreeze", func() {
+			freeze.Status.DeferredShoots = []gardencorev1beta1.DeferredShootMaintenance{
+				{Namespace: "garden-other", Name: "shoot", LastDeferredTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour))},
+				{Namespace: "garden-dev", Name: "shoot", LastDeferredTime: metav1.NewTime(fakeClock.Now().Add(-24 * time.Hour))},
+			}
+			Expect(fakeClient.Status().Update(ctx, freeze)).To(Succeed())
+
+			Expect(DeferMaintenance(ctx, fakeClient, recorder, fakeClock.Now(), shoot, freeze)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(freeze), freeze)).To(Succeed())
+			Expect(freeze.Status.DeferredShoots).To(ConsistOf(
+				gardencorev1beta1.DeferredShootMaintenance{Namespace: "garden-other", Name: "shoot", LastDeferredTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour))},
+				gardencorev1beta1.DeferredShootMaintenance{Namespace: "garden-dev", Name: "shoot", LastDeferredTime: metav1.NewTime(fakeClock.Now())},
+			))
+		})
+		It("should remove entries from previous windows of the MaintenanceFreeze", func() {
+			freeze.Status.DeferredShoots = []gardencorev1beta1.DeferredShootMaintenance{
+				{Namespace: "garden-other", Name: "shoot", LastDeferredTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour))},
+				{Namespace: "garden-old", Name: "shoot", LastDeferredTime: metav1.NewTime(fakeClock.Now().Add(-24 * time.Hour))},
+			}
+			Expect(fakeClient.Status().Update(ctx, freeze)).To(Succeed())
+
+			Expect(DeferMaintenance(ctx, fakeClient, recorder, fakeClock.Now(), shoot, freeze)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(freeze), freeze)).To(Succeed())
+			Expect(freeze.Status.DeferredShoots).To(ConsistOf(
+				gardencorev1beta1.DeferredShootMaintenance{Namespace: "garden-other", Name: "shoot", LastDeferredTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour))},
+				gardencorev1beta1.DeferredShootMaintenance{Namespace: "garden-dev", Name: "shoot", LastDeferredTime: metav1.NewTime(fakeClock.Now())},
+			))
+		})
+
+		It("should only report the most recently deferred Shoots", func() {
+			for i := range MaxDeferredShoots {
+				freeze.Status.DeferredShoots = append(freeze.Status.DeferredShoots, gardencorev1beta1.DeferredShootMaintenance{
+					Namespace:        "garden-other",
+					Name:             fmt.Sprintf("shoot-%d", i),
+					LastDeferredTime: metav1.NewTime(fakeClock.Now().Add(-time.Duration(i+1) * time.Minute)),
+				})
+			}
+			Expect(fakeClient.Status().Update(ctx, freeze)).To(Succeed())
+
+			Expect(DeferMaintenance(ctx, fakeClient, recorder, fakeClock.Now(), shoot, freeze)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(freeze), freeze)).To(Succeed())
+			Expect(freeze.Status.DeferredShoots).To(HaveLen(MaxDeferredShoots))
+			Expect(freeze.Status.DeferredShoots).To(ContainElement(gardencorev1beta1.DeferredShootMaintenance{Namespace: "garden-dev", Name: "shoot", LastDeferredTime: metav1.NewTime(fakeClock.Now())}))
+			Expect(freeze.Status.DeferredShoots).NotTo(ContainElement(HaveField("Name", fmt.Sprintf("shoot-%d", MaxDeferredShoots-1))))
+		})
+	})
+})
@@ -43,6 +43,10 @@ type Reconciler struct {
 	Config   controllermanagerconfigv1alpha1.ShootMaintenanceControllerConfiguration
 	Clock    clock.Clock
 	Recorder events.EventRecorder
+	// DeferForceUpdates specifies that expired Kubernetes versions are not force updated in the maintenance time window
+	// but only if the maintenance is triggered via the `maintain` operation annotation, e.g., by the shoot-force-upgrade
+	// controller which triggers the force updates in waves.
+	DeferForceUpdates bool
 }
 
 // Reconcile reconciles Shoots and maintains them by updating versions or triggering operations.
@@ -71,6 +75,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 
+	// Maintenance explicitly requested via the `maintain` operation annotation is performed even during a maintenance
+	// freeze.
+	if !hasMaintainNowAnnotation(shoot) {
+		freeze, err := helper.FindActiveMaintenanceFreeze(ctx, r.Client, r.Clock.Now(), shoot)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed checking for active maintenance freezes: %w", err)
+		}
+
+		if freeze != nil {
+			if err := helper.DeferMaintenance(ctx, r.Client, r.Recorder, r.Clock.Now(), shoot, freeze); err != nil {
+				return reconcile.Result{}, err
+			}
+
+			log.Info("Deferred maintenance of Shoot due to active maintenance freeze", "maintenanceFreeze", freeze.Name)
+			log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
+	}
+
 	if err := r.reconcile(ctx, log, shoot); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -125,7 +148,9 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 	}
 
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+	forceUpdateExpired := !r.DeferForceUpdates || hasMaintainNowAnnotation(shoot)
+
+	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, forceUpdateExpired, cloudProfile, func(v string) (string, error) {
 		maintainedShoot.Spec.Kubernetes.Version = v
 		return v, nil
 	})
@@ -277,7 +302,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 
 		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, forceUpdateExpired, cloudProfile, func(v string) (string, error) {
 			workerPoolSemver, err := semver.NewVersion(v)
 			if err != nil {
 				return "", err
@@ -651,9 +676,10 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 	return maintenanceResults, nil
 }
 
-// maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done
-func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
-	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile)
+// maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done.
+// Expired versions are only force updated if forceUpdateExpired is true.
+func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate, forceUpdateExpired bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
+	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, forceUpdateExpired, profile)
 	if err != nil {
 		return nil, err
 	}
@@ -803,7 +829,7 @@ func determineKubernetesVersion(kubernetesVersion string, profile *gardencorev1b
 	return version, nil
 }
 
-func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile) (shouldBeUpdated bool, reason string, isExpired bool, error error) {
+func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate, forceUpdateExpired bool, profile *gardencorev1beta1.CloudProfile) (shouldBeUpdated bool, reason string, isExpired bool, error error) {
 	versionExistsInCloudProfile, version, err := v1beta1helper.KubernetesVersionExistsInCloudProfile(profile, kubernetesVersion)
 	if err != nil {
 		return false, "", false, err
@@ -815,7 +841,7 @@ func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool,
 		return true, updateReason, true, nil
 	}
 
-	if v1beta1helper.CurrentLifecycleClassification(version) == gardencorev1beta1.ClassificationExpired {
+	if v1beta1helper.CurrentLifecycleClassification(version) == gardencorev1beta1.ClassificationExpired && forceUpdateExpired {
 		updateReason = "Kubernetes version expired - force update required"
 		return true, updateReason, true, nil
 	}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-controller-manager.
+const Namespace = "gardener_controller_manager"
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	factory = promauto.With(runtimemetrics.Registry)
+	// CertificateExpirationTimestampSeconds defines the gauge certificate_expiration_timestamp_seconds.
+	CertificateExpirationTimestampSeconds = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "certificate_expiration_timestamp_seconds",
+			Help:      "Expiration time of gardener-managed certificate authorities and serving certificates in the garden cluster as unix timestamp.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"kind",
+			"shoot",
+		},
+	)
+)
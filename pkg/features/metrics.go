@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package features
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/component-base/featuregate"
+)
+
+var featureGateEnabledDesc = prometheus.NewDesc(
+	"gardener_feature_gate_enabled",
+	"Whether a feature gate is enabled (1) or disabled (0). The overridden label indicates whether the state was overridden for this seed or garden runtime cluster.",
+	[]string{"name", "stage", "overridden"},
+	nil,
+)
+
+type collector struct {
+	featureGate featuregate.MutableFeatureGate
+	overrides   map[string]bool
+}
+
+// RegisterMetrics registers a collector on the passed registry which exposes the states of all feature gates known to
+// the given feature gate map and part of AllFeatureGates. The given overrides (see ApplyOverrides) are used to mark overridden feature gates.
+func RegisterMetrics(r prometheus.Registerer, featureGate featuregate.MutableFeatureGate, overrides map[string]bool) error {
+	return r.Register(&collector{featureGate: featureGate, overrides: overrides})
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- featureGateEnabledDesc
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	for feature, spec := range c.featureGate.GetAll() {
+		// The feature gate map might contain feature gates of libraries (e.g., `AllAlpha`), only expose Gardener's own.
+		if _, ok := AllFeatureGates[feature]; !ok {
+			continue
+		}
+
+		var value float64
+		if c.featureGate.Enabled(feature) {
+			value = 1
+		}
+
+		_, overridden := c.overrides[string(feature)]
+		ch <- prometheus.MustNewConstMetric(featureGateEnabledDesc, prometheus.GaugeValue, value, string(feature), string(spec.PreRelease), strconv.FormatBool(overridden))
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package features
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/component-base/featuregate"
+)
+
+// AnnotationOverrides is the key of an annotation on `Seed`s (evaluated by gardenlet) and `Garden`s (evaluated by
+// gardener-operator) whose value overrides the states of the feature gates configured in the component configuration.
+// This allows enabling features on specific seeds or garden runtime clusters for canarying. The value has the same
+// format as the `--feature-gates` flag, e.g. `Foo=true,Bar=false`.
+const AnnotationOverrides = "features.gardener.cloud/overrides"
+
+// ParseOverrides parses the given value of the AnnotationOverrides annotation.
+func ParseOverrides(value string) (map[string]bool, error) {
+	overrides := make(map[string]bool)
+
+	for pair := range strings.SplitSeq(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, rawValue, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("missing bool value for feature gate %q", pair)
+		}
+
+		enabled, err := strconv.ParseBool(strings.TrimSpace(rawValue))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for feature gate %q: %w", rawValue, key, err)
+		}
+		overrides[strings.TrimSpace(key)] = enabled
+	}
+
+	return overrides, nil
+}
+
+// ApplyOverrides sets the states of the feature gates in the given feature gate map according to the value of the
+// AnnotationOverrides annotation in the given annotations. It returns the overridden feature gates. Unknown feature
+// gates, feature gates which are not registered for the component, and feature gates which are locked to their default
+// cannot be overridden.
+func ApplyOverrides(featureGate featuregate.MutableFeatureGate, annotations map[string]string) (map[string]bool, error) {
+	value, ok := annotations[AnnotationOverrides]
+	if !ok {
+		return nil, nil
+	}
+
+	overrides, err := ParseOverrides(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing %s annotation: %w", AnnotationOverrides, err)
+	}
+
+	for name := range overrides {
+		if _, ok := AllFeatureGates[featuregate.Feature(name)]; !ok {
+			return nil, fmt.Errorf("unknown feature gate %q in %s annotation", name, AnnotationOverrides)
+		}
+	}
+
+	if err := featureGate.SetFromMap(overrides); err != nil {
+		return nil, fmt.Errorf("failed applying feature gate overrides from %s annotation: %w", AnnotationOverrides, err)
+	}
+
+	return overrides, nil
+}
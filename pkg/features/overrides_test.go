@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package features_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/component-base/featuregate"
+
+	. "github.com/gardener/gardener/pkg/features"
+)
+
+var _ = Describe("Overrides", func() {
+	var featureGate featuregate.MutableFeatureGate
+
+	BeforeEach(func() {
+		featureGate = featuregate.NewFeatureGate()
+		Expect(featureGate.Add(GetFeatures(DefaultSeccompProfile, NewWorkerPoolHash, DoNotCopyBackupCredentials))).To(Succeed())
+		Expect(featureGate.SetFromMap(map[string]bool{string(DefaultSeccompProfile): false})).To(Succeed())
+	})
+
+	Describe("#ParseOverrides", func() {
+		It("should parse the overrides", func() {
+			Expect(ParseOverrides(" Foo=true,Bar = false,, ")).To(Equal(map[string]bool{"Foo": true, "Bar": false}))
+		})
+
+		It("should fail for missing values", func() {
+			_, err := ParseOverrides("Foo")
+			Expect(err).To(MatchError(ContainSubstring(`missing bool value for feature gate "Foo"`)))
+		})
+
+		It("should fail for invalid values", func() {
+			_, err := ParseOverrides("Foo=maybe")
+			Expect(err).To(MatchError(ContainSubstring(`invalid value "maybe" for feature gate "Foo"`)))
+		})
+	})
+
+	Describe("#ApplyOverrides", func() {
+		It("should do nothing if the annotation is not set", func() {
+			Expect(ApplyOverrides(featureGate, map[string]string{"foo": "bar"})).To(BeNil())
+			Expect(featureGate.Enabled(DefaultSeccompProfile)).To(BeFalse())
+		})
+
+		It("should override the configured feature gates", func() {
+			Expect(ApplyOverrides(featureGate, map[string]string{AnnotationOverrides: "DefaultSeccompProfile=true,NewWorkerPoolHash=false"})).To(Equal(map[string]bool{
+				"DefaultSeccompProfile": true,
+				"NewWorkerPoolHash":     false,
+			}))
+			Expect(featureGate.Enabled(DefaultSeccompProfile)).To(BeTrue())
+			Expect(featureGate.Enabled(NewWorkerPoolHash)).To(BeFalse())
+		})
+
+		It("should fail for unknown feature gates", func() {
+			_, err := ApplyOverrides(featureGate, map[string]string{AnnotationOverrides: "Foo=true"})
+			Expect(err).To(MatchError(ContainSubstring(`unknown feature gate "Foo"`)))
+		})
+
+		It("should fail for feature gates which are not registered", func() {
+			_, err := ApplyOverrides(featureGate, map[string]string{AnnotationOverrides: "InPlaceNodeUpdates=true"})
+			Expect(err).To(MatchError(ContainSubstring("unrecognized feature gate: InPlaceNodeUpdates")))
+		})
+
+		It("should fail for feature gates which are locked to their default", func() {
+			_, err := ApplyOverrides(featureGate, map[string]string{AnnotationOverrides: "DoNotCopyBackupCredentials=false"})
+			Expect(err).To(MatchError(ContainSubstring("cannot set feature gate DoNotCopyBackupCredentials to false")))
+		})
+	})
+
+	Describe("#RegisterMetrics", func() {
+		It("should expose the states of the feature gates", func() {
+			registry := prometheus.NewRegistry()
+			Expect(RegisterMetrics(registry, featureGate, map[string]bool{"NewWorkerPoolHash": true})).To(Succeed())
+
+			Expect(testutil.CollectAndCompare(registry, strings.NewReader(`
+# HELP gardener_feature_gate_enabled Whether a feature gate is enabled (1) or disabled (0). The overridden label indicates whether the state was overridden for this seed or garden runtime cluster.
+# TYPE gardener_feature_gate_enabled gauge
+gardener_feature_gate_enabled{name="DefaultSeccompProfile",overridden="false",stage="ALPHA"} 0
+gardener_feature_gate_enabled{name="DoNotCopyBackupCredentials",overridden="false",stage=""} 1
+gardener_feature_gate_enabled{name="NewWorkerPoolHash",overridden="true",stage="BETA"} 1
+`), "gardener_feature_gate_enabled")).To(Succeed())
+		})
+	})
+})
@@ -110,6 +110,7 @@ var _ = Describe("CustomResourceDefinitions", func() {
 					HaveField("ObjectMeta.Name", "selfhostedshootexposures.extensions.gardener.cloud"),
 					HaveField("ObjectMeta.Name", "scrapeconfigs.monitoring.coreos.com"),
 					HaveField("ObjectMeta.Name", "servicemonitors.monitoring.coreos.com"),
+					HaveField("ObjectMeta.Name", "shootleftovers.extensions.gardener.cloud"),
 					HaveField("ObjectMeta.Name", "thanosrulers.monitoring.coreos.com"),
 					HaveField("ObjectMeta.Name", "verticalpodautoscalercheckpoints.autoscaling.k8s.io"),
 					HaveField("ObjectMeta.Name", "verticalpodautoscalers.autoscaling.k8s.io"),
@@ -24,6 +24,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/utils/timewindow"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/gardener/operator"
 )
@@ -165,9 +166,28 @@ func ReadManifests(log logr.Logger, fsys fs.FS) (Resources, error) {
 		return Resources{}, fmt.Errorf("must provide a *gardencorev1beta1.Shoot resource, but did not find any")
 	}
 
+	defaultMaintenanceTimeWindow(resources.Shoot)
+
 	return resources, nil
 }
 
+// defaultMaintenanceTimeWindow sets a random maintenance time window if the Shoot does not specify one, like the
+// gardener-apiserver does for Shoots created via the API.
+func defaultMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot) {
+	if shoot.Spec.Maintenance == nil {
+		shoot.Spec.Maintenance = &gardencorev1beta1.Maintenance{}
+	}
+	if shoot.Spec.Maintenance.TimeWindow != nil {
+		return
+	}
+
+	mt := timewindow.RandomMaintenanceTimeWindow()
+	shoot.Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{
+		Begin: mt.Begin().Formatted(),
+		End:   mt.End().Formatted(),
+	}
+}
+
 // VisitManifestFiles calls the visit func for all manifest files in the given file system.
 // It ignores hidden files and directories (starting with a dot).
 func VisitManifestFiles(fsys fs.FS, visit func(path string, file fs.File) error) error {
@@ -76,6 +76,14 @@ var _ = Describe("Resources", func() {
 			Expect(resources.WorkloadIdentities[1].Name).To(Equal("workloadIdentity2"))
 		})
 
+		It("should default the maintenance time window of the Shoot", func() {
+			resources, err := gardenadm.ReadManifests(log, fsys)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resources.Shoot.Spec.Maintenance.TimeWindow).NotTo(BeNil())
+			Expect(resources.Shoot.Spec.Maintenance.TimeWindow.Begin).To(HaveSuffix("0000+0000"))
+		})
+
 		It("should ignore hidden files", func() {
 			// invalid content should not be read
 			fsys[".cloudprofile-foo.yaml"] = &fstest.MapFile{Data: []byte(`{`)}
@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	gardenletbootstraputil "github.com/gardener/gardener/pkg/gardenlet/bootstrap/util"
 	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet/attestation"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/certificatesigningrequest"
 )
@@ -38,7 +39,8 @@ const (
 )
 
 // RequestKubeconfigWithBootstrapClient creates a kubeconfig with a signed certificate using the given bootstrap client
-// returns the kubeconfig []byte representation, the CSR name, the seed name or an error.
+// returns the kubeconfig []byte representation, the CSR name, the seed name or an error. If bootstrapAttestation is
+// set, the CSR is annotated with the attestation evidence created by the configured provider.
 func RequestKubeconfigWithBootstrapClient(
 	ctx context.Context,
 	log logr.Logger,
@@ -48,6 +50,7 @@ func RequestKubeconfigWithBootstrapClient(
 	seedConfig *gardenletconfigv1alpha1.SeedConfig,
 	selfHostedShootMeta *types.NamespacedName,
 	validityDuration *metav1.Duration,
+	bootstrapAttestation *gardenletconfigv1alpha1.BootstrapAttestation,
 ) (
 	[]byte,
 	string,
@@ -82,7 +85,20 @@ func RequestKubeconfigWithBootstrapClient(
 		return nil, "", fmt.Errorf("failed determining gardenlet bootstrap scenario (seed or self-hosted shoot)")
 	}
 
-	certData, privateKeyData, csrName, err := certificatesigningrequest.RequestCertificate(ctx, log, bootstrapClientSet.Kubernetes(), certificateSubject, []string{}, []net.IP{}, validityDuration, csrPrefix)
+	var opts []certificatesigningrequest.RequestOption
+	if bootstrapAttestation != nil {
+		provider, err := attestation.NewProvider(bootstrapAttestation.Provider, bootstrapAttestation.Config)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed creating attestation provider: %w", err)
+		}
+
+		log.Info("Attaching attestation evidence to certificate signing request", "attestationProvider", bootstrapAttestation.Provider)
+		opts = append(opts, certificatesigningrequest.WithAnnotations(func(ctx context.Context, certificateRequest []byte) (map[string]string, error) {
+			return attestation.Annotations(ctx, bootstrapAttestation.Provider, provider, certificateRequest)
+		}))
+	}
+
+	certData, privateKeyData, csrName, err := certificatesigningrequest.RequestCertificate(ctx, log, bootstrapClientSet.Kubernetes(), certificateSubject, []string{}, []net.IP{}, validityDuration, csrPrefix, opts...)
 	if err != nil {
 		return nil, "", fmt.Errorf("unable to bootstrap the kubeconfig for the Garden cluster: %w", err)
 	}
@@ -194,7 +194,7 @@ var _ = Describe("Bootstrap", func() {
 					},
 				})
 
-				kubeconfig, csrName, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, seedConfig, selfHostedShootMeta, nil)
+				kubeconfig, csrName, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, seedConfig, selfHostedShootMeta, nil, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(kubeconfig).ToNot(BeEmpty())
@@ -215,7 +215,7 @@ var _ = Describe("Bootstrap", func() {
 					WithKubernetes(kubeClient).
 					Build()
 
-				_, _, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, seedConfig, selfHostedShootMeta, nil)
+				_, _, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, seedConfig, selfHostedShootMeta, nil, nil)
 				Expect(err).To(MatchError(ContainSubstring("is denied")))
 			})
 
@@ -233,9 +233,19 @@ var _ = Describe("Bootstrap", func() {
 					WithKubernetes(kubeClient).
 					Build()
 
-				_, _, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, seedConfig, selfHostedShootMeta, nil)
+				_, _, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, seedConfig, selfHostedShootMeta, nil, nil)
 				Expect(err).To(MatchError(ContainSubstring("failed")))
 			})
+
+			It("should return an error - the attestation provider is not registered", func() {
+				bootstrapClientSet := fakekubernetes.NewClientSetBuilder().
+					WithRESTConfig(bootstrapClientConfig).
+					WithKubernetes(kubeClient).
+					Build()
+
+				_, _, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, seedConfig, selfHostedShootMeta, nil, &gardenletconfigv1alpha1.BootstrapAttestation{Provider: "unknown"})
+				Expect(err).To(MatchError(ContainSubstring(`attestation provider "unknown" is not registered`)))
+			})
 		})
 
 		When("gardenlet is responsible for shoot", func() {
@@ -277,7 +287,7 @@ var _ = Describe("Bootstrap", func() {
 					},
 				})
 
-				kubeconfig, csrName, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, nil, selfHostedShootMeta, nil)
+				kubeconfig, csrName, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, nil, selfHostedShootMeta, nil, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(kubeconfig).ToNot(BeEmpty())
@@ -298,7 +308,7 @@ var _ = Describe("Bootstrap", func() {
 					WithKubernetes(kubeClient).
 					Build()
 
-				_, _, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, nil, selfHostedShootMeta, nil)
+				_, _, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, nil, selfHostedShootMeta, nil, nil)
 				Expect(err).To(MatchError(ContainSubstring("is denied")))
 			})
 
@@ -316,7 +326,7 @@ var _ = Describe("Bootstrap", func() {
 					WithKubernetes(kubeClient).
 					Build()
 
-				_, _, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, nil, selfHostedShootMeta, nil)
+				_, _, err := RequestKubeconfigWithBootstrapClient(ctx, testLogger, runtimeClient, bootstrapClientSet, kubeconfigKey, bootstrapKubeconfigKey, nil, selfHostedShootMeta, nil, nil)
 				Expect(err).To(MatchError(ContainSubstring("failed")))
 			})
 		})
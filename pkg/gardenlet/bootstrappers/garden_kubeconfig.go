@@ -76,7 +76,12 @@ func (g *GardenKubeconfig) Start(ctx context.Context) (err error) {
 
 	var gardenAPIReader client.Reader
 	if len(g.Config.GardenClientConnection.GardenClusterCACert) == 0 {
-		gardenClient, err := kubernetes.NewClientFromBytes(g.Result.Kubeconfig)
+		// The client is only used for reading the CA bundle once, hence, it should not eat up the garden cluster's
+		// request budget of gardenlet.
+		gardenClient, err := kubernetes.NewClientFromBytes(g.Result.Kubeconfig,
+			kubernetes.WithUserAgentSuffix("bootstrap"),
+			kubernetes.WithRequestPriority(kubernetes.RequestPriorityLow),
+		)
 		if err != nil {
 			return fmt.Errorf("unable to create garden client from kubeconfig: %w", err)
 		}
@@ -155,5 +160,6 @@ func (g *GardenKubeconfig) getOrBootstrapKubeconfig(ctx context.Context) ([]byte
 		g.Config.SeedConfig,
 		selfHostedShootMeta,
 		g.Config.GardenClientConnection.KubeconfigValidity.Validity,
+		g.Config.GardenClientConnection.BootstrapAttestation,
 	)
 }
@@ -219,7 +219,7 @@ var _ = Describe("GardenKubeconfig", func() {
 							&NewClientFromBytes, func(_ []byte, _ ...kubernetes.ConfigFunc) (kubernetes.Interface, error) {
 								return nil, nil
 							},
-							&RequestKubeconfigWithBootstrapClient, func(_ context.Context, _ logr.Logger, _ client.Client, _ kubernetes.Interface, _, _ client.ObjectKey, _ *gardenletconfigv1alpha1.SeedConfig, _ *types.NamespacedName, _ *metav1.Duration) ([]byte, string, error) {
+							&RequestKubeconfigWithBootstrapClient, func(_ context.Context, _ logr.Logger, _ client.Client, _ kubernetes.Interface, _, _ client.ObjectKey, _ *gardenletconfigv1alpha1.SeedConfig, _ *types.NamespacedName, _ *metav1.Duration, _ *gardenletconfigv1alpha1.BootstrapAttestation) ([]byte, string, error) {
 								return requestedKubeconfig, csrName, nil
 							},
 						))
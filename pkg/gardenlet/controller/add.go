@@ -36,13 +36,16 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/status"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/tokenrequestor/workloadidentity"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/vpaevictionrequirements"
+	"github.com/gardener/gardener/pkg/gardenlet/offlinebundle"
 	"github.com/gardener/gardener/pkg/healthz"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // AddToManager adds all gardenlet controllers to the given manager.
 // selfHostedShoot is only needed to be non-nil when running in self-hosted shoot mode.
+// offlineBundle is only needed to be non-nil when gardenlet is configured to run with an offline bundle.
 func AddToManager(
 	ctx context.Context,
 	mgr manager.Manager,
@@ -53,6 +56,7 @@ func AddToManager(
 	cfg *gardenletconfigv1alpha1.GardenletConfiguration,
 	healthManager healthz.Manager,
 	selfHostedShoot *gardencorev1beta1.Shoot,
+	offlineBundle *offlinebundle.Bundle,
 ) error {
 	identity, err := gardenerutils.DetermineIdentity()
 	if err != nil {
@@ -111,7 +115,8 @@ func AddToManager(
 		}
 
 		if err := (&gardenlet.Reconciler{
-			Config: *cfg,
+			Config:       *cfg,
+			HelmRegistry: offlineBundle.HelmRegistry(oci.NewHelmRegistry(gardenCluster.GetClient())),
 		}).AddToManager(mgr, gardenCluster, seedClientSet); err != nil {
 			return fmt.Errorf("failed adding Gardenlet controller: %w", err)
 		}
@@ -180,12 +185,13 @@ func AddToManager(
 		return fmt.Errorf("failed adding Bastion controller: %w", err)
 	}
 
-	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, gardenClusterIdentity); err != nil {
+	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, gardenClusterIdentity, offlineBundle); err != nil {
 		return fmt.Errorf("failed adding ControllerInstallation controller: %w", err)
 	}
 
 	if err := (&gardenlet.Reconciler{
-		Config: *cfg,
+		Config:       *cfg,
+		HelmRegistry: offlineBundle.HelmRegistry(oci.NewHelmRegistry(gardenCluster.GetClient())),
 	}).AddToManager(mgr, gardenCluster, seedClientSet); err != nil {
 		return fmt.Errorf("failed adding Gardenlet controller: %w", err)
 	}
@@ -201,7 +207,7 @@ func AddToManager(
 		return fmt.Errorf("failed adding NetworkPolicy controller: %w", err)
 	}
 
-	if err := seed.AddToManager(mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, healthManager); err != nil {
+	if err := seed.AddToManager(mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, healthManager, offlineBundle); err != nil {
 		return fmt.Errorf("failed adding Seed controller: %w", err)
 	}
 
@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -30,6 +31,7 @@ import (
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -115,8 +117,7 @@ func (r *Reconciler) reconcileBastion(
 			DefaultSpec: extensionsv1alpha1.DefaultSpec{
 				Type: *bastion.Spec.ProviderType,
 			},
-			UserData: createUserData(bastion),
-			Ingress:  extensionIngress,
+			Ingress: extensionIngress,
 		}
 	)
 
@@ -124,26 +125,47 @@ func (r *Reconciler) reconcileBastion(
 		if !apierrors.IsNotFound(err) {
 			return err
 		}
+
+		// The user data and the session recording configuration of the extension Bastion are immutable, hence they are
+		// only computed when it is created. This way, changes to the defaults in the controller configuration only
+		// affect new bastions.
+		sessionRecording, err := r.sessionRecording(seedCtx, bastion, shoot)
+		if err != nil {
+			if patchErr := patchReadyCondition(gardenCtx, r.GardenClient, r.Clock, bastion, gardencorev1beta1.ConditionFalse, "FailedReconciling", err.Error()); patchErr != nil {
+				log.Error(patchErr, "Failed patching ready condition")
+			}
+			return fmt.Errorf("failed to determine session recording configuration: %w", err)
+		}
+
+		extensionBastionSpec.UserData = createUserData(bastion, r.idleTimeout(bastion), sessionRecording != nil)
+		extensionBastionSpec.SessionRecording = sessionRecording
+
 		// if the extension Bastion doesn't exist yet, create it
 		mustReconcileExtensionBastion = true
-	} else if !reflect.DeepEqual(extensionBastion.Spec, extensionBastionSpec) {
-		// if the extensionBastionSpec has changed, reconcile it
-		mustReconcileExtensionBastion = true
-	} else if extensionBastion.Status.LastOperation == nil {
-		// if the extension did not record a lastOperation yet, record it as error in the bastion status
-		lastObservedError = fmt.Errorf("extension did not record a last operation yet")
 	} else {
-		lastOperationState := extensionBastion.Status.LastOperation.State
-		if extensionBastion.Status.LastError != nil ||
-			lastOperationState == gardencorev1beta1.LastOperationStateError ||
-			lastOperationState == gardencorev1beta1.LastOperationStateFailed {
-			if lastOperationState == gardencorev1beta1.LastOperationStateFailed {
-				mustReconcileExtensionBastion = true
-			}
+		// keep the immutable fields of the existing extension Bastion
+		extensionBastionSpec.UserData = extensionBastion.Spec.UserData
+		extensionBastionSpec.SessionRecording = extensionBastion.Spec.SessionRecording
+
+		if !reflect.DeepEqual(extensionBastion.Spec, extensionBastionSpec) {
+			// if the extensionBastionSpec has changed, reconcile it
+			mustReconcileExtensionBastion = true
+		} else if extensionBastion.Status.LastOperation == nil {
+			// if the extension did not record a lastOperation yet, record it as error in the bastion status
+			lastObservedError = fmt.Errorf("extension did not record a last operation yet")
+		} else {
+			lastOperationState := extensionBastion.Status.LastOperation.State
+			if extensionBastion.Status.LastError != nil ||
+				lastOperationState == gardencorev1beta1.LastOperationStateError ||
+				lastOperationState == gardencorev1beta1.LastOperationStateFailed {
+				if lastOperationState == gardencorev1beta1.LastOperationStateFailed {
+					mustReconcileExtensionBastion = true
+				}
 
-			lastObservedError = fmt.Errorf("extension state is not Succeeded but %v", lastOperationState)
-			if extensionBastion.Status.LastError != nil {
-				lastObservedError = v1beta1helper.NewErrorWithCodes(fmt.Errorf("error during reconciliation: %s", extensionBastion.Status.LastError.Description), extensionBastion.Status.LastError.Codes...)
+				lastObservedError = fmt.Errorf("extension state is not Succeeded but %v", lastOperationState)
+				if extensionBastion.Status.LastError != nil {
+					lastObservedError = v1beta1helper.NewErrorWithCodes(fmt.Errorf("error during reconciliation: %s", extensionBastion.Status.LastError.Description), extensionBastion.Status.LastError.Codes...)
+				}
 			}
 		}
 	}
@@ -265,16 +287,117 @@ func patchReadyCondition(ctx context.Context, c client.StatusClient, clock clock
 	return c.Status().Patch(ctx, bastion, patch)
 }
 
-func createUserData(bastion *operationsv1alpha1.Bastion) []byte {
+// SessionRecordingDirectory is the directory on the bastion host to which SSH sessions are recorded.
+const SessionRecordingDirectory = "/var/log/gardener-bastion-sessions"
+
+// idleTimeout returns the idle timeout for the given bastion. The value from the Bastion spec takes precedence over the
+// default from the controller configuration.
+func (r *Reconciler) idleTimeout(bastion *operationsv1alpha1.Bastion) *metav1.Duration {
+	if bastion.Spec.IdleTimeout != nil {
+		return bastion.Spec.IdleTimeout
+	}
+	return r.Config.DefaultIdleTimeout
+}
+
+// sessionRecording returns the session recording configuration of the extension Bastion. Sessions are recorded to the
+// backup bucket of the shoot, hence the shoot's BackupEntry must exist in the seed if recording is enabled.
+func (r *Reconciler) sessionRecording(ctx context.Context, bastion *operationsv1alpha1.Bastion, shoot *gardencorev1beta1.Shoot) (*extensionsv1alpha1.BastionSessionRecording, error) {
+	enabled := ptr.Deref(r.Config.DefaultSessionRecording, false)
+	if bastion.Spec.SessionRecording != nil {
+		enabled = bastion.Spec.SessionRecording.Enabled
+	}
+
+	if !enabled {
+		return nil, nil
+	}
+
+	backupEntryName, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID, shoot.UID)
+	if err != nil {
+		return nil, fmt.Errorf("failed generating backup entry name: %w", err)
+	}
+
+	backupEntry := &extensionsv1alpha1.BackupEntry{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: backupEntryName}, backupEntry); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("session recording is enabled but the shoot has no backup entry %q", backupEntryName)
+		}
+		return nil, fmt.Errorf("failed getting backup entry %q: %w", backupEntryName, err)
+	}
+
+	return &extensionsv1alpha1.BastionSessionRecording{
+		BucketName: backupEntry.Spec.BucketName,
+		Prefix:     backupEntryName + "/bastion-sessions/" + bastion.Name,
+		SecretRef:  backupEntry.Spec.SecretRef,
+	}, nil
+}
+
+func createUserData(bastion *operationsv1alpha1.Bastion, idleTimeout *metav1.Duration, recordSessions bool) []byte {
 	userData := fmt.Sprintf(`#!/bin/bash -eu
 
 id gardener || useradd gardener -mU
 mkdir -p /home/gardener/.ssh
 echo "%s" > /home/gardener/.ssh/authorized_keys
 chown gardener:gardener /home/gardener/.ssh/authorized_keys
-echo "gardener ALL=(ALL) NOPASSWD:ALL" >/etc/sudoers.d/99-gardener-user
-systemctl start sshd || systemctl start ssh
 `, bastion.Spec.SSHPublicKey)
 
+	if !recordSessions {
+		// If sessions are recorded, the gardener user must not gain root privileges, since it could tamper with the
+		// recordings otherwise.
+		userData += "echo \"gardener ALL=(ALL) NOPASSWD:ALL\" >/etc/sudoers.d/99-gardener-user\n"
+	}
+
+	if idleTimeout != nil {
+		// sshd uses the first obtained value for each keyword, hence the settings are prepended to the configuration.
+		// ChannelTimeout and UnusedConnectionTimeout are only supported as of OpenSSH 9.2. For older versions, connections
+		// of unresponsive clients are closed via ClientAliveInterval/ClientAliveCountMax and idle interactive shells are
+		// terminated via TMOUT.
+		seconds := int64(idleTimeout.Duration.Seconds())
+		userData += fmt.Sprintf(`read -r openssh_major openssh_minor <<<"$(ssh -V 2>&1 | sed -n 's/^OpenSSH_\([0-9]*\)\.\([0-9]*\).*/\1 \2/p')" || true
+if [ "${openssh_major:-0}" -gt 9 ] || { [ "${openssh_major:-0}" -eq 9 ] && [ "${openssh_minor:-0}" -ge 2 ]; }; then
+  sed -i '1i UnusedConnectionTimeout %[1]ds' /etc/ssh/sshd_config
+  sed -i '1i ChannelTimeout *=%[1]ds' /etc/ssh/sshd_config
+else
+  sed -i '1i ClientAliveCountMax %[2]d' /etc/ssh/sshd_config
+  sed -i '1i ClientAliveInterval 60' /etc/ssh/sshd_config
+  echo "readonly TMOUT=%[1]d; export TMOUT" > /etc/profile.d/99-gardener-idle-timeout.sh
+fi
+`, seconds, max(1, seconds/60))
+	}
+
+	if recordSessions {
+		// The sessions are recorded by root into a directory which is only accessible by root, so that the gardener
+		// user cannot modify or delete the recordings. The gardener user is only allowed to run the recorder via sudo.
+		// Forwarded connections (e.g., via ProxyJump) do not run the forced command and can therefore not be recorded.
+		userData += fmt.Sprintf(`mkdir -p %[1]s
+chown root:root %[1]s
+chmod 0700 %[1]s
+cat <<'EOF' > /usr/local/bin/gardener-bastion-record
+#!/bin/bash
+session="%[1]s/$(date -u +%%Y%%m%%dT%%H%%M%%SZ)-$$"
+if [ $# -gt 0 ]; then
+  exec script -qfc "runuser -u gardener -- bash -c $(printf '%%q' "$1")" "$session.log"
+fi
+exec script -qfc "runuser -l gardener" "$session.log"
+EOF
+cat <<'EOF' > /usr/local/bin/gardener-bastion-session
+#!/bin/bash
+if [ -n "${SSH_ORIGINAL_COMMAND:-}" ]; then
+  exec sudo -n /usr/local/bin/gardener-bastion-record "$SSH_ORIGINAL_COMMAND"
+fi
+exec sudo -n /usr/local/bin/gardener-bastion-record
+EOF
+chmod 0755 /usr/local/bin/gardener-bastion-record /usr/local/bin/gardener-bastion-session
+echo "gardener ALL=(root) NOPASSWD: /usr/local/bin/gardener-bastion-record" >/etc/sudoers.d/99-gardener-user
+printf '\nMatch User gardener\n  ForceCommand /usr/local/bin/gardener-bastion-session\n' >> /etc/ssh/sshd_config
+`, SessionRecordingDirectory)
+	}
+
+	userData += "systemctl start sshd || systemctl start ssh\n"
+
+	if idleTimeout != nil || recordSessions {
+		// sshd might already be running, hence it has to pick up the changed configuration.
+		userData += "systemctl reload sshd || systemctl reload ssh\n"
+	}
+
 	return []byte(userData)
 }
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/bastion"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		seedClient   client.Client
+		reconciler   *Reconciler
+
+		namespace   = "garden-" + projectName
+		technicalID = "shoot--" + projectName + "--shoot"
+		shootUID    = types.UID("1234")
+
+		shoot       *gardencorev1beta1.Shoot
+		bastion     *operationsv1alpha1.Bastion
+		backupEntry *extensionsv1alpha1.BackupEntry
+		request     reconcile.Request
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&operationsv1alpha1.Bastion{}).
+			Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Clock:        testclock.NewFakeClock(time.Now()),
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace, UID: shootUID},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: technicalID},
+		}
+		bastion = &operationsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{Name: bastionName, Namespace: namespace},
+			Spec: operationsv1alpha1.BastionSpec{
+				ShootRef:     corev1.LocalObjectReference{Name: shoot.Name},
+				SSHPublicKey: "ssh-ed25519 AAAA",
+				ProviderType: ptr.To("local"),
+			},
+		}
+		backupEntry = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: technicalID + "--" + string(shootUID)},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				BucketName: "bucket",
+				SecretRef:  corev1.SecretReference{Name: "entry-secret", Namespace: "garden"},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(bastion)}
+
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	getExtensionBastion := func() *extensionsv1alpha1.Bastion {
+		extensionBastion := &extensionsv1alpha1.Bastion{}
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Name: bastionName, Namespace: technicalID}, extensionBastion)).To(Succeed())
+		return extensionBastion
+	}
+
+	It("should create the extension Bastion without idle timeout and session recording", func() {
+		Expect(gardenClient.Create(ctx, bastion)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		extensionBastion := getExtensionBastion()
+		Expect(extensionBastion.Spec.Type).To(Equal("local"))
+		Expect(extensionBastion.Spec.SessionRecording).To(BeNil())
+		Expect(string(extensionBastion.Spec.UserData)).To(ContainSubstring(`echo "ssh-ed25519 AAAA" > /home/gardener/.ssh/authorized_keys`))
+		Expect(string(extensionBastion.Spec.UserData)).NotTo(ContainSubstring("ChannelTimeout"))
+		Expect(string(extensionBastion.Spec.UserData)).NotTo(ContainSubstring("ForceCommand"))
+		Expect(string(extensionBastion.Spec.UserData)).To(ContainSubstring(`echo "gardener ALL=(ALL) NOPASSWD:ALL" >/etc/sudoers.d/99-gardener-user`))
+	})
+
+	It("should enforce the idle timeout from the Bastion spec over the default", func() {
+		reconciler.Config = gardenletconfigv1alpha1.BastionControllerConfiguration{DefaultIdleTimeout: &metav1.Duration{Duration: time.Hour}}
+		bastion.Spec.IdleTimeout = &metav1.Duration{Duration: 15 * time.Minute}
+		Expect(gardenClient.Create(ctx, bastion)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		userData := string(getExtensionBastion().Spec.UserData)
+		Expect(userData).To(ContainSubstring("sed -i '1i UnusedConnectionTimeout 900s' /etc/ssh/sshd_config"))
+		Expect(userData).To(ContainSubstring("sed -i '1i ChannelTimeout *=900s' /etc/ssh/sshd_config"))
+		Expect(userData).To(ContainSubstring("sed -i '1i ClientAliveCountMax 15' /etc/ssh/sshd_config"))
+		Expect(userData).To(ContainSubstring("sed -i '1i ClientAliveInterval 60' /etc/ssh/sshd_config"))
+		Expect(userData).To(ContainSubstring(`echo "readonly TMOUT=900; export TMOUT" > /etc/profile.d/99-gardener-idle-timeout.sh`))
+		Expect(userData).To(HaveSuffix("systemctl reload sshd || systemctl reload ssh\n"))
+	})
+
+	It("should enforce the default idle timeout", func() {
+		reconciler.Config = gardenletconfigv1alpha1.BastionControllerConfiguration{DefaultIdleTimeout: &metav1.Duration{Duration: time.Hour}}
+		Expect(gardenClient.Create(ctx, bastion)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(string(getExtensionBastion().Spec.UserData)).To(ContainSubstring("ChannelTimeout *=3600s"))
+	})
+
+	It("should record sessions to the backup bucket of the shoot", func() {
+		bastion.Spec.SessionRecording = &operationsv1alpha1.BastionSessionRecording{Enabled: true}
+		Expect(gardenClient.Create(ctx, bastion)).To(Succeed())
+		Expect(seedClient.Create(ctx, backupEntry)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		extensionBastion := getExtensionBastion()
+		Expect(extensionBastion.Spec.SessionRecording).To(Equal(&extensionsv1alpha1.BastionSessionRecording{
+			BucketName: "bucket",
+			Prefix:     backupEntry.Name + "/bastion-sessions/" + bastionName,
+			SecretRef:  corev1.SecretReference{Name: "entry-secret", Namespace: "garden"},
+		}))
+		userData := string(extensionBastion.Spec.UserData)
+		Expect(userData).To(ContainSubstring("ForceCommand /usr/local/bin/gardener-bastion-session"))
+		Expect(userData).To(ContainSubstring("chmod 0700 /var/log/gardener-bastion-sessions"))
+		Expect(userData).To(ContainSubstring(`echo "gardener ALL=(root) NOPASSWD: /usr/local/bin/gardener-bastion-record" >/etc/sudoers.d/99-gardener-user`))
+		Expect(userData).NotTo(ContainSubstring("NOPASSWD:ALL"))
+	})
+
+	It("should not record sessions if the Bastion disables it explicitly", func() {
+		reconciler.Config = gardenletconfigv1alpha1.BastionControllerConfiguration{DefaultSessionRecording: ptr.To(true)}
+		bastion.Spec.SessionRecording = &operationsv1alpha1.BastionSessionRecording{Enabled: false}
+		Expect(gardenClient.Create(ctx, bastion)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(getExtensionBastion().Spec.SessionRecording).To(BeNil())
+	})
+
+	It("should fail if session recording is enabled but the shoot has no backup entry", func() {
+		reconciler.Config = gardenletconfigv1alpha1.BastionControllerConfiguration{DefaultSessionRecording: ptr.To(true)}
+		Expect(gardenClient.Create(ctx, bastion)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("the shoot has no backup entry")))
+
+		Expect(gardenClient.Get(ctx, request.NamespacedName, bastion)).To(Succeed())
+		Expect(bastion.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", operationsv1alpha1.BastionReady),
+			HaveField("Status", gardencorev1beta1.ConditionFalse),
+			HaveField("Reason", "FailedReconciling"),
+		)))
+	})
+
+	It("should keep the immutable fields of an existing extension Bastion", func() {
+		Expect(gardenClient.Create(ctx, bastion)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		userData := getExtensionBastion().Spec.UserData
+
+		reconciler.Config = gardenletconfigv1alpha1.BastionControllerConfiguration{
+			DefaultIdleTimeout:      &metav1.Duration{Duration: time.Hour},
+			DefaultSessionRecording: ptr.To(true),
+		}
+
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		extensionBastion := getExtensionBastion()
+		Expect(extensionBastion.Spec.UserData).To(Equal(userData))
+		Expect(extensionBastion.Spec.SessionRecording).To(BeNil())
+	})
+})
@@ -17,6 +17,8 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/controllerinstallation"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/required"
+	"github.com/gardener/gardener/pkg/gardenlet/offlinebundle"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // AddToManager adds all ControllerInstallation controllers to the given manager.
@@ -29,6 +31,7 @@ func AddToManager(
 	cfg gardenletconfigv1alpha1.GardenletConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	gardenClusterIdentity string,
+	offlineBundle *offlinebundle.Bundle,
 ) error {
 	if err := (&care.Reconciler{
 		Config: *cfg.Controllers.ControllerInstallationCare,
@@ -41,6 +44,7 @@ func AddToManager(
 		Config:                cfg,
 		Identity:              identity,
 		GardenClusterIdentity: gardenClusterIdentity,
+		HelmRegistry:          offlineBundle.HelmRegistry(oci.NewHelmRegistry(gardenCluster.GetClient())),
 	}).AddToManager(ctx, mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
@@ -20,6 +20,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -63,6 +64,10 @@ const usablePortsRangeSize = 5
 // the process of being deleted when deleting a ControllerInstallation.
 var RequeueDurationWhenResourceDeletionStillPresent = 5 * time.Second
 
+// RequeueDurationWhenDependenciesNotSatisfied is the duration used for requeuing when the ControllerRegistrations the
+// extension depends on are not installed yet.
+var RequeueDurationWhenDependenciesNotSatisfied = 15 * time.Second
+
 // Reconciler reconciles ControllerInstallations and deploys them into the seed cluster or the self-hosted shoot cluster.
 type Reconciler struct {
 	GardenClient          client.Client
@@ -149,6 +154,17 @@ func (r *Reconciler) reconcile(
 		return reconcile.Result{}, err
 	}
 
+	// Extensions declaring dependencies are only installed after gardener-controller-manager reported that all of their
+	// dependencies are installed. Already installed extensions are not blocked to not prevent updates in case a
+	// dependency becomes temporarily unavailable.
+	if len(controllerRegistration.Spec.Dependencies) > 0 && conditionInstalled.Status != gardencorev1beta1.ConditionTrue {
+		if condition := v1beta1helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1beta1.ControllerInstallationDependenciesSatisfied); condition == nil || condition.Status != gardencorev1beta1.ConditionTrue {
+			log.Info("Waiting for dependencies of extension to be installed", "dependencies", controllerRegistration.Spec.Dependencies)
+			conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionFalse, "DependenciesNotSatisfied", "Installation is pending until all ControllerRegistrations this extension depends on are installed.")
+			return reconcile.Result{RequeueAfter: RequeueDurationWhenDependenciesNotSatisfied}, nil
+		}
+	}
+
 	seed := &gardencorev1beta1.Seed{}
 	if err := r.GardenClient.Get(gardenCtx, client.ObjectKey{Name: controllerInstallation.Spec.SeedRef.Name}, seed); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -169,7 +185,10 @@ func (r *Reconciler) reconcile(
 		}
 	}
 
-	var helmValues map[string]any
+	var (
+		helmValues map[string]any
+		err        error
+	)
 	if controllerDeployment.Helm != nil && controllerDeployment.Helm.Values != nil {
 		if err := json.Unmarshal(controllerDeployment.Helm.Values.Raw, &helmValues); err != nil {
 			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("chart values cannot be unmarshalled: %+v", err))
@@ -177,6 +196,14 @@ func (r *Reconciler) reconcile(
 		}
 	}
 
+	if controllerDeployment.Helm != nil && len(controllerDeployment.Helm.ValuesOverlays) > 0 {
+		helmValues, err = mergeValuesOverlays(helmValues, controllerDeployment.Helm.ValuesOverlays, seed.Labels)
+		if err != nil {
+			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("chart values overlays cannot be applied: %+v", err))
+			return reconcile.Result{}, err
+		}
+	}
+
 	seedIsGarden, err := gardenletutils.SeedIsGarden(seedCtx, r.SeedClientSet.Client())
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed checking whether the seed is the garden cluster at the same time: %w", err)
@@ -490,6 +517,34 @@ func (r *Reconciler) delete(
 	return reconcile.Result{}, nil
 }
 
+// mergeValuesOverlays merges the values of all overlays whose seed selector matches the given seed labels into the given
+// values. Overlays are merged in the order of the list, i.e., later overlays take precedence.
+func mergeValuesOverlays(values map[string]any, overlays []gardencorev1.HelmValuesOverlay, seedLabels map[string]string) (map[string]any, error) {
+	for i, overlay := range overlays {
+		selector := labels.Everything()
+		if overlay.SeedSelector != nil {
+			var err error
+			selector, err = metav1.LabelSelectorAsSelector(overlay.SeedSelector)
+			if err != nil {
+				return nil, fmt.Errorf("seed selector of values overlay %d is invalid: %w", i, err)
+			}
+		}
+
+		if !selector.Matches(labels.Set(seedLabels)) || overlay.Values == nil {
+			continue
+		}
+
+		var overlayValues map[string]any
+		if err := json.Unmarshal(overlay.Values.Raw, &overlayValues); err != nil {
+			return nil, fmt.Errorf("values of values overlay %d cannot be unmarshalled: %w", i, err)
+		}
+
+		values = utils.MergeMaps(values, overlayValues)
+	}
+
+	return values, nil
+}
+
 func patchConditions(ctx context.Context, c client.StatusClient, controllerInstallation *gardencorev1beta1.ControllerInstallation, conditions ...gardencorev1beta1.Condition) error {
 	patch := client.StrategicMergeFrom(controllerInstallation.DeepCopy())
 	controllerInstallation.Status.Conditions = v1beta1helper.MergeConditions(controllerInstallation.Status.Conditions, conditions...)
@@ -13,10 +13,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/controllerinstallation"
 )
 
@@ -190,4 +193,81 @@ var _ = Describe("Reconciler", func() {
 			}
 		})
 	})
+
+	Describe("#MergeValuesOverlays", func() {
+		var (
+			values     map[string]any
+			seedLabels map[string]string
+		)
+
+		BeforeEach(func() {
+			values = map[string]any{
+				"replicas": float64(1),
+				"resources": map[string]any{
+					"requests": map[string]any{"cpu": "100m", "memory": "128Mi"},
+				},
+			}
+			seedLabels = map[string]string{"size": "big"}
+		})
+
+		It("should return the values unchanged if no overlay matches", func() {
+			result, err := MergeValuesOverlays(values, []gardencorev1.HelmValuesOverlay{
+				{
+					SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"size": "small"}},
+					Values:       &apiextensionsv1.JSON{Raw: []byte(`{"replicas":2}`)},
+				},
+			}, seedLabels)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(values))
+		})
+
+		It("should merge matching overlays in order", func() {
+			result, err := MergeValuesOverlays(values, []gardencorev1.HelmValuesOverlay{
+				{
+					Values: &apiextensionsv1.JSON{Raw: []byte(`{"replicas":2,"foo":"bar"}`)},
+				},
+				{
+					SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"size": "big"}},
+					Values:       &apiextensionsv1.JSON{Raw: []byte(`{"replicas":3,"resources":{"requests":{"memory":"1Gi"}}}`)},
+				},
+				{
+					SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"size": "small"}},
+					Values:       &apiextensionsv1.JSON{Raw: []byte(`{"replicas":4}`)},
+				},
+			}, seedLabels)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(map[string]any{
+				"replicas": float64(3),
+				"foo":      "bar",
+				"resources": map[string]any{
+					"requests": map[string]any{"cpu": "100m", "memory": "1Gi"},
+				},
+			}))
+		})
+
+		It("should merge overlays into empty values", func() {
+			result, err := MergeValuesOverlays(nil, []gardencorev1.HelmValuesOverlay{
+				{Values: &apiextensionsv1.JSON{Raw: []byte(`{"replicas":2}`)}},
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(map[string]any{"replicas": float64(2)}))
+		})
+
+		It("should return an error if the seed selector is invalid", func() {
+			_, err := MergeValuesOverlays(values, []gardencorev1.HelmValuesOverlay{
+				{
+					SeedSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "size", Operator: "invalid"}}},
+					Values:       &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+				},
+			}, seedLabels)
+			Expect(err).To(MatchError(ContainSubstring("seed selector of values overlay 0 is invalid")))
+		})
+
+		It("should return an error if the values cannot be unmarshalled", func() {
+			_, err := MergeValuesOverlays(values, []gardencorev1.HelmValuesOverlay{
+				{Values: &apiextensionsv1.JSON{Raw: []byte(`["foo"]`)}},
+			}, seedLabels)
+			Expect(err).To(MatchError(ContainSubstring("values of values overlay 0 cannot be unmarshalled")))
+		})
+	})
 })
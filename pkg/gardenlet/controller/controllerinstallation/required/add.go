@@ -81,6 +81,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster, seedCluste
 		{extensionsv1alpha1.NetworkResource, &extensionsv1alpha1.Network{}, func() client.ObjectList { return &extensionsv1alpha1.NetworkList{} }},
 		{extensionsv1alpha1.OperatingSystemConfigResource, &extensionsv1alpha1.OperatingSystemConfig{}, func() client.ObjectList { return &extensionsv1alpha1.OperatingSystemConfigList{} }},
 		{extensionsv1alpha1.SelfHostedShootExposureResource, &extensionsv1alpha1.SelfHostedShootExposure{}, func() client.ObjectList { return &extensionsv1alpha1.SelfHostedShootExposureList{} }},
+		{extensionsv1alpha1.ShootLeftoverResource, &extensionsv1alpha1.ShootLeftover{}, func() client.ObjectList { return &extensionsv1alpha1.ShootLeftoverList{} }},
 		{extensionsv1alpha1.WorkerResource, &extensionsv1alpha1.Worker{}, func() client.ObjectList { return &extensionsv1alpha1.WorkerList{} }},
 	} {
 		eventHandler := handler.EnqueueRequestsFromMapFunc(r.MapObjectKindToControllerInstallations(
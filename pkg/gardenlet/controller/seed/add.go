@@ -16,8 +16,10 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/crd"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/lease"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
+	"github.com/gardener/gardener/pkg/gardenlet/offlinebundle"
 	"github.com/gardener/gardener/pkg/healthz"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
@@ -31,6 +33,7 @@ func AddToManager(
 	cfg gardenletconfigv1alpha1.GardenletConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	healthManager healthz.Manager,
+	offlineBundle *offlinebundle.Bundle,
 ) error {
 	var (
 		componentImageVectors imagevectorutils.ComponentImageVectors
@@ -43,6 +46,7 @@ func AddToManager(
 			return fmt.Errorf("failed reading component-specific image vector override: %w", err)
 		}
 	}
+	componentImageVectors = offlineBundle.MergeComponentImageVectors(componentImageVectors)
 
 	if err := (&care.Reconciler{
 		Config:   *cfg.Controllers.SeedCare,
@@ -51,6 +55,13 @@ func AddToManager(
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if err := (&crd.Reconciler{
+		Config:   *cfg.Controllers.SeedCRD,
+		SeedName: cfg.SeedConfig.Name,
+	}).AddToManager(mgr, gardenCluster, seedClientSet); err != nil {
+		return fmt.Errorf("failed adding CRD reconciler: %w", err)
+	}
+
 	if err := lease.AddToManager(mgr, gardenCluster, seedClientSet.RESTClient(), *cfg.Controllers.Seed, healthManager, cfg.SeedConfig.Name, nil, ptr.To(gardencorev1beta1.GardenerSeedLeaseNamespace)); err != nil {
 		return fmt.Errorf("failed adding lease reconciler: %w", err)
 	}
@@ -21,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	"github.com/gardener/gardener/imagevector"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
@@ -38,9 +39,18 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster, seedCluste
 	if r.SeedClient == nil {
 		r.SeedClient = seedCluster.GetClient()
 	}
+	if r.SeedAPIReader == nil {
+		r.SeedAPIReader = seedCluster.GetAPIReader()
+	}
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.ImageVector == nil {
+		r.ImageVector = imagevector.Containers()
+	}
+	if capacityPressure := r.Config.CapacityPressure; r.CapacityCheck == nil && capacityPressure != nil && capacityPressure.Enabled {
+		r.CapacityCheck = NewCapacityCheck(r.SeedAPIReader, r.Clock, *capacityPressure)
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
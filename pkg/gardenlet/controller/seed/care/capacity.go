@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	resourcehelper "k8s.io/component-helpers/resource"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// capacityResourceNames are the resources considered for the capacity pressure check.
+var capacityResourceNames = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// podListPageSize is the maximum number of pods read from the seed cluster with a single list request.
+const podListPageSize = 500
+
+// CapacityCheck computes whether the seed cluster is under capacity pressure. It remembers the utilization observed in
+// previous checks in order to extrapolate its growth.
+type CapacityCheck struct {
+	seedReader client.Reader
+	clock      clock.Clock
+	threshold  float64
+	horizon    time.Duration
+
+	lock    sync.Mutex
+	samples []capacitySample
+}
+
+type capacitySample struct {
+	time        time.Time
+	utilization map[corev1.ResourceName]float64
+}
+
+// NewCapacityCheck creates a new CapacityCheck instance with the given parameters. The seed reader should not be backed
+// by a cache since all pods of the seed cluster are read.
+func NewCapacityCheck(seedReader client.Reader, clock clock.Clock, config gardenletconfigv1alpha1.SeedCapacityPressure) *CapacityCheck {
+	return &CapacityCheck{
+		seedReader: seedReader,
+		clock:      clock,
+		threshold:  float64(ptr.Deref(config.Threshold, 80)) / 100,
+		horizon:    ptr.Deref(config.PredictionHorizon, metav1.Duration{Duration: time.Hour}).Duration,
+	}
+}
+
+// Check computes the SeedCapacityPressure condition. The condition is 'True' if the requested CPU or memory of all
+// pods exceeds the configured threshold of the allocatable resources of the schedulable nodes, or if this is expected
+// to happen within the prediction horizon based on the growth observed in previous checks.
+func (c *CapacityCheck) Check(ctx context.Context, condition gardencorev1beta1.Condition) gardencorev1beta1.Condition {
+	utilization, err := c.utilization(ctx)
+	if err != nil {
+		return v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, condition, err)
+	}
+
+	predictedUtilization := c.addSampleAndPredict(c.clock.Now(), utilization)
+
+	for _, resourceName := range capacityResourceNames {
+		if utilization[resourceName] >= c.threshold {
+			return v1beta1helper.UpdatedConditionWithClock(c.clock, condition, gardencorev1beta1.ConditionTrue, "CapacityPressure",
+				fmt.Sprintf("%.0f%% of the allocatable %s of the seed's nodes is requested (threshold: %.0f%%).", utilization[resourceName]*100, resourceName, c.threshold*100))
+		}
+	}
+
+	for _, resourceName := range capacityResourceNames {
+		if predicted, ok := predictedUtilization[resourceName]; ok && predicted >= c.threshold {
+			return v1beta1helper.UpdatedConditionWithClock(c.clock, condition, gardencorev1beta1.ConditionTrue, "PredictedCapacityPressure",
+				fmt.Sprintf("%.0f%% of the allocatable %s of the seed's nodes is requested and is expected to exceed the threshold of %.0f%% within %s.", utilization[resourceName]*100, resourceName, c.threshold*100, c.horizon))
+		}
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(c.clock, condition, gardencorev1beta1.ConditionFalse, "SufficientCapacity",
+		fmt.Sprintf("The requested resources are below %.0f%% of the allocatable resources of the seed's nodes.", c.threshold*100))
+}
+
+// utilization returns the ratio of the resources requested by all non-terminated pods bound to a node and the
+// allocatable resources of all schedulable nodes.
+func (c *CapacityCheck) utilization(ctx context.Context) (map[corev1.ResourceName]float64, error) {
+	nodeList := &corev1.NodeList{}
+	if err := c.seedReader.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	allocatable := make(map[corev1.ResourceName]float64, len(capacityResourceNames))
+	for _, node := range nodeList.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		for _, resourceName := range capacityResourceNames {
+			quantity := node.Status.Allocatable[resourceName]
+			allocatable[resourceName] += quantity.AsApproximateFloat64()
+		}
+	}
+
+	requested := make(map[corev1.ResourceName]float64, len(capacityResourceNames))
+	for continueToken := ""; ; {
+		podList := &corev1.PodList{}
+		if err := c.seedReader.List(ctx, podList, client.Limit(podListPageSize), client.Continue(continueToken)); err != nil {
+			return nil, fmt.Errorf("failed listing pods: %w", err)
+		}
+
+		for _, pod := range podList.Items {
+			if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+
+			// The effective requests consider init containers, sidecar containers and the pod overhead the same way as
+			// the kube-scheduler does.
+			podRequests := resourcehelper.PodRequests(&pod, resourcehelper.PodResourcesOptions{})
+			for _, resourceName := range capacityResourceNames {
+				quantity := podRequests[resourceName]
+				requested[resourceName] += quantity.AsApproximateFloat64()
+			}
+		}
+
+		if continueToken = podList.Continue; continueToken == "" {
+			break
+		}
+	}
+
+	utilization := make(map[corev1.ResourceName]float64, len(capacityResourceNames))
+	for _, resourceName := range capacityResourceNames {
+		if allocatable[resourceName] == 0 {
+			return nil, errors.New("no allocatable resources found on schedulable nodes")
+		}
+		utilization[resourceName] = requested[resourceName] / allocatable[resourceName]
+	}
+
+	return utilization, nil
+}
+
+// addSampleAndPredict remembers the given utilization and returns the utilization extrapolated to the end of the
+// prediction horizon. The extrapolation is based on a linear regression of the samples within the prediction horizon.
+// No prediction is returned if there are not enough samples yet.
+func (c *CapacityCheck) addSampleAndPredict(now time.Time, utilization map[corev1.ResourceName]float64) map[corev1.ResourceName]float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var samples []capacitySample
+	for _, sample := range c.samples {
+		if now.Sub(sample.time) <= c.horizon {
+			samples = append(samples, sample)
+		}
+	}
+	c.samples = append(samples, capacitySample{time: now, utilization: utilization})
+
+	if len(c.samples) < 2 || c.horizon == 0 {
+		return nil
+	}
+
+	predicted := make(map[corev1.ResourceName]float64, len(capacityResourceNames))
+	for _, resourceName := range capacityResourceNames {
+		var (
+			n                        = float64(len(c.samples))
+			sumX, sumY, sumXY, sumXX float64
+		)
+
+		for _, sample := range c.samples {
+			x := sample.time.Sub(now).Seconds()
+			y := sample.utilization[resourceName]
+			sumX += x
+			sumY += y
+			sumXY += x * y
+			sumXX += x * x
+		}
+
+		denominator := n*sumXX - sumX*sumX
+		if denominator == 0 {
+			continue
+		}
+
+		slope := (n*sumXY - sumX*sumY) / denominator
+		if slope <= 0 {
+			continue
+		}
+
+		predicted[resourceName] = utilization[resourceName] + slope*c.horizon.Seconds()
+	}
+
+	return predicted
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
+)
+
+var _ = Describe("CapacityCheck", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClock     *testclock.FakeClock
+		seedClient    client.Client
+		capacityCheck *CapacityCheck
+		condition     gardencorev1beta1.Condition
+
+		requests = func(cpu, memory string) corev1.ResourceRequirements {
+			return corev1.ResourceRequirements{Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			}}
+		}
+
+		createPod = func(mutate func(*corev1.Pod)) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{GenerateName: "pod-", Namespace: "shoot--foo--bar"},
+				Spec: corev1.PodSpec{
+					NodeName:   "node-1",
+					Containers: []corev1.Container{{Name: "app"}},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			}
+			mutate(pod)
+			ExpectWithOffset(1, seedClient.Create(ctx, pod)).To(Succeed())
+		}
+
+		addPod = func(cpu, memory string, phase corev1.PodPhase) {
+			createPod(func(pod *corev1.Pod) {
+				pod.Spec.Containers[0].Resources = requests(cpu, memory)
+				pod.Status.Phase = phase
+			})
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		capacityCheck = NewCapacityCheck(seedClient, fakeClock, gardenletconfigv1alpha1.SeedCapacityPressure{
+			Enabled:           true,
+			Threshold:         ptr.To[int32](80),
+			PredictionHorizon: &metav1.Duration{Duration: time.Hour},
+		})
+		condition = gardencorev1beta1.Condition{Type: gardencorev1beta1.SeedCapacityPressure}
+
+		for _, node := range []*corev1.Node{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+				Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "cordoned"},
+				Spec:       corev1.NodeSpec{Unschedulable: true},
+				Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100"),
+					corev1.ResourceMemory: resource.MustParse("100Gi"),
+				}},
+			},
+		} {
+			Expect(seedClient.Create(ctx, node)).To(Succeed())
+		}
+	})
+
+	It("should report sufficient capacity", func() {
+		addPod("2", "8Gi", corev1.PodRunning)
+
+		Expect(capacityCheck.Check(ctx, condition)).To(And(
+			HaveField("Status", gardencorev1beta1.ConditionFalse),
+			HaveField("Reason", "SufficientCapacity"),
+		))
+	})
+
+	It("should report capacity pressure if the requested resources exceed the threshold", func() {
+		addPod("7", "8Gi", corev1.PodRunning)
+
+		Expect(capacityCheck.Check(ctx, condition)).To(And(
+			HaveField("Status", gardencorev1beta1.ConditionTrue),
+			HaveField("Reason", "CapacityPressure"),
+			HaveField("Message", "88% of the allocatable cpu of the seed's nodes is requested (threshold: 80%)."),
+		))
+	})
+
+	It("should not consider terminated pods", func() {
+		addPod("2", "8Gi", corev1.PodRunning)
+		addPod("6", "8Gi", corev1.PodSucceeded)
+		addPod("6", "8Gi", corev1.PodFailed)
+
+		Expect(capacityCheck.Check(ctx, condition)).To(HaveField("Status", gardencorev1beta1.ConditionFalse))
+	})
+
+	It("should not consider pods which are not bound to a node", func() {
+		addPod("2", "8Gi", corev1.PodRunning)
+		createPod(func(pod *corev1.Pod) {
+			pod.Spec.NodeName = ""
+			pod.Spec.Containers[0].Resources = requests("6", "8Gi")
+			pod.Status.Phase = corev1.PodPending
+		})
+
+		Expect(capacityCheck.Check(ctx, condition)).To(HaveField("Status", gardencorev1beta1.ConditionFalse))
+	})
+
+	It("should consider the largest init container if it requests more than the containers", func() {
+		createPod(func(pod *corev1.Pod) {
+			pod.Spec.InitContainers = []corev1.Container{
+				{Name: "init-1", Resources: requests("7", "1Gi")},
+				{Name: "init-2", Resources: requests("1", "1Gi")},
+			}
+			pod.Spec.Containers = []corev1.Container{
+				{Name: "app-1", Resources: requests("1", "4Gi")},
+				{Name: "app-2", Resources: requests("1", "4Gi")},
+			}
+		})
+
+		Expect(capacityCheck.Check(ctx, condition)).To(And(
+			HaveField("Status", gardencorev1beta1.ConditionTrue),
+			HaveField("Reason", "CapacityPressure"),
+			HaveField("Message", "88% of the allocatable cpu of the seed's nodes is requested (threshold: 80%)."),
+		))
+	})
+
+	It("should consider the containers if they request more than the largest init container", func() {
+		createPod(func(pod *corev1.Pod) {
+			pod.Spec.InitContainers = []corev1.Container{{Name: "init", Resources: requests("4", "1Gi")}}
+			pod.Spec.Containers = []corev1.Container{
+				{Name: "app-1", Resources: requests("3", "4Gi")},
+				{Name: "app-2", Resources: requests("3", "4Gi")},
+			}
+		})
+
+		Expect(capacityCheck.Check(ctx, condition)).To(And(
+			HaveField("Status", gardencorev1beta1.ConditionFalse),
+			HaveField("Reason", "SufficientCapacity"),
+		))
+	})
+
+	It("should consider the pod overhead", func() {
+		createPod(func(pod *corev1.Pod) {
+			pod.Spec.Containers[0].Resources = requests("6", "8Gi")
+			pod.Spec.Overhead = corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			}
+		})
+
+		Expect(capacityCheck.Check(ctx, condition)).To(And(
+			HaveField("Status", gardencorev1beta1.ConditionTrue),
+			HaveField("Reason", "CapacityPressure"),
+			HaveField("Message", "88% of the allocatable cpu of the seed's nodes is requested (threshold: 80%)."),
+		))
+	})
+
+	It("should report predicted capacity pressure if the requested resources grow quickly", func() {
+		addPod("1", "8Gi", corev1.PodRunning)
+		Expect(capacityCheck.Check(ctx, condition)).To(HaveField("Reason", "SufficientCapacity"))
+
+		fakeClock.Step(10 * time.Minute)
+		addPod("1", "0", corev1.PodRunning)
+		Expect(capacityCheck.Check(ctx, condition)).To(And(
+			HaveField("Status", gardencorev1beta1.ConditionTrue),
+			HaveField("Reason", "PredictedCapacityPressure"),
+			HaveField("Message", "25% of the allocatable cpu of the seed's nodes is requested and is expected to exceed the threshold of 80% within 1h0m0s."),
+		))
+	})
+
+	It("should not report predicted capacity pressure if the requested resources grow slowly", func() {
+		addPod("1", "8Gi", corev1.PodRunning)
+		Expect(capacityCheck.Check(ctx, condition)).To(HaveField("Reason", "SufficientCapacity"))
+
+		fakeClock.Step(30 * time.Minute)
+		addPod("250m", "0", corev1.PodRunning)
+		Expect(capacityCheck.Check(ctx, condition)).To(HaveField("Reason", "SufficientCapacity"))
+	})
+
+	It("should not report predicted capacity pressure if the requested resources shrink", func() {
+		addPod("6", "8Gi", corev1.PodRunning)
+		Expect(capacityCheck.Check(ctx, condition)).To(HaveField("Reason", "SufficientCapacity"))
+
+		fakeClock.Step(10 * time.Minute)
+		Expect(seedClient.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace("shoot--foo--bar"))).To(Succeed())
+		addPod("2", "8Gi", corev1.PodRunning)
+		Expect(capacityCheck.Check(ctx, condition)).To(HaveField("Reason", "SufficientCapacity"))
+	})
+
+	It("should report an unknown status if there are no schedulable nodes", func() {
+		Expect(seedClient.DeleteAllOf(ctx, &corev1.Node{})).To(Succeed())
+
+		Expect(capacityCheck.Check(ctx, condition)).To(HaveField("Status", gardencorev1beta1.ConditionUnknown))
+	})
+})
@@ -18,6 +18,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	commonprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
+	"github.com/gardener/gardener/pkg/component/seed/ingresscertificate"
 	"github.com/gardener/gardener/pkg/features"
 	healthchecker "github.com/gardener/gardener/pkg/utils/kubernetes/health/checker"
 )
@@ -98,7 +99,8 @@ func (h *health) listPrometheuses(ctx context.Context) (*monitoringv1.Prometheus
 
 func (h *health) checkSystemComponents(ctx context.Context, condition gardencorev1beta1.Condition, managedResources []resourcesv1alpha1.ManagedResource, prometheuses *monitoringv1.PrometheusList) *gardencorev1beta1.Condition {
 	if exitCondition := h.healthChecker.CheckManagedResources(condition, managedResources, func(managedResource resourcesv1alpha1.ManagedResource) bool {
-		return managedResource.Spec.Class != nil
+		// The health of the ingress wildcard certificate is reported in a dedicated condition.
+		return managedResource.Spec.Class != nil && managedResource.Name != ingresscertificate.ManagedResourceName
 	}, nil); exitCondition != nil {
 		return exitCondition
 	}
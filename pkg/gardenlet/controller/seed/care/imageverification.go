@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+const (
+	// imageVerificationRecheckInterval is the interval after which the verification of an image is repeated. Images
+	// which are pinned to a digest and were verified successfully are not verified again.
+	imageVerificationRecheckInterval = time.Hour
+	// maxReportedUnverifiableImages is the maximum number of unverifiable images listed in the condition message.
+	maxReportedUnverifiableImages = 10
+)
+
+// ImageVerificationCheck verifies the container images of the image vector deployed by gardenlet. It remembers the
+// results of previous checks in order to limit the requests to the registries.
+type ImageVerificationCheck struct {
+	verifier             imagevectorutils.Verifier
+	clock                clock.Clock
+	imageVector          imagevectorutils.ImageVector
+	requirePinnedDigests bool
+
+	lock    sync.Mutex
+	results map[string]imageVerificationResult
+}
+
+type imageVerificationResult struct {
+	time time.Time
+	err  error
+}
+
+// NewImageVerificationCheck creates a new ImageVerificationCheck instance with the given parameters.
+func NewImageVerificationCheck(verifier imagevectorutils.Verifier, clock clock.Clock, imageVector imagevectorutils.ImageVector, config gardenletconfigv1alpha1.SeedImageVerification) *ImageVerificationCheck {
+	return &ImageVerificationCheck{
+		verifier:             verifier,
+		clock:                clock,
+		imageVector:          imageVector,
+		requirePinnedDigests: config.RequirePinnedDigests,
+		results:              make(map[string]imageVerificationResult),
+	}
+}
+
+// Check computes the SeedImagesVerified condition. The condition is 'True' if all images of the image vector could be
+// verified. Otherwise, it is 'False' and the message lists the unverifiable images.
+func (c *ImageVerificationCheck) Check(ctx context.Context, condition gardencorev1beta1.Condition) gardencorev1beta1.Condition {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	images := c.images()
+
+	var unverifiable []string
+	for _, image := range images {
+		if err := c.verify(ctx, image); err != nil {
+			unverifiable = append(unverifiable, fmt.Sprintf("%s: %s", image, err))
+		}
+	}
+
+	if len(unverifiable) == 0 {
+		return v1beta1helper.UpdatedConditionWithClock(c.clock, condition, gardencorev1beta1.ConditionTrue, "ImagesVerified",
+			fmt.Sprintf("All %d images were verified successfully.", len(images)))
+	}
+
+	message := fmt.Sprintf("%d of %d images could not be verified: ", len(unverifiable), len(images))
+	if len(unverifiable) > maxReportedUnverifiableImages {
+		message += strings.Join(unverifiable[:maxReportedUnverifiableImages], "; ") + fmt.Sprintf("; and %d more", len(unverifiable)-maxReportedUnverifiableImages)
+	} else {
+		message += strings.Join(unverifiable, "; ")
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(c.clock, condition, gardencorev1beta1.ConditionFalse, "UnverifiableImages", message)
+}
+
+// images returns the sorted list of concrete images of the image vector. Image sources without tag are skipped since
+// their tag is only determined when the image is deployed.
+func (c *ImageVerificationCheck) images() []string {
+	var images []string
+	for _, source := range c.imageVector {
+		image := source.ToImage(nil)
+		if image.Ref == nil && image.Tag == nil {
+			continue
+		}
+		if s := image.String(); !slices.Contains(images, s) {
+			images = append(images, s)
+		}
+	}
+	slices.Sort(images)
+	return images
+}
+
+func (c *ImageVerificationCheck) verify(ctx context.Context, image string) error {
+	pinned := (&imagevectorutils.Image{Ref: &image}).IsPinned()
+	if c.requirePinnedDigests && !pinned {
+		return errors.New("image is not pinned to a digest")
+	}
+
+	now := c.clock.Now()
+	if result, ok := c.results[image]; ok && ((pinned && result.err == nil) || now.Sub(result.time) < imageVerificationRecheckInterval) {
+		return result.err
+	}
+
+	err := c.verifier.Verify(ctx, image)
+	// Do not remember failures caused by the cancellation of the context.
+	if ctx.Err() == nil {
+		c.results[image] = imageVerificationResult{time: now, err: err}
+	}
+	return err
+}
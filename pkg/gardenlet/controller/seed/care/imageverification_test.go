@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("ImageVerificationCheck", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClock   *testclock.FakeClock
+		imageVector imagevectorutils.ImageVector
+		verified    []string
+		failures    map[string]error
+		verifier    imagevectorutils.Verifier
+		condition   gardencorev1beta1.Condition
+
+		digest = "sha256:" + strings.Repeat("a", 64)
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		imageVector = imagevectorutils.ImageVector{
+			{Name: "foo", Repository: ptr.To("registry.local/foo"), Tag: ptr.To("v1")},
+			{Name: "bar", Repository: ptr.To("registry.local/bar"), Tag: ptr.To("v2"), Digest: &digest},
+			{Name: "baz", Ref: ptr.To("registry.local/baz:v3")},
+			{Name: "hyperkube", Repository: ptr.To("registry.local/hyperkube")},
+		}
+		verified = nil
+		failures = map[string]error{}
+		verifier = verifierFunc(func(image string) error {
+			verified = append(verified, image)
+			return failures[image]
+		})
+		condition = gardencorev1beta1.Condition{Type: gardencorev1beta1.SeedImagesVerified}
+	})
+
+	It("should set the condition to true if all images are verified", func() {
+		check := NewImageVerificationCheck(verifier, fakeClock, imageVector, gardenletconfigv1alpha1.SeedImageVerification{Enabled: true})
+
+		updatedCondition := check.Check(ctx, condition)
+		Expect(updatedCondition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(updatedCondition.Reason).To(Equal("ImagesVerified"))
+		Expect(updatedCondition.Message).To(Equal("All 3 images were verified successfully."))
+		Expect(verified).To(ConsistOf("registry.local/foo:v1", "registry.local/bar:v2@"+digest, "registry.local/baz:v3"))
+	})
+
+	It("should set the condition to false and list the unverifiable images", func() {
+		failures["registry.local/foo:v1"] = errors.New("no signatures found")
+		check := NewImageVerificationCheck(verifier, fakeClock, imageVector, gardenletconfigv1alpha1.SeedImageVerification{Enabled: true})
+
+		updatedCondition := check.Check(ctx, condition)
+		Expect(updatedCondition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(updatedCondition.Reason).To(Equal("UnverifiableImages"))
+		Expect(updatedCondition.Message).To(Equal("1 of 3 images could not be verified: registry.local/foo:v1: no signatures found"))
+	})
+
+	It("should report images which are not pinned to a digest if required", func() {
+		check := NewImageVerificationCheck(verifier, fakeClock, imageVector, gardenletconfigv1alpha1.SeedImageVerification{Enabled: true, RequirePinnedDigests: true})
+
+		updatedCondition := check.Check(ctx, condition)
+		Expect(updatedCondition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(updatedCondition.Message).To(Equal("2 of 3 images could not be verified: registry.local/baz:v3: image is not pinned to a digest; registry.local/foo:v1: image is not pinned to a digest"))
+		Expect(verified).To(ConsistOf("registry.local/bar:v2@" + digest))
+	})
+
+	It("should truncate the list of unverifiable images", func() {
+		imageVector = nil
+		for i := range 12 {
+			image := fmt.Sprintf("registry.local/image%02d:v1", i)
+			imageVector = append(imageVector, &imagevectorutils.ImageSource{Name: fmt.Sprintf("image%02d", i), Ref: &image})
+			failures[image] = errors.New("failed")
+		}
+		check := NewImageVerificationCheck(verifier, fakeClock, imageVector, gardenletconfigv1alpha1.SeedImageVerification{Enabled: true})
+
+		updatedCondition := check.Check(ctx, condition)
+		Expect(updatedCondition.Message).To(HavePrefix("12 of 12 images could not be verified: registry.local/image00:v1: failed;"))
+		Expect(updatedCondition.Message).To(HaveSuffix("registry.local/image09:v1: failed; and 2 more"))
+	})
+
+	It("should remember the verification results", func() {
+		failures["registry.local/foo:v1"] = errors.New("no signatures found")
+		check := NewImageVerificationCheck(verifier, fakeClock, imageVector, gardenletconfigv1alpha1.SeedImageVerification{Enabled: true})
+
+		check.Check(ctx, condition)
+		Expect(verified).To(HaveLen(3))
+
+		By("Do not verify images again within the recheck interval")
+		verified = nil
+		fakeClock.Step(30 * time.Minute)
+		Expect(check.Check(ctx, condition).Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(verified).To(BeEmpty())
+
+		By("Verify images again after the recheck interval except for successfully verified pinned images")
+		delete(failures, "registry.local/foo:v1")
+		fakeClock.Step(time.Hour)
+		Expect(check.Check(ctx, condition).Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(verified).To(ConsistOf("registry.local/foo:v1", "registry.local/baz:v3"))
+	})
+})
+
+type verifierFunc func(image string) error
+
+func (f verifierFunc) Verify(_ context.Context, image string) error {
+	return f(image)
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/seed/ingresscertificate"
+	healthutils "github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+// CheckIngressWildcardCertificate computes the SeedIngressWildcardCertificateReady condition. The condition is 'True'
+// if the wildcard certificate for the ingress domain was issued and is not expired. Otherwise, it is 'False' and the
+// message contains the reason why the certificate is not (or no longer) ready, e.g., a failed renewal.
+func CheckIngressWildcardCertificate(ctx context.Context, c client.Client, clock clock.Clock, namespace string, condition gardencorev1beta1.Condition) gardencorev1beta1.Condition {
+	managedResource := &resourcesv1alpha1.ManagedResource{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ingresscertificate.ManagedResourceName, Namespace: namespace}, managedResource); err != nil {
+		if apierrors.IsNotFound(err) {
+			return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, "CertificateNotRequested", "The wildcard certificate has not been requested yet.")
+		}
+		return v1beta1helper.UpdatedConditionUnknownErrorWithClock(clock, condition, err)
+	}
+
+	if err := healthutils.CheckManagedResource(managedResource); err != nil {
+		return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, "CertificateNotReady", err.Error())
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ingresscertificate.SecretName, Namespace: namespace}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, "CertificateNotIssued", "The wildcard certificate has not been issued yet.")
+		}
+		return v1beta1helper.UpdatedConditionUnknownErrorWithClock(clock, condition, err)
+	}
+
+	notAfter, err := certificateNotAfter(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, "CertificateInvalid", fmt.Sprintf("The wildcard certificate cannot be parsed: %v", err))
+	}
+
+	if !clock.Now().Before(notAfter) {
+		return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, "CertificateExpired",
+			fmt.Sprintf("The wildcard certificate expired at %s.", notAfter.UTC().Format(time.RFC3339)))
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionTrue, "CertificateReady",
+		fmt.Sprintf("The wildcard certificate is valid until %s.", notAfter.UTC().Format(time.RFC3339)))
+}
+
+func certificateNotAfter(data []byte) (time.Time, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return time.Time{}, errors.New("no PEM data found")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return certificate.NotAfter, nil
+}
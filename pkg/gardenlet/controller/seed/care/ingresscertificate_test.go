@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("CheckIngressWildcardCertificate", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "garden"
+
+		c         client.Client
+		fakeClock *testclock.FakeClock
+		condition gardencorev1beta1.Condition
+
+		managedResource *resourcesv1alpha1.ManagedResource
+		secret          *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+		condition = gardencorev1beta1.Condition{Type: gardencorev1beta1.SeedIngressWildcardCertificateReady}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed-ingress-wildcard-certificate", Namespace: namespace, Generation: 1},
+			Spec:       resourcesv1alpha1.ManagedResourceSpec{Class: ptr.To("seed")},
+			Status: resourcesv1alpha1.ManagedResourceStatus{
+				ObservedGeneration: 1,
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+					{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+				},
+			},
+		}
+
+		certificate, err := (&secretsutils.CertificateSecretConfig{
+			Name:       "wildcard",
+			CommonName: "*.ingress.seed.example.com",
+			CertType:   secretsutils.ServerCert,
+			Validity:   ptr.To(time.Hour),
+		}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed-ingress-wildcard-certificate", Namespace: namespace},
+			Data:       map[string][]byte{"tls.crt": certificate.CertificatePEM},
+		}
+	})
+
+	It("should set the condition to false if the certificate was not requested", func() {
+		updatedCondition := CheckIngressWildcardCertificate(ctx, c, fakeClock, namespace, condition)
+		Expect(updatedCondition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(updatedCondition.Reason).To(Equal("CertificateNotRequested"))
+	})
+
+	It("should set the condition to false if the certificate is not ready", func() {
+		managedResource.Status.Conditions[1].Status = gardencorev1beta1.ConditionFalse
+		managedResource.Status.Conditions[1].Message = `certificate state is "Error" ("Ready" expected)`
+		Expect(c.Create(ctx, managedResource)).To(Succeed())
+
+		updatedCondition := CheckIngressWildcardCertificate(ctx, c, fakeClock, namespace, condition)
+		Expect(updatedCondition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(updatedCondition.Reason).To(Equal("CertificateNotReady"))
+		Expect(updatedCondition.Message).To(ContainSubstring(`certificate state is "Error"`))
+	})
+
+	It("should set the condition to false if the certificate was not issued", func() {
+		Expect(c.Create(ctx, managedResource)).To(Succeed())
+
+		updatedCondition := CheckIngressWildcardCertificate(ctx, c, fakeClock, namespace, condition)
+		Expect(updatedCondition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(updatedCondition.Reason).To(Equal("CertificateNotIssued"))
+	})
+
+	It("should set the condition to true if the certificate is valid", func() {
+		Expect(c.Create(ctx, managedResource)).To(Succeed())
+		Expect(c.Create(ctx, secret)).To(Succeed())
+
+		updatedCondition := CheckIngressWildcardCertificate(ctx, c, fakeClock, namespace, condition)
+		Expect(updatedCondition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(updatedCondition.Reason).To(Equal("CertificateReady"))
+		Expect(updatedCondition.Message).To(HavePrefix("The wildcard certificate is valid until"))
+	})
+
+	It("should set the condition to false if the certificate expired", func() {
+		Expect(c.Create(ctx, managedResource)).To(Succeed())
+		Expect(c.Create(ctx, secret)).To(Succeed())
+		fakeClock.Step(2 * time.Hour)
+
+		updatedCondition := CheckIngressWildcardCertificate(ctx, c, fakeClock, namespace, condition)
+		Expect(updatedCondition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(updatedCondition.Reason).To(Equal("CertificateExpired"))
+	})
+})
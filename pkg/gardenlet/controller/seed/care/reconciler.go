@@ -14,6 +14,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -21,7 +22,9 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health/checker"
 )
@@ -37,6 +40,17 @@ type Reconciler struct {
 	Clock        clock.Clock
 	Namespace    *string
 	SeedName     string
+	// SeedAPIReader is an uncached reader for the seed cluster used by the capacity pressure check.
+	SeedAPIReader client.Reader
+	// ImageVector is the image vector whose images are verified if the image verification is enabled.
+	ImageVector imagevectorutils.ImageVector
+	// ImageVerifier is used for verifying the images. If nil, a cosign verifier is created based on the configuration.
+	ImageVerifier imagevectorutils.Verifier
+	// CapacityCheck computes the capacity pressure condition. It is created when the reconciler is added to the manager
+	// if the check is enabled. If nil, the condition is removed.
+	CapacityCheck *CapacityCheck
+
+	imageVerificationCheck *ImageVerificationCheck
 }
 
 // Reconcile reconciles Seed resources and executes health check operations.
@@ -76,11 +90,71 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 		seedConditions,
 	)
 
+	var (
+		existingConditions = seedConditions.ConvertToSlice()
+		conditionTypes     = seedConditions.ConditionTypes()
+	)
+
+	// Trigger capacity pressure check
+	if r.CapacityCheck != nil {
+		capacityPressureCondition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedCapacityPressure)
+		existingConditions = append(existingConditions, capacityPressureCondition)
+		conditionTypes = append(conditionTypes, gardencorev1beta1.SeedCapacityPressure)
+		updatedConditions = append(updatedConditions, r.CapacityCheck.Check(ctx, capacityPressureCondition))
+	} else if capacityPressureCondition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedCapacityPressure); capacityPressureCondition != nil {
+		// Remove the condition if the check was disabled.
+		existingConditions = append(existingConditions, *capacityPressureCondition)
+		conditionTypes = append(conditionTypes, gardencorev1beta1.SeedCapacityPressure)
+	}
+
+	// Trigger image verification check
+	if imageVerification := r.Config.ImageVerification; imageVerification != nil && imageVerification.Enabled {
+		if r.imageVerificationCheck == nil {
+			verifier := r.ImageVerifier
+			if verifier == nil {
+				keyless := &imagevectorutils.KeylessOptions{
+					FulcioRoots:     imageVerification.FulcioRoots,
+					RekorPublicKeys: imageVerification.RekorPublicKeys,
+				}
+				for _, identity := range imageVerification.KeylessIdentities {
+					keyless.Identities = append(keyless.Identities, imagevectorutils.KeylessIdentity{Issuer: identity.Issuer, Subject: identity.Subject})
+				}
+
+				cosignVerifier, err := imagevectorutils.NewCosignVerifier(imageVerification.PublicKeys, keyless)
+				if err != nil {
+					return reconcile.Result{}, fmt.Errorf("failed creating image verifier: %w", err)
+				}
+				verifier = cosignVerifier
+			}
+			r.imageVerificationCheck = NewImageVerificationCheck(verifier, r.Clock, r.ImageVector, *imageVerification)
+		}
+		imagesVerifiedCondition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedImagesVerified)
+		existingConditions = append(existingConditions, imagesVerifiedCondition)
+		conditionTypes = append(conditionTypes, gardencorev1beta1.SeedImagesVerified)
+		updatedConditions = append(updatedConditions, r.imageVerificationCheck.Check(ctx, imagesVerifiedCondition))
+	} else if imagesVerifiedCondition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedImagesVerified); imagesVerifiedCondition != nil {
+		// Remove the condition if the check was disabled.
+		existingConditions = append(existingConditions, *imagesVerifiedCondition)
+		conditionTypes = append(conditionTypes, gardencorev1beta1.SeedImagesVerified)
+	}
+
+	// Trigger ingress wildcard certificate check
+	if seed.Spec.Ingress != nil && seed.Spec.Ingress.WildcardCertificate != nil {
+		ingressCertificateCondition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedIngressWildcardCertificateReady)
+		existingConditions = append(existingConditions, ingressCertificateCondition)
+		conditionTypes = append(conditionTypes, gardencorev1beta1.SeedIngressWildcardCertificateReady)
+		updatedConditions = append(updatedConditions, CheckIngressWildcardCertificate(ctx, r.SeedClient, r.Clock, ptr.Deref(r.Namespace, v1beta1constants.GardenNamespace), ingressCertificateCondition))
+	} else if ingressCertificateCondition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedIngressWildcardCertificateReady); ingressCertificateCondition != nil {
+		// Remove the condition if the wildcard certificate is no longer requested.
+		existingConditions = append(existingConditions, *ingressCertificateCondition)
+		conditionTypes = append(conditionTypes, gardencorev1beta1.SeedIngressWildcardCertificateReady)
+	}
+
 	// Update Seed status conditions if necessary
-	if v1beta1helper.ConditionsNeedUpdate(seedConditions.ConvertToSlice(), updatedConditions) {
+	if v1beta1helper.ConditionsNeedUpdate(existingConditions, updatedConditions) {
 		// Rebuild seed conditions to ensure that only the conditions with the
 		// correct types will be updated, and any other conditions will remain intact
-		conditions := v1beta1helper.BuildConditions(seed.Status.Conditions, updatedConditions, seedConditions.ConditionTypes())
+		conditions := v1beta1helper.BuildConditions(seed.Status.Conditions, updatedConditions, conditionTypes)
 
 		log.Info("Updating seed status conditions")
 		patch := client.StrategicMergeFrom(seed.DeepCopy())
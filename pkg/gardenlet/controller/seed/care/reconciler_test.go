@@ -12,9 +12,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -25,6 +28,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health/checker"
 	"github.com/gardener/gardener/pkg/utils/test"
 )
@@ -161,6 +165,90 @@ var _ = Describe("Seed Care Control", func() {
 				})
 			})
 
+			Context("when capacity pressure check is configured", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithVars(&NewHealthCheck,
+						healthCheckFunc(func(_ SeedConditions) []gardencorev1beta1.Condition { return nil })))
+
+					Expect(seedClient.Create(ctx, &corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: "node"},
+						Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("4"),
+							corev1.ResourceMemory: resource.MustParse("16Gi"),
+						}},
+					})).To(Succeed())
+				})
+
+				It("should set the capacity pressure condition if the check is enabled", func() {
+					reconciler.CapacityCheck = NewCapacityCheck(seedClient, fakeClock, gardenletconfigv1alpha1.SeedCapacityPressure{Enabled: true})
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedSeed := &gardencorev1beta1.Seed{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
+					Expect(updatedSeed.Status.Conditions).To(ConsistOf(And(
+						HaveField("Type", gardencorev1beta1.SeedCapacityPressure),
+						HaveField("Status", gardencorev1beta1.ConditionFalse),
+						HaveField("Reason", "SufficientCapacity"),
+					)))
+				})
+
+				It("should remove the capacity pressure condition if the check is disabled", func() {
+					seed.Status = gardencorev1beta1.SeedStatus{
+						Conditions: []gardencorev1beta1.Condition{{
+							Type:   gardencorev1beta1.SeedCapacityPressure,
+							Status: gardencorev1beta1.ConditionTrue,
+						}},
+					}
+					Expect(gardenClient.Status().Update(ctx, seed)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedSeed := &gardencorev1beta1.Seed{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
+					Expect(updatedSeed.Status.Conditions).To(BeEmpty())
+				})
+			})
+
+			Context("when image verification is configured", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithVars(&NewHealthCheck,
+						healthCheckFunc(func(_ SeedConditions) []gardencorev1beta1.Condition { return nil })))
+				})
+
+				It("should set the images verified condition if the check is enabled", func() {
+					reconciler.Config.ImageVerification = &gardenletconfigv1alpha1.SeedImageVerification{Enabled: true}
+					reconciler.ImageVector = imagevectorutils.ImageVector{{Name: "foo", Repository: ptr.To("registry.local/foo"), Tag: ptr.To("v1")}}
+					reconciler.ImageVerifier = verifierFunc(func(string) error { return nil })
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedSeed := &gardencorev1beta1.Seed{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
+					Expect(updatedSeed.Status.Conditions).To(ConsistOf(And(
+						HaveField("Type", gardencorev1beta1.SeedImagesVerified),
+						HaveField("Status", gardencorev1beta1.ConditionTrue),
+						HaveField("Reason", "ImagesVerified"),
+					)))
+				})
+
+				It("should remove the images verified condition if the check is disabled", func() {
+					seed.Status = gardencorev1beta1.SeedStatus{
+						Conditions: []gardencorev1beta1.Condition{{
+							Type:   gardencorev1beta1.SeedImagesVerified,
+							Status: gardencorev1beta1.ConditionFalse,
+						}},
+					}
+					Expect(gardenClient.Status().Update(ctx, seed)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedSeed := &gardencorev1beta1.Seed{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
+					Expect(updatedSeed.Status.Conditions).To(BeEmpty())
+				})
+			})
+
 			Context("when conditions are changed", func() {
 				var conditions []gardencorev1beta1.Condition
 
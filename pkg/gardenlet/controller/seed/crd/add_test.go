@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package crd_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/crd"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#SeedPredicate", func() {
+		var (
+			p    predicate.Predicate
+			seed *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{SeedName: "seed"}).SeedPredicate()
+			seed = &gardencorev1beta1.Seed{}
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{})).To(BeTrue())
+		})
+
+		It("should return false for update events with non-seed objects", func() {
+			Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: seed})).To(BeFalse())
+		})
+
+		It("should return false for update events without relevant changes", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed.DeepCopy()})).To(BeFalse())
+		})
+
+		It("should return true for update events if the generation changed", func() {
+			newSeed := seed.DeepCopy()
+			newSeed.Generation++
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: newSeed})).To(BeTrue())
+		})
+
+		It("should return true for update events if the reconciliation finished successfully", func() {
+			seed.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeCreate,
+				State: gardencorev1beta1.LastOperationStateProcessing,
+			}
+			newSeed := seed.DeepCopy()
+			newSeed.Status.LastOperation.State = gardencorev1beta1.LastOperationStateSucceeded
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: newSeed})).To(BeTrue())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+		})
+	})
+})
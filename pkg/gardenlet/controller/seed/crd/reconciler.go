@@ -0,0 +1,285 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package crd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	resourcesv1alpha1helper "github.com/gardener/gardener/pkg/api/resources/v1alpha1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/autoscaling/vpa"
+	"github.com/gardener/gardener/pkg/component/crddeployer"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/component/networking/istio"
+	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
+	seedcrds "github.com/gardener/gardener/pkg/component/seed/crds"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+)
+
+const (
+	// LabelManagedBy is the label key used by other actors (e.g., Helm) to indicate that they manage a resource.
+	LabelManagedBy = "app.kubernetes.io/managed-by"
+
+	// ReasonCustomResourceDefinitionsReady is the reason used when all custom resource definitions are free of
+	// conflicts.
+	ReasonCustomResourceDefinitionsReady = "CustomResourceDefinitionsReady"
+	// ReasonConflictingCustomResourceDefinitions is the reason used when at least one custom resource definition
+	// conflicts with the version managed by another actor or with the versions stored in etcd.
+	ReasonConflictingCustomResourceDefinitions = "ConflictingCustomResourceDefinitions"
+)
+
+// Reconciler reconciles the custom resource definitions gardenlet installs in the seed cluster. They are managed via
+// ManagedResources, and custom resource definitions which conflict with versions owned by other actors are not
+// touched but reported in the SeedCustomResourceDefinitionsReady condition of the Seed.
+type Reconciler struct {
+	GardenClient    client.Client
+	SeedClient      client.Client
+	Config          gardenletconfigv1alpha1.SeedCRDControllerConfiguration
+	Clock           clock.Clock
+	SeedName        string
+	SeedVersion     *semver.Version
+	GardenNamespace string
+}
+
+// Reconcile reconciles the custom resource definitions in the seed cluster.
+func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(reconcileCtx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(reconcileCtx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if seed.DeletionTimestamp != nil {
+		log.V(1).Info("Seed is being deleted, custom resource definitions are cleaned up by the seed controller")
+		return reconcile.Result{}, nil
+	}
+
+	// The ManagedResources can only be reconciled after gardener-resource-manager was deployed during the initial
+	// reconciliation of the seed. Until then, the custom resource definitions are bootstrapped by the seed controller.
+	if !seedBootstrapped(seed) {
+		log.V(1).Info("Seed was not yet bootstrapped, requeueing", "requeueAfter", r.Config.SyncPeriod.Duration)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	ctx, cancel := controllerutils.GetChildReconciliationContext(reconcileCtx, r.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	seedIsGarden, err := gardenletutils.SeedIsGarden(ctx, r.SeedClient)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether seed is garden: %w", err)
+	}
+
+	desiredCRDs, err := r.desiredCRDs(seed, seedIsGarden)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var conflicts []string
+	for _, group := range seedcrds.AllGroups {
+		crds, ok := desiredCRDs[group]
+		if !ok {
+			// Releasing the custom resource definitions does not delete them since the ManagedResource keeps its objects.
+			log.V(1).Info("Custom resource definitions are not managed for this seed, releasing them", "group", group)
+			if err := seedcrds.New(r.SeedClient, r.GardenNamespace, seedcrds.Values{Group: group}).Destroy(ctx); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed releasing custom resource definitions of group %s: %w", group, err)
+			}
+			continue
+		}
+
+		groupConflicts, err := r.reconcileGroup(ctx, log, group, crds)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed reconciling custom resource definitions of group %s: %w", group, err)
+		}
+		conflicts = append(conflicts, groupConflicts...)
+	}
+
+	if err := r.updateCondition(ctx, log, seed, conflicts); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating seed status condition: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func seedBootstrapped(seed *gardencorev1beta1.Seed) bool {
+	lastOperation := seed.Status.LastOperation
+	if lastOperation == nil {
+		return false
+	}
+	return lastOperation.Type != gardencorev1beta1.LastOperationTypeCreate || lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
+
+func (r *Reconciler) desiredCRDs(seed *gardencorev1beta1.Seed, seedIsGarden bool) (map[string][]*apiextensionsv1.CustomResourceDefinition, error) {
+	deployers := map[string]func() (crddeployer.Interface, error){
+		seedcrds.GroupMachineControllerManager: func() (crddeployer.Interface, error) {
+			return machinecontrollermanager.NewCRD(r.SeedClient)
+		},
+	}
+
+	// If the seed is the garden cluster, the custom resource definitions for etcd-druid, Istio and VPA are managed by
+	// gardener-operator.
+	if !seedIsGarden {
+		deployers[seedcrds.GroupEtcdDruid] = func() (crddeployer.Interface, error) { return etcd.NewCRD(r.SeedClient, r.SeedVersion) }
+		deployers[seedcrds.GroupIstio] = func() (crddeployer.Interface, error) { return istio.NewCRD(r.SeedClient) }
+
+		if v1beta1helper.SeedSettingVerticalPodAutoscalerEnabled(seed.Spec.Settings) {
+			deployers[seedcrds.GroupVPA] = func() (crddeployer.Interface, error) { return vpa.NewCRD(r.SeedClient, nil) }
+		}
+	}
+
+	out := make(map[string][]*apiextensionsv1.CustomResourceDefinition, len(deployers))
+	for group, newDeployer := range deployers {
+		deployer, err := newDeployer()
+		if err != nil {
+			return nil, fmt.Errorf("failed computing custom resource definitions of group %s: %w", group, err)
+		}
+		out[group] = deployer.CustomResourceDefinitions()
+	}
+
+	return out, nil
+}
+
+// reconcileGroup deploys the ManagedResource for the given group of custom resource definitions. Custom resource
+// definitions which conflict with the existing ones are not added to the ManagedResource. The conflicts are returned.
+func (r *Reconciler) reconcileGroup(ctx context.Context, log logr.Logger, group string, desiredCRDs []*apiextensionsv1.CustomResourceDefinition) ([]string, error) {
+	var (
+		managedResourceKey = types.NamespacedName{Namespace: r.GardenNamespace, Name: seedcrds.ManagedResourceName(group)}
+		managedCRDs        []*apiextensionsv1.CustomResourceDefinition
+		conflicts          []string
+	)
+
+	for _, desiredCRD := range desiredCRDs {
+		existingCRD := &apiextensionsv1.CustomResourceDefinition{}
+		if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(desiredCRD), existingCRD); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed reading custom resource definition %s: %w", desiredCRD.Name, err)
+			}
+			managedCRDs = append(managedCRDs, desiredCRD)
+			continue
+		}
+
+		owner := foreignOwner(existingCRD, managedResourceKey)
+
+		if conflict := detectConflict(existingCRD, desiredCRD, owner); conflict != "" {
+			log.Info("Custom resource definition conflicts with the existing one, skipping it", "group", group, "crdName", desiredCRD.Name, "conflict", conflict)
+			conflicts = append(conflicts, fmt.Sprintf("%s: %s", desiredCRD.Name, conflict))
+			continue
+		}
+
+		if owner != "" {
+			log.V(1).Info("Custom resource definition is managed by another actor with compatible versions, skipping it", "group", group, "crdName", desiredCRD.Name, "owner", owner)
+			continue
+		}
+
+		managedCRDs = append(managedCRDs, desiredCRD)
+	}
+
+	return conflicts, seedcrds.New(r.SeedClient, r.GardenNamespace, seedcrds.Values{Group: group, CustomResourceDefinitions: managedCRDs}).Deploy(ctx)
+}
+
+// foreignOwner returns a description of the actor managing the given custom resource definition if it is not managed
+// by gardenlet via the ManagedResource with the given key. Otherwise, it returns an empty string.
+func foreignOwner(crd *apiextensionsv1.CustomResourceDefinition, managedResourceKey types.NamespacedName) string {
+	if origin, ok := crd.Annotations[resourcesv1alpha1.OriginAnnotation]; ok {
+		if _, key, err := resourcesv1alpha1helper.SplitOrigin(origin); err != nil || key != managedResourceKey {
+			return fmt.Sprintf("ManagedResource %q", origin)
+		}
+		return ""
+	}
+
+	if managedBy, ok := crd.Labels[LabelManagedBy]; ok && managedBy != "gardener" {
+		return fmt.Sprintf("%q", managedBy)
+	}
+
+	return ""
+}
+
+// detectConflict returns a description of the conflict between the existing and the desired custom resource
+// definition, or an empty string if there is none. Versions which are still stored in etcd but no longer defined by
+// the desired custom resource definition are always a conflict since the API server rejects such updates. If the
+// existing custom resource definition is owned by another actor, any skew of the served or storage versions is a
+// conflict.
+func detectConflict(existing, desired *apiextensionsv1.CustomResourceDefinition, owner string) string {
+	var (
+		desiredVersions, desiredStorageVersion   = versions(desired)
+		existingVersions, existingStorageVersion = versions(existing)
+	)
+
+	if removedStoredVersions := sets.New(existing.Status.StoredVersions...).Difference(desiredVersions); removedStoredVersions.Len() > 0 {
+		return fmt.Sprintf("versions %v are stored in etcd but not defined by the desired version set %v", sets.List(removedStoredVersions), sets.List(desiredVersions))
+	}
+
+	if owner != "" && (!existingVersions.Equal(desiredVersions) || existingStorageVersion != desiredStorageVersion) {
+		return fmt.Sprintf("managed by %s with served versions %v and storage version %q, but gardenlet requires served versions %v and storage version %q",
+			owner, sets.List(existingVersions), existingStorageVersion, sets.List(desiredVersions), desiredStorageVersion)
+	}
+
+	return ""
+}
+
+func versions(crd *apiextensionsv1.CustomResourceDefinition) (sets.Set[string], string) {
+	var (
+		served  = sets.New[string]()
+		storage string
+	)
+
+	for _, version := range crd.Spec.Versions {
+		if version.Served {
+			served.Insert(version.Name)
+		}
+		if version.Storage {
+			storage = version.Name
+		}
+	}
+
+	return served, storage
+}
+
+func (r *Reconciler) updateCondition(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed, conflicts []string) error {
+	var (
+		condition    = v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedCustomResourceDefinitionsReady)
+		newCondition gardencorev1beta1.Condition
+	)
+
+	if len(conflicts) > 0 {
+		slices.Sort(conflicts)
+		newCondition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, ReasonConflictingCustomResourceDefinitions,
+			"The following custom resource definitions are not managed by gardenlet because of conflicts: "+strings.Join(conflicts, "; "))
+	} else {
+		newCondition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, ReasonCustomResourceDefinitionsReady,
+			"All custom resource definitions are free of conflicts.")
+	}
+
+	if !v1beta1helper.ConditionsNeedUpdate([]gardencorev1beta1.Condition{condition}, []gardencorev1beta1.Condition{newCondition}) {
+		return nil
+	}
+
+	log.Info("Updating seed status condition", "status", newCondition.Status, "reason", newCondition.Reason)
+	patch := client.StrategicMergeFrom(seed.DeepCopy())
+	seed.Status.Conditions = v1beta1helper.MergeConditions(seed.Status.Conditions, newCondition)
+	return r.GardenClient.Status().Patch(ctx, seed, patch)
+}
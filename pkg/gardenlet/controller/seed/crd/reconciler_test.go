@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package crd_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/crd"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		seedName   = "seed"
+		namespace  = "garden"
+		syncPeriod = time.Hour
+		machineCRD = "machines.machine.sapcloud.io"
+	)
+
+	var (
+		ctx = context.Background()
+
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+		request      reconcile.Request
+
+		seed *gardencorev1beta1.Seed
+
+		managedCRDNames = func(group string) []string {
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "seed-crds-" + group}, managedResource)).To(Succeed())
+			ExpectWithOffset(1, managedResource.Spec.KeepObjects).To(PointTo(BeTrue()))
+
+			secret := &corev1.Secret{}
+			ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+
+			manifests, err := test.ExtractManifestsFromManagedResourceData(secret.Data)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, manifest := range manifests {
+				crd, err := kubernetesutils.DecodeCRD(manifest)
+				ExpectWithOffset(1, err).NotTo(HaveOccurred())
+				names = append(names, crd.Name)
+			}
+			return names
+		}
+
+		condition = func() *gardencorev1beta1.Condition {
+			ExpectWithOffset(1, gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+			return v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedCustomResourceDefinitionsReady)
+		}
+
+		crdWithVersion = func(version string) *apiextensionsv1.CustomResourceDefinition {
+			return &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: machineCRD},
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "machine.sapcloud.io",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Machine", Plural: "machines"},
+					Scope: apiextensionsv1.NamespaceScoped,
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{Name: version, Served: true, Storage: true},
+					},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Seed{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		reconciler = &Reconciler{
+			GardenClient:    gardenClient,
+			SeedClient:      seedClient,
+			Config:          gardenletconfigv1alpha1.SeedCRDControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+			Clock:           fakeClock,
+			SeedName:        seedName,
+			SeedVersion:     semver.MustParse("1.33.0"),
+			GardenNamespace: namespace,
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKey{Name: seedName}}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName},
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+		seed.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:  gardencorev1beta1.LastOperationTypeReconcile,
+			State: gardencorev1beta1.LastOperationStateSucceeded,
+		}
+		Expect(gardenClient.Status().Update(ctx, seed)).To(Succeed())
+	})
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(gardenClient.Delete(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should requeue if the seed was not yet bootstrapped", func() {
+		seed.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:  gardencorev1beta1.LastOperationTypeCreate,
+			State: gardencorev1beta1.LastOperationStateProcessing,
+		}
+		Expect(gardenClient.Status().Update(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+		Expect(seedClient.List(ctx, managedResourceList)).To(Succeed())
+		Expect(managedResourceList.Items).To(BeEmpty())
+		Expect(condition()).To(BeNil())
+	})
+
+	It("should deploy the ManagedResources for all groups and report that there are no conflicts", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(managedCRDNames("machine-controller-manager")).To(ConsistOf(
+			"machineclasses.machine.sapcloud.io",
+			"machinedeployments.machine.sapcloud.io",
+			"machinesets.machine.sapcloud.io",
+			machineCRD,
+		))
+		Expect(managedCRDNames("etcd-druid")).To(ContainElements("etcds.druid.gardener.cloud", "etcdcopybackupstasks.druid.gardener.cloud"))
+		Expect(managedCRDNames("istio")).To(ContainElements("gateways.networking.istio.io", "virtualservices.networking.istio.io"))
+		Expect(managedCRDNames("vpa")).To(ConsistOf("verticalpodautoscalers.autoscaling.k8s.io", "verticalpodautoscalercheckpoints.autoscaling.k8s.io"))
+
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionTrue),
+			"Reason": Equal("CustomResourceDefinitionsReady"),
+		})))
+	})
+
+	It("should release the VPA custom resource definitions if VPA is disabled", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(managedCRDNames("vpa")).NotTo(BeEmpty())
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		seed.Spec.Settings = &gardencorev1beta1.SeedSettings{VerticalPodAutoscaler: &gardencorev1beta1.SeedSettingVerticalPodAutoscaler{Enabled: false}}
+		Expect(gardenClient.Update(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "seed-crds-vpa"}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+	})
+
+	It("should only manage the machine-controller-manager custom resource definitions if the seed is the garden", func() {
+		Expect(seedClient.Create(ctx, &operatorv1alpha1.Garden{ObjectMeta: metav1.ObjectMeta{Name: "garden"}})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(managedCRDNames("machine-controller-manager")).To(HaveLen(4))
+		for _, group := range []string{"etcd-druid", "istio", "vpa"} {
+			Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "seed-crds-" + group}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		}
+	})
+
+	It("should take over custom resource definitions which were bootstrapped by gardenlet", func() {
+		Expect(seedClient.Create(ctx, crdWithVersion("v1alpha1"))).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(managedCRDNames("machine-controller-manager")).To(ContainElement(machineCRD))
+		Expect(condition().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should not take over compatible custom resource definitions managed by another actor", func() {
+		crd := crdWithVersion("v1alpha1")
+		crd.Labels = map[string]string{"app.kubernetes.io/managed-by": "Helm"}
+		Expect(seedClient.Create(ctx, crd)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(managedCRDNames("machine-controller-manager")).NotTo(ContainElement(machineCRD))
+		Expect(condition().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should report a conflict if another actor manages a custom resource definition with different versions", func() {
+		crd := crdWithVersion("v1beta1")
+		crd.Labels = map[string]string{"app.kubernetes.io/managed-by": "Helm"}
+		Expect(seedClient.Create(ctx, crd)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(managedCRDNames("machine-controller-manager")).To(HaveLen(3))
+		Expect(managedCRDNames("machine-controller-manager")).NotTo(ContainElement(machineCRD))
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":  Equal("ConflictingCustomResourceDefinitions"),
+			"Message": ContainSubstring(`machines.machine.sapcloud.io: managed by "Helm" with served versions [v1beta1] and storage version "v1beta1", but gardenlet requires served versions [v1alpha1] and storage version "v1alpha1"`),
+		})))
+	})
+
+	It("should report a conflict if a custom resource definition is owned by a different ManagedResource", func() {
+		crd := crdWithVersion("v1beta1")
+		crd.Annotations = map[string]string{"resources.gardener.cloud/origin": "shoot--foo--bar/extension-foo"}
+		Expect(seedClient.Create(ctx, crd)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(managedCRDNames("machine-controller-manager")).NotTo(ContainElement(machineCRD))
+		Expect(condition().Message).To(ContainSubstring(`managed by ManagedResource "shoot--foo--bar/extension-foo"`))
+	})
+
+	It("should keep managing custom resource definitions owned by its own ManagedResource", func() {
+		crd := crdWithVersion("v1beta1")
+		crd.Annotations = map[string]string{"resources.gardener.cloud/origin": "seed:garden/seed-crds-machine-controller-manager"}
+		Expect(seedClient.Create(ctx, crd)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(managedCRDNames("machine-controller-manager")).To(ContainElement(machineCRD))
+		Expect(condition().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should report a conflict if a version stored in etcd is no longer defined", func() {
+		crd := crdWithVersion("v1alpha1")
+		crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1alpha0", Served: true})
+		crd.Status.StoredVersions = []string{"v1alpha0", "v1alpha1"}
+		Expect(seedClient.Create(ctx, crd)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(managedCRDNames("machine-controller-manager")).NotTo(ContainElement(machineCRD))
+		Expect(condition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Message": ContainSubstring("machines.machine.sapcloud.io: versions [v1alpha0] are stored in etcd but not defined by the desired version set [v1alpha1]"),
+		})))
+	})
+
+	It("should do nothing if the seed is being deleted", func() {
+		seed.Finalizers = []string{"gardener"}
+		Expect(gardenClient.Update(ctx, seed)).To(Succeed())
+		Expect(gardenClient.Delete(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+		Expect(seedClient.List(ctx, managedResourceList)).To(Succeed())
+		Expect(managedResourceList.Items).To(BeEmpty())
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"time"
 
 	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2"
 	proberapi "github.com/gardener/dependency-watchdog/api/prober"
@@ -31,6 +32,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/autoscaling/clusterautoscaler"
 	"github.com/gardener/gardener/pkg/component/autoscaling/vpa"
 	"github.com/gardener/gardener/pkg/component/clusteridentity"
+	"github.com/gardener/gardener/pkg/component/crddeployer"
 	"github.com/gardener/gardener/pkg/component/etcd/etcd"
 	"github.com/gardener/gardener/pkg/component/extensions"
 	extensioncrds "github.com/gardener/gardener/pkg/component/extensions/crds"
@@ -69,6 +71,8 @@ import (
 	"github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector"
 	oteloperator "github.com/gardener/gardener/pkg/component/observability/opentelemetry/operator"
 	"github.com/gardener/gardener/pkg/component/observability/plutono"
+	"github.com/gardener/gardener/pkg/component/seed/binpackingscheduler"
+	"github.com/gardener/gardener/pkg/component/seed/ingresscertificate"
 	seedsystem "github.com/gardener/gardener/pkg/component/seed/system"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/features"
@@ -101,11 +105,13 @@ type components struct {
 	istioDefaultLabels      map[string]string
 	istioDefaultNamespace   string
 	nginxIngressController  component.DeployWaiter
+	ingressCertificate      component.DeployWaiter
 	verticalPodAutoscaler   component.DeployWaiter
 	etcdDruid               component.DeployWaiter
 	clusterAutoscaler       component.DeployWaiter
 	dwdWeeder               component.DeployWaiter
 	dwdProber               component.DeployWaiter
+	binPackingScheduler     component.DeployWaiter
 
 	kubeAPIServerService component.Deployer
 	kubeAPIServerIngress component.Deployer
@@ -144,7 +150,14 @@ func (r *Reconciler) instantiateComponents(
 	err error,
 ) {
 	// crds
-	c.machineCRD, err = machinecontrollermanager.NewCRD(r.SeedClientSet.Client())
+	// The lifecycle of the CRDs of machine-controller-manager, etcd-druid, Istio and VPA is managed by the seed CRD
+	// controller via ManagedResources, hence they are only bootstrapped here if they do not exist yet.
+	var bootstrapCRDOpts []crddeployer.Option
+	if r.Config.Controllers.SeedCRD != nil {
+		bootstrapCRDOpts = append(bootstrapCRDOpts, crddeployer.CreateOnly())
+	}
+
+	c.machineCRD, err = machinecontrollermanager.NewCRD(r.SeedClientSet.Client(), bootstrapCRDOpts...)
 	if err != nil {
 		return
 	}
@@ -152,15 +165,15 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
-	c.etcdCRD, err = etcd.NewCRD(r.SeedClientSet.Client(), r.SeedVersion)
+	c.etcdCRD, err = etcd.NewCRD(r.SeedClientSet.Client(), r.SeedVersion, bootstrapCRDOpts...)
 	if err != nil {
 		return
 	}
-	c.istioCRD, err = istio.NewCRD(r.SeedClientSet.Client())
+	c.istioCRD, err = istio.NewCRD(r.SeedClientSet.Client(), bootstrapCRDOpts...)
 	if err != nil {
 		return
 	}
-	c.vpaCRD, err = vpa.NewCRD(r.SeedClientSet.Client(), nil)
+	c.vpaCRD, err = vpa.NewCRD(r.SeedClientSet.Client(), nil, bootstrapCRDOpts...)
 	if err != nil {
 		return
 	}
@@ -208,6 +221,7 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
+	c.ingressCertificate = r.newIngressCertificate(seed.GetInfo())
 	c.verticalPodAutoscaler, err = r.newVerticalPodAutoscaler(seed.GetInfo().Spec.Settings, secretsManager, seedIsGarden)
 	if err != nil {
 		return
@@ -221,6 +235,10 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
+	c.binPackingScheduler, err = r.newBinPackingScheduler(seed.GetInfo().Spec.Settings)
+	if err != nil {
+		return
+	}
 
 	c.kubeAPIServerService = r.newKubeAPIServerService(wildCardCertSecret)
 	c.kubeAPIServerIngress = r.newKubeAPIServerIngress(seed, wildCardCertSecret, c.istioDefaultLabels, c.istioDefaultNamespace)
@@ -313,9 +331,15 @@ func (r *Reconciler) newGardenerResourceManager(seed *gardencorev1beta1.Seed, se
 
 	endpointSliceHintsEnabled := v1beta1helper.SeedSettingTopologyAwareRoutingEnabled(seed.Spec.Settings) && versionutils.ConstraintK8sLess132.Check(r.SeedVersion)
 
+	var schedulingProfile *gardencorev1beta1.SchedulingProfile
+	if v1beta1helper.SeedSettingSchedulingBinPackingEnabled(seed.Spec.Settings) {
+		schedulingProfile = ptr.To(gardencorev1beta1.SchedulingProfileBinPacking)
+	}
+
 	return sharedcomponent.NewRuntimeGardenerResourceManager(r.SeedClientSet.Client(), r.GardenNamespace, secretsManager, resourcemanager.Values{
 		DefaultSeccompProfileEnabled:              features.DefaultFeatureGate.Enabled(features.DefaultSeccompProfile),
 		HighAvailabilityConfigWebhookEnabled:      true,
+		ImageOverridesWebhookEnabled:              len(gardenlethelper.GetAllowedImageOverrides(&r.Config)) > 0,
 		DefaultNotReadyToleration:                 defaultNotReadyTolerationSeconds,
 		DefaultUnreachableToleration:              defaultUnreachableTolerationSeconds,
 		EndpointSliceHintsEnabled:                 endpointSliceHintsEnabled,
@@ -323,10 +347,11 @@ func (r *Reconciler) newGardenerResourceManager(seed *gardencorev1beta1.Seed, se
 		LogFormat:                                 r.Config.LogFormat,
 		NetworkPolicyAdditionalNamespaceSelectors: additionalNetworkPolicyNamespaceSelectors,
 		PriorityClassName:                         v1beta1constants.PriorityClassNameSeedSystemCritical,
+		SchedulingProfile:                         schedulingProfile,
 		SecretNameServerCA:                        v1beta1constants.SecretNameCASeed,
 		Zones:                                     seed.Spec.Provider.Zones,
 		PodKubeAPIServerLoadBalancingWebhook: resourcemanager.PodKubeAPIServerLoadBalancingWebhook{
-			Enabled: features.DefaultFeatureGate.Enabled(features.IstioTLSTermination),
+			Enabled: r.istioTLSTerminationEnabled(seed),
 			Configs: []resourcemanager.PodKubeAPIServerLoadBalancingWebhookConfig{
 				{
 					NamespaceSelector: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot},
@@ -339,6 +364,57 @@ func (r *Reconciler) newGardenerResourceManager(seed *gardencorev1beta1.Seed, se
 	})
 }
 
+// istioTLSTerminationEnabled returns true if at least one istio ingress gateway of the seed might terminate TLS for
+// shoot kube-apiservers, i.e., if it is enabled via feature gate, seed setting, or for any exposure class handler.
+func (r *Reconciler) istioTLSTerminationEnabled(seed *gardencorev1beta1.Seed) bool {
+	if features.DefaultFeatureGate.Enabled(features.IstioTLSTermination) || v1beta1helper.SeedSettingIstioTLSTerminationEnabled(seed.Spec.Settings) {
+		return true
+	}
+
+	for _, handler := range r.Config.ExposureClassHandlers {
+		if ptr.Deref(handler.IstioTLSTermination, false) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// istioCanaryUpgrade returns the configuration for canary upgrades of the istio control plane, or nil if canary
+// upgrades are disabled.
+func (r *Reconciler) istioCanaryUpgrade() *istio.CanaryUpgradeValues {
+	if r.Config.Controllers == nil || r.Config.Controllers.Seed == nil || r.Config.Controllers.Seed.IstioCanaryUpgrade == nil || !r.Config.Controllers.Seed.IstioCanaryUpgrade.Enabled {
+		return nil
+	}
+
+	config := r.Config.Controllers.Seed.IstioCanaryUpgrade
+	return &istio.CanaryUpgradeValues{
+		IngressGatewayPercentage: int(ptr.Deref(config.IngressGatewayPercentage, 25)),
+		VerificationPeriod:       ptr.Deref(config.VerificationPeriod, metav1.Duration{Duration: 30 * time.Minute}).Duration,
+		FailureThreshold:         ptr.Deref(config.FailureThreshold, metav1.Duration{Duration: 10 * time.Minute}).Duration,
+		Clock:                    r.Clock,
+	}
+}
+
+// requeueAfter returns the duration after which the seed is reconciled again. During canary upgrades of the istio
+// control plane, the seed is reconciled more often so that the health of the canary is sampled throughout the canary
+// phase.
+func (r *Reconciler) requeueAfter(ctx context.Context) (time.Duration, error) {
+	requeueAfter := r.Config.Controllers.Seed.SyncPeriod.Duration
+
+	if canaryUpgrade := r.istioCanaryUpgrade(); canaryUpgrade != nil {
+		inProgress, err := istio.CanaryUpgradeInProgress(ctx, r.SeedClientSet.Client(), v1beta1constants.IstioSystemNamespace)
+		if err != nil {
+			return 0, fmt.Errorf("failed checking whether an istio canary upgrade is in progress: %w", err)
+		}
+		if inProgress {
+			requeueAfter = min(requeueAfter, canaryUpgrade.SampleInterval())
+		}
+	}
+
+	return requeueAfter, nil
+}
+
 func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, seedIsGarden bool) (component.DeployWaiter, map[string]string, string, error) {
 	labels := sharedcomponent.GetIstioZoneLabels(r.Config.SNI.Ingress.Labels, nil)
 
@@ -365,10 +441,12 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, seedIsGar
 		r.Config.SNI.Ingress.ServiceExternalIP,
 		servicePorts,
 		seed.GetLoadBalancerServiceProxyProtocolTermination(),
+		seed.GetLoadBalancerServiceProxyProtocolTLVs(),
 		true,
 		seed.GetInfo().Spec.Provider.Zones,
 		seed.IsDualStack(),
 		r.SeedVersion,
+		r.istioCanaryUpgrade(),
 	)
 	if err != nil {
 		return nil, nil, "", err
@@ -395,6 +473,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, seedIsGar
 				&zone,
 				seed.IsDualStack(),
 				seed.GetZonalLoadBalancerServiceProxyProtocolTermination(zone),
+				seed.GetZonalLoadBalancerServiceProxyProtocolTLVs(zone),
 				r.SeedVersion,
 			); err != nil {
 				return nil, nil, "", err
@@ -418,6 +497,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, seedIsGar
 			nil,
 			seed.IsDualStack(),
 			seed.GetLoadBalancerServiceProxyProtocolTermination(),
+			seed.GetLoadBalancerServiceProxyProtocolTLVs(),
 			r.SeedVersion,
 		); err != nil {
 			return nil, nil, "", err
@@ -441,6 +521,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, seedIsGar
 					&zone,
 					seed.IsDualStack(),
 					seed.GetZonalLoadBalancerServiceProxyProtocolTermination(zone),
+					seed.GetZonalLoadBalancerServiceProxyProtocolTLVs(zone),
 					r.SeedVersion,
 				); err != nil {
 					return nil, nil, "", err
@@ -521,6 +602,24 @@ func (r *Reconciler) newDependencyWatchdogs(seedSettings *gardencorev1beta1.Seed
 	return
 }
 
+func (r *Reconciler) newBinPackingScheduler(settings *gardencorev1beta1.SeedSettings) (component.DeployWaiter, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeScheduler, imagevectorutils.RuntimeVersion(r.SeedVersion.String()), imagevectorutils.TargetVersion(r.SeedVersion.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	deployer := binpackingscheduler.New(r.SeedClientSet.Client(), r.GardenNamespace, binpackingscheduler.Values{
+		Image:    image.String(),
+		Replicas: 2,
+	})
+
+	if !v1beta1helper.SeedSettingSchedulingBinPackingEnabled(settings) {
+		return component.OpDestroyAndWait(deployer), nil
+	}
+
+	return deployer, nil
+}
+
 func (r *Reconciler) newSystem(seed *gardencorev1beta1.Seed) (component.DeployWaiter, error) {
 	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePauseContainer)
 	if err != nil {
@@ -970,6 +1069,7 @@ func (r *Reconciler) newNginxIngressController(seed *seedpkg.Seed, istioDefaultL
 		true,
 		component.ClusterTypeSeed,
 		"",
+		nil,
 		v1beta1constants.SeedNginxIngressClass,
 		[]string{seed.GetIngressFQDN("*")},
 		istioDefaultLabels,
@@ -977,6 +1077,23 @@ func (r *Reconciler) newNginxIngressController(seed *seedpkg.Seed, istioDefaultL
 	)
 }
 
+func (r *Reconciler) newIngressCertificate(seed *gardencorev1beta1.Seed) component.DeployWaiter {
+	values := ingresscertificate.Values{}
+	if seed.Spec.Ingress != nil {
+		values.Domain = seed.Spec.Ingress.Domain
+		if seed.Spec.Ingress.WildcardCertificate != nil {
+			values.IssuerName = seed.Spec.Ingress.WildcardCertificate.IssuerName
+		}
+	}
+
+	c := ingresscertificate.New(r.SeedClientSet.Client(), r.GardenNamespace, values)
+	if seed.Spec.Ingress == nil || seed.Spec.Ingress.WildcardCertificate == nil {
+		return component.OpDestroyWithoutWait(c)
+	}
+
+	return c
+}
+
 func (r *Reconciler) newKubeAPIServerService(wildCardCertSecret *corev1.Secret) component.Deployer {
 	c := kubeapiserverexposure.NewInternalNameService(r.SeedClientSet.Client(), r.GardenNamespace)
 	if wildCardCertSecret == nil {
@@ -121,7 +121,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, r.updateStatusOperationError(ctx, seed, err, operationType)
 	}
 
-	return reconcile.Result{RequeueAfter: r.Config.Controllers.Seed.SyncPeriod.Duration}, r.updateStatusOperationSuccess(ctx, seed, operationType)
+	requeueAfter, err := r.requeueAfter(ctx)
+	if err != nil {
+		return reconcile.Result{}, r.updateStatusOperationError(ctx, seed, err, operationType)
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, r.updateStatusOperationSuccess(ctx, seed, operationType)
 }
 
 func (r *Reconciler) reportProgress(log logr.Logger, seed *gardencorev1beta1.Seed) flow.ProgressReporter {
@@ -22,6 +22,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/clusteridentity"
+	seedcrds "github.com/gardener/gardener/pkg/component/seed/crds"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -152,6 +153,10 @@ func (r *Reconciler) runDeleteSeedFlow(
 			Name: "Destroying nginx-ingress",
 			Fn:   component.OpDestroyAndWait(c.nginxIngressController).Destroy,
 		})
+		destroyIngressCertificate = g.Add(flow.Task{
+			Name: "Destroying ingress wildcard certificate",
+			Fn:   component.OpDestroyAndWait(c.ingressCertificate).Destroy,
+		})
 		destroyDWDWeeder = g.Add(flow.Task{
 			Name: "Destroy dependency-watchdog-weeder",
 			Fn:   component.OpDestroyAndWait(c.dwdWeeder).Destroy,
@@ -160,6 +165,10 @@ func (r *Reconciler) runDeleteSeedFlow(
 			Name: "Destroy dependency-watchdog-prober",
 			Fn:   component.OpDestroyAndWait(c.dwdProber).Destroy,
 		})
+		destroyBinPackingScheduler = g.Add(flow.Task{
+			Name: "Destroy bin-packing scheduler",
+			Fn:   component.OpDestroyAndWait(c.binPackingScheduler).Destroy,
+		})
 		destroyKubeAPIServerIngress = g.Add(flow.Task{
 			Name: "Destroy kube-apiserver ingress",
 			Fn:   component.OpDestroyAndWait(c.kubeAPIServerIngress).Destroy,
@@ -272,9 +281,11 @@ func (r *Reconciler) runDeleteSeedFlow(
 			destroyAggregatePrometheus,
 			destroyAlertManager,
 			destroyNginxIngress,
+			destroyIngressCertificate,
 			destroyClusterAutoscaler,
 			destroyDWDWeeder,
 			destroyDWDProber,
+			destroyBinPackingScheduler,
 			destroyKubeAPIServerIngress,
 			destroyKubeAPIServerService,
 			destroyIstio,
@@ -308,10 +319,17 @@ func (r *Reconciler) runDeleteSeedFlow(
 			Dependencies: flow.NewTaskIDs(ensureNoControllerInstallationsExist),
 		})
 
+		// The ManagedResources keep their objects, hence the custom resource definitions are only released here and
+		// deleted by the following tasks.
+		destroyCRDManagedResources = g.Add(flow.Task{
+			Name:         "Destroying ManagedResources for custom resource definitions",
+			Fn:           r.destroyCRDManagedResources,
+			Dependencies: flow.NewTaskIDs(ensureNoControllerInstallationsExist),
+		})
 		destroyMachineCRDs = g.Add(flow.Task{
 			Name:         "Destroying machine-controller-manager custom resource definitions",
 			Fn:           component.OpDestroyAndWait(c.machineCRD).Destroy,
-			Dependencies: flow.NewTaskIDs(ensureNoControllerInstallationsExist),
+			Dependencies: flow.NewTaskIDs(destroyCRDManagedResources),
 		})
 		destroyExtensionCRDs = g.Add(flow.Task{
 			Name:         "Destroying extensions-related custom resource definitions",
@@ -322,20 +340,20 @@ func (r *Reconciler) runDeleteSeedFlow(
 		destroyEtcdCRDs = g.Add(flow.Task{
 			Name:         "Destroying ETCD-related custom resource definitions",
 			Fn:           component.OpDestroyAndWait(c.etcdCRD).Destroy,
-			Dependencies: flow.NewTaskIDs(ensureNoControllerInstallationsExist),
+			Dependencies: flow.NewTaskIDs(destroyCRDManagedResources),
 			SkipIf:       seedIsGarden,
 		})
 		destroyIstioCRDs = g.Add(flow.Task{
 			Name:         "Destroying Istio custom resource definitions",
 			Fn:           component.OpDestroyAndWait(c.istioCRD).Destroy,
 			SkipIf:       seedIsGarden,
-			Dependencies: flow.NewTaskIDs(ensureNoControllerInstallationsExist),
+			Dependencies: flow.NewTaskIDs(destroyCRDManagedResources),
 		})
 		destroyVPACRDs = g.Add(flow.Task{
 			Name:         "Destroying VPA-related custom resource definitions",
 			Fn:           component.OpDestroyAndWait(c.vpaCRD).Destroy,
 			SkipIf:       seedIsGarden || !vpaEnabled(seed.GetInfo().Spec.Settings),
-			Dependencies: flow.NewTaskIDs(ensureNoControllerInstallationsExist),
+			Dependencies: flow.NewTaskIDs(destroyCRDManagedResources),
 		})
 		destroyFluentCRDs = g.Add(flow.Task{
 			Name:         "Destroying Fluent Operator custom resource definitions",
@@ -410,6 +428,16 @@ func (r *Reconciler) runDeleteSeedFlow(
 	return nil
 }
 
+func (r *Reconciler) destroyCRDManagedResources(ctx context.Context) error {
+	var fns []flow.TaskFn
+
+	for _, group := range seedcrds.AllGroups {
+		fns = append(fns, component.OpDestroyAndWait(seedcrds.New(r.SeedClientSet.Client(), r.GardenNamespace, seedcrds.Values{Group: group})).Destroy)
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
 func ensureNoControllerInstallations(c client.Client, seedName string) func(ctx context.Context) error {
 	return func(ctx context.Context) error {
 		associatedControllerInstallations, err := controllerutils.DetermineControllerInstallationAssociations(ctx, c, seedName)
@@ -339,6 +339,11 @@ func (r *Reconciler) runReconcileSeedFlow(
 			},
 			Dependencies: flow.NewTaskIDs(deployIstio),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying ingress wildcard certificate",
+			Fn:           c.ingressCertificate.Deploy,
+			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying cluster-autoscaler resources",
 			Fn:           c.clusterAutoscaler.Deploy,
@@ -354,6 +359,11 @@ func (r *Reconciler) runReconcileSeedFlow(
 			Fn:           c.dwdProber.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying bin-packing scheduler",
+			Fn:           c.binPackingScheduler.Deploy,
+			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+		})
 		_ = g.Add(flow.Task{
 			Name: "Renewing garden access secrets",
 			Fn: func(ctx context.Context) error {
@@ -14,14 +14,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	gardenlethelper "github.com/gardener/gardener/pkg/api/config/gardenlet/v1alpha1/helper"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/availabilityreport"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/controlplanedrift"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/debugpod"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/eventmirror"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/infrastructuredrift"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/lease"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/leftover"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/status"
@@ -81,6 +88,55 @@ func AddToManager(
 		return fmt.Errorf("failed adding status reconciler: %w", err)
 	}
 
+	if config := cfg.Controllers.ShootEventMirror; config != nil {
+		if err := (&eventmirror.Reconciler{
+			Config: *config,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding event mirror reconciler: %w", err)
+		}
+	}
+
+	if config := cfg.Controllers.ShootLeftover; config != nil {
+		if err := (&leftover.Reconciler{
+			Config: *config,
+		}).AddToManager(mgr, seedCluster); err != nil {
+			return fmt.Errorf("failed adding leftover reconciler: %w", err)
+		}
+	}
+
+	// The availability reports are aggregated from the measurements of the API server availability probing of the care
+	// reconciler, hence there is nothing to report if the probing is disabled.
+	if config := cfg.Controllers.ShootAvailabilityReport; config != nil && gardenlethelper.GetAPIServerAvailabilityProbing(&cfg) != nil {
+		if err := (&availabilityreport.Reconciler{
+			Config:   *config,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding availability report reconciler: %w", err)
+		}
+	}
+
+	if config := cfg.Controllers.ShootInfrastructureDrift; config != nil {
+		if err := (&infrastructuredrift.Reconciler{
+			Config:   *config,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding infrastructure drift reconciler: %w", err)
+		}
+	}
+
+	if err := (&debugpod.Reconciler{
+		Config:   *cfg.Controllers.ShootDebugPod,
+		SeedName: cfg.SeedConfig.Name,
+	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+		return fmt.Errorf("failed adding debug pod reconciler: %w", err)
+	}
+
+	if err := (&controlplanedrift.Reconciler{
+		SeedName: cfg.SeedConfig.Name,
+	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+		return fmt.Errorf("failed adding control plane drift reconciler: %w", err)
+	}
+
 	// If gardenlet is responsible for an unmanaged seed we want to add the state reconciler which performs periodic
 	// backups of shoot states (see GEP-0022).
 	if shootStateControllerEnabled {
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package availabilityreport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+// Reconciler aggregates the API server availability measurements of the shoot care controller into monthly reports in
+// the status of the Shoots.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       gardenletconfigv1alpha1.ShootAvailabilityReportControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+}
+
+// Reconcile updates the availability reports of all Shoots whose control plane runs on the seed.
+func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.GardenClient.List(ctx, shootList, client.MatchingFields{core.ShootStatusSeedName: r.SeedName}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var errs []error
+	for i := range shootList.Items {
+		shoot := &shootList.Items[i]
+
+		if err := r.updateReports(ctx, shoot); err != nil {
+			log.Error(err, "Failed updating availability reports", "shoot", client.ObjectKeyFromObject(shoot))
+			errs = append(errs, fmt.Errorf("failed updating availability reports of shoot %s: %w", client.ObjectKeyFromObject(shoot), err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) updateReports(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	configMap := &corev1.ConfigMap{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: care.APIServerAvailabilityConfigMapName, Namespace: v1beta1helper.ControlPlaneNamespaceForShoot(shoot)}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading availability measurements: %w", err)
+	}
+
+	var (
+		now        = r.Clock.Now().UTC()
+		accounting = care.DecodeAPIServerAvailabilityAccounting(configMap.Data)
+		reports    = slices.Clone(shoot.Status.AvailabilityReports)
+	)
+
+	for _, downtime := range []*care.APIServerDowntime{accounting.Previous, accounting.Current} {
+		if downtime != nil {
+			reports = mergeReport(reports, downtime, now)
+		}
+	}
+
+	slices.SortFunc(reports, func(a, b gardencorev1beta1.ShootAvailabilityReport) int {
+		return strings.Compare(a.Period, b.Period)
+	})
+	if maxReports := int(ptr.Deref(r.Config.MaxReports, 12)); len(reports) > maxReports {
+		reports = reports[len(reports)-maxReports:]
+	}
+
+	if apiequality.Semantic.DeepEqual(shoot.Status.AvailabilityReports, reports) {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.AvailabilityReports = reports
+	return r.GardenClient.Status().Patch(ctx, shoot, patch)
+}
+
+// mergeReport adds or updates the report for the month of the given downtime. Final reports are not changed anymore.
+func mergeReport(reports []gardencorev1beta1.ShootAvailabilityReport, downtime *care.APIServerDowntime, now time.Time) []gardencorev1beta1.ShootAvailabilityReport {
+	report := gardencorev1beta1.ShootAvailabilityReport{
+		Period:              downtime.Period(),
+		Downtime:            metav1.Duration{Duration: downtime.Downtime},
+		MaintenanceDowntime: metav1.Duration{Duration: downtime.MaintenanceDowntime},
+		Availability:        strconv.FormatFloat(downtime.Availability(now), 'f', 3, 64),
+		Final:               !now.Before(downtime.WindowEnd()),
+		LastUpdateTime:      metav1.Time{Time: now},
+	}
+
+	index := slices.IndexFunc(reports, func(r gardencorev1beta1.ShootAvailabilityReport) bool { return r.Period == report.Period })
+	if index < 0 {
+		return append(reports, report)
+	}
+
+	existing := reports[index]
+	if existing.Final {
+		return reports
+	}
+
+	// Keep the report unchanged if the accounted downtime did not change. Otherwise, the availability of the current
+	// month would change with every sync, causing an update of the Shoot status each time.
+	if existing.Downtime.Duration == report.Downtime.Duration &&
+		existing.MaintenanceDowntime.Duration == report.MaintenanceDowntime.Duration &&
+		existing.Final == report.Final {
+		return reports
+	}
+
+	reports[index] = report
+	return reports
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package availabilityreport_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/availabilityreport"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+
+		shoot *gardencorev1beta1.Shoot
+
+		seedName  = "seed"
+		namespace = "shoot--foo--bar"
+		now       = time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	)
+
+	createMeasurements := func(data map[string]string) {
+		ExpectWithOffset(1, seedClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "apiserver-availability", Namespace: namespace},
+			Data:       data,
+		})).To(Succeed())
+	}
+
+	reconcileAndGetReports := func() []gardencorev1beta1.ShootAvailabilityReport {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		return shoot.Status.AvailabilityReports
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Status.SeedName, "")}
+			}).
+			Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(now)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Clock:        fakeClock,
+			SeedName:     seedName,
+			Config: gardenletconfigv1alpha1.ShootAvailabilityReportControllerConfiguration{
+				SyncPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+				MaxReports: ptr.To[int32](12),
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: &seedName},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		shoot.Status = gardencorev1beta1.ShootStatus{SeedName: &seedName, TechnicalID: namespace}
+		Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+	})
+
+	It("should requeue after the sync period", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Minute}))
+	})
+
+	It("should not add reports if there are no measurements", func() {
+		Expect(reconcileAndGetReports()).To(BeEmpty())
+	})
+
+	It("should add the reports of the current and the previous month", func() {
+		createMeasurements(map[string]string{
+			"windowStart":                 "2026-10-01T00:00:00Z",
+			"downtime":                    "1h0m0s",
+			"maintenanceDowntime":         "5m0s",
+			"previousWindowStart":         "2026-09-01T00:00:00Z",
+			"previousDowntime":            "3h0m0s",
+			"previousMaintenanceDowntime": "0s",
+			"lastProbeTime":               "2026-10-17T09:59:00Z",
+			"lastProbeSuccessful":         "true",
+		})
+
+		Expect(reconcileAndGetReports()).To(Equal([]gardencorev1beta1.ShootAvailabilityReport{
+			{
+				Period:         "2026-09",
+				Downtime:       metav1.Duration{Duration: 3 * time.Hour},
+				Availability:   "99.583",
+				Final:          true,
+				LastUpdateTime: metav1.Time{Time: now.Local()},
+			},
+			{
+				Period:              "2026-10",
+				Downtime:            metav1.Duration{Duration: time.Hour},
+				MaintenanceDowntime: metav1.Duration{Duration: 5 * time.Minute},
+				Availability:        "99.746",
+				LastUpdateTime:      metav1.Time{Time: now.Local()},
+			},
+		}))
+	})
+
+	It("should not update the report if the measurements did not change", func() {
+		createMeasurements(map[string]string{
+			"windowStart": "2026-10-01T00:00:00Z",
+			"downtime":    "0s",
+		})
+		Expect(reconcileAndGetReports()).To(HaveLen(1))
+
+		fakeClock.Step(time.Hour)
+		reports := reconcileAndGetReports()
+		Expect(reports).To(HaveLen(1))
+		Expect(reports[0].LastUpdateTime.Time).To(BeTemporally("==", now))
+	})
+
+	It("should not update the report if only the availability of the elapsed part of the month changed", func() {
+		createMeasurements(map[string]string{
+			"windowStart": "2026-10-01T00:00:00Z",
+			"downtime":    "1h0m0s",
+		})
+		Expect(reconcileAndGetReports()).To(HaveLen(1))
+
+		fakeClock.Step(time.Hour)
+		reports := reconcileAndGetReports()
+		Expect(reports).To(HaveLen(1))
+		Expect(reports[0].Availability).To(Equal("99.746"))
+		Expect(reports[0].LastUpdateTime.Time).To(BeTemporally("==", now))
+	})
+
+	It("should update the report if the downtime changed", func() {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "apiserver-availability", Namespace: namespace},
+			Data: map[string]string{
+				"windowStart": "2026-10-01T00:00:00Z",
+				"downtime":    "1h0m0s",
+			},
+		}
+		Expect(seedClient.Create(ctx, configMap)).To(Succeed())
+		Expect(reconcileAndGetReports()).To(HaveLen(1))
+
+		fakeClock.Step(time.Hour)
+		configMap.Data["downtime"] = "2h0m0s"
+		Expect(seedClient.Update(ctx, configMap)).To(Succeed())
+
+		reports := reconcileAndGetReports()
+		Expect(reports).To(HaveLen(1))
+		Expect(reports[0].Downtime.Duration).To(Equal(2 * time.Hour))
+		Expect(reports[0].Availability).To(Equal("99.494"))
+		Expect(reports[0].LastUpdateTime.Time).To(BeTemporally("==", now.Add(time.Hour)))
+	})
+
+	It("should not change final reports", func() {
+		shoot.Status.AvailabilityReports = []gardencorev1beta1.ShootAvailabilityReport{{
+			Period:         "2026-09",
+			Downtime:       metav1.Duration{Duration: 2 * time.Hour},
+			Availability:   "99.722",
+			Final:          true,
+			LastUpdateTime: metav1.Time{Time: now.Add(-24 * time.Hour)},
+		}}
+		Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		createMeasurements(map[string]string{
+			"windowStart":         "2026-10-01T00:00:00Z",
+			"downtime":            "0s",
+			"previousWindowStart": "2026-09-01T00:00:00Z",
+			"previousDowntime":    "3h0m0s",
+		})
+
+		reports := reconcileAndGetReports()
+		Expect(reports).To(HaveLen(2))
+		Expect(reports[0].Downtime.Duration).To(Equal(2 * time.Hour))
+		Expect(reports[0].Availability).To(Equal("99.722"))
+		Expect(reports[1].Period).To(Equal("2026-10"))
+	})
+
+	It("should keep only the configured number of reports", func() {
+		reconciler.Config.MaxReports = ptr.To[int32](2)
+		shoot.Status.AvailabilityReports = []gardencorev1beta1.ShootAvailabilityReport{
+			{Period: "2026-08", Availability: "100.000", Final: true},
+			{Period: "2026-09", Availability: "100.000", Final: true},
+		}
+		Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		createMeasurements(map[string]string{
+			"windowStart": "2026-10-01T00:00:00Z",
+			"downtime":    "0s",
+		})
+
+		reports := reconcileAndGetReports()
+		Expect(reports).To(HaveLen(2))
+		Expect(reports[0].Period).To(Equal("2026-09"))
+		Expect(reports[1].Period).To(Equal("2026-10"))
+	})
+
+	It("should ignore shoots whose control plane runs on another seed", func() {
+		shoot.Status.SeedName = ptr.To("other-seed")
+		Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		createMeasurements(map[string]string{
+			"windowStart": "2026-10-01T00:00:00Z",
+			"downtime":    "0s",
+		})
+
+		Expect(reconcileAndGetReports()).To(BeEmpty())
+	})
+})
@@ -38,6 +38,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.Recorder == nil {
+		r.Recorder = gardenCluster.GetEventRecorder(ControllerName + "-controller")
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
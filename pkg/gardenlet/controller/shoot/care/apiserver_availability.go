@@ -0,0 +1,430 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/utils/timewindow"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// APIServerAvailabilityConfigMapName is the name of the ConfigMap in the control plane namespace of a shoot which
+	// contains the accounted downtime of its API server.
+	APIServerAvailabilityConfigMapName = "apiserver-availability"
+	// VantagePointSeed is the name of the vantage point for probes from the seed cluster.
+	VantagePointSeed = "seed"
+	// VantagePointExternal is the name of the vantage point for probes from the external prober.
+	VantagePointExternal = "external"
+
+	dataKeyWindowStart                 = "windowStart"
+	dataKeyDowntime                    = "downtime"
+	dataKeyMaintenanceDowntime         = "maintenanceDowntime"
+	dataKeyPreviousWindowStart         = "previousWindowStart"
+	dataKeyPreviousDowntime            = "previousDowntime"
+	dataKeyPreviousMaintenanceDowntime = "previousMaintenanceDowntime"
+	dataKeyLastProbeTime               = "lastProbeTime"
+	dataKeyLastProbeState              = "lastProbeSuccessful"
+)
+
+// APIServerDowntime is the accounted downtime of a shoot's API server in a calendar month (UTC).
+type APIServerDowntime struct {
+	// WindowStart is the start of the accounting window. It is the beginning of the month, or the time of the first
+	// probe if the API server was probed for the first time in this month.
+	WindowStart time.Time
+	// Downtime is the downtime outside of the maintenance time window.
+	Downtime time.Duration
+	// MaintenanceDowntime is the downtime within the maintenance time window.
+	MaintenanceDowntime time.Duration
+}
+
+// Period returns the calendar month of the accounting window in the format 'YYYY-MM'.
+func (d APIServerDowntime) Period() string {
+	return d.WindowStart.UTC().Format("2006-01")
+}
+
+// WindowEnd returns the end of the calendar month of the accounting window.
+func (d APIServerDowntime) WindowEnd() time.Time {
+	windowStart := d.WindowStart.UTC()
+	return time.Date(windowStart.Year(), windowStart.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+}
+
+// Availability returns the availability in percent from the start of the accounting window until the given time (at
+// most until the end of the window). The downtime within the maintenance time window is not considered.
+func (d APIServerDowntime) Availability(until time.Time) float64 {
+	if windowEnd := d.WindowEnd(); until.After(windowEnd) {
+		until = windowEnd
+	}
+
+	elapsed := until.Sub(d.WindowStart)
+	if elapsed <= 0 {
+		return 100
+	}
+	return max(0, 100*(1-float64(d.Downtime)/float64(elapsed)))
+}
+
+// APIServerAvailabilityAccounting is the accounting data of a shoot's API server availability which is persisted in
+// the ConfigMap with the name APIServerAvailabilityConfigMapName.
+type APIServerAvailabilityAccounting struct {
+	// Current is the downtime of the current month.
+	Current *APIServerDowntime
+	// Previous is the final downtime of the previous month.
+	Previous *APIServerDowntime
+	// LastProbeTime is the time of the last probe.
+	LastProbeTime time.Time
+	// LastProbeSuccessful indicates whether the last probe was successful.
+	LastProbeSuccessful bool
+}
+
+// DecodeAPIServerAvailabilityAccounting decodes the accounting data from the given ConfigMap data. Data which cannot
+// be parsed is ignored.
+func DecodeAPIServerAvailabilityAccounting(data map[string]string) APIServerAvailabilityAccounting {
+	accounting := APIServerAvailabilityAccounting{
+		Current:  decodeAPIServerDowntime(data, dataKeyWindowStart, dataKeyDowntime, dataKeyMaintenanceDowntime),
+		Previous: decodeAPIServerDowntime(data, dataKeyPreviousWindowStart, dataKeyPreviousDowntime, dataKeyPreviousMaintenanceDowntime),
+	}
+
+	if lastProbeTime, err := time.Parse(time.RFC3339, data[dataKeyLastProbeTime]); err == nil {
+		accounting.LastProbeTime = lastProbeTime
+	}
+	accounting.LastProbeSuccessful, _ = strconv.ParseBool(data[dataKeyLastProbeState])
+
+	return accounting
+}
+
+func decodeAPIServerDowntime(data map[string]string, windowStartKey, downtimeKey, maintenanceDowntimeKey string) *APIServerDowntime {
+	windowStart, err := time.Parse(time.RFC3339, data[windowStartKey])
+	if err != nil {
+		return nil
+	}
+
+	downtime := &APIServerDowntime{WindowStart: windowStart.UTC()}
+	downtime.Downtime, _ = time.ParseDuration(data[downtimeKey])
+	downtime.MaintenanceDowntime, _ = time.ParseDuration(data[maintenanceDowntimeKey])
+	return downtime
+}
+
+// Encode encodes the accounting data into ConfigMap data.
+func (a APIServerAvailabilityAccounting) Encode() map[string]string {
+	data := map[string]string{
+		dataKeyLastProbeTime:  a.LastProbeTime.UTC().Format(time.RFC3339),
+		dataKeyLastProbeState: strconv.FormatBool(a.LastProbeSuccessful),
+	}
+
+	if a.Current != nil {
+		data[dataKeyWindowStart] = a.Current.WindowStart.Format(time.RFC3339)
+		data[dataKeyDowntime] = a.Current.Downtime.String()
+		data[dataKeyMaintenanceDowntime] = a.Current.MaintenanceDowntime.String()
+	}
+	if a.Previous != nil {
+		data[dataKeyPreviousWindowStart] = a.Previous.WindowStart.Format(time.RFC3339)
+		data[dataKeyPreviousDowntime] = a.Previous.Downtime.String()
+		data[dataKeyPreviousMaintenanceDowntime] = a.Previous.MaintenanceDowntime.String()
+	}
+
+	return data
+}
+
+// APIServerProber probes the external endpoint of a shoot's API server from multiple vantage points and accounts the
+// downtime of the current calendar month.
+type APIServerProber struct {
+	seedClient            client.Client
+	clock                 clock.Clock
+	config                *gardenletconfigv1alpha1.APIServerAvailabilityProbing
+	namespace             string
+	address               string
+	maintenanceTimeWindow *timewindow.MaintenanceTimeWindow
+	shoot                 *gardencorev1beta1.Shoot
+
+	seedHTTPClient     *http.Client
+	externalHTTPClient *http.Client
+}
+
+// NewAPIServerProber returns a new prober for the API server of the given shoot reachable via the given address. The
+// downtime is accounted in a ConfigMap in the given namespace of the seed cluster. Downtime within the maintenance time
+// window of the shoot is accounted separately. If the ConfigMap does not exist yet, e.g., after the control plane has
+// been migrated to another seed, the accounting is restored from the availability reports in the shoot status.
+func NewAPIServerProber(
+	seedClient client.Client,
+	clock clock.Clock,
+	config *gardenletconfigv1alpha1.APIServerAvailabilityProbing,
+	namespace string,
+	address string,
+	shoot *gardencorev1beta1.Shoot,
+) *APIServerProber {
+	return &APIServerProber{
+		seedClient:            seedClient,
+		clock:                 clock,
+		config:                config,
+		namespace:             namespace,
+		address:               address,
+		maintenanceTimeWindow: shootMaintenanceTimeWindow(shoot),
+		shoot:                 shoot,
+		seedHTTPClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				// #nosec G402 -- The probe only checks whether the endpoint responds and does not send any credentials.
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		},
+		externalHTTPClient: &http.Client{},
+	}
+}
+
+// APIServerAvailabilityResult is the result of probing the API server.
+type APIServerAvailabilityResult struct {
+	// VantagePoints are the names of the vantage points from which the API server was probed.
+	VantagePoints []string
+	// Failures contains the failure messages per vantage point from which the API server was not reachable.
+	Failures map[string]string
+	// APIServerDowntime is the accounted downtime in the current accounting window.
+	APIServerDowntime
+	// Availability is the availability in percent in the current accounting window.
+	Availability float64
+}
+
+// Message returns a human-readable description of the result.
+func (r *APIServerAvailabilityResult) Message() string {
+	accounting := fmt.Sprintf("Downtime since %s: %s (availability: %.3f%%).", r.WindowStart.Format(time.RFC3339), r.Downtime, r.Availability)
+	if r.MaintenanceDowntime > 0 {
+		accounting = fmt.Sprintf("Downtime since %s: %s, excluding %s in maintenance time windows (availability: %.3f%%).", r.WindowStart.Format(time.RFC3339), r.Downtime, r.MaintenanceDowntime, r.Availability)
+	}
+
+	if len(r.Failures) == 0 {
+		return fmt.Sprintf("API server is reachable from all vantage points (%s). %s", strings.Join(r.VantagePoints, ", "), accounting)
+	}
+
+	var failures []string
+	for _, vantagePoint := range r.VantagePoints {
+		if failure, ok := r.Failures[vantagePoint]; ok {
+			failures = append(failures, fmt.Sprintf("%s: %s", vantagePoint, failure))
+		}
+	}
+	return fmt.Sprintf("API server is not reachable from all vantage points (%s). %s", strings.Join(failures, "; "), accounting)
+}
+
+// Probe probes the API server from all vantage points and updates the accounted downtime. The time since the previous
+// probe is accounted as downtime if the API server is not reachable from at least one vantage point. It is accounted
+// as maintenance downtime if the probe is performed within the maintenance time window.
+func (p *APIServerProber) Probe(ctx context.Context) (*APIServerAvailabilityResult, error) {
+	result := &APIServerAvailabilityResult{
+		VantagePoints: []string{VantagePointSeed},
+		Failures:      map[string]string{},
+	}
+
+	if err := p.probeFromSeed(ctx); err != nil {
+		result.Failures[VantagePointSeed] = err.Error()
+	}
+
+	if p.config.ExternalProber != nil {
+		result.VantagePoints = append(result.VantagePoints, VantagePointExternal)
+		if err := p.probeFromExternalProber(ctx); err != nil {
+			result.Failures[VantagePointExternal] = err.Error()
+		}
+	}
+
+	if err := p.accountDowntime(ctx, result); err != nil {
+		return nil, fmt.Errorf("failed accounting API server downtime: %w", err)
+	}
+
+	return result, nil
+}
+
+func (p *APIServerProber) endpoint() string {
+	return "https://" + p.address + "/healthz"
+}
+
+func (p *APIServerProber) probeFromSeed(ctx context.Context) error {
+	// Every response of the API server, even if the request is not authorized, indicates that it is reachable.
+	statusCode, _, err := p.get(ctx, p.seedHTTPClient, p.endpoint())
+	if err != nil {
+		return err
+	}
+	if statusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("unexpected status code %d", statusCode)
+	}
+	return nil
+}
+
+func (p *APIServerProber) probeFromExternalProber(ctx context.Context) error {
+	proberURL, err := url.Parse(p.config.ExternalProber.URL)
+	if err != nil {
+		return fmt.Errorf("invalid prober URL: %w", err)
+	}
+
+	query := proberURL.Query()
+	query.Set("target", p.endpoint())
+	if p.config.ExternalProber.Module != nil {
+		query.Set("module", *p.config.ExternalProber.Module)
+	}
+	proberURL.RawQuery = query.Encode()
+
+	statusCode, body, err := p.get(ctx, p.externalHTTPClient, proberURL.String())
+	if err != nil {
+		return fmt.Errorf("prober not reachable: %w", err)
+	}
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("prober returned unexpected status code %d", statusCode)
+	}
+
+	success, err := parseProbeSuccess(body)
+	if err != nil {
+		return err
+	}
+	if !success {
+		return fmt.Errorf("probe failed")
+	}
+	return nil
+}
+
+func (p *APIServerProber) get(ctx context.Context, httpClient *http.Client, rawURL string) (int, []byte, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, ptr.Deref(p.config.Timeout, metav1.Duration{Duration: 10 * time.Second}).Duration)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(timeoutCtx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if err != nil {
+		return 0, nil, err
+	}
+	return response.StatusCode, body, nil
+}
+
+// parseProbeSuccess parses the 'probe_success' metric from the response of a blackbox exporter compatible prober.
+func parseProbeSuccess(body []byte) (bool, error) {
+	scanner := bufio.NewScanner(strings.NewReader(string(body)))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "probe_success" {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid value for probe_success metric: %w", err)
+		}
+		return value == 1, nil
+	}
+
+	return false, fmt.Errorf("prober did not report probe_success metric")
+}
+
+func (p *APIServerProber) accountDowntime(ctx context.Context, result *APIServerAvailabilityResult) error {
+	var (
+		now       = p.clock.Now().UTC()
+		available = len(result.Failures) == 0
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: APIServerAvailabilityConfigMapName, Namespace: p.namespace}}
+	)
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, p.seedClient, configMap, func() error {
+		accounting := DecodeAPIServerAvailabilityAccounting(configMap.Data)
+
+		if accounting.Current == nil {
+			accounting = RestoreAPIServerAvailabilityAccounting(p.shoot, now)
+		}
+
+		switch {
+		case accounting.Current == nil:
+			accounting.Current = &APIServerDowntime{WindowStart: now}
+		case !now.Before(accounting.Current.WindowEnd()):
+			// The month has ended, its downtime is kept until the current month has ended as well, so that it can be
+			// reported after the rollover.
+			monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+			accounting.Previous = accounting.Current
+			accounting.Current = &APIServerDowntime{WindowStart: monthStart}
+			if accounting.LastProbeTime.Before(monthStart) {
+				accounting.LastProbeTime = monthStart
+			}
+		}
+
+		if !available && !accounting.LastProbeTime.IsZero() && now.After(accounting.LastProbeTime) {
+			if p.maintenanceTimeWindow != nil && p.maintenanceTimeWindow.Contains(now) {
+				accounting.Current.MaintenanceDowntime += now.Sub(accounting.LastProbeTime)
+			} else {
+				accounting.Current.Downtime += now.Sub(accounting.LastProbeTime)
+			}
+		}
+
+		accounting.Current.Downtime = accounting.Current.Downtime.Truncate(time.Second)
+		accounting.Current.MaintenanceDowntime = accounting.Current.MaintenanceDowntime.Truncate(time.Second)
+		accounting.LastProbeTime = now
+		accounting.LastProbeSuccessful = available
+
+		result.APIServerDowntime = *accounting.Current
+		result.Availability = accounting.Current.Availability(now)
+
+		configMap.Data = accounting.Encode()
+		return nil
+	})
+	return err
+}
+
+// RestoreAPIServerAvailabilityAccounting restores the accounting data from the availability reports of the current and
+// the previous month in the status of the given shoot which are not final yet. This way, the downtime accounted on the
+// source seed is kept when the control plane is migrated to another seed. The accounting windows are assumed to start
+// at the beginning of the month or at the creation of the shoot, whichever is later. The time between the last probe on
+// the source seed and the first probe on the destination seed is not accounted.
+func RestoreAPIServerAvailabilityAccounting(shoot *gardencorev1beta1.Shoot, now time.Time) APIServerAvailabilityAccounting {
+	var (
+		accounting    APIServerAvailabilityAccounting
+		monthStart    = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		currentPeriod = monthStart.Format("2006-01")
+		previousMonth = monthStart.AddDate(0, -1, 0).Format("2006-01")
+	)
+
+	for _, report := range shoot.Status.AvailabilityReports {
+		if report.Final || (report.Period != currentPeriod && report.Period != previousMonth) {
+			continue
+		}
+
+		windowStart, err := time.Parse("2006-01", report.Period)
+		if err != nil {
+			continue
+		}
+		if creationTimestamp := shoot.CreationTimestamp.UTC(); windowStart.Before(creationTimestamp) {
+			windowStart = creationTimestamp.Truncate(time.Second)
+		}
+
+		downtime := &APIServerDowntime{
+			WindowStart:         windowStart,
+			Downtime:            report.Downtime.Duration,
+			MaintenanceDowntime: report.MaintenanceDowntime.Duration,
+		}
+		if report.Period == currentPeriod {
+			accounting.Current = downtime
+		} else {
+			accounting.Previous = downtime
+		}
+	}
+
+	return accounting
+}
@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("APIServerProber", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+
+		seedClient client.Client
+		fakeClock  *testclock.FakeClock
+		config     *gardenletconfigv1alpha1.APIServerAvailabilityProbing
+		shoot      *gardencorev1beta1.Shoot
+
+		apiServerStatusCode int
+		apiServer           *httptest.Server
+		address             string
+
+		proberResponse string
+		proberQueries  []map[string]string
+		prober         *httptest.Server
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, time.October, 11, 0, 0, 0, 0, time.UTC))
+		config = &gardenletconfigv1alpha1.APIServerAvailabilityProbing{Enabled: true, Timeout: &metav1.Duration{Duration: 5 * time.Second}}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Date(2026, time.August, 15, 12, 0, 0, 0, time.UTC))},
+			Spec: gardencorev1beta1.ShootSpec{
+				Maintenance: &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}},
+			},
+		}
+
+		apiServerStatusCode = http.StatusUnauthorized
+		apiServer = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/healthz" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(apiServerStatusCode)
+		}))
+		address = strings.TrimPrefix(apiServer.URL, "https://")
+
+		proberResponse = "probe_duration_seconds 0.1\nprobe_success 1\n"
+		proberQueries = nil
+		prober = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proberQueries = append(proberQueries, map[string]string{"target": r.URL.Query().Get("target"), "module": r.URL.Query().Get("module")})
+			fmt.Fprint(w, proberResponse)
+		}))
+
+		DeferCleanup(func() {
+			apiServer.Close()
+			prober.Close()
+		})
+	})
+
+	probe := func() *APIServerAvailabilityResult {
+		result, err := NewAPIServerProber(seedClient, fakeClock, config, namespace, address, shoot).Probe(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+
+	accountingData := func() map[string]string {
+		configMap := &corev1.ConfigMap{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "apiserver-availability", Namespace: namespace}, configMap)).To(Succeed())
+		return configMap.Data
+	}
+
+	Describe("#Probe", func() {
+		It("should report the API server as reachable from the seed", func() {
+			result := probe()
+
+			Expect(result.VantagePoints).To(ConsistOf("seed"))
+			Expect(result.Failures).To(BeEmpty())
+			Expect(result.WindowStart).To(Equal(time.Date(2026, time.October, 11, 0, 0, 0, 0, time.UTC)))
+			Expect(result.Downtime).To(BeZero())
+			Expect(result.Availability).To(Equal(float64(100)))
+			Expect(accountingData()).To(Equal(map[string]string{
+				"windowStart":         "2026-10-11T00:00:00Z",
+				"downtime":            "0s",
+				"maintenanceDowntime": "0s",
+				"lastProbeTime":       "2026-10-11T00:00:00Z",
+				"lastProbeSuccessful": "true",
+			}))
+		})
+
+		It("should report the API server as unreachable from the seed if it responds with a server error", func() {
+			apiServerStatusCode = http.StatusServiceUnavailable
+
+			result := probe()
+
+			Expect(result.Failures).To(HaveKeyWithValue("seed", "unexpected status code 503"))
+			Expect(accountingData()).To(HaveKeyWithValue("lastProbeSuccessful", "false"))
+		})
+
+		It("should report the API server as unreachable from the seed if it does not respond", func() {
+			apiServer.Close()
+
+			Expect(probe().Failures).To(HaveKey("seed"))
+		})
+
+		Context("with external prober", func() {
+			BeforeEach(func() {
+				config.ExternalProber = &gardenletconfigv1alpha1.ExternalAPIServerProber{URL: prober.URL + "/probe", Module: ptr.To("http_2xx")}
+			})
+
+			It("should report the API server as reachable from all vantage points", func() {
+				result := probe()
+
+				Expect(result.VantagePoints).To(ConsistOf("seed", "external"))
+				Expect(result.Failures).To(BeEmpty())
+				Expect(proberQueries).To(ConsistOf(map[string]string{"target": "https://" + address + "/healthz", "module": "http_2xx"}))
+			})
+
+			It("should report the API server as unreachable from the external prober if the probe failed", func() {
+				proberResponse = "probe_success 0\n"
+
+				Expect(probe().Failures).To(Equal(map[string]string{"external": "probe failed"}))
+			})
+
+			It("should report the API server as unreachable from the external prober if the result is missing", func() {
+				proberResponse = "foo 1\n"
+
+				Expect(probe().Failures).To(Equal(map[string]string{"external": "prober did not report probe_success metric"}))
+			})
+
+			It("should report the API server as unreachable from the external prober if the prober is not reachable", func() {
+				prober.Close()
+
+				Expect(probe().Failures).To(HaveKeyWithValue("external", ContainSubstring("prober not reachable")))
+			})
+		})
+
+		Context("downtime accounting", func() {
+			It("should account the time since the previous probe as downtime if the API server is unreachable", func() {
+				probe()
+				fakeClock.Step(time.Hour)
+				probe()
+
+				apiServerStatusCode = http.StatusServiceUnavailable
+				fakeClock.Step(time.Minute)
+				result := probe()
+				Expect(result.Downtime).To(Equal(time.Minute))
+				Expect(result.Availability).To(BeNumerically("~", 100*(1-1.0/61), 0.0001))
+
+				fakeClock.Step(2 * time.Minute)
+				Expect(probe().Downtime).To(Equal(3 * time.Minute))
+
+				apiServerStatusCode = http.StatusOK
+				fakeClock.Step(time.Minute)
+				Expect(probe().Downtime).To(Equal(3 * time.Minute))
+				Expect(accountingData()).To(HaveKeyWithValue("downtime", "3m0s"))
+			})
+
+			It("should not account downtime for the first probe in a window", func() {
+				apiServerStatusCode = http.StatusServiceUnavailable
+
+				Expect(probe().Downtime).To(BeZero())
+			})
+
+			It("should account the downtime within the maintenance time window separately", func() {
+				fakeClock.SetTime(time.Date(2026, time.October, 11, 22, 0, 0, 0, time.UTC))
+				probe()
+
+				apiServerStatusCode = http.StatusServiceUnavailable
+				fakeClock.Step(5 * time.Minute)
+				result := probe()
+				Expect(result.Downtime).To(BeZero())
+				Expect(result.MaintenanceDowntime).To(Equal(5 * time.Minute))
+				Expect(result.Availability).To(Equal(float64(100)))
+				Expect(accountingData()).To(HaveKeyWithValue("maintenanceDowntime", "5m0s"))
+			})
+
+			It("should keep the downtime of the previous month when a new month starts", func() {
+				Expect(seedClient.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "apiserver-availability", Namespace: namespace},
+					Data: map[string]string{
+						"windowStart":                 "2026-09-01T00:00:00Z",
+						"downtime":                    "1h0m0s",
+						"maintenanceDowntime":         "10m0s",
+						"previousWindowStart":         "2026-08-01T00:00:00Z",
+						"previousDowntime":            "2h0m0s",
+						"previousMaintenanceDowntime": "0s",
+						"lastProbeTime":               "2026-09-30T23:59:00Z",
+						"lastProbeSuccessful":         "false",
+					},
+				})).To(Succeed())
+
+				apiServerStatusCode = http.StatusServiceUnavailable
+				fakeClock.SetTime(time.Date(2026, time.October, 1, 0, 1, 0, 0, time.UTC))
+				result := probe()
+				Expect(result.WindowStart).To(Equal(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)))
+				Expect(result.Downtime).To(Equal(time.Minute))
+				Expect(accountingData()).To(Equal(map[string]string{
+					"windowStart":                 "2026-10-01T00:00:00Z",
+					"downtime":                    "1m0s",
+					"maintenanceDowntime":         "0s",
+					"previousWindowStart":         "2026-09-01T00:00:00Z",
+					"previousDowntime":            "1h0m0s",
+					"previousMaintenanceDowntime": "10m0s",
+					"lastProbeTime":               "2026-10-01T00:01:00Z",
+					"lastProbeSuccessful":         "false",
+				}))
+			})
+		})
+	})
+
+	Describe("#RestoreAPIServerAvailabilityAccounting", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Date(2026, time.October, 11, 0, 0, 0, 0, time.UTC)
+			shoot.Status.AvailabilityReports = []gardencorev1beta1.ShootAvailabilityReport{
+				{Period: "2026-08", Downtime: metav1.Duration{Duration: 4 * time.Hour}, Final: true},
+				{Period: "2026-09", Downtime: metav1.Duration{Duration: 2 * time.Hour}},
+				{Period: "2026-10", Downtime: metav1.Duration{Duration: time.Hour}, MaintenanceDowntime: metav1.Duration{Duration: 5 * time.Minute}},
+			}
+		})
+
+		It("should restore the downtime of the reports which are not final", func() {
+			Expect(RestoreAPIServerAvailabilityAccounting(shoot, now)).To(Equal(APIServerAvailabilityAccounting{
+				Current:  &APIServerDowntime{WindowStart: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), Downtime: time.Hour, MaintenanceDowntime: 5 * time.Minute},
+				Previous: &APIServerDowntime{WindowStart: time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC), Downtime: 2 * time.Hour},
+			}))
+		})
+
+		It("should start the windows with the creation of the shoot", func() {
+			shoot.CreationTimestamp = metav1.NewTime(time.Date(2026, time.September, 20, 8, 0, 0, 0, time.UTC))
+
+			accounting := RestoreAPIServerAvailabilityAccounting(shoot, now)
+			Expect(accounting.Current.WindowStart).To(Equal(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(accounting.Previous.WindowStart).To(Equal(time.Date(2026, time.September, 20, 8, 0, 0, 0, time.UTC)))
+		})
+
+		It("should ignore reports of older months", func() {
+			now = time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC)
+
+			Expect(RestoreAPIServerAvailabilityAccounting(shoot, now)).To(BeZero())
+		})
+
+		It("should continue the accounting from the reports if the ConfigMap does not exist", func() {
+			fakeClock.SetTime(now)
+			apiServerStatusCode = http.StatusServiceUnavailable
+
+			result := probe()
+			Expect(result.WindowStart).To(Equal(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(result.Downtime).To(Equal(time.Hour))
+			Expect(accountingData()).To(Equal(map[string]string{
+				"windowStart":                 "2026-10-01T00:00:00Z",
+				"downtime":                    "1h0m0s",
+				"maintenanceDowntime":         "5m0s",
+				"previousWindowStart":         "2026-09-01T00:00:00Z",
+				"previousDowntime":            "2h0m0s",
+				"previousMaintenanceDowntime": "0s",
+				"lastProbeTime":               "2026-10-11T00:00:00Z",
+				"lastProbeSuccessful":         "false",
+			}))
+		})
+	})
+
+	Describe("APIServerAvailabilityResult", func() {
+		Describe("#Message", func() {
+			var result *APIServerAvailabilityResult
+
+			BeforeEach(func() {
+				result = &APIServerAvailabilityResult{
+					VantagePoints: []string{"seed", "external"},
+					APIServerDowntime: APIServerDowntime{
+						WindowStart: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+						Downtime:    time.Minute,
+					},
+					Availability: 99.99,
+				}
+			})
+
+			It("should describe a reachable API server", func() {
+				Expect(result.Message()).To(Equal("API server is reachable from all vantage points (seed, external). Downtime since 2026-10-01T00:00:00Z: 1m0s (availability: 99.990%)."))
+			})
+
+			It("should describe an unreachable API server", func() {
+				result.Failures = map[string]string{"external": "probe failed"}
+
+				Expect(result.Message()).To(Equal("API server is not reachable from all vantage points (external: probe failed). Downtime since 2026-10-01T00:00:00Z: 1m0s (availability: 99.990%)."))
+			})
+
+			It("should mention the downtime within maintenance time windows", func() {
+				result.MaintenanceDowntime = 5 * time.Minute
+
+				Expect(result.Message()).To(Equal("API server is reachable from all vantage points (seed, external). Downtime since 2026-10-01T00:00:00Z: 1m0s, excluding 5m0s in maintenance time windows (availability: 99.990%)."))
+			})
+		})
+	})
+
+	Describe("APIServerDowntime", func() {
+		var downtime APIServerDowntime
+
+		BeforeEach(func() {
+			downtime = APIServerDowntime{
+				WindowStart: time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC),
+				Downtime:    3 * time.Hour,
+			}
+		})
+
+		It("should return the period", func() {
+			Expect(downtime.Period()).To(Equal("2026-09"))
+		})
+
+		It("should return the end of the window", func() {
+			Expect(downtime.WindowEnd()).To(Equal(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should compute the availability until the given time", func() {
+			Expect(downtime.Availability(time.Date(2026, time.September, 2, 0, 0, 0, 0, time.UTC))).To(Equal(87.5))
+		})
+
+		It("should compute the availability until the end of the window at most", func() {
+			Expect(downtime.Availability(time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC))).To(BeNumerically("~", 99.5833, 0.0001))
+		})
+	})
+})
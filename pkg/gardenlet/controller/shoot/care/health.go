@@ -37,8 +37,10 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/utils/timewindow"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
+	"github.com/gardener/gardener/pkg/component/networking/konnectivity"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -182,6 +184,14 @@ func (h *Health) Check(
 				return nil
 			},
 		)
+		if conditions.apiServerAvailability != nil {
+			taskFns = append(taskFns,
+				func(ctx context.Context) error {
+					availabilityCondition := h.checkAPIServerAvailabilityFromVantagePoints(ctx, *conditions.apiServerAvailability)
+					conditions.apiServerAvailability = &availabilityCondition
+					return nil
+				})
+		}
 		if conditions.everyNodeReady != nil {
 			taskFns = append(taskFns,
 				func(ctx context.Context) error {
@@ -202,6 +212,10 @@ func (h *Health) Check(
 
 		conditions.apiServerAvailable = v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, conditions.apiServerAvailable, "APIServerDown", "Could not reach API server during client initialization.")
 		conditions.systemComponentsHealthy = v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, conditions.systemComponentsHealthy, message)
+		if conditions.apiServerAvailability != nil {
+			availabilityCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.apiServerAvailability, message)
+			conditions.apiServerAvailability = &availabilityCondition
+		}
 		if conditions.everyNodeReady != nil {
 			nodeCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.everyNodeReady, message)
 			conditions.everyNodeReady = &nodeCondition
@@ -404,6 +418,39 @@ func (h *Health) checkAPIServerAvailability(ctx context.Context, shootRestClient
 	})
 }
 
+// checkAPIServerAvailabilityFromVantagePoints probes the external endpoint of the API server from all configured vantage
+// points and reports the result together with the accounted downtime.
+func (h *Health) checkAPIServerAvailabilityFromVantagePoints(ctx context.Context, condition gardencorev1beta1.Condition) gardencorev1beta1.Condition {
+	result, err := NewAPIServerProber(
+		h.seedClient.Client(),
+		h.clock,
+		gardenlethelper.GetAPIServerAvailabilityProbing(h.gardenletConfiguration),
+		h.shoot.ControlPlaneNamespace,
+		h.shoot.ComputeOutOfClusterAPIServerAddress(false),
+		h.shoot.GetInfo(),
+	).Probe(ctx)
+	if err != nil {
+		return v1beta1helper.UpdatedConditionUnknownErrorWithClock(h.clock, condition, err)
+	}
+
+	if len(result.Failures) > 0 {
+		return v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "APIServerUnreachable", result.Message())
+	}
+	return v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "APIServerReachable", result.Message())
+}
+
+func shootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot) *timewindow.MaintenanceTimeWindow {
+	if shoot.Spec.Maintenance == nil || shoot.Spec.Maintenance.TimeWindow == nil {
+		return nil
+	}
+
+	timeWindow, err := timewindow.ParseMaintenanceTimeWindow(shoot.Spec.Maintenance.TimeWindow.Begin, shoot.Spec.Maintenance.TimeWindow.End)
+	if err != nil {
+		return nil
+	}
+	return timeWindow
+}
+
 // checkControlPlane checks whether the core components of the Shoot controlplane (ETCD, KAPI, KCM..) are healthy.
 func (h *Health) checkControlPlane(
 	ctx context.Context,
@@ -436,6 +483,14 @@ func (h *Health) checkControlPlane(
 		}
 	}
 
+	if v1beta1helper.SeedSettingControlPlaneResourceQuotaEnabled(h.seed.GetInfo().Spec.Settings) {
+		if exhaustedResources, err := CheckControlPlaneResourceQuota(ctx, h.seedClient.Client(), h.shoot.ControlPlaneNamespace); err != nil {
+			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "ResourceQuotaCheckError", err.Error())), nil
+		} else if len(exhaustedResources) > 0 {
+			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "ResourceQuotaExhausted", fmt.Sprintf("The resource quota of the control plane namespace is exhausted for the following resources: %s", strings.Join(exhaustedResources, ", ")))), nil
+		}
+	}
+
 	if exitCondition := h.healthChecker.CheckManagedResources(condition, managedResources, func(managedResource resourcesv1alpha1.ManagedResource) bool {
 		return managedResource.Spec.Class != nil &&
 			sets.New("", string(gardencorev1beta1.ShootControlPlaneHealthy)).Has(managedResource.Labels[v1beta1constants.LabelCareConditionType])
@@ -479,6 +534,28 @@ func CheckIfDependencyWatchdogProberScaledDownControllers(ctx context.Context, s
 	return scaledDownDeploymentNames, nil
 }
 
+// CheckControlPlaneResourceQuota checks whether the ResourceQuota in the control plane namespace is exhausted. It returns
+// a description of all resources whose used amount has reached the hard limit.
+func CheckControlPlaneResourceQuota(ctx context.Context, seedClient client.Client, controlPlaneNamespace string) ([]string, error) {
+	resourceQuota := &corev1.ResourceQuota{}
+	if err := seedClient.Get(ctx, client.ObjectKey{Name: botanist.ControlPlaneResourceQuotaName, Namespace: controlPlaneNamespace}, resourceQuota); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading ResourceQuota %s: %w", botanist.ControlPlaneResourceQuotaName, err)
+	}
+
+	var exhaustedResources []string
+	for _, resourceName := range sets.List(sets.KeySet(resourceQuota.Status.Hard)) {
+		hard := resourceQuota.Status.Hard[resourceName]
+		if used, ok := resourceQuota.Status.Used[resourceName]; ok && used.Cmp(hard) >= 0 {
+			exhaustedResources = append(exhaustedResources, fmt.Sprintf("%s (used: %s, hard: %s)", resourceName, used.String(), hard.String()))
+		}
+	}
+
+	return exhaustedResources, nil
+}
+
 var monitoringSelector = labels.SelectorFromSet(map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleMonitoring})
 
 // checkObservabilityComponents checks whether the  observability components of the Shoot control plane (Prometheus, Vali, Plutono..) are healthy.
@@ -570,7 +647,12 @@ func (h *Health) checkSystemComponents(
 			return &c, nil
 		}
 
-		if established, err := botanist.CheckTunnelConnection(ctx, logr.Discard(), shootClient, v1beta1constants.VPNTunnel); err != nil || !established {
+		tunnelName := v1beta1constants.VPNTunnel
+		if !h.shoot.RunsControlPlane() && v1beta1helper.GetNodeConnectivityMode(h.shoot.GetInfo().Spec.Kubernetes.KubeAPIServer) == gardencorev1beta1.NodeConnectivityModeKonnectivity {
+			tunnelName = konnectivity.TunnelName
+		}
+
+		if established, err := botanist.CheckTunnelConnection(ctx, logr.Discard(), shootClient, tunnelName); err != nil || !established {
 			msg := "Tunnel connection has not been established"
 			if err != nil {
 				msg += fmt.Sprintf(" (%+v)", err)
@@ -657,6 +739,10 @@ func (h *Health) CheckClusterNodes(
 		}
 	}
 
+	if msg, err := CheckNodesInPlaceUpdate(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes); err != nil {
+		return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, msg, err.Error())), nil
+	}
+
 	if err := botanist.OperatingSystemConfigUpdatedForAllWorkerPools(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToCloudConfigSecretMeta); err != nil {
 		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "OperatingSystemConfigOutdated", err.Error())
 		return &c, nil
@@ -834,6 +920,67 @@ func CheckNodesScaling(ctx context.Context, seedClient client.Client, nodeList [
 	return "", nil
 }
 
+// CheckNodesInPlaceUpdate checks the per-node progress of in-place updates for all worker pools with an in-place update
+// strategy. If nodes failed to be updated or an update is still in progress, it returns a string describing the state
+// and an error containing the progress of the affected worker pool.
+func CheckNodesInPlaceUpdate(workers []gardencorev1beta1.Worker, workerPoolToNodes map[string][]corev1.Node) (string, error) {
+	var inProgressErr error
+
+	for _, pool := range workers {
+		if !v1beta1helper.IsUpdateStrategyInPlace(pool.UpdateStrategy) {
+			continue
+		}
+
+		var (
+			nodes                      = workerPoolToNodes[pool.Name]
+			updatedNodes               int
+			failedNodes, updatingNodes []string
+		)
+
+		for _, node := range nodes {
+			switch {
+			case node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult] == machinev1alpha1.LabelValueNodeUpdateFailed:
+				failedNodes = append(failedNodes, node.Name)
+			case node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult] == machinev1alpha1.LabelValueNodeUpdateSuccessful:
+				updatedNodes++
+			case nodeInPlaceUpdateInProgress(node):
+				updatingNodes = append(updatingNodes, node.Name)
+			default:
+				updatedNodes++
+			}
+		}
+
+		if len(failedNodes) > 0 {
+			return "NodesInPlaceUpdateFailed", fmt.Errorf("in-place update failed for %d node(s) in worker pool %q: %s", len(failedNodes), pool.Name, strings.Join(failedNodes, ", "))
+		}
+
+		if len(updatingNodes) > 0 && inProgressErr == nil {
+			inProgressErr = fmt.Errorf("in-place update in progress for worker pool %q (%d/%d nodes updated), nodes being updated: %s", pool.Name, updatedNodes, len(nodes), strings.Join(updatingNodes, ", "))
+		}
+	}
+
+	if inProgressErr != nil {
+		return "NodesInPlaceUpdating", inProgressErr
+	}
+
+	return "", nil
+}
+
+func nodeInPlaceUpdateInProgress(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type != machinev1alpha1.NodeInPlaceUpdate {
+			continue
+		}
+
+		switch condition.Reason {
+		case machinev1alpha1.SelectedForUpdate, machinev1alpha1.ReadyForUpdate, machinev1alpha1.DrainSuccessful:
+			return true
+		}
+	}
+
+	return false
+}
+
 func checkNodesScalingUp(machineList *machinev1alpha1.MachineList, readyNodes, desiredMachines int) error {
 	if readyNodes == desiredMachines {
 		return nil
@@ -1005,6 +1152,7 @@ var unstableLastOperationTypes = sets.New(
 // ShootConditions contains all shoot related conditions of the shoot status subresource.
 type ShootConditions struct {
 	apiServerAvailable             gardencorev1beta1.Condition
+	apiServerAvailability          *gardencorev1beta1.Condition
 	controlPlaneHealthy            gardencorev1beta1.Condition
 	observabilityComponentsHealthy gardencorev1beta1.Condition
 	systemComponentsHealthy        gardencorev1beta1.Condition
@@ -1013,12 +1161,14 @@ type ShootConditions struct {
 
 // ConvertToSlice returns the shoot conditions as a slice.
 func (s ShootConditions) ConvertToSlice() []gardencorev1beta1.Condition {
-	conditions := []gardencorev1beta1.Condition{
-		s.apiServerAvailable,
-		s.controlPlaneHealthy,
-		s.observabilityComponentsHealthy,
+	conditions := []gardencorev1beta1.Condition{s.apiServerAvailable}
+
+	if s.apiServerAvailability != nil {
+		conditions = append(conditions, *s.apiServerAvailability)
 	}
 
+	conditions = append(conditions, s.controlPlaneHealthy, s.observabilityComponentsHealthy)
+
 	if s.everyNodeReady != nil {
 		conditions = append(conditions, *s.everyNodeReady)
 	}
@@ -1028,12 +1178,14 @@ func (s ShootConditions) ConvertToSlice() []gardencorev1beta1.Condition {
 
 // ConditionTypes returns all shoot condition types.
 func (s ShootConditions) ConditionTypes() []gardencorev1beta1.ConditionType {
-	types := []gardencorev1beta1.ConditionType{
-		s.apiServerAvailable.Type,
-		s.controlPlaneHealthy.Type,
-		s.observabilityComponentsHealthy.Type,
+	types := []gardencorev1beta1.ConditionType{s.apiServerAvailable.Type}
+
+	if s.apiServerAvailability != nil {
+		types = append(types, gardencorev1beta1.ShootAPIServerAvailability)
 	}
 
+	types = append(types, s.controlPlaneHealthy.Type, s.observabilityComponentsHealthy.Type)
+
 	if s.everyNodeReady != nil {
 		types = append(types, gardencorev1beta1.ShootEveryNodeReady)
 	}
@@ -1042,8 +1194,9 @@ func (s ShootConditions) ConditionTypes() []gardencorev1beta1.ConditionType {
 }
 
 // NewShootConditions returns a new instance of ShootConditions.
-// All conditions are retrieved from the given 'shoot' or newly initialized.
-func NewShootConditions(clock clock.Clock, shoot *gardencorev1beta1.Shoot) ShootConditions {
+// All conditions are retrieved from the given 'shoot' or newly initialized. The APIServerAvailability condition is only
+// considered if 'apiServerAvailabilityProbing' is true.
+func NewShootConditions(clock clock.Clock, shoot *gardencorev1beta1.Shoot, apiServerAvailabilityProbing bool) ShootConditions {
 	shootConditions := ShootConditions{
 		apiServerAvailable:             v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootAPIServerAvailable),
 		controlPlaneHealthy:            v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootControlPlaneHealthy),
@@ -1056,5 +1209,10 @@ func NewShootConditions(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoot
 		shootConditions.everyNodeReady = &nodeCondition
 	}
 
+	if apiServerAvailabilityProbing {
+		availabilityCondition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootAPIServerAvailability)
+		shootConditions.apiServerAvailability = &availabilityCondition
+	}
+
 	return shootConditions
 }
@@ -20,6 +20,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -427,6 +428,74 @@ var _ = Describe("health check", func() {
 		)
 	})
 
+	Describe("#CheckNodesInPlaceUpdate", func() {
+		var (
+			workers []gardencorev1beta1.Worker
+
+			newNode = func(name string, labels map[string]string, inPlaceUpdateReason string) corev1.Node {
+				node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+				if inPlaceUpdateReason != "" {
+					node.Status.Conditions = []corev1.NodeCondition{{Type: machinev1alpha1.NodeInPlaceUpdate, Status: corev1.ConditionTrue, Reason: inPlaceUpdateReason}}
+				}
+				return node
+			}
+		)
+
+		BeforeEach(func() {
+			workers = []gardencorev1beta1.Worker{
+				{Name: "rolling"},
+				{Name: "in-place", UpdateStrategy: ptr.To(gardencorev1beta1.AutoInPlaceUpdate)},
+			}
+		})
+
+		It("should succeed when no worker pool uses an in-place update strategy", func() {
+			workers = workers[:1]
+
+			msg, err := CheckNodesInPlaceUpdate(workers, map[string][]corev1.Node{
+				"rolling": {newNode("node1", nil, machinev1alpha1.ReadyForUpdate)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(BeEmpty())
+		})
+
+		It("should succeed when all nodes are updated", func() {
+			msg, err := CheckNodesInPlaceUpdate(workers, map[string][]corev1.Node{
+				"in-place": {
+					newNode("node1", map[string]string{machinev1alpha1.LabelKeyNodeUpdateResult: machinev1alpha1.LabelValueNodeUpdateSuccessful}, machinev1alpha1.UpdateSuccessful),
+					newNode("node2", nil, ""),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(BeEmpty())
+		})
+
+		It("should report the progress when nodes are being updated", func() {
+			msg, err := CheckNodesInPlaceUpdate(workers, map[string][]corev1.Node{
+				"in-place": {
+					newNode("node1", map[string]string{machinev1alpha1.LabelKeyNodeUpdateResult: machinev1alpha1.LabelValueNodeUpdateSuccessful}, machinev1alpha1.UpdateSuccessful),
+					newNode("node2", nil, machinev1alpha1.ReadyForUpdate),
+					newNode("node3", nil, machinev1alpha1.SelectedForUpdate),
+				},
+			})
+			Expect(msg).To(Equal("NodesInPlaceUpdating"))
+			Expect(err).To(MatchError(`in-place update in progress for worker pool "in-place" (1/3 nodes updated), nodes being updated: node2, node3`))
+		})
+
+		It("should report failed nodes with precedence over nodes being updated", func() {
+			workers = append(workers, gardencorev1beta1.Worker{Name: "in-place-manual", UpdateStrategy: ptr.To(gardencorev1beta1.ManualInPlaceUpdate)})
+
+			msg, err := CheckNodesInPlaceUpdate(workers, map[string][]corev1.Node{
+				"in-place": {newNode("node1", nil, machinev1alpha1.DrainSuccessful)},
+				"in-place-manual": {
+					newNode("node2", map[string]string{machinev1alpha1.LabelKeyNodeUpdateResult: machinev1alpha1.LabelValueNodeUpdateFailed}, machinev1alpha1.UpdateFailed),
+					newNode("node3", nil, ""),
+				},
+			})
+			Expect(msg).To(Equal("NodesInPlaceUpdateFailed"))
+			Expect(err).To(MatchError(`in-place update failed for 1 node(s) in worker pool "in-place-manual": node2`))
+		})
+	})
+
 	Describe("#CheckNodesScaling", func() {
 		Describe("Rolling update", func() {
 			It("should prioritize NodesRollOutScalingUp over NodesScalingUp when returning an error if not enough machine objects as desired were created", func() {
@@ -889,6 +958,35 @@ var _ = Describe("health check", func() {
 		})
 	})
 
+	Describe("#CheckControlPlaneResourceQuota", func() {
+		It("should report nothing if the ResourceQuota does not exist", func() {
+			exhaustedResources, err := CheckControlPlaneResourceQuota(ctx, fakeClient, controlPlaneNamespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exhaustedResources).To(BeEmpty())
+		})
+
+		It("should report the resources whose quota is exhausted", func() {
+			Expect(fakeClient.Create(ctx, &corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-control-plane", Namespace: controlPlaneNamespace},
+				Status: corev1.ResourceQuotaStatus{
+					Hard: corev1.ResourceList{
+						corev1.ResourceRequestsCPU:    resource.MustParse("8"),
+						corev1.ResourceRequestsMemory: resource.MustParse("32Gi"),
+						corev1.ResourcePods:           resource.MustParse("50"),
+					},
+					Used: corev1.ResourceList{
+						corev1.ResourceRequestsCPU:    resource.MustParse("8"),
+						corev1.ResourceRequestsMemory: resource.MustParse("16Gi"),
+					},
+				},
+			})).To(Succeed())
+
+			exhaustedResources, err := CheckControlPlaneResourceQuota(ctx, fakeClient, controlPlaneNamespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exhaustedResources).To(HaveExactElements("requests.cpu (used: 8, hard: 8)"))
+		})
+	})
+
 	Describe("#CheckForExpiredNodeLeases", func() {
 		var (
 			nodeName = "node1"
@@ -1029,7 +1127,7 @@ var _ = Describe("health check", func() {
 							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
 						},
 					},
-				})
+				}, false)
 
 				Expect(conditions.ConvertToSlice()).To(ConsistOf(
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
@@ -1041,7 +1139,7 @@ var _ = Describe("health check", func() {
 			})
 
 			It("should initialize all conditions for workerless shoot", func() {
-				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{})
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{}, false)
 
 				Expect(conditions.ConvertToSlice()).To(ConsistOf(
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
@@ -1059,7 +1157,7 @@ var _ = Describe("health check", func() {
 							{Type: "Foo"},
 						},
 					},
-				})
+				}, false)
 
 				Expect(conditions.ConvertToSlice()).To(ConsistOf(
 					OfType("APIServerAvailable"),
@@ -1078,7 +1176,7 @@ var _ = Describe("health check", func() {
 							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
 						},
 					},
-				})
+				}, false)
 
 				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
 					OfType("APIServerAvailable"),
@@ -1088,6 +1186,18 @@ var _ = Describe("health check", func() {
 					OfType("SystemComponentsHealthy"),
 				))
 			})
+
+			It("should return the expected conditions if the API server availability is probed", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{}, true)
+
+				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
+					OfType("APIServerAvailable"),
+					OfType("APIServerAvailability"),
+					OfType("ControlPlaneHealthy"),
+					OfType("ObservabilityComponentsHealthy"),
+					OfType("SystemComponentsHealthy"),
+				))
+			})
 		})
 
 		Describe("#ConditionTypes", func() {
@@ -1098,7 +1208,7 @@ var _ = Describe("health check", func() {
 							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
 						},
 					},
-				})
+				}, false)
 
 				Expect(conditions.ConditionTypes()).To(HaveExactElements(
 					gardencorev1beta1.ConditionType("APIServerAvailable"),
@@ -1108,6 +1218,18 @@ var _ = Describe("health check", func() {
 					gardencorev1beta1.ConditionType("SystemComponentsHealthy"),
 				))
 			})
+
+			It("should return the expected condition types if the API server availability is probed", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{}, true)
+
+				Expect(conditions.ConditionTypes()).To(HaveExactElements(
+					gardencorev1beta1.ConditionType("APIServerAvailable"),
+					gardencorev1beta1.ConditionType("APIServerAvailability"),
+					gardencorev1beta1.ConditionType("ControlPlaneHealthy"),
+					gardencorev1beta1.ConditionType("ObservabilityComponentsHealthy"),
+					gardencorev1beta1.ConditionType("SystemComponentsHealthy"),
+				))
+			})
 		})
 	})
 })
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+// Inventory contains required information for computing the inventory of the machines and nodes of a shoot.
+type Inventory struct {
+	shoot *shoot.Shoot
+
+	seedClient             client.Client
+	initializeShootClients ShootClientInit
+
+	log logr.Logger
+}
+
+// NewInventory returns a new inventory instance.
+func NewInventory(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	shootClientInit ShootClientInit,
+) *Inventory {
+	return &Inventory{
+		shoot:                  shoot,
+		seedClient:             seedClient,
+		initializeShootClients: shootClientInit,
+		log:                    log,
+	}
+}
+
+// Compute computes the inventory of the shoot's worker pools based on the machine objects in the seed cluster. If the
+// nodes of the shoot can be inspected, the number of nodes with an outdated operating system config is computed as well.
+// It returns nil for workerless shoots.
+func (i *Inventory) Compute(ctx context.Context) (*gardencorev1beta1.ShootInventory, error) {
+	if i.shoot.IsWorkerless {
+		return nil, nil
+	}
+
+	machineList := &machinev1alpha1.MachineList{}
+	if err := i.seedClient.List(ctx, machineList, client.InNamespace(i.shoot.ControlPlaneNamespace)); err != nil {
+		return nil, fmt.Errorf("failed listing machines: %w", err)
+	}
+
+	nodeNameToNode, outdatedNodes := i.inspectNodes(ctx)
+
+	var (
+		workerPoolToInventory = make(map[string]*gardencorev1beta1.WorkerPoolInventory)
+		inventory             = &gardencorev1beta1.ShootInventory{}
+	)
+
+	for _, worker := range i.shoot.GetInfo().Spec.Provider.Workers {
+		workerPoolInventory := &gardencorev1beta1.WorkerPoolInventory{Name: worker.Name}
+		if outdatedNodes != nil {
+			workerPoolInventory.OutdatedNodes = ptr.To(outdatedNodes[worker.Name])
+		}
+		workerPoolToInventory[worker.Name] = workerPoolInventory
+	}
+
+	for _, machine := range machineList.Items {
+		workerPoolInventory, ok := workerPoolToInventory[machine.Spec.NodeTemplateSpec.Labels[v1beta1constants.LabelWorkerPool]]
+		if !ok {
+			continue
+		}
+
+		var (
+			nodeName = machine.Labels["node"]
+			node     = nodeNameToNode[nodeName]
+			joined   = nodeName != ""
+			zone     = machine.Spec.NodeTemplateSpec.Labels[corev1.LabelTopologyZone]
+		)
+
+		if zone == "" && node != nil {
+			zone = node.Labels[corev1.LabelTopologyZone]
+		}
+
+		workerPoolInventory.Machines++
+		if joined {
+			workerPoolInventory.Nodes++
+		}
+
+		phase := string(machine.Status.CurrentStatus.Phase)
+		if phase == "" {
+			// https://github.com/gardener/machine-controller-manager/issues/466
+			phase = string(machinev1alpha1.MachinePending)
+		}
+		workerPoolInventory.MachinePhases = incrementMachinePhase(workerPoolInventory.MachinePhases, phase)
+
+		if zone != "" {
+			workerPoolInventory.Zones = incrementZone(workerPoolInventory.Zones, zone, joined)
+		}
+	}
+
+	for _, worker := range i.shoot.GetInfo().Spec.Provider.Workers {
+		workerPoolInventory := workerPoolToInventory[worker.Name]
+		slices.SortFunc(workerPoolInventory.MachinePhases, func(a, b gardencorev1beta1.MachinePhaseCount) int { return strings.Compare(a.Phase, b.Phase) })
+		slices.SortFunc(workerPoolInventory.Zones, func(a, b gardencorev1beta1.ZoneInventory) int { return strings.Compare(a.Name, b.Name) })
+		inventory.WorkerPools = append(inventory.WorkerPools, *workerPoolInventory)
+	}
+
+	return inventory, nil
+}
+
+// inspectNodes returns the nodes of the shoot and the number of nodes with an outdated operating system config per
+// worker pool. The latter is nil if the nodes of the shoot could not be inspected.
+func (i *Inventory) inspectNodes(ctx context.Context) (map[string]*corev1.Node, map[string]int32) {
+	shootClient, apiServerRunning, err := i.initializeShootClients()
+	if err != nil || !apiServerRunning {
+		if err != nil {
+			i.log.Info("Could not initialize Shoot client for computing the inventory, the number of outdated nodes is not reported", "reason", err.Error())
+		}
+		return nil, nil
+	}
+
+	workerPoolToNodes, err := botanist.WorkerPoolToNodesMap(ctx, shootClient.Client())
+	if err != nil {
+		i.log.Info("Could not list nodes for computing the inventory, the number of outdated nodes is not reported", "reason", err.Error())
+		return nil, nil
+	}
+
+	workerPoolToOperatingSystemConfigSecretMeta, err := botanist.WorkerPoolToOperatingSystemConfigSecretMetaMap(ctx, shootClient.Client(), v1beta1constants.GardenRoleOperatingSystemConfig)
+	if err != nil {
+		i.log.Info("Could not list operating system config secrets for computing the inventory, the number of outdated nodes is not reported", "reason", err.Error())
+		return nil, nil
+	}
+
+	var (
+		nodeNameToNode = make(map[string]*corev1.Node)
+		outdatedNodes  = make(map[string]int32)
+	)
+
+	for pool, nodes := range workerPoolToNodes {
+		secretMeta, ok := workerPoolToOperatingSystemConfigSecretMeta[pool]
+
+		for _, node := range nodes {
+			nodeNameToNode[node.Name] = &node
+
+			if ok && botanist.OperatingSystemConfigOutdated(node, secretMeta) {
+				outdatedNodes[pool]++
+			}
+		}
+	}
+
+	return nodeNameToNode, outdatedNodes
+}
+
+func incrementMachinePhase(machinePhases []gardencorev1beta1.MachinePhaseCount, phase string) []gardencorev1beta1.MachinePhaseCount {
+	for idx := range machinePhases {
+		if machinePhases[idx].Phase == phase {
+			machinePhases[idx].Count++
+			return machinePhases
+		}
+	}
+
+	return append(machinePhases, gardencorev1beta1.MachinePhaseCount{Phase: phase, Count: 1})
+}
+
+func incrementZone(zones []gardencorev1beta1.ZoneInventory, zone string, joined bool) []gardencorev1beta1.ZoneInventory {
+	var nodes int32
+	if joined {
+		nodes = 1
+	}
+
+	for idx := range zones {
+		if zones[idx].Name == zone {
+			zones[idx].Machines++
+			zones[idx].Nodes += nodes
+			return zones
+		}
+	}
+
+	return append(zones, gardencorev1beta1.ZoneInventory{Name: zone, Machines: 1, Nodes: nodes})
+}
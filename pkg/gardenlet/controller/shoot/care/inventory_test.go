@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"errors"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("Inventory", func() {
+	var (
+		ctx         = context.Background()
+		namespace   = "shoot--foo--bar"
+		seedClient  client.Client
+		shootClient client.Client
+		shoot       *shootpkg.Shoot
+
+		shootClientInit ShootClientInit
+	)
+
+	newMachine := func(name, pool, zone, node string, phase machinev1alpha1.MachinePhase) *machinev1alpha1.Machine {
+		machine := &machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: machinev1alpha1.MachineSpec{
+				NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"worker.gardener.cloud/pool": pool}}},
+			},
+			Status: machinev1alpha1.MachineStatus{CurrentStatus: machinev1alpha1.CurrentStatus{Phase: phase}},
+		}
+		if zone != "" {
+			machine.Spec.NodeTemplateSpec.Labels["topology.kubernetes.io/zone"] = zone
+		}
+		if node != "" {
+			machine.Labels = map[string]string{"node": node}
+		}
+		return machine
+	}
+
+	newNode := func(name, pool, zone, checksum string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      map[string]string{"worker.gardener.cloud/pool": pool, "topology.kubernetes.io/zone": zone},
+			Annotations: map[string]string{"checksum/cloud-config-data": checksum},
+		}}
+	}
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+		shoot = &shootpkg.Shoot{ControlPlaneNamespace: namespace}
+		shoot.SetInfo(&gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "pool1"}, {Name: "pool2"}},
+				},
+			},
+		})
+
+		shootClientInit = func() (kubernetes.Interface, bool, error) {
+			return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+		}
+
+		for _, obj := range []client.Object{
+			newMachine("machine1", "pool1", "zone-a", "node1", machinev1alpha1.MachineRunning),
+			newMachine("machine2", "pool1", "zone-b", "node2", machinev1alpha1.MachineRunning),
+			newMachine("machine3", "pool1", "zone-b", "", ""),
+			newMachine("machine4", "pool2", "", "", machinev1alpha1.MachineFailed),
+			newMachine("machine5", "other", "zone-a", "", machinev1alpha1.MachineRunning),
+		} {
+			Expect(seedClient.Create(ctx, obj)).To(Succeed())
+		}
+
+		for _, obj := range []client.Object{
+			newNode("node1", "pool1", "zone-a", "current"),
+			newNode("node2", "pool1", "zone-b", "outdated"),
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:        "gardener-node-agent-pool1",
+				Namespace:   "kube-system",
+				Labels:      map[string]string{"gardener.cloud/role": "operating-system-config", "worker.gardener.cloud/pool": "pool1"},
+				Annotations: map[string]string{"checksum/data-script": "current"},
+			}},
+		} {
+			Expect(shootClient.Create(ctx, obj)).To(Succeed())
+		}
+	})
+
+	It("should return nil for workerless shoots", func() {
+		shoot.IsWorkerless = true
+
+		Expect(NewInventory(logr.Discard(), shoot, seedClient, shootClientInit).Compute(ctx)).To(BeNil())
+	})
+
+	It("should compute the inventory of all worker pools", func() {
+		Expect(NewInventory(logr.Discard(), shoot, seedClient, shootClientInit).Compute(ctx)).To(Equal(&gardencorev1beta1.ShootInventory{
+			WorkerPools: []gardencorev1beta1.WorkerPoolInventory{
+				{
+					Name:     "pool1",
+					Machines: 3,
+					Nodes:    2,
+					MachinePhases: []gardencorev1beta1.MachinePhaseCount{
+						{Phase: "Pending", Count: 1},
+						{Phase: "Running", Count: 2},
+					},
+					Zones: []gardencorev1beta1.ZoneInventory{
+						{Name: "zone-a", Machines: 1, Nodes: 1},
+						{Name: "zone-b", Machines: 2, Nodes: 1},
+					},
+					OutdatedNodes: ptr.To[int32](1),
+				},
+				{
+					Name:     "pool2",
+					Machines: 1,
+					MachinePhases: []gardencorev1beta1.MachinePhaseCount{
+						{Phase: "Failed", Count: 1},
+					},
+					OutdatedNodes: ptr.To[int32](0),
+				},
+			},
+		}))
+	})
+
+	It("should not report outdated nodes if the shoot client cannot be initialized", func() {
+		shootClientInit = func() (kubernetes.Interface, bool, error) {
+			return nil, false, errors.New("fake")
+		}
+
+		inventory, err := NewInventory(logr.Discard(), shoot, seedClient, shootClientInit).Compute(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inventory.WorkerPools).To(HaveLen(2))
+		Expect(inventory.WorkerPools[0].Machines).To(Equal(int32(3)))
+		Expect(inventory.WorkerPools[0].OutdatedNodes).To(BeNil())
+		Expect(inventory.WorkerPools[1].OutdatedNodes).To(BeNil())
+	})
+})
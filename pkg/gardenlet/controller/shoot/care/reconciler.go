@@ -12,8 +12,10 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +45,10 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewRemediator is used to create a new remediation instance.
+	NewRemediator = defaultNewRemediator
+	// NewInventoryCollector is used to create a new inventory instance.
+	NewInventoryCollector = defaultNewInventoryCollector
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
@@ -52,6 +58,7 @@ type Reconciler struct {
 	ShootClientMap        clientmap.ClientMap
 	Config                gardenletconfigv1alpha1.GardenletConfiguration
 	Clock                 clock.Clock
+	Recorder              events.EventRecorder
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
 	SeedName              string
@@ -90,7 +97,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	defer cancel()
 
 	// Initialize conditions based on the current status.
-	shootConditions := NewShootConditions(r.Clock, shoot)
+	shootConditions := NewShootConditions(r.Clock, shoot, gardenlethelper.GetAPIServerAvailabilityProbing(&r.Config) != nil)
 
 	// Initialize constraints based on the current status.
 	shootConstraints := NewShootConstraints(r.Clock, shoot)
@@ -157,7 +164,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	)
 	if err != nil {
 		updatedConditions, updatedConstraints := r.setStatusToUnknown("Precondition failed: operation could not be initialized", shootConditions.ConvertToSlice(), shootConstraints.ConvertToSlice())
-		if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints); err != nil {
+		if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints, shoot.Status.Inventory); err != nil {
 			log.Error(err, "Error when trying to update the shoot status after failed operation initialization")
 		}
 		return reconcile.Result{}, err
@@ -167,6 +174,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		updatedInventory                      = shoot.Status.Inventory
 	)
 
 	if err := flow.Parallel(
@@ -203,6 +211,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			)
 			return nil
 		},
+		// Compute inventory of machines and nodes
+		func(ctx context.Context) error {
+			inventory, err := NewInventoryCollector(
+				log,
+				o.Shoot,
+				r.SeedClientSet.Client(),
+				initializeShootClients,
+			).Compute(ctx)
+			if err != nil {
+				// errors during the inventory computation are only being logged and do not cause the care operation to fail
+				log.Error(err, "Error when trying to compute the inventory")
+				return nil
+			}
+			updatedInventory = inventory
+			return nil
+		},
 		// Trigger garbage collection
 		func(ctx context.Context) error {
 			NewGarbageCollector(o, initializeShootClients).Collect(ctx)
@@ -217,11 +241,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			}
 			return nil
 		},
+		// Trigger remediation of well-known unhealthy states
+		func(ctx context.Context) error {
+			if remediation := r.Config.Controllers.ShootCare.Remediation; remediation != nil && len(remediation.Rules) > 0 {
+				controllerRegistrationList := &gardencorev1beta1.ControllerRegistrationList{}
+				if err := r.GardenClient.List(ctx, controllerRegistrationList); err != nil {
+					log.Error(err, "Failed listing ControllerRegistrations, skipping remediation")
+					return nil
+				}
+
+				_ = NewRemediator(log, o.Shoot, r.SeedClientSet.Client(), initializeShootClients, r.Clock, r.Recorder, remediation.Rules, v1beta1helper.ErrorCodeDefinitionsFromControllerRegistrations(controllerRegistrationList.Items)).Remediate(ctx)
+				// errors during remediation are only being logged and do not cause the care operation to fail
+			}
+			return nil
+		},
 	)(careCtx); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints); err != nil {
+	if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints, updatedInventory); err != nil {
 		log.Error(err, "Error when trying to update the shoot status")
 		return reconcile.Result{}, err
 	}
@@ -237,9 +275,11 @@ func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1b
 	return out
 }
 
-func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, existingConditions ShootConditions, updatedConditions []gardencorev1beta1.Condition, existingConstraints ShootConstraints, updatedConstraints []gardencorev1beta1.Condition) error {
-	// Update Shoot status (conditions, constraints) only if necessary
-	if !v1beta1helper.ConditionsNeedUpdate(existingConditions.ConvertToSlice(), updatedConditions) && !v1beta1helper.ConditionsNeedUpdate(existingConstraints.ConvertToSlice(), updatedConstraints) {
+func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, existingConditions ShootConditions, updatedConditions []gardencorev1beta1.Condition, existingConstraints ShootConstraints, updatedConstraints []gardencorev1beta1.Condition, updatedInventory *gardencorev1beta1.ShootInventory) error {
+	// Update Shoot status (conditions, constraints, inventory) only if necessary
+	if !v1beta1helper.ConditionsNeedUpdate(existingConditions.ConvertToSlice(), updatedConditions) &&
+		!v1beta1helper.ConditionsNeedUpdate(existingConstraints.ConvertToSlice(), updatedConstraints) &&
+		apiequality.Semantic.DeepEqual(shoot.Status.Inventory, updatedInventory) {
 		return nil
 	}
 
@@ -248,11 +288,12 @@ func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *ga
 	mergedConditions := v1beta1helper.BuildConditions(shoot.Status.Conditions, updatedConditions, existingConditions.ConditionTypes())
 	mergedConstraints := v1beta1helper.BuildConditions(shoot.Status.Constraints, updatedConstraints, existingConstraints.ConstraintTypes())
 
-	log.V(1).Info("Updating status conditions and constraints")
+	log.V(1).Info("Updating status conditions, constraints and inventory")
 
 	patch := client.StrategicMergeFrom(shoot.DeepCopy())
 	shoot.Status.Conditions = mergedConditions
 	shoot.Status.Constraints = mergedConstraints
+	shoot.Status.Inventory = updatedInventory
 	return r.GardenClient.Status().Patch(ctx, shoot, patch)
 }
 
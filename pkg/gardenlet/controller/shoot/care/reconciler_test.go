@@ -246,6 +246,7 @@ var _ = Describe("Shoot Care Control", func() {
 				shootClientMap clientmap.ClientMap
 				managedSeed    *seedmanagementv1alpha1.ManagedSeed
 				operationFunc  NewOperationFunc
+				inventory      *gardencorev1beta1.ShootInventory
 			)
 
 			JustBeforeEach(func() {
@@ -264,6 +265,7 @@ var _ = Describe("Shoot Care Control", func() {
 				DeferCleanup(test.WithVars(
 					&NewOperation, operationFunc,
 					&NewGarbageCollector, nopGarbageCollectorFunc(),
+					&NewInventoryCollector, inventoryCollectorFunc(inventory),
 				))
 				reconciler = &Reconciler{
 					GardenClient:   gardenClient,
@@ -277,6 +279,34 @@ var _ = Describe("Shoot Care Control", func() {
 
 			AfterEach(func() {
 				shoot = nil
+				inventory = nil
+			})
+
+			Context("when the inventory is computed", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+					))
+
+					inventory = &gardencorev1beta1.ShootInventory{
+						WorkerPools: []gardencorev1beta1.WorkerPoolInventory{{
+							Name:          "worker",
+							Machines:      2,
+							Nodes:         1,
+							MachinePhases: []gardencorev1beta1.MachinePhaseCount{{Phase: "Pending", Count: 1}, {Phase: "Running", Count: 1}},
+							OutdatedNodes: ptr.To[int32](0),
+						}},
+					}
+				})
+
+				It("should set the inventory", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Inventory).To(Equal(inventory))
+				})
 			})
 
 			Context("when no conditions / constraints are returned", func() {
@@ -585,6 +615,20 @@ func constraintCheckFunc(fn resultingConstraintFunc) NewConstraintCheckFunc {
 	}
 }
 
+type inventoryCollector struct {
+	inventory *gardencorev1beta1.ShootInventory
+}
+
+func (i *inventoryCollector) Compute(_ context.Context) (*gardencorev1beta1.ShootInventory, error) {
+	return i.inventory, nil
+}
+
+func inventoryCollectorFunc(inventory *gardencorev1beta1.ShootInventory) NewInventoryCollectorFunc {
+	return func(_ logr.Logger, _ *shootpkg.Shoot, _ client.Client, _ ShootClientInit) InventoryCollector {
+		return &inventoryCollector{inventory: inventory}
+	}
+}
+
 func opFunc(op *operation.Operation, err error) NewOperationFunc {
 	return func(
 		_ context.Context,
@@ -0,0 +1,355 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/api/extensions"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// Remediation contains required information for the automatic remediation of well-known unhealthy states of shoot
+// clusters.
+type Remediation struct {
+	log                    logr.Logger
+	shoot                  *shoot.Shoot
+	seedClient             client.Client
+	initializeShootClients ShootClientInit
+	clock                  clock.Clock
+	recorder               events.EventRecorder
+	rules                  []gardenletconfigv1alpha1.ShootRemediationRule
+	errorCodeDefinitions   []gardencorev1beta1.ErrorCodeDefinition
+}
+
+// NewRemediation creates a new instance for the automatic remediation of well-known unhealthy states.
+func NewRemediation(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	shootClientInit ShootClientInit,
+	clock clock.Clock,
+	recorder events.EventRecorder,
+	rules []gardenletconfigv1alpha1.ShootRemediationRule,
+	errorCodeDefinitions []gardencorev1beta1.ErrorCodeDefinition,
+) *Remediation {
+	return &Remediation{
+		log:                    log,
+		shoot:                  shoot,
+		seedClient:             seedClient,
+		initializeShootClients: shootClientInit,
+		clock:                  clock,
+		recorder:               recorder,
+		rules:                  rules,
+		errorCodeDefinitions:   errorCodeDefinitions,
+	}
+}
+
+// Remediate performs the configured remediation actions. Every performed action is recorded as an event for the Shoot.
+// No actions are performed while the Shoot is hibernated, in deletion, or while an operation is in progress.
+func (r *Remediation) Remediate(ctx context.Context) error {
+	shootObj := r.shoot.GetInfo()
+
+	if shootObj.DeletionTimestamp != nil ||
+		v1beta1helper.HibernationIsEnabled(shootObj) || shootObj.Status.IsHibernated ||
+		(shootObj.Status.LastOperation != nil && shootObj.Status.LastOperation.State == gardencorev1beta1.LastOperationStateProcessing) {
+		return nil
+	}
+
+	var fns []flow.TaskFn
+
+	for _, rule := range r.rules {
+		var (
+			action    = rule.Action
+			threshold time.Duration
+			fn        func(context.Context, time.Duration) error
+		)
+
+		if rule.Threshold != nil {
+			threshold = rule.Threshold.Duration
+		}
+
+		switch action {
+		case gardenletconfigv1alpha1.ShootRemediationActionRestartCrashLoopingPods:
+			fn = r.restartCrashLoopingPods
+		case gardenletconfigv1alpha1.ShootRemediationActionRetriggerFailedExtensions:
+			fn = r.retriggerFailedExtensions
+		case gardenletconfigv1alpha1.ShootRemediationActionKickStuckWebhooks:
+			fn = r.kickStuckWebhooks
+		default:
+			continue
+		}
+
+		fns = append(fns, func(ctx context.Context) error {
+			if err := fn(ctx, threshold); err != nil {
+				r.log.Error(err, "Remediation action failed", "action", action)
+				return fmt.Errorf("remediation action %s failed: %w", action, err)
+			}
+			return nil
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
+// restartCrashLoopingPods deletes pods in the control plane namespace which are crash-looping for longer than the
+// given threshold. Only pods managed by a controller are considered since they are recreated.
+func (r *Remediation) restartCrashLoopingPods(ctx context.Context, threshold time.Duration) error {
+	podList := &corev1.PodList{}
+	if err := r.seedClient.List(ctx, podList, client.InNamespace(r.shoot.ControlPlaneNamespace)); err != nil {
+		return fmt.Errorf("failed listing pods in control plane namespace: %w", err)
+	}
+
+	for _, pod := range podList.Items {
+		if metav1.GetControllerOf(&pod) == nil || !isCrashLooping(&pod) || !r.isNotReadyForLongerThan(&pod, threshold) {
+			continue
+		}
+
+		if err := deletePod(ctx, r.seedClient, &pod); err != nil {
+			return err
+		}
+
+		r.log.Info("Restarted crash-looping control plane pod", "pod", client.ObjectKeyFromObject(&pod))
+		r.recordEventf("Restarted control plane pod %q because it was crash-looping for more than %s", pod.Name, threshold)
+	}
+
+	return nil
+}
+
+// retriggerFailedExtensions triggers a new reconciliation of extension resources whose last operation failed more
+// than the given threshold ago. Resources with errors which cannot be resolved by retrying are not considered.
+func (r *Remediation) retriggerFailedExtensions(ctx context.Context, threshold time.Duration) error {
+	extensionObjectLists := []client.ObjectList{
+		&extensionsv1alpha1.ExtensionList{},
+	}
+
+	if !r.shoot.IsWorkerless {
+		extensionObjectLists = append(extensionObjectLists,
+			&extensionsv1alpha1.ContainerRuntimeList{},
+			&extensionsv1alpha1.ControlPlaneList{},
+			&extensionsv1alpha1.InfrastructureList{},
+			&extensionsv1alpha1.NetworkList{},
+			&extensionsv1alpha1.OperatingSystemConfigList{},
+			&extensionsv1alpha1.WorkerList{},
+		)
+	}
+
+	for _, listObj := range extensionObjectLists {
+		if err := r.seedClient.List(ctx, listObj, client.InNamespace(r.shoot.ControlPlaneNamespace)); err != nil {
+			return fmt.Errorf("failed listing extension resources of kind %T: %w", listObj, err)
+		}
+
+		if err := meta.EachListItem(listObj, func(o runtime.Object) error {
+			obj, err := extensions.Accessor(o)
+			if err != nil {
+				return err
+			}
+
+			if !r.mustRetrigger(obj, threshold) {
+				return nil
+			}
+
+			gvk, err := apiutil.GVKForObject(obj, r.seedClient.Scheme())
+			if err != nil {
+				return err
+			}
+			kind := gvk.Kind
+
+			patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+			kubernetesutils.SetMetaDataAnnotation(obj, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+			if err := r.seedClient.Patch(ctx, obj, patch); err != nil {
+				return fmt.Errorf("failed triggering reconciliation of %s %q: %w", kind, obj.GetName(), err)
+			}
+
+			r.log.Info("Triggered reconciliation of failed extension resource", "kind", kind, "name", obj.GetName())
+			r.recordEventf("Triggered reconciliation of %s %q because its last operation failed more than %s ago", kind, obj.GetName(), threshold)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Remediation) mustRetrigger(obj extensionsv1alpha1.Object, threshold time.Duration) bool {
+	lastOperation := obj.GetExtensionStatus().GetLastOperation()
+	if lastOperation == nil || lastOperation.State != gardencorev1beta1.LastOperationStateFailed {
+		return false
+	}
+
+	if r.clock.Since(lastOperation.LastUpdateTime.Time) < threshold {
+		return false
+	}
+
+	if _, ok := obj.GetAnnotations()[v1beta1constants.GardenerOperation]; ok {
+		return false
+	}
+
+	if lastError := obj.GetExtensionStatus().GetLastError(); lastError != nil && v1beta1helper.HasNonRetryableErrorCode(r.errorCodeDefinitions, *lastError) {
+		return false
+	}
+
+	return true
+}
+
+// kickStuckWebhooks restarts the pods serving webhooks in the shoot cluster which fail closed if none of the pods is
+// ready for longer than the given threshold. Webhooks managed by Gardener are not considered.
+func (r *Remediation) kickStuckWebhooks(ctx context.Context, threshold time.Duration) error {
+	shootClientSet, apiServerRunning, err := r.initializeShootClients()
+	if err != nil {
+		return err
+	}
+	if !apiServerRunning {
+		return nil
+	}
+
+	var (
+		shootClient   = shootClientSet.Client()
+		services      = sets.New[types.NamespacedName]()
+		labelSelector = client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(
+			utils.MustNewRequirement(resourcesv1alpha1.ManagedBy, selection.NotIn, resourcesv1alpha1.GardenerManager),
+		)}
+		addServiceIfFailingClosed = func(failurePolicy *admissionregistrationv1.FailurePolicyType, clientConfig admissionregistrationv1.WebhookClientConfig) {
+			if (failurePolicy == nil || *failurePolicy == admissionregistrationv1.Fail) && clientConfig.Service != nil {
+				services.Insert(types.NamespacedName{Namespace: clientConfig.Service.Namespace, Name: clientConfig.Service.Name})
+			}
+		}
+	)
+
+	validatingWebhookConfigs := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := shootClient.List(ctx, validatingWebhookConfigs, labelSelector); err != nil {
+		return fmt.Errorf("failed listing ValidatingWebhookConfigurations: %w", err)
+	}
+	for _, config := range validatingWebhookConfigs.Items {
+		for _, w := range config.Webhooks {
+			addServiceIfFailingClosed(w.FailurePolicy, w.ClientConfig)
+		}
+	}
+
+	mutatingWebhookConfigs := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := shootClient.List(ctx, mutatingWebhookConfigs, labelSelector); err != nil {
+		return fmt.Errorf("failed listing MutatingWebhookConfigurations: %w", err)
+	}
+	for _, config := range mutatingWebhookConfigs.Items {
+		for _, w := range config.Webhooks {
+			addServiceIfFailingClosed(w.FailurePolicy, w.ClientConfig)
+		}
+	}
+
+	for key := range services {
+		service := &corev1.Service{}
+		if err := shootClient.Get(ctx, key, service); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed reading webhook service %s: %w", key, err)
+		}
+
+		if len(service.Spec.Selector) == 0 {
+			continue
+		}
+
+		podList := &corev1.PodList{}
+		if err := shootClient.List(ctx, podList, client.InNamespace(service.Namespace), client.MatchingLabels(service.Spec.Selector)); err != nil {
+			return fmt.Errorf("failed listing pods of webhook service %s: %w", key, err)
+		}
+
+		if len(podList.Items) == 0 || anyPodReady(podList.Items) {
+			continue
+		}
+
+		for _, pod := range podList.Items {
+			if metav1.GetControllerOf(&pod) == nil || !r.isNotReadyForLongerThan(&pod, threshold) {
+				continue
+			}
+
+			if err := deletePod(ctx, shootClient, &pod); err != nil {
+				return err
+			}
+
+			r.log.Info("Restarted pod of stuck webhook service", "service", key, "pod", client.ObjectKeyFromObject(&pod))
+			r.recordEventf("Restarted pod %q serving webhook service %q because the webhook had no ready endpoints for more than %s", client.ObjectKeyFromObject(&pod), key, threshold)
+		}
+	}
+
+	return nil
+}
+
+func (r *Remediation) isNotReadyForLongerThan(pod *corev1.Pod, threshold time.Duration) bool {
+	notReadySince := pod.CreationTimestamp.Time
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type != corev1.PodReady {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			return false
+		}
+		notReadySince = condition.LastTransitionTime.Time
+	}
+
+	return r.clock.Since(notReadySince) >= threshold
+}
+
+func (r *Remediation) recordEventf(messageFmt string, args ...any) {
+	if r.recorder == nil {
+		return
+	}
+	r.recorder.Eventf(r.shoot.GetInfo(), nil, corev1.EventTypeNormal, gardencorev1beta1.EventRemediated, gardencorev1beta1.EventActionRemediate, messageFmt, args...)
+}
+
+func isCrashLooping(pod *corev1.Pod) bool {
+	for _, containerStatus := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason == "CrashLoopBackOff" {
+			return true
+		}
+	}
+	return false
+}
+
+func anyPodReady(pods []corev1.Pod) bool {
+	for _, pod := range pods {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func deletePod(ctx context.Context, c client.Client, pod *corev1.Pod) error {
+	if err := c.Delete(ctx, pod, client.Preconditions{UID: &pod.UID}); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting pod %s: %w", client.ObjectKeyFromObject(pod), err)
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Remediation", func() {
+	var (
+		ctx = context.Background()
+
+		namespace = "shoot--foo--bar"
+		now       = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		fakeClock       *testclock.FakeClock
+		seedClient      client.Client
+		shootClient     client.Client
+		shootClientInit func() (kubernetes.Interface, bool, error)
+		recorder        *events.FakeRecorder
+
+		shoot                *shootpkg.Shoot
+		rules                []gardenletconfigv1alpha1.ShootRemediationRule
+		errorCodeDefinitions []gardencorev1beta1.ErrorCodeDefinition
+
+		owner = metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs", UID: "1", Controller: ptr.To(true)}
+
+		newPod = func(name, namespace string, ready bool, notReadySince time.Time, waitingReason string) *corev1.Pod {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            name,
+					Namespace:       namespace,
+					Labels:          map[string]string{"app": "webhook"},
+					OwnerReferences: []metav1.OwnerReference{owner},
+				},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{
+						Type:               corev1.PodReady,
+						Status:             corev1.ConditionFalse,
+						LastTransitionTime: metav1.Time{Time: notReadySince},
+					}},
+				},
+			}
+			if ready {
+				pod.Status.Conditions[0].Status = corev1.ConditionTrue
+			}
+			if waitingReason != "" {
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+					Name:  "app",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: waitingReason}},
+				}}
+			}
+			return pod
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(now)
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		shootClientSet := fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build()
+		shootClientInit = func() (kubernetes.Interface, bool, error) {
+			return shootClientSet, true, nil
+		}
+		recorder = events.NewFakeRecorder(10)
+
+		shoot = &shootpkg.Shoot{ControlPlaneNamespace: namespace}
+		shoot.SetInfo(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}})
+		rules = nil
+		errorCodeDefinitions = nil
+	})
+
+	remediate := func() error {
+		return NewRemediation(logr.Discard(), shoot, seedClient, shootClientInit, fakeClock, recorder, rules, errorCodeDefinitions).Remediate(ctx)
+	}
+
+	Describe("RestartCrashLoopingPods", func() {
+		BeforeEach(func() {
+			rules = []gardenletconfigv1alpha1.ShootRemediationRule{{
+				Action:    gardenletconfigv1alpha1.ShootRemediationActionRestartCrashLoopingPods,
+				Threshold: &metav1.Duration{Duration: 10 * time.Minute},
+			}}
+		})
+
+		It("should restart pods crash-looping for longer than the threshold", func() {
+			crashLooping := newPod("crash-looping", namespace, false, now.Add(-15*time.Minute), "CrashLoopBackOff")
+			recentlyCrashLooping := newPod("recently-crash-looping", namespace, false, now.Add(-5*time.Minute), "CrashLoopBackOff")
+			pending := newPod("pending", namespace, false, now.Add(-15*time.Minute), "ContainerCreating")
+			unowned := newPod("unowned", namespace, false, now.Add(-15*time.Minute), "CrashLoopBackOff")
+			unowned.OwnerReferences = nil
+
+			for _, pod := range []*corev1.Pod{crashLooping, recentlyCrashLooping, pending, unowned} {
+				Expect(seedClient.Create(ctx, pod)).To(Succeed())
+			}
+
+			Expect(remediate()).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(crashLooping), &corev1.Pod{})).To(BeNotFoundError())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(recentlyCrashLooping), &corev1.Pod{})).To(Succeed())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pending), &corev1.Pod{})).To(Succeed())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(unowned), &corev1.Pod{})).To(Succeed())
+			Expect(recorder.Events).To(Receive(Equal(`Normal Remediated Restarted control plane pod "crash-looping" because it was crash-looping for more than 10m0s`)))
+			Expect(recorder.Events).NotTo(Receive())
+		})
+
+		It("should not perform any action while an operation is in progress", func() {
+			shoot.GetInfo().Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateProcessing}
+			crashLooping := newPod("crash-looping", namespace, false, now.Add(-15*time.Minute), "CrashLoopBackOff")
+			Expect(seedClient.Create(ctx, crashLooping)).To(Succeed())
+
+			Expect(remediate()).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(crashLooping), &corev1.Pod{})).To(Succeed())
+			Expect(recorder.Events).NotTo(Receive())
+		})
+	})
+
+	Describe("RetriggerFailedExtensions", func() {
+		var newExtension func(name string, state gardencorev1beta1.LastOperationState, lastUpdate time.Time) *extensionsv1alpha1.Extension
+
+		BeforeEach(func() {
+			rules = []gardenletconfigv1alpha1.ShootRemediationRule{{
+				Action:    gardenletconfigv1alpha1.ShootRemediationActionRetriggerFailedExtensions,
+				Threshold: &metav1.Duration{Duration: 10 * time.Minute},
+			}}
+			shoot.IsWorkerless = true
+
+			newExtension = func(name string, state gardencorev1beta1.LastOperationState, lastUpdate time.Time) *extensionsv1alpha1.Extension {
+				return &extensionsv1alpha1.Extension{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Status: extensionsv1alpha1.ExtensionStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						LastOperation: &gardencorev1beta1.LastOperation{State: state, LastUpdateTime: metav1.Time{Time: lastUpdate}},
+					}},
+				}
+			}
+		})
+
+		It("should trigger the reconciliation of extensions which failed longer ago than the threshold", func() {
+			failed := newExtension("failed", gardencorev1beta1.LastOperationStateFailed, now.Add(-15*time.Minute))
+			recentlyFailed := newExtension("recently-failed", gardencorev1beta1.LastOperationStateFailed, now.Add(-5*time.Minute))
+			succeeded := newExtension("succeeded", gardencorev1beta1.LastOperationStateSucceeded, now.Add(-15*time.Minute))
+			misconfigured := newExtension("misconfigured", gardencorev1beta1.LastOperationStateFailed, now.Add(-15*time.Minute))
+			misconfigured.Status.LastError = &gardencorev1beta1.LastError{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem}}
+
+			for _, extension := range []*extensionsv1alpha1.Extension{failed, recentlyFailed, succeeded, misconfigured} {
+				Expect(seedClient.Create(ctx, extension)).To(Succeed())
+			}
+
+			Expect(remediate()).To(Succeed())
+
+			for _, extension := range []*extensionsv1alpha1.Extension{failed, recentlyFailed, succeeded, misconfigured} {
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+			}
+			Expect(failed.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+			Expect(recentlyFailed.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(succeeded.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(misconfigured.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(recorder.Events).To(Receive(Equal(`Normal Remediated Triggered reconciliation of Extension "failed" because its last operation failed more than 10m0s ago`)))
+			Expect(recorder.Events).NotTo(Receive())
+		})
+
+		It("should not trigger the reconciliation of extensions which failed with non-retryable error codes defined by extensions", func() {
+			errorCodeDefinitions = []gardencorev1beta1.ErrorCodeDefinition{{Code: "ERR_FOO", NonRetryable: true}, {Code: "ERR_BAR"}}
+
+			nonRetryable := newExtension("non-retryable", gardencorev1beta1.LastOperationStateFailed, now.Add(-15*time.Minute))
+			nonRetryable.Status.LastError = &gardencorev1beta1.LastError{Codes: []gardencorev1beta1.ErrorCode{"ERR_FOO"}}
+			retryable := newExtension("retryable", gardencorev1beta1.LastOperationStateFailed, now.Add(-15*time.Minute))
+			retryable.Status.LastError = &gardencorev1beta1.LastError{Codes: []gardencorev1beta1.ErrorCode{"ERR_BAR"}}
+
+			for _, extension := range []*extensionsv1alpha1.Extension{nonRetryable, retryable} {
+				Expect(seedClient.Create(ctx, extension)).To(Succeed())
+			}
+
+			Expect(remediate()).To(Succeed())
+
+			for _, extension := range []*extensionsv1alpha1.Extension{nonRetryable, retryable} {
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+			}
+			Expect(nonRetryable.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(retryable.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		})
+	})
+
+	Describe("KickStuckWebhooks", func() {
+		var (
+			fail = admissionregistrationv1.Fail
+
+			service       *corev1.Service
+			webhookConfig *admissionregistrationv1.ValidatingWebhookConfiguration
+		)
+
+		BeforeEach(func() {
+			rules = []gardenletconfigv1alpha1.ShootRemediationRule{{
+				Action:    gardenletconfigv1alpha1.ShootRemediationActionKickStuckWebhooks,
+				Threshold: &metav1.Duration{Duration: 10 * time.Minute},
+			}}
+
+			service = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "webhook", Namespace: "default"},
+				Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "webhook"}},
+			}
+			webhookConfig = &admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "webhook"},
+				Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+					Name:          "webhook.example.com",
+					FailurePolicy: &fail,
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{
+						Service: &admissionregistrationv1.ServiceReference{Name: service.Name, Namespace: service.Namespace},
+					},
+				}},
+			}
+
+			Expect(shootClient.Create(ctx, service)).To(Succeed())
+			Expect(shootClient.Create(ctx, webhookConfig)).To(Succeed())
+		})
+
+		It("should restart the pods of webhooks without ready endpoints", func() {
+			pod := newPod("webhook", service.Namespace, false, now.Add(-15*time.Minute), "")
+			Expect(shootClient.Create(ctx, pod)).To(Succeed())
+
+			Expect(remediate()).To(Succeed())
+
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(BeNotFoundError())
+			Expect(recorder.Events).To(Receive(Equal(`Normal Remediated Restarted pod "default/webhook" serving webhook service "default/webhook" because the webhook had no ready endpoints for more than 10m0s`)))
+		})
+
+		It("should not restart the pods of webhooks with ready endpoints", func() {
+			pod := newPod("webhook", service.Namespace, false, now.Add(-15*time.Minute), "")
+			readyPod := newPod("webhook-ready", service.Namespace, true, now.Add(-15*time.Minute), "")
+			Expect(shootClient.Create(ctx, pod)).To(Succeed())
+			Expect(shootClient.Create(ctx, readyPod)).To(Succeed())
+
+			Expect(remediate()).To(Succeed())
+
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+			Expect(recorder.Events).NotTo(Receive())
+		})
+
+		It("should not restart the pods of webhooks which fail open", func() {
+			webhookConfig.Webhooks[0].FailurePolicy = ptr.To(admissionregistrationv1.Ignore)
+			Expect(shootClient.Update(ctx, webhookConfig)).To(Succeed())
+			pod := newPod("webhook", service.Namespace, false, now.Add(-15*time.Minute), "")
+			Expect(shootClient.Create(ctx, pod)).To(Succeed())
+
+			Expect(remediate()).To(Succeed())
+
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+		})
+
+		It("should not restart the pods before the threshold is exceeded", func() {
+			pod := newPod("webhook", service.Namespace, false, now.Add(-5*time.Minute), "")
+			Expect(shootClient.Create(ctx, pod)).To(Succeed())
+
+			Expect(remediate()).To(Succeed())
+
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+		})
+	})
+
+	It("should not perform actions for which no rule is configured", func() {
+		crashLooping := newPod("crash-looping", namespace, false, now.Add(-15*time.Minute), "CrashLoopBackOff")
+		Expect(seedClient.Create(ctx, crashLooping)).To(Succeed())
+
+		Expect(remediate()).To(Succeed())
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(crashLooping), &corev1.Pod{})).To(Succeed())
+	})
+})
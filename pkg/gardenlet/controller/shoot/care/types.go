@@ -11,6 +11,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -98,6 +99,34 @@ var defaultNewConstraintCheck = func(
 	)
 }
 
+// InventoryCollector is an interface used to compute the inventory of the machines and nodes of a shoot.
+type InventoryCollector interface {
+	Compute(ctx context.Context) (*gardencorev1beta1.ShootInventory, error)
+}
+
+// NewInventoryCollectorFunc is a function used to create a new instance for computing the inventory.
+type NewInventoryCollectorFunc func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	shootClientInit ShootClientInit,
+) InventoryCollector
+
+// defaultNewInventoryCollector is the default function to create a new instance for computing the inventory.
+var defaultNewInventoryCollector NewInventoryCollectorFunc = func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	shootClientInit ShootClientInit,
+) InventoryCollector {
+	return NewInventory(
+		log,
+		shoot,
+		seedClient,
+		shootClientInit,
+	)
+}
+
 // GarbageCollector is an interface used to perform garbage collection.
 type GarbageCollector interface {
 	Collect(ctx context.Context)
@@ -124,6 +153,39 @@ var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1
 	return NewWebhookRemediation(log, shoot, init)
 }
 
+// Remediator is an interface used to perform the automatic remediation of well-known unhealthy states.
+type Remediator interface {
+	Remediate(ctx context.Context) error
+}
+
+// NewRemediatorFunc is a function used to create a new instance to perform the automatic remediation of well-known
+// unhealthy states.
+type NewRemediatorFunc func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	shootClientInit ShootClientInit,
+	clock clock.Clock,
+	recorder events.EventRecorder,
+	rules []gardenletconfigv1alpha1.ShootRemediationRule,
+	errorCodeDefinitions []gardencorev1beta1.ErrorCodeDefinition,
+) Remediator
+
+// defaultNewRemediator is the default function to create a new instance to perform the automatic remediation of
+// well-known unhealthy states.
+var defaultNewRemediator NewRemediatorFunc = func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	shootClientInit ShootClientInit,
+	clock clock.Clock,
+	recorder events.EventRecorder,
+	rules []gardenletconfigv1alpha1.ShootRemediationRule,
+	errorCodeDefinitions []gardencorev1beta1.ErrorCodeDefinition,
+) Remediator {
+	return NewRemediation(log, shoot, seedClient, shootClientInit, clock, recorder, rules, errorCodeDefinitions)
+}
+
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
 type NewOperationFunc func(
 	ctx context.Context,
@@ -0,0 +1,353 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplanedrift
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap in the control plane namespace which contains the drift report.
+	ConfigMapName = "control-plane-drift"
+	// ConfigMapDataKeyReport is the key in the data of the ConfigMap which contains the drift report.
+	ConfigMapDataKeyReport = "report.yaml"
+	// LabelValueRoleControlPlaneDrift is the value of the 'gardener.cloud/role' label of the drift report ConfigMap.
+	LabelValueRoleControlPlaneDrift = "control-plane-drift"
+
+	// EventControlPlaneDriftComputed is the reason of the event which is emitted on the Shoot when the drift report
+	// was published.
+	EventControlPlaneDriftComputed = "ControlPlaneDriftComputed"
+	// EventActionComputeDrift describes the action of the drift events.
+	EventActionComputeDrift = "ComputeDrift"
+
+	// FieldOwner is the field manager used for the server-side apply dry-run requests.
+	FieldOwner = "gardenlet-control-plane-drift"
+
+	redactedValue = "<redacted>"
+)
+
+// ObjectState describes the result of the drift computation for a single object.
+type ObjectState string
+
+const (
+	// ObjectStateDrifted means that the live object deviates from the desired state.
+	ObjectStateDrifted ObjectState = "Drifted"
+	// ObjectStateMissing means that the desired object does not exist in the seed cluster.
+	ObjectStateMissing ObjectState = "Missing"
+	// ObjectStateError means that the drift of the object could not be computed.
+	ObjectStateError ObjectState = "Error"
+)
+
+// DriftReport is the report which is published in the ConfigMap.
+type DriftReport struct {
+	// ComputedAt is the time when the report was computed.
+	ComputedAt metav1.Time `json:"computedAt"`
+	// ObjectsChecked is the number of desired objects which were compared with their live state.
+	ObjectsChecked int `json:"objectsChecked"`
+	// Objects contains all objects which are not in sync with their desired state.
+	Objects []ObjectReport `json:"objects,omitempty"`
+}
+
+// ObjectReport describes the drift of a single object.
+type ObjectReport struct {
+	// ManagedResource is the name of the ManagedResource which contains the desired state of the object.
+	ManagedResource string `json:"managedResource"`
+	// APIVersion is the API version of the object.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the object.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the object.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the object.
+	Name string `json:"name"`
+	// State is the state of the object.
+	State ObjectState `json:"state"`
+	// Error contains the reason why the drift could not be computed.
+	Error string `json:"error,omitempty"`
+	// Differences contains the fields whose live values deviate from the desired values.
+	Differences []Difference `json:"differences,omitempty"`
+}
+
+// Difference describes a field whose live value deviates from the desired value.
+type Difference struct {
+	// Path is the path of the field.
+	Path string `json:"path"`
+	// Desired is the desired value of the field.
+	Desired any `json:"desired,omitempty"`
+	// Live is the live value of the field.
+	Live any `json:"live,omitempty"`
+}
+
+// ignoredPaths contains the fields which are set by the API server or by other controllers and never constitute drift.
+var ignoredPaths = []string{
+	"metadata.creationTimestamp",
+	"metadata.generation",
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"metadata.uid",
+	"status",
+}
+
+// Reconciler computes the drift between the desired state of the control plane objects of Shoots (as stored in the
+// ManagedResources of class 'seed') and their live state in the seed cluster when the Shoot is annotated with the
+// 'compute-control-plane-drift' operation. The drift is computed via server-side apply dry-run requests and published
+// as a report in a ConfigMap in the control plane namespace.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Clock        clock.Clock
+	Recorder     events.EventRecorder
+	SeedName     string
+}
+
+// Reconcile computes the control plane drift of Shoots.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if ptr.Deref(shoot.Status.SeedName, "") != r.SeedName || !hasOperation(shoot) {
+		return reconcile.Result{}, nil
+	}
+
+	namespace := v1beta1helper.ControlPlaneNamespaceForShoot(shoot)
+
+	log.Info("Computing control plane drift", "namespace", namespace)
+	report, err := r.computeReport(ctx, namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	data, err := sigsyaml.Marshal(report)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed marshalling drift report: %w", err)
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: namespace}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.SeedClient, configMap, func() error {
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, v1beta1constants.GardenRole, LabelValueRoleControlPlaneDrift)
+		configMap.Data = map[string]string{ConfigMapDataKeyReport: string(data)}
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed publishing drift report: %w", err)
+	}
+
+	var drifted, missing, failed int
+	for _, object := range report.Objects {
+		switch object.State {
+		case ObjectStateDrifted:
+			drifted++
+		case ObjectStateMissing:
+			missing++
+		case ObjectStateError:
+			failed++
+		}
+	}
+
+	eventType := corev1.EventTypeNormal
+	if len(report.Objects) > 0 {
+		eventType = corev1.EventTypeWarning
+	}
+	r.Recorder.Eventf(shoot, nil, eventType, EventControlPlaneDriftComputed, EventActionComputeDrift, "Computed control plane drift of %d objects (drifted: %d, missing: %d, failed: %d), report published in ConfigMap %s/%s", report.ObjectsChecked, drifted, missing, failed, namespace, ConfigMapName)
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	if operations := v1beta1helper.RemoveOperation(v1beta1helper.GetShootGardenerOperations(shoot.Annotations), v1beta1constants.ShootOperationComputeControlPlaneDrift); len(operations) == 0 {
+		delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+	} else {
+		shoot.Annotations[v1beta1constants.GardenerOperation] = strings.Join(operations, v1beta1constants.GardenerOperationsSeparator)
+	}
+	if err := r.GardenClient.Patch(ctx, shoot, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed removing operation annotation: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) computeReport(ctx context.Context, namespace string) (*DriftReport, error) {
+	report := &DriftReport{ComputedAt: metav1.Time{Time: r.Clock.Now().UTC().Truncate(time.Second)}}
+
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.SeedClient.List(ctx, managedResourceList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing managed resources: %w", err)
+	}
+
+	// The desired objects are decoded into unstructured objects since the scheme of the seed client does not
+	// necessarily know all types contained in the managed resources.
+	decoder := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+
+	for _, managedResource := range managedResourceList.Items {
+		if ptr.Deref(managedResource.Spec.Class, "") != v1beta1constants.SeedResourceManagerClass {
+			continue
+		}
+
+		for _, secretRef := range managedResource.Spec.SecretRefs {
+			secret := &corev1.Secret{}
+			if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: secretRef.Name, Namespace: namespace}, secret); err != nil {
+				return nil, fmt.Errorf("failed reading secret %s of managed resource %s: %w", secretRef.Name, client.ObjectKeyFromObject(&managedResource), err)
+			}
+
+			objects, err := managedresources.ExtractObjectsFromSecret(decoder, secret)
+			if err != nil {
+				return nil, fmt.Errorf("failed extracting objects of managed resource %s: %w", client.ObjectKeyFromObject(&managedResource), err)
+			}
+
+			for _, obj := range objects {
+				desired, ok := obj.(*unstructured.Unstructured)
+				if !ok || desired.GetAnnotations()[resourcesv1alpha1.Ignore] == "true" || desired.GetAnnotations()[resourcesv1alpha1.Mode] == resourcesv1alpha1.ModeIgnore {
+					continue
+				}
+
+				report.ObjectsChecked++
+				if objectReport := r.computeObjectReport(ctx, desired); objectReport != nil {
+					objectReport.ManagedResource = managedResource.Name
+					report.Objects = append(report.Objects, *objectReport)
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(report.Objects, func(a, b ObjectReport) int {
+		return cmp.Or(
+			cmp.Compare(a.ManagedResource, b.ManagedResource),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return report, nil
+}
+
+// computeObjectReport returns the drift of the given desired object or nil if the live object is in sync.
+func (r *Reconciler) computeObjectReport(ctx context.Context, desired *unstructured.Unstructured) *ObjectReport {
+	objectReport := &ObjectReport{
+		APIVersion: desired.GetAPIVersion(),
+		Kind:       desired.GetKind(),
+		Namespace:  desired.GetNamespace(),
+		Name:       desired.GetName(),
+	}
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(desired.GroupVersionKind())
+	if err := r.SeedClient.Get(ctx, client.ObjectKeyFromObject(desired), live); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			objectReport.State = ObjectStateMissing
+			return objectReport
+		}
+
+		objectReport.State, objectReport.Error = ObjectStateError, err.Error()
+		return objectReport
+	}
+
+	// The dry-run request returns the object as it would look like if the desired state was applied. Fields which are
+	// not part of the desired state are kept as they are, hence only deviations from the desired state are detected.
+	applied := desired.DeepCopy()
+	applied.SetResourceVersion("")
+	if err := r.SeedClient.Patch(ctx, applied, client.Apply, client.DryRunAll, client.FieldOwner(FieldOwner), client.ForceOwnership); err != nil {
+		objectReport.State, objectReport.Error = ObjectStateError, err.Error()
+		return objectReport
+	}
+
+	differences := computeDifferences("", applied.Object, live.Object)
+	if len(differences) == 0 {
+		return nil
+	}
+
+	if desired.GroupVersionKind().GroupKind() == corev1.SchemeGroupVersion.WithKind("Secret").GroupKind() {
+		for i, difference := range differences {
+			if strings.HasPrefix(difference.Path, "data") || strings.HasPrefix(difference.Path, "stringData") {
+				differences[i].Desired, differences[i].Live = redact(difference.Desired), redact(difference.Live)
+			}
+		}
+	}
+
+	objectReport.State, objectReport.Differences = ObjectStateDrifted, differences
+	return objectReport
+}
+
+// computeDifferences returns the fields of the applied object whose values deviate from the live object. Fields which
+// only exist in the live object are not considered since the server-side apply request does not remove them.
+func computeDifferences(path string, applied, live any) []Difference {
+	if slices.Contains(ignoredPaths, path) || applied == nil {
+		return nil
+	}
+
+	switch appliedValue := applied.(type) {
+	case map[string]any:
+		liveValue, ok := live.(map[string]any)
+		if !ok {
+			return []Difference{{Path: path, Desired: applied, Live: live}}
+		}
+
+		var differences []Difference
+		for _, key := range slices.Sorted(maps.Keys(appliedValue)) {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			differences = append(differences, computeDifferences(childPath, appliedValue[key], liveValue[key])...)
+		}
+		return differences
+
+	case []any:
+		liveValue, ok := live.([]any)
+		if !ok || len(liveValue) != len(appliedValue) {
+			return []Difference{{Path: path, Desired: applied, Live: live}}
+		}
+
+		var differences []Difference
+		for i := range appliedValue {
+			differences = append(differences, computeDifferences(fmt.Sprintf("%s[%d]", path, i), appliedValue[i], liveValue[i])...)
+		}
+		return differences
+	}
+
+	if !apiequality.Semantic.DeepEqual(applied, live) {
+		return []Difference{{Path: path, Desired: applied, Live: live}}
+	}
+	return nil
+}
+
+func redact(value any) any {
+	if value == nil {
+		return nil
+	}
+	return redactedValue
+}
@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplanedrift_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/controlplanedrift"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+		seedClient   client.Client
+		recorder     *events.FakeRecorder
+		reconciler   *Reconciler
+
+		shoot *gardencorev1beta1.Shoot
+
+		seedName  = "seed"
+		namespace = "shoot--foo--bar"
+		now       = time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		recorder = events.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Clock:        testclock.NewFakeClock(now),
+			Recorder:     recorder,
+			SeedName:     seedName,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bar",
+				Namespace:   "garden-foo",
+				Annotations: map[string]string{"gardener.cloud/operation": "compute-control-plane-drift"},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName:    &seedName,
+				TechnicalID: namespace,
+			},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	createManagedResource := func(name string, class *string, objects ...client.Object) {
+		data := map[string][]byte{}
+		for _, obj := range objects {
+			raw, err := yaml.Marshal(obj)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			data[obj.GetName()+".yaml"] = raw
+		}
+
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "managedresource-" + name, Namespace: namespace}, Data: data}
+		ExpectWithOffset(1, seedClient.Create(ctx, secret)).To(Succeed())
+		ExpectWithOffset(1, seedClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				Class:      class,
+				SecretRefs: []corev1.LocalObjectReference{{Name: secret.Name}},
+			},
+		})).To(Succeed())
+	}
+
+	reconcileShoot := func() *DriftReport {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+		configMap := &corev1.ConfigMap{}
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Name: "control-plane-drift", Namespace: namespace}, configMap)).To(Succeed())
+		ExpectWithOffset(1, configMap.Labels).To(HaveKeyWithValue("gardener.cloud/role", "control-plane-drift"))
+
+		report := &DriftReport{}
+		ExpectWithOffset(1, yaml.Unmarshal([]byte(configMap.Data["report.yaml"]), report)).To(Succeed())
+		return report
+	}
+
+	configMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: namespace, Labels: map[string]string{"app": "foo"}},
+			Data:       data,
+		}
+	}
+
+	It("should report drifted and missing objects", func() {
+		createManagedResource("foo", ptr.To("seed"),
+			configMap(map[string]string{"foo": "bar", "baz": "qux"}),
+			&corev1.Service{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+				ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: namespace},
+			},
+		)
+
+		live := configMap(map[string]string{"foo": "changed", "baz": "qux", "manual": "edit"})
+		live.Labels["other"] = "label"
+		Expect(seedClient.Create(ctx, live)).To(Succeed())
+
+		report := reconcileShoot()
+		Expect(report.ComputedAt.Time).To(BeTemporally("==", now))
+		Expect(report.ObjectsChecked).To(Equal(2))
+		Expect(report.Objects).To(Equal([]ObjectReport{
+			{
+				ManagedResource: "foo",
+				APIVersion:      "v1",
+				Kind:            "ConfigMap",
+				Namespace:       namespace,
+				Name:            "config",
+				State:           ObjectStateDrifted,
+				Differences:     []Difference{{Path: "data.foo", Desired: "bar", Live: "changed"}},
+			},
+			{
+				ManagedResource: "foo",
+				APIVersion:      "v1",
+				Kind:            "Service",
+				Namespace:       namespace,
+				Name:            "service",
+				State:           ObjectStateMissing,
+			},
+		}))
+
+		Expect(recorder.Events).To(Receive(Equal("Warning ControlPlaneDriftComputed Computed control plane drift of 2 objects (drifted: 1, missing: 1, failed: 0), report published in ConfigMap shoot--foo--bar/control-plane-drift")))
+	})
+
+	It("should not report objects which are in sync", func() {
+		createManagedResource("foo", ptr.To("seed"), configMap(map[string]string{"foo": "bar"}))
+		Expect(seedClient.Create(ctx, configMap(map[string]string{"foo": "bar", "manual": "edit"}))).To(Succeed())
+
+		report := reconcileShoot()
+		Expect(report.ObjectsChecked).To(Equal(1))
+		Expect(report.Objects).To(BeEmpty())
+
+		Expect(recorder.Events).To(Receive(HavePrefix("Normal ControlPlaneDriftComputed")))
+	})
+
+	It("should redact the data of secrets", func() {
+		secret := &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+			Data:       map[string][]byte{"password": []byte("desired")},
+		}
+		createManagedResource("foo", ptr.To("seed"), secret)
+
+		live := secret.DeepCopy()
+		live.Data["password"] = []byte("live")
+		Expect(seedClient.Create(ctx, live)).To(Succeed())
+
+		report := reconcileShoot()
+		Expect(report.Objects).To(ConsistOf(HaveField("Differences", ConsistOf(Difference{Path: "data.password", Desired: "<redacted>", Live: "<redacted>"}))))
+	})
+
+	It("should ignore managed resources for the shoot cluster and ignored objects", func() {
+		createManagedResource("shoot", nil, configMap(map[string]string{"foo": "bar"}))
+
+		ignored := configMap(map[string]string{"foo": "bar"})
+		ignored.Name = "ignored"
+		ignored.Annotations = map[string]string{"resources.gardener.cloud/ignore": "true"}
+		createManagedResource("seed", ptr.To("seed"), ignored)
+
+		report := reconcileShoot()
+		Expect(report.ObjectsChecked).To(BeZero())
+		Expect(report.Objects).To(BeEmpty())
+	})
+
+	It("should only remove its own operation from the annotation", func() {
+		shoot.Annotations["gardener.cloud/operation"] = "compute-control-plane-drift;rotate-ssh-keypair"
+		Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+		reconcileShoot()
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "rotate-ssh-keypair"))
+	})
+
+	It("should remove the annotation", func() {
+		reconcileShoot()
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+	})
+
+	It("should do nothing if the shoot is not annotated", func() {
+		delete(shoot.Annotations, "gardener.cloud/operation")
+		Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "control-plane-drift", Namespace: namespace}, &corev1.ConfigMap{})).NotTo(Succeed())
+	})
+
+	Describe("#ShootPredicate", func() {
+		It("should only react on annotated shoots on the seed", func() {
+			p := reconciler.ShootPredicate()
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+
+			otherShoot := shoot.DeepCopy()
+			otherShoot.Status.SeedName = ptr.To("other")
+			Expect(p.Create(event.CreateEvent{Object: otherShoot})).To(BeFalse())
+
+			notAnnotatedShoot := shoot.DeepCopy()
+			delete(notAnnotatedShoot.Annotations, "gardener.cloud/operation")
+			Expect(p.Update(event.UpdateEvent{ObjectOld: notAnnotatedShoot, ObjectNew: notAnnotatedShoot})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: notAnnotatedShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+	})
+})
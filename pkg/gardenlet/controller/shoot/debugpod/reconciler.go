@@ -0,0 +1,273 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debugpod
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/imagevector"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// LabelValueRoleDebug is the value of the 'gardener.cloud/role' label of debug pods.
+	LabelValueRoleDebug = "debug"
+	// AnnotationRequestedBy is the annotation of debug pods which contains the name of the user who requested them.
+	AnnotationRequestedBy = "debug.gardener.cloud/requested-by"
+	// AnnotationReason is the annotation of debug pods which contains the reason why they were requested.
+	AnnotationReason = "debug.gardener.cloud/reason"
+	// AnnotationExpirationTimestamp is the annotation of debug pods which contains the time when they are deleted.
+	AnnotationExpirationTimestamp = "debug.gardener.cloud/expiration-timestamp"
+
+	// EventDebugPodCreated is the reason of the event which is emitted on the Shoot when a debug pod was created.
+	EventDebugPodCreated = "DebugPodCreated"
+	// EventDebugPodDeleted is the reason of the event which is emitted on the Shoot when a debug pod was deleted.
+	EventDebugPodDeleted = "DebugPodDeleted"
+	// EventActionDebug describes the action of the debug pod events.
+	EventActionDebug = "Debug"
+
+	containerName       = "debug"
+	volumeNameEtcdCA    = "etcd-ca"
+	volumeNameEtcdTLS   = "etcd-client-tls"
+	volumeMountPathCA   = "/var/run/secrets/etcd/ca"
+	volumeMountPathTLS  = "/var/run/secrets/etcd/client"
+	secretNameCABundle  = v1beta1constants.SecretNameCAETCD + "-bundle"
+	secretsManagerValue = "secrets-manager"
+)
+
+// Reconciler creates the debug pods which were requested via the 'shoots/debugpod' subresource in the control plane
+// namespaces of the Shoots on the seed and deletes them again after they have expired.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       gardenletconfigv1alpha1.ShootDebugPodControllerConfiguration
+	Clock        clock.Clock
+	Recorder     events.EventRecorder
+	SeedName     string
+}
+
+// Reconcile reconciles the debug pods of Shoots.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if !r.isResponsible(shoot) {
+		log.V(1).Info("Skipping because Shoot does not belong to this seed")
+		return reconcile.Result{}, nil
+	}
+
+	var (
+		now       = r.Clock.Now()
+		namespace = v1beta1helper.ControlPlaneNamespaceForShoot(shoot)
+		active    = map[string]gardencorev1beta1.ShootDebugPod{}
+		requeue   time.Duration
+	)
+
+	for _, debugPod := range shoot.Status.DebugPods {
+		remaining := debugPod.ExpirationTimestamp.Sub(now)
+		if remaining <= 0 {
+			continue
+		}
+
+		active[debugPod.Name] = debugPod
+		if requeue == 0 || remaining < requeue {
+			requeue = remaining
+		}
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.SeedClient.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabels{v1beta1constants.GardenRole: LabelValueRoleDebug}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing debug pods: %w", err)
+	}
+
+	existing := make(map[string]struct{}, len(podList.Items))
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		existing[pod.Name] = struct{}{}
+
+		if _, ok := active[pod.Name]; ok {
+			continue
+		}
+
+		log.Info("Deleting expired debug pod", "pod", client.ObjectKeyFromObject(pod))
+		if err := r.SeedClient.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, fmt.Errorf("failed deleting debug pod %s: %w", client.ObjectKeyFromObject(pod), err)
+		}
+		r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, EventDebugPodDeleted, EventActionDebug, "Deleted debug pod %s/%s requested by %s", namespace, pod.Name, pod.Annotations[AnnotationRequestedBy])
+	}
+
+	if shoot.DeletionTimestamp == nil {
+		for _, debugPod := range active {
+			if _, ok := existing[debugPod.Name]; ok {
+				continue
+			}
+
+			pod, err := r.newPod(ctx, namespace, debugPod, now)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+
+			log.Info("Creating debug pod", "pod", client.ObjectKeyFromObject(pod), "requestedBy", debugPod.RequestedBy)
+			if err := r.SeedClient.Create(ctx, pod); client.IgnoreAlreadyExists(err) != nil {
+				return reconcile.Result{}, fmt.Errorf("failed creating debug pod %s: %w", client.ObjectKeyFromObject(pod), err)
+			}
+			r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, EventDebugPodCreated, EventActionDebug, "Created debug pod %s/%s requested by %s (reason: %s), expires at %s", namespace, pod.Name, debugPod.RequestedBy, debugPod.Reason, debugPod.ExpirationTimestamp.UTC().Format(time.RFC3339))
+		}
+	}
+
+	if len(active) != len(shoot.Status.DebugPods) {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.DebugPods = slices.DeleteFunc(shoot.Status.DebugPods, func(debugPod gardencorev1beta1.ShootDebugPod) bool {
+			_, ok := active[debugPod.Name]
+			return !ok
+		})
+		if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing expired debug pods from shoot status: %w", err)
+		}
+	}
+
+	if requeue > 0 {
+		return reconcile.Result{RequeueAfter: requeue}, nil
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) newPod(ctx context.Context, namespace string, debugPod gardencorev1beta1.ShootDebugPod, now time.Time) (*corev1.Pod, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameOpsToolbelt)
+	if err != nil {
+		return nil, fmt.Errorf("failed finding debug pod image: %w", err)
+	}
+
+	etcdMainServiceName := etcdconstants.ServiceName(v1beta1constants.ETCDRoleMain)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      debugPod.Name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				v1beta1constants.GardenRole:              LabelValueRoleDebug,
+				v1beta1constants.LabelNetworkPolicyToDNS: v1beta1constants.LabelNetworkPolicyAllowed,
+				gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port): v1beta1constants.LabelNetworkPolicyAllowed,
+				gardenerutils.NetworkPolicyLabel(etcdMainServiceName, etcdconstants.PortEtcdClient):                         v1beta1constants.LabelNetworkPolicyAllowed,
+			},
+			Annotations: map[string]string{
+				AnnotationRequestedBy:         debugPod.RequestedBy,
+				AnnotationReason:              debugPod.Reason,
+				AnnotationExpirationTimestamp: debugPod.ExpirationTimestamp.UTC().Format(time.RFC3339),
+			},
+		},
+		Spec: corev1.PodSpec{
+			// The kubelet terminates the pod after its expiration even if gardenlet is not able to delete it in time.
+			ActiveDeadlineSeconds:        ptr.To(int64(debugPod.ExpirationTimestamp.Sub(now).Seconds()) + 1),
+			AutomountServiceAccountToken: ptr.To(false),
+			EnableServiceLinks:           ptr.To(false),
+			RestartPolicy:                corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:    containerName,
+				Image:   image.String(),
+				Command: []string{"sleep", strconv.FormatInt(int64(debugPod.ExpirationTimestamp.Sub(now).Seconds())+1, 10)},
+				Env: []corev1.EnvVar{{
+					Name:  "ETCDCTL_ENDPOINTS",
+					Value: fmt.Sprintf("https://%s:%d", etcdMainServiceName, etcdconstants.PortEtcdClient),
+				}},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("10m"),
+						corev1.ResourceMemory: resource.MustParse("32Mi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("512Mi"),
+					},
+				},
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: ptr.To(false),
+				},
+			}},
+		},
+	}
+
+	// Mount the etcd client certificate if it is available so that operators can use etcdctl right away.
+	caSecret, err := r.newestManagedSecret(ctx, namespace, secretNameCABundle)
+	if err != nil {
+		return nil, err
+	}
+	clientSecret, err := r.newestManagedSecret(ctx, namespace, etcd.SecretNameClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if caSecret != nil && clientSecret != nil {
+		container := &pod.Spec.Containers[0]
+		container.Env = append(container.Env,
+			corev1.EnvVar{Name: "ETCDCTL_CACERT", Value: volumeMountPathCA + "/" + secretsutils.DataKeyCertificateBundle},
+			corev1.EnvVar{Name: "ETCDCTL_CERT", Value: volumeMountPathTLS + "/" + secretsutils.DataKeyCertificate},
+			corev1.EnvVar{Name: "ETCDCTL_KEY", Value: volumeMountPathTLS + "/" + secretsutils.DataKeyPrivateKey},
+		)
+		container.VolumeMounts = []corev1.VolumeMount{
+			{Name: volumeNameEtcdCA, MountPath: volumeMountPathCA, ReadOnly: true},
+			{Name: volumeNameEtcdTLS, MountPath: volumeMountPathTLS, ReadOnly: true},
+		}
+		pod.Spec.Volumes = []corev1.Volume{
+			{Name: volumeNameEtcdCA, VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: caSecret.Name}}},
+			{Name: volumeNameEtcdTLS, VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: clientSecret.Name}}},
+		}
+	}
+
+	return pod, nil
+}
+
+// newestManagedSecret returns the newest secret with the given name which is managed by the secrets manager of the
+// shoot control plane, or nil if no such secret exists.
+func (r *Reconciler) newestManagedSecret(ctx context.Context, namespace, name string) (*corev1.Secret, error) {
+	secretList := &corev1.SecretList{}
+	if err := r.SeedClient.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{
+		secretsmanager.LabelKeyName:      name,
+		secretsmanager.LabelKeyManagedBy: secretsManagerValue,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing secrets with name %s: %w", name, err)
+	}
+
+	var newest *corev1.Secret
+	for i := range secretList.Items {
+		if secret := &secretList.Items[i]; newest == nil || secret.CreationTimestamp.After(newest.CreationTimestamp.Time) {
+			newest = secret
+		}
+	}
+
+	return newest, nil
+}
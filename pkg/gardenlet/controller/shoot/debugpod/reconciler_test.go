@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debugpod_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/debugpod"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		recorder     *events.FakeRecorder
+		reconciler   *Reconciler
+
+		shoot *gardencorev1beta1.Shoot
+
+		seedName  = "seed"
+		namespace = "shoot--foo--bar"
+		now       = time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(now)
+		recorder = events.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Clock:        fakeClock,
+			Recorder:     recorder,
+			SeedName:     seedName,
+			Config:       gardenletconfigv1alpha1.ShootDebugPodControllerConfiguration{ConcurrentSyncs: ptr.To(1)},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName:    &seedName,
+				TechnicalID: namespace,
+				DebugPods: []gardencorev1beta1.ShootDebugPod{{
+					Name:                "debug-abcde",
+					RequestedBy:         "operator",
+					Reason:              "investigate etcd latency",
+					CreationTimestamp:   metav1.Time{Time: now.Add(-10 * time.Minute)},
+					ExpirationTimestamp: metav1.Time{Time: now.Add(time.Hour)},
+				}},
+			},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	reconcileShoot := func() reconcile.Result {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return result
+	}
+
+	It("should create the requested debug pod and requeue at its expiration", func() {
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		pod := &corev1.Pod{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "debug-abcde", Namespace: namespace}, pod)).To(Succeed())
+		Expect(pod.Labels).To(HaveKeyWithValue("gardener.cloud/role", "debug"))
+		Expect(pod.Labels).To(HaveKeyWithValue("networking.resources.gardener.cloud/to-kube-apiserver-tcp-443", "allowed"))
+		Expect(pod.Labels).To(HaveKeyWithValue("networking.resources.gardener.cloud/to-etcd-main-client-tcp-2379", "allowed"))
+		Expect(pod.Annotations).To(HaveKeyWithValue("debug.gardener.cloud/requested-by", "operator"))
+		Expect(pod.Annotations).To(HaveKeyWithValue("debug.gardener.cloud/reason", "investigate etcd latency"))
+		Expect(pod.Spec.ActiveDeadlineSeconds).To(PointTo(Equal(int64(3601))))
+		Expect(pod.Spec.AutomountServiceAccountToken).To(PointTo(BeFalse()))
+		Expect(pod.Spec.Volumes).To(BeEmpty())
+
+		Expect(recorder.Events).To(Receive(ContainSubstring("Created debug pod shoot--foo--bar/debug-abcde requested by operator")))
+	})
+
+	It("should mount the etcd client certificates if they exist", func() {
+		for _, name := range []string{"ca-etcd-bundle", "etcd-client"} {
+			Expect(seedClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-12345",
+				Namespace: namespace,
+				Labels:    map[string]string{"name": name, "managed-by": "secrets-manager"},
+			}})).To(Succeed())
+		}
+
+		reconcileShoot()
+
+		pod := &corev1.Pod{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "debug-abcde", Namespace: namespace}, pod)).To(Succeed())
+		Expect(pod.Spec.Volumes).To(ConsistOf(
+			HaveField("VolumeSource.Secret.SecretName", "ca-etcd-bundle-12345"),
+			HaveField("VolumeSource.Secret.SecretName", "etcd-client-12345"),
+		))
+		Expect(pod.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "ETCDCTL_CERT", Value: "/var/run/secrets/etcd/client/tls.crt"}))
+	})
+
+	It("should delete expired debug pods and remove them from the shoot status", func() {
+		reconcileShoot()
+
+		fakeClock.Step(time.Hour)
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "debug-abcde", Namespace: namespace}, &corev1.Pod{})).To(BeNotFoundError())
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Status.DebugPods).To(BeEmpty())
+
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("Deleted debug pod shoot--foo--bar/debug-abcde requested by operator")))
+	})
+
+	It("should do nothing if the shoot belongs to another seed", func() {
+		shoot.Status.SeedName = ptr.To("other")
+		Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+
+		podList := &corev1.PodList{}
+		Expect(seedClient.List(ctx, podList)).To(Succeed())
+		Expect(podList.Items).To(BeEmpty())
+	})
+
+	Describe("#ShootPredicate", func() {
+		It("should only react on changes of the debug pods of shoots on the seed", func() {
+			p := reconciler.ShootPredicate()
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+
+			otherShoot := shoot.DeepCopy()
+			otherShoot.Status.SeedName = ptr.To("other")
+			Expect(p.Create(event.CreateEvent{Object: otherShoot})).To(BeFalse())
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: shoot.DeepCopy()})).To(BeFalse())
+
+			newShoot := shoot.DeepCopy()
+			newShoot.Status.DebugPods = append(newShoot.Status.DebugPods, gardencorev1beta1.ShootDebugPod{Name: "debug-fghij"})
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+	})
+})
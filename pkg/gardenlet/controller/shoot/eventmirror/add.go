@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmirror
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "shoot-event-mirror"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster, seedCluster cluster.Cluster) error {
+	if r.SeedClient == nil {
+		r.SeedClient = seedCluster.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Recorder == nil {
+		r.Recorder = gardenCluster.GetEventRecorder(ControllerName + "-controller")
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: *r.Config.ConcurrentSyncs,
+			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+		}).
+		WatchesRawSource(source.Kind[client.Object](
+			seedCluster.GetCache(),
+			&corev1.Event{},
+			&handler.EnqueueRequestForObject{},
+			r.EventPredicate(),
+		)).
+		Complete(r)
+}
+
+// EventPredicate returns a predicate.Predicate that returns true for events in the control plane namespaces of shoots
+// which match at least one of the configured rules. Updates are only considered if the event occurred again.
+func (r *Reconciler) EventPredicate() predicate.Predicate {
+	relevant := func(obj client.Object) bool {
+		event, ok := obj.(*corev1.Event)
+		if !ok {
+			return false
+		}
+
+		return strings.HasPrefix(event.Namespace, v1beta1constants.TechnicalIDPrefix) && r.matchingRule(event) != nil
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return relevant(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldEvent, ok := e.ObjectOld.(*corev1.Event)
+			if !ok {
+				return false
+			}
+
+			newEvent, ok := e.ObjectNew.(*corev1.Event)
+			if !ok {
+				return false
+			}
+
+			return occurrences(oldEvent) != occurrences(newEvent) && relevant(newEvent)
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+func occurrences(event *corev1.Event) int32 {
+	if event.Series != nil {
+		return event.Series.Count
+	}
+	return event.Count
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmirror_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/eventmirror"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#EventPredicate", func() {
+		var (
+			p  predicate.Predicate
+			ev *corev1.Event
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{
+				Config: gardenletconfigv1alpha1.ShootEventMirrorControllerConfiguration{
+					Rules: []gardenletconfigv1alpha1.ShootEventMirrorRule{{Name: "webhook", Message: ptr.To("failed calling webhook")}},
+				},
+			}).EventPredicate()
+
+			ev = &corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "shoot--foo--bar"},
+				Message:    `Internal error occurred: failed calling webhook "foo.example.com"`,
+				Count:      1,
+			}
+		})
+
+		It("should return true for matching events in shoot namespaces", func() {
+			Expect(p.Create(event.CreateEvent{Object: ev})).To(BeTrue())
+		})
+
+		It("should return false for events in other namespaces", func() {
+			ev.Namespace = "garden"
+
+			Expect(p.Create(event.CreateEvent{Object: ev})).To(BeFalse())
+		})
+
+		It("should return false for events not matching any rule", func() {
+			ev.Message = "Pulled image"
+
+			Expect(p.Create(event.CreateEvent{Object: ev})).To(BeFalse())
+		})
+
+		It("should return true for updates only if the event occurred again", func() {
+			oldEvent := ev.DeepCopy()
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldEvent, ObjectNew: ev})).To(BeFalse())
+
+			ev.Count++
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldEvent, ObjectNew: ev})).To(BeTrue())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: ev})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: ev})).To(BeFalse())
+		})
+	})
+})
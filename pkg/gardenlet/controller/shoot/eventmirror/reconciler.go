@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmirror
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	"github.com/gardener/gardener/pkg/extensions"
+)
+
+// ActionMirror is the action of events mirrored to Shoots.
+const ActionMirror = "Mirror"
+
+// maxNoteLength is the maximum length of the note of events accepted by the API server.
+const maxNoteLength = 1024
+
+// Reconciler mirrors important events from the control plane namespaces of shoots in the seed cluster to the respective
+// Shoot objects in the garden cluster. Events with the same reason and message for the same object are mirrored only
+// once per deduplication window, and the number of mirrored events per Shoot is rate limited.
+type Reconciler struct {
+	SeedClient client.Client
+	Config     gardenletconfigv1alpha1.ShootEventMirrorControllerConfiguration
+	Clock      clock.Clock
+	Recorder   events.EventRecorder
+
+	rulesOnce sync.Once
+	rules     []rule
+
+	lock     sync.Mutex
+	mirrored map[string]time.Time
+	limiters map[string]*rate.Limiter
+}
+
+type rule struct {
+	name    string
+	reason  *regexp.Regexp
+	message *regexp.Regexp
+}
+
+// Reconcile mirrors the event to the Shoot object in the garden cluster if it matches one of the configured rules.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	event := &corev1.Event{}
+	if err := r.SeedClient.Get(ctx, request.NamespacedName, event); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	matchingRule := r.matchingRule(event)
+	if matchingRule == nil {
+		return reconcile.Result{}, nil
+	}
+
+	shoot, err := extensions.GetShoot(ctx, r.SeedClient, event.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Cluster is gone, not mirroring event")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed reading shoot from cluster resource: %w", err)
+	}
+	if shoot == nil {
+		log.Info("Shoot is missing in cluster resource, not mirroring event")
+		return reconcile.Result{}, nil
+	}
+
+	var (
+		shootKey = client.ObjectKeyFromObject(shoot).String()
+		key      = fmt.Sprintf("%s/%s/%s/%s/%s", shootKey, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Reason, event.Message)
+	)
+
+	if !r.shouldMirror(shootKey, key) {
+		log.V(1).Info("Event was already mirrored recently or rate limit of shoot was exceeded, skipping", "shoot", shootKey)
+		return reconcile.Result{}, nil
+	}
+
+	eventType := event.Type
+	if eventType != corev1.EventTypeNormal {
+		eventType = corev1.EventTypeWarning
+	}
+
+	note := fmt.Sprintf("[%s] %s %q in the control plane: %s", matchingRule.name, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Message)
+	if len(note) > maxNoteLength {
+		note = note[:maxNoteLength-3] + "..."
+	}
+
+	log.Info("Mirroring event to shoot", "shoot", shootKey, "rule", matchingRule.name, "reason", event.Reason)
+	r.Recorder.Eventf(shoot, nil, eventType, event.Reason, ActionMirror, "%s", note)
+
+	return reconcile.Result{}, nil
+}
+
+// shouldMirror returns true if an event with the given key was not mirrored within the deduplication window and the
+// rate limit of the given shoot was not exceeded yet. It records the event as mirrored in this case.
+func (r *Reconciler) shouldMirror(shootKey, key string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.mirrored == nil {
+		r.mirrored = make(map[string]time.Time)
+	}
+	if r.limiters == nil {
+		r.limiters = make(map[string]*rate.Limiter)
+	}
+
+	now := r.Clock.Now()
+	r.pruneLocked(now)
+
+	if _, ok := r.mirrored[key]; ok {
+		return false
+	}
+
+	limiter, ok := r.limiters[shootKey]
+	if !ok {
+		maxEventsPerHour := int(*r.Config.MaxEventsPerHour)
+		limiter = rate.NewLimiter(rate.Every(time.Hour/time.Duration(maxEventsPerHour)), maxEventsPerHour)
+		r.limiters[shootKey] = limiter
+	}
+
+	if !limiter.AllowN(now, 1) {
+		return false
+	}
+
+	r.mirrored[key] = now
+	return true
+}
+
+// pruneLocked removes mirrored events whose deduplication window has passed and rate limiters which are fully
+// replenished, so that the memory footprint does not grow with the number of events and shoots over time.
+func (r *Reconciler) pruneLocked(now time.Time) {
+	for key, mirroredAt := range r.mirrored {
+		if now.Sub(mirroredAt) >= r.Config.DeduplicationWindow.Duration {
+			delete(r.mirrored, key)
+		}
+	}
+
+	for shootKey, limiter := range r.limiters {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(r.limiters, shootKey)
+		}
+	}
+}
+
+// matchingRule returns the first rule matching the given event or nil if no rule matches.
+func (r *Reconciler) matchingRule(event *corev1.Event) *rule {
+	r.rulesOnce.Do(func() {
+		for _, configRule := range r.Config.Rules {
+			compiled := rule{name: configRule.Name}
+			if configRule.Reason != nil {
+				compiled.reason = regexp.MustCompile(*configRule.Reason)
+			}
+			if configRule.Message != nil {
+				compiled.message = regexp.MustCompile(*configRule.Message)
+			}
+			r.rules = append(r.rules, compiled)
+		}
+	})
+
+	for i, rule := range r.rules {
+		if rule.reason == nil && rule.message == nil {
+			continue
+		}
+		if rule.reason != nil && !rule.reason.MatchString(event.Reason) {
+			continue
+		}
+		if rule.message != nil && !rule.message.MatchString(event.Message) {
+			continue
+		}
+		return &r.rules[i]
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package eventmirror_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/eventmirror"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+
+		seedClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *events.FakeRecorder
+		reconciler *Reconciler
+
+		ev *corev1.Event
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+		recorder = events.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			SeedClient: seedClient,
+			Config: gardenletconfigv1alpha1.ShootEventMirrorControllerConfiguration{
+				Rules: []gardenletconfigv1alpha1.ShootEventMirrorRule{
+					{Name: "etcd-quota", Message: ptr.To("database space exceeded")},
+					{Name: "webhook", Reason: ptr.To("^FailedCreate$"), Message: ptr.To("failed calling webhook")},
+				},
+				DeduplicationWindow: &metav1.Duration{Duration: time.Hour},
+				MaxEventsPerHour:    ptr.To[int32](2),
+			},
+			Clock:    fakeClock,
+			Recorder: recorder,
+		}
+
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Spec: extensionsv1alpha1.ClusterSpec{
+				Shoot: runtime.RawExtension{Object: &gardencorev1beta1.Shoot{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Shoot"},
+					ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+				}},
+			},
+		})).To(Succeed())
+
+		ev = &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "etcd-main-0.123", Namespace: namespace},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "etcd-main-0"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "Unhealthy",
+			Message:        "etcdserver: mvcc: database space exceeded",
+		}
+	})
+
+	reconcileEvent := func(ev *corev1.Event) {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ev)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	It("should mirror matching events to the shoot", func() {
+		Expect(seedClient.Create(ctx, ev)).To(Succeed())
+
+		reconcileEvent(ev)
+
+		Expect(recorder.Events).To(Receive(Equal(`Warning Unhealthy [etcd-quota] Pod "etcd-main-0" in the control plane: etcdserver: mvcc: database space exceeded`)))
+	})
+
+	It("should not mirror events which do not match all fields of a rule", func() {
+		ev.Message = `failed calling webhook "foo.example.com"`
+		Expect(seedClient.Create(ctx, ev)).To(Succeed())
+
+		reconcileEvent(ev)
+
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should do nothing if the event is gone", func() {
+		reconcileEvent(ev)
+
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should do nothing if the cluster is gone", func() {
+		ev.Namespace = "shoot--foo--baz"
+		Expect(seedClient.Create(ctx, ev)).To(Succeed())
+
+		reconcileEvent(ev)
+
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should deduplicate events within the deduplication window", func() {
+		Expect(seedClient.Create(ctx, ev)).To(Succeed())
+
+		reconcileEvent(ev)
+		Expect(recorder.Events).To(HaveLen(1))
+
+		fakeClock.Step(30 * time.Minute)
+		reconcileEvent(ev)
+		Expect(recorder.Events).To(HaveLen(1))
+
+		fakeClock.Step(30 * time.Minute)
+		reconcileEvent(ev)
+		Expect(recorder.Events).To(HaveLen(2))
+	})
+
+	It("should rate limit the mirrored events per shoot", func() {
+		for _, name := range []string{"etcd-main-0", "etcd-main-1", "etcd-main-2"} {
+			ev := ev.DeepCopy()
+			ev.Name = name + ".123"
+			ev.InvolvedObject.Name = name
+			Expect(seedClient.Create(ctx, ev)).To(Succeed())
+
+			reconcileEvent(ev)
+		}
+		Expect(recorder.Events).To(HaveLen(2))
+
+		fakeClock.Step(30 * time.Minute)
+		ev.Name = "etcd-main-2.123"
+		reconcileEvent(ev)
+		Expect(recorder.Events).To(HaveLen(3))
+	})
+})
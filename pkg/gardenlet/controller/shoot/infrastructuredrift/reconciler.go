@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructuredrift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// PendingOperationRequeueInterval is the interval after which Shoots are requeued while the operation annotation on
+// their Infrastructure has not been processed yet.
+const PendingOperationRequeueInterval = 30 * time.Second
+
+// Reconciler periodically annotates the Infrastructure resources of Shoots with the 'detect-drift' operation so that
+// the Infrastructure extensions report whether the infrastructure deviates from its desired state. The result of the
+// last drift detection is reported in the 'InfrastructureDrifted' constraint of the Shoots.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       gardenletconfigv1alpha1.ShootInfrastructureDriftControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+}
+
+// Reconcile detects the infrastructure drift of Shoots.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if ptr.Deref(shoot.Status.SeedName, "") != r.SeedName || shoot.DeletionTimestamp != nil || v1beta1helper.IsWorkerless(shoot) {
+		log.V(1).Info("Shoot is not managed by this seed, is being deleted, or has no infrastructure, stop reconciling")
+		return reconcile.Result{}, nil
+	}
+
+	infrastructure := &extensionsv1alpha1.Infrastructure{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: shoot.Name, Namespace: v1beta1helper.ControlPlaneNamespaceForShoot(shoot)}, infrastructure); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Infrastructure does not exist yet, requeueing")
+			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed reading infrastructure: %w", err)
+	}
+
+	drift := extensionsv1alpha1helper.GetInfrastructureDrift(infrastructure)
+	if err := r.updateConstraint(ctx, shoot, drift); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if _, ok := infrastructure.Annotations[v1beta1constants.GardenerOperation]; ok {
+		log.V(1).Info("Infrastructure has a pending operation, requeueing", "operation", infrastructure.Annotations[v1beta1constants.GardenerOperation])
+		return reconcile.Result{RequeueAfter: PendingOperationRequeueInterval}, nil
+	}
+
+	if lastOperation := infrastructure.Status.LastOperation; lastOperation == nil ||
+		lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded ||
+		lastOperation.Type == gardencorev1beta1.LastOperationTypeMigrate ||
+		lastOperation.Type == gardencorev1beta1.LastOperationTypeDelete {
+		log.V(1).Info("Infrastructure has not been reconciled successfully, requeueing")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	if drift != nil {
+		if nextDetection := drift.LastDetectionTime.Add(r.Config.SyncPeriod.Duration); r.Clock.Now().Before(nextDetection) {
+			return reconcile.Result{RequeueAfter: nextDetection.Sub(r.Clock.Now())}, nil
+		}
+	}
+
+	log.Info("Requesting drift detection of infrastructure")
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	metav1.SetMetaDataAnnotation(&infrastructure.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationDetectDrift)
+	if err := r.SeedClient.Patch(ctx, infrastructure, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed requesting drift detection: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: PendingOperationRequeueInterval}, nil
+}
+
+func (r *Reconciler) updateConstraint(ctx context.Context, shoot *gardencorev1beta1.Shoot, drift *extensionsv1alpha1.InfrastructureDrift) error {
+	var (
+		status  = gardencorev1beta1.ConditionUnknown
+		reason  = "InfrastructureDriftUnknown"
+		message = "The drift of the infrastructure has not been detected since its last reconciliation."
+	)
+
+	if drift != nil {
+		switch drift.State {
+		case extensionsv1alpha1.InfrastructureDriftStateInSync:
+			status, reason, message = gardencorev1beta1.ConditionFalse, "InfrastructureInSync", "The infrastructure matches its desired state."
+		case extensionsv1alpha1.InfrastructureDriftStateDrifted:
+			status, reason, message = gardencorev1beta1.ConditionTrue, "InfrastructureDrifted", "The infrastructure deviates from its desired state."
+		default:
+			message = "The drift of the infrastructure could not be detected."
+		}
+
+		if drift.Description != nil {
+			message = *drift.Description
+		}
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, shoot.Status.Constraints, gardencorev1beta1.ShootInfrastructureDrifted)
+	updatedCondition := v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, status, reason, message)
+	if !v1beta1helper.ConditionsNeedUpdate([]gardencorev1beta1.Condition{condition}, []gardencorev1beta1.Condition{updatedCondition}) {
+		return nil
+	}
+
+	patch := client.StrategicMergeFrom(shoot.DeepCopy())
+	shoot.Status.Constraints = v1beta1helper.MergeConditions(shoot.Status.Constraints, updatedCondition)
+	if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed updating %s constraint: %w", gardencorev1beta1.ShootInfrastructureDrifted, err)
+	}
+
+	return nil
+}
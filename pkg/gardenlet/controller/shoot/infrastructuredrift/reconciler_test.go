@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructuredrift_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/infrastructuredrift"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+		seedClient   client.Client
+		reconciler   *Reconciler
+
+		shoot          *gardencorev1beta1.Shoot
+		infrastructure *extensionsv1alpha1.Infrastructure
+
+		seedName   = "seed"
+		namespace  = "shoot--foo--bar"
+		syncPeriod = time.Hour
+		now        = time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config:       gardenletconfigv1alpha1.ShootInfrastructureDriftControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+			Clock:        testclock.NewFakeClock(now),
+			SeedName:     seedName,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{Name: "worker"}}},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName:    &seedName,
+				TechnicalID: namespace,
+			},
+		}
+
+		infrastructure = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:           gardencorev1beta1.LastOperationTypeReconcile,
+						State:          gardencorev1beta1.LastOperationStateSucceeded,
+						LastUpdateTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	reconcileShoot := func() reconcile.Result {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return result
+	}
+
+	expectConstraint := func(status gardencorev1beta1.ConditionStatus, reason, message string) {
+		ExpectWithOffset(1, gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		ExpectWithOffset(1, shoot.Status.Constraints).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(gardencorev1beta1.ShootInfrastructureDrifted),
+			"Status":  Equal(status),
+			"Reason":  Equal(reason),
+			"Message": Equal(message),
+		})))
+	}
+
+	expectDriftDetectionRequested := func(requested bool) {
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKeyFromObject(infrastructure), infrastructure)).To(Succeed())
+		if requested {
+			ExpectWithOffset(1, infrastructure.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "detect-drift"))
+		} else {
+			ExpectWithOffset(1, infrastructure.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+		}
+	}
+
+	It("should do nothing if the shoot is not managed by this seed", func() {
+		shoot.Status.SeedName = ptr.To("other")
+		Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+		expectDriftDetectionRequested(false)
+	})
+
+	It("should requeue if the infrastructure does not exist yet", func() {
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Status.Constraints).To(BeEmpty())
+	})
+
+	It("should request a drift detection if the drift was never detected", func() {
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: PendingOperationRequeueInterval}))
+		expectConstraint(gardencorev1beta1.ConditionUnknown, "InfrastructureDriftUnknown", "The drift of the infrastructure has not been detected since its last reconciliation.")
+		expectDriftDetectionRequested(true)
+	})
+
+	It("should request a drift detection if the drift was detected before the last reconciliation", func() {
+		infrastructure.Status.Drift = &extensionsv1alpha1.InfrastructureDrift{
+			State:             extensionsv1alpha1.InfrastructureDriftStateDrifted,
+			LastDetectionTime: metav1.NewTime(now.Add(-3 * time.Hour)),
+		}
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: PendingOperationRequeueInterval}))
+		expectConstraint(gardencorev1beta1.ConditionUnknown, "InfrastructureDriftUnknown", "The drift of the infrastructure has not been detected since its last reconciliation.")
+		expectDriftDetectionRequested(true)
+	})
+
+	It("should report the infrastructure to be in sync and requeue until the next detection is due", func() {
+		infrastructure.Status.Drift = &extensionsv1alpha1.InfrastructureDrift{
+			State:             extensionsv1alpha1.InfrastructureDriftStateInSync,
+			LastDetectionTime: metav1.NewTime(now.Add(-10 * time.Minute)),
+		}
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 50 * time.Minute}))
+		expectConstraint(gardencorev1beta1.ConditionFalse, "InfrastructureInSync", "The infrastructure matches its desired state.")
+		expectDriftDetectionRequested(false)
+	})
+
+	It("should report the drift and request the next detection if it is due", func() {
+		infrastructure.Status.Drift = &extensionsv1alpha1.InfrastructureDrift{
+			State:             extensionsv1alpha1.InfrastructureDriftStateDrifted,
+			Description:       ptr.To("security group rule was removed"),
+			LastDetectionTime: metav1.NewTime(now.Add(-time.Hour)),
+		}
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: PendingOperationRequeueInterval}))
+		expectConstraint(gardencorev1beta1.ConditionTrue, "InfrastructureDrifted", "security group rule was removed")
+		expectDriftDetectionRequested(true)
+	})
+
+	It("should report that the drift could not be detected", func() {
+		infrastructure.Status.Drift = &extensionsv1alpha1.InfrastructureDrift{
+			State:             extensionsv1alpha1.InfrastructureDriftStateUnknown,
+			Description:       ptr.To("The extension does not support drift detection"),
+			LastDetectionTime: metav1.NewTime(now.Add(-time.Minute)),
+		}
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 59 * time.Minute}))
+		expectConstraint(gardencorev1beta1.ConditionUnknown, "InfrastructureDriftUnknown", "The extension does not support drift detection")
+		expectDriftDetectionRequested(false)
+	})
+
+	It("should not request a drift detection while an operation is pending", func() {
+		infrastructure.Annotations = map[string]string{"gardener.cloud/operation": "reconcile"}
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: PendingOperationRequeueInterval}))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(infrastructure), infrastructure)).To(Succeed())
+		Expect(infrastructure.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "reconcile"))
+	})
+
+	It("should not request a drift detection if the infrastructure was not reconciled successfully", func() {
+		infrastructure.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		expectDriftDetectionRequested(false)
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p interface {
+			Create(event.CreateEvent) bool
+			Update(event.UpdateEvent) bool
+			Delete(event.DeleteEvent) bool
+		}
+
+		JustBeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		It("should return true for shoots with workers on the seed", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for shoots on other seeds", func() {
+			shoot.Status.SeedName = ptr.To("other")
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return false for workerless shoots", func() {
+			shoot.Spec.Provider.Workers = nil
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return true for updates only if the shoot was scheduled to the seed", func() {
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Status.SeedName = nil
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should return false for delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+		})
+	})
+})
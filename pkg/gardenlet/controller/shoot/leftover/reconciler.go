@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package leftover
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/shootleftover"
+	"github.com/gardener/gardener/pkg/extensions"
+)
+
+// minimumSecretAge is the minimum age of a ShootLeftover secret before it is considered for garbage collection. This
+// prevents deleting secrets whose ShootLeftover resource has not been created yet.
+const minimumSecretAge = 10 * time.Minute
+
+const (
+	// EventLeftoverResourcesDetected is the reason of events recorded for ShootLeftovers with leftover resources.
+	EventLeftoverResourcesDetected = "LeftoverResourcesDetected"
+	// EventShootLeftoverDeleted is the reason of events recorded for garbage collected ShootLeftovers.
+	EventShootLeftoverDeleted = "ShootLeftoverDeleted"
+
+	// ActionScan is the action of events recorded for the scan results of ShootLeftovers.
+	ActionScan = "Scan"
+	// ActionDelete is the action of events recorded for garbage collected ShootLeftovers.
+	ActionDelete = "Delete"
+)
+
+// Reconciler garbage collects ShootLeftover resources and the credentials they reference. ShootLeftover resources are
+// deleted once the provider extension reported that no leftover resources exist, or once the retention period has
+// passed. Secrets referencing the infrastructure credentials are deleted once their ShootLeftover resource is gone.
+// Detected leftover resources and deletions are recorded as events on the ShootLeftover resources.
+type Reconciler struct {
+	SeedClient client.Client
+	Config     gardenletconfigv1alpha1.ShootLeftoverControllerConfiguration
+	Clock      clock.Clock
+	Recorder   events.EventRecorder
+}
+
+// Reconcile performs the garbage collection of ShootLeftover resources.
+func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shootLeftoverList := &extensionsv1alpha1.ShootLeftoverList{}
+	if err := r.SeedClient.List(ctx, shootLeftoverList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing ShootLeftovers: %w", err)
+	}
+
+	existing := sets.New[string]()
+	for _, shootLeftover := range shootLeftoverList.Items {
+		existing.Insert(shootLeftover.Name)
+
+		if shootLeftover.DeletionTimestamp != nil {
+			continue
+		}
+
+		if len(shootLeftover.Status.Resources) > 0 {
+			r.Recorder.Eventf(&shootLeftover, nil, corev1.EventTypeWarning, EventLeftoverResourcesDetected, ActionScan, "%d leftover resources detected: %s", len(shootLeftover.Status.Resources), describeResources(shootLeftover.Status.Resources))
+		}
+
+		reason, ok := r.shouldBeDeleted(&shootLeftover)
+		if !ok {
+			continue
+		}
+
+		log.Info("Deleting ShootLeftover", "shootLeftover", client.ObjectKeyFromObject(&shootLeftover), "reason", reason, "leftoverResources", len(shootLeftover.Status.Resources))
+		if err := extensions.DeleteExtensionObject(ctx, r.SeedClient, &shootLeftover); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed deleting ShootLeftover %s: %w", client.ObjectKeyFromObject(&shootLeftover), err)
+		}
+		r.Recorder.Eventf(&shootLeftover, nil, corev1.EventTypeNormal, EventShootLeftoverDeleted, ActionDelete, "Deleted ShootLeftover of shoot %s/%s since %s (%d leftover resources)",
+			shootLeftover.Labels[v1beta1constants.LabelShootNamespace], shootLeftover.Labels[v1beta1constants.LabelShootName], reason, len(shootLeftover.Status.Resources))
+	}
+
+	if err := r.deleteOrphanedSecrets(ctx, log, existing); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) shouldBeDeleted(shootLeftover *extensionsv1alpha1.ShootLeftover) (string, bool) {
+	if r.Clock.Since(shootLeftover.CreationTimestamp.Time) > r.Config.RetentionPeriod.Duration {
+		return "retention period has passed", true
+	}
+
+	lastOperation := shootLeftover.Status.LastOperation
+	if shootLeftover.Status.LastScanTime != nil &&
+		len(shootLeftover.Status.Resources) == 0 &&
+		lastOperation != nil &&
+		lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
+		return "no leftover resources detected", true
+	}
+
+	return "", false
+}
+
+// maxDescribedResources is the maximum number of resources listed in the note of an event.
+const maxDescribedResources = 10
+
+func describeResources(resources []extensionsv1alpha1.LeftoverResource) string {
+	var descriptions []string
+	for i, resource := range resources {
+		if i == maxDescribedResources {
+			descriptions = append(descriptions, fmt.Sprintf("and %d more", len(resources)-maxDescribedResources))
+			break
+		}
+		descriptions = append(descriptions, resource.Kind+"/"+resource.ID)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func (r *Reconciler) deleteOrphanedSecrets(ctx context.Context, log logr.Logger, existingShootLeftovers sets.Set[string]) error {
+	secretList := &corev1.SecretList{}
+	if err := r.SeedClient.List(ctx, secretList, client.InNamespace(v1beta1constants.GardenNamespace), client.MatchingLabels{v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeShootLeftover}); err != nil {
+		return fmt.Errorf("failed listing ShootLeftover secrets: %w", err)
+	}
+
+	for _, secret := range secretList.Items {
+		if secret.DeletionTimestamp != nil ||
+			r.Clock.Since(secret.CreationTimestamp.Time) < minimumSecretAge ||
+			existingShootLeftovers.Has(strings.TrimPrefix(secret.Name, shootleftover.SecretNamePrefix)) {
+			continue
+		}
+
+		log.Info("Deleting orphaned ShootLeftover secret", "secret", client.ObjectKeyFromObject(&secret))
+		if err := r.SeedClient.Delete(ctx, &secret); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting secret %s: %w", client.ObjectKeyFromObject(&secret), err)
+		}
+	}
+
+	return nil
+}
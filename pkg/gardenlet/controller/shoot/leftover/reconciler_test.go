@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package leftover_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/leftover"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *events.FakeRecorder
+		reconciler *Reconciler
+
+		now = time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	)
+
+	newShootLeftover := func(name string, age time.Duration) *extensionsv1alpha1.ShootLeftover {
+		return &extensionsv1alpha1.ShootLeftover{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Labels:            map[string]string{"shoot.gardener.cloud/name": "bar", "shoot.gardener.cloud/namespace": "garden-foo"},
+				CreationTimestamp: metav1.Time{Time: now.Add(-age)},
+			},
+		}
+	}
+
+	newSecret := func(name string, age time.Duration) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "garden",
+				Labels:            map[string]string{"gardener.cloud/purpose": "shootleftover"},
+				CreationTimestamp: metav1.Time{Time: now.Add(-age)},
+			},
+		}
+	}
+
+	scanned := func(shootLeftover *extensionsv1alpha1.ShootLeftover, state gardencorev1beta1.LastOperationState, resources ...extensionsv1alpha1.LeftoverResource) *extensionsv1alpha1.ShootLeftover {
+		shootLeftover.Status.LastOperation = &gardencorev1beta1.LastOperation{State: state}
+		shootLeftover.Status.LastScanTime = &metav1.Time{Time: now.Add(-time.Minute)}
+		shootLeftover.Status.Resources = resources
+		return shootLeftover
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(now)
+		recorder = events.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			SeedClient: fakeClient,
+			Clock:      fakeClock,
+			Recorder:   recorder,
+			Config: gardenletconfigv1alpha1.ShootLeftoverControllerConfiguration{
+				SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+				RetentionPeriod: &metav1.Duration{Duration: 720 * time.Hour},
+			},
+		}
+	})
+
+	It("should requeue after the sync period", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+	})
+
+	It("should delete ShootLeftovers whose retention period has passed", func() {
+		shootLeftover := scanned(newShootLeftover("expired", 721*time.Hour), gardencorev1beta1.LastOperationStateSucceeded, extensionsv1alpha1.LeftoverResource{Kind: "Disk", ID: "disk-1"})
+		Expect(fakeClient.Create(ctx, shootLeftover)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootLeftover), shootLeftover)).To(BeNotFoundError())
+		Expect(recorder.Events).To(Receive(Equal("Warning LeftoverResourcesDetected 1 leftover resources detected: Disk/disk-1")))
+		Expect(recorder.Events).To(Receive(Equal("Normal ShootLeftoverDeleted Deleted ShootLeftover of shoot garden-foo/bar since retention period has passed (1 leftover resources)")))
+	})
+
+	It("should delete ShootLeftovers for which no leftover resources were detected", func() {
+		shootLeftover := scanned(newShootLeftover("clean", time.Hour), gardencorev1beta1.LastOperationStateSucceeded)
+		Expect(fakeClient.Create(ctx, shootLeftover)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootLeftover), shootLeftover)).To(BeNotFoundError())
+		Expect(recorder.Events).To(Receive(Equal("Normal ShootLeftoverDeleted Deleted ShootLeftover of shoot garden-foo/bar since no leftover resources detected (0 leftover resources)")))
+	})
+
+	It("should keep ShootLeftovers with detected leftover resources", func() {
+		shootLeftover := scanned(newShootLeftover("dirty", time.Hour), gardencorev1beta1.LastOperationStateSucceeded, extensionsv1alpha1.LeftoverResource{Kind: "LoadBalancer", ID: "lb-1"})
+		Expect(fakeClient.Create(ctx, shootLeftover)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootLeftover), shootLeftover)).To(Succeed())
+		Expect(recorder.Events).To(Receive(Equal("Warning LeftoverResourcesDetected 1 leftover resources detected: LoadBalancer/lb-1")))
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should shorten the list of leftover resources in events", func() {
+		var resources []extensionsv1alpha1.LeftoverResource
+		for i := range 12 {
+			resources = append(resources, extensionsv1alpha1.LeftoverResource{Kind: "Disk", ID: fmt.Sprintf("disk-%d", i)})
+		}
+		shootLeftover := scanned(newShootLeftover("dirty", time.Hour), gardencorev1beta1.LastOperationStateSucceeded, resources...)
+		Expect(fakeClient.Create(ctx, shootLeftover)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.Events).To(Receive(Equal("Warning LeftoverResourcesDetected 12 leftover resources detected: Disk/disk-0, Disk/disk-1, Disk/disk-2, Disk/disk-3, Disk/disk-4, Disk/disk-5, Disk/disk-6, Disk/disk-7, Disk/disk-8, Disk/disk-9, and 2 more")))
+	})
+
+	It("should keep ShootLeftovers which were not scanned successfully yet", func() {
+		notScanned := newShootLeftover("not-scanned", time.Hour)
+		failed := scanned(newShootLeftover("failed", time.Hour), gardencorev1beta1.LastOperationStateError)
+		Expect(fakeClient.Create(ctx, notScanned)).To(Succeed())
+		Expect(fakeClient.Create(ctx, failed)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(notScanned), notScanned)).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(failed), failed)).To(Succeed())
+	})
+
+	It("should delete secrets of ShootLeftovers which no longer exist", func() {
+		shootLeftover := newShootLeftover("dirty", time.Hour)
+		Expect(fakeClient.Create(ctx, shootLeftover)).To(Succeed())
+
+		var (
+			referencedSecret = newSecret("shootleftover-dirty", time.Hour)
+			orphanedSecret   = newSecret("shootleftover-gone", time.Hour)
+			recentSecret     = newSecret("shootleftover-new", time.Minute)
+			unrelatedSecret  = newSecret("foo", time.Hour)
+		)
+		unrelatedSecret.Labels = nil
+
+		for _, secret := range []*corev1.Secret{referencedSecret, orphanedSecret, recentSecret, unrelatedSecret} {
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+		}
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(referencedSecret), referencedSecret)).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(orphanedSecret), orphanedSecret)).To(BeNotFoundError())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(recentSecret), recentSecret)).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(unrelatedSecret), unrelatedSecret)).To(Succeed())
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -125,12 +126,20 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 	}
 
 	r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, gardencorev1beta1.EventActionReconcile, "%s Shoot cluster", utils.IifString(isRestoring, "Restoring", "Reconciling"))
-	if flowErr := r.runReconcileShootFlow(ctx, o, operationType); flowErr != nil {
+	timestamps := newReconciliationTimestampsRecorder(r.Clock, operationType)
+	if flowErr := r.runReconcileShootFlow(ctx, o, operationType, timestamps); flowErr != nil {
 		r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, gardencorev1beta1.EventActionReconcile, flowErr.Description)
-		updateErr := r.patchShootStatusOperationError(ctx, shoot, flowErr.Description, operationType, flowErr.LastErrors...)
+		updateErr := r.patchShootStatusReconciliationTimestamps(ctx, shoot, timestamps.finish(gardencorev1beta1.LastOperationStateError))
+		if updateErr == nil {
+			updateErr = r.patchShootStatusOperationError(ctx, shoot, flowErr.Description, operationType, flowErr.LastErrors...)
+		}
 		return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(flowErr.Description), updateErr)
 	}
 
+	if err := r.patchShootStatusReconciliationTimestamps(ctx, shoot, timestamps.finish(gardencorev1beta1.LastOperationStateSucceeded)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching reconciliation timestamps: %w", err)
+	}
+
 	r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.EventReconciled, gardencorev1beta1.EventActionReconcile, "%s Shoot cluster", utils.IifString(isRestoring, "Restored", "Reconciled"))
 	if err := r.patchShootStatusOperationSuccess(ctx, shoot, &o.Seed.GetInfo().Name, operationType); err != nil {
 		return reconcile.Result{}, err
@@ -463,7 +472,38 @@ func (r *Reconciler) initializeOperation(
 
 func (r *Reconciler) syncClusterResourceToSeed(ctx context.Context, shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project, cloudProfile *gardencorev1beta1.CloudProfile, seed *gardencorev1beta1.Seed) error {
 	clusterName := gardenerutils.ComputeTechnicalID(project.Name, shoot)
-	return gardenerextensions.SyncClusterResourceToSeed(ctx, r.SeedClientSet.Client(), clusterName, shoot, cloudProfile, seed)
+
+	// The egress filter exceptions are consumed by networking filter extensions via the Cluster resource, hence changes
+	// are audited when they are synced to the seed.
+	var oldEgressFilter *gardencorev1beta1.EgressFilter
+	oldShoot, err := gardenerextensions.GetShoot(ctx, r.SeedClientSet.Client(), clusterName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed reading shoot from cluster resource: %w", err)
+	}
+	if oldShoot != nil {
+		oldEgressFilter = egressFilter(oldShoot)
+	}
+
+	if err := gardenerextensions.SyncClusterResourceToSeed(ctx, r.SeedClientSet.Client(), clusterName, shoot, cloudProfile, seed); err != nil {
+		return err
+	}
+
+	if newEgressFilter := egressFilter(shoot); !equality.Semantic.DeepEqual(oldEgressFilter, newEgressFilter) {
+		var allowList, denyList []string
+		if newEgressFilter != nil {
+			allowList, denyList = newEgressFilter.AllowList, newEgressFilter.DenyList
+		}
+		r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootEventEgressFilterUpdated, gardencorev1beta1.EventActionReconcile, "Egress filter exceptions updated (allowList: %v, denyList: %v)", allowList, denyList)
+	}
+
+	return nil
+}
+
+func egressFilter(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.EgressFilter {
+	if shoot.Spec.Networking == nil || shoot.Spec.Networking.Egress == nil {
+		return nil
+	}
+	return shoot.Spec.Networking.Egress.Filter
 }
 
 func (r *Reconciler) checkSeedAndSyncClusterResource(ctx context.Context, shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project, cloudProfile *gardencorev1beta1.CloudProfile, seed *gardencorev1beta1.Seed) error {
@@ -989,10 +1029,17 @@ func (r *Reconciler) patchShootStatusOperationError(
 	operationType gardencorev1beta1.LastOperationType,
 	lastErrors ...gardencorev1beta1.LastError,
 ) error {
+	// Error codes contributed by extensions are classified according to the definitions in their ControllerRegistrations.
+	controllerRegistrationList := &gardencorev1beta1.ControllerRegistrationList{}
+	if err := r.GardenClient.List(ctx, controllerRegistrationList); err != nil {
+		return fmt.Errorf("failed listing ControllerRegistrations: %w", err)
+	}
+
 	var (
-		now          = metav1.NewTime(r.Clock.Now().UTC())
-		state        = gardencorev1beta1.LastOperationStateError
-		willNotRetry = v1beta1helper.HasNonRetryableErrorCode(lastErrors...) || utils.HasTimeElapsed(shoot.Status.RetryCycleStartTime, r.Config.Controllers.Shoot.RetryDuration.Duration)
+		now                  = metav1.NewTime(r.Clock.Now().UTC())
+		state                = gardencorev1beta1.LastOperationStateError
+		errorCodeDefinitions = v1beta1helper.ErrorCodeDefinitionsFromControllerRegistrations(controllerRegistrationList.Items)
+		willNotRetry         = v1beta1helper.HasNonRetryableErrorCode(errorCodeDefinitions, lastErrors...) || utils.HasTimeElapsed(shoot.Status.RetryCycleStartTime, r.Config.Controllers.Shoot.RetryDuration.Duration)
 	)
 
 	statusPatch := client.StrategicMergeFrom(shoot.DeepCopy())
@@ -1006,6 +1053,7 @@ func (r *Reconciler) patchShootStatusOperationError(
 
 	shoot.Status.Gardener = *r.Identity
 	shoot.Status.LastErrors = lastErrors
+	reportErrorCodeMetrics(operationType, lastErrors, errorCodeDefinitions)
 
 	if shoot.Status.LastOperation == nil {
 		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{}
@@ -1304,6 +1352,42 @@ func reportMetrics(shoot *gardencorev1beta1.Shoot, operationType gardencorev1bet
 		Observe(float64(duration.Seconds()))
 }
 
+// newTaskObserver returns a flow.TaskObserver which reports the duration and failures of the flow tasks. The purpose
+// and the seed are used as labels instead of the shoot name to keep the cardinality of the metrics bounded.
+func newTaskObserver(shoot *gardencorev1beta1.Shoot, seedName string) flow.TaskObserver {
+	purpose := string(v1beta1helper.GetPurpose(shoot))
+
+	return func(flowName string, id flow.TaskID, duration time.Duration, err error) {
+		gardenletmetrics.ShootFlowTaskDurationSeconds.
+			WithLabelValues(flowName, string(id), purpose, seedName).
+			Observe(duration.Seconds())
+
+		if err != nil {
+			gardenletmetrics.ShootFlowTaskFailuresTotal.
+				WithLabelValues(flowName, string(id), purpose, seedName).
+				Inc()
+		}
+	}
+}
+
+func reportErrorCodeMetrics(operationType gardencorev1beta1.LastOperationType, lastErrors []gardencorev1beta1.LastError, errorCodeDefinitions []gardencorev1beta1.ErrorCodeDefinition) {
+	codes := sets.New[gardencorev1beta1.ErrorCode]()
+	for _, lastError := range lastErrors {
+		codes.Insert(lastError.Codes...)
+	}
+
+	for _, code := range sets.List(codes) {
+		userError := "unknown"
+		if definition, ok := v1beta1helper.ErrorCodeDefinitionFor(code, errorCodeDefinitions); ok {
+			userError = strconv.FormatBool(definition.UserError)
+		}
+
+		gardenletmetrics.ShootOperationErrorCodesTotal.
+			WithLabelValues(string(operationType), string(code), userError).
+			Inc()
+	}
+}
+
 func manualInPlacePendingWorkersPresent(inPlaceUpdates *gardencorev1beta1.InPlaceUpdatesStatus) bool {
 	return inPlaceUpdates != nil && inPlaceUpdates.PendingWorkerUpdates != nil && len(inPlaceUpdates.PendingWorkerUpdates.ManualInPlaceUpdate) > 0
 }
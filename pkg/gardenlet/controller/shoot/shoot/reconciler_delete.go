@@ -141,6 +141,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		hasNodesCIDR            = o.Shoot.GetInfo().Spec.Networking != nil && o.Shoot.GetInfo().Spec.Networking.Nodes != nil
 		nonTerminatingNamespace = botanist.SeedNamespaceObject.UID != "" && botanist.SeedNamespaceObject.Status.Phase != corev1.NamespaceTerminating
 		cleanupShootResources   = nonTerminatingNamespace && kubeAPIServerDeploymentFound && (infrastructure != nil || o.Shoot.IsWorkerless)
+		aggressiveCleanup       = v1beta1helper.ShootUsesAggressiveCleanup(o.Shoot.GetInfo())
 	)
 
 	if hasNodesCIDR {
@@ -405,10 +406,11 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		)
 
 		cleanKubernetesResources = g.Add(flow.Task{
-			Name:         "Cleaning Kubernetes resources",
-			Fn:           flow.TaskFn(botanist.CleanKubernetesResources).Timeout(10 * time.Minute),
-			SkipIf:       !cleanupShootResources,
-			Dependencies: flow.NewTaskIDs(syncPointReadyForCleanup),
+			Name:   "Cleaning Kubernetes resources",
+			Fn:     flow.TaskFn(botanist.CleanKubernetesResources).Timeout(10 * time.Minute),
+			SkipIf: !cleanupShootResources,
+			// In the aggressive cleanup mode, the Kubernetes resources are cleaned up in parallel to the extended API groups.
+			Dependencies: flow.NewTaskIDs(initializeShootClients, deployControlPlane, deployKubeControllerManager, waitForControllersToBeActive).InsertIf(!aggressiveCleanup, cleanExtendedAPIs),
 		})
 		deleteMetricsServer = g.Add(flow.Task{
 			Name: "Deleting metrics-server",
@@ -644,6 +646,12 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			SkipIf:       botanist.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(deleteInfrastructure),
 		})
+		deployShootLeftover = g.Add(flow.Task{
+			Name:         "Deploying shoot leftover resource for detecting orphaned infrastructure resources",
+			Fn:           flow.TaskFn(botanist.DeployShootLeftover).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       botanist.Shoot.IsWorkerless || r.Config.Controllers.ShootLeftover == nil,
+			Dependencies: flow.NewTaskIDs(waitUntilInfrastructureDeleted),
+		})
 		destroyExternalDomainDNSRecord = g.Add(flow.Task{
 			Name:         "Destroying external domain DNS record",
 			Fn:           botanist.DestroyExternalDNSRecord,
@@ -674,6 +682,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			destroyIngressDomainDNSRecord,
 			destroyExternalDomainDNSRecord,
 			waitUntilInfrastructureDeleted,
+			deployShootLeftover,
 		)
 
 		destroyInternalDomainDNSRecord = g.Add(flow.Task{
@@ -723,6 +732,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorCleaner:     o.CleanShootTaskError,
 		ErrorContext:     errorContext,
+		TaskObserver:     newTaskObserver(o.Shoot.GetInfo(), o.Seed.GetInfo().Name),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -194,10 +194,18 @@ func (r *Reconciler) runMigrateShootFlow(ctx context.Context, o *operation.Opera
 			SkipIf:       !cleanupShootResources,
 			Dependencies: flow.NewTaskIDs(ensureResourceManagerScaledUp, wakeUpKubeAPIServerWithNodeAgentAuthorizer),
 		})
+		// Lower the TTL of the DNS records before the control plane is torn down so that clients pick up the addresses of
+		// the destination seed quickly once the DNS records have been restored there.
+		lowerDNSRecordTTLs = g.Add(flow.Task{
+			Name:         "Lowering TTL of DNS records for control plane cutover",
+			Fn:           botanist.LowerDNSRecordTTLsForMigration,
+			SkipIf:       !nonTerminatingNamespace,
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		deleteManagedResources = g.Add(flow.Task{
 			Name:         "Deleting all Managed Resources from the Shoot's namespace",
 			Fn:           botanist.DeleteManagedResources,
-			Dependencies: flow.NewTaskIDs(keepManagedResourcesObjectsInShoot, ensureResourceManagerScaledUp, wakeUpKubeAPIServerWithNodeAgentAuthorizer),
+			Dependencies: flow.NewTaskIDs(keepManagedResourcesObjectsInShoot, ensureResourceManagerScaledUp, wakeUpKubeAPIServerWithNodeAgentAuthorizer, lowerDNSRecordTTLs),
 		})
 		waitForManagedResourcesDeletion = g.Add(flow.Task{
 			Name:         "Waiting until ManagedResources are deleted",
@@ -459,6 +467,7 @@ func (r *Reconciler) runMigrateShootFlow(ctx context.Context, o *operation.Opera
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorContext:     errorContext,
 		ErrorCleaner:     o.CleanShootTaskError,
+		TaskObserver:     newTaskObserver(o.Shoot.GetInfo(), o.Seed.GetInfo().Name),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -40,7 +40,7 @@ import (
 
 // runReconcileShootFlow reconciles the Shoot cluster.
 // It receives an Operation object <o> which stores the Shoot object.
-func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Operation, operationType gardencorev1beta1.LastOperationType) *v1beta1helper.WrappedLastErrors {
+func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Operation, operationType gardencorev1beta1.LastOperationType, timestamps *reconciliationTimestampsRecorder) *v1beta1helper.WrappedLastErrors {
 	// We create the botanists (which will do the actual work).
 	var (
 		botanist                *botanistpkg.Botanist
@@ -162,6 +162,11 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name: "Deploying Shoot namespace in Seed",
 			Fn:   flow.TaskFn(botanist.DeployControlPlaneNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying resource quota for Shoot namespace in Seed",
+			Fn:           flow.TaskFn(botanist.DeployControlPlaneResourceQuota).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		ensureShootClusterIdentity = g.Add(flow.Task{
 			Name:         "Ensuring Shoot cluster identity",
 			Fn:           flow.TaskFn(botanist.EnsureShootClusterIdentity).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -173,6 +178,11 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(deployNamespace),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Reconciling image overrides",
+			Fn:           flow.TaskFn(botanist.ReconcileImageOverrides).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		reconcileIstioInternalLoadbalancingConfigMap = g.Add(flow.Task{
 			Name:         "Reconcile Istio internal load balancing ConfigMap",
 			Fn:           flow.TaskFn(botanist.ReconcileIstioInternalLoadBalancingConfigMap).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -207,6 +217,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 						return err
 					}
 				}
+				timestamps.infrastructureReady()
 				return removeTaskAnnotation(ctx, o, generation, v1beta1constants.ShootTaskDeployInfrastructure)
 			}),
 			SkipIf:       o.Shoot.IsWorkerless,
@@ -415,7 +426,11 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		waitUntilControlPlaneReady = g.Add(flow.Task{
 			Name: "Waiting until shoot control plane has been reconciled",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return botanist.Shoot.Components.Extensions.ControlPlane.Wait(ctx)
+				if err := botanist.Shoot.Components.Extensions.ControlPlane.Wait(ctx); err != nil {
+					return err
+				}
+				timestamps.controlPlaneReady()
+				return nil
 			}),
 			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployControlPlane),
@@ -742,6 +757,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
+		// The konnectivity-agent is deployed after the vpn-shoot to ensure that the connectivity is only removed after the
+		// kube-apiserver switched to the respective other mode when migrating between VPN and konnectivity.
+		deployKonnectivityAgent = g.Add(flow.Task{
+			Name:         "Deploying konnectivity-agent system component",
+			Fn:           flow.TaskFn(botanist.DeployKonnectivityAgent).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, waitUntilKubeAPIServerIsReady, deployAPIServerProxy, deployVPNShoot, waitUntilShootNamespacesReady),
+		})
 		deployBlackboxExporter = g.Add(flow.Task{
 			Name:         "Deploying blackbox-exporter",
 			Fn:           flow.TaskFn(botanist.ReconcileBlackboxExporterCluster).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -768,6 +791,24 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
+		deployManagedAddons = g.Add(flow.Task{
+			Name:         "Deploying managed addons",
+			Fn:           flow.TaskFn(botanist.DeployManagedAddons).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
+		})
+		deployVolumeSnapshots = g.Add(flow.Task{
+			Name:         "Deploying volume snapshot classes and policies",
+			Fn:           flow.TaskFn(botanist.DeployVolumeSnapshots).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, waitUntilShootNamespacesReady),
+		})
+		deployAccelerators = g.Add(flow.Task{
+			Name:         "Deploying accelerator drivers and device plugins",
+			Fn:           flow.TaskFn(botanist.DeployAccelerators).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, waitUntilShootNamespacesReady),
+		})
 		deployManagedResourceForGardenerNodeAgent = g.Add(flow.Task{
 			Name:         "Deploying managed resources for the gardener-node-agent",
 			Fn:           flow.TaskFn(botanist.DeployManagedResourceForGardenerNodeAgent).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -784,11 +825,15 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			deployNodeLocalDNS,
 			deployMetricsServer,
 			deployVPNShoot,
+			deployKonnectivityAgent,
 			deployNodeProblemDetector,
 			deployKubeProxy,
 			deployBlackboxExporter,
 			deployKubernetesDashboard,
 			deployNginxIngressAddon,
+			deployManagedAddons,
+			deployVolumeSnapshots,
+			deployAccelerators,
 		)
 
 		scaleClusterAutoscalerToZero = g.Add(flow.Task{
@@ -835,6 +880,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 				if err := botanist.Shoot.Components.Extensions.Worker.Wait(ctx); err != nil {
 					return err
 				}
+				timestamps.nodesReady()
 
 				// If the worker is ready, all the AutoInPlaceUpdate worker pools should be updated already, so we can remove them from the status.
 				if shootHasPendingInPlaceUpdateWorkers(o.Shoot.GetInfo()) {
@@ -917,6 +963,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(syncPointAllSystemComponentsDeployed, waitUntilNetworkIsReady, waitUntilWorkerReady),
 		})
+		// The konnectivity-agent is only removed after the tunnel connection via the vpn-shoot exists when migrating from
+		// konnectivity to VPN. Hibernated shoots skip the wait, hence the konnectivity-agent is removed right away.
+		_ = g.Add(flow.Task{
+			Name:         "Destroying konnectivity-agent system component",
+			Fn:           flow.TaskFn(botanist.DestroyKonnectivityAgent).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, deployKonnectivityAgent, waitUntilTunnelConnectionExists),
+		})
 		_ = g.Add(flow.Task{
 			Name: "Waiting until all shoot worker nodes have updated the operating system config",
 			Fn: func(ctx context.Context) error {
@@ -1008,7 +1062,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, initializeShootClients),
 		})
-		_ = g.Add(flow.Task{
+		waitUntilContainerRuntimeResourcesReady = g.Add(flow.Task{
 			Name: "Waiting until container runtime resources are ready",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.ContainerRuntime.Wait(ctx)
@@ -1016,6 +1070,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployContainerRuntimeResources),
 		})
+		_ = g.Add(flow.Task{
+			Name: "Deploying RuntimeClasses for container runtimes",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.Shoot.Components.Extensions.ContainerRuntime.DeployRuntimeClasses(ctx)
+			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
+			Dependencies: flow.NewTaskIDs(waitUntilContainerRuntimeResourcesReady),
+		})
 		deleteStaleContainerRuntimeResources = g.Add(flow.Task{
 			Name: "Deleting stale container runtime resources",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
@@ -1052,6 +1114,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorContext:     errorContext,
 		ErrorCleaner:     o.CleanShootTaskError,
+		TaskObserver:     newTaskObserver(o.Shoot.GetInfo(), o.Seed.GetInfo().Name),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
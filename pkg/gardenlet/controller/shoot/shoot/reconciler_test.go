@@ -6,12 +6,15 @@ package shoot
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -20,6 +23,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 )
 
 var _ = Describe("Reconciler", func() {
@@ -243,4 +247,68 @@ var _ = Describe("Reconciler", func() {
 			Expect(shoot.Status.Credentials.Rotation.ServiceAccountKey.LastInitiationFinishedTime.UTC()).To(Equal(fakeClock.Now()))
 		})
 	})
+
+	Describe("#syncClusterResourceToSeed", func() {
+		var (
+			recorder *events.FakeRecorder
+			project  *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			seedClientSet = fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build()
+			recorder = events.NewFakeRecorder(1)
+
+			reconciler = &Reconciler{
+				SeedClientSet: seedClientSet,
+				Recorder:      recorder,
+			}
+
+			project = &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "local"}}
+			shoot.Status.TechnicalID = "shoot--local--shoot"
+		})
+
+		It("should not record an event if the egress filter is not set", func() {
+			Expect(reconciler.syncClusterResourceToSeed(ctx, shoot, project, nil, nil)).To(Succeed())
+			Expect(recorder.Events).NotTo(Receive())
+		})
+
+		It("should record an event if the egress filter changes", func() {
+			shoot.Spec.Networking = &gardencorev1beta1.Networking{
+				Egress: &gardencorev1beta1.Egress{
+					Filter: &gardencorev1beta1.EgressFilter{AllowList: []string{"10.1.0.0/16"}},
+				},
+			}
+
+			Expect(reconciler.syncClusterResourceToSeed(ctx, shoot, project, nil, nil)).To(Succeed())
+			Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ShootEventEgressFilterUpdated)))
+
+			By("Sync the unchanged egress filter again")
+			Expect(reconciler.syncClusterResourceToSeed(ctx, shoot, project, nil, nil)).To(Succeed())
+			Expect(recorder.Events).NotTo(Receive())
+
+			By("Remove the egress filter")
+			shoot.Spec.Networking.Egress = nil
+			Expect(reconciler.syncClusterResourceToSeed(ctx, shoot, project, nil, nil)).To(Succeed())
+			Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ShootEventEgressFilterUpdated)))
+		})
+	})
+
+	Describe("#newTaskObserver", func() {
+		It("should report the duration and failures of the flow tasks", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+			observer := newTaskObserver(shoot, "seed")
+
+			failures := gardenletmetrics.ShootFlowTaskFailuresTotal.WithLabelValues("Shoot cluster reconciliation", "task", "production", "seed")
+			failuresBefore := testutil.ToFloat64(failures)
+
+			observer("Shoot cluster reconciliation", "task", 2*time.Second, nil)
+			Expect(testutil.ToFloat64(failures)).To(Equal(failuresBefore))
+
+			observer("Shoot cluster reconciliation", "task", time.Second, errors.New("fake"))
+			Expect(testutil.ToFloat64(failures)).To(Equal(failuresBefore + 1))
+
+			Expect(testutil.CollectAndCount(gardenletmetrics.ShootFlowTaskDurationSeconds)).To(BeNumerically(">=", 1))
+		})
+	})
 })
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// maxReconciliationTimestamps is the maximum number of reconciliation timestamps entries kept in the Shoot status.
+const maxReconciliationTimestamps = 10
+
+// reconciliationTimestampsRecorder records the timestamps of the relevant phases of a Shoot reconciliation. It is safe
+// for concurrent use since the phases are reached by flow tasks running in parallel.
+type reconciliationTimestampsRecorder struct {
+	clock clock.Clock
+
+	lock       sync.Mutex
+	timestamps gardencorev1beta1.ShootReconciliationTimestamps
+}
+
+func newReconciliationTimestampsRecorder(clock clock.Clock, operationType gardencorev1beta1.LastOperationType) *reconciliationTimestampsRecorder {
+	return &reconciliationTimestampsRecorder{
+		clock: clock,
+		timestamps: gardencorev1beta1.ShootReconciliationTimestamps{
+			Type:        operationType,
+			FlowStarted: metav1.NewTime(clock.Now().UTC()),
+		},
+	}
+}
+
+func (r *reconciliationTimestampsRecorder) infrastructureReady() {
+	r.record(&r.timestamps.InfrastructureReady)
+}
+
+func (r *reconciliationTimestampsRecorder) controlPlaneReady() {
+	r.record(&r.timestamps.ControlPlaneReady)
+}
+
+func (r *reconciliationTimestampsRecorder) nodesReady() {
+	r.record(&r.timestamps.NodesReady)
+}
+
+// record sets the given timestamp to the current time unless it was already recorded, i.e., only the first time a
+// phase was reached is kept if the respective task is retried.
+func (r *reconciliationTimestampsRecorder) record(timestamp **metav1.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if *timestamp == nil {
+		now := metav1.NewTime(r.clock.Now().UTC())
+		*timestamp = &now
+	}
+}
+
+// finish marks the reconciliation as finished with the given state and returns the recorded timestamps.
+func (r *reconciliationTimestampsRecorder) finish(state gardencorev1beta1.LastOperationState) gardencorev1beta1.ShootReconciliationTimestamps {
+	r.record(&r.timestamps.Finished)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.timestamps.State = state
+	return *r.timestamps.DeepCopy()
+}
+
+// appendReconciliationTimestamps appends the given entry to the list of reconciliation timestamps and only keeps the
+// newest entries.
+func appendReconciliationTimestamps(list []gardencorev1beta1.ShootReconciliationTimestamps, entry gardencorev1beta1.ShootReconciliationTimestamps) []gardencorev1beta1.ShootReconciliationTimestamps {
+	list = append(list, entry)
+	if len(list) > maxReconciliationTimestamps {
+		list = list[len(list)-maxReconciliationTimestamps:]
+	}
+	return list
+}
+
+func (r *Reconciler) patchShootStatusReconciliationTimestamps(ctx context.Context, shoot *gardencorev1beta1.Shoot, timestamps gardencorev1beta1.ShootReconciliationTimestamps) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.ReconciliationTimestamps = appendReconciliationTimestamps(shoot.Status.ReconciliationTimestamps, timestamps)
+	return r.GardenClient.Status().Patch(ctx, shoot, patch)
+}
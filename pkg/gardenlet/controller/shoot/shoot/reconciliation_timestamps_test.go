@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("ReconciliationTimestamps", func() {
+	var (
+		fakeClock *testclock.FakeClock
+		start     time.Time
+	)
+
+	BeforeEach(func() {
+		start = time.Date(2024, 5, 14, 19, 59, 39, 0, time.UTC)
+		fakeClock = testclock.NewFakeClock(start)
+	})
+
+	Describe("#reconciliationTimestampsRecorder", func() {
+		It("should record the timestamps of the reached phases", func() {
+			recorder := newReconciliationTimestampsRecorder(fakeClock, gardencorev1beta1.LastOperationTypeCreate)
+
+			fakeClock.Step(time.Minute)
+			recorder.infrastructureReady()
+			fakeClock.Step(time.Minute)
+			recorder.controlPlaneReady()
+			fakeClock.Step(time.Minute)
+			recorder.nodesReady()
+			fakeClock.Step(time.Minute)
+
+			Expect(recorder.finish(gardencorev1beta1.LastOperationStateSucceeded)).To(Equal(gardencorev1beta1.ShootReconciliationTimestamps{
+				Type:                gardencorev1beta1.LastOperationTypeCreate,
+				FlowStarted:         metav1.NewTime(start),
+				InfrastructureReady: ptr.To(metav1.NewTime(start.Add(time.Minute))),
+				ControlPlaneReady:   ptr.To(metav1.NewTime(start.Add(2 * time.Minute))),
+				NodesReady:          ptr.To(metav1.NewTime(start.Add(3 * time.Minute))),
+				Finished:            ptr.To(metav1.NewTime(start.Add(4 * time.Minute))),
+				State:               gardencorev1beta1.LastOperationStateSucceeded,
+			}))
+		})
+
+		It("should only keep the first time a phase was reached", func() {
+			recorder := newReconciliationTimestampsRecorder(fakeClock, gardencorev1beta1.LastOperationTypeReconcile)
+
+			fakeClock.Step(time.Minute)
+			recorder.infrastructureReady()
+			fakeClock.Step(time.Minute)
+			recorder.infrastructureReady()
+
+			Expect(recorder.finish(gardencorev1beta1.LastOperationStateError)).To(Equal(gardencorev1beta1.ShootReconciliationTimestamps{
+				Type:                gardencorev1beta1.LastOperationTypeReconcile,
+				FlowStarted:         metav1.NewTime(start),
+				InfrastructureReady: ptr.To(metav1.NewTime(start.Add(time.Minute))),
+				Finished:            ptr.To(metav1.NewTime(start.Add(2 * time.Minute))),
+				State:               gardencorev1beta1.LastOperationStateError,
+			}))
+		})
+	})
+
+	Describe("#appendReconciliationTimestamps", func() {
+		It("should append the entry", func() {
+			entry := gardencorev1beta1.ShootReconciliationTimestamps{FlowStarted: metav1.NewTime(start)}
+
+			Expect(appendReconciliationTimestamps(nil, entry)).To(ConsistOf(entry))
+		})
+
+		It("should only keep the newest entries", func() {
+			var list []gardencorev1beta1.ShootReconciliationTimestamps
+			for i := range maxReconciliationTimestamps + 2 {
+				list = appendReconciliationTimestamps(list, gardencorev1beta1.ShootReconciliationTimestamps{FlowStarted: metav1.NewTime(start.Add(time.Duration(i) * time.Hour))})
+			}
+
+			Expect(list).To(HaveLen(maxReconciliationTimestamps))
+			Expect(list[0].FlowStarted).To(Equal(metav1.NewTime(start.Add(2 * time.Hour))))
+			Expect(list[maxReconciliationTimestamps-1].FlowStarted).To(Equal(metav1.NewTime(start.Add(time.Duration(maxReconciliationTimestamps+1) * time.Hour))))
+		})
+	})
+
+	Describe("#patchShootStatusReconciliationTimestamps", func() {
+		It("should append the timestamps to the Shoot status", func() {
+			var (
+				ctx          = context.Background()
+				gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+				reconciler   = &Reconciler{GardenClient: gardenClient, Clock: fakeClock}
+				existing     = gardencorev1beta1.ShootReconciliationTimestamps{Type: gardencorev1beta1.LastOperationTypeCreate, FlowStarted: metav1.NewTime(start.Add(-time.Hour))}
+				entry        = gardencorev1beta1.ShootReconciliationTimestamps{Type: gardencorev1beta1.LastOperationTypeReconcile, FlowStarted: metav1.NewTime(start)}
+				shoot        = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-local"}}
+			)
+
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+			shoot.Status.ReconciliationTimestamps = []gardencorev1beta1.ShootReconciliationTimestamps{existing}
+			Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.patchShootStatusReconciliationTimestamps(ctx, shoot, entry)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.ReconciliationTimestamps).To(HaveLen(2))
+			Expect(shoot.Status.ReconciliationTimestamps[0].Type).To(Equal(gardencorev1beta1.LastOperationTypeCreate))
+			Expect(shoot.Status.ReconciliationTimestamps[1].Type).To(Equal(gardencorev1beta1.LastOperationTypeReconcile))
+		})
+	})
+})
@@ -29,4 +29,46 @@ var (
 			"hibernated",
 		},
 	)
+	// ShootOperationErrorCodesTotal defines the counter shoot_operation_error_codes_total.
+	ShootOperationErrorCodesTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "shoot_operation_error_codes_total",
+			Help:      "Number of failed shoot operations per reported error code.",
+		},
+		[]string{
+			"operation",
+			"code",
+			"user_error",
+		},
+	)
+	// ShootFlowTaskDurationSeconds defines the histogram shoot_flow_task_duration_seconds.
+	ShootFlowTaskDurationSeconds = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "shoot_flow_task_duration_seconds",
+			Help:      "Duration of the tasks of shoot flows in seconds.",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 3, 10),
+		},
+		[]string{
+			"flow",
+			"task",
+			"purpose",
+			"seed",
+		},
+	)
+	// ShootFlowTaskFailuresTotal defines the counter shoot_flow_task_failures_total.
+	ShootFlowTaskFailuresTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "shoot_flow_task_failures_total",
+			Help:      "Number of failed tasks of shoot flows.",
+		},
+		[]string{
+			"flow",
+			"task",
+			"purpose",
+			"seed",
+		},
+	)
 )
@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package offlinebundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"sigs.k8s.io/yaml"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+const (
+	// MediaTypeBundle is the media type of the layer containing the bundle tarball in an OCI artifact.
+	MediaTypeBundle = "application/vnd.gardener.offline-bundle.v1.tar+gzip"
+
+	// FileContainers is the path of the image vector override for the container images in the bundle tarball.
+	FileContainers = "imagevector/containers.yaml"
+	// FileCharts is the path of the image vector override for the Helm chart images in the bundle tarball.
+	FileCharts = "imagevector/charts.yaml"
+	// FileComponents is the path of the component-specific image vector overrides in the bundle tarball.
+	FileComponents = "imagevector/components.yaml"
+	// FileChartIndex is the path of the index of the Helm charts in the bundle tarball.
+	FileChartIndex = "charts/index.yaml"
+)
+
+// ChartIndex is the index of the Helm charts contained in a bundle.
+type ChartIndex struct {
+	// Charts is the list of Helm charts contained in the bundle.
+	Charts []ChartIndexEntry `json:"charts"`
+}
+
+// ChartIndexEntry is a Helm chart contained in a bundle.
+type ChartIndexEntry struct {
+	// Ref is the reference of the OCI repository of the chart, e.g. `example.com/charts/foo:v1.0.0`.
+	Ref string `json:"ref"`
+	// Path is the path of the chart archive in the bundle tarball.
+	Path string `json:"path"`
+}
+
+// Bundle contains the image vector overrides and Helm charts of an offline bundle.
+type Bundle struct {
+	// Containers is the image vector override for the container images.
+	Containers imagevectorutils.ImageVector
+	// Charts is the image vector override for the Helm chart images.
+	Charts imagevectorutils.ImageVector
+	// Components are the component-specific image vector overrides.
+	Components imagevectorutils.ComponentImageVectors
+
+	helmCharts map[string][]byte
+}
+
+// Load reads the bundle configured in the given configuration, verifies its signature and returns its content.
+func Load(cfg *gardenletconfigv1alpha1.OfflineBundle) (*Bundle, error) {
+	tarball, err := readTarball(cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading offline bundle %s: %w", cfg.Path, err)
+	}
+
+	signature, err := os.ReadFile(cfg.SignaturePath) // #nosec: G304 -- The offline bundle is a feature. In reality files can be read from the Pod's file system only.
+	if err != nil {
+		return nil, fmt.Errorf("failed reading offline bundle signature: %w", err)
+	}
+
+	publicKey, err := os.ReadFile(cfg.PublicKeyPath) // #nosec: G304 -- The offline bundle is a feature. In reality files can be read from the Pod's file system only.
+	if err != nil {
+		return nil, fmt.Errorf("failed reading public key for offline bundle: %w", err)
+	}
+
+	if err := VerifySignature(tarball, signature, publicKey); err != nil {
+		return nil, fmt.Errorf("failed verifying signature of offline bundle %s: %w", cfg.Path, err)
+	}
+
+	return Parse(tarball)
+}
+
+// Parse parses the given (gzip-compressed) bundle tarball. The signature of the tarball is not verified.
+func Parse(tarball []byte) (*Bundle, error) {
+	files, err := extract(tarball)
+	if err != nil {
+		return nil, fmt.Errorf("failed extracting offline bundle: %w", err)
+	}
+
+	bundle := &Bundle{}
+
+	if data, ok := files[FileContainers]; ok {
+		if bundle.Containers, err = imagevectorutils.Read(data); err != nil {
+			return nil, fmt.Errorf("failed reading %s of offline bundle: %w", FileContainers, err)
+		}
+	}
+
+	if data, ok := files[FileCharts]; ok {
+		if bundle.Charts, err = imagevectorutils.Read(data); err != nil {
+			return nil, fmt.Errorf("failed reading %s of offline bundle: %w", FileCharts, err)
+		}
+	}
+
+	if data, ok := files[FileComponents]; ok {
+		if bundle.Components, err = imagevectorutils.ReadComponentOverwrite(data); err != nil {
+			return nil, fmt.Errorf("failed reading %s of offline bundle: %w", FileComponents, err)
+		}
+	}
+
+	if data, ok := files[FileChartIndex]; ok {
+		index := &ChartIndex{}
+		if err := yaml.Unmarshal(data, index); err != nil {
+			return nil, fmt.Errorf("failed reading %s of offline bundle: %w", FileChartIndex, err)
+		}
+
+		bundle.helmCharts = make(map[string][]byte, len(index.Charts))
+		for _, chart := range index.Charts {
+			archive, ok := files[path.Clean(chart.Path)]
+			if !ok {
+				return nil, fmt.Errorf("chart archive %s for %s listed in %s is not contained in offline bundle", chart.Path, chart.Ref, FileChartIndex)
+			}
+			bundle.helmCharts[chart.Ref] = archive
+		}
+	}
+
+	return bundle, nil
+}
+
+// MergeComponentImageVectors returns the given component-specific image vector overrides merged with the ones of the
+// bundle. The overrides of the bundle take precedence. It is safe to call this method on a nil Bundle.
+func (b *Bundle) MergeComponentImageVectors(componentImageVectors imagevectorutils.ComponentImageVectors) imagevectorutils.ComponentImageVectors {
+	if b == nil || len(b.Components) == 0 {
+		return componentImageVectors
+	}
+
+	out := make(imagevectorutils.ComponentImageVectors, len(componentImageVectors)+len(b.Components))
+	maps.Copy(out, componentImageVectors)
+	maps.Copy(out, b.Components)
+	return out
+}
+
+// HelmRegistry returns an oci.Interface which serves the Helm charts contained in the bundle. Charts which are not
+// contained in the bundle are pulled via the given fallback. It is safe to call this method on a nil Bundle.
+func (b *Bundle) HelmRegistry(fallback oci.Interface) oci.Interface {
+	if b == nil || len(b.helmCharts) == 0 {
+		return fallback
+	}
+	return &helmRegistry{charts: b.helmCharts, fallback: fallback}
+}
+
+type helmRegistry struct {
+	charts   map[string][]byte
+	fallback oci.Interface
+}
+
+func (r *helmRegistry) Pull(ctx context.Context, repository *gardencorev1.OCIRepository) ([]byte, error) {
+	if archive, ok := r.charts[repository.GetURL()]; ok {
+		return archive, nil
+	}
+
+	if r.fallback == nil {
+		return nil, fmt.Errorf("chart %s is not contained in offline bundle", repository.GetURL())
+	}
+	return r.fallback.Pull(ctx, repository)
+}
+
+// readTarball reads the bundle tarball from the given path. If the path is a directory, it is expected to be in the OCI
+// image layout and the tarball is read from the layer with media type MediaTypeBundle.
+func readTarball(bundlePath string) ([]byte, error) {
+	info, err := os.Stat(bundlePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return os.ReadFile(bundlePath) // #nosec: G304 -- The offline bundle is a feature. In reality files can be read from the Pod's file system only.
+	}
+
+	index, err := layout.ImageIndexFromPath(bundlePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading OCI image layout: %w", err)
+	}
+
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed reading OCI image index: %w", err)
+	}
+
+	for _, descriptor := range indexManifest.Manifests {
+		image, err := index.Image(descriptor.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading OCI image %s: %w", descriptor.Digest, err)
+		}
+
+		layers, err := image.Layers()
+		if err != nil {
+			return nil, fmt.Errorf("failed reading layers of OCI image %s: %w", descriptor.Digest, err)
+		}
+
+		for _, layer := range layers {
+			mediaType, err := layer.MediaType()
+			if err != nil {
+				return nil, err
+			}
+			if mediaType != MediaTypeBundle {
+				continue
+			}
+
+			blob, err := layer.Compressed()
+			if err != nil {
+				return nil, fmt.Errorf("failed reading bundle layer: %w", err)
+			}
+			defer blob.Close()
+
+			return io.ReadAll(blob)
+		}
+	}
+
+	return nil, fmt.Errorf("no layer with media type %s found in OCI image layout", MediaTypeBundle)
+}
+
+// extract returns the regular files contained in the given (gzip-compressed) tarball by their cleaned paths.
+func extract(tarball []byte) (map[string][]byte, error) {
+	var reader io.Reader = bytes.NewReader(tarball)
+
+	if bytes.HasPrefix(tarball, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	var (
+		files     = map[string][]byte{}
+		tarReader = tar.NewReader(reader)
+	)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tarReader) // #nosec: G110 -- The content of the bundle is trusted since its signature was verified.
+		if err != nil {
+			return nil, fmt.Errorf("failed reading %s: %w", header.Name, err)
+		}
+		files[path.Clean(header.Name)] = data
+	}
+}
@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package offlinebundle_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	. "github.com/gardener/gardener/pkg/gardenlet/offlinebundle"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	ocifake "github.com/gardener/gardener/pkg/utils/oci/fake"
+)
+
+var _ = Describe("Bundle", func() {
+	var (
+		ctx = context.Background()
+
+		containersYAML = `images:
+- name: foo
+  repository: registry.local/foo
+  tag: v1.0.0
+`
+		chartsYAML = `images:
+- name: bar
+  repository: registry.local/charts/bar
+  tag: v2.0.0
+`
+		componentsYAML = `components:
+- name: etcd-druid
+  imageVectorOverwrite: |
+    images:
+    - name: etcd
+      repository: registry.local/etcd
+      tag: v3.0.0
+`
+		chartIndexYAML = `charts:
+- ref: example.com/charts/bar:v2.0.0
+  path: charts/bar-v2.0.0.tgz
+`
+		chartArchive = []byte("chart-archive")
+
+		files map[string][]byte
+	)
+
+	BeforeEach(func() {
+		files = map[string][]byte{
+			FileContainers:          []byte(containersYAML),
+			FileCharts:              []byte(chartsYAML),
+			FileComponents:          []byte(componentsYAML),
+			FileChartIndex:          []byte(chartIndexYAML),
+			"charts/bar-v2.0.0.tgz": chartArchive,
+		}
+	})
+
+	Describe("#Parse", func() {
+		It("should parse a gzip-compressed tarball", func() {
+			bundle, err := Parse(createTarball(files, true))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bundle.Containers).To(ConsistOf(&imagevectorutils.ImageSource{Name: "foo", Repository: ptr.To("registry.local/foo"), Tag: ptr.To("v1.0.0")}))
+			Expect(bundle.Charts).To(ConsistOf(&imagevectorutils.ImageSource{Name: "bar", Repository: ptr.To("registry.local/charts/bar"), Tag: ptr.To("v2.0.0")}))
+			Expect(bundle.Components).To(HaveKeyWithValue("etcd-druid", ContainSubstring("registry.local/etcd")))
+		})
+
+		It("should parse an uncompressed tarball", func() {
+			bundle, err := Parse(createTarball(files, false))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bundle.Containers).To(HaveLen(1))
+		})
+
+		It("should parse an empty tarball", func() {
+			bundle, err := Parse(createTarball(nil, true))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bundle.Containers).To(BeEmpty())
+			Expect(bundle.Charts).To(BeEmpty())
+			Expect(bundle.Components).To(BeEmpty())
+		})
+
+		It("should fail if an image vector is invalid", func() {
+			files[FileContainers] = []byte("images: foo")
+
+			_, err := Parse(createTarball(files, true))
+			Expect(err).To(MatchError(ContainSubstring("failed reading " + FileContainers)))
+		})
+
+		It("should fail if a chart listed in the index is missing", func() {
+			delete(files, "charts/bar-v2.0.0.tgz")
+
+			_, err := Parse(createTarball(files, true))
+			Expect(err).To(MatchError(ContainSubstring("chart archive charts/bar-v2.0.0.tgz for example.com/charts/bar:v2.0.0 listed in charts/index.yaml is not contained in offline bundle")))
+		})
+
+		It("should fail if the data is not a tarball", func() {
+			_, err := Parse([]byte("foo"))
+			Expect(err).To(MatchError(ContainSubstring("failed extracting offline bundle")))
+		})
+	})
+
+	Describe("#Load", func() {
+		var (
+			dir        string
+			privateKey ed25519.PrivateKey
+			cfg        *gardenletconfigv1alpha1.OfflineBundle
+		)
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+
+			var publicKey ed25519.PublicKey
+			var err error
+			publicKey, privateKey, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg = &gardenletconfigv1alpha1.OfflineBundle{
+				Path:          filepath.Join(dir, "bundle.tar.gz"),
+				SignaturePath: filepath.Join(dir, "bundle.tar.gz.sig"),
+				PublicKeyPath: filepath.Join(dir, "key.pub"),
+			}
+			Expect(os.WriteFile(cfg.PublicKeyPath, encodePublicKey(publicKey), 0600)).To(Succeed())
+		})
+
+		It("should load a signed tarball", func() {
+			tarball := createTarball(files, true)
+			Expect(os.WriteFile(cfg.Path, tarball, 0600)).To(Succeed())
+			Expect(os.WriteFile(cfg.SignaturePath, ed25519.Sign(privateKey, tarball), 0600)).To(Succeed())
+
+			bundle, err := Load(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bundle.Containers).To(HaveLen(1))
+		})
+
+		It("should load a signed bundle in the OCI image layout", func() {
+			tarball := createTarball(files, true)
+
+			cfg.Path = filepath.Join(dir, "oci")
+			ociLayout, err := layout.Write(cfg.Path, empty.Index)
+			Expect(err).NotTo(HaveOccurred())
+			image, err := mutate.AppendLayers(empty.Image, static.NewLayer(tarball, MediaTypeBundle))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ociLayout.AppendImage(image)).To(Succeed())
+			Expect(os.WriteFile(cfg.SignaturePath, ed25519.Sign(privateKey, tarball), 0600)).To(Succeed())
+
+			bundle, err := Load(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bundle.Charts).To(HaveLen(1))
+		})
+
+		It("should fail if the OCI image layout does not contain a bundle layer", func() {
+			cfg.Path = filepath.Join(dir, "oci")
+			ociLayout, err := layout.Write(cfg.Path, empty.Index)
+			Expect(err).NotTo(HaveOccurred())
+			image, err := mutate.AppendLayers(empty.Image, static.NewLayer([]byte("foo"), "application/octet-stream"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ociLayout.AppendImage(image)).To(Succeed())
+
+			_, err = Load(cfg)
+			Expect(err).To(MatchError(ContainSubstring("no layer with media type " + MediaTypeBundle + " found")))
+		})
+
+		It("should fail if the signature is invalid", func() {
+			tarball := createTarball(files, true)
+			Expect(os.WriteFile(cfg.Path, tarball, 0600)).To(Succeed())
+			Expect(os.WriteFile(cfg.SignaturePath, ed25519.Sign(privateKey, []byte("foo")), 0600)).To(Succeed())
+
+			_, err := Load(cfg)
+			Expect(err).To(MatchError(ContainSubstring("failed verifying signature of offline bundle")))
+		})
+
+		It("should fail if the bundle does not exist", func() {
+			_, err := Load(cfg)
+			Expect(err).To(MatchError(ContainSubstring("failed reading offline bundle")))
+		})
+	})
+
+	Describe("#MergeComponentImageVectors", func() {
+		It("should return the given image vectors for a nil bundle", func() {
+			var bundle *Bundle
+			Expect(bundle.MergeComponentImageVectors(imagevectorutils.ComponentImageVectors{"foo": "bar"})).To(Equal(imagevectorutils.ComponentImageVectors{"foo": "bar"}))
+		})
+
+		It("should merge the image vectors and prefer the ones of the bundle", func() {
+			bundle := &Bundle{Components: imagevectorutils.ComponentImageVectors{"foo": "baz", "etcd-druid": "etcd"}}
+
+			Expect(bundle.MergeComponentImageVectors(imagevectorutils.ComponentImageVectors{"foo": "bar", "bar": "foo"})).To(Equal(imagevectorutils.ComponentImageVectors{
+				"foo":        "baz",
+				"bar":        "foo",
+				"etcd-druid": "etcd",
+			}))
+		})
+	})
+
+	Describe("#HelmRegistry", func() {
+		var (
+			fallback      *ocifake.Registry
+			bundledChart  = &gardencorev1.OCIRepository{Ref: ptr.To("example.com/charts/bar:v2.0.0")}
+			fallbackChart = &gardencorev1.OCIRepository{Ref: ptr.To("example.com/charts/foo:v1.0.0")}
+		)
+
+		BeforeEach(func() {
+			fallback = ocifake.NewRegistry()
+			fallback.AddArtifact(fallbackChart, []byte("fallback-archive"))
+		})
+
+		It("should return the fallback for a nil bundle", func() {
+			var bundle *Bundle
+			Expect(bundle.HelmRegistry(fallback)).To(BeIdenticalTo(fallback))
+		})
+
+		It("should serve charts from the bundle and fall back for all other charts", func() {
+			bundle, err := Parse(createTarball(files, true))
+			Expect(err).NotTo(HaveOccurred())
+			registry := bundle.HelmRegistry(fallback)
+
+			Expect(registry.Pull(ctx, bundledChart)).To(Equal(chartArchive))
+			Expect(registry.Pull(ctx, fallbackChart)).To(Equal([]byte("fallback-archive")))
+		})
+
+		It("should fail for charts not contained in the bundle without fallback", func() {
+			bundle, err := Parse(createTarball(files, true))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = bundle.HelmRegistry(nil).Pull(ctx, fallbackChart)
+			Expect(err).To(MatchError("chart example.com/charts/foo:v1.0.0 is not contained in offline bundle"))
+		})
+	})
+})
+
+func createTarball(files map[string][]byte, compress bool) []byte {
+	var (
+		buf       bytes.Buffer
+		tarWriter *tar.Writer
+		gzWriter  *gzip.Writer
+	)
+
+	if compress {
+		gzWriter = gzip.NewWriter(&buf)
+		tarWriter = tar.NewWriter(gzWriter)
+	} else {
+		tarWriter = tar.NewWriter(&buf)
+	}
+
+	for name, data := range files {
+		ExpectWithOffset(1, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data)), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tarWriter.Write(data)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	ExpectWithOffset(1, tarWriter.Close()).To(Succeed())
+	if gzWriter != nil {
+		ExpectWithOffset(1, gzWriter.Close()).To(Succeed())
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package offlinebundle
+
+import (
+	"encoding/base64"
+	"strings"
+
+	signatureutils "github.com/gardener/gardener/pkg/utils/signature"
+)
+
+// VerifySignature verifies the given signature of the data with the given PEM-encoded public key. RSA (PKCS #1 v1.5)
+// and ECDSA signatures are expected to be created over the SHA-256 digest of the data, Ed25519 signatures over the data
+// itself. The signature can either be binary or base64-encoded.
+func VerifySignature(data, signature, publicKeyPEM []byte) error {
+	publicKey, err := signatureutils.ParsePublicKey(publicKeyPEM)
+	if err != nil {
+		return err
+	}
+
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature))); err == nil {
+		signature = decoded
+	}
+
+	return signatureutils.Verify(publicKey, data, signature)
+}
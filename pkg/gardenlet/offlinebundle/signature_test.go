@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package offlinebundle_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenlet/offlinebundle"
+)
+
+var _ = Describe("Signature", func() {
+	var (
+		data   = []byte("bundle")
+		digest = sha256.Sum256(data)
+	)
+
+	Describe("#VerifySignature", func() {
+		It("should verify an RSA signature", func() {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(VerifySignature(data, signature, encodePublicKey(&key.PublicKey))).To(Succeed())
+			Expect(VerifySignature([]byte("tampered"), signature, encodePublicKey(&key.PublicKey))).To(MatchError(ContainSubstring("invalid signature")))
+		})
+
+		It("should verify an ECDSA signature", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(VerifySignature(data, signature, encodePublicKey(&key.PublicKey))).To(Succeed())
+			Expect(VerifySignature([]byte("tampered"), signature, encodePublicKey(&key.PublicKey))).To(MatchError("invalid signature"))
+		})
+
+		It("should verify an Ed25519 signature", func() {
+			publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			signature := ed25519.Sign(privateKey, data)
+
+			Expect(VerifySignature(data, signature, encodePublicKey(publicKey))).To(Succeed())
+			Expect(VerifySignature([]byte("tampered"), signature, encodePublicKey(publicKey))).To(MatchError("invalid signature"))
+		})
+
+		It("should verify a base64-encoded signature", func() {
+			publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, data))
+
+			Expect(VerifySignature(data, []byte(signature+"\n"), encodePublicKey(publicKey))).To(Succeed())
+		})
+
+		It("should fail if the signature was created with another key", func() {
+			publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			_, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(VerifySignature(data, ed25519.Sign(otherPrivateKey, data), encodePublicKey(publicKey))).To(MatchError("invalid signature"))
+		})
+
+		It("should fail if the public key is not PEM-encoded", func() {
+			Expect(VerifySignature(data, nil, []byte("foo"))).To(MatchError("no PEM-encoded public key found"))
+		})
+
+		It("should fail if the public key cannot be parsed", func() {
+			Expect(VerifySignature(data, nil, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("foo")}))).To(MatchError(ContainSubstring("failed parsing public key")))
+		})
+	})
+})
+
+func encodePublicKey(publicKey any) []byte {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
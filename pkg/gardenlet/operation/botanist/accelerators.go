@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/component/shoot/accelerators"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// acceleratorImageNames maps the accelerator vendors to the name prefix of their driver installer images (which is
+// suffixed with the machine image name) and the name of their device plugin image.
+var acceleratorImageNames = map[gardencorev1beta1.AcceleratorVendor]struct {
+	driverInstallerPrefix string
+	devicePlugin          string
+}{
+	gardencorev1beta1.AcceleratorVendorNVIDIA: {"nvidia-driver-installer-", imagevector.ContainerImageNameNvidiaDevicePlugin},
+	gardencorev1beta1.AcceleratorVendorAMD:    {"amd-gpu-driver-installer-", imagevector.ContainerImageNameAmdGpuDevicePlugin},
+}
+
+// DefaultAccelerators returns a deployer for the drivers and device plugins of hardware accelerators.
+func (b *Botanist) DefaultAccelerators() accelerators.Interface {
+	return accelerators.New(
+		b.SeedClientSet.Client(),
+		b.Shoot.ControlPlaneNamespace,
+	)
+}
+
+// DeployAccelerators deploys the drivers and device plugins for all worker pools with managed accelerator drivers. If
+// there are no such worker pools, it destroys them.
+func (b *Botanist) DeployAccelerators(ctx context.Context) error {
+	var workerPools []accelerators.WorkerPool
+
+	for _, worker := range b.Shoot.GetInfo().Spec.Provider.Workers {
+		if worker.Accelerators == nil || !ptr.Deref(worker.Accelerators.ManagedDriver, false) {
+			continue
+		}
+
+		workerPool, err := acceleratorWorkerPool(worker)
+		if err != nil {
+			return fmt.Errorf("failed computing accelerator driver for worker pool %q: %w", worker.Name, err)
+		}
+		workerPools = append(workerPools, workerPool)
+	}
+
+	if len(workerPools) == 0 {
+		return b.Shoot.Components.SystemComponents.Accelerators.Destroy(ctx)
+	}
+
+	b.Shoot.Components.SystemComponents.Accelerators.SetWorkerPools(workerPools)
+	return b.Shoot.Components.SystemComponents.Accelerators.Deploy(ctx)
+}
+
+func acceleratorWorkerPool(worker gardencorev1beta1.Worker) (accelerators.WorkerPool, error) {
+	imageNames, ok := acceleratorImageNames[worker.Accelerators.Vendor]
+	if !ok {
+		return accelerators.WorkerPool{}, fmt.Errorf("unsupported accelerator vendor %q", worker.Accelerators.Vendor)
+	}
+
+	if worker.Machine.Image == nil || worker.Machine.Image.Version == nil {
+		return accelerators.WorkerPool{}, fmt.Errorf("machine image and its version must be set")
+	}
+
+	var (
+		machineImage        = worker.Machine.Image.Name
+		machineImageVersion = *worker.Machine.Image.Version
+		findOptions         []imagevectorutils.FindOptionFunc
+	)
+
+	if worker.Machine.Architecture != nil {
+		findOptions = append(findOptions, imagevectorutils.Architecture(*worker.Machine.Architecture))
+	}
+
+	// The driver installer images are matched against the machine image version since the drivers must be built for the
+	// kernel of the machine image.
+	driverInstallerImage, err := imagevector.Containers().FindImage(imageNames.driverInstallerPrefix+machineImage, append(findOptions, imagevectorutils.TargetVersion(machineImageVersion))...)
+	if err != nil {
+		return accelerators.WorkerPool{}, fmt.Errorf("no %s driver available for machine image %s in version %s: %w", worker.Accelerators.Vendor, machineImage, machineImageVersion, err)
+	}
+
+	devicePluginImage, err := imagevector.Containers().FindImage(imageNames.devicePlugin, findOptions...)
+	if err != nil {
+		return accelerators.WorkerPool{}, err
+	}
+
+	return accelerators.WorkerPool{
+		Name:                 worker.Name,
+		Vendor:               worker.Accelerators.Vendor,
+		MachineImageVersion:  machineImageVersion,
+		DriverInstallerImage: driverInstallerImage.String(),
+		DevicePluginImage:    devicePluginImage.String(),
+	}, nil
+}
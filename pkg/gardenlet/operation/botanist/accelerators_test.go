@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/shoot/accelerators"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Accelerators", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+
+		fakeClient client.Client
+		botanist   *Botanist
+
+		managedResource *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+			Shoot: &shootpkg.Shoot{
+				ControlPlaneNamespace: namespace,
+				Components: &shootpkg.Components{
+					SystemComponents: &shootpkg.SystemComponents{},
+				},
+			},
+		}}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
+		botanist.Shoot.Components.SystemComponents.Accelerators = botanist.DefaultAccelerators()
+
+		managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: accelerators.ManagedResourceName, Namespace: namespace}}
+	})
+
+	worker := func(name, imageName string, acceleratorsConfig *gardencorev1beta1.WorkerAccelerators) gardencorev1beta1.Worker {
+		return gardencorev1beta1.Worker{
+			Name: name,
+			Machine: gardencorev1beta1.Machine{
+				Image:        &gardencorev1beta1.ShootMachineImage{Name: imageName, Version: ptr.To("1877.3.0")},
+				Architecture: ptr.To("amd64"),
+			},
+			Accelerators: acceleratorsConfig,
+		}
+	}
+
+	Describe("#DeployAccelerators", func() {
+		It("should deploy the drivers for the worker pools with managed accelerator drivers", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+				worker("cpu", "gardenlinux", nil),
+				worker("unmanaged", "gardenlinux", &gardencorev1beta1.WorkerAccelerators{Vendor: gardencorev1beta1.AcceleratorVendorNVIDIA, ManagedDriver: ptr.To(false)}),
+				worker("gpu", "gardenlinux", &gardencorev1beta1.WorkerAccelerators{Vendor: gardencorev1beta1.AcceleratorVendorNVIDIA, ManagedDriver: ptr.To(true)}),
+			}}}})
+
+			Expect(botanist.DeployAccelerators(ctx)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			manifests, err := test.ExtractManifestsFromManagedResourceData(secret.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifests).To(ConsistOf(And(
+				ContainSubstring("name: accelerator-driver-gpu"),
+				ContainSubstring("image: ghcr.io/gardenlinux/gardenlinux-nvidia-installer:"),
+				ContainSubstring("image: nvcr.io/nvidia/k8s-device-plugin:"),
+			)))
+		})
+
+		It("should fail if there is no driver for the machine image", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+				worker("gpu", "foo", &gardencorev1beta1.WorkerAccelerators{Vendor: gardencorev1beta1.AcceleratorVendorAMD, ManagedDriver: ptr.To(true)}),
+			}}}})
+
+			Expect(botanist.DeployAccelerators(ctx)).To(MatchError(ContainSubstring(`failed computing accelerator driver for worker pool "gpu": no amd driver available for machine image foo in version 1877.3.0`)))
+		})
+
+		It("should delete the ManagedResource if there are no worker pools with managed accelerator drivers", func() {
+			Expect(fakeClient.Create(ctx, managedResource)).To(Succeed())
+
+			Expect(botanist.DeployAccelerators(ctx)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+})
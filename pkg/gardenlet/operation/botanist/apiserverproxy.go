@@ -41,6 +41,7 @@ func (b *Botanist) DefaultAPIServerProxy() (apiserverproxy.Interface, error) {
 		ProxySeedServerHost: b.outOfClusterAPIServerFQDN(),
 		DNSLookupFamily:     dnsLookupFamily,
 		IstioTLSTermination: b.ShootUsesIstioTLSTermination(),
+		KonnectivityEnabled: b.ShootUsesKonnectivity(),
 	}
 
 	return apiserverproxy.New(b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.SecretsManager, values), nil
@@ -19,6 +19,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
+	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -69,6 +70,7 @@ var _ = Describe("APIServerProxy", func() {
 					InternalDomain: &gardenerutils.Domain{Provider: "some-provider"},
 				},
 				SeedClientSet: seedClientSet,
+				Seed:          &seedpkg.Seed{},
 				Shoot: &shootpkg.Shoot{
 					Components: &shootpkg.Components{
 						SystemComponents: &shootpkg.SystemComponents{},
@@ -80,6 +82,7 @@ var _ = Describe("APIServerProxy", func() {
 			},
 		}
 
+		botanist.Seed.SetInfo(&gardencorev1beta1.Seed{})
 		botanist.Shoot.SetInfo(shoot)
 		comp, err := botanist.DefaultAPIServerProxy()
 		Expect(err).NotTo(HaveOccurred())
@@ -82,6 +82,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		if err != nil {
 			return nil, err
 		}
+		o.Shoot.Components.Extensions.ShootLeftover = b.DefaultShootLeftover()
 		o.Shoot.Components.Extensions.Worker = b.DefaultWorker()
 	}
 
@@ -200,6 +201,10 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		if err != nil {
 			return nil, err
 		}
+		o.Shoot.Components.SystemComponents.KonnectivityAgent, err = b.DefaultKonnectivityAgent()
+		if err != nil {
+			return nil, err
+		}
 		o.Shoot.Components.SystemComponents.NodeProblemDetector, err = b.DefaultNodeProblemDetector()
 		if err != nil {
 			return nil, err
@@ -212,6 +217,8 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		if err != nil {
 			return nil, err
 		}
+		o.Shoot.Components.SystemComponents.Accelerators = b.DefaultAccelerators()
+		o.Shoot.Components.SystemComponents.VolumeSnapshots = b.DefaultVolumeSnapshots()
 	}
 
 	// other components
@@ -232,6 +239,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		if err != nil {
 			return nil, err
 		}
+		o.Shoot.Components.Addons.ManagedAddons = b.DefaultManagedAddons()
 	}
 
 	return b, nil
@@ -22,9 +22,13 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"k8s.io/kube-aggregator/pkg/controllers/autoregister"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
@@ -162,10 +166,11 @@ func (b *Botanist) CleanWebhooks(ctx context.Context) error {
 	var (
 		c       = b.ShootClientSet.Client()
 		ensurer = utilclient.DefaultGoneEnsurer()
-		ops     = utilclient.NewCleanOps(ensurer, utilclient.DefaultCleaner())
+		policy  = ptr.Deref(b.Shoot.GetInfo().Spec.Cleanup, gardencorev1beta1.Cleanup{}).Webhooks
+		ops     = newForceFinalizingCleanOps(utilclient.NewCleanOps(ensurer, utilclient.DefaultCleaner()), policy)
 	)
 
-	cleanOptions, err := b.getCleanOptions(GracePeriodFiveMinutes, FinalizeAfterFiveMinutes, v1beta1constants.AnnotationShootCleanupWebhooksFinalizeGracePeriodSeconds, 1)
+	cleanOptions, err := b.getCleanOptions(GracePeriodFiveMinutes, FinalizeAfterFiveMinutes, v1beta1constants.AnnotationShootCleanupWebhooksFinalizeGracePeriodSeconds, 1, policy)
 	if err != nil {
 		return err
 	}
@@ -181,10 +186,16 @@ func (b *Botanist) CleanExtendedAPIs(ctx context.Context) error {
 	var (
 		c       = b.ShootClientSet.Client()
 		ensurer = utilclient.DefaultGoneEnsurer()
-		ops     = utilclient.NewCleanOps(ensurer, utilclient.DefaultCleaner())
+		policy  = ptr.Deref(b.Shoot.GetInfo().Spec.Cleanup, gardencorev1beta1.Cleanup{}).ExtendedAPIs
+		ops     = newForceFinalizingCleanOps(utilclient.NewCleanOps(ensurer, utilclient.DefaultCleaner()), policy)
 	)
 
-	cleanOptions, err := b.getCleanOptions(GracePeriodFiveMinutes, FinalizeAfterOneHour, v1beta1constants.AnnotationShootCleanupExtendedAPIsFinalizeGracePeriodSeconds, 0.1)
+	finalizeAfter := FinalizeAfterOneHour
+	if helper.ShootUsesAggressiveCleanup(b.Shoot.GetInfo()) {
+		finalizeAfter = FinalizeAfterFiveMinutes
+	}
+
+	cleanOptions, err := b.getCleanOptions(GracePeriodFiveMinutes, finalizeAfter, v1beta1constants.AnnotationShootCleanupExtendedAPIsFinalizeGracePeriodSeconds, 0.1, policy)
 	if err != nil {
 		return err
 	}
@@ -204,11 +215,13 @@ func (b *Botanist) CleanKubernetesResources(ctx context.Context) error {
 		c                  = b.ShootClientSet.Client()
 		ensurer            = utilclient.DefaultGoneEnsurer()
 		cleaner            = utilclient.DefaultCleaner()
-		ops                = utilclient.NewCleanOps(ensurer, cleaner)
-		snapshotContentOps = utilclient.NewCleanOps(ensurer, cleaner, utilclient.DefaultVolumeSnapshotContentCleaner())
+		cleanup            = ptr.Deref(b.Shoot.GetInfo().Spec.Cleanup, gardencorev1beta1.Cleanup{})
+		ops                = newForceFinalizingCleanOps(utilclient.NewCleanOps(ensurer, cleaner), cleanup.KubernetesResources)
+		snapshotOps        = newForceFinalizingCleanOps(utilclient.NewCleanOps(ensurer, cleaner), cleanup.VolumeSnapshots)
+		snapshotContentOps = newForceFinalizingCleanOps(utilclient.NewCleanOps(ensurer, cleaner, utilclient.DefaultVolumeSnapshotContentCleaner()), cleanup.VolumeSnapshots)
 	)
 
-	cleanOptions, err := b.getCleanOptions(GracePeriodFiveMinutes, FinalizeAfterFiveMinutes, v1beta1constants.AnnotationShootCleanupKubernetesResourcesFinalizeGracePeriodSeconds, 1)
+	cleanOptions, err := b.getCleanOptions(GracePeriodFiveMinutes, FinalizeAfterFiveMinutes, v1beta1constants.AnnotationShootCleanupKubernetesResourcesFinalizeGracePeriodSeconds, 1, cleanup.KubernetesResources)
 	if err != nil {
 		return err
 	}
@@ -218,11 +231,19 @@ func (b *Botanist) CleanKubernetesResources(ctx context.Context) error {
 		return fmt.Errorf("failed to handle cleanup start time: %w", err)
 	}
 
-	if err := b.addAdditionalCleanOptionsForKubernetesCleanup(ctx, cleanOptions, cleanupStartTime, FinalizeAfterFiveMinutes, v1beta1constants.AnnotationShootCleanupKubernetesResourcesFinalizeGracePeriodSeconds); err != nil {
+	namespaces, err := b.addAdditionalCleanOptionsForKubernetesCleanup(ctx, cleanOptions, cleanupStartTime, FinalizeAfterFiveMinutes, v1beta1constants.AnnotationShootCleanupKubernetesResourcesFinalizeGracePeriodSeconds)
+	if err != nil {
 		return fmt.Errorf("failed to add additional clean options %w", err)
 	}
 
-	snapshotCleanOptions, err := b.getCleanOptions(GracePeriodFiveMinutes, FinalizeAfterOneHour, v1beta1constants.AnnotationShootCleanupKubernetesResourcesFinalizeGracePeriodSeconds, 0.5)
+	aggressiveCleanup := helper.ShootUsesAggressiveCleanup(b.Shoot.GetInfo())
+
+	snapshotFinalizeAfter := FinalizeAfterOneHour
+	if aggressiveCleanup {
+		snapshotFinalizeAfter = FinalizeAfterFiveMinutes
+	}
+
+	snapshotCleanOptions, err := b.getCleanOptions(GracePeriodFiveMinutes, snapshotFinalizeAfter, v1beta1constants.AnnotationShootCleanupKubernetesResourcesFinalizeGracePeriodSeconds, 0.5, cleanup.VolumeSnapshots)
 	if err != nil {
 		return err
 	}
@@ -236,6 +257,10 @@ func (b *Botanist) CleanKubernetesResources(ctx context.Context) error {
 		)(ctx)
 	}
 
+	if aggressiveCleanup {
+		return b.cleanKubernetesResourcesAggressively(ctx, ops, snapshotOps, snapshotContentOps, namespaces, cleanOptions, snapshotCleanOptions)
+	}
+
 	return flow.Parallel(
 		cleanResourceFn(ops, b.Logger, c, &batchv1.CronJobList{}, CronJobCleanOption, cleanOptions),
 		cleanResourceFn(ops, b.Logger, c, &appsv1.DaemonSetList{}, DaemonSetCleanOption, cleanOptions),
@@ -250,11 +275,54 @@ func (b *Botanist) CleanKubernetesResources(ctx context.Context) error {
 		cleanResourceFn(ops, b.Logger, c, &corev1.PersistentVolumeClaimList{}, PersistentVolumeClaimCleanOption, cleanOptions),
 		// Cleaning up VolumeSnapshots can take a longer time if many snapshots were taken.
 		// Hence, we only finalize these objects after 1h.
-		cleanResourceFn(ops, b.Logger, c, &volumesnapshotv1.VolumeSnapshotList{}, VolumeSnapshotContentCleanOption, snapshotCleanOptions),
+		cleanResourceFn(snapshotOps, b.Logger, c, &volumesnapshotv1.VolumeSnapshotList{}, VolumeSnapshotContentCleanOption, snapshotCleanOptions),
 		cleanResourceFn(snapshotContentOps, b.Logger, c, &volumesnapshotv1.VolumeSnapshotContentList{}, VolumeSnapshotContentCleanOption, snapshotCleanOptions),
 	)(ctx)
 }
 
+// AggressiveCleanupNamespaceConcurrency is the maximum number of namespaces whose workloads are cleaned up in parallel
+// in the aggressive cleanup mode.
+var AggressiveCleanupNamespaceConcurrency = 10
+
+// cleanKubernetesResourcesAggressively cleans up load balancers, volumes and the workloads in all namespaces at the same
+// time. The workloads are cleaned up per namespace with bounded concurrency to limit the load on the shoot API server.
+func (b *Botanist) cleanKubernetesResourcesAggressively(
+	ctx context.Context,
+	ops, snapshotOps, snapshotContentOps utilclient.CleanOps,
+	namespaces []string,
+	cleanOptions, snapshotCleanOptions *utilclient.CleanOptions,
+) error {
+	c := b.ShootClientSet.Client()
+
+	namespaceFns := make([]flow.TaskFn, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		inNamespace := utilclient.ListWith{client.InNamespace(namespace)}
+
+		namespaceFns = append(namespaceFns, flow.Parallel(
+			cleanResourceFn(ops, b.Logger, c, &batchv1.CronJobList{}, CronJobCleanOption, inNamespace, cleanOptions),
+			cleanResourceFn(ops, b.Logger, c, &appsv1.DaemonSetList{}, DaemonSetCleanOption, inNamespace, cleanOptions),
+			cleanResourceFn(ops, b.Logger, c, &appsv1.DeploymentList{}, DeploymentCleanOption, inNamespace, cleanOptions),
+			cleanResourceFn(ops, b.Logger, c, &networkingv1.IngressList{}, IngressCleanOption, inNamespace, cleanOptions),
+			cleanResourceFn(ops, b.Logger, c, &batchv1.JobList{}, JobCleanOption, inNamespace, cleanOptions),
+			cleanResourceFn(ops, b.Logger, c, &corev1.PodList{}, PodCleanOption, inNamespace, cleanOptions),
+			cleanResourceFn(ops, b.Logger, c, &appsv1.ReplicaSetList{}, ReplicaSetCleanOption, inNamespace, cleanOptions),
+			cleanResourceFn(ops, b.Logger, c, &corev1.ReplicationControllerList{}, ReplicationControllerCleanOption, inNamespace, cleanOptions),
+			cleanResourceFn(ops, b.Logger, c, &appsv1.StatefulSetList{}, StatefulSetCleanOption, inNamespace, cleanOptions),
+		))
+	}
+
+	return flow.Parallel(
+		// load balancers
+		cleanResourceFn(ops, b.Logger, c, &corev1.ServiceList{}, ServiceCleanOption, cleanOptions),
+		// volumes
+		cleanResourceFn(ops, b.Logger, c, &corev1.PersistentVolumeClaimList{}, PersistentVolumeClaimCleanOption, cleanOptions),
+		cleanResourceFn(snapshotOps, b.Logger, c, &volumesnapshotv1.VolumeSnapshotList{}, VolumeSnapshotContentCleanOption, snapshotCleanOptions),
+		cleanResourceFn(snapshotContentOps, b.Logger, c, &volumesnapshotv1.VolumeSnapshotContentList{}, VolumeSnapshotContentCleanOption, snapshotCleanOptions),
+		// workloads
+		flow.ParallelN(AggressiveCleanupNamespaceConcurrency, namespaceFns...),
+	)(ctx)
+}
+
 // addAndGetCleanupStartTime adds the cleanup start time annotation to the shoot namespace if not already present
 // and returns the timestamp.
 func (b *Botanist) addAndGetCleanupStartTime(ctx context.Context) (*time.Time, error) {
@@ -295,13 +363,16 @@ func (b *Botanist) addAdditionalCleanOptionsForKubernetesCleanup(
 	cleanupStartTime *time.Time,
 	defaultFinalizeAfter utilclient.FinalizeGracePeriodSeconds,
 	customFinalizeAfterAnnotation string,
-) error {
+) (
+	[]string,
+	error,
+) {
 	// Kubernetes objects in unknown namespaces should be ignored.
 	// Finalizing such objects will fail and block the cleanup process indefinitely.
 	// See https://github.com/gardener/gardener/issues/13847 for more information.
 	namespaceList := &corev1.NamespaceList{}
 	if err := b.ShootClientSet.Client().List(ctx, namespaceList); err != nil {
-		return fmt.Errorf("could not list namespaces: %w", err)
+		return nil, fmt.Errorf("could not list namespaces: %w", err)
 	}
 	namespaces := make([]string, 0, len(namespaceList.Items))
 	for _, ns := range namespaceList.Items {
@@ -317,16 +388,19 @@ func (b *Botanist) addAdditionalCleanOptionsForKubernetesCleanup(
 	if v, ok := b.Shoot.GetInfo().Annotations[customFinalizeAfterAnnotation]; ok {
 		seconds, err := strconv.Atoi(v)
 		if err != nil {
-			return fmt.Errorf("could not parse finalize seconds from annotation %s: %w", customFinalizeAfterAnnotation, err)
+			return nil, fmt.Errorf("could not parse finalize seconds from annotation %s: %w", customFinalizeAfterAnnotation, err)
 		}
 		finalizeSeconds = int64(seconds)
 	}
+	if policy := ptr.Deref(b.Shoot.GetInfo().Spec.Cleanup, gardencorev1beta1.Cleanup{}).KubernetesResources; policy != nil && policy.FinalizeGracePeriod != nil {
+		finalizeSeconds = int64(policy.FinalizeGracePeriod.Seconds())
+	}
 
 	cleanOptions.IgnoreLeftovers = append(cleanOptions.IgnoreLeftovers,
 		utilclient.IgnoreObjectsCreatedAfter(cleanupStartTime.Add(time.Duration(finalizeSeconds)*time.Second)),
 	)
 
-	return nil
+	return namespaces, nil
 }
 
 func (b *Botanist) getCleanOptions(
@@ -334,6 +408,7 @@ func (b *Botanist) getCleanOptions(
 	defaultFinalizeAfter utilclient.FinalizeGracePeriodSeconds,
 	annotationKey string,
 	gracePeriodSecondsFactor float64,
+	policy *gardencorev1beta1.CleanupPolicy,
 ) (
 	*utilclient.CleanOptions,
 	error,
@@ -355,9 +430,52 @@ func (b *Botanist) getCleanOptions(
 		}
 	}
 
+	// The cleanup policy in the Shoot specification takes precedence over the annotation.
+	if policy != nil && policy.FinalizeGracePeriod != nil {
+		seconds := int64(policy.FinalizeGracePeriod.Seconds())
+
+		gracePeriodSeconds = defaultGracePeriodSeconds
+		if seconds < int64(defaultFinalizeAfter) {
+			gracePeriodSeconds = client.GracePeriodSeconds(int(float64(seconds) * gracePeriodSecondsFactor))
+		}
+		finalizeAfter = utilclient.FinalizeGracePeriodSeconds(seconds)
+	}
+
 	cleanOpts := &utilclient.CleanOptions{}
 	utilclient.DeleteWith{gracePeriodSeconds}.ApplyToClean(cleanOpts)
 	finalizeAfter.ApplyToClean(cleanOpts)
 
 	return cleanOpts, nil
 }
+
+// forceFinalizingCleanOps finalizes the resources of the given kinds right after their deletion was requested.
+type forceFinalizingCleanOps struct {
+	utilclient.CleanOps
+	kinds sets.Set[schema.GroupKind]
+}
+
+func newForceFinalizingCleanOps(ops utilclient.CleanOps, policy *gardencorev1beta1.CleanupPolicy) utilclient.CleanOps {
+	if policy == nil || len(policy.ForceFinalize) == 0 {
+		return ops
+	}
+
+	kinds := sets.New[schema.GroupKind]()
+	for _, kind := range policy.ForceFinalize {
+		kinds.Insert(schema.GroupKind{Group: kind.Group, Kind: kind.Kind})
+	}
+
+	return &forceFinalizingCleanOps{CleanOps: ops, kinds: kinds}
+}
+
+func (o *forceFinalizingCleanOps) CleanAndEnsureGone(ctx context.Context, log logr.Logger, c client.Client, obj runtime.Object, opts ...utilclient.CleanOption) error {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return fmt.Errorf("could not get GroupVersionKind from object %v: %w", obj, err)
+	}
+
+	if o.kinds.Has(schema.GroupKind{Group: gvk.Group, Kind: strings.TrimSuffix(gvk.Kind, "List")}) {
+		opts = append(opts, utilclient.FinalizeGracePeriodSeconds(0))
+	}
+
+	return o.CleanOps.CleanAndEnsureGone(ctx, log, c, obj, opts...)
+}
@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Cleanup", func() {
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		fakeClient client.Client
+		botanist   *Botanist
+		shoot      *gardencorev1beta1.Shoot
+
+		webhookConfiguration *admissionregistrationv1.ValidatingWebhookConfiguration
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+		DeferCleanup(cancel)
+
+		// The webhook configuration is stuck in deletion because of a finalizer of a third-party controller.
+		webhookConfiguration = &admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "third-party",
+				Finalizers:        []string{"third-party.example.com/finalizer"},
+				DeletionTimestamp: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			},
+		}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(webhookConfiguration).Build()
+
+		shoot = &gardencorev1beta1.Shoot{}
+		botanist = &Botanist{Operation: &operation.Operation{
+			Logger:         logr.Discard(),
+			ShootClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+			Shoot:          &shootpkg.Shoot{},
+		}}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	Describe("#CleanWebhooks", func() {
+		It("should not finalize the resources before the default finalize grace period", func() {
+			Expect(botanist.CleanWebhooks(ctx)).NotTo(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(webhookConfiguration), webhookConfiguration)).To(Succeed())
+		})
+
+		It("should finalize the resources after the finalize grace period of the cleanup policy", func() {
+			shoot.Spec.Cleanup = &gardencorev1beta1.Cleanup{
+				Webhooks: &gardencorev1beta1.CleanupPolicy{FinalizeGracePeriod: &metav1.Duration{Duration: 30 * time.Second}},
+			}
+
+			Expect(botanist.CleanWebhooks(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(webhookConfiguration), webhookConfiguration)).To(BeNotFoundError())
+		})
+
+		It("should force-finalize the resources of the kinds listed in the cleanup policy", func() {
+			shoot.Spec.Cleanup = &gardencorev1beta1.Cleanup{
+				Webhooks: &gardencorev1beta1.CleanupPolicy{ForceFinalize: []metav1.GroupKind{{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"}}},
+			}
+
+			Expect(botanist.CleanWebhooks(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(webhookConfiguration), webhookConfiguration)).To(BeNotFoundError())
+		})
+
+		It("should not force-finalize the resources of other kinds", func() {
+			shoot.Spec.Cleanup = &gardencorev1beta1.Cleanup{
+				Webhooks: &gardencorev1beta1.CleanupPolicy{ForceFinalize: []metav1.GroupKind{{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"}}},
+			}
+
+			Expect(botanist.CleanWebhooks(ctx)).NotTo(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(webhookConfiguration), webhookConfiguration)).To(Succeed())
+		})
+	})
+
+	Describe("#CleanKubernetesResources", func() {
+		var (
+			seedNamespace *corev1.Namespace
+			namespaces    []string
+			objects       []client.Object
+
+			volumeSnapshot *volumesnapshotv1.VolumeSnapshot
+		)
+
+		BeforeEach(func() {
+			seedNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar", UID: "1"}}
+			botanist.SeedNamespaceObject = seedNamespace
+			botanist.SeedClientSet = fakekubernetes.NewClientSetBuilder().WithClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(seedNamespace).Build()).Build()
+			botanist.Clock = clock.RealClock{}
+
+			namespaces = []string{"default", "foo", "bar"}
+			objects = nil
+			for _, namespace := range namespaces {
+				objects = append(objects,
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}},
+					&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: namespace}},
+					&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: namespace}},
+				)
+			}
+			objects = append(objects,
+				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "load-balancer", Namespace: "foo"}, Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}},
+				&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "volume", Namespace: "foo"}},
+			)
+
+			// The volume snapshot is stuck in deletion because of a finalizer of the snapshot controller.
+			volumeSnapshot = &volumesnapshotv1.VolumeSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "snapshot",
+					Namespace:         "foo",
+					Finalizers:        []string{"snapshot.storage.kubernetes.io/volumesnapshot-as-source-protection"},
+					DeletionTimestamp: &metav1.Time{Time: time.Now().Add(-10 * time.Minute)},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(append(objects, volumeSnapshot)...).Build()
+			botanist.ShootClientSet = fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build()
+		})
+
+		It("should only finalize volume snapshots after one hour", func() {
+			Expect(botanist.CleanKubernetesResources(ctx)).NotTo(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(volumeSnapshot), volumeSnapshot)).To(Succeed())
+		})
+
+		Context("aggressive cleanup mode", func() {
+			BeforeEach(func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootAggressiveCleanup, "true")
+			})
+
+			It("should clean up the workloads, load balancers and volumes", func() {
+				Expect(botanist.CleanKubernetesResources(ctx)).To(Succeed())
+
+				for _, obj := range objects {
+					if _, ok := obj.(*corev1.Namespace); ok {
+						continue
+					}
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(BeNotFoundError(), fmt.Sprintf("%T %s", obj, client.ObjectKeyFromObject(obj)))
+				}
+			})
+
+			It("should finalize volume snapshots after five minutes", func() {
+				Expect(botanist.CleanKubernetesResources(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(volumeSnapshot), volumeSnapshot)).To(BeNotFoundError())
+			})
+
+			It("should clean up the workloads of at most the configured number of namespaces in parallel", func() {
+				DeferCleanup(func(concurrency int) { AggressiveCleanupNamespaceConcurrency = concurrency }, AggressiveCleanupNamespaceConcurrency)
+				AggressiveCleanupNamespaceConcurrency = 2
+
+				var (
+					lock             sync.Mutex
+					inFlight         = map[string]int{}
+					maxInFlight      int
+					sawAllNamespaces = map[string]bool{}
+				)
+
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(append(objects, volumeSnapshot)...).WithInterceptorFuncs(interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						listOptions := (&client.ListOptions{}).ApplyOptions(opts)
+						if _, ok := list.(*appsv1.DeploymentList); !ok || listOptions.Namespace == "" {
+							return c.List(ctx, list, opts...)
+						}
+
+						lock.Lock()
+						inFlight[listOptions.Namespace]++
+						sawAllNamespaces[listOptions.Namespace] = true
+						maxInFlight = max(maxInFlight, len(inFlight))
+						lock.Unlock()
+
+						defer func() {
+							lock.Lock()
+							if inFlight[listOptions.Namespace]--; inFlight[listOptions.Namespace] == 0 {
+								delete(inFlight, listOptions.Namespace)
+							}
+							lock.Unlock()
+						}()
+
+						time.Sleep(20 * time.Millisecond)
+						return c.List(ctx, list, opts...)
+					},
+				}).Build()
+				botanist.ShootClientSet = fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build()
+
+				Expect(botanist.CleanKubernetesResources(ctx)).To(Succeed())
+
+				Expect(sawAllNamespaces).To(HaveLen(len(namespaces)))
+				Expect(maxInFlight).To(Equal(2))
+			})
+		})
+	})
+})
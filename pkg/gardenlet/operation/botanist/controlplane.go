@@ -19,6 +19,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
 	extensionscontrolplane "github.com/gardener/gardener/pkg/component/extensions/controlplane"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -195,6 +196,20 @@ func (b *Botanist) RestoreControlPlane(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.ControlPlane.Restore(ctx, b.Shoot.GetShootState())
 }
 
+// controlPlaneComponentInjections returns the injections for the control plane components which the provider extension
+// reported in the status of the ControlPlane resource.
+func (b *Botanist) controlPlaneComponentInjections(ctx context.Context) (extensionsv1alpha1.ControlPlaneComponentInjections, error) {
+	if b.Shoot.IsWorkerless || b.Shoot.Components.Extensions == nil || b.Shoot.Components.Extensions.ControlPlane == nil {
+		return extensionsv1alpha1.ControlPlaneComponentInjections{}, nil
+	}
+
+	componentInjections, err := b.Shoot.Components.Extensions.ControlPlane.ComponentInjections(ctx)
+	if err != nil {
+		return extensionsv1alpha1.ControlPlaneComponentInjections{}, fmt.Errorf("failed reading component injections from ControlPlane resource: %w", err)
+	}
+	return ptr.Deref(componentInjections, extensionsv1alpha1.ControlPlaneComponentInjections{}), nil
+}
+
 // RestartControlPlanePods restarts (deletes) pods of the shoot control plane.
 func (b *Botanist) RestartControlPlanePods(ctx context.Context) error {
 	return b.SeedClientSet.Client().DeleteAllOf(
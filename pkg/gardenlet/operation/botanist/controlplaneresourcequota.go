@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// ControlPlaneResourceQuotaName is the name of the ResourceQuota and the LimitRange in the control plane namespace.
+	ControlPlaneResourceQuotaName = "gardener-control-plane"
+	// LabelControlPlaneSizeClass is the label on the ResourceQuota and the LimitRange in the control plane namespace
+	// which contains the name of the size class of the shoot.
+	LabelControlPlaneSizeClass = "control-plane.shoot.gardener.cloud/size-class"
+)
+
+// DeployControlPlaneResourceQuota deploys the ResourceQuota and the LimitRange for the control plane namespace
+// according to the size class of the shoot configured in the seed settings. If the control plane resource quota is
+// not enabled for the seed, they are deleted.
+func (b *Botanist) DeployControlPlaneResourceQuota(ctx context.Context) error {
+	var (
+		resourceQuota = &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: ControlPlaneResourceQuotaName, Namespace: b.Shoot.ControlPlaneNamespace}}
+		limitRange    = &corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: ControlPlaneResourceQuotaName, Namespace: b.Shoot.ControlPlaneNamespace}}
+		sizeClass     = v1beta1helper.ControlPlaneSizeClassForShoot(b.Seed.GetInfo().Spec.Settings, b.Shoot.GetInfo())
+	)
+
+	if sizeClass == nil {
+		return kubernetesutils.DeleteObjects(ctx, b.SeedClientSet.Client(), resourceQuota, limitRange)
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), resourceQuota, func() error {
+		metav1.SetMetaDataLabel(&resourceQuota.ObjectMeta, LabelControlPlaneSizeClass, sizeClass.Name)
+		resourceQuota.Spec.Hard = sizeClass.Quota
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if len(sizeClass.ContainerMax) == 0 && len(sizeClass.ContainerDefaultRequest) == 0 {
+		return kubernetesutils.DeleteObject(ctx, b.SeedClientSet.Client(), limitRange)
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), limitRange, func() error {
+		metav1.SetMetaDataLabel(&limitRange.ObjectMeta, LabelControlPlaneSizeClass, sizeClass.Name)
+		limitRange.Spec.Limits = []corev1.LimitRangeItem{{
+			Type:           corev1.LimitTypeContainer,
+			Max:            sizeClass.ContainerMax,
+			Default:        sizeClass.ContainerMax,
+			DefaultRequest: sizeClass.ContainerDefaultRequest,
+		}}
+		return nil
+	})
+	return err
+}
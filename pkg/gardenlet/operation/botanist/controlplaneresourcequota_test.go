@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ControlPlaneResourceQuota", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+
+		seedClient client.Client
+		botanist   *Botanist
+
+		seedSettings  *gardencorev1beta1.SeedSettings
+		resourceQuota *corev1.ResourceQuota
+		limitRange    *corev1.LimitRange
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
+			Seed:          &seed.Seed{},
+			Shoot:         &shoot.Shoot{ControlPlaneNamespace: namespace},
+		}}
+
+		seedSettings = &gardencorev1beta1.SeedSettings{
+			ControlPlaneResourceQuota: &gardencorev1beta1.SeedSettingControlPlaneResourceQuota{
+				Enabled: true,
+				SizeClasses: []gardencorev1beta1.ControlPlaneSizeClass{
+					{
+						Name:                    "small",
+						MaxNodes:                ptr.To[int32](10),
+						Quota:                   corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("8")},
+						ContainerMax:            corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+						ContainerDefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+					},
+					{
+						Name:  "large",
+						Quota: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("32")},
+					},
+				},
+			},
+		}
+		botanist.Seed.SetInfo(&gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Settings: seedSettings}})
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+			{Name: "pool", Maximum: 5},
+		}}}})
+
+		resourceQuota = &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: "gardener-control-plane", Namespace: namespace}}
+		limitRange = &corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: "gardener-control-plane", Namespace: namespace}}
+	})
+
+	Describe("#DeployControlPlaneResourceQuota", func() {
+		It("should deploy the ResourceQuota and the LimitRange of the matching size class", func() {
+			Expect(botanist.DeployControlPlaneResourceQuota(ctx)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(resourceQuota), resourceQuota)).To(Succeed())
+			Expect(resourceQuota.Labels).To(HaveKeyWithValue("control-plane.shoot.gardener.cloud/size-class", "small"))
+			Expect(resourceQuota.Spec.Hard).To(Equal(corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("8")}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(limitRange), limitRange)).To(Succeed())
+			Expect(limitRange.Labels).To(HaveKeyWithValue("control-plane.shoot.gardener.cloud/size-class", "small"))
+			Expect(limitRange.Spec.Limits).To(ConsistOf(corev1.LimitRangeItem{
+				Type:           corev1.LimitTypeContainer,
+				Max:            corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+				Default:        corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+				DefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+			}))
+		})
+
+		It("should delete the LimitRange if the size class does not configure container limits", func() {
+			Expect(botanist.DeployControlPlaneResourceQuota(ctx)).To(Succeed())
+
+			botanist.Shoot.GetInfo().Spec.Provider.Workers[0].Maximum = 20
+			Expect(botanist.DeployControlPlaneResourceQuota(ctx)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(resourceQuota), resourceQuota)).To(Succeed())
+			Expect(resourceQuota.Labels).To(HaveKeyWithValue("control-plane.shoot.gardener.cloud/size-class", "large"))
+			Expect(resourceQuota.Spec.Hard).To(Equal(corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("32")}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(limitRange), limitRange)).To(BeNotFoundError())
+		})
+
+		It("should delete the ResourceQuota and the LimitRange if the setting is disabled", func() {
+			Expect(botanist.DeployControlPlaneResourceQuota(ctx)).To(Succeed())
+
+			seedSettings.ControlPlaneResourceQuota.Enabled = false
+			Expect(botanist.DeployControlPlaneResourceQuota(ctx)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(resourceQuota), resourceQuota)).To(BeNotFoundError())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(limitRange), limitRange)).To(BeNotFoundError())
+		})
+	})
+})
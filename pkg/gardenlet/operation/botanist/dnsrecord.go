@@ -6,14 +6,22 @@ package botanist
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	extensionsdnsrecord "github.com/gardener/gardener/pkg/component/extensions/dnsrecord"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -41,6 +49,11 @@ func (b *Botanist) DefaultExternalDNSRecord() extensionsdnsrecord.Interface {
 		}
 		credentialsDeployer = extensionsdnsrecord.CredentialsDeployerFromCredentials(b.Shoot.ExternalDomain.Credentials, b.Shoot.GetInfo())
 		values.DNSName = v1beta1helper.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain)
+
+		if dns := b.Shoot.GetInfo().Spec.DNS; dns != nil && dns.APIServerRecord != nil {
+			values.TTLs = b.apiServerDNSRecordTTLs(dns.APIServerRecord.TTLs)
+			values.Failover = dns.APIServerRecord.Failover
+		}
 	}
 
 	return extensionsdnsrecord.New(
@@ -165,9 +178,138 @@ func (b *Botanist) deployOrRestoreDNSRecord(ctx context.Context, dnsRecord compo
 	return dnsRecord.Deploy(ctx)
 }
 
+// LowerDNSRecordTTLsForMigration lowers the TTL of all DNSRecords in the control plane namespace to the configured
+// cutover TTL and waits until the previous TTL has expired. This ensures that clients resolve the addresses of the
+// destination seed quickly once the DNS records have been restored there. The expiry of the previous TTL is recorded
+// on the DNSRecords, so that it is still honored if the migration is retried after the TTL has already been lowered.
+func (b *Botanist) LowerDNSRecordTTLsForMigration(ctx context.Context) error {
+	cutoverTTL := b.dnsRecordCutoverTTLSeconds()
+	if cutoverTTL == nil {
+		return nil
+	}
+
+	dnsRecordList := &extensionsv1alpha1.DNSRecordList{}
+	if err := b.SeedClientSet.Client().List(ctx, dnsRecordList, client.InNamespace(b.Shoot.ControlPlaneNamespace)); err != nil {
+		return fmt.Errorf("failed listing DNSRecords: %w", err)
+	}
+
+	var (
+		now               = b.Clock.Now().UTC()
+		previousTTLExpiry time.Time
+		fns               []flow.TaskFn
+	)
+
+	for _, dnsRecord := range dnsRecordList.Items {
+		if dnsRecord.DeletionTimestamp != nil {
+			continue
+		}
+
+		previousTTL := ptr.Deref(dnsRecord.Spec.TTL, ptr.Deref(b.dnsRecordTTLSeconds(), 0))
+		if previousTTL <= *cutoverTTL {
+			// The TTL might have been lowered by a previous attempt of the migration, hence, honor the recorded expiry of
+			// the previous TTL.
+			value, ok := dnsRecord.Annotations[v1beta1constants.AnnotationShootDNSCutoverPreviousTTLExpiry]
+			if !ok {
+				continue
+			}
+
+			expiry, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return fmt.Errorf("failed parsing annotation %s of DNSRecord %s: %w", v1beta1constants.AnnotationShootDNSCutoverPreviousTTLExpiry, client.ObjectKeyFromObject(&dnsRecord), err)
+			}
+			if expiry.After(previousTTLExpiry) {
+				previousTTLExpiry = expiry
+			}
+
+			fns = append(fns, func(ctx context.Context) error {
+				return b.waitUntilDNSRecordReady(ctx, &dnsRecord)
+			})
+			continue
+		}
+
+		expiry := now.Add(time.Duration(previousTTL) * time.Second)
+		if expiry.After(previousTTLExpiry) {
+			previousTTLExpiry = expiry
+		}
+
+		fns = append(fns, func(ctx context.Context) error {
+			patch := client.MergeFrom(dnsRecord.DeepCopy())
+			dnsRecord.Spec.TTL = cutoverTTL
+			metav1.SetMetaDataAnnotation(&dnsRecord.ObjectMeta, v1beta1constants.AnnotationShootDNSCutoverPreviousTTLExpiry, expiry.Format(time.RFC3339))
+			metav1.SetMetaDataAnnotation(&dnsRecord.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+			metav1.SetMetaDataAnnotation(&dnsRecord.ObjectMeta, v1beta1constants.GardenerTimestamp, now.Format(time.RFC3339Nano))
+			if err := b.SeedClientSet.Client().Patch(ctx, &dnsRecord, patch); err != nil {
+				return fmt.Errorf("failed lowering TTL of DNSRecord %s: %w", client.ObjectKeyFromObject(&dnsRecord), err)
+			}
+
+			return b.waitUntilDNSRecordReady(ctx, &dnsRecord)
+		})
+	}
+
+	if err := flow.Parallel(fns...)(ctx); err != nil {
+		return err
+	}
+
+	remaining := previousTTLExpiry.Sub(b.Clock.Now())
+	if remaining <= 0 {
+		return nil
+	}
+
+	// Wait until the records with the previous TTL have expired in the caches of the resolvers.
+	b.Logger.Info("Waiting until previous TTL of DNS records has expired", "expiry", previousTTLExpiry, "remaining", remaining)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-b.Clock.After(remaining):
+		return nil
+	}
+}
+
+func (b *Botanist) waitUntilDNSRecordReady(ctx context.Context, dnsRecord *extensionsv1alpha1.DNSRecord) error {
+	return extensions.WaitUntilExtensionObjectReady(
+		ctx,
+		b.SeedClientSet.Client(),
+		b.Logger,
+		dnsRecord,
+		extensionsv1alpha1.DNSRecordResource,
+		extensionsdnsrecord.DefaultInterval,
+		extensionsdnsrecord.DefaultSevereThreshold,
+		extensionsdnsrecord.DefaultTimeout,
+		nil,
+	)
+}
+
 func (b *Botanist) dnsRecordTTLSeconds() *int64 {
+	if b.IsRestorePhase() {
+		// Keep the low TTL while the DNS records are cut over to the destination seed. The regular TTL is applied again
+		// with the first reconciliation after the migration.
+		if cutoverTTL := b.dnsRecordCutoverTTLSeconds(); cutoverTTL != nil {
+			return cutoverTTL
+		}
+	}
+
 	if b.Config != nil && b.Config.Controllers != nil && b.Config.Controllers.Shoot != nil {
 		return b.Config.Controllers.Shoot.DNSEntryTTLSeconds
 	}
 	return ptr.To(int64(120))
 }
+
+func (b *Botanist) apiServerDNSRecordTTLs(ttls []gardencorev1beta1.DNSRecordTTL) map[extensionsv1alpha1.DNSRecordType]int64 {
+	if len(ttls) == 0 || (b.IsRestorePhase() && b.dnsRecordCutoverTTLSeconds() != nil) {
+		// Keep the low cutover TTL while the DNS records are cut over to the destination seed.
+		return nil
+	}
+
+	out := make(map[extensionsv1alpha1.DNSRecordType]int64, len(ttls))
+	for _, ttl := range ttls {
+		out[extensionsv1alpha1.DNSRecordType(ttl.RecordType)] = ttl.Seconds
+	}
+	return out
+}
+
+func (b *Botanist) dnsRecordCutoverTTLSeconds() *int64 {
+	if b.Config != nil && b.Config.Controllers != nil && b.Config.Controllers.Shoot != nil && b.Config.Controllers.Shoot.ControlPlaneMigration != nil {
+		return b.Config.Controllers.Shoot.ControlPlaneMigration.DNSCutoverTTLSeconds
+	}
+	return nil
+}
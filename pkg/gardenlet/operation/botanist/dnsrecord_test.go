@@ -12,6 +12,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -20,9 +21,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -202,6 +205,20 @@ var _ = Describe("dnsrecord", func() {
 			}))
 		})
 
+		It("should use the API server record configuration of the shoot", func() {
+			failover := &gardencorev1beta1.DNSFailover{Values: []string{"5.6.7.8"}}
+			shoot := b.Shoot.GetInfo()
+			shoot.Spec.DNS.APIServerRecord = &gardencorev1beta1.DNSAPIServerRecord{
+				TTLs:     []gardencorev1beta1.DNSRecordTTL{{RecordType: "A", Seconds: 60}},
+				Failover: failover,
+			}
+			b.Shoot.SetInfo(shoot)
+
+			values := b.DefaultExternalDNSRecord().GetValues()
+			Expect(values.TTLs).To(Equal(map[extensionsv1alpha1.DNSRecordType]int64{extensionsv1alpha1.DNSRecordTypeA: 60}))
+			Expect(values.Failover).To(Equal(failover))
+		})
+
 		DescribeTable("should set AnnotateOperation value to true",
 			func(mutateShootFn func()) {
 				mutateShootFn()
@@ -648,4 +665,131 @@ var _ = Describe("dnsrecord", func() {
 			Expect(b.MigrateInternalDNSRecord(ctx)).To(MatchError(testErr))
 		})
 	})
+
+	Describe("#LowerDNSRecordTTLsForMigration", func() {
+		var (
+			fakeClock *testclock.FakeClock
+			dnsRecord *extensionsv1alpha1.DNSRecord
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(now)
+
+			// Simulate the extension controller picking up the reconciliation request.
+			c = fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					if err := c.Patch(ctx, obj, patch, opts...); err != nil {
+						return err
+					}
+					record := obj.(*extensionsv1alpha1.DNSRecord)
+					delete(record.Annotations, v1beta1constants.GardenerOperation)
+					record.Status.LastOperation = &gardencorev1beta1.LastOperation{
+						State:          gardencorev1beta1.LastOperationStateSucceeded,
+						LastUpdateTime: metav1.NewTime(now.Add(time.Minute)),
+					}
+					return c.Update(ctx, record)
+				},
+			}).Build()
+
+			dnsRecord = &extensionsv1alpha1.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{Name: shootName + "-external", Namespace: controlPlaneNamespace},
+				Spec:       extensionsv1alpha1.DNSRecordSpec{TTL: ptr.To(ttl)},
+			}
+			Expect(c.Create(ctx, dnsRecord)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			b.Clock = fakeClock
+			b.Config.Controllers.Shoot.ControlPlaneMigration = &gardenletconfigv1alpha1.ShootControlPlaneMigration{DNSCutoverTTLSeconds: ptr.To[int64](30)}
+		})
+
+		It("should do nothing if no cutover TTL is configured", func() {
+			b.Config.Controllers.Shoot.ControlPlaneMigration = nil
+
+			Expect(b.LowerDNSRecordTTLsForMigration(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(dnsRecord), dnsRecord)).To(Succeed())
+			Expect(dnsRecord.Spec.TTL).To(PointTo(Equal(ttl)))
+		})
+
+		It("should not wait if the TTL has already been lowered", func() {
+			dnsRecord.Spec.TTL = ptr.To[int64](30)
+			Expect(c.Update(ctx, dnsRecord)).To(Succeed())
+
+			Expect(b.LowerDNSRecordTTLsForMigration(ctx)).To(Succeed())
+		})
+
+		It("should lower the TTL and wait until the previous TTL has expired", func() {
+			errCh := make(chan error)
+			go func() {
+				defer GinkgoRecover()
+				errCh <- b.LowerDNSRecordTTLsForMigration(ctx)
+			}()
+
+			Eventually(fakeClock.HasWaiters).Should(BeTrue())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(dnsRecord), dnsRecord)).To(Succeed())
+			Expect(dnsRecord.Spec.TTL).To(PointTo(Equal(int64(30))))
+			Consistently(errCh).ShouldNot(Receive())
+
+			fakeClock.Step(time.Duration(ttl) * time.Second)
+			Eventually(errCh).Should(Receive(BeNil()))
+		})
+
+		It("should honor the expiry of the previous TTL when the migration is retried", func() {
+			cancelCtx, cancel := context.WithCancel(ctx)
+			errCh := make(chan error)
+			go func() {
+				defer GinkgoRecover()
+				errCh <- b.LowerDNSRecordTTLsForMigration(cancelCtx)
+			}()
+
+			Eventually(fakeClock.HasWaiters).Should(BeTrue())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(dnsRecord), dnsRecord)).To(Succeed())
+			Expect(dnsRecord.Spec.TTL).To(PointTo(Equal(int64(30))))
+			Expect(dnsRecord.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationShootDNSCutoverPreviousTTLExpiry, now.Add(time.Duration(ttl)*time.Second).UTC().Format(time.RFC3339)))
+
+			// Simulate that the migration flow is aborted while waiting and retried later.
+			cancel()
+			Eventually(errCh).Should(Receive(MatchError(context.Canceled)))
+			fakeClock.Step(time.Duration(ttl-10) * time.Second)
+
+			go func() {
+				defer GinkgoRecover()
+				errCh <- b.LowerDNSRecordTTLsForMigration(ctx)
+			}()
+
+			Eventually(fakeClock.HasWaiters).Should(BeTrue())
+			Consistently(errCh).ShouldNot(Receive())
+
+			fakeClock.Step(10 * time.Second)
+			Eventually(errCh).Should(Receive(BeNil()))
+		})
+
+		It("should not wait if the previous TTL has already expired when the migration is retried", func() {
+			dnsRecord.Spec.TTL = ptr.To[int64](30)
+			metav1.SetMetaDataAnnotation(&dnsRecord.ObjectMeta, v1beta1constants.AnnotationShootDNSCutoverPreviousTTLExpiry, now.Add(-time.Second).Format(time.RFC3339))
+			dnsRecord.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				State:          gardencorev1beta1.LastOperationStateSucceeded,
+				LastUpdateTime: metav1.NewTime(now.Add(time.Minute)),
+			}
+			Expect(c.Update(ctx, dnsRecord)).To(Succeed())
+
+			Expect(b.LowerDNSRecordTTLsForMigration(ctx)).To(Succeed())
+		})
+
+		It("should fail if the recorded expiry of the previous TTL cannot be parsed", func() {
+			dnsRecord.Spec.TTL = ptr.To[int64](30)
+			metav1.SetMetaDataAnnotation(&dnsRecord.ObjectMeta, v1beta1constants.AnnotationShootDNSCutoverPreviousTTLExpiry, "foo")
+			Expect(c.Update(ctx, dnsRecord)).To(Succeed())
+
+			Expect(b.LowerDNSRecordTTLsForMigration(ctx)).To(MatchError(ContainSubstring("failed parsing annotation")))
+		})
+
+		It("should use the cutover TTL for restored DNS records", func() {
+			b.Shoot.GetInfo().Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore}
+
+			Expect(b.DefaultExternalDNSRecord().GetValues().TTL).To(PointTo(Equal(int64(30))))
+			Expect(b.DefaultInternalDNSRecord().GetValues().TTL).To(PointTo(Equal(int64(30))))
+		})
+	})
 })
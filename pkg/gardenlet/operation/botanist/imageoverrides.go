@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/imagevector"
+	gardenlethelper "github.com/gardener/gardener/pkg/api/config/gardenlet/v1alpha1/helper"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// imageOverrides contains the image overrides requested for a shoot via the `shoot.gardener.cloud/image-overrides`
+// annotation.
+type imageOverrides struct {
+	// repositories maps the repositories of the overridden images to the images which should be used instead.
+	repositories map[string]string
+	// active contains the applied overrides in the form `<image-name>=<image>`.
+	active []string
+	// ignored contains the overrides which are not applied because they are not allowed in the gardenlet configuration
+	// or refer to unknown images.
+	ignored []string
+}
+
+func (b *Botanist) computeImageOverrides() (*imageOverrides, error) {
+	overrides, err := gardenerutils.ParseImageOverrides(b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootImageOverrides])
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result        = &imageOverrides{repositories: make(map[string]string)}
+		allowedImages = sets.New(gardenlethelper.GetAllowedImageOverrides(b.Config)...)
+	)
+
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		image := overrides[name]
+
+		repositories := imagevector.Containers().Repositories(name)
+		if !allowedImages.Has(name) || len(repositories) == 0 {
+			result.ignored = append(result.ignored, name)
+			continue
+		}
+
+		for _, repository := range repositories {
+			result.repositories[repository] = image
+		}
+		result.active = append(result.active, name+"="+image)
+	}
+
+	return result, nil
+}
+
+// setImageOverrides maintains the label and annotation on the control plane namespace which instruct the
+// image-overrides webhook of the seed's gardener-resource-manager to replace the images of the overridden components.
+func setImageOverrides(namespace *corev1.Namespace, overrides *imageOverrides) error {
+	if len(overrides.repositories) == 0 {
+		delete(namespace.Labels, resourcesv1alpha1.ImageOverridesConsider)
+		delete(namespace.Annotations, resourcesv1alpha1.ImageOverrides)
+		return nil
+	}
+
+	value, err := json.Marshal(overrides.repositories)
+	if err != nil {
+		return fmt.Errorf("failed marshalling image overrides: %w", err)
+	}
+
+	metav1.SetMetaDataLabel(&namespace.ObjectMeta, resourcesv1alpha1.ImageOverridesConsider, "true")
+	metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, resourcesv1alpha1.ImageOverrides, string(value))
+	return nil
+}
+
+// ReconcileImageOverrides applies the image overrides of the shoot to the existing workload resources in the control
+// plane namespace and reports the active overrides in the `ImageOverridesActive` constraint of the shoot.
+func (b *Botanist) ReconcileImageOverrides(ctx context.Context) error {
+	overrides, err := b.computeImageOverrides()
+	if err != nil {
+		return err
+	}
+
+	if len(overrides.repositories) > 0 {
+		if err := b.triggerImageOverrides(ctx, overrides.repositories); err != nil {
+			return err
+		}
+	}
+
+	if len(overrides.active) == 0 && len(overrides.ignored) == 0 {
+		if v1beta1helper.GetCondition(b.Shoot.GetInfo().Status.Constraints, gardencorev1beta1.ShootImageOverridesActive) == nil {
+			return nil
+		}
+
+		return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, true, false, func(shoot *gardencorev1beta1.Shoot) error {
+			shoot.Status.Constraints = v1beta1helper.RemoveConditions(shoot.Status.Constraints, gardencorev1beta1.ShootImageOverridesActive)
+			return nil
+		})
+	}
+
+	var (
+		status   = gardencorev1beta1.ConditionTrue
+		reason   = "ImagesOverridden"
+		messages []string
+	)
+
+	if len(overrides.active) > 0 {
+		messages = append(messages, fmt.Sprintf("Images of control plane components are overridden: %s.", strings.Join(overrides.active, ", ")))
+	} else {
+		status = gardencorev1beta1.ConditionFalse
+		reason = "ImageOverridesNotAllowed"
+	}
+
+	if len(overrides.ignored) > 0 {
+		messages = append(messages, fmt.Sprintf("Overrides for the following images are ignored because they are unknown or not allowed by the gardenlet configuration: %s.", strings.Join(overrides.ignored, ", ")))
+	}
+
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, true, false, func(shoot *gardencorev1beta1.Shoot) error {
+		condition := v1beta1helper.GetOrInitConditionWithClock(b.Clock, shoot.Status.Constraints, gardencorev1beta1.ShootImageOverridesActive)
+		condition = v1beta1helper.UpdatedConditionWithClock(b.Clock, condition, status, reason, strings.Join(messages, " "))
+		shoot.Status.Constraints = v1beta1helper.MergeConditions(shoot.Status.Constraints, condition)
+		return nil
+	})
+}
+
+// triggerImageOverrides sends empty patches for all workload resources in the control plane namespace which still use
+// an overridden image. This way, the image-overrides webhook also mutates resources which would otherwise only be
+// updated once their desired state changes.
+func (b *Botanist) triggerImageOverrides(ctx context.Context, repositories map[string]string) error {
+	var (
+		deploymentList  = &appsv1.DeploymentList{}
+		statefulSetList = &appsv1.StatefulSetList{}
+		daemonSetList   = &appsv1.DaemonSetList{}
+		objects         []client.Object
+	)
+
+	for _, list := range []client.ObjectList{deploymentList, statefulSetList, daemonSetList} {
+		if err := b.SeedClientSet.Client().List(ctx, list, client.InNamespace(b.Shoot.ControlPlaneNamespace)); err != nil {
+			return fmt.Errorf("failed listing %T: %w", list, err)
+		}
+	}
+
+	for _, obj := range deploymentList.Items {
+		if usesOverriddenImage(obj.Spec.Template.Spec, repositories) {
+			objects = append(objects, obj.DeepCopy())
+		}
+	}
+	for _, obj := range statefulSetList.Items {
+		if usesOverriddenImage(obj.Spec.Template.Spec, repositories) {
+			objects = append(objects, obj.DeepCopy())
+		}
+	}
+	for _, obj := range daemonSetList.Items {
+		if usesOverriddenImage(obj.Spec.Template.Spec, repositories) {
+			objects = append(objects, obj.DeepCopy())
+		}
+	}
+
+	for _, obj := range objects {
+		b.Logger.Info("Triggering image overrides", "kind", fmt.Sprintf("%T", obj), "object", client.ObjectKeyFromObject(obj))
+		if err := b.SeedClientSet.Client().Patch(ctx, obj, client.RawPatch(types.MergePatchType, []byte("{}"))); err != nil {
+			return fmt.Errorf("failed triggering image overrides for %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	return nil
+}
+
+func usesOverriddenImage(podSpec corev1.PodSpec, repositories map[string]string) bool {
+	for _, container := range slices.Concat(podSpec.InitContainers, podSpec.Containers) {
+		if image, ok := repositories[imagevectorutils.RepositoryOf(container.Image)]; ok && image != container.Image {
+			return true
+		}
+	}
+	return false
+}
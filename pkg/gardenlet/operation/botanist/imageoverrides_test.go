@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ImageOverrides", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+
+		gardenClient client.Client
+		seedClient   client.Client
+		botanist     *Botanist
+		shoot        *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bar",
+				Namespace: "garden-foo",
+			},
+		}
+
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).WithObjects(shoot).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			Logger:        logr.Discard(),
+			Clock:         testclock.NewFakeClock(metav1.Now().Time),
+			GardenClient:  gardenClient,
+			SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
+			Shoot:         &shootpkg.Shoot{ControlPlaneNamespace: namespace},
+			Config: &gardenletconfigv1alpha1.GardenletConfiguration{
+				Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{
+					Shoot: &gardenletconfigv1alpha1.ShootControllerConfiguration{
+						ImageOverrides: &gardenletconfigv1alpha1.ShootImageOverrides{AllowedImages: []string{"kube-apiserver"}},
+					},
+				},
+			},
+		}}
+	})
+
+	JustBeforeEach(func() {
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	Describe("#ReconcileImageOverrides", func() {
+		var deployment *appsv1.Deployment
+
+		BeforeEach(func() {
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: namespace},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "kube-apiserver", Image: "registry.k8s.io/kube-apiserver:v1.33.1"}},
+						},
+					},
+				},
+			}
+			Expect(seedClient.Create(ctx, deployment)).To(Succeed())
+		})
+
+		It("should not report a constraint if no images are overridden", func() {
+			Expect(botanist.ReconcileImageOverrides(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.Constraints).NotTo(ContainCondition(OfType(gardencorev1beta1.ShootImageOverridesActive)))
+		})
+
+		It("should report the active overrides and remove the constraint once the overrides are removed", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/image-overrides", "kube-apiserver=example.com/kube-apiserver:v1.33.1-hotfix")
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.ReconcileImageOverrides(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.Constraints).To(ContainCondition(
+				OfType(gardencorev1beta1.ShootImageOverridesActive),
+				WithStatus(gardencorev1beta1.ConditionTrue),
+				WithReason("ImagesOverridden"),
+				WithMessage("kube-apiserver=example.com/kube-apiserver:v1.33.1-hotfix"),
+			))
+
+			By("removing the overrides")
+			delete(shoot.Annotations, "shoot.gardener.cloud/image-overrides")
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.ReconcileImageOverrides(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.Constraints).NotTo(ContainCondition(OfType(gardencorev1beta1.ShootImageOverridesActive)))
+		})
+
+		It("should report overrides which are not allowed or refer to unknown images", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/image-overrides", "kube-scheduler=example.com/kube-scheduler:v1.33.1-hotfix,foo=example.com/foo:bar")
+
+			Expect(botanist.ReconcileImageOverrides(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.Constraints).To(ContainCondition(
+				OfType(gardencorev1beta1.ShootImageOverridesActive),
+				WithStatus(gardencorev1beta1.ConditionFalse),
+				WithReason("ImageOverridesNotAllowed"),
+				WithMessage("foo, kube-scheduler"),
+			))
+		})
+
+		It("should fail if the image overrides cannot be parsed", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/image-overrides", "kube-apiserver")
+
+			Expect(botanist.ReconcileImageOverrides(ctx)).To(MatchError(ContainSubstring("invalid image override")))
+		})
+	})
+})
@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/utils/ptr"
+
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -20,16 +22,28 @@ import (
 
 // DefaultInfrastructure creates the default deployer for the Infrastructure custom resource.
 func (b *Botanist) DefaultInfrastructure() infrastructure.Interface {
+	var egress *gardencorev1beta1.Egress
+	if networking := b.Shoot.GetInfo().Spec.Networking; networking != nil {
+		egress = networking.Egress
+	}
+
+	var reconcileOnlyOnDrift bool
+	if b.Config != nil && b.Config.Controllers != nil && b.Config.Controllers.ShootInfrastructureDrift != nil {
+		reconcileOnlyOnDrift = ptr.Deref(b.Config.Controllers.ShootInfrastructureDrift.ReconcileOnlyOnDrift, false)
+	}
+
 	return infrastructure.New(
 		b.Logger,
 		b.SeedClientSet.Client(),
 		&infrastructure.Values{
-			Namespace:         b.Shoot.ControlPlaneNamespace,
-			Name:              b.Shoot.GetInfo().Name,
-			Type:              b.Shoot.GetInfo().Spec.Provider.Type,
-			ProviderConfig:    b.Shoot.GetInfo().Spec.Provider.InfrastructureConfig,
-			Region:            b.Shoot.GetInfo().Spec.Region,
-			AnnotateOperation: controllerutils.HasTask(b.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskDeployInfrastructure) || b.IsRestorePhase(),
+			Namespace:            b.Shoot.ControlPlaneNamespace,
+			Name:                 b.Shoot.GetInfo().Name,
+			Type:                 b.Shoot.GetInfo().Spec.Provider.Type,
+			ProviderConfig:       b.Shoot.GetInfo().Spec.Provider.InfrastructureConfig,
+			Region:               b.Shoot.GetInfo().Spec.Region,
+			Egress:               egress,
+			AnnotateOperation:    controllerutils.HasTask(b.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskDeployInfrastructure) || b.IsRestorePhase(),
+			ReconcileOnlyOnDrift: reconcileOnlyOnDrift,
 		},
 		infrastructure.DefaultInterval,
 		infrastructure.DefaultSevereThreshold,
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/imagevector"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/component/networking/konnectivity"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// ShootUsesKonnectivity returns true if the connectivity from the control plane to the shoot's cluster network is
+// established via konnectivity instead of the VPN.
+func (b *Botanist) ShootUsesKonnectivity() bool {
+	return !b.Shoot.IsWorkerless &&
+		!b.Shoot.RunsControlPlane() &&
+		v1beta1helper.GetNodeConnectivityMode(b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer) == gardencorev1beta1.NodeConnectivityModeKonnectivity
+}
+
+// DefaultKonnectivityAgent returns a deployer for the konnectivity-agent.
+func (b *Botanist) DefaultKonnectivityAgent() (konnectivity.Interface, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKonnectivityAgent, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	if err != nil {
+		return nil, err
+	}
+
+	var replicas int32 = 1
+	if b.Shoot.VPNHighAvailabilityEnabled {
+		replicas = 2
+	}
+
+	return konnectivity.New(
+		b.SeedClientSet.Client(),
+		b.Shoot.ControlPlaneNamespace,
+		b.SecretsManager,
+		konnectivity.Values{
+			Image:             image.String(),
+			VPAEnabled:        b.Shoot.WantsVerticalPodAutoscaler,
+			VPAUpdateDisabled: b.Shoot.VPNVPAUpdateDisabled,
+			Replicas:          replicas,
+		},
+	), nil
+}
+
+// TunnelName returns the name of the component which establishes the connectivity from the control plane to the
+// shoot's cluster network, i.e., either the konnectivity-agent or the vpn-shoot.
+func (b *Botanist) TunnelName() string {
+	if b.ShootUsesKonnectivity() {
+		return konnectivity.TunnelName
+	}
+	return v1beta1constants.VPNTunnel
+}
+
+// DeployKonnectivityAgent deploys the konnectivity-agent if the shoot uses konnectivity. If the shoot uses the VPN,
+// the konnectivity-agent is removed via DestroyKonnectivityAgent only after the VPN tunnel has been established.
+func (b *Botanist) DeployKonnectivityAgent(ctx context.Context) error {
+	if !b.ShootUsesKonnectivity() {
+		return nil
+	}
+
+	// The konnectivity-agents reach the konnectivity-server via the apiserver-proxy which is only deployed if the shoot
+	// uses DNS.
+	if !b.ShootUsesDNS() {
+		return fmt.Errorf("node connectivity mode %q requires the shoot to use internal and external DNS", gardencorev1beta1.NodeConnectivityModeKonnectivity)
+	}
+
+	b.Shoot.Components.SystemComponents.KonnectivityAgent.SetAdvertiseIPAddress(b.APIServerClusterIP)
+	return b.Shoot.Components.SystemComponents.KonnectivityAgent.Deploy(ctx)
+}
+
+// DestroyKonnectivityAgent destroys the konnectivity-agent if the shoot does not use konnectivity.
+func (b *Botanist) DestroyKonnectivityAgent(ctx context.Context) error {
+	if b.ShootUsesKonnectivity() {
+		return nil
+	}
+
+	return b.Shoot.Components.SystemComponents.KonnectivityAgent.Destroy(ctx)
+}
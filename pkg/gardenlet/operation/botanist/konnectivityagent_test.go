@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
+	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("KonnectivityAgent", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+
+		seedClient client.Client
+		shoot      *gardencorev1beta1.Shoot
+		botanist   *Botanist
+
+		managedResource *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		externalClusterDomain := "external.foo.bar.com"
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bar",
+				Namespace: "foo",
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				DNS: &gardencorev1beta1.DNS{
+					Domain: &externalClusterDomain,
+				},
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						NodeConnectivityMode: ptr.To(gardencorev1beta1.NodeConnectivityModeKonnectivity),
+					},
+				},
+			},
+		}
+
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				Clock:              clock.RealClock{},
+				APIServerClusterIP: "10.2.170.21",
+				Garden: &garden.Garden{
+					InternalDomain: &gardenerutils.Domain{Provider: "some-provider"},
+				},
+				SeedClientSet:  fake.NewClientSetBuilder().WithClient(seedClient).Build(),
+				SecretsManager: fakesecretsmanager.New(seedClient, namespace),
+				Seed:           &seedpkg.Seed{},
+				Shoot: &shootpkg.Shoot{
+					ControlPlaneNamespace: namespace,
+					Components: &shootpkg.Components{
+						SystemComponents: &shootpkg.SystemComponents{},
+					},
+					InternalClusterDomain: ptr.To("internal.foo.bar.com"),
+					ExternalClusterDomain: &externalClusterDomain,
+					ExternalDomain:        &gardenerutils.Domain{Provider: "some-external-provider"},
+				},
+			},
+		}
+		botanist.Seed.SetInfo(&gardencorev1beta1.Seed{})
+		botanist.Shoot.SetInfo(shoot)
+
+		managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "shoot-core-konnectivity-agent", Namespace: namespace}}
+	})
+
+	Describe("#ShootUsesKonnectivity", func() {
+		It("should return true if the node connectivity mode is Konnectivity", func() {
+			Expect(botanist.ShootUsesKonnectivity()).To(BeTrue())
+		})
+
+		It("should return false if the node connectivity mode is not set", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer = nil
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.ShootUsesKonnectivity()).To(BeFalse())
+		})
+
+		It("should return false if the node connectivity mode is VPN", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.NodeConnectivityMode = ptr.To(gardencorev1beta1.NodeConnectivityModeVPN)
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.ShootUsesKonnectivity()).To(BeFalse())
+		})
+
+		It("should return false for workerless shoots", func() {
+			botanist.Shoot.IsWorkerless = true
+
+			Expect(botanist.ShootUsesKonnectivity()).To(BeFalse())
+		})
+	})
+
+	Describe("#DeployKonnectivityAgent", func() {
+		JustBeforeEach(func() {
+			comp, err := botanist.DefaultKonnectivityAgent()
+			Expect(err).NotTo(HaveOccurred())
+			botanist.Shoot.Components.SystemComponents.KonnectivityAgent = comp
+		})
+
+		It("should deploy the konnectivity-agent", func() {
+			Expect(seedClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca-vpn", Namespace: namespace}, Data: map[string][]byte{"bundle.crt": []byte("FOOBAR")}})).To(Succeed())
+
+			Expect(botanist.DeployKonnectivityAgent(ctx)).To(Succeed())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		})
+
+		It("should fail if the shoot does not use DNS", func() {
+			botanist.Shoot.ExternalClusterDomain = nil
+
+			Expect(botanist.DeployKonnectivityAgent(ctx)).To(MatchError(ContainSubstring("requires the shoot to use internal and external DNS")))
+		})
+
+		It("should not destroy the konnectivity-agent if the shoot uses the VPN", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.NodeConnectivityMode = ptr.To(gardencorev1beta1.NodeConnectivityModeVPN)
+			botanist.Shoot.SetInfo(shoot)
+			Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+
+			Expect(botanist.DeployKonnectivityAgent(ctx)).To(Succeed())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		})
+	})
+
+	Describe("#DestroyKonnectivityAgent", func() {
+		JustBeforeEach(func() {
+			comp, err := botanist.DefaultKonnectivityAgent()
+			Expect(err).NotTo(HaveOccurred())
+			botanist.Shoot.Components.SystemComponents.KonnectivityAgent = comp
+
+			Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+		})
+
+		It("should destroy the konnectivity-agent if the shoot uses the VPN", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.NodeConnectivityMode = ptr.To(gardencorev1beta1.NodeConnectivityModeVPN)
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.DestroyKonnectivityAgent(ctx)).To(Succeed())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+
+		It("should not destroy the konnectivity-agent if the shoot uses konnectivity", func() {
+			Expect(botanist.DestroyKonnectivityAgent(ctx)).To(Succeed())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		})
+	})
+
+	Describe("#TunnelName", func() {
+		It("should return the konnectivity-agent if the shoot uses konnectivity", func() {
+			Expect(botanist.TunnelName()).To(Equal("konnectivity-agent"))
+		})
+
+		It("should return the vpn-shoot if the shoot uses the VPN", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.NodeConnectivityMode = ptr.To(gardencorev1beta1.NodeConnectivityModeVPN)
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.TunnelName()).To(Equal("vpn-shoot"))
+		})
+	})
+})
@@ -40,7 +40,7 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		}
 	)
 
-	if !b.Shoot.IsWorkerless && !b.Shoot.RunsControlPlane() {
+	if !b.Shoot.IsWorkerless && !b.Shoot.RunsControlPlane() && !b.ShootUsesKonnectivity() {
 		vpnConfig.Enabled = true
 		vpnConfig.HighAvailabilityEnabled = b.Shoot.VPNHighAvailabilityEnabled
 		vpnConfig.HighAvailabilityNumberOfSeedServers = b.Shoot.VPNHighAvailabilityNumberOfSeedServers
@@ -216,6 +216,12 @@ func (b *Botanist) DeployKubeAPIServer(ctx context.Context, enableNodeAgentAutho
 		}
 	}
 
+	componentInjections, err := b.controlPlaneComponentInjections(ctx)
+	if err != nil {
+		return err
+	}
+	b.Shoot.Components.ControlPlane.KubeAPIServer.SetComponentInjection(componentInjections.KubeAPIServer)
+
 	if err := shared.DeployKubeAPIServer(
 		ctx,
 		b.SeedClientSet.Client(),
@@ -321,6 +321,7 @@ var _ = Describe("KubeAPIServer", func() {
 					kubeAPIServer.EXPECT().SetSeedPodNetwork(gomock.Any())
 					kubeAPIServer.EXPECT().SetServerCertificateConfig(gomock.Any())
 					kubeAPIServer.EXPECT().SetServiceAccountConfig(gomock.Any())
+					kubeAPIServer.EXPECT().SetComponentInjection(nil)
 					kubeAPIServer.EXPECT().Deploy(ctx)
 
 					Expect(botanist.DeployKubeAPIServer(ctx, false)).To(Succeed())
@@ -392,6 +393,7 @@ var _ = Describe("KubeAPIServer", func() {
 					kubeAPIServer.EXPECT().SetSeedPodNetwork(gomock.Any())
 					kubeAPIServer.EXPECT().SetServerCertificateConfig(gomock.Any())
 					kubeAPIServer.EXPECT().SetServiceAccountConfig(expectedConfig)
+					kubeAPIServer.EXPECT().SetComponentInjection(nil)
 					kubeAPIServer.EXPECT().Deploy(ctx)
 
 					Expect(botanist.DeployKubeAPIServer(ctx, false)).To(Succeed())
@@ -521,6 +523,7 @@ users:
 			kubeAPIServer.EXPECT().SetSeedPodNetwork(gomock.Any())
 			kubeAPIServer.EXPECT().SetServerCertificateConfig(gomock.Any())
 			kubeAPIServer.EXPECT().SetServiceAccountConfig(gomock.Any())
+			kubeAPIServer.EXPECT().SetComponentInjection(nil)
 			kubeAPIServer.EXPECT().AppendAuthorizationWebhook(expectedAuthorizationWebhook, logr.Discard())
 			kubeAPIServer.EXPECT().Deploy(ctx)
 
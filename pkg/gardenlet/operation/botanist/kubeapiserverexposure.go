@@ -15,7 +15,6 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	kubeapiserverexposure "github.com/gardener/gardener/pkg/component/kubernetes/apiserverexposure"
-	"github.com/gardener/gardener/pkg/features"
 )
 
 // DefaultKubeAPIServerService returns a deployer for the kube-apiserver service.
@@ -53,6 +52,7 @@ func (b *Botanist) defaultKubeAPIServerServiceWithSuffix(suffix string, register
 			TopologyAwareRoutingEnabled: b.Shoot.TopologyAwareRoutingEnabled && !b.ShootUsesIstioTLSTermination(),
 			RuntimeKubernetesVersion:    b.Seed.KubernetesVersion,
 			NameSuffix:                  suffix,
+			KonnectivityEnabled:         b.ShootUsesKonnectivity(),
 		},
 		func() client.ObjectKey {
 			return client.ObjectKey{Name: b.IstioServiceName(), Namespace: b.IstioNamespace()}
@@ -70,7 +70,7 @@ func (b *Botanist) ShootUsesDNS() bool {
 
 // ShootUsesIstioTLSTermination returns true if the shoot uses Istio TLS termination aka L7 load-balancing.
 func (b *Botanist) ShootUsesIstioTLSTermination() bool {
-	return features.DefaultFeatureGate.Enabled(features.IstioTLSTermination) && v1beta1helper.IsShootIstioTLSTerminationEnabled(b.Shoot.GetInfo())
+	return b.IstioTLSTerminationEnabled() && v1beta1helper.IsShootIstioTLSTerminationEnabled(b.Shoot.GetInfo())
 }
 
 // DefaultKubeAPIServerSNI returns a deployer for the kube-apiserver SNI.
@@ -174,6 +174,10 @@ func (b *Botanist) setAPIServerServiceClusterIPs(clusterIPs []string) {
 				WildcardConfiguration: wildcardConfiguration,
 			}
 
+			if kubeAPIServer := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.AccessRestrictions != nil {
+				values.SourceRanges = kubeAPIServer.AccessRestrictions.SourceRanges
+			}
+
 			return values
 		},
 	)
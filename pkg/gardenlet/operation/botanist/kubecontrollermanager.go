@@ -31,7 +31,7 @@ func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interfa
 		b.Shoot.IsWorkerless,
 		metav1.HasAnnotation(b.Shoot.GetInfo().ObjectMeta, v1beta1constants.ShootAlphaControlPlaneScaleDownDisabled),
 		nil,
-		kubecontrollermanager.ControllerWorkers{},
+		kubecontrollermanager.ControllerWorkersFromConfig(b.Shoot.GetInfo().Spec.Kubernetes.KubeControllerManager),
 		kubecontrollermanager.ControllerSyncPeriods{},
 		nil,
 	)
@@ -52,6 +52,12 @@ func (b *Botanist) DeployKubeControllerManager(ctx context.Context) error {
 	b.Shoot.Components.ControlPlane.KubeControllerManager.SetServiceNetworks(b.Shoot.Networks.Services)
 	b.Shoot.Components.ControlPlane.KubeControllerManager.SetPodNetworks(b.Shoot.Networks.Pods)
 
+	componentInjections, err := b.controlPlaneComponentInjections(ctx)
+	if err != nil {
+		return err
+	}
+	b.Shoot.Components.ControlPlane.KubeControllerManager.SetComponentInjection(componentInjections.KubeControllerManager)
+
 	return b.Shoot.Components.ControlPlane.KubeControllerManager.Deploy(ctx)
 }
 
@@ -111,6 +111,7 @@ var _ = Describe("KubeControllerManager", func() {
 				kubeControllerManager.EXPECT().SetRuntimeConfig(map[string]bool{"foo": true})
 				kubeControllerManager.EXPECT().SetServiceNetworks(botanist.Shoot.Networks.Services)
 				kubeControllerManager.EXPECT().SetPodNetworks(botanist.Shoot.Networks.Pods)
+				kubeControllerManager.EXPECT().SetComponentInjection(nil)
 			})
 
 			Context("kube-apiserver is already scaled down", func() {
@@ -308,6 +309,7 @@ var _ = Describe("KubeControllerManager", func() {
 			kubeControllerManager.EXPECT().SetRuntimeConfig(map[string]bool{"foo": true})
 			kubeControllerManager.EXPECT().SetServiceNetworks(botanist.Shoot.Networks.Services)
 			kubeControllerManager.EXPECT().SetPodNetworks(botanist.Shoot.Networks.Pods)
+			kubeControllerManager.EXPECT().SetComponentInjection(nil)
 			kubeControllerManager.EXPECT().Deploy(ctx).Return(fakeErr)
 
 			kubernetesClient.EXPECT().Client().Return(c)
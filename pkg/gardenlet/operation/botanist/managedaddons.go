@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/component/shoot/managedaddons"
+)
+
+// DefaultManagedAddons returns a deployer for the managed addons.
+func (b *Botanist) DefaultManagedAddons() managedaddons.Interface {
+	return managedaddons.New(b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, nil)
+}
+
+// DeployManagedAddons deploys the managed addons selected in the Shoot specification. The addons are resolved from the
+// addon catalog which is provided by the extensions in the garden namespace of the seed cluster.
+func (b *Botanist) DeployManagedAddons(ctx context.Context) error {
+	var addons []managedaddons.Addon
+
+	if managedAddons := b.Shoot.GetInfo().Spec.ManagedAddons; len(managedAddons) > 0 {
+		catalog, err := managedaddons.LoadCatalog(ctx, b.SeedClientSet.Client())
+		if err != nil {
+			return err
+		}
+
+		if addons, err = catalog.Resolve(managedAddons); err != nil {
+			return fmt.Errorf("failed resolving managed addons: %w", err)
+		}
+	}
+
+	b.Shoot.Components.Addons.ManagedAddons.SetAddons(addons)
+	return b.Shoot.Components.Addons.ManagedAddons.Deploy(ctx)
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/shoot/managedaddons"
+	mockmanagedaddons "github.com/gardener/gardener/pkg/component/shoot/managedaddons/mock"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("ManagedAddons", func() {
+	var (
+		ctx     = context.Background()
+		fakeErr = errors.New("fake err")
+
+		ctrl          *gomock.Controller
+		fakeClient    client.Client
+		managedAddons *mockmanagedaddons.MockInterface
+		botanist      *Botanist
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		managedAddons = mockmanagedaddons.NewMockInterface(ctrl)
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+			Shoot: &shootpkg.Shoot{
+				Components: &shootpkg.Components{
+					Addons: &shootpkg.Addons{ManagedAddons: managedAddons},
+				},
+			},
+		}}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
+
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "addon-foo-1.2.3",
+				Namespace: "garden",
+				Labels:    map[string]string{"addon.gardener.cloud/name": "foo", "addon.gardener.cloud/version": "1.2.3"},
+			},
+			Data: map[string][]byte{"foo.yaml": []byte("foo")},
+		})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DeployManagedAddons", func() {
+		It("should deploy the resolved addons", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{
+				ManagedAddons: []gardencorev1beta1.ManagedAddon{{Name: "foo", Version: ptr.To("~1.2")}},
+			}})
+
+			managedAddons.EXPECT().SetAddons(gomock.Any()).Do(func(addons []managedaddons.Addon) {
+				Expect(addons).To(HaveLen(1))
+				Expect(addons[0].Name).To(Equal("foo"))
+				Expect(addons[0].Version).To(Equal(semver.MustParse("1.2.3")))
+				Expect(addons[0].Manifests).To(Equal(map[string][]byte{"foo.yaml": []byte("foo")}))
+			})
+			managedAddons.EXPECT().Deploy(ctx)
+
+			Expect(botanist.DeployManagedAddons(ctx)).To(Succeed())
+		})
+
+		It("should deploy no addons if none are selected", func() {
+			managedAddons.EXPECT().SetAddons(nil)
+			managedAddons.EXPECT().Deploy(ctx).Return(fakeErr)
+
+			Expect(botanist.DeployManagedAddons(ctx)).To(MatchError(fakeErr))
+		})
+
+		It("should fail if an addon cannot be resolved", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{
+				ManagedAddons: []gardencorev1beta1.ManagedAddon{{Name: "foo", Version: ptr.To(">= 2")}},
+			}})
+
+			Expect(botanist.DeployManagedAddons(ctx)).To(MatchError(ContainSubstring(`failed resolving managed addons: no version of addon "foo" satisfies the constraint ">= 2"`)))
+		})
+	})
+})
@@ -83,13 +83,21 @@ func (b *Botanist) DefaultPrometheus() (prometheus.Interface, error) {
 		externalLabels = utils.MergeStringMaps(externalLabels, b.Config.Monitoring.Shoot.ExternalLabels)
 	}
 
+	retention := monitoringv1.Duration("30d")
+	if value, ok := b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootMonitoringRetention]; ok {
+		if _, err := gardenerutils.ParseMonitoringRetention(value); err != nil {
+			return nil, fmt.Errorf("failed parsing monitoring retention %q: %w", value, err)
+		}
+		retention = monitoringv1.Duration(value)
+	}
+
 	values := prometheus.Values{
 		Name:                "shoot",
 		PriorityClassName:   v1beta1constants.PriorityClassNameShootControlPlane100,
 		StorageCapacity:     resource.MustParse(b.Seed.GetValidVolumeSize("20Gi")),
 		ClusterType:         component.ClusterTypeShoot,
 		Replicas:            b.Shoot.GetReplicas(1),
-		Retention:           ptr.To(monitoringv1.Duration("30d")),
+		Retention:           &retention,
 		RetentionSize:       "15GB",
 		RestrictToNamespace: true,
 		HealthCheckBy:       prometheus.Gardenlet,
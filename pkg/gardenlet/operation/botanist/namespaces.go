@@ -75,6 +75,14 @@ func (b *Botanist) DeployControlPlaneNamespace(ctx context.Context) error {
 		metav1.SetMetaDataLabel(&namespace.ObjectMeta, podsecurityadmissionapi.EnforceLevelLabel, string(podsecurityadmissionapi.LevelPrivileged))
 		metav1.SetMetaDataLabel(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigConsider, "true")
 
+		imageOverrides, err := b.computeImageOverrides()
+		if err != nil {
+			return err
+		}
+		if err := setImageOverrides(namespace, imageOverrides); err != nil {
+			return err
+		}
+
 		existingFailureToleranceType, failureToleranceTypeExisting := namespace.Annotations[resourcesv1alpha1.HighAvailabilityConfigFailureToleranceType]
 
 		shootFailureToleranceType := v1beta1helper.GetFailureToleranceType(b.Shoot.GetInfo())
@@ -19,6 +19,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -205,6 +206,33 @@ var _ = Describe("Namespaces", func() {
 			))
 		})
 
+		It("should successfully deploy the namespace w/ image overrides and remove them again", func() {
+			botanist.Config = &gardenletconfigv1alpha1.GardenletConfiguration{
+				Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{
+					Shoot: &gardenletconfigv1alpha1.ShootControllerConfiguration{
+						ImageOverrides: &gardenletconfigv1alpha1.ShootImageOverrides{AllowedImages: []string{"kube-apiserver"}},
+					},
+				},
+			}
+			metav1.SetMetaDataAnnotation(&defaultShootInfo.ObjectMeta, "shoot.gardener.cloud/image-overrides", "kube-apiserver=example.com/kube-apiserver:v1.33.1-hotfix,kube-scheduler=example.com/kube-scheduler:v1.33.1-hotfix")
+			botanist.Shoot.SetInfo(defaultShootInfo)
+
+			Expect(botanist.DeployControlPlaneNamespace(ctx)).To(Succeed())
+
+			defaultExpectations("", 1)
+			Expect(botanist.SeedNamespaceObject.Labels).To(HaveKeyWithValue("image-overrides.resources.gardener.cloud/consider", "true"))
+			Expect(botanist.SeedNamespaceObject.Annotations).To(HaveKeyWithValue("image-overrides.resources.gardener.cloud/images", `{"registry.k8s.io/kube-apiserver":"example.com/kube-apiserver:v1.33.1-hotfix"}`))
+
+			delete(defaultShootInfo.Annotations, "shoot.gardener.cloud/image-overrides")
+			botanist.Shoot.SetInfo(defaultShootInfo)
+
+			Expect(botanist.DeployControlPlaneNamespace(ctx)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+			Expect(obj.Labels).NotTo(HaveKey("image-overrides.resources.gardener.cloud/consider"))
+			Expect(obj.Annotations).NotTo(HaveKey("image-overrides.resources.gardener.cloud/images"))
+		})
+
 		It("should successfully deploy the namespace with enabled extension labels", func() {
 			defaultShootInfo.Spec.Extensions = []gardencorev1beta1.Extension{
 				{Type: extensionType1},
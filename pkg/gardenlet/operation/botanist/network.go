@@ -27,6 +27,7 @@ func (b *Botanist) DefaultNetwork() network.Interface {
 			Type:           *b.Shoot.GetInfo().Spec.Networking.Type,
 			IPFamilies:     ipFamilies,
 			ProviderConfig: b.Shoot.GetInfo().Spec.Networking.ProviderConfig,
+			Egress:         b.Shoot.GetInfo().Spec.Networking.Egress,
 		},
 		network.DefaultInterval,
 		network.DefaultSevereThreshold,
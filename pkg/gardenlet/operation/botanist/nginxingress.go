@@ -10,12 +10,15 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	extensionsdnsrecord "github.com/gardener/gardener/pkg/component/extensions/dnsrecord"
+	"github.com/gardener/gardener/pkg/component/networking/nginxingress"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/utils"
@@ -27,8 +30,10 @@ import (
 func (b *Botanist) DefaultNginxIngress() (component.DeployWaiter, error) {
 	var (
 		configData               map[string]string
+		loadBalancerAnnotations  map[string]string
 		loadBalancerSourceRanges []string
 		externalTrafficPolicy    corev1.ServiceExternalTrafficPolicy
+		autoscaling              *nginxingress.Autoscaling
 	)
 
 	if b.Shoot.GetInfo().Spec.Addons != nil && b.Shoot.GetInfo().Spec.Addons.NginxIngress != nil {
@@ -40,6 +45,8 @@ func (b *Botanist) DefaultNginxIngress() (component.DeployWaiter, error) {
 		if b.Shoot.GetInfo().Spec.Addons.NginxIngress.ExternalTrafficPolicy != nil {
 			externalTrafficPolicy = *b.Shoot.GetInfo().Spec.Addons.NginxIngress.ExternalTrafficPolicy
 		}
+		loadBalancerAnnotations = b.Shoot.GetInfo().Spec.Addons.NginxIngress.LoadBalancerAnnotations
+		autoscaling = getAutoscaling(b.Shoot.GetInfo().Spec.Addons.NginxIngress.Autoscaling)
 	}
 
 	return sharedcomponent.NewNginxIngress(
@@ -48,12 +55,13 @@ func (b *Botanist) DefaultNginxIngress() (component.DeployWaiter, error) {
 		metav1.NamespaceSystem,
 		b.Shoot.KubernetesVersion,
 		configData,
-		nil,
+		loadBalancerAnnotations,
 		loadBalancerSourceRanges,
 		v1beta1constants.PriorityClassNameShootSystem600,
 		b.Shoot.WantsVerticalPodAutoscaler,
 		component.ClusterTypeShoot,
 		externalTrafficPolicy,
+		autoscaling,
 		v1beta1constants.ShootNginxIngressClass,
 		nil,
 		nil,
@@ -96,6 +104,18 @@ func getConfig(config map[string]string) map[string]string {
 	return defaultConfig
 }
 
+func getAutoscaling(autoscaling *gardencorev1beta1.NginxIngressAutoscaling) *nginxingress.Autoscaling {
+	if autoscaling == nil {
+		return nil
+	}
+
+	return &nginxingress.Autoscaling{
+		MinReplicas:                    ptr.Deref(autoscaling.MinReplicas, 1),
+		MaxReplicas:                    autoscaling.MaxReplicas,
+		TargetCPUUtilizationPercentage: ptr.Deref(autoscaling.TargetCPUUtilizationPercentage, 80),
+	}
+}
+
 // NeedsIngressDNS returns true if the Shoot cluster needs ingress DNS.
 func (b *Botanist) NeedsIngressDNS() bool {
 	return b.NeedsExternalDNS() && v1beta1helper.NginxIngressEnabled(b.Shoot.GetInfo().Spec.Addons)
@@ -141,6 +141,18 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 		}
 
 		b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHPublicKeys(publicKeys)
+
+		caSSHSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameCASSH)
+		if !found {
+			return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCASSH)
+		}
+		caPublicKeys := []string{string(caSSHSecret.Data[secretsutils.DataKeySSHAuthorizedKeys])}
+
+		if caSSHSecretOld, found := b.SecretsManager.Get(v1beta1constants.SecretNameCASSH, secretsmanager.Old); found {
+			caPublicKeys = append(caPublicKeys, string(caSSHSecretOld.Data[secretsutils.DataKeySSHAuthorizedKeys]))
+		}
+
+		b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHCAPublicKeys(caPublicKeys)
 	}
 
 	var clusterDNSAddresses []string
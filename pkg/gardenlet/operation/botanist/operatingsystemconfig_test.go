@@ -77,6 +77,7 @@ var _ = Describe("operatingsystemconfig", func() {
 		By("Create secrets managed outside of this function for which secretsmanager.Get() will be called")
 		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: namespace}, Data: map[string][]byte{"bundle.crt": []byte(caBundle)}})).To(Succeed())
 		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ssh-keypair", Namespace: namespace}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca-ssh", Namespace: namespace}, Data: map[string][]byte{"id_rsa.pub": []byte("ssh-rsa ca")}})).To(Succeed())
 
 		botanist = &Botanist{
 			Operation: &operation.Operation{
@@ -132,6 +133,7 @@ var _ = Describe("operatingsystemconfig", func() {
 			BeforeEach(func() {
 				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
 				operatingSystemConfig.EXPECT().SetSSHPublicKeys(gomock.AssignableToTypeOf([]string{}))
+				operatingSystemConfig.EXPECT().SetSSHCAPublicKeys([]string{"ssh-rsa ca"})
 				operatingSystemConfig.EXPECT().SetClusterDNSAddresses(coreDNS)
 			})
 
@@ -195,6 +197,7 @@ var _ = Describe("operatingsystemconfig", func() {
 			BeforeEach(func() {
 				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
 				operatingSystemConfig.EXPECT().SetSSHPublicKeys(gomock.AssignableToTypeOf([]string{}))
+				operatingSystemConfig.EXPECT().SetSSHCAPublicKeys([]string{"ssh-rsa ca"})
 				operatingSystemConfig.EXPECT().SetClusterDNSAddresses(coreDNS)
 
 				shoot := botanist.Shoot.GetInfo()
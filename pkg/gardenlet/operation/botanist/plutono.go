@@ -47,5 +47,11 @@ func (b *Botanist) DeployPlutono(ctx context.Context) error {
 		b.Shoot.Components.ControlPlane.Plutono.SetWildcardCertName(ptr.To(b.ControlPlaneWildcardCert.GetName()))
 	}
 
+	additionalDashboards, err := plutono.LoadAdditionalShootDashboards(ctx, b.SeedClientSet.Client(), v1beta1constants.GardenNamespace, b.Shoot.Purpose)
+	if err != nil {
+		return err
+	}
+	b.Shoot.Components.ControlPlane.Plutono.SetAdditionalDashboards(additionalDashboards)
+
 	return b.Shoot.Components.ControlPlane.Plutono.Deploy(ctx)
 }
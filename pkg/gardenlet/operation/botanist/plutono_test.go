@@ -114,6 +114,33 @@ var _ = Describe("Plutono", func() {
 
 	Describe("#DeployPlutono", func() {
 		It("should successfully deploy plutono", func() {
+			mockPlutono.EXPECT().SetAdditionalDashboards(map[string]map[string]string{})
+			mockPlutono.EXPECT().Deploy(ctx)
+			Expect(botanist.DeployPlutono(ctx)).To(Succeed())
+		})
+
+		It("should successfully deploy plutono with additional dashboards", func() {
+			botanist.Shoot.Purpose = gardencorev1beta1.ShootPurposeEvaluation
+
+			Expect(seedClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "garden",
+					Labels:    map[string]string{"dashboard.monitoring.gardener.cloud/additional-shoot": "true"},
+				},
+				Data: map[string]string{"foo.json": "{}"},
+			})).To(Succeed())
+			Expect(seedClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "bar",
+					Namespace:   "garden",
+					Labels:      map[string]string{"dashboard.monitoring.gardener.cloud/additional-shoot": "true"},
+					Annotations: map[string]string{"dashboard.monitoring.gardener.cloud/shoot-purposes": "production"},
+				},
+				Data: map[string]string{"bar.json": "{}"},
+			})).To(Succeed())
+
+			mockPlutono.EXPECT().SetAdditionalDashboards(map[string]map[string]string{"foo": {"foo.json": "{}"}})
 			mockPlutono.EXPECT().Deploy(ctx)
 			Expect(botanist.DeployPlutono(ctx)).To(Succeed())
 		})
@@ -59,6 +59,7 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 			// TODO(vitanovs): Remove the VPAInPlaceUpdates webhook once the
 			// VPAInPlaceUpdates feature gates is deprecated.
 			VPAInPlaceUpdatesEnabled: b.isVPAInPlaceUpdatesEnabled(),
+			WorkloadDefaults:         b.Shoot.GetInfo().Spec.Kubernetes.WorkloadDefaults,
 		}
 	)
 
@@ -66,6 +67,10 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		values.MachineNamespace = ptr.To(b.Shoot.ControlPlaneNamespace)
 	}
 
+	if kubeAPIServer := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.ServiceAccountConfig != nil && kubeAPIServer.ServiceAccountConfig.ProjectedTokenExpiration != nil {
+		values.ProjectedTokenExpirationSeconds = ptr.To(int64(kubeAPIServer.ServiceAccountConfig.ProjectedTokenExpiration.Seconds()))
+	}
+
 	if b.Shoot.IsSelfHosted() {
 		values.KubernetesServiceHost = nil
 
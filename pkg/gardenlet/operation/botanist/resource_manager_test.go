@@ -109,6 +109,25 @@ var _ = Describe("ResourceManager", func() {
 			Expect(resourceManager.GetValues().DefaultUnreachableToleration).To(Equal(unreachableTolerationSeconds))
 		})
 
+		It("should consider the projected token expiration of the Shoot", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{
+						KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+							ServiceAccountConfig: &gardencorev1beta1.ServiceAccountConfig{
+								ProjectedTokenExpiration: &metav1.Duration{Duration: 2 * time.Hour},
+							},
+						},
+					},
+				},
+			})
+
+			resourceManager, err := botanist.DefaultResourceManager()
+			Expect(resourceManager).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceManager.GetValues().ProjectedTokenExpirationSeconds).To(PointTo(Equal(int64(7200))))
+		})
+
 		It("should successfully set PodTopologySpreadConstraintsEnabled=true if MatchLabelKeysInPodTopologySpread feature gate is disabled in the Shoot", func() {
 			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
 				Spec: gardencorev1beta1.ShootSpec{
@@ -152,6 +171,28 @@ var _ = Describe("ResourceManager", func() {
 			Expect(resourceManager.GetValues().NodeAgentAuthorizerAuthorizeWithSelectors).To(PointTo(Equal(true)))
 		})
 
+		It("should pass the workload defaults of the Shoot", func() {
+			workloadDefaults := &gardencorev1beta1.WorkloadDefaults{
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+					MaxSkew:           1,
+					TopologyKey:       corev1.LabelTopologyZone,
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+				}},
+			}
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{
+						WorkloadDefaults: workloadDefaults,
+					},
+				},
+			})
+
+			resourceManager, err := botanist.DefaultResourceManager()
+			Expect(resourceManager).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceManager.GetValues().WorkloadDefaults).To(Equal(workloadDefaults))
+		})
+
 		Context("self-hosted shoots", func() {
 			BeforeEach(func() {
 				shoot := botanist.Shoot.GetInfo()
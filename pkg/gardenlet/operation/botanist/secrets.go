@@ -52,7 +52,7 @@ func (b *Botanist) InitializeSecretsManagement(ctx context.Context) error {
 	}
 
 	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
-		taskFns = append(taskFns, b.generateSSHKeypair)
+		taskFns = append(taskFns, b.generateSSHKeypair, b.generateSSHCertificateAuthority)
 	} else {
 		taskFns = append(taskFns, b.deleteSSHKeypair)
 	}
@@ -81,6 +81,9 @@ func (b *Botanist) lastSecretRotationStartTimes() map[string]time.Time {
 
 		if shootStatus.Credentials.Rotation.SSHKeypair != nil && shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime != nil {
 			rotation[v1beta1constants.SecretNameSSHKeyPair] = shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime.Time
+			// The SSH certificate authority signs short-lived user certificates issued via the shoots/ssh subresource.
+			// Hence, let's rotate it together with the SSH keypair.
+			rotation[v1beta1constants.SecretNameCASSH] = shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime.Time
 		}
 
 		if shootStatus.Credentials.Rotation.Observability != nil && shootStatus.Credentials.Rotation.Observability.LastInitiationTime != nil {
@@ -345,6 +348,27 @@ func (b *Botanist) generateSSHKeypair(ctx context.Context) error {
 	return nil
 }
 
+func (b *Botanist) generateSSHCertificateAuthority(ctx context.Context) error {
+	caSSHSecret, err := b.SecretsManager.Generate(ctx, &secretsutils.RSASecretConfig{
+		Name:       v1beta1constants.SecretNameCASSH,
+		Bits:       4096,
+		UsedForSSH: true,
+	}, secretsmanager.Persist(), secretsmanager.Rotate(secretsmanager.KeepOld))
+	if err != nil {
+		return err
+	}
+
+	// The private key of the SSH certificate authority is only synced as an internal secret to the garden so that the
+	// gardener-apiserver can sign user certificates with it. It must never be exposed to end-users.
+	return b.syncInternalSecretToGarden(
+		ctx,
+		gardenerutils.ShootProjectSecretSuffixCASSH,
+		map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleCASSH},
+		nil,
+		caSSHSecret.Data,
+	)
+}
+
 func (b *Botanist) generateObservabilityIngressPassword(ctx context.Context) error {
 	secret, err := b.SecretsManager.Generate(ctx, &secretsutils.BasicAuthSecretConfig{
 		Name:           v1beta1constants.SecretNameObservabilityIngressUsers,
@@ -471,7 +495,16 @@ func (b *Botanist) syncShootConfigMapToGarden(
 }
 
 func (b *Botanist) deleteSSHKeypair(ctx context.Context) error {
-	return b.deleteShootCredentialFromGarden(ctx, gardenerutils.ShootProjectSecretSuffixSSHKeypair, gardenerutils.ShootProjectSecretSuffixOldSSHKeypair)
+	if err := b.deleteShootCredentialFromGarden(ctx, gardenerutils.ShootProjectSecretSuffixSSHKeypair, gardenerutils.ShootProjectSecretSuffixOldSSHKeypair); err != nil {
+		return err
+	}
+
+	return kubernetesutils.DeleteObject(ctx, b.GardenClient, &gardencorev1beta1.InternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gardenerutils.ComputeShootProjectResourceName(b.Shoot.GetInfo().Name, gardenerutils.ShootProjectSecretSuffixCASSH),
+			Namespace: b.Shoot.GetInfo().Namespace,
+		},
+	})
 }
 
 func (b *Botanist) deleteShootCredentialFromGarden(ctx context.Context, nameSuffixes ...string) error {
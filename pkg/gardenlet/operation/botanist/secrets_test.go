@@ -335,6 +335,26 @@ var _ = Describe("Secrets", func() {
 				Expect(gardenSecret.Labels).To(HaveKeyWithValue("gardener.cloud/role", "ssh-keypair"))
 			})
 
+			It("should generate the ssh certificate authority and sync it as internal secret to the garden", func() {
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				secretList := &corev1.SecretList{}
+				Expect(seedClient.List(ctx, secretList, client.InNamespace(controlPlaneNamespace), client.MatchingLabels{
+					"name":       "ca-ssh",
+					"managed-by": "secrets-manager",
+				})).To(Succeed())
+				Expect(secretList.Items).To(HaveLen(1))
+				Expect(secretList.Items[0].Labels).To(And(
+					HaveKeyWithValue("persist", "true"),
+					HaveKeyWithValue("rotation-strategy", "keepold"),
+				))
+
+				internalSecret := &gardencorev1beta1.InternalSecret{}
+				Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: shootName + ".ca-ssh"}, internalSecret)).To(Succeed())
+				Expect(internalSecret.Labels).To(HaveKeyWithValue("gardener.cloud/role", "ca-ssh"))
+				Expect(internalSecret.Data).To(And(HaveKey("id_rsa"), HaveKey("id_rsa.pub")))
+			})
+
 			It("should not generate the ssh keypair in case of workerless shoot", func() {
 				shoot := botanist.Shoot.GetInfo()
 				shoot.Spec.Provider.Workers = nil
@@ -363,6 +383,7 @@ var _ = Describe("Secrets", func() {
 			It("should delete ssh-keypair secrets when ssh access is set to false in workers settings", func() {
 				Expect(gardenClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: shootName + ".ssh-keypair", Namespace: gardenNamespace}})).To(Succeed())
 				Expect(gardenClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: shootName + ".ssh-keypair.old", Namespace: gardenNamespace}})).To(Succeed())
+				Expect(gardenClient.Create(ctx, &gardencorev1beta1.InternalSecret{ObjectMeta: metav1.ObjectMeta{Name: shootName + ".ca-ssh", Namespace: gardenNamespace}})).To(Succeed())
 
 				shoot := botanist.Shoot.GetInfo()
 				shoot.Spec = gardencorev1beta1.ShootSpec{
@@ -381,6 +402,7 @@ var _ = Describe("Secrets", func() {
 				gardenSecret := &corev1.Secret{}
 				Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: shootName + ".ssh-keypair"}, gardenSecret)).To(BeNotFoundError())
 				Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: shootName + ".ssh-keypair.old"}, gardenSecret)).To(BeNotFoundError())
+				Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: shootName + ".ca-ssh"}, &gardencorev1beta1.InternalSecret{})).To(BeNotFoundError())
 			})
 
 			Context("observability credentials", func() {
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/extensions/shootleftover"
+	"github.com/gardener/gardener/pkg/utils/workloadidentity"
+)
+
+// DefaultShootLeftover creates the default deployer for the ShootLeftover custom resource.
+func (b *Botanist) DefaultShootLeftover() component.Deployer {
+	var cleanup bool
+	if b.Config != nil && b.Config.Controllers != nil && b.Config.Controllers.ShootLeftover != nil {
+		cleanup = ptr.Deref(b.Config.Controllers.ShootLeftover.Cleanup, false)
+	}
+
+	name := b.shootLeftoverName()
+
+	return shootleftover.New(
+		b.SeedClientSet.Client(),
+		b.Clock,
+		&shootleftover.Values{
+			Name:           name,
+			Type:           b.Shoot.GetInfo().Spec.Provider.Type,
+			ProviderConfig: b.Shoot.GetInfo().Spec.Provider.InfrastructureConfig,
+			Region:         b.Shoot.GetInfo().Spec.Region,
+			TechnicalID:    b.Shoot.ControlPlaneNamespace,
+			SecretRef: corev1.SecretReference{
+				Name:      shootleftover.SecretNamePrefix + name,
+				Namespace: v1beta1constants.GardenNamespace,
+			},
+			Cleanup:        cleanup,
+			ShootName:      b.Shoot.GetInfo().Name,
+			ShootNamespace: b.Shoot.GetInfo().Namespace,
+		},
+	)
+}
+
+// DeployShootLeftover deploys the ShootLeftover custom resource together with a secret in the garden namespace of the
+// seed which references the WorkloadIdentity of the shoot. Both outlive the control plane namespace of the shoot so that
+// the provider extension can detect resources which were left behind after the shoot was deleted. Static credentials
+// are never copied out of the control plane namespace, hence no ShootLeftover is deployed for shoots using them.
+func (b *Botanist) DeployShootLeftover(ctx context.Context) error {
+	switch credentials := b.Shoot.Credentials.(type) {
+	case *securityv1alpha1.WorkloadIdentity:
+		if err := workloadidentity.Deploy(
+			ctx, b.SeedClientSet.Client(), credentials, shootleftover.SecretNamePrefix+b.shootLeftoverName(), v1beta1constants.GardenNamespace,
+			nil, map[string]string{v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeShootLeftover},
+			b.Shoot.GetInfo(),
+		); err != nil {
+			return err
+		}
+		return b.Shoot.Components.Extensions.ShootLeftover.Deploy(ctx)
+	case *gardencorev1beta1.InternalSecret, *corev1.Secret:
+		b.Logger.Info("Skipping deployment of ShootLeftover since the shoot uses static credentials which are not copied to the seed")
+		return nil
+	default:
+		return fmt.Errorf("unexpected type %T, should be either Secret, InternalSecret, or WorkloadIdentity", credentials)
+	}
+}
+
+func (b *Botanist) shootLeftoverName() string {
+	uid := b.Shoot.GetInfo().Status.UID
+	if uid == "" {
+		uid = b.Shoot.GetInfo().UID
+	}
+	return fmt.Sprintf("%s--%s", b.Shoot.ControlPlaneNamespace, uid)
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ShootLeftover", func() {
+	var (
+		ctx = context.TODO()
+
+		controlPlaneNamespace = "shoot--foo--bar"
+		name                  = "shoot--foo--bar--daa71cd9-c81a-45ac-a3d3-8bc2f4926a30"
+		secretName            = "shootleftover-" + name
+
+		seedClient client.Client
+		botanist   *Botanist
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				Logger:        logr.Discard(),
+				Clock:         testclock.NewFakeClock(time.Now()),
+				SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
+				Config: &gardenletconfigv1alpha1.GardenletConfiguration{
+					Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{
+						ShootLeftover: &gardenletconfigv1alpha1.ShootLeftoverControllerConfiguration{Cleanup: ptr.To(true)},
+					},
+				},
+				Shoot: &shootpkg.Shoot{
+					ControlPlaneNamespace: controlPlaneNamespace,
+					Components: &shootpkg.Components{
+						Extensions: &shootpkg.Extensions{},
+					},
+				},
+			},
+		}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Shoot",
+				APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bar",
+				Namespace: "garden-foo",
+				UID:       types.UID("daa71cd9-c81a-45ac-a3d3-8bc2f4926a30"),
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Type:                 "provider",
+					InfrastructureConfig: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+				},
+				Region: "region",
+			},
+		})
+		botanist.Shoot.Components.Extensions.ShootLeftover = botanist.DefaultShootLeftover()
+	})
+
+	Describe("#DeployShootLeftover", func() {
+		It("should reference WorkloadIdentity credentials and deploy the ShootLeftover resource", func() {
+			botanist.Shoot.Credentials = &securityv1alpha1.WorkloadIdentity{
+				ObjectMeta: metav1.ObjectMeta{Name: "wi-name", Namespace: "wi-namespace"},
+				Spec: securityv1alpha1.WorkloadIdentitySpec{
+					TargetSystem: securityv1alpha1.TargetSystem{Type: "provider"},
+				},
+			}
+
+			Expect(botanist.DeployShootLeftover(ctx)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: "garden", Name: secretName}, secret)).To(Succeed())
+			Expect(secret.Labels).To(HaveKeyWithValue("gardener.cloud/purpose", "shootleftover"))
+			Expect(secret.Annotations).To(HaveKeyWithValue("workloadidentity.security.gardener.cloud/name", "wi-name"))
+			Expect(secret.Annotations).To(HaveKeyWithValue("workloadidentity.security.gardener.cloud/namespace", "wi-namespace"))
+			Expect(secret.Data).To(BeEmpty())
+
+			shootLeftover := &extensionsv1alpha1.ShootLeftover{}
+			Expect(seedClient.Get(ctx, client.ObjectKey{Name: name}, shootLeftover)).To(Succeed())
+			Expect(shootLeftover.Labels).To(Equal(map[string]string{
+				"shoot.gardener.cloud/name":      "bar",
+				"shoot.gardener.cloud/namespace": "garden-foo",
+			}))
+			Expect(shootLeftover.Spec).To(Equal(extensionsv1alpha1.ShootLeftoverSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           "provider",
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+				},
+				Region:      "region",
+				TechnicalID: controlPlaneNamespace,
+				SecretRef:   corev1.SecretReference{Name: secretName, Namespace: "garden"},
+				Cleanup:     true,
+			}))
+		})
+
+		DescribeTable("should neither copy static credentials nor deploy the ShootLeftover resource",
+			func(credentials client.Object) {
+				botanist.Shoot.Credentials = credentials
+
+				Expect(botanist.DeployShootLeftover(ctx)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: "garden", Name: secretName}, &corev1.Secret{})).To(BeNotFoundError())
+				Expect(seedClient.Get(ctx, client.ObjectKey{Name: name}, &extensionsv1alpha1.ShootLeftover{})).To(BeNotFoundError())
+			},
+
+			Entry("Secret", &corev1.Secret{Data: map[string][]byte{"foo": []byte("bar")}}),
+			Entry("InternalSecret", &gardencorev1beta1.InternalSecret{Data: map[string][]byte{"foo": []byte("bar")}}),
+		)
+
+		It("should return an error when shoot credentials are of unknown type", func() {
+			botanist.Shoot.Credentials = &corev1.Pod{}
+
+			Expect(botanist.DeployShootLeftover(ctx)).To(MatchError(Equal("unexpected type *v1.Pod, should be either Secret, InternalSecret, or WorkloadIdentity")))
+		})
+	})
+})
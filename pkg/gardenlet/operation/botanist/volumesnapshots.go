@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/shoot/volumesnapshots"
+)
+
+// DefaultVolumeSnapshots returns a deployer for the volume snapshot classes and policies configured in the Shoot
+// specification.
+func (b *Botanist) DefaultVolumeSnapshots() component.DeployWaiter {
+	var values volumesnapshots.Values
+
+	if systemComponents := b.Shoot.GetInfo().Spec.SystemComponents; systemComponents != nil && systemComponents.VolumeSnapshots != nil {
+		values.Classes = systemComponents.VolumeSnapshots.Classes
+		values.Policies = systemComponents.VolumeSnapshots.Policies
+	}
+
+	return volumesnapshots.New(
+		b.SeedClientSet.Client(),
+		b.Shoot.ControlPlaneNamespace,
+		values,
+	)
+}
+
+// DeployVolumeSnapshots deploys the volume snapshot classes and policies into the shoot cluster if they are configured.
+// Otherwise, it destroys them.
+func (b *Botanist) DeployVolumeSnapshots(ctx context.Context) error {
+	if systemComponents := b.Shoot.GetInfo().Spec.SystemComponents; systemComponents == nil || systemComponents.VolumeSnapshots == nil {
+		return b.Shoot.Components.SystemComponents.VolumeSnapshots.Destroy(ctx)
+	}
+
+	return b.Shoot.Components.SystemComponents.VolumeSnapshots.Deploy(ctx)
+}
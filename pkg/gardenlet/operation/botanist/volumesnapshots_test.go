@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/shoot/volumesnapshots"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("VolumeSnapshots", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+
+		fakeClient client.Client
+		botanist   *Botanist
+
+		managedResource *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+			Shoot: &shootpkg.Shoot{
+				ControlPlaneNamespace: namespace,
+				Components: &shootpkg.Components{
+					SystemComponents: &shootpkg.SystemComponents{},
+				},
+			},
+		}}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
+
+		managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: volumesnapshots.ManagedResourceName, Namespace: namespace}}
+	})
+
+	Describe("#DeployVolumeSnapshots", func() {
+		It("should deploy the ManagedResource if volume snapshots are configured", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{
+				SystemComponents: &gardencorev1beta1.SystemComponents{
+					VolumeSnapshots: &gardencorev1beta1.VolumeSnapshots{
+						Classes: []gardencorev1beta1.VolumeSnapshotClass{{Name: "default", Driver: "csi.example.com"}},
+					},
+				},
+			}})
+			botanist.Shoot.Components.SystemComponents.VolumeSnapshots = botanist.DefaultVolumeSnapshots()
+
+			Expect(botanist.DeployVolumeSnapshots(ctx)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		})
+
+		It("should delete the ManagedResource if volume snapshots are not configured", func() {
+			Expect(fakeClient.Create(ctx, managedResource)).To(Succeed())
+			botanist.Shoot.Components.SystemComponents.VolumeSnapshots = botanist.DefaultVolumeSnapshots()
+
+			Expect(botanist.DeployVolumeSnapshots(ctx)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+})
@@ -59,8 +59,12 @@ func (b *Botanist) DefaultVPNSeedServer() (vpnseedserver.Interface, error) {
 	), nil
 }
 
-// DeployVPNServer deploys the vpn-seed-server.
+// DeployVPNServer deploys the vpn-seed-server. It is destroyed if the shoot uses konnectivity instead of the VPN.
 func (b *Botanist) DeployVPNServer(ctx context.Context) error {
+	if b.ShootUsesKonnectivity() {
+		return b.Shoot.Components.ControlPlane.VPNSeedServer.Destroy(ctx)
+	}
+
 	b.Shoot.Components.ControlPlane.VPNSeedServer.SetNodeNetworkCIDRs(b.Shoot.Networks.Nodes)
 	b.Shoot.Components.ControlPlane.VPNSeedServer.SetServiceNetworkCIDRs(b.Shoot.Networks.Services)
 	b.Shoot.Components.ControlPlane.VPNSeedServer.SetPodNetworkCIDRs(b.Shoot.Networks.Pods)
@@ -132,6 +132,7 @@ var _ = Describe("VPNSeedServer", func() {
 					Nodes:    []net.IPNet{{IP: net.IP{10, 0, 3, 0}, Mask: net.CIDRMask(24, 32)}},
 				},
 			}
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
 			botanist.Config = &gardenletconfigv1alpha1.GardenletConfiguration{
 				SNI: &gardenletconfigv1alpha1.SNI{
 					Ingress: &gardenletconfigv1alpha1.SNIIngress{
@@ -165,4 +166,30 @@ var _ = Describe("VPNSeedServer", func() {
 			Expect(botanist.DeployVPNServer(ctx)).To(Equal(fakeErr))
 		})
 	})
+
+	Describe("#DeployVPNSeedServer with konnectivity", func() {
+		It("should destroy the vpn-seed-server", func() {
+			ctx := context.TODO()
+			vpnSeedServer := mockvpnseedserver.NewMockInterface(ctrl)
+			botanist.Shoot = &shootpkg.Shoot{
+				Components: &shootpkg.Components{
+					ControlPlane: &shootpkg.ControlPlane{
+						VPNSeedServer: vpnSeedServer,
+					},
+				},
+			}
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{
+						KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+							NodeConnectivityMode: ptr.To(gardencorev1beta1.NodeConnectivityModeKonnectivity),
+						},
+					},
+				},
+			})
+
+			vpnSeedServer.EXPECT().Destroy(ctx)
+			Expect(botanist.DeployVPNServer(ctx)).To(Succeed())
+		})
+	})
 })
@@ -59,8 +59,12 @@ func (b *Botanist) DefaultVPNShoot() (vpnshoot.Interface, error) {
 	), nil
 }
 
-// DeployVPNShoot deploys the vpn-shoot.
+// DeployVPNShoot deploys the vpn-shoot. It is destroyed if the shoot uses konnectivity instead of the VPN.
 func (b *Botanist) DeployVPNShoot(ctx context.Context) error {
+	if b.ShootUsesKonnectivity() {
+		return b.Shoot.Components.SystemComponents.VPNShoot.Destroy(ctx)
+	}
+
 	b.Shoot.Components.SystemComponents.VPNShoot.SetPodNetworkCIDRs(b.Shoot.Networks.Pods)
 	b.Shoot.Components.SystemComponents.VPNShoot.SetServiceNetworkCIDRs(b.Shoot.Networks.Services)
 	b.Shoot.Components.SystemComponents.VPNShoot.SetNodeNetworkCIDRs(b.Shoot.Networks.Nodes)
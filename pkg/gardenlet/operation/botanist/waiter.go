@@ -35,13 +35,13 @@ func (b *Botanist) WaitUntilNginxIngressServiceIsReady(ctx context.Context) erro
 	return nil
 }
 
-// WaitUntilTunnelConnectionExists waits until a port forward connection to the tunnel pod (vpn-shoot) in the kube-system
-// namespace of the Shoot cluster can be established.
+// WaitUntilTunnelConnectionExists waits until a port forward connection to the tunnel pod (vpn-shoot or
+// konnectivity-agent) in the kube-system namespace of the Shoot cluster can be established.
 func (b *Botanist) WaitUntilTunnelConnectionExists(ctx context.Context) error {
 	const timeout = 15 * time.Minute
 
 	return retry.UntilTimeout(ctx, 5*time.Second, timeout, func(ctx context.Context) (bool, error) {
-		return CheckTunnelConnection(ctx, b.Logger, b.ShootClientSet, v1beta1constants.VPNTunnel)
+		return CheckTunnelConnection(ctx, b.Logger, b.ShootClientSet, b.TunnelName())
 	})
 }
 
@@ -7,6 +7,7 @@ package botanist
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -140,9 +141,9 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 			}
 
 			if nodeChecksum, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; !ok {
-				result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q hasn't been reported yet", node.Name))
+				result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q hasn't been reported yet%s", node.Name, failedToConvergeMessage(node)))
 			} else if nodeChecksum != secretChecksum {
-				result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q is outdated (current: %s, desired: %s)", node.Name, nodeChecksum, secretChecksum))
+				result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q is outdated (current: %s, desired: %s)%s", node.Name, nodeChecksum, secretChecksum, failedToConvergeMessage(node)))
 			}
 		}
 	}
@@ -150,6 +151,32 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 	return result
 }
 
+// failedToConvergeMessage returns a message suffix listing the files and units which gardener-node-agent reported as
+// failed in the apply status annotation of the given node. It returns an empty string if there are none.
+func failedToConvergeMessage(node corev1.Node) string {
+	var failed []string
+	for entry := range strings.SplitSeq(node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyApplyStatus], ",") {
+		if name, ok := strings.CutSuffix(entry, "=Failed"); ok {
+			failed = append(failed, name)
+		}
+	}
+
+	if len(failed) == 0 {
+		return ""
+	}
+	return ", failed to converge: " + strings.Join(failed, ", ")
+}
+
+// OperatingSystemConfigOutdated checks if the given node has not yet successfully applied the desired version of the
+// operating system config whose secret has the given metadata. Nodes which are about to be deleted are not considered.
+func OperatingSystemConfigOutdated(node corev1.Node, operatingSystemConfigSecretMeta metav1.ObjectMeta) bool {
+	if nodeToBeDeleted(node, operatingSystemConfigSecretMeta.Name) {
+		return false
+	}
+
+	return node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] != operatingSystemConfigSecretMeta.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig]
+}
+
 func nodeToBeDeleted(node corev1.Node, gardenerNodeAgentSecretName string) bool {
 	if nodeTaintedForNoSchedule(node) {
 		return true
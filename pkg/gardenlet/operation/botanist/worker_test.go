@@ -302,6 +302,24 @@ var _ = Describe("Worker", func() {
 			}},
 			MatchError(ContainSubstring("is outdated")),
 		),
+		Entry("checksum annotation outdated with failed units",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			map[string][]corev1.Node{"pool1": {{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"checksum/cloud-config-data":             "outdated",
+					"node-agent.gardener.cloud/apply-status": "file:/etc/foo=Succeeded,unit:foo.service=Failed,unit:bar.service=Pending",
+				},
+				Labels: map[string]string{
+					"worker.gardener.cloud/kubernetes-version":              "1.24.0",
+					"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0",
+				},
+			}}}},
+			map[string]metav1.ObjectMeta{"pool1": {
+				Name:        "gardener-node-agent--c63c0",
+				Annotations: map[string]string{"checksum/data-script": "foo"},
+			}},
+			MatchError(ContainSubstring("is outdated (current: outdated, desired: foo), failed to converge: unit:foo.service")),
+		),
 		Entry("skip node marked by MCM for termination",
 			[]gardencorev1beta1.Worker{{Name: "pool1"}},
 			map[string][]corev1.Node{"pool1": {{
@@ -367,6 +385,44 @@ var _ = Describe("Worker", func() {
 		),
 	)
 
+	DescribeTable("#OperatingSystemConfigOutdated",
+		func(node corev1.Node, secretMeta metav1.ObjectMeta, expected bool) {
+			Expect(OperatingSystemConfigOutdated(node, secretMeta)).To(Equal(expected))
+		},
+
+		Entry("checksum annotation missing",
+			corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+			}},
+			metav1.ObjectMeta{Name: "gardener-node-agent--c63c0", Annotations: map[string]string{"checksum/data-script": "foo"}},
+			true,
+		),
+		Entry("checksum annotation outdated",
+			corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"checksum/cloud-config-data": "outdated"},
+				Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+			}},
+			metav1.ObjectMeta{Name: "gardener-node-agent--c63c0", Annotations: map[string]string{"checksum/data-script": "foo"}},
+			true,
+		),
+		Entry("node whose OSC key does not match secret OSC key",
+			corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"checksum/cloud-config-data": "outdated"},
+				Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+			}},
+			metav1.ObjectMeta{Name: "gardener-node-agent--c63c1", Annotations: map[string]string{"checksum/data-script": "foo"}},
+			false,
+		),
+		Entry("up-to-date",
+			corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"checksum/cloud-config-data": "foo"},
+				Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+			}},
+			metav1.ObjectMeta{Name: "gardener-node-agent--c63c0", Annotations: map[string]string{"checksum/data-script": "foo"}},
+			false,
+		),
+	)
+
 	Describe("#WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools", func() {
 		var (
 			seedInterface  *kubernetesmock.MockInterface
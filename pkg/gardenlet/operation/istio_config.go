@@ -13,6 +13,7 @@ import (
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
+	"github.com/gardener/gardener/pkg/features"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -53,6 +54,16 @@ func (o *Operation) WildcardIstioLabels() map[string]string {
 	return sharedcomponent.GetIstioZoneLabels(o.Config.SNI.Ingress.Labels, nil)
 }
 
+// IstioTLSTerminationEnabled returns true if the istio ingress gateway responsible for the shoot cluster terminates TLS
+// for the kube-apiserver and originates new TLS connections with client certificates to it. The setting of the exposure
+// class handler takes precedence over the seed setting and the IstioTLSTermination feature gate.
+func (o *Operation) IstioTLSTerminationEnabled() bool {
+	if exposureClassHandler := o.exposureClassHandler(); exposureClassHandler != nil && exposureClassHandler.IstioTLSTermination != nil {
+		return *exposureClassHandler.IstioTLSTermination
+	}
+	return features.DefaultFeatureGate.Enabled(features.IstioTLSTermination) || v1beta1helper.SeedSettingIstioTLSTerminationEnabled(o.Seed.GetInfo().Spec.Settings)
+}
+
 func (o *Operation) istioLabels(zone *string) map[string]string {
 	if exposureClassHandler := o.exposureClassHandler(); exposureClassHandler != nil {
 		return sharedcomponent.GetIstioZoneLabels(gardenerutils.GetMandatoryExposureClassHandlerSNILabels(exposureClassHandler.SNI.Ingress.Labels, exposureClassHandler.Name), zone)
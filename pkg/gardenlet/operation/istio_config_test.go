@@ -10,15 +10,18 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/features"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("istioconfig", func() {
@@ -194,5 +197,46 @@ var _ = Describe("istioconfig", func() {
 				),
 			)
 		})
+		Describe("#IstioTLSTerminationEnabled", func() {
+			BeforeEach(func() {
+				operation.Config = gardenletConfig.DeepCopy()
+			})
+
+			It("should return false by default", func() {
+				Expect(operation.IstioTLSTerminationEnabled()).To(BeFalse())
+			})
+
+			It("should return true if the feature gate is enabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.IstioTLSTermination, true))
+
+				Expect(operation.IstioTLSTerminationEnabled()).To(BeTrue())
+			})
+
+			It("should return true if it is enabled in the seed settings", func() {
+				seed.Spec.Settings.IstioTLSTermination = &gardencorev1beta1.SeedSettingIstioTLSTermination{Enabled: true}
+
+				Expect(operation.IstioTLSTerminationEnabled()).To(BeTrue())
+			})
+
+			It("should fall back to the seed settings if the exposure class handler does not configure it", func() {
+				seed.Spec.Settings.IstioTLSTermination = &gardencorev1beta1.SeedSettingIstioTLSTermination{Enabled: true}
+				operation.Shoot.ExposureClass = exposureClass
+
+				Expect(operation.IstioTLSTerminationEnabled()).To(BeTrue())
+			})
+
+			It("should prefer the setting of the exposure class handler", func() {
+				seed.Spec.Settings.IstioTLSTermination = &gardencorev1beta1.SeedSettingIstioTLSTermination{Enabled: true}
+				operation.Config.ExposureClassHandlers[0].IstioTLSTermination = ptr.To(false)
+				operation.Shoot.ExposureClass = exposureClass
+
+				Expect(operation.IstioTLSTerminationEnabled()).To(BeFalse())
+
+				seed.Spec.Settings.IstioTLSTermination = nil
+				operation.Config.ExposureClassHandlers[0].IstioTLSTermination = ptr.To(true)
+
+				Expect(operation.IstioTLSTerminationEnabled()).To(BeTrue())
+			})
+		})
 	})
 })
@@ -239,6 +239,31 @@ func (s *Seed) GetZonalLoadBalancerServiceProxyProtocolTermination(zone string)
 	return s.GetLoadBalancerServiceProxyProtocolTermination()
 }
 
+// GetLoadBalancerServiceProxyProtocolTLVs returns the PROXY protocol TLVs which should be parsed for load balancer services.
+func (s *Seed) GetLoadBalancerServiceProxyProtocolTLVs() []gardencorev1beta1.ProxyProtocolTLV {
+	seed := s.GetInfo()
+	if seed.Spec.Settings != nil && seed.Spec.Settings.LoadBalancerServices != nil && seed.Spec.Settings.LoadBalancerServices.ProxyProtocol != nil {
+		return seed.Spec.Settings.LoadBalancerServices.ProxyProtocol.TLVs
+	}
+	return nil
+}
+
+// GetZonalLoadBalancerServiceProxyProtocolTLVs returns the PROXY protocol TLVs which should be parsed for load balancer services for the specified zone.
+func (s *Seed) GetZonalLoadBalancerServiceProxyProtocolTLVs(zone string) []gardencorev1beta1.ProxyProtocolTLV {
+	seed := s.GetInfo()
+	if seed.Spec.Settings != nil && seed.Spec.Settings.LoadBalancerServices != nil {
+		for _, zoneSettings := range seed.Spec.Settings.LoadBalancerServices.Zones {
+			if zoneSettings.Name == zone {
+				if zoneSettings.ProxyProtocol != nil {
+					return zoneSettings.ProxyProtocol.TLVs
+				}
+				break
+			}
+		}
+	}
+	return s.GetLoadBalancerServiceProxyProtocolTLVs()
+}
+
 // IsDualStack checks if the seed is a dual-stack seed.
 func (s *Seed) IsDualStack() bool {
 	seed := s.GetInfo()
@@ -257,6 +257,33 @@ var _ = Describe("seed", func() {
 		})
 	})
 
+	Describe("#GetLoadBalancerServiceProxyProtocolTLVs", func() {
+		It("should return the TLVs", func() {
+			seed := &Seed{}
+			seed.SetInfo(&gardencorev1beta1.Seed{
+				Spec: gardencorev1beta1.SeedSpec{
+					Settings: &gardencorev1beta1.SeedSettings{
+						LoadBalancerServices: &gardencorev1beta1.SeedSettingLoadBalancerServices{
+							ProxyProtocol: &gardencorev1beta1.LoadBalancerServicesProxyProtocol{
+								Allowed: true,
+								TLVs:    []gardencorev1beta1.ProxyProtocolTLV{{Type: 234, Name: "vpce-id"}},
+							},
+						},
+					},
+				},
+			})
+
+			Expect(seed.GetLoadBalancerServiceProxyProtocolTLVs()).To(ConsistOf(gardencorev1beta1.ProxyProtocolTLV{Type: 234, Name: "vpce-id"}))
+		})
+
+		It("should return no TLVs if no settings are available", func() {
+			seed := &Seed{}
+			seed.SetInfo(&gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{}})
+
+			Expect(seed.GetLoadBalancerServiceProxyProtocolTLVs()).To(BeNil())
+		})
+	})
+
 	Describe("#GetZonalLoadBalancerServiceAnnotations", func() {
 		It("should return the zonal annotations", func() {
 			var (
@@ -479,6 +506,57 @@ var _ = Describe("seed", func() {
 		})
 	})
 
+	Describe("#GetZonalLoadBalancerServiceProxyProtocolTLVs", func() {
+		var seed *Seed
+
+		BeforeEach(func() {
+			seed = &Seed{}
+			seed.SetInfo(&gardencorev1beta1.Seed{
+				Spec: gardencorev1beta1.SeedSpec{
+					Settings: &gardencorev1beta1.SeedSettings{
+						LoadBalancerServices: &gardencorev1beta1.SeedSettingLoadBalancerServices{
+							ProxyProtocol: &gardencorev1beta1.LoadBalancerServicesProxyProtocol{
+								Allowed: true,
+								TLVs:    []gardencorev1beta1.ProxyProtocolTLV{{Type: 234, Name: "vpce-id"}},
+							},
+							Zones: []gardencorev1beta1.SeedSettingLoadBalancerServicesZones{
+								{
+									Name: "a",
+									ProxyProtocol: &gardencorev1beta1.LoadBalancerServicesProxyProtocol{
+										Allowed: true,
+										TLVs:    []gardencorev1beta1.ProxyProtocolTLV{{Type: 238, Name: "link-id"}},
+									},
+								},
+								{
+									Name: "b",
+									ProxyProtocol: &gardencorev1beta1.LoadBalancerServicesProxyProtocol{
+										Allowed: false,
+									},
+								},
+								{
+									Name: "c",
+								},
+							},
+						},
+					},
+				},
+			})
+		})
+
+		It("should return the zonal TLVs", func() {
+			Expect(seed.GetZonalLoadBalancerServiceProxyProtocolTLVs("a")).To(ConsistOf(gardencorev1beta1.ProxyProtocolTLV{Type: 238, Name: "link-id"}))
+		})
+
+		It("should return no TLVs if the zonal proxy protocol settings do not specify any", func() {
+			Expect(seed.GetZonalLoadBalancerServiceProxyProtocolTLVs("b")).To(BeEmpty())
+		})
+
+		It("should return the global TLVs if no zonal proxy protocol settings are available", func() {
+			Expect(seed.GetZonalLoadBalancerServiceProxyProtocolTLVs("c")).To(ConsistOf(gardencorev1beta1.ProxyProtocolTLV{Type: 234, Name: "vpce-id"}))
+			Expect(seed.GetZonalLoadBalancerServiceProxyProtocolTLVs("d")).To(ConsistOf(gardencorev1beta1.ProxyProtocolTLV{Type: 234, Name: "vpce-id"}))
+		})
+	})
+
 	Describe("#GetNodeCIDR", func() {
 		It("should return the node network CIDR", func() {
 			var (
@@ -37,6 +37,7 @@ import (
 	kubeproxy "github.com/gardener/gardener/pkg/component/kubernetes/proxy"
 	"github.com/gardener/gardener/pkg/component/networking/apiserverproxy"
 	"github.com/gardener/gardener/pkg/component/networking/coredns"
+	"github.com/gardener/gardener/pkg/component/networking/konnectivity"
 	"github.com/gardener/gardener/pkg/component/networking/nodelocaldns"
 	vpnseedserver "github.com/gardener/gardener/pkg/component/networking/vpn/seedserver"
 	vpnshoot "github.com/gardener/gardener/pkg/component/networking/vpn/shoot"
@@ -46,6 +47,8 @@ import (
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
 	"github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector"
 	"github.com/gardener/gardener/pkg/component/observability/plutono"
+	"github.com/gardener/gardener/pkg/component/shoot/accelerators"
+	"github.com/gardener/gardener/pkg/component/shoot/managedaddons"
 	shootsystem "github.com/gardener/gardener/pkg/component/shoot/system"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -160,15 +163,18 @@ type Extensions struct {
 	Infrastructure        infrastructure.Interface
 	Network               network.Interface
 	OperatingSystemConfig operatingsystemconfig.Interface
+	ShootLeftover         component.Deployer
 	Worker                worker.Interface
 }
 
 // SystemComponents contains references to system components.
 type SystemComponents struct {
+	Accelerators        accelerators.Interface
 	APIServerProxy      apiserverproxy.Interface
 	BlackboxExporter    component.DeployWaiter
 	ClusterIdentity     clusteridentity.Interface
 	CoreDNS             coredns.Interface
+	KonnectivityAgent   konnectivity.Interface
 	KubeProxy           kubeproxy.Interface
 	MetricsServer       component.DeployWaiter
 	Namespaces          component.DeployWaiter
@@ -176,6 +182,7 @@ type SystemComponents struct {
 	NodeProblemDetector component.DeployWaiter
 	NodeExporter        component.DeployWaiter
 	Resources           shootsystem.Interface
+	VolumeSnapshots     component.DeployWaiter
 	VPNShoot            vpnshoot.Interface
 }
 
@@ -183,6 +190,7 @@ type SystemComponents struct {
 type Addons struct {
 	KubernetesDashboard kubernetesdashboard.Interface
 	NginxIngress        component.Deployer
+	ManagedAddons       managedaddons.Interface
 }
 
 // Networks contains pre-calculated subnets and IP address for various components.
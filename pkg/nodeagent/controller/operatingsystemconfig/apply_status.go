@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	applyKindFile = "file"
+	applyKindUnit = "unit"
+)
+
+// applyError is returned when a single file or unit of the operating system config could not be applied.
+type applyError struct {
+	kind string
+	name string
+	err  error
+}
+
+func (e *applyError) Error() string {
+	return e.err.Error()
+}
+
+func (e *applyError) Unwrap() error {
+	return e.err
+}
+
+func fileApplyError(path string, err error) error {
+	return &applyError{kind: applyKindFile, name: path, err: err}
+}
+
+func unitApplyError(name string, err error) error {
+	return &applyError{kind: applyKindUnit, name: name, err: err}
+}
+
+func applyKey(kind, name string) string {
+	return kind + ":" + name
+}
+
+// applyStatus tracks the files and units which have to be applied for an operating system config.
+type applyStatus struct {
+	keys []string
+}
+
+// newApplyStatus collects the changed files, changed units and units with commands from the given changes. It must
+// be called before the changes are applied.
+func newApplyStatus(changes *operatingSystemConfigChanges) *applyStatus {
+	s := &applyStatus{}
+
+	for _, file := range changes.Files.Changed {
+		s.insert(applyKey(applyKindFile, file.Path))
+	}
+	for _, unit := range changes.Units.Changed {
+		s.insert(applyKey(applyKindUnit, unit.Name))
+	}
+	for _, unit := range changes.Units.Commands {
+		s.insert(applyKey(applyKindUnit, unit.Name))
+	}
+
+	return s
+}
+
+func (s *applyStatus) insert(key string) {
+	if !slices.Contains(s.keys, key) {
+		s.keys = append(s.keys, key)
+	}
+}
+
+// value computes the annotation value based on the remaining changes and the error which occurred while applying
+// them. Entries which are no longer part of the remaining changes have been applied successfully, the entry the error
+// refers to has failed, and all others are still pending.
+func (s *applyStatus) value(changes *operatingSystemConfigChanges, err error) string {
+	pending := make(map[string]struct{})
+	for _, file := range changes.Files.Changed {
+		pending[applyKey(applyKindFile, file.Path)] = struct{}{}
+	}
+	for _, unit := range changes.Units.Changed {
+		pending[applyKey(applyKindUnit, unit.Name)] = struct{}{}
+	}
+	for _, unit := range changes.Units.Commands {
+		pending[applyKey(applyKindUnit, unit.Name)] = struct{}{}
+	}
+
+	failed := failedApplyKeys(err)
+
+	keys := s.keys
+	for _, key := range failed {
+		if !slices.Contains(keys, key) {
+			keys = append(slices.Clone(keys), key)
+		}
+	}
+
+	var value []string
+	for _, key := range keys {
+		state := stateSucceeded
+		if slices.Contains(failed, key) {
+			state = stateFailed
+		} else if _, ok := pending[key]; ok {
+			state = statePending
+		}
+		value = append(value, fmt.Sprintf("%s=%s", key, state))
+	}
+
+	return strings.Join(value, ",")
+}
+
+// failedApplyKeys returns the keys of all files and units the given error refers to. Errors of parallel executions are
+// collected in a multierror, hence each of them is inspected.
+func failedApplyKeys(err error) []string {
+	errs := []error{err}
+	if multiErr := (&multierror.Error{}); errors.As(err, &multiErr) {
+		errs = multiErr.Errors
+	}
+
+	var keys []string
+	for _, e := range errs {
+		if applyErr := (&applyError{}); errors.As(e, &applyErr) {
+			keys = append(keys, applyKey(applyErr.kind, applyErr.name))
+		}
+	}
+	return keys
+}
@@ -19,6 +19,7 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
@@ -33,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/client-go/tools/events"
@@ -250,6 +252,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
+	applyStatus := newApplyStatus(oscChanges)
+
 	if isInPlaceUpdate(oscChanges) {
 		// In case of in-place update, we use retries for certain cases like OS update with higher timeouts,
 		// so we need to overwrite the context to use a longer timeout.
@@ -275,7 +279,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	log.Info("Applying new or changed inline and secretRef files")
 	if err := r.applyChangedInlineFiles(ctx, log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed inline files: %w", err)
+		return reconcile.Result{}, r.reportApplyStatus(ctx, log, node, applyStatus, oscChanges, fmt.Errorf("failed applying changed inline files: %w", err))
 	}
 
 	log.Info("Applying containerd registries")
@@ -286,12 +290,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	log.Info("Applying new or changed imageRef files")
 	if err := r.applyChangedImageRefFiles(ctx, log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed imageRef files: %w", err)
+		return reconcile.Result{}, r.reportApplyStatus(ctx, log, node, applyStatus, oscChanges, fmt.Errorf("failed applying changed imageRef files: %w", err))
 	}
 
 	log.Info("Applying new or changed units", "changedUnits", len(oscChanges.Units.Changed))
 	if err := r.applyChangedUnits(ctx, log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed units: %w", err)
+		return reconcile.Result{}, r.reportApplyStatus(ctx, log, node, applyStatus, oscChanges, fmt.Errorf("failed applying changed units: %w", err))
 	}
 
 	log.Info("Removing no longer needed units", "deletedUnits", len(oscChanges.Units.Deleted))
@@ -306,7 +310,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	log.Info("Executing unit commands (start/stop)", "unitCommands", len(oscChanges.Units.Commands))
 	if err := r.executeUnitCommands(ctx, log, node, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
+		return reconcile.Result{}, r.reportApplyStatus(ctx, log, node, applyStatus, oscChanges, fmt.Errorf("failed executing unit commands: %w", err))
 	}
 
 	if isInPlaceKubeletUpdate(oscChanges) {
@@ -334,6 +338,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed removing deleted files: %w", err)
 	}
 
+	log.Info("Executing bootstrap steps", "bootstrapSteps", len(osc.Status.ExtensionBootstrapSteps))
+	if err := r.executeBootstrapSteps(ctx, log, node, osc.Status.ExtensionBootstrapSteps, oscChecksum); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed executing bootstrap steps: %w", err)
+	}
+
 	if err := r.performInPlaceUpdate(ctx, log, osc, oscChanges, node, osVersion); err != nil {
 		// If the error is retriable, we requeue with a delay.
 		if retriableErrorPatternRegex.MatchString(err.Error()) {
@@ -384,6 +393,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	patch := client.MergeFrom(node.DeepCopy())
 	metav1.SetMetaDataLabel(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum)
+	delete(node.Annotations, nodeagentconfigv1alpha1.AnnotationKeyApplyStatus)
 	if err := r.Client.Patch(ctx, node, patch); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed patching Node annotations after OSC was applied: %w", err)
 	}
@@ -391,6 +401,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, serialReconciliationLease.release(ctx)
 }
 
+// reportApplyStatus reports the state of the files and units which had to be applied in an annotation on the node, so
+// that it is visible which of them failed to converge. It returns the given error.
+func (r *Reconciler) reportApplyStatus(ctx context.Context, log logr.Logger, node *corev1.Node, status *applyStatus, changes *operatingSystemConfigChanges, err error) error {
+	if node == nil {
+		return err
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyApplyStatus, status.value(changes, err))
+	if patchErr := r.Client.Patch(ctx, node, patch); patchErr != nil {
+		log.Error(patchErr, "Failed patching node with apply status")
+	}
+
+	return err
+}
+
 func (r *Reconciler) getNode(ctx context.Context) (*corev1.Node, bool, error) {
 	if r.NodeName != "" {
 		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: r.NodeName}}
@@ -453,7 +479,7 @@ func (r *Reconciler) applyChangedImageRefFiles(ctx context.Context, log logr.Log
 		}
 
 		if err != nil {
-			return fmt.Errorf("unable to copy file %q from image %q to %q: %w", filePathInImage, file.Content.ImageRef.Image, file.Path, err)
+			return fileApplyError(file.Path, fmt.Errorf("unable to copy file %q from image %q to %q: %w", filePathInImage, file.Content.ImageRef.Image, file.Path, err))
 		}
 
 		fileLog.Info("Successfully applied new or changed file from image", "filePathInImage", filePathInImage)
@@ -510,23 +536,23 @@ func (r *Reconciler) applyChangedInlineFiles(ctx context.Context, log logr.Logge
 	for _, file := range slices.Clone(changes.Files.Changed) {
 		data, ok, err := r.getFileContentData(ctx, file)
 		if err != nil {
-			return fmt.Errorf("unable to get data of file %q: %w", file.Path, err)
+			return fileApplyError(file.Path, fmt.Errorf("unable to get data of file %q: %w", file.Path, err))
 		}
 		if !ok {
 			continue
 		}
 
 		if err := r.FS.MkdirAll(filepath.Dir(file.Path), defaultDirPermissions); err != nil {
-			return fmt.Errorf("unable to create directory %q: %w", file.Path, err)
+			return fileApplyError(file.Path, fmt.Errorf("unable to create directory %q: %w", file.Path, err))
 		}
 
 		tmpFilePath := filepath.Join(tmpDir, filepath.Base(file.Path))
 		if err := r.FS.WriteFile(tmpFilePath, data, getFilePermissions(file)); err != nil {
-			return fmt.Errorf("unable to create temporary file %q: %w", tmpFilePath, err)
+			return fileApplyError(file.Path, fmt.Errorf("unable to create temporary file %q: %w", tmpFilePath, err))
 		}
 
 		if err := filespkg.Move(r.FS, tmpFilePath, file.Path); err != nil {
-			return fmt.Errorf("unable to rename temporary file %q to %q: %w", tmpFilePath, file.Path, err)
+			return fileApplyError(file.Path, fmt.Errorf("unable to rename temporary file %q to %q: %w", tmpFilePath, file.Path, err))
 		}
 
 		log.Info("Successfully applied new or changed file", "path", file.Path)
@@ -560,20 +586,20 @@ func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, cha
 		if unit.Content != nil {
 			oldUnitContent, err := r.FS.ReadFile(unitFilePath)
 			if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-				return fmt.Errorf("unable to read existing unit file %q for %q: %w", unitFilePath, unit.Name, err)
+				return unitApplyError(unit.Name, fmt.Errorf("unable to read existing unit file %q for %q: %w", unitFilePath, unit.Name, err))
 			}
 
 			newUnitContent := []byte(*unit.Content)
 			if !bytes.Equal(newUnitContent, oldUnitContent) {
 				if err := r.FS.WriteFile(unitFilePath, newUnitContent, defaultFilePermissions); err != nil {
-					return fmt.Errorf("unable to write unit file %q for %q: %w", unitFilePath, unit.Name, err)
+					return unitApplyError(unit.Name, fmt.Errorf("unable to write unit file %q for %q: %w", unitFilePath, unit.Name, err))
 				}
 				log.Info("Successfully applied new or changed unit file", "path", unitFilePath)
 			}
 
 			// ensure file permissions are restored in case somebody changed them manually
 			if err := r.FS.Chmod(unitFilePath, defaultFilePermissions); err != nil {
-				return fmt.Errorf("unable to ensure permissions for unit file %q for %q: %w", unitFilePath, unit.Name, err)
+				return unitApplyError(unit.Name, fmt.Errorf("unable to ensure permissions for unit file %q for %q: %w", unitFilePath, unit.Name, err))
 			}
 		}
 
@@ -581,11 +607,11 @@ func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, cha
 
 		if len(unit.DropIns) == 0 {
 			if err := r.FS.RemoveAll(dropInDirectory); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-				return fmt.Errorf("unable to delete systemd drop-in folder for unit %q: %w", unit.Name, err)
+				return unitApplyError(unit.Name, fmt.Errorf("unable to delete systemd drop-in folder for unit %q: %w", unit.Name, err))
 			}
 		} else {
 			if err := r.FS.MkdirAll(dropInDirectory, defaultDirPermissions); err != nil {
-				return fmt.Errorf("unable to create drop-in directory %q for unit %q: %w", dropInDirectory, unit.Name, err)
+				return unitApplyError(unit.Name, fmt.Errorf("unable to create drop-in directory %q for unit %q: %w", dropInDirectory, unit.Name, err))
 			}
 
 			for _, dropIn := range slices.Clone(unit.DropInsChanges.Changed) {
@@ -593,20 +619,20 @@ func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, cha
 
 				oldDropInContent, err := r.FS.ReadFile(dropInFilePath)
 				if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-					return fmt.Errorf("unable to read existing drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err)
+					return unitApplyError(unit.Name, fmt.Errorf("unable to read existing drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err))
 				}
 
 				newDropInContent := []byte(dropIn.Content)
 				if !bytes.Equal(newDropInContent, oldDropInContent) {
 					if err := r.FS.WriteFile(dropInFilePath, newDropInContent, defaultFilePermissions); err != nil {
-						return fmt.Errorf("unable to write drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err)
+						return unitApplyError(unit.Name, fmt.Errorf("unable to write drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err))
 					}
 					log.Info("Successfully applied new or changed drop-in file for unit", "path", dropInFilePath, "unit", unit.Name)
 				}
 
 				// ensure file permissions are restored in case somebody changed them manually
 				if err := r.FS.Chmod(dropInFilePath, defaultFilePermissions); err != nil {
-					return fmt.Errorf("unable to ensure permissions for drop-in file %q for unit %q: %w", unitFilePath, unit.Name, err)
+					return unitApplyError(unit.Name, fmt.Errorf("unable to ensure permissions for drop-in file %q for unit %q: %w", unitFilePath, unit.Name, err))
 				}
 				if err := changes.completedUnitDropInChanged(unit.Name, dropIn.Name); err != nil {
 					return err
@@ -616,7 +642,7 @@ func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, cha
 			for _, dropIn := range slices.Clone(unit.DropInsChanges.Deleted) {
 				dropInFilePath := path.Join(dropInDirectory, dropIn.Name)
 				if err := r.FS.Remove(dropInFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-					return fmt.Errorf("unable to delete drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err)
+					return unitApplyError(unit.Name, fmt.Errorf("unable to delete drop-in file %q for unit %q: %w", dropInFilePath, unit.Name, err))
 				}
 				log.Info("Successfully removed no longer needed drop-in file for unit", "path", dropInFilePath, "unitName", unit.Name)
 				if err := changes.completedUnitDropInDeleted(unit.Name, dropIn.Name); err != nil {
@@ -627,12 +653,12 @@ func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, cha
 
 		if unit.Name == nodeagentconfigv1alpha1.UnitName || ptr.Deref(unit.Enable, true) {
 			if err := r.DBus.Enable(ctx, unit.Name); err != nil {
-				return fmt.Errorf("unable to enable unit %q: %w", unit.Name, err)
+				return unitApplyError(unit.Name, fmt.Errorf("unable to enable unit %q: %w", unit.Name, err))
 			}
 			log.Info("Successfully enabled unit", "unitName", unit.Name)
 		} else {
 			if err := r.DBus.Disable(ctx, unit.Name); err != nil {
-				return fmt.Errorf("unable to disable unit %q: %w", unit.Name, err)
+				return unitApplyError(unit.Name, fmt.Errorf("unable to disable unit %q: %w", unit.Name, err))
 			}
 			log.Info("Successfully disabled unit", "unitName", unit.Name)
 		}
@@ -645,7 +671,7 @@ func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, cha
 			// See https://kubernetes.io/docs/tasks/administer-cluster/cpu-management-policies/#changing-the-cpu-manager-policy
 			log.Info("Removing kubelet cpu manager policy state file", "path", pathKubeletCPUManagerPolicyState)
 			if err := r.FS.Remove(pathKubeletCPUManagerPolicyState); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
-				return fmt.Errorf("failed removing kubelet cpu manager policy state file %q: %w", kubeletcomponent.PathKubeconfigReal, err)
+				return unitApplyError(unit.Name, fmt.Errorf("failed removing kubelet cpu manager policy state file %q: %w", kubeletcomponent.PathKubeconfigReal, err))
 			}
 		}
 	}
@@ -728,7 +754,7 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 
 		restart = func(ctx context.Context, unitName string) error {
 			if err := r.DBus.Restart(ctx, r.Recorder, node, unitName); err != nil {
-				return fmt.Errorf("unable to restart unit %q: %w", unitName, err)
+				return unitApplyError(unitName, fmt.Errorf("unable to restart unit %q: %w", unitName, err))
 			}
 			log.Info("Successfully restarted unit", "unitName", unitName)
 
@@ -743,7 +769,7 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 
 		stop = func(ctx context.Context, unitName string) error {
 			if err := r.DBus.Stop(ctx, r.Recorder, node, unitName); err != nil {
-				return fmt.Errorf("unable to stop unit %q: %w", unitName, err)
+				return unitApplyError(unitName, fmt.Errorf("unable to stop unit %q: %w", unitName, err))
 			}
 			log.Info("Successfully stopped unit", "unitName", unitName)
 			return oscChanges.completedUnitCommand(unitName)
@@ -774,7 +800,7 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 			case "":
 				return oscChanges.completedUnitCommand(unit.Name)
 			}
-			return fmt.Errorf("unknown unit command %q", unit.Command)
+			return unitApplyError(unit.Name, fmt.Errorf("unknown unit command %q", unit.Command))
 		})
 	}
 
@@ -787,6 +813,109 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 	return flow.Parallel(fns...)(ctx)
 }
 
+const (
+	statePending   = "Pending"
+	stateSucceeded = "Succeeded"
+	stateFailed    = "Failed"
+)
+
+// executeBootstrapSteps starts the units of the given bootstrap steps. A step is only executed after all steps it
+// depends on have succeeded, independent steps are executed in parallel. The state of the steps is reported in an
+// annotation on the node together with the checksum of the operating system configuration. Steps which already
+// succeeded for the given checksum are not executed again.
+func (r *Reconciler) executeBootstrapSteps(ctx context.Context, log logr.Logger, node *corev1.Node, steps []extensionsv1alpha1.BootstrapStep, oscChecksum string) error {
+	if len(steps) == 0 {
+		if node == nil || (!metav1.HasAnnotation(node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyBootstrapSteps) && !metav1.HasAnnotation(node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumBootstrapSteps)) {
+			return nil
+		}
+
+		patch := client.MergeFrom(node.DeepCopy())
+		delete(node.Annotations, nodeagentconfigv1alpha1.AnnotationKeyBootstrapSteps)
+		delete(node.Annotations, nodeagentconfigv1alpha1.AnnotationKeyChecksumBootstrapSteps)
+		return r.Client.Patch(ctx, node, patch)
+	}
+
+	sortedSteps, err := extensionsv1alpha1helper.SortBootstrapSteps(steps)
+	if err != nil {
+		return err
+	}
+
+	var (
+		g              = flow.NewGraph("Bootstrap steps")
+		mutex          sync.Mutex
+		states         = make(map[string]string, len(sortedSteps))
+		succeededSteps = succeededBootstrapSteps(node, oscChecksum)
+	)
+
+	for _, step := range sortedSteps {
+		states[step.Name] = statePending
+		if succeededSteps.Has(step.Name) {
+			log.Info("Skipping bootstrap step which already succeeded for this operating system config", "bootstrapStep", step.Name)
+			states[step.Name] = stateSucceeded
+		}
+
+		dependencies := flow.NewTaskIDs()
+		for _, dependency := range step.DependsOn {
+			dependencies.Insert(flow.TaskID(dependency))
+		}
+
+		_ = g.Add(flow.Task{
+			Name: step.Name,
+			Fn: func(ctx context.Context) error {
+				err := r.DBus.Start(ctx, r.Recorder, node, step.UnitName)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if err != nil {
+					states[step.Name] = stateFailed
+					return fmt.Errorf("unable to start unit %q of bootstrap step %q: %w", step.UnitName, step.Name, err)
+				}
+
+				log.Info("Successfully executed bootstrap step", "bootstrapStep", step.Name, "unitName", step.UnitName)
+				states[step.Name] = stateSucceeded
+				return nil
+			},
+			SkipIf:       succeededSteps.Has(step.Name),
+			Dependencies: dependencies,
+		})
+	}
+
+	flowErr := g.Compile().Run(ctx, flow.Opts{Log: log})
+
+	if node != nil {
+		var value []string
+		for _, step := range sortedSteps {
+			value = append(value, step.Name+"="+states[step.Name])
+		}
+
+		patch := client.MergeFrom(node.DeepCopy())
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyBootstrapSteps, strings.Join(value, ","))
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumBootstrapSteps, oscChecksum)
+		if err := r.Client.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed patching node with bootstrap steps status: %w", err)
+		}
+	}
+
+	return flowErr
+}
+
+// succeededBootstrapSteps returns the names of the bootstrap steps which are reported as succeeded on the node for the
+// operating system config with the given checksum.
+func succeededBootstrapSteps(node *corev1.Node, oscChecksum string) sets.Set[string] {
+	succeeded := sets.New[string]()
+	if node == nil || node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumBootstrapSteps] != oscChecksum {
+		return succeeded
+	}
+
+	for _, stepState := range strings.Split(node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyBootstrapSteps], ",") {
+		if name, state, ok := strings.Cut(stepState, "="); ok && state == stateSucceeded {
+			succeeded.Insert(name)
+		}
+	}
+	return succeeded
+}
+
 func isInPlaceUpdate(changes *operatingSystemConfigChanges) bool {
 	return changes.InPlaceUpdates.OperatingSystem ||
 		changes.InPlaceUpdates.Kubelet.MinorVersion ||
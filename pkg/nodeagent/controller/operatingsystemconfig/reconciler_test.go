@@ -245,6 +245,125 @@ PRETTY_NAME="Garden Linux 1592Foo"
 		})
 	})
 
+	Context("#executeBootstrapSteps", func() {
+		var steps []extensionsv1alpha1.BootstrapStep
+
+		BeforeEach(func() {
+			steps = []extensionsv1alpha1.BootstrapStep{
+				{Name: "third", UnitName: "third.service", DependsOn: []string{"second"}},
+				{Name: "first", UnitName: "first.service"},
+				{Name: "second", UnitName: "second.service", DependsOn: []string{"first"}},
+			}
+		})
+
+		It("should do nothing if there are no bootstrap steps", func() {
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, nil, "checksum")).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(BeEmpty())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).NotTo(HaveKey("node-agent.gardener.cloud/bootstrap-steps"))
+			Expect(node.Annotations).NotTo(HaveKey("checksum/bootstrap-steps"))
+		})
+
+		It("should remove the status annotations if there are no bootstrap steps anymore", func() {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "node-agent.gardener.cloud/bootstrap-steps", "foo=Succeeded")
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "checksum/bootstrap-steps", "checksum")
+			Expect(c.Update(ctx, node)).To(Succeed())
+
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, nil, "checksum")).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).NotTo(HaveKey("node-agent.gardener.cloud/bootstrap-steps"))
+			Expect(node.Annotations).NotTo(HaveKey("checksum/bootstrap-steps"))
+		})
+
+		It("should start the units in the order of the dependencies and report the status", func() {
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, steps, "checksum")).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStart, UnitNames: []string{"first.service"}},
+				{Action: fakedbus.ActionStart, UnitNames: []string{"second.service"}},
+				{Action: fakedbus.ActionStart, UnitNames: []string{"third.service"}},
+			}))
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/bootstrap-steps", "first=Succeeded,second=Succeeded,third=Succeeded"))
+			Expect(node.Annotations).To(HaveKeyWithValue("checksum/bootstrap-steps", "checksum"))
+		})
+
+		It("should not start the units again on a second reconciliation of the same configuration", func() {
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, steps, "checksum")).To(Succeed())
+			Expect(fakeDBus.Actions).To(HaveLen(3))
+
+			fakeDBus.Actions = nil
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, steps, "checksum")).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(BeEmpty())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/bootstrap-steps", "first=Succeeded,second=Succeeded,third=Succeeded"))
+		})
+
+		It("should start the units again if the configuration changed", func() {
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, steps, "checksum")).To(Succeed())
+
+			fakeDBus.Actions = nil
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, steps, "new-checksum")).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStart, UnitNames: []string{"first.service"}},
+				{Action: fakedbus.ActionStart, UnitNames: []string{"second.service"}},
+				{Action: fakedbus.ActionStart, UnitNames: []string{"third.service"}},
+			}))
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue("checksum/bootstrap-steps", "new-checksum"))
+		})
+
+		It("should not execute dependent steps if a step fails", func() {
+			fakeDBus.InjectStartFailure(errors.New("fake"), "second.service")
+
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, steps, "checksum")).To(MatchError(ContainSubstring(`unable to start unit "second.service" of bootstrap step "second": fake`)))
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStart, UnitNames: []string{"first.service"}},
+				{Action: fakedbus.ActionStart, UnitNames: []string{"second.service"}},
+			}))
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/bootstrap-steps", "first=Succeeded,second=Failed,third=Pending"))
+		})
+
+		It("should only execute the steps which did not succeed yet when retrying", func() {
+			fakeDBus.InjectStartFailure(errors.New("fake"), "second.service")
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, steps, "checksum")).NotTo(Succeed())
+
+			fakeDBus.Actions = nil
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, steps, "checksum")).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStart, UnitNames: []string{"second.service"}},
+				{Action: fakedbus.ActionStart, UnitNames: []string{"third.service"}},
+			}))
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/bootstrap-steps", "first=Succeeded,second=Succeeded,third=Succeeded"))
+		})
+
+		It("should start all units if the node is not registered yet", func() {
+			Expect(reconciler.executeBootstrapSteps(ctx, log, nil, steps, "checksum")).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(HaveLen(3))
+		})
+
+		It("should return an error if the dependencies are cyclic", func() {
+			Expect(reconciler.executeBootstrapSteps(ctx, log, node, []extensionsv1alpha1.BootstrapStep{
+				{Name: "first", UnitName: "first.service", DependsOn: []string{"second"}},
+				{Name: "second", UnitName: "second.service", DependsOn: []string{"first"}},
+			}, "checksum")).To(MatchError(ContainSubstring("cyclic dependencies")))
+
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+	})
+
 	Context("#updateOSInPlace", func() {
 		var (
 			osc        *extensionsv1alpha1.OperatingSystemConfig
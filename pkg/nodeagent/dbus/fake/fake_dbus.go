@@ -72,6 +72,15 @@ func (d *DBus) InjectRestartFailure(err error, unitNames ...string) {
 	d.failures[key] = err
 }
 
+// InjectStartFailure returns the given error the first time a start is triggered on the given units.
+func (d *DBus) InjectStartFailure(err error, unitNames ...string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	key := failureKey(SystemdAction{Action: ActionStart, UnitNames: unitNames})
+	d.failures[key] = err
+}
+
 func (d *DBus) maybeError(action SystemdAction) error {
 	key := failureKey(action)
 	err, ok := d.failures[key]
@@ -169,11 +178,13 @@ func (d *DBus) Start(_ context.Context, _ events.EventRecorder, _ runtime.Object
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
 
-	d.Actions = append(d.Actions, SystemdAction{
+	action := SystemdAction{
 		Action:    ActionStart,
 		UnitNames: []string{unitName},
-	})
-	return nil
+	}
+	d.Actions = append(d.Actions, action)
+
+	return d.maybeError(action)
 }
 
 // Stop implements dbus.DBus.
@@ -13,6 +13,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/events"
@@ -21,6 +22,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils"
@@ -91,7 +93,7 @@ func (d *deployment) Delete(ctx context.Context, log logr.Logger, extension *ope
 func (d *deployment) createOrUpdateAdmissionRuntimeClusterResources(ctx context.Context, genericTokenKubeconfigSecretName string, extension *operatorv1alpha1.Extension) error {
 	archive, err := d.helmRegistry.Pull(ctx, extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster.Helm.OCIRepository)
 	if err != nil {
-		return fmt.Errorf("failed pulling Helm chart from OCI repository %q: %w", extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster.Helm.OCIRepository.GetURL(), err)
+		return operator.NewChartPullError(extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster.Helm.OCIRepository, err)
 	}
 
 	accessSecret := d.getVirtualClusterAccessSecret(resourceName(extension))
@@ -181,7 +183,7 @@ func (d *deployment) deleteAdmissionRuntimeClusterResources(ctx context.Context,
 func (d *deployment) createOrUpdateAdmissionVirtualClusterResources(ctx context.Context, virtualClusterClientSet kubernetes.Interface, extension *operatorv1alpha1.Extension) error {
 	archive, err := d.helmRegistry.Pull(ctx, extension.Spec.Deployment.AdmissionDeployment.VirtualCluster.Helm.OCIRepository)
 	if err != nil {
-		return fmt.Errorf("failed pulling Helm chart from OCI repository %q: %w", extension.Spec.Deployment.AdmissionDeployment.VirtualCluster.Helm.OCIRepository.GetURL(), err)
+		return operator.NewChartPullError(extension.Spec.Deployment.AdmissionDeployment.VirtualCluster.Helm.OCIRepository, err)
 	}
 
 	accessSecret := d.getVirtualClusterAccessSecret(resourceName(extension))
@@ -205,9 +207,14 @@ func (d *deployment) createOrUpdateAdmissionVirtualClusterResources(ctx context.
 		}
 	}
 	namespace := virtualNamespace(extension)
+	clusterRole := virtualClusterRole(extension)
 	registry := managedresources.NewRegistry(kubernetes.GardenScheme, kubernetes.GardenCodec, kubernetes.GardenSerializer)
-	if err := registry.Add(namespace); err != nil {
-		return fmt.Errorf("failed adding namespace to registry: %w", err)
+	if err := registry.Add(
+		namespace,
+		clusterRole,
+		virtualClusterRoleBinding(clusterRole, accessSecret.ServiceAccountName),
+	); err != nil {
+		return fmt.Errorf("failed adding objects to registry: %w", err)
 	}
 
 	renderedChart, err := virtualClusterClientSet.ChartRenderer().RenderArchive(archive, extension.Name, namespace.Name, utils.MergeMaps(helmValues, gardenerValues))
@@ -287,6 +294,63 @@ func virtualNamespace(extension *operatorv1alpha1.Extension) *corev1.Namespace {
 	}
 }
 
+// virtualClusterRole returns the ClusterRole granting the admission read access to the resources in the virtual garden
+// cluster which are typically needed to validate or mutate requests. Additional permissions can still be granted by
+// the virtual chart.
+func virtualClusterRole(extension *operatorv1alpha1.Extension) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "gardener.cloud:extension-admission:" + extension.Name,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{gardencorev1beta1.GroupName},
+				Resources: []string{
+					"cloudprofiles",
+					"namespacedcloudprofiles",
+					"secretbindings",
+					"shoots",
+				},
+				Verbs: []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{securityv1alpha1.GroupName},
+				Resources: []string{
+					"credentialsbindings",
+					"workloadidentities",
+				},
+				Verbs: []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{corev1.GroupName},
+				Resources: []string{
+					"configmaps",
+					"secrets",
+				},
+				Verbs: []string{"get", "list", "watch"},
+			},
+		},
+	}
+}
+
+func virtualClusterRoleBinding(clusterRole *rbacv1.ClusterRole, serviceAccountName string) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterRole.Name,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole.Name,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccountName,
+			Namespace: metav1.NamespaceSystem,
+		}},
+	}
+}
+
 // New creates a new admission deployer.
 func New(runtimeClientSet kubernetes.Interface, recorder events.EventRecorder, gardenNamespace string, registry oci.Interface) Interface {
 	return &deployment{
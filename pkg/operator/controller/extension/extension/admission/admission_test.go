@@ -13,8 +13,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/events"
@@ -34,6 +36,7 @@ import (
 	ocifake "github.com/gardener/gardener/pkg/utils/oci/fake"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("Admission", func() {
@@ -53,6 +56,7 @@ var _ = Describe("Admission", func() {
 		ociRegistry       *ocifake.Registry
 
 		admission Interface
+		consistOf func(...client.Object) gomegatypes.GomegaMatcher
 
 		extensionName string
 		extension     *operatorv1alpha1.Extension
@@ -74,6 +78,7 @@ var _ = Describe("Admission", func() {
 		virtualClientSet = fakekubernetes.NewClientSetBuilder().WithChartRenderer(chartRenderer).WithClient(virtualClient).Build()
 
 		admission = New(runtimeClientSet, &events.FakeRecorder{}, "garden", ociRegistry)
+		consistOf = NewManagedResourceConsistOfObjectsMatcher(runtimeClient)
 
 		extensionName = "test-extension"
 		extension = &operatorv1alpha1.Extension{
@@ -169,8 +174,54 @@ var _ = Describe("Admission", func() {
 			})()
 
 			Expect(admission.Reconcile(ctx, log, virtualClientSet, genericKubeconfigSecretName, extension)).To(Succeed())
-			Expect(runtimeClient.Get(ctx, client.ObjectKey{Name: "extension-admission-virtual-" + extensionName, Namespace: "garden"}, &resourcesv1alpha1.ManagedResource{})).To(Succeed())
+			virtualManagedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(runtimeClient.Get(ctx, client.ObjectKey{Name: "extension-admission-virtual-" + extensionName, Namespace: "garden"}, virtualManagedResource)).To(Succeed())
 			Expect(runtimeClient.Get(ctx, client.ObjectKey{Name: "extension-admission-runtime-" + extensionName, Namespace: "garden"}, &resourcesv1alpha1.ManagedResource{})).To(Succeed())
+
+			Expect(virtualManagedResource).To(consistOf(
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "extension-" + extensionName,
+						Annotations: map[string]string{
+							"gardener.cloud/role":                     "extension",
+							"extensions.operator.gardener.cloud/name": extensionName,
+						},
+					},
+				},
+				&rbacv1.ClusterRole{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:extension-admission:" + extensionName},
+					Rules: []rbacv1.PolicyRule{
+						{
+							APIGroups: []string{"core.gardener.cloud"},
+							Resources: []string{"cloudprofiles", "namespacedcloudprofiles", "secretbindings", "shoots"},
+							Verbs:     []string{"get", "list", "watch"},
+						},
+						{
+							APIGroups: []string{"security.gardener.cloud"},
+							Resources: []string{"credentialsbindings", "workloadidentities"},
+							Verbs:     []string{"get", "list", "watch"},
+						},
+						{
+							APIGroups: []string{""},
+							Resources: []string{"configmaps", "secrets"},
+							Verbs:     []string{"get", "list", "watch"},
+						},
+					},
+				},
+				&rbacv1.ClusterRoleBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:extension-admission:" + extensionName},
+					RoleRef: rbacv1.RoleRef{
+						APIGroup: "rbac.authorization.k8s.io",
+						Kind:     "ClusterRole",
+						Name:     "gardener.cloud:extension-admission:" + extensionName,
+					},
+					Subjects: []rbacv1.Subject{{
+						Kind:      "ServiceAccount",
+						Name:      "extension-admission-" + extensionName,
+						Namespace: "kube-system",
+					}},
+				},
+			))
 		})
 
 		It("should succeed if admission deployment is not defined", func() {
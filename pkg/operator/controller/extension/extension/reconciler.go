@@ -34,6 +34,9 @@ import (
 const (
 	// ReasonReconcileFailed indicates the reconciliation failed.
 	ReasonReconcileFailed = "ReconcileFailed"
+	// ReasonChartPullFailed indicates the reconciliation failed because a Helm chart could not be pulled from its OCI
+	// repository.
+	ReasonChartPullFailed = "ChartPullFailed"
 	// ReasonReconcileSuccess indicates the reconciliation succeeded.
 	ReasonReconcileSuccess = "ReconcileSuccessful"
 	// ReasonDeleteFailed indicates the deletion failed.
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -120,7 +121,16 @@ func (r *Reconciler) reconcile(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log: log,
 	}); err != nil {
-		conditions.installed = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditions.installed, gardencorev1beta1.ConditionFalse, ReasonReconcileFailed, err.Error())
+		var (
+			reason       = ReasonReconcileFailed
+			chartPullErr *operator.ChartPullError
+		)
+		if errors.As(err, &chartPullErr) {
+			reason = ReasonChartPullFailed
+			r.Recorder.Eventf(extension, nil, corev1.EventTypeWarning, ReasonChartPullFailed, gardencorev1beta1.EventActionReconcile, chartPullErr.Error())
+		}
+
+		conditions.installed = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditions.installed, gardencorev1beta1.ConditionFalse, reason, err.Error())
 		if updateErr := r.updateExtensionStatus(ctx, log, extension, conditions); updateErr != nil {
 			return reconcile.Result{}, errors.Join(err, fmt.Errorf("failed to update extension status: %w", updateErr))
 		}
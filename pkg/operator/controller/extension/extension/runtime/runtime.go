@@ -88,7 +88,7 @@ func (d *deployer) Delete(ctx context.Context, log logr.Logger, extension *opera
 func (d *deployer) createOrUpdateResources(ctx context.Context, extension *operatorv1alpha1.Extension) error {
 	archive, err := d.helmRegistry.Pull(ctx, extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository)
 	if err != nil {
-		return fmt.Errorf("failed pulling Helm chart from OCI repository %q: %w", extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository.GetURL(), err)
+		return operator.NewChartPullError(extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository, err)
 	}
 
 	gardenerValues := map[string]any{
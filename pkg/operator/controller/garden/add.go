@@ -15,6 +15,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/operator/controller/garden/care"
+	"github.com/gardener/gardener/pkg/operator/controller/garden/etcdcapacity"
 	"github.com/gardener/gardener/pkg/operator/controller/garden/garden"
 	"github.com/gardener/gardener/pkg/operator/controller/garden/reference"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
@@ -54,6 +55,12 @@ func AddToManager(
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if err := (&etcdcapacity.Reconciler{
+		Config: *cfg,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding etcd capacity reconciler: %w", err)
+	}
+
 	if err := reference.AddToManager(mgr, v1beta1constants.GardenNamespace); err != nil {
 		return fmt.Errorf("failed adding reference reconciler: %w", err)
 	}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdcapacity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	prom "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+// QueryFunc is a function type for running an instant query against a Prometheus instance.
+type QueryFunc func(ctx context.Context, endpoint string, port int, query string) (model.Vector, error)
+
+// QueryPrometheus runs the given instant query against the Prometheus instance reachable at the given endpoint.
+func QueryPrometheus(ctx context.Context, endpoint string, port int, query string) (model.Vector, error) {
+	client, err := prom.NewClient(prom.Config{Address: fmt.Sprintf("http://%s:%d", endpoint, port)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Prometheus client: %w", err)
+	}
+
+	// set a maximum timeout for the query, but callers can set a shorter timeout via the context
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	result, warnings, err := promv1.NewAPI(client).Query(ctx, query, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+
+	if len(warnings) > 0 {
+		return nil, fmt.Errorf("query returned warnings: %s", strings.Join(warnings, ", "))
+	}
+
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("query returned an unexpected result type: %s", result.Type())
+	}
+
+	return vector, nil
+}
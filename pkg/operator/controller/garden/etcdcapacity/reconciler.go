@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdcapacity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/operator/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// queryDBSize is the query for the DB size of the virtual garden etcds in bytes.
+	queryDBSize = `max by (role) (etcd_mvcc_db_total_size_in_bytes{job="virtual-garden-etcd"})`
+	// queryKeys is the query for the number of keys stored in the virtual garden etcds.
+	queryKeys = `max by (role) (etcd_debugging_mvcc_keys_total{job="virtual-garden-etcd"})`
+)
+
+var (
+	defaultQuota = resource.MustParse("8Gi")
+	roles        = []string{v1beta1constants.ETCDRoleMain, v1beta1constants.ETCDRoleEvents}
+)
+
+// Reconciler checks the DB sizes of the virtual garden etcds and reports recommendations for the etcd settings via
+// the VirtualGardenETCDCapacity condition of the Garden.
+type Reconciler struct {
+	RuntimeClient   client.Client
+	Config          operatorconfigv1alpha1.OperatorConfiguration
+	Clock           clock.Clock
+	GardenNamespace string
+	// QueryFunc is used to query the garden Prometheus. It is defaulted by the AddToManager function but the field is
+	// still public for usage in tests.
+	QueryFunc QueryFunc
+}
+
+// Reconcile checks the DB sizes of the virtual garden etcds and updates the VirtualGardenETCDCapacity condition.
+func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(reconcileCtx)
+
+	garden := &operatorv1alpha1.Garden{}
+	if err := r.RuntimeClient.Get(reconcileCtx, req.NamespacedName, garden); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if garden.DeletionTimestamp != nil {
+		log.V(1).Info("Garden is being deleted, stop checking etcd capacity")
+		return reconcile.Result{}, nil
+	}
+
+	syncPeriod := r.Config.Controllers.GardenETCDCapacity.SyncPeriod.Duration
+
+	ctx, cancel := controllerutils.GetChildReconciliationContext(reconcileCtx, syncPeriod)
+	defer cancel()
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, garden.Status.Conditions, operatorv1alpha1.VirtualGardenETCDCapacity)
+	updatedCondition, err := r.check(ctx, garden, condition)
+	if err != nil {
+		log.Error(err, "Failed checking etcd capacity")
+		updatedCondition = v1beta1helper.UpdatedConditionUnknownErrorWithClock(r.Clock, condition, err)
+	}
+
+	if v1beta1helper.ConditionsNeedUpdate([]gardencorev1beta1.Condition{condition}, []gardencorev1beta1.Condition{updatedCondition}) {
+		log.Info("Updating garden status condition", "type", operatorv1alpha1.VirtualGardenETCDCapacity, "status", updatedCondition.Status)
+		patch := client.MergeFrom(garden.DeepCopy())
+		garden.Status.Conditions = v1beta1helper.MergeConditions(garden.Status.Conditions, updatedCondition)
+		if err := r.RuntimeClient.Status().Patch(reconcileCtx, garden, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed patching garden status: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: syncPeriod}, nil
+}
+
+func (r *Reconciler) check(ctx context.Context, garden *operatorv1alpha1.Garden, condition gardencorev1beta1.Condition) (gardencorev1beta1.Condition, error) {
+	endpoint := fmt.Sprintf("prometheus-garden.%s.svc.cluster.local", r.GardenNamespace)
+
+	dbSizes, err := r.queryByRole(ctx, endpoint, queryDBSize)
+	if err != nil {
+		return condition, fmt.Errorf("failed querying etcd DB sizes: %w", err)
+	}
+
+	keys, err := r.queryByRole(ctx, endpoint, queryKeys)
+	if err != nil {
+		return condition, fmt.Errorf("failed querying etcd key counts: %w", err)
+	}
+
+	var (
+		threshold       = int64(ptr.Deref(r.Config.Controllers.GardenETCDCapacity.QuotaUtilizationThresholdPercentage, 80))
+		utilizations    []string
+		recommendations []string
+	)
+
+	for _, role := range roles {
+		dbSize, ok := dbSizes[role]
+		if !ok {
+			continue
+		}
+
+		etcdObj := &druidcorev1alpha1.Etcd{}
+		if err := r.RuntimeClient.Get(ctx, client.ObjectKey{Name: operatorv1alpha1.VirtualGardenNamePrefix + etcd.Name(role), Namespace: r.GardenNamespace}, etcdObj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return condition, fmt.Errorf("failed reading etcd %q: %w", role, err)
+		}
+
+		quota := ptr.Deref(etcdObj.Spec.Etcd.Quota, defaultQuota)
+		utilization := dbSize * 100 / quota.Value()
+		utilizations = append(utilizations, fmt.Sprintf("%s: %d%% of %s", role, utilization, quota.String()))
+
+		if utilization < threshold {
+			continue
+		}
+
+		var objectCount string
+		if count, ok := keys[role]; ok {
+			objectCount = fmt.Sprintf(", %d keys", count)
+		}
+
+		recommendations = append(recommendations, fmt.Sprintf("The DB size of etcd %q is %s (%d%% of quota %s%s). Recommendation: %s.",
+			etcdObj.Name, formatBytes(dbSize), utilization, quota.String(), objectCount, strings.Join(recommend(garden, etcdObj, role, dbSize, quota, threshold), "; ")))
+	}
+
+	if len(utilizations) == 0 {
+		return v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(r.Clock, condition, "No DB size metrics are available for the virtual garden etcds yet."), nil
+	}
+
+	if len(recommendations) > 0 {
+		return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "QuotaUtilizationHigh", strings.Join(recommendations, " ")), nil
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "SufficientCapacity",
+		fmt.Sprintf("The DB sizes of the virtual garden etcds are below %d%% of their quotas (%s).", threshold, strings.Join(utilizations, ", "))), nil
+}
+
+func (r *Reconciler) queryByRole(ctx context.Context, endpoint, query string) (map[string]int64, error) {
+	vector, err := r.QueryFunc(ctx, endpoint, 80, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]int64, len(vector))
+	for _, sample := range vector {
+		result[string(sample.Metric["role"])] = int64(sample.Value)
+	}
+	return result, nil
+}
+
+// recommend computes recommendations for the settings of the etcd with the given role so that its DB size falls below
+// the utilization threshold again.
+func recommend(garden *operatorv1alpha1.Garden, etcdObj *druidcorev1alpha1.Etcd, role string, dbSize int64, quota resource.Quantity, threshold int64) []string {
+	recommendedQuota := quota.DeepCopy()
+	for dbSize*100/recommendedQuota.Value() >= threshold {
+		recommendedQuota.Add(recommendedQuota)
+	}
+
+	recommendations := []string{fmt.Sprintf("increase .spec.virtualCluster.etcd.%s.quota to %s", role, recommendedQuota.String())}
+
+	if storageCapacity := etcdObj.Spec.StorageCapacity; storageCapacity != nil {
+		// Leave some headroom on the volume so that etcd does not run out of disk space before reaching its quota.
+		recommendedStorageCapacity := recommendedQuota.DeepCopy()
+		recommendedStorageCapacity.Add(*resource.NewQuantity(recommendedQuota.Value()/2, resource.BinarySI))
+		if storageCapacity.Cmp(recommendedStorageCapacity) < 0 {
+			recommendations = append(recommendations, fmt.Sprintf("resize the volumes of the etcd (currently %s) to at least %s", storageCapacity.String(), recommendedStorageCapacity.String()))
+		}
+	}
+
+	// etcd keeps its DB memory-mapped, hence it should not be scaled down below the DB size.
+	recommendedMemory := *resource.NewQuantity(roundUpToGi(dbSize), resource.BinarySI)
+	if minAllowed := minAllowedMemory(garden, role); minAllowed == nil || minAllowed.Cmp(recommendedMemory) < 0 {
+		recommendations = append(recommendations, fmt.Sprintf("set .spec.virtualCluster.etcd.%s.autoscaling.minAllowed.memory to at least %s", role, recommendedMemory.String()))
+	}
+
+	return recommendations
+}
+
+func minAllowedMemory(garden *operatorv1alpha1.Garden, role string) *resource.Quantity {
+	etcdConfig := garden.Spec.VirtualCluster.ETCD
+	if etcdConfig == nil {
+		return nil
+	}
+
+	var autoscaling *gardencorev1beta1.ControlPlaneAutoscaling
+	switch role {
+	case v1beta1constants.ETCDRoleMain:
+		if etcdConfig.Main != nil {
+			autoscaling = etcdConfig.Main.Autoscaling
+		}
+	case v1beta1constants.ETCDRoleEvents:
+		if etcdConfig.Events != nil {
+			autoscaling = etcdConfig.Events.Autoscaling
+		}
+	}
+
+	if autoscaling == nil {
+		return nil
+	}
+
+	if memory, ok := autoscaling.MinAllowed[corev1.ResourceMemory]; ok {
+		return &memory
+	}
+	return nil
+}
+
+func roundUpToGi(bytes int64) int64 {
+	const gi = 1 << 30
+	return (bytes + gi - 1) / gi * gi
+}
+
+func formatBytes(bytes int64) string {
+	return fmt.Sprintf("%.2fGi", float64(bytes)/(1<<30))
+}
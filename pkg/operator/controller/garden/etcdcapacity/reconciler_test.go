@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdcapacity_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/operator/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	operatorclient "github.com/gardener/gardener/pkg/operator/client"
+	. "github.com/gardener/gardener/pkg/operator/controller/garden/etcdcapacity"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		gardenName      = "garden"
+		gardenNamespace = "garden"
+		syncPeriod      = 5 * time.Minute
+		gi              = 1 << 30
+	)
+
+	var (
+		ctx           = context.Background()
+		runtimeClient client.Client
+		fakeClock     *testclock.FakeClock
+		reconciler    *Reconciler
+		req           reconcile.Request
+
+		garden     *operatorv1alpha1.Garden
+		etcdMain   *druidcorev1alpha1.Etcd
+		etcdEvents *druidcorev1alpha1.Etcd
+
+		queries  map[string]model.Vector
+		queryErr error
+	)
+
+	sample := func(role string, value float64) *model.Sample {
+		return &model.Sample{Metric: model.Metric{"role": model.LabelValue(role)}, Value: model.SampleValue(value)}
+	}
+
+	getCondition := func() *gardencorev1beta1.Condition {
+		updatedGarden := &operatorv1alpha1.Garden{}
+		ExpectWithOffset(1, runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), updatedGarden)).To(Succeed())
+		return v1beta1helper.GetCondition(updatedGarden.Status.Conditions, operatorv1alpha1.VirtualGardenETCDCapacity)
+	}
+
+	BeforeEach(func() {
+		runtimeClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.RuntimeScheme).WithStatusSubresource(&operatorv1alpha1.Garden{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		queries = map[string]model.Vector{
+			`max by (role) (etcd_mvcc_db_total_size_in_bytes{job="virtual-garden-etcd"})`: {sample("main", 2*gi), sample("events", 0.5*gi)},
+			`max by (role) (etcd_debugging_mvcc_keys_total{job="virtual-garden-etcd"})`:   {sample("main", 100000), sample("events", 5000)},
+		}
+		queryErr = nil
+
+		reconciler = &Reconciler{
+			RuntimeClient: runtimeClient,
+			Config: operatorconfigv1alpha1.OperatorConfiguration{
+				Controllers: operatorconfigv1alpha1.ControllerConfiguration{
+					GardenETCDCapacity: operatorconfigv1alpha1.GardenETCDCapacityControllerConfiguration{
+						SyncPeriod:                          &metav1.Duration{Duration: syncPeriod},
+						QuotaUtilizationThresholdPercentage: ptr.To[int32](80),
+					},
+				},
+			},
+			Clock:           fakeClock,
+			GardenNamespace: gardenNamespace,
+			QueryFunc: func(_ context.Context, endpoint string, port int, query string) (model.Vector, error) {
+				Expect(endpoint).To(Equal("prometheus-garden.garden.svc.cluster.local"))
+				Expect(port).To(Equal(80))
+				return queries[query], queryErr
+			},
+		}
+
+		garden = &operatorv1alpha1.Garden{ObjectMeta: metav1.ObjectMeta{Name: gardenName}}
+		etcdMain = &druidcorev1alpha1.Etcd{
+			ObjectMeta: metav1.ObjectMeta{Name: "virtual-garden-etcd-main", Namespace: gardenNamespace},
+			Spec: druidcorev1alpha1.EtcdSpec{
+				Etcd:            druidcorev1alpha1.EtcdConfig{Quota: ptr.To(resource.MustParse("8Gi"))},
+				StorageCapacity: ptr.To(resource.MustParse("25Gi")),
+			},
+		}
+		etcdEvents = &druidcorev1alpha1.Etcd{
+			ObjectMeta: metav1.ObjectMeta{Name: "virtual-garden-etcd-events", Namespace: gardenNamespace},
+			Spec: druidcorev1alpha1.EtcdSpec{
+				Etcd:            druidcorev1alpha1.EtcdConfig{Quota: ptr.To(resource.MustParse("8Gi"))},
+				StorageCapacity: ptr.To(resource.MustParse("10Gi")),
+			},
+		}
+
+		req = reconcile.Request{NamespacedName: client.ObjectKey{Name: gardenName}}
+	})
+
+	JustBeforeEach(func() {
+		Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+		Expect(runtimeClient.Create(ctx, etcdMain)).To(Succeed())
+		Expect(runtimeClient.Create(ctx, etcdEvents)).To(Succeed())
+	})
+
+	It("should do nothing if the garden is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "other"}})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should report sufficient capacity if the DB sizes are below the threshold", func() {
+		Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("SufficientCapacity"))
+		Expect(condition.Message).To(Equal("The DB sizes of the virtual garden etcds are below 80% of their quotas (main: 25% of 8Gi, events: 6% of 8Gi)."))
+	})
+
+	It("should recommend new etcd settings if a DB size exceeds the threshold", func() {
+		queries[`max by (role) (etcd_mvcc_db_total_size_in_bytes{job="virtual-garden-etcd"})`] = model.Vector{sample("main", 7*gi), sample("events", 0.5*gi)}
+
+		Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("QuotaUtilizationHigh"))
+		Expect(condition.Message).To(Equal(`The DB size of etcd "virtual-garden-etcd-main" is 7.00Gi (87% of quota 8Gi, 100000 keys). ` +
+			"Recommendation: increase .spec.virtualCluster.etcd.main.quota to 16Gi; set .spec.virtualCluster.etcd.main.autoscaling.minAllowed.memory to at least 7Gi."))
+	})
+
+	Context("with small volume and configured minimum memory", func() {
+		BeforeEach(func() {
+			garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{
+				Events: &operatorv1alpha1.ETCDEvents{
+					Autoscaling: &gardencorev1beta1.ControlPlaneAutoscaling{
+						MinAllowed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+					},
+				},
+			}
+			queries[`max by (role) (etcd_mvcc_db_total_size_in_bytes{job="virtual-garden-etcd"})`] = model.Vector{sample("main", 1*gi), sample("events", 7.5*gi)}
+		})
+
+		It("should recommend resizing the volume but no memory change", func() {
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			condition := getCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Message).To(Equal(`The DB size of etcd "virtual-garden-etcd-events" is 7.50Gi (93% of quota 8Gi, 5000 keys). ` +
+				"Recommendation: increase .spec.virtualCluster.etcd.events.quota to 16Gi; resize the volumes of the etcd (currently 10Gi) to at least 24Gi."))
+		})
+	})
+
+	It("should set the condition to unknown if no metrics are available", func() {
+		queries = map[string]model.Vector{}
+
+		Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+		Expect(condition.Message).To(Equal("No DB size metrics are available for the virtual garden etcds yet."))
+	})
+
+	It("should set the condition to unknown if querying Prometheus fails", func() {
+		queryErr = errors.New("fake")
+
+		Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+		Expect(condition.Message).To(ContainSubstring("failed querying etcd DB sizes: fake"))
+	})
+
+	It("should keep other conditions of the garden", func() {
+		garden.Status.Conditions = []gardencorev1beta1.Condition{{Type: operatorv1alpha1.RuntimeComponentsHealthy, Status: gardencorev1beta1.ConditionTrue}}
+		Expect(runtimeClient.Status().Update(ctx, garden)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		updatedGarden := &operatorv1alpha1.Garden{}
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), updatedGarden)).To(Succeed())
+		Expect(updatedGarden.Status.Conditions).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garden
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/api/operator/v1alpha1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/utils/timewindow"
+)
+
+// defaultRotationCompletionDelay is the default minimum period between the end of the preparation phase and the
+// automatic completion of a credentials rotation.
+const defaultRotationCompletionDelay = 7 * 24 * time.Hour
+
+// AutomaticCredentialsRotationOperations returns the operations which must be performed to automatically rotate the
+// credentials of the virtual garden according to `.spec.virtualCluster.maintenance.autoRotation`. Operations are only
+// returned if the given time is within the maintenance time window of the garden.
+func AutomaticCredentialsRotationOperations(garden *operatorv1alpha1.Garden, now time.Time) []string {
+	autoRotation := garden.Spec.VirtualCluster.Maintenance.AutoRotation
+	if autoRotation == nil || autoRotation.Credentials == nil || !isInMaintenanceTimeWindow(garden, now) {
+		return nil
+	}
+
+	var operations []string
+
+	if config := autoRotation.Credentials.CertificateAuthorities; config != nil {
+		var lastCompletionTime, lastInitiationFinishedTime *metav1.Time
+		if garden.Status.Credentials != nil && garden.Status.Credentials.Rotation != nil && garden.Status.Credentials.Rotation.CertificateAuthorities != nil {
+			lastCompletionTime = garden.Status.Credentials.Rotation.CertificateAuthorities.LastCompletionTime
+			lastInitiationFinishedTime = garden.Status.Credentials.Rotation.CertificateAuthorities.LastInitiationFinishedTime
+		}
+
+		operations = append(operations, phasedRotationOperation(
+			garden,
+			now,
+			*config,
+			helper.GetCARotationPhase(garden.Status.Credentials),
+			lastCompletionTime,
+			lastInitiationFinishedTime,
+			v1beta1constants.OperationRotateCAStart,
+			v1beta1constants.OperationRotateCAComplete,
+		)...)
+	}
+
+	if config := autoRotation.Credentials.ServiceAccountKey; config != nil {
+		var lastCompletionTime, lastInitiationFinishedTime *metav1.Time
+		if garden.Status.Credentials != nil && garden.Status.Credentials.Rotation != nil && garden.Status.Credentials.Rotation.ServiceAccountKey != nil {
+			lastCompletionTime = garden.Status.Credentials.Rotation.ServiceAccountKey.LastCompletionTime
+			lastInitiationFinishedTime = garden.Status.Credentials.Rotation.ServiceAccountKey.LastInitiationFinishedTime
+		}
+
+		operations = append(operations, phasedRotationOperation(
+			garden,
+			now,
+			*config,
+			helper.GetServiceAccountKeyRotationPhase(garden.Status.Credentials),
+			lastCompletionTime,
+			lastInitiationFinishedTime,
+			v1beta1constants.OperationRotateServiceAccountKeyStart,
+			v1beta1constants.OperationRotateServiceAccountKeyComplete,
+		)...)
+	}
+
+	return operations
+}
+
+func phasedRotationOperation(
+	garden *operatorv1alpha1.Garden,
+	now time.Time,
+	config operatorv1alpha1.MaintenancePhasedRotationConfig,
+	phase gardencorev1beta1.CredentialsRotationPhase,
+	lastCompletionTime *metav1.Time,
+	lastInitiationFinishedTime *metav1.Time,
+	startOperation, completeOperation string,
+) []string {
+	switch phase {
+	case "", gardencorev1beta1.RotationCompleted:
+		// If the credentials have never been rotated, use the garden's creation timestamp to determine whether the
+		// rotation period has passed.
+		latestRotationCompletionTime := garden.CreationTimestamp.Time
+		if lastCompletionTime != nil {
+			latestRotationCompletionTime = lastCompletionTime.Time
+		}
+
+		if latestRotationCompletionTime.Before(now.Add(-config.RotationPeriod.Duration)) {
+			return []string{startOperation}
+		}
+
+	case gardencorev1beta1.RotationPrepared:
+		completionDelay := defaultRotationCompletionDelay
+		if config.CompletionDelay != nil {
+			completionDelay = config.CompletionDelay.Duration
+		}
+
+		if lastInitiationFinishedTime != nil && lastInitiationFinishedTime.Time.Before(now.Add(-completionDelay)) {
+			return []string{completeOperation}
+		}
+	}
+
+	return nil
+}
+
+func isInMaintenanceTimeWindow(garden *operatorv1alpha1.Garden, now time.Time) bool {
+	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(garden.Spec.VirtualCluster.Maintenance.TimeWindow.Begin, garden.Spec.VirtualCluster.Maintenance.TimeWindow.End)
+	if err != nil {
+		return false
+	}
+
+	return maintenanceTimeWindow.Contains(now)
+}
+
+// durationUntilNextAutomaticRotationCheck returns the duration until the garden should be reconciled again such that
+// automatic credentials rotations are performed within the next maintenance time window. It returns 0 if no automatic
+// rotation is configured.
+func durationUntilNextAutomaticRotationCheck(garden *operatorv1alpha1.Garden, now time.Time) time.Duration {
+	autoRotation := garden.Spec.VirtualCluster.Maintenance.AutoRotation
+	if autoRotation == nil || autoRotation.Credentials == nil ||
+		(autoRotation.Credentials.CertificateAuthorities == nil && autoRotation.Credentials.ServiceAccountKey == nil) {
+		return 0
+	}
+
+	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(garden.Spec.VirtualCluster.Maintenance.TimeWindow.Begin, garden.Spec.VirtualCluster.Maintenance.TimeWindow.End)
+	if err != nil {
+		return 0
+	}
+
+	return maintenanceTimeWindow.RandomDurationUntilNext(now, false)
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garden_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operator/controller/garden/garden"
+)
+
+var _ = Describe("AutoRotation", func() {
+	Describe("#AutomaticCredentialsRotationOperations", func() {
+		var (
+			garden             *operatorv1alpha1.Garden
+			creationTime       = time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+			insideTimeWindow   = time.Date(2025, 6, 1, 10, 30, 0, 0, time.UTC)
+			outsideTimeWindow  = time.Date(2025, 6, 1, 15, 0, 0, 0, time.UTC)
+			rotationPeriod     = metav1.Duration{Duration: 90 * 24 * time.Hour}
+			lastCompletionTime = metav1.NewTime(insideTimeWindow.Add(-24 * time.Hour))
+		)
+
+		BeforeEach(func() {
+			garden = &operatorv1alpha1.Garden{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(creationTime)},
+				Spec: operatorv1alpha1.GardenSpec{
+					VirtualCluster: operatorv1alpha1.VirtualCluster{
+						Maintenance: operatorv1alpha1.Maintenance{
+							TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{Begin: "100000+0000", End: "110000+0000"},
+							AutoRotation: &operatorv1alpha1.MaintenanceAutoRotation{
+								Credentials: &operatorv1alpha1.MaintenanceCredentialsAutoRotation{
+									CertificateAuthorities: &operatorv1alpha1.MaintenancePhasedRotationConfig{RotationPeriod: rotationPeriod},
+									ServiceAccountKey:      &operatorv1alpha1.MaintenancePhasedRotationConfig{RotationPeriod: rotationPeriod},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return nothing if auto rotation is not configured", func() {
+			garden.Spec.VirtualCluster.Maintenance.AutoRotation = nil
+
+			Expect(AutomaticCredentialsRotationOperations(garden, insideTimeWindow)).To(BeEmpty())
+		})
+
+		It("should return nothing if the time is outside the maintenance time window", func() {
+			Expect(AutomaticCredentialsRotationOperations(garden, outsideTimeWindow)).To(BeEmpty())
+		})
+
+		It("should start the rotations if the credentials were never rotated and the rotation period has passed", func() {
+			Expect(AutomaticCredentialsRotationOperations(garden, insideTimeWindow)).To(ConsistOf("rotate-ca-start", "rotate-serviceaccount-key-start"))
+		})
+
+		It("should not start the rotations if the rotation period has not yet passed", func() {
+			garden.Status.Credentials = &operatorv1alpha1.Credentials{
+				Rotation: &operatorv1alpha1.CredentialsRotation{
+					CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationCompleted, LastCompletionTime: &lastCompletionTime},
+					ServiceAccountKey:      &gardencorev1beta1.ServiceAccountKeyRotation{Phase: gardencorev1beta1.RotationCompleted, LastCompletionTime: &lastCompletionTime},
+				},
+			}
+
+			Expect(AutomaticCredentialsRotationOperations(garden, insideTimeWindow)).To(BeEmpty())
+		})
+
+		It("should not start or complete the rotations if they are currently preparing", func() {
+			garden.Status.Credentials = &operatorv1alpha1.Credentials{
+				Rotation: &operatorv1alpha1.CredentialsRotation{
+					CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPreparing},
+					ServiceAccountKey:      &gardencorev1beta1.ServiceAccountKeyRotation{Phase: gardencorev1beta1.RotationPreparing},
+				},
+			}
+
+			Expect(AutomaticCredentialsRotationOperations(garden, insideTimeWindow)).To(BeEmpty())
+		})
+
+		It("should complete the rotations only after the completion delay has passed", func() {
+			preparedTwoDaysAgo := metav1.NewTime(insideTimeWindow.Add(-48 * time.Hour))
+			preparedTenDaysAgo := metav1.NewTime(insideTimeWindow.Add(-10 * 24 * time.Hour))
+
+			garden.Spec.VirtualCluster.Maintenance.AutoRotation.Credentials.ServiceAccountKey.CompletionDelay = &metav1.Duration{Duration: 24 * time.Hour}
+			garden.Status.Credentials = &operatorv1alpha1.Credentials{
+				Rotation: &operatorv1alpha1.CredentialsRotation{
+					CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPrepared, LastInitiationFinishedTime: &preparedTwoDaysAgo},
+					ServiceAccountKey:      &gardencorev1beta1.ServiceAccountKeyRotation{Phase: gardencorev1beta1.RotationPrepared, LastInitiationFinishedTime: &preparedTwoDaysAgo},
+				},
+			}
+
+			Expect(AutomaticCredentialsRotationOperations(garden, insideTimeWindow)).To(ConsistOf("rotate-serviceaccount-key-complete"))
+
+			garden.Status.Credentials.Rotation.CertificateAuthorities.LastInitiationFinishedTime = &preparedTenDaysAgo
+
+			Expect(AutomaticCredentialsRotationOperations(garden, insideTimeWindow)).To(ConsistOf("rotate-ca-complete", "rotate-serviceaccount-key-complete"))
+		})
+	})
+})
@@ -538,6 +538,7 @@ func (r *Reconciler) newEtcd(
 		defragmentationScheduleFormat string
 		storageClassName              *string
 		storageCapacity               string
+		quota                         *resource.Quantity
 		minAllowed                    corev1.ResourceList
 	)
 
@@ -549,6 +550,9 @@ func (r *Reconciler) newEtcd(
 		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Main != nil && etcd.Main.Autoscaling != nil {
 			minAllowed = etcd.Main.Autoscaling.MinAllowed
 		}
+		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Main != nil {
+			quota = etcd.Main.Quota
+		}
 		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Main != nil && etcd.Main.Storage != nil {
 			storageClassName = etcd.Main.Storage.ClassName
 			if etcd.Main.Storage.Capacity != nil {
@@ -563,6 +567,9 @@ func (r *Reconciler) newEtcd(
 		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Events != nil && etcd.Events.Autoscaling != nil {
 			minAllowed = etcd.Events.Autoscaling.MinAllowed
 		}
+		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Events != nil {
+			quota = etcd.Events.Quota
+		}
 		if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.Events != nil && etcd.Events.Storage != nil {
 			storageClassName = etcd.Events.Storage.ClassName
 			if etcd.Events.Storage.Capacity != nil {
@@ -602,6 +609,7 @@ func (r *Reconciler) newEtcd(
 			Replicas:                    replicas,
 			Autoscaling:                 etcd.AutoscalingConfig{MinAllowed: minAllowed},
 			StorageCapacity:             storageCapacity,
+			Quota:                       quota,
 			StorageClassName:            storageClassName,
 			DefragmentationSchedule:     &defragmentationSchedule,
 			CARotationPhase:             helper.GetCARotationPhase(garden.Status.Credentials),
@@ -946,10 +954,12 @@ func (r *Reconciler) newIstio(ctx context.Context, garden *operatorv1alpha1.Gard
 			{Name: "tcp", Port: 443, TargetPort: intstr.FromInt32(9443)},
 		},
 		nil,
+		nil,
 		false,
 		garden.Spec.RuntimeCluster.Provider.Zones,
 		len(garden.Spec.RuntimeCluster.Networking.IPFamilies) == 2,
 		r.RuntimeVersion,
+		nil,
 	)
 }
 
@@ -1075,6 +1085,7 @@ func (r *Reconciler) newNginxIngressController(garden *operatorv1alpha1.Garden,
 		true,
 		component.ClusterTypeSeed,
 		"",
+		nil,
 		v1beta1constants.SeedNginxIngressClass,
 		ingressDomains,
 		ingressGatewayValues[0].Labels,
@@ -136,7 +136,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{RequeueAfter: controllerutils.DefaultRequeueAfterDuration}, nil
 	}
 
-	return reconcile.Result{RequeueAfter: r.Config.Controllers.Garden.SyncPeriod.Duration}, nil
+	requeueAfter := r.Config.Controllers.Garden.SyncPeriod.Duration
+	if d := durationUntilNextAutomaticRotationCheck(garden, r.Clock.Now()); d > 0 && d < requeueAfter {
+		requeueAfter = d
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *Reconciler) ensureAtMostOneGardenExists(ctx context.Context) error {
@@ -174,12 +179,16 @@ func (r *Reconciler) reportProgress(log logr.Logger, garden *operatorv1alpha1.Ga
 
 func (r *Reconciler) updateStatusOperationStart(ctx context.Context, garden *operatorv1alpha1.Garden, operationType gardencorev1beta1.LastOperationType) error {
 	var (
-		now                = metav1.NewTime(r.Clock.Now().UTC())
-		operations         = helper.GetGardenerOperations(garden.Annotations)
-		filteredOperations = sets.New(operations...).UnsortedList()
-		description        string
+		now         = metav1.NewTime(r.Clock.Now().UTC())
+		operations  = helper.GetGardenerOperations(garden.Annotations)
+		description string
 	)
 
+	if operationType == gardencorev1beta1.LastOperationTypeReconcile {
+		operations = append(operations, AutomaticCredentialsRotationOperations(garden, now.Time)...)
+	}
+	filteredOperations := sets.New(operations...).UnsortedList()
+
 	k8sLess134, err := versionutils.CompareVersions(garden.Spec.VirtualCluster.Kubernetes.Version, "<", "1.34")
 	if err != nil {
 		return fmt.Errorf("failed checking if Virtual Cluster k8s version is less than 1.34: %w", err)
@@ -277,6 +286,10 @@ func (r *Reconciler) updateStatusOperationStart(ctx context.Context, garden *ope
 		case operatorv1alpha1.OperationRotateWorkloadIdentityKeyComplete:
 			updatedOperations = v1beta1helper.RemoveOperation(updatedOperations, operation)
 			completeRotationWorkloadIdentityKey(garden, &now)
+
+		case operatorv1alpha1.OperationRestoreETCDMain:
+			updatedOperations = v1beta1helper.RemoveOperation(updatedOperations, operation)
+			startRestoreETCDMain(garden, &now)
 		}
 	}
 
@@ -397,6 +410,12 @@ func (r *Reconciler) updateStatusOperationSuccess(ctx context.Context, garden *o
 		})
 	}
 
+	if helper.IsETCDMainRestoreInProgress(garden.Status) {
+		helper.MutateETCDMainRestore(garden, func(restore *operatorv1alpha1.BackupRestoreStatus) {
+			restore.LastCompletionTime = &now
+		})
+	}
+
 	return r.RuntimeClientSet.Client().Status().Update(ctx, garden)
 }
 
@@ -511,6 +530,13 @@ func startRotationObservability(garden *operatorv1alpha1.Garden, now *metav1.Tim
 	})
 }
 
+func startRestoreETCDMain(garden *operatorv1alpha1.Garden, now *metav1.Time) {
+	helper.MutateETCDMainRestore(garden, func(restore *operatorv1alpha1.BackupRestoreStatus) {
+		restore.Mode = helper.GetETCDMainRestoreMode(garden)
+		restore.LastInitiationTime = now
+	})
+}
+
 func startRotationWorkloadIdentityKey(garden *operatorv1alpha1.Garden, now *metav1.Time) {
 	helper.MutateWorkloadIdentityKeyRotation(garden, func(rotation *operatorv1alpha1.WorkloadIdentityKeyRotation) {
 		rotation.Phase = gardencorev1beta1.RotationPreparing
@@ -14,9 +14,11 @@ import (
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/go-logr/logr"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -281,6 +283,18 @@ func (r *Reconciler) reconcile(
 			Fn:           flow.Parallel(c.etcdMain.Wait, c.etcdEvents.Wait),
 			Dependencies: flow.NewTaskIDs(deployEtcds),
 		})
+		restoreEtcdMain = g.Add(flow.Task{
+			Name:         "Restoring main ETCD of virtual garden from backup",
+			Fn:           r.restoreEtcdMainFunc(log, garden, secretsManager, c.etcdMain),
+			SkipIf:       !backupConfigured || !helper.IsETCDMainRestoreInProgress(garden.Status),
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Reporting main ETCD backup status",
+			Fn:           flow.TaskFn(func(ctx context.Context) error { return r.reportEtcdMainBackupStatus(ctx, garden, c.etcdMain) }).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !backupConfigured,
+			Dependencies: flow.NewTaskIDs(restoreEtcdMain),
+		})
 		deployExtensionResourcesBeforeKAPI = g.Add(flow.Task{
 			Name: "Deploying extension resources before kube-apiserver",
 			Fn:   flow.TaskFn(c.extensions.DeployBeforeKubeAPIServer).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -298,7 +312,7 @@ func (r *Reconciler) reconcile(
 		deployKubeAPIServer = g.Add(flow.Task{
 			Name:         "Deploying Kubernetes API Server",
 			Fn:           r.deployKubeAPIServerFunc(garden, c.kubeAPIServer),
-			Dependencies: flow.NewTaskIDs(waitUntilEtcdsReady, waitUntilExtensionResourcesBeforeKAPIReady),
+			Dependencies: flow.NewTaskIDs(restoreEtcdMain, waitUntilExtensionResourcesBeforeKAPIReady),
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
 			Name:         "Waiting until Kubernetes API server rolled out",
@@ -342,7 +356,7 @@ func (r *Reconciler) reconcile(
 		deployGardenerAPIServer = g.Add(flow.Task{
 			Name:         "Deploying Gardener API Server",
 			Fn:           r.deployGardenerAPIServerFunc(garden, c.gardenerAPIServer),
-			Dependencies: flow.NewTaskIDs(waitUntilEtcdsReady, waitUntilKubeAPIServerIsReady, waitUntilVirtualGardenGardenerResourceManagerIsReady),
+			Dependencies: flow.NewTaskIDs(restoreEtcdMain, waitUntilKubeAPIServerIsReady, waitUntilVirtualGardenGardenerResourceManagerIsReady),
 		})
 		waitUntilGardenerAPIServerReady = g.Add(flow.Task{
 			Name:         "Waiting until Gardener API server rolled out",
@@ -881,14 +895,7 @@ func etcdMainBackupBucketNameAndPrefix(garden *operatorv1alpha1.Garden) (string,
 func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain, etcdEvents etcd.Interface, backupBucket *extensionsv1alpha1.BackupBucket) func(context.Context) error {
 	return func(ctx context.Context) error {
 		if backup := helper.GetETCDMainBackup(garden); backup != nil {
-			snapshotSchedule, err := timewindow.DetermineSchedule(
-				"%d %d * * *",
-				garden.Spec.VirtualCluster.Maintenance.TimeWindow.Begin,
-				garden.Spec.VirtualCluster.Maintenance.TimeWindow.End,
-				garden.UID,
-				garden.CreationTimestamp,
-				timewindow.RandomizeWithinFirstHourOfTimeWindow,
-			)
+			snapshotSchedule, err := etcdMainFullSnapshotSchedule(garden, backup)
 			if err != nil {
 				return err
 			}
@@ -906,15 +913,31 @@ func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain,
 			container, prefix := etcdMainBackupBucketNameAndPrefix(garden)
 
 			etcdMain.SetBackupConfig(&etcd.BackupConfig{
-				Provider:             backup.Provider,
-				SecretRefName:        secretRefName,
-				Container:            container,
-				Prefix:               prefix,
-				FullSnapshotSchedule: snapshotSchedule,
-				LeaderElection:       backupLeaderElection,
+				Provider:                     backup.Provider,
+				SecretRefName:                secretRefName,
+				Container:                    container,
+				Prefix:                       prefix,
+				FullSnapshotSchedule:         snapshotSchedule,
+				DeltaSnapshotRetentionPeriod: backup.DeltaSnapshotRetentionPeriod,
+				LeaderElection:               backupLeaderElection,
 			})
 		}
 
+		// If a restore of the main ETCD was interrupted after it has been scaled down, keep it scaled down so that the
+		// restore flow can continue from where it stopped.
+		if helper.IsETCDMainRestoreInProgress(garden.Status) {
+			existingEtcd, err := etcdMain.Get(ctx)
+			if client.IgnoreNotFound(err) != nil {
+				return err
+			}
+
+			if existingEtcd != nil && existingEtcd.Spec.Replicas == 0 {
+				originalReplicas := etcdMain.GetReplicas()
+				defer etcdMain.SetReplicas(originalReplicas)
+				etcdMain.SetReplicas(ptr.To[int32](0))
+			}
+		}
+
 		// Roll out the new peer CA first so that every member in the cluster trusts the old and the new CA.
 		// This is required because peer certificates which are used for client and server authentication at the same time,
 		// are re-created with the new CA in the `Deploy` step.
@@ -931,6 +954,21 @@ func (r *Reconciler) deployEtcdsFunc(garden *operatorv1alpha1.Garden, etcdMain,
 	}
 }
 
+func etcdMainFullSnapshotSchedule(garden *operatorv1alpha1.Garden, backup *operatorv1alpha1.Backup) (string, error) {
+	if backup.FullSnapshotSchedule != nil {
+		return *backup.FullSnapshotSchedule, nil
+	}
+
+	return timewindow.DetermineSchedule(
+		"%d %d * * *",
+		garden.Spec.VirtualCluster.Maintenance.TimeWindow.Begin,
+		garden.Spec.VirtualCluster.Maintenance.TimeWindow.End,
+		garden.UID,
+		garden.CreationTimestamp,
+		timewindow.RandomizeWithinFirstHourOfTimeWindow,
+	)
+}
+
 func (r *Reconciler) deployKubeAPIServerFunc(garden *operatorv1alpha1.Garden, kubeAPIServer kubeapiserver.Interface) flow.TaskFn {
 	return func(ctx context.Context) error {
 		var (
@@ -1023,6 +1061,147 @@ func (r *Reconciler) snapshotETCDFunc(secretsManager secretsmanager.Interface, e
 	}
 }
 
+// restoreEtcdMainFunc restores the main ETCD of the virtual garden from its backups. The virtual kube-apiserver is
+// scaled down first so that no writes happen during the restore. In 'Drill' mode, a full snapshot is taken before the
+// data is wiped. Afterwards, the ETCD is scaled down, its volumes are deleted, and it is scaled up again with a single
+// member which restores the data from the backups before further members join the cluster.
+func (r *Reconciler) restoreEtcdMainFunc(log logr.Logger, garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface, etcdMain etcd.Interface) flow.TaskFn {
+	return func(ctx context.Context) error {
+		desiredReplicas := ptr.Deref(etcdMain.GetReplicas(), 1)
+
+		etcdObj, err := etcdMain.Get(ctx)
+		if err != nil {
+			return fmt.Errorf("failed reading main ETCD: %w", err)
+		}
+
+		// The main ETCD is only still running if a previous restore attempt did not already scale it down.
+		if etcdObj.Spec.Replicas > 0 {
+			log.Info("Scaling down virtual garden kube-apiserver before restoring main ETCD")
+			if err := kubernetesutils.ScaleDeployment(ctx, r.RuntimeClientSet.Client(), client.ObjectKey{Namespace: r.GardenNamespace, Name: operatorv1alpha1.DeploymentNameVirtualGardenKubeAPIServer}, 0); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed scaling down virtual garden kube-apiserver: %w", err)
+			}
+
+			if helper.GetETCDMainRestoreMode(garden) == operatorv1alpha1.RestoreModeDrill {
+				log.Info("Taking full snapshot of main ETCD before restoring it")
+				if err := r.snapshotETCDFunc(secretsManager, etcdMain)(ctx); err != nil {
+					return fmt.Errorf("failed taking full snapshot of main ETCD: %w", err)
+				}
+			}
+
+			log.Info("Scaling down main ETCD")
+			if err := scaleEtcd(ctx, etcdMain, 0); err != nil {
+				return err
+			}
+		}
+
+		log.Info("Deleting volumes of main ETCD")
+		if err := r.deleteEtcdVolumes(ctx, etcdObj); err != nil {
+			return err
+		}
+
+		log.Info("Scaling up main ETCD to restore data from backup")
+		if err := scaleEtcd(ctx, etcdMain, 1); err != nil {
+			return err
+		}
+
+		if desiredReplicas > 1 {
+			log.Info("Scaling up main ETCD to desired replicas", "replicas", desiredReplicas)
+			if err := scaleEtcd(ctx, etcdMain, desiredReplicas); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func scaleEtcd(ctx context.Context, etcdMain etcd.Interface, replicas int32) error {
+	if err := retry.UntilTimeout(ctx, 5*time.Second, 2*time.Minute, func(ctx context.Context) (bool, error) {
+		if err := etcdMain.Scale(ctx, replicas); err != nil {
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	}); err != nil {
+		return fmt.Errorf("failed scaling main ETCD to %d replicas: %w", replicas, err)
+	}
+
+	return etcdMain.Wait(ctx)
+}
+
+func (r *Reconciler) deleteEtcdVolumes(ctx context.Context, etcdObj *druidcorev1alpha1.Etcd) error {
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := r.RuntimeClientSet.Client().List(ctx, pvcList, client.InNamespace(etcdObj.Namespace)); err != nil {
+		return fmt.Errorf("failed listing persistent volume claims: %w", err)
+	}
+
+	volumeClaimTemplate := etcdObj.Name
+	if etcdObj.Spec.VolumeClaimTemplate != nil {
+		volumeClaimTemplate = *etcdObj.Spec.VolumeClaimTemplate
+	}
+	prefix := fmt.Sprintf("%s-%s-", volumeClaimTemplate, druidcorev1alpha1.GetStatefulSetName(etcdObj.ObjectMeta))
+
+	var pvcs []client.Object
+	for _, pvc := range pvcList.Items {
+		if strings.HasPrefix(pvc.Name, prefix) {
+			pvcs = append(pvcs, pvc.DeepCopy())
+		}
+	}
+
+	if err := kubernetesutils.DeleteObjects(ctx, r.RuntimeClientSet.Client(), pvcs...); err != nil {
+		return fmt.Errorf("failed deleting persistent volume claims of main ETCD: %w", err)
+	}
+
+	var fns []flow.TaskFn
+	for _, pvc := range pvcs {
+		fns = append(fns, func(ctx context.Context) error {
+			return kubernetesutils.WaitUntilResourceDeleted(ctx, r.RuntimeClientSet.Client(), pvc, 5*time.Second)
+		})
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	return flow.Parallel(fns...)(timeoutCtx)
+}
+
+// reportEtcdMainBackupStatus reports the times of the last full and delta snapshots of the main ETCD in the status of
+// the Garden. They are read from the snapshot leases maintained by etcd-backup-restore.
+func (r *Reconciler) reportEtcdMainBackupStatus(ctx context.Context, garden *operatorv1alpha1.Garden, etcdMain etcd.Interface) error {
+	etcdObj, err := etcdMain.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed reading main ETCD: %w", err)
+	}
+
+	snapshotTime := func(leaseName string) (*metav1.Time, error) {
+		lease := &coordinationv1.Lease{}
+		if err := r.RuntimeClientSet.Client().Get(ctx, client.ObjectKey{Namespace: etcdObj.Namespace, Name: leaseName}, lease); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		if lease.Spec.RenewTime == nil {
+			return nil, nil
+		}
+		return &metav1.Time{Time: lease.Spec.RenewTime.Time}, nil
+	}
+
+	lastFullSnapshotTime, err := snapshotTime(druidcorev1alpha1.GetFullSnapshotLeaseName(etcdObj.ObjectMeta))
+	if err != nil {
+		return fmt.Errorf("failed reading full snapshot lease of main ETCD: %w", err)
+	}
+	lastDeltaSnapshotTime, err := snapshotTime(druidcorev1alpha1.GetDeltaSnapshotLeaseName(etcdObj.ObjectMeta))
+	if err != nil {
+		return fmt.Errorf("failed reading delta snapshot lease of main ETCD: %w", err)
+	}
+
+	patch := client.MergeFrom(garden.DeepCopy())
+	if garden.Status.Backup == nil {
+		garden.Status.Backup = &operatorv1alpha1.BackupStatus{}
+	}
+	garden.Status.Backup.LastFullSnapshotTime = lastFullSnapshotTime
+	garden.Status.Backup.LastDeltaSnapshotTime = lastDeltaSnapshotTime
+
+	return r.RuntimeClientSet.Client().Status().Patch(ctx, garden, patch)
+}
+
 func (r *Reconciler) deployVirtualGardenGardenerResourceManager(secretsManager secretsmanager.Interface, resourceManager resourcemanager.Interface) flow.TaskFn {
 	return func(ctx context.Context) error {
 		return shared.DeployGardenerResourceManager(
@@ -28,11 +28,13 @@ func (h *Handler) ValidateCreate(_ context.Context, obj runtime.Object) (admissi
 		return nil, fmt.Errorf("expected *operatorv1alpha1.Extension but got %T", obj)
 	}
 
+	warnings := GetWarnings(extension)
+
 	if errs := validation.ValidateExtension(extension); len(errs) > 0 {
-		return nil, apierrors.NewInvalid(operatorv1alpha1.Kind("Extension"), extension.Name, errs)
+		return warnings, apierrors.NewInvalid(operatorv1alpha1.Kind("Extension"), extension.Name, errs)
 	}
 
-	return nil, nil
+	return warnings, nil
 }
 
 // ValidateUpdate performs the validation.
@@ -46,15 +48,17 @@ func (h *Handler) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Objec
 		return nil, fmt.Errorf("expected *operatorv1alpha1.Extension but got %T", newObj)
 	}
 
+	warnings := GetWarnings(newExtension)
+
 	if errs := validation.ValidateExtensionUpdate(oldExtension, newExtension); len(errs) > 0 {
-		return nil, apierrors.NewInvalid(operatorv1alpha1.Kind("Extension"), newExtension.Name, errs)
+		return warnings, apierrors.NewInvalid(operatorv1alpha1.Kind("Extension"), newExtension.Name, errs)
 	}
 
 	if errs := validation.ValidateExtension(newExtension); len(errs) > 0 {
-		return nil, apierrors.NewInvalid(operatorv1alpha1.Kind("Extension"), newExtension.Name, errs)
+		return warnings, apierrors.NewInvalid(operatorv1alpha1.Kind("Extension"), newExtension.Name, errs)
 	}
 
-	return nil, nil
+	return warnings, nil
 }
 
 // ValidateDelete performs the validation.
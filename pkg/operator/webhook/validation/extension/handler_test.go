@@ -26,6 +26,8 @@ var _ = Describe("Handler", func() {
 		handler   *Handler
 		resources []gardencorev1beta1.ControllerResource
 		extension *operatorv1alpha1.Extension
+
+		matchDigestWarning = ConsistOf(ContainSubstring("you should pin the Helm chart in spec.deployment.extension.helm.ociRepository by its digest"))
 	)
 
 	BeforeEach(func() {
@@ -54,9 +56,17 @@ var _ = Describe("Handler", func() {
 	})
 
 	Describe("#ValidateCreate", func() {
-		It("should return success for valid resource", func() {
+		It("should return success with a warning for valid resource not pinned by digest", func() {
 			warning, err := handler.ValidateCreate(ctx, extension)
-			Expect(warning).To(BeNil())
+			Expect(warning).To(matchDigestWarning)
+			Expect(err).To(Succeed())
+		})
+
+		It("should return success without warnings for valid resource pinned by digest", func() {
+			extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository.Digest = ptr.To("sha256:2d2c4f1b7e8a6b5b7f0e1b3a4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2c3d")
+
+			warning, err := handler.ValidateCreate(ctx, extension)
+			Expect(warning).To(BeEmpty())
 			Expect(err).To(Succeed())
 		})
 
@@ -65,7 +75,7 @@ var _ = Describe("Handler", func() {
 			extension.Spec.Resources = append(extension.Spec.Resources, resources[0])
 
 			warning, err := handler.ValidateCreate(ctx, extension)
-			Expect(warning).To(BeNil())
+			Expect(warning).To(matchDigestWarning)
 			Expect(err).To(HaveOccurred())
 
 			var statusErr *apierrors.StatusError
@@ -97,7 +107,7 @@ var _ = Describe("Handler", func() {
 			newExtension := extension.DeepCopy()
 
 			warning, err := handler.ValidateUpdate(ctx, extension, newExtension)
-			Expect(warning).To(BeNil())
+			Expect(warning).To(matchDigestWarning)
 			Expect(err).To(Succeed())
 		})
 
@@ -106,7 +116,7 @@ var _ = Describe("Handler", func() {
 			newExtension.Spec.Resources = append(newExtension.Spec.Resources, gardencorev1beta1.ControllerResource{Kind: "BackupBucket", Type: "test", Primary: ptr.To(false)})
 
 			warning, err := handler.ValidateUpdate(ctx, extension, newExtension)
-			Expect(warning).To(BeNil())
+			Expect(warning).To(matchDigestWarning)
 			Expect(err).To(Succeed())
 		})
 
@@ -116,7 +126,7 @@ var _ = Describe("Handler", func() {
 			newExtension.Spec.Resources[0].Primary = ptr.To(false)
 
 			warning, err := handler.ValidateUpdate(ctx, extension, newExtension)
-			Expect(warning).To(BeNil())
+			Expect(warning).To(matchDigestWarning)
 			Expect(err).To(MatchError(ContainSubstring("field is immutable")))
 		})
 	})
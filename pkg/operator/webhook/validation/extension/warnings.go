@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extension
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+)
+
+// GetWarnings returns warnings for the given Extension.
+func GetWarnings(extension *operatorv1alpha1.Extension) []string {
+	var warnings []string
+
+	if extension.Spec.Deployment == nil {
+		return warnings
+	}
+
+	fldPath := field.NewPath("spec", "deployment")
+
+	if deployment := extension.Spec.Deployment.ExtensionDeployment; deployment != nil {
+		warnings = append(warnings, getHelmWarnings(deployment.Helm, fldPath.Child("extension", "helm"))...)
+	}
+
+	if deployment := extension.Spec.Deployment.AdmissionDeployment; deployment != nil {
+		if deployment.RuntimeCluster != nil {
+			warnings = append(warnings, getHelmWarnings(deployment.RuntimeCluster.Helm, fldPath.Child("admission", "runtimeCluster", "helm"))...)
+		}
+		if deployment.VirtualCluster != nil {
+			warnings = append(warnings, getHelmWarnings(deployment.VirtualCluster.Helm, fldPath.Child("admission", "virtualCluster", "helm"))...)
+		}
+	}
+
+	return warnings
+}
+
+func getHelmWarnings(helm *operatorv1alpha1.ExtensionHelm, fldPath *field.Path) []string {
+	if helm == nil || helm.OCIRepository == nil || isPinnedByDigest(helm.OCIRepository) {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("you should pin the Helm chart in %s by its digest, otherwise the deployed chart may change without any modification of the Extension if the referenced tag is moved", fldPath.Child("ociRepository"))}
+}
+
+func isPinnedByDigest(oci *gardencorev1.OCIRepository) bool {
+	if oci.Ref != nil {
+		return strings.Contains(*oci.Ref, "@")
+	}
+	return oci.Digest != nil
+}
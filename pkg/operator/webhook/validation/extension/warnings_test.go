@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extension_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operator/webhook/validation/extension"
+)
+
+var _ = Describe("Warnings", func() {
+	Describe("#GetWarnings", func() {
+		It("should not return warnings when no deployment is configured", func() {
+			Expect(GetWarnings(&operatorv1alpha1.Extension{})).To(BeEmpty())
+		})
+
+		DescribeTable("spec.deployment.*.helm.ociRepository",
+			func(ociRepository *gardencorev1.OCIRepository, matcher gomegatypes.GomegaMatcher) {
+				helm := &operatorv1alpha1.ExtensionHelm{OCIRepository: ociRepository}
+				extension := &operatorv1alpha1.Extension{
+					Spec: operatorv1alpha1.ExtensionSpec{
+						Deployment: &operatorv1alpha1.Deployment{
+							ExtensionDeployment: &operatorv1alpha1.ExtensionDeploymentSpec{
+								DeploymentSpec: operatorv1alpha1.DeploymentSpec{Helm: helm},
+							},
+							AdmissionDeployment: &operatorv1alpha1.AdmissionDeploymentSpec{
+								RuntimeCluster: &operatorv1alpha1.DeploymentSpec{Helm: helm},
+								VirtualCluster: &operatorv1alpha1.DeploymentSpec{Helm: helm},
+							},
+						},
+					},
+				}
+
+				Expect(GetWarnings(extension)).To(matcher)
+			},
+
+			Entry("should not return warnings when the chart is pinned by digest",
+				&gardencorev1.OCIRepository{Repository: ptr.To("example.com/repo"), Tag: ptr.To("v1.0.0"), Digest: ptr.To("sha256:abc")},
+				BeEmpty(),
+			),
+			Entry("should not return warnings when the ref contains a digest",
+				&gardencorev1.OCIRepository{Ref: ptr.To("example.com/repo:v1.0.0@sha256:abc")},
+				BeEmpty(),
+			),
+			Entry("should return warnings when the chart is referenced by tag",
+				&gardencorev1.OCIRepository{Repository: ptr.To("example.com/repo"), Tag: ptr.To("v1.0.0")},
+				ConsistOf(
+					ContainSubstring("spec.deployment.extension.helm.ociRepository"),
+					ContainSubstring("spec.deployment.admission.runtimeCluster.helm.ociRepository"),
+					ContainSubstring("spec.deployment.admission.virtualCluster.helm.ociRepository"),
+				),
+			),
+			Entry("should return warnings when the ref does not contain a digest",
+				&gardencorev1.OCIRepository{Ref: ptr.To("example.com/repo:v1.0.0")},
+				HaveLen(3),
+			),
+		)
+	})
+})
@@ -41,7 +41,7 @@ func (h *Handler) ValidateCreate(ctx context.Context, obj runtime.Object) (admis
 		return nil, apierrors.NewInternalError(err)
 	}
 	if otherGardensAlreadyExist {
-		return nil, apierrors.NewBadRequest("there can be only one operator.gardener.cloud/v1alpha1.Garden resource in the system at a time")
+		return nil, apierrors.NewBadRequest("there can be only one operator.gardener.cloud/v1alpha1.Garden resource in the system at a time, multiple virtual gardens in one runtime cluster are not supported")
 	}
 
 	garden, ok := obj.(*operatorv1alpha1.Garden)
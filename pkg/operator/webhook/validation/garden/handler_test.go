@@ -91,7 +91,7 @@ var _ = Describe("Handler", func() {
 			statusError, ok := err.(*apierrors.StatusError)
 			Expect(ok).To(BeTrue())
 			Expect(statusError.Status().Code).To(Equal(int32(http.StatusBadRequest)))
-			Expect(statusError.Status().Message).To(ContainSubstring("there can be only one operator.gardener.cloud/v1alpha1.Garden resource in the system at a time"))
+			Expect(statusError.Status().Message).To(ContainSubstring("there can be only one operator.gardener.cloud/v1alpha1.Garden resource in the system at a time, multiple virtual gardens in one runtime cluster are not supported"))
 		})
 
 		Context("forbidden finalizers", func() {
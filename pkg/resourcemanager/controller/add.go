@@ -25,6 +25,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/networkpolicy"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/volumesnapshotpolicy"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 )
 
@@ -90,5 +91,13 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 		return fmt.Errorf("failed adding node controller: %w", err)
 	}
 
+	if cfg.Controllers.VolumeSnapshotPolicy.Enabled {
+		if err := (&volumesnapshotpolicy.Reconciler{
+			Config: cfg.Controllers.VolumeSnapshotPolicy,
+		}).AddToManager(mgr, targetCluster); err != nil {
+			return fmt.Errorf("failed adding volume snapshot policy controller: %w", err)
+		}
+	}
+
 	return nil
 }
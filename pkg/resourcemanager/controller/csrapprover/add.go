@@ -5,6 +5,8 @@
 package csrapprover
 
 import (
+	"fmt"
+
 	certificatesv1 "k8s.io/api/certificates/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -31,6 +33,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 	if r.TargetClient == nil {
 		r.TargetClient = targetCluster.GetClient()
 	}
+	if r.NodeAddressVerifier == nil {
+		var err error
+		if r.NodeAddressVerifier, err = NewNodeAddressVerifier(r.Config, r.SourceClient); err != nil {
+			return fmt.Errorf("failed creating node address verifier: %w", err)
+		}
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
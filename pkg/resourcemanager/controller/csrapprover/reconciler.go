@@ -16,7 +16,6 @@ import (
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/authentication/user"
 	certificatesclientv1 "k8s.io/client-go/kubernetes/typed/certificates/v1"
 	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
@@ -44,6 +43,8 @@ type Reconciler struct {
 	TargetClient       client.Client
 	CertificatesClient certificatesclientv1.CertificateSigningRequestInterface
 	Config             resourcemanagerconfigv1alpha1.CSRApproverControllerConfig
+	// NodeAddressVerifier verifies the DNS names and IP addresses requested in kubelet server certificate CSRs.
+	NodeAddressVerifier NodeAddressVerifier
 }
 
 // Reconcile performs the main reconciliation logic.
@@ -195,28 +196,6 @@ func (r *Reconciler) mustApproveKubeletServing(ctx context.Context, csr *certifi
 		}
 	}
 
-	var (
-		hostNames   []string
-		ipAddresses []netip.Addr
-	)
-
-	for _, address := range node.Status.Addresses {
-		switch address.Type {
-		case corev1.NodeHostName, corev1.NodeInternalDNS, corev1.NodeExternalDNS:
-			hostNames = append(hostNames, address.Address)
-		case corev1.NodeInternalIP, corev1.NodeExternalIP:
-			comparableIP, err := netip.ParseAddr(address.Address)
-			if err != nil {
-				return fmt.Sprintf("IP address %q in node.Status.Addresses is invalid: %v", address.Address, err), false, nil //nolint:nilerr
-			}
-			ipAddresses = append(ipAddresses, comparableIP)
-		}
-	}
-
-	if !sets.New(hostNames...).Equal(sets.New(x509cr.DNSNames...)) {
-		return "DNS names in CSR do not match addresses of type 'Hostname' or 'InternalDNS' or 'ExternalDNS' in node object", false, nil
-	}
-
 	var ipAddressesInCSR []netip.Addr
 	for _, ip := range x509cr.IPAddresses {
 		if comparableIP, ok := netip.AddrFromSlice(ip); ok {
@@ -224,8 +203,8 @@ func (r *Reconciler) mustApproveKubeletServing(ctx context.Context, csr *certifi
 		}
 	}
 
-	if !sets.New(ipAddresses...).Equal(sets.New(ipAddressesInCSR...)) {
-		return "IP addresses in CSR do not match addresses of type 'InternalIP' or 'ExternalIP' in node object", false, nil
+	if reason, ok, err := r.NodeAddressVerifier.Verify(ctx, node, x509cr.DNSNames, ipAddressesInCSR); err != nil || !ok {
+		return reason, false, err
 	}
 
 	return "all checks passed", true, nil
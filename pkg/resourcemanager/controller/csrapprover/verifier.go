@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrapprover
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
+)
+
+// NodeAddressVerifier verifies the DNS names and IP addresses requested in kubelet server certificate CSRs.
+type NodeAddressVerifier interface {
+	// Verify returns whether the given DNS names and IP addresses belong to the given node. If not, it also returns a
+	// reason.
+	Verify(ctx context.Context, node *corev1.Node, dnsNames []string, ipAddresses []netip.Addr) (string, bool, error)
+}
+
+// NewNodeAddressVerifier returns a NodeAddressVerifier for the given configuration.
+func NewNodeAddressVerifier(config resourcemanagerconfigv1alpha1.CSRApproverControllerConfig, sourceClient client.Client) (NodeAddressVerifier, error) {
+	if config.NodeAddressVerification == nil {
+		return &nodeVerifier{}, nil
+	}
+
+	switch config.NodeAddressVerification.Backend {
+	case resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendNode, "":
+		return &nodeVerifier{}, nil
+
+	case resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendMachine:
+		if config.MachineNamespace == nil {
+			return nil, fmt.Errorf("machine namespace must be configured for the %s backend", config.NodeAddressVerification.Backend)
+		}
+		return &machineVerifier{client: sourceClient, namespace: *config.MachineNamespace}, nil
+
+	case resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendWebhook:
+		if config.NodeAddressVerification.Webhook == nil {
+			return nil, fmt.Errorf("webhook must be configured for the %s backend", config.NodeAddressVerification.Backend)
+		}
+		return newWebhookVerifier(*config.NodeAddressVerification.Webhook)
+
+	default:
+		return nil, fmt.Errorf("unsupported node address verification backend %q", config.NodeAddressVerification.Backend)
+	}
+}
+
+// nodeVerifier verifies that the requested addresses match the addresses in the status of the Node object.
+type nodeVerifier struct{}
+
+func (v *nodeVerifier) Verify(_ context.Context, node *corev1.Node, dnsNames []string, ipAddresses []netip.Addr) (string, bool, error) {
+	return verifyAddresses(node.Status.Addresses, "node object", dnsNames, ipAddresses)
+}
+
+// machineVerifier verifies that the requested addresses match the addresses in the status of the Machine object of
+// the node.
+type machineVerifier struct {
+	client    client.Client
+	namespace string
+}
+
+func (v *machineVerifier) Verify(ctx context.Context, node *corev1.Node, dnsNames []string, ipAddresses []netip.Addr) (string, bool, error) {
+	machineList := &machinev1alpha1.MachineList{}
+	if err := v.client.List(ctx, machineList, client.InNamespace(v.namespace), client.MatchingLabels{machinev1alpha1.NodeLabelKey: node.Name}); err != nil {
+		return "", false, fmt.Errorf("failed to list machine objects: %w", err)
+	}
+
+	if length := len(machineList.Items); length != 1 {
+		return fmt.Sprintf("Expected exactly one machine in namespace %q for node %q but found %d", v.namespace, node.Name, length), false, nil
+	}
+
+	return verifyAddresses(machineList.Items[0].Status.Addresses, "machine object", dnsNames, ipAddresses)
+}
+
+func verifyAddresses(addresses []corev1.NodeAddress, source string, dnsNames []string, ipAddresses []netip.Addr) (string, bool, error) {
+	var (
+		hostNames        []string
+		allowedAddresses []netip.Addr
+	)
+
+	for _, address := range addresses {
+		switch address.Type {
+		case corev1.NodeHostName, corev1.NodeInternalDNS, corev1.NodeExternalDNS:
+			hostNames = append(hostNames, address.Address)
+		case corev1.NodeInternalIP, corev1.NodeExternalIP:
+			comparableIP, err := netip.ParseAddr(address.Address)
+			if err != nil {
+				return fmt.Sprintf("IP address %q in status addresses of %s is invalid: %v", address.Address, source, err), false, nil //nolint:nilerr
+			}
+			allowedAddresses = append(allowedAddresses, comparableIP)
+		}
+	}
+
+	if !sets.New(hostNames...).Equal(sets.New(dnsNames...)) {
+		return fmt.Sprintf("DNS names in CSR do not match addresses of type 'Hostname' or 'InternalDNS' or 'ExternalDNS' in %s", source), false, nil
+	}
+
+	if !sets.New(allowedAddresses...).Equal(sets.New(ipAddresses...)) {
+		return fmt.Sprintf("IP addresses in CSR do not match addresses of type 'InternalIP' or 'ExternalIP' in %s", source), false, nil
+	}
+
+	return "", true, nil
+}
+
+// NodeAddressVerificationRequest is the request sent to node address verification webhooks.
+type NodeAddressVerificationRequest struct {
+	// NodeName is the name of the node requesting the kubelet server certificate.
+	NodeName string `json:"nodeName"`
+	// ProviderID is the provider ID of the node.
+	ProviderID string `json:"providerID,omitempty"`
+	// DNSNames are the DNS names requested in the CSR.
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// IPAddresses are the IP addresses requested in the CSR.
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// NodeAddressVerificationResponse is the response expected from node address verification webhooks.
+type NodeAddressVerificationResponse struct {
+	// Allowed indicates whether the requested DNS names and IP addresses belong to the node.
+	Allowed bool `json:"allowed"`
+	// Reason is a human-readable explanation of the decision.
+	Reason string `json:"reason,omitempty"`
+}
+
+// webhookVerifier delegates the verification of the requested addresses to an external webhook.
+type webhookVerifier struct {
+	httpClient *http.Client
+	url        string
+}
+
+func newWebhookVerifier(config resourcemanagerconfigv1alpha1.NodeAddressVerificationWebhook) (*webhookVerifier, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(config.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(config.CABundle) {
+			return nil, fmt.Errorf("failed parsing CA bundle of node address verification webhook")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	if config.Timeout != nil {
+		httpClient.Timeout = config.Timeout.Duration
+	}
+
+	return &webhookVerifier{httpClient: httpClient, url: config.URL}, nil
+}
+
+func (v *webhookVerifier) Verify(ctx context.Context, node *corev1.Node, dnsNames []string, ipAddresses []netip.Addr) (string, bool, error) {
+	verificationRequest := NodeAddressVerificationRequest{
+		NodeName:   node.Name,
+		ProviderID: node.Spec.ProviderID,
+		DNSNames:   dnsNames,
+	}
+	for _, ip := range ipAddresses {
+		verificationRequest.IPAddresses = append(verificationRequest.IPAddresses, ip.String())
+	}
+
+	body, err := json.Marshal(verificationRequest)
+	if err != nil {
+		return "", false, fmt.Errorf("failed marshalling node address verification request: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, v.url, bytes.NewReader(body))
+	if err != nil {
+		return "", false, fmt.Errorf("failed creating node address verification request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := v.httpClient.Do(request)
+	if err != nil {
+		return "", false, fmt.Errorf("failed calling node address verification webhook: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		responseBody, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return "", false, fmt.Errorf("node address verification webhook returned unexpected status code %d: %s", response.StatusCode, string(responseBody))
+	}
+
+	verificationResponse := &NodeAddressVerificationResponse{}
+	if err := json.NewDecoder(response.Body).Decode(verificationResponse); err != nil {
+		return "", false, fmt.Errorf("failed decoding node address verification response: %w", err)
+	}
+
+	if !verificationResponse.Allowed {
+		reason := "addresses in CSR were rejected by node address verification webhook"
+		if verificationResponse.Reason != "" {
+			reason += ": " + verificationResponse.Reason
+		}
+		return reason, false, nil
+	}
+
+	return "", true, nil
+}
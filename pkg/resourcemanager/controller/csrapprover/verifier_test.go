@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrapprover_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/csrapprover"
+)
+
+var _ = Describe("NodeAddressVerifier", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		config     resourcemanagerconfigv1alpha1.CSRApproverControllerConfig
+
+		node        *corev1.Node
+		dnsNames    = []string{"node-1", "node-1.internal"}
+		ipAddresses = []netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("192.0.2.1")}
+		addresses   = []corev1.NodeAddress{
+			{Type: corev1.NodeHostName, Address: "node-1"},
+			{Type: corev1.NodeInternalDNS, Address: "node-1.internal"},
+			{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+			{Type: corev1.NodeExternalIP, Address: "192.0.2.1"},
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		config = resourcemanagerconfigv1alpha1.CSRApproverControllerConfig{MachineNamespace: ptr.To("shoot--foo--bar")}
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Spec:       corev1.NodeSpec{ProviderID: "provider:///node-1"},
+		}
+	})
+
+	Describe("Node backend", func() {
+		var verifier NodeAddressVerifier
+
+		BeforeEach(func() {
+			var err error
+			verifier, err = NewNodeAddressVerifier(config, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should accept addresses matching the node object", func() {
+			node.Status.Addresses = addresses
+
+			_, ok, err := verifier.Verify(ctx, node, dnsNames, ipAddresses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should reject addresses not matching the node object", func() {
+			node.Status.Addresses = addresses[:3]
+
+			reason, ok, err := verifier.Verify(ctx, node, dnsNames, ipAddresses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("IP addresses in CSR do not match addresses of type 'InternalIP' or 'ExternalIP' in node object"))
+		})
+	})
+
+	Describe("Machine backend", func() {
+		var (
+			verifier NodeAddressVerifier
+			machine  *machinev1alpha1.Machine
+		)
+
+		BeforeEach(func() {
+			config.NodeAddressVerification = &resourcemanagerconfigv1alpha1.NodeAddressVerification{Backend: resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendMachine}
+
+			var err error
+			verifier, err = NewNodeAddressVerifier(config, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			machine = &machinev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "machine-1",
+					Namespace: "shoot--foo--bar",
+					Labels:    map[string]string{"node": node.Name},
+				},
+			}
+		})
+
+		It("should fail without machine namespace", func() {
+			config.MachineNamespace = nil
+			_, err := NewNodeAddressVerifier(config, fakeClient)
+			Expect(err).To(MatchError(ContainSubstring("machine namespace must be configured")))
+		})
+
+		It("should accept addresses matching the machine object", func() {
+			machine.Status.Addresses = addresses
+			Expect(fakeClient.Create(ctx, machine)).To(Succeed())
+
+			_, ok, err := verifier.Verify(ctx, node, dnsNames, ipAddresses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should reject addresses not matching the machine object", func() {
+			node.Status.Addresses = addresses
+			machine.Status.Addresses = addresses[2:]
+			Expect(fakeClient.Create(ctx, machine)).To(Succeed())
+
+			reason, ok, err := verifier.Verify(ctx, node, dnsNames, ipAddresses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("DNS names in CSR do not match addresses of type 'Hostname' or 'InternalDNS' or 'ExternalDNS' in machine object"))
+		})
+
+		It("should reject the addresses if the machine does not exist", func() {
+			reason, ok, err := verifier.Verify(ctx, node, dnsNames, ipAddresses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(ContainSubstring("Expected exactly one machine"))
+		})
+	})
+
+	Describe("Webhook backend", func() {
+		var (
+			server   *httptest.Server
+			response NodeAddressVerificationResponse
+			request  NodeAddressVerificationRequest
+			status   int
+		)
+
+		BeforeEach(func() {
+			response = NodeAddressVerificationResponse{}
+			request = NodeAddressVerificationRequest{}
+			status = http.StatusOK
+
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+
+				w.WriteHeader(status)
+				Expect(json.NewEncoder(w).Encode(response)).To(Succeed())
+			}))
+			DeferCleanup(server.Close)
+
+			config.NodeAddressVerification = &resourcemanagerconfigv1alpha1.NodeAddressVerification{
+				Backend: resourcemanagerconfigv1alpha1.NodeAddressVerificationBackendWebhook,
+				Webhook: &resourcemanagerconfigv1alpha1.NodeAddressVerificationWebhook{
+					URL:      server.URL,
+					CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+				},
+			}
+		})
+
+		It("should fail for an invalid CA bundle", func() {
+			config.NodeAddressVerification.Webhook.CABundle = []byte("foo")
+			_, err := NewNodeAddressVerifier(config, fakeClient)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing CA bundle")))
+		})
+
+		It("should accept the addresses if the webhook allows them", func() {
+			response.Allowed = true
+
+			verifier, err := NewNodeAddressVerifier(config, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, ok, err := verifier.Verify(ctx, node, dnsNames, ipAddresses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			Expect(request).To(Equal(NodeAddressVerificationRequest{
+				NodeName:    "node-1",
+				ProviderID:  "provider:///node-1",
+				DNSNames:    dnsNames,
+				IPAddresses: []string{"10.0.0.1", "192.0.2.1"},
+			}))
+		})
+
+		It("should reject the addresses if the webhook denies them", func() {
+			response.Reason = "unknown address"
+
+			verifier, err := NewNodeAddressVerifier(config, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			reason, ok, err := verifier.Verify(ctx, node, dnsNames, ipAddresses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(reason).To(Equal("addresses in CSR were rejected by node address verification webhook: unknown address"))
+		})
+
+		It("should return an error if the webhook fails", func() {
+			status = http.StatusInternalServerError
+
+			verifier, err := NewNodeAddressVerifier(config, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = verifier.Verify(ctx, node, dnsNames, ipAddresses)
+			Expect(err).To(MatchError(ContainSubstring("unexpected status code 500")))
+		})
+	})
+
+	It("should fail for unsupported backends", func() {
+		config.NodeAddressVerification = &resourcemanagerconfigv1alpha1.NodeAddressVerification{Backend: "foo"}
+		_, err := NewNodeAddressVerifier(config, fakeClient)
+		Expect(err).To(MatchError(ContainSubstring("unsupported node address verification backend")))
+	})
+})
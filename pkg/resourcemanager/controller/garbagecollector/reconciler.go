@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -28,6 +29,7 @@ import (
 	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	resourcemanagermetrics "github.com/gardener/gardener/pkg/resourcemanager/metrics"
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 )
 
@@ -49,6 +51,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconc
 	var (
 		labels                  = client.MatchingLabels{references.LabelKeyGarbageCollectable: references.LabelValueGarbageCollectable}
 		objectsToGarbageCollect = sets.New[objectId]()
+		expiredObjects          = sets.New[objectId]()
 	)
 
 	for _, resource := range []struct {
@@ -65,6 +68,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconc
 		}
 
 		for _, obj := range objList.Items {
+			if ttl, ok := obj.Annotations[references.AnnotationKeyGarbageCollectableTTL]; ok {
+				expired, err := r.ttlExpired(obj.CreationTimestamp, ttl)
+				if err != nil {
+					log.Error(err, "Ignoring invalid garbage collection TTL", "kind", resource.kind, "namespace", obj.Namespace, "name", obj.Name)
+				} else if expired {
+					expiredObjects.Insert(objectId{resource.kind, obj.Namespace, obj.Name})
+					continue
+				}
+			}
+
 			if obj.CreationTimestamp.Add(*r.MinimumObjectLifetime).UTC().After(r.Clock.Now().UTC()) {
 				// Do not consider recently created objects for garbage collection.
 				continue
@@ -112,13 +125,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconc
 	}
 
 	var (
+		dryRun    = ptr.Deref(r.Config.DryRun, false)
 		results   = make(chan error, 1)
 		wg        wait.Group
 		errorList = &multierror.Error{ErrorFormat: errorsutils.NewErrorFormatFuncWithPrefix("Could not delete all unused resources")}
 	)
 
-	for id := range objectsToGarbageCollect {
+	for id := range objectsToGarbageCollect.Union(expiredObjects) {
 		objId := id
+		reason := reasonUnreferenced
+		if expiredObjects.Has(objId) {
+			reason = reasonTTLExpired
+		}
 
 		wg.StartWithContext(ctx, func(ctx context.Context) {
 			var (
@@ -135,15 +153,31 @@ func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconc
 				return
 			}
 
+			if dryRun {
+				log.Info("Would delete resource (dry run)",
+					"kind", objId.kind,
+					"namespace", objId.namespace,
+					"name", objId.name,
+					"reason", reason,
+				)
+				resourcemanagermetrics.GarbageCollectorCollectedObjectsTotal.WithLabelValues(objId.kind, reason, "true").Inc()
+				return
+			}
+
 			log.Info("Delete resource",
 				"kind", objId.kind,
 				"namespace", objId.namespace,
 				"name", objId.name,
+				"reason", reason,
 			)
 
-			if err := r.TargetClient.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
-				results <- err
+			if err := r.TargetClient.Delete(ctx, obj); err != nil {
+				if client.IgnoreNotFound(err) != nil {
+					results <- err
+				}
+				return
 			}
+			resourcemanagermetrics.GarbageCollectorCollectedObjectsTotal.WithLabelValues(objId.kind, reason, "false").Inc()
 		})
 	}
 
@@ -161,6 +195,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconc
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, errorList.ErrorOrNil()
 }
 
+// ttlExpired returns whether the given TTL of an object created at the given time has expired.
+func (r *Reconciler) ttlExpired(creationTimestamp metav1.Time, ttl string) (bool, error) {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return false, fmt.Errorf("failed parsing TTL %q: %w", ttl, err)
+	}
+	return !creationTimestamp.Add(duration).UTC().After(r.Clock.Now().UTC()), nil
+}
+
+const (
+	reasonUnreferenced = "unreferenced"
+	reasonTTLExpired   = "ttl-expired"
+)
+
 type objectId struct {
 	kind      string
 	namespace string
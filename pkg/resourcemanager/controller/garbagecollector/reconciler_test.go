@@ -10,12 +10,14 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -25,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	resourcemanagermetrics "github.com/gardener/gardener/pkg/resourcemanager/metrics"
 )
 
 var _ = Describe("Collector", func() {
@@ -253,6 +256,81 @@ var _ = Describe("Collector", func() {
 				*labeledConfigMap7,
 			))
 		})
+
+		Context("with TTL", func() {
+			var (
+				expiredSecret    *corev1.Secret
+				notExpiredSecret *corev1.Secret
+				invalidTTLSecret *corev1.Secret
+			)
+
+			BeforeEach(func() {
+				expiredSecret = &corev1.Secret{ObjectMeta: *labeledObjectMeta.DeepCopy()}
+				expiredSecret.Name += "-expired"
+				expiredSecret.Annotations = map[string]string{"resources.gardener.cloud/garbage-collectable-ttl": "20s"}
+				expiredSecret.CreationTimestamp = creationTimestamp
+
+				notExpiredSecret = &corev1.Secret{ObjectMeta: *labeledObjectMeta.DeepCopy()}
+				notExpiredSecret.Name += "-not-expired"
+				notExpiredSecret.Annotations = map[string]string{"resources.gardener.cloud/garbage-collectable-ttl": "1h"}
+				notExpiredSecret.CreationTimestamp = creationTimestamp
+
+				invalidTTLSecret = &corev1.Secret{ObjectMeta: *labeledObjectMeta.DeepCopy()}
+				invalidTTLSecret.Name += "-invalid"
+				invalidTTLSecret.Annotations = map[string]string{"resources.gardener.cloud/garbage-collectable-ttl": "foo"}
+
+				Expect(c.Create(ctx, expiredSecret)).To(Succeed())
+				Expect(c.Create(ctx, notExpiredSecret)).To(Succeed())
+				Expect(c.Create(ctx, invalidTTLSecret)).To(Succeed())
+			})
+
+			It("should delete expired resources even if they are still referenced", func() {
+				Expect(c.Create(ctx, &appsv1.Deployment{ObjectMeta: objectMetaFor("deploy1", expiredSecret, notExpiredSecret, invalidTTLSecret)})).To(Succeed())
+
+				collectedBefore := testutil.ToFloat64(resourcemanagermetrics.GarbageCollectorCollectedObjectsTotal.WithLabelValues("secret", "ttl-expired", "false"))
+
+				_, err := gc.Reconcile(ctx, reconcile.Request{})
+				Expect(err).NotTo(HaveOccurred())
+
+				secretList := &corev1.SecretList{}
+				Expect(c.List(ctx, secretList)).To(Succeed())
+				Expect(secretList.Items).To(ConsistOf(*notExpiredSecret, *invalidTTLSecret))
+
+				Expect(testutil.ToFloat64(resourcemanagermetrics.GarbageCollectorCollectedObjectsTotal.WithLabelValues("secret", "ttl-expired", "false"))).To(Equal(collectedBefore + 1))
+			})
+
+			It("should treat unexpired resources and those with invalid TTL like any other resource", func() {
+				_, err := gc.Reconcile(ctx, reconcile.Request{})
+				Expect(err).NotTo(HaveOccurred())
+
+				secretList := &corev1.SecretList{}
+				Expect(c.List(ctx, secretList)).To(Succeed())
+				// The unexpired secret is kept because it was created recently, while the other one is unreferenced.
+				Expect(secretList.Items).To(ConsistOf(*notExpiredSecret))
+			})
+		})
+
+		It("should not delete anything in dry-run mode", func() {
+			gc.Config.DryRun = ptr.To(true)
+
+			Expect(c.Create(ctx, labeledSecret1)).To(Succeed())
+			Expect(c.Create(ctx, labeledConfigMap1)).To(Succeed())
+
+			collectedBefore := testutil.ToFloat64(resourcemanagermetrics.GarbageCollectorCollectedObjectsTotal.WithLabelValues("secret", "unreferenced", "true"))
+
+			_, err := gc.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			secretList := &corev1.SecretList{}
+			Expect(c.List(ctx, secretList)).To(Succeed())
+			Expect(secretList.Items).To(ConsistOf(*labeledSecret1))
+
+			configMapList := &corev1.ConfigMapList{}
+			Expect(c.List(ctx, configMapList)).To(Succeed())
+			Expect(configMapList.Items).To(ConsistOf(*labeledConfigMap1))
+
+			Expect(testutil.ToFloat64(resourcemanagermetrics.GarbageCollectorCollectedObjectsTotal.WithLabelValues("secret", "unreferenced", "true"))).To(Equal(collectedBefore + 1))
+		})
 	})
 })
 
@@ -32,6 +32,10 @@ const (
 	// makes the GRM's garbage collector controller considering it for potential deletion in case it is unused by any
 	// workload.
 	LabelValueGarbageCollectable = "true"
+	// AnnotationKeyGarbageCollectableTTL is a constant for an annotation key on a garbage-collectable Secret or
+	// ConfigMap resource which contains a duration (e.g. `24h`) after which the GRM's garbage collector controller
+	// deletes it, regardless of whether it is still referenced by any workload.
+	AnnotationKeyGarbageCollectableTTL = "resources.gardener.cloud/garbage-collectable-ttl"
 
 	delimiter = "-"
 	// AnnotationKeyPrefix is a constant for the prefix used in annotations keys to indicate references to
@@ -49,6 +49,13 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.CustomHealthChecks == nil {
+		customHealthChecks, err := utils.NewCustomHealthChecks(r.Config.CustomHealthChecks)
+		if err != nil {
+			return err
+		}
+		r.CustomHealthChecks = customHealthChecks
+	}
 
 	c, err := builder.
 		ControllerManagedBy(mgr).
@@ -102,7 +109,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 			targetCluster.GetCache(),
 			obj,
 			handler.EnqueueRequestsFromMapFunc(utils.MapToOriginManagedResource(c.GetLogger(), clusterID)),
-			utils.HealthStatusChanged(c.GetLogger()),
+			utils.HealthStatusChanged(c.GetLogger(), r.CustomHealthChecks),
 		)); err != nil {
 			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
 		}
@@ -19,7 +19,7 @@ import (
 )
 
 // HealthStatusChanged returns a predicate that filters for events that indicate a change in the object's health status.
-func HealthStatusChanged(log logr.Logger) predicate.Predicate {
+func HealthStatusChanged(log logr.Logger, customHealthChecks CustomHealthChecks) predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
 			return e.Object.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] != "true"
@@ -34,7 +34,7 @@ func HealthStatusChanged(log logr.Logger) predicate.Predicate {
 			}
 
 			var oldHealthy, newHealthy bool
-			checked, oldErr := CheckHealth(e.ObjectOld)
+			checked, oldErr := customHealthChecks.CheckHealth(e.ObjectOld)
 			if !checked {
 				if oldErr != nil {
 					log.Error(oldErr, "Error determining health status of old object", "object", e.ObjectOld)
@@ -43,7 +43,7 @@ func HealthStatusChanged(log logr.Logger) predicate.Predicate {
 			}
 			oldHealthy = oldErr != nil
 
-			checked, newErr := CheckHealth(e.ObjectNew)
+			checked, newErr := customHealthChecks.CheckHealth(e.ObjectNew)
 			if !checked {
 				if newErr != nil {
 					log.Error(newErr, "Error determining health status of new object", "object", e.ObjectNew)
@@ -6,6 +6,7 @@ package utils
 
 import (
 	"context"
+	"fmt"
 
 	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -16,10 +17,13 @@ import (
 	apiextensionsinstall "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/install"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
@@ -95,6 +99,45 @@ func CheckHealth(obj client.Object) (bool, error) {
 	return false, nil
 }
 
+// CustomHealthChecks contains health checks for objects of arbitrary kinds, e.g., custom resources of other operators.
+// Objects of these kinds are checked as unstructured objects.
+type CustomHealthChecks map[schema.GroupKind]health.Func
+
+// NewCustomHealthChecks compiles the given custom health check configurations.
+func NewCustomHealthChecks(configs []resourcemanagerconfigv1alpha1.CustomHealthCheck) (CustomHealthChecks, error) {
+	checks := make(CustomHealthChecks, len(configs))
+	for _, config := range configs {
+		check, err := health.NewCELHealthCheck(config.Expression, config.Message)
+		if err != nil {
+			return nil, fmt.Errorf("failed compiling custom health check for %s: %w", schema.GroupKind{Group: config.Group, Kind: config.Kind}, err)
+		}
+		checks[schema.GroupKind{Group: config.Group, Kind: config.Kind}] = check
+	}
+	return checks, nil
+}
+
+// Has returns true if a custom health check is configured for the given GroupKind.
+func (c CustomHealthChecks) Has(groupKind schema.GroupKind) bool {
+	_, ok := c[groupKind]
+	return ok
+}
+
+// CheckHealth checks whether the given object is healthy. Unstructured objects of kinds with a custom health check are
+// checked with it, all other objects are checked with the built-in health checks (see CheckHealth).
+// It returns a bool indicating whether the object was actually checked and an error if any health check failed.
+func (c CustomHealthChecks) CheckHealth(obj client.Object) (bool, error) {
+	if unstructuredObj, ok := obj.(*unstructured.Unstructured); ok {
+		if check, ok := c[unstructuredObj.GroupVersionKind().GroupKind()]; ok {
+			if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+				return false, nil
+			}
+			return true, check(obj)
+		}
+	}
+
+	return CheckHealth(obj)
+}
+
 // FetchAdditionalFailureMessage fetches warning event messages for some objects as additional failure information.
 func FetchAdditionalFailureMessage(ctx context.Context, c client.Client, obj client.Object) (string, error) {
 	switch obj.(type) {
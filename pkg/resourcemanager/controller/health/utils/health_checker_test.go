@@ -15,10 +15,13 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
@@ -574,3 +577,76 @@ var _ = Describe("CheckHealth", func() {
 		testSuite()
 	})
 })
+
+var _ = Describe("CustomHealthChecks", func() {
+	var (
+		customHealthChecks CustomHealthChecks
+		dnsEntry           *unstructured.Unstructured
+	)
+
+	BeforeEach(func() {
+		var err error
+		customHealthChecks, err = NewCustomHealthChecks([]resourcemanagerconfigv1alpha1.CustomHealthCheck{{
+			Group:      "dns.gardener.cloud",
+			Kind:       "DNSEntry",
+			Expression: `has(object.status) && object.status.state == "Ready"`,
+			Message:    "DNSEntry is not ready",
+		}})
+		Expect(err).NotTo(HaveOccurred())
+
+		dnsEntry = &unstructured.Unstructured{}
+		dnsEntry.SetAPIVersion("dns.gardener.cloud/v1alpha1")
+		dnsEntry.SetKind("DNSEntry")
+	})
+
+	Describe("#NewCustomHealthChecks", func() {
+		It("should fail for invalid expressions", func() {
+			_, err := NewCustomHealthChecks([]resourcemanagerconfigv1alpha1.CustomHealthCheck{{Kind: "Foo", Expression: "object."}})
+			Expect(err).To(MatchError(ContainSubstring("failed compiling custom health check for Foo")))
+		})
+	})
+
+	Describe("#Has", func() {
+		It("should return whether a custom health check is configured", func() {
+			Expect(customHealthChecks.Has(schema.GroupKind{Group: "dns.gardener.cloud", Kind: "DNSEntry"})).To(BeTrue())
+			Expect(customHealthChecks.Has(schema.GroupKind{Group: "apps", Kind: "Deployment"})).To(BeFalse())
+		})
+	})
+
+	Describe("#CheckHealth", func() {
+		It("should check unstructured objects with the custom health check", func() {
+			checked, err := customHealthChecks.CheckHealth(dnsEntry)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("DNSEntry is not ready"))
+
+			Expect(unstructured.SetNestedField(dnsEntry.Object, "Ready", "status", "state")).To(Succeed())
+			checked, err = customHealthChecks.CheckHealth(dnsEntry)
+			Expect(checked).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not check objects with skip-health-check annotation", func() {
+			dnsEntry.SetAnnotations(map[string]string{resourcesv1alpha1.SkipHealthCheck: "true"})
+
+			checked, err := customHealthChecks.CheckHealth(dnsEntry)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fall back to the built-in health checks for other objects", func() {
+			checked, err := customHealthChecks.CheckHealth(&appsv1.Deployment{})
+			Expect(checked).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not check unstructured objects without custom health check", func() {
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion("foo.bar/v1")
+			obj.SetKind("Foo")
+
+			checked, err := customHealthChecks.CheckHealth(obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
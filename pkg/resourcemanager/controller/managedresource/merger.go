@@ -105,21 +105,55 @@ func merge(origin string, desired, current *unstructured.Unstructured, forceOver
 	}
 	preserveResources := annotations[resourcesv1alpha1.PreserveResources] == "true"
 
+	var err error
 	switch newObject.GroupVersionKind().GroupKind() {
 	case appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(), extensionsv1beta1.SchemeGroupVersion.WithKind("Deployment").GroupKind():
-		return mergeDeployment(scheme.Scheme, oldObject, newObject, preserveReplicas, preserveResources)
+		err = mergeDeployment(scheme.Scheme, oldObject, newObject, preserveReplicas, preserveResources)
 	case batchv1.SchemeGroupVersion.WithKind("Job").GroupKind():
-		return mergeJob(scheme.Scheme, oldObject, newObject, preserveResources)
+		err = mergeJob(scheme.Scheme, oldObject, newObject, preserveResources)
 	case batchv1.SchemeGroupVersion.WithKind("CronJob").GroupKind():
-		return mergeCronJob(scheme.Scheme, oldObject, newObject, preserveResources)
+		err = mergeCronJob(scheme.Scheme, oldObject, newObject, preserveResources)
 	case appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(), extensionsv1beta1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind():
-		return mergeStatefulSet(scheme.Scheme, oldObject, newObject, preserveReplicas, preserveResources)
+		err = mergeStatefulSet(scheme.Scheme, oldObject, newObject, preserveReplicas, preserveResources)
 	case appsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind():
-		return mergeDaemonSet(scheme.Scheme, oldObject, newObject, preserveResources)
+		err = mergeDaemonSet(scheme.Scheme, oldObject, newObject, preserveResources)
 	case corev1.SchemeGroupVersion.WithKind("Service").GroupKind():
-		return mergeService(scheme.Scheme, oldObject, newObject)
+		err = mergeService(scheme.Scheme, oldObject, newObject)
 	case corev1.SchemeGroupVersion.WithKind("ServiceAccount").GroupKind():
-		return mergeServiceAccount(scheme.Scheme, oldObject, newObject)
+		err = mergeServiceAccount(scheme.Scheme, oldObject, newObject)
+	}
+	if err != nil {
+		return err
+	}
+
+	return preserveFields(oldObject, newObject, annotations[resourcesv1alpha1.PreserveFields])
+}
+
+// preserveFields keeps the values of the given comma-separated field paths of the old object in the new object. Fields
+// which are not set in the old object are taken from the new object.
+func preserveFields(oldObj, newObj *unstructured.Unstructured, paths string) error {
+	for path := range strings.SplitSeq(paths, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		fields := strings.Split(path, ".")
+		if fields[0] == "metadata" || fields[0] == "status" {
+			return fmt.Errorf("field %q cannot be preserved via the %s annotation", path, resourcesv1alpha1.PreserveFields)
+		}
+
+		value, found, err := unstructured.NestedFieldCopy(oldObj.Object, fields...)
+		if err != nil {
+			return fmt.Errorf("failed reading field %q to preserve: %w", path, err)
+		}
+		if !found {
+			continue
+		}
+
+		if err := unstructured.SetNestedField(newObj.Object, value, fields...); err != nil {
+			return fmt.Errorf("failed preserving field %q: %w", path, err)
+		}
 	}
 
 	return nil
@@ -256,6 +256,43 @@ var _ = Describe("merger", func() {
 			Expect(current.Object["status"]).To(BeNil())
 		})
 
+		Describe("preserve fields annotation", func() {
+			BeforeEach(func() {
+				current.Object["spec"] = map[string]any{
+					"priority":              int64(10),
+					"schedulerName":         "custom-scheduler",
+					"nodeSelector":          map[string]any{"foo": "bar"},
+					"hostname":              "current",
+					"activeDeadlineSeconds": int64(30),
+				}
+				desired.Object["spec"] = map[string]any{
+					"priority":      int64(20),
+					"schedulerName": "default-scheduler",
+					"hostname":      "desired",
+					"subdomain":     "desired",
+				}
+			})
+
+			It("should keep the current values of the given fields", func() {
+				desired.SetAnnotations(map[string]string{"resources.gardener.cloud/preserve-fields": "spec.priority, spec.nodeSelector,spec.subdomain"})
+
+				Expect(merge(origin, desired, current, false, nil, false, nil, false)).To(Succeed())
+				Expect(current.Object["spec"]).To(Equal(map[string]any{
+					"priority":      int64(10),
+					"schedulerName": "default-scheduler",
+					"nodeSelector":  map[string]any{"foo": "bar"},
+					"hostname":      "desired",
+					"subdomain":     "desired",
+				}))
+			})
+
+			It("should fail for metadata fields", func() {
+				desired.SetAnnotations(map[string]string{"resources.gardener.cloud/preserve-fields": "metadata.labels"})
+
+				Expect(merge(origin, desired, current, false, nil, false, nil, false)).To(MatchError(ContainSubstring(`field "metadata.labels" cannot be preserved`)))
+			})
+		})
+
 		Describe("sets warning annotation", func() {
 			AfterEach(func() {
 				Expect(current.GetAnnotations()).
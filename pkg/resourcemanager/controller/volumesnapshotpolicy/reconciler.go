@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package volumesnapshotpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"github.com/robfig/cron"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap in the kube-system namespace which contains the volume snapshot
+	// policies.
+	ConfigMapName = "gardener-volume-snapshot-policies"
+	// DataKeyPolicies is the key in the data of the ConfigMap which contains the volume snapshot policies.
+	DataKeyPolicies = "policies.yaml"
+	// StatusConfigMapName is the name of the ConfigMap in the kube-system namespace in which the controller stores the
+	// time of the last run of each policy.
+	StatusConfigMapName = "gardener-volume-snapshot-policies-status"
+	// LabelPolicy is the label on VolumeSnapshots which contains the name of the policy which created them.
+	LabelPolicy = "snapshot.gardener.cloud/policy"
+
+	defaultRetention int32 = 7
+)
+
+// Reconciler takes scheduled VolumeSnapshots of PersistentVolumeClaims according to the policies in the ConfigMap and
+// deletes the snapshots exceeding the retention of the policies.
+type Reconciler struct {
+	TargetClient client.Client
+	TargetReader client.Reader
+	Config       resourcemanagerconfigv1alpha1.VolumeSnapshotPolicyControllerConfig
+	Clock        clock.Clock
+}
+
+// Reconcile takes the due VolumeSnapshots of all policies and requeues until the next scheduled time.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.TargetClient.Get(ctx, request.NamespacedName, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, cleaning up status ConfigMap")
+			return reconcile.Result{}, client.IgnoreNotFound(r.TargetClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: StatusConfigMapName, Namespace: metav1.NamespaceSystem}}))
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	var policies []gardencorev1beta1.VolumeSnapshotPolicy
+	if err := yaml.Unmarshal([]byte(configMap.Data[DataKeyPolicies]), &policies); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed decoding volume snapshot policies: %w", err)
+	}
+
+	statusConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: StatusConfigMapName, Namespace: metav1.NamespaceSystem}}
+	if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(statusConfigMap), statusConfigMap); client.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading status ConfigMap: %w", err)
+	}
+	lastRuns := make(map[string]string, len(policies))
+
+	var (
+		now          = r.Clock.Now().UTC()
+		requeueAfter time.Duration
+		errs         []error
+	)
+
+	for _, policy := range policies {
+		policyLog := log.WithValues("policy", policy.Name)
+
+		schedule, err := cron.ParseStandard(policy.Schedule)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed parsing schedule of policy %q: %w", policy.Name, err))
+			continue
+		}
+
+		lastRun := now
+		if v, ok := statusConfigMap.Data[policy.Name]; ok {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				lastRun = t
+			}
+		}
+
+		if !schedule.Next(lastRun).After(now) {
+			policyLog.Info("Taking scheduled volume snapshots")
+			if err := r.takeSnapshots(ctx, policyLog, policy, now); err != nil {
+				errs = append(errs, fmt.Errorf("failed taking snapshots for policy %q: %w", policy.Name, err))
+				lastRuns[policy.Name] = lastRun.Format(time.RFC3339)
+				continue
+			}
+			lastRun = now
+		}
+		lastRuns[policy.Name] = lastRun.Format(time.RFC3339)
+
+		if err := r.deleteExpiredSnapshots(ctx, policyLog, policy); err != nil {
+			errs = append(errs, fmt.Errorf("failed deleting expired snapshots for policy %q: %w", policy.Name, err))
+		}
+
+		if next := schedule.Next(now).Sub(now); requeueAfter == 0 || next < requeueAfter {
+			requeueAfter = next
+		}
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.TargetClient, statusConfigMap, func() error {
+		statusConfigMap.Data = lastRuns
+		return nil
+	}); err != nil {
+		errs = append(errs, fmt.Errorf("failed updating status ConfigMap: %w", err))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *Reconciler) takeSnapshots(ctx context.Context, log logr.Logger, policy gardencorev1beta1.VolumeSnapshotPolicy, now time.Time) error {
+	selector := labels.Everything()
+	if policy.Selector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(policy.Selector); err != nil {
+			return fmt.Errorf("failed parsing selector: %w", err)
+		}
+	}
+
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := r.TargetReader.List(ctx, pvcList, client.InNamespace(policy.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed listing PersistentVolumeClaims: %w", err)
+	}
+
+	var errs []error
+	for _, pvc := range pvcList.Items {
+		if pvc.DeletionTimestamp != nil || pvc.Status.Phase != corev1.ClaimBound {
+			continue
+		}
+
+		volumeSnapshot := &volumesnapshotv1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      snapshotName(policy.Name, pvc.Name, now),
+				Namespace: policy.Namespace,
+				Labels:    map[string]string{LabelPolicy: policy.Name},
+			},
+			Spec: volumesnapshotv1.VolumeSnapshotSpec{
+				Source:                  volumesnapshotv1.VolumeSnapshotSource{PersistentVolumeClaimName: ptr.To(pvc.Name)},
+				VolumeSnapshotClassName: policy.VolumeSnapshotClassName,
+			},
+		}
+
+		if err := r.TargetClient.Create(ctx, volumeSnapshot); err != nil && !apierrors.IsAlreadyExists(err) {
+			errs = append(errs, fmt.Errorf("failed creating VolumeSnapshot for PersistentVolumeClaim %q: %w", pvc.Name, err))
+			continue
+		}
+		log.V(1).Info("Created VolumeSnapshot", "volumeSnapshot", client.ObjectKeyFromObject(volumeSnapshot))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Reconciler) deleteExpiredSnapshots(ctx context.Context, log logr.Logger, policy gardencorev1beta1.VolumeSnapshotPolicy) error {
+	volumeSnapshotList := &volumesnapshotv1.VolumeSnapshotList{}
+	if err := r.TargetReader.List(ctx, volumeSnapshotList, client.InNamespace(policy.Namespace), client.MatchingLabels{LabelPolicy: policy.Name}); err != nil {
+		return fmt.Errorf("failed listing VolumeSnapshots: %w", err)
+	}
+
+	snapshotsPerPVC := make(map[string][]volumesnapshotv1.VolumeSnapshot)
+	for _, volumeSnapshot := range volumeSnapshotList.Items {
+		pvcName := ptr.Deref(volumeSnapshot.Spec.Source.PersistentVolumeClaimName, "")
+		snapshotsPerPVC[pvcName] = append(snapshotsPerPVC[pvcName], volumeSnapshot)
+	}
+
+	var (
+		retention = int(ptr.Deref(policy.Retention, defaultRetention))
+		errs      []error
+	)
+
+	for _, volumeSnapshots := range snapshotsPerPVC {
+		if len(volumeSnapshots) <= retention {
+			continue
+		}
+
+		// newest snapshots first
+		slices.SortFunc(volumeSnapshots, func(a, b volumesnapshotv1.VolumeSnapshot) int {
+			return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+		})
+
+		for _, volumeSnapshot := range volumeSnapshots[retention:] {
+			log.Info("Deleting expired VolumeSnapshot", "volumeSnapshot", client.ObjectKeyFromObject(&volumeSnapshot))
+			if err := r.TargetClient.Delete(ctx, &volumeSnapshot); client.IgnoreNotFound(err) != nil {
+				errs = append(errs, fmt.Errorf("failed deleting VolumeSnapshot %q: %w", volumeSnapshot.Name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func snapshotName(policyName, pvcName string, now time.Time) string {
+	name := fmt.Sprintf("%s-%s-%s", policyName, now.Format("20060102150405"), pvcName)
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		name = strings.TrimRight(name[:validation.DNS1123SubdomainMaxLength], "-.")
+	}
+	return name
+}
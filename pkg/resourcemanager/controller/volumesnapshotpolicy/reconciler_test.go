@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package volumesnapshotpolicy_test
+
+import (
+	"context"
+	"time"
+
+	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resourcemanagerclient "github.com/gardener/gardener/pkg/resourcemanager/client"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/volumesnapshotpolicy"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		configMap       *corev1.ConfigMap
+		statusConfigMap *corev1.ConfigMap
+		request         reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(resourcemanagerclient.TargetScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 1, 30, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			TargetClient: fakeClient,
+			TargetReader: fakeClient,
+			Clock:        fakeClock,
+		}
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "gardener-volume-snapshot-policies", Namespace: "kube-system"},
+			Data: map[string]string{"policies.yaml": `- name: daily
+  schedule: "0 2 * * *"
+  namespace: default
+  selector:
+    matchLabels:
+      backup: "true"
+  volumeSnapshotClassName: retain
+  retention: 2
+`},
+		}
+		statusConfigMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gardener-volume-snapshot-policies-status", Namespace: "kube-system"}}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(configMap)}
+
+		Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+		for _, pvc := range []*corev1.PersistentVolumeClaim{
+			{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default", Labels: map[string]string{"backup": "true"}}, Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "default", Labels: map[string]string{"backup": "true"}}, Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}, Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound}},
+		} {
+			Expect(fakeClient.Create(ctx, pvc)).To(Succeed())
+		}
+	})
+
+	It("should remember the first run and requeue until the next scheduled time", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(statusConfigMap), statusConfigMap)).To(Succeed())
+		Expect(statusConfigMap.Data).To(Equal(map[string]string{"daily": "2026-01-01T01:30:00Z"}))
+
+		volumeSnapshotList := &volumesnapshotv1.VolumeSnapshotList{}
+		Expect(fakeClient.List(ctx, volumeSnapshotList)).To(Succeed())
+		Expect(volumeSnapshotList.Items).To(BeEmpty())
+	})
+
+	It("should take the snapshots of the selected bound PersistentVolumeClaims when they are due", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+
+		fakeClock.Step(30 * time.Minute)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 24 * time.Hour}))
+
+		volumeSnapshotList := &volumesnapshotv1.VolumeSnapshotList{}
+		Expect(fakeClient.List(ctx, volumeSnapshotList)).To(Succeed())
+		Expect(volumeSnapshotList.Items).To(HaveLen(1))
+		Expect(volumeSnapshotList.Items[0].Name).To(Equal("daily-20260101020000-data"))
+		Expect(volumeSnapshotList.Items[0].Namespace).To(Equal("default"))
+		Expect(volumeSnapshotList.Items[0].Labels).To(Equal(map[string]string{"snapshot.gardener.cloud/policy": "daily"}))
+		Expect(volumeSnapshotList.Items[0].Spec).To(Equal(volumesnapshotv1.VolumeSnapshotSpec{
+			Source:                  volumesnapshotv1.VolumeSnapshotSource{PersistentVolumeClaimName: ptr.To("data")},
+			VolumeSnapshotClassName: ptr.To("retain"),
+		}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(statusConfigMap), statusConfigMap)).To(Succeed())
+		Expect(statusConfigMap.Data).To(Equal(map[string]string{"daily": "2026-01-01T02:00:00Z"}))
+	})
+
+	It("should delete the snapshots exceeding the retention", func() {
+		for i, name := range []string{"oldest", "older", "newest"} {
+			Expect(fakeClient.Create(ctx, &volumesnapshotv1.VolumeSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         "default",
+					Labels:            map[string]string{"snapshot.gardener.cloud/policy": "daily"},
+					CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(time.Duration(i-10) * time.Hour)),
+				},
+				Spec: volumesnapshotv1.VolumeSnapshotSpec{Source: volumesnapshotv1.VolumeSnapshotSource{PersistentVolumeClaimName: ptr.To("data")}},
+			})).To(Succeed())
+		}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		volumeSnapshotList := &volumesnapshotv1.VolumeSnapshotList{}
+		Expect(fakeClient.List(ctx, volumeSnapshotList)).To(Succeed())
+		Expect(volumeSnapshotList.Items).To(ConsistOf(
+			HaveField("Name", "older"),
+			HaveField("Name", "newest"),
+		))
+	})
+
+	It("should delete the status ConfigMap if the policies ConfigMap is gone", func() {
+		Expect(fakeClient.Create(ctx, statusConfigMap)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, configMap)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(statusConfigMap), statusConfigMap)).To(BeNotFoundError())
+	})
+})
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-resource-manager.
+const Namespace = "gardener_resource_manager"
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	factory = promauto.With(runtimemetrics.Registry)
+	// GarbageCollectorCollectedObjectsTotal defines the counter garbage_collector_collected_objects_total.
+	GarbageCollectorCollectedObjectsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "garbage_collector_collected_objects_total",
+			Help:      "Number of objects collected by the garbage collector.",
+		},
+		[]string{
+			"kind",
+			"reason",
+			"dry_run",
+		},
+	)
+)
@@ -16,6 +16,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/endpointslicehints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/extensionvalidation"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/highavailabilityconfig"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/imageoverrides"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/kubernetesservicehost"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/nodeagentauthorizer"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podkubeapiserverloadbalancing"
@@ -25,15 +26,17 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/seccompprofile"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/systemcomponentsconfig"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/vpainplaceupdates"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/workloaddefaults"
 )
 
 // AddToManager adds all webhook handlers to the given manager.
 func AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Cluster, cfg *resourcemanagerconfigv1alpha1.ResourceManagerConfiguration) error {
 	if cfg.Webhooks.CRDDeletionProtection.Enabled {
 		if err := (&crddeletionprotection.Handler{
-			Logger:       mgr.GetLogger().WithName("webhook").WithName(crddeletionprotection.HandlerName),
-			SourceReader: sourceCluster.GetAPIReader(),
-			Decoder:      admission.NewDecoder(mgr.GetScheme()),
+			Logger:                 mgr.GetLogger().WithName("webhook").WithName(crddeletionprotection.HandlerName),
+			SourceReader:           sourceCluster.GetAPIReader(),
+			Decoder:                admission.NewDecoder(mgr.GetScheme()),
+			CheckExistingResources: cfg.Webhooks.CRDDeletionProtection.CheckExistingResources,
 		}).AddToManager(mgr); err != nil {
 			return fmt.Errorf("failed adding %s webhook handler: %w", crddeletionprotection.HandlerName, err)
 		}
@@ -64,6 +67,16 @@ func AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Clus
 		}
 	}
 
+	if cfg.Webhooks.ImageOverrides.Enabled {
+		if err := (&imageoverrides.Handler{
+			Logger:       mgr.GetLogger().WithName("webhook").WithName(imageoverrides.HandlerName),
+			TargetClient: targetCluster.GetClient(),
+			Decoder:      admission.NewDecoder(mgr.GetScheme()),
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding %s webhook handler: %w", imageoverrides.HandlerName, err)
+		}
+	}
+
 	if cfg.Webhooks.KubernetesServiceHost.Enabled {
 		if err := (&kubernetesservicehost.Handler{
 			Logger: mgr.GetLogger().WithName("webhook").WithName(kubernetesservicehost.HandlerName),
@@ -96,7 +109,9 @@ func AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Clus
 
 	if cfg.Webhooks.PodSchedulerName.Enabled {
 		if err := (&podschedulername.Handler{
+			TargetReader:  targetCluster.GetCache(),
 			SchedulerName: *cfg.Webhooks.PodSchedulerName.SchedulerName,
+			Rules:         cfg.Webhooks.PodSchedulerName.Rules,
 		}).AddToManager(mgr); err != nil {
 			return fmt.Errorf("failed adding %s webhook handler: %w", podschedulername.HandlerName, err)
 		}
@@ -145,5 +160,15 @@ func AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Clus
 		}
 	}
 
+	if cfg.Webhooks.WorkloadDefaults.Enabled {
+		if err := (&workloaddefaults.Handler{
+			Logger:                    mgr.GetLogger().WithName("webhook").WithName(workloaddefaults.HandlerName),
+			TopologySpreadConstraints: cfg.Webhooks.WorkloadDefaults.TopologySpreadConstraints,
+			Affinity:                  cfg.Webhooks.WorkloadDefaults.Affinity,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding %s webhook handler: %w", workloaddefaults.HandlerName, err)
+		}
+	}
+
 	return nil
 }
@@ -7,7 +7,9 @@ package crddeletionprotection
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,7 +21,9 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -36,6 +40,9 @@ type Handler struct {
 	Logger       logr.Logger
 	SourceReader client.Reader
 	Decoder      admission.Decoder
+	// CheckExistingResources defines whether the deletion of CustomResourceDefinitions is also prevented as long as
+	// custom resources of them still exist.
+	CheckExistingResources bool
 }
 
 // Handle validates the DELETE request.
@@ -48,7 +55,10 @@ func (h *Handler) Handle(ctx context.Context, request admission.Request) admissi
 		return admission.Allowed("operation is not DELETE")
 	}
 
-	var listOp client.ListOption
+	var (
+		listOp client.ListOption
+		isCRD  bool
+	)
 
 	// Ignore all resources other than our expected ones
 	switch request.Resource {
@@ -56,6 +66,7 @@ func (h *Handler) Handle(ctx context.Context, request admission.Request) admissi
 		metav1.GroupVersionResource{Group: apiextensionsv1beta1.SchemeGroupVersion.Group, Version: apiextensionsv1beta1.SchemeGroupVersion.Version, Resource: "customresourcedefinitions"},
 		metav1.GroupVersionResource{Group: apiextensionsv1.SchemeGroupVersion.Group, Version: apiextensionsv1.SchemeGroupVersion.Version, Resource: "customresourcedefinitions"}:
 		listOp = client.MatchingLabels(ObjectSelector)
+		isCRD = true
 
 	case
 		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "backupbuckets"},
@@ -69,6 +80,7 @@ func (h *Handler) Handle(ctx context.Context, request admission.Request) admissi
 		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "networks"},
 		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "operatingsystemconfigs"},
 		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "selfhostedshootexposures"},
+		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "shootleftovers"},
 		metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "workers"}:
 		listOp = client.InNamespace(request.Namespace)
 
@@ -99,6 +111,17 @@ func (h *Handler) Handle(ctx context.Context, request admission.Request) admissi
 	if err := admitObjectDeletion(log, obj); err != nil {
 		return admission.Denied(err.Error())
 	}
+
+	if isCRD && h.CheckExistingResources {
+		reason, err := h.checkForExistingResources(ctx, log, obj)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if reason != "" {
+			return admission.Denied(reason)
+		}
+	}
+
 	return admission.Allowed("")
 }
 
@@ -131,3 +154,94 @@ func checkIfObjectDeletionIsConfirmed(log logr.Logger, object runtime.Object) er
 	log.Info("Deletion is confirmed - allowing deletion")
 	return nil
 }
+
+// checkForExistingResources checks if custom resources of the given CustomResourceDefinition (or list of them) still
+// exist. If so, it returns a reason for denying the deletion which lists the number of resources per namespace.
+func (h *Handler) checkForExistingResources(ctx context.Context, log logr.Logger, obj runtime.Object) (string, error) {
+	var reasons []string
+
+	check := func(o runtime.Object) error {
+		unstructuredObj, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expected *unstructured.Unstructured but got %T", o)
+		}
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObj.UnstructuredContent(), crd); err != nil {
+			return fmt.Errorf("failed converting object to CustomResourceDefinition: %w", err)
+		}
+
+		reason, err := h.checkForExistingResourcesOfCRD(ctx, crd)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			log.Info("Custom resources still exist - preventing deletion", "name", crd.Name)
+			reasons = append(reasons, reason)
+		}
+		return nil
+	}
+
+	var err error
+	if meta.IsListType(obj) {
+		err = meta.EachListItem(obj, check)
+	} else {
+		err = check(obj)
+	}
+
+	return strings.Join(reasons, "; "), err
+}
+
+func (h *Handler) checkForExistingResourcesOfCRD(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition) (string, error) {
+	version := servedVersion(crd)
+	if version == "" {
+		return "", nil
+	}
+
+	list := &metav1.PartialObjectMetadataList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{Group: crd.Spec.Group, Version: version, Kind: crd.Spec.Names.Kind + "List"})
+	if err := h.SourceReader.List(ctx, list); err != nil {
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed listing resources of CustomResourceDefinition %s: %w", crd.Name, err)
+	}
+
+	if len(list.Items) == 0 {
+		return "", nil
+	}
+
+	countPerNamespace := make(map[string]int)
+	for _, item := range list.Items {
+		countPerNamespace[item.Namespace]++
+	}
+
+	var counts []string
+	for _, namespace := range slices.Sorted(maps.Keys(countPerNamespace)) {
+		if namespace == "" {
+			counts = append(counts, fmt.Sprintf("%d cluster-scoped", countPerNamespace[namespace]))
+			continue
+		}
+		counts = append(counts, fmt.Sprintf("%d in namespace %q", countPerNamespace[namespace], namespace))
+	}
+
+	return fmt.Sprintf("CustomResourceDefinition %s cannot be deleted because %d %s resources still exist (%s)", crd.Name, len(list.Items), crd.Spec.Names.Kind, strings.Join(counts, ", ")), nil
+}
+
+// servedVersion returns the storage version of the given CustomResourceDefinition if it is served, or otherwise the
+// first served version.
+func servedVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	var version string
+	for _, v := range crd.Spec.Versions {
+		if !v.Served {
+			continue
+		}
+		if v.Storage {
+			return v.Name
+		}
+		if version == "" {
+			version = v.Name
+		}
+	}
+	return version
+}
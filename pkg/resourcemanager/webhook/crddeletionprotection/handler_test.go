@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -88,6 +89,7 @@ var _ = Describe("handler", func() {
 				{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "networks"},
 				{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "operatingsystemconfigs"},
 				{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "selfhostedshootexposures"},
+				{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "shootleftovers"},
 				{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "workers"},
 			}
 			fooResource = metav1.GroupVersionResource{Group: "foo", Version: "bar", Resource: "baz"}
@@ -320,6 +322,84 @@ var _ = Describe("handler", func() {
 				}
 			})
 		})
+
+		Context("existing resources are checked", func() {
+			var (
+				fakeClient client.Client
+				crd        *apiextensionsv1.CustomResourceDefinition
+			)
+
+			BeforeEach(func() {
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+				handler = &Handler{Logger: log, SourceReader: fakeClient, Decoder: decoder, CheckExistingResources: true}
+
+				crd = &apiextensionsv1.CustomResourceDefinition{
+					TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "infrastructures.extensions.gardener.cloud",
+						Labels:      map[string]string{gardenerutils.DeletionProtected: "true"},
+						Annotations: deletionConfirmedAnnotations,
+					},
+					Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+						Group: "extensions.gardener.cloud",
+						Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Infrastructure", Plural: "infrastructures"},
+						Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+							{Name: "v1alpha0", Served: false},
+							{Name: "v1alpha1", Served: true, Storage: true},
+						},
+					},
+				}
+
+				crdJSON, err := json.Marshal(crd)
+				Expect(err).NotTo(HaveOccurred())
+
+				request.Name = crd.Name
+				request.Resource = metav1.GroupVersionResource{Group: apiextensionsv1.SchemeGroupVersion.Group, Version: apiextensionsv1.SchemeGroupVersion.Version, Resource: "customresourcedefinitions"}
+				request.OldObject = runtime.RawExtension{Raw: crdJSON}
+			})
+
+			createInfrastructure := func(namespace, name string) {
+				ExpectWithOffset(1, fakeClient.Create(ctx, &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}})).To(Succeed())
+			}
+
+			It("should admit the deletion because no resources exist", func() {
+				expectAllowed(handler.Handle(ctx, request), Equal(""))
+			})
+
+			It("should prevent the deletion because resources still exist", func() {
+				createInfrastructure("shoot--foo--bar", "bar")
+				createInfrastructure("shoot--foo--baz", "baz")
+				createInfrastructure("shoot--foo--baz", "baz2")
+
+				expectDenied(handler.Handle(ctx, request), Equal(`CustomResourceDefinition infrastructures.extensions.gardener.cloud cannot be deleted because 3 Infrastructure resources still exist (1 in namespace "shoot--foo--bar", 2 in namespace "shoot--foo--baz")`))
+			})
+
+			It("should still prevent the deletion if it is not confirmed", func() {
+				crd.Annotations = nil
+				crdJSON, err := json.Marshal(crd)
+				Expect(err).NotTo(HaveOccurred())
+				request.OldObject = runtime.RawExtension{Raw: crdJSON}
+
+				expectDenied(handler.Handle(ctx, request), ContainSubstring("annotation to delete"))
+			})
+
+			It("should not check for existing resources if the check is disabled", func() {
+				handler = &Handler{Logger: log, SourceReader: fakeClient, Decoder: decoder}
+				createInfrastructure("shoot--foo--bar", "bar")
+
+				expectAllowed(handler.Handle(ctx, request), Equal(""))
+			})
+
+			It("should not check for existing resources for other resources than CRDs", func() {
+				createInfrastructure("shoot--foo--bar", "bar")
+
+				obj := &unstructured.Unstructured{}
+				request.Resource = metav1.GroupVersionResource{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Version: extensionsv1alpha1.SchemeGroupVersion.Version, Resource: "infrastructures"}
+				request.OldObject = runtime.RawExtension{Raw: getObjectJSONWithLabelsAnnotations(obj, request.Resource, nil, deletionConfirmedAnnotations)}
+
+				expectAllowed(handler.Handle(ctx, request), Equal(""))
+			})
+		})
 	})
 })
 
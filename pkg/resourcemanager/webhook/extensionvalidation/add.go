@@ -39,6 +39,8 @@ const (
 	WebhookPathOperatingSystemConfig = "/validate-extensions-gardener-cloud-v1alpha1-operatingsystemconfig"
 	// WebhookPathSelfHostedShootExposure is the HTTP handler path for this admission webhook handler for SelfHostedShootExposure.
 	WebhookPathSelfHostedShootExposure = "/validate-extensions-gardener-cloud-v1alpha1-selfhostedshootexposure"
+	// WebhookPathShootLeftover is the HTTP handler path for this admission webhook handler for ShootLeftover.
+	WebhookPathShootLeftover = "/validate-extensions-gardener-cloud-v1alpha1-shootleftover"
 	// WebhookPathWorker is the HTTP handler path for this admission webhook handler for Worker.
 	WebhookPathWorker = "/validate-extensions-gardener-cloud-v1alpha1-worker"
 )
@@ -82,6 +84,9 @@ func AddToManager(mgr manager.Manager) error {
 		func() error {
 			return builder.WebhookManagedBy(mgr, &extensionsv1alpha1.SelfHostedShootExposure{}).WithValidator(&selfHostedShootExposureValidator{}).Complete()
 		},
+		func() error {
+			return builder.WebhookManagedBy(mgr, &extensionsv1alpha1.ShootLeftover{}).WithValidator(&shootLeftoverValidator{}).Complete()
+		},
 		func() error {
 			return builder.WebhookManagedBy(mgr, &extensionsv1alpha1.Worker{}).WithValidator(&workerValidator{}).Complete()
 		},
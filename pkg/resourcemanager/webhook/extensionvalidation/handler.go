@@ -29,6 +29,7 @@ type (
 	networkValidator                 struct{}
 	operatingSystemConfigValidator   struct{}
 	selfHostedShootExposureValidator struct{}
+	shootLeftoverValidator           struct{}
 	workerValidator                  struct{}
 )
 
@@ -212,6 +213,24 @@ func (selfHostedShootExposureValidator) ValidateDelete(_ context.Context, _ *ext
 	return nil, nil
 }
 
+func (shootLeftoverValidator) ValidateCreate(_ context.Context, obj *extensionsv1alpha1.ShootLeftover) (admission.Warnings, error) {
+	if errs := validation.ValidateShootLeftover(obj); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(extensionsv1alpha1.Kind(extensionsv1alpha1.ShootLeftoverResource), obj.GetName(), errs)
+	}
+	return nil, nil
+}
+
+func (shootLeftoverValidator) ValidateUpdate(_ context.Context, oldObj, newObj *extensionsv1alpha1.ShootLeftover) (admission.Warnings, error) {
+	if errs := validation.ValidateShootLeftoverUpdate(newObj, oldObj); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(extensionsv1alpha1.Kind(extensionsv1alpha1.ShootLeftoverResource), newObj.GetName(), errs)
+	}
+	return nil, nil
+}
+
+func (shootLeftoverValidator) ValidateDelete(_ context.Context, _ *extensionsv1alpha1.ShootLeftover) (admission.Warnings, error) {
+	return nil, nil
+}
+
 func (networkValidator) ValidateCreate(_ context.Context, obj *extensionsv1alpha1.Network) (admission.Warnings, error) {
 	if errs := validation.ValidateNetwork(obj); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(extensionsv1alpha1.Kind(extensionsv1alpha1.NetworkResource), obj.GetName(), errs)
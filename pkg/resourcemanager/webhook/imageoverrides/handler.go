@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imageoverrides
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// Handler handles admission requests and replaces the images of containers in the pod templates of workload resources
+// based on the image overrides maintained in the annotation of the respective namespace.
+type Handler struct {
+	Logger       logr.Logger
+	TargetClient client.Reader
+	Decoder      admission.Decoder
+}
+
+// Handle replaces the images of the containers of the provided resource.
+func (h *Handler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	namespace := &corev1.Namespace{}
+	if err := h.TargetClient.Get(ctx, client.ObjectKey{Name: req.Namespace}, namespace); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	value, ok := namespace.Annotations[resourcesv1alpha1.ImageOverrides]
+	if !ok {
+		return admission.Allowed("no image overrides configured for namespace")
+	}
+
+	overrides := map[string]string{}
+	if err := json.Unmarshal([]byte(value), &overrides); err != nil {
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed parsing image overrides of namespace %s: %w", req.Namespace, err))
+	}
+
+	var (
+		obj         runtime.Object
+		podTemplate *corev1.PodTemplateSpec
+	)
+
+	switch (schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}) {
+	case appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind():
+		deployment := &appsv1.Deployment{}
+		obj, podTemplate = deployment, &deployment.Spec.Template
+	case appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind():
+		statefulSet := &appsv1.StatefulSet{}
+		obj, podTemplate = statefulSet, &statefulSet.Spec.Template
+	case appsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind():
+		daemonSet := &appsv1.DaemonSet{}
+		obj, podTemplate = daemonSet, &daemonSet.Spec.Template
+	default:
+		return admission.Allowed(fmt.Sprintf("unexpected resource: %s", req.Kind))
+	}
+
+	if err := h.Decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusUnprocessableEntity, err)
+	}
+
+	log := h.Logger.WithValues("kind", req.Kind.Kind, "namespace", req.Namespace, "name", req.Name)
+	initContainersMutated := overrideImages(log, podTemplate.Spec.InitContainers, overrides)
+	if containersMutated := overrideImages(log, podTemplate.Spec.Containers, overrides); !initContainersMutated && !containersMutated {
+		return admission.Allowed("no images to override")
+	}
+
+	marshalled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshalled)
+}
+
+func overrideImages(log logr.Logger, containers []corev1.Container, overrides map[string]string) bool {
+	var mutated bool
+
+	for i, container := range containers {
+		image, ok := overrides[imagevectorutils.RepositoryOf(container.Image)]
+		if !ok || image == container.Image {
+			continue
+		}
+
+		log.Info("Overriding image of container", "container", container.Name, "image", container.Image, "override", image)
+		containers[i].Image = image
+		mutated = true
+	}
+
+	return mutated
+}
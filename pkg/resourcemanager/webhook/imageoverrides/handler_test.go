@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imageoverrides_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/resourcemanager/webhook/imageoverrides"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		handler    *Handler
+
+		namespace  *corev1.Namespace
+		deployment *appsv1.Deployment
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		handler = &Handler{
+			Logger:       logr.Discard(),
+			TargetClient: fakeClient,
+			Decoder:      admission.NewDecoder(kubernetes.SeedScheme),
+		}
+
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "shoot--foo--bar",
+				Annotations: map[string]string{
+					resourcesv1alpha1.ImageOverrides: `{"example.com/kube-apiserver":"example.com/hotfix/kube-apiserver:v1.33.1-hotfix"}`,
+				},
+			},
+		}
+
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: namespace.Name},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						InitContainers: []corev1.Container{{Name: "init", Image: "example.com/alpine:3.20"}},
+						Containers: []corev1.Container{
+							{Name: "kube-apiserver", Image: "example.com/kube-apiserver:v1.33.1"},
+							{Name: "sidecar", Image: "example.com/sidecar:v1.0.0"},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	request := func(obj runtime.Object, kind string) admission.Request {
+		raw, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Group: appsv1.GroupName, Version: "v1", Kind: kind},
+			Namespace: namespace.Name,
+			Name:      "kube-apiserver",
+			Object:    runtime.RawExtension{Raw: raw},
+		}}
+	}
+
+	It("should fail if the namespace does not exist", func() {
+		response := handler.Handle(ctx, request(deployment, "Deployment"))
+
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Code).To(Equal(int32(http.StatusInternalServerError)))
+	})
+
+	It("should not mutate anything if the namespace has no image overrides", func() {
+		delete(namespace.Annotations, resourcesv1alpha1.ImageOverrides)
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		response := handler.Handle(ctx, request(deployment, "Deployment"))
+
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patches).To(BeEmpty())
+	})
+
+	It("should fail if the image overrides cannot be parsed", func() {
+		namespace.Annotations[resourcesv1alpha1.ImageOverrides] = "foo"
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		response := handler.Handle(ctx, request(deployment, "Deployment"))
+
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Code).To(Equal(int32(http.StatusInternalServerError)))
+	})
+
+	It("should not mutate anything if no container uses an overridden image", func() {
+		namespace.Annotations[resourcesv1alpha1.ImageOverrides] = `{"example.com/etcd":"example.com/hotfix/etcd:v3.5.1"}`
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		response := handler.Handle(ctx, request(deployment, "Deployment"))
+
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patches).To(BeEmpty())
+	})
+
+	It("should override the images of deployments", func() {
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		response := handler.Handle(ctx, request(deployment, "Deployment"))
+
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patches).To(ConsistOf(jsonpatch.NewOperation("replace", "/spec/template/spec/containers/0/image", "example.com/hotfix/kube-apiserver:v1.33.1-hotfix")))
+	})
+
+	It("should override the images of init containers of statefulsets", func() {
+		namespace.Annotations[resourcesv1alpha1.ImageOverrides] = `{"example.com/alpine":"example.com/alpine:3.20.1"}`
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		statefulSet := &appsv1.StatefulSet{Spec: appsv1.StatefulSetSpec{Template: deployment.Spec.Template}}
+
+		response := handler.Handle(ctx, request(statefulSet, "StatefulSet"))
+
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patches).To(ConsistOf(jsonpatch.NewOperation("replace", "/spec/template/spec/initContainers/0/image", "example.com/alpine:3.20.1")))
+	})
+
+	It("should override the images of daemonsets", func() {
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		daemonSet := &appsv1.DaemonSet{Spec: appsv1.DaemonSetSpec{Template: deployment.Spec.Template}}
+
+		response := handler.Handle(ctx, request(daemonSet, "DaemonSet"))
+
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patches).To(ConsistOf(jsonpatch.NewOperation("replace", "/spec/template/spec/containers/0/image", "example.com/hotfix/kube-apiserver:v1.33.1-hotfix")))
+	})
+
+	It("should not mutate already overridden images", func() {
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+		deployment.Spec.Template.Spec.Containers[0].Image = "example.com/hotfix/kube-apiserver:v1.33.1-hotfix"
+
+		response := handler.Handle(ctx, request(deployment, "Deployment"))
+
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patches).To(BeEmpty())
+	})
+})
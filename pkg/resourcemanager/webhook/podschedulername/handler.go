@@ -9,25 +9,103 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
 )
 
 // Handler handles admission requests and sets the spec.schedulerName field in Pod resources.
 type Handler struct {
+	// TargetReader is used to read the labels of namespaces if any of the rules specifies a namespace selector.
+	TargetReader client.Reader
+	// SchedulerName is the scheduler name for pods which are not matched by any of the rules.
 	SchedulerName string
+	// Rules is a list of rules which are evaluated in order. The scheduler name of the first matching rule is used.
+	Rules []resourcemanagerconfigv1alpha1.PodSchedulerNameRule
 }
 
 // Default defaults the scheduler name of the provided pod.
-func (h *Handler) Default(_ context.Context, obj runtime.Object) error {
+func (h *Handler) Default(ctx context.Context, obj runtime.Object) error {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
 		return fmt.Errorf("expected *corev1.Pod but got %T", obj)
 	}
 
 	// Only overwrite the scheduler name when no custom scheduler name is specified
-	if pod.Spec.SchedulerName == "" || pod.Spec.SchedulerName == corev1.DefaultSchedulerName {
-		pod.Spec.SchedulerName = h.SchedulerName
+	if pod.Spec.SchedulerName != "" && pod.Spec.SchedulerName != corev1.DefaultSchedulerName {
+		return nil
+	}
+
+	schedulerName, err := h.schedulerNameFor(ctx, pod)
+	if err != nil {
+		return err
 	}
 
+	pod.Spec.SchedulerName = schedulerName
 	return nil
 }
+
+// schedulerNameFor returns the scheduler name of the first rule matching the given pod, or the default scheduler name
+// if no rule matches.
+func (h *Handler) schedulerNameFor(ctx context.Context, pod *corev1.Pod) (string, error) {
+	var namespace *corev1.Namespace
+
+	for i, rule := range h.Rules {
+		if matches, err := selectorMatches(rule.PodSelector, pod.Labels); err != nil {
+			return "", fmt.Errorf("failed parsing pod selector of rule %d: %w", i, err)
+		} else if !matches {
+			continue
+		}
+
+		if rule.NamespaceSelector != nil {
+			if namespace == nil {
+				var err error
+				if namespace, err = h.getNamespace(ctx); err != nil {
+					return "", err
+				}
+			}
+
+			if matches, err := selectorMatches(rule.NamespaceSelector, namespace.Labels); err != nil {
+				return "", fmt.Errorf("failed parsing namespace selector of rule %d: %w", i, err)
+			} else if !matches {
+				continue
+			}
+		}
+
+		return rule.SchedulerName, nil
+	}
+
+	return h.SchedulerName, nil
+}
+
+func (h *Handler) getNamespace(ctx context.Context) (*corev1.Namespace, error) {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := &corev1.Namespace{}
+	// We use `req.Namespace` instead of `pod.Namespace` due to https://github.com/kubernetes/kubernetes/issues/88282.
+	if err := h.TargetReader.Get(ctx, client.ObjectKey{Name: req.Namespace}, namespace); err != nil {
+		return nil, fmt.Errorf("failed reading namespace %q: %w", req.Namespace, err)
+	}
+
+	return namespace, nil
+}
+
+func selectorMatches(labelSelector *metav1.LabelSelector, objLabels map[string]string) (bool, error) {
+	if labelSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, err
+	}
+
+	return selector.Matches(labels.Set(objLabels)), nil
+}
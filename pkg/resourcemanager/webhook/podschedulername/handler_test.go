@@ -9,8 +9,15 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/resourcemanager/webhook/podschedulername"
 )
 
@@ -42,5 +49,74 @@ var _ = Describe("Handler", func() {
 			Expect(handler.Default(ctx, pod)).To(Succeed())
 			Expect(pod.Spec.SchedulerName).To(Equal(handler.SchedulerName))
 		})
+
+		Context("with rules", func() {
+			var (
+				fakeClient client.Client
+				namespace  *corev1.Namespace
+			)
+
+			BeforeEach(func() {
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+				namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+					Name:   "shoot--foo--bar",
+					Labels: map[string]string{"gardener.cloud/role": "shoot"},
+				}}
+				Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+				ctx = admission.NewContextWithRequest(context.TODO(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Namespace: namespace.Name}})
+
+				handler.TargetReader = fakeClient
+				handler.Rules = []resourcemanagerconfigv1alpha1.PodSchedulerNameRule{
+					{
+						SchedulerName:     "bin-packing-scheduler",
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"gardener.cloud/role": "shoot"}},
+						PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"gardener.cloud/role": "controlplane"}},
+					},
+					{
+						SchedulerName: "baz-scheduler",
+						PodSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"app": "baz"}},
+					},
+				}
+			})
+
+			It("should use the scheduler name of the first matching rule", func() {
+				pod.Labels = map[string]string{"gardener.cloud/role": "controlplane", "app": "baz"}
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+				Expect(pod.Spec.SchedulerName).To(Equal("bin-packing-scheduler"))
+			})
+
+			It("should not match a rule if the namespace selector does not match", func() {
+				namespace.Labels = nil
+				Expect(fakeClient.Update(ctx, namespace)).To(Succeed())
+				pod.Labels = map[string]string{"gardener.cloud/role": "controlplane", "app": "baz"}
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+				Expect(pod.Spec.SchedulerName).To(Equal("baz-scheduler"))
+			})
+
+			It("should use the default scheduler name if no rule matches", func() {
+				pod.Labels = map[string]string{"app": "foo"}
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+				Expect(pod.Spec.SchedulerName).To(Equal(handler.SchedulerName))
+			})
+
+			It("should patch the scheduler name when the pod specifies the default scheduler", func() {
+				pod.Spec.SchedulerName = corev1.DefaultSchedulerName
+				pod.Labels = map[string]string{"app": "baz"}
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+				Expect(pod.Spec.SchedulerName).To(Equal("baz-scheduler"))
+			})
+
+			It("should fail if the namespace cannot be read", func() {
+				Expect(fakeClient.Delete(ctx, namespace)).To(Succeed())
+				pod.Labels = map[string]string{"gardener.cloud/role": "controlplane"}
+
+				Expect(handler.Default(ctx, pod)).To(MatchError(ContainSubstring("failed reading namespace")))
+			})
+		})
 	})
 })
@@ -29,7 +29,8 @@ type Handler struct {
 	ExpirationSeconds int64
 }
 
-// Default defaults the volumes and mounts for the projected ServiceAccount token of the provided pod.
+// Default defaults the volumes and mounts for the projected ServiceAccount token and the requested kubeconfigs of the
+// provided pod.
 func (h *Handler) Default(ctx context.Context, obj runtime.Object) error {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
@@ -43,6 +44,17 @@ func (h *Handler) Default(ctx context.Context, obj runtime.Object) error {
 
 	log := h.Logger.WithValues("pod", kubernetesutils.ObjectKeyForCreateWebhooks(pod, req))
 
+	if err := h.mountKubeconfigs(log, pod); err != nil {
+		log.Error(err, "Error mounting kubeconfigs")
+		return err
+	}
+
+	return h.mountServiceAccountToken(ctx, log, req.Namespace, pod)
+}
+
+// mountServiceAccountToken mounts the projected ServiceAccount token into all containers of the given pod if it meets
+// the requirements.
+func (h *Handler) mountServiceAccountToken(ctx context.Context, log logr.Logger, namespace string, pod *corev1.Pod) error {
 	if len(pod.Spec.ServiceAccountName) == 0 || pod.Spec.ServiceAccountName == "default" {
 		log.Info("Pod's service account name is empty or defaulted, nothing to be done", "serviceAccountName", pod.Spec.ServiceAccountName)
 		return nil
@@ -50,7 +62,7 @@ func (h *Handler) Default(ctx context.Context, obj runtime.Object) error {
 
 	serviceAccount := &corev1.ServiceAccount{}
 	// We use `req.Namespace` instead of `pod.Namespace` due to https://github.com/kubernetes/kubernetes/issues/88282.
-	if err := h.TargetReader.Get(ctx, client.ObjectKey{Namespace: req.Namespace, Name: pod.Spec.ServiceAccountName}, serviceAccount); err != nil {
+	if err := h.TargetReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: pod.Spec.ServiceAccountName}, serviceAccount); err != nil {
 		log.Error(err, "Error getting service account", "serviceAccountName", pod.Spec.ServiceAccountName)
 		return err
 	}
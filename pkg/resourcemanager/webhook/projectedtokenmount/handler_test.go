@@ -10,6 +10,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -203,5 +204,202 @@ var _ = Describe("Handler", func() {
 				})
 			})
 		})
+
+		Context("kubeconfigs", func() {
+			BeforeEach(func() {
+				pod.Spec.ServiceAccountName = "default"
+			})
+
+			It("should mount the requested kubeconfigs", func() {
+				pod.Annotations = map[string]string{"projected-token-mount.resources.gardener.cloud/kubeconfigs": `[
+  {"name": "garden", "server": "https://api.garden.example.com", "audience": "garden", "caConfigMapName": "garden-ca", "caConfigMapKey": "bundle.crt"},
+  {"name": "other", "server": "https://api.other.example.com", "audience": "other", "expirationSeconds": 3600}
+]`}
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+
+				Expect(pod.Annotations).To(HaveKeyWithValue("projected-token-mount.resources.gardener.cloud/kubeconfig-garden", `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority: /var/run/secrets/gardener.cloud/kubeconfigs/garden/ca.crt
+    server: https://api.garden.example.com
+  name: garden
+contexts:
+- context:
+    cluster: garden
+    user: garden
+  name: garden
+current-context: garden
+kind: Config
+users:
+- name: garden
+  user:
+    tokenFile: /var/run/secrets/gardener.cloud/kubeconfigs/garden/token
+`))
+				Expect(pod.Annotations).To(HaveKeyWithValue("projected-token-mount.resources.gardener.cloud/kubeconfig-other", `apiVersion: v1
+clusters:
+- cluster:
+    server: https://api.other.example.com
+  name: other
+contexts:
+- context:
+    cluster: other
+    user: other
+  name: other
+current-context: other
+kind: Config
+users:
+- name: other
+  user:
+    tokenFile: /var/run/secrets/gardener.cloud/kubeconfigs/other/token
+`))
+
+				Expect(pod.Spec.Volumes).To(ConsistOf(
+					corev1.Volume{
+						Name: "kubeconfig-garden",
+						VolumeSource: corev1.VolumeSource{
+							Projected: &corev1.ProjectedVolumeSource{
+								DefaultMode: ptr.To[int32](420),
+								Sources: []corev1.VolumeProjection{
+									{
+										ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+											Audience:          "garden",
+											ExpirationSeconds: &handler.ExpirationSeconds,
+											Path:              "token",
+										},
+									},
+									{
+										DownwardAPI: &corev1.DownwardAPIProjection{
+											Items: []corev1.DownwardAPIVolumeFile{{
+												FieldRef: &corev1.ObjectFieldSelector{
+													APIVersion: "v1",
+													FieldPath:  "metadata.annotations['projected-token-mount.resources.gardener.cloud/kubeconfig-garden']",
+												},
+												Path: "kubeconfig",
+											}},
+										},
+									},
+									{
+										ConfigMap: &corev1.ConfigMapProjection{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "garden-ca",
+											},
+											Items: []corev1.KeyToPath{{
+												Key:  "bundle.crt",
+												Path: "ca.crt",
+											}},
+										},
+									},
+								},
+							},
+						},
+					},
+					corev1.Volume{
+						Name: "kubeconfig-other",
+						VolumeSource: corev1.VolumeSource{
+							Projected: &corev1.ProjectedVolumeSource{
+								DefaultMode: ptr.To[int32](420),
+								Sources: []corev1.VolumeProjection{
+									{
+										ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+											Audience:          "other",
+											ExpirationSeconds: ptr.To[int64](3600),
+											Path:              "token",
+										},
+									},
+									{
+										DownwardAPI: &corev1.DownwardAPIProjection{
+											Items: []corev1.DownwardAPIVolumeFile{{
+												FieldRef: &corev1.ObjectFieldSelector{
+													APIVersion: "v1",
+													FieldPath:  "metadata.annotations['projected-token-mount.resources.gardener.cloud/kubeconfig-other']",
+												},
+												Path: "kubeconfig",
+											}},
+										},
+									},
+								},
+							},
+						},
+					},
+				))
+
+				for _, container := range append(pod.Spec.Containers, pod.Spec.InitContainers...) {
+					Expect(container.VolumeMounts).To(ConsistOf(
+						corev1.VolumeMount{
+							Name:      "kubeconfig-garden",
+							ReadOnly:  true,
+							MountPath: "/var/run/secrets/gardener.cloud/kubeconfigs/garden",
+						},
+						corev1.VolumeMount{
+							Name:      "kubeconfig-other",
+							ReadOnly:  true,
+							MountPath: "/var/run/secrets/gardener.cloud/kubeconfigs/other",
+						},
+					))
+				}
+			})
+
+			It("should mount the kubeconfigs in addition to the service account token", func() {
+				pod.Spec.ServiceAccountName = serviceAccountName
+				Expect(fakeClient.Create(ctx, serviceAccount)).To(Succeed())
+				pod.Annotations = map[string]string{"projected-token-mount.resources.gardener.cloud/kubeconfigs": `[{"name": "garden", "server": "https://api.garden.example.com", "audience": "garden"}]`}
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+
+				Expect(pod.Spec.Volumes).To(HaveLen(2))
+				Expect(pod.Spec.Volumes[0].Name).To(Equal("kubeconfig-garden"))
+				Expect(pod.Spec.Volumes[1].Name).To(Equal("kube-api-access-gardener"))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(HaveLen(2))
+			})
+
+			It("should not mount a kubeconfig again if its volume already exists", func() {
+				pod.Annotations = map[string]string{"projected-token-mount.resources.gardener.cloud/kubeconfigs": `[{"name": "garden", "server": "https://api.garden.example.com", "audience": "garden"}]`}
+				pod.Spec.Volumes = []corev1.Volume{{Name: "kubeconfig-garden"}}
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+
+				Expect(pod.Spec.Volumes).To(ConsistOf(corev1.Volume{Name: "kubeconfig-garden"}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(BeEmpty())
+				Expect(pod.Annotations).NotTo(HaveKey("projected-token-mount.resources.gardener.cloud/kubeconfig-garden"))
+			})
+
+			It("should return an error if the annotation cannot be unmarshalled", func() {
+				pod.Annotations = map[string]string{"projected-token-mount.resources.gardener.cloud/kubeconfigs": "{"}
+
+				Expect(handler.Default(ctx, pod)).To(MatchError(ContainSubstring("failed unmarshalling")))
+				Expect(pod.Spec.Volumes).To(BeEmpty())
+			})
+
+			DescribeTable("should return an error if the requested kubeconfigs are invalid",
+				func(kubeconfigs string, matcher types.GomegaMatcher) {
+					pod.Annotations = map[string]string{"projected-token-mount.resources.gardener.cloud/kubeconfigs": kubeconfigs}
+
+					Expect(handler.Default(ctx, pod)).To(MatchError(matcher))
+					Expect(pod.Spec.Volumes).To(BeEmpty())
+				},
+
+				Entry("missing fields", `[{}]`, And(
+					ContainSubstring("[0].name: Required value"),
+					ContainSubstring("[0].server: Required value"),
+					ContainSubstring("[0].audience: Required value"),
+				)),
+				Entry("invalid name", `[{"name": "Foo", "server": "https://foo", "audience": "foo"}]`,
+					ContainSubstring("[0].name: Invalid value"),
+				),
+				Entry("duplicate name", `[{"name": "foo", "server": "https://foo", "audience": "foo"}, {"name": "foo", "server": "https://foo", "audience": "foo"}]`,
+					ContainSubstring("[1].name: Duplicate value"),
+				),
+				Entry("non-https server", `[{"name": "foo", "server": "http://foo", "audience": "foo"}]`,
+					ContainSubstring("[0].server: Invalid value"),
+				),
+				Entry("CA key without ConfigMap name", `[{"name": "foo", "server": "https://foo", "audience": "foo", "caConfigMapKey": "ca"}]`,
+					ContainSubstring("[0].caConfigMapName: Required value"),
+				),
+				Entry("too low expiration seconds", `[{"name": "foo", "server": "https://foo", "audience": "foo", "expirationSeconds": 60}]`,
+					ContainSubstring("[0].expirationSeconds: Invalid value"),
+				),
+			)
+		})
 	})
 })
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package projectedtokenmount
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"k8s.io/utils/ptr"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// ProjectedKubeconfig describes a kubeconfig for a further cluster which shall be mounted into a Pod. A list of them
+// can be specified via the `projected-token-mount.resources.gardener.cloud/kubeconfigs` annotation.
+type ProjectedKubeconfig struct {
+	// Name is the name of the kubeconfig. It is used for the name of the volume and for the mount path.
+	Name string `json:"name"`
+	// Server is the URL of the API server of the cluster.
+	Server string `json:"server"`
+	// Audience is the audience of the projected ServiceAccount token used by the kubeconfig.
+	Audience string `json:"audience"`
+	// CAConfigMapName is the name of a ConfigMap in the namespace of the Pod which contains the CA bundle of the API
+	// server. If it is not set, the system trust store is used for verifying the API server's certificate.
+	CAConfigMapName string `json:"caConfigMapName,omitempty"`
+	// CAConfigMapKey is the key of the CA bundle in the ConfigMap. Defaults to `ca.crt`.
+	CAConfigMapKey string `json:"caConfigMapKey,omitempty"`
+	// ExpirationSeconds is the requested validity of the projected ServiceAccount token. Defaults to the expiration
+	// seconds of the ServiceAccount token mounted by this webhook.
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+const (
+	// KubeconfigMountPathPrefix is the path under which the kubeconfigs requested via the
+	// `projected-token-mount.resources.gardener.cloud/kubeconfigs` annotation are mounted. Each kubeconfig is mounted
+	// to `<prefix>/<name>/kubeconfig`.
+	KubeconfigMountPathPrefix = "/var/run/secrets/gardener.cloud/kubeconfigs"
+
+	kubeconfigVolumeNamePrefix = "kubeconfig-"
+	kubeconfigPath             = "kubeconfig"
+	tokenPath                  = "token"
+	caPath                     = "ca.crt"
+	defaultCAConfigMapKey      = "ca.crt"
+	// minExpirationSeconds is the minimum expiration of projected ServiceAccount tokens accepted by the API server.
+	minExpirationSeconds = 600
+)
+
+// mountKubeconfigs mounts the kubeconfigs requested via the ProjectedTokenKubeconfigs annotation into all containers of
+// the given pod. The kubeconfigs themselves are stored in annotations of the pod and projected via the downward API,
+// while the tokens are projected ServiceAccount tokens with the requested audiences.
+func (h *Handler) mountKubeconfigs(log logr.Logger, pod *corev1.Pod) error {
+	value, ok := pod.Annotations[resourcesv1alpha1.ProjectedTokenKubeconfigs]
+	if !ok {
+		return nil
+	}
+
+	var kubeconfigs []ProjectedKubeconfig
+	if err := json.Unmarshal([]byte(value), &kubeconfigs); err != nil {
+		return fmt.Errorf("failed unmarshalling %s annotation: %w", resourcesv1alpha1.ProjectedTokenKubeconfigs, err)
+	}
+
+	if err := validateProjectedKubeconfigs(kubeconfigs); err != nil {
+		return err
+	}
+
+	defaultExpirationSeconds, err := tokenExpirationSeconds(pod.Annotations, h.ExpirationSeconds)
+	if err != nil {
+		return fmt.Errorf("failed getting the token expiration seconds: %w", err)
+	}
+
+	for _, kubeconfig := range kubeconfigs {
+		volumeName := kubeconfigVolumeNamePrefix + kubeconfig.Name
+		if slices.ContainsFunc(pod.Spec.Volumes, func(volume corev1.Volume) bool { return volume.Name == volumeName }) {
+			log.Info("Pod already has a volume for the kubeconfig, nothing to be done", "kubeconfig", kubeconfig.Name)
+			continue
+		}
+
+		kubeconfigRaw, err := generateKubeconfig(kubeconfig)
+		if err != nil {
+			return fmt.Errorf("failed generating kubeconfig %q: %w", kubeconfig.Name, err)
+		}
+
+		log.Info("Mounting kubeconfig", "kubeconfig", kubeconfig.Name, "server", kubeconfig.Server, "audience", kubeconfig.Audience)
+
+		metav1.SetMetaDataAnnotation(&pod.ObjectMeta, resourcesv1alpha1.ProjectedTokenKubeconfigPrefix+kubeconfig.Name, string(kubeconfigRaw))
+
+		pod.Spec.Volumes = append(pod.Spec.Volumes, getKubeconfigVolume(kubeconfig, ptr.Deref(kubeconfig.ExpirationSeconds, defaultExpirationSeconds)))
+		for i := range pod.Spec.Containers {
+			pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, getKubeconfigVolumeMount(kubeconfig.Name))
+		}
+		for i := range pod.Spec.InitContainers {
+			pod.Spec.InitContainers[i].VolumeMounts = append(pod.Spec.InitContainers[i].VolumeMounts, getKubeconfigVolumeMount(kubeconfig.Name))
+		}
+	}
+
+	return nil
+}
+
+func validateProjectedKubeconfigs(kubeconfigs []ProjectedKubeconfig) error {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("metadata", "annotations").Key(resourcesv1alpha1.ProjectedTokenKubeconfigs)
+		names   = sets.New[string]()
+	)
+
+	for i, kubeconfig := range kubeconfigs {
+		idxPath := fldPath.Index(i)
+
+		if len(kubeconfig.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must be specified"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(kubeconfigVolumeNamePrefix + kubeconfig.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), kubeconfig.Name, msg))
+			}
+			if names.Has(kubeconfig.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), kubeconfig.Name))
+			}
+			names.Insert(kubeconfig.Name)
+		}
+
+		if len(kubeconfig.Server) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("server"), "server must be specified"))
+		} else if serverURL, err := url.Parse(kubeconfig.Server); err != nil || serverURL.Scheme != "https" || len(serverURL.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("server"), kubeconfig.Server, "server must be a valid https URL"))
+		}
+
+		if len(kubeconfig.Audience) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("audience"), "audience must be specified"))
+		}
+
+		if len(kubeconfig.CAConfigMapKey) > 0 && len(kubeconfig.CAConfigMapName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("caConfigMapName"), "CA ConfigMap name must be specified if a key is given"))
+		}
+
+		if kubeconfig.ExpirationSeconds != nil && *kubeconfig.ExpirationSeconds < minExpirationSeconds {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("expirationSeconds"), *kubeconfig.ExpirationSeconds, fmt.Sprintf("must be at least %d", minExpirationSeconds)))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func kubeconfigMountPath(name string) string {
+	return filepath.Join(KubeconfigMountPathPrefix, name)
+}
+
+func generateKubeconfig(kubeconfig ProjectedKubeconfig) ([]byte, error) {
+	cluster := clientcmdv1.Cluster{Server: kubeconfig.Server}
+	if len(kubeconfig.CAConfigMapName) > 0 {
+		cluster.CertificateAuthority = filepath.Join(kubeconfigMountPath(kubeconfig.Name), caPath)
+	}
+
+	return runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
+		kubeconfig.Name,
+		cluster,
+		clientcmdv1.AuthInfo{TokenFile: filepath.Join(kubeconfigMountPath(kubeconfig.Name), tokenPath)},
+	))
+}
+
+func getKubeconfigVolume(kubeconfig ProjectedKubeconfig, expirationSeconds int64) corev1.Volume {
+	sources := []corev1.VolumeProjection{
+		{
+			ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+				Audience:          kubeconfig.Audience,
+				ExpirationSeconds: &expirationSeconds,
+				Path:              tokenPath,
+			},
+		},
+		{
+			DownwardAPI: &corev1.DownwardAPIProjection{
+				Items: []corev1.DownwardAPIVolumeFile{{
+					FieldRef: &corev1.ObjectFieldSelector{
+						APIVersion: "v1",
+						FieldPath:  fmt.Sprintf("metadata.annotations['%s']", resourcesv1alpha1.ProjectedTokenKubeconfigPrefix+kubeconfig.Name),
+					},
+					Path: kubeconfigPath,
+				}},
+			},
+		},
+	}
+
+	if len(kubeconfig.CAConfigMapName) > 0 {
+		sources = append(sources, corev1.VolumeProjection{
+			ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: kubeconfig.CAConfigMapName,
+				},
+				Items: []corev1.KeyToPath{{
+					Key:  cmp.Or(kubeconfig.CAConfigMapKey, defaultCAConfigMapKey),
+					Path: caPath,
+				}},
+			},
+		})
+	}
+
+	return corev1.Volume{
+		Name: kubeconfigVolumeNamePrefix + kubeconfig.Name,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				DefaultMode: ptr.To[int32](420),
+				Sources:     sources,
+			},
+		},
+	}
+}
+
+func getKubeconfigVolumeMount(name string) corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      kubeconfigVolumeNamePrefix + name,
+		MountPath: kubeconfigMountPath(name),
+		ReadOnly:  true,
+	}
+}
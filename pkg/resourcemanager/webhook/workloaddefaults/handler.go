@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workloaddefaults
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// podSpecificLabels are labels whose values differ between the pods of the same workload. They are not considered when
+// the label selector of a default constraint or affinity term is derived from the labels of a pod.
+var podSpecificLabels = []string{
+	appsv1.ControllerRevisionHashLabelKey,
+	appsv1.StatefulSetPodNameLabel,
+	appsv1.PodIndexLabel,
+	batchv1.JobCompletionIndexAnnotation,
+}
+
+// Handler handles admission requests and adds the default topology spread constraints and affinity to Pod resources.
+type Handler struct {
+	Logger logr.Logger
+
+	// TopologySpreadConstraints are the topology spread constraints that should be added to pods which do not specify
+	// any.
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint
+	// Affinity is the affinity that should be added to pods which do not specify the respective node affinity, pod
+	// affinity or pod anti-affinity.
+	Affinity *corev1.Affinity
+}
+
+// Default adds the default topology spread constraints and affinity to the provided pod.
+func (h *Handler) Default(ctx context.Context, obj runtime.Object) error {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return fmt.Errorf("expected *corev1.Pod but got %T", obj)
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	log := h.Logger.WithValues("pod", kubernetesutils.ObjectKeyForCreateWebhooks(pod, req))
+
+	if kubernetesutils.PodManagedByDaemonSet(pod) {
+		log.Info("Pod is managed by DaemonSet, skipping further handling")
+		return nil
+	}
+
+	selector := workloadSelector(pod)
+
+	if len(pod.Spec.TopologySpreadConstraints) == 0 && len(h.TopologySpreadConstraints) > 0 {
+		log.Info("Adding default topology spread constraints")
+		pod.Spec.TopologySpreadConstraints = h.defaultTopologySpreadConstraints(selector)
+	}
+
+	if h.Affinity != nil {
+		h.handleAffinity(log, pod, selector)
+	}
+
+	return nil
+}
+
+func (h *Handler) defaultTopologySpreadConstraints(selector *metav1.LabelSelector) []corev1.TopologySpreadConstraint {
+	var constraints []corev1.TopologySpreadConstraint
+
+	for _, constraint := range h.TopologySpreadConstraints {
+		if constraint.LabelSelector == nil {
+			if selector == nil {
+				// A constraint without selector does not spread anything, hence it is skipped for pods without labels.
+				continue
+			}
+			constraint.LabelSelector = selector.DeepCopy()
+		}
+
+		constraints = append(constraints, *constraint.DeepCopy())
+	}
+
+	return constraints
+}
+
+func (h *Handler) handleAffinity(log logr.Logger, pod *corev1.Pod, selector *metav1.LabelSelector) {
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &corev1.Affinity{}
+	}
+
+	if pod.Spec.Affinity.NodeAffinity == nil && h.Affinity.NodeAffinity != nil {
+		log.Info("Adding default node affinity")
+		pod.Spec.Affinity.NodeAffinity = h.Affinity.NodeAffinity.DeepCopy()
+	}
+
+	if pod.Spec.Affinity.PodAffinity == nil && h.Affinity.PodAffinity != nil {
+		podAffinity := h.Affinity.PodAffinity.DeepCopy()
+		podAffinity.RequiredDuringSchedulingIgnoredDuringExecution = defaultPodAffinityTerms(podAffinity.RequiredDuringSchedulingIgnoredDuringExecution, selector)
+		podAffinity.PreferredDuringSchedulingIgnoredDuringExecution = defaultWeightedPodAffinityTerms(podAffinity.PreferredDuringSchedulingIgnoredDuringExecution, selector)
+		if len(podAffinity.RequiredDuringSchedulingIgnoredDuringExecution) > 0 || len(podAffinity.PreferredDuringSchedulingIgnoredDuringExecution) > 0 {
+			log.Info("Adding default pod affinity")
+			pod.Spec.Affinity.PodAffinity = podAffinity
+		}
+	}
+
+	if pod.Spec.Affinity.PodAntiAffinity == nil && h.Affinity.PodAntiAffinity != nil {
+		podAntiAffinity := h.Affinity.PodAntiAffinity.DeepCopy()
+		podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = defaultPodAffinityTerms(podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, selector)
+		podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = defaultWeightedPodAffinityTerms(podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, selector)
+		if len(podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution) > 0 || len(podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution) > 0 {
+			log.Info("Adding default pod anti-affinity")
+			pod.Spec.Affinity.PodAntiAffinity = podAntiAffinity
+		}
+	}
+
+	if *pod.Spec.Affinity == (corev1.Affinity{}) {
+		pod.Spec.Affinity = nil
+	}
+}
+
+func defaultPodAffinityTerms(terms []corev1.PodAffinityTerm, selector *metav1.LabelSelector) []corev1.PodAffinityTerm {
+	var result []corev1.PodAffinityTerm
+
+	for _, term := range terms {
+		if term.LabelSelector == nil {
+			if selector == nil {
+				continue
+			}
+			term.LabelSelector = selector.DeepCopy()
+		}
+
+		result = append(result, term)
+	}
+
+	return result
+}
+
+func defaultWeightedPodAffinityTerms(terms []corev1.WeightedPodAffinityTerm, selector *metav1.LabelSelector) []corev1.WeightedPodAffinityTerm {
+	var result []corev1.WeightedPodAffinityTerm
+
+	for _, term := range terms {
+		if term.PodAffinityTerm.LabelSelector == nil {
+			if selector == nil {
+				continue
+			}
+			term.PodAffinityTerm.LabelSelector = selector.DeepCopy()
+		}
+
+		result = append(result, term)
+	}
+
+	return result
+}
+
+// workloadSelector returns a label selector matching all pods of the workload the given pod belongs to, or nil if the
+// pod does not have any labels identifying its workload.
+func workloadSelector(pod *corev1.Pod) *metav1.LabelSelector {
+	matchLabels := maps.Clone(pod.Labels)
+	for _, key := range podSpecificLabels {
+		delete(matchLabels, key)
+	}
+
+	if len(matchLabels) == 0 {
+		return nil
+	}
+
+	return &metav1.LabelSelector{MatchLabels: matchLabels}
+}
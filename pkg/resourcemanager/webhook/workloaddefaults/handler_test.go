@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workloaddefaults_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/webhook/workloaddefaults"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		handler *Handler
+		pod     *corev1.Pod
+
+		zoneConstraint      corev1.TopologySpreadConstraint
+		nodeAffinity        *corev1.NodeAffinity
+		hostAntiAffinity    *corev1.PodAntiAffinity
+		expectedPodSelector *metav1.LabelSelector
+	)
+
+	BeforeEach(func() {
+		ctx = admission.NewContextWithRequest(ctx, admission.Request{})
+
+		zoneConstraint = corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+		}
+		nodeAffinity = &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"workload"}}},
+				}},
+			},
+		}
+		hostAntiAffinity = &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+				Weight:          100,
+				PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: corev1.LabelHostname},
+			}},
+		}
+
+		handler = &Handler{
+			Logger:                    log,
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{zoneConstraint},
+			Affinity: &corev1.Affinity{
+				NodeAffinity:    nodeAffinity,
+				PodAntiAffinity: hostAntiAffinity,
+			},
+		}
+
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"app":                                  "foo",
+					appsv1.DefaultDeploymentUniqueLabelKey: "123abc",
+				},
+			},
+		}
+
+		expectedPodSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo", appsv1.DefaultDeploymentUniqueLabelKey: "123abc"}}
+	})
+
+	Describe("#Default", func() {
+		It("should add the default topology spread constraints and affinity", func() {
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+
+			expectedConstraint := zoneConstraint
+			expectedConstraint.LabelSelector = expectedPodSelector
+			Expect(pod.Spec.TopologySpreadConstraints).To(ConsistOf(expectedConstraint))
+
+			expectedAntiAffinity := hostAntiAffinity.DeepCopy()
+			expectedAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].PodAffinityTerm.LabelSelector = expectedPodSelector
+			Expect(pod.Spec.Affinity).To(Equal(&corev1.Affinity{
+				NodeAffinity:    nodeAffinity,
+				PodAntiAffinity: expectedAntiAffinity,
+			}))
+		})
+
+		It("should not derive the selector from pod-specific labels", func() {
+			pod.Labels = map[string]string{
+				"app":                                 "foo",
+				appsv1.ControllerRevisionHashLabelKey: "456def",
+				appsv1.StatefulSetPodNameLabel:        "foo-0",
+				appsv1.PodIndexLabel:                  "0",
+			}
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Spec.TopologySpreadConstraints[0].LabelSelector).To(Equal(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}))
+		})
+
+		It("should keep configured label selectors", func() {
+			selector := &metav1.LabelSelector{MatchLabels: map[string]string{"bar": "baz"}}
+			handler.TopologySpreadConstraints[0].LabelSelector = selector
+			handler.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].PodAffinityTerm.LabelSelector = selector
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Spec.TopologySpreadConstraints[0].LabelSelector).To(Equal(selector))
+			Expect(pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].PodAffinityTerm.LabelSelector).To(Equal(selector))
+		})
+
+		It("should skip constraints and terms without label selector if the pod has no labels", func() {
+			pod.Labels = nil
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Spec.TopologySpreadConstraints).To(BeEmpty())
+			Expect(pod.Spec.Affinity).To(Equal(&corev1.Affinity{NodeAffinity: nodeAffinity}))
+		})
+
+		It("should not overwrite topology spread constraints and affinities of the pod", func() {
+			podConstraint := corev1.TopologySpreadConstraint{MaxSkew: 2, TopologyKey: corev1.LabelHostname}
+			podAntiAffinity := &corev1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{TopologyKey: corev1.LabelTopologyZone}},
+			}
+			pod.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{podConstraint}
+			pod.Spec.Affinity = &corev1.Affinity{PodAntiAffinity: podAntiAffinity}
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Spec.TopologySpreadConstraints).To(ConsistOf(podConstraint))
+			Expect(pod.Spec.Affinity).To(Equal(&corev1.Affinity{
+				NodeAffinity:    nodeAffinity,
+				PodAntiAffinity: podAntiAffinity,
+			}))
+		})
+
+		It("should not mutate pods managed by a DaemonSet", func() {
+			pod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "foo", Controller: ptr.To(true)}}
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Spec.TopologySpreadConstraints).To(BeNil())
+			Expect(pod.Spec.Affinity).To(BeNil())
+		})
+
+		It("should not mutate the configured defaults", func() {
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(handler.TopologySpreadConstraints[0].LabelSelector).To(BeNil())
+			Expect(handler.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].PodAffinityTerm.LabelSelector).To(BeNil())
+		})
+	})
+})
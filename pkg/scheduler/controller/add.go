@@ -10,16 +10,27 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	"github.com/gardener/gardener/pkg/scheduler/controller/seeddrain"
 	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 )
 
 // AddToManager adds all scheduler controllers to the given manager.
 func AddToManager(mgr manager.Manager, cfg *schedulerconfigv1alpha1.SchedulerConfiguration) error {
-	if err := (&shoot.Reconciler{
+	shootReconciler := &shoot.Reconciler{
 		Config: cfg.Schedulers.Shoot,
-	}).AddToManager(mgr); err != nil {
+	}
+	if err := shootReconciler.AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
+	if cfg.Schedulers.SeedDrain != nil {
+		if err := (&seeddrain.Reconciler{
+			Config:         cfg.Schedulers.SeedDrain,
+			SeedDeterminer: shootReconciler,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding SeedDrain controller: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seeddrain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/scheduler/controller/seeddrain"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("#SeedDrainPredicate", func() {
+		var (
+			predicate predicate.Predicate
+			seed      *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			predicate = reconciler.SeedDrainPredicate()
+			seed = &gardencorev1beta1.Seed{}
+		})
+
+		It("should return false if the seed is not being drained", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: seed})).To(BeFalse())
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeFalse())
+		})
+
+		It("should return true if the seed is being drained", func() {
+			seed.Spec.Settings = &gardencorev1beta1.SeedSettings{Drain: &gardencorev1beta1.SeedSettingDrain{Enabled: true}}
+
+			Expect(predicate.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeTrue())
+		})
+
+		It("should return true if the seed still has a drain status", func() {
+			seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{}
+
+			Expect(predicate.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeTrue())
+		})
+
+		It("should return false for other objects", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: &gardencorev1beta1.Shoot{}})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seeddrain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// EventSeedDrainMigrationStarted is an event reason for shoots whose control plane migration was triggered
+	// because their seed is being drained.
+	EventSeedDrainMigrationStarted = "SeedDrainMigrationStarted"
+	// EventSeedDrainMigrationFailed is an event reason for shoots for which no target seed could be determined or
+	// bound while their seed is being drained.
+	EventSeedDrainMigrationFailed = "SeedDrainMigrationFailed"
+	// EventSeedDrained is an event reason for seeds which no longer host any shoots after being drained.
+	EventSeedDrained = "SeedDrained"
+
+	defaultMaxConcurrentMigrations int32 = 5
+)
+
+// SeedDeterminer determines an appropriate seed for a shoot.
+type SeedDeterminer interface {
+	// DetermineSeed returns an appropriate seed for the given shoot.
+	DetermineSeed(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error)
+}
+
+// Reconciler evacuates all shoots from seeds which are being drained by triggering control plane migrations in
+// rate-limited batches.
+type Reconciler struct {
+	Client         client.Client
+	Config         *schedulerconfigv1alpha1.SeedDrainSchedulerConfiguration
+	SeedDeterminer SeedDeterminer
+	Recorder       events.EventRecorder
+	Clock          clock.Clock
+}
+
+// Reconcile evacuates all shoots from seeds which are being drained.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if !helper.SeedSettingDrainEnabled(seed.Spec.Settings) {
+		if seed.Status.Drain == nil {
+			return reconcile.Result{}, nil
+		}
+
+		log.Info("Seed is no longer being drained, removing drain status")
+		patch := client.MergeFrom(seed.DeepCopy())
+		seed.Status.Drain = nil
+		if err := r.Client.Status().Patch(ctx, seed, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing drain status: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	remainingShoots, migratingShoots, err := r.listShoots(ctx, seed.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		maxConcurrentMigrations = ptr.Deref(seed.Spec.Settings.Drain.MaxConcurrentMigrations, defaultMaxConcurrentMigrations)
+		budget                  = int(maxConcurrentMigrations) - len(migratingShoots)
+		started                 int
+		errs                    []error
+	)
+
+	for _, shoot := range remainingShoots {
+		if budget <= 0 {
+			break
+		}
+
+		if shoot.DeletionTimestamp != nil {
+			continue
+		}
+		if shoot.Status.SeedName != nil && *shoot.Status.SeedName != seed.Name {
+			// The shoot is still being migrated onto this seed, hence it cannot be migrated away before that is done.
+			continue
+		}
+
+		shootLog := log.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+
+		targetSeed, err := r.SeedDeterminer.DetermineSeed(ctx, shootLog, shoot)
+		if err != nil {
+			r.Recorder.Eventf(shoot, seed, corev1.EventTypeWarning, EventSeedDrainMigrationFailed, gardencorev1beta1.EventActionMigrate, "Failed to determine target seed while draining seed %q: %s", seed.Name, err.Error())
+			errs = append(errs, fmt.Errorf("failed determining target seed for shoot %s: %w", client.ObjectKeyFromObject(shoot), err))
+			continue
+		}
+
+		shoot.Spec.SeedName = &targetSeed.Name
+		if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+			if !apierrors.IsConflict(err) {
+				r.Recorder.Eventf(shoot, seed, corev1.EventTypeWarning, EventSeedDrainMigrationFailed, gardencorev1beta1.EventActionMigrate, "Failed to bind shoot to seed %q while draining seed %q: %s", targetSeed.Name, seed.Name, err.Error())
+			}
+			errs = append(errs, fmt.Errorf("failed binding shoot %s to seed %s: %w", client.ObjectKeyFromObject(shoot), targetSeed.Name, err))
+			continue
+		}
+
+		shootLog.Info("Triggered control plane migration of shoot", "targetSeed", targetSeed.Name)
+		r.Recorder.Eventf(shoot, seed, corev1.EventTypeNormal, EventSeedDrainMigrationStarted, gardencorev1beta1.EventActionMigrate, "Migrating control plane from seed %q to seed %q because the source seed is being drained", seed.Name, targetSeed.Name)
+		budget--
+		started++
+	}
+
+	wasDrained := seed.Status.Drain != nil && seed.Status.Drain.RemainingShoots == 0 && seed.Status.Drain.MigratingShoots == 0
+
+	patch := client.MergeFrom(seed.DeepCopy())
+	seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{
+		RemainingShoots: int32(len(remainingShoots) - started), // #nosec G115 -- Number of shoots does not exceed int32 limits.
+		MigratingShoots: int32(len(migratingShoots) + started), // #nosec G115 -- Number of shoots does not exceed int32 limits.
+		LastUpdateTime:  metav1.NewTime(r.Clock.Now().UTC()),
+	}
+	if err := r.Client.Status().Patch(ctx, seed, patch); err != nil {
+		errs = append(errs, fmt.Errorf("failed updating drain status: %w", err))
+	}
+
+	if seed.Status.Drain.RemainingShoots == 0 && seed.Status.Drain.MigratingShoots == 0 && !wasDrained {
+		log.Info("Seed does not host any shoots anymore")
+		r.Recorder.Eventf(seed, nil, corev1.EventTypeNormal, EventSeedDrained, gardencorev1beta1.EventActionMigrate, "Seed has been drained, it does not host any shoots anymore")
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, errors.Join(errs...)
+}
+
+// listShoots returns the shoots which are still scheduled onto the seed with the given name as well as those which
+// are currently being migrated away from it.
+func (r *Reconciler) listShoots(ctx context.Context, seedName string) ([]*gardencorev1beta1.Shoot, []*gardencorev1beta1.Shoot, error) {
+	remainingShootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, remainingShootList, client.MatchingFields{core.ShootSeedName: seedName}); err != nil {
+		return nil, nil, fmt.Errorf("failed listing shoots scheduled onto seed: %w", err)
+	}
+
+	migratingShootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, migratingShootList, client.MatchingFields{core.ShootStatusSeedName: seedName}); err != nil {
+		return nil, nil, fmt.Errorf("failed listing shoots hosted by seed: %w", err)
+	}
+
+	remainingShoots := make([]*gardencorev1beta1.Shoot, 0, len(remainingShootList.Items))
+	for i := range remainingShootList.Items {
+		remainingShoots = append(remainingShoots, &remainingShootList.Items[i])
+	}
+	// Sort the shoots to migrate them in a deterministic order.
+	slices.SortFunc(remainingShoots, func(a, b *gardencorev1beta1.Shoot) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+
+	var migratingShoots []*gardencorev1beta1.Shoot
+	for i, shoot := range migratingShootList.Items {
+		if ptr.Deref(shoot.Spec.SeedName, seedName) != seedName {
+			migratingShoots = append(migratingShoots, &migratingShootList.Items[i])
+		}
+	}
+
+	return remainingShoots, migratingShoots, nil
+}
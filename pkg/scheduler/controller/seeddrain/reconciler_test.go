@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seeddrain_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/scheduler/controller/seeddrain"
+)
+
+type fakeSeedDeterminer struct {
+	seed *gardencorev1beta1.Seed
+	err  error
+}
+
+func (f *fakeSeedDeterminer) DetermineSeed(_ context.Context, _ logr.Logger, _ *gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
+	return f.seed, f.err
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *events.FakeRecorder
+		determiner *fakeSeedDeterminer
+		reconciler *Reconciler
+
+		seed       *gardencorev1beta1.Seed
+		targetSeed *gardencorev1beta1.Seed
+		request    reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}, &gardencorev1beta1.Shoot{}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Status.SeedName, "")}
+			}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					if subResourceName == "binding" {
+						return c.Update(ctx, obj)
+					}
+					return c.SubResource(subResourceName).Update(ctx, obj)
+				},
+			}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		recorder = events.NewFakeRecorder(20)
+
+		targetSeed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "target"}}
+		determiner = &fakeSeedDeterminer{seed: targetSeed}
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: &schedulerconfigv1alpha1.SeedDrainSchedulerConfiguration{
+				SyncPeriod: &metav1.Duration{Duration: time.Minute},
+			},
+			SeedDeterminer: determiner,
+			Recorder:       recorder,
+			Clock:          fakeClock,
+		}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+			Spec: gardencorev1beta1.SeedSpec{
+				Settings: &gardencorev1beta1.SeedSettings{
+					Drain: &gardencorev1beta1.SeedSettingDrain{
+						Enabled:                 true,
+						MaxConcurrentMigrations: ptr.To[int32](2),
+					},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+	})
+
+	createShoot := func(name string, specSeedName, statusSeedName *string) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: specSeedName},
+		}
+		ExpectWithOffset(1, fakeClient.Create(ctx, shoot)).To(Succeed())
+		if statusSeedName != nil {
+			shoot.Status.SeedName = statusSeedName
+			ExpectWithOffset(1, fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+		}
+		return shoot
+	}
+
+	getShootSeedName := func(shoot *gardencorev1beta1.Shoot) *string {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		return shoot.Spec.SeedName
+	}
+
+	It("should do nothing if the seed does not exist", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should remove the drain status if the seed is no longer being drained", func() {
+		seed.Spec.Settings = nil
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{RemainingShoots: 1}
+		Expect(fakeClient.Status().Update(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Drain).To(BeNil())
+	})
+
+	It("should migrate shoots in batches and record the progress", func() {
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+
+		shoot1 := createShoot("shoot1", ptr.To(seed.Name), ptr.To(seed.Name))
+		shoot2 := createShoot("shoot2", ptr.To(seed.Name), ptr.To(seed.Name))
+		shoot3 := createShoot("shoot3", ptr.To(seed.Name), ptr.To(seed.Name))
+		createShoot("migrating", ptr.To("other"), ptr.To(seed.Name))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(getShootSeedName(shoot1)).To(PointTo(Equal(targetSeed.Name)))
+		Expect(getShootSeedName(shoot2)).To(PointTo(Equal(seed.Name)))
+		Expect(getShootSeedName(shoot3)).To(PointTo(Equal(seed.Name)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventSeedDrainMigrationStarted)))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(2)))
+		Expect(seed.Status.Drain.MigratingShoots).To(Equal(int32(2)))
+		Expect(seed.Status.Drain.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+	})
+
+	It("should skip shoots which are being deleted or still migrating onto the seed", func() {
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+
+		shoot1 := createShoot("shoot1", ptr.To(seed.Name), ptr.To("other"))
+		shoot2 := createShoot("shoot2", ptr.To(seed.Name), ptr.To(seed.Name))
+		shoot2.Finalizers = []string{"test"}
+		Expect(fakeClient.Update(ctx, shoot2)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, shoot2)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(getShootSeedName(shoot1)).To(PointTo(Equal(seed.Name)))
+		Expect(getShootSeedName(shoot2)).To(PointTo(Equal(seed.Name)))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(2)))
+		Expect(seed.Status.Drain.MigratingShoots).To(BeZero())
+	})
+
+	It("should report an error if no target seed can be determined", func() {
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		shoot := createShoot("shoot", ptr.To(seed.Name), ptr.To(seed.Name))
+		determiner.err = fmt.Errorf("no candidates")
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("no candidates")))
+
+		Expect(getShootSeedName(shoot)).To(PointTo(Equal(seed.Name)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventSeedDrainMigrationFailed)))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(1)))
+	})
+
+	It("should report that the seed has been drained", func() {
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventSeedDrained)))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(recorder.Events).NotTo(Receive())
+	})
+})
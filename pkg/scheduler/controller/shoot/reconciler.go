@@ -12,6 +12,7 @@ import (
 	"math"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -21,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -40,6 +42,7 @@ type Reconciler struct {
 	Config          *schedulerconfigv1alpha1.ShootSchedulerConfiguration
 	GardenNamespace string
 	Recorder        events.EventRecorder
+	Clock           clock.Clock
 }
 
 // Reconcile schedules shoots to seeds.
@@ -186,7 +189,7 @@ func (r *Reconciler) DetermineSeed(
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
+	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds, r.Clock.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -194,6 +197,7 @@ func (r *Reconciler) DetermineSeed(
 	if err != nil {
 		return nil, err
 	}
+	filteredSeeds = preferSeedsWithoutCapacityPressure(filteredSeeds)
 	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
 }
 
@@ -226,7 +230,7 @@ func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cl
 }
 
 func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
-	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
+	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && !v1beta1helper.SeedSettingDrainEnabled(seed.Spec.Settings) && verifySeedReadiness(seed)
 }
 
 func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
@@ -463,8 +467,9 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 	return candidates, nil
 }
 
-func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, now time.Time) ([]gardencorev1beta1.Seed, error) {
 	var (
+		tolerations   = v1beta1helper.NonExpiredTolerations(shoot.Spec.Tolerations, now)
 		candidates    []gardencorev1beta1.Seed
 		seedNameToErr = make(map[string]error)
 		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
@@ -478,7 +483,7 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 			}
 		}
 
-		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
+		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, tolerations) {
 			seedNameToErr[seed.Name] = errors.New("shoot does not tolerate the seed's taints")
 			continue
 		}
@@ -497,6 +502,22 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	return candidates, nil
 }
 
+// preferSeedsWithoutCapacityPressure returns the seeds which are not under capacity pressure. If all seeds are under
+// capacity pressure, all of them are returned.
+func preferSeedsWithoutCapacityPressure(seedList []gardencorev1beta1.Seed) []gardencorev1beta1.Seed {
+	var seedsWithoutCapacityPressure []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedCapacityPressure); cond == nil || cond.Status != gardencorev1beta1.ConditionTrue {
+			seedsWithoutCapacityPressure = append(seedsWithoutCapacityPressure, seed)
+		}
+	}
+
+	if len(seedsWithoutCapacityPressure) == 0 {
+		return seedList
+	}
+	return seedsWithoutCapacityPressure
+}
+
 // getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
 func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
 	var (
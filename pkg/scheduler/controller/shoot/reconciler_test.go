@@ -7,6 +7,7 @@ package shoot
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -15,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -33,6 +35,7 @@ var _ = Describe("Scheduler_Control", func() {
 		fakeGardenClient client.Client
 
 		reconciler   *Reconciler
+		fakeClock    *testclock.FakeClock
 		cloudProfile *gardencorev1beta1.CloudProfile
 		project      *gardencorev1beta1.Project
 		seed         *gardencorev1beta1.Seed
@@ -128,6 +131,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 	BeforeEach(func() {
 		log = logr.Discard()
+		fakeClock = testclock.NewFakeClock(time.Now())
 		fakeGardenClient = fakeclient.
 			NewClientBuilder().
 			WithScheme(kubernetes.GardenScheme).
@@ -139,6 +143,7 @@ var _ = Describe("Scheduler_Control", func() {
 		reconciler = &Reconciler{
 			Client: fakeGardenClient,
 			Config: schedulerConfiguration.Schedulers.Shoot,
+			Clock:  fakeClock,
 		}
 	})
 
@@ -632,6 +637,46 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
+
+		It("should prefer seed clusters without capacity pressure", func() {
+			seed.Status.Conditions = append(seed.Status.Conditions, gardencorev1beta1.Condition{
+				Type:   gardencorev1beta1.SeedCapacityPressure,
+				Status: gardencorev1beta1.ConditionTrue,
+			})
+
+			secondSeed := seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &secondSeed.Name
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should fall back to seed clusters with capacity pressure", func() {
+			seed.Status.Conditions = append(seed.Status.Conditions, gardencorev1beta1.Condition{
+				Type:   gardencorev1beta1.SeedCapacityPressure,
+				Status: gardencorev1beta1.ConditionTrue,
+			})
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
 	})
 
 	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using default seed determination strategy", func() {
@@ -783,6 +828,32 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should find a seed cluster whose taints are tolerated by a non-expired toleration", func() {
+			seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+			shoot.Spec.Tolerations = []gardencorev1beta1.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{Time: fakeClock.Now().Add(time.Hour)}}}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should fail because it cannot find a seed cluster due to expired tolerations", func() {
+			seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+			shoot.Spec.Tolerations = []gardencorev1beta1.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{Time: fakeClock.Now().Add(-time.Hour)}}}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError("0/1 seed cluster candidate(s) are eligible for scheduling: {seed-1 => shoot does not tolerate the seed's taints}"))
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail because it cannot find a seed cluster due to no available capacity for shoots", func() {
 			seed.Status.Allocatable = corev1.ResourceList{
 				gardencorev1beta1.ResourceShoots: resource.MustParse("1"),
@@ -912,6 +983,25 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
+
+		It("should fail because it cannot find a seed cluster due to being drained", func() {
+			seed.Spec.Settings = &gardencorev1beta1.SeedSettings{
+				Scheduling: &gardencorev1beta1.SeedSettingScheduling{
+					Visible: true,
+				},
+				Drain: &gardencorev1beta1.SeedSettingDrain{
+					Enabled: true,
+				},
+			}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(HaveOccurred())
+			Expect(bestSeed).To(BeNil())
+		})
 	})
 
 	Context("#DetermineBestSeedCandidate", func() {
@@ -28,6 +28,10 @@ const (
 // ErrorCleaner is called when a task which errored during the previous reconciliation phase completes with success
 type ErrorCleaner func(context.Context, string)
 
+// TaskObserver is called when a task which was not skipped has finished. It receives the name of the flow, the ID of
+// the task, its duration and the error returned by the task, if any.
+type TaskObserver func(flowName string, id TaskID, duration time.Duration, err error)
+
 type nodes map[TaskID]*node
 
 func (ns nodes) rootIDs() TaskIDs {
@@ -102,6 +106,8 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// TaskObserver is called for every finished task, e.g., to collect additional metrics.
+	TaskObserver TaskObserver
 }
 
 // Run starts an execution of a Flow.
@@ -185,6 +191,7 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.TaskObserver,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -200,6 +207,7 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	taskObserver     TaskObserver
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -365,6 +373,9 @@ func (e *execution) reportTaskMetrics(r *nodeResult) {
 	if flowTaskResults != nil {
 		flowTaskResults.WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.Error == nil, "success", "error")).Inc()
 	}
+	if e.taskObserver != nil && !r.skipped {
+		e.taskObserver(e.flow.name, r.TaskID, r.duration, r.Error)
+	}
 }
 
 func (e *execution) reportFlowMetrics() {
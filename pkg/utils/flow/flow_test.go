@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -147,6 +148,29 @@ var _ = Describe("Flow", func() {
 			Expect(cleaned).To(BeTrue())
 		})
 
+		It("should call the task observer for every task which was not skipped", func() {
+			var (
+				err1 = errors.New("err1")
+
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return err1 }})
+				_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return nil }, SkipIf: true})
+				f = g.Compile()
+
+				observed = map[flow.TaskID]error{}
+			)
+
+			Expect(f.Run(ctx, flow.Opts{TaskObserver: func(flowName string, id flow.TaskID, _ time.Duration, err error) {
+				Expect(flowName).To(Equal("foo"))
+				observed[id] = err
+			}})).To(HaveOccurred())
+
+			Expect(observed).To(HaveLen(2))
+			Expect(observed).To(HaveKeyWithValue(flow.TaskID("x"), BeNil()))
+			Expect(observed).To(HaveKeyWithValue(flow.TaskID("y"), MatchError(err1)))
+		})
+
 		It("should stop the execution after the context has been canceled in between tasks", func() {
 			var (
 				testCtx, cancelTestCtx = context.WithCancel(context.Background())
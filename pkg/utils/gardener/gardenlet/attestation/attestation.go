@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationProvider is the annotation on CertificateSigningRequests which contains the name of the attestation
+	// provider that created the evidence.
+	AnnotationProvider = "attestation.gardener.cloud/provider"
+	// AnnotationEvidence is the annotation on CertificateSigningRequests which contains the base64-encoded attestation
+	// evidence.
+	AnnotationEvidence = "attestation.gardener.cloud/evidence"
+)
+
+// Provider creates attestation evidence proving the identity of the machine gardenlet is running on, e.g., based on a
+// TPM quote.
+type Provider interface {
+	// Attest returns the attestation evidence for the given PEM-encoded certificate request. The evidence must be bound
+	// to the certificate request (e.g., by using a digest of it as nonce) so that it cannot be replayed for other
+	// requests.
+	Attest(ctx context.Context, certificateRequest []byte) ([]byte, error)
+}
+
+// Verifier verifies attestation evidence created by the Provider with the same name.
+type Verifier interface {
+	// Verify returns an error if the given evidence does not prove that the certificate request was created on a machine
+	// which is allowed to register the seed with the given name.
+	Verify(ctx context.Context, seedName string, certificateRequest, evidence []byte) error
+}
+
+// ProviderFactory creates a Provider based on the given plugin configuration.
+type ProviderFactory func(config *runtime.RawExtension) (Provider, error)
+
+// VerifierFactory creates a Verifier based on the given plugin configuration. The reader can be used to read objects
+// from the garden cluster.
+type VerifierFactory func(reader client.Reader, config *runtime.RawExtension) (Verifier, error)
+
+var (
+	lock      sync.RWMutex
+	providers = map[string]ProviderFactory{}
+	verifiers = map[string]VerifierFactory{}
+)
+
+// RegisterProvider registers the factory of an attestation provider plugin under the given name. It is supposed to be
+// called in an init function of the plugin package.
+func RegisterProvider(name string, factory ProviderFactory) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, ok := providers[name]; ok {
+		panic(fmt.Sprintf("attestation provider %q is already registered", name))
+	}
+	providers[name] = factory
+}
+
+// RegisterVerifier registers the factory of an attestation verifier plugin under the given name. It is supposed to be
+// called in an init function of the plugin package.
+func RegisterVerifier(name string, factory VerifierFactory) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, ok := verifiers[name]; ok {
+		panic(fmt.Sprintf("attestation verifier %q is already registered", name))
+	}
+	verifiers[name] = factory
+}
+
+// NewProvider creates the attestation provider registered under the given name.
+func NewProvider(name string, config *runtime.RawExtension) (Provider, error) {
+	lock.RLock()
+	factory, ok := providers[name]
+	lock.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("attestation provider %q is not registered", name)
+	}
+	return factory(config)
+}
+
+// NewVerifier creates the attestation verifier registered under the given name.
+func NewVerifier(name string, reader client.Reader, config *runtime.RawExtension) (Verifier, error) {
+	lock.RLock()
+	factory, ok := verifiers[name]
+	lock.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("attestation verifier %q is not registered", name)
+	}
+	return factory(reader, config)
+}
+
+// Annotations returns the annotations for a CertificateSigningRequest containing the evidence created by the given
+// provider for the given PEM-encoded certificate request.
+func Annotations(ctx context.Context, providerName string, provider Provider, certificateRequest []byte) (map[string]string, error) {
+	evidence, err := provider.Attest(ctx, certificateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating attestation evidence with provider %q: %w", providerName, err)
+	}
+
+	return map[string]string{
+		AnnotationProvider: providerName,
+		AnnotationEvidence: base64.StdEncoding.EncodeToString(evidence),
+	}, nil
+}
+
+// Verify verifies the attestation evidence in the annotations of the given CertificateSigningRequest with the given
+// verifier.
+func Verify(ctx context.Context, verifierName string, verifier Verifier, seedName string, csr *certificatesv1.CertificateSigningRequest) error {
+	if providerName := csr.Annotations[AnnotationProvider]; providerName != verifierName {
+		return fmt.Errorf("attestation evidence must be created by provider %q but annotation %s is %q", verifierName, AnnotationProvider, providerName)
+	}
+
+	evidence, err := base64.StdEncoding.DecodeString(csr.Annotations[AnnotationEvidence])
+	if err != nil {
+		return fmt.Errorf("failed decoding attestation evidence in annotation %s: %w", AnnotationEvidence, err)
+	}
+	if len(evidence) == 0 {
+		return fmt.Errorf("annotation %s does not contain attestation evidence", AnnotationEvidence)
+	}
+
+	if err := verifier.Verify(ctx, seedName, csr.Spec.Request, evidence); err != nil {
+		return fmt.Errorf("attestation evidence could not be verified: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAttestation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Utils Gardener Gardenlet Attestation Suite")
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/gardener/gardener/pkg/utils/gardener/gardenlet/attestation"
+)
+
+type fakeProvider struct{}
+
+func (fakeProvider) Attest(_ context.Context, certificateRequest []byte) ([]byte, error) {
+	return append([]byte("quote:"), certificateRequest...), nil
+}
+
+type fakeVerifier struct{ seedName string }
+
+func (v fakeVerifier) Verify(_ context.Context, seedName string, certificateRequest, evidence []byte) error {
+	if seedName != v.seedName {
+		return errors.New("unknown seed")
+	}
+	if !bytes.Equal(evidence, append([]byte("quote:"), certificateRequest...)) {
+		return errors.New("evidence does not match")
+	}
+	return nil
+}
+
+func init() {
+	RegisterProvider("fake", func(*runtime.RawExtension) (Provider, error) { return fakeProvider{}, nil })
+	RegisterVerifier("fake", func(_ client.Reader, config *runtime.RawExtension) (Verifier, error) {
+		return fakeVerifier{seedName: string(config.Raw)}, nil
+	})
+}
+
+var _ = Describe("Attestation", func() {
+	var (
+		ctx                = context.Background()
+		certificateRequest = []byte("csr")
+
+		provider Provider
+		verifier Verifier
+		csr      *certificatesv1.CertificateSigningRequest
+	)
+
+	BeforeEach(func() {
+		var err error
+		provider, err = NewProvider("fake", nil)
+		Expect(err).NotTo(HaveOccurred())
+		verifier, err = NewVerifier("fake", nil, &runtime.RawExtension{Raw: []byte("seed")})
+		Expect(err).NotTo(HaveOccurred())
+
+		annotations, err := Annotations(ctx, "fake", provider, certificateRequest)
+		Expect(err).NotTo(HaveOccurred())
+		csr = &certificatesv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec:       certificatesv1.CertificateSigningRequestSpec{Request: certificateRequest},
+		}
+	})
+
+	Describe("#NewProvider", func() {
+		It("should fail for unregistered providers", func() {
+			_, err := NewProvider("unknown", nil)
+			Expect(err).To(MatchError(`attestation provider "unknown" is not registered`))
+		})
+	})
+
+	Describe("#NewVerifier", func() {
+		It("should fail for unregistered verifiers", func() {
+			_, err := NewVerifier("unknown", nil, nil)
+			Expect(err).To(MatchError(`attestation verifier "unknown" is not registered`))
+		})
+	})
+
+	Describe("#RegisterProvider", func() {
+		It("should panic if the provider is already registered", func() {
+			Expect(func() {
+				RegisterProvider("fake", func(*runtime.RawExtension) (Provider, error) { return fakeProvider{}, nil })
+			}).To(Panic())
+		})
+	})
+
+	Describe("#Annotations", func() {
+		It("should return the provider and the encoded evidence", func() {
+			Expect(csr.Annotations).To(Equal(map[string]string{
+				"attestation.gardener.cloud/provider": "fake",
+				"attestation.gardener.cloud/evidence": "cXVvdGU6Y3Ny",
+			}))
+		})
+	})
+
+	Describe("#Verify", func() {
+		It("should succeed for valid evidence", func() {
+			Expect(Verify(ctx, "fake", verifier, "seed", csr)).To(Succeed())
+		})
+
+		It("should fail if the evidence was created by another provider", func() {
+			csr.Annotations[AnnotationProvider] = "other"
+
+			Expect(Verify(ctx, "fake", verifier, "seed", csr)).To(MatchError(ContainSubstring(`must be created by provider "fake"`)))
+		})
+
+		It("should fail if the evidence is missing", func() {
+			delete(csr.Annotations, AnnotationEvidence)
+
+			Expect(Verify(ctx, "fake", verifier, "seed", csr)).To(MatchError(ContainSubstring("does not contain attestation evidence")))
+		})
+
+		It("should fail if the evidence is not base64-encoded", func() {
+			csr.Annotations[AnnotationEvidence] = "%"
+
+			Expect(Verify(ctx, "fake", verifier, "seed", csr)).To(MatchError(ContainSubstring("failed decoding attestation evidence")))
+		})
+
+		It("should fail if the evidence was created for another certificate request", func() {
+			csr.Spec.Request = []byte("other-csr")
+
+			Expect(Verify(ctx, "fake", verifier, "seed", csr)).To(MatchError(ContainSubstring("evidence does not match")))
+		})
+
+		It("should fail if the verifier rejects the seed", func() {
+			Expect(Verify(ctx, "fake", verifier, "other-seed", csr)).To(MatchError(ContainSubstring("unknown seed")))
+		})
+	})
+})
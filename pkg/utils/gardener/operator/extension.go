@@ -5,6 +5,7 @@
 package operator
 
 import (
+	"fmt"
 	"slices"
 	"strings"
 
@@ -107,7 +108,8 @@ func ControllerRegistrationForExtension(extension *operatorv1alpha1.Extension) (
 				Name: extension.Name,
 			},
 			Spec: gardencorev1beta1.ControllerRegistrationSpec{
-				Resources: resources,
+				Resources:  resources,
+				ErrorCodes: extension.Spec.ErrorCodes,
 				Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{
 					Policy:       extension.Spec.Deployment.ExtensionDeployment.Policy,
 					SeedSelector: extension.Spec.Deployment.ExtensionDeployment.SeedSelector,
@@ -127,3 +129,24 @@ func ControllerRegistrationForExtension(extension *operatorv1alpha1.Extension) (
 
 	return controllerRegistration, controllerDeployment
 }
+
+// ChartPullError is returned if a Helm chart of an extension cannot be pulled from its OCI repository.
+type ChartPullError struct {
+	// URL is the URL of the OCI repository.
+	URL string
+	// Err is the error returned when pulling the Helm chart.
+	Err error
+}
+
+// NewChartPullError returns a new ChartPullError for the given OCI repository.
+func NewChartPullError(ociRepository *gardencorev1.OCIRepository, err error) error {
+	return &ChartPullError{URL: ociRepository.GetURL(), Err: err}
+}
+
+func (e *ChartPullError) Error() string {
+	return fmt.Sprintf("failed pulling Helm chart from OCI repository %q: %v", e.URL, e.Err)
+}
+
+func (e *ChartPullError) Unwrap() error {
+	return e.Err
+}
@@ -5,6 +5,9 @@
 package operator_test
 
 import (
+	"errors"
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -96,6 +99,9 @@ var _ = Describe("Extension", func() {
 							Type: "local",
 						},
 					},
+					ErrorCodes: []gardencorev1beta1.ErrorCodeDefinition{
+						{Code: "ERR_TEST", Description: "test", UserError: true},
+					},
 					Deployment: &operatorv1alpha1.Deployment{
 						ExtensionDeployment: &operatorv1alpha1.ExtensionDeploymentSpec{
 							DeploymentSpec: operatorv1alpha1.DeploymentSpec{
@@ -124,6 +130,7 @@ var _ = Describe("Extension", func() {
 
 			Expect(registration.Name).To(Equal(extension.Name))
 			Expect(registration.Spec.Resources).To(Equal(extension.Spec.Resources))
+			Expect(registration.Spec.ErrorCodes).To(Equal(extension.Spec.ErrorCodes))
 			Expect(registration.Spec.Deployment.Policy).To(HaveValue(Equal(gardencorev1beta1.ControllerDeploymentPolicyAlways)))
 			Expect(registration.Spec.Deployment.SeedSelector).To(Equal(extension.Spec.Deployment.ExtensionDeployment.SeedSelector))
 			Expect(registration.Spec.Deployment.DeploymentRefs).To(ConsistOf(gardencorev1beta1.DeploymentRef{Name: deployment.Name}))
@@ -152,4 +159,19 @@ var _ = Describe("Extension", func() {
 			Expect(registration.Annotations).To(HaveKeyWithValue("security.gardener.cloud/pod-security-enforce", "true"))
 		})
 	})
+
+	Describe("#NewChartPullError", func() {
+		It("should return an error wrapping the cause", func() {
+			cause := errors.New("not found")
+
+			err := NewChartPullError(&gardencorev1.OCIRepository{Ref: ptr.To("example.com/repo:v1.0.0")}, fmt.Errorf("wrapped: %w", cause))
+
+			Expect(err).To(MatchError(`failed pulling Helm chart from OCI repository "example.com/repo:v1.0.0": wrapped: not found`))
+			Expect(err).To(MatchError(cause))
+
+			var chartPullErr *ChartPullError
+			Expect(errors.As(fmt.Errorf("task failed: %w", err), &chartPullErr)).To(BeTrue())
+			Expect(chartPullErr.URL).To(Equal("example.com/repo:v1.0.0"))
+		})
+	})
 })
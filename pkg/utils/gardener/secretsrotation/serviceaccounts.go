@@ -22,7 +22,6 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
-	"github.com/gardener/gardener/pkg/utils/retry"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -86,28 +85,11 @@ func CreateNewServiceAccountSecrets(ctx context.Context, log logr.Logger, c clie
 				return fmt.Errorf("error creating new ServiceAccount secret: %w", err)
 			}
 
-			timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-			defer cancel()
-
-			return retry.Until(timeoutCtx, time.Second, func(ctx context.Context) (bool, error) {
-				// Make sure we have the most recent version of the service account when we reach this point (which might
-				// take a while given the above limiter.Wait call - in the meantime, the object might have been changed).
-				if err := c.Get(ctx, client.ObjectKeyFromObject(&serviceAccount), &serviceAccount); err != nil {
-					return retry.SevereError(err)
-				}
-
-				patch := client.MergeFromWithOptions(serviceAccount.DeepCopy(), client.MergeFromWithOptimisticLock{})
+			// The service account might have been changed since it was listed (which might take a while given the above
+			// limiter.Wait call), hence conflicts are retried with the most recent version of the service account.
+			return kubernetesutils.PatchWithRetry(ctx, c, &serviceAccount, func(serviceAccount *corev1.ServiceAccount) {
 				metav1.SetMetaDataLabel(&serviceAccount.ObjectMeta, labelKeyRotationKeyName, serviceAccountKeySecret.Name)
 				serviceAccount.Secrets = append([]corev1.ObjectReference{{Name: secret.Name}}, serviceAccount.Secrets...)
-
-				if err := c.Patch(ctx, &serviceAccount, patch); err != nil {
-					if apierrors.IsConflict(err) {
-						return retry.MinorError(err)
-					}
-					return retry.SevereError(err)
-				}
-
-				return retry.Ok()
 			})
 		})
 	}
@@ -173,30 +155,13 @@ func DeleteOldServiceAccountSecrets(ctx context.Context, log logr.Logger, c clie
 				return fmt.Errorf("error deleting old ServiceAccount secrets: %w", err)
 			}
 
-			timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-			defer cancel()
-
-			return retry.Until(timeoutCtx, time.Second, func(ctx context.Context) (bool, error) {
-				// Make sure we have the most recent version of the service account when we reach this point (which might
-				// take a while given the above limiter.Wait call - in the meantime, the object might have been changed).
-				// Also, when deleting above secrets, kube-controller-manager might already remove them from the service
-				// account which definitely changes the object.
-				if err := c.Get(ctx, client.ObjectKeyFromObject(&serviceAccount), &serviceAccount); err != nil {
-					return retry.SevereError(err)
-				}
-
-				patch := client.MergeFromWithOptions(serviceAccount.DeepCopy(), client.MergeFromWithOptimisticLock{})
+			// The service account might have been changed since it was listed (which might take a while given the above
+			// limiter.Wait call), hence conflicts are retried with the most recent version of the service account. Also,
+			// when deleting above secrets, kube-controller-manager might already remove them from the service account which
+			// definitely changes the object.
+			return kubernetesutils.PatchWithRetry(ctx, c, &serviceAccount, func(serviceAccount *corev1.ServiceAccount) {
 				delete(serviceAccount.Labels, labelKeyRotationKeyName)
 				serviceAccount.Secrets = remainingSecrets
-
-				if err := c.Patch(ctx, &serviceAccount, patch); err != nil {
-					if apierrors.IsConflict(err) {
-						return retry.MinorError(err)
-					}
-					return retry.SevereError(err)
-				}
-
-				return retry.Ok()
 			})
 		})
 	}
@@ -8,6 +8,7 @@ import (
 	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/prometheus/common/model"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -209,6 +211,8 @@ const (
 	ShootProjectSecretSuffixCACluster = "ca-cluster"
 	// ShootProjectSecretSuffixCAClient is a constant for a shoot project secret with suffix 'ca-client'.
 	ShootProjectSecretSuffixCAClient = "ca-client"
+	// ShootProjectSecretSuffixCASSH is a constant for a shoot project secret with suffix 'ca-ssh'.
+	ShootProjectSecretSuffixCASSH = v1beta1constants.SecretNameCASSH
 	// ShootProjectSecretSuffixSSHKeypair is a constant for a shoot project secret with suffix 'ssh-keypair'.
 	ShootProjectSecretSuffixSSHKeypair = v1beta1constants.SecretNameSSHKeyPair
 	// ShootProjectSecretSuffixOldSSHKeypair is a constant for a shoot project secret with suffix 'ssh-keypair.old'.
@@ -235,6 +239,7 @@ func GetShootProjectSecretSuffixes() []string {
 func GetShootProjectInternalSecretSuffixes() []string {
 	return []string{
 		ShootProjectSecretSuffixCAClient,
+		ShootProjectSecretSuffixCASSH,
 	}
 }
 
@@ -956,3 +961,44 @@ func CalculateWorkerPoolHashForInPlaceUpdate(workerPoolName string, kubernetesVe
 
 	return utils.ComputeSHA256Hex([]byte(result.String()))[:16], nil
 }
+
+// ParseImageOverrides parses the value of the `shoot.gardener.cloud/image-overrides` annotation, i.e., a comma-separated
+// list of `<image-name>=<image>` pairs, and returns a map from image names to the images overriding them.
+func ParseImageOverrides(value string) (map[string]string, error) {
+	overrides := make(map[string]string)
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		name, image, found := strings.Cut(pair, "=")
+		name, image = strings.TrimSpace(name), strings.TrimSpace(image)
+		if !found || name == "" || image == "" {
+			return nil, fmt.Errorf("invalid image override %q, expected format <image-name>=<image>", pair)
+		}
+
+		if _, ok := overrides[name]; ok {
+			return nil, fmt.Errorf("duplicate image override for %q", name)
+		}
+		overrides[name] = image
+	}
+
+	return overrides, nil
+}
+
+// ParseMonitoringRetention parses the value of the `shoot.gardener.cloud/monitoring-retention` annotation, i.e., a
+// positive Prometheus duration like `7d`.
+func ParseMonitoringRetention(value string) (time.Duration, error) {
+	retention, err := model.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if retention <= 0 {
+		return 0, errors.New("retention must be positive")
+	}
+
+	return time.Duration(retention), nil
+}
@@ -365,7 +365,7 @@ var _ = Describe("Shoot", func() {
 
 	Describe("#GetShootProjectInternalSecretSuffixes", func() {
 		It("should return the expected list", func() {
-			Expect(GetShootProjectInternalSecretSuffixes()).To(ConsistOf("ca-client"))
+			Expect(GetShootProjectInternalSecretSuffixes()).To(ConsistOf("ca-client", "ca-ssh"))
 		})
 	})
 
@@ -2382,4 +2382,48 @@ var _ = Describe("Shoot", func() {
 			Expect(err).To(MatchError(ContainSubstring("failed to parse")))
 		})
 	})
+
+	Describe("#ParseImageOverrides", func() {
+		It("should return an empty map for an empty value", func() {
+			Expect(ParseImageOverrides("")).To(BeEmpty())
+		})
+
+		It("should parse the image overrides", func() {
+			Expect(ParseImageOverrides("kube-apiserver=registry.example.com/kube-apiserver:v1.33.1-hotfix, etcd = registry.example.com/etcd@sha256:abc,")).To(Equal(map[string]string{
+				"kube-apiserver": "registry.example.com/kube-apiserver:v1.33.1-hotfix",
+				"etcd":           "registry.example.com/etcd@sha256:abc",
+			}))
+		})
+
+		It("should fail for pairs without image", func() {
+			_, err := ParseImageOverrides("kube-apiserver=")
+			Expect(err).To(MatchError(ContainSubstring("invalid image override")))
+		})
+
+		It("should fail for pairs without separator", func() {
+			_, err := ParseImageOverrides("kube-apiserver")
+			Expect(err).To(MatchError(ContainSubstring("invalid image override")))
+		})
+
+		It("should fail for duplicate image names", func() {
+			_, err := ParseImageOverrides("etcd=foo:1,etcd=foo:2")
+			Expect(err).To(MatchError(ContainSubstring("duplicate image override")))
+		})
+	})
+
+	Describe("#ParseMonitoringRetention", func() {
+		It("should parse the monitoring retention", func() {
+			Expect(ParseMonitoringRetention("7d")).To(Equal(7 * 24 * time.Hour))
+		})
+
+		It("should fail for invalid durations", func() {
+			_, err := ParseMonitoringRetention("foo")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for non-positive durations", func() {
+			_, err := ParseMonitoringRetention("0s")
+			Expect(err).To(MatchError("retention must be positive"))
+		})
+	})
 })
@@ -12,7 +12,9 @@ import (
 	"slices"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -86,6 +88,12 @@ func mergeImageSources(old, override *ImageSource) *ImageSource {
 		repository, tag = nil, nil
 	}
 
+	// The digest of the old source must not be kept if the override points to another image.
+	digest := override.Digest
+	if digest == nil && override.Ref == nil && override.Repository == nil && override.Tag == nil {
+		digest = old.Digest
+	}
+
 	runtimeVersion := override.RuntimeVersion
 	if runtimeVersion == nil {
 		runtimeVersion = old.RuntimeVersion
@@ -109,6 +117,7 @@ func mergeImageSources(old, override *ImageSource) *ImageSource {
 		Ref:            ref,
 		Repository:     repository,
 		Tag:            tag,
+		Digest:         digest,
 		Version:        version,
 	}
 }
@@ -346,6 +355,37 @@ func (v ImageVector) FindImage(name string, opts ...FindOptionFunc) (*Image, err
 	return bestCandidate.ToImage(o.TargetVersion), nil
 }
 
+// Repositories returns the repositories of all sources with the given <name> in the image vector.
+func (v ImageVector) Repositories(name string) []string {
+	repositories := sets.New[string]()
+
+	for _, source := range v {
+		if source.Name != name {
+			continue
+		}
+
+		if source.Ref != nil {
+			repositories.Insert(RepositoryOf(*source.Ref))
+		} else if source.Repository != nil {
+			repositories.Insert(*source.Repository)
+		}
+	}
+
+	return sets.List(repositories)
+}
+
+// RepositoryOf returns the repository of the given image reference, i.e., the reference without tag and digest.
+func RepositoryOf(ref string) string {
+	ref, _, _ = strings.Cut(ref, "@")
+
+	// A colon only separates the tag if it occurs after the last slash, otherwise it belongs to the registry port.
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref = ref[:i]
+	}
+
+	return ref
+}
+
 // FindImages returns an image map with the given <names> from the sources in the image vector.
 // The <k8sVersion> specifies the kubernetes version the image will be running on.
 // The <targetK8sVersion> specifies the kubernetes version the image shall target.
@@ -368,11 +408,17 @@ func FindImages(v ImageVector, names []string, opts ...FindOptionFunc) (map[stri
 // ToImage applies the given <targetK8sVersion> to the source to produce an output image. This only works when the image
 // is not specified via 'Ref' and when 'Tag' is not set.
 // If the tag of an image source is empty, it will use the given <targetVersion> as tag.
+// If the image source is pinned to a digest, the digest is appended to the ref or tag.
 func (i *ImageSource) ToImage(targetVersion *string) *Image {
 	if i.Ref != nil {
+		ref := i.Ref
+		if i.Digest != nil {
+			ref = ptr.To(*ref + "@" + *i.Digest)
+		}
+
 		return &Image{
 			Name:    i.Name,
-			Ref:     i.Ref,
+			Ref:     ref,
 			Version: i.Version,
 		}
 	}
@@ -388,6 +434,14 @@ func (i *ImageSource) ToImage(targetVersion *string) *Image {
 		version = tag
 	}
 
+	if i.Digest != nil {
+		if tag == nil {
+			tag = i.Digest
+		} else {
+			tag = ptr.To(*tag + "@" + *i.Digest)
+		}
+	}
+
 	return &Image{
 		Name:       i.Name,
 		Repository: i.Repository,
@@ -404,6 +458,11 @@ func (i *Image) WithOptionalTag(tag string) {
 	}
 }
 
+// IsPinned returns true if the image is pinned to a digest.
+func (i *Image) IsPinned() bool {
+	return strings.Contains(i.String(), "@"+SHA256TagPrefix)
+}
+
 // String returns the string representation of the image.
 func (i *Image) String() string {
 	if i.Ref != nil {
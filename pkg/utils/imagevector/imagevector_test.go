@@ -7,6 +7,7 @@ package imagevector_test
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -336,6 +337,31 @@ images:
 			Entry("repo+tag override ref", ImageVector{image1Src7}, ImageVector{image1Src1}, ImageVector{image1Src1}),
 		)
 
+		Describe("#Merge with digests", func() {
+			var digest = "sha256:" + strings.Repeat("a", 64)
+
+			It("should keep the digest if the override does not point to another image", func() {
+				Expect(Merge(
+					ImageVector{{Name: "foo", Repository: ptr.To("repo"), Tag: ptr.To("v1"), Digest: &digest}},
+					ImageVector{{Name: "foo", Version: ptr.To("v1.0.0")}},
+				)).To(ConsistOf(HaveField("Digest", PointTo(Equal(digest)))))
+			})
+
+			It("should drop the digest if the override points to another image", func() {
+				Expect(Merge(
+					ImageVector{{Name: "foo", Repository: ptr.To("repo"), Tag: ptr.To("v1"), Digest: &digest}},
+					ImageVector{{Name: "foo", Tag: ptr.To("v2")}},
+				)).To(ConsistOf(And(HaveField("Tag", PointTo(Equal("v2"))), HaveField("Digest", BeNil()))))
+			})
+
+			It("should override the digest", func() {
+				Expect(Merge(
+					ImageVector{{Name: "foo", Repository: ptr.To("repo"), Tag: ptr.To("v1")}},
+					ImageVector{{Name: "foo", Digest: &digest}},
+				)).To(ConsistOf(And(HaveField("Tag", PointTo(Equal("v1"))), HaveField("Digest", PointTo(Equal(digest))))))
+			})
+		})
+
 		Describe("#WithEnvOverride", func() {
 			It("should override the ImageVector with the settings of the env variable", func() {
 				var (
@@ -614,6 +640,33 @@ images:
 		})
 	})
 
+	Describe("#Repositories", func() {
+		It("should return the repositories of all sources with the given name", func() {
+			v := ImageVector{
+				{Name: "foo", Repository: ptr.To("example.com/foo"), Tag: ptr.To("v1")},
+				{Name: "foo", Repository: ptr.To("example.com/foo"), Tag: ptr.To("v2")},
+				{Name: "foo", Ref: ptr.To("example.com:5000/mirror/foo:v3@sha256:abc")},
+				{Name: "bar", Repository: ptr.To("example.com/bar")},
+			}
+
+			Expect(v.Repositories("foo")).To(Equal([]string{"example.com/foo", "example.com:5000/mirror/foo"}))
+			Expect(v.Repositories("baz")).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("#RepositoryOf",
+		func(ref, expected string) {
+			Expect(RepositoryOf(ref)).To(Equal(expected))
+		},
+
+		Entry("without tag", "example.com/foo", "example.com/foo"),
+		Entry("with tag", "example.com/foo:v1", "example.com/foo"),
+		Entry("with digest", "example.com/foo@sha256:abc", "example.com/foo"),
+		Entry("with tag and digest", "example.com/foo:v1@sha256:abc", "example.com/foo"),
+		Entry("with registry port", "example.com:5000/foo", "example.com:5000/foo"),
+		Entry("with registry port and tag", "example.com:5000/foo:v1", "example.com:5000/foo"),
+	)
+
 	Describe("> Image", func() {
 		Describe("#WithOptionalTag", func() {
 			It("should do nothing because ref is set", func() {
@@ -641,6 +694,19 @@ images:
 			})
 		})
 
+		Describe("#IsPinned", func() {
+			It("should return true if the image is pinned to a digest", func() {
+				Expect((&Image{Ref: ptr.To("repo:v1@sha256:foo")}).IsPinned()).To(BeTrue())
+				Expect((&Image{Repository: ptr.To("repo"), Tag: ptr.To("sha256:foo")}).IsPinned()).To(BeTrue())
+				Expect((&Image{Repository: ptr.To("repo"), Tag: ptr.To("v1@sha256:foo")}).IsPinned()).To(BeTrue())
+			})
+
+			It("should return false if the image is not pinned to a digest", func() {
+				Expect((&Image{Ref: ptr.To("repo:v1")}).IsPinned()).To(BeFalse())
+				Expect((&Image{Repository: ptr.To("repo"), Tag: ptr.To("v1")}).IsPinned()).To(BeFalse())
+			})
+		})
+
 		Describe("#String", func() {
 			var repo = ptr.To("my-repo")
 
@@ -733,6 +799,51 @@ images:
 				}))
 			})
 
+			It("should append the digest to the ref", func() {
+				source := ImageSource{
+					Name:   name,
+					Ref:    ptr.To("ref:v1"),
+					Digest: ptr.To("sha256:foo"),
+				}
+
+				Expect(source.ToImage(nil)).To(Equal(&Image{
+					Name: name,
+					Ref:  ptr.To("ref:v1@sha256:foo"),
+				}))
+			})
+
+			It("should append the digest to the tag", func() {
+				source := ImageSource{
+					Name:       name,
+					Repository: repository,
+					Tag:        &tag,
+					Digest:     ptr.To("sha256:foo"),
+				}
+
+				Expect(source.ToImage(nil)).To(Equal(&Image{
+					Name:       name,
+					Repository: repository,
+					Tag:        ptr.To(tag + "@sha256:foo"),
+					Version:    &tag,
+				}))
+			})
+
+			It("should use the digest as tag if no tag is given", func() {
+				source := ImageSource{
+					Name:       name,
+					Repository: repository,
+					Digest:     ptr.To("sha256:foo"),
+				}
+
+				image := source.ToImage(nil)
+				Expect(image).To(Equal(&Image{
+					Name:       name,
+					Repository: repository,
+					Tag:        ptr.To("sha256:foo"),
+				}))
+				Expect(image.String()).To(Equal(*repository + "@sha256:foo"))
+			})
+
 			It("should return an image with the given version as tag", func() {
 				var (
 					version = "1.8.0"
@@ -5,10 +5,15 @@
 package imagevector
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/Masterminds/semver/v3"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+var digestRegex = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
 // ValidateImageVector validates the given ImageVector.
 func ValidateImageVector(imageVector ImageVector, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -66,6 +71,20 @@ func validateImageSource(imageSource *ImageSource, fldPath *field.Path) field.Er
 		}
 	}
 
+	if imageSource.Digest != nil {
+		digestPath := fldPath.Child("digest")
+
+		if !digestRegex.MatchString(*imageSource.Digest) {
+			allErrs = append(allErrs, field.Invalid(digestPath, *imageSource.Digest, "digest must be a sha256 digest (sha256:<64 hex characters>)"))
+		}
+		if imageSource.Ref != nil && strings.Contains(*imageSource.Ref, "@") {
+			allErrs = append(allErrs, field.Forbidden(digestPath, "cannot specify digest when ref already contains a digest"))
+		}
+		if imageSource.Tag != nil && (strings.Contains(*imageSource.Tag, "@") || strings.HasPrefix(*imageSource.Tag, SHA256TagPrefix)) {
+			allErrs = append(allErrs, field.Forbidden(digestPath, "cannot specify digest when tag already contains a digest"))
+		}
+	}
+
 	// Ensure runtimeVersion and targetVersion are valid semver constraints if specified
 	if imageSource.RuntimeVersion != nil {
 		if _, err := semver.NewConstraint(*imageSource.RuntimeVersion); err != nil {
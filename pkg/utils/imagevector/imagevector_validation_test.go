@@ -5,6 +5,8 @@
 package imagevector_test
 
 import (
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -121,6 +123,52 @@ var _ = Describe("validation", func() {
 		})
 	})
 
+	Describe("#ValidateImageVector with digests", func() {
+		var digest = "sha256:" + strings.Repeat("a", 64)
+
+		It("should allow valid digests", func() {
+			Expect(ValidateImageVector(ImageVector{
+				{Name: "foo", Repository: ptr.To("repo"), Tag: ptr.To("v1"), Digest: &digest},
+				{Name: "bar", Ref: ptr.To("repo:v1"), Digest: &digest},
+			}, field.NewPath("images"))).To(BeEmpty())
+		})
+
+		It("should forbid invalid digests", func() {
+			Expect(ValidateImageVector(ImageVector{
+				{Name: "foo", Repository: ptr.To("repo"), Digest: ptr.To("sha512:foo")},
+			}, field.NewPath("images"))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("images[0].digest"),
+				})),
+			))
+		})
+
+		It("should forbid digests if the ref or tag already contain a digest", func() {
+			Expect(ValidateImageVector(ImageVector{
+				{Name: "foo", Ref: ptr.To("repo:v1@" + digest), Digest: &digest},
+				{Name: "bar", Repository: ptr.To("repo"), Tag: ptr.To("v1@" + digest), Digest: &digest},
+				{Name: "baz", Repository: ptr.To("repo"), Tag: &digest, Digest: &digest},
+			}, field.NewPath("images"))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("images[0].digest"),
+					"Detail": Equal("cannot specify digest when ref already contains a digest"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("images[1].digest"),
+					"Detail": Equal("cannot specify digest when tag already contains a digest"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("images[2].digest"),
+					"Detail": Equal("cannot specify digest when tag already contains a digest"),
+				})),
+			))
+		})
+	})
+
 	Describe("#ValidateComponentImageVectors", func() {
 		It("should allow valid component image vectors", func() {
 			errorList := ValidateComponentImageVectors(componentImageVectors("test-component1", imageVector("test-image1", nil, ptr.To("test-repo"), ptr.To("test-tag"), ">= 1.6, < 1.8", ">= 1.8")), field.NewPath("components"))
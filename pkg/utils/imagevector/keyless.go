@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	signatureutils "github.com/gardener/gardener/pkg/utils/signature"
+)
+
+var (
+	// oidFulcioIssuer is the OID of the certificate extension containing the OIDC issuer as raw string, see
+	// https://github.com/sigstore/fulcio/blob/main/docs/oid-info.md.
+	oidFulcioIssuer = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	// oidFulcioIssuerV2 is the OID of the certificate extension containing the OIDC issuer as DER-encoded string.
+	oidFulcioIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+func (v *CosignVerifier) configureKeyless(keyless *KeylessOptions) error {
+	v.fulcioRoots = x509.NewCertPool()
+	for i, root := range keyless.FulcioRoots {
+		if !v.fulcioRoots.AppendCertsFromPEM([]byte(root)) {
+			return fmt.Errorf("failed parsing Fulcio root certificate %d", i)
+		}
+	}
+
+	if len(keyless.Identities) == 0 {
+		return errors.New("at least one identity is required for verifying keyless signatures")
+	}
+	for i, identity := range keyless.Identities {
+		if identity.Issuer == "" || identity.Subject == "" {
+			return fmt.Errorf("identity %d must specify both issuer and subject", i)
+		}
+	}
+	v.identities = keyless.Identities
+
+	if len(keyless.RekorPublicKeys) == 0 {
+		return errors.New("at least one Rekor public key is required for verifying keyless signatures")
+	}
+	v.rekorPublicKeys = make(map[string]crypto.PublicKey, len(keyless.RekorPublicKeys))
+	for i, publicKey := range keyless.RekorPublicKeys {
+		key, err := signatureutils.ParsePublicKey([]byte(publicKey))
+		if err != nil {
+			return fmt.Errorf("failed parsing Rekor public key %d: %w", i, err)
+		}
+		logID, err := rekorLogID(key)
+		if err != nil {
+			return fmt.Errorf("failed computing log ID of Rekor public key %d: %w", i, err)
+		}
+		v.rekorPublicKeys[logID] = key
+	}
+
+	return nil
+}
+
+// verifyKeylessSignature verifies a keyless signature. The signature is only accepted if it was recorded in the Rekor
+// transparency log while the signing certificate was valid, and if the signing certificate chains up to a Fulcio root
+// and was issued to one of the accepted identities.
+func (v *CosignVerifier) verifyKeylessSignature(payload, signature []byte, certificatePEM string, annotations map[string]string) error {
+	certificate, err := parseCertificate(certificatePEM)
+	if err != nil {
+		return err
+	}
+
+	integratedTime, err := v.verifyRekorBundle(annotations[CosignAnnotationBundle], payload, signature, certificate)
+	if err != nil {
+		return fmt.Errorf("failed verifying transparency log entry: %w", err)
+	}
+
+	if err := v.verifyCertificate(certificate, annotations[CosignAnnotationChain], integratedTime); err != nil {
+		return err
+	}
+
+	if err := v.verifyIdentity(certificate); err != nil {
+		return err
+	}
+
+	return signatureutils.Verify(certificate.PublicKey, payload, signature)
+}
+
+func parseCertificate(certificatePEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil {
+		return nil, errors.New("no PEM-encoded signing certificate found")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing signing certificate: %w", err)
+	}
+
+	return certificate, nil
+}
+
+// verifyCertificate verifies the chain of the signing certificate at the given time. Fulcio certificates are only valid
+// for a few minutes, hence the time must be a trusted point in time at which the signature was created.
+func (v *CosignVerifier) verifyCertificate(certificate *x509.Certificate, chainPEM string, at time.Time) error {
+	intermediates := x509.NewCertPool()
+	intermediates.AppendCertsFromPEM([]byte(chainPEM))
+
+	if _, err := certificate.Verify(x509.VerifyOptions{
+		Roots:         v.fulcioRoots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("failed verifying signing certificate: %w", err)
+	}
+
+	return nil
+}
+
+// verifyIdentity verifies that the signing certificate was issued to one of the accepted identities.
+func (v *CosignVerifier) verifyIdentity(certificate *x509.Certificate) error {
+	issuer := certificateOIDCIssuer(certificate)
+
+	subjects := slices.Clone(certificate.EmailAddresses)
+	for _, uri := range certificate.URIs {
+		subjects = append(subjects, uri.String())
+	}
+
+	for _, identity := range v.identities {
+		if identity.Issuer == issuer && slices.Contains(subjects, identity.Subject) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("signing certificate for subjects %v issued by %q does not match any accepted identity", subjects, issuer)
+}
+
+func certificateOIDCIssuer(certificate *x509.Certificate) string {
+	var issuer string
+	for _, extension := range certificate.Extensions {
+		switch {
+		case extension.Id.Equal(oidFulcioIssuerV2):
+			var value string
+			if rest, err := asn1.Unmarshal(extension.Value, &value); err == nil && len(rest) == 0 {
+				return value
+			}
+		case extension.Id.Equal(oidFulcioIssuer):
+			issuer = string(extension.Value)
+		}
+	}
+	return issuer
+}
+
+// rekorBundle is the bundle which cosign attaches to keyless signatures. It contains the transparency log entry of the
+// signature and the signed entry timestamp which Rekor issued when it integrated the entry into the log.
+type rekorBundle struct {
+	SignedEntryTimestamp []byte             `json:"SignedEntryTimestamp"`
+	Payload              rekorBundlePayload `json:"Payload"`
+}
+
+// rekorBundlePayload is the payload signed by the signed entry timestamp. The fields are ordered alphabetically by their
+// JSON names so that marshalling produces the canonical JSON representation (RFC 8785) which Rekor signed.
+type rekorBundlePayload struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+}
+
+// hashedRekordEntry is the body of a transparency log entry of kind `hashedrekord`.
+type hashedRekordEntry struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Data struct {
+			Hash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"hash"`
+		} `json:"data"`
+		Signature struct {
+			Content   []byte `json:"content"`
+			PublicKey struct {
+				Content []byte `json:"content"`
+			} `json:"publicKey"`
+		} `json:"signature"`
+	} `json:"spec"`
+}
+
+// verifyRekorBundle verifies that the given bundle was issued by a trusted Rekor instance for the given signature,
+// payload and signing certificate. It returns the time at which the entry was integrated into the transparency log.
+func (v *CosignVerifier) verifyRekorBundle(bundleJSON string, payload, signature []byte, certificate *x509.Certificate) (time.Time, error) {
+	if bundleJSON == "" {
+		return time.Time{}, errors.New("signature does not contain a Rekor bundle")
+	}
+
+	var bundle rekorBundle
+	if err := json.Unmarshal([]byte(bundleJSON), &bundle); err != nil {
+		return time.Time{}, fmt.Errorf("failed parsing Rekor bundle: %w", err)
+	}
+
+	rekorPublicKey, ok := v.rekorPublicKeys[bundle.Payload.LogID]
+	if !ok {
+		return time.Time{}, fmt.Errorf("bundle was issued by untrusted transparency log %q", bundle.Payload.LogID)
+	}
+
+	signedPayload, err := json.Marshal(bundle.Payload)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed marshalling Rekor bundle payload: %w", err)
+	}
+	if err := signatureutils.Verify(rekorPublicKey, signedPayload, bundle.SignedEntryTimestamp); err != nil {
+		return time.Time{}, fmt.Errorf("failed verifying signed entry timestamp: %w", err)
+	}
+
+	body, err := base64.StdEncoding.DecodeString(bundle.Payload.Body)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed decoding transparency log entry: %w", err)
+	}
+
+	var entry hashedRekordEntry
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return time.Time{}, fmt.Errorf("failed parsing transparency log entry: %w", err)
+	}
+	if entry.Kind != "hashedrekord" {
+		return time.Time{}, fmt.Errorf("unsupported transparency log entry kind %q", entry.Kind)
+	}
+
+	payloadDigest := sha256.Sum256(payload)
+	if entry.Spec.Data.Hash.Algorithm != "sha256" || entry.Spec.Data.Hash.Value != hex.EncodeToString(payloadDigest[:]) {
+		return time.Time{}, errors.New("transparency log entry does not match the signature payload")
+	}
+	if !bytes.Equal(entry.Spec.Signature.Content, signature) {
+		return time.Time{}, errors.New("transparency log entry does not match the signature")
+	}
+	if block, _ := pem.Decode(entry.Spec.Signature.PublicKey.Content); block == nil || !bytes.Equal(block.Bytes, certificate.Raw) {
+		return time.Time{}, errors.New("transparency log entry does not match the signing certificate")
+	}
+
+	return time.Unix(bundle.Payload.IntegratedTime, 0), nil
+}
+
+// rekorLogID returns the ID of the transparency log with the given public key, i.e., the hex-encoded SHA-256 digest of
+// the DER-encoded public key.
+func rekorLogID(publicKey crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(der)
+	return hex.EncodeToString(digest[:]), nil
+}
@@ -22,6 +22,9 @@ type ImageSource struct {
 	Ref        *string `json:"ref,omitempty"        yaml:"ref,omitempty"`
 	Repository *string `json:"repository,omitempty" yaml:"repository,omitempty"`
 	Tag        *string `json:"tag,omitempty"        yaml:"tag,omitempty"`
+	// Digest pins the image to the given digest (e.g., `sha256:073...`). It is appended to the Ref or to the Tag, hence
+	// it must not be set if the Ref or the Tag already contain a digest.
+	Digest *string `json:"digest,omitempty" yaml:"digest,omitempty"`
 
 	// Version is a human-readable version of the image (helpful in case the ref/tag does not specify it because only a
 	// digest is used).
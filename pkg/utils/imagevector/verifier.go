@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	signatureutils "github.com/gardener/gardener/pkg/utils/signature"
+)
+
+const (
+	// CosignSignatureTagSuffix is the suffix of the tag under which cosign stores the signatures of an image. The tag is
+	// derived from the digest of the image, e.g. `sha256-<hex>.sig`.
+	CosignSignatureTagSuffix = ".sig"
+	// CosignAnnotationSignature is the annotation of a signature layer containing the base64-encoded signature.
+	CosignAnnotationSignature = "dev.cosignproject.cosign/signature"
+	// CosignAnnotationCertificate is the annotation of a signature layer containing the PEM-encoded signing certificate
+	// for keyless signatures.
+	CosignAnnotationCertificate = "dev.sigstore.cosign/certificate"
+	// CosignAnnotationChain is the annotation of a signature layer containing the PEM-encoded certificate chain of the
+	// signing certificate for keyless signatures.
+	CosignAnnotationChain = "dev.sigstore.cosign/chain"
+	// CosignAnnotationBundle is the annotation of a signature layer containing the Rekor bundle, i.e., the proof that the
+	// signature was recorded in the Rekor transparency log.
+	CosignAnnotationBundle = "dev.sigstore.cosign/bundle"
+
+	maxSignaturePayloadSize = 1 << 20
+)
+
+// Verifier verifies images.
+type Verifier interface {
+	// Verify verifies the given image reference.
+	Verify(ctx context.Context, image string) error
+}
+
+// CosignVerifier verifies the cosign signatures of images.
+type CosignVerifier struct {
+	publicKeys      []crypto.PublicKey
+	fulcioRoots     *x509.CertPool
+	identities      []KeylessIdentity
+	rekorPublicKeys map[string]crypto.PublicKey
+	remoteOptions   []remote.Option
+}
+
+// KeylessOptions configures the verification of keyless signatures.
+type KeylessOptions struct {
+	// FulcioRoots are PEM-encoded Fulcio root certificates. The signing certificate must chain up to one of them.
+	FulcioRoots []string
+	// Identities are the identities which are accepted as signers. The signing certificate must have been issued to one
+	// of them.
+	Identities []KeylessIdentity
+	// RekorPublicKeys are PEM-encoded public keys of the Rekor transparency log. The signature must have been recorded
+	// in the transparency log while the signing certificate was valid.
+	RekorPublicKeys []string
+}
+
+// KeylessIdentity is an identity which is accepted as signer of keyless signatures.
+type KeylessIdentity struct {
+	// Issuer is the OIDC issuer which authenticated the signer.
+	Issuer string
+	// Subject is the subject alternative name (an email address or URI) of the signing certificate.
+	Subject string
+}
+
+var _ Verifier = &CosignVerifier{}
+
+// NewCosignVerifier returns a verifier for cosign signatures. Signatures are accepted if they can be verified with one
+// of the given PEM-encoded public keys, or, for keyless signatures, if the signing certificate chains up to one of the
+// configured Fulcio root certificates, was issued to one of the configured identities and the signature was recorded in
+// the Rekor transparency log while the signing certificate was valid. The given remote options are used for all
+// registry requests.
+func NewCosignVerifier(publicKeys []string, keyless *KeylessOptions, remoteOptions ...remote.Option) (*CosignVerifier, error) {
+	verifier := &CosignVerifier{remoteOptions: remoteOptions}
+
+	for i, publicKey := range publicKeys {
+		key, err := signatureutils.ParsePublicKey([]byte(publicKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing public key %d: %w", i, err)
+		}
+		verifier.publicKeys = append(verifier.publicKeys, key)
+	}
+
+	if keyless != nil && len(keyless.FulcioRoots) > 0 {
+		if err := verifier.configureKeyless(keyless); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(verifier.publicKeys) == 0 && verifier.fulcioRoots == nil {
+		return nil, errors.New("at least one public key or Fulcio root certificate is required")
+	}
+
+	return verifier, nil
+}
+
+// Verify verifies that the given image has a valid cosign signature. If the image is not pinned to a digest, the
+// digest is resolved via the registry.
+func (v *CosignVerifier) Verify(ctx context.Context, image string) error {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return fmt.Errorf("failed parsing image reference: %w", err)
+	}
+
+	remoteOptions := append([]remote.Option{remote.WithContext(ctx)}, v.remoteOptions...)
+
+	var digest v1.Hash
+	if d, ok := ref.(name.Digest); ok {
+		if digest, err = v1.NewHash(d.DigestStr()); err != nil {
+			return fmt.Errorf("failed parsing digest: %w", err)
+		}
+	} else {
+		descriptor, err := remote.Head(ref, remoteOptions...)
+		if err != nil {
+			return fmt.Errorf("failed resolving digest: %w", err)
+		}
+		digest = descriptor.Digest
+	}
+
+	signatureRef := ref.Context().Tag(fmt.Sprintf("%s-%s%s", digest.Algorithm, digest.Hex, CosignSignatureTagSuffix))
+	signatureImage, err := remote.Image(signatureRef, remoteOptions...)
+	if err != nil {
+		return fmt.Errorf("failed fetching signatures from %s: %w", signatureRef, err)
+	}
+
+	manifest, err := signatureImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("failed reading signature manifest: %w", err)
+	}
+
+	var errs []error
+	for _, layer := range manifest.Layers {
+		err := v.verifyLayer(signatureImage, layer, digest)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return errors.New("no signatures found")
+	}
+	return fmt.Errorf("no valid signature found: %w", errors.Join(errs...))
+}
+
+func (v *CosignVerifier) verifyLayer(signatureImage v1.Image, descriptor v1.Descriptor, digest v1.Hash) error {
+	signature, err := base64.StdEncoding.DecodeString(descriptor.Annotations[CosignAnnotationSignature])
+	if err != nil || len(signature) == 0 {
+		return fmt.Errorf("layer %s does not contain a valid signature annotation", descriptor.Digest)
+	}
+
+	layer, err := signatureImage.LayerByDigest(descriptor.Digest)
+	if err != nil {
+		return fmt.Errorf("failed reading layer %s: %w", descriptor.Digest, err)
+	}
+
+	reader, err := layer.Compressed()
+	if err != nil {
+		return fmt.Errorf("failed reading layer %s: %w", descriptor.Digest, err)
+	}
+	defer reader.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(reader, maxSignaturePayloadSize))
+	if err != nil {
+		return fmt.Errorf("failed reading layer %s: %w", descriptor.Digest, err)
+	}
+
+	if err := v.verifyPayloadSignature(payload, signature, descriptor.Annotations); err != nil {
+		return err
+	}
+
+	return verifyPayloadDigest(payload, digest)
+}
+
+func (v *CosignVerifier) verifyPayloadSignature(payload, signature []byte, annotations map[string]string) error {
+	for _, publicKey := range v.publicKeys {
+		if err := signatureutils.Verify(publicKey, payload, signature); err == nil {
+			return nil
+		}
+	}
+
+	if certificatePEM, ok := annotations[CosignAnnotationCertificate]; ok && v.fulcioRoots != nil {
+		return v.verifyKeylessSignature(payload, signature, certificatePEM, annotations)
+	}
+
+	return signatureutils.ErrInvalidSignature
+}
+
+// simpleSigningPayload is the payload signed by cosign, see
+// https://github.com/containers/image/blob/main/docs/containers-signature.5.md.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+func verifyPayloadDigest(payload []byte, digest v1.Hash) error {
+	var p simpleSigningPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("failed parsing signature payload: %w", err)
+	}
+
+	if !strings.EqualFold(p.Critical.Image.DockerManifestDigest, digest.String()) {
+		return fmt.Errorf("signature payload is for digest %q instead of %q", p.Critical.Image.DockerManifestDigest, digest)
+	}
+
+	return nil
+}
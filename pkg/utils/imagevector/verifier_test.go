@@ -0,0 +1,422 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("CosignVerifier", func() {
+	var (
+		ctx = context.Background()
+
+		server *httptest.Server
+		image  string
+		digest v1.Hash
+
+		key          *ecdsa.PrivateKey
+		publicKeyPEM string
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(registry.New())
+		DeferCleanup(server.Close)
+
+		img, err := random.Image(16, 1)
+		Expect(err).NotTo(HaveOccurred())
+		digest, err = img.Digest()
+		Expect(err).NotTo(HaveOccurred())
+
+		image = strings.TrimPrefix(server.URL, "http://") + "/foo:v1"
+		Expect(remote.Write(mustParseReference(image), img)).To(Succeed())
+
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		publicKeyPEM = encodePublicKey(&key.PublicKey)
+	})
+
+	pushSignedPayload := func(payload, signature []byte, annotations map[string]string) {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[CosignAnnotationSignature] = base64.StdEncoding.EncodeToString(signature)
+
+		signatureImage, err := mutate.Append(empty.Image, mutate.Addendum{
+			Layer:       static.NewLayer(payload, "application/vnd.dev.cosign.simplesigning.v1+json"),
+			Annotations: annotations,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		signatureRef := mustParseReference(image).Context().Tag(fmt.Sprintf("sha256-%s.sig", digest.Hex))
+		Expect(remote.Write(signatureRef, signatureImage)).To(Succeed())
+	}
+
+	pushSignature := func(payload []byte, signer *ecdsa.PrivateKey, annotations map[string]string) {
+		pushSignedPayload(payload, sign(payload, signer), annotations)
+	}
+
+	payloadFor := func(digest v1.Hash) []byte {
+		return []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"foo"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"}}`, digest))
+	}
+
+	Describe("#NewCosignVerifier", func() {
+		var keyless *KeylessOptions
+
+		BeforeEach(func() {
+			_, _, rootPEM := createCertificate(nil, nil, true)
+			keyless = &KeylessOptions{
+				FulcioRoots:     []string{rootPEM},
+				Identities:      []KeylessIdentity{{Issuer: "https://issuer.example.com", Subject: "signer@example.com"}},
+				RekorPublicKeys: []string{publicKeyPEM},
+			}
+		})
+
+		It("should succeed for a complete keyless configuration", func() {
+			_, err := NewCosignVerifier(nil, keyless)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if neither public keys nor Fulcio roots are given", func() {
+			_, err := NewCosignVerifier(nil, &KeylessOptions{})
+			Expect(err).To(MatchError("at least one public key or Fulcio root certificate is required"))
+		})
+
+		It("should fail if a public key is invalid", func() {
+			_, err := NewCosignVerifier([]string{"foo"}, nil)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing public key 0")))
+		})
+
+		It("should fail if a Fulcio root is invalid", func() {
+			keyless.FulcioRoots = []string{"foo"}
+
+			_, err := NewCosignVerifier(nil, keyless)
+			Expect(err).To(MatchError("failed parsing Fulcio root certificate 0"))
+		})
+
+		It("should fail if no identity is given", func() {
+			keyless.Identities = nil
+
+			_, err := NewCosignVerifier(nil, keyless)
+			Expect(err).To(MatchError("at least one identity is required for verifying keyless signatures"))
+		})
+
+		It("should fail if an identity is incomplete", func() {
+			keyless.Identities = append(keyless.Identities, KeylessIdentity{Subject: "signer@example.com"})
+
+			_, err := NewCosignVerifier(nil, keyless)
+			Expect(err).To(MatchError("identity 1 must specify both issuer and subject"))
+		})
+
+		It("should fail if no Rekor public key is given", func() {
+			keyless.RekorPublicKeys = nil
+
+			_, err := NewCosignVerifier(nil, keyless)
+			Expect(err).To(MatchError("at least one Rekor public key is required for verifying keyless signatures"))
+		})
+
+		It("should fail if a Rekor public key is invalid", func() {
+			keyless.RekorPublicKeys = []string{"foo"}
+
+			_, err := NewCosignVerifier(nil, keyless)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing Rekor public key 0")))
+		})
+	})
+
+	Describe("#Verify", func() {
+		Context("with public keys", func() {
+			var verifier *CosignVerifier
+
+			BeforeEach(func() {
+				var err error
+				verifier, err = NewCosignVerifier([]string{publicKeyPEM}, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should verify a signed image referenced by tag", func() {
+				pushSignature(payloadFor(digest), key, nil)
+
+				Expect(verifier.Verify(ctx, image)).To(Succeed())
+			})
+
+			It("should verify a signed image referenced by digest", func() {
+				pushSignature(payloadFor(digest), key, nil)
+
+				Expect(verifier.Verify(ctx, image+"@"+digest.String())).To(Succeed())
+			})
+
+			It("should fail if the image is not signed", func() {
+				Expect(verifier.Verify(ctx, image)).To(MatchError(ContainSubstring("failed fetching signatures")))
+			})
+
+			It("should fail if the image is signed with another key", func() {
+				otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				pushSignature(payloadFor(digest), otherKey, nil)
+
+				Expect(verifier.Verify(ctx, image)).To(MatchError(ContainSubstring("no valid signature found")))
+			})
+
+			It("should fail if the signature payload is for another digest", func() {
+				pushSignature(payloadFor(v1.Hash{Algorithm: "sha256", Hex: strings.Repeat("a", 64)}), key, nil)
+
+				Expect(verifier.Verify(ctx, image)).To(MatchError(ContainSubstring("signature payload is for digest")))
+			})
+		})
+
+		Context("with Fulcio roots", func() {
+			var (
+				rootKey        *ecdsa.PrivateKey
+				root           *x509.Certificate
+				certificate    string
+				rekorKey       *ecdsa.PrivateKey
+				integratedTime time.Time
+				keyless        *KeylessOptions
+			)
+
+			BeforeEach(func() {
+				var rootPEM string
+				rootKey, root, rootPEM = createCertificate(nil, nil, true)
+				_, _, certificate = createCertificate(&key.PublicKey, &certificateIssuer{root, rootKey}, false)
+
+				var err error
+				rekorKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				// The signing certificate was only valid from one hour until 50 minutes ago.
+				integratedTime = time.Now().Add(-55 * time.Minute)
+
+				keyless = &KeylessOptions{
+					FulcioRoots:     []string{rootPEM},
+					Identities:      []KeylessIdentity{{Issuer: "https://issuer.example.com", Subject: "signer@example.com"}},
+					RekorPublicKeys: []string{encodePublicKey(&rekorKey.PublicKey)},
+				}
+			})
+
+			verify := func() error {
+				verifier, err := NewCosignVerifier(nil, keyless)
+				Expect(err).NotTo(HaveOccurred())
+				return verifier.Verify(ctx, image)
+			}
+
+			pushKeylessSignature := func(payload []byte, signer *ecdsa.PrivateKey, certificate string) {
+				signature := sign(payload, signer)
+				pushSignedPayload(payload, signature, map[string]string{
+					CosignAnnotationCertificate: certificate,
+					CosignAnnotationBundle:      rekorBundle(rekorKey, integratedTime, payload, signature, certificate),
+				})
+			}
+
+			It("should verify a keyless signature", func() {
+				pushKeylessSignature(payloadFor(digest), key, certificate)
+
+				Expect(verify()).To(Succeed())
+			})
+
+			It("should fail if the certificate was not issued by a Fulcio root", func() {
+				otherRootKey, otherRoot, _ := createCertificate(nil, nil, true)
+				_, _, otherCertificate := createCertificate(&key.PublicKey, &certificateIssuer{otherRoot, otherRootKey}, false)
+				pushKeylessSignature(payloadFor(digest), key, otherCertificate)
+
+				Expect(verify()).To(MatchError(ContainSubstring("failed verifying signing certificate")))
+			})
+
+			It("should fail if the signature does not match the certificate", func() {
+				otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				pushKeylessSignature(payloadFor(digest), otherKey, certificate)
+
+				Expect(verify()).To(MatchError(ContainSubstring("invalid signature")))
+			})
+
+			It("should fail if the certificate was issued to another subject", func() {
+				keyless.Identities = []KeylessIdentity{{Issuer: "https://issuer.example.com", Subject: "someone@example.com"}}
+				pushKeylessSignature(payloadFor(digest), key, certificate)
+
+				Expect(verify()).To(MatchError(ContainSubstring("does not match any accepted identity")))
+			})
+
+			It("should fail if the signer was authenticated by another issuer", func() {
+				keyless.Identities = []KeylessIdentity{{Issuer: "https://other-issuer.example.com", Subject: "signer@example.com"}}
+				pushKeylessSignature(payloadFor(digest), key, certificate)
+
+				Expect(verify()).To(MatchError(ContainSubstring("does not match any accepted identity")))
+			})
+
+			It("should fail if the signature was not recorded in the transparency log", func() {
+				pushSignature(payloadFor(digest), key, map[string]string{CosignAnnotationCertificate: certificate})
+
+				Expect(verify()).To(MatchError(ContainSubstring("signature does not contain a Rekor bundle")))
+			})
+
+			It("should fail if the bundle was issued by an untrusted transparency log", func() {
+				otherRekorKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				rekorKey = otherRekorKey
+				pushKeylessSignature(payloadFor(digest), key, certificate)
+
+				Expect(verify()).To(MatchError(ContainSubstring("untrusted transparency log")))
+			})
+
+			It("should fail if the signed entry timestamp does not match the bundle", func() {
+				payload := payloadFor(digest)
+				signature := sign(payload, key)
+				bundle := strings.Replace(rekorBundle(rekorKey, integratedTime, payload, signature, certificate),
+					fmt.Sprintf(`"integratedTime":%d`, integratedTime.Unix()), fmt.Sprintf(`"integratedTime":%d`, integratedTime.Unix()+1), 1)
+				pushSignedPayload(payload, signature, map[string]string{CosignAnnotationCertificate: certificate, CosignAnnotationBundle: bundle})
+
+				Expect(verify()).To(MatchError(ContainSubstring("failed verifying signed entry timestamp")))
+			})
+
+			It("should fail if the transparency log entry is for another payload", func() {
+				payload := payloadFor(digest)
+				signature := sign(payload, key)
+				bundle := rekorBundle(rekorKey, integratedTime, []byte("foo"), signature, certificate)
+				pushSignedPayload(payload, signature, map[string]string{CosignAnnotationCertificate: certificate, CosignAnnotationBundle: bundle})
+
+				Expect(verify()).To(MatchError(ContainSubstring("transparency log entry does not match the signature payload")))
+			})
+
+			It("should fail if the signature was recorded after the certificate expired", func() {
+				integratedTime = time.Now()
+				pushKeylessSignature(payloadFor(digest), key, certificate)
+
+				Expect(verify()).To(MatchError(ContainSubstring("failed verifying signing certificate")))
+			})
+		})
+	})
+})
+
+func sign(payload []byte, signer *ecdsa.PrivateKey) []byte {
+	payloadDigest := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, signer, payloadDigest[:])
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return signature
+}
+
+// rekorBundle creates a Rekor bundle for a hashedrekord entry of the given signature which is signed by the given key.
+func rekorBundle(rekorKey *ecdsa.PrivateKey, integratedTime time.Time, payload, signature []byte, certificate string) string {
+	payloadDigest := sha256.Sum256(payload)
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data": map[string]any{"hash": map[string]any{"algorithm": "sha256", "value": hex.EncodeToString(payloadDigest[:])}},
+			"signature": map[string]any{
+				"content":   base64.StdEncoding.EncodeToString(signature),
+				"publicKey": map[string]any{"content": base64.StdEncoding.EncodeToString([]byte(certificate))},
+			},
+		},
+	})
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	der, err := x509.MarshalPKIXPublicKey(&rekorKey.PublicKey)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	logID := sha256.Sum256(der)
+
+	bundlePayload := map[string]any{
+		"body":           base64.StdEncoding.EncodeToString(body),
+		"integratedTime": integratedTime.Unix(),
+		"logID":          hex.EncodeToString(logID[:]),
+		"logIndex":       42,
+	}
+	// Maps are marshalled with sorted keys, which results in the canonical JSON representation signed by Rekor.
+	signedPayload, err := json.Marshal(bundlePayload)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	bundle, err := json.Marshal(map[string]any{
+		"SignedEntryTimestamp": sign(signedPayload, rekorKey),
+		"Payload":              bundlePayload,
+	})
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return string(bundle)
+}
+
+type certificateIssuer struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+// createCertificate creates a self-signed CA certificate if issuer is nil, otherwise a code signing certificate for the
+// given public key.
+func createCertificate(publicKey *ecdsa.PublicKey, issuer *certificateIssuer, isCA bool) (*ecdsa.PrivateKey, *x509.Certificate, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	if publicKey == nil {
+		publicKey = &key.PublicKey
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(-50 * time.Minute),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	if issuer != nil {
+		issuerExtension, err := asn1.MarshalWithParams("https://issuer.example.com", "utf8")
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		template.EmailAddresses = []string{"signer@example.com"}
+		template.ExtraExtensions = []pkix.Extension{{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}, Value: issuerExtension}}
+	}
+	if isCA {
+		template.NotAfter = time.Now().Add(time.Hour)
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	}
+
+	parent, signer := template, key
+	if issuer != nil {
+		parent, signer = issuer.certificate, issuer.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, publicKey, signer)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	certificate, err := x509.ParseCertificate(der)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	return key, certificate, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func encodePublicKey(publicKey any) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func mustParseReference(image string) name.Reference {
+	ref, err := name.ParseReference(image)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return ref
+}
@@ -28,6 +28,24 @@ import (
 	"github.com/gardener/gardener/pkg/utils/retry"
 )
 
+// AnnotationsFunc computes the annotations of the CertificateSigningRequest based on the PEM-encoded certificate request.
+type AnnotationsFunc func(ctx context.Context, certificateRequest []byte) (map[string]string, error)
+
+// RequestOption is an option for RequestCertificate.
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	annotationsFunc AnnotationsFunc
+}
+
+// WithAnnotations returns a RequestOption which sets the annotations computed by the given function on the
+// CertificateSigningRequest.
+func WithAnnotations(fn AnnotationsFunc) RequestOption {
+	return func(o *requestOptions) {
+		o.annotationsFunc = fn
+	}
+}
+
 // RequestCertificate will create a certificate signing request and send it to API server, then it will watch the object's
 // status, once approved, it will return the kube-controller-manager's issued certificate (pem-encoded). If there is any
 // errors, or the watch timeouts, it will return an error.
@@ -40,6 +58,7 @@ func RequestCertificate(
 	ipSANs []net.IP,
 	validityDuration *metav1.Duration,
 	csrPrefix string,
+	opts ...RequestOption,
 ) (
 	[]byte,
 	[]byte,
@@ -55,7 +74,12 @@ func RequestCertificate(
 		return nil, nil, "", fmt.Errorf("error generating client certificate private key: %w", err)
 	}
 
-	certData, csrName, err := requestCertificate(ctx, log, client, privateKeyData, certificateSubject, dnsSANs, ipSANs, validityDuration, csrPrefix)
+	options := &requestOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	certData, csrName, err := requestCertificate(ctx, log, client, privateKeyData, certificateSubject, dnsSANs, ipSANs, validityDuration, csrPrefix, options.annotationsFunc)
 	if err != nil {
 		return nil, nil, "", err
 	}
@@ -117,6 +141,7 @@ func requestCertificate(
 	ipSANs []net.IP,
 	validityDuration *metav1.Duration,
 	csrPrefix string,
+	annotationsFunc AnnotationsFunc,
 ) (
 	certData []byte,
 	csrName string,
@@ -156,7 +181,16 @@ func requestCertificate(
 	log = log.WithValues("certificateSigningRequestName", name)
 	log.Info("Creating certificate signing request")
 
-	reqName, reqUID, err := csrutil.RequestCertificate(client, csrData, name, certificatesv1.KubeAPIServerClientSignerName, requestedDuration, usages, privateKey)
+	var (
+		reqName string
+		reqUID  types.UID
+	)
+
+	if annotationsFunc == nil {
+		reqName, reqUID, err = csrutil.RequestCertificate(client, csrData, name, certificatesv1.KubeAPIServerClientSignerName, requestedDuration, usages, privateKey)
+	} else {
+		reqName, reqUID, err = createWithAnnotations(ctx, client, csrData, name, requestedDuration, usages, annotationsFunc)
+	}
 	if err != nil {
 		return nil, "", err
 	}
@@ -176,6 +210,50 @@ func requestCertificate(
 	return certData, reqName, nil
 }
 
+// createWithAnnotations creates a CertificateSigningRequest like csrutil.RequestCertificate, but additionally sets the
+// annotations computed by the given function. Existing requests are not reused since the annotations might be bound to
+// the certificate request, however, the name is derived from a freshly generated private key anyway.
+func createWithAnnotations(
+	ctx context.Context,
+	client kubernetesclientset.Interface,
+	csrData []byte,
+	name string,
+	requestedDuration *time.Duration,
+	usages []certificatesv1.KeyUsage,
+	annotationsFunc AnnotationsFunc,
+) (
+	string,
+	types.UID,
+	error,
+) {
+	annotations, err := annotationsFunc(ctx, csrData)
+	if err != nil {
+		return "", "", fmt.Errorf("failed computing annotations for certificate signing request: %w", err)
+	}
+
+	csr := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: annotations,
+		},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:    csrData,
+			Usages:     usages,
+			SignerName: certificatesv1.KubeAPIServerClientSignerName,
+		},
+	}
+	if requestedDuration != nil {
+		csr.Spec.ExpirationSeconds = csrutil.DurationToExpirationSeconds(*requestedDuration)
+	}
+
+	csr, err = client.CertificatesV1().CertificateSigningRequests().Create(ctx, csr, metav1.CreateOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("cannot create certificate signing request: %w", err)
+	}
+
+	return csr.Name, csr.UID, nil
+}
+
 // waitForCertificate is heavily inspired from k8s.io/client-go/util/certificate/csr.WaitForCertificate. We don't call
 // this function directly because it performs LIST/WATCH requests while waiting for the certificate. However, gardenlet
 // is only allowed to GET CSR resources related to its seed.
@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto"
 	"crypto/x509/pkix"
+	"errors"
 	"net"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -106,6 +108,46 @@ var _ = Describe("Certificate", func() {
 			Expect(strings.HasPrefix(csrName, csrPrefix)).To(BeTrue())
 		}, NodeTimeout(time.Second*5))
 
+		It("should set the computed annotations on the CSR", func(ctx context.Context) {
+			go func(ctx context.Context) {
+				defer GinkgoRecover()
+				Eventually(func(g Gomega) {
+					csrList, err := clientSet.CertificatesV1().CertificateSigningRequests().List(ctx, metav1.ListOptions{})
+					g.Expect(err).NotTo(HaveOccurred())
+					g.Expect(csrList.Items).To(HaveLen(1))
+					g.Expect(csrList.Items[0].Annotations).To(HaveKeyWithValue("foo", string(csrList.Items[0].Spec.Request)))
+					g.Expect(csrList.Items[0].Spec.ExpirationSeconds).To(PointTo(Equal(int32(3600))))
+
+					timeNow := metav1.Now()
+					condition := certificatesv1.CertificateSigningRequestCondition{
+						Type:               certificatesv1.CertificateApproved,
+						Status:             corev1.ConditionTrue,
+						Reason:             "RequestApproved",
+						LastTransitionTime: timeNow,
+						LastUpdateTime:     timeNow,
+					}
+					handleCSR(ctx, g, clientSet, csrPrefix, condition, expectedCertData)
+				}).Should(Succeed())
+			}(ctx)
+
+			certData, _, _, err := RequestCertificate(ctx, log, clientSet, certificateSubject, dnsSANs, ipSANs, validityDuration, csrPrefix, WithAnnotations(func(_ context.Context, certificateRequest []byte) (map[string]string, error) {
+				return map[string]string{"foo": string(certificateRequest)}, nil
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certData).To(Equal(expectedCertData))
+		}, NodeTimeout(time.Second*5))
+
+		It("should return an error if the annotations cannot be computed", func(ctx context.Context) {
+			_, _, _, err := RequestCertificate(ctx, log, clientSet, certificateSubject, dnsSANs, ipSANs, validityDuration, csrPrefix, WithAnnotations(func(context.Context, []byte) (map[string]string, error) {
+				return nil, errors.New("fake")
+			}))
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+
+			csrList, err := clientSet.CertificatesV1().CertificateSigningRequests().List(ctx, metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(csrList.Items).To(BeEmpty())
+		})
+
 		It("should return an error if the CSR was denied", func(ctx context.Context) {
 			go func(ctx context.Context) {
 				defer GinkgoRecover()
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CELVariableObject is the name of the variable containing the checked object in CEL health check expressions.
+	CELVariableObject = "object"
+
+	// celCostLimit is the maximum runtime cost of evaluating a single health check expression.
+	celCostLimit = 1000000
+)
+
+var newCELEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable(CELVariableObject, cel.DynType),
+		cel.OptionalTypes(),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Sets(),
+	)
+})
+
+// NewCELHealthCheck compiles the given CEL expression and returns a health check function for it. The expression can
+// access the checked object via the `object` variable and must evaluate to true if the object is healthy. If it
+// evaluates to false, the health check fails with the given message.
+func NewCELHealthCheck(expression, message string) (Func, error) {
+	env, err := newCELEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to bool but evaluates to %s", outputType)
+	}
+
+	program, err := env.Program(ast, cel.CostLimit(celCostLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	if message == "" {
+		message = fmt.Sprintf("health check expression %q evaluated to false", expression)
+	}
+
+	return func(obj client.Object) error {
+		unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("failed converting object to unstructured: %w", err)
+		}
+
+		out, _, err := program.Eval(map[string]any{CELVariableObject: unstructuredObj})
+		if err != nil {
+			return fmt.Errorf("failed evaluating health check expression: %w", err)
+		}
+
+		healthy, ok := out.Value().(bool)
+		if !ok {
+			return fmt.Errorf("health check expression must evaluate to bool but evaluated to %s", out.Type().TypeName())
+		}
+		if !healthy {
+			return fmt.Errorf("%s", message)
+		}
+
+		return nil
+	}, nil
+}
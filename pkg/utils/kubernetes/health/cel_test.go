@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+var _ = Describe("CEL", func() {
+	Describe("#NewCELHealthCheck", func() {
+		It("should fail for invalid expressions", func() {
+			_, err := health.NewCELHealthCheck("object.status.", "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for expressions not evaluating to bool", func() {
+			_, err := health.NewCELHealthCheck("'foo'", "")
+			Expect(err).To(MatchError(ContainSubstring("must evaluate to bool")))
+		})
+
+		Context("unstructured objects", func() {
+			var (
+				check health.Func
+				obj   *unstructured.Unstructured
+			)
+
+			BeforeEach(func() {
+				var err error
+				check, err = health.NewCELHealthCheck(`has(object.status) && object.status.state == "Ready"`, "entry is not ready")
+				Expect(err).NotTo(HaveOccurred())
+
+				obj = &unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "dns.gardener.cloud/v1alpha1",
+					"kind":       "DNSEntry",
+				}}
+			})
+
+			It("should succeed if the expression evaluates to true", func() {
+				obj.Object["status"] = map[string]any{"state": "Ready"}
+				Expect(check(obj)).To(Succeed())
+			})
+
+			It("should fail with the configured message if the expression evaluates to false", func() {
+				obj.Object["status"] = map[string]any{"state": "Pending"}
+				Expect(check(obj)).To(MatchError("entry is not ready"))
+			})
+
+			It("should fail if the object does not have the expected fields", func() {
+				Expect(check(obj)).To(MatchError("entry is not ready"))
+			})
+		})
+
+		It("should check typed objects", func() {
+			check, err := health.NewCELHealthCheck(`object.status.phase == "Running"`, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(check(&corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}})).To(Succeed())
+			Expect(check(&corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}})).To(MatchError(ContainSubstring("evaluated to false")))
+		})
+
+		It("should fail if the expression cannot be evaluated", func() {
+			check, err := health.NewCELHealthCheck(`object.status.phase == "Running"`, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(check(&unstructured.Unstructured{Object: map[string]any{}})).To(MatchError(ContainSubstring("failed evaluating")))
+		})
+	})
+})
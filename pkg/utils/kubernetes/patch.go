@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PatchRetryBackoff is the backoff used by PatchWithRetry and PatchStatusWithRetry for retrying conflicts. It is
+// exposed for testing purposes.
+var PatchRetryBackoff = retry.DefaultBackoff
+
+// PatchWithRetry applies the given mutate function to the object and patches it with a merge patch using an optimistic
+// lock, i.e. the patch fails with a conflict if the object was changed in the meantime. On conflicts, the latest
+// version of the object is read, the mutate function is applied again and the patch is retried with exponential
+// backoff (see PatchRetryBackoff). Hence, the mutate function must be idempotent and must not rely on the state of the
+// object before the first call.
+func PatchWithRetry[T client.Object](ctx context.Context, c client.Client, obj T, mutate func(obj T)) error {
+	return patchWithRetry(ctx, c, obj, mutate, func(ctx context.Context, obj client.Object, patch client.Patch) error {
+		return c.Patch(ctx, obj, patch)
+	})
+}
+
+// PatchStatusWithRetry is like PatchWithRetry but patches the status subresource of the object.
+func PatchStatusWithRetry[T client.Object](ctx context.Context, c client.Client, obj T, mutate func(obj T)) error {
+	return patchWithRetry(ctx, c, obj, mutate, func(ctx context.Context, obj client.Object, patch client.Patch) error {
+		return c.Status().Patch(ctx, obj, patch)
+	})
+}
+
+func patchWithRetry[T client.Object](
+	ctx context.Context,
+	reader client.Reader,
+	obj T,
+	mutate func(T),
+	patchFn func(context.Context, client.Object, client.Patch) error,
+) error {
+	var attempt int
+
+	return retry.RetryOnConflict(PatchRetryBackoff, func() error {
+		// The previous attempt failed with a conflict, hence the object has to be read again.
+		if attempt > 0 {
+			if err := reader.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+				return err
+			}
+		}
+		attempt++
+
+		patch := client.MergeFromWithOptions(obj.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+		mutate(obj)
+		return patchFn(ctx, obj, patch)
+	})
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Patch", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+
+		node      *corev1.Node
+		staleNode *corev1.Node
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		DeferCleanup(test.WithVar(&PatchRetryBackoff, wait.Backoff{Steps: 2}))
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		staleNode = node.DeepCopy()
+
+		node.Labels = map[string]string{"foo": "bar"}
+		Expect(fakeClient.Update(ctx, node)).To(Succeed())
+	})
+
+	Describe("#PatchWithRetry", func() {
+		It("should patch the object", func() {
+			Expect(PatchWithRetry(ctx, fakeClient, node, func(node *corev1.Node) {
+				node.Spec.Unschedulable = true
+			})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+		})
+
+		It("should retry conflicts with the latest version of the object", func() {
+			var calls int
+			Expect(PatchWithRetry(ctx, fakeClient, staleNode, func(node *corev1.Node) {
+				calls++
+				metav1.SetMetaDataLabel(&node.ObjectMeta, "baz", "qux")
+			})).To(Succeed())
+
+			Expect(calls).To(Equal(2))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Labels).To(Equal(map[string]string{"foo": "bar", "baz": "qux"}))
+		})
+
+		It("should return the conflict if the retries are exhausted", func() {
+			DeferCleanup(test.WithVar(&PatchRetryBackoff, wait.Backoff{Steps: 1}))
+
+			Expect(PatchWithRetry(ctx, fakeClient, staleNode, func(node *corev1.Node) {
+				node.Spec.Unschedulable = true
+			})).To(BeConflictError())
+		})
+	})
+
+	Describe("#PatchStatusWithRetry", func() {
+		It("should retry conflicts with the latest version of the object", func() {
+			Expect(PatchStatusWithRetry(ctx, fakeClient, staleNode, func(node *corev1.Node) {
+				node.Status.Phase = corev1.NodeRunning
+			})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Phase).To(Equal(corev1.NodeRunning))
+			Expect(node.Labels).To(Equal(map[string]string{"foo": "bar"}))
+		})
+	})
+})
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresources
+
+import (
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// ObjectOption is an option for a single object of a ManagedResource. The options are encoded as annotations on the
+// object which are honored by gardener-resource-manager when it applies the object.
+type ObjectOption func(obj client.Object)
+
+// SkipHealthCheck returns an ObjectOption which excludes the object from the health checks of the ManagedResource.
+func SkipHealthCheck() ObjectOption {
+	return withAnnotation(resourcesv1alpha1.SkipHealthCheck, "true")
+}
+
+// KeepObject returns an ObjectOption which prevents the deletion of the object when it is removed from the
+// ManagedResource or when the ManagedResource is deleted.
+func KeepObject() ObjectOption {
+	return withAnnotation(resourcesv1alpha1.KeepObject, "true")
+}
+
+// DeleteOnInvalidUpdate returns an ObjectOption which makes gardener-resource-manager delete and re-create the object
+// when an update is rejected as invalid, e.g. because immutable fields were changed.
+func DeleteOnInvalidUpdate() ObjectOption {
+	return withAnnotation(resourcesv1alpha1.DeleteOnInvalidUpdate, "true")
+}
+
+// FinalizeDeletionAfter returns an ObjectOption which makes gardener-resource-manager forcefully remove the finalizers
+// of the object if its deletion has not finished after the given duration.
+func FinalizeDeletionAfter(d time.Duration) ObjectOption {
+	return withAnnotation(resourcesv1alpha1.FinalizeDeletionAfter, d.String())
+}
+
+// PreserveReplicas returns an ObjectOption which keeps the current `.spec.replicas` of the workload object during
+// updates.
+func PreserveReplicas() ObjectOption {
+	return withAnnotation(resourcesv1alpha1.PreserveReplicas, "true")
+}
+
+// PreserveResources returns an ObjectOption which keeps the current resource requirements of all containers of the
+// workload object during updates.
+func PreserveResources() ObjectOption {
+	return withAnnotation(resourcesv1alpha1.PreserveResources, "true")
+}
+
+// PreserveFields returns an ObjectOption which keeps the current values of the given field paths (e.g.
+// `spec.minReadySeconds`) of the object during updates. Calling it multiple times adds further paths.
+func PreserveFields(paths ...string) ObjectOption {
+	return func(obj client.Object) {
+		value := strings.Join(paths, ",")
+		if existing := obj.GetAnnotations()[resourcesv1alpha1.PreserveFields]; existing != "" {
+			value = existing + "," + value
+		}
+		withAnnotation(resourcesv1alpha1.PreserveFields, value)(obj)
+	}
+}
+
+// ApplyObjectOptions annotates the given object according to the given options.
+func ApplyObjectOptions(obj client.Object, opts ...ObjectOption) {
+	for _, opt := range opts {
+		opt(obj)
+	}
+}
+
+func withAnnotation(key, value string) ObjectOption {
+	return func(obj client.Object) {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string, 1)
+		}
+		annotations[key] = value
+		obj.SetAnnotations(annotations)
+	}
+}
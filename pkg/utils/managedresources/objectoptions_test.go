@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresources_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+var _ = Describe("ObjectOptions", func() {
+	var deployment *appsv1.Deployment
+
+	BeforeEach(func() {
+		deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name:        "foo",
+			Namespace:   "bar",
+			Annotations: map[string]string{"foo": "bar"},
+		}}
+	})
+
+	Describe("#ApplyObjectOptions", func() {
+		It("should annotate the object according to the options", func() {
+			ApplyObjectOptions(deployment,
+				SkipHealthCheck(),
+				KeepObject(),
+				DeleteOnInvalidUpdate(),
+				FinalizeDeletionAfter(5*time.Minute),
+				PreserveReplicas(),
+				PreserveResources(),
+				PreserveFields("spec.minReadySeconds"),
+				PreserveFields("spec.revisionHistoryLimit", "spec.paused"),
+			)
+
+			Expect(deployment.Annotations).To(Equal(map[string]string{
+				"foo": "bar",
+				"resources.gardener.cloud/skip-health-check":        "true",
+				"resources.gardener.cloud/keep-object":              "true",
+				"resources.gardener.cloud/delete-on-invalid-update": "true",
+				"resources.gardener.cloud/finalize-deletion-after":  "5m0s",
+				"resources.gardener.cloud/preserve-replicas":        "true",
+				"resources.gardener.cloud/preserve-resources":       "true",
+				"resources.gardener.cloud/preserve-fields":          "spec.minReadySeconds,spec.revisionHistoryLimit,spec.paused",
+			}))
+		})
+
+		It("should initialize the annotations if necessary", func() {
+			deployment.Annotations = nil
+
+			ApplyObjectOptions(deployment, SkipHealthCheck())
+
+			Expect(deployment.Annotations).To(Equal(map[string]string{"resources.gardener.cloud/skip-health-check": "true"}))
+		})
+	})
+})
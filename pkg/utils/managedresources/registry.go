@@ -129,6 +129,18 @@ func (r *Registry) Add(objs ...client.Object) error {
 	return nil
 }
 
+// AddWithOptions adds a copy of the given object to the registry which is annotated according to the given
+// options. The given object itself is not modified.
+func (r *Registry) AddWithOptions(obj client.Object, opts ...ObjectOption) error {
+	if obj == nil || reflect.ValueOf(obj) == reflect.Zero(reflect.TypeOf(obj)) {
+		return nil
+	}
+
+	objCopy := obj.DeepCopyObject().(client.Object)
+	ApplyObjectOptions(objCopy, opts...)
+	return r.Add(objCopy)
+}
+
 // AddSerialized adds the provided serialized YAML for the registry.
 // The provided filename is required and determines the internal sorting order.
 func (r *Registry) AddSerialized(filename string, serializationYAML []byte) {
@@ -121,6 +121,24 @@ roleRef:
 		})
 	})
 
+	Describe("#AddWithOptions", func() {
+		It("should add an annotated copy of the object", func() {
+			Expect(registry.AddWithOptions(roleBinding, SkipHealthCheck(), KeepObject())).To(Succeed())
+
+			Expect(roleBinding.Annotations).To(BeEmpty())
+			Expect(registry.RegisteredObjects()).To(ConsistOf(HaveField("ObjectMeta.Annotations", Equal(map[string]string{
+				"resources.gardener.cloud/skip-health-check": "true",
+				"resources.gardener.cloud/keep-object":       "true",
+			}))))
+			Expect(registry.String()).To(ContainSubstring("resources.gardener.cloud/skip-health-check: \"true\""))
+		})
+
+		It("should do nothing because the object is nil", func() {
+			Expect(registry.AddWithOptions(nil, SkipHealthCheck())).To(Succeed())
+			Expect(registry.RegisteredObjects()).To(BeEmpty())
+		})
+	})
+
 	Describe("#SerializedObjects", func() {
 		It("should return the serialized object map", func() {
 			Expect(registry.Add(secret)).To(Succeed())
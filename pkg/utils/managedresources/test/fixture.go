@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package test
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+// ManagedResourceForSeed returns the ManagedResource and its secret containing the given objects exactly as they are
+// created by managedresources.CreateForSeed, i.e. as gardenlet deploys them for the seed's gardener-resource-manager.
+// The objects are serialized with the seed scheme and Brotli-compressed. The returned objects do not have a resource
+// version set, so that they can be created with a (fake) client.
+func ManagedResourceForSeed(namespace, name string, keepObjects bool, objects ...client.Object) (*resourcesv1alpha1.ManagedResource, *corev1.Secret, error) {
+	registry := managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+
+	return build(registry, namespace, name, objects, func(ctx context.Context, c client.Client, data map[string][]byte) error {
+		return managedresources.CreateForSeed(ctx, c, namespace, name, keepObjects, data)
+	})
+}
+
+// ManagedResourceForShoot returns the ManagedResource and its secret containing the given objects exactly as they are
+// created by managedresources.CreateForShoot, i.e. as gardenlet deploys them for the shoot's gardener-resource-manager.
+// The objects are serialized with the shoot scheme and Brotli-compressed. The returned objects do not have a resource
+// version set, so that they can be created with a (fake) client.
+func ManagedResourceForShoot(namespace, name, origin string, keepObjects bool, objects ...client.Object) (*resourcesv1alpha1.ManagedResource, *corev1.Secret, error) {
+	registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+
+	return build(registry, namespace, name, objects, func(ctx context.Context, c client.Client, data map[string][]byte) error {
+		return managedresources.CreateForShoot(ctx, c, namespace, name, origin, keepObjects, data)
+	})
+}
+
+func build(
+	registry *managedresources.Registry,
+	namespace, name string,
+	objects []client.Object,
+	create func(context.Context, client.Client, map[string][]byte) error,
+) (
+	*resourcesv1alpha1.ManagedResource,
+	*corev1.Secret,
+	error,
+) {
+	data, err := registry.AddAllAndSerialize(objects...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed serializing objects: %w", err)
+	}
+
+	var (
+		ctx        = context.Background()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+	)
+
+	if err := create(ctx, fakeClient, data); err != nil {
+		return nil, nil, err
+	}
+
+	managedResource := &resourcesv1alpha1.ManagedResource{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, managedResource); err != nil {
+		return nil, nil, fmt.Errorf("failed reading ManagedResource: %w", err)
+	}
+
+	if len(managedResource.Spec.SecretRefs) != 1 {
+		return nil, nil, fmt.Errorf("expected exactly one secret reference in ManagedResource, got %d", len(managedResource.Spec.SecretRefs))
+	}
+
+	secret := &corev1.Secret{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret); err != nil {
+		return nil, nil, fmt.Errorf("failed reading ManagedResource secret: %w", err)
+	}
+
+	managedResource.ResourceVersion = ""
+	secret.ResourceVersion = ""
+
+	return managedResource, secret, nil
+}
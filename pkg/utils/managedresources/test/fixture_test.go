@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package test_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/managedresources/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Fixture", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		name      = "test"
+	)
+
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		consistOf  func(...client.Object) gomegatypes.GomegaMatcher
+
+		configMap *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		consistOf = NewManagedResourceConsistOfObjectsMatcher(fakeClient)
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "kube-system"},
+			Data:       map[string]string{"foo": "bar"},
+		}
+	})
+
+	Describe("#ManagedResourceForSeed", func() {
+		It("should return the ManagedResource and secret as created for the seed", func() {
+			managedResource, secret, err := ManagedResourceForSeed(namespace, name, false, configMap)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(managedResource.Spec.Class).To(Equal(ptr.To("seed")))
+			Expect(managedResource.Spec.KeepObjects).To(Equal(ptr.To(false)))
+			Expect(managedResource.Spec.SecretRefs).To(ConsistOf(corev1.LocalObjectReference{Name: secret.Name}))
+			Expect(secret.Immutable).To(Equal(ptr.To(true)))
+			Expect(secret.Name).To(HavePrefix("managedresource-" + name))
+
+			Expect(fakeClient.Create(ctx, managedResource)).To(Succeed())
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+			Expect(managedResource).To(consistOf(configMap))
+		})
+	})
+
+	Describe("#ManagedResourceForShoot", func() {
+		It("should return the ManagedResource and secret as created for the shoot", func() {
+			managedResource, secret, err := ManagedResourceForShoot(namespace, name, "gardener", true, configMap)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(managedResource.Spec.Class).To(BeNil())
+			Expect(managedResource.Labels).To(HaveKeyWithValue("origin", "gardener"))
+			Expect(managedResource.Spec.KeepObjects).To(Equal(ptr.To(true)))
+			Expect(managedResource.Spec.InjectLabels).To(HaveKeyWithValue("shoot.gardener.cloud/no-cleanup", "true"))
+
+			Expect(fakeClient.Create(ctx, managedResource)).To(Succeed())
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+			Expect(managedResource).To(consistOf(configMap))
+		})
+	})
+})
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package signature_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/signature"
+)
+
+var _ = Describe("Signature", func() {
+	var (
+		data   = []byte("data")
+		digest = sha256.Sum256(data)
+	)
+
+	Describe("#ParsePublicKey", func() {
+		It("should parse a PEM-encoded public key", func() {
+			publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			der, err := x509.MarshalPKIXPublicKey(publicKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))).To(Equal(publicKey))
+		})
+
+		It("should fail if the data is not PEM-encoded", func() {
+			_, err := ParsePublicKey([]byte("foo"))
+			Expect(err).To(MatchError("no PEM-encoded public key found"))
+		})
+
+		It("should fail if the public key cannot be parsed", func() {
+			_, err := ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("foo")}))
+			Expect(err).To(MatchError(ContainSubstring("failed parsing public key")))
+		})
+	})
+
+	Describe("#Verify", func() {
+		It("should verify RSA signatures", func() {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Verify(&key.PublicKey, data, signature)).To(Succeed())
+			Expect(Verify(&key.PublicKey, []byte("tampered"), signature)).To(MatchError(ErrInvalidSignature))
+		})
+
+		It("should verify ECDSA signatures", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Verify(&key.PublicKey, data, signature)).To(Succeed())
+			Expect(Verify(&key.PublicKey, []byte("tampered"), signature)).To(MatchError(ErrInvalidSignature))
+		})
+
+		It("should verify Ed25519 signatures", func() {
+			publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			signature := ed25519.Sign(privateKey, data)
+
+			Expect(Verify(publicKey, data, signature)).To(Succeed())
+			Expect(Verify(publicKey, []byte("tampered"), signature)).To(MatchError(ErrInvalidSignature))
+		})
+
+		It("should fail for unsupported public keys", func() {
+			Expect(Verify("foo", data, nil)).To(MatchError("unsupported public key type string"))
+		})
+	})
+})
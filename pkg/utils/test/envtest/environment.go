@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package envtest
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+// DefaultKubernetesVersion is the Kubernetes version which is reported to charts rendered in a ComponentEnvironment if
+// no other version is configured.
+const DefaultKubernetesVersion = "1.33.0"
+
+// ComponentEnvironment wraps envtest.Environment with the seed CRDs of Gardener and the CRDs of istio pre-installed.
+// Additionally, it constructs the clients needed by botanist components, i.e. a client, a chart renderer with fake
+// capabilities and a chart applier. This allows component tests to deploy components (or their charts) into a real
+// API server and to assert the created objects, e.g. istio Gateways and VirtualServices.
+type ComponentEnvironment struct {
+	*envtest.Environment
+
+	// KubernetesVersion is the Kubernetes version which is reported to charts via `.Capabilities.KubeVersion`. Defaults
+	// to DefaultKubernetesVersion.
+	KubernetesVersion string
+	// AdditionalCRDPaths are paths to further CRDs which are installed besides the Gardener and istio CRDs.
+	AdditionalCRDPaths []string
+
+	// Client is a client for the test environment using the seed scheme. It is set by Start.
+	Client client.Client
+	// ChartRenderer is a chart renderer with fake capabilities for the configured Kubernetes version. It is set by
+	// Start.
+	ChartRenderer chartrenderer.Interface
+	// ChartApplier is a chart applier using the ChartRenderer. It is set by Start.
+	ChartApplier kubernetes.ChartApplier
+}
+
+// Start starts the underlying envtest.Environment and constructs the clients.
+func (e *ComponentEnvironment) Start() (*rest.Config, error) {
+	if e.Environment == nil {
+		e.Environment = &envtest.Environment{}
+	}
+	if e.KubernetesVersion == "" {
+		e.KubernetesVersion = DefaultKubernetesVersion
+	}
+
+	e.CRDInstallOptions.Paths = append(e.CRDInstallOptions.Paths, append(CRDPaths(), e.AdditionalCRDPaths...)...)
+	e.ErrorIfCRDPathMissing = true
+
+	restConfig, err := e.Environment.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	e.Client, err = client.New(restConfig, client.Options{Scheme: kubernetes.SeedScheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client: %w", err)
+	}
+
+	e.ChartRenderer = chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v" + e.KubernetesVersion})
+	e.ChartApplier = kubernetes.NewChartApplier(e.ChartRenderer, kubernetes.NewApplier(e.Client, e.Client.RESTMapper()))
+
+	return restConfig, nil
+}
+
+// CreateManagedResourceObjects creates the objects of the given ManagedResource in the test environment, similar to
+// what gardener-resource-manager would do. This allows asserting that the objects of components which are deployed via
+// ManagedResources are accepted by the API server, e.g. that they are valid according to the schemas of their CRDs.
+func (e *ComponentEnvironment) CreateManagedResourceObjects(ctx context.Context, namespace, name string) ([]client.Object, error) {
+	objects, err := managedresources.GetObjects(ctx, e.Client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objects {
+		if obj.GetNamespace() == "" && isNamespaced(e.Client, obj) {
+			obj.SetNamespace(namespace)
+		}
+
+		if err := e.Client.Create(ctx, obj); err != nil {
+			return nil, fmt.Errorf("failed creating object %s %q of ManagedResource %q: %w", obj.GetObjectKind().GroupVersionKind().Kind, client.ObjectKeyFromObject(obj), client.ObjectKey{Namespace: namespace, Name: name}, err)
+		}
+	}
+
+	return objects, nil
+}
+
+func isNamespaced(c client.Client, obj client.Object) bool {
+	namespaced, err := c.IsObjectNamespaced(obj)
+	return err == nil && namespaced
+}
+
+// CRDPaths returns the paths to the CRDs which are pre-installed in a ComponentEnvironment, i.e. the seed CRDs of
+// Gardener and the CRDs of istio.
+func CRDPaths() []string {
+	repositoryRoot := repositoryRoot()
+
+	return []string{
+		filepath.Join(repositoryRoot, "example", "seed-crds"),
+		filepath.Join(repositoryRoot, "pkg", "component", "networking", "istio", "charts", "istio", "istio-crds", "crd-all.gen.yaml"),
+	}
+}
+
+func repositoryRoot() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "..")
+}
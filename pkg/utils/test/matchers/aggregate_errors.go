@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package matchers
+
+import (
+	"fmt"
+
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+type aggregateErrorMatcher struct {
+	matcher types.GomegaMatcher
+}
+
+func (a *aggregateErrorMatcher) Match(actual any) (success bool, err error) {
+	// is purely nil?
+	if actual == nil {
+		return false, nil
+	}
+
+	actualErr, actualOk := actual.(error)
+	if !actualOk {
+		return false, fmt.Errorf("expected an error-type.  got:\n%s", format.Object(actual, 1))
+	}
+
+	for _, e := range flattenErrors(actualErr) {
+		if success, err := a.matcher.Match(e); err == nil && success {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (a *aggregateErrorMatcher) FailureMessage(actual any) (message string) {
+	return format.Message(actual, "to contain an error matching", a.matcher)
+}
+
+func (a *aggregateErrorMatcher) NegatedFailureMessage(actual any) (message string) {
+	return format.Message(actual, "not to contain an error matching", a.matcher)
+}
+
+// flattenErrors returns the given error and all errors it aggregates recursively.
+func flattenErrors(err error) []error {
+	var nested []error
+
+	switch e := err.(type) {
+	case utilerrors.Aggregate:
+		nested = e.Errors()
+	case interface{ WrappedErrors() []error }:
+		nested = e.WrappedErrors()
+	case interface{ Unwrap() []error }:
+		nested = e.Unwrap()
+	}
+
+	errs := []error{err}
+	for _, n := range nested {
+		errs = append(errs, flattenErrors(n)...)
+	}
+	return errs
+}
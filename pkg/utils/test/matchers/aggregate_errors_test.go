@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package matchers_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ContainErrorMatching", func() {
+	var (
+		notFoundErr = apierrors.NewNotFound(schema.GroupResource{Group: "baz", Resource: "bar"}, "foo")
+		conflictErr = apierrors.NewConflict(schema.GroupResource{Group: "baz", Resource: "bar"}, "foo", fmt.Errorf("got err"))
+		randomErr   = fmt.Errorf("random error")
+	)
+
+	It("should match a single error", func() {
+		Expect(notFoundErr).To(ContainErrorMatching(BeNotFoundError()))
+		Expect(randomErr).NotTo(ContainErrorMatching(BeNotFoundError()))
+	})
+
+	It("should match errors of an aggregate", func() {
+		err := utilerrors.NewAggregate([]error{randomErr, conflictErr})
+
+		Expect(err).To(ContainErrorMatching(BeConflictError()))
+		Expect(err).To(ContainErrorMatching("random error"))
+		Expect(err).NotTo(ContainErrorMatching(BeNotFoundError()))
+	})
+
+	It("should match errors of nested aggregates", func() {
+		err := errors.Join(randomErr, utilerrors.NewAggregate([]error{multierror.Append(nil, notFoundErr)}))
+
+		Expect(err).To(ContainErrorMatching(BeNotFoundError()))
+		Expect(err).To(ContainErrorMatching(randomErr))
+		Expect(err).NotTo(ContainErrorMatching(BeConflictError()))
+	})
+
+	It("should be false when error is nil", func() {
+		Expect(nil).NotTo(ContainErrorMatching(BeNotFoundError()))
+	})
+
+	It("should throw error when actual is not error", func() {
+		success, err := ContainErrorMatching(BeNotFoundError()).Match("not an error")
+
+		Expect(success).Should(BeFalse())
+		Expect(err).Should(HaveOccurred())
+	})
+})
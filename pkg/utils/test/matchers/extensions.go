@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package matchers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+	gomegatypes "github.com/onsi/gomega/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// HaveLastOperation returns a matcher for checking whether an extension object (e.g., a DNSRecord) has a last
+// operation of the given type and state. For example:
+//
+//	Expect(dnsRecord).To(HaveLastOperation(gardencorev1beta1.LastOperationTypeReconcile, gardencorev1beta1.LastOperationStateSucceeded))
+func HaveLastOperation(lastOperationType gardencorev1beta1.LastOperationType, state gardencorev1beta1.LastOperationState) gomegatypes.GomegaMatcher {
+	return &extensionStatusMatcher{
+		description: fmt.Sprintf("to have last operation %s %s", lastOperationType, state),
+		match: func(obj extensionsv1alpha1.Object) bool {
+			lastOperation := obj.GetExtensionStatus().GetLastOperation()
+			return lastOperation != nil && lastOperation.Type == lastOperationType && lastOperation.State == state
+		},
+	}
+}
+
+// HaveLastOperationState returns a matcher for checking whether an extension object has a last operation in the given
+// state, regardless of its type.
+func HaveLastOperationState(state gardencorev1beta1.LastOperationState) gomegatypes.GomegaMatcher {
+	return &extensionStatusMatcher{
+		description: fmt.Sprintf("to have last operation state %s", state),
+		match: func(obj extensionsv1alpha1.Object) bool {
+			lastOperation := obj.GetExtensionStatus().GetLastOperation()
+			return lastOperation != nil && lastOperation.State == state
+		},
+	}
+}
+
+// BeSuccessfullyReconciled returns a matcher for checking whether an extension object has observed its current
+// generation and its last operation succeeded.
+func BeSuccessfullyReconciled() gomegatypes.GomegaMatcher {
+	return &extensionStatusMatcher{
+		description: "to be successfully reconciled",
+		match: func(obj extensionsv1alpha1.Object) bool {
+			status := obj.GetExtensionStatus()
+			lastOperation := status.GetLastOperation()
+			return status.GetObservedGeneration() == obj.GetGeneration() &&
+				lastOperation != nil && lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+		},
+	}
+}
+
+// HaveLastError returns a matcher for checking whether an extension object has a last error whose description contains
+// all the given substrings.
+func HaveLastError(descriptionSubstrings ...string) gomegatypes.GomegaMatcher {
+	description := "to have a last error"
+	if len(descriptionSubstrings) > 0 {
+		description += fmt.Sprintf(" containing %q", descriptionSubstrings)
+	}
+
+	return &extensionStatusMatcher{
+		description: description,
+		match: func(obj extensionsv1alpha1.Object) bool {
+			lastError := obj.GetExtensionStatus().GetLastError()
+			if lastError == nil {
+				return false
+			}
+			for _, substring := range descriptionSubstrings {
+				if !strings.Contains(lastError.Description, substring) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// HaveNoLastError returns a matcher for checking whether an extension object does not have a last error.
+func HaveNoLastError() gomegatypes.GomegaMatcher {
+	return &extensionStatusMatcher{
+		description: "to have no last error",
+		match: func(obj extensionsv1alpha1.Object) bool {
+			return obj.GetExtensionStatus().GetLastError() == nil
+		},
+	}
+}
+
+// HaveExtensionCondition returns a matcher for checking whether an extension object has a condition matching all the
+// given matchers, e.g.:
+//
+//	Expect(extension).To(HaveExtensionCondition(OfType("Foo"), WithStatus(gardencorev1beta1.ConditionTrue)))
+func HaveExtensionCondition(matchers ...gomegatypes.GomegaMatcher) gomegatypes.GomegaMatcher {
+	conditionMatcher := ContainCondition(matchers...)
+
+	return &extensionStatusMatcher{
+		description: "to have a matching condition",
+		match: func(obj extensionsv1alpha1.Object) bool {
+			ok, err := conditionMatcher.Match(obj.GetExtensionStatus().GetConditions())
+			return err == nil && ok
+		},
+	}
+}
+
+// extensionStatusMatcher matches the status of an extension object. In contrast to matching the status fields with
+// generic matchers, the failure messages only contain a summary of the relevant status fields instead of the complete
+// object.
+type extensionStatusMatcher struct {
+	description string
+	match       func(extensionsv1alpha1.Object) bool
+}
+
+func (m *extensionStatusMatcher) Match(actual any) (bool, error) {
+	obj, ok := actual.(extensionsv1alpha1.Object)
+	if !ok {
+		return false, fmt.Errorf("expected an extension object but got %T", actual)
+	}
+
+	return m.match(obj), nil
+}
+
+func (m *extensionStatusMatcher) FailureMessage(actual any) string {
+	return m.message(actual, m.description)
+}
+
+func (m *extensionStatusMatcher) NegatedFailureMessage(actual any) string {
+	return m.message(actual, "not "+m.description)
+}
+
+func (m *extensionStatusMatcher) message(actual any, description string) string {
+	obj, ok := actual.(extensionsv1alpha1.Object)
+	if !ok {
+		return format.Message(actual, description)
+	}
+
+	return fmt.Sprintf("Expected\n%s%T %s\n%s\nbut it has the following status:\n%s",
+		format.Indent, obj, client.ObjectKeyFromObject(obj), description,
+		format.IndentString(summarizeExtensionStatus(obj), 1))
+}
+
+func summarizeExtensionStatus(obj extensionsv1alpha1.Object) string {
+	var (
+		summary strings.Builder
+		status  = obj.GetExtensionStatus()
+	)
+
+	fmt.Fprintf(&summary, "generation: %d, observedGeneration: %d\n", obj.GetGeneration(), status.GetObservedGeneration())
+
+	if lastOperation := status.GetLastOperation(); lastOperation != nil {
+		fmt.Fprintf(&summary, "lastOperation: %s %s (%d%%): %s\n", lastOperation.Type, lastOperation.State, lastOperation.Progress, lastOperation.Description)
+	} else {
+		summary.WriteString("lastOperation: <none>\n")
+	}
+
+	if lastError := status.GetLastError(); lastError != nil {
+		fmt.Fprintf(&summary, "lastError: %s", lastError.Description)
+		if len(lastError.Codes) > 0 {
+			fmt.Fprintf(&summary, " (codes: %v)", lastError.Codes)
+		}
+		summary.WriteString("\n")
+	} else {
+		summary.WriteString("lastError: <none>\n")
+	}
+
+	if conditions := status.GetConditions(); len(conditions) > 0 {
+		summary.WriteString("conditions:\n")
+		for _, condition := range conditions {
+			fmt.Fprintf(&summary, "- %s=%s (%s): %s\n", condition.Type, condition.Status, condition.Reason, condition.Message)
+		}
+	} else {
+		summary.WriteString("conditions: <none>\n")
+	}
+
+	return strings.TrimSuffix(summary.String(), "\n")
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package matchers_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Extension matchers", func() {
+	var dnsRecord *extensionsv1alpha1.DNSRecord
+
+	BeforeEach(func() {
+		dnsRecord = &extensionsv1alpha1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar", Generation: 2},
+			Status: extensionsv1alpha1.DNSRecordStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					ObservedGeneration: 2,
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:        gardencorev1beta1.LastOperationTypeReconcile,
+						State:       gardencorev1beta1.LastOperationStateError,
+						Progress:    50,
+						Description: "Error reconciling DNSRecord",
+					},
+					LastError: &gardencorev1beta1.LastError{
+						Description: "failed to create record: zone not found",
+						Codes:       []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem},
+					},
+					Conditions: []gardencorev1beta1.Condition{
+						{Type: "Ready", Status: gardencorev1beta1.ConditionFalse, Reason: "ZoneNotFound", Message: "zone not found"},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#HaveLastOperation", func() {
+		It("should match the type and state of the last operation", func() {
+			Expect(dnsRecord).To(HaveLastOperation(gardencorev1beta1.LastOperationTypeReconcile, gardencorev1beta1.LastOperationStateError))
+			Expect(dnsRecord).NotTo(HaveLastOperation(gardencorev1beta1.LastOperationTypeDelete, gardencorev1beta1.LastOperationStateError))
+			Expect(dnsRecord).NotTo(HaveLastOperation(gardencorev1beta1.LastOperationTypeReconcile, gardencorev1beta1.LastOperationStateSucceeded))
+		})
+
+		It("should not match if there is no last operation", func() {
+			dnsRecord.Status.LastOperation = nil
+			Expect(dnsRecord).NotTo(HaveLastOperationState(gardencorev1beta1.LastOperationStateError))
+		})
+
+		It("should return a readable failure message", func() {
+			matcher := HaveLastOperationState(gardencorev1beta1.LastOperationStateSucceeded)
+			Expect(matcher.Match(dnsRecord)).To(BeFalse())
+			Expect(matcher.FailureMessage(dnsRecord)).To(Equal(`Expected
+    *v1alpha1.DNSRecord bar/foo
+to have last operation state Succeeded
+but it has the following status:
+    generation: 2, observedGeneration: 2
+    lastOperation: Reconcile Error (50%): Error reconciling DNSRecord
+    lastError: failed to create record: zone not found (codes: [ERR_CONFIGURATION_PROBLEM])
+    conditions:
+    - Ready=False (ZoneNotFound): zone not found`))
+		})
+
+		It("should return an error for non-extension objects", func() {
+			_, err := HaveLastOperationState(gardencorev1beta1.LastOperationStateSucceeded).Match(&corev1.Pod{})
+			Expect(err).To(MatchError("expected an extension object but got *v1.Pod"))
+		})
+	})
+
+	Describe("#BeSuccessfullyReconciled", func() {
+		It("should match if the generation is observed and the last operation succeeded", func() {
+			Expect(dnsRecord).NotTo(BeSuccessfullyReconciled())
+
+			dnsRecord.Status.LastOperation.State = gardencorev1beta1.LastOperationStateSucceeded
+			Expect(dnsRecord).To(BeSuccessfullyReconciled())
+
+			dnsRecord.Generation = 3
+			Expect(dnsRecord).NotTo(BeSuccessfullyReconciled())
+		})
+	})
+
+	Describe("#HaveLastError", func() {
+		It("should match the description of the last error", func() {
+			Expect(dnsRecord).To(HaveLastError())
+			Expect(dnsRecord).To(HaveLastError("failed to create record", "zone not found"))
+			Expect(dnsRecord).NotTo(HaveLastError("quota exceeded"))
+			Expect(dnsRecord).NotTo(HaveNoLastError())
+
+			dnsRecord.Status.LastError = nil
+			Expect(dnsRecord).NotTo(HaveLastError())
+			Expect(dnsRecord).To(HaveNoLastError())
+		})
+	})
+
+	Describe("#HaveExtensionCondition", func() {
+		It("should match the conditions", func() {
+			Expect(dnsRecord).To(HaveExtensionCondition(OfType("Ready"), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("ZoneNotFound")))
+			Expect(dnsRecord).NotTo(HaveExtensionCondition(OfType("Ready"), WithStatus(gardencorev1beta1.ConditionTrue)))
+		})
+	})
+})
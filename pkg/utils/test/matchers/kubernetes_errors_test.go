@@ -157,3 +157,87 @@ var _ = Describe("BeNoMatchError", func() {
 		Expect(err).Should(HaveOccurred())
 	})
 })
+
+var _ = Describe("BeConflictError", func() {
+	It("should be true when error is conflict", func() {
+		err := apierrors.NewConflict(schema.GroupResource{Group: "baz", Resource: "bar"}, "foo", fmt.Errorf("got err"))
+		Expect(err).To(BeConflictError())
+	})
+
+	It("should be false when error is not k8s conflict", func() {
+		err := apierrors.NewResourceExpired("opsie")
+		Expect(err).ToNot(BeConflictError())
+	})
+
+	It("should be false when error is random error", func() {
+		err := fmt.Errorf("not k8s error")
+		Expect(err).ToNot(BeConflictError())
+	})
+
+	It("should be false when error is nil", func() {
+		Expect(nil).ToNot(BeConflictError())
+	})
+
+	It("should throw error when actual is not error", func() {
+		success, err := BeConflictError().Match("not an error")
+
+		Expect(success).Should(BeFalse())
+		Expect(err).Should(HaveOccurred())
+	})
+})
+
+var _ = Describe("BeTooManyRequestsError", func() {
+	It("should be true when error is too many requests", func() {
+		err := apierrors.NewTooManyRequests("slow down", 10)
+		Expect(err).To(BeTooManyRequestsError())
+	})
+
+	It("should be false when error is not k8s too many requests", func() {
+		err := apierrors.NewResourceExpired("opsie")
+		Expect(err).ToNot(BeTooManyRequestsError())
+	})
+
+	It("should be false when error is random error", func() {
+		err := fmt.Errorf("not k8s error")
+		Expect(err).ToNot(BeTooManyRequestsError())
+	})
+
+	It("should be false when error is nil", func() {
+		Expect(nil).ToNot(BeTooManyRequestsError())
+	})
+
+	It("should throw error when actual is not error", func() {
+		success, err := BeTooManyRequestsError().Match("not an error")
+
+		Expect(success).Should(BeFalse())
+		Expect(err).Should(HaveOccurred())
+	})
+})
+
+var _ = Describe("BeServerTimeoutError", func() {
+	It("should be true when error is server timeout", func() {
+		err := apierrors.NewServerTimeout(schema.GroupResource{Group: "baz", Resource: "bar"}, "get", 10)
+		Expect(err).To(BeServerTimeoutError())
+	})
+
+	It("should be false when error is not k8s server timeout", func() {
+		err := apierrors.NewResourceExpired("opsie")
+		Expect(err).ToNot(BeServerTimeoutError())
+	})
+
+	It("should be false when error is random error", func() {
+		err := fmt.Errorf("not k8s error")
+		Expect(err).ToNot(BeServerTimeoutError())
+	})
+
+	It("should be false when error is nil", func() {
+		Expect(nil).ToNot(BeServerTimeoutError())
+	})
+
+	It("should throw error when actual is not error", func() {
+		success, err := BeServerTimeoutError().Match("not an error")
+
+		Expect(success).Should(BeFalse())
+		Expect(err).Should(HaveOccurred())
+	})
+})
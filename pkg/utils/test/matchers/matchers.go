@@ -112,6 +112,42 @@ func BeInvalidError() types.GomegaMatcher {
 	}
 }
 
+// BeConflictError checks if error is a Conflict.
+func BeConflictError() types.GomegaMatcher {
+	return &kubernetesErrors{
+		checkFunc: apierrors.IsConflict,
+		message:   "Conflict",
+	}
+}
+
+// BeTooManyRequestsError checks if error is TooManyRequests.
+func BeTooManyRequestsError() types.GomegaMatcher {
+	return &kubernetesErrors{
+		checkFunc: apierrors.IsTooManyRequests,
+		message:   "TooManyRequests",
+	}
+}
+
+// BeServerTimeoutError checks if error is a ServerTimeout.
+func BeServerTimeoutError() types.GomegaMatcher {
+	return &kubernetesErrors{
+		checkFunc: apierrors.IsServerTimeout,
+		message:   "ServerTimeout",
+	}
+}
+
+// ContainErrorMatching checks if the actual error or any of the errors it aggregates matches the given element, e.g.
+// the errors of an `utilerrors.Aggregate`, of an `errors.Join` or of a `multierror.Error`. Aggregated errors are
+// flattened recursively. The element can either be a matcher or anything accepted by `MatchError`.
+func ContainErrorMatching(element any) types.GomegaMatcher {
+	matcher, ok := element.(types.GomegaMatcher)
+	if !ok {
+		matcher = MatchError(element)
+	}
+
+	return &aggregateErrorMatcher{matcher: matcher}
+}
+
 // ShareSameReferenceAs checks if objects shares the same underlying reference as the passed object.
 // This can be used to check if maps or slices have the same underlying data store.
 // Only objects that work for 'reflect.ValueOf(x).Pointer' can be compared.
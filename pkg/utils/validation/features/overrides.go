@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package features
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/component-base/featuregate"
+
+	"github.com/gardener/gardener/pkg/features"
+)
+
+// ValidateFeatureGateOverrides validates the value of the feature gate overrides annotation (see
+// features.AnnotationOverrides) in the given annotations.
+func ValidateFeatureGateOverrides(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	value, ok := annotations[features.AnnotationOverrides]
+	if !ok {
+		return allErrs
+	}
+	fldPath = fldPath.Key(features.AnnotationOverrides)
+
+	overrides, err := features.ParseOverrides(value)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, value, err.Error()))
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		spec, ok := features.AllFeatureGates[featuregate.Feature(name)]
+		if !ok {
+			allErrs = append(allErrs, field.Invalid(fldPath, name, "unknown feature gate"))
+			continue
+		}
+
+		if spec.LockToDefault && overrides[name] != spec.Default {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("cannot set feature gate %s to %t, feature is locked to %t", name, overrides[name], spec.Default)))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package features_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener/pkg/utils/validation/features"
+)
+
+var _ = Describe("Overrides", func() {
+	Describe("#ValidateFeatureGateOverrides", func() {
+		var fldPath = field.NewPath("metadata", "annotations")
+
+		DescribeTable("should validate the annotation",
+			func(annotations map[string]string, matcher gomegatypes.GomegaMatcher) {
+				Expect(ValidateFeatureGateOverrides(annotations, fldPath)).To(matcher)
+			},
+
+			Entry("no annotation", nil, BeEmpty()),
+			Entry("valid overrides", map[string]string{"features.gardener.cloud/overrides": "DefaultSeccompProfile=true, NewWorkerPoolHash=false"}, BeEmpty()),
+			Entry("locked feature gate set to its default", map[string]string{"features.gardener.cloud/overrides": "DoNotCopyBackupCredentials=true"}, BeEmpty()),
+			Entry("invalid format", map[string]string{"features.gardener.cloud/overrides": "DefaultSeccompProfile"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("metadata.annotations[features.gardener.cloud/overrides]"),
+			})))),
+			Entry("unknown feature gate", map[string]string{"features.gardener.cloud/overrides": "Foo=true"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"BadValue": Equal("Foo"),
+				"Detail":   Equal("unknown feature gate"),
+			})))),
+			Entry("locked feature gate", map[string]string{"features.gardener.cloud/overrides": "DoNotCopyBackupCredentials=false"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Detail": ContainSubstring("feature is locked to true"),
+			})))),
+		)
+	})
+})
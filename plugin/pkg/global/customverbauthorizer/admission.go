@@ -25,6 +25,7 @@ import (
 	"github.com/gardener/gardener/pkg/api/core/helper"
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
@@ -55,6 +56,9 @@ const (
 	// CustomVerbShootMarkSelfHosted is a constant for the custom verb that allows setting the
 	// `.spec.provider.workers[].controlPlane` field in the `Shoot` spec which marks it as 'self-hosted shoot cluster'.
 	CustomVerbShootMarkSelfHosted = "mark-self-hosted"
+	// CustomVerbShootOverrideImages is a constant for the custom verb that allows setting or changing the
+	// `shoot.gardener.cloud/image-overrides` annotation on `Shoot` resources.
+	CustomVerbShootOverrideImages = "override-images"
 )
 
 // Register registers a plugin.
@@ -257,7 +261,13 @@ func (c *CustomVerbAuthorizer) admitShoots(ctx context.Context, a admission.Attr
 	}
 
 	if mustCheckIfShootIsSelfHosted(oldObj, obj) {
-		return c.authorize(ctx, a, CustomVerbShootMarkSelfHosted, "modify .spec.provider.workers[].controlPlane")
+		if err := c.authorize(ctx, a, CustomVerbShootMarkSelfHosted, "modify .spec.provider.workers[].controlPlane"); err != nil {
+			return err
+		}
+	}
+
+	if mustCheckImageOverrides(oldObj, obj) {
+		return c.authorize(ctx, a, CustomVerbShootOverrideImages, "set or modify the "+v1beta1constants.AnnotationShootImageOverrides+" annotation")
 	}
 
 	return nil
@@ -421,6 +431,11 @@ func mustCheckLimits(oldLimits, limits *core.Limits, parentCloudProfile *v1beta1
 		ptr.Deref(limits.MaxNodesTotal, 0) > ptr.Deref(parentCloudProfile.Spec.Limits.MaxNodesTotal, 0)
 }
 
+func mustCheckImageOverrides(oldShoot, shoot *core.Shoot) bool {
+	value, ok := shoot.Annotations[v1beta1constants.AnnotationShootImageOverrides]
+	return ok && value != oldShoot.Annotations[v1beta1constants.AnnotationShootImageOverrides]
+}
+
 func mustCheckIfShootIsSelfHosted(oldShoot, shoot *core.Shoot) bool {
 	return !apiequality.Semantic.DeepEqual(helper.ControlPlaneWorkerPoolForShoot(oldShoot.Spec.Provider.Workers), helper.ControlPlaneWorkerPoolForShoot(shoot.Spec.Provider.Workers))
 }
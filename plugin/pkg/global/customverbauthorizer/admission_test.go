@@ -923,6 +923,70 @@ var _ = Describe("customverbauthorizer", func() {
 					})
 				})
 			})
+
+			Context("override images", func() {
+				BeforeEach(func() {
+					authorizeAttributes.Verb = "override-images"
+				})
+
+				It("should always allow creating a shoot without image overrides", func() {
+					attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should always allow removing the image overrides", func() {
+					oldShoot := shoot.DeepCopy()
+					metav1.SetMetaDataAnnotation(&oldShoot.ObjectMeta, "shoot.gardener.cloud/image-overrides", "kube-apiserver=foo:bar")
+
+					attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should always allow updating a shoot without changing the image overrides", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/image-overrides", "kube-apiserver=foo:bar")
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Purpose = ptr.To(core.ShootPurposeProduction)
+
+					attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				})
+
+				Describe("permissions granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+					})
+
+					It("should allow creating a shoot with image overrides", func() {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/image-overrides", "kube-apiserver=foo:bar")
+
+						attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					})
+
+					It("should allow changing the image overrides", func() {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/image-overrides", "kube-apiserver=foo:bar")
+						oldShoot := shoot.DeepCopy()
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/image-overrides", "kube-apiserver=foo:baz")
+
+						attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					})
+				})
+
+				Describe("permissions not granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+					})
+
+					It("should forbid adding image overrides to an existing shoot", func() {
+						oldShoot := shoot.DeepCopy()
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/image-overrides", "kube-apiserver=foo:bar")
+
+						attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(ctx, attrs, nil)).NotTo(Succeed())
+					})
+				})
+			})
 		})
 	})
 
@@ -329,6 +329,14 @@ func (e *ExtensionValidator) validateShoot(kindToExtensions map[string][]extensi
 		result = multierror.Append(result, err)
 	}
 
+	if spec.DNS != nil && spec.DNS.APIServerRecord != nil && spec.DNS.APIServerRecord.Failover != nil {
+		if primaryProvider := gardencorehelper.FindPrimaryDNSProvider(spec.DNS.Providers); primaryProvider != nil && primaryProvider.Type != nil {
+			if !isCapabilitySupported(kindToExtensions, extensionsv1alpha1.DNSRecordResource, *primaryProvider.Type, gardencorev1beta1.ControllerResourceCapabilityDNSFailover) {
+				result = multierror.Append(result, fmt.Errorf("given Shoot uses DNS provider type which does not support capability %q: %s (%q)", gardencorev1beta1.ControllerResourceCapabilityDNSFailover, field.NewPath("spec", "dns", "apiServerRecord", "failover"), *primaryProvider.Type))
+			}
+		}
+	}
+
 	if workerless {
 		if err := requiredExtensions.areSupportedForWorkerlessShoots(workerlessSupportedExtensionTypes); err != nil {
 			result = multierror.Append(result, err)
@@ -375,9 +383,18 @@ func isExtensionRegistered(kindToExtensions map[string][]extension, extensionKin
 	return nil
 }
 
+// isCapabilitySupported returns true if the primary controller of the given kind/type combination advertises the
+// given capability.
+func isCapabilitySupported(kindToExtensions map[string][]extension, extensionKind, extensionType string, capability gardencorev1beta1.ControllerResourceCapability) bool {
+	return slices.ContainsFunc(kindToExtensions[extensionKind], func(ext extension) bool {
+		return ext.extensionType == extensionType && slices.Contains(ext.capabilities, capability)
+	})
+}
+
 type extension struct {
 	extensionType        string
 	clusterCompatibility []gardencorev1beta1.ClusterType
+	capabilities         []gardencorev1beta1.ControllerResourceCapability
 }
 
 // computeRegisteredPrimaryExtensionKindTypes computes a map that maps the extension kind to the set of types that are
@@ -391,7 +408,7 @@ func computeRegisteredPrimaryExtensionKindTypes(controllerRegistrationList []*ga
 				continue
 			}
 
-			out[resource.Kind] = append(out[resource.Kind], extension{extensionType: resource.Type, clusterCompatibility: resource.ClusterCompatibility})
+			out[resource.Kind] = append(out[resource.Kind], extension{extensionType: resource.Type, clusterCompatibility: resource.ClusterCompatibility, capabilities: resource.Capabilities})
 		}
 	}
 
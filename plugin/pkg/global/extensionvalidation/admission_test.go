@@ -422,6 +422,40 @@ var _ = Describe("ExtensionValidator", func() {
 			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
 		})
 
+		Context("DNS failover", func() {
+			var shootWithFailover *core.Shoot
+
+			BeforeEach(func() {
+				shootWithFailover = shoot.DeepCopy()
+				shootWithFailover.Spec.Extensions = []core.Extension{{Type: "foo1"}, {Type: "foo2"}}
+				shootWithFailover.Spec.DNS = &core.DNS{
+					Providers: []core.DNSProvider{{Type: ptr.To("foo-dns"), Primary: ptr.To(true)}},
+					APIServerRecord: &core.DNSAPIServerRecord{
+						Failover: &core.DNSFailover{Values: []string{"1.2.3.4"}},
+					},
+				}
+				registerAllExtensions()
+			})
+
+			It("should prevent the object from being created because the DNS provider does not support failover", func() {
+				Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(createControllerRegistrationForKindType(extensionsv1alpha1.DNSRecordResource, "foo-dns", true, nil))).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(shootWithFailover, nil, core.Kind("Shoot").WithVersion("version"), shootWithFailover.Namespace, shootWithFailover.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring(`does not support capability "DNSFailover": spec.dns.apiServerRecord.failover ("foo-dns")`)))
+			})
+
+			It("should allow to create the object because the DNS provider supports failover", func() {
+				controllerRegistration := createControllerRegistrationForKindType(extensionsv1alpha1.DNSRecordResource, "foo-dns", true, nil)
+				controllerRegistration.Spec.Resources[0].Capabilities = []gardencorev1beta1.ControllerResourceCapability{gardencorev1beta1.ControllerResourceCapabilityDNSFailover}
+				Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(shootWithFailover, nil, core.Kind("Shoot").WithVersion("version"), shootWithFailover.Namespace, shootWithFailover.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+		})
+
 		Context("Workerless Shoot", func() {
 			It("should prevent the object from being created because the extension type doesn't support workerless Shoots", func() {
 				var (
@@ -14,6 +14,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -193,8 +194,15 @@ func (v *ManagedSeed) Admit(ctx context.Context, a admission.Attributes, _ admis
 
 	var allErrs field.ErrorList
 
+	// Render the referenced seed template into the gardenlet configuration
+	errs, err := v.admitSeedTemplateRef(ctx, managedSeed, field.NewPath("spec", "gardenlet"))
+	if err != nil {
+		return err
+	}
+	allErrs = append(allErrs, errs...)
+
 	// Admit gardenlet against shoot
-	errs, err := v.admitGardenlet(&managedSeed.Spec.Gardenlet, shoot, field.NewPath("spec", "gardenlet"))
+	errs, err = v.admitGardenlet(&managedSeed.Spec.Gardenlet, shoot, field.NewPath("spec", "gardenlet"))
 	if err != nil {
 		return err
 	}
@@ -266,6 +274,53 @@ func (v *ManagedSeed) getShoot(ctx context.Context, namespace, name string) (*ga
 	return shoot, err
 }
 
+// admitSeedTemplateRef renders the seed template of the referenced SeedTemplate (patched with the given overrides) into
+// the seedConfig of the gardenlet configuration. The seedConfig is rendered on every create and update so that
+// ManagedSeeds referencing the same SeedTemplate do not drift apart.
+func (v *ManagedSeed) admitSeedTemplateRef(ctx context.Context, managedSeed *seedmanagement.ManagedSeed, fldPath *field.Path) (field.ErrorList, error) {
+	var (
+		allErrs   field.ErrorList
+		gardenlet = &managedSeed.Spec.Gardenlet
+		ref       = gardenlet.SeedTemplateRef
+	)
+
+	// Do not re-render the seed template for ManagedSeeds in deletion, the referenced SeedTemplate might be gone already.
+	if ref == nil || ref.Name == "" || managedSeed.DeletionTimestamp != nil {
+		return allErrs, nil
+	}
+	refPath := fldPath.Child("seedTemplateRef")
+
+	seedTemplate, err := v.seedManagementClient.SeedmanagementV1alpha1().SeedTemplates().Get(ctx, ref.Name, kubernetesclient.DefaultGetOptions())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return append(allErrs, field.Invalid(refPath.Child("name"), ref.Name, fmt.Sprintf("seed template %s not found", ref.Name))), nil
+		}
+		return allErrs, apierrors.NewInternalError(fmt.Errorf("could not get seed template %s: %v", ref.Name, err))
+	}
+
+	rendered, err := seedmanagementhelper.RenderSeedTemplate(&seedTemplate.Template, ref.Overrides)
+	if err != nil {
+		return append(allErrs, field.Invalid(refPath.Child("overrides"), string(ref.Overrides.Raw), err.Error())), nil
+	}
+
+	if gardenlet.Config == nil {
+		gardenlet.Config = &gardenletconfigv1alpha1.GardenletConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gardenletconfigv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "GardenletConfiguration",
+			},
+		}
+	}
+
+	gardenletConfig, ok := gardenlet.Config.(*gardenletconfigv1alpha1.GardenletConfiguration)
+	if !ok {
+		return allErrs, apierrors.NewInternalError(fmt.Errorf("expected *gardenletconfigv1alpha1.GardenletConfiguration but got %T", gardenlet.Config))
+	}
+	gardenletConfig.SeedConfig = &gardenletconfigv1alpha1.SeedConfig{SeedTemplate: *rendered}
+
+	return allErrs, nil
+}
+
 func (v *ManagedSeed) admitGardenlet(gardenlet *seedmanagement.GardenletConfig, shoot *gardencorev1beta1.Shoot, fldPath *field.Path) (field.ErrorList, error) {
 	var allErrs field.ErrorList
 
@@ -565,6 +565,90 @@ var _ = Describe("ManagedSeed", func() {
 				Expect(err).To(BeInternalServerError())
 				Expect(err).To(MatchError(ContainSubstring("expected *gardenletconfigv1alpha1.GardenletConfiguration but got *v1.Pod")))
 			})
+
+			Context("seed template reference", func() {
+				var seedTemplate *seedmanagementv1alpha1.SeedTemplate
+
+				BeforeEach(func() {
+					seedTemplate = &seedmanagementv1alpha1.SeedTemplate{
+						ObjectMeta: metav1.ObjectMeta{Name: "template"},
+						Template: gardencorev1beta1.SeedTemplate{
+							ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}},
+							Spec: gardencorev1beta1.SeedSpec{
+								Backup: &gardencorev1beta1.Backup{},
+								Settings: &gardencorev1beta1.SeedSettings{
+									VerticalPodAutoscaler: &gardencorev1beta1.SeedSettingVerticalPodAutoscaler{Enabled: true},
+								},
+							},
+						},
+					}
+
+					seedManagementClient.AddReactor("get", "seedtemplates", func(action testing.Action) (bool, runtime.Object, error) {
+						if action.(testing.GetAction).GetName() != seedTemplate.Name {
+							return true, nil, apierrors.NewNotFound(seedmanagementv1alpha1.Resource("seedtemplate"), action.(testing.GetAction).GetName())
+						}
+						return true, seedTemplate, nil
+					})
+
+					managedSeed.Spec.Gardenlet.Config = nil
+					managedSeed.Spec.Gardenlet.SeedTemplateRef = &seedmanagement.SeedTemplateReference{Name: seedTemplate.Name}
+				})
+
+				It("should render the referenced seed template into the gardenlet configuration", func() {
+					managedSeed.Spec.Gardenlet.SeedTemplateRef.Overrides = &runtime.RawExtension{Raw: []byte(`{"metadata":{"labels":{"baz":"qux"}},"spec":{"settings":{"verticalPodAutoscaler":{"enabled":false}}}}`)}
+
+					Expect(admissionHandler.Admit(ctx, getManagedSeedAttributes(managedSeed), nil)).To(Succeed())
+
+					gardenletConfig, ok := managedSeed.Spec.Gardenlet.Config.(*gardenletconfigv1alpha1.GardenletConfiguration)
+					Expect(ok).To(BeTrue())
+					Expect(gardenletConfig.SeedConfig).NotTo(BeNil())
+					Expect(gardenletConfig.SeedConfig.Labels).To(Equal(map[string]string{"foo": "bar", "baz": "qux"}))
+					Expect(gardenletConfig.SeedConfig.Spec.Backup).To(Equal(&gardencorev1beta1.Backup{Provider: provider}))
+					Expect(gardenletConfig.SeedConfig.Spec.Settings.VerticalPodAutoscaler.Enabled).To(BeFalse())
+					Expect(gardenletConfig.SeedConfig.Spec.Provider.Type).To(Equal(provider))
+				})
+
+				It("should overwrite the seed config with the rendered seed template", func() {
+					managedSeed.Spec.Gardenlet.Config = &gardenletconfigv1alpha1.GardenletConfiguration{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: gardenletconfigv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "GardenletConfiguration",
+						},
+						SeedConfig: &gardenletconfigv1alpha1.SeedConfig{
+							SeedTemplate: gardencorev1beta1.SeedTemplate{
+								ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"drifted": "true"}},
+							},
+						},
+					}
+
+					Expect(admissionHandler.Admit(ctx, getManagedSeedAttributes(managedSeed), nil)).To(Succeed())
+
+					gardenletConfig := managedSeed.Spec.Gardenlet.Config.(*gardenletconfigv1alpha1.GardenletConfiguration)
+					Expect(gardenletConfig.SeedConfig.Labels).To(Equal(map[string]string{"foo": "bar"}))
+				})
+
+				It("should forbid the ManagedSeed creation if the SeedTemplate does not exist", func() {
+					managedSeed.Spec.Gardenlet.SeedTemplateRef.Name = "does-not-exist"
+
+					err := admissionHandler.Admit(ctx, getManagedSeedAttributes(managedSeed), nil)
+					Expect(err).To(BeInvalidError())
+					Expect(getErrorList(err)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.gardenlet.seedTemplateRef.name"),
+							"Detail": ContainSubstring("seed template does-not-exist not found"),
+						})),
+					))
+				})
+
+				It("should not render the seed template if the ManagedSeed is in deletion", func() {
+					managedSeed.DeletionTimestamp = &metav1.Time{}
+					managedSeed.Spec.Gardenlet.SeedTemplateRef.Name = "does-not-exist"
+
+					Expect(admissionHandler.Admit(ctx, getManagedSeedAttributes(managedSeed), nil)).To(Succeed())
+					Expect(managedSeed.Spec.Gardenlet.Config).To(BeNil())
+				})
+			})
 		})
 	})
 
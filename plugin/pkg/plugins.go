@@ -51,6 +51,8 @@ const (
 	PluginNameShootExposureClass = "ShootExposureClass"
 	// PluginNameShootManagedSeed is the name of the ShootManagedSeed admission plugin.
 	PluginNameShootManagedSeed = "ShootManagedSeed"
+	// PluginNameShootPurposeProfile is the name of the ShootPurposeProfile admission plugin.
+	PluginNameShootPurposeProfile = "ShootPurposeProfile"
 	// PluginNameShootNodeLocalDNSEnabledByDefault is the name of the ShootNodeLocalDNSEnabledByDefault admission plugin.
 	PluginNameShootNodeLocalDNSEnabledByDefault = "ShootNodeLocalDNSEnabledByDefault"
 	// PluginNameClusterOpenIDConnectPreset is the name of the ClusterOpenIDConnectPreset admission plugin.
@@ -86,6 +88,7 @@ func AllOrderedPluginNames() []string {
 		PluginNameShootManagedSeed,                  // ShootManagedSeed
 		PluginNameShootNodeLocalDNSEnabledByDefault, // ShootNodeLocalDNSEnabledByDefault
 		PluginNameShootDNSRewriting,                 // ShootDNSRewriting
+		PluginNameShootPurposeProfile,               // ShootPurposeProfile
 		PluginNameShootQuotaValidator,               // ShootQuotaValidator
 		PluginNameShootMutator,                      // ShootMutator
 		PluginNameShootValidator,                    // ShootValidator
@@ -38,6 +38,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 )
 
 // Register registers a plugin.
@@ -54,6 +55,7 @@ type MutateShoot struct {
 	cloudProfileLister           gardencorev1beta1listers.CloudProfileLister
 	namespacedCloudProfileLister gardencorev1beta1listers.NamespacedCloudProfileLister
 	seedLister                   gardencorev1beta1listers.SeedLister
+	projectLister                gardencorev1beta1listers.ProjectLister
 	readyFunc                    admission.ReadyFunc
 }
 
@@ -87,11 +89,15 @@ func (m *MutateShoot) SetCoreInformerFactory(f gardencoreinformers.SharedInforme
 	seedInformer := f.Core().V1beta1().Seeds()
 	m.seedLister = seedInformer.Lister()
 
+	projectInformer := f.Core().V1beta1().Projects()
+	m.projectLister = projectInformer.Lister()
+
 	readyFuncs = append(
 		readyFuncs,
 		cloudProfileInformer.Informer().HasSynced,
 		namespacedCloudProfileInformer.Informer().HasSynced,
 		seedInformer.Informer().HasSynced,
+		projectInformer.Informer().HasSynced,
 	)
 }
 
@@ -106,6 +112,9 @@ func (m *MutateShoot) ValidateInitialization() error {
 	if m.seedLister == nil {
 		return errors.New("missing seed lister")
 	}
+	if m.projectLister == nil {
+		return errors.New("missing project lister")
+	}
 	return nil
 }
 
@@ -162,6 +171,14 @@ func (m *MutateShoot) Admit(_ context.Context, a admission.Attributes, _ admissi
 		if len(ptr.Deref(shoot.Spec.CloudProfileName, "")) > 0 && shoot.Spec.CloudProfile != nil {
 			return fmt.Errorf("new shoot can only specify either cloudProfileName or cloudProfile reference")
 		}
+
+		project, err := admissionutils.ProjectForNamespaceFromLister(m.projectLister, shoot.Namespace)
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("could not find referenced project: %w", err))
+		}
+		if err := applyProjectShootDefaults(shoot, project); err != nil {
+			return apierrors.NewInternalError(err)
+		}
 	}
 
 	cloudProfileSpec, err := gardenerutils.GetCloudProfileSpec(m.cloudProfileLister, m.namespacedCloudProfileLister, shoot)
@@ -211,6 +228,63 @@ type mutationContext struct {
 	oldShoot         *core.Shoot
 }
 
+// applyProjectShootDefaults sets the fields of the Shoot which are not specified to the Shoot defaults of the project.
+func applyProjectShootDefaults(shoot *core.Shoot, project *gardencorev1beta1.Project) error {
+	if project.Spec.ShootDefaults == nil {
+		return nil
+	}
+
+	defaults := &core.ProjectShootDefaults{}
+	if err := api.Scheme.Convert(project.Spec.ShootDefaults.DeepCopy(), defaults, nil); err != nil {
+		return fmt.Errorf("failed converting shoot defaults of project %q: %w", project.Name, err)
+	}
+
+	if defaults.Maintenance != nil {
+		if shoot.Spec.Maintenance == nil {
+			shoot.Spec.Maintenance = &core.Maintenance{}
+		}
+		if shoot.Spec.Maintenance.TimeWindow == nil {
+			shoot.Spec.Maintenance.TimeWindow = defaults.Maintenance.TimeWindow
+		}
+		if shoot.Spec.Maintenance.ConfineSpecUpdateRollout == nil {
+			shoot.Spec.Maintenance.ConfineSpecUpdateRollout = defaults.Maintenance.ConfineSpecUpdateRollout
+		}
+	}
+
+	if defaults.Hibernation != nil && len(defaults.Hibernation.Schedules) > 0 {
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &core.Hibernation{}
+		}
+		if len(shoot.Spec.Hibernation.Schedules) == 0 {
+			shoot.Spec.Hibernation.Schedules = defaults.Hibernation.Schedules
+		}
+	}
+
+	if defaults.Workers != nil {
+		for i := range shoot.Spec.Provider.Workers {
+			worker := &shoot.Spec.Provider.Workers[i]
+
+			if worker.Machine.Image == nil && defaults.Workers.MachineImage != nil {
+				worker.Machine.Image = defaults.Workers.MachineImage.DeepCopy()
+			}
+			if worker.Volume == nil && defaults.Workers.Volume != nil {
+				worker.Volume = defaults.Workers.Volume.DeepCopy()
+			}
+			for key, value := range defaults.Workers.Labels {
+				if _, ok := worker.Labels[key]; ok {
+					continue
+				}
+				if worker.Labels == nil {
+					worker.Labels = make(map[string]string, len(defaults.Workers.Labels))
+				}
+				worker.Labels[key] = value
+			}
+		}
+	}
+
+	return nil
+}
+
 func addCreatedByAnnotation(shoot *core.Shoot, userName string) {
 	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenCreatedBy, userName)
 }
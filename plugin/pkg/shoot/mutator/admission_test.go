@@ -78,6 +78,7 @@ var _ = Describe("mutator", func() {
 			validMachineImageName = "some-machine-image"
 
 			cloudProfile gardencorev1beta1.CloudProfile
+			project      gardencorev1beta1.Project
 			seed         gardencorev1beta1.Seed
 			shoot        core.Shoot
 
@@ -127,6 +128,14 @@ var _ = Describe("mutator", func() {
 					},
 				},
 			}
+			project = gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-project",
+				},
+				Spec: gardencorev1beta1.ProjectSpec{
+					Namespace: ptr.To("garden-my-project"),
+				},
+			}
 			seed = gardencorev1beta1.Seed{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "seed",
@@ -166,6 +175,8 @@ var _ = Describe("mutator", func() {
 			admissionHandler.AssignReadyFunc(func() bool { return true })
 			coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
 			admissionHandler.SetCoreInformerFactory(coreInformerFactory)
+
+			Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
 		})
 
 		It("should ignore a kind other than shoot", func() {
@@ -213,6 +224,15 @@ var _ = Describe("mutator", func() {
 				Expect(err).To(BeInternalServerError())
 			})
 
+			It("should reject because the referenced project was not found", func() {
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Delete(&project)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+				err := admissionHandler.Admit(ctx, attrs, nil)
+				Expect(err).To(BeInternalServerError())
+			})
+
 			It("should exit early if CloudProfile is not set", func() {
 				shoot.Spec.CloudProfileName = nil
 				shoot.Spec.CloudProfile = nil
@@ -475,6 +495,78 @@ var _ = Describe("mutator", func() {
 			)
 		})
 
+		Context("project shoot defaults", func() {
+			BeforeEach(func() {
+				project.Spec.ShootDefaults = &gardencorev1beta1.ProjectShootDefaults{
+					Maintenance: &gardencorev1beta1.ProjectShootMaintenanceDefaults{
+						TimeWindow:               &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+						ConfineSpecUpdateRollout: ptr.To(true),
+					},
+					Hibernation: &gardencorev1beta1.ProjectShootHibernationDefaults{
+						Schedules: []gardencorev1beta1.HibernationSchedule{{Start: ptr.To("00 20 * * 1,2,3,4,5")}},
+					},
+					Workers: &gardencorev1beta1.ProjectShootWorkerDefaults{
+						MachineImage: &gardencorev1beta1.ShootMachineImage{Name: validMachineImageName},
+						Volume:       &gardencorev1beta1.Volume{VolumeSize: "50Gi"},
+						Labels:       map[string]string{"team": "foo", "cost-center": "bar"},
+					},
+				}
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Update(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+
+				shoot.Spec.Provider.Workers[0].Machine.Image = nil
+				shoot.Spec.Provider.Workers[0].Labels = map[string]string{"team": "baz"}
+			})
+
+			It("should apply the project defaults to a new shoot", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+				Expect(shoot.Spec.Maintenance).To(Equal(&core.Maintenance{
+					TimeWindow:               &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+					ConfineSpecUpdateRollout: ptr.To(true),
+				}))
+				Expect(shoot.Spec.Hibernation).To(Equal(&core.Hibernation{
+					Schedules: []core.HibernationSchedule{{Start: ptr.To("00 20 * * 1,2,3,4,5")}},
+				}))
+				Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(&core.ShootMachineImage{Name: validMachineImageName, Version: "0.0.1"}))
+				Expect(shoot.Spec.Provider.Workers[0].Volume).To(Equal(&core.Volume{VolumeSize: "50Gi"}))
+				Expect(shoot.Spec.Provider.Workers[0].Labels).To(Equal(map[string]string{"team": "baz", "cost-center": "bar"}))
+			})
+
+			It("should not overwrite fields specified in the shoot", func() {
+				shoot.Spec.Maintenance = &core.Maintenance{
+					TimeWindow:               &core.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"},
+					ConfineSpecUpdateRollout: ptr.To(false),
+				}
+				shoot.Spec.Hibernation = &core.Hibernation{Schedules: []core.HibernationSchedule{{End: ptr.To("00 08 * * 1")}}}
+				shoot.Spec.Provider.Workers[0].Volume = &core.Volume{VolumeSize: "20Gi"}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+				Expect(shoot.Spec.Maintenance).To(Equal(&core.Maintenance{
+					TimeWindow:               &core.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"},
+					ConfineSpecUpdateRollout: ptr.To(false),
+				}))
+				Expect(shoot.Spec.Hibernation.Schedules).To(Equal([]core.HibernationSchedule{{End: ptr.To("00 08 * * 1")}}))
+				Expect(shoot.Spec.Provider.Workers[0].Volume).To(Equal(&core.Volume{VolumeSize: "20Gi"}))
+			})
+
+			It("should not apply the project defaults to an existing shoot", func() {
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.Provider.Workers[0].Machine.Image = &core.ShootMachineImage{Name: validMachineImageName, Version: "0.0.1"}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+				Expect(shoot.Spec.Maintenance).To(BeNil())
+				Expect(shoot.Spec.Hibernation).To(BeNil())
+				Expect(shoot.Spec.Provider.Workers[0].Volume).To(BeNil())
+				Expect(shoot.Spec.Provider.Workers[0].Labels).To(Equal(map[string]string{"team": "baz"}))
+			})
+		})
+
 		Context("networking settings", func() {
 			var (
 				podsCIDR     = "100.96.0.0/11"
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package purposeprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/prometheus/common/model"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile/validation"
+)
+
+// defaultMonitoringRetention is the retention of the monitoring data used by gardenlet if a shoot does not specify one.
+const defaultMonitoringRetention = 30 * 24 * time.Hour
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootPurposeProfile, func(config io.Reader) (admission.Interface, error) {
+		cfg, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validation.ValidateConfiguration(cfg); err != nil {
+			return nil, fmt.Errorf("invalid config: %+v", err)
+		}
+
+		return New(cfg.Profiles), nil
+	})
+}
+
+// PurposeProfile contains required information to process admission requests.
+type PurposeProfile struct {
+	*admission.Handler
+
+	profiles map[core.ShootPurpose]shootpurposeprofile.PurposeProfile
+}
+
+// New creates a new ShootPurposeProfile admission plugin.
+func New(profiles []shootpurposeprofile.PurposeProfile) *PurposeProfile {
+	profilesByPurpose := make(map[core.ShootPurpose]shootpurposeprofile.PurposeProfile, len(profiles))
+	for _, profile := range profiles {
+		profilesByPurpose[profile.Purpose] = profile
+	}
+
+	return &PurposeProfile{
+		Handler:  admission.NewHandler(admission.Create, admission.Update),
+		profiles: profilesByPurpose,
+	}
+}
+
+var (
+	_ admission.MutationInterface   = (*PurposeProfile)(nil)
+	_ admission.ValidationInterface = (*PurposeProfile)(nil)
+)
+
+// Admit defaults the settings configured in the profile for the purpose of new shoot clusters.
+func (p *PurposeProfile) Admit(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	switch {
+	case a.GetKind().GroupKind() != core.Kind("Shoot"),
+		a.GetOperation() != admission.Create,
+		a.GetSubresource() != "":
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("could not convert resource into Shoot object"))
+	}
+
+	profile, ok := p.profiles[purposeOf(shoot)]
+	if !ok || profile.Defaults == nil {
+		return nil
+	}
+
+	if profile.Defaults.HighAvailabilityFailureToleranceType != nil && (shoot.Spec.ControlPlane == nil || shoot.Spec.ControlPlane.HighAvailability == nil) {
+		if shoot.Spec.ControlPlane == nil {
+			shoot.Spec.ControlPlane = &core.ControlPlane{}
+		}
+		shoot.Spec.ControlPlane.HighAvailability = &core.HighAvailability{
+			FailureTolerance: core.FailureTolerance{Type: *profile.Defaults.HighAvailabilityFailureToleranceType},
+		}
+	}
+
+	if profile.Defaults.MonitoringRetention != nil {
+		if _, ok := shoot.Annotations[v1beta1constants.AnnotationShootMonitoringRetention]; !ok {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootMonitoringRetention, model.Duration(profile.Defaults.MonitoringRetention.Duration).String())
+		}
+	}
+
+	return nil
+}
+
+// Validate enforces the requirements configured in the profile for the purpose of shoot clusters.
+func (p *PurposeProfile) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	switch {
+	case a.GetKind().GroupKind() != core.Kind("Shoot"),
+		a.GetSubresource() != "":
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("could not convert resource into Shoot object"))
+	}
+
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok := a.GetOldObject().(*core.Shoot)
+		if !ok {
+			return apierrors.NewInternalError(errors.New("could not convert old resource into Shoot object"))
+		}
+
+		// Do not block updates of shoots in deletion or updates which do not touch any of the enforced settings, so that
+		// existing shoots violating a newly configured profile can still be operated.
+		if shoot.DeletionTimestamp != nil || !enforcedSettingsChanged(oldShoot, shoot) {
+			return nil
+		}
+	}
+
+	purpose := purposeOf(shoot)
+	profile, ok := p.profiles[purpose]
+	if !ok || profile.Requirements == nil {
+		return nil
+	}
+
+	if allErrs := validateRequirements(shoot, profile.Requirements); len(allErrs) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("shoot violates the profile for purpose %q: %w", purpose, allErrs.ToAggregate()))
+	}
+
+	return nil
+}
+
+func validateRequirements(shoot *core.Shoot, requirements *shootpurposeprofile.PurposeProfileRequirements) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if requirements.HighAvailability && (shoot.Spec.ControlPlane == nil || shoot.Spec.ControlPlane.HighAvailability == nil) {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "controlPlane", "highAvailability"), "control plane must be highly available"))
+	}
+
+	if requirements.HibernationForbidden && shoot.Spec.Hibernation != nil {
+		hibernationPath := field.NewPath("spec", "hibernation")
+
+		if ptr.Deref(shoot.Spec.Hibernation.Enabled, false) {
+			allErrs = append(allErrs, field.Forbidden(hibernationPath.Child("enabled"), "hibernation is not allowed"))
+		}
+
+		if len(shoot.Spec.Hibernation.Schedules) > 0 {
+			allErrs = append(allErrs, field.Forbidden(hibernationPath.Child("schedules"), "hibernation schedules are not allowed"))
+		}
+	}
+
+	if requirements.MaxMonitoringRetention != nil {
+		maxRetention := requirements.MaxMonitoringRetention.Duration
+		retentionPath := field.NewPath("metadata", "annotations").Key(v1beta1constants.AnnotationShootMonitoringRetention)
+
+		if value, ok := shoot.Annotations[v1beta1constants.AnnotationShootMonitoringRetention]; !ok {
+			if defaultMonitoringRetention > maxRetention {
+				allErrs = append(allErrs, field.Required(retentionPath, fmt.Sprintf("monitoring retention must be set to at most %s since the default retention of %s exceeds the maximum", model.Duration(maxRetention), model.Duration(defaultMonitoringRetention))))
+			}
+		} else if retention, err := gardenerutils.ParseMonitoringRetention(value); err == nil && retention > maxRetention {
+			allErrs = append(allErrs, field.Invalid(retentionPath, value, fmt.Sprintf("monitoring retention must not exceed %s", model.Duration(maxRetention))))
+		}
+	}
+
+	return allErrs
+}
+
+func enforcedSettingsChanged(oldShoot, newShoot *core.Shoot) bool {
+	return !apiequality.Semantic.DeepEqual(oldShoot.Spec.Purpose, newShoot.Spec.Purpose) ||
+		!apiequality.Semantic.DeepEqual(oldShoot.Spec.ControlPlane, newShoot.Spec.ControlPlane) ||
+		!apiequality.Semantic.DeepEqual(oldShoot.Spec.Hibernation, newShoot.Spec.Hibernation) ||
+		oldShoot.Annotations[v1beta1constants.AnnotationShootMonitoringRetention] != newShoot.Annotations[v1beta1constants.AnnotationShootMonitoringRetention]
+}
+
+func purposeOf(shoot *core.Shoot) core.ShootPurpose {
+	return ptr.Deref(shoot.Spec.Purpose, core.ShootPurposeEvaluation)
+}
@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package purposeprofile_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile"
+)
+
+var _ = Describe("ShootPurposeProfile", func() {
+	var (
+		ctx      context.Context
+		plugin   *purposeprofile.PurposeProfile
+		attrs    admission.Attributes
+		userInfo *user.DefaultInfo
+
+		shoot, expectedShoot *core.Shoot
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		plugin = purposeprofile.New([]shootpurposeprofile.PurposeProfile{
+			{
+				Purpose: core.ShootPurposeProduction,
+				Defaults: &shootpurposeprofile.PurposeProfileDefaults{
+					HighAvailabilityFailureToleranceType: ptr.To(core.FailureToleranceTypeZone),
+					MonitoringRetention:                  &metav1.Duration{Duration: 14 * 24 * time.Hour},
+				},
+				Requirements: &shootpurposeprofile.PurposeProfileRequirements{
+					HighAvailability:     true,
+					HibernationForbidden: true,
+				},
+			},
+			{
+				Purpose: core.ShootPurposeEvaluation,
+				Requirements: &shootpurposeprofile.PurposeProfileRequirements{
+					MaxMonitoringRetention: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+				},
+			},
+		})
+
+		userInfo = &user.DefaultInfo{Name: "foo"}
+
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec:       core.ShootSpec{Purpose: ptr.To(core.ShootPurposeProduction)},
+		}
+		expectedShoot = shoot.DeepCopy()
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			purposeprofile.Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootPurposeProfile"))
+		})
+	})
+
+	Describe("#Handles", func() {
+		It("should only handle CREATE and UPDATE operations", func() {
+			Expect(plugin.Handles(admission.Create)).To(BeTrue())
+			Expect(plugin.Handles(admission.Update)).To(BeTrue())
+			Expect(plugin.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(plugin.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#Admit", func() {
+		Context("ignored requests", func() {
+			It("should ignore resources other than Shoot", func() {
+				project := &core.Project{}
+				attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should ignore operations other than Create", func() {
+				attrs = admission.NewAttributesRecord(shoot, shoot.DeepCopy(), core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+				Expect(shoot).To(Equal(expectedShoot))
+			})
+
+			It("should ignore subresources", func() {
+				attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "status", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+				Expect(shoot).To(Equal(expectedShoot))
+			})
+
+			It("should ignore shoots with purposes without profile", func() {
+				shoot.Spec.Purpose = ptr.To(core.ShootPurposeTesting)
+				expectedShoot = shoot.DeepCopy()
+
+				attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+				Expect(shoot).To(Equal(expectedShoot))
+			})
+		})
+
+		It("should fail, if object is not a shoot", func() {
+			attrs = admission.NewAttributesRecord(&core.Project{}, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			err := plugin.Admit(ctx, attrs, nil)
+			Expect(err).To(BeInternalServerError())
+			Expect(err).To(MatchError(ContainSubstring("could not convert")))
+		})
+
+		It("should default the settings of the profile", func() {
+			expectedShoot.Annotations = map[string]string{"shoot.gardener.cloud/monitoring-retention": "2w"}
+			expectedShoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}}}
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+			Expect(shoot).To(Equal(expectedShoot))
+		})
+
+		It("should not overwrite settings specified by the shoot", func() {
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/monitoring-retention": "3d"}
+			shoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeNode}}}
+			expectedShoot = shoot.DeepCopy()
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+			Expect(shoot).To(Equal(expectedShoot))
+		})
+	})
+
+	Describe("#Validate", func() {
+		BeforeEach(func() {
+			shoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}}}
+		})
+
+		It("should allow shoots fulfilling the requirements", func() {
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			Expect(plugin.Validate(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should forbid shoots without highly available control plane", func() {
+			shoot.Spec.ControlPlane = nil
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			err := plugin.Validate(ctx, attrs, nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(ContainSubstring(`shoot violates the profile for purpose "production": spec.controlPlane.highAvailability: Required value: control plane must be highly available`)))
+		})
+
+		It("should forbid hibernating shoots", func() {
+			shoot.Spec.Hibernation = &core.Hibernation{
+				Enabled:   ptr.To(true),
+				Schedules: []core.HibernationSchedule{{Start: ptr.To("00 20 * * 1,2,3,4,5")}},
+			}
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			err := plugin.Validate(ctx, attrs, nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(And(
+				ContainSubstring("spec.hibernation.enabled: Forbidden: hibernation is not allowed"),
+				ContainSubstring("spec.hibernation.schedules: Forbidden: hibernation schedules are not allowed"),
+			)))
+		})
+
+		It("should allow waking up shoots", func() {
+			shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(false)}
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			Expect(plugin.Validate(ctx, attrs, nil)).To(Succeed())
+		})
+
+		Context("monitoring retention", func() {
+			BeforeEach(func() {
+				shoot.Spec.Purpose = ptr.To(core.ShootPurposeEvaluation)
+			})
+
+			It("should allow retentions up to the maximum", func() {
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/monitoring-retention": "7d"}
+
+				attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(plugin.Validate(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should forbid retentions exceeding the maximum", func() {
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/monitoring-retention": "8d"}
+
+				attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := plugin.Validate(ctx, attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("monitoring retention must not exceed 1w")))
+			})
+
+			It("should require a retention if the default retention exceeds the maximum", func() {
+				attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := plugin.Validate(ctx, attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("monitoring retention must be set to at most 1w since the default retention of 30d exceeds the maximum")))
+			})
+		})
+
+		Context("update", func() {
+			var oldShoot *core.Shoot
+
+			BeforeEach(func() {
+				shoot.Spec.ControlPlane = nil
+				oldShoot = shoot.DeepCopy()
+			})
+
+			It("should allow updates which do not change the enforced settings", func() {
+				shoot.Labels = map[string]string{"foo": "bar"}
+
+				attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(plugin.Validate(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should allow updates of shoots in deletion", func() {
+				shoot.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
+
+				attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(plugin.Validate(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should forbid updates which change the enforced settings", func() {
+				oldShoot.Spec.Purpose = ptr.To(core.ShootPurposeDevelopment)
+
+				attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(plugin.Validate(ctx, attrs, nil)).To(BeForbiddenError())
+			})
+		})
+	})
+})
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootpurposeprofile
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootPurposeProfile admission controller.
+type Configuration struct {
+	metav1.TypeMeta
+
+	// Profiles is a list of profiles which are applied to shoots depending on their purpose.
+	Profiles []PurposeProfile
+}
+
+// PurposeProfile contains the settings which are defaulted and enforced for shoots with a specific purpose.
+type PurposeProfile struct {
+	// Purpose is the purpose of the shoots this profile applies to.
+	Purpose core.ShootPurpose
+	// Defaults contains the settings which are defaulted for new shoots with this purpose.
+	Defaults *PurposeProfileDefaults
+	// Requirements contains the settings which are enforced for shoots with this purpose.
+	Requirements *PurposeProfileRequirements
+}
+
+// PurposeProfileDefaults contains the settings which are defaulted for new shoots.
+type PurposeProfileDefaults struct {
+	// HighAvailabilityFailureToleranceType is the failure tolerance type of the control plane which is defaulted if the
+	// shoot does not configure a highly available control plane.
+	HighAvailabilityFailureToleranceType *core.FailureToleranceType
+	// MonitoringRetention is the retention of the shoot's monitoring data which is defaulted if the shoot does not
+	// specify one.
+	MonitoringRetention *metav1.Duration
+}
+
+// PurposeProfileRequirements contains the settings which are enforced for shoots.
+type PurposeProfileRequirements struct {
+	// HighAvailability specifies whether the control plane of the shoot must be highly available.
+	HighAvailability bool
+	// MaxMonitoringRetention is the maximum retention of the shoot's monitoring data.
+	MaxMonitoringRetention *metav1.Duration
+	// HibernationForbidden specifies whether hibernating the shoot is forbidden, i.e., whether enabling the hibernation
+	// or configuring hibernation schedules is rejected.
+	HibernationForbidden bool
+}
@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile
+// +k8s:defaulter-gen=TypeMeta
+// +groupName=shootpurposeprofile.admission.gardener.cloud
+
+package v1alpha1 // import "github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile/v1alpha1"
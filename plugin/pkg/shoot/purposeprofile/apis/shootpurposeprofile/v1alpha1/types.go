@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootPurposeProfile admission controller.
+type Configuration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Profiles is a list of profiles which are applied to shoots depending on their purpose.
+	Profiles []PurposeProfile `json:"profiles,omitempty"`
+}
+
+// PurposeProfile contains the settings which are defaulted and enforced for shoots with a specific purpose.
+type PurposeProfile struct {
+	// Purpose is the purpose of the shoots this profile applies to.
+	Purpose gardencorev1beta1.ShootPurpose `json:"purpose"`
+	// Defaults contains the settings which are defaulted for new shoots with this purpose.
+	// +optional
+	Defaults *PurposeProfileDefaults `json:"defaults,omitempty"`
+	// Requirements contains the settings which are enforced for shoots with this purpose.
+	// +optional
+	Requirements *PurposeProfileRequirements `json:"requirements,omitempty"`
+}
+
+// PurposeProfileDefaults contains the settings which are defaulted for new shoots.
+type PurposeProfileDefaults struct {
+	// HighAvailabilityFailureToleranceType is the failure tolerance type of the control plane which is defaulted if the
+	// shoot does not configure a highly available control plane.
+	// +optional
+	HighAvailabilityFailureToleranceType *gardencorev1beta1.FailureToleranceType `json:"highAvailabilityFailureToleranceType,omitempty"`
+	// MonitoringRetention is the retention of the shoot's monitoring data which is defaulted if the shoot does not
+	// specify one.
+	// +optional
+	MonitoringRetention *metav1.Duration `json:"monitoringRetention,omitempty"`
+}
+
+// PurposeProfileRequirements contains the settings which are enforced for shoots.
+type PurposeProfileRequirements struct {
+	// HighAvailability specifies whether the control plane of the shoot must be highly available.
+	// +optional
+	HighAvailability bool `json:"highAvailability,omitempty"`
+	// MaxMonitoringRetention is the maximum retention of the shoot's monitoring data.
+	// +optional
+	MaxMonitoringRetention *metav1.Duration `json:"maxMonitoringRetention,omitempty"`
+	// HibernationForbidden specifies whether hibernating the shoot is forbidden, i.e., whether enabling the hibernation
+	// or configuring hibernation schedules is rejected.
+	// +optional
+	HibernationForbidden bool `json:"hibernationForbidden,omitempty"`
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/api/core/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile"
+)
+
+var availablePurposes = sets.New(
+	string(core.ShootPurposeEvaluation),
+	string(core.ShootPurposeTesting),
+	string(core.ShootPurposeDevelopment),
+	string(core.ShootPurposeProduction),
+	string(core.ShootPurposeInfrastructure),
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *shootpurposeprofile.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if config == nil {
+		return allErrs
+	}
+
+	purposes := sets.New[core.ShootPurpose]()
+
+	for i, profile := range config.Profiles {
+		idxPath := field.NewPath("profiles").Index(i)
+
+		if !availablePurposes.Has(string(profile.Purpose)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("purpose"), profile.Purpose, sets.List(availablePurposes)))
+		} else if purposes.Has(profile.Purpose) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("purpose"), profile.Purpose))
+		}
+		purposes.Insert(profile.Purpose)
+
+		allErrs = append(allErrs, validateProfile(profile, idxPath)...)
+	}
+
+	return allErrs
+}
+
+func validateProfile(profile shootpurposeprofile.PurposeProfile, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if defaults := profile.Defaults; defaults != nil {
+		defaultsPath := fldPath.Child("defaults")
+
+		if defaults.HighAvailabilityFailureToleranceType != nil {
+			allErrs = append(allErrs, validation.ValidateFailureToleranceTypeValue(*defaults.HighAvailabilityFailureToleranceType, defaultsPath.Child("highAvailabilityFailureToleranceType"))...)
+		}
+
+		if defaults.MonitoringRetention != nil && defaults.MonitoringRetention.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(defaultsPath.Child("monitoringRetention"), defaults.MonitoringRetention.Duration.String(), "must be positive"))
+		}
+	}
+
+	if requirements := profile.Requirements; requirements != nil {
+		requirementsPath := fldPath.Child("requirements")
+
+		if requirements.MaxMonitoringRetention != nil {
+			if requirements.MaxMonitoringRetention.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(requirementsPath.Child("maxMonitoringRetention"), requirements.MaxMonitoringRetention.Duration.String(), "must be positive"))
+			}
+
+			if profile.Defaults != nil && profile.Defaults.MonitoringRetention != nil && profile.Defaults.MonitoringRetention.Duration > requirements.MaxMonitoringRetention.Duration {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("defaults", "monitoringRetention"), profile.Defaults.MonitoringRetention.Duration.String(), "must not exceed requirements.maxMonitoringRetention"))
+			}
+		}
+	}
+
+	return allErrs
+}
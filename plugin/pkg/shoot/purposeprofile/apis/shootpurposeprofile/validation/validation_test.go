@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/purposeprofile/apis/shootpurposeprofile/validation"
+)
+
+var _ = Describe("Validation", func() {
+	Describe("#ValidateConfiguration", func() {
+		var config *shootpurposeprofile.Configuration
+
+		BeforeEach(func() {
+			config = &shootpurposeprofile.Configuration{}
+		})
+
+		It("should allow empty configuration", func() {
+			errorList := ValidateConfiguration(config)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should allow valid profiles", func() {
+			config.Profiles = []shootpurposeprofile.PurposeProfile{
+				{
+					Purpose: core.ShootPurposeProduction,
+					Defaults: &shootpurposeprofile.PurposeProfileDefaults{
+						HighAvailabilityFailureToleranceType: ptr.To(core.FailureToleranceTypeZone),
+						MonitoringRetention:                  &metav1.Duration{Duration: 30 * 24 * time.Hour},
+					},
+					Requirements: &shootpurposeprofile.PurposeProfileRequirements{
+						HighAvailability:     true,
+						HibernationForbidden: true,
+					},
+				},
+				{
+					Purpose: core.ShootPurposeEvaluation,
+					Defaults: &shootpurposeprofile.PurposeProfileDefaults{
+						MonitoringRetention: &metav1.Duration{Duration: 24 * time.Hour},
+					},
+					Requirements: &shootpurposeprofile.PurposeProfileRequirements{
+						MaxMonitoringRetention: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+					},
+				},
+			}
+
+			errorList := ValidateConfiguration(config)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid unknown and duplicate purposes", func() {
+			config.Profiles = []shootpurposeprofile.PurposeProfile{
+				{Purpose: "foo"},
+				{Purpose: core.ShootPurposeTesting},
+				{Purpose: core.ShootPurposeTesting},
+			}
+
+			errorList := ValidateConfiguration(config)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("profiles[0].purpose"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("profiles[2].purpose"),
+				})),
+			))
+		})
+
+		It("should forbid invalid defaults and requirements", func() {
+			config.Profiles = []shootpurposeprofile.PurposeProfile{
+				{
+					Purpose: core.ShootPurposeDevelopment,
+					Defaults: &shootpurposeprofile.PurposeProfileDefaults{
+						HighAvailabilityFailureToleranceType: ptr.To(core.FailureToleranceType("foo")),
+						MonitoringRetention:                  &metav1.Duration{Duration: -time.Hour},
+					},
+					Requirements: &shootpurposeprofile.PurposeProfileRequirements{
+						MaxMonitoringRetention: &metav1.Duration{},
+					},
+				},
+			}
+
+			errorList := ValidateConfiguration(config)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("profiles[0].defaults.highAvailabilityFailureToleranceType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("profiles[0].defaults.monitoringRetention"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("profiles[0].requirements.maxMonitoringRetention"),
+				})),
+			))
+		})
+
+		It("should forbid default monitoring retentions exceeding the maximum", func() {
+			config.Profiles = []shootpurposeprofile.PurposeProfile{
+				{
+					Purpose: core.ShootPurposeEvaluation,
+					Defaults: &shootpurposeprofile.PurposeProfileDefaults{
+						MonitoringRetention: &metav1.Duration{Duration: 14 * 24 * time.Hour},
+					},
+					Requirements: &shootpurposeprofile.PurposeProfileRequirements{
+						MaxMonitoringRetention: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+					},
+				},
+			}
+
+			errorList := ValidateConfiguration(config)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("profiles[0].defaults.monitoringRetention"),
+					"Detail": Equal("must not exceed requirements.maxMonitoringRetention"),
+				})),
+			))
+		})
+	})
+})
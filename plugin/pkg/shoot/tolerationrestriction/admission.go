@@ -21,6 +21,7 @@ import (
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
 	"github.com/gardener/gardener/pkg/utils"
+	timeutils "github.com/gardener/gardener/pkg/utils/time"
 	plugin "github.com/gardener/gardener/plugin/pkg"
 	"github.com/gardener/gardener/plugin/pkg/shoot/tolerationrestriction/apis/shoottolerationrestriction"
 	"github.com/gardener/gardener/plugin/pkg/shoot/tolerationrestriction/apis/shoottolerationrestriction/validation"
@@ -52,6 +53,7 @@ type TolerationRestriction struct {
 
 	defaults  []core.Toleration
 	allowlist []core.Toleration
+	time      timeutils.Ops
 }
 
 var (
@@ -66,6 +68,7 @@ func New(config *shoottolerationrestriction.Configuration) (*TolerationRestricti
 		Handler:   admission.NewHandler(admission.Create, admission.Update),
 		defaults:  config.Defaults,
 		allowlist: config.Whitelist,
+		time:      timeutils.DefaultOps(),
 	}, nil
 }
 
@@ -221,24 +224,42 @@ func (t *TolerationRestriction) validateShoot(shoot, oldShoot *core.Shoot) error
 	if errList := gardencorevalidation.ValidateTolerationsAgainstAllowlist(tolerationsToValidate, allowlist, field.NewPath("spec", "tolerations")); len(errList) > 0 {
 		return fmt.Errorf("error while validating tolerations against allowlist: %+v", errList)
 	}
+
+	now := t.time.Now()
+	for _, toleration := range tolerationsToValidate {
+		if toleration.ExpirationTimestamp != nil && !now.Before(toleration.ExpirationTimestamp.Time) {
+			return fmt.Errorf("expiration timestamp of toleration %q must be in the future", utils.IDForKeyWithOptionalValue(toleration.Key, toleration.Value))
+		}
+	}
+
 	return nil
 }
 
+// getNewOrChangedTolerations returns the tolerations which were added or whose expiration was extended or removed
+// compared to the old shoot.
 func getNewOrChangedTolerations(shoot, oldShoot *core.Shoot) []core.Toleration {
 	var (
-		oldTolerations          = sets.New[string]()
+		oldTolerations          = make(map[string]core.Toleration, len(oldShoot.Spec.Tolerations))
 		newOrChangedTolerations []core.Toleration
 	)
 
 	for _, toleration := range oldShoot.Spec.Tolerations {
-		oldTolerations.Insert(utils.IDForKeyWithOptionalValue(toleration.Key, toleration.Value))
+		oldTolerations[utils.IDForKeyWithOptionalValue(toleration.Key, toleration.Value)] = toleration
 	}
 
 	for _, toleration := range shoot.Spec.Tolerations {
-		if !oldTolerations.Has(utils.IDForKeyWithOptionalValue(toleration.Key, toleration.Value)) {
+		oldToleration, ok := oldTolerations[utils.IDForKeyWithOptionalValue(toleration.Key, toleration.Value)]
+		if !ok || expirationExtended(oldToleration, toleration) {
 			newOrChangedTolerations = append(newOrChangedTolerations, toleration)
 		}
 	}
 
 	return newOrChangedTolerations
 }
+
+func expirationExtended(oldToleration, newToleration core.Toleration) bool {
+	if oldToleration.ExpirationTimestamp == nil {
+		return false
+	}
+	return newToleration.ExpirationTimestamp == nil || newToleration.ExpirationTimestamp.After(oldToleration.ExpirationTimestamp.Time)
+}
@@ -6,6 +6,7 @@ package tolerationrestriction_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -213,6 +214,24 @@ var _ = Describe("toleration restriction", func() {
 					attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
 					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
 				})
+
+				It("should allow creating the shoot because its whitelisted toleration expires in the future", func() {
+					project.Spec.Tolerations = &gardencorev1beta1.ProjectTolerations{Whitelist: []gardencorev1beta1.Toleration{{Key: "foo"}}}
+					shoot.Spec.Tolerations = []core.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(time.Hour)}}}
+
+					Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+					attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should reject creating the shoot because its whitelisted toleration is already expired", func() {
+					project.Spec.Tolerations = &gardencorev1beta1.ProjectTolerations{Whitelist: []gardencorev1beta1.Toleration{{Key: "foo"}}}
+					shoot.Spec.Tolerations = []core.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(-time.Hour)}}}
+
+					Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+					attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring(`expiration timestamp of toleration "foo" must be in the future`)))
+				})
 			})
 
 			Context("UPDATE", func() {
@@ -277,7 +296,47 @@ var _ = Describe("toleration restriction", func() {
 					attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
 					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
 				})
+
+				It("should allow updating the shoot because the expiration of a (non-whitelisted) toleration was shortened", func() {
+					shoot.Spec.Tolerations = []core.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(2 * time.Hour)}}}
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Tolerations[0].ExpirationTimestamp = &metav1.Time{Time: time.Now().Add(time.Hour)}
+
+					Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+					attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should allow updating the shoot because an expired toleration was not changed", func() {
+					shoot.Spec.Tolerations = []core.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(-time.Hour)}}}
+					oldShoot := shoot.DeepCopy()
+
+					Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+					attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should reject updating the shoot because the expiration of a (non-whitelisted) toleration was extended", func() {
+					shoot.Spec.Tolerations = []core.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(time.Hour)}}}
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Tolerations[0].ExpirationTimestamp = &metav1.Time{Time: time.Now().Add(2 * time.Hour)}
+
+					Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+					attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+				})
+
+				It("should reject updating the shoot because the expiration of a (non-whitelisted) toleration was removed", func() {
+					shoot.Spec.Tolerations = []core.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(time.Hour)}}}
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Tolerations[0].ExpirationTimestamp = nil
+
+					Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+					attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+				})
 			})
+
 		})
 	})
 
@@ -15,8 +15,8 @@ import (
 func ValidateConfiguration(config *shoottolerationrestriction.Configuration) field.ErrorList {
 	var allErrs field.ErrorList
 
-	allErrs = append(allErrs, validation.ValidateTolerations(config.Defaults, field.NewPath("defaults"))...)
-	allErrs = append(allErrs, validation.ValidateTolerations(config.Whitelist, field.NewPath("whitelist"))...)
+	allErrs = append(allErrs, validation.ValidateTolerationsWithoutExpiration(config.Defaults, field.NewPath("defaults"))...)
+	allErrs = append(allErrs, validation.ValidateTolerationsWithoutExpiration(config.Whitelist, field.NewPath("whitelist"))...)
 
 	return allErrs
 }
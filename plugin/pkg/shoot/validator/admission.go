@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
@@ -26,11 +27,13 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/warning"
 	kubeinformers "k8s.io/client-go/informers"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/api/core/helper"
+	"github.com/gardener/gardener/pkg/api/core/shoot/validationrules"
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -43,13 +46,20 @@ import (
 	securityinformers "github.com/gardener/gardener/pkg/client/security/informers/externalversions"
 	securityv1alpha1listers "github.com/gardener/gardener/pkg/client/security/listers/security/v1alpha1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	timeutils "github.com/gardener/gardener/pkg/utils/time"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	plugin "github.com/gardener/gardener/plugin/pkg"
 	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 )
 
-const internalVersionErrorMsg = "must not use apiVersion 'internal'"
+const (
+	internalVersionErrorMsg = "must not use apiVersion 'internal'"
+
+	// expiringVersionWarningPeriod is the period before the expiration of a Kubernetes or machine image version in which
+	// users applying a Shoot using this version are warned.
+	expiringVersionWarningPeriod = 30 * 24 * time.Hour
+)
 
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
@@ -72,6 +82,7 @@ type ValidateShoot struct {
 	secretBindingLister          gardencorev1beta1listers.SecretBindingLister
 	credentialsBindingLister     securityv1alpha1listers.CredentialsBindingLister
 	readyFunc                    admission.ReadyFunc
+	time                         timeutils.Ops
 }
 
 var (
@@ -87,6 +98,7 @@ var (
 func New() (*ValidateShoot, error) {
 	return &ValidateShoot{
 		Handler: admission.NewHandler(admission.Create, admission.Update, admission.Delete),
+		time:    timeutils.DefaultOps(),
 	}, nil
 }
 
@@ -326,7 +338,7 @@ func (v *ValidateShoot) Validate(ctx context.Context, a admission.Attributes, _
 	if err := validationContext.validateProjectMembership(a); err != nil {
 		return err
 	}
-	if err := validationContext.validateScheduling(ctx, a, v.authorizer, v.shootLister, v.seedLister); err != nil {
+	if err := validationContext.validateScheduling(ctx, a, v.authorizer, v.shootLister, v.seedLister, v.time.Now()); err != nil {
 		return err
 	}
 	if err := validationContext.validateDeletion(a); err != nil {
@@ -353,6 +365,7 @@ func (v *ValidateShoot) Validate(ctx context.Context, a admission.Attributes, _
 	allErrs = append(allErrs, validationContext.validateProvider(a)...)
 	allErrs = append(allErrs, validationContext.validateAdmissionPlugins(a, v.secretLister)...)
 	allErrs = append(allErrs, validationContext.validateLimits(a)...)
+	allErrs = append(allErrs, validationContext.validateShootValidationRules(a)...)
 
 	// Skip the validation if the operation is admission.Delete or the spec hasn't changed.
 	if a.GetOperation() != admission.Delete && !reflect.DeepEqual(validationContext.shoot.Spec, validationContext.oldShoot.Spec) {
@@ -367,6 +380,10 @@ func (v *ValidateShoot) Validate(ctx context.Context, a admission.Attributes, _
 		return admission.NewForbidden(a, allErrs.ToAggregate())
 	}
 
+	for _, w := range validationContext.getWarningsForExpiringVersions(a, v.time.Now()) {
+		warning.AddWarning(ctx, "", w)
+	}
+
 	return nil
 }
 
@@ -410,6 +427,10 @@ func (c *validationContext) validateProjectMembership(a admission.Attributes) er
 		return admission.NewForbidden(a, fmt.Errorf("cannot create shoot '%s' in project '%s' that is already marked for deletion", c.shoot.Name, c.project.Name))
 	}
 
+	if c.project.Labels[v1beta1constants.ProjectStaleCleanupStage] == v1beta1constants.ProjectStaleCleanupStageLocked {
+		return admission.NewForbidden(a, fmt.Errorf("cannot create shoot '%s' in project '%s' that is locked because it is stale and will be deleted soon", c.shoot.Name, c.project.Name))
+	}
+
 	return nil
 }
 
@@ -422,7 +443,7 @@ func (c *validationContext) validateSeedSelectionForMultiZonalShoot() error {
 	return nil
 }
 
-func (c *validationContext) validateScheduling(ctx context.Context, a admission.Attributes, authorizer authorizer.Authorizer, shootLister gardencorev1beta1listers.ShootLister, seedLister gardencorev1beta1listers.SeedLister) error {
+func (c *validationContext) validateScheduling(ctx context.Context, a admission.Attributes, authorizer authorizer.Authorizer, shootLister gardencorev1beta1listers.ShootLister, seedLister gardencorev1beta1listers.SeedLister, now time.Time) error {
 	var (
 		shootIsBeingScheduled          = c.oldShoot.Spec.SeedName == nil && c.shoot.Spec.SeedName != nil
 		shootIsBeingRescheduled        = c.oldShoot.Spec.SeedName != nil && c.shoot.Spec.SeedName != nil && *c.shoot.Spec.SeedName != *c.oldShoot.Spec.SeedName
@@ -460,6 +481,10 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' that is already marked for deletion", c.shoot.Name, c.seed.Name))
 		}
 
+		if v1beta1helper.SeedSettingDrainEnabled(c.seed.Spec.Settings) {
+			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' that is being drained", c.shoot.Name, c.seed.Name))
+		}
+
 		var seedTaints []core.SeedTaint
 		if c.seed.Spec.Taints != nil {
 			for _, taint := range c.seed.Spec.Taints {
@@ -470,7 +495,7 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 			}
 		}
 
-		if !helper.TaintsAreTolerated(seedTaints, c.shoot.Spec.Tolerations) {
+		if !helper.TaintsAreTolerated(seedTaints, helper.NonExpiredTolerations(c.shoot.Spec.Tolerations, now)) {
 			return admission.NewForbidden(a, fmt.Errorf("forbidden to use a seed whose taints are not tolerated by the shoot"))
 		}
 
@@ -1830,6 +1855,97 @@ func validateMaxNodesTotal(workers []core.Worker, maxNodesTotal int32) field.Err
 	return allErrs
 }
 
+func (c *validationContext) validateShootValidationRules(a admission.Attributes) field.ErrorList {
+	if a.GetOperation() == admission.Delete || c.shoot.DeletionTimestamp != nil || len(c.cloudProfileSpec.ShootValidationRules) == 0 {
+		return nil
+	}
+
+	// Existing Shoots which do not satisfy rules added to the CloudProfile later on must still be updatable, e.g., to
+	// remove finalizers. Hence, the rules are only evaluated if the specification is changed.
+	var oldShoot *core.Shoot
+	if a.GetOperation() == admission.Update {
+		if reflect.DeepEqual(c.shoot.Spec, c.oldShoot.Spec) {
+			return nil
+		}
+		oldShoot = c.oldShoot
+	}
+
+	var (
+		allErrs field.ErrorList
+		fldPath = field.NewPath("spec")
+	)
+
+	for _, rule := range c.cloudProfileSpec.ShootValidationRules {
+		program, err := validationrules.Compile(rule.Expression)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("failed compiling validation rule %q of the CloudProfile: %w", rule.Name, err)))
+			continue
+		}
+
+		valid, err := validationrules.Evaluate(program, c.shoot, oldShoot)
+		if err != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("validation rule %q of the CloudProfile could not be evaluated: %v", rule.Name, err)))
+			continue
+		}
+
+		if !valid {
+			allErrs = append(allErrs, field.Forbidden(fldPath, ptr.Deref(rule.Message, fmt.Sprintf("shoot does not satisfy validation rule %q of the CloudProfile", rule.Name))))
+		}
+	}
+
+	return allErrs
+}
+
+func (c *validationContext) getWarningsForExpiringVersions(a admission.Attributes, now time.Time) []string {
+	if a.GetOperation() == admission.Delete || c.shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var (
+		warnings    []string
+		expiresSoon = func(version gardencorev1beta1.ExpirableVersion) (string, bool) {
+			expirationTime := v1beta1helper.VersionExpirationTime(version)
+			if expirationTime == nil || !expirationTime.After(now) || expirationTime.After(now.Add(expiringVersionWarningPeriod)) {
+				return "", false
+			}
+			return expirationTime.UTC().Format(time.RFC3339), true
+		}
+		kubernetesVersions = sets.New(c.shoot.Spec.Kubernetes.Version)
+		machineImages      = sets.New[string]()
+	)
+
+	for _, worker := range c.shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			kubernetesVersions.Insert(*worker.Kubernetes.Version)
+		}
+		if worker.Machine.Image != nil && worker.Machine.Image.Version != "" {
+			machineImages.Insert(worker.Machine.Image.Name + ":" + worker.Machine.Image.Version)
+		}
+	}
+
+	for _, version := range c.cloudProfileSpec.Kubernetes.Versions {
+		if !kubernetesVersions.Has(version.Version) {
+			continue
+		}
+		if expirationTime, ok := expiresSoon(version); ok {
+			warnings = append(warnings, fmt.Sprintf("the Kubernetes version %s expires at %s. Update the Shoot to a supported version before, otherwise it will be updated forcefully during its maintenance time window.", version.Version, expirationTime))
+		}
+	}
+
+	for _, machineImage := range sets.List(machineImages) {
+		name, version, _ := strings.Cut(machineImage, ":")
+		imageVersion, found := v1beta1helper.FindMachineImageVersion(c.cloudProfileSpec.MachineImages, name, version)
+		if !found {
+			continue
+		}
+		if expirationTime, ok := expiresSoon(imageVersion.ExpirableVersion); ok {
+			warnings = append(warnings, fmt.Sprintf("the machine image version %s expires at %s. Update the worker pools using it to a supported version before, otherwise they will be updated forcefully during the maintenance time window of the Shoot.", machineImage, expirationTime))
+		}
+	}
+
+	return warnings
+}
+
 func (c *validationContext) validateDefaultDomainCompatibilityForRescheduling(oldSeed *gardencorev1beta1.Seed) error {
 	if c.shoot.Spec.DNS == nil || c.shoot.Spec.DNS.Domain == nil {
 		return nil
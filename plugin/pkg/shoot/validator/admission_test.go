@@ -21,6 +21,7 @@ import (
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/warning"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
@@ -392,6 +393,22 @@ var _ = Describe("validator", func() {
 				Expect(err.Error()).To(ContainSubstring("already marked for deletion"))
 			})
 
+			It("should reject create operations on Shoot resources in projects which are locked because they are stale", func() {
+				project.Labels = map[string]string{v1beta1constants.ProjectStaleCleanupStage: v1beta1constants.ProjectStaleCleanupStageLocked}
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Validate(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("is locked because it is stale"))
+			})
+
 			It("should reject Shoot resources with not fulfilling the length constraints", func() {
 				tooLongName := "too-long-namespace"
 				project.ObjectMeta = metav1.ObjectMeta{
@@ -544,6 +561,38 @@ var _ = Describe("validator", func() {
 			)
 		})
 
+		Context("checks for shoots referencing a seed which is being drained", func() {
+			BeforeEach(func() {
+				seed = *seedBase.DeepCopy()
+				seed.Spec.Settings = &gardencorev1beta1.SeedSettings{
+					Drain: &gardencorev1beta1.SeedSettingDrain{Enabled: true},
+				}
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+			})
+
+			It("should reject creating a shoot on a seed which is being drained", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Validate(ctx, attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("cannot schedule shoot '%s' on seed '%s' that is being drained", shoot.Name, seed.Name))
+			})
+
+			It("should allow updating a shoot which is already scheduled on a seed which is being drained", func() {
+				oldShoot := shootBase.DeepCopy()
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				err := admissionHandler.Validate(ctx, attrs, nil)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
 		Context("checks for shoots referencing a deleted seed", func() {
 			var oldShoot *core.Shoot
 
@@ -6104,6 +6153,29 @@ var _ = Describe("validator", func() {
 
 					Expect(err).NotTo(HaveOccurred())
 				})
+
+				It("update of binding should fail because the toleration for the seed's taints is expired", func() {
+					newSeed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+					shoot.Spec.Tolerations = []core.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(-time.Hour)}}}
+					oldShoot.Spec.Tolerations = shoot.Spec.Tolerations
+
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Validate(context.TODO(), attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("forbidden to use a seed whose taints are not tolerated by the shoot"))
+				})
+
+				It("update of binding should pass because the toleration for the seed's taints is not yet expired", func() {
+					newSeed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+					shoot.Spec.Tolerations = []core.Toleration{{Key: "foo", ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(time.Hour)}}}
+					oldShoot.Spec.Tolerations = shoot.Spec.Tolerations
+
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Validate(context.TODO(), attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
 			})
 
 			Context("seed capacity", func() {
@@ -6403,5 +6475,165 @@ var _ = Describe("validator", func() {
 				})
 			})
 		})
+
+		Context("shoot validation rules", func() {
+			BeforeEach(func() {
+				cloudProfile.Spec.ShootValidationRules = []gardencorev1beta1.ShootValidationRule{
+					{
+						Name:       "machine-types",
+						Expression: `shoot.spec.region != "` + shoot.Spec.Region + `" || shoot.spec.provider.workers.all(w, w.maximum <= 5)`,
+						Message:    ptr.To("workers in this region must not have more than 5 nodes"),
+					},
+					{
+						Name:       "region",
+						Expression: `oldShoot == null || oldShoot.spec.region == shoot.spec.region`,
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+			})
+
+			It("should allow shoots satisfying all rules", func() {
+				shoot.Spec.Provider.Workers[0].Maximum = 5
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should forbid shoots violating a rule with the message of the rule", func() {
+				shoot.Spec.Provider.Workers[0].Maximum = 6
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+				err := admissionHandler.Validate(ctx, attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("workers in this region must not have more than 5 nodes")))
+			})
+
+			It("should forbid updates violating a rule with a default message", func() {
+				shoot.Spec.Provider.Workers[0].Maximum = 5
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.Region = "other-region"
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+				err := admissionHandler.Validate(ctx, attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring(`shoot does not satisfy validation rule "region" of the CloudProfile`)))
+			})
+
+			It("should forbid shoots if a rule cannot be evaluated", func() {
+				cloudProfile.Spec.ShootValidationRules = []gardencorev1beta1.ShootValidationRule{{
+					Name:       "dns",
+					Expression: `shoot.spec.dns.domain.endsWith(".example.com")`,
+				}}
+				shoot.Spec.DNS = nil
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+				err := admissionHandler.Validate(ctx, attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring(`validation rule "dns" of the CloudProfile could not be evaluated`)))
+			})
+
+			It("should allow updates of shoots violating a rule if the spec is unchanged", func() {
+				shoot.Spec.Provider.Workers[0].Maximum = 6
+				oldShoot := shoot.DeepCopy()
+				shoot.Labels = map[string]string{"foo": "bar"}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should allow deleting shoots violating a rule", func() {
+				shoot.Spec.Provider.Workers[0].Maximum = 6
+
+				attrs := admission.NewAttributesRecord(nil, &shoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Delete, &metav1.DeleteOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+			})
+		})
+
+		Context("warnings for expiring versions", func() {
+			var recorder *warningRecorder
+
+			BeforeEach(func() {
+				recorder = &warningRecorder{}
+				ctx = warning.WithWarningRecorder(ctx, recorder)
+			})
+
+			JustBeforeEach(func() {
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+			})
+
+			It("should not warn if the versions do not expire", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(BeEmpty())
+			})
+
+			It("should not warn if the versions expire after the warning period", func() {
+				cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = &metav1.Time{Time: time.Now().Add(60 * 24 * time.Hour)}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(BeEmpty())
+			})
+
+			It("should warn if the Kubernetes version expires soon", func() {
+				cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = &metav1.Time{Time: time.Now().Add(7 * 24 * time.Hour)}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(ConsistOf(HavePrefix("the Kubernetes version 1.6.4 expires at ")))
+			})
+
+			It("should warn if a machine image version expires soon", func() {
+				cloudProfile.Spec.MachineImages[0].Versions = []gardencorev1beta1.MachineImageVersion{*validMachineImageVersions[0].DeepCopy()}
+				cloudProfile.Spec.MachineImages[0].Versions[0].Lifecycle = []gardencorev1beta1.LifecycleStage{
+					{Classification: gardencorev1beta1.ClassificationSupported},
+					{Classification: gardencorev1beta1.ClassificationExpired, StartTime: &metav1.Time{Time: time.Now().Add(24 * time.Hour)}},
+				}
+				oldShoot := shoot.DeepCopy()
+				shoot.Labels = map[string]string{"foo": "bar"}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(ConsistOf(HavePrefix("the machine image version " + validMachineImageName + ":0.0.1 expires at ")))
+			})
+
+			It("should not warn when the shoot is deleted", func() {
+				cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = &metav1.Time{Time: time.Now().Add(7 * 24 * time.Hour)}
+
+				attrs := admission.NewAttributesRecord(nil, &shoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Delete, &metav1.DeleteOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(BeEmpty())
+			})
+		})
 	})
 })
+
+type warningRecorder struct {
+	warnings []string
+}
+
+func (r *warningRecorder) AddWarning(_, text string) {
+	r.warnings = append(r.warnings, text)
+}
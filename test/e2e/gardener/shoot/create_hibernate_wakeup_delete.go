@@ -19,7 +19,6 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	localv1alpha1 "github.com/gardener/gardener/pkg/provider-local/apis/local/v1alpha1"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -32,40 +31,21 @@ import (
 
 var _ = Describe("Shoot Tests", Label("Shoot", "default"), func() {
 	Describe("Create, Hibernate, Wake up and Delete Shoot", func() {
-		test := func(s *ShootContext) {
-			ItShouldCreateShoot(s)
-			ItShouldWaitForShootToBeReconciledAndHealthy(s)
-			ItShouldInitializeShootClient(s)
-			ItShouldGetResponsibleSeed(s)
-			seed.ItShouldInitializeSeedClient(&s.SeedContext)
-
+		test := NewShootScenario().
+			Then(StepCreateShoot).
 			// validate Prometheus health checks are in place for the shoot Prometheus.
-			itShouldVerifyShootPrometheusHealthCheck(s)
-
-			if !v1beta1helper.IsWorkerless(s.Shoot) {
-				inclusterclient.VerifyInClusterAccessToAPIServer(s)
-			}
-
-			if !v1beta1helper.IsWorkerless(s.Shoot) {
+			Then(itShouldVerifyShootPrometheusHealthCheck).
+			ThenIf(HasWorkers,
+				inclusterclient.VerifyInClusterAccessToAPIServer,
 				// We verify the node readiness feature in this specific e2e test because it uses a single-node shoot cluster.
 				// The default shoot e2e test deals with multiple nodes, deleting all of them and waiting for them to be recreated
 				// might increase the test duration undesirably.
-				node.VerifyNodeCriticalComponentsBootstrapping(s)
-			}
-
-			ItShouldHibernateShoot(s)
-			ItShouldWaitForShootToBeReconciledAndHealthy(s)
-
-			ItShouldWakeUpShoot(s)
-			ItShouldWaitForShootToBeReconciledAndHealthy(s)
-
-			if !v1beta1helper.IsWorkerless(s.Shoot) {
-				inclusterclient.VerifyInClusterAccessToAPIServer(s)
-			}
-
-			ItShouldDeleteShoot(s)
-			ItShouldWaitForShootToBeDeleted(s)
-		}
+				node.VerifyNodeCriticalComponentsBootstrapping,
+			).
+			Then(StepHibernateShoot, StepWakeUpShoot).
+			ThenIf(HasWorkers, inclusterclient.VerifyInClusterAccessToAPIServer).
+			Then(StepDeleteShoot).
+			Register
 
 		Context("Shoot with workers", Label("basic"), Ordered, func() {
 			test(NewTestContext().ForShoot(DefaultShoot("e2e-wake-up")))
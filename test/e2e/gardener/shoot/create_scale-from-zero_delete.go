@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/gardener/gardener/test/e2e"
+	. "github.com/gardener/gardener/test/e2e/gardener"
+	"github.com/gardener/gardener/test/e2e/gardener/seed"
+)
+
+var _ = Describe("Shoot Tests", Label("Shoot", "default"), func() {
+	Describe("Create Shoot with worker pool scaling from zero, Delete", Label("scale-from-zero"), Ordered, func() {
+		var (
+			s         *ShootContext
+			burstPool gardencorev1beta1.Worker
+		)
+
+		BeforeTestSetup(func() {
+			shoot := DefaultShoot("e2e-zero")
+
+			burstPool = DefaultWorker("burst", nil)
+			burstPool.Minimum = 0
+			burstPool.Maximum = 1
+			burstPool.ClusterAutoscaler = &gardencorev1beta1.ClusterAutoscalerOptions{
+				CapacityHints: corev1.ResourceList{"example.com/batch-slots": resource.MustParse("4")},
+			}
+			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, burstPool)
+
+			s = NewTestContext().ForShoot(shoot)
+		})
+
+		ItShouldCreateShoot(s)
+		ItShouldWaitForShootToBeReconciledAndHealthy(s)
+		ItShouldInitializeShootClient(s)
+		ItShouldGetResponsibleSeed(s)
+		seed.ItShouldInitializeSeedClient(&s.SeedContext)
+
+		It("should propagate the capacity hints to the node template of the worker pool", func(ctx SpecContext) {
+			worker := &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: s.Shoot.Name, Namespace: s.Shoot.Status.TechnicalID}}
+
+			Eventually(ctx, s.SeedKomega.Object(worker)).Should(
+				HaveField("Spec.Pools", ContainElement(And(
+					HaveField("Name", burstPool.Name),
+					HaveField("NodeTemplate.Capacity", HaveKeyWithValue(corev1.ResourceName("example.com/batch-slots"), resource.MustParse("4"))),
+				))),
+			)
+		}, SpecTimeout(time.Minute))
+
+		It("should not have any nodes in the worker pool", func(ctx SpecContext) {
+			Eventually(ctx, s.ShootKomega.ObjectList(&corev1.NodeList{}, client.MatchingLabels{"worker.gardener.cloud/pool": burstPool.Name})).Should(
+				HaveField("Items", BeEmpty()),
+			)
+		}, SpecTimeout(time.Minute))
+
+		pod := newPodForNamespace(metav1.NamespaceDefault)
+		pod.Name = "burst"
+		pod.Spec.NodeSelector = map[string]string{"worker.gardener.cloud/pool": burstPool.Name}
+
+		It("should create a pod for the worker pool", func(ctx SpecContext) {
+			DeferCleanup(func(ctx SpecContext) {
+				Eventually(ctx, func() error {
+					return s.ShootClient.Delete(ctx, pod)
+				}).Should(Or(Succeed(), BeNotFoundError()))
+			}, NodeTimeout(time.Minute))
+
+			Eventually(ctx, func() error {
+				return s.ShootClient.Create(ctx, pod)
+			}).Should(Succeed())
+		}, SpecTimeout(time.Minute))
+
+		It("should scale up the worker pool from zero and run the pod", func(ctx SpecContext) {
+			Eventually(ctx, s.ShootKomega.Object(pod)).WithPolling(10 * time.Second).Should(
+				HaveField("Status.Phase", corev1.PodRunning),
+			)
+		}, SpecTimeout(15*time.Minute))
+
+		ItShouldDeleteShoot(s)
+		ItShouldWaitForShootToBeDeleted(s)
+	})
+})
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	. "github.com/gardener/gardener/test/e2e/gardener"
+	"github.com/gardener/gardener/test/e2e/gardener/seed"
+	"github.com/gardener/gardener/test/framework"
+)
+
+// This file contains a library of common shoot scenario steps which combine multiple atomic steps (`It`s), e.g.,
+// creating a shoot and waiting for it to be healthy. They are supposed to be composed to test cases via
+// framework.Scenario, e.g.,
+//  NewShootScenario().
+//    Then(StepCreateShoot).
+//    Then(StepHibernateShoot, StepWakeUpShoot).
+//    Then(StepDeleteShoot).
+//    Register(s)
+
+// NewShootScenario returns a new framework.Scenario for a ShootContext which collects the shoot artifacts on failure.
+func NewShootScenario() *framework.Scenario[*ShootContext] {
+	return framework.NewScenario[*ShootContext]().CollectArtifactsOnFailure(CollectShootArtifacts)
+}
+
+// HasWorkers returns true if the shoot of the given context has workers. It can be used as condition for
+// framework.Scenario.ThenIf.
+func HasWorkers(s *ShootContext) bool {
+	return !v1beta1helper.IsWorkerless(s.Shoot)
+}
+
+// StepCreateShoot creates the shoot, waits for it to be reconciled and healthy and initializes the shoot and seed
+// clients.
+func StepCreateShoot(s *ShootContext) {
+	ItShouldCreateShoot(s)
+	ItShouldWaitForShootToBeReconciledAndHealthy(s)
+	ItShouldInitializeShootClient(s)
+	ItShouldGetResponsibleSeed(s)
+	seed.ItShouldInitializeSeedClient(&s.SeedContext)
+}
+
+// StepHibernateShoot hibernates the shoot and waits for it to be reconciled and healthy.
+func StepHibernateShoot(s *ShootContext) {
+	ItShouldHibernateShoot(s)
+	ItShouldWaitForShootToBeReconciledAndHealthy(s)
+}
+
+// StepWakeUpShoot wakes up the shoot and waits for it to be reconciled and healthy.
+func StepWakeUpShoot(s *ShootContext) {
+	ItShouldWakeUpShoot(s)
+	ItShouldWaitForShootToBeReconciledAndHealthy(s)
+}
+
+// StepDeleteShoot deletes the shoot and waits for it to be gone.
+func StepDeleteShoot(s *ShootContext) {
+	ItShouldDeleteShoot(s)
+	ItShouldWaitForShootToBeDeleted(s)
+}
+
+// CollectShootArtifacts writes the shoot, its events and the pods of its control plane into the given directory.
+func CollectShootArtifacts(ctx context.Context, s *ShootContext, dir string) error {
+	if s.Shoot == nil {
+		return nil
+	}
+
+	var errs []error
+
+	shoot := s.Shoot.DeepCopy()
+	if err := s.GardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot); err != nil {
+		errs = append(errs, fmt.Errorf("failed reading shoot: %w", err))
+	} else if err := writeArtifact(dir, "shoot.yaml", shoot); err != nil {
+		errs = append(errs, err)
+	}
+
+	eventList := &corev1.EventList{}
+	if err := s.GardenClient.List(ctx, eventList, client.InNamespace(shoot.Namespace), client.MatchingFields{"involvedObject.name": shoot.Name}); err != nil {
+		errs = append(errs, fmt.Errorf("failed listing shoot events: %w", err))
+	} else if err := writeArtifact(dir, "shoot-events.yaml", eventList); err != nil {
+		errs = append(errs, err)
+	}
+
+	if s.SeedClient != nil && shoot.Status.TechnicalID != "" {
+		podList := &corev1.PodList{}
+		if err := s.SeedClient.List(ctx, podList, client.InNamespace(shoot.Status.TechnicalID)); err != nil {
+			errs = append(errs, fmt.Errorf("failed listing control plane pods: %w", err))
+		} else if err := writeArtifact(dir, "control-plane-pods.yaml", podList); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func writeArtifact(dir, name string, obj any) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed marshalling %s: %w", name, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+		return fmt.Errorf("failed writing %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+)
+
+// ScenarioStep registers the ginkgo nodes (typically one or more `It`s) of a single step of a scenario for the given
+// test context. Existing step helpers with a matching signature, e.g. `func(s *ShootContext)`, can be used as-is.
+type ScenarioStep[T any] func(t T)
+
+// ArtifactCollector collects artifacts for the given test context into the given directory. It is called when a step
+// of a scenario failed.
+type ArtifactCollector[T any] func(ctx context.Context, t T, dir string) error
+
+// Scenario composes an e2e test case from reusable steps, e.g. create → verify addons → rotate credentials →
+// hibernate → wake up → delete. The steps are registered in order when the scenario is registered for a test context
+// in an ordered container. This allows sharing the same sequence of steps between multiple test cases (e.g., for
+// shoots with and without workers) instead of copying it.
+type Scenario[T any] struct {
+	steps      []ScenarioStep[T]
+	collectors []ArtifactCollector[T]
+}
+
+// NewScenario returns a new empty Scenario.
+func NewScenario[T any]() *Scenario[T] {
+	return &Scenario[T]{}
+}
+
+// Then appends the given steps to the scenario.
+func (s *Scenario[T]) Then(steps ...ScenarioStep[T]) *Scenario[T] {
+	s.steps = append(s.steps, steps...)
+	return s
+}
+
+// ThenIf appends the given steps to the scenario which are only registered if the given condition is true for the test
+// context. The condition is evaluated during tree construction, i.e. when the scenario is registered.
+func (s *Scenario[T]) ThenIf(condition func(t T) bool, steps ...ScenarioStep[T]) *Scenario[T] {
+	return s.Then(func(t T) {
+		if !condition(t) {
+			return
+		}
+		for _, step := range steps {
+			step(t)
+		}
+	})
+}
+
+// CollectArtifactsOnFailure adds collectors which are called when a step of the scenario failed.
+func (s *Scenario[T]) CollectArtifactsOnFailure(collectors ...ArtifactCollector[T]) *Scenario[T] {
+	s.collectors = append(s.collectors, collectors...)
+	return s
+}
+
+// Register registers the steps of the scenario for the given test context in the current container, which is expected
+// to be ordered. If artifact collectors are configured, the artifacts are collected after the first failing step into
+// the directory returned by ArtifactsDir.
+func (s *Scenario[T]) Register(t T) {
+	ginkgo.GinkgoHelper()
+
+	if len(s.collectors) > 0 {
+		collectors := s.collectors
+
+		ginkgo.AfterEach(func(ctx ginkgo.SpecContext) {
+			report := ginkgo.CurrentSpecReport()
+			if !report.Failed() {
+				return
+			}
+
+			dir, err := ArtifactsDir(report.FullText())
+			if err != nil {
+				ginkgo.GinkgoWriter.Printf("Failed creating artifacts directory: %v\n", err)
+				return
+			}
+
+			ginkgo.GinkgoWriter.Printf("Collecting artifacts into %s\n", dir)
+			for _, collect := range collectors {
+				if err := collect(ctx, t, dir); err != nil {
+					ginkgo.GinkgoWriter.Printf("Failed collecting artifacts: %v\n", err)
+				}
+			}
+		}, ginkgo.NodeTimeout(5*time.Minute))
+	}
+
+	for _, step := range s.steps {
+		step(t)
+	}
+}
+
+var nonFileNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// ArtifactsDir creates and returns a directory for the artifacts of the test with the given name. The directory is
+// created below the location specified via the $ARTIFACTS env var, which is set in CI. If it is not set, a temporary
+// directory is used instead.
+func ArtifactsDir(testName string) (string, error) {
+	name := nonFileNameCharacters.ReplaceAllString(testName, "-")
+	if len(name) > 200 {
+		name = name[:200]
+	}
+
+	root := os.Getenv("ARTIFACTS")
+	if root == "" {
+		return os.MkdirTemp("", "e2e-artifacts-"+name+"-")
+	}
+
+	dir := filepath.Join(root, "e2e", name)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return "", fmt.Errorf("failed creating directory %s: %w", dir, err)
+	}
+	return dir, nil
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/test/framework"
+)
+
+var _ = Describe("Scenario", func() {
+	Describe("#ArtifactsDir", func() {
+		It("should create a directory below $ARTIFACTS named after the test", func() {
+			artifacts := GinkgoT().TempDir()
+			GinkgoT().Setenv("ARTIFACTS", artifacts)
+
+			dir, err := framework.ArtifactsDir("Shoot Tests Create, Hibernate [Shoot, default]")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dir).To(Equal(filepath.Join(artifacts, "e2e", "Shoot-Tests-Create-Hibernate-Shoot-default-")))
+			Expect(dir).To(BeADirectory())
+		})
+	})
+})
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sni_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/gardener/gardener/pkg/logger"
+	componentenvtest "github.com/gardener/gardener/pkg/utils/test/envtest"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+func TestSNI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Integration Component APIServerExposure SNI Suite")
+}
+
+var (
+	ctx = context.Background()
+
+	testEnv       *componentenvtest.ComponentEnvironment
+	testNamespace *corev1.Namespace
+)
+
+var _ = BeforeSuite(func() {
+	logf.SetLogger(logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, zap.WriteTo(GinkgoWriter)))
+
+	By("Start test environment")
+	testEnv = &componentenvtest.ComponentEnvironment{}
+
+	restConfig, err := testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(restConfig).NotTo(BeNil())
+
+	DeferCleanup(func() {
+		By("Stop test environment")
+		Expect(testEnv.Stop()).To(Succeed())
+	})
+
+	By("Create test Namespace")
+	testNamespace = &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			// create dedicated namespace for each test run, so that we can run multiple tests concurrently for stress tests
+			GenerateName: "shoot--foo--",
+		},
+	}
+	Expect(testEnv.Client.Create(ctx, testNamespace)).To(Succeed())
+
+	DeferCleanup(func() {
+		By("Delete test Namespace")
+		Expect(testEnv.Client.Delete(ctx, testNamespace)).To(Or(Succeed(), BeNotFoundError()))
+	})
+})
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sni_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/component/kubernetes/apiserverexposure"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+)
+
+var _ = Describe("SNI", func() {
+	var (
+		hosts       = []string{"api.foo.example.com", "api.foo.internal.example.com"}
+		istioLabels = map[string]string{"app": "istio-ingressgateway"}
+	)
+
+	It("should deploy Gateway, VirtualService and DestinationRule accepted by the istio CRDs", func() {
+		for _, name := range []string{"ca", "ca-client", "kube-apiserver", "kube-apiserver-current"} {
+			Expect(testEnv.Client.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace.Name}})).To(Succeed())
+		}
+
+		sni := apiserverexposure.NewSNI(testEnv.Client, v1beta1constants.DeploymentNameKubeAPIServer, testNamespace.Name, fakesecretsmanager.New(testEnv.Client, testNamespace.Name), func() *apiserverexposure.SNIValues {
+			return &apiserverexposure.SNIValues{
+				Hosts: hosts,
+				IstioIngressGateway: apiserverexposure.IstioIngressGateway{
+					Namespace: "istio-ingress",
+					Labels:    istioLabels,
+				},
+			}
+		})
+		Expect(sni.Deploy(ctx)).To(Succeed())
+
+		key := client.ObjectKey{Namespace: testNamespace.Name, Name: v1beta1constants.DeploymentNameKubeAPIServer}
+
+		gateway := &istionetworkingv1beta1.Gateway{}
+		Expect(testEnv.Client.Get(ctx, key, gateway)).To(Succeed())
+		Expect(gateway.Spec.Selector).To(Equal(istioLabels))
+		Expect(gateway.Spec.Servers).To(ContainElement(HaveField("Hosts", Equal(hosts))))
+
+		virtualService := &istionetworkingv1beta1.VirtualService{}
+		Expect(testEnv.Client.Get(ctx, key, virtualService)).To(Succeed())
+		Expect(virtualService.Spec.Hosts).To(Equal(hosts))
+		Expect(virtualService.Spec.Gateways).To(ConsistOf(gateway.Name))
+
+		destinationRule := &istionetworkingv1beta1.DestinationRule{}
+		Expect(testEnv.Client.Get(ctx, key, destinationRule)).To(Succeed())
+	})
+})
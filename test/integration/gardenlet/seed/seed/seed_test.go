@@ -524,6 +524,7 @@ var _ = Describe("Seed controller tests", func() {
 						"networks.extensions.gardener.cloud",
 						"operatingsystemconfigs.extensions.gardener.cloud",
 						"selfhostedshootexposures.extensions.gardener.cloud",
+						"shootleftovers.extensions.gardener.cloud",
 						"workers.extensions.gardener.cloud",
 					}
 					crdsSharedWithGardenCluster = []string{
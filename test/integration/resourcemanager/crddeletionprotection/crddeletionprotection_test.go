@@ -54,6 +54,7 @@ var _ = Describe("Extension CRDs Webhook Handler", func() {
 			&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "networks.extensions.gardener.cloud"}},
 			&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "operatingsystemconfigs.extensions.gardener.cloud"}},
 			&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "selfhostedshootexposures.extensions.gardener.cloud"}},
+			&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "shootleftovers.extensions.gardener.cloud"}},
 			&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "workers.extensions.gardener.cloud"}},
 		}
 		objects = []client.Object{
@@ -67,6 +68,7 @@ var _ = Describe("Extension CRDs Webhook Handler", func() {
 			&extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace.Name, Name: "foo"}},
 			&extensionsv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace.Name, Name: "foo"}},
 			&extensionsv1alpha1.OperatingSystemConfig{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace.Name, Name: "foo"}},
+			&extensionsv1alpha1.ShootLeftover{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"}},
 			&extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace.Name, Name: "foo"}},
 		}
 		objects = append(objects, crdObjects...)
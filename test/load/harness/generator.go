@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package harness
+
+import (
+	"fmt"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// LabelRunID is the label key put on all objects generated by a load test run. Its value is the ID of the run.
+	LabelRunID = "load.gardener.cloud/run"
+
+	namePrefix = "ld"
+	// leaseDurationSeconds is the lease duration of the synthetic seeds, it mirrors the default of the gardenlet.
+	leaseDurationSeconds int32 = 2
+)
+
+// NewRunID returns a random ID for a load test run. It is short enough to keep the names of the generated shoots within
+// the length limits enforced by the gardener-apiserver.
+func NewRunID() (string, error) {
+	return utils.GenerateRandomStringFromCharset(4, "0123456789abcdefghijklmnopqrstuvwxyz")
+}
+
+// ShootTemplate contains the settings used for all generated shoots.
+type ShootTemplate struct {
+	// CloudProfileName is the name of the CloudProfile referenced by the shoots.
+	CloudProfileName string
+	// CredentialsBindingName is the name of the CredentialsBinding referenced by the shoots.
+	CredentialsBindingName string
+	// Region is the region of the shoots.
+	Region string
+	// ProviderType is the provider type of the shoots.
+	ProviderType string
+	// MachineType is the machine type of the shoots' worker pool.
+	MachineType string
+	// NetworkingType is the networking type of the shoots.
+	NetworkingType string
+	// NodesCIDR is the node network of the shoots.
+	NodesCIDR string
+	// KubernetesVersion is the Kubernetes version of the shoots.
+	KubernetesVersion string
+}
+
+// Generator generates the synthetic Seeds and Shoots of a load test run.
+type Generator struct {
+	// RunID is the ID of the load test run.
+	RunID string
+	// ProjectNamespace is the namespace in which the shoots are created.
+	ProjectNamespace string
+	// TemplateSeed is the seed whose specification is copied for all synthetic seeds.
+	TemplateSeed *gardencorev1beta1.Seed
+	// ShootTemplate contains the settings used for all generated shoots.
+	ShootTemplate ShootTemplate
+}
+
+// SeedName returns the name of the synthetic seed with the given index.
+func (g *Generator) SeedName(index int) string {
+	return fmt.Sprintf("%s-%s-s%d", namePrefix, g.RunID, index)
+}
+
+// ShootName returns the name of the shoot with the given index.
+func (g *Generator) ShootName(index int) string {
+	return fmt.Sprintf("%s-%s-%d", namePrefix, g.RunID, index)
+}
+
+// Labels returns the labels put on all objects of the load test run.
+func (g *Generator) Labels() map[string]string {
+	return map[string]string{LabelRunID: g.RunID}
+}
+
+// Seed returns the synthetic seed with the given index. Its specification is copied from the template seed, but the
+// backup configuration and the default domains are dropped since nobody serves them for synthetic seeds.
+func (g *Generator) Seed(index int) *gardencorev1beta1.Seed {
+	name := g.SeedName(index)
+
+	spec := g.TemplateSeed.Spec.DeepCopy()
+	spec.Backup = nil
+	spec.DNS.Defaults = nil
+	spec.Settings = &gardencorev1beta1.SeedSettings{
+		Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+	}
+	if spec.Ingress != nil {
+		spec.Ingress.Domain = fmt.Sprintf("%s.%s", name, spec.Ingress.Domain)
+	}
+
+	return &gardencorev1beta1.Seed{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: g.Labels(),
+		},
+		Spec: *spec,
+	}
+}
+
+// MutateSeedStatus marks the given synthetic seed as ready, i.e., it sets the status fields which the gardenlet would
+// maintain for a healthy seed and which are considered by the gardener-scheduler.
+func (g *Generator) MutateSeedStatus(seed *gardencorev1beta1.Seed, now time.Time) {
+	seed.Status.ObservedGeneration = seed.Generation
+	seed.Status.LastOperation = &gardencorev1beta1.LastOperation{
+		Type:           gardencorev1beta1.LastOperationTypeReconcile,
+		State:          gardencorev1beta1.LastOperationStateSucceeded,
+		Progress:       100,
+		Description:    "Synthetic seed of load test run " + g.RunID,
+		LastUpdateTime: metav1.NewTime(now),
+	}
+	seed.Status.Conditions = []gardencorev1beta1.Condition{{
+		Type:               gardencorev1beta1.GardenletReady,
+		Status:             gardencorev1beta1.ConditionTrue,
+		Reason:             "LoadTest",
+		Message:            "Heartbeats are sent by the load test harness.",
+		LastTransitionTime: metav1.NewTime(now),
+		LastUpdateTime:     metav1.NewTime(now),
+	}}
+}
+
+// MutateSeedLease renews the given lease of a synthetic seed like the gardenlet does for the seed it is responsible for.
+func (g *Generator) MutateSeedLease(lease *coordinationv1.Lease, seed *gardencorev1beta1.Seed, now time.Time) {
+	lease.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+		Kind:       "Seed",
+		Name:       seed.Name,
+		UID:        seed.UID,
+	}}
+	lease.Spec.HolderIdentity = ptr.To(seed.Name)
+	lease.Spec.LeaseDurationSeconds = ptr.To(leaseDurationSeconds)
+	lease.Spec.RenewTime = &metav1.MicroTime{Time: now}
+}
+
+// Shoot returns the shoot with the given index. If seedName is empty, the shoot selects the synthetic seeds of the run
+// via its seed selector and is hence scheduled by the gardener-scheduler. Otherwise, it is bound to the given seed and
+// created in hibernated state to keep the load on the real seed's gardenlet at the reconciliation itself.
+func (g *Generator) Shoot(index int, seedName string) *gardencorev1beta1.Shoot {
+	t := g.ShootTemplate
+
+	shoot := &gardencorev1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      g.ShootName(index),
+			Namespace: g.ProjectNamespace,
+			Labels:    g.Labels(),
+		},
+		Spec: gardencorev1beta1.ShootSpec{
+			CloudProfile:           &gardencorev1beta1.CloudProfileReference{Name: t.CloudProfileName},
+			CredentialsBindingName: ptr.To(t.CredentialsBindingName),
+			Region:                 t.Region,
+			Kubernetes: gardencorev1beta1.Kubernetes{
+				Version: t.KubernetesVersion,
+			},
+			Networking: &gardencorev1beta1.Networking{
+				Type:  ptr.To(t.NetworkingType),
+				Nodes: ptr.To(t.NodesCIDR),
+			},
+			Provider: gardencorev1beta1.Provider{
+				Type: t.ProviderType,
+				Workers: []gardencorev1beta1.Worker{{
+					Name:    "load",
+					Machine: gardencorev1beta1.Machine{Type: t.MachineType},
+					CRI:     &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD},
+					Minimum: 1,
+					Maximum: 1,
+				}},
+			},
+		},
+	}
+
+	if seedName == "" {
+		shoot.Spec.SeedSelector = &gardencorev1beta1.SeedSelector{
+			LabelSelector: metav1.LabelSelector{MatchLabels: g.Labels()},
+		}
+	} else {
+		shoot.Spec.SeedName = ptr.To(seedName)
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+	}
+
+	return shoot
+}
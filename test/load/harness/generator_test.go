@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package harness_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/test/load/harness"
+)
+
+var _ = Describe("Generator", func() {
+	var generator *harness.Generator
+
+	BeforeEach(func() {
+		generator = &harness.Generator{
+			RunID:            "ab12",
+			ProjectNamespace: "garden-local",
+			TemplateSeed: &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "local"},
+				Spec: gardencorev1beta1.SeedSpec{
+					Backup: &gardencorev1beta1.Backup{Provider: "local"},
+					DNS: gardencorev1beta1.SeedDNS{
+						Defaults: []gardencorev1beta1.SeedDNSProviderConfig{{Domain: "external.local.gardener.cloud"}},
+					},
+					Ingress:  &gardencorev1beta1.Ingress{Domain: "ingress.local.seed.local.gardener.cloud"},
+					Provider: gardencorev1beta1.SeedProvider{Type: "local", Region: "local"},
+				},
+			},
+			ShootTemplate: harness.ShootTemplate{
+				CloudProfileName:       "local",
+				CredentialsBindingName: "local",
+				Region:                 "local",
+				ProviderType:           "local",
+				MachineType:            "local",
+				NetworkingType:         "calico",
+				NodesCIDR:              "10.0.0.0/16",
+				KubernetesVersion:      "1.34",
+			},
+		}
+	})
+
+	Describe("#NewRunID", func() {
+		It("should return a short random ID", func() {
+			runID, err := harness.NewRunID()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runID).To(MatchRegexp(`^[0-9a-z]{4}$`))
+		})
+	})
+
+	Describe("#Seed", func() {
+		It("should copy the template seed without backup and default domains", func() {
+			seed := generator.Seed(3)
+
+			Expect(seed.Name).To(Equal("ld-ab12-s3"))
+			Expect(seed.Labels).To(Equal(map[string]string{"load.gardener.cloud/run": "ab12"}))
+			Expect(seed.Spec.Backup).To(BeNil())
+			Expect(seed.Spec.DNS.Defaults).To(BeEmpty())
+			Expect(seed.Spec.Ingress.Domain).To(Equal("ld-ab12-s3.ingress.local.seed.local.gardener.cloud"))
+			Expect(seed.Spec.Provider).To(Equal(generator.TemplateSeed.Spec.Provider))
+			Expect(seed.Spec.Settings.Scheduling.Visible).To(BeTrue())
+
+			Expect(generator.TemplateSeed.Spec.Backup).NotTo(BeNil())
+			Expect(generator.TemplateSeed.Spec.Ingress.Domain).To(Equal("ingress.local.seed.local.gardener.cloud"))
+		})
+	})
+
+	Describe("#MutateSeedStatus", func() {
+		It("should mark the seed as ready", func() {
+			now := time.Now()
+			seed := generator.Seed(0)
+			seed.Generation = 2
+
+			generator.MutateSeedStatus(seed, now)
+
+			Expect(seed.Status.ObservedGeneration).To(Equal(int64(2)))
+			Expect(seed.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			Expect(seed.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(gardencorev1beta1.GardenletReady),
+				"Status": Equal(gardencorev1beta1.ConditionTrue),
+			})))
+		})
+	})
+
+	Describe("#MutateSeedLease", func() {
+		It("should renew the lease", func() {
+			now := time.Now()
+			seed := generator.Seed(0)
+			seed.UID = "uid"
+			lease := &coordinationv1.Lease{}
+
+			generator.MutateSeedLease(lease, seed, now)
+
+			Expect(lease.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+				APIVersion: "core.gardener.cloud/v1beta1",
+				Kind:       "Seed",
+				Name:       "ld-ab12-s0",
+				UID:        "uid",
+			}))
+			Expect(lease.Spec.HolderIdentity).To(PointTo(Equal("ld-ab12-s0")))
+			Expect(lease.Spec.RenewTime.Time).To(Equal(now))
+		})
+	})
+
+	Describe("#Shoot", func() {
+		It("should select the synthetic seeds if no seed name is given", func() {
+			shoot := generator.Shoot(42, "")
+
+			Expect(shoot.Name).To(Equal("ld-ab12-42"))
+			Expect(shoot.Namespace).To(Equal("garden-local"))
+			Expect(shoot.Spec.SeedName).To(BeNil())
+			Expect(shoot.Spec.SeedSelector.MatchLabels).To(Equal(map[string]string{"load.gardener.cloud/run": "ab12"}))
+			Expect(shoot.Spec.Hibernation).To(BeNil())
+			Expect(shoot.Spec.Provider.Workers).To(HaveLen(1))
+			Expect(shoot.Spec.Networking.Nodes).To(PointTo(Equal("10.0.0.0/16")))
+		})
+
+		It("should bind the shoot to the given seed and hibernate it", func() {
+			shoot := generator.Shoot(1, "local")
+
+			Expect(shoot.Spec.SeedName).To(PointTo(Equal("local")))
+			Expect(shoot.Spec.SeedSelector).To(BeNil())
+			Expect(shoot.Spec.Hibernation.Enabled).To(Equal(ptr.To(true)))
+		})
+	})
+})
@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package harness
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"sync"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	metricQueueDuration = runtimemetrics.WorkQueueSubsystem + "_" + runtimemetrics.QueueLatencyKey
+	metricWorkDuration  = runtimemetrics.WorkQueueSubsystem + "_" + runtimemetrics.WorkDurationKey
+	metricDepth         = runtimemetrics.WorkQueueSubsystem + "_" + runtimemetrics.DepthKey
+	metricAdds          = runtimemetrics.WorkQueueSubsystem + "_" + runtimemetrics.AddsKey
+)
+
+// Bucket is a cumulative bucket of a histogram.
+type Bucket struct {
+	// UpperBound is the upper bound of the bucket.
+	UpperBound float64
+	// CumulativeCount is the number of observations less than or equal to the upper bound.
+	CumulativeCount uint64
+}
+
+// Histogram is a Prometheus histogram.
+type Histogram struct {
+	// Buckets are the buckets of the histogram sorted by their upper bound.
+	Buckets []Bucket
+	// Count is the total number of observations.
+	Count uint64
+}
+
+// Sub returns the histogram of the observations made since the given older snapshot of the same histogram.
+func (h Histogram) Sub(older Histogram) Histogram {
+	out := Histogram{Count: h.Count - min(older.Count, h.Count)}
+	for i, bucket := range h.Buckets {
+		var olderCount uint64
+		if i < len(older.Buckets) && older.Buckets[i].UpperBound == bucket.UpperBound {
+			olderCount = older.Buckets[i].CumulativeCount
+		}
+		out.Buckets = append(out.Buckets, Bucket{UpperBound: bucket.UpperBound, CumulativeCount: bucket.CumulativeCount - min(olderCount, bucket.CumulativeCount)})
+	}
+	return out
+}
+
+// Quantile estimates the given quantile by linear interpolation within the bucket it falls into, like PromQL's
+// histogram_quantile does. Observations in the +Inf bucket are attributed to the highest finite upper bound.
+func (h Histogram) Quantile(q float64) float64 {
+	if h.Count == 0 || len(h.Buckets) == 0 {
+		return 0
+	}
+
+	var (
+		rank       = q * float64(h.Count)
+		lowerBound float64
+		lowerCount uint64
+	)
+
+	for _, bucket := range h.Buckets {
+		if math.IsInf(bucket.UpperBound, 1) {
+			break
+		}
+
+		if float64(bucket.CumulativeCount) >= rank {
+			inBucket := bucket.CumulativeCount - lowerCount
+			if inBucket == 0 {
+				return bucket.UpperBound
+			}
+			return lowerBound + (bucket.UpperBound-lowerBound)*(rank-float64(lowerCount))/float64(inBucket)
+		}
+
+		lowerBound, lowerCount = bucket.UpperBound, bucket.CumulativeCount
+	}
+
+	return lowerBound
+}
+
+// Summarize summarizes the histogram.
+func (h Histogram) Summarize() LatencySummary {
+	return LatencySummary{
+		Count: h.Count,
+		P50:   h.Quantile(0.5),
+		P90:   h.Quantile(0.9),
+		P99:   h.Quantile(0.99),
+		Max:   h.Quantile(1),
+	}
+}
+
+// QueueMetrics contains the metrics of a controller's workqueue.
+type QueueMetrics struct {
+	// QueueDuration is the histogram of how long items stay in the queue before being processed.
+	QueueDuration Histogram
+	// WorkDuration is the histogram of how long processing an item takes.
+	WorkDuration Histogram
+	// Depth is the current depth of the queue.
+	Depth float64
+	// Adds is the total number of items added to the queue.
+	Adds float64
+}
+
+// ParseWorkqueueMetrics parses the workqueue metrics of all controllers from the given Prometheus text exposition.
+// The result is keyed by the name of the workqueue.
+func ParseWorkqueueMetrics(r io.Reader) (map[string]QueueMetrics, error) {
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing metrics: %w", err)
+	}
+
+	out := make(map[string]QueueMetrics)
+	update := func(metric *dto.Metric, mutate func(*QueueMetrics)) {
+		name := labelValue(metric, "name")
+		if name == "" {
+			return
+		}
+		queue := out[name]
+		mutate(&queue)
+		out[name] = queue
+	}
+
+	for _, metric := range families[metricQueueDuration].GetMetric() {
+		update(metric, func(q *QueueMetrics) { q.QueueDuration = toHistogram(metric.GetHistogram()) })
+	}
+	for _, metric := range families[metricWorkDuration].GetMetric() {
+		update(metric, func(q *QueueMetrics) { q.WorkDuration = toHistogram(metric.GetHistogram()) })
+	}
+	for _, metric := range families[metricDepth].GetMetric() {
+		// The depth is reported per priority, hence, sum it up.
+		update(metric, func(q *QueueMetrics) { q.Depth += metric.GetGauge().GetValue() })
+	}
+	for _, metric := range families[metricAdds].GetMetric() {
+		update(metric, func(q *QueueMetrics) { q.Adds = metric.GetCounter().GetValue() })
+	}
+
+	return out, nil
+}
+
+func labelValue(metric *dto.Metric, name string) string {
+	for _, label := range metric.GetLabel() {
+		if label.GetName() == name {
+			return label.GetValue()
+		}
+	}
+	return ""
+}
+
+func toHistogram(h *dto.Histogram) Histogram {
+	out := Histogram{Count: h.GetSampleCount()}
+	for _, bucket := range h.GetBucket() {
+		out.Buckets = append(out.Buckets, Bucket{UpperBound: bucket.GetUpperBound(), CumulativeCount: bucket.GetCumulativeCount()})
+	}
+	slices.SortFunc(out.Buckets, func(a, b Bucket) int { return cmp.Compare(a.UpperBound, b.UpperBound) })
+	return out
+}
+
+// QueueSummary summarizes the behaviour of a controller's workqueue during a load test run.
+type QueueSummary struct {
+	// Adds is the number of items added to the queue during the run.
+	Adds float64 `json:"adds"`
+	// MaxDepth is the maximum depth of the queue observed during the run.
+	MaxDepth float64 `json:"maxDepth"`
+	// QueueDuration summarizes how long items stayed in the queue before being processed.
+	QueueDuration LatencySummary `json:"queueDuration"`
+	// WorkDuration summarizes how long processing an item took.
+	WorkDuration LatencySummary `json:"workDuration"`
+}
+
+// MetricsCollector periodically scrapes the metrics endpoints of the Gardener components and keeps track of their
+// workqueues.
+type MetricsCollector struct {
+	// Endpoints maps component names to the URLs of their metrics endpoints.
+	Endpoints map[string]string
+	// HTTPClient is the client used for scraping.
+	HTTPClient *http.Client
+
+	lock     sync.Mutex
+	first    map[string]map[string]QueueMetrics
+	last     map[string]map[string]QueueMetrics
+	maxDepth map[string]map[string]float64
+}
+
+// Collect scrapes all endpoints once. The first successful scrape of an endpoint serves as the baseline which later
+// scrapes are compared against.
+func (c *MetricsCollector) Collect(ctx context.Context) error {
+	var errs []error
+
+	for component, url := range c.Endpoints {
+		queues, err := c.scrape(ctx, url)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed scraping metrics of %s: %w", component, err))
+			continue
+		}
+
+		c.lock.Lock()
+		if c.first == nil {
+			c.first, c.last, c.maxDepth = make(map[string]map[string]QueueMetrics), make(map[string]map[string]QueueMetrics), make(map[string]map[string]float64)
+		}
+		if _, ok := c.first[component]; !ok {
+			c.first[component] = queues
+			c.maxDepth[component] = make(map[string]float64)
+		}
+		c.last[component] = queues
+		for name, queue := range queues {
+			c.maxDepth[component][name] = max(c.maxDepth[component][name], queue.Depth)
+		}
+		c.lock.Unlock()
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *MetricsCollector) scrape(ctx context.Context, url string) (map[string]QueueMetrics, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return ParseWorkqueueMetrics(resp.Body)
+}
+
+// Summaries returns the summaries of all workqueues for the time between the first and the last scrape. The result is
+// keyed by "<component>/<workqueue>". Workqueues without any additions during the run are omitted.
+func (c *MetricsCollector) Summaries() map[string]QueueSummary {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	out := make(map[string]QueueSummary)
+	for component, queues := range c.last {
+		for name, last := range queues {
+			first := c.first[component][name]
+			if last.Adds-first.Adds <= 0 {
+				continue
+			}
+
+			out[component+"/"+name] = QueueSummary{
+				Adds:          last.Adds - first.Adds,
+				MaxDepth:      c.maxDepth[component][name],
+				QueueDuration: last.QueueDuration.Sub(first.QueueDuration).Summarize(),
+				WorkDuration:  last.WorkDuration.Sub(first.WorkDuration).Summarize(),
+			}
+		}
+	}
+	return out
+}
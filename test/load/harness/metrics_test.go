@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package harness_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/test/load/harness"
+)
+
+func workqueueMetrics(name string, adds, depth float64, queueBuckets [3]uint64) string {
+	return fmt.Sprintf(`# HELP workqueue_adds_total Total number of adds handled by workqueue
+# TYPE workqueue_adds_total counter
+workqueue_adds_total{controller="%[1]s",name="%[1]s"} %[2]v
+# HELP workqueue_depth Current depth of workqueue
+# TYPE workqueue_depth gauge
+workqueue_depth{controller="%[1]s",name="%[1]s",priority=""} %[3]v
+workqueue_depth{controller="%[1]s",name="%[1]s",priority="10"} 1
+# HELP workqueue_queue_duration_seconds How long in seconds an item stays in workqueue before being requested
+# TYPE workqueue_queue_duration_seconds histogram
+workqueue_queue_duration_seconds_bucket{controller="%[1]s",name="%[1]s",le="0.1"} %[4]d
+workqueue_queue_duration_seconds_bucket{controller="%[1]s",name="%[1]s",le="1"} %[5]d
+workqueue_queue_duration_seconds_bucket{controller="%[1]s",name="%[1]s",le="+Inf"} %[6]d
+workqueue_queue_duration_seconds_sum{controller="%[1]s",name="%[1]s"} 1
+workqueue_queue_duration_seconds_count{controller="%[1]s",name="%[1]s"} %[6]d
+# HELP workqueue_work_duration_seconds How long in seconds processing an item from workqueue takes
+# TYPE workqueue_work_duration_seconds histogram
+workqueue_work_duration_seconds_bucket{controller="%[1]s",name="%[1]s",le="0.1"} %[4]d
+workqueue_work_duration_seconds_bucket{controller="%[1]s",name="%[1]s",le="1"} %[5]d
+workqueue_work_duration_seconds_bucket{controller="%[1]s",name="%[1]s",le="+Inf"} %[6]d
+workqueue_work_duration_seconds_sum{controller="%[1]s",name="%[1]s"} 1
+workqueue_work_duration_seconds_count{controller="%[1]s",name="%[1]s"} %[6]d
+`, name, adds, depth, queueBuckets[0], queueBuckets[1], queueBuckets[2])
+}
+
+var _ = Describe("Metrics", func() {
+	Describe("Histogram", func() {
+		histogram := harness.Histogram{
+			Count: 10,
+			Buckets: []harness.Bucket{
+				{UpperBound: 1, CumulativeCount: 5},
+				{UpperBound: 2, CumulativeCount: 5},
+				{UpperBound: 4, CumulativeCount: 9},
+				{UpperBound: math.Inf(1), CumulativeCount: 10},
+			},
+		}
+
+		Describe("#Quantile", func() {
+			It("should interpolate within the bucket", func() {
+				Expect(histogram.Quantile(0.25)).To(BeNumerically("~", 0.5))
+				Expect(histogram.Quantile(0.5)).To(BeNumerically("~", 1))
+				Expect(histogram.Quantile(0.7)).To(BeNumerically("~", 3))
+			})
+
+			It("should return the highest finite upper bound for observations in the +Inf bucket", func() {
+				Expect(histogram.Quantile(0.99)).To(BeNumerically("~", 4))
+				Expect(histogram.Quantile(1)).To(BeNumerically("~", 4))
+			})
+
+			It("should return 0 for empty histograms", func() {
+				Expect(harness.Histogram{}.Quantile(0.5)).To(BeZero())
+			})
+		})
+
+		Describe("#Sub", func() {
+			It("should return the observations made since the older snapshot", func() {
+				older := harness.Histogram{
+					Count: 4,
+					Buckets: []harness.Bucket{
+						{UpperBound: 1, CumulativeCount: 4},
+						{UpperBound: 2, CumulativeCount: 4},
+						{UpperBound: 4, CumulativeCount: 4},
+						{UpperBound: math.Inf(1), CumulativeCount: 4},
+					},
+				}
+
+				Expect(histogram.Sub(older)).To(Equal(harness.Histogram{
+					Count: 6,
+					Buckets: []harness.Bucket{
+						{UpperBound: 1, CumulativeCount: 1},
+						{UpperBound: 2, CumulativeCount: 1},
+						{UpperBound: 4, CumulativeCount: 5},
+						{UpperBound: math.Inf(1), CumulativeCount: 6},
+					},
+				}))
+			})
+
+			It("should return the histogram itself if the older snapshot is empty", func() {
+				Expect(histogram.Sub(harness.Histogram{})).To(Equal(histogram))
+			})
+		})
+	})
+
+	Describe("#ParseWorkqueueMetrics", func() {
+		It("should parse the workqueue metrics", func() {
+			queues, err := harness.ParseWorkqueueMetrics(strings.NewReader(workqueueMetrics("shoot", 12, 3, [3]uint64{2, 8, 10})))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(queues).To(HaveLen(1))
+			Expect(queues["shoot"].Adds).To(Equal(float64(12)))
+			Expect(queues["shoot"].Depth).To(Equal(float64(4)))
+			Expect(queues["shoot"].QueueDuration.Count).To(Equal(uint64(10)))
+			Expect(queues["shoot"].QueueDuration.Buckets).To(Equal([]harness.Bucket{
+				{UpperBound: 0.1, CumulativeCount: 2},
+				{UpperBound: 1, CumulativeCount: 8},
+				{UpperBound: math.Inf(1), CumulativeCount: 10},
+			}))
+			Expect(queues["shoot"].WorkDuration).To(Equal(queues["shoot"].QueueDuration))
+		})
+
+		It("should fail for invalid input", func() {
+			_, err := harness.ParseWorkqueueMetrics(strings.NewReader("invalid metric{"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("MetricsCollector", func() {
+		var (
+			ctx       = context.Background()
+			responses []string
+			server    *httptest.Server
+			collector *harness.MetricsCollector
+		)
+
+		BeforeEach(func() {
+			responses = []string{
+				workqueueMetrics("shoot", 10, 0, [3]uint64{5, 5, 5}),
+				workqueueMetrics("shoot", 20, 7, [3]uint64{5, 15, 15}),
+				workqueueMetrics("shoot", 30, 2, [3]uint64{5, 25, 25}),
+			}
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				response := responses[0]
+				responses = responses[1:]
+				_, _ = w.Write([]byte(response))
+			}))
+			DeferCleanup(server.Close)
+
+			collector = &harness.MetricsCollector{
+				Endpoints:  map[string]string{"scheduler": server.URL},
+				HTTPClient: server.Client(),
+			}
+		})
+
+		It("should summarize the workqueues between the first and the last scrape", func() {
+			Expect(collector.Collect(ctx)).To(Succeed())
+			Expect(collector.Collect(ctx)).To(Succeed())
+			Expect(collector.Collect(ctx)).To(Succeed())
+
+			summaries := collector.Summaries()
+			Expect(summaries).To(HaveLen(1))
+			Expect(summaries["scheduler/shoot"].Adds).To(Equal(float64(20)))
+			Expect(summaries["scheduler/shoot"].MaxDepth).To(Equal(float64(8)))
+			Expect(summaries["scheduler/shoot"].QueueDuration.Count).To(Equal(uint64(20)))
+			Expect(summaries["scheduler/shoot"].QueueDuration.P50).To(BeNumerically("~", 0.55))
+		})
+
+		It("should omit workqueues without additions", func() {
+			responses[1] = responses[0]
+
+			Expect(collector.Collect(ctx)).To(Succeed())
+			Expect(collector.Collect(ctx)).To(Succeed())
+
+			Expect(collector.Summaries()).To(BeEmpty())
+		})
+
+		It("should fail if an endpoint cannot be scraped", func() {
+			collector.Endpoints["gardenlet"] = server.URL + "/unknown"
+			server.Config.Handler = http.NotFoundHandler()
+
+			Expect(collector.Collect(ctx)).To(MatchError(ContainSubstring("failed scraping metrics of gardenlet")))
+		})
+	})
+})
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package harness
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// PhaseCreate is the duration of the API request creating an object.
+	PhaseCreate = "shoot-create"
+	// PhaseScheduling is the duration from the creation of a shoot until the gardener-scheduler assigned a seed.
+	PhaseScheduling = "shoot-scheduling"
+	// PhaseGardenletPickup is the duration from the creation of a shoot until the gardenlet started reconciling it,
+	// i.e., reported the first last operation.
+	PhaseGardenletPickup = "shoot-gardenlet-pickup"
+)
+
+// Recorder records the latencies of the phases which objects of a load test run go through.
+type Recorder struct {
+	clock clock.Clock
+
+	lock      sync.Mutex
+	created   map[string]time.Time
+	expected  map[string]sets.Set[string]
+	early     map[string]map[string]time.Time
+	latencies map[string][]time.Duration
+}
+
+// NewRecorder returns a new Recorder.
+func NewRecorder(clock clock.Clock) *Recorder {
+	return &Recorder{
+		clock:     clock,
+		created:   make(map[string]time.Time),
+		expected:  make(map[string]sets.Set[string]),
+		early:     make(map[string]map[string]time.Time),
+		latencies: make(map[string][]time.Duration),
+	}
+}
+
+// Created records that the create request for the object with the given key was sent at the given time and has just
+// returned successfully. The object is expected to go through the given phases.
+func (r *Recorder) Created(key string, createdAt time.Time, phases ...string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.created[key] = createdAt
+	r.latencies[PhaseCreate] = append(r.latencies[PhaseCreate], r.clock.Now().Sub(createdAt))
+
+	for _, phase := range phases {
+		// The watch might deliver the object before the create request returned.
+		if observedAt, ok := r.early[phase][key]; ok {
+			delete(r.early[phase], key)
+			r.latencies[phase] = append(r.latencies[phase], observedAt.Sub(createdAt))
+			continue
+		}
+
+		if r.expected[phase] == nil {
+			r.expected[phase] = sets.New[string]()
+		}
+		r.expected[phase].Insert(key)
+	}
+}
+
+// Observe records that the object with the given key reached the given phase. Only the first observation of an
+// expected phase is recorded.
+func (r *Recorder) Observe(phase, key string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.clock.Now()
+
+	createdAt, ok := r.created[key]
+	if !ok {
+		if r.early[phase] == nil {
+			r.early[phase] = make(map[string]time.Time)
+		}
+		if _, ok := r.early[phase][key]; !ok {
+			r.early[phase][key] = now
+		}
+		return
+	}
+
+	if !r.expected[phase].Has(key) {
+		return
+	}
+
+	r.expected[phase].Delete(key)
+	r.latencies[phase] = append(r.latencies[phase], now.Sub(createdAt))
+}
+
+// ObserveShoot records the phases reached by the given shoot.
+func (r *Recorder) ObserveShoot(shoot *gardencorev1beta1.Shoot) {
+	key := shoot.Namespace + "/" + shoot.Name
+
+	if shoot.Spec.SeedName != nil {
+		r.Observe(PhaseScheduling, key)
+	}
+	if shoot.Status.LastOperation != nil {
+		r.Observe(PhaseGardenletPickup, key)
+	}
+}
+
+// Pending returns the number of objects which have not yet reached the given phase.
+func (r *Recorder) Pending(phase string) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.expected[phase].Len()
+}
+
+// Done returns true if all objects reached all expected phases.
+func (r *Recorder) Done() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, keys := range r.expected {
+		if keys.Len() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Summaries returns the latency summaries of all phases.
+func (r *Recorder) Summaries() map[string]LatencySummary {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	out := make(map[string]LatencySummary, len(r.latencies))
+	for phase, latencies := range r.latencies {
+		out[phase] = SummarizeDurations(latencies)
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package harness_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/test/load/harness"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		fakeClock *testclock.FakeClock
+		recorder  *harness.Recorder
+
+		shoot *gardencorev1beta1.Shoot
+		key   = "garden-local/ld-ab12-0"
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		recorder = harness.NewRecorder(fakeClock)
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "ld-ab12-0", Namespace: "garden-local"}}
+	})
+
+	It("should record the latencies of the expected phases", func() {
+		createdAt := fakeClock.Now()
+		fakeClock.Step(100 * time.Millisecond)
+		recorder.Created(key, createdAt, harness.PhaseScheduling)
+
+		Expect(recorder.Pending(harness.PhaseScheduling)).To(Equal(1))
+		Expect(recorder.Done()).To(BeFalse())
+
+		fakeClock.Step(time.Second)
+		recorder.ObserveShoot(shoot)
+		Expect(recorder.Pending(harness.PhaseScheduling)).To(Equal(1))
+
+		shoot.Spec.SeedName = ptr.To("ld-ab12-s0")
+		recorder.ObserveShoot(shoot)
+		fakeClock.Step(time.Second)
+		recorder.ObserveShoot(shoot)
+
+		Expect(recorder.Pending(harness.PhaseScheduling)).To(Equal(0))
+		Expect(recorder.Done()).To(BeTrue())
+		Expect(recorder.Summaries()).To(Equal(map[string]harness.LatencySummary{
+			harness.PhaseCreate:     {Count: 1, P50: 0.1, P90: 0.1, P99: 0.1, Max: 0.1},
+			harness.PhaseScheduling: {Count: 1, P50: 1.1, P90: 1.1, P99: 1.1, Max: 1.1},
+		}))
+	})
+
+	It("should not record phases which are not expected", func() {
+		recorder.Created(key, fakeClock.Now(), harness.PhaseGardenletPickup)
+
+		shoot.Spec.SeedName = ptr.To("local")
+		recorder.ObserveShoot(shoot)
+
+		Expect(recorder.Summaries()).NotTo(HaveKey(harness.PhaseScheduling))
+		Expect(recorder.Pending(harness.PhaseGardenletPickup)).To(Equal(1))
+
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{}
+		recorder.ObserveShoot(shoot)
+
+		Expect(recorder.Summaries()).To(HaveKey(harness.PhaseGardenletPickup))
+		Expect(recorder.Done()).To(BeTrue())
+	})
+
+	It("should record phases observed before the create request returned", func() {
+		createdAt := fakeClock.Now()
+		fakeClock.Step(time.Second)
+
+		shoot.Spec.SeedName = ptr.To("ld-ab12-s0")
+		recorder.ObserveShoot(shoot)
+
+		fakeClock.Step(time.Second)
+		recorder.Created(key, createdAt, harness.PhaseScheduling)
+
+		Expect(recorder.Done()).To(BeTrue())
+		Expect(recorder.Summaries()[harness.PhaseScheduling]).To(Equal(harness.LatencySummary{Count: 1, P50: 1, P90: 1, P99: 1, Max: 1}))
+	})
+})
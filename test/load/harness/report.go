@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package harness
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Report is the result of a load test run.
+type Report struct {
+	// RunID is the ID of the load test run.
+	RunID string `json:"runID"`
+	// StartTime is the time the run started.
+	StartTime time.Time `json:"startTime"`
+	// EndTime is the time the measurement of the run ended.
+	EndTime time.Time `json:"endTime"`
+	// Seeds is the number of synthetic seeds.
+	Seeds int `json:"seeds"`
+	// Shoots is the number of shoots scheduled onto the synthetic seeds.
+	Shoots int `json:"shoots"`
+	// SeedBoundShoots is the number of shoots bound to a real seed.
+	SeedBoundShoots int `json:"seedBoundShoots"`
+	// Errors is the number of failed requests.
+	Errors int `json:"errors"`
+	// Pending is the number of objects per phase which did not reach the phase before the run ended.
+	Pending map[string]int `json:"pending,omitempty"`
+	// Latencies contains the summaries of the latencies per phase.
+	Latencies map[string]LatencySummary `json:"latencies"`
+	// Queues contains the summaries of the workqueues keyed by "<component>/<workqueue>".
+	Queues map[string]QueueSummary `json:"queues,omitempty"`
+}
+
+// Regression is a quantile which got worse compared to the baseline.
+type Regression struct {
+	// Metric is the name of the metric, e.g. "latency/shoot-scheduling" or "queue/scheduler/shoot/queueDuration".
+	Metric string
+	// Quantile is the quantile which regressed.
+	Quantile string
+	// Baseline is the value in the baseline report in seconds.
+	Baseline float64
+	// Current is the value in the current report in seconds.
+	Current float64
+}
+
+func (r Regression) String() string {
+	return fmt.Sprintf("%s %s: %.3fs -> %.3fs (%+.0f%%)", r.Metric, r.Quantile, r.Baseline, r.Current, (r.Current/r.Baseline-1)*100)
+}
+
+// Compare compares the current report with the baseline report and returns all quantiles which got worse by more than
+// the given relative tolerance and more than the given absolute minimum. The absolute minimum prevents noise in very
+// short latencies from being reported. Metrics which are only present in one of the reports are ignored.
+func Compare(baseline, current *Report, tolerance float64, minDelta time.Duration) []Regression {
+	var regressions []Regression
+
+	compare := func(metric string, baseline, current LatencySummary) {
+		if baseline.Count == 0 || current.Count == 0 {
+			return
+		}
+
+		currentQuantiles := current.Quantiles()
+		for quantile, baselineValue := range baseline.Quantiles() {
+			currentValue := currentQuantiles[quantile]
+			if currentValue > baselineValue*(1+tolerance) && currentValue-baselineValue > minDelta.Seconds() {
+				regressions = append(regressions, Regression{Metric: metric, Quantile: quantile, Baseline: baselineValue, Current: currentValue})
+			}
+		}
+	}
+
+	for phase, summary := range current.Latencies {
+		compare("latency/"+phase, baseline.Latencies[phase], summary)
+	}
+	for queue, summary := range current.Queues {
+		compare("queue/"+queue+"/queueDuration", baseline.Queues[queue].QueueDuration, summary.QueueDuration)
+		compare("queue/"+queue+"/workDuration", baseline.Queues[queue].WorkDuration, summary.WorkDuration)
+	}
+
+	slices.SortFunc(regressions, func(a, b Regression) int {
+		return strings.Compare(a.Metric+"/"+a.Quantile, b.Metric+"/"+b.Quantile)
+	})
+	return regressions
+}
+
+// ReadReport reads a report from the given JSON file.
+func ReadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path) // #nosec: G304 -- The path is provided by the user running the load test.
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling report %s: %w", path, err)
+	}
+	return report, nil
+}
+
+// WriteJSON writes the report as JSON to the given file.
+func (r *Report) WriteJSON(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// WriteText writes a human-readable representation of the report to the given writer.
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Load test run %s (%s)\n", r.RunID, r.EndTime.Sub(r.StartTime).Round(time.Second))
+	fmt.Fprintf(tw, "Seeds: %d, Shoots: %d, Seed-bound shoots: %d, Errors: %d\n\n", r.Seeds, r.Shoots, r.SeedBoundShoots, r.Errors)
+
+	fmt.Fprintln(tw, "PHASE\tCOUNT\tPENDING\tP50\tP90\tP99\tMAX")
+	for _, phase := range sets.List(sets.KeySet(r.Latencies).Union(sets.KeySet(r.Pending))) {
+		s := r.Latencies[phase]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.3fs\t%.3fs\t%.3fs\t%.3fs\n", phase, s.Count, r.Pending[phase], s.P50, s.P90, s.P99, s.Max)
+	}
+
+	if len(r.Queues) > 0 {
+		fmt.Fprintln(tw, "\nWORKQUEUE\tADDS\tMAX DEPTH\tQUEUE P50\tQUEUE P99\tWORK P50\tWORK P99")
+		for _, queue := range sets.List(sets.KeySet(r.Queues)) {
+			s := r.Queues[queue]
+			fmt.Fprintf(tw, "%s\t%.0f\t%.0f\t%.3fs\t%.3fs\t%.3fs\t%.3fs\n", queue, s.Adds, s.MaxDepth, s.QueueDuration.P50, s.QueueDuration.P99, s.WorkDuration.P50, s.WorkDuration.P99)
+		}
+	}
+
+	return tw.Flush()
+}
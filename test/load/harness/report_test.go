@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package harness_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/test/load/harness"
+)
+
+var _ = Describe("Report", func() {
+	var baseline, current *harness.Report
+
+	BeforeEach(func() {
+		baseline = &harness.Report{
+			RunID: "base",
+			Latencies: map[string]harness.LatencySummary{
+				harness.PhaseScheduling: {Count: 100, P50: 1, P90: 2, P99: 3, Max: 4},
+				harness.PhaseCreate:     {Count: 100, P50: 0.01, P90: 0.02, P99: 0.03, Max: 0.04},
+			},
+			Queues: map[string]harness.QueueSummary{
+				"scheduler/shoot": {
+					Adds:          100,
+					QueueDuration: harness.LatencySummary{Count: 100, P50: 1, P90: 1, P99: 1},
+					WorkDuration:  harness.LatencySummary{Count: 100, P50: 1, P90: 1, P99: 1},
+				},
+			},
+		}
+
+		current = &harness.Report{
+			RunID: "current",
+			Latencies: map[string]harness.LatencySummary{
+				harness.PhaseScheduling:      {Count: 100, P50: 1.1, P90: 3, P99: 3, Max: 10},
+				harness.PhaseCreate:          {Count: 100, P50: 0.05, P90: 0.05, P99: 0.05, Max: 0.05},
+				harness.PhaseGardenletPickup: {Count: 1, P50: 100, P90: 100, P99: 100, Max: 100},
+			},
+			Queues: map[string]harness.QueueSummary{
+				"scheduler/shoot": {
+					Adds:          100,
+					QueueDuration: harness.LatencySummary{Count: 100, P50: 1, P90: 1, P99: 2},
+					WorkDuration:  harness.LatencySummary{Count: 100, P50: 1, P90: 1, P99: 1},
+				},
+			},
+		}
+	})
+
+	Describe("#Compare", func() {
+		It("should report quantiles exceeding the tolerance and the minimum delta", func() {
+			Expect(harness.Compare(baseline, current, 0.2, 100*time.Millisecond)).To(Equal([]harness.Regression{
+				{Metric: "latency/shoot-scheduling", Quantile: "p90", Baseline: 2, Current: 3},
+				{Metric: "queue/scheduler/shoot/queueDuration", Quantile: "p99", Baseline: 1, Current: 2},
+			}))
+		})
+
+		It("should report small deltas if the minimum delta is zero", func() {
+			Expect(harness.Compare(baseline, current, 0.2, 0)).To(ContainElement(
+				harness.Regression{Metric: "latency/shoot-create", Quantile: "p50", Baseline: 0.01, Current: 0.05},
+			))
+		})
+
+		It("should not report anything when comparing against itself", func() {
+			Expect(harness.Compare(baseline, baseline, 0, 0)).To(BeEmpty())
+		})
+	})
+
+	It("should write and read the report", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "report.json")
+
+		Expect(current.WriteJSON(path)).To(Succeed())
+		Expect(harness.ReadReport(path)).To(Equal(current))
+	})
+
+	It("should write a human-readable report", func() {
+		current.Pending = map[string]int{harness.PhaseGardenletPickup: 2}
+
+		buf := &bytes.Buffer{}
+		Expect(current.WriteText(buf)).To(Succeed())
+
+		Expect(buf.String()).To(ContainSubstring("Load test run current"))
+		Expect(buf.String()).To(MatchRegexp(`shoot-gardenlet-pickup\s+1\s+2\s+100.000s`))
+		Expect(buf.String()).To(MatchRegexp(`scheduler/shoot\s+100\s+0\s+1.000s\s+2.000s`))
+	})
+})
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package harness
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// LatencySummary summarizes the distribution of a latency. All values are in seconds.
+type LatencySummary struct {
+	// Count is the number of samples.
+	Count uint64 `json:"count"`
+	// P50 is the median.
+	P50 float64 `json:"p50"`
+	// P90 is the 90th percentile.
+	P90 float64 `json:"p90"`
+	// P99 is the 99th percentile.
+	P99 float64 `json:"p99"`
+	// Max is the maximum.
+	Max float64 `json:"max"`
+}
+
+// Quantiles returns the quantiles of the summary which are compared against a baseline.
+func (s LatencySummary) Quantiles() map[string]float64 {
+	return map[string]float64{"p50": s.P50, "p90": s.P90, "p99": s.P99}
+}
+
+// SummarizeDurations computes the summary of the given durations using the nearest-rank method.
+func SummarizeDurations(durations []time.Duration) LatencySummary {
+	if len(durations) == 0 {
+		return LatencySummary{}
+	}
+
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	percentile := func(p float64) float64 {
+		rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+		return sorted[max(rank, 0)].Seconds()
+	}
+
+	return LatencySummary{
+		Count: uint64(len(sorted)),
+		P50:   percentile(0.5),
+		P90:   percentile(0.9),
+		P99:   percentile(0.99),
+		Max:   sorted[len(sorted)-1].Seconds(),
+	}
+}
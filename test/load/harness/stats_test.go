@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package harness_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/test/load/harness"
+)
+
+var _ = Describe("Stats", func() {
+	Describe("#SummarizeDurations", func() {
+		It("should return an empty summary if there are no samples", func() {
+			Expect(harness.SummarizeDurations(nil)).To(Equal(harness.LatencySummary{}))
+		})
+
+		It("should compute the percentiles using the nearest rank", func() {
+			var durations []time.Duration
+			for i := 100; i > 0; i-- {
+				durations = append(durations, time.Duration(i)*time.Second)
+			}
+
+			Expect(harness.SummarizeDurations(durations)).To(Equal(harness.LatencySummary{Count: 100, P50: 50, P90: 90, P99: 99, Max: 100}))
+			Expect(durations[0]).To(Equal(100*time.Second), "input must not be sorted in place")
+		})
+	})
+})
@@ -0,0 +1,447 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/test/load/harness"
+)
+
+const name = "load-test"
+
+func main() {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   name,
+		Short: "Generate synthetic Seeds and Shoots and measure the latencies of the Gardener control plane",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			log, err := logger.NewZapLogger(logger.InfoLevel, logger.FormatText)
+			if err != nil {
+				return fmt.Errorf("error instantiating zap logger: %w", err)
+			}
+
+			logf.SetLogger(log)
+			klog.SetLogger(log)
+
+			log = logf.Log.WithName(name)
+
+			// don't output usage on further errors raised during execution
+			cmd.SilenceUsage = true
+			// further errors will be logged properly, don't duplicate
+			cmd.SilenceErrors = true
+
+			return run(cmd.Context(), log, opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	if err := cmd.ExecuteContext(signals.SetupSignalHandler()); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+type options struct {
+	kubeconfig string
+	qps        float32
+	burst      int
+
+	runID            string
+	seeds            int
+	shoots           int
+	seedBoundShoots  int
+	seedName         string
+	templateSeedName string
+	projectNamespace string
+	shootTemplate    harness.ShootTemplate
+	concurrency      int
+
+	timeout           time.Duration
+	heartbeatInterval time.Duration
+	metricsEndpoints  map[string]string
+	metricsInterval   time.Duration
+
+	reportFile   string
+	baselineFile string
+	tolerance    float64
+	minDelta     time.Duration
+
+	cleanup        bool
+	cleanupTimeout time.Duration
+}
+
+func (o *options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.kubeconfig, "kubeconfig", os.Getenv("KUBECONFIG"), "Path to the kubeconfig of the garden cluster.")
+	fs.Float32Var(&o.qps, "qps", 200, "Maximum QPS of the load test client.")
+	fs.IntVar(&o.burst, "burst", 400, "Maximum burst of the load test client.")
+
+	fs.StringVar(&o.runID, "run-id", "", "ID of the run used in the names of the generated objects. A random ID is generated if empty.")
+	fs.IntVar(&o.seeds, "seeds", 10, "Number of synthetic seeds to generate. Their gardenlet heartbeats are simulated by the load test.")
+	fs.IntVar(&o.shoots, "shoots", 1000, "Number of shoots to generate which are scheduled onto the synthetic seeds.")
+	fs.IntVar(&o.seedBoundShoots, "seed-bound-shoots", 0, "Number of hibernated shoots to generate which are bound to the real seed given by --seed-name. They are used to measure how fast the gardenlet picks up new shoots.")
+	fs.StringVar(&o.seedName, "seed-name", "local", "Name of the real seed for the seed-bound shoots.")
+	fs.StringVar(&o.templateSeedName, "template-seed-name", "local", "Name of the seed whose specification is copied for the synthetic seeds.")
+	fs.StringVar(&o.projectNamespace, "project-namespace", "garden-local", "Namespace of the project in which the shoots are generated.")
+	fs.StringVar(&o.shootTemplate.CloudProfileName, "cloud-profile", "local", "Name of the CloudProfile used by the shoots.")
+	fs.StringVar(&o.shootTemplate.CredentialsBindingName, "credentials-binding", "local", "Name of the CredentialsBinding used by the shoots.")
+	fs.StringVar(&o.shootTemplate.Region, "region", "local", "Region of the shoots.")
+	fs.StringVar(&o.shootTemplate.ProviderType, "provider-type", "local", "Provider type of the shoots.")
+	fs.StringVar(&o.shootTemplate.MachineType, "machine-type", "local", "Machine type of the shoots' worker pool.")
+	fs.StringVar(&o.shootTemplate.NetworkingType, "networking-type", "calico", "Networking type of the shoots.")
+	fs.StringVar(&o.shootTemplate.NodesCIDR, "nodes-cidr", "10.0.0.0/16", "Node network of the shoots.")
+	fs.StringVar(&o.shootTemplate.KubernetesVersion, "kubernetes-version", "1.34", "Kubernetes version of the shoots.")
+	fs.IntVar(&o.concurrency, "concurrency", 20, "Number of objects which are created in parallel.")
+
+	fs.DurationVar(&o.timeout, "timeout", 30*time.Minute, "Maximum duration to wait for all objects to reach their expected phases.")
+	fs.DurationVar(&o.heartbeatInterval, "heartbeat-interval", 10*time.Second, "Interval in which the leases of the synthetic seeds are renewed.")
+	fs.StringToStringVar(&o.metricsEndpoints, "metrics-endpoint", nil, "Metrics endpoints of Gardener components whose workqueues should be measured, e.g. 'scheduler=http://localhost:19251/metrics'.")
+	fs.DurationVar(&o.metricsInterval, "metrics-interval", 10*time.Second, "Interval in which the metrics endpoints are scraped and the progress is reported.")
+
+	fs.StringVar(&o.reportFile, "report-file", "", "File to write the JSON report of the run to.")
+	fs.StringVar(&o.baselineFile, "baseline-file", "", "JSON report of a previous run to compare this run against. The command fails if regressions are detected.")
+	fs.Float64Var(&o.tolerance, "tolerance", 0.2, "Relative increase of a quantile compared to the baseline which is tolerated.")
+	fs.DurationVar(&o.minDelta, "min-regression", 100*time.Millisecond, "Minimum absolute increase of a quantile compared to the baseline which is reported as regression.")
+
+	fs.BoolVar(&o.cleanup, "cleanup", true, "Delete the generated objects after the run.")
+	fs.DurationVar(&o.cleanupTimeout, "cleanup-timeout", 15*time.Minute, "Maximum duration to wait for the generated objects to be deleted.")
+}
+
+func (o *options) validate() error {
+	if o.kubeconfig == "" {
+		return errors.New("--kubeconfig or the KUBECONFIG environment variable must be set")
+	}
+	if o.seeds < 1 && o.shoots > 0 {
+		return errors.New("--seeds must be at least 1 if --shoots is set")
+	}
+	if o.shoots < 0 || o.seedBoundShoots < 0 {
+		return errors.New("--shoots and --seed-bound-shoots must not be negative")
+	}
+	if o.concurrency < 1 {
+		return errors.New("--concurrency must be at least 1")
+	}
+	if o.tolerance < 0 {
+		return errors.New("--tolerance must not be negative")
+	}
+	return nil
+}
+
+func run(ctx context.Context, log logr.Logger, opts *options) error {
+	restConfig, err := clientcmd.BuildConfigFromFlags("", opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed reading kubeconfig: %w", err)
+	}
+	restConfig.QPS, restConfig.Burst = opts.qps, opts.burst
+
+	c, err := client.NewWithWatch(restConfig, client.Options{Scheme: kubernetes.GardenScheme})
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	var baseline *harness.Report
+	if opts.baselineFile != "" {
+		if baseline, err = harness.ReadReport(opts.baselineFile); err != nil {
+			return fmt.Errorf("failed reading baseline: %w", err)
+		}
+	}
+
+	runID := opts.runID
+	if runID == "" {
+		if runID, err = harness.NewRunID(); err != nil {
+			return err
+		}
+	}
+	log = log.WithValues("runID", runID)
+
+	templateSeed := &gardencorev1beta1.Seed{}
+	if err := c.Get(ctx, client.ObjectKey{Name: opts.templateSeedName}, templateSeed); err != nil {
+		return fmt.Errorf("failed reading template seed: %w", err)
+	}
+
+	var (
+		generator = &harness.Generator{
+			RunID:            runID,
+			ProjectNamespace: opts.projectNamespace,
+			TemplateSeed:     templateSeed,
+			ShootTemplate:    opts.shootTemplate,
+		}
+		recorder  = harness.NewRecorder(clock.RealClock{})
+		collector = &harness.MetricsCollector{
+			Endpoints:  opts.metricsEndpoints,
+			HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		}
+		errorCount atomic.Int64
+		report     = &harness.Report{
+			RunID:           runID,
+			StartTime:       time.Now().UTC(),
+			Seeds:           opts.seeds,
+			Shoots:          opts.shoots,
+			SeedBoundShoots: opts.seedBoundShoots,
+		}
+	)
+
+	if err := collector.Collect(ctx); err != nil {
+		return fmt.Errorf("failed initial scrape of metrics endpoints: %w", err)
+	}
+
+	// The heartbeats must continue until the cleanup finished, hence, they are not bound to the measurement.
+	heartbeatCtx, cancelHeartbeat := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelHeartbeat()
+
+	if opts.cleanup {
+		defer func() {
+			cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), opts.cleanupTimeout)
+			defer cancel()
+
+			if err := cleanup(cleanupCtx, log, c, generator, opts.concurrency); err != nil {
+				log.Error(err, "Failed cleaning up generated objects, delete them manually", "labelSelector", harness.LabelRunID+"="+runID)
+			}
+		}()
+	}
+
+	log.Info("Creating synthetic seeds", "count", opts.seeds, "templateSeed", opts.templateSeedName)
+	seeds, err := createSeeds(ctx, c, generator, opts.seeds, opts.concurrency)
+	if err != nil {
+		return err
+	}
+
+	if err := renewLeases(ctx, c, generator, seeds, opts.concurrency); err != nil {
+		return fmt.Errorf("failed creating leases of synthetic seeds: %w", err)
+	}
+	go wait.Until(func() {
+		if err := renewLeases(heartbeatCtx, c, generator, seeds, opts.concurrency); err != nil {
+			log.Error(err, "Failed renewing leases of synthetic seeds")
+		}
+	}, opts.heartbeatInterval, heartbeatCtx.Done())
+
+	measureCtx, cancelMeasure := context.WithTimeout(ctx, opts.timeout)
+	defer cancelMeasure()
+
+	go watchShoots(measureCtx, log, c, generator, recorder)
+
+	log.Info("Creating shoots", "count", opts.shoots, "seedBoundCount", opts.seedBoundShoots)
+	var tasks []flow.TaskFn
+	for i := range opts.shoots + opts.seedBoundShoots {
+		seedName, phases := "", []string{harness.PhaseScheduling}
+		if i >= opts.shoots {
+			seedName, phases = opts.seedName, []string{harness.PhaseGardenletPickup}
+		}
+
+		shoot := generator.Shoot(i, seedName)
+		tasks = append(tasks, func(ctx context.Context) error {
+			createdAt := time.Now()
+			if err := c.Create(ctx, shoot); err != nil {
+				errorCount.Add(1)
+				log.Error(err, "Failed creating shoot", "shoot", client.ObjectKeyFromObject(shoot))
+				return nil
+			}
+			recorder.Created(client.ObjectKeyFromObject(shoot).String(), createdAt, phases...)
+			return nil
+		})
+	}
+
+	go func() {
+		_ = flow.ParallelN(opts.concurrency, tasks...)(measureCtx)
+	}()
+
+	if err := wait.PollUntilContextCancel(measureCtx, opts.metricsInterval, false, func(ctx context.Context) (bool, error) {
+		if err := collector.Collect(ctx); err != nil {
+			log.Error(err, "Failed scraping metrics endpoints")
+		}
+
+		log.Info("Waiting for shoots",
+			"pendingScheduling", recorder.Pending(harness.PhaseScheduling),
+			"pendingGardenletPickup", recorder.Pending(harness.PhaseGardenletPickup),
+			"errors", errorCount.Load(),
+		)
+
+		summaries := recorder.Summaries()
+		created := summaries[harness.PhaseCreate].Count + uint64(errorCount.Load()) // #nosec G115 -- The error count is never negative.
+		return created == uint64(opts.shoots+opts.seedBoundShoots) && recorder.Done(), nil
+	}); err != nil {
+		log.Info("Not all shoots reached their expected phases before the timeout", "timeout", opts.timeout)
+	}
+
+	if err := collector.Collect(context.WithoutCancel(ctx)); err != nil {
+		log.Error(err, "Failed final scrape of metrics endpoints")
+	}
+
+	report.EndTime = time.Now().UTC()
+	report.Errors = int(errorCount.Load())
+	report.Latencies = recorder.Summaries()
+	report.Queues = collector.Summaries()
+	report.Pending = map[string]int{}
+	for _, phase := range []string{harness.PhaseScheduling, harness.PhaseGardenletPickup} {
+		if pending := recorder.Pending(phase); pending > 0 {
+			report.Pending[phase] = pending
+		}
+	}
+
+	if err := report.WriteText(os.Stdout); err != nil {
+		return err
+	}
+
+	if opts.reportFile != "" {
+		if err := report.WriteJSON(opts.reportFile); err != nil {
+			return fmt.Errorf("failed writing report: %w", err)
+		}
+		log.Info("Wrote report", "path", opts.reportFile)
+	}
+
+	if baseline != nil {
+		regressions := harness.Compare(baseline, report, opts.tolerance, opts.minDelta)
+		fmt.Printf("\nRegressions compared to baseline %s: %d\n", baseline.RunID, len(regressions))
+		for _, regression := range regressions {
+			fmt.Printf("  %s\n", regression)
+		}
+
+		if len(regressions) > 0 {
+			return fmt.Errorf("detected %d regression(s) compared to baseline %s", len(regressions), baseline.RunID)
+		}
+	}
+
+	return nil
+}
+
+func createSeeds(ctx context.Context, c client.Client, generator *harness.Generator, count, concurrency int) ([]*gardencorev1beta1.Seed, error) {
+	seeds := make([]*gardencorev1beta1.Seed, count)
+
+	var tasks []flow.TaskFn
+	for i := range count {
+		tasks = append(tasks, func(ctx context.Context) error {
+			seed := generator.Seed(i)
+			if err := c.Create(ctx, seed); err != nil {
+				return fmt.Errorf("failed creating seed %s: %w", seed.Name, err)
+			}
+
+			patch := client.MergeFrom(seed.DeepCopy())
+			generator.MutateSeedStatus(seed, time.Now())
+			if err := c.Status().Patch(ctx, seed, patch); err != nil {
+				return fmt.Errorf("failed updating status of seed %s: %w", seed.Name, err)
+			}
+
+			seeds[i] = seed
+			return nil
+		})
+	}
+
+	return seeds, flow.ParallelN(concurrency, tasks...)(ctx)
+}
+
+func renewLeases(ctx context.Context, c client.Client, generator *harness.Generator, seeds []*gardencorev1beta1.Seed, concurrency int) error {
+	var tasks []flow.TaskFn
+	for _, seed := range seeds {
+		tasks = append(tasks, func(ctx context.Context) error {
+			lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: seed.Name, Namespace: gardencorev1beta1.GardenerSeedLeaseNamespace}}
+			_, err := controllerutils.CreateOrGetAndMergePatch(ctx, c, lease, func() error {
+				generator.MutateSeedLease(lease, seed, time.Now())
+				return nil
+			})
+			return err
+		})
+	}
+
+	return flow.ParallelN(concurrency, tasks...)(ctx)
+}
+
+func watchShoots(ctx context.Context, log logr.Logger, c client.WithWatch, generator *harness.Generator, recorder *harness.Recorder) {
+	for ctx.Err() == nil {
+		watcher, err := c.Watch(ctx, &gardencorev1beta1.ShootList{}, client.InNamespace(generator.ProjectNamespace), client.MatchingLabels(generator.Labels()))
+		if err != nil {
+			log.Error(err, "Failed watching shoots, retrying")
+			time.Sleep(time.Second)
+			continue
+		}
+
+		for event := range watcher.ResultChan() {
+			if shoot, ok := event.Object.(*gardencorev1beta1.Shoot); ok {
+				recorder.ObserveShoot(shoot)
+			}
+		}
+		watcher.Stop()
+	}
+}
+
+func cleanup(ctx context.Context, log logr.Logger, c client.Client, generator *harness.Generator, concurrency int) error {
+	log.Info("Deleting shoots")
+	if err := deleteAll(ctx, c, &gardencorev1beta1.ShootList{}, concurrency, client.InNamespace(generator.ProjectNamespace), client.MatchingLabels(generator.Labels())); err != nil {
+		return err
+	}
+
+	// Seeds cannot be deleted as long as shoots are scheduled onto them.
+	if err := wait.PollUntilContextCancel(ctx, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := c.List(ctx, shootList, client.InNamespace(generator.ProjectNamespace), client.MatchingLabels(generator.Labels())); err != nil {
+			return false, err
+		}
+		log.Info("Waiting for shoots to be deleted", "remaining", len(shootList.Items))
+		return len(shootList.Items) == 0, nil
+	}); err != nil {
+		return fmt.Errorf("failed waiting for shoots to be deleted: %w", err)
+	}
+
+	log.Info("Deleting synthetic seeds")
+	return deleteAll(ctx, c, &gardencorev1beta1.SeedList{}, concurrency, client.MatchingLabels(generator.Labels()))
+}
+
+func deleteAll(ctx context.Context, c client.Client, list client.ObjectList, concurrency int, opts ...client.ListOption) error {
+	if err := c.List(ctx, list, opts...); err != nil {
+		return err
+	}
+
+	var tasks []flow.TaskFn
+	if err := meta.EachListItem(list, func(o runtime.Object) error {
+		obj, ok := o.(client.Object)
+		if !ok {
+			return fmt.Errorf("unexpected object type %T", o)
+		}
+
+		tasks = append(tasks, func(ctx context.Context) error {
+			if err := gardenerutils.ConfirmDeletion(ctx, c, obj); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			return client.IgnoreNotFound(c.Delete(ctx, obj))
+		})
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return flow.ParallelN(concurrency, tasks...)(ctx)
+}